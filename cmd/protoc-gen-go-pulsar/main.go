@@ -6,12 +6,16 @@ import (
 	"log"
 	"strings"
 
+	_ "github.com/cosmos/cosmos-proto/features/amino"
 	_ "github.com/cosmos/cosmos-proto/features/fastreflection"
+	_ "github.com/cosmos/cosmos-proto/features/interfaces"
+	_ "github.com/cosmos/cosmos-proto/features/json"
 	_ "github.com/cosmos/cosmos-proto/features/protoc"
 	"github.com/cosmos/cosmos-proto/generator"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/pluginpb"
 )
 
@@ -22,25 +26,73 @@ func (o ObjectSet) String() string {
 }
 
 func (o ObjectSet) Set(s string) error {
+	ident, err := parseGoIdent(s)
+	if err != nil {
+		return err
+	}
+	o[ident] = true
+	return nil
+}
+
+// parseGoIdent parses a fully qualified Go name, e.g. github.com/my/module/types.Tx.
+func parseGoIdent(s string) (protogen.GoIdent, error) {
 	idx := strings.LastIndexByte(s, '.')
 	if idx < 0 {
-		return fmt.Errorf("invalid object name: %q", s)
+		return protogen.GoIdent{}, fmt.Errorf("invalid object name: %q", s)
 	}
 
-	ident := protogen.GoIdent{
+	return protogen.GoIdent{
 		GoImportPath: protogen.GoImportPath(s[0:idx]),
 		GoName:       s[idx+1:],
+	}, nil
+}
+
+type FieldSet map[protoreflect.FullName]bool
+
+func (o FieldSet) String() string {
+	return fmt.Sprintf("%#v", o)
+}
+
+func (o FieldSet) Set(s string) error {
+	name := protoreflect.FullName(s)
+	if !name.IsValid() || name.Parent() == "" {
+		return fmt.Errorf("invalid field name: %q", s)
 	}
-	o[ident] = true
+	o[name] = true
+	return nil
+}
+
+// ScalarMap maps the names of the scalars declared with cosmos_proto.declare_scalar to the Go
+// types of the fields annotated with them.
+type ScalarMap map[string]protogen.GoIdent
+
+func (o ScalarMap) String() string {
+	return fmt.Sprintf("%#v", o)
+}
+
+func (o ScalarMap) Set(s string) error {
+	name, goType, ok := strings.Cut(s, "=")
+	if !ok || name == "" {
+		return fmt.Errorf("invalid scalar mapping: %q, expected name=import/path.Type", s)
+	}
+	ident, err := parseGoIdent(goType)
+	if err != nil {
+		return err
+	}
+	o[name] = ident
 	return nil
 }
 
 func main() {
 	var features string
 	poolable := make(ObjectSet)
+	lazy := make(FieldSet)
+	scalars := make(ScalarMap)
 
 	var f flag.FlagSet
 	f.Var(poolable, "pool", "use memory pooling for this object")
+	f.Var(lazy, "lazy", "decode this message field lazily")
+	f.Var(scalars, "scalar", "map the fields of this scalar to a Go type (name=import/path.Type)")
 	f.StringVar(&features, "features", "all", "list of features to generate (separated by '+')")
 
 	protogen.Options{ParamFunc: f.Set}.Run(func(plugin *protogen.Plugin) error {
@@ -53,14 +105,19 @@ func main() {
 				rewriteMessageField(message, processedMessages)
 			}
 		}
-		return generateAllFiles(plugin, strings.Split(features, "+"), poolable)
+		return generateAllFiles(plugin, strings.Split(features, "+"), poolable, lazy, scalars)
 	})
 }
 
-var SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+var SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL | pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
+
+const (
+	SupportedEditionsMinimum = descriptorpb.Edition_EDITION_PROTO2
+	SupportedEditionsMaximum = descriptorpb.Edition_EDITION_2023
+)
 
-func generateAllFiles(plugin *protogen.Plugin, featureNames []string, poolable ObjectSet) error {
-	ext := &generator.Extensions{Poolable: poolable}
+func generateAllFiles(plugin *protogen.Plugin, featureNames []string, poolable ObjectSet, lazy FieldSet, scalars ScalarMap) error {
+	ext := &generator.Extensions{Poolable: poolable, Lazy: lazy, Scalars: scalars}
 	gen, err := generator.NewGenerator(plugin.Files, featureNames, ext)
 	if err != nil {
 		return err
@@ -79,7 +136,9 @@ func generateAllFiles(plugin *protogen.Plugin, featureNames []string, poolable O
 		}
 	}
 
-	// plugin.SupportedFeatures = SupportedFeatures
+	plugin.SupportedFeatures = SupportedFeatures
+	plugin.SupportedEditionsMinimum = SupportedEditionsMinimum
+	plugin.SupportedEditionsMaximum = SupportedEditionsMaximum
 	return nil
 }
 
@@ -0,0 +1,294 @@
+package amino
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/cosmos/cosmos-proto/generator"
+	"github.com/cosmos/cosmos-proto/runtime"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	strconvPkg = protogen.GoImportPath("strconv")
+	slicesPkg  = protogen.GoImportPath("slices")
+	mapsPkg    = protogen.GoImportPath("maps")
+
+	runtimePackage = protogen.GoImportPath("github.com/cosmos/cosmos-proto/runtime")
+)
+
+func init() {
+	generator.RegisterFeature("amino", func(gen *generator.GeneratedFile, _ *protogen.Plugin) generator.FeatureGenerator {
+		return aminoFeature{GeneratedFile: gen}
+	})
+}
+
+// aminoFeature generates the MarshalAminoJSON method of the messages, which returns their
+// legacy Amino JSON encoding, signed in SIGN_MODE_LEGACY_AMINO_JSON. The fields are encoded by
+// the generated AppendAminoJSON method in the order of their name, so that the encoding has
+// its keys sorted without being sorted again, while the runtime encodes google.protobuf.Any,
+// resolving the Amino name of the held message, and the messages of other types.
+// The generated code relies on the message structs of the protoc feature.
+type aminoFeature struct {
+	*generator.GeneratedFile
+}
+
+func (g aminoFeature) GenerateFile(file *protogen.File, _ *protogen.Plugin) bool {
+	for _, message := range file.Messages {
+		g.genMessage(message)
+	}
+	return true
+}
+
+func (g aminoFeature) GenerateHelpers() {}
+
+func (g aminoFeature) genMessage(message *protogen.Message) {
+	if generatesAmino(message) {
+		g.genMarshalAminoJSON(message)
+		g.genAppendAminoJSON(message)
+	}
+	for _, nested := range message.Messages {
+		g.genMessage(nested)
+	}
+}
+
+// aminoMethods are the methods generated by the feature, which no field must shadow.
+var aminoMethods = map[string]bool{
+	"MarshalAminoJSON": true,
+	"AppendAminoJSON":  true,
+}
+
+// generatesAmino reports whether the Amino JSON methods are generated for the message. Like the
+// JSON methods, they are not for map entries, the well-known types, messages with weak fields and
+// messages with fields named after the methods, which the runtime encodes through reflection.
+func generatesAmino(message *protogen.Message) bool {
+	if message.Desc.IsMapEntry() || message.Desc.ParentFile().Package() == "google.protobuf" {
+		return false
+	}
+	for _, field := range message.Fields {
+		if field.Desc.IsWeak() || aminoMethods[field.GoName] {
+			return false
+		}
+	}
+	for _, oneof := range message.Oneofs {
+		if aminoMethods[oneof.GoName] {
+			return false
+		}
+	}
+	return true
+}
+
+func (g aminoFeature) genMarshalAminoJSON(message *protogen.Message) {
+	g.P("// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.")
+	g.P("func (x *", message.GoIdent, ") MarshalAminoJSON() ([]byte, error) {")
+	g.P("return x.AppendAminoJSON(nil)")
+	g.P("}")
+	g.P()
+}
+
+// genAppendAminoJSON generates AppendAminoJSON, which appends the populated fields of the
+// message sorted by name, each followed by a comma.
+func (g aminoFeature) genAppendAminoJSON(message *protogen.Message) {
+	fields := append([]*protogen.Field(nil), message.Fields...)
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].Desc.Name() < fields[j].Desc.Name()
+	})
+
+	g.P("// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.")
+	g.P("func (x *", message.GoIdent, ") AppendAminoJSON(b []byte) ([]byte, error) {")
+	g.P("if x == nil {")
+	g.P(`return append(b, "{}"...), nil`)
+	g.P("}")
+	needsErr := false
+	for _, field := range fields {
+		needsErr = needsErr || usesErr(field)
+	}
+	if needsErr {
+		g.P("var err error")
+	}
+	g.P("b = append(b, '{')")
+	for _, field := range fields {
+		g.genField(field)
+	}
+	g.P("if b[len(b)-1] == ',' {")
+	g.P("b[len(b)-1] = '}'")
+	g.P("} else {")
+	g.P("b = append(b, '}')")
+	g.P("}")
+	g.P("return b, nil")
+	g.P("}")
+	g.P()
+}
+
+func (g aminoFeature) genField(field *protogen.Field) {
+	if g.IsLazy(field) {
+		g.P("x.lazyDecode", field.GoName, "()")
+	}
+	fd := field.Desc
+	switch {
+	case field.Oneof != nil && !field.Oneof.Desc.IsSynthetic():
+		g.P("if v, ok := x.", field.Oneof.GoName, ".(*", field.GoIdent, "); ok {")
+		g.genSingular(field, "v."+field.GoName)
+	case fd.IsMap():
+		g.P("if len(x.", field.GoName, ") > 0 {")
+		g.genMap(field)
+	case fd.IsList():
+		g.P("if len(x.", field.GoName, ") > 0 {")
+		g.genList(field)
+	case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+		g.P("if x.", field.GoName, " != nil {")
+		g.genSingular(field, "x."+field.GoName)
+	case fd.HasPresence():
+		g.P("if x.", field.GoName, " != nil {")
+		if fd.Kind() == protoreflect.BytesKind {
+			g.genSingular(field, "x."+field.GoName)
+		} else {
+			g.P("v := *x.", field.GoName)
+			g.genSingular(field, "v")
+		}
+	case g.IsScalar(field):
+		g.P("if v, err := ", runtimePackage.Ident("MarshalScalar"), "(", generator.FieldDescriptorName(field), ", &x.", field.GoName, "); err != nil {")
+		g.P("return b, err")
+		g.P("} else if len(v) > 0 {")
+		if fd.Kind() == protoreflect.StringKind {
+			g.genSingular(field, "string(v)")
+		} else {
+			g.genSingular(field, "v")
+		}
+	default:
+		g.P("if ", zeroCheck(field), " {")
+		g.genSingular(field, "x."+field.GoName)
+	}
+	g.P("}")
+}
+
+// zeroCheck returns the condition under which the field without explicit presence holds a
+// value other than the zero value, the other values being omitted.
+func zeroCheck(field *protogen.Field) string {
+	v := "x." + field.GoName
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return v
+	case protoreflect.StringKind:
+		return v + ` != ""`
+	case protoreflect.BytesKind:
+		return "len(" + v + ") > 0"
+	default:
+		return v + " != 0"
+	}
+}
+
+func (g aminoFeature) genSingular(field *protogen.Field, v string) {
+	g.P("b = append(b, ", fieldName(field), "...)")
+	g.genValue(field, v)
+	g.P("b = append(b, ',')")
+}
+
+func (g aminoFeature) genList(field *protogen.Field) {
+	g.P("b = append(b, ", fieldName(field), "...)")
+	g.P("b = append(b, '[')")
+	g.P("for _, v := range x.", field.GoName, " {")
+	g.genValue(field, "v")
+	g.P("b = append(b, ',')")
+	g.P("}")
+	g.P("b[len(b)-1] = ']'")
+	g.P("b = append(b, ',')")
+}
+
+// genMap generates the encoding of the map field, whose keys are sorted as JSON strings.
+func (g aminoFeature) genMap(field *protogen.Field) {
+	key, value := field.Message.Fields[0], field.Message.Fields[1]
+	g.P("b = append(b, ", fieldName(field), "...)")
+	g.P("b = append(b, '{')")
+	switch key.Desc.Kind() {
+	case protoreflect.StringKind:
+		g.P("for _, k := range ", slicesPkg.Ident("Sorted"), "(", mapsPkg.Ident("Keys"), "(x.", field.GoName, ")) {")
+	case protoreflect.BoolKind:
+		g.P("for _, k := range [...]bool{false, true} {")
+		g.P("if _, ok := x.", field.GoName, "[k]; !ok {")
+		g.P("continue")
+		g.P("}")
+	default:
+		g.P("for _, k := range ", runtimePackage.Ident("SortedAminoJSONKeys"), "(x.", field.GoName, ") {")
+	}
+	g.P("v := x.", field.GoName, "[k]")
+	switch key.Desc.Kind() {
+	case protoreflect.StringKind:
+		g.P("b = ", runtimePackage.Ident("AppendAminoJSONString"), "(b, k)")
+	case protoreflect.BoolKind:
+		g.P("b = append(b, '\"')")
+		g.P("b = ", strconvPkg.Ident("AppendBool"), "(b, k)")
+		g.P("b = append(b, '\"')")
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		g.P("b = ", runtimePackage.Ident("AppendJSONUint64"), "(b, uint64(k))")
+	default:
+		g.P("b = ", runtimePackage.Ident("AppendJSONInt64"), "(b, int64(k))")
+	}
+	g.P("b = append(b, ':')")
+	g.genValue(value, "v")
+	g.P("b = append(b, ',')")
+	g.P("}")
+	g.P("b[len(b)-1] = '}'")
+	g.P("b = append(b, ',')")
+}
+
+// genValue generates the encoding of v, a singular value of the field or an element of its list.
+func (g aminoFeature) genValue(field *protogen.Field, v string) {
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		g.P("b = ", strconvPkg.Ident("AppendBool"), "(b, ", v, ")")
+	case protoreflect.StringKind:
+		g.P("b = ", runtimePackage.Ident("AppendAminoJSONString"), "(b, ", v, ")")
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		g.P("b = ", strconvPkg.Ident("AppendInt"), "(b, int64(", v, "), 10)")
+	case protoreflect.EnumKind:
+		g.P("b = ", strconvPkg.Ident("AppendInt"), "(b, int64(", v, "), 10)")
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		g.P("b = ", strconvPkg.Ident("AppendUint"), "(b, uint64(", v, "), 10)")
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		g.P("b = ", runtimePackage.Ident("AppendJSONInt64"), "(b, ", v, ")")
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		g.P("b = ", runtimePackage.Ident("AppendJSONUint64"), "(b, ", v, ")")
+	case protoreflect.FloatKind:
+		g.P("if b, err = ", runtimePackage.Ident("AppendAminoJSONFloat"), "(b, float64(", v, "), 32); err != nil {")
+		g.P("return b, err")
+		g.P("}")
+	case protoreflect.DoubleKind:
+		g.P("if b, err = ", runtimePackage.Ident("AppendAminoJSONFloat"), "(b, ", v, ", 64); err != nil {")
+		g.P("return b, err")
+		g.P("}")
+	case protoreflect.BytesKind:
+		g.P("b = ", runtimePackage.Ident("AppendJSONBytes"), "(b, ", v, ")")
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch {
+		case field.Message.Desc.FullName() == "google.protobuf.Any":
+			iface := runtime.AcceptsInterface(field.Desc)
+			g.P("if b, err = ", runtimePackage.Ident("AppendAminoJSONAny"), "(b, ", v, ", ", strconv.Quote(iface), "); err != nil {")
+		case g.IsLocalMessage(field.Message) && generatesAmino(field.Message):
+			g.P("if b, err = ", v, ".AppendAminoJSON(b); err != nil {")
+		default:
+			g.P("if b, err = ", runtimePackage.Ident("AppendAminoJSONMessage"), "(b, ", v, "); err != nil {")
+		}
+		g.P("return b, err")
+		g.P("}")
+	}
+}
+
+// fieldName returns the Go string literal of the proto name of the field followed by a colon.
+func fieldName(field *protogen.Field) string {
+	name := runtime.AppendAminoJSONString(nil, string(field.Desc.Name()))
+	return strconv.Quote(string(name) + ":")
+}
+
+// usesErr reports whether the encoding of the field can fail.
+func usesErr(field *protogen.Field) bool {
+	if field.Desc.IsMap() {
+		return usesErr(field.Message.Fields[1])
+	}
+	switch field.Desc.Kind() {
+	case protoreflect.FloatKind, protoreflect.DoubleKind, protoreflect.MessageKind, protoreflect.GroupKind:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+package fastreflection
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// generatesValidateCanonical reports whether the ValidateCanonical method is generated for the
+// message, which is not when a field or a oneof would shadow it.
+func generatesValidateCanonical(message *protogen.Message) bool {
+	for _, field := range message.Fields {
+		if field.GoName == "ValidateCanonical" {
+			return false
+		}
+	}
+	for _, oneof := range message.Oneofs {
+		if oneof.GoName == "ValidateCanonical" {
+			return false
+		}
+	}
+	return true
+}
+
+// genValidateCanonical generates ValidateCanonical, which describes each field encoded in the
+// given bytes to a runtime.CanonicalWalker according to its number, statically.
+func (g *fastGenerator) genValidateCanonical() {
+	if !generatesValidateCanonical(g.message) {
+		return
+	}
+	g.P("// ValidateCanonical returns a *runtime.CanonicalError locating the first difference")
+	g.P("// between b and the canonical encoding of a ", g.message.GoIdent.GoName, ", or nil if b is canonical.")
+	g.P("func (*", g.message.GoIdent, ") ValidateCanonical(b []byte) error {")
+	g.P("w := ", runtimePackage.Ident("NewCanonicalWalker"), "(b, ", messageDescriptorName(g.message), ".FullName())")
+	g.P("for w.Next() {")
+	g.P("switch w.Number() {")
+	oneofs := 0
+	oneofIndexes := map[*protogen.Oneof]int{}
+	for _, oneof := range g.message.Oneofs {
+		if !oneof.Desc.IsSynthetic() {
+			oneofIndexes[oneof] = oneofs
+			oneofs++
+		}
+	}
+	for _, field := range g.message.Fields {
+		g.P("case ", field.Desc.Number(), ":")
+		if inOneof(field) {
+			g.P("w.Oneof(", oneofIndexes[field.Oneof], ")")
+		}
+		g.genValidateCanonicalField(field)
+	}
+	g.P("default:")
+	if hasExtensions(g.message) {
+		g.P("if ", messageDescriptorName(g.message), ".ExtensionRanges().Has(w.Number()) {")
+		g.P("w.Extension()")
+		g.P("} else {")
+		g.P("w.Unknown()")
+		g.P("}")
+	} else {
+		g.P("w.Unknown()")
+	}
+	g.P("}")
+	g.P("}")
+	g.P("return w.Err()")
+	g.P("}")
+	g.P()
+}
+
+func (g *fastGenerator) genValidateCanonicalField(field *protogen.Field) {
+	fd := field.Desc
+	name := `"` + string(fd.Name()) + `"`
+	switch {
+	case fd.IsMap():
+		key, value := field.Message.Fields[0], field.Message.Fields[1]
+		validator := "nil"
+		if value.Message != nil {
+			validator = g.canonicalValidator(value.Message, fieldDescriptorName(field)+".MapValue().Message()")
+		}
+		g.P("w.Map(", name, ", ", kindIdent(key.Desc.Kind()), ", ", kindIdent(value.Desc.Kind()), ", ", validator, ")")
+	case fd.Kind() == protoreflect.MessageKind:
+		g.P("w.Message(", name, ", ", fd.IsList(), ", ", g.canonicalValidator(field.Message, fieldDescriptorName(field)+".Message()"), ")")
+	case fd.Kind() == protoreflect.GroupKind:
+		g.P("w.Group(", name, ", ", fd.IsList(), ", ", g.canonicalValidator(field.Message, fieldDescriptorName(field)+".Message()"), ")")
+	case fd.IsList():
+		g.P("w.List(", name, ", ", kindIdent(fd.Kind()), ", ", fd.IsPacked(), ")")
+	default:
+		g.P("w.Scalar(", name, ", ", kindIdent(fd.Kind()), ", ", !fd.HasPresence(), ")")
+	}
+}
+
+// canonicalValidator returns the validator of the message, which is the message itself when
+// it has a generated ValidateCanonical method, and otherwise the one the runtime finds from
+// its descriptor, obtained by md.
+func (g *fastGenerator) canonicalValidator(message *protogen.Message, md string) string {
+	if g.IsLocalMessage(message) && generatesValidateCanonical(message) {
+		return "(*" + g.QualifiedGoIdent(message.GoIdent) + ")(nil)"
+	}
+	return g.QualifiedGoIdent(runtimePackage.Ident("CanonicalValidatorOf")) + "(" + md + ")"
+}
+
+func kindIdent(kind protoreflect.Kind) protogen.GoIdent {
+	return protoreflectPkg.Ident(kindNames[kind])
+}
+
+var kindNames = map[protoreflect.Kind]string{
+	protoreflect.BoolKind:     "BoolKind",
+	protoreflect.EnumKind:     "EnumKind",
+	protoreflect.Int32Kind:    "Int32Kind",
+	protoreflect.Sint32Kind:   "Sint32Kind",
+	protoreflect.Uint32Kind:   "Uint32Kind",
+	protoreflect.Int64Kind:    "Int64Kind",
+	protoreflect.Sint64Kind:   "Sint64Kind",
+	protoreflect.Uint64Kind:   "Uint64Kind",
+	protoreflect.Sfixed32Kind: "Sfixed32Kind",
+	protoreflect.Fixed32Kind:  "Fixed32Kind",
+	protoreflect.FloatKind:    "FloatKind",
+	protoreflect.Sfixed64Kind: "Sfixed64Kind",
+	protoreflect.Fixed64Kind:  "Fixed64Kind",
+	protoreflect.DoubleKind:   "DoubleKind",
+	protoreflect.StringKind:   "StringKind",
+	protoreflect.BytesKind:    "BytesKind",
+	protoreflect.MessageKind:  "MessageKind",
+	protoreflect.GroupKind:    "GroupKind",
+}
@@ -31,21 +31,22 @@ func (g *clearGen) genComments() {
 func (g *clearGen) generate() {
 	g.genComments()
 	g.P("func (x *", g.typeName, ") Clear(fd ", protoreflectPkg.Ident("FieldDescriptor"), ") {")
-	g.P("switch fd.FullName() {")
+	genFieldSwitch(g.GeneratedFile, "fd")
 	for _, field := range g.message.Fields {
 		g.genField(field)
+		g.P("return")
 	}
-	g.P("default:")
-	g.P("if fd.IsExtension() {")
-	g.P("panic(", fmtPkg.Ident("Errorf"), "(\"proto3 declared messages do not support extensions: ", g.message.Desc.FullName(), "\"))")
-	g.P("}")
-	g.P("panic(fmt.Errorf(\"message ", g.message.Desc.FullName(), " does not contain field %s\", fd.FullName()))")
-	g.P("}")
+	genFieldSwitchEnd(g.GeneratedFile, g.message, "fd", "Clear", false)
 	g.P("}")
 }
 
 func (g *clearGen) genField(field *protogen.Field) {
-	g.P("case \"", field.Desc.FullName(), "\":")
+	genFieldCase(g.GeneratedFile, field, "fd")
+	genLazyDiscard(g.GeneratedFile, field, "x")
+	if g.IsScalar(field) {
+		g.P(runtimePackage.Ident("ClearScalar"), "(&x.", field.GoName, ")")
+		return
+	}
 	if field.Desc.HasPresence() || field.Desc.IsList() || field.Desc.IsMap() || field.Desc.Kind() == protoreflect.BytesKind {
 		g.genNullable(field)
 		return
@@ -56,11 +57,11 @@ func (g *clearGen) genField(field *protogen.Field) {
 
 func (g *clearGen) genNullable(field *protogen.Field) {
 	switch {
-	case field.Desc.ContainingOneof() != nil:
+	case inOneof(field):
 		g.P("x.", field.Oneof.GoName, " = nil")
-	case field.Desc.IsMap(), field.Desc.IsList(), field.Desc.Kind() == protoreflect.BytesKind:
+	case field.Desc.IsMap(), field.Desc.IsList(), field.Desc.Kind() == protoreflect.BytesKind, hasExplicitPresence(field):
 		g.P("x.", field.GoName, " = nil")
-	case field.Desc.Kind() == protoreflect.MessageKind:
+	case field.Desc.Kind() == protoreflect.MessageKind, field.Desc.Kind() == protoreflect.GroupKind:
 		g.P(" x.", field.GoName, " = nil")
 	default:
 		panic("unknown case")
@@ -0,0 +1,167 @@
+package fastreflection
+
+import (
+	"github.com/cosmos/cosmos-proto/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// cloneGen generates the Clone and CopyInto methods, deep copying a message
+// field by field without going through reflection or the wire format.
+type cloneGen struct {
+	*generator.GeneratedFile
+	file    *protogen.File
+	message *protogen.Message
+}
+
+func (g *cloneGen) generate() {
+	if !hasCloneMethods(g.message) {
+		return
+	}
+	g.P("// Clone returns a deep copy of the message.")
+	g.P("func (x *", g.message.GoIdent, ") Clone() *", g.message.GoIdent, " {")
+	g.P("if x == nil {")
+	g.P("return nil")
+	g.P("}")
+	g.P("dst := new(", g.message.GoIdent, ")")
+	g.P("x.CopyInto(dst)")
+	g.P("return dst")
+	g.P("}")
+	g.P()
+
+	g.P("// CopyInto overwrites dst with a deep copy of the message,")
+	g.P("// dst does not share any memory with the message afterwards.")
+	g.P("func (x *", g.message.GoIdent, ") CopyInto(dst *", g.message.GoIdent, ") {")
+	g.P("if x == dst {")
+	g.P("return")
+	g.P("}")
+	g.P("if x == nil {")
+	g.P("dst.Reset()")
+	g.P("return")
+	g.P("}")
+	oneofs := make(map[string]struct{})
+	for _, field := range g.message.Fields {
+		if !inOneof(field) {
+			genLazyDecode(g.GeneratedFile, field, "x")
+			genLazyDiscard(g.GeneratedFile, field, "dst")
+			g.genField(field)
+			continue
+		}
+		if _, ok := oneofs[field.Oneof.GoName]; ok {
+			continue
+		}
+		oneofs[field.Oneof.GoName] = struct{}{}
+		g.genOneof(field.Oneof)
+	}
+	if hasExtensions(g.message) {
+		g.P("dst.extensionFields = nil")
+		g.P("if len(x.extensionFields) > 0 {")
+		g.P(runtimePackage.Ident("MergeExtensions"), "(&dst.extensionFields, &x.extensionFields)")
+		g.P("}")
+	}
+	g.P("dst.unknownFields = ", bytesPkg.Ident("Clone"), "(x.unknownFields)")
+	// the size cached in dst no longer matches its content
+	g.P("dst.sizeCache = 0")
+	g.P("}")
+	g.P()
+}
+
+// hasCloneMethods reports whether Clone and CopyInto are generated for the message,
+// which is not the case when they would clash with the Go name of a field or oneof.
+func hasCloneMethods(message *protogen.Message) bool {
+	for _, field := range message.Fields {
+		if field.GoName == "Clone" || field.GoName == "CopyInto" {
+			return false
+		}
+	}
+	for _, oneof := range message.Oneofs {
+		if oneof.GoName == "Clone" || oneof.GoName == "CopyInto" {
+			return false
+		}
+	}
+	return true
+}
+
+// cloneValue returns the expression deep copying the value v of the field,
+// or of its elements for lists and maps.
+func (g *cloneGen) cloneValue(field *protogen.Field, v string) string {
+	switch {
+	case field.Message != nil:
+		// messages of other packages may be generated without Clone
+		if field.Message.GoIdent.GoImportPath == g.file.GoImportPath && hasCloneMethods(field.Message) {
+			return v + ".Clone()"
+		}
+		return g.QualifiedGoIdent(protoPkg.Ident("Clone")) + "(" + v + ").(*" + g.QualifiedGoIdent(field.Message.GoIdent) + ")"
+	case field.Desc.Kind() == protoreflect.BytesKind:
+		return g.QualifiedGoIdent(bytesPkg.Ident("Clone")) + "(" + v + ")"
+	default:
+		return v
+	}
+}
+
+func (g *cloneGen) genField(field *protogen.Field) {
+	name := field.GoName
+	switch {
+	case field.Desc.IsMap():
+		g.genMap(field)
+	case field.Desc.IsList():
+		g.genList(field)
+	case g.IsScalar(field):
+		g.P(runtimePackage.Ident("CopyScalar"), "(", fieldDescriptorName(field), ", &dst.", name, ", &x.", name, ")")
+	case hasExplicitPresence(field) && field.Desc.Kind() != protoreflect.BytesKind:
+		g.P("if x.", name, " != nil {")
+		g.P("v := *x.", name)
+		g.P("dst.", name, " = &v")
+		g.P("} else {")
+		g.P("dst.", name, " = nil")
+		g.P("}")
+	default:
+		g.P("dst.", name, " = ", g.cloneValue(field, "x."+name))
+	}
+}
+
+func (g *cloneGen) genList(field *protogen.Field) {
+	name := field.GoName
+	if field.Message == nil && field.Desc.Kind() != protoreflect.BytesKind {
+		g.P("dst.", name, " = ", slicesPkg.Ident("Clone"), "(x.", name, ")")
+		return
+	}
+	g.P("if x.", name, " != nil {")
+	goType, _ := g.FieldGoType(field)
+	g.P("dst.", name, " = make(", goType, ", len(x.", name, "))")
+	g.P("for i, v := range x.", name, " {")
+	g.P("dst.", name, "[i] = ", g.cloneValue(field, "v"))
+	g.P("}")
+	g.P("} else {")
+	g.P("dst.", name, " = nil")
+	g.P("}")
+}
+
+func (g *cloneGen) genMap(field *protogen.Field) {
+	name := field.GoName
+	value := field.Message.Fields[1]
+	if value.Message == nil && value.Desc.Kind() != protoreflect.BytesKind {
+		g.P("dst.", name, " = ", mapsPkg.Ident("Clone"), "(x.", name, ")")
+		return
+	}
+	g.P("if x.", name, " != nil {")
+	goType, _ := g.FieldGoType(field)
+	g.P("dst.", name, " = make(", goType, ", len(x.", name, "))")
+	g.P("for k, v := range x.", name, " {")
+	g.P("dst.", name, "[k] = ", g.cloneValue(value, "v"))
+	g.P("}")
+	g.P("} else {")
+	g.P("dst.", name, " = nil")
+	g.P("}")
+}
+
+func (g *cloneGen) genOneof(oneof *protogen.Oneof) {
+	g.P("switch v := x.", oneof.GoName, ".(type) {")
+	g.P("case nil:")
+	g.P("dst.", oneof.GoName, " = nil")
+	for _, field := range oneof.Fields {
+		g.P("case *", field.GoIdent, ":")
+		g.P("dst.", oneof.GoName, " = &", field.GoIdent, "{", field.GoName, ": ", g.cloneValue(field, "v."+field.GoName), "}")
+	}
+	g.P("}")
+}
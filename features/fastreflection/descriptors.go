@@ -1,7 +1,6 @@
 package fastreflection
 
 import (
-	"fmt"
 	"github.com/cosmos/cosmos-proto/generator"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
@@ -39,7 +38,7 @@ func (g *descGen) generate() {
 }
 
 func fieldDescriptorName(field *protogen.Field) string {
-	return fmt.Sprintf("fd_%s_%s", field.Parent.GoIdent.GoName, field.Desc.Name())
+	return generator.FieldDescriptorName(field)
 }
 
 func findParents(message protoreflect.MessageDescriptor) []protoreflect.MessageDescriptor {
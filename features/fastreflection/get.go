@@ -15,16 +15,14 @@ type getGen struct {
 func (g *getGen) generate() {
 	g.genComment()
 	g.P("func (x *", g.typeName, ") Get(descriptor ", protoreflectPkg.Ident("FieldDescriptor"), ") ", protoreflectPkg.Ident("Value"), " {")
-	g.P("switch descriptor.FullName() {")
+	genFieldSwitch(g.GeneratedFile, "descriptor")
 	// implement the fastReflectionFeature Get function
 	for _, field := range g.message.Fields {
-		g.P("case \"", field.Desc.FullName(), "\":")
+		genFieldCase(g.GeneratedFile, field, "descriptor")
+		genLazyDecode(g.GeneratedFile, field, messageReceiver(g.GeneratedFile, g.message))
 		g.genFieldGetter(field)
 	}
-	// insert default case which panics
-	g.P("default:")
-	g.genDefaultCase()
-	g.P("}")
+	genFieldSwitchEnd(g.GeneratedFile, g.message, "descriptor", "Get", true)
 	g.P("}")
 	g.P()
 }
@@ -39,7 +37,7 @@ func (g *getGen) genComment() {
 }
 
 func (g *getGen) genFieldGetter(field *protogen.Field) {
-	if field.Oneof != nil {
+	if inOneof(field) {
 		g.genOneofGetter(field)
 		return
 	}
@@ -54,6 +52,18 @@ func (g *getGen) genFieldGetter(field *protogen.Field) {
 	}
 
 	fieldRef := "x." + field.GoName
+	if g.IsScalar(field) {
+		g.P("return ", runtimePackage.Ident("ScalarValue"), "(descriptor, &", fieldRef, ")")
+		return
+	}
+	if hasExplicitPresence(field) {
+		g.P("if ", fieldRef, " == nil {")
+		g.P("return ", defaultValueForField(g.GeneratedFile, field))
+		g.P("}")
+		if field.Desc.Kind() != protoreflect.BytesKind {
+			fieldRef = "*" + fieldRef
+		}
+	}
 	g.P("value := ", fieldRef)
 	switch field.Desc.Kind() {
 	case protoreflect.BoolKind:
@@ -85,16 +95,16 @@ func (g *getGen) genOneofGetter(fd *protogen.Field) {
 	// handle the case in which the oneof field is not set
 	g.P("if x.", fd.Oneof.GoName, " == nil {")
 	switch fd.Desc.Kind() {
-	case protoreflect.MessageKind:
+	case protoreflect.MessageKind, protoreflect.GroupKind:
 		g.P("return ", kindToValueConstructor(fd.Desc.Kind()), "((*", g.QualifiedGoIdent(fd.Message.GoIdent), ")(nil).ProtoReflect())")
 	default:
-		g.P("return ", kindToValueConstructor(fd.Desc.Kind()), "(", zeroValueForField(g.GeneratedFile, fd), ")")
+		g.P("return ", defaultValueForField(g.GeneratedFile, fd))
 	}
 	// handle the case in which oneof field is set and it matches our sub-onefield type
 	g.P("} else if v, ok := x.", fd.Oneof.GoName, ".(*", fd.GoIdent, "); ok {")
 	oneofTypeContainerFieldName := fd.GoName // field containing the oneof value
 	switch fd.Desc.Kind() {
-	case protoreflect.MessageKind: // it can be mutable
+	case protoreflect.MessageKind, protoreflect.GroupKind: // it can be mutable
 		g.P("return ", kindToValueConstructor(fd.Desc.Kind()), "(v.", oneofTypeContainerFieldName, ".ProtoReflect())")
 	case protoreflect.EnumKind:
 		g.P("return ", kindToValueConstructor(fd.Desc.Kind()), "((", protoreflectPkg.Ident("EnumNumber"), ")(v.", oneofTypeContainerFieldName, "))")
@@ -104,22 +114,14 @@ func (g *getGen) genOneofGetter(fd *protogen.Field) {
 	// handle the case in which the oneof field is set but it does not match our field type
 	g.P("} else {")
 	switch fd.Desc.Kind() {
-	case protoreflect.MessageKind:
+	case protoreflect.MessageKind, protoreflect.GroupKind:
 		g.P("return ", kindToValueConstructor(fd.Desc.Kind()), "((*", g.QualifiedGoIdent(fd.Message.GoIdent), ")(nil).ProtoReflect())")
 	default:
-		g.P("return ", kindToValueConstructor(fd.Desc.Kind()), "(", zeroValueForField(g.GeneratedFile, fd), ")")
+		g.P("return ", defaultValueForField(g.GeneratedFile, fd))
 	}
 	g.P("}")
 }
 
-// genDefaultCase generates the default case for field descriptor
-func (g *getGen) genDefaultCase() {
-	g.P("if descriptor.IsExtension() {")
-	g.P("panic(", fmtPkg.Ident("Errorf"), "(\"proto3 declared messages do not support extensions: ", g.message.Desc.FullName(), "\"))")
-	g.P("}")
-	g.P("panic(fmt.Errorf(\"message ", g.message.Desc.FullName(), " does not contain field %s\", descriptor.FullName()))")
-}
-
 // genMap generates the protoreflect.Message.Get for map types
 func (g *getGen) genMap(field *protogen.Field) {
 	// gen invalid case
@@ -17,6 +17,7 @@ const (
 // if map len(map) != 0
 // if oneof: oneof != nil (if oneof is scalar do we need to check it??)
 // if bytes: len(bytes) != 0
+// if scalar with explicit presence: value != nil
 type hasGen struct {
 	*generator.GeneratedFile
 	typeName string
@@ -40,21 +41,26 @@ func (g *hasGen) genComments() {
 func (g *hasGen) generate() {
 	g.genComments()
 	g.P("func (x *", g.typeName, ") Has(fd ", protoreflectPkg.Ident("FieldDescriptor"), ") bool {")
-	g.P("switch fd.FullName() {")
+	genFieldSwitch(g.GeneratedFile, "fd")
 	for _, field := range g.message.Fields {
 		g.genField(field)
 	}
-	g.P("default:")
-	g.P("if fd.IsExtension() {")
-	g.P("panic(", fmtPkg.Ident("Errorf"), "(\"proto3 declared messages do not support extensions: ", g.message.Desc.FullName(), "\"))")
-	g.P("}")
-	g.P("panic(fmt.Errorf(\"message ", g.message.Desc.FullName(), " does not contain field %s\", fd.FullName()))")
-	g.P("}")
+	genFieldSwitchEnd(g.GeneratedFile, g.message, "fd", "Has", true)
 	g.P("}")
 }
 
 func (g *hasGen) genField(field *protogen.Field) {
-	g.P("case \"", field.Desc.FullName(), "\":")
+	genFieldCase(g.GeneratedFile, field, "fd")
+	if g.IsLazy(field) {
+		// a recorded encoding holds at least one occurrence of the field
+		g.P("if x.lazyFields.Pending(", g.LazyIndex(field), ") {")
+		g.P("return true")
+		g.P("}")
+	}
+	if g.IsScalar(field) {
+		g.P("return ", runtimePackage.Ident("HasScalar"), "(&x.", field.GoName, ")")
+		return
+	}
 	if field.Desc.HasPresence() || field.Desc.IsList() || field.Desc.IsMap() || field.Desc.Kind() == protoreflect.BytesKind {
 		g.genNullable(field)
 		return
@@ -73,7 +79,7 @@ func (g *hasGen) genField(field *protogen.Field) {
 
 func (g *hasGen) genNullable(field *protogen.Field) {
 	switch {
-	case field.Desc.ContainingOneof() != nil:
+	case inOneof(field):
 		// case oneof is nil
 		g.P("if x.", field.Oneof.GoName, " == nil {")
 		g.P("return false")
@@ -84,9 +90,11 @@ func (g *hasGen) genNullable(field *protogen.Field) {
 		g.P("} else { ")
 		g.P("return false")
 		g.P("}")
+	case hasExplicitPresence(field):
+		g.P("return x.", field.GoName, " != nil")
 	case field.Desc.IsMap(), field.Desc.IsList(), field.Desc.Kind() == protoreflect.BytesKind:
 		g.P("return len(x.", field.GoName, ") != 0")
-	case field.Desc.Kind() == protoreflect.MessageKind:
+	case field.Desc.Kind() == protoreflect.MessageKind, field.Desc.Kind() == protoreflect.GroupKind:
 		g.P("return x.", field.GoName, " != nil")
 	default:
 		panic("unknown case")
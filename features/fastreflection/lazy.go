@@ -0,0 +1,49 @@
+package fastreflection
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-proto/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// The encoding of lazy fields is recorded by the generated unmarshal in the lazyFields
+// struct field, and decoded by the lazyDecode methods generated along the getters.
+// Read accesses decode the field first, while write accesses discard its encoding,
+// which is otherwise marshalled back in place of the field until it is decoded.
+
+// genLazyDecode decodes the field of the message x when it is lazy.
+func genLazyDecode(g *generator.GeneratedFile, field *protogen.Field, x string) {
+	if g.IsLazy(field) {
+		g.P(x, ".lazyDecode", field.GoName, "()")
+	}
+}
+
+// messageReceiver returns the receiver of the reflection methods, x, as the message type,
+// whose lazyDecode methods are not promoted to its fastReflection type.
+func messageReceiver(g *generator.GeneratedFile, message *protogen.Message) string {
+	return "(*" + g.QualifiedGoIdent(message.GoIdent) + ")(x)"
+}
+
+// genLazyDiscard discards the encoding of the field of the message x when it is lazy,
+// the field being written. The field must have been decoded before unless it is replaced.
+func genLazyDiscard(g *generator.GeneratedFile, field *protogen.Field, x string) {
+	if g.IsLazy(field) {
+		g.P(x, ".lazyFields.Discard(", g.LazyIndex(field), ")")
+	}
+}
+
+// lazyRaw returns the expression of the encoding of the lazy field of the message x
+// to marshal back, nil when the field must be marshalled.
+func lazyRaw(g *generator.GeneratedFile, field *protogen.Field, x string) string {
+	raw := "RawLazy"
+	if field.Desc.IsList() {
+		raw = "RawLazyList"
+	}
+	return g.QualifiedGoIdent(runtimePackage.Ident(raw)) + "(&" + x + ".lazyFields, " + strconv.Itoa(g.LazyIndex(field)) + ", &" + x + "." + field.GoName + ")"
+}
+
+// hasLazyFields reports whether the message has lazy fields.
+func hasLazyFields(g *generator.GeneratedFile, message *protogen.Message) bool {
+	return len(g.LazyFields(message)) > 0
+}
@@ -60,7 +60,7 @@ func (g *listGen) genGet() {
 	g.P("func (x *", g.typeName, ") Get(i int) ", protoreflectPkg.Ident("Value"), " {")
 	constructor := kindToValueConstructor(g.field.Desc.Kind())
 	switch g.field.Desc.Kind() {
-	case protoreflect.MessageKind:
+	case protoreflect.MessageKind, protoreflect.GroupKind:
 		g.P("return ", constructor, "((*x.list)[i].ProtoReflect())")
 	case protoreflect.EnumKind:
 		g.P("return ", constructor, "((", protoreflectPkg.Ident("EnumNumber"), ")((*x.list)[i]))")
@@ -94,7 +94,7 @@ func (g *listGen) genAppend() {
 func (g *listGen) genAppendMutable() {
 	g.P("func (x *", g.typeName, ") AppendMutable() ", protoreflectPkg.Ident("Value"), " {")
 	switch g.field.Desc.Kind() {
-	case protoreflect.MessageKind:
+	case protoreflect.MessageKind, protoreflect.GroupKind:
 		g.P("v := new(", g.QualifiedGoIdent(g.field.Message.GoIdent), ")")
 		g.P("*x.list = append(*x.list, v)")
 		g.P("return ", protoreflectPkg.Ident("ValueOfMessage"), "(v.ProtoReflect())")
@@ -111,7 +111,7 @@ func (g *listGen) genTruncate() {
 	g.P("func (x *", g.typeName, ") Truncate(n int)", "{")
 
 	switch g.field.Desc.Kind() {
-	case protoreflect.MessageKind: // zero message kinds to avoid keeping data alive
+	case protoreflect.MessageKind, protoreflect.GroupKind: // zero message kinds to avoid keeping data alive
 		g.P("for i := n; i < len(*x.list); i++ {")
 		g.P("(*x.list)[i] = nil")
 		g.P("}")
@@ -132,7 +132,7 @@ func (g *listGen) genNewElement() {
 		g.P("v := ", zeroValue)
 	}
 	switch g.field.Desc.Kind() {
-	case protoreflect.MessageKind:
+	case protoreflect.MessageKind, protoreflect.GroupKind:
 		g.P("return ", kindToValueConstructor(g.field.Desc.Kind()), "(v.ProtoReflect())")
 	case protoreflect.EnumKind:
 		g.P("return ", kindToValueConstructor(g.field.Desc.Kind()), "((", protoreflectPkg.Ident("EnumNumber"), ")(v))")
@@ -252,7 +252,7 @@ func genPrefValueToGoValue(g *generator.GeneratedFile, field *protogen.Field, in
 	unwrapperVar := fmt.Sprintf("%sUnwrapped", inputName)
 	g.P(unwrapperVar, " := ", inputName, ".", unwrapperFunc, "()")
 	switch field.Desc.Kind() {
-	case protoreflect.MessageKind:
+	case protoreflect.MessageKind, protoreflect.GroupKind:
 		g.P(outputName, " := ", unwrapperVar, ".Interface().(*", g.QualifiedGoIdent(field.Message.GoIdent), ")")
 	case protoreflect.EnumKind:
 		g.P(outputName, " := (", g.QualifiedGoIdent(field.Enum.GoIdent), ")(", unwrapperVar, ")")
@@ -297,3 +297,55 @@ func zeroValueForField(g *generator.GeneratedFile, field *protogen.Field) string
 		panic("should not reach")
 	}
 }
+
+// defaultValueForField returns the protoreflect.Value holding the default value
+// of the field, which is the zero value unless the field declares an explicit default.
+func defaultValueForField(g *generator.GeneratedFile, field *protogen.Field) string {
+	if !field.Desc.HasDefault() {
+		return g.QualifiedGoIdent(kindToValueConstructor(field.Desc.Kind())) + "(" + zeroValueForField(g, field) + ")"
+	}
+	value := g.QualifiedGoIdent(field.Parent.GoIdent.GoImportPath.Ident("Default_" + field.Parent.GoIdent.GoName + "_" + field.GoName))
+	switch field.Desc.Kind() {
+	case protoreflect.EnumKind:
+		value = "(" + g.QualifiedGoIdent(protoreflectPkg.Ident("EnumNumber")) + ")(" + value + ")"
+	case protoreflect.BytesKind:
+		value = "append([]byte(nil), " + value + "...)"
+	}
+	return g.QualifiedGoIdent(kindToValueConstructor(field.Desc.Kind())) + "(" + value + ")"
+}
+
+// hasExplicitPresence reports whether the field is a singular scalar, outside of
+// oneofs, which tracks its presence like proto2 optional and required fields do.
+// Such fields are stored as pointers, bytes are stored as a nil-able slice.
+func hasExplicitPresence(field *protogen.Field) bool {
+	return field.Desc.HasPresence() && !inOneof(field) && field.Message == nil
+}
+
+// inOneof reports whether the field is stored in a oneof wrapper type.
+// Members of synthetic oneofs, declared by proto3 optional fields,
+// are stored directly in the message as nullable fields.
+func inOneof(field *protogen.Field) bool {
+	return field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
+}
+
+// enforceUTF8 reports whether the field must hold valid UTF-8, which is the case of
+// string fields in proto3 and configurable through editions features. Bytes fields,
+// which reach the same code paths when mapped to a scalar, never do.
+func enforceUTF8(fd protoreflect.FieldDescriptor) bool {
+	if fd.Kind() != protoreflect.StringKind {
+		return false
+	}
+	if fd.Syntax() == protoreflect.Editions {
+		if fd, ok := fd.(interface{ EnforceUTF8() bool }); ok {
+			return fd.EnforceUTF8()
+		}
+		return true
+	}
+	return fd.Syntax() == protoreflect.Proto3
+}
+
+// closedEnum reports whether the field holds a closed enum, whose unknown
+// values are stored as unknown fields instead of being set on the field.
+func closedEnum(field *protogen.Field) bool {
+	return field.Enum != nil && field.Enum.Desc.IsClosed()
+}
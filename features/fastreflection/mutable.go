@@ -15,13 +15,15 @@ type mutableGen struct {
 func (g *mutableGen) generate() {
 	g.genComment()
 	g.P("func (x *", g.typeName, ") Mutable(fd ", protoreflectPkg.Ident("FieldDescriptor"), ") ", protoreflectPkg.Ident("Value"), " {")
-	g.P("switch fd.FullName()  {")
+	genFieldSwitch(g.GeneratedFile, "fd")
 	// we first output all the fields that are mutable
 	for _, field := range g.message.Fields {
 		if !mutable(field) {
 			continue
 		}
-		g.P("case \"", field.Desc.FullName(), "\":")
+		genFieldCase(g.GeneratedFile, field, "fd")
+		genLazyDecode(g.GeneratedFile, field, messageReceiver(g.GeneratedFile, g.message))
+		genLazyDiscard(g.GeneratedFile, field, "x")
 		g.genField(field)
 	}
 	// then we parse those that are not mutable
@@ -29,16 +31,10 @@ func (g *mutableGen) generate() {
 		if mutable(field) {
 			continue
 		}
-		g.P("case \"", field.Desc.FullName(), "\":")
+		genFieldCase(g.GeneratedFile, field, "fd")
 		g.P("panic(", fmtPkg.Ident("Errorf"), "(\"field ", field.Desc.Name(), " of message ", g.message.Desc.FullName(), " is not mutable\"))")
 	}
-	// then the default case
-	g.P("default:")
-	g.P("if fd.IsExtension() {")
-	g.P("panic(", fmtPkg.Ident("Errorf"), "(\"proto3 declared messages do not support extensions: ", g.message.Desc.FullName(), "\"))")
-	g.P("}")
-	g.P("panic(fmt.Errorf(\"message ", g.message.Desc.FullName(), " does not contain field %s\", fd.FullName()))")
-	g.P("}")
+	genFieldSwitchEnd(g.GeneratedFile, g.message, "fd", "Mutable", true)
 	g.P("}")
 }
 
@@ -48,7 +44,7 @@ func mutable(field *protogen.Field) bool {
 		return true
 	case field.Desc.IsList():
 		return true
-	case field.Desc.Kind() == protoreflect.MessageKind:
+	case field.Desc.Kind() == protoreflect.MessageKind, field.Desc.Kind() == protoreflect.GroupKind:
 		return true
 	default:
 		return false
@@ -69,7 +65,7 @@ func (g *mutableGen) genComment() {
 }
 
 func (g *mutableGen) genField(field *protogen.Field) {
-	if field.Oneof != nil {
+	if inOneof(field) {
 		g.genOneof(field)
 		return
 	}
@@ -89,7 +85,7 @@ func (g *mutableGen) genField(field *protogen.Field) {
 		g.P("}")
 		g.P("value := &", listTypeName(field), "{list: &x.", field.GoName, "}")
 		g.P("return ", protoreflectPkg.Ident("ValueOfList"), "(value)")
-	case field.Desc.Kind() == protoreflect.MessageKind:
+	case field.Desc.Kind() == protoreflect.MessageKind, field.Desc.Kind() == protoreflect.GroupKind:
 		g.P("if x.", field.GoName, " == nil {")
 		g.P("x.", field.GoName, " = new(", g.QualifiedGoIdent(field.Message.GoIdent), ")")
 		g.P("}")
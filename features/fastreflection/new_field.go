@@ -15,17 +15,12 @@ type newFieldGen struct {
 func (g *newFieldGen) generate() {
 	g.genComment()
 	g.P("func (x *", g.typeName, ") NewField(fd ", protoreflectPkg.Ident("FieldDescriptor"), ") ", protoreflectPkg.Ident("Value"), " {")
-	g.P("switch fd.FullName() {")
+	genFieldSwitch(g.GeneratedFile, "fd")
 	for _, field := range g.message.Fields {
-		g.P("case \"", field.Desc.FullName(), "\":")
+		genFieldCase(g.GeneratedFile, field, "fd")
 		g.genField(field)
 	}
-	g.P("default: ")
-	g.P("if fd.IsExtension() {")
-	g.P("panic(", fmtPkg.Ident("Errorf"), "(\"proto3 declared messages do not support extensions: ", g.message.Desc.FullName(), "\"))")
-	g.P("}")
-	g.P("panic(fmt.Errorf(\"message ", g.message.Desc.FullName(), " does not contain field %s\", fd.FullName()))")
-	g.P("}")
+	genFieldSwitchEnd(g.GeneratedFile, g.message, "fd", "NewField", true)
 	g.P("}")
 }
 
@@ -37,16 +32,16 @@ func (g *newFieldGen) genComment() {
 
 func (g *newFieldGen) genField(field *protogen.Field) {
 	switch {
-	case field.Desc.IsMap(), field.Desc.IsList(), field.Desc.Kind() == protoreflect.MessageKind:
+	case field.Desc.IsMap(), field.Desc.IsList(), field.Desc.Kind() == protoreflect.MessageKind, field.Desc.Kind() == protoreflect.GroupKind:
 		g.genMutable(field)
 	default:
-		g.P("return ", kindToValueConstructor(field.Desc.Kind()), "(", zeroValueForField(g.GeneratedFile, field), ")")
+		g.P("return ", defaultValueForField(g.GeneratedFile, field))
 	}
 }
 
 func (g *newFieldGen) genMutable(field *protogen.Field) {
 	switch {
-	case field.Oneof != nil:
+	case inOneof(field):
 		g.genOneof(field)
 	case field.Desc.IsMap():
 		g.P("m := make(map[", getGoType(g.GeneratedFile, field.Message.Fields[0]), "]", getGoType(g.GeneratedFile, field.Message.Fields[1]), ")")
@@ -54,7 +49,7 @@ func (g *newFieldGen) genMutable(field *protogen.Field) {
 	case field.Desc.IsList():
 		g.P("list := []", getGoType(g.GeneratedFile, field), "{}")
 		g.P("return ", protoreflectPkg.Ident("ValueOfList"), "(&", listTypeName(field), "{list: &list})")
-	case field.Desc.Kind() == protoreflect.MessageKind:
+	case field.Desc.Kind() == protoreflect.MessageKind, field.Desc.Kind() == protoreflect.GroupKind:
 		g.P("m := new(", g.QualifiedGoIdent(field.Message.GoIdent), ")")
 		g.P("return ", protoreflectPkg.Ident("ValueOfMessage"), "(m.ProtoReflect())")
 	default:
@@ -63,7 +58,7 @@ func (g *newFieldGen) genMutable(field *protogen.Field) {
 }
 
 func (g *newFieldGen) genOneof(field *protogen.Field) {
-	if field.Desc.Kind() != protoreflect.MessageKind {
+	if field.Desc.Kind() != protoreflect.MessageKind && field.Desc.Kind() != protoreflect.GroupKind {
 		panic("newfield oneof fastGenerator should be applied only to mutable message types")
 	}
 	g.P("value := &", g.QualifiedGoIdent(field.Message.GoIdent), "{}")
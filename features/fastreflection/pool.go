@@ -0,0 +1,145 @@
+package fastreflection
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-proto/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// poolGen generates sync.Pool backed recycling for messages
+// marked as poolable through the plugin pool option.
+type poolGen struct {
+	*generator.GeneratedFile
+	message *protogen.Message
+}
+
+func (g *poolGen) generate() {
+	if !g.ShouldPool(g.message) {
+		return
+	}
+
+	g.genPoolVar()
+	g.genResetKeepCapacity()
+	g.genReturnToPool()
+	g.genFromPool()
+}
+
+func (g *poolGen) genPoolVar() {
+	g.P("var ", poolVarName(g.message), " = ", syncPkg.Ident("Pool"), "{")
+	g.P("New: func() interface{} {")
+	g.P("return &", g.message.GoIdent, "{}")
+	g.P("},")
+	g.P("}")
+	g.P()
+}
+
+// genResetKeepCapacity generates a reset which, unlike Reset, keeps the backing
+// arrays of repeated and bytes fields around so that they can be reused by unmarshal.
+// Poolable singular message fields and map values are returned to their pool, while
+// poolable messages held by lists are reset in place and kept for later reuse. The other
+// elements of lists are dropped, except for bytes which are reused by unmarshal
+// unless they may alias the buffer given to a zero-copy unmarshal.
+func (g *poolGen) genResetKeepCapacity() {
+	g.P("// ResetKeepCapacity resets the message while retaining the allocated")
+	g.P("// capacity of its repeated and bytes fields, nested poolable messages")
+	g.P("// are returned to their pools.")
+	g.P("func (x *", g.message.GoIdent, ") ResetKeepCapacity() {")
+	g.P("if x == nil {")
+	g.P("return")
+	g.P("}")
+	var saved []*protogen.Field
+	for _, field := range g.message.Fields {
+		switch {
+		case inOneof(field):
+			if field.Desc.Kind() != protoreflect.MessageKind || !g.ShouldPool(field.Message) {
+				continue
+			}
+			g.P("if oneof, ok := x.", field.Oneof.GoName, ".(*", field.GoIdent, "); ok {")
+			g.P("oneof.", field.GoName, ".ReturnToPool()")
+			g.P("}")
+		case field.Desc.IsMap():
+			value := field.Message.Fields[1]
+			if value.Desc.Kind() != protoreflect.MessageKind || !g.ShouldPool(value.Message) {
+				continue
+			}
+			g.P("for _, mm := range x.", field.GoName, " {")
+			g.P("mm.ReturnToPool()")
+			g.P("}")
+		case field.Desc.IsList():
+			switch {
+			case field.Desc.Kind() == protoreflect.MessageKind && g.ShouldPool(field.Message):
+				g.P("for _, mm := range x.", field.GoName, " {")
+				g.P("mm.ResetKeepCapacity()")
+				g.P("}")
+			case field.Desc.Kind() == protoreflect.BytesKind:
+				g.P("if x.", generator.ZeroCopiedGoName, " {")
+				g.P("clear(x.", field.GoName, ")")
+				g.P("}")
+			case field.Desc.Kind() == protoreflect.MessageKind || field.Desc.Kind() == protoreflect.StringKind:
+				g.P("clear(x.", field.GoName, ")")
+			}
+			g.P(fmt.Sprintf("f%d", len(saved)), " := x.", field.GoName, "[:0]")
+			saved = append(saved, field)
+		case field.Desc.Kind() == protoreflect.MessageKind:
+			if g.ShouldPool(field.Message) {
+				g.P("x.", field.GoName, ".ReturnToPool()")
+			}
+		case field.Desc.Kind() == protoreflect.BytesKind && !field.Desc.HasPresence() && !g.IsScalar(field):
+			// bytes with explicit presence can not be kept around as a
+			// non-nil empty slice would report the field as populated.
+			g.P("var ", fmt.Sprintf("f%d", len(saved)), " []byte")
+			g.P("if !x.", generator.ZeroCopiedGoName, " {")
+			g.P(fmt.Sprintf("f%d", len(saved)), " = x.", field.GoName, "[:0]")
+			g.P("}")
+			saved = append(saved, field)
+		}
+	}
+	g.P("x.Reset()")
+	for i, field := range saved {
+		g.P("x.", field.GoName, " = ", fmt.Sprintf("f%d", i))
+	}
+	g.P("}")
+	g.P()
+}
+
+func (g *poolGen) genReturnToPool() {
+	g.P("// ReturnToPool resets the message and puts it back into its pool,")
+	g.P("// the message must not be used after this call.")
+	g.P("func (x *", g.message.GoIdent, ") ReturnToPool() {")
+	g.P("if x != nil {")
+	g.P("x.ResetKeepCapacity()")
+	g.P(poolVarName(g.message), ".Put(x)")
+	g.P("}")
+	g.P("}")
+	g.P()
+}
+
+func (g *poolGen) genFromPool() {
+	g.P("// ", poolConstructorName(g.message), " returns a ", g.message.GoIdent.GoName, " taken from its pool,")
+	g.P("// the message should be given back through ReturnToPool once no longer used.")
+	g.P("// Unmarshal into it using proto.UnmarshalOptions{Merge: true}, as a plain")
+	g.P("// unmarshal resets the message and drops the memory retained by the pool.")
+	g.P("func ", poolConstructorName(g.message), "() *", g.message.GoIdent, " {")
+	g.P("return ", poolVarName(g.message), ".Get().(*", g.message.GoIdent, ")")
+	g.P("}")
+	g.P()
+}
+
+func poolVarName(message *protogen.Message) string {
+	return fmt.Sprintf("_%s_pool", message.GoIdent.GoName)
+}
+
+func poolConstructorName(message *protogen.Message) string {
+	return fmt.Sprintf("%sFromPool", message.GoIdent.GoName)
+}
+
+// newMessage returns the expression which allocates a new instance of the
+// provided message, drawing it from its pool when the message is poolable.
+func newMessage(g *generator.GeneratedFile, message *protogen.Message) string {
+	if g.ShouldPool(message) {
+		return g.QualifiedGoIdent(message.GoIdent.GoImportPath.Ident(poolConstructorName(message))) + "()"
+	}
+	return "&" + g.QualifiedGoIdent(message.GoIdent) + "{}"
+}
@@ -0,0 +1,88 @@
+package fastreflection
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genCheckInitializedMethod generates the check of the required fields of the message
+// and of the messages it holds, reporting the path of the first missing one.
+// Fields whose message type can not hold required fields are not visited,
+// so messages without any reachable required field are checked for free.
+func (g *fastGenerator) genCheckInitializedMethod() {
+	g.P(`func `, g.protoMethodName("checkInitialized"), `(input `, protoifacePkg.Ident("CheckInitializedInput"), `) (`, protoifacePkg.Ident("CheckInitializedOutput"), `, error) {`)
+	if !requiresInitCheck(g.message.Desc, map[protoreflect.FullName]bool{}) {
+		g.P(`return `, protoifacePkg.Ident("CheckInitializedOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil`)
+		g.P(`}`)
+		return
+	}
+	g.P(`x := input.Message.Interface().(*`, g.message.GoIdent, `)`)
+	g.P(`if x == nil {`)
+	g.P(`return `, protoifacePkg.Ident("CheckInitializedOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil`)
+	g.P(`}`)
+	fullName := strconv.Quote(string(g.message.Desc.FullName()))
+	for _, field := range g.message.Fields {
+		if field.Desc.Cardinality() != protoreflect.Required {
+			continue
+		}
+		genLazyDecode(g.GeneratedFile, field, "x")
+		g.P(`if x.`, field.GoName, ` == nil {`)
+		g.P(`return `, protoifacePkg.Ident("CheckInitializedOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, `, runtimePackage.Ident("ErrRequiredNotSet"), `(`, fullName, `, "`, field.Desc.Name(), `")`)
+		g.P(`}`)
+	}
+	for _, field := range g.message.Fields {
+		g.genCheckInitializedField(field, fullName)
+	}
+	if hasExtensions(g.message) {
+		g.P(`if err := `, runtimePackage.Ident("CheckExtensionsInitialized"), `(&x.extensionFields); err != nil {`)
+		g.P(`return `, protoifacePkg.Ident("CheckInitializedOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, err`)
+		g.P(`}`)
+	}
+	g.P(`return `, protoifacePkg.Ident("CheckInitializedOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil`)
+	g.P(`}`)
+}
+
+func (g *fastGenerator) genCheckInitializedField(field *protogen.Field, fullName string) {
+	message := field.Message
+	if field.Desc.IsMap() {
+		message = field.Message.Fields[1].Message
+	}
+	if message == nil || !requiresInitCheck(message.Desc, map[protoreflect.FullName]bool{}) {
+		return
+	}
+	genLazyDecode(g.GeneratedFile, field, "x")
+	name := string(field.Desc.Name())
+	notSet := func(path ...interface{}) {
+		args := []interface{}{`return `, protoifacePkg.Ident("CheckInitializedOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, `,
+			runtimePackage.Ident("RequiredNotSetIn"), `(err, `, fullName, `, `}
+		g.P(append(append(args, path...), `)`)...)
+	}
+	switch {
+	case field.Desc.IsMap():
+		g.P(`for k, v := range x.`, field.GoName, ` {`)
+		g.P(`if err := `, protoPkg.Ident("CheckInitialized"), `(v); err != nil {`)
+		notSet(fmtPkg.Ident("Sprintf"), `("`, name, `[%v]", k)`)
+		g.P(`}`)
+		g.P(`}`)
+	case field.Desc.IsList():
+		g.P(`for i, v := range x.`, field.GoName, ` {`)
+		g.P(`if err := `, protoPkg.Ident("CheckInitialized"), `(v); err != nil {`)
+		notSet(fmtPkg.Ident("Sprintf"), `("`, name, `[%d]", i)`)
+		g.P(`}`)
+		g.P(`}`)
+	case inOneof(field):
+		g.P(`if v, ok := x.`, field.Oneof.GoName, `.(*`, field.GoIdent, `); ok && v.`, field.GoName, ` != nil {`)
+		g.P(`if err := `, protoPkg.Ident("CheckInitialized"), `(v.`, field.GoName, `); err != nil {`)
+		notSet(`"`, name, `"`)
+		g.P(`}`)
+		g.P(`}`)
+	default:
+		g.P(`if x.`, field.GoName, ` != nil {`)
+		g.P(`if err := `, protoPkg.Ident("CheckInitialized"), `(x.`, field.GoName, `); err != nil {`)
+		notSet(`"`, name, `"`)
+		g.P(`}`)
+		g.P(`}`)
+	}
+}
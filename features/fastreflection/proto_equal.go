@@ -0,0 +1,177 @@
+package fastreflection
+
+import (
+	"github.com/cosmos/cosmos-proto/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genEqualMethod generates the comparison of two messages, following the semantics of
+// proto.Equal: fields must be populated in both messages and hold equal values, NaNs are
+// equal to each other, empty bytes are equal regardless of nil-ness, and unknown fields
+// are compared per field number.
+func (g *fastGenerator) genEqualMethod() {
+	g.P(`func `, g.protoMethodName("equal"), `(input `, protoifacePkg.Ident("EqualInput"), `) `, protoifacePkg.Ident("EqualOutput"), ` {`)
+	g.P(`x, xok := input.MessageA.Interface().(*`, g.message.GoIdent, `)`)
+	g.P(`y, yok := input.MessageB.Interface().(*`, g.message.GoIdent, `)`)
+	// messages of another concrete type, such as dynamic messages,
+	// are left to the reflection based comparison.
+	g.P(`if !xok || !yok {`)
+	g.P(`return `, protoifacePkg.Ident("EqualOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: `, protoreflectPkg.Ident("ValueOfMessage"), `(input.MessageA).Equal(`, protoreflectPkg.Ident("ValueOfMessage"), `(input.MessageB))}`)
+	g.P(`}`)
+	g.P(`if x == y {`)
+	g.P(`return `, protoifacePkg.Ident("EqualOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}`)
+	g.P(`}`)
+	g.P(`if x == nil || y == nil {`)
+	g.P(`return `, protoifacePkg.Ident("EqualOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals}`)
+	g.P(`}`)
+	gen := &equalGen{GeneratedFile: g.GeneratedFile, file: g.file}
+	oneofs := make(map[string]struct{})
+	for _, field := range g.message.Fields {
+		if !inOneof(field) {
+			genLazyDecode(g.GeneratedFile, field, "x")
+			genLazyDecode(g.GeneratedFile, field, "y")
+			gen.genField(field)
+			continue
+		}
+		if _, ok := oneofs[field.Oneof.GoName]; ok {
+			continue
+		}
+		oneofs[field.Oneof.GoName] = struct{}{}
+		gen.genOneof(field.Oneof)
+	}
+	if hasExtensions(g.message) {
+		g.P(`if !`, runtimePackage.Ident("EqualExtensions"), `(&x.extensionFields, &y.extensionFields) {`)
+		g.P(`return `, protoifacePkg.Ident("EqualOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals}`)
+		g.P(`}`)
+	}
+	g.P(`return `, protoifacePkg.Ident("EqualOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: `, runtimePackage.Ident("EqualUnknown"), `(x.unknownFields, y.unknownFields)}`)
+	g.P(`}`)
+}
+
+// genEqual generates the exported Equal method, unless it would clash with the Go name
+// of a field or oneof of the message.
+func (g *fastGenerator) genEqual() {
+	if !hasEqualMethod(g.message) {
+		return
+	}
+	g.P("// Equal reports whether the message is equal to y, following the semantics of proto.Equal.")
+	g.P("func (x *", g.message.GoIdent, ") Equal(y *", g.message.GoIdent, ") bool {")
+	g.P("return ", g.protoMethodName("equal"), "(", protoifacePkg.Ident("EqualInput"), "{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal")
+	g.P("}")
+	g.P()
+}
+
+// hasEqualMethod reports whether Equal is generated for the message, which is not the
+// case when it would clash with the Go name of a field or oneof.
+func hasEqualMethod(message *protogen.Message) bool {
+	for _, field := range message.Fields {
+		if field.GoName == "Equal" {
+			return false
+		}
+	}
+	for _, oneof := range message.Oneofs {
+		if oneof.GoName == "Equal" {
+			return false
+		}
+	}
+	return true
+}
+
+type equalGen struct {
+	*generator.GeneratedFile
+	file *protogen.File
+}
+
+// notEqual returns the expression reporting whether the values a and b
+// of the field, or of its elements for lists and maps, differ.
+func (g *equalGen) notEqual(field *protogen.Field, a, b string) string {
+	switch {
+	case field.Message != nil:
+		// messages of other packages may be generated without Equal
+		if field.Message.GoIdent.GoImportPath == g.file.GoImportPath && hasEqualMethod(field.Message) {
+			return "!" + a + ".Equal(" + b + ")"
+		}
+		return "!" + g.QualifiedGoIdent(protoPkg.Ident("Equal")) + "(" + a + ", " + b + ")"
+	case field.Desc.Kind() == protoreflect.BytesKind:
+		return "!" + g.QualifiedGoIdent(bytesPkg.Ident("Equal")) + "(" + a + ", " + b + ")"
+	case field.Desc.Kind() == protoreflect.DoubleKind:
+		return "!" + g.QualifiedGoIdent(runtimePackage.Ident("EqualFloat")) + "(" + a + ", " + b + ")"
+	case field.Desc.Kind() == protoreflect.FloatKind:
+		return "!" + g.QualifiedGoIdent(runtimePackage.Ident("EqualFloat")) + "(float64(" + a + "), float64(" + b + "))"
+	default:
+		return a + " != " + b
+	}
+}
+
+func (g *equalGen) returnNotEqual() {
+	g.P(`return `, protoifacePkg.Ident("EqualOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals}`)
+}
+
+func (g *equalGen) genField(field *protogen.Field) {
+	x, y := "x."+field.GoName, "y."+field.GoName
+	switch {
+	case field.Desc.IsMap():
+		g.genMap(field)
+		return
+	case field.Desc.IsList():
+		g.genList(field)
+		return
+	case field.Message != nil:
+		g.P(`if `, g.notEqual(field, x, y), ` {`)
+	case g.IsScalar(field):
+		g.P(`if !`, runtimePackage.Ident("EqualScalar"), `(&`, x, `, &`, y, `) {`)
+	case hasExplicitPresence(field) && field.Desc.Kind() == protoreflect.BytesKind:
+		g.P(`if (`, x, ` == nil) != (`, y, ` == nil) || `, g.notEqual(field, x, y), ` {`)
+	case hasExplicitPresence(field):
+		g.P(`if (`, x, ` == nil) != (`, y, ` == nil) || `, x, ` != nil && `, g.notEqual(field, "*"+x, "*"+y), ` {`)
+	case field.Desc.Kind() == protoreflect.DoubleKind:
+		// a negative zero is populated while a positive zero is not
+		g.P(`if `, g.notEqual(field, x, y), ` || `, x, ` == 0 && `, mathPkg.Ident("Signbit"), `(`, x, `) != `, mathPkg.Ident("Signbit"), `(`, y, `) {`)
+	case field.Desc.Kind() == protoreflect.FloatKind:
+		g.P(`if `, g.notEqual(field, x, y), ` || `, x, ` == 0 && `, mathPkg.Ident("Signbit"), `(float64(`, x, `)) != `, mathPkg.Ident("Signbit"), `(float64(`, y, `)) {`)
+	default:
+		g.P(`if `, g.notEqual(field, x, y), ` {`)
+	}
+	g.returnNotEqual()
+	g.P(`}`)
+}
+
+func (g *equalGen) genList(field *protogen.Field) {
+	name := field.GoName
+	g.P(`if len(x.`, name, `) != len(y.`, name, `) {`)
+	g.returnNotEqual()
+	g.P(`}`)
+	g.P(`for i, v := range x.`, name, ` {`)
+	g.P(`if `, g.notEqual(field, "v", "y."+name+"[i]"), ` {`)
+	g.returnNotEqual()
+	g.P(`}`)
+	g.P(`}`)
+}
+
+func (g *equalGen) genMap(field *protogen.Field) {
+	name := field.GoName
+	g.P(`if len(x.`, name, `) != len(y.`, name, `) {`)
+	g.returnNotEqual()
+	g.P(`}`)
+	g.P(`for k, v := range x.`, name, ` {`)
+	g.P(`if w, ok := y.`, name, `[k]; !ok || `, g.notEqual(field.Message.Fields[1], "v", "w"), ` {`)
+	g.returnNotEqual()
+	g.P(`}`)
+	g.P(`}`)
+}
+
+func (g *equalGen) genOneof(oneof *protogen.Oneof) {
+	g.P(`switch v := x.`, oneof.GoName, `.(type) {`)
+	g.P(`case nil:`)
+	g.P(`if y.`, oneof.GoName, ` != nil {`)
+	g.returnNotEqual()
+	g.P(`}`)
+	for _, field := range oneof.Fields {
+		g.P(`case *`, field.GoIdent, `:`)
+		g.P(`if w, ok := y.`, oneof.GoName, `.(*`, field.GoIdent, `); !ok || `, g.notEqual(field, "v."+field.GoName, "w."+field.GoName), ` {`)
+		g.returnNotEqual()
+		g.P(`}`)
+	}
+	g.P(`}`)
+}
@@ -26,7 +26,7 @@ func (g *fastGenerator) genMarshalMethod() {
 
 	var numGen counter
 	// MARSHAL METHOD
-	g.P(`marshal := func(input `, protoifacePkg.Ident("MarshalInput"), `) (`, protoifacePkg.Ident("MarshalOutput"), `, error) {`)
+	g.P(`func `, g.protoMethodName("marshal"), `(input `, protoifacePkg.Ident("MarshalInput"), `) (`, protoifacePkg.Ident("MarshalOutput"), `, error) {`)
 
 	// setup
 	g.P(`x := input.Message.Interface().(*`, g.message.GoIdent, `)`)
@@ -37,11 +37,19 @@ func (g *fastGenerator) genMarshalMethod() {
 	g.P("}, nil")
 	g.P("}")
 
+	if hasLazyFields(g.GeneratedFile, g.message) {
+		// the size computed below must hold until the fields are written
+		g.P("x.lazyFields.StartMarshal()")
+		g.P("defer x.lazyFields.EndMarshal()")
+	}
+
 	// core
 	g.P("options := ", runtimePackage.Ident("MarshalInputToOptions"), "(input)")
 	g.P("_ = options")
+	// the message is encoded backwards in the space appended to the caller's buffer
 	g.P("size := options.Size(x)")
-	g.P(`dAtA := make([]byte, size)`)
+	g.P(`buf := append(input.Buf, make([]byte, size)...)`)
+	g.P(`dAtA := buf[len(input.Buf):]`)
 
 	// from here we need to do what MarshalToSizedBuffer was doing
 	g.P("i := len(dAtA)")
@@ -57,13 +65,16 @@ func (g *fastGenerator) genMarshalMethod() {
 	oneofs := make(map[string]struct{})
 	for i := len(g.message.Oneofs) - 1; i >= 0; i-- {
 		field := g.message.Oneofs[i]
+		if field.Desc.IsSynthetic() {
+			continue
+		}
 		fieldname := field.GoName
 		if _, ok := oneofs[fieldname]; !ok {
 			oneofs[fieldname] = struct{}{}
 			g.P("switch x := x.", fieldname, ".(type) {")
 			for _, ooField := range field.Fields {
 				g.P("case *", ooField.GoIdent, ": ")
-				g.marshalField(true, &numGen, ooField, true)
+				g.marshalField(&numGen, ooField, true)
 			}
 			g.P("}")
 		}
@@ -82,27 +93,51 @@ func (g *fastGenerator) genMarshalMethod() {
 	// then we do everything else
 	for i := len(messageFields) - 1; i >= 0; i-- {
 		field := messageFields[i]
-		isOneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
-		if !isOneof {
-			g.marshalField(true, &numGen, field, false)
+		isOneof := inOneof(field)
+		if g.IsLazy(field) {
+			g.P("if raw := ", lazyRaw(g.GeneratedFile, field, "x"), "; raw != nil {")
+			g.P("i -= len(raw)")
+			g.P("copy(dAtA[i:], raw)")
+			g.P("} else {")
+			g.marshalField(&numGen, field, false)
+			g.P("}")
+		} else if !isOneof {
+			g.marshalField(&numGen, field, false)
 		}
 	}
 
-	g.P("if input.Buf != nil {")
-	g.P(`input.Buf = append(input.Buf, dAtA...)`)
-	g.P("} else {")
-	g.P("input.Buf = dAtA")
-	g.P("}")
+	// extensions come first, filling exactly the space left in front of the fields
+	if hasExtensions(g.message) {
+		g.P(`if len(x.extensionFields) > 0 {`)
+		g.P(`if encoded, err := `, runtimePackage.Ident("AppendExtensions"), `(dAtA[:0:i], &x.extensionFields, options); err != nil {`)
+		g.P(`return `, protoifacePkg.Ident("MarshalOutput"), " {")
+		g.P("NoUnkeyedLiterals: input.NoUnkeyedLiterals,")
+		g.P("Buf: input.Buf,")
+		g.P("}, err")
+		g.P(`} else if len(encoded) != i {`)
+		g.P(`return `, protoifacePkg.Ident("MarshalOutput"), " {")
+		g.P("NoUnkeyedLiterals: input.NoUnkeyedLiterals,")
+		g.P("Buf: input.Buf,")
+		g.P("}, ", runtimePackage.Ident("ErrSizeMismatch"))
+		g.P(`}`)
+		g.P(`}`)
+	}
+
 	g.P(`return `, protoifacePkg.Ident("MarshalOutput"), `{`)
 	g.P(`		NoUnkeyedLiterals: input.NoUnkeyedLiterals,`)
-	g.P(`		Buf: input.Buf,`)
+	g.P(`		Buf: buf,`)
 	g.P("}, nil")
 	g.P("}")
 }
 
-func (g *fastGenerator) marshalField(proto3 bool, numGen *counter, field *protogen.Field, oneof bool) {
+func (g *fastGenerator) marshalField(numGen *counter, field *protogen.Field, oneof bool) {
+	if g.IsScalar(field) {
+		g.marshalScalar(field)
+		return
+	}
 	fieldname := field.GoName
-	nullable := field.Message != nil || (field.Oneof != nil && field.Oneof.Desc.IsSynthetic())
+	implicitPresence := !field.Desc.HasPresence()
+	nullable := field.Message != nil || hasExplicitPresence(field)
 	repeated := field.Desc.Cardinality() == protoreflect.Repeated
 	if repeated && !oneof {
 		g.P(`if len(x.`, fieldname, `) > 0 {`)
@@ -133,7 +168,7 @@ func (g *fastGenerator) marshalField(proto3 bool, numGen *counter, field *protog
 		} else if nullable {
 			g.encodeFixed64(g.Ident("math", "Float64bits"), `(float64(*x.`+fieldname, `))`)
 			g.encodeKey(fieldNumber, wireType)
-		} else if proto3 {
+		} else if implicitPresence {
 			if !oneof {
 				g.P(`if x.`, fieldname, ` != 0 || `, mathPackage.Ident("Signbit"), `(x.`, fieldname, `) {`)
 			}
@@ -163,7 +198,7 @@ func (g *fastGenerator) marshalField(proto3 bool, numGen *counter, field *protog
 		} else if nullable {
 			g.encodeFixed32(g.Ident("math", "Float32bits"), `(float32(*x.`+fieldname, `))`)
 			g.encodeKey(fieldNumber, wireType)
-		} else if proto3 {
+		} else if implicitPresence {
 			if !oneof {
 				g.P(`if x.`, fieldname, ` != 0 || `, mathPackage.Ident("Signbit"), `(float64(x.`, fieldname, `)) {`)
 			}
@@ -215,7 +250,7 @@ func (g *fastGenerator) marshalField(proto3 bool, numGen *counter, field *protog
 		} else if nullable {
 			g.encodeVarint(`*x.`, fieldname)
 			g.encodeKey(fieldNumber, wireType)
-		} else if proto3 {
+		} else if implicitPresence {
 			if !oneof {
 				g.P(`if x.`, fieldname, ` != 0 {`)
 			}
@@ -243,7 +278,7 @@ func (g *fastGenerator) marshalField(proto3 bool, numGen *counter, field *protog
 		} else if nullable {
 			g.encodeFixed64("*x.", fieldname)
 			g.encodeKey(fieldNumber, wireType)
-		} else if proto3 {
+		} else if implicitPresence {
 			if !oneof {
 				g.P(`if x.`, fieldname, ` != 0 {`)
 			}
@@ -271,7 +306,7 @@ func (g *fastGenerator) marshalField(proto3 bool, numGen *counter, field *protog
 		} else if nullable {
 			g.encodeFixed32("*x." + fieldname)
 			g.encodeKey(fieldNumber, wireType)
-		} else if proto3 {
+		} else if implicitPresence {
 			if !oneof {
 				g.P(`if x.`, fieldname, ` != 0 {`)
 			}
@@ -314,7 +349,7 @@ func (g *fastGenerator) marshalField(proto3 bool, numGen *counter, field *protog
 			g.P(`dAtA[i] = 0`)
 			g.P(`}`)
 			g.encodeKey(fieldNumber, wireType)
-		} else if proto3 {
+		} else if implicitPresence {
 			if !oneof {
 				g.P(`if x.`, fieldname, ` {`)
 			}
@@ -340,20 +375,23 @@ func (g *fastGenerator) marshalField(proto3 bool, numGen *counter, field *protog
 	case protoreflect.StringKind:
 		if repeated {
 			val := g.reverseListRange(`x.`, fieldname)
+			g.validateUTF8String(field, val)
 			g.P(`i -= len(`, val, `)`)
 			g.P(`copy(dAtA[i:], `, val, `)`)
 			g.encodeVarint(`len(`, val, `)`)
 			g.encodeKey(fieldNumber, wireType)
 			g.P(`}`)
 		} else if nullable {
+			g.validateUTF8String(field, `*x.`+fieldname)
 			g.P(`i -= len(*x.`, fieldname, `)`)
 			g.P(`copy(dAtA[i:], *x.`, fieldname, `)`)
 			g.encodeVarint(`len(*x.`, fieldname, `)`)
 			g.encodeKey(fieldNumber, wireType)
-		} else if proto3 {
+		} else if implicitPresence {
 			if !oneof {
 				g.P(`if len(x.`, fieldname, `) > 0 {`)
 			}
+			g.validateUTF8String(field, `x.`+fieldname)
 			g.P(`i -= len(x.`, fieldname, `)`)
 			g.P(`copy(dAtA[i:], x.`, fieldname, `)`)
 			g.encodeVarint(`len(x.`, fieldname, `)`)
@@ -362,20 +400,30 @@ func (g *fastGenerator) marshalField(proto3 bool, numGen *counter, field *protog
 				g.P(`}`)
 			}
 		} else {
+			g.validateUTF8String(field, `x.`+fieldname)
 			g.P(`i -= len(x.`, fieldname, `)`)
 			g.P(`copy(dAtA[i:], x.`, fieldname, `)`)
 			g.encodeVarint(`len(x.`, fieldname, `)`)
 			g.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.GroupKind:
-		panic(fmt.Errorf("marshaler does not support group %v", fieldname))
+		if repeated {
+			val := g.reverseListRange(`x.`, fieldname)
+			g.encodeKey(fieldNumber, protowire.EndGroupType)
+			g.marshalBackward(val, false, field.Message)
+			g.encodeKey(fieldNumber, protowire.StartGroupType)
+			g.P(`}`)
+		} else {
+			g.encodeKey(fieldNumber, protowire.EndGroupType)
+			g.marshalBackward(`x.`+fieldname, false, field.Message)
+			g.encodeKey(fieldNumber, protowire.StartGroupType)
+		}
 	case protoreflect.MessageKind:
 		if field.Desc.IsMap() {
 			goTypK, _ := g.FieldGoType(field.Message.Fields[0])
-			goTypV, ptr := g.FieldGoType(field.Message.Fields[1])
-			if ptr {
-				goTypV = "*" + goTypV
-			}
+			// map values are never stored behind pointers, even when the
+			// entry field tracks presence as it does in proto2 files.
+			goTypV, _ := g.FieldGoType(field.Message.Fields[1])
 			keyKind := field.Message.Fields[0].Desc.Kind()
 			valKind := field.Message.Fields[1].Desc.Kind()
 
@@ -447,7 +495,7 @@ func (g *fastGenerator) marshalField(proto3 bool, numGen *counter, field *protog
 			g.encodeVarint(`len(`, val, `)`)
 			g.encodeKey(fieldNumber, wireType)
 			g.P(`}`)
-		} else if proto3 {
+		} else if implicitPresence {
 			if !oneof {
 				g.P(`if len(x.`, fieldname, `) > 0 {`)
 			}
@@ -499,7 +547,7 @@ func (g *fastGenerator) marshalField(proto3 bool, numGen *counter, field *protog
 		} else if nullable {
 			g.encodeVarint(`(uint32(*x.`, fieldname, `) << 1) ^ uint32((*x.`, fieldname, ` >> 31))`)
 			g.encodeKey(fieldNumber, wireType)
-		} else if proto3 {
+		} else if implicitPresence {
 			if !oneof {
 				g.P(`if x.`, fieldname, ` != 0 {`)
 			}
@@ -547,7 +595,7 @@ func (g *fastGenerator) marshalField(proto3 bool, numGen *counter, field *protog
 		} else if nullable {
 			g.encodeVarint(`(uint64(*x.`, fieldname, `) << 1) ^ uint64((*x.`, fieldname, ` >> 63))`)
 			g.encodeKey(fieldNumber, wireType)
-		} else if proto3 {
+		} else if implicitPresence {
 			if !oneof {
 				g.P(`if x.`, fieldname, ` != 0 {`)
 			}
@@ -569,18 +617,62 @@ func (g *fastGenerator) marshalField(proto3 bool, numGen *counter, field *protog
 }
 
 func (g *fastGenerator) marshalBackward(varName string, varInt bool, message *protogen.Message) {
-	g.P(`encoded, err := `, "options.Marshal(", varName, ")")
-	g.P(`if err != nil {`)
+	// nested messages are appended in place right before the already encoded
+	// bytes, relying on their size cached by the computation of the message size.
+	g.P(`l = options.Size(`, varName, `)`)
+	g.P(`i -= l`)
+	g.P(`if encoded, err := options.MarshalAppend(dAtA[:i], `, varName, `); err != nil {`)
 	g.P(`return `, protoifacePkg.Ident("MarshalOutput"), " {")
 	g.P("NoUnkeyedLiterals: input.NoUnkeyedLiterals,")
 	g.P("Buf: input.Buf,")
 	g.P("}, err")
+	g.P(`} else if len(encoded) != i+l {`)
+	g.P(`return `, protoifacePkg.Ident("MarshalOutput"), " {")
+	g.P("NoUnkeyedLiterals: input.NoUnkeyedLiterals,")
+	g.P("Buf: input.Buf,")
+	g.P("}, ", runtimePackage.Ident("ErrSizeMismatch"))
 	g.P(`}`)
+	if varInt {
+		g.encodeVarint(`l`)
+	}
+}
+
+// marshalScalar generates the encoding of a field mapped to a Go type through the scalar
+// option, whose Marshal method gives the content of the field.
+func (g *fastGenerator) marshalScalar(field *protogen.Field) {
+	g.P(`if encoded, err := `, runtimePackage.Ident("MarshalScalar"), `(`, fieldDescriptorName(field), `, &x.`, field.GoName, `); err != nil {`)
+	g.P(`return `, protoifacePkg.Ident("MarshalOutput"), " {")
+	g.P("NoUnkeyedLiterals: input.NoUnkeyedLiterals,")
+	g.P("Buf: input.Buf,")
+	g.P("}, err")
+	g.P(`} else if len(encoded) > 0 {`)
+	if enforceUTF8(field.Desc) {
+		g.P(`if !`, utf8Pkg.Ident("Valid"), `(encoded) {`)
+		g.P(`return `, protoifacePkg.Ident("MarshalOutput"), " {")
+		g.P("NoUnkeyedLiterals: input.NoUnkeyedLiterals,")
+		g.P("Buf: input.Buf,")
+		g.P("}, ", runtimePackage.Ident("ErrInvalidUTF8"))
+		g.P(`}`)
+	}
 	g.P(`i -= len(encoded)`)
 	g.P(`copy(dAtA[i:], encoded)`)
-	if varInt {
-		g.encodeVarint(`len(encoded)`)
+	g.encodeVarint(`len(encoded)`)
+	g.encodeKey(field.Desc.Number(), protowire.BytesType)
+	g.P(`}`)
+}
+
+// validateUTF8String generates the rejection of invalid UTF-8 strings
+// for fields which require their contents to be validated.
+func (g *fastGenerator) validateUTF8String(field *protogen.Field, varName string) {
+	if !enforceUTF8(field.Desc) {
+		return
 	}
+	g.P(`if !`, utf8Pkg.Ident("ValidString"), `(`, varName, `) {`)
+	g.P(`return `, protoifacePkg.Ident("MarshalOutput"), " {")
+	g.P("NoUnkeyedLiterals: input.NoUnkeyedLiterals,")
+	g.P("Buf: input.Buf,")
+	g.P("}, ", runtimePackage.Ident("ErrInvalidUTF8"))
+	g.P(`}`)
 }
 
 func (g *fastGenerator) reverseListRange(expression ...string) string {
@@ -638,6 +730,9 @@ func (g *fastGenerator) mapField(kvField *protogen.Field, varName string) {
 		g.P(`dAtA[i] = 0`)
 		g.P(`}`)
 	case protoreflect.StringKind, protoreflect.BytesKind:
+		if kvField.Desc.Kind() == protoreflect.StringKind {
+			g.validateUTF8String(kvField, varName)
+		}
 		g.P(`i -= len(`, varName, `)`)
 		g.P(`copy(dAtA[i:], `, varName, `)`)
 		g.encodeVarint(`len(`, varName, `)`)
@@ -0,0 +1,170 @@
+package fastreflection
+
+import (
+	"github.com/cosmos/cosmos-proto/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMergeMethod generates the merge of a source message into a destination message,
+// following the semantics of proto.Merge: populated scalars overwrite, lists are appended,
+// maps are merged, singular messages are merged recursively and unknown fields are concatenated.
+// Composite values are deep copied, so that the destination never aliases the source.
+func (g *fastGenerator) genMergeMethod() {
+	g.P(`func `, g.protoMethodName("merge"), `(input `, protoifacePkg.Ident("MergeInput"), `) `, protoifacePkg.Ident("MergeOutput"), ` {`)
+	g.P(`dst, ok := input.Destination.Interface().(*`, g.message.GoIdent, `)`)
+	g.P(`if !ok {`)
+	g.P(`return `, protoifacePkg.Ident("MergeOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals}`)
+	g.P(`}`)
+	// sources of another concrete type, such as dynamic messages,
+	// are left to the reflection based merge.
+	g.P(`src, ok := input.Source.Interface().(*`, g.message.GoIdent, `)`)
+	g.P(`if !ok {`)
+	g.P(`return `, protoifacePkg.Ident("MergeOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals}`)
+	g.P(`}`)
+	g.P(`if src == nil {`)
+	g.P(`return `, protoifacePkg.Ident("MergeOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: `, protoifacePkg.Ident("MergeComplete"), `}`)
+	g.P(`}`)
+	oneofs := make(map[string]struct{})
+	for _, field := range g.message.Fields {
+		if !inOneof(field) {
+			genLazyDecode(g.GeneratedFile, field, "src")
+			genLazyDecode(g.GeneratedFile, field, "dst")
+			genLazyDiscard(g.GeneratedFile, field, "dst")
+			(&mergeGen{GeneratedFile: g.GeneratedFile}).genField(field)
+			continue
+		}
+		if _, ok := oneofs[field.Oneof.GoName]; ok {
+			continue
+		}
+		oneofs[field.Oneof.GoName] = struct{}{}
+		(&mergeGen{GeneratedFile: g.GeneratedFile}).genOneof(field.Oneof)
+	}
+	if hasExtensions(g.message) {
+		g.P(`if len(src.extensionFields) > 0 {`)
+		g.P(runtimePackage.Ident("MergeExtensions"), `(&dst.extensionFields, &src.extensionFields)`)
+		g.P(`}`)
+	}
+	g.P(`if len(src.unknownFields) > 0 {`)
+	g.P(`dst.unknownFields = append(dst.unknownFields, src.unknownFields...)`)
+	g.P(`}`)
+	g.P(`return `, protoifacePkg.Ident("MergeOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: `, protoifacePkg.Ident("MergeComplete"), `}`)
+	g.P(`}`)
+}
+
+type mergeGen struct {
+	*generator.GeneratedFile
+}
+
+func (g *mergeGen) genField(field *protogen.Field) {
+	name := field.GoName
+	switch {
+	case field.Desc.IsMap():
+		g.genMap(field)
+	case field.Desc.IsList():
+		g.genList(field)
+	case field.Message != nil:
+		g.P(`if src.`, name, ` != nil {`)
+		g.P(`if dst.`, name, ` == nil {`)
+		g.P(`dst.`, name, ` = `, newMessage(g.GeneratedFile, field.Message))
+		g.P(`}`)
+		g.P(protoPkg.Ident("Merge"), `(dst.`, name, `, src.`, name, `)`)
+		g.P(`}`)
+	case g.IsScalar(field):
+		g.P(`if `, runtimePackage.Ident("HasScalar"), `(&src.`, name, `) {`)
+		g.P(runtimePackage.Ident("CopyScalar"), `(`, fieldDescriptorName(field), `, &dst.`, name, `, &src.`, name, `)`)
+		g.P(`}`)
+	case hasExplicitPresence(field):
+		g.P(`if src.`, name, ` != nil {`)
+		if field.Desc.Kind() == protoreflect.BytesKind {
+			g.P(`dst.`, name, ` = append([]byte{}, src.`, name, `...)`)
+		} else {
+			g.P(`v := *src.`, name)
+			g.P(`dst.`, name, ` = &v`)
+		}
+		g.P(`}`)
+	case field.Desc.Kind() == protoreflect.BytesKind:
+		g.P(`if len(src.`, name, `) != 0 {`)
+		g.P(`dst.`, name, ` = append([]byte{}, src.`, name, `...)`)
+		g.P(`}`)
+	case field.Desc.Kind() == protoreflect.DoubleKind:
+		g.P(`if src.`, name, ` != 0 || `, mathPkg.Ident("Signbit"), `(src.`, name, `) {`)
+		g.P(`dst.`, name, ` = src.`, name)
+		g.P(`}`)
+	case field.Desc.Kind() == protoreflect.FloatKind:
+		g.P(`if src.`, name, ` != 0 || `, mathPkg.Ident("Signbit"), `(float64(src.`, name, `)) {`)
+		g.P(`dst.`, name, ` = src.`, name)
+		g.P(`}`)
+	default:
+		g.P(`if src.`, name, ` != `, zeroValueForField(g.GeneratedFile, field), ` {`)
+		g.P(`dst.`, name, ` = src.`, name)
+		g.P(`}`)
+	}
+}
+
+func (g *mergeGen) genList(field *protogen.Field) {
+	name := field.GoName
+	switch {
+	case field.Message != nil:
+		g.P(`for _, v := range src.`, name, ` {`)
+		g.P(`m := `, newMessage(g.GeneratedFile, field.Message))
+		g.P(protoPkg.Ident("Merge"), `(m, v)`)
+		g.P(`dst.`, name, ` = append(dst.`, name, `, m)`)
+		g.P(`}`)
+	case field.Desc.Kind() == protoreflect.BytesKind:
+		g.P(`for _, v := range src.`, name, ` {`)
+		g.P(`dst.`, name, ` = append(dst.`, name, `, append([]byte{}, v...))`)
+		g.P(`}`)
+	default:
+		g.P(`if len(src.`, name, `) != 0 {`)
+		g.P(`dst.`, name, ` = append(dst.`, name, `, src.`, name, `...)`)
+		g.P(`}`)
+	}
+}
+
+func (g *mergeGen) genMap(field *protogen.Field) {
+	name := field.GoName
+	value := field.Message.Fields[1]
+	goType, _ := g.FieldGoType(field)
+	g.P(`if len(src.`, name, `) != 0 {`)
+	g.P(`if dst.`, name, ` == nil {`)
+	g.P(`dst.`, name, ` = make(`, goType, `, len(src.`, name, `))`)
+	g.P(`}`)
+	g.P(`for k, v := range src.`, name, ` {`)
+	switch {
+	case value.Message != nil:
+		// map values are replaced rather than merged
+		g.P(`m := `, newMessage(g.GeneratedFile, value.Message))
+		g.P(protoPkg.Ident("Merge"), `(m, v)`)
+		g.P(`dst.`, name, `[k] = m`)
+	case value.Desc.Kind() == protoreflect.BytesKind:
+		g.P(`dst.`, name, `[k] = append([]byte{}, v...)`)
+	default:
+		g.P(`dst.`, name, `[k] = v`)
+	}
+	g.P(`}`)
+	g.P(`}`)
+}
+
+func (g *mergeGen) genOneof(oneof *protogen.Oneof) {
+	g.P(`switch v := src.`, oneof.GoName, `.(type) {`)
+	for _, field := range oneof.Fields {
+		g.P(`case *`, field.GoIdent, `:`)
+		switch {
+		case field.Message != nil:
+			// a message already held by the same oneof member is merged into
+			g.P(`if dv, ok := dst.`, oneof.GoName, `.(*`, field.GoIdent, `); ok && dv.`, field.GoName, ` != nil {`)
+			g.P(protoPkg.Ident("Merge"), `(dv.`, field.GoName, `, v.`, field.GoName, `)`)
+			g.P(`} else {`)
+			g.P(`m := `, newMessage(g.GeneratedFile, field.Message))
+			g.P(protoPkg.Ident("Merge"), `(m, v.`, field.GoName, `)`)
+			g.P(`dst.`, oneof.GoName, ` = &`, field.GoIdent, `{`, field.GoName, `: m}`)
+			g.P(`}`)
+		case field.Desc.Kind() == protoreflect.BytesKind:
+			g.P(`dst.`, oneof.GoName, ` = &`, field.GoIdent, `{`, field.GoName, `: append([]byte{}, v.`, field.GoName, `...)}`)
+		default:
+			g.P(`dst.`, oneof.GoName, ` = &`, field.GoIdent, `{`, field.GoName, `: v.`, field.GoName, `}`)
+		}
+	}
+	g.P(`}`)
+}
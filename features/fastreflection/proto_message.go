@@ -12,10 +12,17 @@ const (
 	protoifacePkg   = protogen.GoImportPath("google.golang.org/protobuf/runtime/protoiface")
 	protoimplPkg    = protogen.GoImportPath("google.golang.org/protobuf/runtime/protoimpl")
 	protoPkg        = protogen.GoImportPath("google.golang.org/protobuf/proto")
+	protowirePkg    = protogen.GoImportPath("google.golang.org/protobuf/encoding/protowire")
 
 	sortPkg     = protogen.GoImportPath("sort")
+	bytesPkg    = protogen.GoImportPath("bytes")
+	slicesPkg   = protogen.GoImportPath("slices")
+	mapsPkg     = protogen.GoImportPath("maps")
 	fmtPkg      = protogen.GoImportPath("fmt")
 	mathPackage = protogen.GoImportPath("math")
+	syncPkg     = protogen.GoImportPath("sync")
+	atomicPkg   = protogen.GoImportPath("sync/atomic")
+	utf8Pkg     = protogen.GoImportPath("unicode/utf8")
 
 	runtimePackage = protogen.GoImportPath("github.com/cosmos/cosmos-proto/runtime")
 )
@@ -53,6 +60,10 @@ func genMessage(f *protogen.File, g *generator.GeneratedFile, message *protogen.
 	gen.genSetUnknown()
 	gen.genIsValid()
 	gen.genProtoMethods()
+	gen.genPool()
+	gen.genClone()
+	gen.genEqual()
+	gen.genValidateCanonical()
 }
 
 func fastReflectionTypeName(message *protogen.Message) string {
@@ -247,6 +258,21 @@ func (g *fastGenerator) genWhichOneof() {
 	}).generate()
 }
 
+func (g *fastGenerator) genPool() {
+	(&poolGen{
+		GeneratedFile: g.GeneratedFile,
+		message:       g.message,
+	}).generate()
+}
+
+func (g *fastGenerator) genClone() {
+	(&cloneGen{
+		GeneratedFile: g.GeneratedFile,
+		file:          g.file,
+		message:       g.message,
+	}).generate()
+}
+
 func (g *fastGenerator) genGetUnknown() {
 	g.P("// GetUnknown retrieves the entire list of unknown fields.")
 	g.P("// The caller may only mutate the contents of the RawFields")
@@ -288,6 +314,7 @@ func (g *fastGenerator) genIsValid() {
 }
 
 func (g *fastGenerator) genProtoMethods() {
+	methods := "_" + g.typeName + "_methods"
 	g.P("// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.")
 	g.P("// This method may return nil.")
 	g.P("//")
@@ -295,19 +322,40 @@ func (g *fastGenerator) genProtoMethods() {
 	g.P(`// "google.golang.org/protobuf/runtime/protoiface".Methods.`)
 	g.P("// Consult the protoiface package documentation for details.")
 	g.P("func (x *", g.typeName, ") ProtoMethods() *", protoifacePkg.Ident("Methods"), " {")
+	g.P("return ", methods)
+	g.P("}")
+	g.P()
+
+	// the protobuf runtime asks for the methods on every operation,
+	// so they are shared by all the messages of the type.
+	g.P("var ", methods, " = &", protoifacePkg.Ident("Methods"), "{ ")
+	g.P("NoUnkeyedLiterals: struct{}{},")
+	g.P("Flags: ", protoifacePkg.Ident("SupportMarshalDeterministic"), "|", protoifacePkg.Ident("SupportUnmarshalDiscardUnknown"), ",")
+	g.P("Size: ", g.protoMethodName("size"), ",")
+	g.P("Marshal: ", g.protoMethodName("marshal"), ",")
+	g.P("Unmarshal: ", g.protoMethodName("unmarshal"), ",")
+	g.P("Merge: ", g.protoMethodName("merge"), ",")
+	g.P("CheckInitialized: ", g.protoMethodName("checkInitialized"), ",")
+	g.P("Equal: ", g.protoMethodName("equal"), ",")
+	g.P("}")
+	g.P()
 
 	g.genSizeMethod()
+	g.P()
 	g.genMarshalMethod()
+	g.P()
 	g.genUnmarshalMethod()
+	g.P()
+	g.genMergeMethod()
+	g.P()
+	g.genCheckInitializedMethod()
+	g.P()
+	g.genEqualMethod()
+	g.P()
+}
 
-	g.P("return &", protoifacePkg.Ident("Methods"), "{ ")
-	g.P("NoUnkeyedLiterals: struct{}{},")
-	g.P("Flags: ", protoifacePkg.Ident("SupportMarshalDeterministic"), "|", protoifacePkg.Ident("SupportUnmarshalDiscardUnknown"), ",")
-	g.P("Size: size,")
-	g.P("Marshal: marshal,")
-	g.P("Unmarshal: unmarshal,")
-	g.P("Merge: nil,")
-	g.P("CheckInitialized: nil,")
-	g.P("}")
-	g.P("}")
+// protoMethodName returns the name of the package level function
+// implementing the given protoiface.Methods operation for the message.
+func (g *fastGenerator) protoMethodName(op string) string {
+	return "_" + g.typeName + "_" + op
 }
@@ -31,7 +31,7 @@ var kindToGoType = map[protoreflect.Kind]string{
 
 func (g *fastGenerator) genSizeMethod() {
 
-	g.P(`size := func(input `, protoifacePkg.Ident("SizeInput"), ") ", protoifacePkg.Ident("SizeOutput"), " {")
+	g.P(`func `, g.protoMethodName("size"), `(input `, protoifacePkg.Ident("SizeInput"), ") ", protoifacePkg.Ident("SizeOutput"), " {")
 	g.P("x := input.Message.Interface().(*", g.message.GoIdent, ")")
 	g.P(`if x == nil {`)
 	g.P(`return `, protoifacePkg.Ident("SizeOutput"), "{ ")
@@ -39,6 +39,18 @@ func (g *fastGenerator) genSizeMethod() {
 	g.P("Size: 0,")
 	g.P("}")
 	g.P(`}`)
+	// the cached size is only trusted when the caller guarantees that
+	// it was computed by a previous call and the message is unchanged since.
+	// As in protobuf-go, the cache holds the size plus one, so that the zero
+	// value of a message which was never sized is not read as a size of zero.
+	g.P("if input.Flags&", protoifacePkg.Ident("MarshalUseCachedSize"), " != 0 {")
+	g.P("if size := ", atomicPkg.Ident("LoadInt32"), "(&x.sizeCache); size > 0 {")
+	g.P(`return `, protoifacePkg.Ident("SizeOutput"), "{ ")
+	g.P("NoUnkeyedLiterals: input.NoUnkeyedLiterals,")
+	g.P("Size: int(size - 1),")
+	g.P("}")
+	g.P("}")
+	g.P("}")
 	g.P("options := ", runtimePackage.Ident("SizeInputToOptions"), "(input)")
 	g.P("_ = options")
 	g.P(`var n int`)
@@ -46,9 +58,16 @@ func (g *fastGenerator) genSizeMethod() {
 	g.P(`_ = l`)
 	oneofs := make(map[string]struct{})
 	for _, field := range g.message.Fields {
-		oneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
-		if !oneof {
-			g.field(true, field, false)
+		oneof := inOneof(field)
+		if g.IsLazy(field) {
+			// the encoding recorded by the unmarshal is marshalled back as is, as long as it holds the field
+			g.P("if raw := ", lazyRaw(g.GeneratedFile, field, "x"), "; raw != nil {")
+			g.P("n += len(raw)")
+			g.P("} else {")
+			g.field(field, false)
+			g.P("}")
+		} else if !oneof {
+			g.field(field, false)
 		} else {
 			fieldName := field.Oneof.GoName
 			if _, ok := oneofs[fieldName]; !ok {
@@ -60,17 +79,25 @@ func (g *fastGenerator) genSizeMethod() {
 					g.P("if x == nil {")
 					g.P("break")
 					g.P("}")
-					g.field(true, ooField, true)
+					g.field(ooField, true)
 				}
 				g.P("}")
 			}
 		}
 	}
 
+	if hasExtensions(g.message) {
+		g.P(`n += `, runtimePackage.Ident("SizeExtensions"), `(&x.extensionFields, options)`)
+	}
 	// last thing to do
 	g.P(`if x.unknownFields != nil {`)
 	g.P(`n+=len(x.unknownFields)`)
 	g.P(`}`)
+	g.P("if n > ", mathPackage.Ident("MaxInt32"), "-1 {")
+	g.P(atomicPkg.Ident("StoreInt32"), "(&x.sizeCache, 0)")
+	g.P("} else {")
+	g.P(atomicPkg.Ident("StoreInt32"), "(&x.sizeCache, int32(n+1))")
+	g.P("}")
 	g.P(`return `, protoifacePkg.Ident("SizeOutput"), "{ ")
 	g.P("NoUnkeyedLiterals: input.NoUnkeyedLiterals,")
 	g.P("Size: n,")
@@ -79,9 +106,19 @@ func (g *fastGenerator) genSizeMethod() {
 	g.P()
 }
 
-func (g *fastGenerator) field(proto3 bool, field *protogen.Field, oneof bool) {
+func (g *fastGenerator) field(field *protogen.Field, oneof bool) {
 	fieldname := field.GoName
-	nullable := field.Message != nil || (field.Oneof != nil && field.Oneof.Desc.IsSynthetic())
+	if g.IsScalar(field) {
+		key := generator.KeySize(field.Desc.Number(), protowire.BytesType)
+		g.P(`l = `, runtimePackage.Ident("SizeScalar"), `(&x.`, fieldname, `)`)
+		g.P(`if l > 0 {`)
+		g.P(`n+=`, strconv.Itoa(key), `+l+`, runtimePackage.Ident("Sov"), `(uint64(l))`)
+		g.P(`}`)
+		return
+	}
+	// presence is resolved from the field syntax or edition features
+	implicitPresence := !field.Desc.HasPresence()
+	nullable := field.Message != nil || hasExplicitPresence(field)
 	repeated := field.Desc.Cardinality() == protoreflect.Repeated
 	if repeated && !oneof {
 		g.P(`if len(x.`, fieldname, `) > 0 {`)
@@ -102,7 +139,7 @@ func (g *fastGenerator) field(proto3 bool, field *protogen.Field, oneof bool) {
 			g.P(`n+=`, strconv.Itoa(key), `+`, runtimePackage.Ident("Sov"), `(uint64(len(x.`, fieldname, `)*8))`, `+len(x.`, fieldname, `)*8`)
 		} else if repeated {
 			g.P(`n+=`, strconv.Itoa(key+8), `*len(x.`, fieldname, `)`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			if !oneof {
 				g.P(`if x.`, fieldname, ` != 0 {`)
 			}
@@ -118,7 +155,7 @@ func (g *fastGenerator) field(proto3 bool, field *protogen.Field, oneof bool) {
 			g.P(`n+=`, strconv.Itoa(key), `+`, runtimePackage.Ident("Sov"), `(uint64(len(x.`, fieldname, `)*8))`, `+len(x.`, fieldname, `)*8`)
 		} else if repeated {
 			g.P(`n+=`, strconv.Itoa(key+8), `*len(x.`, fieldname, `)`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			if !oneof {
 				g.P(`if x.`, fieldname, ` != 0 || `, mathPackage.Ident("Signbit"), `(x.`, fieldname, `) {`)
 			}
@@ -134,7 +171,7 @@ func (g *fastGenerator) field(proto3 bool, field *protogen.Field, oneof bool) {
 			g.P(`n+=`, strconv.Itoa(key), `+`, runtimePackage.Ident("Sov"), `(uint64(len(x.`, fieldname, `)*4))`, `+len(x.`, fieldname, `)*4`)
 		} else if repeated {
 			g.P(`n+=`, strconv.Itoa(key+4), `*len(x.`, fieldname, `)`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			if !oneof {
 				g.P(`if x.`, fieldname, ` != 0 {`)
 			}
@@ -150,7 +187,7 @@ func (g *fastGenerator) field(proto3 bool, field *protogen.Field, oneof bool) {
 			g.P(`n+=`, strconv.Itoa(key), `+`, runtimePackage.Ident("Sov"), `(uint64(len(x.`, fieldname, `)*4))`, `+len(x.`, fieldname, `)*4`)
 		} else if repeated {
 			g.P(`n+=`, strconv.Itoa(key+4), `*len(x.`, fieldname, `)`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			if !oneof {
 				g.P(`if x.`, fieldname, ` != 0 || `, mathPackage.Ident("Signbit"), `(float64(x.`, fieldname, `)) {`)
 			}
@@ -174,7 +211,7 @@ func (g *fastGenerator) field(proto3 bool, field *protogen.Field, oneof bool) {
 			g.P(`}`)
 		} else if nullable {
 			g.P(`n+=`, strconv.Itoa(key), `+`, runtimePackage.Ident("Sov"), `(uint64(*x.`, fieldname, `))`)
-		} else if proto3 {
+		} else if implicitPresence {
 			if !oneof {
 				g.P(`if x.`, fieldname, ` != 0 {`)
 			}
@@ -190,7 +227,7 @@ func (g *fastGenerator) field(proto3 bool, field *protogen.Field, oneof bool) {
 			g.P(`n+=`, strconv.Itoa(key), `+`, runtimePackage.Ident("Sov"), `(uint64(len(x.`, fieldname, `)))`, `+len(x.`, fieldname, `)*1`)
 		} else if repeated {
 			g.P(`n+=`, strconv.Itoa(key+1), `*len(x.`, fieldname, `)`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			if !oneof {
 				g.P(`if x.`, fieldname, ` {`)
 			}
@@ -210,7 +247,7 @@ func (g *fastGenerator) field(proto3 bool, field *protogen.Field, oneof bool) {
 		} else if nullable {
 			g.P(`l=len(*x.`, fieldname, `)`)
 			g.P(`n+=`, strconv.Itoa(key), `+l+`, runtimePackage.Ident("Sov"), `(uint64(l))`)
-		} else if proto3 {
+		} else if implicitPresence {
 			g.P(`l=len(x.`, fieldname, `)`)
 			if !oneof {
 				g.P(`if l > 0 {`)
@@ -224,15 +261,23 @@ func (g *fastGenerator) field(proto3 bool, field *protogen.Field, oneof bool) {
 			g.P(`n+=`, strconv.Itoa(key), `+l+`, runtimePackage.Ident("Sov"), `(uint64(l))`)
 		}
 	case protoreflect.GroupKind:
-		panic(fmt.Errorf("size does not support group %v", fieldname))
+		// groups are delimited by a start and an end tag of the same size
+		if repeated {
+			g.P(`for _, e := range x.`, fieldname, ` { `)
+			g.messageSize("e", field.Message)
+			g.P(`n+=`, strconv.Itoa(2*key), `+l`)
+			g.P(`}`)
+		} else {
+			g.messageSize("x."+fieldname, field.Message)
+			g.P(`n+=`, strconv.Itoa(2*key), `+l`)
+		}
 	case protoreflect.MessageKind:
 		if field.Desc.IsMap() {
 			fieldKeySize := generator.KeySize(field.Desc.Number(), generator.ProtoWireType(field.Desc.Kind()))
 			goTypeK, _ := g.FieldGoType(field.Message.Fields[0])
-			goTypeV, ptr := g.FieldGoType(field.Message.Fields[1])
-			if ptr {
-				goTypeV = "*" + goTypeV
-			}
+			// map values are never stored behind pointers, even when the
+			// entry field tracks presence as it does in proto2 files.
+			goTypeV, _ := g.FieldGoType(field.Message.Fields[1])
 			keyKeySize := generator.KeySize(1, generator.ProtoWireType(field.Message.Fields[0].Desc.Kind()))
 			valueKeySize := generator.KeySize(2, generator.ProtoWireType(field.Message.Fields[1].Desc.Kind()))
 
@@ -335,7 +380,7 @@ func (g *fastGenerator) field(proto3 bool, field *protogen.Field, oneof bool) {
 			g.P(`l = len(b)`)
 			g.P(`n+=`, strconv.Itoa(key), `+l+`, runtimePackage.Ident("Sov"), `(uint64(l))`)
 			g.P(`}`)
-		} else if proto3 {
+		} else if implicitPresence {
 			g.P(`l=len(x.`, fieldname, `)`)
 			if !oneof {
 				g.P(`if l > 0 {`)
@@ -361,7 +406,7 @@ func (g *fastGenerator) field(proto3 bool, field *protogen.Field, oneof bool) {
 			g.P(`}`)
 		} else if nullable {
 			g.P(`n+=`, strconv.Itoa(key), `+`, runtimePackage.Ident("Soz"), `(uint64(*x.`, fieldname, `))`)
-		} else if proto3 {
+		} else if implicitPresence {
 			if !oneof {
 				g.P(`if x.`, fieldname, ` != 0 {`)
 			}
@@ -3,9 +3,11 @@ package fastreflection
 import (
 	"fmt"
 	"github.com/cosmos/cosmos-proto/generator"
+	"github.com/cosmos/cosmos-proto/runtime"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -14,8 +16,10 @@ func (g *fastGenerator) genUnmarshalMethod() {
 
 	required := g.message.Desc.RequiredNumbers()
 
+	g.genLazyScalars()
+
 	// UNMARSHAL METHOD
-	g.P(`unmarshal := func(input `, protoifacePkg.Ident("UnmarshalInput"), `) (`, protoifacePkg.Ident("UnmarshalOutput"), `, error) {`)
+	g.P(`func `, g.protoMethodName("unmarshal"), `(input `, protoifacePkg.Ident("UnmarshalInput"), `) (`, protoifacePkg.Ident("UnmarshalOutput"), `, error) {`)
 	g.P(`x := input.Message.Interface().(*`, g.message.GoIdent, `)`)
 	g.P(`if x == nil {`)
 	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), ` {`)
@@ -23,9 +27,25 @@ func (g *fastGenerator) genUnmarshalMethod() {
 	g.P("Flags:               input.Flags,")
 	g.P("}, nil")
 	g.P("}")
+	g.P("if input.Depth < 0 {")
+	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, ", runtimePackage.Ident("ErrRecursionLimit"), "(", messageDescriptorName(g.message), ".FullName())")
+	g.P("}")
 	g.P("options := ", runtimePackage.Ident("UnmarshalInputToOptions"), "(input)")
 	g.P("_ = options")
 	g.P("dAtA := input.Buf")
+	if hasZeroCopyFields(g.message) {
+		g.P("zeroCopy := ", runtimePackage.Ident("IsZeroCopy"), "(options)")
+	}
+	if g.ReusesBytes(g.message) {
+		// the memory of the bytes fields is reused as long as they do not alias an input
+		g.P("if zeroCopy {")
+		g.P("x.", generator.ZeroCopiedGoName, " = true")
+		g.P("}")
+	}
+	// the budget is started by the top-level message and passed on to the nested ones
+	if usesDecodeBudget(g.message) {
+		g.P("options, budget := ", runtimePackage.Ident("StartDecodeBudget"), "(options)")
+	}
 	// body
 	if required.Len() > 0 {
 		g.P(`var hasFields [`, strconv.Itoa(1+(required.Len()-1)/64), `]uint64`)
@@ -46,41 +66,21 @@ func (g *fastGenerator) genUnmarshalMethod() {
 	g.P(`}`)
 	g.P(`switch fieldNum {`)
 	for _, field := range g.message.Fields {
-		g.unmarshalField(field, g.message, true, required)
+		g.unmarshalField(field, g.message, required)
 	}
 	g.P(`default:`)
-	if len(g.message.Extensions) > 0 {
-		c := []string{}
-		eranges := g.message.Desc.ExtensionRanges()
-		for e := 0; e < eranges.Len(); e++ {
-			erange := eranges.Get(e)
-			c = append(c, `((fieldNum >= `, strconv.Itoa(int(erange[0])), ") && (fieldNum < ", strconv.Itoa(int(erange[1])), `))`)
-		}
-		g.P(`if `, strings.Join(c, "||"), `{`)
-		g.P(`var sizeOfWire int`)
-		g.P(`for {`)
-		g.P(`sizeOfWire++`)
-		g.P(`wire >>= 7`)
-		g.P(`if wire == 0 {`)
-		g.P(`break`)
-		g.P(`}`)
-		g.P(`}`)
-		g.P(`iNdEx-=sizeOfWire`)
-		g.P(`skippy, err := `, runtimePackage.Ident("Skip"), `(dAtA[iNdEx:])`)
-		g.P(`if err != nil {`)
+	g.P(`iNdEx=preIndex`)
+	if hasExtensions(g.message) {
+		g.P(`if n, err := `, runtimePackage.Ident("UnmarshalExtension"), `(dAtA[iNdEx:], &x.extensionFields, &x.unknownFields, `, messageDescriptorName(g.message), `, options); err != nil {`)
 		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", `err`)
+		g.P(`} else if n > 0 {`)
+		g.P(`iNdEx += n`)
+		g.P(`continue`)
 		g.P(`}`)
-		g.P(`if (skippy < 0) || (iNdEx + skippy) < 0 {`)
-		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", runtimePackage.Ident("ErrInvalidLength"))
-		g.P(`}`)
-		g.P(`if (iNdEx + skippy) > l {`)
-		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", g.Ident("io", `ErrUnexpectedEOF`))
-		g.P(`}`)
-		g.P(g.Ident(generator.ProtoPkg, "AppendExtension"), `(m, int32(fieldNum), dAtA[iNdEx:iNdEx+skippy])`)
-		g.P(`iNdEx += skippy`)
-		g.P(`} else {`)
 	}
-	g.P(`iNdEx=preIndex`)
+	g.P(`if err := `, runtimePackage.Ident("CheckUnknownField"), `(options, `, messageDescriptorName(g.message), `, `, protoreflectPkg.Ident("FieldNumber"), `(fieldNum)); err != nil {`)
+	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", `err`)
+	g.P(`}`)
 	g.P(`skippy, err := `, runtimePackage.Ident("Skip"), `(dAtA[iNdEx:])`)
 	g.P(`if err != nil {`)
 	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", `err`)
@@ -95,35 +95,192 @@ func (g *fastGenerator) genUnmarshalMethod() {
 	g.P(`x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)`)
 	g.P("}")
 	g.P(`iNdEx += skippy`)
-	if len(g.message.Extensions) > 0 {
-		g.P(`}`)
-	}
 	g.P(`}`)
 	g.P(`}`)
 
-	for _, field := range g.message.Fields {
-		if field.Desc.Cardinality() != protoreflect.Required {
+	g.P()
+	g.P(`if iNdEx > l {`)
+	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, ", g.Ident("io", `ErrUnexpectedEOF`))
+	g.P(`}`)
+	g.genUnmarshalInitialized(required)
+	g.P(`}`)
+}
+
+// lazyMessageItem records the occurrence of the lazy field, unless the field holds a value,
+// which the field does while its encoding was not decoded only when it was assigned, or the
+// occurrence is invalid, in which case it is decoded eagerly.
+func (g *fastGenerator) lazyMessageItem(field *protogen.Field, message *protogen.Message) {
+	index := g.LazyIndex(field)
+	empty := `x.` + field.GoName + ` == nil`
+	if field.Desc.IsList() {
+		empty = `len(x.` + field.GoName + `) == 0`
+	}
+	scalars := "nil"
+	if len(lazyScalars(field.Message)) > 0 {
+		scalars = lazyScalarsVarName(message, field)
+	}
+	g.P(`if `, empty, ` && x.lazyFields.Append(`, index, `, `, len(g.LazyFields(message)), `, dAtA[preIndex:postIndex], `, fieldDescriptorName(field), `.Message(), `, scalars, `, options) {`)
+	g.P(`iNdEx = postIndex`)
+	g.P(`break`)
+	g.P(`}`)
+	g.P(`x.lazyDecode`, field.GoName, `()`)
+	g.P(`x.lazyFields.Discard(`, index, `)`)
+	g.messageItem(field, field.GoName, `dAtA[iNdEx:postIndex]`)
+}
+
+// genLazyScalars generates the scalars validating the fields of the messages held by the
+// lazy fields of the message, which their encoding is checked against before being recorded.
+func (g *fastGenerator) genLazyScalars() {
+	for _, field := range g.LazyFields(g.message) {
+		scalars := lazyScalars(field.Message)
+		if len(scalars) == 0 {
 			continue
 		}
-		var fieldBit int
-		for fieldBit = 0; fieldBit < required.Len(); fieldBit++ {
-			if required.Get(fieldBit) == field.Desc.Number() {
-				break
+		names := make([]string, 0, len(scalars))
+		for name := range scalars {
+			names = append(names, string(name))
+		}
+		sort.Strings(names)
+		g.P("var ", lazyScalarsVarName(g.message, field), " = map[", protoreflectPkg.Ident("FullName"), "]string{")
+		for _, name := range names {
+			g.P(strconv.Quote(name), ": ", strconv.Quote(scalars[protoreflect.FullName(name)]), ",")
+		}
+		g.P("}")
+		g.P()
+	}
+}
+
+func lazyScalarsVarName(message *protogen.Message, field *protogen.Field) string {
+	return fmt.Sprintf("_%s_%s_scalars", message.GoIdent.GoName, field.GoName)
+}
+
+// lazyScalars returns the scalars validating the fields of the message and of its nested
+// messages, keyed by the full names of the fields.
+func lazyScalars(message *protogen.Message) map[protoreflect.FullName]string {
+	scalars := map[protoreflect.FullName]string{}
+	seen := map[protoreflect.FullName]bool{}
+	var walk func(message *protogen.Message)
+	walk = func(message *protogen.Message) {
+		if seen[message.Desc.FullName()] {
+			return
+		}
+		seen[message.Desc.FullName()] = true
+		for _, field := range message.Fields {
+			if scalar := validatedScalar(field); scalar != "" {
+				scalars[field.Desc.FullName()] = scalar
+			}
+			if field.Message != nil {
+				walk(field.Message)
 			}
 		}
-		if fieldBit == required.Len() {
-			panic("missing required field")
+	}
+	walk(message)
+	return scalars
+}
+
+// validatedScalar returns the scalar validating the values of the field, which are those of
+// the string and bytes fields and of the maps with string or bytes values annotated with the
+// cosmos_proto.scalar option, or an empty string.
+func validatedScalar(field *protogen.Field) string {
+	kind := field.Desc.Kind()
+	if field.Desc.IsMap() {
+		kind = field.Desc.MapValue().Kind()
+	}
+	if kind != protoreflect.StringKind && kind != protoreflect.BytesKind {
+		return ""
+	}
+	return runtime.FieldScalar(field.Desc)
+}
+
+// hasZeroCopyFields reports whether the message holds string or bytes fields,
+// whose unmarshal differs in zero-copy mode.
+func hasZeroCopyFields(message *protogen.Message) bool {
+	for _, field := range message.Fields {
+		fields := []*protogen.Field{field}
+		if field.Desc.IsMap() {
+			fields = field.Message.Fields
+		}
+		for _, field := range fields {
+			switch field.Desc.Kind() {
+			case protoreflect.StringKind, protoreflect.BytesKind:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// genUnmarshalInitialized generates the successful return of unmarshal, which reports
+// the message as initialized when it is known that no required field is missing.
+// Messages which can not be cheaply proven initialized are left to the proto runtime,
+// which checks them on its own unless partial messages are allowed.
+func (g *fastGenerator) genUnmarshalInitialized(required protoreflect.FieldNumbers) {
+	switch {
+	case !requiresInitCheck(g.message.Desc, map[protoreflect.FullName]bool{}):
+		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: ", protoifacePkg.Ident("UnmarshalInitialized"), "}, ", `nil`)
+	case nestedRequiresInitCheck(g.message.Desc), hasExtensions(g.message):
+		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, ", `nil`)
+	default:
+		// required fields decoded in previous merges are not tracked,
+		// so a missing bit does not necessarily mean the field is unset.
+		var checks []string
+		for word := 0; word <= (required.Len()-1)/64; word++ {
+			var mask uint64
+			for bit := word * 64; bit < required.Len() && bit < (word+1)*64; bit++ {
+				mask |= uint64(1) << (bit % 64)
+			}
+			checks = append(checks, fmt.Sprintf("hasFields[%d] & 0x%x == 0x%x", word, mask, mask))
 		}
-		g.P(`if hasFields[`, strconv.Itoa(fieldBit/64), `] & uint64(`, fmt.Sprintf("0x%08x", uint64(1)<<(fieldBit%64)), `) == 0 {`)
-		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", `new(`, g.Ident(generator.ProtoPkg, "RequiredNotSetError"), `)`)
+		g.P(`var flags `, protoifacePkg.Ident("UnmarshalOutputFlags"))
+		g.P(`if `, strings.Join(checks, " && "), ` {`)
+		g.P(`flags |= `, protoifacePkg.Ident("UnmarshalInitialized"))
 		g.P(`}`)
+		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: flags}, ", `nil`)
 	}
-	g.P()
-	g.P(`if iNdEx > l {`)
-	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, ", g.Ident("io", `ErrUnexpectedEOF`))
-	g.P(`}`)
-	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, ", `nil`)
-	g.P(`}`)
+}
+
+// requiresInitCheck reports whether the message, or any message reachable from it,
+// declares required fields. Messages declaring extension ranges may hold
+// extensions with required fields and always need to be checked.
+func requiresInitCheck(md protoreflect.MessageDescriptor, seen map[protoreflect.FullName]bool) bool {
+	if seen[md.FullName()] {
+		return false
+	}
+	seen[md.FullName()] = true
+	if md.RequiredNumbers().Len() > 0 || md.ExtensionRanges().Len() > 0 {
+		return true
+	}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if fd.Message() != nil && requiresInitCheck(fd.Message(), seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// nestedRequiresInitCheck reports whether any message held by the fields
+// of the message declares required fields, directly or transitively.
+func nestedRequiresInitCheck(md protoreflect.MessageDescriptor) bool {
+	seen := map[protoreflect.FullName]bool{}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if fd.Message() == nil {
+			continue
+		}
+		if requiresInitCheck(fd.Message(), seen) {
+			return true
+		}
+	}
+	return false
 }
 
 func (g *fastGenerator) decodeVarint(varName string, typName string) {
@@ -143,18 +300,19 @@ func (g *fastGenerator) decodeVarint(varName string, typName string) {
 	g.P(`}`)
 }
 
-func (g *fastGenerator) unmarshalField(field *protogen.Field, message *protogen.Message, proto3 bool, required protoreflect.FieldNumbers) {
+func (g *fastGenerator) unmarshalField(field *protogen.Field, message *protogen.Message, required protoreflect.FieldNumbers) {
 	fieldname := field.GoName
 	errFieldname := fieldname
-	if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+	if inOneof(field) {
 		fieldname = field.Oneof.GoName
 	}
 
 	g.P(`case `, strconv.Itoa(int(field.Desc.Number())), `:`)
 	wireType := generator.ProtoWireType(field.Desc.Kind())
-	if field.Desc.IsList() && wireType != protowire.BytesType {
+	if field.Desc.IsList() && wireType != protowire.BytesType && wireType != protowire.StartGroupType {
 		g.P(`if wireType == `, strconv.Itoa(int(wireType)), `{`)
-		g.fieldItem(field, fieldname, message, false)
+		g.spendBudget(`List(`, fieldDescriptorName(field), `, len(x.`, fieldname, `), 1, `, goSize(field.Desc.Kind()), `)`)
+		g.fieldItem(field, fieldname, message)
 		g.P(`} else if wireType == `, strconv.Itoa(int(protowire.BytesType)), `{`)
 		g.P(`var packedLen int`)
 		g.decodeVarint("packedLen", "int")
@@ -175,7 +333,7 @@ func (g *fastGenerator) unmarshalField(field *protogen.Field, message *protogen.
 			g.P(`elementCount = packedLen/`, 8)
 		case protoreflect.FloatKind, protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind:
 			g.P(`elementCount = packedLen/`, 4)
-		case protoreflect.Int64Kind, protoreflect.Uint64Kind, protoreflect.Int32Kind, protoreflect.Uint32Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		case protoreflect.Int64Kind, protoreflect.Uint64Kind, protoreflect.Int32Kind, protoreflect.Uint32Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind, protoreflect.EnumKind:
 			g.P(`var count int`)
 			g.P(`for _, integer := range dAtA[iNdEx:postIndex] {`)
 			g.P(`if integer < 128 {`)
@@ -187,6 +345,7 @@ func (g *fastGenerator) unmarshalField(field *protogen.Field, message *protogen.
 			g.P(`elementCount = packedLen`)
 		}
 
+		g.spendBudget(`List(`, fieldDescriptorName(field), `, len(x.`, fieldname, `), elementCount, `, goSize(field.Desc.Kind()), `)`)
 		g.P(`if elementCount != 0 && len(x.`, fieldname, `) == 0 {`)
 
 		fieldtyp, _ := g.FieldGoType(field)
@@ -194,7 +353,7 @@ func (g *fastGenerator) unmarshalField(field *protogen.Field, message *protogen.
 		g.P(`}`)
 
 		g.P(`for iNdEx < postIndex {`)
-		g.fieldItem(field, fieldname, message, false)
+		g.fieldItem(field, fieldname, message)
 		g.P(`}`)
 		g.P(`} else {`)
 		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", g.Ident("fmt", "Errorf"), `("proto: wrong wireType = %d for field `, errFieldname, `", wireType)`)
@@ -203,7 +362,10 @@ func (g *fastGenerator) unmarshalField(field *protogen.Field, message *protogen.
 		g.P(`if wireType != `, strconv.Itoa(int(wireType)), `{`)
 		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", g.Ident("fmt", "Errorf"), `("proto: wrong wireType = %d for field `, errFieldname, `", wireType)`)
 		g.P(`}`)
-		g.fieldItem(field, fieldname, message, proto3)
+		if field.Desc.IsList() {
+			g.spendBudget(`List(`, fieldDescriptorName(field), `, len(x.`, fieldname, `), 1, `, goSize(field.Desc.Kind()), `)`)
+		}
+		g.fieldItem(field, fieldname, message)
 	}
 
 	if field.Desc.Cardinality() == protoreflect.Required {
@@ -220,12 +382,18 @@ func (g *fastGenerator) unmarshalField(field *protogen.Field, message *protogen.
 	}
 }
 
-func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, message *protogen.Message, proto3 bool) {
+func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, message *protogen.Message) {
+	implicitPresence := !field.Desc.HasPresence()
 	repeated := field.Desc.Cardinality() == protoreflect.Repeated
 	typ := g.noStarOrSliceType(field)
-	oneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
+	oneof := inOneof(field)
 	nullable := field.Oneof != nil && field.Oneof.Desc.IsSynthetic()
 
+	if g.IsScalar(field) {
+		g.scalarItem(field, fieldname)
+		return
+	}
+
 	switch field.Desc.Kind() {
 	case protoreflect.DoubleKind:
 		g.P(`var v uint64`)
@@ -235,7 +403,7 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 		} else if repeated {
 			g.P(`v2 := `, typ, "(", g.Ident("math", "Float64frombits"), `(v))`)
 			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, v2)`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			g.P(`x.`, fieldname, ` = `, typ, "(", g.Ident("math", "Float64frombits"), `(v))`)
 		} else {
 			g.P(`v2 := `, typ, "(", g.Ident("math", "Float64frombits"), `(v))`)
@@ -249,7 +417,7 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 		} else if repeated {
 			g.P(`v2 := `, typ, "(", g.Ident("math", "Float32frombits"), `(v))`)
 			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, v2)`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			g.P(`x.`, fieldname, ` = `, typ, "(", g.Ident("math", "Float32frombits"), `(v))`)
 		} else {
 			g.P(`v2 := `, typ, "(", g.Ident("math", "Float32frombits"), `(v))`)
@@ -264,7 +432,7 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 			g.P(`var v `, typ)
 			g.decodeVarint("v", typ)
 			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			g.P(`x.`, fieldname, ` = 0`)
 			g.decodeVarint("x."+fieldname, typ)
 		} else {
@@ -281,7 +449,7 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 			g.P(`var v `, typ)
 			g.decodeVarint("v", typ)
 			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			g.P(`x.`, fieldname, ` = 0`)
 			g.decodeVarint("x."+fieldname, typ)
 		} else {
@@ -298,7 +466,7 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 			g.P(`var v `, typ)
 			g.decodeVarint("v", typ)
 			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			g.P(`x.`, fieldname, ` = 0`)
 			g.decodeVarint("x."+fieldname, typ)
 		} else {
@@ -315,7 +483,7 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 			g.P(`var v `, typ)
 			g.decodeFixed64("v", typ)
 			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			g.P(`x.`, fieldname, ` = 0`)
 			g.decodeFixed64("x."+fieldname, typ)
 		} else {
@@ -332,7 +500,7 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 			g.P(`var v `, typ)
 			g.decodeFixed32("v", typ)
 			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			g.P(`x.`, fieldname, ` = 0`)
 			g.decodeFixed32("x."+fieldname, typ)
 		} else {
@@ -348,7 +516,7 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 			g.P(`x.`, fieldname, ` = &`, field.GoIdent, `{b}`)
 		} else if repeated {
 			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, `, typ, `(v != 0))`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			g.P(`x.`, fieldname, ` = `, typ, `(v != 0)`)
 		} else {
 			g.P(`b := `, typ, `(v != 0)`)
@@ -368,19 +536,31 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 		g.P(`if postIndex > l {`)
 		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", g.Ident("io", `ErrUnexpectedEOF`))
 		g.P(`}`)
+		g.spendBudget(`Length(`, fieldDescriptorName(field), `, intStringLen)`)
+		g.validateUTF8Bytes(field, `dAtA[iNdEx:postIndex]`)
+		g.validateScalar(field, fieldname, `dAtA[iNdEx:postIndex]`)
+		str := g.QualifiedGoIdent(runtimePackage.Ident("String")) + `(dAtA[iNdEx:postIndex], zeroCopy)`
+		if typ != "string" {
+			str = typ + "(" + str + ")"
+		}
 		if oneof {
-			g.P(`x.`, fieldname, ` = &`, field.GoIdent, `{`, typ, `(dAtA[iNdEx:postIndex])}`)
+			g.P(`x.`, fieldname, ` = &`, field.GoIdent, `{`, str, `}`)
 		} else if repeated {
-			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, `, typ, `(dAtA[iNdEx:postIndex]))`)
-		} else if proto3 && !nullable {
-			g.P(`x.`, fieldname, ` = `, typ, `(dAtA[iNdEx:postIndex])`)
+			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, `, str, `)`)
+		} else if implicitPresence && !nullable {
+			g.P(`x.`, fieldname, ` = `, str)
 		} else {
-			g.P(`s := `, typ, `(dAtA[iNdEx:postIndex])`)
+			g.P(`s := `, str)
 			g.P(`x.`, fieldname, ` = &s`)
 		}
 		g.P(`iNdEx = postIndex`)
 	case protoreflect.GroupKind:
-		panic(fmt.Errorf("unmarshaler does not support group %v", fieldname))
+		g.P(`group, n := `, protowirePkg.Ident("ConsumeGroup"), `(`, protowirePkg.Ident("Number"), `(fieldNum), dAtA[iNdEx:])`)
+		g.P(`if n < 0 {`)
+		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", protowirePkg.Ident("ParseError"), `(n)`)
+		g.P(`}`)
+		g.messageItem(field, fieldname, `group`)
+		g.P(`iNdEx += n`)
 	case protoreflect.MessageKind:
 		g.P(`var msglen int`)
 		g.decodeVarint("msglen", "int")
@@ -394,14 +574,7 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 		g.P(`if postIndex > l {`)
 		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", g.Ident("io", `ErrUnexpectedEOF`))
 		g.P(`}`)
-		if oneof {
-			buf := `dAtA[iNdEx:postIndex]`
-			msgname := g.noStarOrSliceType(field)
-			g.P(`v := &`, msgname, `{}`)
-			g.decodeMessage("v", buf, field.Message)
-			g.P(`x.`, fieldname, ` = &`, field.GoIdent, `{v}`)
-
-		} else if field.Desc.IsMap() {
+		if field.Desc.IsMap() {
 			goTyp, _ := g.FieldGoType(field)
 			goTypK, _ := g.FieldGoType(field.Message.Fields[0])
 			goTypV, _ := g.FieldGoType(field.Message.Fields[1])
@@ -412,6 +585,18 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 
 			g.P("var mapkey ", goTypK)
 			g.P("var mapvalue ", goTypV)
+			messageValue := field.Message.Fields[1].Message != nil
+			if messageValue {
+				g.P("var mapvalueErr error")
+			}
+			valueScalar := validatedScalar(field)
+			if valueScalar != "" {
+				g.P("var mapvalueRaw []byte")
+			}
+			closedValue := closedEnum(field.Message.Fields[1])
+			if closedValue {
+				g.P("var unknownValue bool")
+			}
 			g.P(`for iNdEx < postIndex {`)
 
 			g.P(`entryPreIndex := iNdEx`)
@@ -420,9 +605,12 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 			g.P(`fieldNum := int32(wire >> 3)`)
 
 			g.P(`if fieldNum == 1 {`)
-			g.unmarshalMapField("mapkey", field.Message.Fields[0])
+			g.unmarshalMapField("mapkey", field.Message.Fields[0], field)
 			g.P(`} else if fieldNum == 2 {`)
-			g.unmarshalMapField("mapvalue", field.Message.Fields[1])
+			g.unmarshalMapField("mapvalue", field.Message.Fields[1], field)
+			if closedValue {
+				g.P(`unknownValue = !(`, enumValidity(field.Message.Fields[1].Enum, "mapvalue"), `)`)
+			}
 			g.P(`} else {`)
 			g.P(`iNdEx = entryPreIndex`)
 			g.P(`skippy, err := `, runtimePackage.Ident("Skip"), `(dAtA[iNdEx:])`)
@@ -438,18 +626,39 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 			g.P(`iNdEx += skippy`)
 			g.P(`}`)
 			g.P(`}`)
-			g.P(`x.`, fieldname, `[mapkey] = mapvalue`)
-		} else if repeated {
-			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, &`, field.Message.GoIdent, `{})`)
-
-			varname := fmt.Sprintf("x.%s[len(x.%s) - 1]", fieldname, fieldname)
-			buf := `dAtA[iNdEx:postIndex]`
-			g.decodeMessage(varname, buf, field.Message)
-		} else {
-			g.P(`if x.`, fieldname, ` == nil {`)
-			g.P(`x.`, fieldname, ` = &`, field.Message.GoIdent, `{}`)
+			if messageValue {
+				g.P(`if mapvalueErr != nil {`)
+				g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", runtimePackage.Ident("InvalidScalarInMap"), `(mapvalueErr, `, fieldDescriptorName(field), `, mapkey)`)
+				g.P(`}`)
+			}
+			if valueScalar != "" {
+				// the value is validated once its key is known, a missing value being empty
+				g.P(`if err := `, runtimePackage.Ident("ValidateScalarInMap"), `(`, strconv.Quote(valueScalar), `, `, fieldDescriptorName(field), `, mapkey, mapvalueRaw); err != nil {`)
+				g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err")
+				g.P(`}`)
+			}
+			// only new keys allocate an entry
+			g.P(`if budget != nil {`)
+			g.P(`if _, ok := x.`, fieldname, `[mapkey]; !ok {`)
+			g.spendBudget(`MapEntry(`, fieldDescriptorName(field), `, len(x.`, fieldname, `), `, goSize(field.Message.Fields[0].Desc.Kind())+goSize(field.Message.Fields[1].Desc.Kind()), `)`)
 			g.P(`}`)
-			g.decodeMessage("x."+fieldname, "dAtA[iNdEx:postIndex]", field.Message)
+			g.P(`}`)
+			if closedValue {
+				// entries holding unknown values of closed enums are kept whole
+				g.P(`if unknownValue {`)
+				g.P("if !options.DiscardUnknown {")
+				g.P(`x.unknownFields = append(x.unknownFields, dAtA[preIndex:postIndex]...)`)
+				g.P(`}`)
+				g.P(`} else {`)
+				g.P(`x.`, fieldname, `[mapkey] = mapvalue`)
+				g.P(`}`)
+			} else {
+				g.P(`x.`, fieldname, `[mapkey] = mapvalue`)
+			}
+		} else if g.IsLazy(field) {
+			g.lazyMessageItem(field, message)
+		} else {
+			g.messageItem(field, fieldname, `dAtA[iNdEx:postIndex]`)
 		}
 		g.P(`iNdEx = postIndex`)
 
@@ -466,18 +675,27 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 		g.P(`if postIndex > l {`)
 		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", g.Ident("io", `ErrUnexpectedEOF`))
 		g.P(`}`)
+		g.spendBudget(`Length(`, fieldDescriptorName(field), `, byteLen)`)
+		g.validateScalar(field, fieldname, `dAtA[iNdEx:postIndex]`)
+		// the memory of the current value is reused, unless it may alias a buffer given
+		// to a zero-copy unmarshal, which would be overwritten
 		if oneof {
-			g.P(`v := make([]byte, postIndex-iNdEx)`)
-			g.P(`copy(v, dAtA[iNdEx:postIndex])`)
-			g.P(`x.`, fieldname, ` = &`, field.GoIdent, `{v}`)
+			g.P(`x.`, fieldname, ` = &`, field.GoIdent, `{`, runtimePackage.Ident("Bytes"), `(dAtA[iNdEx:postIndex], zeroCopy)}`)
 		} else if repeated {
-			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, make([]byte, postIndex-iNdEx))`)
-			g.P(`copy(x.`, fieldname, `[len(x.`, fieldname, `)-1], dAtA[iNdEx:postIndex])`)
+			g.P(`if zeroCopy || x.`, generator.ZeroCopiedGoName, ` {`)
+			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, `, runtimePackage.Ident("Bytes"), `(dAtA[iNdEx:postIndex], zeroCopy))`)
+			g.P(`} else {`)
+			g.P(`x.`, fieldname, ` = `, runtimePackage.Ident("AppendBytes"), `(x.`, fieldname, `, dAtA[iNdEx:postIndex])`)
+			g.P(`}`)
 		} else {
-			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `[:0] , dAtA[iNdEx:postIndex]...)`)
+			g.P(`if zeroCopy || x.`, generator.ZeroCopiedGoName, ` {`)
+			g.P(`x.`, fieldname, ` = `, runtimePackage.Ident("Bytes"), `(dAtA[iNdEx:postIndex], zeroCopy)`)
+			g.P(`} else {`)
+			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `[:0], dAtA[iNdEx:postIndex]...)`)
 			g.P(`if x.`, fieldname, ` == nil {`)
 			g.P(`x.`, fieldname, ` = []byte{}`)
 			g.P(`}`)
+			g.P(`}`)
 		}
 		g.P(`iNdEx = postIndex`)
 	case protoreflect.Uint32Kind:
@@ -489,7 +707,7 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 			g.P(`var v `, typ)
 			g.decodeVarint("v", typ)
 			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			g.P(`x.`, fieldname, ` = 0`)
 			g.decodeVarint("x."+fieldname, typ)
 		} else {
@@ -498,22 +716,31 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 			g.P(`x.`, fieldname, ` = &v`)
 		}
 	case protoreflect.EnumKind:
+		if !oneof && !repeated && implicitPresence && !nullable {
+			g.P(`x.`, fieldname, ` = 0`)
+			g.decodeVarint("x."+fieldname, typ)
+			break
+		}
+		g.P(`var v `, typ)
+		g.decodeVarint("v", typ)
+		if closedEnum(field) {
+			g.P(`if !(`, enumValidity(field.Enum, "v"), `) {`)
+			g.P("if !options.DiscardUnknown {")
+			g.P(`x.unknownFields = `, protowirePkg.Ident("AppendTag"), `(x.unknownFields, `, strconv.Itoa(int(field.Desc.Number())), `, `, protowirePkg.Ident("VarintType"), `)`)
+			g.P(`x.unknownFields = `, protowirePkg.Ident("AppendVarint"), `(x.unknownFields, uint64(v))`)
+			g.P(`}`)
+			g.P(`} else {`)
+		}
 		if oneof {
-			g.P(`var v `, typ)
-			g.decodeVarint("v", typ)
 			g.P(`x.`, fieldname, ` = &`, field.GoIdent, `{v}`)
 		} else if repeated {
-			g.P(`var v `, typ)
-			g.decodeVarint("v", typ)
 			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
-			g.P(`x.`, fieldname, ` = 0`)
-			g.decodeVarint("x."+fieldname, typ)
 		} else {
-			g.P(`var v `, typ)
-			g.decodeVarint("v", typ)
 			g.P(`x.`, fieldname, ` = &v`)
 		}
+		if closedEnum(field) {
+			g.P(`}`)
+		}
 	case protoreflect.Sfixed32Kind:
 		if oneof {
 			g.P(`var v `, typ)
@@ -523,7 +750,7 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 			g.P(`var v `, typ)
 			g.decodeFixed32("v", typ)
 			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			g.P(`x.`, fieldname, ` = 0`)
 			g.decodeFixed32("x."+fieldname, typ)
 		} else {
@@ -540,7 +767,7 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 			g.P(`var v `, typ)
 			g.decodeFixed64("v", typ)
 			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			g.P(`x.`, fieldname, ` = 0`)
 			g.decodeFixed64("x."+fieldname, typ)
 		} else {
@@ -556,7 +783,7 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 			g.P(`x.`, fieldname, ` = &`, field.GoIdent, `{v}`)
 		} else if repeated {
 			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			g.P(`x.`, fieldname, ` = v`)
 		} else {
 			g.P(`x.`, fieldname, ` = &v`)
@@ -569,7 +796,7 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 			g.P(`x.`, fieldname, ` = &`, field.GoIdent, `{`, typ, `(v)}`)
 		} else if repeated {
 			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, `, typ, `(v))`)
-		} else if proto3 && !nullable {
+		} else if implicitPresence && !nullable {
 			g.P(`x.`, fieldname, ` = `, typ, `(v)`)
 		} else {
 			g.P(`v2 := `, typ, `(v)`)
@@ -580,6 +807,123 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 	}
 }
 
+// messageItem generates the decoding of the provided buffer into
+// a singular, repeated or oneof message field.
+func (g *fastGenerator) messageItem(field *protogen.Field, fieldname string, buf string) {
+	switch {
+	case inOneof(field):
+		// a later occurrence of the set field is merged into it
+		g.P(`if oneof, ok := x.`, fieldname, `.(*`, field.GoIdent, `); ok && oneof.`, field.GoName, ` != nil {`)
+		g.decodeMessage("oneof."+field.GoName, buf, field, "-1")
+		g.P(`} else {`)
+		g.spendMessage(field, nil)
+		g.P(`v := `, newMessage(g.GeneratedFile, field.Message))
+		g.decodeMessage("v", buf, field, "-1")
+		g.P(`x.`, fieldname, ` = &`, field.GoIdent, `{v}`)
+		g.P(`}`)
+	case field.Desc.IsList():
+		varname := fmt.Sprintf("x.%s[len(x.%s) - 1]", fieldname, fieldname)
+		if g.ShouldPool(field.Message) {
+			// reuse the messages retained by ResetKeepCapacity when possible
+			g.P(`if len(x.`, fieldname, `) == cap(x.`, fieldname, `) {`)
+			g.spendMessage(field, nil)
+			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, `, newMessage(g.GeneratedFile, field.Message), `)`)
+			g.P(`} else {`)
+			g.P(`x.`, fieldname, ` = x.`, fieldname, `[:len(x.`, fieldname, `) + 1]`)
+			g.P(`if `, varname, ` == nil {`)
+			g.spendMessage(field, nil)
+			g.P(varname, ` = `, newMessage(g.GeneratedFile, field.Message))
+			g.P(`}`)
+			g.P(`}`)
+		} else {
+			g.spendMessage(field, nil)
+			g.P(`x.`, fieldname, ` = append(x.`, fieldname, `, &`, field.Message.GoIdent, `{})`)
+		}
+		g.decodeMessage(varname, buf, field, "len(x."+fieldname+") - 1")
+	default:
+		g.P(`if x.`, fieldname, ` == nil {`)
+		g.spendMessage(field, nil)
+		g.P(`x.`, fieldname, ` = `, newMessage(g.GeneratedFile, field.Message))
+		g.P(`}`)
+		g.decodeMessage("x."+fieldname, buf, field, "-1")
+	}
+}
+
+// scalarItem generates the decoding of a field mapped to a Go type through the scalar option,
+// whose Unmarshal method is given the content of the field.
+func (g *fastGenerator) scalarItem(field *protogen.Field, fieldname string) {
+	g.P(`var byteLen int`)
+	g.decodeVarint("byteLen", "int")
+	g.P(`if byteLen < 0 {`)
+	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", runtimePackage.Ident("ErrInvalidLength"))
+	g.P(`}`)
+	g.P(`postIndex := iNdEx + byteLen`)
+	g.P(`if postIndex < 0 {`)
+	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", runtimePackage.Ident("ErrInvalidLength"))
+	g.P(`}`)
+	g.P(`if postIndex > l {`)
+	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", g.Ident("io", `ErrUnexpectedEOF`))
+	g.P(`}`)
+	g.spendBudget(`Length(`, fieldDescriptorName(field), `, byteLen)`)
+	g.validateUTF8Bytes(field, `dAtA[iNdEx:postIndex]`)
+	g.validateScalar(field, fieldname, `dAtA[iNdEx:postIndex]`)
+	g.P(`if err := `, runtimePackage.Ident("UnmarshalScalar"), `(`, fieldDescriptorName(field), `, &x.`, fieldname, `, dAtA[iNdEx:postIndex]); err != nil {`)
+	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err")
+	g.P(`}`)
+	g.P(`iNdEx = postIndex`)
+}
+
+// validateUTF8Bytes generates the rejection of string field contents
+// which are not valid UTF-8, when the field requires it.
+func (g *fastGenerator) validateUTF8Bytes(field *protogen.Field, buf string) {
+	if !enforceUTF8(field.Desc) {
+		return
+	}
+	g.P(`if !`, utf8Pkg.Ident("Valid"), `(`, buf, `) {`)
+	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", runtimePackage.Ident("ErrInvalidUTF8"))
+	g.P(`}`)
+}
+
+// validateScalar generates the validation of the value of a field annotated with a scalar,
+// through the validator registered for the scalar in scalarregistry.Global at run time.
+func (g *fastGenerator) validateScalar(field *protogen.Field, fieldname, buf string) {
+	scalar := validatedScalar(field)
+	if scalar == "" {
+		return
+	}
+	index := "-1"
+	if field.Desc.IsList() {
+		index = "len(x." + fieldname + ")"
+	}
+	g.P(`if err := `, runtimePackage.Ident("ValidateScalar"), `(`, strconv.Quote(scalar), `, `, fieldDescriptorName(field), `, `, index, `, `, buf, `); err != nil {`)
+	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err")
+	g.P(`}`)
+}
+
+// enumValidity returns the expression checking that varName holds
+// one of the values declared by the enum.
+func enumValidity(enum *protogen.Enum, varName string) string {
+	var numbers []int
+	for _, value := range enum.Values {
+		numbers = append(numbers, int(value.Desc.Number()))
+	}
+	sort.Ints(numbers)
+	var checks []string
+	for i := 0; i < len(numbers); {
+		j := i
+		for j+1 < len(numbers) && numbers[j+1] <= numbers[j]+1 {
+			j++
+		}
+		if numbers[i] == numbers[j] {
+			checks = append(checks, fmt.Sprintf("%s == %d", varName, numbers[i]))
+		} else {
+			checks = append(checks, fmt.Sprintf("(%s >= %d && %s <= %d)", varName, numbers[i], varName, numbers[j]))
+		}
+		i = j + 1
+	}
+	return strings.Join(checks, " || ")
+}
+
 func (g *fastGenerator) noStarOrSliceType(field *protogen.Field) string {
 	typ, _ := g.FieldGoType(field)
 	if typ[0] == '[' && typ[1] == ']' {
@@ -607,15 +951,22 @@ func (g *fastGenerator) decodeFixed32(varName string, typeName string) {
 	g.P(`iNdEx += 4`)
 }
 
-func (g *fastGenerator) decodeMessage(varName, buf string, message *protogen.Message) {
-
+// decodeMessage generates the decoding of buf into the message held by the field, or by
+// its element index, whose invalid scalars are reported from the decoded message. The
+// messages packed in google.protobuf.Any values are checked for rejected unknown fields.
+func (g *fastGenerator) decodeMessage(varName, buf string, field *protogen.Field, index string) {
 	g.P("if err := options.Unmarshal(", buf, ", ", varName, "); err != nil {")
-	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", `err`)
+	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", runtimePackage.Ident("InvalidScalarIn"), `(err, `, fieldDescriptorName(field), `, `, index, `)`)
 	g.P(`}`)
-
+	if generator.IsAny(field.Message) {
+		g.P("if err := ", runtimePackage.Ident("CheckUnknownAny"), "(options, ", varName, "); err != nil {")
+		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err")
+		g.P(`}`)
+	}
 }
 
-func (g *fastGenerator) unmarshalMapField(varName string, field *protogen.Field) {
+// unmarshalMapField generates the decoding of the key or the value field of an entry of the map.
+func (g *fastGenerator) unmarshalMapField(varName string, field, mapField *protogen.Field) {
 	switch field.Desc.Kind() {
 	case protoreflect.DoubleKind:
 		g.P(`var `, varName, `temp uint64`)
@@ -653,7 +1004,12 @@ func (g *fastGenerator) unmarshalMapField(varName string, field *protogen.Field)
 		g.P(`if postStringIndex`, varName, ` > l {`)
 		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", g.Ident("io", `ErrUnexpectedEOF`))
 		g.P(`}`)
-		g.P(varName, ` = `, "string", `(dAtA[iNdEx:postStringIndex`, varName, `])`)
+		g.spendBudget(`Length(`, fieldDescriptorName(mapField), `, intStringLen`, varName, `)`)
+		g.validateUTF8Bytes(field, `dAtA[iNdEx:postStringIndex`+varName+`]`)
+		if varName == "mapvalue" && validatedScalar(mapField) != "" {
+			g.P(`mapvalueRaw = dAtA[iNdEx:postStringIndex`, varName, `]`)
+		}
+		g.P(varName, ` = `, runtimePackage.Ident("String"), `(dAtA[iNdEx:postStringIndex`, varName, `], zeroCopy)`)
 		g.P(`iNdEx = postStringIndex`, varName)
 	case protoreflect.MessageKind:
 		g.P(`var mapmsglen int`)
@@ -669,8 +1025,16 @@ func (g *fastGenerator) unmarshalMapField(varName string, field *protogen.Field)
 		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", g.Ident("io", `ErrUnexpectedEOF`))
 		g.P(`}`)
 		buf := `dAtA[iNdEx:postmsgIndex]`
-		g.P(varName, ` = &`, g.noStarOrSliceType(field), `{}`)
-		g.decodeMessage(varName, buf, field.Message)
+		g.spendMessage(field, mapField)
+		g.P(varName, ` = `, newMessage(g.GeneratedFile, field.Message))
+		// the error is reported once the entry is decoded, as its key may follow the value
+		g.P("if err := options.Unmarshal(", buf, ", ", varName, "); err != nil {")
+		g.P(varName, "Err = err")
+		if generator.IsAny(field.Message) {
+			g.P("} else if err := ", runtimePackage.Ident("CheckUnknownAny"), "(options, ", varName, "); err != nil {")
+			g.P(varName, "Err = err")
+		}
+		g.P("}")
 		g.P(`iNdEx = postmsgIndex`)
 	case protoreflect.BytesKind:
 		g.P(`var mapbyteLen uint64`)
@@ -686,8 +1050,11 @@ func (g *fastGenerator) unmarshalMapField(varName string, field *protogen.Field)
 		g.P(`if postbytesIndex > l {`)
 		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", g.Ident("io", `ErrUnexpectedEOF`))
 		g.P(`}`)
-		g.P(varName, ` = make([]byte, mapbyteLen)`)
-		g.P(`copy(`, varName, `, dAtA[iNdEx:postbytesIndex])`)
+		g.spendBudget(`Length(`, fieldDescriptorName(mapField), `, intMapbyteLen)`)
+		if validatedScalar(mapField) != "" {
+			g.P(`mapvalueRaw = dAtA[iNdEx:postbytesIndex]`)
+		}
+		g.P(varName, ` = `, runtimePackage.Ident("Bytes"), `(dAtA[iNdEx:postbytesIndex], zeroCopy)`)
 		g.P(`iNdEx = postbytesIndex`)
 	case protoreflect.Uint32Kind:
 		g.decodeVarint(varName, "uint32")
@@ -710,3 +1077,52 @@ func (g *fastGenerator) unmarshalMapField(varName string, field *protogen.Field)
 		g.P(varName, ` = int64(`, varName, `temp)`)
 	}
 }
+
+// usesDecodeBudget reports whether the unmarshal of the message makes allocations
+// bounded by runtime.DecodeLimits, the budget being then passed on to the nested messages.
+func usesDecodeBudget(message *protogen.Message) bool {
+	for _, field := range message.Fields {
+		switch {
+		case field.Desc.IsList() || field.Message != nil:
+			return true
+		case field.Desc.Kind() == protoreflect.StringKind || field.Desc.Kind() == protoreflect.BytesKind:
+			return true
+		}
+	}
+	return false
+}
+
+// spendBudget generates the call of the runtime.DecodeBudget method, which fails the
+// unmarshal when the allocation it precedes exceeds the decode limits.
+func (g *fastGenerator) spendBudget(call ...interface{}) {
+	g.P(append(append([]interface{}{`if err := budget.`}, call...), `; err != nil {`)...)
+	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err")
+	g.P(`}`)
+}
+
+// spendMessage generates the charge of the budget for the allocation of a message held by
+// the field, of the size of the Go struct generated for the message.
+func (g *fastGenerator) spendMessage(field, mapField *protogen.Field) {
+	fd := field
+	if mapField != nil {
+		fd = mapField
+	}
+	g.spendBudget(`Message(`, fieldDescriptorName(fd), `, int(`, g.Ident("unsafe", "Sizeof"), `(`, field.Message.GoIdent, `{})))`)
+}
+
+// goSize returns the size in bytes of a Go value holding a value of the kind, on 64-bit
+// platforms, messages being held through pointers.
+func goSize(kind protoreflect.Kind) int {
+	switch kind {
+	case protoreflect.BoolKind:
+		return 1
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Uint32Kind, protoreflect.EnumKind,
+		protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind, protoreflect.FloatKind:
+		return 4
+	case protoreflect.StringKind:
+		return 16
+	case protoreflect.BytesKind:
+		return 24
+	}
+	return 8
+}
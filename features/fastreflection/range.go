@@ -22,6 +22,9 @@ func (g *rangeGen) generate() {
 	for _, field := range g.message.Fields {
 		g.genField(field)
 	}
+	if hasExtensions(g.message) {
+		g.P(runtimePackage.Ident("RangeExtensions"), "(&x.extensionFields, f)")
+	}
 	g.P("}")
 }
 
@@ -34,11 +37,12 @@ func (g *rangeGen) genComment() {
 }
 
 func (g *rangeGen) genField(field *protogen.Field) {
-	if field.Oneof != nil {
+	if inOneof(field) {
 		g.genOneof(field)
 		return
 	}
 
+	genLazyDecode(g.GeneratedFile, field, messageReceiver(g.GeneratedFile, g.message))
 	switch {
 	case field.Desc.IsMap():
 		g.P("if len(x.", field.GoName, ") != 0 {")
@@ -54,13 +58,34 @@ func (g *rangeGen) genField(field *protogen.Field) {
 		g.P("return")
 		g.P("}")
 		g.P("}")
-	case field.Desc.Kind() == protoreflect.MessageKind:
+	case field.Desc.Kind() == protoreflect.MessageKind, field.Desc.Kind() == protoreflect.GroupKind:
 		g.P("if x.", field.GoName, " != nil {")
 		g.P("value := ", protoreflectPkg.Ident("ValueOfMessage"), "(x.", field.GoName, ".ProtoReflect())")
 		g.P("if !f(", fieldDescriptorName(field), ", value) {")
 		g.P("return")
 		g.P("}")
 		g.P("}")
+	case g.IsScalar(field):
+		g.P("if ", runtimePackage.Ident("HasScalar"), "(&x.", field.GoName, ") {")
+		g.P("value := ", runtimePackage.Ident("ScalarValue"), "(", fieldDescriptorName(field), ", &x.", field.GoName, ")")
+		g.P("if !f(", fieldDescriptorName(field), ", value) {")
+		g.P("return")
+		g.P("}")
+		g.P("}")
+	case hasExplicitPresence(field):
+		g.P("if x.", field.GoName, " != nil {")
+		switch field.Desc.Kind() {
+		case protoreflect.BytesKind:
+			g.P("value := ", kindToValueConstructor(field.Desc.Kind()), "(x.", field.GoName, ")")
+		case protoreflect.EnumKind:
+			g.P("value := ", kindToValueConstructor(field.Desc.Kind()), "((", protoreflectPkg.Ident("EnumNumber"), ")(*x.", field.GoName, "))")
+		default:
+			g.P("value := ", kindToValueConstructor(field.Desc.Kind()), "(*x.", field.GoName, ")")
+		}
+		g.P("if !f(", fieldDescriptorName(field), ", value) {")
+		g.P("return")
+		g.P("}")
+		g.P("}")
 	case field.Desc.Kind() == protoreflect.BytesKind:
 		g.P("if len(x.", field.GoName, ") != 0 {")
 		g.P("value := ", protoreflectPkg.Ident("ValueOfBytes"), "(x.", field.GoName, ")")
@@ -109,7 +134,7 @@ func (g *rangeGen) genOneof(field *protogen.Field) {
 		g.P("case *", g.QualifiedGoIdent(oneofField.GoIdent), ":")
 		g.P("v := ", "o.", oneofField.GoName)
 		switch oneofField.Desc.Kind() {
-		case protoreflect.MessageKind:
+		case protoreflect.MessageKind, protoreflect.GroupKind:
 			g.P("value := ", kindToValueConstructor(oneofField.Desc.Kind()), "(v.ProtoReflect())")
 		case protoreflect.EnumKind:
 			g.P("value :=", kindToValueConstructor(oneofField.Desc.Kind()), "((", protoreflectPkg.Ident("EnumNumber"), ")(v))")
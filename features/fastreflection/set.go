@@ -15,14 +15,14 @@ type setGen struct {
 func (g *setGen) generate() {
 	g.genComment()
 	g.P("func (x *", g.typeName, ") Set(fd ", protoreflectPkg.Ident("FieldDescriptor"), ", value ", protoreflectPkg.Ident("Value"), ") {")
-	g.P("switch fd.FullName() {")
+	genFieldSwitch(g.GeneratedFile, "fd")
 	for _, field := range g.message.Fields {
-		g.P("case \"", field.Desc.FullName(), "\":")
+		genFieldCase(g.GeneratedFile, field, "fd")
+		genLazyDiscard(g.GeneratedFile, field, "x")
 		g.genField(field)
+		g.P("return")
 	}
-	g.P("default:")
-	g.genDefaultCase()
-	g.P("}")
+	genFieldSwitchEnd(g.GeneratedFile, g.message, "fd", "Set", false, "value")
 	g.P("}")
 	g.P()
 }
@@ -41,7 +41,7 @@ func (g *setGen) genComment() {
 }
 
 func (g *setGen) genField(field *protogen.Field) {
-	if field.Oneof != nil {
+	if inOneof(field) {
 		g.genOneof(field)
 		return
 	}
@@ -56,6 +56,14 @@ func (g *setGen) genField(field *protogen.Field) {
 	}
 
 	fieldRef := "x." + field.GoName
+	if g.IsScalar(field) {
+		g.P(runtimePackage.Ident("SetScalar"), "(fd, &", fieldRef, ", value)")
+		return
+	}
+	if hasExplicitPresence(field) {
+		g.genExplicitPresence(field)
+		return
+	}
 
 	switch field.Desc.Kind() {
 	case protoreflect.BoolKind:
@@ -84,12 +92,18 @@ func (g *setGen) genField(field *protogen.Field) {
 
 }
 
-// genDefaultCase generates the default case for field descriptor
-func (g *setGen) genDefaultCase() {
-	g.P("if fd.IsExtension() {")
-	g.P("panic(", fmtPkg.Ident("Errorf"), "(\"proto3 declared messages do not support extensions: ", g.message.Desc.FullName(), "\"))")
-	g.P("}")
-	g.P("panic(fmt.Errorf(\"message ", g.message.Desc.FullName(), " does not contain field %s\", fd.FullName()))")
+// genExplicitPresence generates the set of a scalar tracking its presence,
+// which stores the value behind a pointer, or as a non-nil slice for bytes.
+func (g *setGen) genExplicitPresence(field *protogen.Field) {
+	if field.Desc.Kind() == protoreflect.BytesKind {
+		g.P("x.", field.GoName, " = value.Bytes()")
+		g.P("if x.", field.GoName, " == nil {")
+		g.P("x.", field.GoName, " = []byte{}")
+		g.P("}")
+		return
+	}
+	g.genOneofValueUnwrapper(field)
+	g.P("x.", field.GoName, " = &cv")
 }
 
 func (g *setGen) genOneof(field *protogen.Field) {
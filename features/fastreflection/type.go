@@ -3,6 +3,8 @@ package fastreflection
 import (
 	"fmt"
 	"github.com/cosmos/cosmos-proto/generator"
+	"strconv"
+	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
 )
@@ -48,3 +50,65 @@ func (g *messageTypeGen) generate() {
 func messageDescriptorName(message *protogen.Message) string {
 	return fmt.Sprintf("md_%s", message.GoIdent.GoName)
 }
+
+// genFieldSwitch opens a switch over the number of the field described by varName,
+// whose cases are opened by genFieldCase and which is closed by genFieldSwitchEnd.
+func genFieldSwitch(g *generator.GeneratedFile, varName string) {
+	g.P("switch ", varName, ".Number() {")
+}
+
+// inlineNameLen is the length up to which the compiler compares a string to a constant
+// inline on 64-bit platforms, instead of calling into the runtime.
+const inlineNameLen = 16
+
+// genFieldCase opens the case of the field, which is only taken by the descriptor of the
+// field. Comparing interfaces calls into the runtime, so the identity of the descriptor with
+// the one cached in its fd_ variable is only checked when the full name of the field is too
+// long to be compared inline, as is the case for the fields of messages declared in a package.
+func genFieldCase(g *generator.GeneratedFile, field *protogen.Field, varName string) {
+	g.P("case ", field.Desc.Number(), ": // ", field.Desc.FullName())
+	if name := string(field.Desc.FullName()); len(name) <= inlineNameLen {
+		g.P("if ", varName, ".FullName() != ", strconv.Quote(name), " {")
+	} else {
+		g.P("if ", varName, " != ", fieldDescriptorName(field), " {")
+	}
+	g.P("break")
+	g.P("}")
+}
+
+// genFieldSwitchEnd closes the switch opened by genFieldSwitch, whose cases must all return.
+// Descriptors of the message fields obtained from another source are resolved to the cached
+// ones and handed again to the method, extensions are handed to the runtime implementation
+// of the method and the others do not belong to the message and panic.
+func genFieldSwitchEnd(g *generator.GeneratedFile, message *protogen.Message, varName, method string, returns bool, args ...string) {
+	call := func(fn interface{}, args ...string) {
+		ret := ""
+		if returns {
+			ret = "return "
+		}
+		g.P(ret, fn, "(", strings.Join(args, ", "), ")")
+		if !returns {
+			g.P("return")
+		}
+	}
+	g.P("}")
+	if hasExtensions(message) {
+		g.P("if ", varName, ".IsExtension() {")
+		xd := fmt.Sprintf("%s(%s, %s)", g.QualifiedGoIdent(runtimePackage.Ident("ExtensionDescriptor")), varName, messageDescriptorName(message))
+		call(runtimePackage.Ident(method+"Extension"), append([]string{"&x.extensionFields", xd}, args...)...)
+		g.P("}")
+	}
+	g.P("if fd := ", runtimePackage.Ident("FieldOf"), "(", varName, ", ", messageDescriptorName(message), "); fd != nil {")
+	call("x."+method, append([]string{"fd"}, args...)...)
+	g.P("}")
+	g.P("if ", varName, ".IsExtension() {")
+	g.P("panic(", fmtPkg.Ident("Errorf"), "(\"message ", message.Desc.FullName(), " does not extend %s\", ", varName, ".FullName()))")
+	g.P("}")
+	g.P("panic(", fmtPkg.Ident("Errorf"), "(\"message ", message.Desc.FullName(), " does not contain field %s\", ", varName, ".FullName()))")
+}
+
+// hasExtensions reports whether the message declares extension ranges,
+// whose fields are kept in the extensionFields field of the message.
+func hasExtensions(message *protogen.Message) bool {
+	return message.Desc.ExtensionRanges().Len() > 0
+}
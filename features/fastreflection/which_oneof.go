@@ -40,6 +40,15 @@ func (g *whichOneofGen) genFunc() {
 }
 
 func (g *whichOneofGen) genOneof(oneof *protogen.Oneof) {
+	if oneof.Desc.IsSynthetic() {
+		// the only member of a synthetic oneof is a nullable field
+		field := oneof.Fields[0]
+		g.P("if x.", field.GoName, " == nil {")
+		g.P("return nil")
+		g.P("}")
+		g.P("return x.Descriptor().Fields().ByName(\"", field.Desc.Name(), "\")")
+		return
+	}
 	// if none is populated then return nil
 	g.P("if x.", oneof.GoName, " == nil {")
 	g.P("return nil")
@@ -0,0 +1,301 @@
+package interfaces
+
+import (
+	"fmt"
+	"go/token"
+	"strconv"
+	"strings"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"github.com/cosmos/cosmos-proto/features/fastreflection/copied"
+	"github.com/cosmos/cosmos-proto/generator"
+	"github.com/cosmos/cosmos-proto/runtime"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	protoPkg = protogen.GoImportPath("google.golang.org/protobuf/proto")
+
+	runtimePackage           = protogen.GoImportPath("github.com/cosmos/cosmos-proto/runtime")
+	interfaceregistryPackage = protogen.GoImportPath("github.com/cosmos/cosmos-proto/runtime/interfaceregistry")
+)
+
+func init() {
+	generator.RegisterFeature("interfaces", func(gen *generator.GeneratedFile, plugin *protogen.Plugin) generator.FeatureGenerator {
+		return interfacesFeature{GeneratedFile: gen, declared: declaredInterfaces(plugin)}
+	})
+}
+
+// interfacesFeature generates the Go types of the interfaces declared with the
+// cosmos_proto.declare_interface file option and, for the google.protobuf.Any fields accepting
+// one of them through the cosmos_proto.accepts_interface option, a getter returning the packed
+// message as the Go type of the interface and a setter packing it. The message unpacked by the
+// getter is cached in a runtime.AnyCache struct field, generated by the protoc feature, which
+// the feature therefore requires. The messages annotated with cosmos_proto.implements_interface
+// are registered in interfaceregistry.Global when the package is initialized.
+type interfacesFeature struct {
+	*generator.GeneratedFile
+	declared map[string]declaredInterface
+}
+
+// declaredInterface is an interface declared with the cosmos_proto.declare_interface option.
+type declaredInterface struct {
+	*cosmos_proto.InterfaceDescriptor
+	// file is the file declaring the interface, in whose Go package its type is generated.
+	file *protogen.File
+}
+
+// declaredInterfaces returns the interfaces declared by the files known to the plugin, by their
+// fully qualified name.
+func declaredInterfaces(plugin *protogen.Plugin) map[string]declaredInterface {
+	declared := make(map[string]declaredInterface)
+	for _, file := range plugin.Files {
+		for _, iface := range fileInterfaces(file) {
+			iface := declaredInterface{InterfaceDescriptor: iface, file: file}
+			declared[iface.fullName()] = iface
+		}
+	}
+	return declared
+}
+
+// fileInterfaces returns the interfaces declared by the file.
+func fileInterfaces(file *protogen.File) []*cosmos_proto.InterfaceDescriptor {
+	return proto.GetExtension(file.Desc.Options(), cosmos_proto.E_DeclareInterface).([]*cosmos_proto.InterfaceDescriptor)
+}
+
+// fullName returns the fully qualified name of the interface.
+func (iface declaredInterface) fullName() string {
+	return string(iface.file.Desc.Package().Append(protoreflect.Name(iface.Name)))
+}
+
+// marker returns the name of the marker method of the Go type of the interface, which includes
+// the package of the interface so that a message can implement interfaces of the same name,
+// e.g. Implements_cosmos_crypto_PubKey for cosmos.crypto.PubKey.
+func (iface declaredInterface) marker() string {
+	return "Implements_" + strings.ReplaceAll(iface.fullName(), ".", "_")
+}
+
+// goIdent returns the Go type of the interface, which is its name suffixed with I so that it
+// does not collide with the messages of its package, e.g. PubKeyI for cosmos.crypto.PubKey.
+func (iface declaredInterface) goIdent() protogen.GoIdent {
+	return iface.file.GoImportPath.Ident(iface.Name + "I")
+}
+
+func (g interfacesFeature) GenerateFile(file *protogen.File, plugin *protogen.Plugin) bool {
+	for _, iface := range fileInterfaces(file) {
+		if name := iface.Name + "I"; !token.IsIdentifier(name) || !token.IsExported(name) {
+			plugin.Error(fmt.Errorf("%s: the interface %q is not named after an exported Go identifier", file.Desc.Path(), iface.Name))
+			continue
+		}
+		g.genInterface(declaredInterface{InterfaceDescriptor: iface, file: file})
+	}
+	for _, message := range file.Messages {
+		g.genMessage(message, plugin)
+	}
+	g.genRegistrations(file)
+	return true
+}
+
+func (g interfacesFeature) GenerateHelpers() {}
+
+// genInterface generates the Go type of the interface, whose doc comment starts with its Go name
+// and is followed by its description. Its marker method, generated for the messages implementing
+// the interface, makes the compiler check that the values given to the setters implement it
+// according to the annotations.
+func (g interfacesFeature) genInterface(iface declaredInterface) {
+	goName := iface.goIdent().GoName
+	g.P("// ", goName, " is implemented by the messages declaring the ", iface.fullName(), " interface")
+	g.P("// with the cosmos_proto.implements_interface option.")
+	if iface.Description != "" {
+		g.P("//")
+		for _, line := range strings.Split(strings.TrimSpace(iface.Description), "\n") {
+			g.P(strings.TrimRight("// "+line, " "))
+		}
+	}
+	g.P("type ", goName, " interface {")
+	g.P(protoPkg.Ident("Message"))
+	g.P(iface.marker(), "()")
+	g.P("}")
+	g.P()
+}
+
+func (g interfacesFeature) genMessage(message *protogen.Message, plugin *protogen.Plugin) {
+	if message.Desc.IsMapEntry() {
+		return
+	}
+	for _, field := range message.Fields {
+		if name := runtime.AcceptsInterface(field.Desc); name != "" {
+			g.checkField(field, name, plugin)
+		}
+	}
+	for _, name := range runtime.ImplementsInterfaces(message.Desc) {
+		// interfaces declared out of reach of the plugin have no Go type to implement
+		if iface, ok := g.declared[name]; ok {
+			g.P("// ", iface.marker(), " marks ", message.GoIdent, " as an implementation of the ", name, " interface.")
+			g.P("func (*", message.GoIdent, ") ", iface.marker(), "() {}")
+			g.P()
+		}
+	}
+	for _, field := range g.InterfaceFields(message) {
+		iface, ok := g.declared[runtime.AcceptsInterface(field.Desc)]
+		if !ok {
+			continue
+		}
+		if field.Desc.IsList() {
+			g.genListAccessors(field, iface)
+		} else {
+			g.genAccessors(field, iface)
+		}
+	}
+	for _, nested := range message.Messages {
+		g.genMessage(nested, plugin)
+	}
+}
+
+// genRegistrations registers the messages of the file implementing interfaces in
+// interfaceregistry.Global, once the descriptors of the file are initialized.
+func (g interfacesFeature) genRegistrations(file *protogen.File) {
+	var implementations []*protogen.Message
+	var walk func(messages []*protogen.Message)
+	walk = func(messages []*protogen.Message) {
+		for _, message := range messages {
+			if len(runtime.ImplementsInterfaces(message.Desc)) > 0 {
+				implementations = append(implementations, message)
+			}
+			walk(message.Messages)
+		}
+	}
+	walk(file.Messages)
+	if len(implementations) == 0 {
+		return
+	}
+
+	g.P("func init() {")
+	g.P(copied.InitFunctionName(file), "()")
+	for _, message := range implementations {
+		var ifaces []string
+		for _, iface := range runtime.ImplementsInterfaces(message.Desc) {
+			ifaces = append(ifaces, strconv.Quote(iface))
+		}
+		g.P("if err := ", interfaceregistryPackage.Ident("Global"), ".Register((*", message.GoIdent, ")(nil).ProtoReflect().Type(), ", strings.Join(ifaces, ", "), "); err != nil {")
+		g.P("panic(err)")
+		g.P("}")
+	}
+	g.P("}")
+	g.P()
+}
+
+// checkField reports the fields accepting an interface which are not google.protobuf.Any fields
+// or whose interface is not declared by the files known to the plugin.
+func (g interfacesFeature) checkField(field *protogen.Field, name string, plugin *protogen.Plugin) {
+	switch {
+	case !generator.IsInterfaceField(field):
+		plugin.Error(fmt.Errorf("%s: cosmos_proto.accepts_interface is only supported on singular and repeated google.protobuf.Any fields", field.Desc.FullName()))
+	case g.declared[name].InterfaceDescriptor == nil:
+		plugin.Error(fmt.Errorf("%s: the interface %s is not declared with cosmos_proto.declare_interface", field.Desc.FullName(), name))
+	}
+}
+
+func (g interfacesFeature) genAccessors(field *protogen.Field, iface declaredInterface) {
+	message := field.Parent
+	goType := g.QualifiedGoIdent(iface.goIdent())
+	cache := "x." + generator.AnyCacheGoName(field)
+	name := strconv.Quote(runtime.AcceptsInterface(field.Desc))
+
+	g.P("// Get", field.GoName, "Cached returns the message packed in ", field.Desc.Name(), ", or nil if the field is not set.")
+	g.P("// The message is unpacked on the first call and cached until the field changes.")
+	g.P("func (x *", message.GoIdent, ") Get", field.GoName, "Cached() (", goType, ", error) {")
+	g.P("if x == nil {")
+	g.P("return nil, nil")
+	g.P("}")
+	g.P("m, err := ", cache, ".Unpack(x.Get", field.GoName, "(), ", name, ")")
+	g.P("if m == nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("v, ok := m.(", goType, ")")
+	g.P("if !ok {")
+	g.P("return nil, ", runtimePackage.Ident("ErrInterface"), "(m.ProtoReflect().Descriptor().FullName(), ", name, ")")
+	g.P("}")
+	g.P("return v, nil")
+	g.P("}")
+	g.P()
+
+	g.P("// Set", field.GoName, " packs v in ", field.Desc.Name(), ", which is cleared if v is nil.")
+	g.P("func (x *", message.GoIdent, ") Set", field.GoName, "(v ", goType, ") error {")
+	g.P("a, err := ", cache, ".Pack(v, ", name, ")")
+	g.P("if err != nil {")
+	g.P("return err")
+	g.P("}")
+	if oneof := field.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
+		g.P("if a == nil {")
+		g.P("if _, ok := x.", oneof.GoName, ".(*", field.GoIdent, "); ok {")
+		g.P("x.", oneof.GoName, " = nil")
+		g.P("}")
+		g.P("return nil")
+		g.P("}")
+		g.P("x.", oneof.GoName, " = &", field.GoIdent, "{", field.GoName, ": a}")
+	} else {
+		g.genLazyDiscard(field)
+		g.P("x.", field.GoName, " = a")
+	}
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
+
+func (g interfacesFeature) genListAccessors(field *protogen.Field, iface declaredInterface) {
+	message := field.Parent
+	goType := g.QualifiedGoIdent(iface.goIdent())
+	cache := "x." + generator.AnyCacheGoName(field)
+	name := strconv.Quote(runtime.AcceptsInterface(field.Desc))
+
+	g.P("// Get", field.GoName, "Cached returns the messages packed in ", field.Desc.Name(), ", nil elements included.")
+	g.P("// The messages are unpacked on the first call and cached until the field changes.")
+	g.P("func (x *", message.GoIdent, ") Get", field.GoName, "Cached() ([]", goType, ", error) {")
+	g.P("if x == nil {")
+	g.P("return nil, nil")
+	g.P("}")
+	g.P("ms, err := ", cache, ".UnpackList(x.Get", field.GoName, "(), ", name, ")")
+	g.P("if err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("vs := make([]", goType, ", len(ms))")
+	g.P("for i, m := range ms {")
+	g.P("if m == nil {")
+	g.P("continue")
+	g.P("}")
+	g.P("v, ok := m.(", goType, ")")
+	g.P("if !ok {")
+	g.P("return nil, ", runtimePackage.Ident("ErrInterface"), "(m.ProtoReflect().Descriptor().FullName(), ", name, ")")
+	g.P("}")
+	g.P("vs[i] = v")
+	g.P("}")
+	g.P("return vs, nil")
+	g.P("}")
+	g.P()
+
+	g.P("// Set", field.GoName, " packs each of vs in ", field.Desc.Name(), ".")
+	g.P("func (x *", message.GoIdent, ") Set", field.GoName, "(vs []", goType, ") error {")
+	g.P("ms := make([]", protoPkg.Ident("Message"), ", len(vs))")
+	g.P("for i, v := range vs {")
+	g.P("ms[i] = v")
+	g.P("}")
+	g.P("list, err := ", cache, ".PackList(ms, ", name, ")")
+	g.P("if err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.genLazyDiscard(field)
+	g.P("x.", field.GoName, " = list")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
+
+// genLazyDiscard discards the encoding of the field when it is lazy, the field being replaced.
+func (g interfacesFeature) genLazyDiscard(field *protogen.Field) {
+	if g.IsLazy(field) {
+		g.P("x.lazyFields.Discard(", g.LazyIndex(field), ")")
+	}
+}
@@ -0,0 +1,195 @@
+package json
+
+import (
+	"github.com/cosmos/cosmos-proto/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genAppendJSON generates AppendJSON, which appends the fields of the message in the order of
+// their declaration, each followed by a comma, and then its extensions, like protojson does.
+// Fields are populated under the conditions of Has.
+func (g *jsonGenerator) genAppendJSON() {
+	g.P("// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.")
+	g.P("func (x *", g.message.GoIdent, ") AppendJSON(b []byte) ([]byte, error) {")
+	g.P("if x == nil {")
+	g.P(`return append(b, "{}"...), nil`)
+	g.P("}")
+	extensions := g.message.Desc.ExtensionRanges().Len() > 0
+	needsErr := extensions
+	for _, field := range g.message.Fields {
+		needsErr = needsErr || usesErr(field)
+	}
+	if needsErr {
+		g.P("var err error")
+	}
+	g.P("b = append(b, '{')")
+	for _, field := range g.message.Fields {
+		g.genField(field)
+	}
+	if extensions {
+		g.P("if b, err = ", runtimePackage.Ident("AppendJSONExtensions"), "(b, x.ProtoReflect()); err != nil {")
+		g.P("return b, err")
+		g.P("}")
+	}
+	g.P("if b[len(b)-1] == ',' {")
+	g.P("b[len(b)-1] = '}'")
+	g.P("} else {")
+	g.P("b = append(b, '}')")
+	g.P("}")
+	g.P("return b, nil")
+	g.P("}")
+	g.P()
+}
+
+func (g *jsonGenerator) genField(field *protogen.Field) {
+	if g.IsLazy(field) {
+		g.P("x.lazyDecode", field.GoName, "()")
+	}
+	fd := field.Desc
+	switch {
+	case inOneof(field):
+		g.P("if v, ok := x.", field.Oneof.GoName, ".(*", field.GoIdent, "); ok {")
+		g.genSingular(field, "v."+field.GoName)
+	case fd.IsMap():
+		g.P("if len(x.", field.GoName, ") > 0 {")
+		g.genMap(field)
+	case fd.IsList():
+		g.P("if len(x.", field.GoName, ") > 0 {")
+		g.genList(field)
+	case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+		g.P("if x.", field.GoName, " != nil {")
+		g.genSingular(field, "x."+field.GoName)
+	case fd.HasPresence():
+		g.P("if x.", field.GoName, " != nil {")
+		if fd.Kind() == protoreflect.BytesKind {
+			g.genSingular(field, "x."+field.GoName)
+		} else {
+			g.P("v := *x.", field.GoName)
+			g.genSingular(field, "v")
+		}
+	case g.IsScalar(field):
+		g.P("if v, err := ", runtimePackage.Ident("MarshalScalar"), "(", generator.FieldDescriptorName(field), ", &x.", field.GoName, "); err != nil {")
+		g.P("return b, err")
+		g.P("} else if len(v) > 0 {")
+		if fd.Kind() == protoreflect.StringKind {
+			g.genSingular(field, "string(v)")
+		} else {
+			g.genSingular(field, "v")
+		}
+	default:
+		g.P("if ", g.implicitPresence(field), " {")
+		g.genSingular(field, "x."+field.GoName)
+	}
+	g.P("}")
+}
+
+// implicitPresence returns the condition under which the field without explicit presence
+// is populated, which is holding a value other than the zero value.
+func (g *jsonGenerator) implicitPresence(field *protogen.Field) string {
+	v := "x." + field.GoName
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return v
+	case protoreflect.StringKind:
+		return v + ` != ""`
+	case protoreflect.BytesKind:
+		return "len(" + v + ") > 0"
+	case protoreflect.FloatKind:
+		return v + " != 0 || " + g.QualifiedGoIdent(mathPkg.Ident("Signbit")) + "(float64(" + v + "))"
+	case protoreflect.DoubleKind:
+		return v + " != 0 || " + g.QualifiedGoIdent(mathPkg.Ident("Signbit")) + "(" + v + ")"
+	default:
+		return v + " != 0"
+	}
+}
+
+func (g *jsonGenerator) genSingular(field *protogen.Field, v string) {
+	g.P("b = append(b, ", jsonName(field), "...)")
+	g.genValue(field, v)
+	g.P("b = append(b, ',')")
+}
+
+func (g *jsonGenerator) genList(field *protogen.Field) {
+	g.P("b = append(b, ", jsonName(field), "...)")
+	g.P("b = append(b, '[')")
+	g.P("for _, v := range x.", field.GoName, " {")
+	g.genValue(field, "v")
+	g.P("b = append(b, ',')")
+	g.P("}")
+	g.P("b[len(b)-1] = ']'")
+	g.P("b = append(b, ',')")
+}
+
+// genMap generates the encoding of the map field, whose keys are sorted like protojson does.
+func (g *jsonGenerator) genMap(field *protogen.Field) {
+	key, value := field.Message.Fields[0], field.Message.Fields[1]
+	g.P("b = append(b, ", jsonName(field), "...)")
+	g.P("b = append(b, '{')")
+	if key.Desc.Kind() == protoreflect.BoolKind {
+		g.P("for _, k := range [...]bool{false, true} {")
+		g.P("v, ok := x.", field.GoName, "[k]")
+		g.P("if !ok {")
+		g.P("continue")
+		g.P("}")
+	} else {
+		g.P("for _, k := range ", slicesPkg.Ident("Sorted"), "(", mapsPkg.Ident("Keys"), "(x.", field.GoName, ")) {")
+		g.P("v := x.", field.GoName, "[k]")
+	}
+	switch key.Desc.Kind() {
+	case protoreflect.StringKind:
+		g.P("if b, err = ", runtimePackage.Ident("AppendJSONString"), "(b, k, ", fullName(key), "); err != nil {")
+		g.P("return b, err")
+		g.P("}")
+	case protoreflect.BoolKind:
+		g.P("b = append(b, '\"')")
+		g.P("b = ", strconvPkg.Ident("AppendBool"), "(b, k)")
+		g.P("b = append(b, '\"')")
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		g.P("b = ", runtimePackage.Ident("AppendJSONUint64"), "(b, uint64(k))")
+	default:
+		g.P("b = ", runtimePackage.Ident("AppendJSONInt64"), "(b, int64(k))")
+	}
+	g.P("b = append(b, ':')")
+	g.genValue(value, "v")
+	g.P("b = append(b, ',')")
+	g.P("}")
+	g.P("b[len(b)-1] = '}'")
+	g.P("b = append(b, ',')")
+}
+
+// genValue generates the encoding of v, a singular value of the field or an element of its list.
+func (g *jsonGenerator) genValue(field *protogen.Field, v string) {
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		g.P("b = ", strconvPkg.Ident("AppendBool"), "(b, ", v, ")")
+	case protoreflect.StringKind:
+		g.P("if b, err = ", runtimePackage.Ident("AppendJSONString"), "(b, ", v, ", ", fullName(field), "); err != nil {")
+		g.P("return b, err")
+		g.P("}")
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		g.P("b = ", strconvPkg.Ident("AppendInt"), "(b, int64(", v, "), 10)")
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		g.P("b = ", strconvPkg.Ident("AppendUint"), "(b, uint64(", v, "), 10)")
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		g.P("b = ", runtimePackage.Ident("AppendJSONInt64"), "(b, ", v, ")")
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		g.P("b = ", runtimePackage.Ident("AppendJSONUint64"), "(b, ", v, ")")
+	case protoreflect.FloatKind:
+		g.P("b = ", runtimePackage.Ident("AppendJSONFloat"), "(b, float64(", v, "), 32)")
+	case protoreflect.DoubleKind:
+		g.P("b = ", runtimePackage.Ident("AppendJSONFloat"), "(b, ", v, ", 64)")
+	case protoreflect.BytesKind:
+		g.P("b = ", runtimePackage.Ident("AppendJSONBytes"), "(b, ", v, ")")
+	case protoreflect.EnumKind:
+		g.P("b = ", runtimePackage.Ident("AppendJSONEnum"), "(b, ", protoreflectPkg.Ident("EnumNumber"), "(", v, "), ", v, ".Descriptor())")
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if g.hasJSONMethods(field.Message) {
+			g.P("if b, err = ", v, ".AppendJSON(b); err != nil {")
+		} else {
+			g.P("if b, err = ", runtimePackage.Ident("AppendJSONMessage"), "(b, ", v, "); err != nil {")
+		}
+		g.P("return b, err")
+		g.P("}")
+	}
+}
@@ -0,0 +1,143 @@
+package json
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genDecodeJSON generates DecodeJSON, which decodes the value of each field of the object
+// resolved by the runtime, according to the field number.
+func (g *jsonGenerator) genDecodeJSON() {
+	g.P("// DecodeJSON decodes the next value of d into x. It is called by the runtime, which")
+	g.P("// handles the well-known types and the nesting limit of the decoded messages.")
+	g.P("func (x *", g.message.GoIdent, ") DecodeJSON(d *", runtimePackage.Ident("JSONDecoder"), ") error {")
+	g.P("return d.Object(x.ProtoReflect(), func(fd ", protoreflectPkg.Ident("FieldDescriptor"), ") error {")
+	if len(g.message.Fields) > 0 {
+		g.P("switch fd.Number() {")
+		for _, field := range g.message.Fields {
+			g.P("case ", field.Desc.Number(), ":")
+			g.genDecodeField(field)
+		}
+		g.P("}")
+	}
+	g.P("return nil")
+	g.P("})")
+	g.P("}")
+	g.P()
+}
+
+func (g *jsonGenerator) genDecodeField(field *protogen.Field) {
+	switch {
+	case field.Desc.IsMap():
+		key, value := field.Message.Fields[0], field.Message.Fields[1]
+		goType, _ := g.FieldGoType(field)
+		g.P("if x.", field.GoName, " == nil {")
+		g.P("x.", field.GoName, " = make(", goType, ")")
+		g.P("}")
+		g.P("return d.Map(fd, func(k ", protoreflectPkg.Ident("MapKey"), ") error {")
+		g.P("key := ", mapKey(key))
+		g.P("if _, ok := x.", field.GoName, "[key]; ok {")
+		g.P("return d.DuplicateMapKey()")
+		g.P("}")
+		g.genDecodeValue(value, "fd.MapValue()", func(v string) {
+			g.P("x.", field.GoName, "[key] = ", v)
+		})
+		g.P("return nil")
+		g.P("})")
+	case field.Desc.IsList():
+		g.P("return d.List(func() error {")
+		g.genDecodeValue(field, "fd", func(v string) {
+			g.P("x.", field.GoName, " = append(x.", field.GoName, ", ", v, ")")
+		})
+		g.P("return nil")
+		g.P("})")
+	case g.IsScalar(field):
+		g.P("v, err := d.", decodeMethods[field.Desc.Kind()], "(fd)")
+		g.P("if err != nil {")
+		g.P("return err")
+		g.P("}")
+		if field.Desc.Kind() == protoreflect.StringKind {
+			g.P("return ", runtimePackage.Ident("UnmarshalScalar"), "(fd, &x.", field.GoName, ", []byte(v))")
+		} else {
+			g.P("return ", runtimePackage.Ident("UnmarshalScalar"), "(fd, &x.", field.GoName, ", v)")
+		}
+	case inOneof(field):
+		g.genDecodeValue(field, "fd", func(v string) {
+			g.P("x.", field.Oneof.GoName, " = &", field.GoIdent, "{", field.GoName, ": ", v, "}")
+		})
+	default:
+		g.genDecodeValue(field, "fd", func(v string) {
+			_, pointer := g.FieldGoType(field)
+			if pointer {
+				v = "&" + v
+			}
+			g.P("x.", field.GoName, " = ", v)
+		})
+	}
+}
+
+// mapKey returns the conversion of the protoreflect.MapKey k to the Go type of the key field.
+func mapKey(key *protogen.Field) string {
+	switch key.Desc.Kind() {
+	case protoreflect.StringKind:
+		return "k.String()"
+	case protoreflect.BoolKind:
+		return "k.Bool()"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32(k.Int())"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "k.Int()"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32(k.Uint())"
+	default:
+		return "k.Uint()"
+	}
+}
+
+// decodeMethods are the JSONDecoder methods decoding the scalar kinds.
+var decodeMethods = map[protoreflect.Kind]string{
+	protoreflect.BoolKind:     "Bool",
+	protoreflect.Int32Kind:    "Int32",
+	protoreflect.Sint32Kind:   "Int32",
+	protoreflect.Sfixed32Kind: "Int32",
+	protoreflect.Int64Kind:    "Int64",
+	protoreflect.Sint64Kind:   "Int64",
+	protoreflect.Sfixed64Kind: "Int64",
+	protoreflect.Uint32Kind:   "Uint32",
+	protoreflect.Fixed32Kind:  "Uint32",
+	protoreflect.Uint64Kind:   "Uint64",
+	protoreflect.Fixed64Kind:  "Uint64",
+	protoreflect.FloatKind:    "Float32",
+	protoreflect.DoubleKind:   "Float64",
+	protoreflect.StringKind:   "String",
+	protoreflect.BytesKind:    "Bytes",
+}
+
+// genDecodeValue generates the decoding of the next value as a value of field, whose descriptor
+// is fd, and calls set with the decoded value. Unknown enum names which are discarded are not set.
+func (g *jsonGenerator) genDecodeValue(field *protogen.Field, fd string, set func(v string)) {
+	switch field.Desc.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		// nested messages go through the runtime, which accounts for their nesting
+		g.P("v := new(", field.Message.GoIdent, ")")
+		g.P("if err := d.Message(v); err != nil {")
+		g.P("return err")
+		g.P("}")
+		set("v")
+	case protoreflect.EnumKind:
+		g.P("v, ok, err := d.Enum(", fd, ")")
+		g.P("if err != nil {")
+		g.P("return err")
+		g.P("}")
+		g.P("if ok {")
+		g.P("e := ", field.Enum.GoIdent, "(v)")
+		set("e")
+		g.P("}")
+	default:
+		g.P("v, err := d.", decodeMethods[field.Desc.Kind()], "(", fd, ")")
+		g.P("if err != nil {")
+		g.P("return err")
+		g.P("}")
+		set("v")
+	}
+}
@@ -0,0 +1,146 @@
+package json
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-proto/generator"
+	"github.com/cosmos/cosmos-proto/runtime"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	protoreflectPkg = protogen.GoImportPath("google.golang.org/protobuf/reflect/protoreflect")
+	protojsonPkg    = protogen.GoImportPath("google.golang.org/protobuf/encoding/protojson")
+
+	strconvPkg = protogen.GoImportPath("strconv")
+	slicesPkg  = protogen.GoImportPath("slices")
+	mapsPkg    = protogen.GoImportPath("maps")
+	mathPkg    = protogen.GoImportPath("math")
+
+	runtimePackage = protogen.GoImportPath("github.com/cosmos/cosmos-proto/runtime")
+)
+
+func init() {
+	generator.RegisterFeature("json", func(gen *generator.GeneratedFile, _ *protogen.Plugin) generator.FeatureGenerator {
+		return jsonFeature{GeneratedFile: gen}
+	})
+}
+
+// jsonFeature generates the MarshalJSON and UnmarshalJSON methods of the messages, which
+// implement the JSON format of protojson. The fields of the message are encoded by the
+// generated AppendJSON method and decoded by the generated DecodeJSON method, while the
+// runtime implements the rest of the format, including the well-known types. Like lazy
+// decoding, the generated code relies on the message structs of the protoc feature.
+type jsonFeature struct {
+	*generator.GeneratedFile
+}
+
+func (g jsonFeature) GenerateFile(file *protogen.File, _ *protogen.Plugin) bool {
+	for _, message := range file.Messages {
+		g.genMessage(message)
+	}
+	return true
+}
+
+func (g jsonFeature) GenerateHelpers() {}
+
+func (g jsonFeature) genMessage(message *protogen.Message) {
+	if generatesJSON(message) {
+		gen := &jsonGenerator{GeneratedFile: g.GeneratedFile, message: message}
+		gen.genMarshalJSON()
+		gen.genAppendJSON()
+		gen.genUnmarshalJSON()
+		gen.genDecodeJSON()
+	}
+	for _, nested := range message.Messages {
+		g.genMessage(nested)
+	}
+}
+
+// jsonMethods are the methods generated by the feature, which no field must shadow.
+var jsonMethods = map[string]bool{
+	"MarshalJSON":   true,
+	"AppendJSON":    true,
+	"UnmarshalJSON": true,
+	"DecodeJSON":    true,
+}
+
+// generatesJSON reports whether the JSON methods are generated for the message. They are not for
+// map entries, for the well-known types, whose JSON format is implemented by the runtime, for
+// messages with weak fields and for messages with fields named after the methods, whose JSON
+// format is then implemented by the runtime through reflection.
+func generatesJSON(message *protogen.Message) bool {
+	if message.Desc.IsMapEntry() || message.Desc.ParentFile().Package() == "google.protobuf" {
+		return false
+	}
+	for _, field := range message.Fields {
+		if field.Desc.IsWeak() || jsonMethods[field.GoName] {
+			return false
+		}
+	}
+	for _, oneof := range message.Oneofs {
+		if jsonMethods[oneof.GoName] {
+			return false
+		}
+	}
+	return true
+}
+
+type jsonGenerator struct {
+	*generator.GeneratedFile
+	message *protogen.Message
+}
+
+func (g *jsonGenerator) genMarshalJSON() {
+	g.P("// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.")
+	g.P("func (x *", g.message.GoIdent, ") MarshalJSON() ([]byte, error) {")
+	g.P("return ", runtimePackage.Ident("MarshalJSON"), "(x)")
+	g.P("}")
+	g.P()
+}
+
+func (g *jsonGenerator) genUnmarshalJSON() {
+	g.P("// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.")
+	g.P("func (x *", g.message.GoIdent, ") UnmarshalJSON(b []byte) error {")
+	g.P("return ", runtimePackage.Ident("UnmarshalJSON"), "(b, x, ", protojsonPkg.Ident("UnmarshalOptions"), "{})")
+	g.P("}")
+	g.P()
+}
+
+// inOneof reports whether the field is a member of a oneof, proto3 optional fields excluded.
+func inOneof(field *protogen.Field) bool {
+	return field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
+}
+
+// hasJSONMethods reports whether the message type has the generated JSON methods, which are
+// then called directly rather than through the runtime.
+func (g *jsonGenerator) hasJSONMethods(message *protogen.Message) bool {
+	return g.IsLocalMessage(message) && generatesJSON(message)
+}
+
+// jsonName returns the Go string literal of the JSON name of the field followed by a colon.
+func jsonName(field *protogen.Field) string {
+	name, err := runtime.AppendJSONString(nil, field.Desc.JSONName(), field.Desc.FullName())
+	if err != nil {
+		panic(err)
+	}
+	return strconv.Quote(string(name) + ":")
+}
+
+// fullName returns the Go string literal of the full name of the field.
+func fullName(field *protogen.Field) string {
+	return strconv.Quote(string(field.Desc.FullName()))
+}
+
+// usesErr reports whether the encoding of the field can fail.
+func usesErr(field *protogen.Field) bool {
+	if field.Desc.IsMap() {
+		return usesErr(field.Message.Fields[0]) || usesErr(field.Message.Fields[1])
+	}
+	switch field.Desc.Kind() {
+	case protoreflect.StringKind, protoreflect.MessageKind, protoreflect.GroupKind:
+		return true
+	}
+	return false
+}
@@ -32,7 +32,7 @@ import (
 )
 
 // SupportedFeatures reports the set of supported protobuf language features.
-var SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+var SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL | pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
 
 // GenerateVersionMarkers specifies whether to generate version markers.
 var GenerateVersionMarkers = true
@@ -62,6 +62,13 @@ var (
 	protoregistryPackage goImportPath = protogen.GoImportPath("google.golang.org/protobuf/reflect/protoregistry")
 )
 
+// runtimePackage is the pulsar runtime, holding the encoding of lazy fields and the cache
+// of interface fields.
+const runtimePackage = protogen.GoImportPath("github.com/cosmos/cosmos-proto/runtime")
+
+// lazyFieldsGoName is the name of the struct field holding the encoding of lazy fields.
+const lazyFieldsGoName = "lazyFields"
+
 // GenerateFile generates the contents of a .pb.go file.
 func GenerateFile(gen *protogen.Plugin, file *protogen.File, g *generator.GeneratedFile) *generator.GeneratedFile {
 	// filename := file.GeneratedFilenamePrefix + ".pb.go"
@@ -1411,6 +1418,18 @@ func genMessageInternalFields(g *generator.GeneratedFile, f *fileInfo, m *messag
 		g.P(genid.ExtensionFields_goname, " ", protoimplPackage.Ident("ExtensionFields"))
 		sf.append(genid.ExtensionFields_goname)
 	}
+	if len(g.LazyFields(m.Message)) > 0 {
+		g.P(lazyFieldsGoName, " ", runtimePackage.Ident("LazyFields"))
+		sf.append(lazyFieldsGoName)
+	}
+	for _, field := range g.InterfaceFields(m.Message) {
+		g.P(generator.AnyCacheGoName(field), " ", runtimePackage.Ident("AnyCache"))
+		sf.append(generator.AnyCacheGoName(field))
+	}
+	if g.ReusesBytes(m.Message) {
+		g.P(generator.ZeroCopiedGoName, " bool")
+		sf.append(generator.ZeroCopiedGoName)
+	}
 	if sf.count > 0 {
 		g.P()
 	}
@@ -1456,6 +1475,11 @@ func genMessageField(g *generator.GeneratedFile, f *fileInfo, m *messageInfo, fi
 		{"protobuf", fieldProtobufTagValue(field)},
 		{"json", fieldJSONTagValue(field)},
 	}
+	if g.IsScalar(field) {
+		// the field is unknown to the reflection of protoimpl, which cannot
+		// handle its Go type, and is left to the fast reflection
+		tags = tags[1:]
+	}
 	if field.Desc.IsMap() {
 		key := field.Message.Fields[0]
 		val := field.Message.Fields[1]
@@ -1551,6 +1575,7 @@ func genMessageMethods(g *generator.GeneratedFile, f *fileInfo, m *messageInfo)
 	genMessageBaseMethods(g, f, m)
 	genMessageGetterMethods(g, f, m)
 	genMessageSetterMethods(g, f, m)
+	genMessageLazyMethods(g, f, m)
 }
 
 func genMessageBaseMethods(g *generator.GeneratedFile, f *fileInfo, m *messageInfo) {
@@ -1634,6 +1659,21 @@ func genMessageGetterMethods(g *generator.GeneratedFile, f *fileInfo, m *message
 			g.P("}")
 			g.P("return ", defaultValue)
 			g.P("}")
+		case g.IsScalar(field):
+			g.P(leadingComments, "func (x *", m.GoIdent, ") Get", field.GoName, "() (v ", goType, ") {")
+			g.P("if x != nil {")
+			g.P("return x.", field.GoName)
+			g.P("}")
+			g.P("return v")
+			g.P("}")
+		case g.IsLazy(field):
+			g.P(leadingComments, "func (x *", m.GoIdent, ") Get", field.GoName, "() ", goType, " {")
+			g.P("if x != nil {")
+			g.P("x.lazyDecode", field.GoName, "()")
+			g.P("return x.", field.GoName)
+			g.P("}")
+			g.P("return nil")
+			g.P("}")
 		default:
 			g.P(leadingComments, "func (x *", m.GoIdent, ") Get", field.GoName, "() ", goType, " {")
 			if !field.Desc.HasPresence() || defaultValue == "nil" {
@@ -1654,6 +1694,21 @@ func genMessageGetterMethods(g *generator.GeneratedFile, f *fileInfo, m *message
 	}
 }
 
+// genMessageLazyMethods generates, for each lazy field, the method decoding
+// the encoding recorded by the unmarshal on first access.
+func genMessageLazyMethods(g *generator.GeneratedFile, f *fileInfo, m *messageInfo) {
+	for i, field := range g.LazyFields(m.Message) {
+		decode := "DecodeLazy"
+		if field.Desc.IsList() {
+			decode = "DecodeLazyList"
+		}
+		g.P("func (x *", m.GoIdent, ") lazyDecode", field.GoName, "() {")
+		g.P(runtimePackage.Ident(decode), "(&x.", lazyFieldsGoName, ", ", i, ", &x.", field.GoName, ")")
+		g.P("}")
+		g.P()
+	}
+}
+
 func genMessageSetterMethods(g *generator.GeneratedFile, f *fileInfo, m *messageInfo) {
 	for _, field := range m.Fields {
 		if !field.Desc.IsWeak() {
@@ -1686,6 +1741,9 @@ func fieldGoType(g *generator.GeneratedFile, f *fileInfo, field *protogen.Field)
 	if field.Desc.IsWeak() {
 		return "struct{}", false
 	}
+	if scalar, ok := g.ScalarGoType(field); ok {
+		return g.QualifiedGoIdent(scalar), false
+	}
 
 	pointer = field.Desc.HasPresence()
 	switch field.Desc.Kind() {
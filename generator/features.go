@@ -13,7 +13,7 @@ import (
 
 var defaultFeatures = make(map[string]Feature)
 
-func findFeatures(featureNames []string) ([]Feature, error) {
+func findFeatures(featureNames []string) ([]Feature, map[string]bool, error) {
 	required := make(map[string]Feature)
 	for _, name := range featureNames {
 		if name == "all" {
@@ -23,7 +23,7 @@ func findFeatures(featureNames []string) ([]Feature, error) {
 
 		feat, ok := defaultFeatures[name]
 		if !ok {
-			return nil, fmt.Errorf("unknown feature: %q", name)
+			return nil, nil, fmt.Errorf("unknown feature: %q", name)
 		}
 		required[name] = feat
 	}
@@ -41,10 +41,12 @@ func findFeatures(featureNames []string) ([]Feature, error) {
 	})
 
 	var features []Feature
+	enabled := make(map[string]bool)
 	for _, sp := range sorted {
 		features = append(features, sp.feat)
+		enabled[sp.name] = true
 	}
-	return features, nil
+	return features, enabled, nil
 }
 
 func RegisterFeature(name string, feat Feature) {
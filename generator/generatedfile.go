@@ -6,14 +6,19 @@ package generator
 
 import (
 	"fmt"
+
+	"github.com/cosmos/cosmos-proto/runtime"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 type GeneratedFile struct {
 	*protogen.GeneratedFile
 	Ext           *Extensions
 	LocalPackages map[string]bool
+	// Features holds the names of the features being generated.
+	Features map[string]bool
 }
 
 func (p *GeneratedFile) Ident(path, ident string) string {
@@ -25,6 +30,10 @@ func (p *GeneratedFile) FieldGoType(field *protogen.Field) (goType string, point
 		return "struct{}", false
 	}
 
+	if scalar, ok := p.ScalarGoType(field); ok {
+		return p.QualifiedGoIdent(scalar), false
+	}
+
 	pointer = field.Desc.HasPresence()
 	switch field.Desc.Kind() {
 	case protoreflect.BoolKind:
@@ -63,7 +72,156 @@ func (p *GeneratedFile) FieldGoType(field *protogen.Field) (goType string, point
 	return goType, pointer
 }
 
+// ShouldPool reports whether the provided message was marked as poolable
+// through the plugin's pool option.
+func (p *GeneratedFile) ShouldPool(message *protogen.Message) bool {
+	if message == nil || p.Ext == nil {
+		return false
+	}
+	return p.Ext.Poolable[message.GoIdent]
+}
+
+// IsLazy reports whether the provided field is decoded lazily, which is requested either
+// by the lazy field option or through the plugin's lazy option. Only singular and repeated
+// message fields outside of oneofs can be lazy, the option is ignored on other fields.
+func (p *GeneratedFile) IsLazy(field *protogen.Field) bool {
+	fd := field.Desc
+	if fd.Kind() != protoreflect.MessageKind || fd.IsMap() || fd.IsWeak() {
+		return false
+	}
+	if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+		return false
+	}
+	if p.Ext != nil && p.Ext.Lazy[fd.FullName()] {
+		return true
+	}
+	options, _ := fd.Options().(*descriptorpb.FieldOptions)
+	return options.GetLazy() || options.GetUnverifiedLazy()
+}
+
+// LazyFields returns the lazy fields of the provided message. The position of a field
+// in the returned slice is its index in the runtime.LazyFields of the message.
+func (p *GeneratedFile) LazyFields(message *protogen.Message) []*protogen.Field {
+	var fields []*protogen.Field
+	for _, field := range message.Fields {
+		if p.IsLazy(field) {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) > maxLazyFields {
+		panic(fmt.Sprintf("message %s has more than %d lazy fields", message.Desc.FullName(), maxLazyFields))
+	}
+	return fields
+}
+
+// LazyIndex returns the index of the provided lazy field in the runtime.LazyFields of
+// its message.
+func (p *GeneratedFile) LazyIndex(field *protogen.Field) int {
+	for i, f := range p.LazyFields(field.Parent) {
+		if f == field {
+			return i
+		}
+	}
+	panic("not a lazy field")
+}
+
+// maxLazyFields mirrors the capacity of runtime.LazyFields.
+const maxLazyFields = 64
+
 func (p *GeneratedFile) IsLocalMessage(message *protogen.Message) bool {
 	pkg := string(message.Desc.ParentFile().Package())
 	return p.LocalPackages[pkg]
 }
+
+// InterfaceFields returns the fields of the provided message which get the typed accessors
+// of the interfaces feature, when it is generated: the google.protobuf.Any fields accepting
+// an interface through the cosmos_proto.accepts_interface option, except map fields and the
+// fields whose accessors would collide with the fields or getters of the message.
+func (p *GeneratedFile) InterfaceFields(message *protogen.Message) []*protogen.Field {
+	if !p.Features["interfaces"] {
+		return nil
+	}
+	names := make(map[string]bool)
+	for _, field := range message.Fields {
+		names[field.GoName] = true
+		names["Get"+field.GoName] = true
+	}
+	for _, oneof := range message.Oneofs {
+		names[oneof.GoName] = true
+	}
+	var fields []*protogen.Field
+	for _, field := range message.Fields {
+		if !IsInterfaceField(field) || names["Get"+field.GoName+"Cached"] || names["Set"+field.GoName] {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// IsInterfaceField reports whether the provided field is a singular or repeated
+// google.protobuf.Any field annotated with the cosmos_proto.accepts_interface option.
+func IsInterfaceField(field *protogen.Field) bool {
+	return runtime.AcceptsInterface(field.Desc) != "" && !field.Desc.IsMap() &&
+		IsAny(field.Message)
+}
+
+// IsAny reports whether the provided message, which may be nil, is google.protobuf.Any.
+func IsAny(message *protogen.Message) bool {
+	return message != nil && message.Desc.FullName() == anyFullName
+}
+
+// AnyCacheGoName returns the name of the struct field caching the message unpacked from
+// the provided interface field.
+func AnyCacheGoName(field *protogen.Field) string {
+	return "cached" + field.GoName
+}
+
+const anyFullName protoreflect.FullName = "google.protobuf.Any"
+
+// ScalarGoType returns the Go type the provided field is mapped to through the plugin's scalar
+// option, keyed by the scalar the field is annotated with through the cosmos_proto.scalar option.
+// Only singular string and bytes fields without explicit presence are mapped, whose presence is
+// then tested by comparing the Go value to its zero value, the option is ignored on other fields.
+func (p *GeneratedFile) ScalarGoType(field *protogen.Field) (protogen.GoIdent, bool) {
+	fd := field.Desc
+	if p.Ext == nil || fd.IsList() || fd.IsMap() || fd.HasPresence() || fd.IsExtension() {
+		return protogen.GoIdent{}, false
+	}
+	if fd.Kind() != protoreflect.StringKind && fd.Kind() != protoreflect.BytesKind {
+		return protogen.GoIdent{}, false
+	}
+	ident, ok := p.Ext.Scalars[runtime.FieldScalar(fd)]
+	return ident, ok
+}
+
+// IsScalar reports whether the provided field is mapped to a Go type through the scalar option.
+func (p *GeneratedFile) IsScalar(field *protogen.Field) bool {
+	_, ok := p.ScalarGoType(field)
+	return ok
+}
+
+// FieldDescriptorName returns the name of the package variable holding the descriptor of the
+// provided field, which is declared by the fast reflection.
+func FieldDescriptorName(field *protogen.Field) string {
+	return fmt.Sprintf("fd_%s_%s", field.Parent.GoIdent.GoName, field.Desc.Name())
+}
+
+// ZeroCopiedGoName is the name of the struct field recording that the bytes fields of a
+// message may alias the buffer given to a zero-copy unmarshal.
+const ZeroCopiedGoName = "zeroCopied"
+
+// ReusesBytes reports whether the unmarshal of the provided message reuses the memory of
+// its bytes fields, singular or repeated, which it must not do once they may alias the
+// buffer given to a zero-copy unmarshal. Such messages record it in ZeroCopiedGoName.
+func (p *GeneratedFile) ReusesBytes(message *protogen.Message) bool {
+	for _, field := range message.Fields {
+		if field.Desc.Kind() != protoreflect.BytesKind || field.Desc.IsMap() || p.IsScalar(field) {
+			continue
+		}
+		if field.Oneof == nil || field.Oneof.Desc.IsSynthetic() {
+			return true
+		}
+	}
+	return false
+}
@@ -5,6 +5,8 @@
 package generator
 
 import (
+	"fmt"
+
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
@@ -16,22 +18,31 @@ type featureHelpers struct {
 
 type Extensions struct {
 	Poolable map[protogen.GoIdent]bool
+	Lazy     map[protoreflect.FullName]bool
+	// Scalars maps the names of scalars to the Go types of the fields annotated with them.
+	Scalars map[string]protogen.GoIdent
 }
 
 type Generator struct {
 	seen     map[featureHelpers]bool
 	ext      *Extensions
 	features []Feature
+	enabled  map[string]bool
 	local    map[string]bool
 }
 
 func NewGenerator(allFiles []*protogen.File, featureNames []string, ext *Extensions) (*Generator, error) {
-	features, err := findFeatures(featureNames)
+	features, enabled, err := findFeatures(featureNames)
 
 	if err != nil {
 		return nil, err
 	}
 
+	if ext != nil && len(ext.Scalars) > 0 && !enabled["fast"] {
+		// the reflection of protoimpl does not know the Go types of scalars
+		return nil, fmt.Errorf("the scalar option requires the fast feature")
+	}
+
 	local := make(map[string]bool)
 	for _, f := range allFiles {
 		if f.Generate {
@@ -43,12 +54,15 @@ func NewGenerator(allFiles []*protogen.File, featureNames []string, ext *Extensi
 		seen:     make(map[featureHelpers]bool),
 		ext:      ext,
 		features: features,
+		enabled:  enabled,
 		local:    local,
 	}, nil
 }
 
 func (gen *Generator) GenerateFile(plugin *protogen.Plugin, gf *protogen.GeneratedFile, file *protogen.File) bool {
-	if file.Desc.Syntax() != protoreflect.Proto3 {
+	switch file.Desc.Syntax() {
+	case protoreflect.Proto2, protoreflect.Proto3, protoreflect.Editions:
+	default:
 		return false
 	}
 
@@ -56,6 +70,7 @@ func (gen *Generator) GenerateFile(plugin *protogen.Plugin, gf *protogen.Generat
 		GeneratedFile: gf,
 		Ext:           gen.ext,
 		LocalPackages: gen.local,
+		Features:      gen.enabled,
 	}
 
 	// DEPRECATED: this was used for our fork/copy of protoc-gen-go
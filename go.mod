@@ -1,11 +1,11 @@
 module github.com/cosmos/cosmos-proto
 
-go 1.18
+go 1.23
 
 require (
-	github.com/google/go-cmp v0.5.6
+	github.com/google/go-cmp v0.7.0
 	github.com/stretchr/testify v1.7.0
-	google.golang.org/protobuf v1.27.1
+	google.golang.org/protobuf v1.36.12
 	pgregory.net/rapid v0.4.7
 )
 
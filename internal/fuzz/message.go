@@ -107,7 +107,7 @@ func (g *generator) list(fd protoreflect.FieldDescriptor) {
 
 	for i := 0; i < length; i++ {
 		switch fd.Kind() {
-		case protoreflect.MessageKind:
+		case protoreflect.MessageKind, protoreflect.GroupKind:
 			gen := g.embeddedMessage(list.NewElement().Message().Type())
 			list.Append(protoreflect.ValueOfMessage(gen))
 		default:
@@ -146,7 +146,7 @@ func (g *generator) mapp(fd protoreflect.FieldDescriptor) {
 func (g *generator) value(fd protoreflect.FieldDescriptor) {
 	var value protoreflect.Value
 	switch fd.Kind() {
-	case protoreflect.MessageKind:
+	case protoreflect.MessageKind, protoreflect.GroupKind:
 		msg := g.embeddedMessage(g.m.NewField(fd).Message().Type())
 		value = protoreflect.ValueOfMessage(msg)
 	default:
@@ -231,6 +231,10 @@ func (g *generator) decideOneofs() {
 	md := g.typ.Descriptor()
 	for i := 0; i < md.Oneofs().Len(); i++ {
 		oneof := md.Oneofs().Get(i)
+		// proto3 optional fields are randomly left unpopulated to exercise presence
+		if oneof.IsSynthetic() && !rapid.Bool().Draw(g.t, "populating optional field: "+string(oneof.FullName())).(bool) {
+			continue
+		}
 		index := rapid.IntRange(0, oneof.Fields().Len()-1).Draw(g.t, "deciding oneof field for: "+string(oneof.FullName())).(int)
 		decidedFd := oneof.Fields().Get(index)
 		g.pickedOneofs[oneof.FullName()] = decidedFd.FullName()
@@ -0,0 +1,79 @@
+package test2
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/internal/fuzz"
+	"github.com/cosmos/cosmos-proto/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"pgregory.net/rapid"
+)
+
+func TestValidateCanonical(t *testing.T) {
+	t.Run("marshaled", rapid.MakeCheck(func(t *rapid.T) {
+		msg := fuzz.Message(t, (&TestAllTypes{}).ProtoReflect().Type())
+		b, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Interface())
+		require.NoError(t, err)
+		require.NoError(t, runtime.ValidateCanonical(b, md_TestAllTypes))
+	}))
+
+	t.Run("extensions", func(t *testing.T) {
+		b, err := proto.MarshalOptions{Deterministic: true}.Marshal(newExtendedMessage())
+		require.NoError(t, err)
+		require.NoError(t, (*TestExtensionRange)(nil).ValidateCanonical(b))
+	})
+
+	t.Run("zero values with presence", func(t *testing.T) {
+		b, err := proto.MarshalOptions{Deterministic: true}.Marshal(&TestAllTypes{OptionalInt32: proto.Int32(0), OptionalString: proto.String("")})
+		require.NoError(t, err)
+		require.NoError(t, (*TestAllTypes)(nil).ValidateCanonical(b))
+	})
+}
+
+func TestValidateCanonicalErrors(t *testing.T) {
+	tag := func(num protowire.Number, typ protowire.Type) []byte {
+		return protowire.AppendTag(nil, num, typ)
+	}
+	a := append(tag(17, protowire.VarintType), 1)
+	repeatedA := append(tag(47, protowire.VarintType), 1)
+	for name, tc := range map[string]struct {
+		msg    runtime.CanonicalValidator
+		b      []byte
+		offset int
+		path   string
+		reason string
+	}{
+		"extension after the fields": {
+			msg:    (*TestExtensionRange)(nil),
+			b:      append(protowire.AppendString(tag(1, protowire.BytesType), "name"), append(tag(100, protowire.VarintType), 1)...),
+			offset: 6, path: "[100]", reason: "out of order",
+		},
+		"group": {
+			msg:    (*TestAllTypes)(nil),
+			b:      append(append(append(tag(16, protowire.StartGroupType), a...), a...), tag(16, protowire.EndGroupType)...),
+			offset: 5, path: "optionalgroup.a", reason: "duplicate field",
+		},
+		"repeated group": {
+			msg: (*TestAllTypes)(nil),
+			b: append(append(tag(46, protowire.StartGroupType), tag(46, protowire.EndGroupType)...),
+				append(append(append(tag(46, protowire.StartGroupType), repeatedA...), repeatedA...), tag(46, protowire.EndGroupType)...)...),
+			offset: 9, path: "repeatedgroup[1].a", reason: "duplicate field",
+		},
+		"packed unpacked field": {
+			msg:    (*TestAllTypes)(nil),
+			b:      protowire.AppendBytes(tag(31, protowire.BytesType), []byte{1}),
+			offset: 0, path: "repeated_int32", reason: "wire type 2 instead of 0",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateCanonical(tc.b)
+			var canonical *runtime.CanonicalError
+			require.ErrorAs(t, err, &canonical)
+			require.Equal(t, tc.offset, canonical.Offset)
+			require.Equal(t, tc.path, canonical.Path)
+			require.Contains(t, canonical.Reason, tc.reason)
+		})
+	}
+}
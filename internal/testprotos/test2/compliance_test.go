@@ -0,0 +1,14 @@
+package test2
+
+import (
+	"google.golang.org/protobuf/testing/prototest"
+	"testing"
+)
+
+func TestCompliance(t *testing.T) {
+	prototest.Message{}.Test(t, (&TestAllTypes{}).ProtoReflect().Type())
+	prototest.Message{}.Test(t, (&TestExtensionRange{}).ProtoReflect().Type())
+	prototest.Message{}.Test(t, (&TestRequired{}).ProtoReflect().Type())
+	prototest.Message{}.Test(t, (&TestRequiredForeign{}).ProtoReflect().Type())
+	prototest.Message{}.Test(t, (&TestRequiredGroupFields{}).ProtoReflect().Type())
+}
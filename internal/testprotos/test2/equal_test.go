@@ -0,0 +1,59 @@
+package test2
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/internal/fuzz"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"pgregory.net/rapid"
+)
+
+func TestEqual(t *testing.T) {
+	t.Run("matches reflection equal", rapid.MakeCheck(func(t *rapid.T) {
+		mType := (&TestAllTypes{}).ProtoReflect().Type()
+		x := fuzz.Message(t, mType).Interface()
+		require.True(t, proto.Equal(x, proto.Clone(x)))
+
+		// y differs from x by at most one field, taken from another random message
+		y := proto.Clone(x)
+		other := fuzz.Message(t, mType)
+		fields := mType.Descriptor().Fields()
+		fd := fields.Get(rapid.IntRange(0, fields.Len()-1).Draw(t, "field").(int))
+		if other.Has(fd) {
+			y.ProtoReflect().Set(fd, other.Get(fd))
+		} else {
+			y.ProtoReflect().Clear(fd)
+		}
+
+		dynX, dynY := dynamicpb.NewMessage(mType.Descriptor()), dynamicpb.NewMessage(mType.Descriptor())
+		proto.Merge(dynX, x)
+		proto.Merge(dynY, y)
+		require.Equal(t, protoreflect.ValueOfMessage(dynX).Equal(protoreflect.ValueOfMessage(dynY)), proto.Equal(x, y))
+	}))
+}
+
+func TestEqualExplicitPresence(t *testing.T) {
+	require.False(t, proto.Equal(&TestAllTypes{OptionalBytes: []byte{}}, &TestAllTypes{}))
+	require.True(t, proto.Equal(&TestAllTypes{OptionalBytes: []byte{}}, &TestAllTypes{OptionalBytes: []byte("")}))
+	require.False(t, proto.Equal(&TestAllTypes{OptionalString: proto.String("")}, &TestAllTypes{}))
+	require.False(t, proto.Equal(&TestAllTypes{Optionalgroup: &TestAllTypes_OptionalGroup{}}, &TestAllTypes{}))
+}
+
+func TestEqualExtensions(t *testing.T) {
+	x, y := newExtendedMessage(), newExtendedMessage()
+	require.True(t, proto.Equal(x, y))
+
+	proto.SetExtension(y, E_ExtPacked, []int32{1, 2})
+	require.False(t, proto.Equal(x, y))
+	proto.ClearExtension(y, E_ExtPacked)
+	require.False(t, proto.Equal(x, y))
+	proto.ClearExtension(x, E_ExtPacked)
+	require.True(t, proto.Equal(x, y))
+
+	// empty lists are not populated
+	proto.SetExtension(x, E_ExtPacked, []int32{})
+	require.True(t, proto.Equal(x, y))
+}
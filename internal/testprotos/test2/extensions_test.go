@@ -0,0 +1,141 @@
+package test2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func newExtendedMessage() *TestExtensionRange {
+	m := &TestExtensionRange{Name: proto.String("name")}
+	proto.SetExtension(m, E_ExtInt32, int32(-1))
+	proto.SetExtension(m, E_ExtPacked, []int32{1, 2, 300})
+	proto.SetExtension(m, E_ExtStrings, []string{"a", "", "c"})
+	proto.SetExtension(m, E_ExtMessage, &ForeignMessage{C: proto.Int32(1), D: proto.Int32(2)})
+	proto.SetExtension(m, E_ExtRequired, &TestRequired{RequiredField: proto.Int32(3)})
+	proto.SetExtension(m, E_ExtEnum, ForeignEnum_FOREIGN_BAR)
+	proto.SetExtension(m, E_ExtMessages, []*ForeignMessage{{C: proto.Int32(4)}, {}})
+	return m
+}
+
+func TestExtensionsReflection(t *testing.T) {
+	m := newExtendedMessage()
+	require.Equal(t, int32(-1), proto.GetExtension(m, E_ExtInt32))
+	require.Equal(t, []int32{1, 2, 300}, proto.GetExtension(m, E_ExtPacked))
+	require.Equal(t, ForeignEnum_FOREIGN_BAR, proto.GetExtension(m, E_ExtEnum))
+
+	// Range visits the regular fields and every populated extension
+	visited := map[protoreflect.FullName]bool{}
+	m.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		visited[fd.FullName()] = true
+		return true
+	})
+	require.Len(t, visited, 8)
+	require.True(t, visited[E_ExtMessages.TypeDescriptor().FullName()])
+
+	xd := E_ExtMessage.TypeDescriptor()
+	require.True(t, m.ProtoReflect().Has(xd))
+	m.ProtoReflect().Mutable(xd).Message().Set(xd.Message().Fields().ByName("c"), protoreflect.ValueOfInt32(10))
+	require.Equal(t, int32(10), proto.GetExtension(m, E_ExtMessage).(*ForeignMessage).GetC())
+
+	m.ProtoReflect().Clear(xd)
+	require.False(t, proto.HasExtension(m, E_ExtMessage))
+	require.False(t, m.ProtoReflect().Has(xd))
+
+	// empty lists are not populated
+	proto.SetExtension(m, E_ExtStrings, []string{})
+	require.False(t, m.ProtoReflect().Has(E_ExtStrings.TypeDescriptor()))
+
+	require.PanicsWithError(t, "message goproto.proto.test2.TestAllTypes does not extend "+string(xd.FullName()), func() {
+		(&TestAllTypes{}).ProtoReflect().Get(xd)
+	})
+}
+
+func TestExtensionsRoundTrip(t *testing.T) {
+	m := newExtendedMessage()
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	require.NoError(t, err)
+	require.Equal(t, proto.Size(m), len(b))
+
+	// the encoding matches the one of the reflection based implementation
+	dyn := dynamicpb.NewMessage(m.ProtoReflect().Descriptor())
+	require.NoError(t, proto.Unmarshal(b, dyn))
+	dynBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(dyn)
+	require.NoError(t, err)
+	require.Equal(t, dynBytes, b)
+
+	got := &TestExtensionRange{}
+	require.NoError(t, proto.Unmarshal(b, got))
+	diff := cmp.Diff(m, got, protocmp.Transform())
+	require.Emptyf(t, diff, "non matching messages\n%s", diff)
+	require.Empty(t, got.ProtoReflect().GetUnknown())
+
+	// extensions unknown to the resolver are kept as unknown fields
+	unresolved := &TestExtensionRange{}
+	require.NoError(t, proto.UnmarshalOptions{Resolver: new(protoregistry.Types)}.Unmarshal(b, unresolved))
+	require.Equal(t, "name", unresolved.GetName())
+	require.False(t, proto.HasExtension(unresolved, E_ExtInt32))
+	require.NotEmpty(t, unresolved.ProtoReflect().GetUnknown())
+	reencoded, err := proto.Marshal(unresolved)
+	require.NoError(t, err)
+	require.Len(t, reencoded, len(b))
+}
+
+func TestExtensionsMerge(t *testing.T) {
+	dst := &TestExtensionRange{}
+	proto.SetExtension(dst, E_ExtPacked, []int32{1})
+	proto.SetExtension(dst, E_ExtMessage, &ForeignMessage{C: proto.Int32(1)})
+
+	src := &TestExtensionRange{}
+	proto.SetExtension(src, E_ExtPacked, []int32{2})
+	proto.SetExtension(src, E_ExtMessage, &ForeignMessage{D: proto.Int32(2)})
+	proto.SetExtension(src, E_ExtInt32, int32(3))
+
+	proto.Merge(dst, src)
+	require.Equal(t, []int32{1, 2}, proto.GetExtension(dst, E_ExtPacked))
+	require.Equal(t, int32(3), proto.GetExtension(dst, E_ExtInt32))
+	msg := proto.GetExtension(dst, E_ExtMessage).(*ForeignMessage)
+	require.Equal(t, int32(1), msg.GetC())
+	require.Equal(t, int32(2), msg.GetD())
+
+	// the source is not aliased
+	msg.C = proto.Int32(5)
+	require.Nil(t, proto.GetExtension(src, E_ExtMessage).(*ForeignMessage).C)
+}
+
+func TestExtensionsCheckInitialized(t *testing.T) {
+	m := &TestExtensionRange{}
+	proto.SetExtension(m, E_ExtRequired, &TestRequired{})
+	err := proto.CheckInitialized(m)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "[goproto.proto.test2.ext_required].required_field")
+
+	_, err = proto.Marshal(m)
+	require.Error(t, err)
+
+	b, err := proto.MarshalOptions{AllowPartial: true}.Marshal(m)
+	require.NoError(t, err)
+	require.Error(t, proto.Unmarshal(b, &TestExtensionRange{}))
+	require.NoError(t, proto.UnmarshalOptions{AllowPartial: true}.Unmarshal(b, &TestExtensionRange{}))
+
+	proto.GetExtension(m, E_ExtRequired).(*TestRequired).RequiredField = proto.Int32(1)
+	require.NoError(t, proto.CheckInitialized(m))
+}
+
+func TestExtensionsWireTypeMismatch(t *testing.T) {
+	var bz []byte
+	bz = protowire.AppendTag(bz, 100, protowire.BytesType)
+	bz = protowire.AppendString(bz, "not an int32")
+
+	msg := &TestExtensionRange{}
+	require.NoError(t, proto.Unmarshal(bz, msg))
+	require.False(t, proto.HasExtension(msg, E_ExtInt32))
+	require.Equal(t, bz, []byte(msg.ProtoReflect().GetUnknown()))
+}
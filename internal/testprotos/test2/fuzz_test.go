@@ -0,0 +1,49 @@
+package test2
+
+import (
+	"github.com/cosmos/cosmos-proto/internal/fuzz"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestMarshalUnmarshal(t *testing.T) {
+	t.Run("marshal unmarshal", rapid.MakeCheck(func(t *rapid.T) {
+		mType := (&TestAllTypes{}).ProtoReflect().Type()
+		msg := fuzz.Message(t, mType)
+
+		msgBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Interface())
+		require.NoError(t, err)
+
+		uMsg := mType.New()
+		err = proto.UnmarshalOptions{}.Unmarshal(msgBytes, uMsg.Interface())
+		require.NoError(t, err)
+		cmpOpt := protocmp.Transform()
+		diff := cmp.Diff(uMsg.Interface(), msg.Interface(), cmpOpt)
+		require.Emptyf(t, diff, "non matching messages\n%s", diff)
+	}))
+
+	// the reflection based codec of dynamicpb acts as the reference implementation
+	t.Run("matches reflection codec", rapid.MakeCheck(func(t *rapid.T) {
+		mType := (&TestAllTypes{}).ProtoReflect().Type()
+		msg := fuzz.Message(t, mType)
+
+		msgBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Interface())
+		require.NoError(t, err)
+
+		dynMsg := dynamicpb.NewMessage(mType.Descriptor())
+		require.NoError(t, proto.Unmarshal(msgBytes, dynMsg))
+		dynBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(dynMsg)
+		require.NoError(t, err)
+		require.Equal(t, len(dynBytes), proto.Size(msg.Interface()))
+
+		uMsg := mType.New()
+		require.NoError(t, proto.Unmarshal(dynBytes, uMsg.Interface()))
+		diff := cmp.Diff(uMsg.Interface(), msg.Interface(), protocmp.Transform())
+		require.Emptyf(t, diff, "non matching messages\n%s", diff)
+	}))
+}
@@ -0,0 +1,69 @@
+package test2
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/internal/fuzz"
+	"github.com/cosmos/cosmos-proto/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"pgregory.net/rapid"
+)
+
+func TestJSON(t *testing.T) {
+	for _, typ := range []protoreflect.MessageType{
+		(&TestAllTypes{}).ProtoReflect().Type(),
+		(&TestRequiredForeign{}).ProtoReflect().Type(),
+		(&TestRequiredGroupFields{}).ProtoReflect().Type(),
+	} {
+		t.Run(string(typ.Descriptor().FullName()), rapid.MakeCheck(func(t *rapid.T) {
+			msg := fuzz.Message(t, typ).Interface()
+			want, wantErr := protojson.Marshal(msg)
+			got, err := msg.(json.Marshaler).MarshalJSON()
+			// required fields may be missing, in which case both fail
+			require.Equal(t, wantErr == nil, err == nil, "%v, %v", wantErr, err)
+			if err != nil {
+				return
+			}
+			var buf bytes.Buffer
+			require.NoError(t, json.Compact(&buf, want))
+			require.Equal(t, buf.String(), string(got))
+
+			decoded := typ.New().Interface()
+			require.NoError(t, decoded.(json.Unmarshaler).UnmarshalJSON(got))
+			require.True(t, proto.Equal(msg, decoded))
+		}))
+	}
+}
+
+func TestJSONExtensions(t *testing.T) {
+	m := newExtendedMessage()
+	want, err := protojson.Marshal(m)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, json.Compact(&buf, want))
+	got, err := m.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, buf.String(), string(got))
+
+	decoded := new(TestExtensionRange)
+	require.NoError(t, decoded.UnmarshalJSON(got))
+	require.True(t, proto.Equal(m, decoded))
+
+	require.Error(t, new(TestExtensionRange).UnmarshalJSON([]byte(`{"[cosmos.test2.unknown]":1}`)))
+	require.Error(t, new(TestExtensionRange).UnmarshalJSON([]byte(`{"[`+string(E_ExtInt32.TypeDescriptor().FullName())+`]":1,"[`+string(E_ExtInt32.TypeDescriptor().FullName())+`]":2}`)))
+}
+
+func TestJSONRequired(t *testing.T) {
+	_, err := (&TestRequired{}).MarshalJSON()
+	require.Error(t, err)
+	require.Error(t, new(TestRequired).UnmarshalJSON([]byte(`{"optionalField":"a"}`)))
+
+	partial := new(TestRequired)
+	require.NoError(t, runtime.UnmarshalJSON([]byte(`{"optionalField":"a"}`), partial, protojson.UnmarshalOptions{AllowPartial: true}))
+	require.Equal(t, "a", partial.GetOptionalField())
+}
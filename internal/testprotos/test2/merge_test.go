@@ -0,0 +1,49 @@
+package test2
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/internal/fuzz"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"pgregory.net/rapid"
+)
+
+func TestMerge(t *testing.T) {
+	t.Run("matches reflection merge", rapid.MakeCheck(func(t *rapid.T) {
+		mType := (&TestAllTypes{}).ProtoReflect().Type()
+		dst := fuzz.Message(t, mType).Interface()
+		src := fuzz.Message(t, mType).Interface()
+
+		dynDst := dynamicpb.NewMessage(mType.Descriptor())
+		dynSrc := dynamicpb.NewMessage(mType.Descriptor())
+		proto.Merge(dynDst, dst)
+		proto.Merge(dynSrc, src)
+
+		proto.Merge(dst, src)
+		proto.Merge(dynDst, dynSrc)
+
+		got := dynamicpb.NewMessage(mType.Descriptor())
+		proto.Merge(got, dst)
+		diff := cmp.Diff(got, dynDst, protocmp.Transform())
+		require.Emptyf(t, diff, "non matching messages\n%s", diff)
+	}))
+}
+
+func TestMergeExplicitPresence(t *testing.T) {
+	dst := &TestAllTypes{OptionalInt32: proto.Int32(1), OptionalBytes: []byte("dst")}
+	proto.Merge(dst, &TestAllTypes{OptionalInt32: proto.Int32(0), OptionalBytes: []byte{}})
+
+	// populated zero values overwrite the destination
+	require.Equal(t, int32(0), dst.GetOptionalInt32())
+	require.NotNil(t, dst.OptionalBytes)
+	require.Empty(t, dst.OptionalBytes)
+
+	src := &TestAllTypes{Optionalgroup: &TestAllTypes_OptionalGroup{A: proto.Int32(2)}}
+	proto.Merge(dst, src)
+	require.NotSame(t, src.Optionalgroup, dst.Optionalgroup)
+	require.True(t, proto.Equal(src.Optionalgroup, dst.Optionalgroup))
+}
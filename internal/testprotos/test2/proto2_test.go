@@ -0,0 +1,166 @@
+package test2
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestDefaults(t *testing.T) {
+	msg := (&TestAllTypes{}).ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !fd.HasDefault() {
+			continue
+		}
+		require.False(t, msg.Has(fd), fd.FullName())
+		require.Equal(t, fd.Default().Interface(), msg.Get(fd).Interface(), fd.FullName())
+		require.Equal(t, fd.Default().Interface(), msg.NewField(fd).Interface(), fd.FullName())
+	}
+
+	// the default value of bytes is a copy
+	msg.Get(fd_TestAllTypes_default_bytes).Bytes()[0] = 'W'
+	require.Equal(t, []byte("world"), msg.Get(fd_TestAllTypes_default_bytes).Bytes())
+}
+
+func TestPresence(t *testing.T) {
+	msg := &TestAllTypes{}
+	m := msg.ProtoReflect()
+
+	// zero values are populated once set
+	m.Set(fd_TestAllTypes_optional_int32, protoreflect.ValueOfInt32(0))
+	m.Set(fd_TestAllTypes_optional_string, protoreflect.ValueOfString(""))
+	m.Set(fd_TestAllTypes_optional_bytes, protoreflect.ValueOfBytes(nil))
+	m.Set(fd_TestAllTypes_default_int32, protoreflect.ValueOfInt32(0))
+	require.True(t, m.Has(fd_TestAllTypes_optional_int32))
+	require.True(t, m.Has(fd_TestAllTypes_optional_string))
+	require.True(t, m.Has(fd_TestAllTypes_optional_bytes))
+	require.True(t, m.Has(fd_TestAllTypes_default_int32))
+	require.Equal(t, int32(0), m.Get(fd_TestAllTypes_default_int32).Interface())
+
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+
+	got := &TestAllTypes{}
+	require.NoError(t, proto.Unmarshal(bz, got))
+	require.True(t, proto.Equal(msg, got))
+	require.NotNil(t, got.OptionalInt32)
+	require.NotNil(t, got.OptionalString)
+	require.NotNil(t, got.OptionalBytes)
+	require.Equal(t, int32(0), got.GetDefaultInt32())
+
+	m.Clear(fd_TestAllTypes_default_int32)
+	require.False(t, m.Has(fd_TestAllTypes_default_int32))
+	require.Equal(t, int32(81), m.Get(fd_TestAllTypes_default_int32).Interface())
+}
+
+func TestGroups(t *testing.T) {
+	msg := &TestAllTypes{
+		Optionalgroup: &TestAllTypes_OptionalGroup{A: proto.Int32(1)},
+		Repeatedgroup: []*TestAllTypes_RepeatedGroup{{A: proto.Int32(2)}, {}},
+		OneofField:    &TestAllTypes_Oneofgroup{Oneofgroup: &TestAllTypes_OneofGroup{B: proto.Int32(3)}},
+	}
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+
+	// groups are delimited by start and end group tags
+	num, typ, n := protowire.ConsumeTag(bz)
+	require.Greater(t, n, 0)
+	require.Equal(t, protowire.StartGroupType, typ)
+	_, n = protowire.ConsumeGroup(num, bz[n:])
+	require.Greater(t, n, 0)
+
+	got := &TestAllTypes{}
+	require.NoError(t, proto.Unmarshal(bz, got))
+	require.True(t, proto.Equal(msg, got))
+
+	// truncated groups are rejected
+	require.Error(t, proto.Unmarshal(bz[:len(bz)-1], &TestAllTypes{}))
+}
+
+func TestRequiredFields(t *testing.T) {
+	bz, err := proto.MarshalOptions{AllowPartial: true}.Marshal(&TestRequiredForeign{
+		OptionalMessage: &TestRequired{},
+	})
+	require.NoError(t, err)
+	err = proto.Unmarshal(bz, &TestRequiredForeign{})
+	require.Error(t, err)
+	require.ErrorIs(t, err, proto.Error)
+	require.NoError(t, proto.UnmarshalOptions{AllowPartial: true}.Unmarshal(bz, &TestRequiredForeign{}))
+
+	bz, err = proto.MarshalOptions{AllowPartial: true}.Marshal(&TestRequired{OptionalField: proto.String("x")})
+	require.NoError(t, err)
+	require.Error(t, proto.Unmarshal(bz, &TestRequired{}))
+	// discarding unknown fields must not skip the initialization check
+	require.Error(t, proto.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(bz, &TestRequired{}))
+
+	bz, err = proto.Marshal(&TestRequired{RequiredField: proto.Int32(0)})
+	require.NoError(t, err)
+	require.NoError(t, proto.Unmarshal(bz, &TestRequired{}))
+
+	// a required field set by a previous merge counts as populated
+	merged := &TestRequired{RequiredField: proto.Int32(1)}
+	require.NoError(t, proto.UnmarshalOptions{Merge: true}.Unmarshal(nil, merged))
+
+	bz, err = proto.MarshalOptions{AllowPartial: true}.Marshal(&TestRequiredGroupFields{
+		Repeatedgroup: []*TestRequiredGroupFields_RepeatedGroup{{}},
+	})
+	require.NoError(t, err)
+	require.Error(t, proto.Unmarshal(bz, &TestRequiredGroupFields{}))
+}
+
+func TestCheckInitialized(t *testing.T) {
+	for _, tc := range []struct {
+		msg  proto.Message
+		path string
+	}{
+		{&TestRequired{}, "goproto.proto.test2.TestRequired.required_field"},
+		{&TestRequiredForeign{OptionalMessage: &TestRequired{}}, "goproto.proto.test2.TestRequiredForeign.optional_message.required_field"},
+		{&TestRequiredForeign{
+			RepeatedMessage: []*TestRequired{{RequiredField: proto.Int32(1)}, {}},
+		}, "goproto.proto.test2.TestRequiredForeign.repeated_message[1].required_field"},
+		{&TestRequiredForeign{MapMessage: map[int32]*TestRequired{7: {}}}, "goproto.proto.test2.TestRequiredForeign.map_message[7].required_field"},
+		{&TestRequiredForeign{OneofField: &TestRequiredForeign_OneofMessage{OneofMessage: &TestRequired{}}}, "goproto.proto.test2.TestRequiredForeign.oneof_message.required_field"},
+		{&TestRequiredGroupFields{Repeatedgroup: []*TestRequiredGroupFields_RepeatedGroup{{}}}, "goproto.proto.test2.TestRequiredGroupFields.repeatedgroup[0].a"},
+	} {
+		err := proto.CheckInitialized(tc.msg)
+		var notSet *runtime.RequiredNotSetError
+		require.ErrorAs(t, err, &notSet)
+		require.Equal(t, tc.path, string(notSet.Message)+"."+notSet.Path)
+		require.ErrorIs(t, err, proto.Error)
+
+		// marshaling without allowing partial messages reports the same error
+		_, err = proto.Marshal(tc.msg)
+		require.ErrorAs(t, err, &notSet)
+		require.Equal(t, tc.path, string(notSet.Message)+"."+notSet.Path)
+	}
+
+	require.NoError(t, proto.CheckInitialized(&TestRequiredForeign{
+		OptionalMessage: &TestRequired{RequiredField: proto.Int32(1)},
+		MapMessage:      map[int32]*TestRequired{1: {RequiredField: proto.Int32(0)}},
+	}))
+	require.NoError(t, proto.CheckInitialized(&TestAllTypes{}))
+}
+
+func TestExtensionRangeUnknown(t *testing.T) {
+	var bz []byte
+	bz = protowire.AppendTag(bz, 1, protowire.BytesType)
+	bz = protowire.AppendString(bz, "name")
+	bz = protowire.AppendTag(bz, 200, protowire.VarintType)
+	bz = protowire.AppendVarint(bz, 42)
+
+	// fields within the extension range not matching a known extension are preserved as unknown fields
+	msg := &TestExtensionRange{}
+	require.NoError(t, proto.Unmarshal(bz, msg))
+	require.Equal(t, "name", msg.GetName())
+	require.Equal(t, bz[6:], []byte(msg.ProtoReflect().GetUnknown()))
+
+	got, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	require.Equal(t, bz, got)
+}
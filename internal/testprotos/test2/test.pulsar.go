@@ -0,0 +1,25263 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package test2
+
+import (
+	bytes "bytes"
+	binary "encoding/binary"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	maps "maps"
+	math "math"
+	reflect "reflect"
+	slices "slices"
+	sort "sort"
+	strconv "strconv"
+	sync "sync"
+	atomic "sync/atomic"
+	unsafe "unsafe"
+)
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestAllTypes) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestAllTypes) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.DefaultBool != nil {
+		v := *x.DefaultBool
+		b = append(b, "\"default_bool\":"...)
+		b = strconv.AppendBool(b, v)
+		b = append(b, ',')
+	}
+	if x.DefaultBytes != nil {
+		b = append(b, "\"default_bytes\":"...)
+		b = runtime.AppendJSONBytes(b, x.DefaultBytes)
+		b = append(b, ',')
+	}
+	if x.DefaultDouble != nil {
+		v := *x.DefaultDouble
+		b = append(b, "\"default_double\":"...)
+		if b, err = runtime.AppendAminoJSONFloat(b, v, 64); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.DefaultFixed32 != nil {
+		v := *x.DefaultFixed32
+		b = append(b, "\"default_fixed32\":"...)
+		b = strconv.AppendUint(b, uint64(v), 10)
+		b = append(b, ',')
+	}
+	if x.DefaultFixed64 != nil {
+		v := *x.DefaultFixed64
+		b = append(b, "\"default_fixed64\":"...)
+		b = runtime.AppendJSONUint64(b, v)
+		b = append(b, ',')
+	}
+	if x.DefaultFloat != nil {
+		v := *x.DefaultFloat
+		b = append(b, "\"default_float\":"...)
+		if b, err = runtime.AppendAminoJSONFloat(b, float64(v), 32); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.DefaultForeignEnum != nil {
+		v := *x.DefaultForeignEnum
+		b = append(b, "\"default_foreign_enum\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.DefaultInt32 != nil {
+		v := *x.DefaultInt32
+		b = append(b, "\"default_int32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.DefaultInt64 != nil {
+		v := *x.DefaultInt64
+		b = append(b, "\"default_int64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.DefaultNestedEnum != nil {
+		v := *x.DefaultNestedEnum
+		b = append(b, "\"default_nested_enum\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.DefaultSfixed32 != nil {
+		v := *x.DefaultSfixed32
+		b = append(b, "\"default_sfixed32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.DefaultSfixed64 != nil {
+		v := *x.DefaultSfixed64
+		b = append(b, "\"default_sfixed64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.DefaultSint32 != nil {
+		v := *x.DefaultSint32
+		b = append(b, "\"default_sint32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.DefaultSint64 != nil {
+		v := *x.DefaultSint64
+		b = append(b, "\"default_sint64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.DefaultString != nil {
+		v := *x.DefaultString
+		b = append(b, "\"default_string\":"...)
+		b = runtime.AppendAminoJSONString(b, v)
+		b = append(b, ',')
+	}
+	if x.DefaultUint32 != nil {
+		v := *x.DefaultUint32
+		b = append(b, "\"default_uint32\":"...)
+		b = strconv.AppendUint(b, uint64(v), 10)
+		b = append(b, ',')
+	}
+	if x.DefaultUint64 != nil {
+		v := *x.DefaultUint64
+		b = append(b, "\"default_uint64\":"...)
+		b = runtime.AppendJSONUint64(b, v)
+		b = append(b, ',')
+	}
+	if len(x.MapInt32Int32) > 0 {
+		b = append(b, "\"map_int32_int32\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.MapInt32Int32) {
+			v := x.MapInt32Int32[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapSint64Sint64) > 0 {
+		b = append(b, "\"map_sint64_sint64\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.MapSint64Sint64) {
+			v := x.MapSint64Sint64[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringBytes) > 0 {
+		b = append(b, "\"map_string_bytes\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringBytes)) {
+			v := x.MapStringBytes[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			b = runtime.AppendJSONBytes(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringNestedEnum) > 0 {
+		b = append(b, "\"map_string_nested_enum\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringNestedEnum)) {
+			v := x.MapStringNestedEnum[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringNestedMessage) > 0 {
+		b = append(b, "\"map_string_nested_message\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringNestedMessage)) {
+			v := x.MapStringNestedMessage[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringString) > 0 {
+		b = append(b, "\"map_string_string\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringString)) {
+			v := x.MapStringString[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			b = runtime.AppendAminoJSONString(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofBool); ok {
+		b = append(b, "\"oneof_bool\":"...)
+		b = strconv.AppendBool(b, v.OneofBool)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofBytes); ok {
+		b = append(b, "\"oneof_bytes\":"...)
+		b = runtime.AppendJSONBytes(b, v.OneofBytes)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofDefaults.(*TestAllTypes_OneofDefaultSint32); ok {
+		b = append(b, "\"oneof_default_sint32\":"...)
+		b = strconv.AppendInt(b, int64(v.OneofDefaultSint32), 10)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofDefaults.(*TestAllTypes_OneofDefaultString); ok {
+		b = append(b, "\"oneof_default_string\":"...)
+		b = runtime.AppendAminoJSONString(b, v.OneofDefaultString)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofDouble); ok {
+		b = append(b, "\"oneof_double\":"...)
+		if b, err = runtime.AppendAminoJSONFloat(b, v.OneofDouble, 64); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofEnum); ok {
+		b = append(b, "\"oneof_enum\":"...)
+		b = strconv.AppendInt(b, int64(v.OneofEnum), 10)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofFloat); ok {
+		b = append(b, "\"oneof_float\":"...)
+		if b, err = runtime.AppendAminoJSONFloat(b, float64(v.OneofFloat), 32); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); ok {
+		b = append(b, "\"oneof_nested_message\":"...)
+		if b, err = v.OneofNestedMessage.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofOptional.(*TestAllTypes_OneofOptionalUint32); ok {
+		b = append(b, "\"oneof_optional_uint32\":"...)
+		b = strconv.AppendUint(b, uint64(v.OneofOptionalUint32), 10)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofString); ok {
+		b = append(b, "\"oneof_string\":"...)
+		b = runtime.AppendAminoJSONString(b, v.OneofString)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofUint32); ok {
+		b = append(b, "\"oneof_uint32\":"...)
+		b = strconv.AppendUint(b, uint64(v.OneofUint32), 10)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofUint64); ok {
+		b = append(b, "\"oneof_uint64\":"...)
+		b = runtime.AppendJSONUint64(b, v.OneofUint64)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_Oneofgroup); ok {
+		b = append(b, "\"oneofgroup\":"...)
+		if b, err = v.Oneofgroup.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalBool != nil {
+		v := *x.OptionalBool
+		b = append(b, "\"optional_bool\":"...)
+		b = strconv.AppendBool(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalBytes != nil {
+		b = append(b, "\"optional_bytes\":"...)
+		b = runtime.AppendJSONBytes(b, x.OptionalBytes)
+		b = append(b, ',')
+	}
+	if x.OptionalDouble != nil {
+		v := *x.OptionalDouble
+		b = append(b, "\"optional_double\":"...)
+		if b, err = runtime.AppendAminoJSONFloat(b, v, 64); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalFixed32 != nil {
+		v := *x.OptionalFixed32
+		b = append(b, "\"optional_fixed32\":"...)
+		b = strconv.AppendUint(b, uint64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalFixed64 != nil {
+		v := *x.OptionalFixed64
+		b = append(b, "\"optional_fixed64\":"...)
+		b = runtime.AppendJSONUint64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalFloat != nil {
+		v := *x.OptionalFloat
+		b = append(b, "\"optional_float\":"...)
+		if b, err = runtime.AppendAminoJSONFloat(b, float64(v), 32); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalForeignEnum != nil {
+		v := *x.OptionalForeignEnum
+		b = append(b, "\"optional_foreign_enum\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalForeignMessage != nil {
+		b = append(b, "\"optional_foreign_message\":"...)
+		if b, err = x.OptionalForeignMessage.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalInt32 != nil {
+		v := *x.OptionalInt32
+		b = append(b, "\"optional_int32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalInt64 != nil {
+		v := *x.OptionalInt64
+		b = append(b, "\"optional_int64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalNestedEnum != nil {
+		v := *x.OptionalNestedEnum
+		b = append(b, "\"optional_nested_enum\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalNestedMessage != nil {
+		b = append(b, "\"optional_nested_message\":"...)
+		if b, err = x.OptionalNestedMessage.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalSfixed32 != nil {
+		v := *x.OptionalSfixed32
+		b = append(b, "\"optional_sfixed32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalSfixed64 != nil {
+		v := *x.OptionalSfixed64
+		b = append(b, "\"optional_sfixed64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalSint32 != nil {
+		v := *x.OptionalSint32
+		b = append(b, "\"optional_sint32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalSint64 != nil {
+		v := *x.OptionalSint64
+		b = append(b, "\"optional_sint64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalString != nil {
+		v := *x.OptionalString
+		b = append(b, "\"optional_string\":"...)
+		b = runtime.AppendAminoJSONString(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalUint32 != nil {
+		v := *x.OptionalUint32
+		b = append(b, "\"optional_uint32\":"...)
+		b = strconv.AppendUint(b, uint64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalUint64 != nil {
+		v := *x.OptionalUint64
+		b = append(b, "\"optional_uint64\":"...)
+		b = runtime.AppendJSONUint64(b, v)
+		b = append(b, ',')
+	}
+	if x.Optionalgroup != nil {
+		b = append(b, "\"optionalgroup\":"...)
+		if b, err = x.Optionalgroup.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.PackedBool) > 0 {
+		b = append(b, "\"packed_bool\":"...)
+		b = append(b, '[')
+		for _, v := range x.PackedBool {
+			b = strconv.AppendBool(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.PackedDouble) > 0 {
+		b = append(b, "\"packed_double\":"...)
+		b = append(b, '[')
+		for _, v := range x.PackedDouble {
+			if b, err = runtime.AppendAminoJSONFloat(b, v, 64); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.PackedInt32) > 0 {
+		b = append(b, "\"packed_int32\":"...)
+		b = append(b, '[')
+		for _, v := range x.PackedInt32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.PackedSint64) > 0 {
+		b = append(b, "\"packed_sint64\":"...)
+		b = append(b, '[')
+		for _, v := range x.PackedSint64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedBool) > 0 {
+		b = append(b, "\"repeated_bool\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedBool {
+			b = strconv.AppendBool(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedBytes) > 0 {
+		b = append(b, "\"repeated_bytes\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedBytes {
+			b = runtime.AppendJSONBytes(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedDouble) > 0 {
+		b = append(b, "\"repeated_double\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedDouble {
+			if b, err = runtime.AppendAminoJSONFloat(b, v, 64); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFixed32) > 0 {
+		b = append(b, "\"repeated_fixed32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFixed32 {
+			b = strconv.AppendUint(b, uint64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFixed64) > 0 {
+		b = append(b, "\"repeated_fixed64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFixed64 {
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFloat) > 0 {
+		b = append(b, "\"repeated_float\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFloat {
+			if b, err = runtime.AppendAminoJSONFloat(b, float64(v), 32); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedForeignEnum) > 0 {
+		b = append(b, "\"repeated_foreign_enum\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedForeignEnum {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedForeignMessage) > 0 {
+		b = append(b, "\"repeated_foreign_message\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedForeignMessage {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedInt32) > 0 {
+		b = append(b, "\"repeated_int32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedInt32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedInt64) > 0 {
+		b = append(b, "\"repeated_int64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedInt64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedNestedEnum) > 0 {
+		b = append(b, "\"repeated_nested_enum\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedNestedEnum {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedNestedMessage) > 0 {
+		b = append(b, "\"repeated_nested_message\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedNestedMessage {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSfixed32) > 0 {
+		b = append(b, "\"repeated_sfixed32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSfixed32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSfixed64) > 0 {
+		b = append(b, "\"repeated_sfixed64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSfixed64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSint32) > 0 {
+		b = append(b, "\"repeated_sint32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSint32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSint64) > 0 {
+		b = append(b, "\"repeated_sint64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSint64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedString) > 0 {
+		b = append(b, "\"repeated_string\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedString {
+			b = runtime.AppendAminoJSONString(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedUint32) > 0 {
+		b = append(b, "\"repeated_uint32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedUint32 {
+			b = strconv.AppendUint(b, uint64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedUint64) > 0 {
+		b = append(b, "\"repeated_uint64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedUint64 {
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.Repeatedgroup) > 0 {
+		b = append(b, "\"repeatedgroup\":"...)
+		b = append(b, '[')
+		for _, v := range x.Repeatedgroup {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestAllTypes_NestedMessage) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestAllTypes_NestedMessage) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.A != nil {
+		v := *x.A
+		b = append(b, "\"a\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.Corecursive != nil {
+		b = append(b, "\"corecursive\":"...)
+		if b, err = x.Corecursive.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestAllTypes_OptionalGroup) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestAllTypes_OptionalGroup) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.A != nil {
+		v := *x.A
+		b = append(b, "\"a\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalNestedMessage != nil {
+		b = append(b, "\"optional_nested_message\":"...)
+		if b, err = x.OptionalNestedMessage.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestAllTypes_RepeatedGroup) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestAllTypes_RepeatedGroup) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.A != nil {
+		v := *x.A
+		b = append(b, "\"a\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestAllTypes_OneofGroup) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestAllTypes_OneofGroup) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.A != nil {
+		v := *x.A
+		b = append(b, "\"a\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.B != nil {
+		v := *x.B
+		b = append(b, "\"b\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *ForeignMessage) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *ForeignMessage) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.C != nil {
+		v := *x.C
+		b = append(b, "\"c\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.D != nil {
+		v := *x.D
+		b = append(b, "\"d\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestExtensionRange) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestExtensionRange) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.Name != nil {
+		v := *x.Name
+		b = append(b, "\"name\":"...)
+		b = runtime.AppendAminoJSONString(b, v)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestRequired) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestRequired) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.OptionalField != nil {
+		v := *x.OptionalField
+		b = append(b, "\"optional_field\":"...)
+		b = runtime.AppendAminoJSONString(b, v)
+		b = append(b, ',')
+	}
+	if x.RequiredField != nil {
+		v := *x.RequiredField
+		b = append(b, "\"required_field\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestRequiredForeign) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestRequiredForeign) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if len(x.MapMessage) > 0 {
+		b = append(b, "\"map_message\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.MapMessage) {
+			v := x.MapMessage[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestRequiredForeign_OneofMessage); ok {
+		b = append(b, "\"oneof_message\":"...)
+		if b, err = v.OneofMessage.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalMessage != nil {
+		b = append(b, "\"optional_message\":"...)
+		if b, err = x.OptionalMessage.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.RepeatedMessage) > 0 {
+		b = append(b, "\"repeated_message\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedMessage {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestRequiredGroupFields) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestRequiredGroupFields) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Optionalgroup != nil {
+		b = append(b, "\"optionalgroup\":"...)
+		if b, err = x.Optionalgroup.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.Repeatedgroup) > 0 {
+		b = append(b, "\"repeatedgroup\":"...)
+		b = append(b, '[')
+		for _, v := range x.Repeatedgroup {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestRequiredGroupFields_OptionalGroup) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestRequiredGroupFields_OptionalGroup) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.A != nil {
+		v := *x.A
+		b = append(b, "\"a\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestRequiredGroupFields_RepeatedGroup) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestRequiredGroupFields_RepeatedGroup) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.A != nil {
+		v := *x.A
+		b = append(b, "\"a\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_31_list)(nil)
+
+type _TestAllTypes_31_list struct {
+	list *[]int32
+}
+
+func (x *_TestAllTypes_31_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_31_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt32((*x.list)[i])
+}
+
+func (x *_TestAllTypes_31_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_31_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_31_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedInt32 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_31_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_31_list) NewElement() protoreflect.Value {
+	v := int32(0)
+	return protoreflect.ValueOfInt32(v)
+}
+
+func (x *_TestAllTypes_31_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_32_list)(nil)
+
+type _TestAllTypes_32_list struct {
+	list *[]int64
+}
+
+func (x *_TestAllTypes_32_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_32_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
+
+func (x *_TestAllTypes_32_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_32_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_32_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedInt64 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_32_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_32_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_TestAllTypes_32_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_33_list)(nil)
+
+type _TestAllTypes_33_list struct {
+	list *[]uint32
+}
+
+func (x *_TestAllTypes_33_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_33_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint32((*x.list)[i])
+}
+
+func (x *_TestAllTypes_33_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_33_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_33_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedUint32 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_33_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_33_list) NewElement() protoreflect.Value {
+	v := uint32(0)
+	return protoreflect.ValueOfUint32(v)
+}
+
+func (x *_TestAllTypes_33_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_34_list)(nil)
+
+type _TestAllTypes_34_list struct {
+	list *[]uint64
+}
+
+func (x *_TestAllTypes_34_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_34_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_TestAllTypes_34_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_34_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_34_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedUint64 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_34_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_34_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_TestAllTypes_34_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_35_list)(nil)
+
+type _TestAllTypes_35_list struct {
+	list *[]int32
+}
+
+func (x *_TestAllTypes_35_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_35_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt32((*x.list)[i])
+}
+
+func (x *_TestAllTypes_35_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_35_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_35_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedSint32 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_35_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_35_list) NewElement() protoreflect.Value {
+	v := int32(0)
+	return protoreflect.ValueOfInt32(v)
+}
+
+func (x *_TestAllTypes_35_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_36_list)(nil)
+
+type _TestAllTypes_36_list struct {
+	list *[]int64
+}
+
+func (x *_TestAllTypes_36_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_36_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
+
+func (x *_TestAllTypes_36_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_36_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_36_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedSint64 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_36_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_36_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_TestAllTypes_36_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_37_list)(nil)
+
+type _TestAllTypes_37_list struct {
+	list *[]uint32
+}
+
+func (x *_TestAllTypes_37_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_37_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint32((*x.list)[i])
+}
+
+func (x *_TestAllTypes_37_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_37_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_37_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedFixed32 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_37_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_37_list) NewElement() protoreflect.Value {
+	v := uint32(0)
+	return protoreflect.ValueOfUint32(v)
+}
+
+func (x *_TestAllTypes_37_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_38_list)(nil)
+
+type _TestAllTypes_38_list struct {
+	list *[]uint64
+}
+
+func (x *_TestAllTypes_38_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_38_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_TestAllTypes_38_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_38_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_38_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedFixed64 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_38_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_38_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_TestAllTypes_38_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_39_list)(nil)
+
+type _TestAllTypes_39_list struct {
+	list *[]int32
+}
+
+func (x *_TestAllTypes_39_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_39_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt32((*x.list)[i])
+}
+
+func (x *_TestAllTypes_39_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_39_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_39_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedSfixed32 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_39_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_39_list) NewElement() protoreflect.Value {
+	v := int32(0)
+	return protoreflect.ValueOfInt32(v)
+}
+
+func (x *_TestAllTypes_39_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_40_list)(nil)
+
+type _TestAllTypes_40_list struct {
+	list *[]int64
+}
+
+func (x *_TestAllTypes_40_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_40_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
+
+func (x *_TestAllTypes_40_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_40_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_40_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedSfixed64 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_40_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_40_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_TestAllTypes_40_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_41_list)(nil)
+
+type _TestAllTypes_41_list struct {
+	list *[]float32
+}
+
+func (x *_TestAllTypes_41_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_41_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfFloat32((*x.list)[i])
+}
+
+func (x *_TestAllTypes_41_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Float()
+	concreteValue := (float32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_41_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Float()
+	concreteValue := (float32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_41_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedFloat as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_41_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_41_list) NewElement() protoreflect.Value {
+	v := float32(0)
+	return protoreflect.ValueOfFloat32(v)
+}
+
+func (x *_TestAllTypes_41_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_42_list)(nil)
+
+type _TestAllTypes_42_list struct {
+	list *[]float64
+}
+
+func (x *_TestAllTypes_42_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_42_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfFloat64((*x.list)[i])
+}
+
+func (x *_TestAllTypes_42_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Float()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_42_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Float()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_42_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedDouble as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_42_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_42_list) NewElement() protoreflect.Value {
+	v := float64(0)
+	return protoreflect.ValueOfFloat64(v)
+}
+
+func (x *_TestAllTypes_42_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_43_list)(nil)
+
+type _TestAllTypes_43_list struct {
+	list *[]bool
+}
+
+func (x *_TestAllTypes_43_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_43_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBool((*x.list)[i])
+}
+
+func (x *_TestAllTypes_43_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bool()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_43_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bool()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_43_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedBool as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_43_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_43_list) NewElement() protoreflect.Value {
+	v := false
+	return protoreflect.ValueOfBool(v)
+}
+
+func (x *_TestAllTypes_43_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_44_list)(nil)
+
+type _TestAllTypes_44_list struct {
+	list *[]string
+}
+
+func (x *_TestAllTypes_44_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_44_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TestAllTypes_44_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_44_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_44_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedString as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_44_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_44_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TestAllTypes_44_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_45_list)(nil)
+
+type _TestAllTypes_45_list struct {
+	list *[][]byte
+}
+
+func (x *_TestAllTypes_45_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_45_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_TestAllTypes_45_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_45_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_45_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedBytes as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_45_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_45_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_TestAllTypes_45_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_46_list)(nil)
+
+type _TestAllTypes_46_list struct {
+	list *[]*TestAllTypes_RepeatedGroup
+}
+
+func (x *_TestAllTypes_46_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_46_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TestAllTypes_46_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TestAllTypes_RepeatedGroup)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_46_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TestAllTypes_RepeatedGroup)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_46_list) AppendMutable() protoreflect.Value {
+	v := new(TestAllTypes_RepeatedGroup)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestAllTypes_46_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_46_list) NewElement() protoreflect.Value {
+	v := new(TestAllTypes_RepeatedGroup)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestAllTypes_46_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_48_list)(nil)
+
+type _TestAllTypes_48_list struct {
+	list *[]*TestAllTypes_NestedMessage
+}
+
+func (x *_TestAllTypes_48_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_48_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TestAllTypes_48_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TestAllTypes_NestedMessage)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_48_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TestAllTypes_NestedMessage)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_48_list) AppendMutable() protoreflect.Value {
+	v := new(TestAllTypes_NestedMessage)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestAllTypes_48_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_48_list) NewElement() protoreflect.Value {
+	v := new(TestAllTypes_NestedMessage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestAllTypes_48_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_49_list)(nil)
+
+type _TestAllTypes_49_list struct {
+	list *[]*ForeignMessage
+}
+
+func (x *_TestAllTypes_49_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_49_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TestAllTypes_49_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ForeignMessage)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_49_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ForeignMessage)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_49_list) AppendMutable() protoreflect.Value {
+	v := new(ForeignMessage)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestAllTypes_49_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_49_list) NewElement() protoreflect.Value {
+	v := new(ForeignMessage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestAllTypes_49_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_51_list)(nil)
+
+type _TestAllTypes_51_list struct {
+	list *[]TestAllTypes_NestedEnum
+}
+
+func (x *_TestAllTypes_51_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_51_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
+}
+
+func (x *_TestAllTypes_51_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (TestAllTypes_NestedEnum)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_51_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (TestAllTypes_NestedEnum)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_51_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedNestedEnum as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_51_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_51_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_TestAllTypes_51_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_52_list)(nil)
+
+type _TestAllTypes_52_list struct {
+	list *[]ForeignEnum
+}
+
+func (x *_TestAllTypes_52_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_52_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
+}
+
+func (x *_TestAllTypes_52_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (ForeignEnum)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_52_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (ForeignEnum)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_52_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedForeignEnum as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_52_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_52_list) NewElement() protoreflect.Value {
+	v := 4
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_TestAllTypes_52_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_53_list)(nil)
+
+type _TestAllTypes_53_list struct {
+	list *[]int32
+}
+
+func (x *_TestAllTypes_53_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_53_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt32((*x.list)[i])
+}
+
+func (x *_TestAllTypes_53_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_53_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_53_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field PackedInt32 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_53_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_53_list) NewElement() protoreflect.Value {
+	v := int32(0)
+	return protoreflect.ValueOfInt32(v)
+}
+
+func (x *_TestAllTypes_53_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_54_list)(nil)
+
+type _TestAllTypes_54_list struct {
+	list *[]int64
+}
+
+func (x *_TestAllTypes_54_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_54_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
+
+func (x *_TestAllTypes_54_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_54_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_54_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field PackedSint64 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_54_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_54_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_TestAllTypes_54_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_55_list)(nil)
+
+type _TestAllTypes_55_list struct {
+	list *[]float64
+}
+
+func (x *_TestAllTypes_55_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_55_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfFloat64((*x.list)[i])
+}
+
+func (x *_TestAllTypes_55_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Float()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_55_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Float()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_55_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field PackedDouble as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_55_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_55_list) NewElement() protoreflect.Value {
+	v := float64(0)
+	return protoreflect.ValueOfFloat64(v)
+}
+
+func (x *_TestAllTypes_55_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_57_list)(nil)
+
+type _TestAllTypes_57_list struct {
+	list *[]bool
+}
+
+func (x *_TestAllTypes_57_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_57_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBool((*x.list)[i])
+}
+
+func (x *_TestAllTypes_57_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bool()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_57_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bool()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_57_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field PackedBool as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_57_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_57_list) NewElement() protoreflect.Value {
+	v := false
+	return protoreflect.ValueOfBool(v)
+}
+
+func (x *_TestAllTypes_57_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.Map = (*_TestAllTypes_56_map)(nil)
+
+type _TestAllTypes_56_map struct {
+	m *map[int32]int32
+}
+
+func (x *_TestAllTypes_56_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_TestAllTypes_56_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfInt32(k))
+		mapValue := protoreflect.ValueOfInt32(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_TestAllTypes_56_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.Int()
+	concreteValue := (int32)(keyUnwrapped)
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_TestAllTypes_56_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := (int32)(keyUnwrapped)
+	delete(*x.m, concreteKey)
+}
+
+func (x *_TestAllTypes_56_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := (int32)(keyUnwrapped)
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfInt32(v)
+}
+
+func (x *_TestAllTypes_56_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := (int32)(keyUnwrapped)
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_TestAllTypes_56_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_TestAllTypes_56_map) NewValue() protoreflect.Value {
+	v := int32(0)
+	return protoreflect.ValueOfInt32(v)
+}
+
+func (x *_TestAllTypes_56_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.Map = (*_TestAllTypes_61_map)(nil)
+
+type _TestAllTypes_61_map struct {
+	m *map[int64]int64
+}
+
+func (x *_TestAllTypes_61_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_TestAllTypes_61_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfInt64(k))
+		mapValue := protoreflect.ValueOfInt64(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_TestAllTypes_61_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.Int()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_TestAllTypes_61_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_TestAllTypes_61_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_TestAllTypes_61_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_TestAllTypes_61_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_TestAllTypes_61_map) NewValue() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_TestAllTypes_61_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.Map = (*_TestAllTypes_69_map)(nil)
+
+type _TestAllTypes_69_map struct {
+	m *map[string]string
+}
+
+func (x *_TestAllTypes_69_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_TestAllTypes_69_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfString(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_TestAllTypes_69_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_TestAllTypes_69_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_TestAllTypes_69_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TestAllTypes_69_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_TestAllTypes_69_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_TestAllTypes_69_map) NewValue() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TestAllTypes_69_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.Map = (*_TestAllTypes_70_map)(nil)
+
+type _TestAllTypes_70_map struct {
+	m *map[string][]byte
+}
+
+func (x *_TestAllTypes_70_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_TestAllTypes_70_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfBytes(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_TestAllTypes_70_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_TestAllTypes_70_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_TestAllTypes_70_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_TestAllTypes_70_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_TestAllTypes_70_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_TestAllTypes_70_map) NewValue() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_TestAllTypes_70_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.Map = (*_TestAllTypes_71_map)(nil)
+
+type _TestAllTypes_71_map struct {
+	m *map[string]*TestAllTypes_NestedMessage
+}
+
+func (x *_TestAllTypes_71_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_TestAllTypes_71_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_TestAllTypes_71_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_TestAllTypes_71_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_TestAllTypes_71_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestAllTypes_71_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TestAllTypes_NestedMessage)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_TestAllTypes_71_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
+	}
+	newValue := new(TestAllTypes_NestedMessage)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
+}
+
+func (x *_TestAllTypes_71_map) NewValue() protoreflect.Value {
+	v := new(TestAllTypes_NestedMessage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestAllTypes_71_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.Map = (*_TestAllTypes_73_map)(nil)
+
+type _TestAllTypes_73_map struct {
+	m *map[string]TestAllTypes_NestedEnum
+}
+
+func (x *_TestAllTypes_73_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_TestAllTypes_73_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfEnum(v.Number())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_TestAllTypes_73_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_TestAllTypes_73_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_TestAllTypes_73_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_TestAllTypes_73_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Enum()
+	concreteValue := (TestAllTypes_NestedEnum)(valueUnwrapped)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_TestAllTypes_73_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_TestAllTypes_73_map) NewValue() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_TestAllTypes_73_map) IsValid() bool {
+	return x.m != nil
+}
+
+var (
+	md_TestAllTypes                           protoreflect.MessageDescriptor
+	fd_TestAllTypes_optional_int32            protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_int64            protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_uint32           protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_uint64           protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_sint32           protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_sint64           protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_fixed32          protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_fixed64          protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_sfixed32         protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_sfixed64         protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_float            protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_double           protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_bool             protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_string           protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_bytes            protoreflect.FieldDescriptor
+	fd_TestAllTypes_optionalgroup             protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_nested_message   protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_foreign_message  protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_nested_enum      protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_foreign_enum     protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_int32            protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_int64            protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_uint32           protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_uint64           protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_sint32           protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_sint64           protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_fixed32          protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_fixed64          protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_sfixed32         protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_sfixed64         protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_float            protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_double           protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_bool             protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_string           protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_bytes            protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeatedgroup             protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_nested_message   protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_foreign_message  protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_nested_enum      protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_foreign_enum     protoreflect.FieldDescriptor
+	fd_TestAllTypes_packed_int32              protoreflect.FieldDescriptor
+	fd_TestAllTypes_packed_sint64             protoreflect.FieldDescriptor
+	fd_TestAllTypes_packed_double             protoreflect.FieldDescriptor
+	fd_TestAllTypes_packed_bool               protoreflect.FieldDescriptor
+	fd_TestAllTypes_map_int32_int32           protoreflect.FieldDescriptor
+	fd_TestAllTypes_map_sint64_sint64         protoreflect.FieldDescriptor
+	fd_TestAllTypes_map_string_string         protoreflect.FieldDescriptor
+	fd_TestAllTypes_map_string_bytes          protoreflect.FieldDescriptor
+	fd_TestAllTypes_map_string_nested_message protoreflect.FieldDescriptor
+	fd_TestAllTypes_map_string_nested_enum    protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_int32             protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_int64             protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_uint32            protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_uint64            protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_sint32            protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_sint64            protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_fixed32           protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_fixed64           protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_sfixed32          protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_sfixed64          protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_float             protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_double            protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_bool              protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_string            protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_bytes             protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_nested_enum       protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_foreign_enum      protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_uint32              protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_nested_message      protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_string              protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_bytes               protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_bool                protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_uint64              protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_float               protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_double              protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_enum                protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneofgroup                protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_optional_uint32     protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_default_sint32      protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_default_string      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test2_test_proto_init()
+	md_TestAllTypes = File_internal_testprotos_test2_test_proto.Messages().ByName("TestAllTypes")
+	fd_TestAllTypes_optional_int32 = md_TestAllTypes.Fields().ByName("optional_int32")
+	fd_TestAllTypes_optional_int64 = md_TestAllTypes.Fields().ByName("optional_int64")
+	fd_TestAllTypes_optional_uint32 = md_TestAllTypes.Fields().ByName("optional_uint32")
+	fd_TestAllTypes_optional_uint64 = md_TestAllTypes.Fields().ByName("optional_uint64")
+	fd_TestAllTypes_optional_sint32 = md_TestAllTypes.Fields().ByName("optional_sint32")
+	fd_TestAllTypes_optional_sint64 = md_TestAllTypes.Fields().ByName("optional_sint64")
+	fd_TestAllTypes_optional_fixed32 = md_TestAllTypes.Fields().ByName("optional_fixed32")
+	fd_TestAllTypes_optional_fixed64 = md_TestAllTypes.Fields().ByName("optional_fixed64")
+	fd_TestAllTypes_optional_sfixed32 = md_TestAllTypes.Fields().ByName("optional_sfixed32")
+	fd_TestAllTypes_optional_sfixed64 = md_TestAllTypes.Fields().ByName("optional_sfixed64")
+	fd_TestAllTypes_optional_float = md_TestAllTypes.Fields().ByName("optional_float")
+	fd_TestAllTypes_optional_double = md_TestAllTypes.Fields().ByName("optional_double")
+	fd_TestAllTypes_optional_bool = md_TestAllTypes.Fields().ByName("optional_bool")
+	fd_TestAllTypes_optional_string = md_TestAllTypes.Fields().ByName("optional_string")
+	fd_TestAllTypes_optional_bytes = md_TestAllTypes.Fields().ByName("optional_bytes")
+	fd_TestAllTypes_optionalgroup = md_TestAllTypes.Fields().ByName("optionalgroup")
+	fd_TestAllTypes_optional_nested_message = md_TestAllTypes.Fields().ByName("optional_nested_message")
+	fd_TestAllTypes_optional_foreign_message = md_TestAllTypes.Fields().ByName("optional_foreign_message")
+	fd_TestAllTypes_optional_nested_enum = md_TestAllTypes.Fields().ByName("optional_nested_enum")
+	fd_TestAllTypes_optional_foreign_enum = md_TestAllTypes.Fields().ByName("optional_foreign_enum")
+	fd_TestAllTypes_repeated_int32 = md_TestAllTypes.Fields().ByName("repeated_int32")
+	fd_TestAllTypes_repeated_int64 = md_TestAllTypes.Fields().ByName("repeated_int64")
+	fd_TestAllTypes_repeated_uint32 = md_TestAllTypes.Fields().ByName("repeated_uint32")
+	fd_TestAllTypes_repeated_uint64 = md_TestAllTypes.Fields().ByName("repeated_uint64")
+	fd_TestAllTypes_repeated_sint32 = md_TestAllTypes.Fields().ByName("repeated_sint32")
+	fd_TestAllTypes_repeated_sint64 = md_TestAllTypes.Fields().ByName("repeated_sint64")
+	fd_TestAllTypes_repeated_fixed32 = md_TestAllTypes.Fields().ByName("repeated_fixed32")
+	fd_TestAllTypes_repeated_fixed64 = md_TestAllTypes.Fields().ByName("repeated_fixed64")
+	fd_TestAllTypes_repeated_sfixed32 = md_TestAllTypes.Fields().ByName("repeated_sfixed32")
+	fd_TestAllTypes_repeated_sfixed64 = md_TestAllTypes.Fields().ByName("repeated_sfixed64")
+	fd_TestAllTypes_repeated_float = md_TestAllTypes.Fields().ByName("repeated_float")
+	fd_TestAllTypes_repeated_double = md_TestAllTypes.Fields().ByName("repeated_double")
+	fd_TestAllTypes_repeated_bool = md_TestAllTypes.Fields().ByName("repeated_bool")
+	fd_TestAllTypes_repeated_string = md_TestAllTypes.Fields().ByName("repeated_string")
+	fd_TestAllTypes_repeated_bytes = md_TestAllTypes.Fields().ByName("repeated_bytes")
+	fd_TestAllTypes_repeatedgroup = md_TestAllTypes.Fields().ByName("repeatedgroup")
+	fd_TestAllTypes_repeated_nested_message = md_TestAllTypes.Fields().ByName("repeated_nested_message")
+	fd_TestAllTypes_repeated_foreign_message = md_TestAllTypes.Fields().ByName("repeated_foreign_message")
+	fd_TestAllTypes_repeated_nested_enum = md_TestAllTypes.Fields().ByName("repeated_nested_enum")
+	fd_TestAllTypes_repeated_foreign_enum = md_TestAllTypes.Fields().ByName("repeated_foreign_enum")
+	fd_TestAllTypes_packed_int32 = md_TestAllTypes.Fields().ByName("packed_int32")
+	fd_TestAllTypes_packed_sint64 = md_TestAllTypes.Fields().ByName("packed_sint64")
+	fd_TestAllTypes_packed_double = md_TestAllTypes.Fields().ByName("packed_double")
+	fd_TestAllTypes_packed_bool = md_TestAllTypes.Fields().ByName("packed_bool")
+	fd_TestAllTypes_map_int32_int32 = md_TestAllTypes.Fields().ByName("map_int32_int32")
+	fd_TestAllTypes_map_sint64_sint64 = md_TestAllTypes.Fields().ByName("map_sint64_sint64")
+	fd_TestAllTypes_map_string_string = md_TestAllTypes.Fields().ByName("map_string_string")
+	fd_TestAllTypes_map_string_bytes = md_TestAllTypes.Fields().ByName("map_string_bytes")
+	fd_TestAllTypes_map_string_nested_message = md_TestAllTypes.Fields().ByName("map_string_nested_message")
+	fd_TestAllTypes_map_string_nested_enum = md_TestAllTypes.Fields().ByName("map_string_nested_enum")
+	fd_TestAllTypes_default_int32 = md_TestAllTypes.Fields().ByName("default_int32")
+	fd_TestAllTypes_default_int64 = md_TestAllTypes.Fields().ByName("default_int64")
+	fd_TestAllTypes_default_uint32 = md_TestAllTypes.Fields().ByName("default_uint32")
+	fd_TestAllTypes_default_uint64 = md_TestAllTypes.Fields().ByName("default_uint64")
+	fd_TestAllTypes_default_sint32 = md_TestAllTypes.Fields().ByName("default_sint32")
+	fd_TestAllTypes_default_sint64 = md_TestAllTypes.Fields().ByName("default_sint64")
+	fd_TestAllTypes_default_fixed32 = md_TestAllTypes.Fields().ByName("default_fixed32")
+	fd_TestAllTypes_default_fixed64 = md_TestAllTypes.Fields().ByName("default_fixed64")
+	fd_TestAllTypes_default_sfixed32 = md_TestAllTypes.Fields().ByName("default_sfixed32")
+	fd_TestAllTypes_default_sfixed64 = md_TestAllTypes.Fields().ByName("default_sfixed64")
+	fd_TestAllTypes_default_float = md_TestAllTypes.Fields().ByName("default_float")
+	fd_TestAllTypes_default_double = md_TestAllTypes.Fields().ByName("default_double")
+	fd_TestAllTypes_default_bool = md_TestAllTypes.Fields().ByName("default_bool")
+	fd_TestAllTypes_default_string = md_TestAllTypes.Fields().ByName("default_string")
+	fd_TestAllTypes_default_bytes = md_TestAllTypes.Fields().ByName("default_bytes")
+	fd_TestAllTypes_default_nested_enum = md_TestAllTypes.Fields().ByName("default_nested_enum")
+	fd_TestAllTypes_default_foreign_enum = md_TestAllTypes.Fields().ByName("default_foreign_enum")
+	fd_TestAllTypes_oneof_uint32 = md_TestAllTypes.Fields().ByName("oneof_uint32")
+	fd_TestAllTypes_oneof_nested_message = md_TestAllTypes.Fields().ByName("oneof_nested_message")
+	fd_TestAllTypes_oneof_string = md_TestAllTypes.Fields().ByName("oneof_string")
+	fd_TestAllTypes_oneof_bytes = md_TestAllTypes.Fields().ByName("oneof_bytes")
+	fd_TestAllTypes_oneof_bool = md_TestAllTypes.Fields().ByName("oneof_bool")
+	fd_TestAllTypes_oneof_uint64 = md_TestAllTypes.Fields().ByName("oneof_uint64")
+	fd_TestAllTypes_oneof_float = md_TestAllTypes.Fields().ByName("oneof_float")
+	fd_TestAllTypes_oneof_double = md_TestAllTypes.Fields().ByName("oneof_double")
+	fd_TestAllTypes_oneof_enum = md_TestAllTypes.Fields().ByName("oneof_enum")
+	fd_TestAllTypes_oneofgroup = md_TestAllTypes.Fields().ByName("oneofgroup")
+	fd_TestAllTypes_oneof_optional_uint32 = md_TestAllTypes.Fields().ByName("oneof_optional_uint32")
+	fd_TestAllTypes_oneof_default_sint32 = md_TestAllTypes.Fields().ByName("oneof_default_sint32")
+	fd_TestAllTypes_oneof_default_string = md_TestAllTypes.Fields().ByName("oneof_default_string")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestAllTypes)(nil)
+
+type fastReflection_TestAllTypes TestAllTypes
+
+func (x *TestAllTypes) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestAllTypes)(x)
+}
+
+func (x *TestAllTypes) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test2_test_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestAllTypes_messageType fastReflection_TestAllTypes_messageType
+var _ protoreflect.MessageType = fastReflection_TestAllTypes_messageType{}
+
+type fastReflection_TestAllTypes_messageType struct{}
+
+func (x fastReflection_TestAllTypes_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestAllTypes)(nil)
+}
+func (x fastReflection_TestAllTypes_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestAllTypes)
+}
+func (x fastReflection_TestAllTypes_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestAllTypes
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestAllTypes) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestAllTypes
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestAllTypes) Type() protoreflect.MessageType {
+	return _fastReflection_TestAllTypes_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestAllTypes) New() protoreflect.Message {
+	return new(fastReflection_TestAllTypes)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestAllTypes) Interface() protoreflect.ProtoMessage {
+	return (*TestAllTypes)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestAllTypes) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OptionalInt32 != nil {
+		value := protoreflect.ValueOfInt32(*x.OptionalInt32)
+		if !f(fd_TestAllTypes_optional_int32, value) {
+			return
+		}
+	}
+	if x.OptionalInt64 != nil {
+		value := protoreflect.ValueOfInt64(*x.OptionalInt64)
+		if !f(fd_TestAllTypes_optional_int64, value) {
+			return
+		}
+	}
+	if x.OptionalUint32 != nil {
+		value := protoreflect.ValueOfUint32(*x.OptionalUint32)
+		if !f(fd_TestAllTypes_optional_uint32, value) {
+			return
+		}
+	}
+	if x.OptionalUint64 != nil {
+		value := protoreflect.ValueOfUint64(*x.OptionalUint64)
+		if !f(fd_TestAllTypes_optional_uint64, value) {
+			return
+		}
+	}
+	if x.OptionalSint32 != nil {
+		value := protoreflect.ValueOfInt32(*x.OptionalSint32)
+		if !f(fd_TestAllTypes_optional_sint32, value) {
+			return
+		}
+	}
+	if x.OptionalSint64 != nil {
+		value := protoreflect.ValueOfInt64(*x.OptionalSint64)
+		if !f(fd_TestAllTypes_optional_sint64, value) {
+			return
+		}
+	}
+	if x.OptionalFixed32 != nil {
+		value := protoreflect.ValueOfUint32(*x.OptionalFixed32)
+		if !f(fd_TestAllTypes_optional_fixed32, value) {
+			return
+		}
+	}
+	if x.OptionalFixed64 != nil {
+		value := protoreflect.ValueOfUint64(*x.OptionalFixed64)
+		if !f(fd_TestAllTypes_optional_fixed64, value) {
+			return
+		}
+	}
+	if x.OptionalSfixed32 != nil {
+		value := protoreflect.ValueOfInt32(*x.OptionalSfixed32)
+		if !f(fd_TestAllTypes_optional_sfixed32, value) {
+			return
+		}
+	}
+	if x.OptionalSfixed64 != nil {
+		value := protoreflect.ValueOfInt64(*x.OptionalSfixed64)
+		if !f(fd_TestAllTypes_optional_sfixed64, value) {
+			return
+		}
+	}
+	if x.OptionalFloat != nil {
+		value := protoreflect.ValueOfFloat32(*x.OptionalFloat)
+		if !f(fd_TestAllTypes_optional_float, value) {
+			return
+		}
+	}
+	if x.OptionalDouble != nil {
+		value := protoreflect.ValueOfFloat64(*x.OptionalDouble)
+		if !f(fd_TestAllTypes_optional_double, value) {
+			return
+		}
+	}
+	if x.OptionalBool != nil {
+		value := protoreflect.ValueOfBool(*x.OptionalBool)
+		if !f(fd_TestAllTypes_optional_bool, value) {
+			return
+		}
+	}
+	if x.OptionalString != nil {
+		value := protoreflect.ValueOfString(*x.OptionalString)
+		if !f(fd_TestAllTypes_optional_string, value) {
+			return
+		}
+	}
+	if x.OptionalBytes != nil {
+		value := protoreflect.ValueOfBytes(x.OptionalBytes)
+		if !f(fd_TestAllTypes_optional_bytes, value) {
+			return
+		}
+	}
+	if x.Optionalgroup != nil {
+		value := protoreflect.ValueOfMessage(x.Optionalgroup.ProtoReflect())
+		if !f(fd_TestAllTypes_optionalgroup, value) {
+			return
+		}
+	}
+	if x.OptionalNestedMessage != nil {
+		value := protoreflect.ValueOfMessage(x.OptionalNestedMessage.ProtoReflect())
+		if !f(fd_TestAllTypes_optional_nested_message, value) {
+			return
+		}
+	}
+	if x.OptionalForeignMessage != nil {
+		value := protoreflect.ValueOfMessage(x.OptionalForeignMessage.ProtoReflect())
+		if !f(fd_TestAllTypes_optional_foreign_message, value) {
+			return
+		}
+	}
+	if x.OptionalNestedEnum != nil {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(*x.OptionalNestedEnum))
+		if !f(fd_TestAllTypes_optional_nested_enum, value) {
+			return
+		}
+	}
+	if x.OptionalForeignEnum != nil {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(*x.OptionalForeignEnum))
+		if !f(fd_TestAllTypes_optional_foreign_enum, value) {
+			return
+		}
+	}
+	if len(x.RepeatedInt32) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_31_list{list: &x.RepeatedInt32})
+		if !f(fd_TestAllTypes_repeated_int32, value) {
+			return
+		}
+	}
+	if len(x.RepeatedInt64) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_32_list{list: &x.RepeatedInt64})
+		if !f(fd_TestAllTypes_repeated_int64, value) {
+			return
+		}
+	}
+	if len(x.RepeatedUint32) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_33_list{list: &x.RepeatedUint32})
+		if !f(fd_TestAllTypes_repeated_uint32, value) {
+			return
+		}
+	}
+	if len(x.RepeatedUint64) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_34_list{list: &x.RepeatedUint64})
+		if !f(fd_TestAllTypes_repeated_uint64, value) {
+			return
+		}
+	}
+	if len(x.RepeatedSint32) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_35_list{list: &x.RepeatedSint32})
+		if !f(fd_TestAllTypes_repeated_sint32, value) {
+			return
+		}
+	}
+	if len(x.RepeatedSint64) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_36_list{list: &x.RepeatedSint64})
+		if !f(fd_TestAllTypes_repeated_sint64, value) {
+			return
+		}
+	}
+	if len(x.RepeatedFixed32) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_37_list{list: &x.RepeatedFixed32})
+		if !f(fd_TestAllTypes_repeated_fixed32, value) {
+			return
+		}
+	}
+	if len(x.RepeatedFixed64) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_38_list{list: &x.RepeatedFixed64})
+		if !f(fd_TestAllTypes_repeated_fixed64, value) {
+			return
+		}
+	}
+	if len(x.RepeatedSfixed32) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_39_list{list: &x.RepeatedSfixed32})
+		if !f(fd_TestAllTypes_repeated_sfixed32, value) {
+			return
+		}
+	}
+	if len(x.RepeatedSfixed64) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_40_list{list: &x.RepeatedSfixed64})
+		if !f(fd_TestAllTypes_repeated_sfixed64, value) {
+			return
+		}
+	}
+	if len(x.RepeatedFloat) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_41_list{list: &x.RepeatedFloat})
+		if !f(fd_TestAllTypes_repeated_float, value) {
+			return
+		}
+	}
+	if len(x.RepeatedDouble) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_42_list{list: &x.RepeatedDouble})
+		if !f(fd_TestAllTypes_repeated_double, value) {
+			return
+		}
+	}
+	if len(x.RepeatedBool) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_43_list{list: &x.RepeatedBool})
+		if !f(fd_TestAllTypes_repeated_bool, value) {
+			return
+		}
+	}
+	if len(x.RepeatedString) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_44_list{list: &x.RepeatedString})
+		if !f(fd_TestAllTypes_repeated_string, value) {
+			return
+		}
+	}
+	if len(x.RepeatedBytes) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_45_list{list: &x.RepeatedBytes})
+		if !f(fd_TestAllTypes_repeated_bytes, value) {
+			return
+		}
+	}
+	if len(x.Repeatedgroup) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_46_list{list: &x.Repeatedgroup})
+		if !f(fd_TestAllTypes_repeatedgroup, value) {
+			return
+		}
+	}
+	if len(x.RepeatedNestedMessage) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_48_list{list: &x.RepeatedNestedMessage})
+		if !f(fd_TestAllTypes_repeated_nested_message, value) {
+			return
+		}
+	}
+	if len(x.RepeatedForeignMessage) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_49_list{list: &x.RepeatedForeignMessage})
+		if !f(fd_TestAllTypes_repeated_foreign_message, value) {
+			return
+		}
+	}
+	if len(x.RepeatedNestedEnum) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_51_list{list: &x.RepeatedNestedEnum})
+		if !f(fd_TestAllTypes_repeated_nested_enum, value) {
+			return
+		}
+	}
+	if len(x.RepeatedForeignEnum) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_52_list{list: &x.RepeatedForeignEnum})
+		if !f(fd_TestAllTypes_repeated_foreign_enum, value) {
+			return
+		}
+	}
+	if len(x.PackedInt32) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_53_list{list: &x.PackedInt32})
+		if !f(fd_TestAllTypes_packed_int32, value) {
+			return
+		}
+	}
+	if len(x.PackedSint64) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_54_list{list: &x.PackedSint64})
+		if !f(fd_TestAllTypes_packed_sint64, value) {
+			return
+		}
+	}
+	if len(x.PackedDouble) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_55_list{list: &x.PackedDouble})
+		if !f(fd_TestAllTypes_packed_double, value) {
+			return
+		}
+	}
+	if len(x.PackedBool) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_57_list{list: &x.PackedBool})
+		if !f(fd_TestAllTypes_packed_bool, value) {
+			return
+		}
+	}
+	if len(x.MapInt32Int32) != 0 {
+		value := protoreflect.ValueOfMap(&_TestAllTypes_56_map{m: &x.MapInt32Int32})
+		if !f(fd_TestAllTypes_map_int32_int32, value) {
+			return
+		}
+	}
+	if len(x.MapSint64Sint64) != 0 {
+		value := protoreflect.ValueOfMap(&_TestAllTypes_61_map{m: &x.MapSint64Sint64})
+		if !f(fd_TestAllTypes_map_sint64_sint64, value) {
+			return
+		}
+	}
+	if len(x.MapStringString) != 0 {
+		value := protoreflect.ValueOfMap(&_TestAllTypes_69_map{m: &x.MapStringString})
+		if !f(fd_TestAllTypes_map_string_string, value) {
+			return
+		}
+	}
+	if len(x.MapStringBytes) != 0 {
+		value := protoreflect.ValueOfMap(&_TestAllTypes_70_map{m: &x.MapStringBytes})
+		if !f(fd_TestAllTypes_map_string_bytes, value) {
+			return
+		}
+	}
+	if len(x.MapStringNestedMessage) != 0 {
+		value := protoreflect.ValueOfMap(&_TestAllTypes_71_map{m: &x.MapStringNestedMessage})
+		if !f(fd_TestAllTypes_map_string_nested_message, value) {
+			return
+		}
+	}
+	if len(x.MapStringNestedEnum) != 0 {
+		value := protoreflect.ValueOfMap(&_TestAllTypes_73_map{m: &x.MapStringNestedEnum})
+		if !f(fd_TestAllTypes_map_string_nested_enum, value) {
+			return
+		}
+	}
+	if x.DefaultInt32 != nil {
+		value := protoreflect.ValueOfInt32(*x.DefaultInt32)
+		if !f(fd_TestAllTypes_default_int32, value) {
+			return
+		}
+	}
+	if x.DefaultInt64 != nil {
+		value := protoreflect.ValueOfInt64(*x.DefaultInt64)
+		if !f(fd_TestAllTypes_default_int64, value) {
+			return
+		}
+	}
+	if x.DefaultUint32 != nil {
+		value := protoreflect.ValueOfUint32(*x.DefaultUint32)
+		if !f(fd_TestAllTypes_default_uint32, value) {
+			return
+		}
+	}
+	if x.DefaultUint64 != nil {
+		value := protoreflect.ValueOfUint64(*x.DefaultUint64)
+		if !f(fd_TestAllTypes_default_uint64, value) {
+			return
+		}
+	}
+	if x.DefaultSint32 != nil {
+		value := protoreflect.ValueOfInt32(*x.DefaultSint32)
+		if !f(fd_TestAllTypes_default_sint32, value) {
+			return
+		}
+	}
+	if x.DefaultSint64 != nil {
+		value := protoreflect.ValueOfInt64(*x.DefaultSint64)
+		if !f(fd_TestAllTypes_default_sint64, value) {
+			return
+		}
+	}
+	if x.DefaultFixed32 != nil {
+		value := protoreflect.ValueOfUint32(*x.DefaultFixed32)
+		if !f(fd_TestAllTypes_default_fixed32, value) {
+			return
+		}
+	}
+	if x.DefaultFixed64 != nil {
+		value := protoreflect.ValueOfUint64(*x.DefaultFixed64)
+		if !f(fd_TestAllTypes_default_fixed64, value) {
+			return
+		}
+	}
+	if x.DefaultSfixed32 != nil {
+		value := protoreflect.ValueOfInt32(*x.DefaultSfixed32)
+		if !f(fd_TestAllTypes_default_sfixed32, value) {
+			return
+		}
+	}
+	if x.DefaultSfixed64 != nil {
+		value := protoreflect.ValueOfInt64(*x.DefaultSfixed64)
+		if !f(fd_TestAllTypes_default_sfixed64, value) {
+			return
+		}
+	}
+	if x.DefaultFloat != nil {
+		value := protoreflect.ValueOfFloat32(*x.DefaultFloat)
+		if !f(fd_TestAllTypes_default_float, value) {
+			return
+		}
+	}
+	if x.DefaultDouble != nil {
+		value := protoreflect.ValueOfFloat64(*x.DefaultDouble)
+		if !f(fd_TestAllTypes_default_double, value) {
+			return
+		}
+	}
+	if x.DefaultBool != nil {
+		value := protoreflect.ValueOfBool(*x.DefaultBool)
+		if !f(fd_TestAllTypes_default_bool, value) {
+			return
+		}
+	}
+	if x.DefaultString != nil {
+		value := protoreflect.ValueOfString(*x.DefaultString)
+		if !f(fd_TestAllTypes_default_string, value) {
+			return
+		}
+	}
+	if x.DefaultBytes != nil {
+		value := protoreflect.ValueOfBytes(x.DefaultBytes)
+		if !f(fd_TestAllTypes_default_bytes, value) {
+			return
+		}
+	}
+	if x.DefaultNestedEnum != nil {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(*x.DefaultNestedEnum))
+		if !f(fd_TestAllTypes_default_nested_enum, value) {
+			return
+		}
+	}
+	if x.DefaultForeignEnum != nil {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(*x.DefaultForeignEnum))
+		if !f(fd_TestAllTypes_default_foreign_enum, value) {
+			return
+		}
+	}
+	if x.OneofField != nil {
+		switch o := x.OneofField.(type) {
+		case *TestAllTypes_OneofUint32:
+			v := o.OneofUint32
+			value := protoreflect.ValueOfUint32(v)
+			if !f(fd_TestAllTypes_oneof_uint32, value) {
+				return
+			}
+		case *TestAllTypes_OneofNestedMessage:
+			v := o.OneofNestedMessage
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_TestAllTypes_oneof_nested_message, value) {
+				return
+			}
+		case *TestAllTypes_OneofString:
+			v := o.OneofString
+			value := protoreflect.ValueOfString(v)
+			if !f(fd_TestAllTypes_oneof_string, value) {
+				return
+			}
+		case *TestAllTypes_OneofBytes:
+			v := o.OneofBytes
+			value := protoreflect.ValueOfBytes(v)
+			if !f(fd_TestAllTypes_oneof_bytes, value) {
+				return
+			}
+		case *TestAllTypes_OneofBool:
+			v := o.OneofBool
+			value := protoreflect.ValueOfBool(v)
+			if !f(fd_TestAllTypes_oneof_bool, value) {
+				return
+			}
+		case *TestAllTypes_OneofUint64:
+			v := o.OneofUint64
+			value := protoreflect.ValueOfUint64(v)
+			if !f(fd_TestAllTypes_oneof_uint64, value) {
+				return
+			}
+		case *TestAllTypes_OneofFloat:
+			v := o.OneofFloat
+			value := protoreflect.ValueOfFloat32(v)
+			if !f(fd_TestAllTypes_oneof_float, value) {
+				return
+			}
+		case *TestAllTypes_OneofDouble:
+			v := o.OneofDouble
+			value := protoreflect.ValueOfFloat64(v)
+			if !f(fd_TestAllTypes_oneof_double, value) {
+				return
+			}
+		case *TestAllTypes_OneofEnum:
+			v := o.OneofEnum
+			value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+			if !f(fd_TestAllTypes_oneof_enum, value) {
+				return
+			}
+		case *TestAllTypes_Oneofgroup:
+			v := o.Oneofgroup
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_TestAllTypes_oneofgroup, value) {
+				return
+			}
+		}
+	}
+	if x.OneofOptional != nil {
+		switch o := x.OneofOptional.(type) {
+		case *TestAllTypes_OneofOptionalUint32:
+			v := o.OneofOptionalUint32
+			value := protoreflect.ValueOfUint32(v)
+			if !f(fd_TestAllTypes_oneof_optional_uint32, value) {
+				return
+			}
+		}
+	}
+	if x.OneofDefaults != nil {
+		switch o := x.OneofDefaults.(type) {
+		case *TestAllTypes_OneofDefaultSint32:
+			v := o.OneofDefaultSint32
+			value := protoreflect.ValueOfInt32(v)
+			if !f(fd_TestAllTypes_oneof_default_sint32, value) {
+				return
+			}
+		case *TestAllTypes_OneofDefaultString:
+			v := o.OneofDefaultString
+			value := protoreflect.ValueOfString(v)
+			if !f(fd_TestAllTypes_oneof_default_string, value) {
+				return
+			}
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestAllTypes) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestAllTypes.optional_int32
+		if fd != fd_TestAllTypes_optional_int32 {
+			break
+		}
+		return x.OptionalInt32 != nil
+	case 2: // goproto.proto.test2.TestAllTypes.optional_int64
+		if fd != fd_TestAllTypes_optional_int64 {
+			break
+		}
+		return x.OptionalInt64 != nil
+	case 3: // goproto.proto.test2.TestAllTypes.optional_uint32
+		if fd != fd_TestAllTypes_optional_uint32 {
+			break
+		}
+		return x.OptionalUint32 != nil
+	case 4: // goproto.proto.test2.TestAllTypes.optional_uint64
+		if fd != fd_TestAllTypes_optional_uint64 {
+			break
+		}
+		return x.OptionalUint64 != nil
+	case 5: // goproto.proto.test2.TestAllTypes.optional_sint32
+		if fd != fd_TestAllTypes_optional_sint32 {
+			break
+		}
+		return x.OptionalSint32 != nil
+	case 6: // goproto.proto.test2.TestAllTypes.optional_sint64
+		if fd != fd_TestAllTypes_optional_sint64 {
+			break
+		}
+		return x.OptionalSint64 != nil
+	case 7: // goproto.proto.test2.TestAllTypes.optional_fixed32
+		if fd != fd_TestAllTypes_optional_fixed32 {
+			break
+		}
+		return x.OptionalFixed32 != nil
+	case 8: // goproto.proto.test2.TestAllTypes.optional_fixed64
+		if fd != fd_TestAllTypes_optional_fixed64 {
+			break
+		}
+		return x.OptionalFixed64 != nil
+	case 9: // goproto.proto.test2.TestAllTypes.optional_sfixed32
+		if fd != fd_TestAllTypes_optional_sfixed32 {
+			break
+		}
+		return x.OptionalSfixed32 != nil
+	case 10: // goproto.proto.test2.TestAllTypes.optional_sfixed64
+		if fd != fd_TestAllTypes_optional_sfixed64 {
+			break
+		}
+		return x.OptionalSfixed64 != nil
+	case 11: // goproto.proto.test2.TestAllTypes.optional_float
+		if fd != fd_TestAllTypes_optional_float {
+			break
+		}
+		return x.OptionalFloat != nil
+	case 12: // goproto.proto.test2.TestAllTypes.optional_double
+		if fd != fd_TestAllTypes_optional_double {
+			break
+		}
+		return x.OptionalDouble != nil
+	case 13: // goproto.proto.test2.TestAllTypes.optional_bool
+		if fd != fd_TestAllTypes_optional_bool {
+			break
+		}
+		return x.OptionalBool != nil
+	case 14: // goproto.proto.test2.TestAllTypes.optional_string
+		if fd != fd_TestAllTypes_optional_string {
+			break
+		}
+		return x.OptionalString != nil
+	case 15: // goproto.proto.test2.TestAllTypes.optional_bytes
+		if fd != fd_TestAllTypes_optional_bytes {
+			break
+		}
+		return x.OptionalBytes != nil
+	case 16: // goproto.proto.test2.TestAllTypes.optionalgroup
+		if fd != fd_TestAllTypes_optionalgroup {
+			break
+		}
+		return x.Optionalgroup != nil
+	case 18: // goproto.proto.test2.TestAllTypes.optional_nested_message
+		if fd != fd_TestAllTypes_optional_nested_message {
+			break
+		}
+		return x.OptionalNestedMessage != nil
+	case 19: // goproto.proto.test2.TestAllTypes.optional_foreign_message
+		if fd != fd_TestAllTypes_optional_foreign_message {
+			break
+		}
+		return x.OptionalForeignMessage != nil
+	case 21: // goproto.proto.test2.TestAllTypes.optional_nested_enum
+		if fd != fd_TestAllTypes_optional_nested_enum {
+			break
+		}
+		return x.OptionalNestedEnum != nil
+	case 22: // goproto.proto.test2.TestAllTypes.optional_foreign_enum
+		if fd != fd_TestAllTypes_optional_foreign_enum {
+			break
+		}
+		return x.OptionalForeignEnum != nil
+	case 31: // goproto.proto.test2.TestAllTypes.repeated_int32
+		if fd != fd_TestAllTypes_repeated_int32 {
+			break
+		}
+		return len(x.RepeatedInt32) != 0
+	case 32: // goproto.proto.test2.TestAllTypes.repeated_int64
+		if fd != fd_TestAllTypes_repeated_int64 {
+			break
+		}
+		return len(x.RepeatedInt64) != 0
+	case 33: // goproto.proto.test2.TestAllTypes.repeated_uint32
+		if fd != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
+		return len(x.RepeatedUint32) != 0
+	case 34: // goproto.proto.test2.TestAllTypes.repeated_uint64
+		if fd != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
+		return len(x.RepeatedUint64) != 0
+	case 35: // goproto.proto.test2.TestAllTypes.repeated_sint32
+		if fd != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
+		return len(x.RepeatedSint32) != 0
+	case 36: // goproto.proto.test2.TestAllTypes.repeated_sint64
+		if fd != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
+		return len(x.RepeatedSint64) != 0
+	case 37: // goproto.proto.test2.TestAllTypes.repeated_fixed32
+		if fd != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
+		return len(x.RepeatedFixed32) != 0
+	case 38: // goproto.proto.test2.TestAllTypes.repeated_fixed64
+		if fd != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
+		return len(x.RepeatedFixed64) != 0
+	case 39: // goproto.proto.test2.TestAllTypes.repeated_sfixed32
+		if fd != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
+		return len(x.RepeatedSfixed32) != 0
+	case 40: // goproto.proto.test2.TestAllTypes.repeated_sfixed64
+		if fd != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
+		return len(x.RepeatedSfixed64) != 0
+	case 41: // goproto.proto.test2.TestAllTypes.repeated_float
+		if fd != fd_TestAllTypes_repeated_float {
+			break
+		}
+		return len(x.RepeatedFloat) != 0
+	case 42: // goproto.proto.test2.TestAllTypes.repeated_double
+		if fd != fd_TestAllTypes_repeated_double {
+			break
+		}
+		return len(x.RepeatedDouble) != 0
+	case 43: // goproto.proto.test2.TestAllTypes.repeated_bool
+		if fd != fd_TestAllTypes_repeated_bool {
+			break
+		}
+		return len(x.RepeatedBool) != 0
+	case 44: // goproto.proto.test2.TestAllTypes.repeated_string
+		if fd != fd_TestAllTypes_repeated_string {
+			break
+		}
+		return len(x.RepeatedString) != 0
+	case 45: // goproto.proto.test2.TestAllTypes.repeated_bytes
+		if fd != fd_TestAllTypes_repeated_bytes {
+			break
+		}
+		return len(x.RepeatedBytes) != 0
+	case 46: // goproto.proto.test2.TestAllTypes.repeatedgroup
+		if fd != fd_TestAllTypes_repeatedgroup {
+			break
+		}
+		return len(x.Repeatedgroup) != 0
+	case 48: // goproto.proto.test2.TestAllTypes.repeated_nested_message
+		if fd != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
+		return len(x.RepeatedNestedMessage) != 0
+	case 49: // goproto.proto.test2.TestAllTypes.repeated_foreign_message
+		if fd != fd_TestAllTypes_repeated_foreign_message {
+			break
+		}
+		return len(x.RepeatedForeignMessage) != 0
+	case 51: // goproto.proto.test2.TestAllTypes.repeated_nested_enum
+		if fd != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
+		return len(x.RepeatedNestedEnum) != 0
+	case 52: // goproto.proto.test2.TestAllTypes.repeated_foreign_enum
+		if fd != fd_TestAllTypes_repeated_foreign_enum {
+			break
+		}
+		return len(x.RepeatedForeignEnum) != 0
+	case 53: // goproto.proto.test2.TestAllTypes.packed_int32
+		if fd != fd_TestAllTypes_packed_int32 {
+			break
+		}
+		return len(x.PackedInt32) != 0
+	case 54: // goproto.proto.test2.TestAllTypes.packed_sint64
+		if fd != fd_TestAllTypes_packed_sint64 {
+			break
+		}
+		return len(x.PackedSint64) != 0
+	case 55: // goproto.proto.test2.TestAllTypes.packed_double
+		if fd != fd_TestAllTypes_packed_double {
+			break
+		}
+		return len(x.PackedDouble) != 0
+	case 57: // goproto.proto.test2.TestAllTypes.packed_bool
+		if fd != fd_TestAllTypes_packed_bool {
+			break
+		}
+		return len(x.PackedBool) != 0
+	case 56: // goproto.proto.test2.TestAllTypes.map_int32_int32
+		if fd != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
+		return len(x.MapInt32Int32) != 0
+	case 61: // goproto.proto.test2.TestAllTypes.map_sint64_sint64
+		if fd != fd_TestAllTypes_map_sint64_sint64 {
+			break
+		}
+		return len(x.MapSint64Sint64) != 0
+	case 69: // goproto.proto.test2.TestAllTypes.map_string_string
+		if fd != fd_TestAllTypes_map_string_string {
+			break
+		}
+		return len(x.MapStringString) != 0
+	case 70: // goproto.proto.test2.TestAllTypes.map_string_bytes
+		if fd != fd_TestAllTypes_map_string_bytes {
+			break
+		}
+		return len(x.MapStringBytes) != 0
+	case 71: // goproto.proto.test2.TestAllTypes.map_string_nested_message
+		if fd != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
+		return len(x.MapStringNestedMessage) != 0
+	case 73: // goproto.proto.test2.TestAllTypes.map_string_nested_enum
+		if fd != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
+		return len(x.MapStringNestedEnum) != 0
+	case 81: // goproto.proto.test2.TestAllTypes.default_int32
+		if fd != fd_TestAllTypes_default_int32 {
+			break
+		}
+		return x.DefaultInt32 != nil
+	case 82: // goproto.proto.test2.TestAllTypes.default_int64
+		if fd != fd_TestAllTypes_default_int64 {
+			break
+		}
+		return x.DefaultInt64 != nil
+	case 83: // goproto.proto.test2.TestAllTypes.default_uint32
+		if fd != fd_TestAllTypes_default_uint32 {
+			break
+		}
+		return x.DefaultUint32 != nil
+	case 84: // goproto.proto.test2.TestAllTypes.default_uint64
+		if fd != fd_TestAllTypes_default_uint64 {
+			break
+		}
+		return x.DefaultUint64 != nil
+	case 85: // goproto.proto.test2.TestAllTypes.default_sint32
+		if fd != fd_TestAllTypes_default_sint32 {
+			break
+		}
+		return x.DefaultSint32 != nil
+	case 86: // goproto.proto.test2.TestAllTypes.default_sint64
+		if fd != fd_TestAllTypes_default_sint64 {
+			break
+		}
+		return x.DefaultSint64 != nil
+	case 87: // goproto.proto.test2.TestAllTypes.default_fixed32
+		if fd != fd_TestAllTypes_default_fixed32 {
+			break
+		}
+		return x.DefaultFixed32 != nil
+	case 88: // goproto.proto.test2.TestAllTypes.default_fixed64
+		if fd != fd_TestAllTypes_default_fixed64 {
+			break
+		}
+		return x.DefaultFixed64 != nil
+	case 89: // goproto.proto.test2.TestAllTypes.default_sfixed32
+		if fd != fd_TestAllTypes_default_sfixed32 {
+			break
+		}
+		return x.DefaultSfixed32 != nil
+	case 80: // goproto.proto.test2.TestAllTypes.default_sfixed64
+		if fd != fd_TestAllTypes_default_sfixed64 {
+			break
+		}
+		return x.DefaultSfixed64 != nil
+	case 91: // goproto.proto.test2.TestAllTypes.default_float
+		if fd != fd_TestAllTypes_default_float {
+			break
+		}
+		return x.DefaultFloat != nil
+	case 92: // goproto.proto.test2.TestAllTypes.default_double
+		if fd != fd_TestAllTypes_default_double {
+			break
+		}
+		return x.DefaultDouble != nil
+	case 93: // goproto.proto.test2.TestAllTypes.default_bool
+		if fd != fd_TestAllTypes_default_bool {
+			break
+		}
+		return x.DefaultBool != nil
+	case 94: // goproto.proto.test2.TestAllTypes.default_string
+		if fd != fd_TestAllTypes_default_string {
+			break
+		}
+		return x.DefaultString != nil
+	case 95: // goproto.proto.test2.TestAllTypes.default_bytes
+		if fd != fd_TestAllTypes_default_bytes {
+			break
+		}
+		return x.DefaultBytes != nil
+	case 96: // goproto.proto.test2.TestAllTypes.default_nested_enum
+		if fd != fd_TestAllTypes_default_nested_enum {
+			break
+		}
+		return x.DefaultNestedEnum != nil
+	case 97: // goproto.proto.test2.TestAllTypes.default_foreign_enum
+		if fd != fd_TestAllTypes_default_foreign_enum {
+			break
+		}
+		return x.DefaultForeignEnum != nil
+	case 111: // goproto.proto.test2.TestAllTypes.oneof_uint32
+		if fd != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestAllTypes_OneofUint32); ok {
+			return true
+		} else {
+			return false
+		}
+	case 112: // goproto.proto.test2.TestAllTypes.oneof_nested_message
+		if fd != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); ok {
+			return true
+		} else {
+			return false
+		}
+	case 113: // goproto.proto.test2.TestAllTypes.oneof_string
+		if fd != fd_TestAllTypes_oneof_string {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestAllTypes_OneofString); ok {
+			return true
+		} else {
+			return false
+		}
+	case 114: // goproto.proto.test2.TestAllTypes.oneof_bytes
+		if fd != fd_TestAllTypes_oneof_bytes {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestAllTypes_OneofBytes); ok {
+			return true
+		} else {
+			return false
+		}
+	case 115: // goproto.proto.test2.TestAllTypes.oneof_bool
+		if fd != fd_TestAllTypes_oneof_bool {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestAllTypes_OneofBool); ok {
+			return true
+		} else {
+			return false
+		}
+	case 116: // goproto.proto.test2.TestAllTypes.oneof_uint64
+		if fd != fd_TestAllTypes_oneof_uint64 {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestAllTypes_OneofUint64); ok {
+			return true
+		} else {
+			return false
+		}
+	case 117: // goproto.proto.test2.TestAllTypes.oneof_float
+		if fd != fd_TestAllTypes_oneof_float {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestAllTypes_OneofFloat); ok {
+			return true
+		} else {
+			return false
+		}
+	case 118: // goproto.proto.test2.TestAllTypes.oneof_double
+		if fd != fd_TestAllTypes_oneof_double {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestAllTypes_OneofDouble); ok {
+			return true
+		} else {
+			return false
+		}
+	case 119: // goproto.proto.test2.TestAllTypes.oneof_enum
+		if fd != fd_TestAllTypes_oneof_enum {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestAllTypes_OneofEnum); ok {
+			return true
+		} else {
+			return false
+		}
+	case 121: // goproto.proto.test2.TestAllTypes.oneofgroup
+		if fd != fd_TestAllTypes_oneofgroup {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestAllTypes_Oneofgroup); ok {
+			return true
+		} else {
+			return false
+		}
+	case 120: // goproto.proto.test2.TestAllTypes.oneof_optional_uint32
+		if fd != fd_TestAllTypes_oneof_optional_uint32 {
+			break
+		}
+		if x.OneofOptional == nil {
+			return false
+		} else if _, ok := x.OneofOptional.(*TestAllTypes_OneofOptionalUint32); ok {
+			return true
+		} else {
+			return false
+		}
+	case 122: // goproto.proto.test2.TestAllTypes.oneof_default_sint32
+		if fd != fd_TestAllTypes_oneof_default_sint32 {
+			break
+		}
+		if x.OneofDefaults == nil {
+			return false
+		} else if _, ok := x.OneofDefaults.(*TestAllTypes_OneofDefaultSint32); ok {
+			return true
+		} else {
+			return false
+		}
+	case 123: // goproto.proto.test2.TestAllTypes.oneof_default_string
+		if fd != fd_TestAllTypes_oneof_default_string {
+			break
+		}
+		if x.OneofDefaults == nil {
+			return false
+		} else if _, ok := x.OneofDefaults.(*TestAllTypes_OneofDefaultString); ok {
+			return true
+		} else {
+			return false
+		}
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestAllTypes.optional_int32
+		if fd != fd_TestAllTypes_optional_int32 {
+			break
+		}
+		x.OptionalInt32 = nil
+		return
+	case 2: // goproto.proto.test2.TestAllTypes.optional_int64
+		if fd != fd_TestAllTypes_optional_int64 {
+			break
+		}
+		x.OptionalInt64 = nil
+		return
+	case 3: // goproto.proto.test2.TestAllTypes.optional_uint32
+		if fd != fd_TestAllTypes_optional_uint32 {
+			break
+		}
+		x.OptionalUint32 = nil
+		return
+	case 4: // goproto.proto.test2.TestAllTypes.optional_uint64
+		if fd != fd_TestAllTypes_optional_uint64 {
+			break
+		}
+		x.OptionalUint64 = nil
+		return
+	case 5: // goproto.proto.test2.TestAllTypes.optional_sint32
+		if fd != fd_TestAllTypes_optional_sint32 {
+			break
+		}
+		x.OptionalSint32 = nil
+		return
+	case 6: // goproto.proto.test2.TestAllTypes.optional_sint64
+		if fd != fd_TestAllTypes_optional_sint64 {
+			break
+		}
+		x.OptionalSint64 = nil
+		return
+	case 7: // goproto.proto.test2.TestAllTypes.optional_fixed32
+		if fd != fd_TestAllTypes_optional_fixed32 {
+			break
+		}
+		x.OptionalFixed32 = nil
+		return
+	case 8: // goproto.proto.test2.TestAllTypes.optional_fixed64
+		if fd != fd_TestAllTypes_optional_fixed64 {
+			break
+		}
+		x.OptionalFixed64 = nil
+		return
+	case 9: // goproto.proto.test2.TestAllTypes.optional_sfixed32
+		if fd != fd_TestAllTypes_optional_sfixed32 {
+			break
+		}
+		x.OptionalSfixed32 = nil
+		return
+	case 10: // goproto.proto.test2.TestAllTypes.optional_sfixed64
+		if fd != fd_TestAllTypes_optional_sfixed64 {
+			break
+		}
+		x.OptionalSfixed64 = nil
+		return
+	case 11: // goproto.proto.test2.TestAllTypes.optional_float
+		if fd != fd_TestAllTypes_optional_float {
+			break
+		}
+		x.OptionalFloat = nil
+		return
+	case 12: // goproto.proto.test2.TestAllTypes.optional_double
+		if fd != fd_TestAllTypes_optional_double {
+			break
+		}
+		x.OptionalDouble = nil
+		return
+	case 13: // goproto.proto.test2.TestAllTypes.optional_bool
+		if fd != fd_TestAllTypes_optional_bool {
+			break
+		}
+		x.OptionalBool = nil
+		return
+	case 14: // goproto.proto.test2.TestAllTypes.optional_string
+		if fd != fd_TestAllTypes_optional_string {
+			break
+		}
+		x.OptionalString = nil
+		return
+	case 15: // goproto.proto.test2.TestAllTypes.optional_bytes
+		if fd != fd_TestAllTypes_optional_bytes {
+			break
+		}
+		x.OptionalBytes = nil
+		return
+	case 16: // goproto.proto.test2.TestAllTypes.optionalgroup
+		if fd != fd_TestAllTypes_optionalgroup {
+			break
+		}
+		x.Optionalgroup = nil
+		return
+	case 18: // goproto.proto.test2.TestAllTypes.optional_nested_message
+		if fd != fd_TestAllTypes_optional_nested_message {
+			break
+		}
+		x.OptionalNestedMessage = nil
+		return
+	case 19: // goproto.proto.test2.TestAllTypes.optional_foreign_message
+		if fd != fd_TestAllTypes_optional_foreign_message {
+			break
+		}
+		x.OptionalForeignMessage = nil
+		return
+	case 21: // goproto.proto.test2.TestAllTypes.optional_nested_enum
+		if fd != fd_TestAllTypes_optional_nested_enum {
+			break
+		}
+		x.OptionalNestedEnum = nil
+		return
+	case 22: // goproto.proto.test2.TestAllTypes.optional_foreign_enum
+		if fd != fd_TestAllTypes_optional_foreign_enum {
+			break
+		}
+		x.OptionalForeignEnum = nil
+		return
+	case 31: // goproto.proto.test2.TestAllTypes.repeated_int32
+		if fd != fd_TestAllTypes_repeated_int32 {
+			break
+		}
+		x.RepeatedInt32 = nil
+		return
+	case 32: // goproto.proto.test2.TestAllTypes.repeated_int64
+		if fd != fd_TestAllTypes_repeated_int64 {
+			break
+		}
+		x.RepeatedInt64 = nil
+		return
+	case 33: // goproto.proto.test2.TestAllTypes.repeated_uint32
+		if fd != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
+		x.RepeatedUint32 = nil
+		return
+	case 34: // goproto.proto.test2.TestAllTypes.repeated_uint64
+		if fd != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
+		x.RepeatedUint64 = nil
+		return
+	case 35: // goproto.proto.test2.TestAllTypes.repeated_sint32
+		if fd != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
+		x.RepeatedSint32 = nil
+		return
+	case 36: // goproto.proto.test2.TestAllTypes.repeated_sint64
+		if fd != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
+		x.RepeatedSint64 = nil
+		return
+	case 37: // goproto.proto.test2.TestAllTypes.repeated_fixed32
+		if fd != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
+		x.RepeatedFixed32 = nil
+		return
+	case 38: // goproto.proto.test2.TestAllTypes.repeated_fixed64
+		if fd != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
+		x.RepeatedFixed64 = nil
+		return
+	case 39: // goproto.proto.test2.TestAllTypes.repeated_sfixed32
+		if fd != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
+		x.RepeatedSfixed32 = nil
+		return
+	case 40: // goproto.proto.test2.TestAllTypes.repeated_sfixed64
+		if fd != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
+		x.RepeatedSfixed64 = nil
+		return
+	case 41: // goproto.proto.test2.TestAllTypes.repeated_float
+		if fd != fd_TestAllTypes_repeated_float {
+			break
+		}
+		x.RepeatedFloat = nil
+		return
+	case 42: // goproto.proto.test2.TestAllTypes.repeated_double
+		if fd != fd_TestAllTypes_repeated_double {
+			break
+		}
+		x.RepeatedDouble = nil
+		return
+	case 43: // goproto.proto.test2.TestAllTypes.repeated_bool
+		if fd != fd_TestAllTypes_repeated_bool {
+			break
+		}
+		x.RepeatedBool = nil
+		return
+	case 44: // goproto.proto.test2.TestAllTypes.repeated_string
+		if fd != fd_TestAllTypes_repeated_string {
+			break
+		}
+		x.RepeatedString = nil
+		return
+	case 45: // goproto.proto.test2.TestAllTypes.repeated_bytes
+		if fd != fd_TestAllTypes_repeated_bytes {
+			break
+		}
+		x.RepeatedBytes = nil
+		return
+	case 46: // goproto.proto.test2.TestAllTypes.repeatedgroup
+		if fd != fd_TestAllTypes_repeatedgroup {
+			break
+		}
+		x.Repeatedgroup = nil
+		return
+	case 48: // goproto.proto.test2.TestAllTypes.repeated_nested_message
+		if fd != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
+		x.RepeatedNestedMessage = nil
+		return
+	case 49: // goproto.proto.test2.TestAllTypes.repeated_foreign_message
+		if fd != fd_TestAllTypes_repeated_foreign_message {
+			break
+		}
+		x.RepeatedForeignMessage = nil
+		return
+	case 51: // goproto.proto.test2.TestAllTypes.repeated_nested_enum
+		if fd != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
+		x.RepeatedNestedEnum = nil
+		return
+	case 52: // goproto.proto.test2.TestAllTypes.repeated_foreign_enum
+		if fd != fd_TestAllTypes_repeated_foreign_enum {
+			break
+		}
+		x.RepeatedForeignEnum = nil
+		return
+	case 53: // goproto.proto.test2.TestAllTypes.packed_int32
+		if fd != fd_TestAllTypes_packed_int32 {
+			break
+		}
+		x.PackedInt32 = nil
+		return
+	case 54: // goproto.proto.test2.TestAllTypes.packed_sint64
+		if fd != fd_TestAllTypes_packed_sint64 {
+			break
+		}
+		x.PackedSint64 = nil
+		return
+	case 55: // goproto.proto.test2.TestAllTypes.packed_double
+		if fd != fd_TestAllTypes_packed_double {
+			break
+		}
+		x.PackedDouble = nil
+		return
+	case 57: // goproto.proto.test2.TestAllTypes.packed_bool
+		if fd != fd_TestAllTypes_packed_bool {
+			break
+		}
+		x.PackedBool = nil
+		return
+	case 56: // goproto.proto.test2.TestAllTypes.map_int32_int32
+		if fd != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
+		x.MapInt32Int32 = nil
+		return
+	case 61: // goproto.proto.test2.TestAllTypes.map_sint64_sint64
+		if fd != fd_TestAllTypes_map_sint64_sint64 {
+			break
+		}
+		x.MapSint64Sint64 = nil
+		return
+	case 69: // goproto.proto.test2.TestAllTypes.map_string_string
+		if fd != fd_TestAllTypes_map_string_string {
+			break
+		}
+		x.MapStringString = nil
+		return
+	case 70: // goproto.proto.test2.TestAllTypes.map_string_bytes
+		if fd != fd_TestAllTypes_map_string_bytes {
+			break
+		}
+		x.MapStringBytes = nil
+		return
+	case 71: // goproto.proto.test2.TestAllTypes.map_string_nested_message
+		if fd != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
+		x.MapStringNestedMessage = nil
+		return
+	case 73: // goproto.proto.test2.TestAllTypes.map_string_nested_enum
+		if fd != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
+		x.MapStringNestedEnum = nil
+		return
+	case 81: // goproto.proto.test2.TestAllTypes.default_int32
+		if fd != fd_TestAllTypes_default_int32 {
+			break
+		}
+		x.DefaultInt32 = nil
+		return
+	case 82: // goproto.proto.test2.TestAllTypes.default_int64
+		if fd != fd_TestAllTypes_default_int64 {
+			break
+		}
+		x.DefaultInt64 = nil
+		return
+	case 83: // goproto.proto.test2.TestAllTypes.default_uint32
+		if fd != fd_TestAllTypes_default_uint32 {
+			break
+		}
+		x.DefaultUint32 = nil
+		return
+	case 84: // goproto.proto.test2.TestAllTypes.default_uint64
+		if fd != fd_TestAllTypes_default_uint64 {
+			break
+		}
+		x.DefaultUint64 = nil
+		return
+	case 85: // goproto.proto.test2.TestAllTypes.default_sint32
+		if fd != fd_TestAllTypes_default_sint32 {
+			break
+		}
+		x.DefaultSint32 = nil
+		return
+	case 86: // goproto.proto.test2.TestAllTypes.default_sint64
+		if fd != fd_TestAllTypes_default_sint64 {
+			break
+		}
+		x.DefaultSint64 = nil
+		return
+	case 87: // goproto.proto.test2.TestAllTypes.default_fixed32
+		if fd != fd_TestAllTypes_default_fixed32 {
+			break
+		}
+		x.DefaultFixed32 = nil
+		return
+	case 88: // goproto.proto.test2.TestAllTypes.default_fixed64
+		if fd != fd_TestAllTypes_default_fixed64 {
+			break
+		}
+		x.DefaultFixed64 = nil
+		return
+	case 89: // goproto.proto.test2.TestAllTypes.default_sfixed32
+		if fd != fd_TestAllTypes_default_sfixed32 {
+			break
+		}
+		x.DefaultSfixed32 = nil
+		return
+	case 80: // goproto.proto.test2.TestAllTypes.default_sfixed64
+		if fd != fd_TestAllTypes_default_sfixed64 {
+			break
+		}
+		x.DefaultSfixed64 = nil
+		return
+	case 91: // goproto.proto.test2.TestAllTypes.default_float
+		if fd != fd_TestAllTypes_default_float {
+			break
+		}
+		x.DefaultFloat = nil
+		return
+	case 92: // goproto.proto.test2.TestAllTypes.default_double
+		if fd != fd_TestAllTypes_default_double {
+			break
+		}
+		x.DefaultDouble = nil
+		return
+	case 93: // goproto.proto.test2.TestAllTypes.default_bool
+		if fd != fd_TestAllTypes_default_bool {
+			break
+		}
+		x.DefaultBool = nil
+		return
+	case 94: // goproto.proto.test2.TestAllTypes.default_string
+		if fd != fd_TestAllTypes_default_string {
+			break
+		}
+		x.DefaultString = nil
+		return
+	case 95: // goproto.proto.test2.TestAllTypes.default_bytes
+		if fd != fd_TestAllTypes_default_bytes {
+			break
+		}
+		x.DefaultBytes = nil
+		return
+	case 96: // goproto.proto.test2.TestAllTypes.default_nested_enum
+		if fd != fd_TestAllTypes_default_nested_enum {
+			break
+		}
+		x.DefaultNestedEnum = nil
+		return
+	case 97: // goproto.proto.test2.TestAllTypes.default_foreign_enum
+		if fd != fd_TestAllTypes_default_foreign_enum {
+			break
+		}
+		x.DefaultForeignEnum = nil
+		return
+	case 111: // goproto.proto.test2.TestAllTypes.oneof_uint32
+		if fd != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
+		x.OneofField = nil
+		return
+	case 112: // goproto.proto.test2.TestAllTypes.oneof_nested_message
+		if fd != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
+		x.OneofField = nil
+		return
+	case 113: // goproto.proto.test2.TestAllTypes.oneof_string
+		if fd != fd_TestAllTypes_oneof_string {
+			break
+		}
+		x.OneofField = nil
+		return
+	case 114: // goproto.proto.test2.TestAllTypes.oneof_bytes
+		if fd != fd_TestAllTypes_oneof_bytes {
+			break
+		}
+		x.OneofField = nil
+		return
+	case 115: // goproto.proto.test2.TestAllTypes.oneof_bool
+		if fd != fd_TestAllTypes_oneof_bool {
+			break
+		}
+		x.OneofField = nil
+		return
+	case 116: // goproto.proto.test2.TestAllTypes.oneof_uint64
+		if fd != fd_TestAllTypes_oneof_uint64 {
+			break
+		}
+		x.OneofField = nil
+		return
+	case 117: // goproto.proto.test2.TestAllTypes.oneof_float
+		if fd != fd_TestAllTypes_oneof_float {
+			break
+		}
+		x.OneofField = nil
+		return
+	case 118: // goproto.proto.test2.TestAllTypes.oneof_double
+		if fd != fd_TestAllTypes_oneof_double {
+			break
+		}
+		x.OneofField = nil
+		return
+	case 119: // goproto.proto.test2.TestAllTypes.oneof_enum
+		if fd != fd_TestAllTypes_oneof_enum {
+			break
+		}
+		x.OneofField = nil
+		return
+	case 121: // goproto.proto.test2.TestAllTypes.oneofgroup
+		if fd != fd_TestAllTypes_oneofgroup {
+			break
+		}
+		x.OneofField = nil
+		return
+	case 120: // goproto.proto.test2.TestAllTypes.oneof_optional_uint32
+		if fd != fd_TestAllTypes_oneof_optional_uint32 {
+			break
+		}
+		x.OneofOptional = nil
+		return
+	case 122: // goproto.proto.test2.TestAllTypes.oneof_default_sint32
+		if fd != fd_TestAllTypes_oneof_default_sint32 {
+			break
+		}
+		x.OneofDefaults = nil
+		return
+	case 123: // goproto.proto.test2.TestAllTypes.oneof_default_string
+		if fd != fd_TestAllTypes_oneof_default_string {
+			break
+		}
+		x.OneofDefaults = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestAllTypes) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test2.TestAllTypes.optional_int32
+		if descriptor != fd_TestAllTypes_optional_int32 {
+			break
+		}
+		if x.OptionalInt32 == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.OptionalInt32
+		return protoreflect.ValueOfInt32(value)
+	case 2: // goproto.proto.test2.TestAllTypes.optional_int64
+		if descriptor != fd_TestAllTypes_optional_int64 {
+			break
+		}
+		if x.OptionalInt64 == nil {
+			return protoreflect.ValueOfInt64(int64(0))
+		}
+		value := *x.OptionalInt64
+		return protoreflect.ValueOfInt64(value)
+	case 3: // goproto.proto.test2.TestAllTypes.optional_uint32
+		if descriptor != fd_TestAllTypes_optional_uint32 {
+			break
+		}
+		if x.OptionalUint32 == nil {
+			return protoreflect.ValueOfUint32(uint32(0))
+		}
+		value := *x.OptionalUint32
+		return protoreflect.ValueOfUint32(value)
+	case 4: // goproto.proto.test2.TestAllTypes.optional_uint64
+		if descriptor != fd_TestAllTypes_optional_uint64 {
+			break
+		}
+		if x.OptionalUint64 == nil {
+			return protoreflect.ValueOfUint64(uint64(0))
+		}
+		value := *x.OptionalUint64
+		return protoreflect.ValueOfUint64(value)
+	case 5: // goproto.proto.test2.TestAllTypes.optional_sint32
+		if descriptor != fd_TestAllTypes_optional_sint32 {
+			break
+		}
+		if x.OptionalSint32 == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.OptionalSint32
+		return protoreflect.ValueOfInt32(value)
+	case 6: // goproto.proto.test2.TestAllTypes.optional_sint64
+		if descriptor != fd_TestAllTypes_optional_sint64 {
+			break
+		}
+		if x.OptionalSint64 == nil {
+			return protoreflect.ValueOfInt64(int64(0))
+		}
+		value := *x.OptionalSint64
+		return protoreflect.ValueOfInt64(value)
+	case 7: // goproto.proto.test2.TestAllTypes.optional_fixed32
+		if descriptor != fd_TestAllTypes_optional_fixed32 {
+			break
+		}
+		if x.OptionalFixed32 == nil {
+			return protoreflect.ValueOfUint32(uint32(0))
+		}
+		value := *x.OptionalFixed32
+		return protoreflect.ValueOfUint32(value)
+	case 8: // goproto.proto.test2.TestAllTypes.optional_fixed64
+		if descriptor != fd_TestAllTypes_optional_fixed64 {
+			break
+		}
+		if x.OptionalFixed64 == nil {
+			return protoreflect.ValueOfUint64(uint64(0))
+		}
+		value := *x.OptionalFixed64
+		return protoreflect.ValueOfUint64(value)
+	case 9: // goproto.proto.test2.TestAllTypes.optional_sfixed32
+		if descriptor != fd_TestAllTypes_optional_sfixed32 {
+			break
+		}
+		if x.OptionalSfixed32 == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.OptionalSfixed32
+		return protoreflect.ValueOfInt32(value)
+	case 10: // goproto.proto.test2.TestAllTypes.optional_sfixed64
+		if descriptor != fd_TestAllTypes_optional_sfixed64 {
+			break
+		}
+		if x.OptionalSfixed64 == nil {
+			return protoreflect.ValueOfInt64(int64(0))
+		}
+		value := *x.OptionalSfixed64
+		return protoreflect.ValueOfInt64(value)
+	case 11: // goproto.proto.test2.TestAllTypes.optional_float
+		if descriptor != fd_TestAllTypes_optional_float {
+			break
+		}
+		if x.OptionalFloat == nil {
+			return protoreflect.ValueOfFloat32(float32(0))
+		}
+		value := *x.OptionalFloat
+		return protoreflect.ValueOfFloat32(value)
+	case 12: // goproto.proto.test2.TestAllTypes.optional_double
+		if descriptor != fd_TestAllTypes_optional_double {
+			break
+		}
+		if x.OptionalDouble == nil {
+			return protoreflect.ValueOfFloat64(float64(0))
+		}
+		value := *x.OptionalDouble
+		return protoreflect.ValueOfFloat64(value)
+	case 13: // goproto.proto.test2.TestAllTypes.optional_bool
+		if descriptor != fd_TestAllTypes_optional_bool {
+			break
+		}
+		if x.OptionalBool == nil {
+			return protoreflect.ValueOfBool(false)
+		}
+		value := *x.OptionalBool
+		return protoreflect.ValueOfBool(value)
+	case 14: // goproto.proto.test2.TestAllTypes.optional_string
+		if descriptor != fd_TestAllTypes_optional_string {
+			break
+		}
+		if x.OptionalString == nil {
+			return protoreflect.ValueOfString("")
+		}
+		value := *x.OptionalString
+		return protoreflect.ValueOfString(value)
+	case 15: // goproto.proto.test2.TestAllTypes.optional_bytes
+		if descriptor != fd_TestAllTypes_optional_bytes {
+			break
+		}
+		if x.OptionalBytes == nil {
+			return protoreflect.ValueOfBytes(nil)
+		}
+		value := x.OptionalBytes
+		return protoreflect.ValueOfBytes(value)
+	case 16: // goproto.proto.test2.TestAllTypes.optionalgroup
+		if descriptor != fd_TestAllTypes_optionalgroup {
+			break
+		}
+		value := x.Optionalgroup
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 18: // goproto.proto.test2.TestAllTypes.optional_nested_message
+		if descriptor != fd_TestAllTypes_optional_nested_message {
+			break
+		}
+		value := x.OptionalNestedMessage
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 19: // goproto.proto.test2.TestAllTypes.optional_foreign_message
+		if descriptor != fd_TestAllTypes_optional_foreign_message {
+			break
+		}
+		value := x.OptionalForeignMessage
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 21: // goproto.proto.test2.TestAllTypes.optional_nested_enum
+		if descriptor != fd_TestAllTypes_optional_nested_enum {
+			break
+		}
+		if x.OptionalNestedEnum == nil {
+			return protoreflect.ValueOfEnum(0)
+		}
+		value := *x.OptionalNestedEnum
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case 22: // goproto.proto.test2.TestAllTypes.optional_foreign_enum
+		if descriptor != fd_TestAllTypes_optional_foreign_enum {
+			break
+		}
+		if x.OptionalForeignEnum == nil {
+			return protoreflect.ValueOfEnum(4)
+		}
+		value := *x.OptionalForeignEnum
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case 31: // goproto.proto.test2.TestAllTypes.repeated_int32
+		if descriptor != fd_TestAllTypes_repeated_int32 {
+			break
+		}
+		if len(x.RepeatedInt32) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_31_list{})
+		}
+		listValue := &_TestAllTypes_31_list{list: &x.RepeatedInt32}
+		return protoreflect.ValueOfList(listValue)
+	case 32: // goproto.proto.test2.TestAllTypes.repeated_int64
+		if descriptor != fd_TestAllTypes_repeated_int64 {
+			break
+		}
+		if len(x.RepeatedInt64) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_32_list{})
+		}
+		listValue := &_TestAllTypes_32_list{list: &x.RepeatedInt64}
+		return protoreflect.ValueOfList(listValue)
+	case 33: // goproto.proto.test2.TestAllTypes.repeated_uint32
+		if descriptor != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
+		if len(x.RepeatedUint32) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_33_list{})
+		}
+		listValue := &_TestAllTypes_33_list{list: &x.RepeatedUint32}
+		return protoreflect.ValueOfList(listValue)
+	case 34: // goproto.proto.test2.TestAllTypes.repeated_uint64
+		if descriptor != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
+		if len(x.RepeatedUint64) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_34_list{})
+		}
+		listValue := &_TestAllTypes_34_list{list: &x.RepeatedUint64}
+		return protoreflect.ValueOfList(listValue)
+	case 35: // goproto.proto.test2.TestAllTypes.repeated_sint32
+		if descriptor != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
+		if len(x.RepeatedSint32) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_35_list{})
+		}
+		listValue := &_TestAllTypes_35_list{list: &x.RepeatedSint32}
+		return protoreflect.ValueOfList(listValue)
+	case 36: // goproto.proto.test2.TestAllTypes.repeated_sint64
+		if descriptor != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
+		if len(x.RepeatedSint64) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_36_list{})
+		}
+		listValue := &_TestAllTypes_36_list{list: &x.RepeatedSint64}
+		return protoreflect.ValueOfList(listValue)
+	case 37: // goproto.proto.test2.TestAllTypes.repeated_fixed32
+		if descriptor != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
+		if len(x.RepeatedFixed32) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_37_list{})
+		}
+		listValue := &_TestAllTypes_37_list{list: &x.RepeatedFixed32}
+		return protoreflect.ValueOfList(listValue)
+	case 38: // goproto.proto.test2.TestAllTypes.repeated_fixed64
+		if descriptor != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
+		if len(x.RepeatedFixed64) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_38_list{})
+		}
+		listValue := &_TestAllTypes_38_list{list: &x.RepeatedFixed64}
+		return protoreflect.ValueOfList(listValue)
+	case 39: // goproto.proto.test2.TestAllTypes.repeated_sfixed32
+		if descriptor != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
+		if len(x.RepeatedSfixed32) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_39_list{})
+		}
+		listValue := &_TestAllTypes_39_list{list: &x.RepeatedSfixed32}
+		return protoreflect.ValueOfList(listValue)
+	case 40: // goproto.proto.test2.TestAllTypes.repeated_sfixed64
+		if descriptor != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
+		if len(x.RepeatedSfixed64) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_40_list{})
+		}
+		listValue := &_TestAllTypes_40_list{list: &x.RepeatedSfixed64}
+		return protoreflect.ValueOfList(listValue)
+	case 41: // goproto.proto.test2.TestAllTypes.repeated_float
+		if descriptor != fd_TestAllTypes_repeated_float {
+			break
+		}
+		if len(x.RepeatedFloat) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_41_list{})
+		}
+		listValue := &_TestAllTypes_41_list{list: &x.RepeatedFloat}
+		return protoreflect.ValueOfList(listValue)
+	case 42: // goproto.proto.test2.TestAllTypes.repeated_double
+		if descriptor != fd_TestAllTypes_repeated_double {
+			break
+		}
+		if len(x.RepeatedDouble) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_42_list{})
+		}
+		listValue := &_TestAllTypes_42_list{list: &x.RepeatedDouble}
+		return protoreflect.ValueOfList(listValue)
+	case 43: // goproto.proto.test2.TestAllTypes.repeated_bool
+		if descriptor != fd_TestAllTypes_repeated_bool {
+			break
+		}
+		if len(x.RepeatedBool) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_43_list{})
+		}
+		listValue := &_TestAllTypes_43_list{list: &x.RepeatedBool}
+		return protoreflect.ValueOfList(listValue)
+	case 44: // goproto.proto.test2.TestAllTypes.repeated_string
+		if descriptor != fd_TestAllTypes_repeated_string {
+			break
+		}
+		if len(x.RepeatedString) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_44_list{})
+		}
+		listValue := &_TestAllTypes_44_list{list: &x.RepeatedString}
+		return protoreflect.ValueOfList(listValue)
+	case 45: // goproto.proto.test2.TestAllTypes.repeated_bytes
+		if descriptor != fd_TestAllTypes_repeated_bytes {
+			break
+		}
+		if len(x.RepeatedBytes) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_45_list{})
+		}
+		listValue := &_TestAllTypes_45_list{list: &x.RepeatedBytes}
+		return protoreflect.ValueOfList(listValue)
+	case 46: // goproto.proto.test2.TestAllTypes.repeatedgroup
+		if descriptor != fd_TestAllTypes_repeatedgroup {
+			break
+		}
+		if len(x.Repeatedgroup) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_46_list{})
+		}
+		listValue := &_TestAllTypes_46_list{list: &x.Repeatedgroup}
+		return protoreflect.ValueOfList(listValue)
+	case 48: // goproto.proto.test2.TestAllTypes.repeated_nested_message
+		if descriptor != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
+		if len(x.RepeatedNestedMessage) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_48_list{})
+		}
+		listValue := &_TestAllTypes_48_list{list: &x.RepeatedNestedMessage}
+		return protoreflect.ValueOfList(listValue)
+	case 49: // goproto.proto.test2.TestAllTypes.repeated_foreign_message
+		if descriptor != fd_TestAllTypes_repeated_foreign_message {
+			break
+		}
+		if len(x.RepeatedForeignMessage) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_49_list{})
+		}
+		listValue := &_TestAllTypes_49_list{list: &x.RepeatedForeignMessage}
+		return protoreflect.ValueOfList(listValue)
+	case 51: // goproto.proto.test2.TestAllTypes.repeated_nested_enum
+		if descriptor != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
+		if len(x.RepeatedNestedEnum) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_51_list{})
+		}
+		listValue := &_TestAllTypes_51_list{list: &x.RepeatedNestedEnum}
+		return protoreflect.ValueOfList(listValue)
+	case 52: // goproto.proto.test2.TestAllTypes.repeated_foreign_enum
+		if descriptor != fd_TestAllTypes_repeated_foreign_enum {
+			break
+		}
+		if len(x.RepeatedForeignEnum) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_52_list{})
+		}
+		listValue := &_TestAllTypes_52_list{list: &x.RepeatedForeignEnum}
+		return protoreflect.ValueOfList(listValue)
+	case 53: // goproto.proto.test2.TestAllTypes.packed_int32
+		if descriptor != fd_TestAllTypes_packed_int32 {
+			break
+		}
+		if len(x.PackedInt32) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_53_list{})
+		}
+		listValue := &_TestAllTypes_53_list{list: &x.PackedInt32}
+		return protoreflect.ValueOfList(listValue)
+	case 54: // goproto.proto.test2.TestAllTypes.packed_sint64
+		if descriptor != fd_TestAllTypes_packed_sint64 {
+			break
+		}
+		if len(x.PackedSint64) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_54_list{})
+		}
+		listValue := &_TestAllTypes_54_list{list: &x.PackedSint64}
+		return protoreflect.ValueOfList(listValue)
+	case 55: // goproto.proto.test2.TestAllTypes.packed_double
+		if descriptor != fd_TestAllTypes_packed_double {
+			break
+		}
+		if len(x.PackedDouble) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_55_list{})
+		}
+		listValue := &_TestAllTypes_55_list{list: &x.PackedDouble}
+		return protoreflect.ValueOfList(listValue)
+	case 57: // goproto.proto.test2.TestAllTypes.packed_bool
+		if descriptor != fd_TestAllTypes_packed_bool {
+			break
+		}
+		if len(x.PackedBool) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_57_list{})
+		}
+		listValue := &_TestAllTypes_57_list{list: &x.PackedBool}
+		return protoreflect.ValueOfList(listValue)
+	case 56: // goproto.proto.test2.TestAllTypes.map_int32_int32
+		if descriptor != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
+		if len(x.MapInt32Int32) == 0 {
+			return protoreflect.ValueOfMap(&_TestAllTypes_56_map{})
+		}
+		mapValue := &_TestAllTypes_56_map{m: &x.MapInt32Int32}
+		return protoreflect.ValueOfMap(mapValue)
+	case 61: // goproto.proto.test2.TestAllTypes.map_sint64_sint64
+		if descriptor != fd_TestAllTypes_map_sint64_sint64 {
+			break
+		}
+		if len(x.MapSint64Sint64) == 0 {
+			return protoreflect.ValueOfMap(&_TestAllTypes_61_map{})
+		}
+		mapValue := &_TestAllTypes_61_map{m: &x.MapSint64Sint64}
+		return protoreflect.ValueOfMap(mapValue)
+	case 69: // goproto.proto.test2.TestAllTypes.map_string_string
+		if descriptor != fd_TestAllTypes_map_string_string {
+			break
+		}
+		if len(x.MapStringString) == 0 {
+			return protoreflect.ValueOfMap(&_TestAllTypes_69_map{})
+		}
+		mapValue := &_TestAllTypes_69_map{m: &x.MapStringString}
+		return protoreflect.ValueOfMap(mapValue)
+	case 70: // goproto.proto.test2.TestAllTypes.map_string_bytes
+		if descriptor != fd_TestAllTypes_map_string_bytes {
+			break
+		}
+		if len(x.MapStringBytes) == 0 {
+			return protoreflect.ValueOfMap(&_TestAllTypes_70_map{})
+		}
+		mapValue := &_TestAllTypes_70_map{m: &x.MapStringBytes}
+		return protoreflect.ValueOfMap(mapValue)
+	case 71: // goproto.proto.test2.TestAllTypes.map_string_nested_message
+		if descriptor != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
+		if len(x.MapStringNestedMessage) == 0 {
+			return protoreflect.ValueOfMap(&_TestAllTypes_71_map{})
+		}
+		mapValue := &_TestAllTypes_71_map{m: &x.MapStringNestedMessage}
+		return protoreflect.ValueOfMap(mapValue)
+	case 73: // goproto.proto.test2.TestAllTypes.map_string_nested_enum
+		if descriptor != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
+		if len(x.MapStringNestedEnum) == 0 {
+			return protoreflect.ValueOfMap(&_TestAllTypes_73_map{})
+		}
+		mapValue := &_TestAllTypes_73_map{m: &x.MapStringNestedEnum}
+		return protoreflect.ValueOfMap(mapValue)
+	case 81: // goproto.proto.test2.TestAllTypes.default_int32
+		if descriptor != fd_TestAllTypes_default_int32 {
+			break
+		}
+		if x.DefaultInt32 == nil {
+			return protoreflect.ValueOfInt32(Default_TestAllTypes_DefaultInt32)
+		}
+		value := *x.DefaultInt32
+		return protoreflect.ValueOfInt32(value)
+	case 82: // goproto.proto.test2.TestAllTypes.default_int64
+		if descriptor != fd_TestAllTypes_default_int64 {
+			break
+		}
+		if x.DefaultInt64 == nil {
+			return protoreflect.ValueOfInt64(Default_TestAllTypes_DefaultInt64)
+		}
+		value := *x.DefaultInt64
+		return protoreflect.ValueOfInt64(value)
+	case 83: // goproto.proto.test2.TestAllTypes.default_uint32
+		if descriptor != fd_TestAllTypes_default_uint32 {
+			break
+		}
+		if x.DefaultUint32 == nil {
+			return protoreflect.ValueOfUint32(Default_TestAllTypes_DefaultUint32)
+		}
+		value := *x.DefaultUint32
+		return protoreflect.ValueOfUint32(value)
+	case 84: // goproto.proto.test2.TestAllTypes.default_uint64
+		if descriptor != fd_TestAllTypes_default_uint64 {
+			break
+		}
+		if x.DefaultUint64 == nil {
+			return protoreflect.ValueOfUint64(Default_TestAllTypes_DefaultUint64)
+		}
+		value := *x.DefaultUint64
+		return protoreflect.ValueOfUint64(value)
+	case 85: // goproto.proto.test2.TestAllTypes.default_sint32
+		if descriptor != fd_TestAllTypes_default_sint32 {
+			break
+		}
+		if x.DefaultSint32 == nil {
+			return protoreflect.ValueOfInt32(Default_TestAllTypes_DefaultSint32)
+		}
+		value := *x.DefaultSint32
+		return protoreflect.ValueOfInt32(value)
+	case 86: // goproto.proto.test2.TestAllTypes.default_sint64
+		if descriptor != fd_TestAllTypes_default_sint64 {
+			break
+		}
+		if x.DefaultSint64 == nil {
+			return protoreflect.ValueOfInt64(Default_TestAllTypes_DefaultSint64)
+		}
+		value := *x.DefaultSint64
+		return protoreflect.ValueOfInt64(value)
+	case 87: // goproto.proto.test2.TestAllTypes.default_fixed32
+		if descriptor != fd_TestAllTypes_default_fixed32 {
+			break
+		}
+		if x.DefaultFixed32 == nil {
+			return protoreflect.ValueOfUint32(Default_TestAllTypes_DefaultFixed32)
+		}
+		value := *x.DefaultFixed32
+		return protoreflect.ValueOfUint32(value)
+	case 88: // goproto.proto.test2.TestAllTypes.default_fixed64
+		if descriptor != fd_TestAllTypes_default_fixed64 {
+			break
+		}
+		if x.DefaultFixed64 == nil {
+			return protoreflect.ValueOfUint64(Default_TestAllTypes_DefaultFixed64)
+		}
+		value := *x.DefaultFixed64
+		return protoreflect.ValueOfUint64(value)
+	case 89: // goproto.proto.test2.TestAllTypes.default_sfixed32
+		if descriptor != fd_TestAllTypes_default_sfixed32 {
+			break
+		}
+		if x.DefaultSfixed32 == nil {
+			return protoreflect.ValueOfInt32(Default_TestAllTypes_DefaultSfixed32)
+		}
+		value := *x.DefaultSfixed32
+		return protoreflect.ValueOfInt32(value)
+	case 80: // goproto.proto.test2.TestAllTypes.default_sfixed64
+		if descriptor != fd_TestAllTypes_default_sfixed64 {
+			break
+		}
+		if x.DefaultSfixed64 == nil {
+			return protoreflect.ValueOfInt64(Default_TestAllTypes_DefaultSfixed64)
+		}
+		value := *x.DefaultSfixed64
+		return protoreflect.ValueOfInt64(value)
+	case 91: // goproto.proto.test2.TestAllTypes.default_float
+		if descriptor != fd_TestAllTypes_default_float {
+			break
+		}
+		if x.DefaultFloat == nil {
+			return protoreflect.ValueOfFloat32(Default_TestAllTypes_DefaultFloat)
+		}
+		value := *x.DefaultFloat
+		return protoreflect.ValueOfFloat32(value)
+	case 92: // goproto.proto.test2.TestAllTypes.default_double
+		if descriptor != fd_TestAllTypes_default_double {
+			break
+		}
+		if x.DefaultDouble == nil {
+			return protoreflect.ValueOfFloat64(Default_TestAllTypes_DefaultDouble)
+		}
+		value := *x.DefaultDouble
+		return protoreflect.ValueOfFloat64(value)
+	case 93: // goproto.proto.test2.TestAllTypes.default_bool
+		if descriptor != fd_TestAllTypes_default_bool {
+			break
+		}
+		if x.DefaultBool == nil {
+			return protoreflect.ValueOfBool(Default_TestAllTypes_DefaultBool)
+		}
+		value := *x.DefaultBool
+		return protoreflect.ValueOfBool(value)
+	case 94: // goproto.proto.test2.TestAllTypes.default_string
+		if descriptor != fd_TestAllTypes_default_string {
+			break
+		}
+		if x.DefaultString == nil {
+			return protoreflect.ValueOfString(Default_TestAllTypes_DefaultString)
+		}
+		value := *x.DefaultString
+		return protoreflect.ValueOfString(value)
+	case 95: // goproto.proto.test2.TestAllTypes.default_bytes
+		if descriptor != fd_TestAllTypes_default_bytes {
+			break
+		}
+		if x.DefaultBytes == nil {
+			return protoreflect.ValueOfBytes(append([]byte(nil), Default_TestAllTypes_DefaultBytes...))
+		}
+		value := x.DefaultBytes
+		return protoreflect.ValueOfBytes(value)
+	case 96: // goproto.proto.test2.TestAllTypes.default_nested_enum
+		if descriptor != fd_TestAllTypes_default_nested_enum {
+			break
+		}
+		if x.DefaultNestedEnum == nil {
+			return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(Default_TestAllTypes_DefaultNestedEnum))
+		}
+		value := *x.DefaultNestedEnum
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case 97: // goproto.proto.test2.TestAllTypes.default_foreign_enum
+		if descriptor != fd_TestAllTypes_default_foreign_enum {
+			break
+		}
+		if x.DefaultForeignEnum == nil {
+			return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(Default_TestAllTypes_DefaultForeignEnum))
+		}
+		value := *x.DefaultForeignEnum
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case 111: // goproto.proto.test2.TestAllTypes.oneof_uint32
+		if descriptor != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfUint32(uint32(0))
+		} else if v, ok := x.OneofField.(*TestAllTypes_OneofUint32); ok {
+			return protoreflect.ValueOfUint32(v.OneofUint32)
+		} else {
+			return protoreflect.ValueOfUint32(uint32(0))
+		}
+	case 112: // goproto.proto.test2.TestAllTypes.oneof_nested_message
+		if descriptor != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfMessage((*TestAllTypes_NestedMessage)(nil).ProtoReflect())
+		} else if v, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); ok {
+			return protoreflect.ValueOfMessage(v.OneofNestedMessage.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*TestAllTypes_NestedMessage)(nil).ProtoReflect())
+		}
+	case 113: // goproto.proto.test2.TestAllTypes.oneof_string
+		if descriptor != fd_TestAllTypes_oneof_string {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfString("")
+		} else if v, ok := x.OneofField.(*TestAllTypes_OneofString); ok {
+			return protoreflect.ValueOfString(v.OneofString)
+		} else {
+			return protoreflect.ValueOfString("")
+		}
+	case 114: // goproto.proto.test2.TestAllTypes.oneof_bytes
+		if descriptor != fd_TestAllTypes_oneof_bytes {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfBytes(nil)
+		} else if v, ok := x.OneofField.(*TestAllTypes_OneofBytes); ok {
+			return protoreflect.ValueOfBytes(v.OneofBytes)
+		} else {
+			return protoreflect.ValueOfBytes(nil)
+		}
+	case 115: // goproto.proto.test2.TestAllTypes.oneof_bool
+		if descriptor != fd_TestAllTypes_oneof_bool {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfBool(false)
+		} else if v, ok := x.OneofField.(*TestAllTypes_OneofBool); ok {
+			return protoreflect.ValueOfBool(v.OneofBool)
+		} else {
+			return protoreflect.ValueOfBool(false)
+		}
+	case 116: // goproto.proto.test2.TestAllTypes.oneof_uint64
+		if descriptor != fd_TestAllTypes_oneof_uint64 {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfUint64(uint64(0))
+		} else if v, ok := x.OneofField.(*TestAllTypes_OneofUint64); ok {
+			return protoreflect.ValueOfUint64(v.OneofUint64)
+		} else {
+			return protoreflect.ValueOfUint64(uint64(0))
+		}
+	case 117: // goproto.proto.test2.TestAllTypes.oneof_float
+		if descriptor != fd_TestAllTypes_oneof_float {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfFloat32(float32(0))
+		} else if v, ok := x.OneofField.(*TestAllTypes_OneofFloat); ok {
+			return protoreflect.ValueOfFloat32(v.OneofFloat)
+		} else {
+			return protoreflect.ValueOfFloat32(float32(0))
+		}
+	case 118: // goproto.proto.test2.TestAllTypes.oneof_double
+		if descriptor != fd_TestAllTypes_oneof_double {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfFloat64(float64(0))
+		} else if v, ok := x.OneofField.(*TestAllTypes_OneofDouble); ok {
+			return protoreflect.ValueOfFloat64(v.OneofDouble)
+		} else {
+			return protoreflect.ValueOfFloat64(float64(0))
+		}
+	case 119: // goproto.proto.test2.TestAllTypes.oneof_enum
+		if descriptor != fd_TestAllTypes_oneof_enum {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfEnum(0)
+		} else if v, ok := x.OneofField.(*TestAllTypes_OneofEnum); ok {
+			return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v.OneofEnum))
+		} else {
+			return protoreflect.ValueOfEnum(0)
+		}
+	case 121: // goproto.proto.test2.TestAllTypes.oneofgroup
+		if descriptor != fd_TestAllTypes_oneofgroup {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfMessage((*TestAllTypes_OneofGroup)(nil).ProtoReflect())
+		} else if v, ok := x.OneofField.(*TestAllTypes_Oneofgroup); ok {
+			return protoreflect.ValueOfMessage(v.Oneofgroup.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*TestAllTypes_OneofGroup)(nil).ProtoReflect())
+		}
+	case 120: // goproto.proto.test2.TestAllTypes.oneof_optional_uint32
+		if descriptor != fd_TestAllTypes_oneof_optional_uint32 {
+			break
+		}
+		if x.OneofOptional == nil {
+			return protoreflect.ValueOfUint32(uint32(0))
+		} else if v, ok := x.OneofOptional.(*TestAllTypes_OneofOptionalUint32); ok {
+			return protoreflect.ValueOfUint32(v.OneofOptionalUint32)
+		} else {
+			return protoreflect.ValueOfUint32(uint32(0))
+		}
+	case 122: // goproto.proto.test2.TestAllTypes.oneof_default_sint32
+		if descriptor != fd_TestAllTypes_oneof_default_sint32 {
+			break
+		}
+		if x.OneofDefaults == nil {
+			return protoreflect.ValueOfInt32(Default_TestAllTypes_OneofDefaultSint32)
+		} else if v, ok := x.OneofDefaults.(*TestAllTypes_OneofDefaultSint32); ok {
+			return protoreflect.ValueOfInt32(v.OneofDefaultSint32)
+		} else {
+			return protoreflect.ValueOfInt32(Default_TestAllTypes_OneofDefaultSint32)
+		}
+	case 123: // goproto.proto.test2.TestAllTypes.oneof_default_string
+		if descriptor != fd_TestAllTypes_oneof_default_string {
+			break
+		}
+		if x.OneofDefaults == nil {
+			return protoreflect.ValueOfString(Default_TestAllTypes_OneofDefaultString)
+		} else if v, ok := x.OneofDefaults.(*TestAllTypes_OneofDefaultString); ok {
+			return protoreflect.ValueOfString(v.OneofDefaultString)
+		} else {
+			return protoreflect.ValueOfString(Default_TestAllTypes_OneofDefaultString)
+		}
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestAllTypes); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestAllTypes.optional_int32
+		if fd != fd_TestAllTypes_optional_int32 {
+			break
+		}
+		cv := int32(value.Int())
+		x.OptionalInt32 = &cv
+		return
+	case 2: // goproto.proto.test2.TestAllTypes.optional_int64
+		if fd != fd_TestAllTypes_optional_int64 {
+			break
+		}
+		cv := value.Int()
+		x.OptionalInt64 = &cv
+		return
+	case 3: // goproto.proto.test2.TestAllTypes.optional_uint32
+		if fd != fd_TestAllTypes_optional_uint32 {
+			break
+		}
+		cv := uint32(value.Uint())
+		x.OptionalUint32 = &cv
+		return
+	case 4: // goproto.proto.test2.TestAllTypes.optional_uint64
+		if fd != fd_TestAllTypes_optional_uint64 {
+			break
+		}
+		cv := value.Uint()
+		x.OptionalUint64 = &cv
+		return
+	case 5: // goproto.proto.test2.TestAllTypes.optional_sint32
+		if fd != fd_TestAllTypes_optional_sint32 {
+			break
+		}
+		cv := int32(value.Int())
+		x.OptionalSint32 = &cv
+		return
+	case 6: // goproto.proto.test2.TestAllTypes.optional_sint64
+		if fd != fd_TestAllTypes_optional_sint64 {
+			break
+		}
+		cv := value.Int()
+		x.OptionalSint64 = &cv
+		return
+	case 7: // goproto.proto.test2.TestAllTypes.optional_fixed32
+		if fd != fd_TestAllTypes_optional_fixed32 {
+			break
+		}
+		cv := uint32(value.Uint())
+		x.OptionalFixed32 = &cv
+		return
+	case 8: // goproto.proto.test2.TestAllTypes.optional_fixed64
+		if fd != fd_TestAllTypes_optional_fixed64 {
+			break
+		}
+		cv := value.Uint()
+		x.OptionalFixed64 = &cv
+		return
+	case 9: // goproto.proto.test2.TestAllTypes.optional_sfixed32
+		if fd != fd_TestAllTypes_optional_sfixed32 {
+			break
+		}
+		cv := int32(value.Int())
+		x.OptionalSfixed32 = &cv
+		return
+	case 10: // goproto.proto.test2.TestAllTypes.optional_sfixed64
+		if fd != fd_TestAllTypes_optional_sfixed64 {
+			break
+		}
+		cv := value.Int()
+		x.OptionalSfixed64 = &cv
+		return
+	case 11: // goproto.proto.test2.TestAllTypes.optional_float
+		if fd != fd_TestAllTypes_optional_float {
+			break
+		}
+		cv := float32(value.Float())
+		x.OptionalFloat = &cv
+		return
+	case 12: // goproto.proto.test2.TestAllTypes.optional_double
+		if fd != fd_TestAllTypes_optional_double {
+			break
+		}
+		cv := value.Float()
+		x.OptionalDouble = &cv
+		return
+	case 13: // goproto.proto.test2.TestAllTypes.optional_bool
+		if fd != fd_TestAllTypes_optional_bool {
+			break
+		}
+		cv := value.Bool()
+		x.OptionalBool = &cv
+		return
+	case 14: // goproto.proto.test2.TestAllTypes.optional_string
+		if fd != fd_TestAllTypes_optional_string {
+			break
+		}
+		cv := value.Interface().(string)
+		x.OptionalString = &cv
+		return
+	case 15: // goproto.proto.test2.TestAllTypes.optional_bytes
+		if fd != fd_TestAllTypes_optional_bytes {
+			break
+		}
+		x.OptionalBytes = value.Bytes()
+		if x.OptionalBytes == nil {
+			x.OptionalBytes = []byte{}
+		}
+		return
+	case 16: // goproto.proto.test2.TestAllTypes.optionalgroup
+		if fd != fd_TestAllTypes_optionalgroup {
+			break
+		}
+		x.Optionalgroup = value.Message().Interface().(*TestAllTypes_OptionalGroup)
+		return
+	case 18: // goproto.proto.test2.TestAllTypes.optional_nested_message
+		if fd != fd_TestAllTypes_optional_nested_message {
+			break
+		}
+		x.OptionalNestedMessage = value.Message().Interface().(*TestAllTypes_NestedMessage)
+		return
+	case 19: // goproto.proto.test2.TestAllTypes.optional_foreign_message
+		if fd != fd_TestAllTypes_optional_foreign_message {
+			break
+		}
+		x.OptionalForeignMessage = value.Message().Interface().(*ForeignMessage)
+		return
+	case 21: // goproto.proto.test2.TestAllTypes.optional_nested_enum
+		if fd != fd_TestAllTypes_optional_nested_enum {
+			break
+		}
+		cv := (TestAllTypes_NestedEnum)(value.Enum())
+		x.OptionalNestedEnum = &cv
+		return
+	case 22: // goproto.proto.test2.TestAllTypes.optional_foreign_enum
+		if fd != fd_TestAllTypes_optional_foreign_enum {
+			break
+		}
+		cv := (ForeignEnum)(value.Enum())
+		x.OptionalForeignEnum = &cv
+		return
+	case 31: // goproto.proto.test2.TestAllTypes.repeated_int32
+		if fd != fd_TestAllTypes_repeated_int32 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_31_list)
+		x.RepeatedInt32 = *clv.list
+		return
+	case 32: // goproto.proto.test2.TestAllTypes.repeated_int64
+		if fd != fd_TestAllTypes_repeated_int64 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_32_list)
+		x.RepeatedInt64 = *clv.list
+		return
+	case 33: // goproto.proto.test2.TestAllTypes.repeated_uint32
+		if fd != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_33_list)
+		x.RepeatedUint32 = *clv.list
+		return
+	case 34: // goproto.proto.test2.TestAllTypes.repeated_uint64
+		if fd != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_34_list)
+		x.RepeatedUint64 = *clv.list
+		return
+	case 35: // goproto.proto.test2.TestAllTypes.repeated_sint32
+		if fd != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_35_list)
+		x.RepeatedSint32 = *clv.list
+		return
+	case 36: // goproto.proto.test2.TestAllTypes.repeated_sint64
+		if fd != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_36_list)
+		x.RepeatedSint64 = *clv.list
+		return
+	case 37: // goproto.proto.test2.TestAllTypes.repeated_fixed32
+		if fd != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_37_list)
+		x.RepeatedFixed32 = *clv.list
+		return
+	case 38: // goproto.proto.test2.TestAllTypes.repeated_fixed64
+		if fd != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_38_list)
+		x.RepeatedFixed64 = *clv.list
+		return
+	case 39: // goproto.proto.test2.TestAllTypes.repeated_sfixed32
+		if fd != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_39_list)
+		x.RepeatedSfixed32 = *clv.list
+		return
+	case 40: // goproto.proto.test2.TestAllTypes.repeated_sfixed64
+		if fd != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_40_list)
+		x.RepeatedSfixed64 = *clv.list
+		return
+	case 41: // goproto.proto.test2.TestAllTypes.repeated_float
+		if fd != fd_TestAllTypes_repeated_float {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_41_list)
+		x.RepeatedFloat = *clv.list
+		return
+	case 42: // goproto.proto.test2.TestAllTypes.repeated_double
+		if fd != fd_TestAllTypes_repeated_double {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_42_list)
+		x.RepeatedDouble = *clv.list
+		return
+	case 43: // goproto.proto.test2.TestAllTypes.repeated_bool
+		if fd != fd_TestAllTypes_repeated_bool {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_43_list)
+		x.RepeatedBool = *clv.list
+		return
+	case 44: // goproto.proto.test2.TestAllTypes.repeated_string
+		if fd != fd_TestAllTypes_repeated_string {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_44_list)
+		x.RepeatedString = *clv.list
+		return
+	case 45: // goproto.proto.test2.TestAllTypes.repeated_bytes
+		if fd != fd_TestAllTypes_repeated_bytes {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_45_list)
+		x.RepeatedBytes = *clv.list
+		return
+	case 46: // goproto.proto.test2.TestAllTypes.repeatedgroup
+		if fd != fd_TestAllTypes_repeatedgroup {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_46_list)
+		x.Repeatedgroup = *clv.list
+		return
+	case 48: // goproto.proto.test2.TestAllTypes.repeated_nested_message
+		if fd != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_48_list)
+		x.RepeatedNestedMessage = *clv.list
+		return
+	case 49: // goproto.proto.test2.TestAllTypes.repeated_foreign_message
+		if fd != fd_TestAllTypes_repeated_foreign_message {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_49_list)
+		x.RepeatedForeignMessage = *clv.list
+		return
+	case 51: // goproto.proto.test2.TestAllTypes.repeated_nested_enum
+		if fd != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_51_list)
+		x.RepeatedNestedEnum = *clv.list
+		return
+	case 52: // goproto.proto.test2.TestAllTypes.repeated_foreign_enum
+		if fd != fd_TestAllTypes_repeated_foreign_enum {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_52_list)
+		x.RepeatedForeignEnum = *clv.list
+		return
+	case 53: // goproto.proto.test2.TestAllTypes.packed_int32
+		if fd != fd_TestAllTypes_packed_int32 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_53_list)
+		x.PackedInt32 = *clv.list
+		return
+	case 54: // goproto.proto.test2.TestAllTypes.packed_sint64
+		if fd != fd_TestAllTypes_packed_sint64 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_54_list)
+		x.PackedSint64 = *clv.list
+		return
+	case 55: // goproto.proto.test2.TestAllTypes.packed_double
+		if fd != fd_TestAllTypes_packed_double {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_55_list)
+		x.PackedDouble = *clv.list
+		return
+	case 57: // goproto.proto.test2.TestAllTypes.packed_bool
+		if fd != fd_TestAllTypes_packed_bool {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_57_list)
+		x.PackedBool = *clv.list
+		return
+	case 56: // goproto.proto.test2.TestAllTypes.map_int32_int32
+		if fd != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_TestAllTypes_56_map)
+		x.MapInt32Int32 = *cmv.m
+		return
+	case 61: // goproto.proto.test2.TestAllTypes.map_sint64_sint64
+		if fd != fd_TestAllTypes_map_sint64_sint64 {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_TestAllTypes_61_map)
+		x.MapSint64Sint64 = *cmv.m
+		return
+	case 69: // goproto.proto.test2.TestAllTypes.map_string_string
+		if fd != fd_TestAllTypes_map_string_string {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_TestAllTypes_69_map)
+		x.MapStringString = *cmv.m
+		return
+	case 70: // goproto.proto.test2.TestAllTypes.map_string_bytes
+		if fd != fd_TestAllTypes_map_string_bytes {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_TestAllTypes_70_map)
+		x.MapStringBytes = *cmv.m
+		return
+	case 71: // goproto.proto.test2.TestAllTypes.map_string_nested_message
+		if fd != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_TestAllTypes_71_map)
+		x.MapStringNestedMessage = *cmv.m
+		return
+	case 73: // goproto.proto.test2.TestAllTypes.map_string_nested_enum
+		if fd != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_TestAllTypes_73_map)
+		x.MapStringNestedEnum = *cmv.m
+		return
+	case 81: // goproto.proto.test2.TestAllTypes.default_int32
+		if fd != fd_TestAllTypes_default_int32 {
+			break
+		}
+		cv := int32(value.Int())
+		x.DefaultInt32 = &cv
+		return
+	case 82: // goproto.proto.test2.TestAllTypes.default_int64
+		if fd != fd_TestAllTypes_default_int64 {
+			break
+		}
+		cv := value.Int()
+		x.DefaultInt64 = &cv
+		return
+	case 83: // goproto.proto.test2.TestAllTypes.default_uint32
+		if fd != fd_TestAllTypes_default_uint32 {
+			break
+		}
+		cv := uint32(value.Uint())
+		x.DefaultUint32 = &cv
+		return
+	case 84: // goproto.proto.test2.TestAllTypes.default_uint64
+		if fd != fd_TestAllTypes_default_uint64 {
+			break
+		}
+		cv := value.Uint()
+		x.DefaultUint64 = &cv
+		return
+	case 85: // goproto.proto.test2.TestAllTypes.default_sint32
+		if fd != fd_TestAllTypes_default_sint32 {
+			break
+		}
+		cv := int32(value.Int())
+		x.DefaultSint32 = &cv
+		return
+	case 86: // goproto.proto.test2.TestAllTypes.default_sint64
+		if fd != fd_TestAllTypes_default_sint64 {
+			break
+		}
+		cv := value.Int()
+		x.DefaultSint64 = &cv
+		return
+	case 87: // goproto.proto.test2.TestAllTypes.default_fixed32
+		if fd != fd_TestAllTypes_default_fixed32 {
+			break
+		}
+		cv := uint32(value.Uint())
+		x.DefaultFixed32 = &cv
+		return
+	case 88: // goproto.proto.test2.TestAllTypes.default_fixed64
+		if fd != fd_TestAllTypes_default_fixed64 {
+			break
+		}
+		cv := value.Uint()
+		x.DefaultFixed64 = &cv
+		return
+	case 89: // goproto.proto.test2.TestAllTypes.default_sfixed32
+		if fd != fd_TestAllTypes_default_sfixed32 {
+			break
+		}
+		cv := int32(value.Int())
+		x.DefaultSfixed32 = &cv
+		return
+	case 80: // goproto.proto.test2.TestAllTypes.default_sfixed64
+		if fd != fd_TestAllTypes_default_sfixed64 {
+			break
+		}
+		cv := value.Int()
+		x.DefaultSfixed64 = &cv
+		return
+	case 91: // goproto.proto.test2.TestAllTypes.default_float
+		if fd != fd_TestAllTypes_default_float {
+			break
+		}
+		cv := float32(value.Float())
+		x.DefaultFloat = &cv
+		return
+	case 92: // goproto.proto.test2.TestAllTypes.default_double
+		if fd != fd_TestAllTypes_default_double {
+			break
+		}
+		cv := value.Float()
+		x.DefaultDouble = &cv
+		return
+	case 93: // goproto.proto.test2.TestAllTypes.default_bool
+		if fd != fd_TestAllTypes_default_bool {
+			break
+		}
+		cv := value.Bool()
+		x.DefaultBool = &cv
+		return
+	case 94: // goproto.proto.test2.TestAllTypes.default_string
+		if fd != fd_TestAllTypes_default_string {
+			break
+		}
+		cv := value.Interface().(string)
+		x.DefaultString = &cv
+		return
+	case 95: // goproto.proto.test2.TestAllTypes.default_bytes
+		if fd != fd_TestAllTypes_default_bytes {
+			break
+		}
+		x.DefaultBytes = value.Bytes()
+		if x.DefaultBytes == nil {
+			x.DefaultBytes = []byte{}
+		}
+		return
+	case 96: // goproto.proto.test2.TestAllTypes.default_nested_enum
+		if fd != fd_TestAllTypes_default_nested_enum {
+			break
+		}
+		cv := (TestAllTypes_NestedEnum)(value.Enum())
+		x.DefaultNestedEnum = &cv
+		return
+	case 97: // goproto.proto.test2.TestAllTypes.default_foreign_enum
+		if fd != fd_TestAllTypes_default_foreign_enum {
+			break
+		}
+		cv := (ForeignEnum)(value.Enum())
+		x.DefaultForeignEnum = &cv
+		return
+	case 111: // goproto.proto.test2.TestAllTypes.oneof_uint32
+		if fd != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
+		cv := uint32(value.Uint())
+		x.OneofField = &TestAllTypes_OneofUint32{OneofUint32: cv}
+		return
+	case 112: // goproto.proto.test2.TestAllTypes.oneof_nested_message
+		if fd != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
+		cv := value.Message().Interface().(*TestAllTypes_NestedMessage)
+		x.OneofField = &TestAllTypes_OneofNestedMessage{OneofNestedMessage: cv}
+		return
+	case 113: // goproto.proto.test2.TestAllTypes.oneof_string
+		if fd != fd_TestAllTypes_oneof_string {
+			break
+		}
+		cv := value.Interface().(string)
+		x.OneofField = &TestAllTypes_OneofString{OneofString: cv}
+		return
+	case 114: // goproto.proto.test2.TestAllTypes.oneof_bytes
+		if fd != fd_TestAllTypes_oneof_bytes {
+			break
+		}
+		cv := value.Bytes()
+		x.OneofField = &TestAllTypes_OneofBytes{OneofBytes: cv}
+		return
+	case 115: // goproto.proto.test2.TestAllTypes.oneof_bool
+		if fd != fd_TestAllTypes_oneof_bool {
+			break
+		}
+		cv := value.Bool()
+		x.OneofField = &TestAllTypes_OneofBool{OneofBool: cv}
+		return
+	case 116: // goproto.proto.test2.TestAllTypes.oneof_uint64
+		if fd != fd_TestAllTypes_oneof_uint64 {
+			break
+		}
+		cv := value.Uint()
+		x.OneofField = &TestAllTypes_OneofUint64{OneofUint64: cv}
+		return
+	case 117: // goproto.proto.test2.TestAllTypes.oneof_float
+		if fd != fd_TestAllTypes_oneof_float {
+			break
+		}
+		cv := float32(value.Float())
+		x.OneofField = &TestAllTypes_OneofFloat{OneofFloat: cv}
+		return
+	case 118: // goproto.proto.test2.TestAllTypes.oneof_double
+		if fd != fd_TestAllTypes_oneof_double {
+			break
+		}
+		cv := value.Float()
+		x.OneofField = &TestAllTypes_OneofDouble{OneofDouble: cv}
+		return
+	case 119: // goproto.proto.test2.TestAllTypes.oneof_enum
+		if fd != fd_TestAllTypes_oneof_enum {
+			break
+		}
+		cv := (TestAllTypes_NestedEnum)(value.Enum())
+		x.OneofField = &TestAllTypes_OneofEnum{OneofEnum: cv}
+		return
+	case 121: // goproto.proto.test2.TestAllTypes.oneofgroup
+		if fd != fd_TestAllTypes_oneofgroup {
+			break
+		}
+		cv := value.Message().Interface().(*TestAllTypes_OneofGroup)
+		x.OneofField = &TestAllTypes_Oneofgroup{Oneofgroup: cv}
+		return
+	case 120: // goproto.proto.test2.TestAllTypes.oneof_optional_uint32
+		if fd != fd_TestAllTypes_oneof_optional_uint32 {
+			break
+		}
+		cv := uint32(value.Uint())
+		x.OneofOptional = &TestAllTypes_OneofOptionalUint32{OneofOptionalUint32: cv}
+		return
+	case 122: // goproto.proto.test2.TestAllTypes.oneof_default_sint32
+		if fd != fd_TestAllTypes_oneof_default_sint32 {
+			break
+		}
+		cv := int32(value.Int())
+		x.OneofDefaults = &TestAllTypes_OneofDefaultSint32{OneofDefaultSint32: cv}
+		return
+	case 123: // goproto.proto.test2.TestAllTypes.oneof_default_string
+		if fd != fd_TestAllTypes_oneof_default_string {
+			break
+		}
+		cv := value.Interface().(string)
+		x.OneofDefaults = &TestAllTypes_OneofDefaultString{OneofDefaultString: cv}
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 16: // goproto.proto.test2.TestAllTypes.optionalgroup
+		if fd != fd_TestAllTypes_optionalgroup {
+			break
+		}
+		if x.Optionalgroup == nil {
+			x.Optionalgroup = new(TestAllTypes_OptionalGroup)
+		}
+		return protoreflect.ValueOfMessage(x.Optionalgroup.ProtoReflect())
+	case 18: // goproto.proto.test2.TestAllTypes.optional_nested_message
+		if fd != fd_TestAllTypes_optional_nested_message {
+			break
+		}
+		if x.OptionalNestedMessage == nil {
+			x.OptionalNestedMessage = new(TestAllTypes_NestedMessage)
+		}
+		return protoreflect.ValueOfMessage(x.OptionalNestedMessage.ProtoReflect())
+	case 19: // goproto.proto.test2.TestAllTypes.optional_foreign_message
+		if fd != fd_TestAllTypes_optional_foreign_message {
+			break
+		}
+		if x.OptionalForeignMessage == nil {
+			x.OptionalForeignMessage = new(ForeignMessage)
+		}
+		return protoreflect.ValueOfMessage(x.OptionalForeignMessage.ProtoReflect())
+	case 31: // goproto.proto.test2.TestAllTypes.repeated_int32
+		if fd != fd_TestAllTypes_repeated_int32 {
+			break
+		}
+		if x.RepeatedInt32 == nil {
+			x.RepeatedInt32 = []int32{}
+		}
+		value := &_TestAllTypes_31_list{list: &x.RepeatedInt32}
+		return protoreflect.ValueOfList(value)
+	case 32: // goproto.proto.test2.TestAllTypes.repeated_int64
+		if fd != fd_TestAllTypes_repeated_int64 {
+			break
+		}
+		if x.RepeatedInt64 == nil {
+			x.RepeatedInt64 = []int64{}
+		}
+		value := &_TestAllTypes_32_list{list: &x.RepeatedInt64}
+		return protoreflect.ValueOfList(value)
+	case 33: // goproto.proto.test2.TestAllTypes.repeated_uint32
+		if fd != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
+		if x.RepeatedUint32 == nil {
+			x.RepeatedUint32 = []uint32{}
+		}
+		value := &_TestAllTypes_33_list{list: &x.RepeatedUint32}
+		return protoreflect.ValueOfList(value)
+	case 34: // goproto.proto.test2.TestAllTypes.repeated_uint64
+		if fd != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
+		if x.RepeatedUint64 == nil {
+			x.RepeatedUint64 = []uint64{}
+		}
+		value := &_TestAllTypes_34_list{list: &x.RepeatedUint64}
+		return protoreflect.ValueOfList(value)
+	case 35: // goproto.proto.test2.TestAllTypes.repeated_sint32
+		if fd != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
+		if x.RepeatedSint32 == nil {
+			x.RepeatedSint32 = []int32{}
+		}
+		value := &_TestAllTypes_35_list{list: &x.RepeatedSint32}
+		return protoreflect.ValueOfList(value)
+	case 36: // goproto.proto.test2.TestAllTypes.repeated_sint64
+		if fd != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
+		if x.RepeatedSint64 == nil {
+			x.RepeatedSint64 = []int64{}
+		}
+		value := &_TestAllTypes_36_list{list: &x.RepeatedSint64}
+		return protoreflect.ValueOfList(value)
+	case 37: // goproto.proto.test2.TestAllTypes.repeated_fixed32
+		if fd != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
+		if x.RepeatedFixed32 == nil {
+			x.RepeatedFixed32 = []uint32{}
+		}
+		value := &_TestAllTypes_37_list{list: &x.RepeatedFixed32}
+		return protoreflect.ValueOfList(value)
+	case 38: // goproto.proto.test2.TestAllTypes.repeated_fixed64
+		if fd != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
+		if x.RepeatedFixed64 == nil {
+			x.RepeatedFixed64 = []uint64{}
+		}
+		value := &_TestAllTypes_38_list{list: &x.RepeatedFixed64}
+		return protoreflect.ValueOfList(value)
+	case 39: // goproto.proto.test2.TestAllTypes.repeated_sfixed32
+		if fd != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
+		if x.RepeatedSfixed32 == nil {
+			x.RepeatedSfixed32 = []int32{}
+		}
+		value := &_TestAllTypes_39_list{list: &x.RepeatedSfixed32}
+		return protoreflect.ValueOfList(value)
+	case 40: // goproto.proto.test2.TestAllTypes.repeated_sfixed64
+		if fd != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
+		if x.RepeatedSfixed64 == nil {
+			x.RepeatedSfixed64 = []int64{}
+		}
+		value := &_TestAllTypes_40_list{list: &x.RepeatedSfixed64}
+		return protoreflect.ValueOfList(value)
+	case 41: // goproto.proto.test2.TestAllTypes.repeated_float
+		if fd != fd_TestAllTypes_repeated_float {
+			break
+		}
+		if x.RepeatedFloat == nil {
+			x.RepeatedFloat = []float32{}
+		}
+		value := &_TestAllTypes_41_list{list: &x.RepeatedFloat}
+		return protoreflect.ValueOfList(value)
+	case 42: // goproto.proto.test2.TestAllTypes.repeated_double
+		if fd != fd_TestAllTypes_repeated_double {
+			break
+		}
+		if x.RepeatedDouble == nil {
+			x.RepeatedDouble = []float64{}
+		}
+		value := &_TestAllTypes_42_list{list: &x.RepeatedDouble}
+		return protoreflect.ValueOfList(value)
+	case 43: // goproto.proto.test2.TestAllTypes.repeated_bool
+		if fd != fd_TestAllTypes_repeated_bool {
+			break
+		}
+		if x.RepeatedBool == nil {
+			x.RepeatedBool = []bool{}
+		}
+		value := &_TestAllTypes_43_list{list: &x.RepeatedBool}
+		return protoreflect.ValueOfList(value)
+	case 44: // goproto.proto.test2.TestAllTypes.repeated_string
+		if fd != fd_TestAllTypes_repeated_string {
+			break
+		}
+		if x.RepeatedString == nil {
+			x.RepeatedString = []string{}
+		}
+		value := &_TestAllTypes_44_list{list: &x.RepeatedString}
+		return protoreflect.ValueOfList(value)
+	case 45: // goproto.proto.test2.TestAllTypes.repeated_bytes
+		if fd != fd_TestAllTypes_repeated_bytes {
+			break
+		}
+		if x.RepeatedBytes == nil {
+			x.RepeatedBytes = [][]byte{}
+		}
+		value := &_TestAllTypes_45_list{list: &x.RepeatedBytes}
+		return protoreflect.ValueOfList(value)
+	case 46: // goproto.proto.test2.TestAllTypes.repeatedgroup
+		if fd != fd_TestAllTypes_repeatedgroup {
+			break
+		}
+		if x.Repeatedgroup == nil {
+			x.Repeatedgroup = []*TestAllTypes_RepeatedGroup{}
+		}
+		value := &_TestAllTypes_46_list{list: &x.Repeatedgroup}
+		return protoreflect.ValueOfList(value)
+	case 48: // goproto.proto.test2.TestAllTypes.repeated_nested_message
+		if fd != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
+		if x.RepeatedNestedMessage == nil {
+			x.RepeatedNestedMessage = []*TestAllTypes_NestedMessage{}
+		}
+		value := &_TestAllTypes_48_list{list: &x.RepeatedNestedMessage}
+		return protoreflect.ValueOfList(value)
+	case 49: // goproto.proto.test2.TestAllTypes.repeated_foreign_message
+		if fd != fd_TestAllTypes_repeated_foreign_message {
+			break
+		}
+		if x.RepeatedForeignMessage == nil {
+			x.RepeatedForeignMessage = []*ForeignMessage{}
+		}
+		value := &_TestAllTypes_49_list{list: &x.RepeatedForeignMessage}
+		return protoreflect.ValueOfList(value)
+	case 51: // goproto.proto.test2.TestAllTypes.repeated_nested_enum
+		if fd != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
+		if x.RepeatedNestedEnum == nil {
+			x.RepeatedNestedEnum = []TestAllTypes_NestedEnum{}
+		}
+		value := &_TestAllTypes_51_list{list: &x.RepeatedNestedEnum}
+		return protoreflect.ValueOfList(value)
+	case 52: // goproto.proto.test2.TestAllTypes.repeated_foreign_enum
+		if fd != fd_TestAllTypes_repeated_foreign_enum {
+			break
+		}
+		if x.RepeatedForeignEnum == nil {
+			x.RepeatedForeignEnum = []ForeignEnum{}
+		}
+		value := &_TestAllTypes_52_list{list: &x.RepeatedForeignEnum}
+		return protoreflect.ValueOfList(value)
+	case 53: // goproto.proto.test2.TestAllTypes.packed_int32
+		if fd != fd_TestAllTypes_packed_int32 {
+			break
+		}
+		if x.PackedInt32 == nil {
+			x.PackedInt32 = []int32{}
+		}
+		value := &_TestAllTypes_53_list{list: &x.PackedInt32}
+		return protoreflect.ValueOfList(value)
+	case 54: // goproto.proto.test2.TestAllTypes.packed_sint64
+		if fd != fd_TestAllTypes_packed_sint64 {
+			break
+		}
+		if x.PackedSint64 == nil {
+			x.PackedSint64 = []int64{}
+		}
+		value := &_TestAllTypes_54_list{list: &x.PackedSint64}
+		return protoreflect.ValueOfList(value)
+	case 55: // goproto.proto.test2.TestAllTypes.packed_double
+		if fd != fd_TestAllTypes_packed_double {
+			break
+		}
+		if x.PackedDouble == nil {
+			x.PackedDouble = []float64{}
+		}
+		value := &_TestAllTypes_55_list{list: &x.PackedDouble}
+		return protoreflect.ValueOfList(value)
+	case 57: // goproto.proto.test2.TestAllTypes.packed_bool
+		if fd != fd_TestAllTypes_packed_bool {
+			break
+		}
+		if x.PackedBool == nil {
+			x.PackedBool = []bool{}
+		}
+		value := &_TestAllTypes_57_list{list: &x.PackedBool}
+		return protoreflect.ValueOfList(value)
+	case 56: // goproto.proto.test2.TestAllTypes.map_int32_int32
+		if fd != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
+		if x.MapInt32Int32 == nil {
+			x.MapInt32Int32 = make(map[int32]int32)
+		}
+		value := &_TestAllTypes_56_map{m: &x.MapInt32Int32}
+		return protoreflect.ValueOfMap(value)
+	case 61: // goproto.proto.test2.TestAllTypes.map_sint64_sint64
+		if fd != fd_TestAllTypes_map_sint64_sint64 {
+			break
+		}
+		if x.MapSint64Sint64 == nil {
+			x.MapSint64Sint64 = make(map[int64]int64)
+		}
+		value := &_TestAllTypes_61_map{m: &x.MapSint64Sint64}
+		return protoreflect.ValueOfMap(value)
+	case 69: // goproto.proto.test2.TestAllTypes.map_string_string
+		if fd != fd_TestAllTypes_map_string_string {
+			break
+		}
+		if x.MapStringString == nil {
+			x.MapStringString = make(map[string]string)
+		}
+		value := &_TestAllTypes_69_map{m: &x.MapStringString}
+		return protoreflect.ValueOfMap(value)
+	case 70: // goproto.proto.test2.TestAllTypes.map_string_bytes
+		if fd != fd_TestAllTypes_map_string_bytes {
+			break
+		}
+		if x.MapStringBytes == nil {
+			x.MapStringBytes = make(map[string][]byte)
+		}
+		value := &_TestAllTypes_70_map{m: &x.MapStringBytes}
+		return protoreflect.ValueOfMap(value)
+	case 71: // goproto.proto.test2.TestAllTypes.map_string_nested_message
+		if fd != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
+		if x.MapStringNestedMessage == nil {
+			x.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage)
+		}
+		value := &_TestAllTypes_71_map{m: &x.MapStringNestedMessage}
+		return protoreflect.ValueOfMap(value)
+	case 73: // goproto.proto.test2.TestAllTypes.map_string_nested_enum
+		if fd != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
+		if x.MapStringNestedEnum == nil {
+			x.MapStringNestedEnum = make(map[string]TestAllTypes_NestedEnum)
+		}
+		value := &_TestAllTypes_73_map{m: &x.MapStringNestedEnum}
+		return protoreflect.ValueOfMap(value)
+	case 112: // goproto.proto.test2.TestAllTypes.oneof_nested_message
+		if fd != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
+		if x.OneofField == nil {
+			value := &TestAllTypes_NestedMessage{}
+			oneofValue := &TestAllTypes_OneofNestedMessage{OneofNestedMessage: value}
+			x.OneofField = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.OneofField.(type) {
+		case *TestAllTypes_OneofNestedMessage:
+			return protoreflect.ValueOfMessage(m.OneofNestedMessage.ProtoReflect())
+		default:
+			value := &TestAllTypes_NestedMessage{}
+			oneofValue := &TestAllTypes_OneofNestedMessage{OneofNestedMessage: value}
+			x.OneofField = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case 121: // goproto.proto.test2.TestAllTypes.oneofgroup
+		if fd != fd_TestAllTypes_oneofgroup {
+			break
+		}
+		if x.OneofField == nil {
+			value := &TestAllTypes_OneofGroup{}
+			oneofValue := &TestAllTypes_Oneofgroup{Oneofgroup: value}
+			x.OneofField = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.OneofField.(type) {
+		case *TestAllTypes_Oneofgroup:
+			return protoreflect.ValueOfMessage(m.Oneofgroup.ProtoReflect())
+		default:
+			value := &TestAllTypes_OneofGroup{}
+			oneofValue := &TestAllTypes_Oneofgroup{Oneofgroup: value}
+			x.OneofField = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case 1: // goproto.proto.test2.TestAllTypes.optional_int32
+		if fd != fd_TestAllTypes_optional_int32 {
+			break
+		}
+		panic(fmt.Errorf("field optional_int32 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 2: // goproto.proto.test2.TestAllTypes.optional_int64
+		if fd != fd_TestAllTypes_optional_int64 {
+			break
+		}
+		panic(fmt.Errorf("field optional_int64 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 3: // goproto.proto.test2.TestAllTypes.optional_uint32
+		if fd != fd_TestAllTypes_optional_uint32 {
+			break
+		}
+		panic(fmt.Errorf("field optional_uint32 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 4: // goproto.proto.test2.TestAllTypes.optional_uint64
+		if fd != fd_TestAllTypes_optional_uint64 {
+			break
+		}
+		panic(fmt.Errorf("field optional_uint64 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 5: // goproto.proto.test2.TestAllTypes.optional_sint32
+		if fd != fd_TestAllTypes_optional_sint32 {
+			break
+		}
+		panic(fmt.Errorf("field optional_sint32 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 6: // goproto.proto.test2.TestAllTypes.optional_sint64
+		if fd != fd_TestAllTypes_optional_sint64 {
+			break
+		}
+		panic(fmt.Errorf("field optional_sint64 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 7: // goproto.proto.test2.TestAllTypes.optional_fixed32
+		if fd != fd_TestAllTypes_optional_fixed32 {
+			break
+		}
+		panic(fmt.Errorf("field optional_fixed32 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 8: // goproto.proto.test2.TestAllTypes.optional_fixed64
+		if fd != fd_TestAllTypes_optional_fixed64 {
+			break
+		}
+		panic(fmt.Errorf("field optional_fixed64 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 9: // goproto.proto.test2.TestAllTypes.optional_sfixed32
+		if fd != fd_TestAllTypes_optional_sfixed32 {
+			break
+		}
+		panic(fmt.Errorf("field optional_sfixed32 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 10: // goproto.proto.test2.TestAllTypes.optional_sfixed64
+		if fd != fd_TestAllTypes_optional_sfixed64 {
+			break
+		}
+		panic(fmt.Errorf("field optional_sfixed64 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 11: // goproto.proto.test2.TestAllTypes.optional_float
+		if fd != fd_TestAllTypes_optional_float {
+			break
+		}
+		panic(fmt.Errorf("field optional_float of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 12: // goproto.proto.test2.TestAllTypes.optional_double
+		if fd != fd_TestAllTypes_optional_double {
+			break
+		}
+		panic(fmt.Errorf("field optional_double of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 13: // goproto.proto.test2.TestAllTypes.optional_bool
+		if fd != fd_TestAllTypes_optional_bool {
+			break
+		}
+		panic(fmt.Errorf("field optional_bool of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 14: // goproto.proto.test2.TestAllTypes.optional_string
+		if fd != fd_TestAllTypes_optional_string {
+			break
+		}
+		panic(fmt.Errorf("field optional_string of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 15: // goproto.proto.test2.TestAllTypes.optional_bytes
+		if fd != fd_TestAllTypes_optional_bytes {
+			break
+		}
+		panic(fmt.Errorf("field optional_bytes of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 21: // goproto.proto.test2.TestAllTypes.optional_nested_enum
+		if fd != fd_TestAllTypes_optional_nested_enum {
+			break
+		}
+		panic(fmt.Errorf("field optional_nested_enum of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 22: // goproto.proto.test2.TestAllTypes.optional_foreign_enum
+		if fd != fd_TestAllTypes_optional_foreign_enum {
+			break
+		}
+		panic(fmt.Errorf("field optional_foreign_enum of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 81: // goproto.proto.test2.TestAllTypes.default_int32
+		if fd != fd_TestAllTypes_default_int32 {
+			break
+		}
+		panic(fmt.Errorf("field default_int32 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 82: // goproto.proto.test2.TestAllTypes.default_int64
+		if fd != fd_TestAllTypes_default_int64 {
+			break
+		}
+		panic(fmt.Errorf("field default_int64 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 83: // goproto.proto.test2.TestAllTypes.default_uint32
+		if fd != fd_TestAllTypes_default_uint32 {
+			break
+		}
+		panic(fmt.Errorf("field default_uint32 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 84: // goproto.proto.test2.TestAllTypes.default_uint64
+		if fd != fd_TestAllTypes_default_uint64 {
+			break
+		}
+		panic(fmt.Errorf("field default_uint64 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 85: // goproto.proto.test2.TestAllTypes.default_sint32
+		if fd != fd_TestAllTypes_default_sint32 {
+			break
+		}
+		panic(fmt.Errorf("field default_sint32 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 86: // goproto.proto.test2.TestAllTypes.default_sint64
+		if fd != fd_TestAllTypes_default_sint64 {
+			break
+		}
+		panic(fmt.Errorf("field default_sint64 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 87: // goproto.proto.test2.TestAllTypes.default_fixed32
+		if fd != fd_TestAllTypes_default_fixed32 {
+			break
+		}
+		panic(fmt.Errorf("field default_fixed32 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 88: // goproto.proto.test2.TestAllTypes.default_fixed64
+		if fd != fd_TestAllTypes_default_fixed64 {
+			break
+		}
+		panic(fmt.Errorf("field default_fixed64 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 89: // goproto.proto.test2.TestAllTypes.default_sfixed32
+		if fd != fd_TestAllTypes_default_sfixed32 {
+			break
+		}
+		panic(fmt.Errorf("field default_sfixed32 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 80: // goproto.proto.test2.TestAllTypes.default_sfixed64
+		if fd != fd_TestAllTypes_default_sfixed64 {
+			break
+		}
+		panic(fmt.Errorf("field default_sfixed64 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 91: // goproto.proto.test2.TestAllTypes.default_float
+		if fd != fd_TestAllTypes_default_float {
+			break
+		}
+		panic(fmt.Errorf("field default_float of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 92: // goproto.proto.test2.TestAllTypes.default_double
+		if fd != fd_TestAllTypes_default_double {
+			break
+		}
+		panic(fmt.Errorf("field default_double of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 93: // goproto.proto.test2.TestAllTypes.default_bool
+		if fd != fd_TestAllTypes_default_bool {
+			break
+		}
+		panic(fmt.Errorf("field default_bool of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 94: // goproto.proto.test2.TestAllTypes.default_string
+		if fd != fd_TestAllTypes_default_string {
+			break
+		}
+		panic(fmt.Errorf("field default_string of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 95: // goproto.proto.test2.TestAllTypes.default_bytes
+		if fd != fd_TestAllTypes_default_bytes {
+			break
+		}
+		panic(fmt.Errorf("field default_bytes of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 96: // goproto.proto.test2.TestAllTypes.default_nested_enum
+		if fd != fd_TestAllTypes_default_nested_enum {
+			break
+		}
+		panic(fmt.Errorf("field default_nested_enum of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 97: // goproto.proto.test2.TestAllTypes.default_foreign_enum
+		if fd != fd_TestAllTypes_default_foreign_enum {
+			break
+		}
+		panic(fmt.Errorf("field default_foreign_enum of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 111: // goproto.proto.test2.TestAllTypes.oneof_uint32
+		if fd != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
+		panic(fmt.Errorf("field oneof_uint32 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 113: // goproto.proto.test2.TestAllTypes.oneof_string
+		if fd != fd_TestAllTypes_oneof_string {
+			break
+		}
+		panic(fmt.Errorf("field oneof_string of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 114: // goproto.proto.test2.TestAllTypes.oneof_bytes
+		if fd != fd_TestAllTypes_oneof_bytes {
+			break
+		}
+		panic(fmt.Errorf("field oneof_bytes of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 115: // goproto.proto.test2.TestAllTypes.oneof_bool
+		if fd != fd_TestAllTypes_oneof_bool {
+			break
+		}
+		panic(fmt.Errorf("field oneof_bool of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 116: // goproto.proto.test2.TestAllTypes.oneof_uint64
+		if fd != fd_TestAllTypes_oneof_uint64 {
+			break
+		}
+		panic(fmt.Errorf("field oneof_uint64 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 117: // goproto.proto.test2.TestAllTypes.oneof_float
+		if fd != fd_TestAllTypes_oneof_float {
+			break
+		}
+		panic(fmt.Errorf("field oneof_float of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 118: // goproto.proto.test2.TestAllTypes.oneof_double
+		if fd != fd_TestAllTypes_oneof_double {
+			break
+		}
+		panic(fmt.Errorf("field oneof_double of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 119: // goproto.proto.test2.TestAllTypes.oneof_enum
+		if fd != fd_TestAllTypes_oneof_enum {
+			break
+		}
+		panic(fmt.Errorf("field oneof_enum of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 120: // goproto.proto.test2.TestAllTypes.oneof_optional_uint32
+		if fd != fd_TestAllTypes_oneof_optional_uint32 {
+			break
+		}
+		panic(fmt.Errorf("field oneof_optional_uint32 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 122: // goproto.proto.test2.TestAllTypes.oneof_default_sint32
+		if fd != fd_TestAllTypes_oneof_default_sint32 {
+			break
+		}
+		panic(fmt.Errorf("field oneof_default_sint32 of message goproto.proto.test2.TestAllTypes is not mutable"))
+	case 123: // goproto.proto.test2.TestAllTypes.oneof_default_string
+		if fd != fd_TestAllTypes_oneof_default_string {
+			break
+		}
+		panic(fmt.Errorf("field oneof_default_string of message goproto.proto.test2.TestAllTypes is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestAllTypes) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestAllTypes.optional_int32
+		if fd != fd_TestAllTypes_optional_int32 {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 2: // goproto.proto.test2.TestAllTypes.optional_int64
+		if fd != fd_TestAllTypes_optional_int64 {
+			break
+		}
+		return protoreflect.ValueOfInt64(int64(0))
+	case 3: // goproto.proto.test2.TestAllTypes.optional_uint32
+		if fd != fd_TestAllTypes_optional_uint32 {
+			break
+		}
+		return protoreflect.ValueOfUint32(uint32(0))
+	case 4: // goproto.proto.test2.TestAllTypes.optional_uint64
+		if fd != fd_TestAllTypes_optional_uint64 {
+			break
+		}
+		return protoreflect.ValueOfUint64(uint64(0))
+	case 5: // goproto.proto.test2.TestAllTypes.optional_sint32
+		if fd != fd_TestAllTypes_optional_sint32 {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 6: // goproto.proto.test2.TestAllTypes.optional_sint64
+		if fd != fd_TestAllTypes_optional_sint64 {
+			break
+		}
+		return protoreflect.ValueOfInt64(int64(0))
+	case 7: // goproto.proto.test2.TestAllTypes.optional_fixed32
+		if fd != fd_TestAllTypes_optional_fixed32 {
+			break
+		}
+		return protoreflect.ValueOfUint32(uint32(0))
+	case 8: // goproto.proto.test2.TestAllTypes.optional_fixed64
+		if fd != fd_TestAllTypes_optional_fixed64 {
+			break
+		}
+		return protoreflect.ValueOfUint64(uint64(0))
+	case 9: // goproto.proto.test2.TestAllTypes.optional_sfixed32
+		if fd != fd_TestAllTypes_optional_sfixed32 {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 10: // goproto.proto.test2.TestAllTypes.optional_sfixed64
+		if fd != fd_TestAllTypes_optional_sfixed64 {
+			break
+		}
+		return protoreflect.ValueOfInt64(int64(0))
+	case 11: // goproto.proto.test2.TestAllTypes.optional_float
+		if fd != fd_TestAllTypes_optional_float {
+			break
+		}
+		return protoreflect.ValueOfFloat32(float32(0))
+	case 12: // goproto.proto.test2.TestAllTypes.optional_double
+		if fd != fd_TestAllTypes_optional_double {
+			break
+		}
+		return protoreflect.ValueOfFloat64(float64(0))
+	case 13: // goproto.proto.test2.TestAllTypes.optional_bool
+		if fd != fd_TestAllTypes_optional_bool {
+			break
+		}
+		return protoreflect.ValueOfBool(false)
+	case 14: // goproto.proto.test2.TestAllTypes.optional_string
+		if fd != fd_TestAllTypes_optional_string {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 15: // goproto.proto.test2.TestAllTypes.optional_bytes
+		if fd != fd_TestAllTypes_optional_bytes {
+			break
+		}
+		return protoreflect.ValueOfBytes(nil)
+	case 16: // goproto.proto.test2.TestAllTypes.optionalgroup
+		if fd != fd_TestAllTypes_optionalgroup {
+			break
+		}
+		m := new(TestAllTypes_OptionalGroup)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 18: // goproto.proto.test2.TestAllTypes.optional_nested_message
+		if fd != fd_TestAllTypes_optional_nested_message {
+			break
+		}
+		m := new(TestAllTypes_NestedMessage)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 19: // goproto.proto.test2.TestAllTypes.optional_foreign_message
+		if fd != fd_TestAllTypes_optional_foreign_message {
+			break
+		}
+		m := new(ForeignMessage)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 21: // goproto.proto.test2.TestAllTypes.optional_nested_enum
+		if fd != fd_TestAllTypes_optional_nested_enum {
+			break
+		}
+		return protoreflect.ValueOfEnum(0)
+	case 22: // goproto.proto.test2.TestAllTypes.optional_foreign_enum
+		if fd != fd_TestAllTypes_optional_foreign_enum {
+			break
+		}
+		return protoreflect.ValueOfEnum(4)
+	case 31: // goproto.proto.test2.TestAllTypes.repeated_int32
+		if fd != fd_TestAllTypes_repeated_int32 {
+			break
+		}
+		list := []int32{}
+		return protoreflect.ValueOfList(&_TestAllTypes_31_list{list: &list})
+	case 32: // goproto.proto.test2.TestAllTypes.repeated_int64
+		if fd != fd_TestAllTypes_repeated_int64 {
+			break
+		}
+		list := []int64{}
+		return protoreflect.ValueOfList(&_TestAllTypes_32_list{list: &list})
+	case 33: // goproto.proto.test2.TestAllTypes.repeated_uint32
+		if fd != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
+		list := []uint32{}
+		return protoreflect.ValueOfList(&_TestAllTypes_33_list{list: &list})
+	case 34: // goproto.proto.test2.TestAllTypes.repeated_uint64
+		if fd != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_TestAllTypes_34_list{list: &list})
+	case 35: // goproto.proto.test2.TestAllTypes.repeated_sint32
+		if fd != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
+		list := []int32{}
+		return protoreflect.ValueOfList(&_TestAllTypes_35_list{list: &list})
+	case 36: // goproto.proto.test2.TestAllTypes.repeated_sint64
+		if fd != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
+		list := []int64{}
+		return protoreflect.ValueOfList(&_TestAllTypes_36_list{list: &list})
+	case 37: // goproto.proto.test2.TestAllTypes.repeated_fixed32
+		if fd != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
+		list := []uint32{}
+		return protoreflect.ValueOfList(&_TestAllTypes_37_list{list: &list})
+	case 38: // goproto.proto.test2.TestAllTypes.repeated_fixed64
+		if fd != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_TestAllTypes_38_list{list: &list})
+	case 39: // goproto.proto.test2.TestAllTypes.repeated_sfixed32
+		if fd != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
+		list := []int32{}
+		return protoreflect.ValueOfList(&_TestAllTypes_39_list{list: &list})
+	case 40: // goproto.proto.test2.TestAllTypes.repeated_sfixed64
+		if fd != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
+		list := []int64{}
+		return protoreflect.ValueOfList(&_TestAllTypes_40_list{list: &list})
+	case 41: // goproto.proto.test2.TestAllTypes.repeated_float
+		if fd != fd_TestAllTypes_repeated_float {
+			break
+		}
+		list := []float32{}
+		return protoreflect.ValueOfList(&_TestAllTypes_41_list{list: &list})
+	case 42: // goproto.proto.test2.TestAllTypes.repeated_double
+		if fd != fd_TestAllTypes_repeated_double {
+			break
+		}
+		list := []float64{}
+		return protoreflect.ValueOfList(&_TestAllTypes_42_list{list: &list})
+	case 43: // goproto.proto.test2.TestAllTypes.repeated_bool
+		if fd != fd_TestAllTypes_repeated_bool {
+			break
+		}
+		list := []bool{}
+		return protoreflect.ValueOfList(&_TestAllTypes_43_list{list: &list})
+	case 44: // goproto.proto.test2.TestAllTypes.repeated_string
+		if fd != fd_TestAllTypes_repeated_string {
+			break
+		}
+		list := []string{}
+		return protoreflect.ValueOfList(&_TestAllTypes_44_list{list: &list})
+	case 45: // goproto.proto.test2.TestAllTypes.repeated_bytes
+		if fd != fd_TestAllTypes_repeated_bytes {
+			break
+		}
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_TestAllTypes_45_list{list: &list})
+	case 46: // goproto.proto.test2.TestAllTypes.repeatedgroup
+		if fd != fd_TestAllTypes_repeatedgroup {
+			break
+		}
+		list := []*TestAllTypes_RepeatedGroup{}
+		return protoreflect.ValueOfList(&_TestAllTypes_46_list{list: &list})
+	case 48: // goproto.proto.test2.TestAllTypes.repeated_nested_message
+		if fd != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
+		list := []*TestAllTypes_NestedMessage{}
+		return protoreflect.ValueOfList(&_TestAllTypes_48_list{list: &list})
+	case 49: // goproto.proto.test2.TestAllTypes.repeated_foreign_message
+		if fd != fd_TestAllTypes_repeated_foreign_message {
+			break
+		}
+		list := []*ForeignMessage{}
+		return protoreflect.ValueOfList(&_TestAllTypes_49_list{list: &list})
+	case 51: // goproto.proto.test2.TestAllTypes.repeated_nested_enum
+		if fd != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
+		list := []TestAllTypes_NestedEnum{}
+		return protoreflect.ValueOfList(&_TestAllTypes_51_list{list: &list})
+	case 52: // goproto.proto.test2.TestAllTypes.repeated_foreign_enum
+		if fd != fd_TestAllTypes_repeated_foreign_enum {
+			break
+		}
+		list := []ForeignEnum{}
+		return protoreflect.ValueOfList(&_TestAllTypes_52_list{list: &list})
+	case 53: // goproto.proto.test2.TestAllTypes.packed_int32
+		if fd != fd_TestAllTypes_packed_int32 {
+			break
+		}
+		list := []int32{}
+		return protoreflect.ValueOfList(&_TestAllTypes_53_list{list: &list})
+	case 54: // goproto.proto.test2.TestAllTypes.packed_sint64
+		if fd != fd_TestAllTypes_packed_sint64 {
+			break
+		}
+		list := []int64{}
+		return protoreflect.ValueOfList(&_TestAllTypes_54_list{list: &list})
+	case 55: // goproto.proto.test2.TestAllTypes.packed_double
+		if fd != fd_TestAllTypes_packed_double {
+			break
+		}
+		list := []float64{}
+		return protoreflect.ValueOfList(&_TestAllTypes_55_list{list: &list})
+	case 57: // goproto.proto.test2.TestAllTypes.packed_bool
+		if fd != fd_TestAllTypes_packed_bool {
+			break
+		}
+		list := []bool{}
+		return protoreflect.ValueOfList(&_TestAllTypes_57_list{list: &list})
+	case 56: // goproto.proto.test2.TestAllTypes.map_int32_int32
+		if fd != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
+		m := make(map[int32]int32)
+		return protoreflect.ValueOfMap(&_TestAllTypes_56_map{m: &m})
+	case 61: // goproto.proto.test2.TestAllTypes.map_sint64_sint64
+		if fd != fd_TestAllTypes_map_sint64_sint64 {
+			break
+		}
+		m := make(map[int64]int64)
+		return protoreflect.ValueOfMap(&_TestAllTypes_61_map{m: &m})
+	case 69: // goproto.proto.test2.TestAllTypes.map_string_string
+		if fd != fd_TestAllTypes_map_string_string {
+			break
+		}
+		m := make(map[string]string)
+		return protoreflect.ValueOfMap(&_TestAllTypes_69_map{m: &m})
+	case 70: // goproto.proto.test2.TestAllTypes.map_string_bytes
+		if fd != fd_TestAllTypes_map_string_bytes {
+			break
+		}
+		m := make(map[string][]byte)
+		return protoreflect.ValueOfMap(&_TestAllTypes_70_map{m: &m})
+	case 71: // goproto.proto.test2.TestAllTypes.map_string_nested_message
+		if fd != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
+		m := make(map[string]*TestAllTypes_NestedMessage)
+		return protoreflect.ValueOfMap(&_TestAllTypes_71_map{m: &m})
+	case 73: // goproto.proto.test2.TestAllTypes.map_string_nested_enum
+		if fd != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
+		m := make(map[string]TestAllTypes_NestedEnum)
+		return protoreflect.ValueOfMap(&_TestAllTypes_73_map{m: &m})
+	case 81: // goproto.proto.test2.TestAllTypes.default_int32
+		if fd != fd_TestAllTypes_default_int32 {
+			break
+		}
+		return protoreflect.ValueOfInt32(Default_TestAllTypes_DefaultInt32)
+	case 82: // goproto.proto.test2.TestAllTypes.default_int64
+		if fd != fd_TestAllTypes_default_int64 {
+			break
+		}
+		return protoreflect.ValueOfInt64(Default_TestAllTypes_DefaultInt64)
+	case 83: // goproto.proto.test2.TestAllTypes.default_uint32
+		if fd != fd_TestAllTypes_default_uint32 {
+			break
+		}
+		return protoreflect.ValueOfUint32(Default_TestAllTypes_DefaultUint32)
+	case 84: // goproto.proto.test2.TestAllTypes.default_uint64
+		if fd != fd_TestAllTypes_default_uint64 {
+			break
+		}
+		return protoreflect.ValueOfUint64(Default_TestAllTypes_DefaultUint64)
+	case 85: // goproto.proto.test2.TestAllTypes.default_sint32
+		if fd != fd_TestAllTypes_default_sint32 {
+			break
+		}
+		return protoreflect.ValueOfInt32(Default_TestAllTypes_DefaultSint32)
+	case 86: // goproto.proto.test2.TestAllTypes.default_sint64
+		if fd != fd_TestAllTypes_default_sint64 {
+			break
+		}
+		return protoreflect.ValueOfInt64(Default_TestAllTypes_DefaultSint64)
+	case 87: // goproto.proto.test2.TestAllTypes.default_fixed32
+		if fd != fd_TestAllTypes_default_fixed32 {
+			break
+		}
+		return protoreflect.ValueOfUint32(Default_TestAllTypes_DefaultFixed32)
+	case 88: // goproto.proto.test2.TestAllTypes.default_fixed64
+		if fd != fd_TestAllTypes_default_fixed64 {
+			break
+		}
+		return protoreflect.ValueOfUint64(Default_TestAllTypes_DefaultFixed64)
+	case 89: // goproto.proto.test2.TestAllTypes.default_sfixed32
+		if fd != fd_TestAllTypes_default_sfixed32 {
+			break
+		}
+		return protoreflect.ValueOfInt32(Default_TestAllTypes_DefaultSfixed32)
+	case 80: // goproto.proto.test2.TestAllTypes.default_sfixed64
+		if fd != fd_TestAllTypes_default_sfixed64 {
+			break
+		}
+		return protoreflect.ValueOfInt64(Default_TestAllTypes_DefaultSfixed64)
+	case 91: // goproto.proto.test2.TestAllTypes.default_float
+		if fd != fd_TestAllTypes_default_float {
+			break
+		}
+		return protoreflect.ValueOfFloat32(Default_TestAllTypes_DefaultFloat)
+	case 92: // goproto.proto.test2.TestAllTypes.default_double
+		if fd != fd_TestAllTypes_default_double {
+			break
+		}
+		return protoreflect.ValueOfFloat64(Default_TestAllTypes_DefaultDouble)
+	case 93: // goproto.proto.test2.TestAllTypes.default_bool
+		if fd != fd_TestAllTypes_default_bool {
+			break
+		}
+		return protoreflect.ValueOfBool(Default_TestAllTypes_DefaultBool)
+	case 94: // goproto.proto.test2.TestAllTypes.default_string
+		if fd != fd_TestAllTypes_default_string {
+			break
+		}
+		return protoreflect.ValueOfString(Default_TestAllTypes_DefaultString)
+	case 95: // goproto.proto.test2.TestAllTypes.default_bytes
+		if fd != fd_TestAllTypes_default_bytes {
+			break
+		}
+		return protoreflect.ValueOfBytes(append([]byte(nil), Default_TestAllTypes_DefaultBytes...))
+	case 96: // goproto.proto.test2.TestAllTypes.default_nested_enum
+		if fd != fd_TestAllTypes_default_nested_enum {
+			break
+		}
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(Default_TestAllTypes_DefaultNestedEnum))
+	case 97: // goproto.proto.test2.TestAllTypes.default_foreign_enum
+		if fd != fd_TestAllTypes_default_foreign_enum {
+			break
+		}
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(Default_TestAllTypes_DefaultForeignEnum))
+	case 111: // goproto.proto.test2.TestAllTypes.oneof_uint32
+		if fd != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
+		return protoreflect.ValueOfUint32(uint32(0))
+	case 112: // goproto.proto.test2.TestAllTypes.oneof_nested_message
+		if fd != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
+		value := &TestAllTypes_NestedMessage{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 113: // goproto.proto.test2.TestAllTypes.oneof_string
+		if fd != fd_TestAllTypes_oneof_string {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 114: // goproto.proto.test2.TestAllTypes.oneof_bytes
+		if fd != fd_TestAllTypes_oneof_bytes {
+			break
+		}
+		return protoreflect.ValueOfBytes(nil)
+	case 115: // goproto.proto.test2.TestAllTypes.oneof_bool
+		if fd != fd_TestAllTypes_oneof_bool {
+			break
+		}
+		return protoreflect.ValueOfBool(false)
+	case 116: // goproto.proto.test2.TestAllTypes.oneof_uint64
+		if fd != fd_TestAllTypes_oneof_uint64 {
+			break
+		}
+		return protoreflect.ValueOfUint64(uint64(0))
+	case 117: // goproto.proto.test2.TestAllTypes.oneof_float
+		if fd != fd_TestAllTypes_oneof_float {
+			break
+		}
+		return protoreflect.ValueOfFloat32(float32(0))
+	case 118: // goproto.proto.test2.TestAllTypes.oneof_double
+		if fd != fd_TestAllTypes_oneof_double {
+			break
+		}
+		return protoreflect.ValueOfFloat64(float64(0))
+	case 119: // goproto.proto.test2.TestAllTypes.oneof_enum
+		if fd != fd_TestAllTypes_oneof_enum {
+			break
+		}
+		return protoreflect.ValueOfEnum(0)
+	case 121: // goproto.proto.test2.TestAllTypes.oneofgroup
+		if fd != fd_TestAllTypes_oneofgroup {
+			break
+		}
+		value := &TestAllTypes_OneofGroup{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 120: // goproto.proto.test2.TestAllTypes.oneof_optional_uint32
+		if fd != fd_TestAllTypes_oneof_optional_uint32 {
+			break
+		}
+		return protoreflect.ValueOfUint32(uint32(0))
+	case 122: // goproto.proto.test2.TestAllTypes.oneof_default_sint32
+		if fd != fd_TestAllTypes_oneof_default_sint32 {
+			break
+		}
+		return protoreflect.ValueOfInt32(Default_TestAllTypes_OneofDefaultSint32)
+	case 123: // goproto.proto.test2.TestAllTypes.oneof_default_string
+		if fd != fd_TestAllTypes_oneof_default_string {
+			break
+		}
+		return protoreflect.ValueOfString(Default_TestAllTypes_OneofDefaultString)
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestAllTypes) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "goproto.proto.test2.TestAllTypes.oneof_field":
+		if x.OneofField == nil {
+			return nil
+		}
+		switch x.OneofField.(type) {
+		case *TestAllTypes_OneofUint32:
+			return x.Descriptor().Fields().ByName("oneof_uint32")
+		case *TestAllTypes_OneofNestedMessage:
+			return x.Descriptor().Fields().ByName("oneof_nested_message")
+		case *TestAllTypes_OneofString:
+			return x.Descriptor().Fields().ByName("oneof_string")
+		case *TestAllTypes_OneofBytes:
+			return x.Descriptor().Fields().ByName("oneof_bytes")
+		case *TestAllTypes_OneofBool:
+			return x.Descriptor().Fields().ByName("oneof_bool")
+		case *TestAllTypes_OneofUint64:
+			return x.Descriptor().Fields().ByName("oneof_uint64")
+		case *TestAllTypes_OneofFloat:
+			return x.Descriptor().Fields().ByName("oneof_float")
+		case *TestAllTypes_OneofDouble:
+			return x.Descriptor().Fields().ByName("oneof_double")
+		case *TestAllTypes_OneofEnum:
+			return x.Descriptor().Fields().ByName("oneof_enum")
+		case *TestAllTypes_Oneofgroup:
+			return x.Descriptor().Fields().ByName("oneofgroup")
+		}
+	case "goproto.proto.test2.TestAllTypes.oneof_optional":
+		if x.OneofOptional == nil {
+			return nil
+		}
+		switch x.OneofOptional.(type) {
+		case *TestAllTypes_OneofOptionalUint32:
+			return x.Descriptor().Fields().ByName("oneof_optional_uint32")
+		}
+	case "goproto.proto.test2.TestAllTypes.oneof_defaults":
+		if x.OneofDefaults == nil {
+			return nil
+		}
+		switch x.OneofDefaults.(type) {
+		case *TestAllTypes_OneofDefaultSint32:
+			return x.Descriptor().Fields().ByName("oneof_default_sint32")
+		case *TestAllTypes_OneofDefaultString:
+			return x.Descriptor().Fields().ByName("oneof_default_string")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test2.TestAllTypes", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestAllTypes) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestAllTypes) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestAllTypes_methods
+}
+
+var _fastReflection_TestAllTypes_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestAllTypes_size,
+	Marshal:           _fastReflection_TestAllTypes_marshal,
+	Unmarshal:         _fastReflection_TestAllTypes_unmarshal,
+	Merge:             _fastReflection_TestAllTypes_merge,
+	CheckInitialized:  _fastReflection_TestAllTypes_checkInitialized,
+	Equal:             _fastReflection_TestAllTypes_equal,
+}
+
+func _fastReflection_TestAllTypes_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestAllTypes)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.OptionalInt32 != nil {
+		n += 1 + runtime.Sov(uint64(*x.OptionalInt32))
+	}
+	if x.OptionalInt64 != nil {
+		n += 1 + runtime.Sov(uint64(*x.OptionalInt64))
+	}
+	if x.OptionalUint32 != nil {
+		n += 1 + runtime.Sov(uint64(*x.OptionalUint32))
+	}
+	if x.OptionalUint64 != nil {
+		n += 1 + runtime.Sov(uint64(*x.OptionalUint64))
+	}
+	if x.OptionalSint32 != nil {
+		n += 1 + runtime.Soz(uint64(*x.OptionalSint32))
+	}
+	if x.OptionalSint64 != nil {
+		n += 1 + runtime.Soz(uint64(*x.OptionalSint64))
+	}
+	if x.OptionalFixed32 != nil {
+		n += 5
+	}
+	if x.OptionalFixed64 != nil {
+		n += 9
+	}
+	if x.OptionalSfixed32 != nil {
+		n += 5
+	}
+	if x.OptionalSfixed64 != nil {
+		n += 9
+	}
+	if x.OptionalFloat != nil {
+		n += 5
+	}
+	if x.OptionalDouble != nil {
+		n += 9
+	}
+	if x.OptionalBool != nil {
+		n += 2
+	}
+	if x.OptionalString != nil {
+		l = len(*x.OptionalString)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.OptionalBytes != nil {
+		l = len(x.OptionalBytes)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.Optionalgroup != nil {
+		l = options.Size(x.Optionalgroup)
+		n += 4 + l
+	}
+	if x.OptionalNestedMessage != nil {
+		l = options.Size(x.OptionalNestedMessage)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.OptionalForeignMessage != nil {
+		l = options.Size(x.OptionalForeignMessage)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.OptionalNestedEnum != nil {
+		n += 2 + runtime.Sov(uint64(*x.OptionalNestedEnum))
+	}
+	if x.OptionalForeignEnum != nil {
+		n += 2 + runtime.Sov(uint64(*x.OptionalForeignEnum))
+	}
+	if len(x.RepeatedInt32) > 0 {
+		for _, e := range x.RepeatedInt32 {
+			n += 2 + runtime.Sov(uint64(e))
+		}
+	}
+	if len(x.RepeatedInt64) > 0 {
+		for _, e := range x.RepeatedInt64 {
+			n += 2 + runtime.Sov(uint64(e))
+		}
+	}
+	if len(x.RepeatedUint32) > 0 {
+		for _, e := range x.RepeatedUint32 {
+			n += 2 + runtime.Sov(uint64(e))
+		}
+	}
+	if len(x.RepeatedUint64) > 0 {
+		for _, e := range x.RepeatedUint64 {
+			n += 2 + runtime.Sov(uint64(e))
+		}
+	}
+	if len(x.RepeatedSint32) > 0 {
+		for _, e := range x.RepeatedSint32 {
+			n += 2 + runtime.Soz(uint64(e))
+		}
+	}
+	if len(x.RepeatedSint64) > 0 {
+		for _, e := range x.RepeatedSint64 {
+			n += 2 + runtime.Soz(uint64(e))
+		}
+	}
+	if len(x.RepeatedFixed32) > 0 {
+		n += 6 * len(x.RepeatedFixed32)
+	}
+	if len(x.RepeatedFixed64) > 0 {
+		n += 10 * len(x.RepeatedFixed64)
+	}
+	if len(x.RepeatedSfixed32) > 0 {
+		n += 6 * len(x.RepeatedSfixed32)
+	}
+	if len(x.RepeatedSfixed64) > 0 {
+		n += 10 * len(x.RepeatedSfixed64)
+	}
+	if len(x.RepeatedFloat) > 0 {
+		n += 6 * len(x.RepeatedFloat)
+	}
+	if len(x.RepeatedDouble) > 0 {
+		n += 10 * len(x.RepeatedDouble)
+	}
+	if len(x.RepeatedBool) > 0 {
+		n += 3 * len(x.RepeatedBool)
+	}
+	if len(x.RepeatedString) > 0 {
+		for _, s := range x.RepeatedString {
+			l = len(s)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if len(x.RepeatedBytes) > 0 {
+		for _, b := range x.RepeatedBytes {
+			l = len(b)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if len(x.Repeatedgroup) > 0 {
+		for _, e := range x.Repeatedgroup {
+			l = options.Size(e)
+			n += 4 + l
+		}
+	}
+	if len(x.RepeatedNestedMessage) > 0 {
+		for _, e := range x.RepeatedNestedMessage {
+			l = options.Size(e)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if len(x.RepeatedForeignMessage) > 0 {
+		for _, e := range x.RepeatedForeignMessage {
+			l = options.Size(e)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if len(x.RepeatedNestedEnum) > 0 {
+		for _, e := range x.RepeatedNestedEnum {
+			n += 2 + runtime.Sov(uint64(e))
+		}
+	}
+	if len(x.RepeatedForeignEnum) > 0 {
+		for _, e := range x.RepeatedForeignEnum {
+			n += 2 + runtime.Sov(uint64(e))
+		}
+	}
+	if len(x.PackedInt32) > 0 {
+		l = 0
+		for _, e := range x.PackedInt32 {
+			l += runtime.Sov(uint64(e))
+		}
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.PackedSint64) > 0 {
+		l = 0
+		for _, e := range x.PackedSint64 {
+			l += runtime.Soz(uint64(e))
+		}
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.PackedDouble) > 0 {
+		n += 2 + runtime.Sov(uint64(len(x.PackedDouble)*8)) + len(x.PackedDouble)*8
+	}
+	if len(x.PackedBool) > 0 {
+		n += 2 + runtime.Sov(uint64(len(x.PackedBool))) + len(x.PackedBool)*1
+	}
+	if len(x.MapInt32Int32) > 0 {
+		SiZeMaP := func(k int32, v int32) {
+			mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]int32, 0, len(x.MapInt32Int32))
+			for k := range x.MapInt32Int32 {
+				sortme = append(sortme, k)
+			}
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapInt32Int32[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapInt32Int32 {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if len(x.MapSint64Sint64) > 0 {
+		SiZeMaP := func(k int64, v int64) {
+			mapEntrySize := 1 + runtime.Soz(uint64(k)) + 1 + runtime.Soz(uint64(v))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]int64, 0, len(x.MapSint64Sint64))
+			for k := range x.MapSint64Sint64 {
+				sortme = append(sortme, k)
+			}
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapSint64Sint64[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapSint64Sint64 {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if len(x.MapStringString) > 0 {
+		SiZeMaP := func(k string, v string) {
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.MapStringString))
+			for k := range x.MapStringString {
+				sortme = append(sortme, k)
+			}
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.MapStringString[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapStringString {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if len(x.MapStringBytes) > 0 {
+		SiZeMaP := func(k string, v []byte) {
+			l = 1 + len(v) + runtime.Sov(uint64(len(v)))
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.MapStringBytes))
+			for k := range x.MapStringBytes {
+				sortme = append(sortme, k)
+			}
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.MapStringBytes[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapStringBytes {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if len(x.MapStringNestedMessage) > 0 {
+		SiZeMaP := func(k string, v *TestAllTypes_NestedMessage) {
+			l := 0
+			if v != nil {
+				l = options.Size(v)
+			}
+			l += 1 + runtime.Sov(uint64(l))
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.MapStringNestedMessage))
+			for k := range x.MapStringNestedMessage {
+				sortme = append(sortme, k)
+			}
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.MapStringNestedMessage[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapStringNestedMessage {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if len(x.MapStringNestedEnum) > 0 {
+		SiZeMaP := func(k string, v TestAllTypes_NestedEnum) {
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + runtime.Sov(uint64(v))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.MapStringNestedEnum))
+			for k := range x.MapStringNestedEnum {
+				sortme = append(sortme, k)
+			}
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.MapStringNestedEnum[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapStringNestedEnum {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if x.DefaultInt32 != nil {
+		n += 2 + runtime.Sov(uint64(*x.DefaultInt32))
+	}
+	if x.DefaultInt64 != nil {
+		n += 2 + runtime.Sov(uint64(*x.DefaultInt64))
+	}
+	if x.DefaultUint32 != nil {
+		n += 2 + runtime.Sov(uint64(*x.DefaultUint32))
+	}
+	if x.DefaultUint64 != nil {
+		n += 2 + runtime.Sov(uint64(*x.DefaultUint64))
+	}
+	if x.DefaultSint32 != nil {
+		n += 2 + runtime.Soz(uint64(*x.DefaultSint32))
+	}
+	if x.DefaultSint64 != nil {
+		n += 2 + runtime.Soz(uint64(*x.DefaultSint64))
+	}
+	if x.DefaultFixed32 != nil {
+		n += 6
+	}
+	if x.DefaultFixed64 != nil {
+		n += 10
+	}
+	if x.DefaultSfixed32 != nil {
+		n += 6
+	}
+	if x.DefaultSfixed64 != nil {
+		n += 10
+	}
+	if x.DefaultFloat != nil {
+		n += 6
+	}
+	if x.DefaultDouble != nil {
+		n += 10
+	}
+	if x.DefaultBool != nil {
+		n += 3
+	}
+	if x.DefaultString != nil {
+		l = len(*x.DefaultString)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.DefaultBytes != nil {
+		l = len(x.DefaultBytes)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.DefaultNestedEnum != nil {
+		n += 2 + runtime.Sov(uint64(*x.DefaultNestedEnum))
+	}
+	if x.DefaultForeignEnum != nil {
+		n += 2 + runtime.Sov(uint64(*x.DefaultForeignEnum))
+	}
+	switch x := x.OneofField.(type) {
+	case *TestAllTypes_OneofUint32:
+		if x == nil {
+			break
+		}
+		n += 2 + runtime.Sov(uint64(x.OneofUint32))
+	case *TestAllTypes_OneofNestedMessage:
+		if x == nil {
+			break
+		}
+		l = options.Size(x.OneofNestedMessage)
+		n += 2 + l + runtime.Sov(uint64(l))
+	case *TestAllTypes_OneofString:
+		if x == nil {
+			break
+		}
+		l = len(x.OneofString)
+		n += 2 + l + runtime.Sov(uint64(l))
+	case *TestAllTypes_OneofBytes:
+		if x == nil {
+			break
+		}
+		l = len(x.OneofBytes)
+		n += 2 + l + runtime.Sov(uint64(l))
+	case *TestAllTypes_OneofBool:
+		if x == nil {
+			break
+		}
+		n += 3
+	case *TestAllTypes_OneofUint64:
+		if x == nil {
+			break
+		}
+		n += 2 + runtime.Sov(uint64(x.OneofUint64))
+	case *TestAllTypes_OneofFloat:
+		if x == nil {
+			break
+		}
+		n += 6
+	case *TestAllTypes_OneofDouble:
+		if x == nil {
+			break
+		}
+		n += 10
+	case *TestAllTypes_OneofEnum:
+		if x == nil {
+			break
+		}
+		n += 2 + runtime.Sov(uint64(x.OneofEnum))
+	case *TestAllTypes_Oneofgroup:
+		if x == nil {
+			break
+		}
+		l = options.Size(x.Oneofgroup)
+		n += 4 + l
+	}
+	switch x := x.OneofOptional.(type) {
+	case *TestAllTypes_OneofOptionalUint32:
+		if x == nil {
+			break
+		}
+		n += 2 + runtime.Sov(uint64(x.OneofOptionalUint32))
+	}
+	switch x := x.OneofDefaults.(type) {
+	case *TestAllTypes_OneofDefaultSint32:
+		if x == nil {
+			break
+		}
+		n += 2 + runtime.Soz(uint64(x.OneofDefaultSint32))
+	case *TestAllTypes_OneofDefaultString:
+		if x == nil {
+			break
+		}
+		l = len(x.OneofDefaultString)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestAllTypes_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	switch x := x.OneofDefaults.(type) {
+	case *TestAllTypes_OneofDefaultSint32:
+		i = runtime.EncodeVarint(dAtA, i, uint64((uint32(x.OneofDefaultSint32)<<1)^uint32((x.OneofDefaultSint32>>31))))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xd0
+	case *TestAllTypes_OneofDefaultString:
+		i -= len(x.OneofDefaultString)
+		copy(dAtA[i:], x.OneofDefaultString)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OneofDefaultString)))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xda
+	}
+	switch x := x.OneofOptional.(type) {
+	case *TestAllTypes_OneofOptionalUint32:
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.OneofOptionalUint32))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xc0
+	}
+	switch x := x.OneofField.(type) {
+	case *TestAllTypes_OneofUint32:
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.OneofUint32))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xf8
+	case *TestAllTypes_OneofNestedMessage:
+		l = options.Size(x.OneofNestedMessage)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.OneofNestedMessage); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x82
+	case *TestAllTypes_OneofString:
+		i -= len(x.OneofString)
+		copy(dAtA[i:], x.OneofString)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OneofString)))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x8a
+	case *TestAllTypes_OneofBytes:
+		i -= len(x.OneofBytes)
+		copy(dAtA[i:], x.OneofBytes)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OneofBytes)))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x92
+	case *TestAllTypes_OneofBool:
+		i--
+		if x.OneofBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x98
+	case *TestAllTypes_OneofUint64:
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.OneofUint64))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xa0
+	case *TestAllTypes_OneofFloat:
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(x.OneofFloat))))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xad
+	case *TestAllTypes_OneofDouble:
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.OneofDouble))))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xb1
+	case *TestAllTypes_OneofEnum:
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.OneofEnum))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xb8
+	case *TestAllTypes_Oneofgroup:
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xcc
+		l = options.Size(x.Oneofgroup)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Oneofgroup); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xcb
+	}
+	if x.DefaultForeignEnum != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.DefaultForeignEnum))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x88
+	}
+	if x.DefaultNestedEnum != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.DefaultNestedEnum))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x80
+	}
+	if x.DefaultBytes != nil {
+		i -= len(x.DefaultBytes)
+		copy(dAtA[i:], x.DefaultBytes)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DefaultBytes)))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xfa
+	}
+	if x.DefaultString != nil {
+		i -= len(*x.DefaultString)
+		copy(dAtA[i:], *x.DefaultString)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(*x.DefaultString)))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xf2
+	}
+	if x.DefaultBool != nil {
+		i--
+		if *x.DefaultBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xe8
+	}
+	if x.DefaultDouble != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*x.DefaultDouble))))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xe1
+	}
+	if x.DefaultFloat != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(*x.DefaultFloat))))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xdd
+	}
+	if x.DefaultSfixed32 != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*x.DefaultSfixed32))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xcd
+	}
+	if x.DefaultFixed64 != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*x.DefaultFixed64))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xc1
+	}
+	if x.DefaultFixed32 != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*x.DefaultFixed32))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xbd
+	}
+	if x.DefaultSint64 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64((uint64(*x.DefaultSint64)<<1)^uint64((*x.DefaultSint64>>63))))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xb0
+	}
+	if x.DefaultSint32 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64((uint32(*x.DefaultSint32)<<1)^uint32((*x.DefaultSint32>>31))))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xa8
+	}
+	if x.DefaultUint64 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.DefaultUint64))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xa0
+	}
+	if x.DefaultUint32 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.DefaultUint32))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x98
+	}
+	if x.DefaultInt64 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.DefaultInt64))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x90
+	}
+	if x.DefaultInt32 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.DefaultInt32))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x88
+	}
+	if x.DefaultSfixed64 != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*x.DefaultSfixed64))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x81
+	}
+	if len(x.MapStringNestedEnum) > 0 {
+		MaRsHaLmAp := func(k string, v TestAllTypes_NestedEnum) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i = runtime.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xca
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForMapStringNestedEnum := make([]string, 0, len(x.MapStringNestedEnum))
+			for k := range x.MapStringNestedEnum {
+				keysForMapStringNestedEnum = append(keysForMapStringNestedEnum, string(k))
+			}
+			sort.Slice(keysForMapStringNestedEnum, func(i, j int) bool {
+				return keysForMapStringNestedEnum[i] < keysForMapStringNestedEnum[j]
+			})
+			for iNdEx := len(keysForMapStringNestedEnum) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapStringNestedEnum[string(keysForMapStringNestedEnum[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapStringNestedEnum[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapStringNestedEnum {
+				v := x.MapStringNestedEnum[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.MapStringNestedMessage) > 0 {
+		MaRsHaLmAp := func(k string, v *TestAllTypes_NestedMessage) (protoiface.MarshalOutput, error) {
+			baseI := i
+			l = options.Size(v)
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], v); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xba
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForMapStringNestedMessage := make([]string, 0, len(x.MapStringNestedMessage))
+			for k := range x.MapStringNestedMessage {
+				keysForMapStringNestedMessage = append(keysForMapStringNestedMessage, string(k))
+			}
+			sort.Slice(keysForMapStringNestedMessage, func(i, j int) bool {
+				return keysForMapStringNestedMessage[i] < keysForMapStringNestedMessage[j]
+			})
+			for iNdEx := len(keysForMapStringNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapStringNestedMessage[string(keysForMapStringNestedMessage[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapStringNestedMessage[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapStringNestedMessage {
+				v := x.MapStringNestedMessage[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.MapStringBytes) > 0 {
+		MaRsHaLmAp := func(k string, v []byte) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xb2
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForMapStringBytes := make([]string, 0, len(x.MapStringBytes))
+			for k := range x.MapStringBytes {
+				keysForMapStringBytes = append(keysForMapStringBytes, string(k))
+			}
+			sort.Slice(keysForMapStringBytes, func(i, j int) bool {
+				return keysForMapStringBytes[i] < keysForMapStringBytes[j]
+			})
+			for iNdEx := len(keysForMapStringBytes) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapStringBytes[string(keysForMapStringBytes[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapStringBytes[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapStringBytes {
+				v := x.MapStringBytes[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.MapStringString) > 0 {
+		MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xaa
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForMapStringString := make([]string, 0, len(x.MapStringString))
+			for k := range x.MapStringString {
+				keysForMapStringString = append(keysForMapStringString, string(k))
+			}
+			sort.Slice(keysForMapStringString, func(i, j int) bool {
+				return keysForMapStringString[i] < keysForMapStringString[j]
+			})
+			for iNdEx := len(keysForMapStringString) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapStringString[string(keysForMapStringString[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapStringString[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapStringString {
+				v := x.MapStringString[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.MapSint64Sint64) > 0 {
+		MaRsHaLmAp := func(k int64, v int64) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i = runtime.EncodeVarint(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			i--
+			dAtA[i] = 0x10
+			i = runtime.EncodeVarint(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xea
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForMapSint64Sint64 := make([]int64, 0, len(x.MapSint64Sint64))
+			for k := range x.MapSint64Sint64 {
+				keysForMapSint64Sint64 = append(keysForMapSint64Sint64, int64(k))
+			}
+			sort.Slice(keysForMapSint64Sint64, func(i, j int) bool {
+				return keysForMapSint64Sint64[i] < keysForMapSint64Sint64[j]
+			})
+			for iNdEx := len(keysForMapSint64Sint64) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapSint64Sint64[int64(keysForMapSint64Sint64[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapSint64Sint64[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapSint64Sint64 {
+				v := x.MapSint64Sint64[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.PackedBool) > 0 {
+		for iNdEx := len(x.PackedBool) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if x.PackedBool[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PackedBool)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(x.MapInt32Int32) > 0 {
+		MaRsHaLmAp := func(k int32, v int32) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i = runtime.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = runtime.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xc2
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForMapInt32Int32 := make([]int32, 0, len(x.MapInt32Int32))
+			for k := range x.MapInt32Int32 {
+				keysForMapInt32Int32 = append(keysForMapInt32Int32, int32(k))
+			}
+			sort.Slice(keysForMapInt32Int32, func(i, j int) bool {
+				return keysForMapInt32Int32[i] < keysForMapInt32Int32[j]
+			})
+			for iNdEx := len(keysForMapInt32Int32) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapInt32Int32[int32(keysForMapInt32Int32[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapInt32Int32[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapInt32Int32 {
+				v := x.MapInt32Int32[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.PackedDouble) > 0 {
+		for iNdEx := len(x.PackedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float64bits(float64(x.PackedDouble[iNdEx]))
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f1))
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PackedDouble)*8))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(x.PackedSint64) > 0 {
+		var pksize3 int
+		for _, num := range x.PackedSint64 {
+			pksize3 += runtime.Soz(uint64(num))
+		}
+		i -= pksize3
+		j2 := i
+		for _, num := range x.PackedSint64 {
+			x4 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x4 >= 1<<7 {
+				dAtA[j2] = uint8(uint64(x4)&0x7f | 0x80)
+				j2++
+				x4 >>= 7
+			}
+			dAtA[j2] = uint8(x4)
+			j2++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize3))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(x.PackedInt32) > 0 {
+		var pksize6 int
+		for _, num := range x.PackedInt32 {
+			pksize6 += runtime.Sov(uint64(num))
+		}
+		i -= pksize6
+		j5 := i
+		for _, num1 := range x.PackedInt32 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA[j5] = uint8(num)
+			j5++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize6))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(x.RepeatedForeignEnum) > 0 {
+		for iNdEx := len(x.RepeatedForeignEnum) - 1; iNdEx >= 0; iNdEx-- {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RepeatedForeignEnum[iNdEx]))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa0
+		}
+	}
+	if len(x.RepeatedNestedEnum) > 0 {
+		for iNdEx := len(x.RepeatedNestedEnum) - 1; iNdEx >= 0; iNdEx-- {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RepeatedNestedEnum[iNdEx]))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x98
+		}
+	}
+	if len(x.RepeatedForeignMessage) > 0 {
+		for iNdEx := len(x.RepeatedForeignMessage) - 1; iNdEx >= 0; iNdEx-- {
+			l = options.Size(x.RepeatedForeignMessage[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.RepeatedForeignMessage[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(x.RepeatedNestedMessage) > 0 {
+		for iNdEx := len(x.RepeatedNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
+			l = options.Size(x.RepeatedNestedMessage[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.RepeatedNestedMessage[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(x.Repeatedgroup) > 0 {
+		for iNdEx := len(x.Repeatedgroup) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf4
+			l = options.Size(x.Repeatedgroup[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.Repeatedgroup[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf3
+		}
+	}
+	if len(x.RepeatedBytes) > 0 {
+		for iNdEx := len(x.RepeatedBytes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(x.RepeatedBytes[iNdEx])
+			copy(dAtA[i:], x.RepeatedBytes[iNdEx])
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedBytes[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(x.RepeatedString) > 0 {
+		for iNdEx := len(x.RepeatedString) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(x.RepeatedString[iNdEx])
+			copy(dAtA[i:], x.RepeatedString[iNdEx])
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedString[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(x.RepeatedBool) > 0 {
+		for iNdEx := len(x.RepeatedBool) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if x.RepeatedBool[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd8
+		}
+	}
+	if len(x.RepeatedDouble) > 0 {
+		for iNdEx := len(x.RepeatedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f7 := math.Float64bits(float64(x.RepeatedDouble[iNdEx]))
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f7))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd1
+		}
+	}
+	if len(x.RepeatedFloat) > 0 {
+		for iNdEx := len(x.RepeatedFloat) - 1; iNdEx >= 0; iNdEx-- {
+			f8 := math.Float32bits(float32(x.RepeatedFloat[iNdEx]))
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f8))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xcd
+		}
+	}
+	if len(x.RepeatedSfixed64) > 0 {
+		for iNdEx := len(x.RepeatedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(x.RepeatedSfixed64[iNdEx]))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc1
+		}
+	}
+	if len(x.RepeatedSfixed32) > 0 {
+		for iNdEx := len(x.RepeatedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.RepeatedSfixed32[iNdEx]))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xbd
+		}
+	}
+	if len(x.RepeatedFixed64) > 0 {
+		for iNdEx := len(x.RepeatedFixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(x.RepeatedFixed64[iNdEx]))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb1
+		}
+	}
+	if len(x.RepeatedFixed32) > 0 {
+		for iNdEx := len(x.RepeatedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.RepeatedFixed32[iNdEx]))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xad
+		}
+	}
+	if len(x.RepeatedSint64) > 0 {
+		for iNdEx := len(x.RepeatedSint64) - 1; iNdEx >= 0; iNdEx-- {
+			x9 := (uint64(x.RepeatedSint64[iNdEx]) << 1) ^ uint64((x.RepeatedSint64[iNdEx] >> 63))
+			i = runtime.EncodeVarint(dAtA, i, uint64(x9))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa0
+		}
+	}
+	if len(x.RepeatedSint32) > 0 {
+		for iNdEx := len(x.RepeatedSint32) - 1; iNdEx >= 0; iNdEx-- {
+			x10 := (uint32(x.RepeatedSint32[iNdEx]) << 1) ^ uint32((x.RepeatedSint32[iNdEx] >> 31))
+			i = runtime.EncodeVarint(dAtA, i, uint64(x10))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x98
+		}
+	}
+	if len(x.RepeatedUint64) > 0 {
+		for iNdEx := len(x.RepeatedUint64) - 1; iNdEx >= 0; iNdEx-- {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RepeatedUint64[iNdEx]))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x90
+		}
+	}
+	if len(x.RepeatedUint32) > 0 {
+		for iNdEx := len(x.RepeatedUint32) - 1; iNdEx >= 0; iNdEx-- {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RepeatedUint32[iNdEx]))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x88
+		}
+	}
+	if len(x.RepeatedInt64) > 0 {
+		for iNdEx := len(x.RepeatedInt64) - 1; iNdEx >= 0; iNdEx-- {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RepeatedInt64[iNdEx]))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x80
+		}
+	}
+	if len(x.RepeatedInt32) > 0 {
+		for iNdEx := len(x.RepeatedInt32) - 1; iNdEx >= 0; iNdEx-- {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RepeatedInt32[iNdEx]))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf8
+		}
+	}
+	if x.OptionalForeignEnum != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalForeignEnum))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if x.OptionalNestedEnum != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalNestedEnum))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if x.OptionalForeignMessage != nil {
+		l = options.Size(x.OptionalForeignMessage)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.OptionalForeignMessage); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if x.OptionalNestedMessage != nil {
+		l = options.Size(x.OptionalNestedMessage)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.OptionalNestedMessage); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if x.Optionalgroup != nil {
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x84
+		l = options.Size(x.Optionalgroup)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Optionalgroup); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x83
+	}
+	if x.OptionalBytes != nil {
+		i -= len(x.OptionalBytes)
+		copy(dAtA[i:], x.OptionalBytes)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OptionalBytes)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if x.OptionalString != nil {
+		i -= len(*x.OptionalString)
+		copy(dAtA[i:], *x.OptionalString)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(*x.OptionalString)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if x.OptionalBool != nil {
+		i--
+		if *x.OptionalBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if x.OptionalDouble != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*x.OptionalDouble))))
+		i--
+		dAtA[i] = 0x61
+	}
+	if x.OptionalFloat != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(*x.OptionalFloat))))
+		i--
+		dAtA[i] = 0x5d
+	}
+	if x.OptionalSfixed64 != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*x.OptionalSfixed64))
+		i--
+		dAtA[i] = 0x51
+	}
+	if x.OptionalSfixed32 != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*x.OptionalSfixed32))
+		i--
+		dAtA[i] = 0x4d
+	}
+	if x.OptionalFixed64 != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*x.OptionalFixed64))
+		i--
+		dAtA[i] = 0x41
+	}
+	if x.OptionalFixed32 != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*x.OptionalFixed32))
+		i--
+		dAtA[i] = 0x3d
+	}
+	if x.OptionalSint64 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64((uint64(*x.OptionalSint64)<<1)^uint64((*x.OptionalSint64>>63))))
+		i--
+		dAtA[i] = 0x30
+	}
+	if x.OptionalSint32 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64((uint32(*x.OptionalSint32)<<1)^uint32((*x.OptionalSint32>>31))))
+		i--
+		dAtA[i] = 0x28
+	}
+	if x.OptionalUint64 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalUint64))
+		i--
+		dAtA[i] = 0x20
+	}
+	if x.OptionalUint32 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalUint32))
+		i--
+		dAtA[i] = 0x18
+	}
+	if x.OptionalInt64 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalInt64))
+		i--
+		dAtA[i] = 0x10
+	}
+	if x.OptionalInt32 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalInt32))
+		i--
+		dAtA[i] = 0x8
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestAllTypes_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestAllTypes.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	if zeroCopy {
+		x.zeroCopied = true
+	}
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalInt32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OptionalInt32 = &v
+		case 2:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalInt64", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OptionalInt64 = &v
+		case 3:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalUint32", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OptionalUint32 = &v
+		case 4:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalUint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OptionalUint64 = &v
+		case 5:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalSint32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			x.OptionalSint32 = &v
+		case 6:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalSint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			v2 := int64(v)
+			x.OptionalSint64 = &v2
+		case 7:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalFixed32", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			x.OptionalFixed32 = &v
+		case 8:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalFixed64", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			x.OptionalFixed64 = &v
+		case 9:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalSfixed32", wireType)
+			}
+			var v int32
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			x.OptionalSfixed32 = &v
+		case 10:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalSfixed64", wireType)
+			}
+			var v int64
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			x.OptionalSfixed64 = &v
+		case 11:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalFloat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			v2 := float32(math.Float32frombits(v))
+			x.OptionalFloat = &v2
+		case 12:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			x.OptionalDouble = &v2
+		case 13:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			x.OptionalBool = &b
+		case 14:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_optional_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			s := runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			x.OptionalString = &s
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_optional_bytes, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if zeroCopy || x.zeroCopied {
+				x.OptionalBytes = runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy)
+			} else {
+				x.OptionalBytes = append(x.OptionalBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.OptionalBytes == nil {
+					x.OptionalBytes = []byte{}
+				}
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 3 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Optionalgroup", wireType)
+			}
+			group, n := protowire.ConsumeGroup(protowire.Number(fieldNum), dAtA[iNdEx:])
+			if n < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, protowire.ParseError(n)
+			}
+			if x.Optionalgroup == nil {
+				if err := budget.Message(fd_TestAllTypes_optionalgroup, int(unsafe.Sizeof(TestAllTypes_OptionalGroup{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Optionalgroup = &TestAllTypes_OptionalGroup{}
+			}
+			if err := options.Unmarshal(group, x.Optionalgroup); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_optionalgroup, -1)
+			}
+			iNdEx += n
+		case 18:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.OptionalNestedMessage == nil {
+				if err := budget.Message(fd_TestAllTypes_optional_nested_message, int(unsafe.Sizeof(TestAllTypes_NestedMessage{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.OptionalNestedMessage = &TestAllTypes_NestedMessage{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OptionalNestedMessage); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_optional_nested_message, -1)
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalForeignMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.OptionalForeignMessage == nil {
+				if err := budget.Message(fd_TestAllTypes_optional_foreign_message, int(unsafe.Sizeof(ForeignMessage{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.OptionalForeignMessage = &ForeignMessage{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OptionalForeignMessage); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_optional_foreign_message, -1)
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalNestedEnum", wireType)
+			}
+			var v TestAllTypes_NestedEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= TestAllTypes_NestedEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if !(v >= -1 && v <= 2) {
+				if !options.DiscardUnknown {
+					x.unknownFields = protowire.AppendTag(x.unknownFields, 21, protowire.VarintType)
+					x.unknownFields = protowire.AppendVarint(x.unknownFields, uint64(v))
+				}
+			} else {
+				x.OptionalNestedEnum = &v
+			}
+		case 22:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalForeignEnum", wireType)
+			}
+			var v ForeignEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= ForeignEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if !(v >= 4 && v <= 6) {
+				if !options.DiscardUnknown {
+					x.unknownFields = protowire.AppendTag(x.unknownFields, 22, protowire.VarintType)
+					x.unknownFields = protowire.AppendVarint(x.unknownFields, uint64(v))
+				}
+			} else {
+				x.OptionalForeignEnum = &v
+			}
+		case 31:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_int32, len(x.RepeatedInt32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RepeatedInt32 = append(x.RepeatedInt32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_int32, len(x.RepeatedInt32), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedInt32) == 0 {
+					x.RepeatedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RepeatedInt32 = append(x.RepeatedInt32, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32", wireType)
+			}
+		case 32:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_int64, len(x.RepeatedInt64), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RepeatedInt64 = append(x.RepeatedInt64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_int64, len(x.RepeatedInt64), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedInt64) == 0 {
+					x.RepeatedInt64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RepeatedInt64 = append(x.RepeatedInt64, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt64", wireType)
+			}
+		case 33:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_uint32, len(x.RepeatedUint32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RepeatedUint32 = append(x.RepeatedUint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_uint32, len(x.RepeatedUint32), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedUint32) == 0 {
+					x.RepeatedUint32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RepeatedUint32 = append(x.RepeatedUint32, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint32", wireType)
+			}
+		case 34:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_uint64, len(x.RepeatedUint64), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RepeatedUint64 = append(x.RepeatedUint64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_uint64, len(x.RepeatedUint64), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedUint64) == 0 {
+					x.RepeatedUint64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RepeatedUint64 = append(x.RepeatedUint64, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint64", wireType)
+			}
+		case 35:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_sint32, len(x.RepeatedSint32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				x.RepeatedSint32 = append(x.RepeatedSint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_sint32, len(x.RepeatedSint32), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedSint32) == 0 {
+					x.RepeatedSint32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					x.RepeatedSint32 = append(x.RepeatedSint32, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint32", wireType)
+			}
+		case 36:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_sint64, len(x.RepeatedSint64), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				x.RepeatedSint64 = append(x.RepeatedSint64, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_sint64, len(x.RepeatedSint64), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedSint64) == 0 {
+					x.RepeatedSint64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					x.RepeatedSint64 = append(x.RepeatedSint64, int64(v))
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint64", wireType)
+			}
+		case 37:
+			if wireType == 5 {
+				if err := budget.List(fd_TestAllTypes_repeated_fixed32, len(x.RepeatedFixed32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint32
+				if (iNdEx + 4) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				x.RepeatedFixed32 = append(x.RepeatedFixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if err := budget.List(fd_TestAllTypes_repeated_fixed32, len(x.RepeatedFixed32), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedFixed32) == 0 {
+					x.RepeatedFixed32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					x.RepeatedFixed32 = append(x.RepeatedFixed32, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedFixed32", wireType)
+			}
+		case 38:
+			if wireType == 1 {
+				if err := budget.List(fd_TestAllTypes_repeated_fixed64, len(x.RepeatedFixed64), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				x.RepeatedFixed64 = append(x.RepeatedFixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if err := budget.List(fd_TestAllTypes_repeated_fixed64, len(x.RepeatedFixed64), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedFixed64) == 0 {
+					x.RepeatedFixed64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					x.RepeatedFixed64 = append(x.RepeatedFixed64, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedFixed64", wireType)
+			}
+		case 39:
+			if wireType == 5 {
+				if err := budget.List(fd_TestAllTypes_repeated_sfixed32, len(x.RepeatedSfixed32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int32
+				if (iNdEx + 4) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				x.RepeatedSfixed32 = append(x.RepeatedSfixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if err := budget.List(fd_TestAllTypes_repeated_sfixed32, len(x.RepeatedSfixed32), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedSfixed32) == 0 {
+					x.RepeatedSfixed32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					if (iNdEx + 4) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					x.RepeatedSfixed32 = append(x.RepeatedSfixed32, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedSfixed32", wireType)
+			}
+		case 40:
+			if wireType == 1 {
+				if err := budget.List(fd_TestAllTypes_repeated_sfixed64, len(x.RepeatedSfixed64), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				x.RepeatedSfixed64 = append(x.RepeatedSfixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if err := budget.List(fd_TestAllTypes_repeated_sfixed64, len(x.RepeatedSfixed64), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedSfixed64) == 0 {
+					x.RepeatedSfixed64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					x.RepeatedSfixed64 = append(x.RepeatedSfixed64, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedSfixed64", wireType)
+			}
+		case 41:
+			if wireType == 5 {
+				if err := budget.List(fd_TestAllTypes_repeated_float, len(x.RepeatedFloat), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint32
+				if (iNdEx + 4) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				x.RepeatedFloat = append(x.RepeatedFloat, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if err := budget.List(fd_TestAllTypes_repeated_float, len(x.RepeatedFloat), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedFloat) == 0 {
+					x.RepeatedFloat = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					x.RepeatedFloat = append(x.RepeatedFloat, v2)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedFloat", wireType)
+			}
+		case 42:
+			if wireType == 1 {
+				if err := budget.List(fd_TestAllTypes_repeated_double, len(x.RepeatedDouble), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				x.RepeatedDouble = append(x.RepeatedDouble, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if err := budget.List(fd_TestAllTypes_repeated_double, len(x.RepeatedDouble), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedDouble) == 0 {
+					x.RepeatedDouble = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					x.RepeatedDouble = append(x.RepeatedDouble, v2)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedDouble", wireType)
+			}
+		case 43:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_bool, len(x.RepeatedBool), 1, 1); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RepeatedBool = append(x.RepeatedBool, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if err := budget.List(fd_TestAllTypes_repeated_bool, len(x.RepeatedBool), elementCount, 1); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedBool) == 0 {
+					x.RepeatedBool = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RepeatedBool = append(x.RepeatedBool, bool(v != 0))
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedBool", wireType)
+			}
+		case 44:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedString", wireType)
+			}
+			if err := budget.List(fd_TestAllTypes_repeated_string, len(x.RepeatedString), 1, 16); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_repeated_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.RepeatedString = append(x.RepeatedString, runtime.String(dAtA[iNdEx:postIndex], zeroCopy))
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedBytes", wireType)
+			}
+			if err := budget.List(fd_TestAllTypes_repeated_bytes, len(x.RepeatedBytes), 1, 24); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_repeated_bytes, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if zeroCopy || x.zeroCopied {
+				x.RepeatedBytes = append(x.RepeatedBytes, runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy))
+			} else {
+				x.RepeatedBytes = runtime.AppendBytes(x.RepeatedBytes, dAtA[iNdEx:postIndex])
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 3 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Repeatedgroup", wireType)
+			}
+			if err := budget.List(fd_TestAllTypes_repeatedgroup, len(x.Repeatedgroup), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			group, n := protowire.ConsumeGroup(protowire.Number(fieldNum), dAtA[iNdEx:])
+			if n < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, protowire.ParseError(n)
+			}
+			if err := budget.Message(fd_TestAllTypes_repeatedgroup, int(unsafe.Sizeof(TestAllTypes_RepeatedGroup{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.Repeatedgroup = append(x.Repeatedgroup, &TestAllTypes_RepeatedGroup{})
+			if err := options.Unmarshal(group, x.Repeatedgroup[len(x.Repeatedgroup)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_repeatedgroup, len(x.Repeatedgroup)-1)
+			}
+			iNdEx += n
+		case 48:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedNestedMessage", wireType)
+			}
+			if err := budget.List(fd_TestAllTypes_repeated_nested_message, len(x.RepeatedNestedMessage), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Message(fd_TestAllTypes_repeated_nested_message, int(unsafe.Sizeof(TestAllTypes_NestedMessage{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.RepeatedNestedMessage = append(x.RepeatedNestedMessage, &TestAllTypes_NestedMessage{})
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RepeatedNestedMessage[len(x.RepeatedNestedMessage)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_repeated_nested_message, len(x.RepeatedNestedMessage)-1)
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedForeignMessage", wireType)
+			}
+			if err := budget.List(fd_TestAllTypes_repeated_foreign_message, len(x.RepeatedForeignMessage), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Message(fd_TestAllTypes_repeated_foreign_message, int(unsafe.Sizeof(ForeignMessage{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.RepeatedForeignMessage = append(x.RepeatedForeignMessage, &ForeignMessage{})
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RepeatedForeignMessage[len(x.RepeatedForeignMessage)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_repeated_foreign_message, len(x.RepeatedForeignMessage)-1)
+			}
+			iNdEx = postIndex
+		case 51:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_nested_enum, len(x.RepeatedNestedEnum), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v TestAllTypes_NestedEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= TestAllTypes_NestedEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if !(v >= -1 && v <= 2) {
+					if !options.DiscardUnknown {
+						x.unknownFields = protowire.AppendTag(x.unknownFields, 51, protowire.VarintType)
+						x.unknownFields = protowire.AppendVarint(x.unknownFields, uint64(v))
+					}
+				} else {
+					x.RepeatedNestedEnum = append(x.RepeatedNestedEnum, v)
+				}
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_nested_enum, len(x.RepeatedNestedEnum), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedNestedEnum) == 0 {
+					x.RepeatedNestedEnum = make([]TestAllTypes_NestedEnum, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v TestAllTypes_NestedEnum
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= TestAllTypes_NestedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if !(v >= -1 && v <= 2) {
+						if !options.DiscardUnknown {
+							x.unknownFields = protowire.AppendTag(x.unknownFields, 51, protowire.VarintType)
+							x.unknownFields = protowire.AppendVarint(x.unknownFields, uint64(v))
+						}
+					} else {
+						x.RepeatedNestedEnum = append(x.RepeatedNestedEnum, v)
+					}
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedNestedEnum", wireType)
+			}
+		case 52:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_foreign_enum, len(x.RepeatedForeignEnum), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v ForeignEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ForeignEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if !(v >= 4 && v <= 6) {
+					if !options.DiscardUnknown {
+						x.unknownFields = protowire.AppendTag(x.unknownFields, 52, protowire.VarintType)
+						x.unknownFields = protowire.AppendVarint(x.unknownFields, uint64(v))
+					}
+				} else {
+					x.RepeatedForeignEnum = append(x.RepeatedForeignEnum, v)
+				}
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_foreign_enum, len(x.RepeatedForeignEnum), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedForeignEnum) == 0 {
+					x.RepeatedForeignEnum = make([]ForeignEnum, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ForeignEnum
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ForeignEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if !(v >= 4 && v <= 6) {
+						if !options.DiscardUnknown {
+							x.unknownFields = protowire.AppendTag(x.unknownFields, 52, protowire.VarintType)
+							x.unknownFields = protowire.AppendVarint(x.unknownFields, uint64(v))
+						}
+					} else {
+						x.RepeatedForeignEnum = append(x.RepeatedForeignEnum, v)
+					}
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedForeignEnum", wireType)
+			}
+		case 53:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_packed_int32, len(x.PackedInt32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.PackedInt32 = append(x.PackedInt32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_packed_int32, len(x.PackedInt32), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.PackedInt32) == 0 {
+					x.PackedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.PackedInt32 = append(x.PackedInt32, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PackedInt32", wireType)
+			}
+		case 54:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_packed_sint64, len(x.PackedSint64), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				x.PackedSint64 = append(x.PackedSint64, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_packed_sint64, len(x.PackedSint64), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.PackedSint64) == 0 {
+					x.PackedSint64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					x.PackedSint64 = append(x.PackedSint64, int64(v))
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PackedSint64", wireType)
+			}
+		case 55:
+			if wireType == 1 {
+				if err := budget.List(fd_TestAllTypes_packed_double, len(x.PackedDouble), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				x.PackedDouble = append(x.PackedDouble, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if err := budget.List(fd_TestAllTypes_packed_double, len(x.PackedDouble), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.PackedDouble) == 0 {
+					x.PackedDouble = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					x.PackedDouble = append(x.PackedDouble, v2)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PackedDouble", wireType)
+			}
+		case 57:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_packed_bool, len(x.PackedBool), 1, 1); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.PackedBool = append(x.PackedBool, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if err := budget.List(fd_TestAllTypes_packed_bool, len(x.PackedBool), elementCount, 1); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.PackedBool) == 0 {
+					x.PackedBool = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.PackedBool = append(x.PackedBool, bool(v != 0))
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PackedBool", wireType)
+			}
+		case 56:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapInt32Int32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapInt32Int32 == nil {
+				x.MapInt32Int32 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapInt32Int32[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_int32_int32, len(x.MapInt32Int32), 8); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapInt32Int32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 61:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapSint64Sint64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapSint64Sint64 == nil {
+				x.MapSint64Sint64 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
+					mapvalue = int64(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapSint64Sint64[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_sint64_sint64, len(x.MapSint64Sint64), 16); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapSint64Sint64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 69:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapStringString", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapStringString == nil {
+				x.MapStringString = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_map_string_string, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_map_string_string, intStringLenmapvalue); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapvalue = runtime.String(dAtA[iNdEx:postStringIndexmapvalue], zeroCopy)
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapStringString[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_string_string, len(x.MapStringString), 32); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapStringString[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 70:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapStringBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapStringBytes == nil {
+				x.MapStringBytes = make(map[string][]byte)
+			}
+			var mapkey string
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_map_string_bytes, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postbytesIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_map_string_bytes, intMapbyteLen); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapvalue = runtime.Bytes(dAtA[iNdEx:postbytesIndex], zeroCopy)
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapStringBytes[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_string_bytes, len(x.MapStringBytes), 40); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapStringBytes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 71:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapStringNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapStringNestedMessage == nil {
+				x.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage)
+			}
+			var mapkey string
+			var mapvalue *TestAllTypes_NestedMessage
+			var mapvalueErr error
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_map_string_nested_message, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Message(fd_TestAllTypes_map_string_nested_message, int(unsafe.Sizeof(TestAllTypes_NestedMessage{}))); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapvalue = &TestAllTypes_NestedMessage{}
+					if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+						mapvalueErr = err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if mapvalueErr != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarInMap(mapvalueErr, fd_TestAllTypes_map_string_nested_message, mapkey)
+			}
+			if budget != nil {
+				if _, ok := x.MapStringNestedMessage[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_string_nested_message, len(x.MapStringNestedMessage), 24); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapStringNestedMessage[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 73:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapStringNestedEnum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapStringNestedEnum == nil {
+				x.MapStringNestedEnum = make(map[string]TestAllTypes_NestedEnum)
+			}
+			var mapkey string
+			var mapvalue TestAllTypes_NestedEnum
+			var unknownValue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_map_string_nested_enum, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= TestAllTypes_NestedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					unknownValue = !(mapvalue >= -1 && mapvalue <= 2)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapStringNestedEnum[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_string_nested_enum, len(x.MapStringNestedEnum), 20); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			if unknownValue {
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[preIndex:postIndex]...)
+				}
+			} else {
+				x.MapStringNestedEnum[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 81:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultInt32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.DefaultInt32 = &v
+		case 82:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultInt64", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.DefaultInt64 = &v
+		case 83:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultUint32", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.DefaultUint32 = &v
+		case 84:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultUint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.DefaultUint64 = &v
+		case 85:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultSint32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			x.DefaultSint32 = &v
+		case 86:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultSint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			v2 := int64(v)
+			x.DefaultSint64 = &v2
+		case 87:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultFixed32", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			x.DefaultFixed32 = &v
+		case 88:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultFixed64", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			x.DefaultFixed64 = &v
+		case 89:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultSfixed32", wireType)
+			}
+			var v int32
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			x.DefaultSfixed32 = &v
+		case 80:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultSfixed64", wireType)
+			}
+			var v int64
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			x.DefaultSfixed64 = &v
+		case 91:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultFloat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			v2 := float32(math.Float32frombits(v))
+			x.DefaultFloat = &v2
+		case 92:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			x.DefaultDouble = &v2
+		case 93:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			x.DefaultBool = &b
+		case 94:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_default_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			s := runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			x.DefaultString = &s
+			iNdEx = postIndex
+		case 95:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_default_bytes, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if zeroCopy || x.zeroCopied {
+				x.DefaultBytes = runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy)
+			} else {
+				x.DefaultBytes = append(x.DefaultBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.DefaultBytes == nil {
+					x.DefaultBytes = []byte{}
+				}
+			}
+			iNdEx = postIndex
+		case 96:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultNestedEnum", wireType)
+			}
+			var v TestAllTypes_NestedEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= TestAllTypes_NestedEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if !(v >= -1 && v <= 2) {
+				if !options.DiscardUnknown {
+					x.unknownFields = protowire.AppendTag(x.unknownFields, 96, protowire.VarintType)
+					x.unknownFields = protowire.AppendVarint(x.unknownFields, uint64(v))
+				}
+			} else {
+				x.DefaultNestedEnum = &v
+			}
+		case 97:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultForeignEnum", wireType)
+			}
+			var v ForeignEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= ForeignEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if !(v >= 4 && v <= 6) {
+				if !options.DiscardUnknown {
+					x.unknownFields = protowire.AppendTag(x.unknownFields, 97, protowire.VarintType)
+					x.unknownFields = protowire.AppendVarint(x.unknownFields, uint64(v))
+				}
+			} else {
+				x.DefaultForeignEnum = &v
+			}
+		case 111:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofUint32", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OneofField = &TestAllTypes_OneofUint32{v}
+		case 112:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if oneof, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); ok && oneof.OneofNestedMessage != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], oneof.OneofNestedMessage); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_oneof_nested_message, -1)
+				}
+			} else {
+				if err := budget.Message(fd_TestAllTypes_oneof_nested_message, int(unsafe.Sizeof(TestAllTypes_NestedMessage{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				v := &TestAllTypes_NestedMessage{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_oneof_nested_message, -1)
+				}
+				x.OneofField = &TestAllTypes_OneofNestedMessage{v}
+			}
+			iNdEx = postIndex
+		case 113:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_oneof_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.OneofField = &TestAllTypes_OneofString{runtime.String(dAtA[iNdEx:postIndex], zeroCopy)}
+			iNdEx = postIndex
+		case 114:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_oneof_bytes, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.OneofField = &TestAllTypes_OneofBytes{runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy)}
+			iNdEx = postIndex
+		case 115:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			x.OneofField = &TestAllTypes_OneofBool{b}
+		case 116:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofUint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OneofField = &TestAllTypes_OneofUint64{v}
+		case 117:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofFloat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			x.OneofField = &TestAllTypes_OneofFloat{float32(math.Float32frombits(v))}
+		case 118:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			x.OneofField = &TestAllTypes_OneofDouble{float64(math.Float64frombits(v))}
+		case 119:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofEnum", wireType)
+			}
+			var v TestAllTypes_NestedEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= TestAllTypes_NestedEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if !(v >= -1 && v <= 2) {
+				if !options.DiscardUnknown {
+					x.unknownFields = protowire.AppendTag(x.unknownFields, 119, protowire.VarintType)
+					x.unknownFields = protowire.AppendVarint(x.unknownFields, uint64(v))
+				}
+			} else {
+				x.OneofField = &TestAllTypes_OneofEnum{v}
+			}
+		case 121:
+			if wireType != 3 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Oneofgroup", wireType)
+			}
+			group, n := protowire.ConsumeGroup(protowire.Number(fieldNum), dAtA[iNdEx:])
+			if n < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, protowire.ParseError(n)
+			}
+			if oneof, ok := x.OneofField.(*TestAllTypes_Oneofgroup); ok && oneof.Oneofgroup != nil {
+				if err := options.Unmarshal(group, oneof.Oneofgroup); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_oneofgroup, -1)
+				}
+			} else {
+				if err := budget.Message(fd_TestAllTypes_oneofgroup, int(unsafe.Sizeof(TestAllTypes_OneofGroup{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				v := &TestAllTypes_OneofGroup{}
+				if err := options.Unmarshal(group, v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_oneofgroup, -1)
+				}
+				x.OneofField = &TestAllTypes_Oneofgroup{v}
+			}
+			iNdEx += n
+		case 120:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofOptionalUint32", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OneofOptional = &TestAllTypes_OneofOptionalUint32{v}
+		case 122:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofDefaultSint32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			x.OneofDefaults = &TestAllTypes_OneofDefaultSint32{v}
+		case 123:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofDefaultString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_oneof_default_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.OneofDefaults = &TestAllTypes_OneofDefaultString{runtime.String(dAtA[iNdEx:postIndex], zeroCopy)}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestAllTypes, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_TestAllTypes_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestAllTypes)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestAllTypes)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.OptionalInt32 != nil {
+		v := *src.OptionalInt32
+		dst.OptionalInt32 = &v
+	}
+	if src.OptionalInt64 != nil {
+		v := *src.OptionalInt64
+		dst.OptionalInt64 = &v
+	}
+	if src.OptionalUint32 != nil {
+		v := *src.OptionalUint32
+		dst.OptionalUint32 = &v
+	}
+	if src.OptionalUint64 != nil {
+		v := *src.OptionalUint64
+		dst.OptionalUint64 = &v
+	}
+	if src.OptionalSint32 != nil {
+		v := *src.OptionalSint32
+		dst.OptionalSint32 = &v
+	}
+	if src.OptionalSint64 != nil {
+		v := *src.OptionalSint64
+		dst.OptionalSint64 = &v
+	}
+	if src.OptionalFixed32 != nil {
+		v := *src.OptionalFixed32
+		dst.OptionalFixed32 = &v
+	}
+	if src.OptionalFixed64 != nil {
+		v := *src.OptionalFixed64
+		dst.OptionalFixed64 = &v
+	}
+	if src.OptionalSfixed32 != nil {
+		v := *src.OptionalSfixed32
+		dst.OptionalSfixed32 = &v
+	}
+	if src.OptionalSfixed64 != nil {
+		v := *src.OptionalSfixed64
+		dst.OptionalSfixed64 = &v
+	}
+	if src.OptionalFloat != nil {
+		v := *src.OptionalFloat
+		dst.OptionalFloat = &v
+	}
+	if src.OptionalDouble != nil {
+		v := *src.OptionalDouble
+		dst.OptionalDouble = &v
+	}
+	if src.OptionalBool != nil {
+		v := *src.OptionalBool
+		dst.OptionalBool = &v
+	}
+	if src.OptionalString != nil {
+		v := *src.OptionalString
+		dst.OptionalString = &v
+	}
+	if src.OptionalBytes != nil {
+		dst.OptionalBytes = append([]byte{}, src.OptionalBytes...)
+	}
+	if src.Optionalgroup != nil {
+		if dst.Optionalgroup == nil {
+			dst.Optionalgroup = &TestAllTypes_OptionalGroup{}
+		}
+		proto.Merge(dst.Optionalgroup, src.Optionalgroup)
+	}
+	if src.OptionalNestedMessage != nil {
+		if dst.OptionalNestedMessage == nil {
+			dst.OptionalNestedMessage = &TestAllTypes_NestedMessage{}
+		}
+		proto.Merge(dst.OptionalNestedMessage, src.OptionalNestedMessage)
+	}
+	if src.OptionalForeignMessage != nil {
+		if dst.OptionalForeignMessage == nil {
+			dst.OptionalForeignMessage = &ForeignMessage{}
+		}
+		proto.Merge(dst.OptionalForeignMessage, src.OptionalForeignMessage)
+	}
+	if src.OptionalNestedEnum != nil {
+		v := *src.OptionalNestedEnum
+		dst.OptionalNestedEnum = &v
+	}
+	if src.OptionalForeignEnum != nil {
+		v := *src.OptionalForeignEnum
+		dst.OptionalForeignEnum = &v
+	}
+	if len(src.RepeatedInt32) != 0 {
+		dst.RepeatedInt32 = append(dst.RepeatedInt32, src.RepeatedInt32...)
+	}
+	if len(src.RepeatedInt64) != 0 {
+		dst.RepeatedInt64 = append(dst.RepeatedInt64, src.RepeatedInt64...)
+	}
+	if len(src.RepeatedUint32) != 0 {
+		dst.RepeatedUint32 = append(dst.RepeatedUint32, src.RepeatedUint32...)
+	}
+	if len(src.RepeatedUint64) != 0 {
+		dst.RepeatedUint64 = append(dst.RepeatedUint64, src.RepeatedUint64...)
+	}
+	if len(src.RepeatedSint32) != 0 {
+		dst.RepeatedSint32 = append(dst.RepeatedSint32, src.RepeatedSint32...)
+	}
+	if len(src.RepeatedSint64) != 0 {
+		dst.RepeatedSint64 = append(dst.RepeatedSint64, src.RepeatedSint64...)
+	}
+	if len(src.RepeatedFixed32) != 0 {
+		dst.RepeatedFixed32 = append(dst.RepeatedFixed32, src.RepeatedFixed32...)
+	}
+	if len(src.RepeatedFixed64) != 0 {
+		dst.RepeatedFixed64 = append(dst.RepeatedFixed64, src.RepeatedFixed64...)
+	}
+	if len(src.RepeatedSfixed32) != 0 {
+		dst.RepeatedSfixed32 = append(dst.RepeatedSfixed32, src.RepeatedSfixed32...)
+	}
+	if len(src.RepeatedSfixed64) != 0 {
+		dst.RepeatedSfixed64 = append(dst.RepeatedSfixed64, src.RepeatedSfixed64...)
+	}
+	if len(src.RepeatedFloat) != 0 {
+		dst.RepeatedFloat = append(dst.RepeatedFloat, src.RepeatedFloat...)
+	}
+	if len(src.RepeatedDouble) != 0 {
+		dst.RepeatedDouble = append(dst.RepeatedDouble, src.RepeatedDouble...)
+	}
+	if len(src.RepeatedBool) != 0 {
+		dst.RepeatedBool = append(dst.RepeatedBool, src.RepeatedBool...)
+	}
+	if len(src.RepeatedString) != 0 {
+		dst.RepeatedString = append(dst.RepeatedString, src.RepeatedString...)
+	}
+	for _, v := range src.RepeatedBytes {
+		dst.RepeatedBytes = append(dst.RepeatedBytes, append([]byte{}, v...))
+	}
+	for _, v := range src.Repeatedgroup {
+		m := &TestAllTypes_RepeatedGroup{}
+		proto.Merge(m, v)
+		dst.Repeatedgroup = append(dst.Repeatedgroup, m)
+	}
+	for _, v := range src.RepeatedNestedMessage {
+		m := &TestAllTypes_NestedMessage{}
+		proto.Merge(m, v)
+		dst.RepeatedNestedMessage = append(dst.RepeatedNestedMessage, m)
+	}
+	for _, v := range src.RepeatedForeignMessage {
+		m := &ForeignMessage{}
+		proto.Merge(m, v)
+		dst.RepeatedForeignMessage = append(dst.RepeatedForeignMessage, m)
+	}
+	if len(src.RepeatedNestedEnum) != 0 {
+		dst.RepeatedNestedEnum = append(dst.RepeatedNestedEnum, src.RepeatedNestedEnum...)
+	}
+	if len(src.RepeatedForeignEnum) != 0 {
+		dst.RepeatedForeignEnum = append(dst.RepeatedForeignEnum, src.RepeatedForeignEnum...)
+	}
+	if len(src.PackedInt32) != 0 {
+		dst.PackedInt32 = append(dst.PackedInt32, src.PackedInt32...)
+	}
+	if len(src.PackedSint64) != 0 {
+		dst.PackedSint64 = append(dst.PackedSint64, src.PackedSint64...)
+	}
+	if len(src.PackedDouble) != 0 {
+		dst.PackedDouble = append(dst.PackedDouble, src.PackedDouble...)
+	}
+	if len(src.PackedBool) != 0 {
+		dst.PackedBool = append(dst.PackedBool, src.PackedBool...)
+	}
+	if len(src.MapInt32Int32) != 0 {
+		if dst.MapInt32Int32 == nil {
+			dst.MapInt32Int32 = make(map[int32]int32, len(src.MapInt32Int32))
+		}
+		for k, v := range src.MapInt32Int32 {
+			dst.MapInt32Int32[k] = v
+		}
+	}
+	if len(src.MapSint64Sint64) != 0 {
+		if dst.MapSint64Sint64 == nil {
+			dst.MapSint64Sint64 = make(map[int64]int64, len(src.MapSint64Sint64))
+		}
+		for k, v := range src.MapSint64Sint64 {
+			dst.MapSint64Sint64[k] = v
+		}
+	}
+	if len(src.MapStringString) != 0 {
+		if dst.MapStringString == nil {
+			dst.MapStringString = make(map[string]string, len(src.MapStringString))
+		}
+		for k, v := range src.MapStringString {
+			dst.MapStringString[k] = v
+		}
+	}
+	if len(src.MapStringBytes) != 0 {
+		if dst.MapStringBytes == nil {
+			dst.MapStringBytes = make(map[string][]byte, len(src.MapStringBytes))
+		}
+		for k, v := range src.MapStringBytes {
+			dst.MapStringBytes[k] = append([]byte{}, v...)
+		}
+	}
+	if len(src.MapStringNestedMessage) != 0 {
+		if dst.MapStringNestedMessage == nil {
+			dst.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage, len(src.MapStringNestedMessage))
+		}
+		for k, v := range src.MapStringNestedMessage {
+			m := &TestAllTypes_NestedMessage{}
+			proto.Merge(m, v)
+			dst.MapStringNestedMessage[k] = m
+		}
+	}
+	if len(src.MapStringNestedEnum) != 0 {
+		if dst.MapStringNestedEnum == nil {
+			dst.MapStringNestedEnum = make(map[string]TestAllTypes_NestedEnum, len(src.MapStringNestedEnum))
+		}
+		for k, v := range src.MapStringNestedEnum {
+			dst.MapStringNestedEnum[k] = v
+		}
+	}
+	if src.DefaultInt32 != nil {
+		v := *src.DefaultInt32
+		dst.DefaultInt32 = &v
+	}
+	if src.DefaultInt64 != nil {
+		v := *src.DefaultInt64
+		dst.DefaultInt64 = &v
+	}
+	if src.DefaultUint32 != nil {
+		v := *src.DefaultUint32
+		dst.DefaultUint32 = &v
+	}
+	if src.DefaultUint64 != nil {
+		v := *src.DefaultUint64
+		dst.DefaultUint64 = &v
+	}
+	if src.DefaultSint32 != nil {
+		v := *src.DefaultSint32
+		dst.DefaultSint32 = &v
+	}
+	if src.DefaultSint64 != nil {
+		v := *src.DefaultSint64
+		dst.DefaultSint64 = &v
+	}
+	if src.DefaultFixed32 != nil {
+		v := *src.DefaultFixed32
+		dst.DefaultFixed32 = &v
+	}
+	if src.DefaultFixed64 != nil {
+		v := *src.DefaultFixed64
+		dst.DefaultFixed64 = &v
+	}
+	if src.DefaultSfixed32 != nil {
+		v := *src.DefaultSfixed32
+		dst.DefaultSfixed32 = &v
+	}
+	if src.DefaultSfixed64 != nil {
+		v := *src.DefaultSfixed64
+		dst.DefaultSfixed64 = &v
+	}
+	if src.DefaultFloat != nil {
+		v := *src.DefaultFloat
+		dst.DefaultFloat = &v
+	}
+	if src.DefaultDouble != nil {
+		v := *src.DefaultDouble
+		dst.DefaultDouble = &v
+	}
+	if src.DefaultBool != nil {
+		v := *src.DefaultBool
+		dst.DefaultBool = &v
+	}
+	if src.DefaultString != nil {
+		v := *src.DefaultString
+		dst.DefaultString = &v
+	}
+	if src.DefaultBytes != nil {
+		dst.DefaultBytes = append([]byte{}, src.DefaultBytes...)
+	}
+	if src.DefaultNestedEnum != nil {
+		v := *src.DefaultNestedEnum
+		dst.DefaultNestedEnum = &v
+	}
+	if src.DefaultForeignEnum != nil {
+		v := *src.DefaultForeignEnum
+		dst.DefaultForeignEnum = &v
+	}
+	switch v := src.OneofField.(type) {
+	case *TestAllTypes_OneofUint32:
+		dst.OneofField = &TestAllTypes_OneofUint32{OneofUint32: v.OneofUint32}
+	case *TestAllTypes_OneofNestedMessage:
+		if dv, ok := dst.OneofField.(*TestAllTypes_OneofNestedMessage); ok && dv.OneofNestedMessage != nil {
+			proto.Merge(dv.OneofNestedMessage, v.OneofNestedMessage)
+		} else {
+			m := &TestAllTypes_NestedMessage{}
+			proto.Merge(m, v.OneofNestedMessage)
+			dst.OneofField = &TestAllTypes_OneofNestedMessage{OneofNestedMessage: m}
+		}
+	case *TestAllTypes_OneofString:
+		dst.OneofField = &TestAllTypes_OneofString{OneofString: v.OneofString}
+	case *TestAllTypes_OneofBytes:
+		dst.OneofField = &TestAllTypes_OneofBytes{OneofBytes: append([]byte{}, v.OneofBytes...)}
+	case *TestAllTypes_OneofBool:
+		dst.OneofField = &TestAllTypes_OneofBool{OneofBool: v.OneofBool}
+	case *TestAllTypes_OneofUint64:
+		dst.OneofField = &TestAllTypes_OneofUint64{OneofUint64: v.OneofUint64}
+	case *TestAllTypes_OneofFloat:
+		dst.OneofField = &TestAllTypes_OneofFloat{OneofFloat: v.OneofFloat}
+	case *TestAllTypes_OneofDouble:
+		dst.OneofField = &TestAllTypes_OneofDouble{OneofDouble: v.OneofDouble}
+	case *TestAllTypes_OneofEnum:
+		dst.OneofField = &TestAllTypes_OneofEnum{OneofEnum: v.OneofEnum}
+	case *TestAllTypes_Oneofgroup:
+		if dv, ok := dst.OneofField.(*TestAllTypes_Oneofgroup); ok && dv.Oneofgroup != nil {
+			proto.Merge(dv.Oneofgroup, v.Oneofgroup)
+		} else {
+			m := &TestAllTypes_OneofGroup{}
+			proto.Merge(m, v.Oneofgroup)
+			dst.OneofField = &TestAllTypes_Oneofgroup{Oneofgroup: m}
+		}
+	}
+	switch v := src.OneofOptional.(type) {
+	case *TestAllTypes_OneofOptionalUint32:
+		dst.OneofOptional = &TestAllTypes_OneofOptionalUint32{OneofOptionalUint32: v.OneofOptionalUint32}
+	}
+	switch v := src.OneofDefaults.(type) {
+	case *TestAllTypes_OneofDefaultSint32:
+		dst.OneofDefaults = &TestAllTypes_OneofDefaultSint32{OneofDefaultSint32: v.OneofDefaultSint32}
+	case *TestAllTypes_OneofDefaultString:
+		dst.OneofDefaults = &TestAllTypes_OneofDefaultString{OneofDefaultString: v.OneofDefaultString}
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestAllTypes_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestAllTypes_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestAllTypes)
+	y, yok := input.MessageB.Interface().(*TestAllTypes)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalInt32 == nil) != (y.OptionalInt32 == nil) || x.OptionalInt32 != nil && *x.OptionalInt32 != *y.OptionalInt32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalInt64 == nil) != (y.OptionalInt64 == nil) || x.OptionalInt64 != nil && *x.OptionalInt64 != *y.OptionalInt64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalUint32 == nil) != (y.OptionalUint32 == nil) || x.OptionalUint32 != nil && *x.OptionalUint32 != *y.OptionalUint32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalUint64 == nil) != (y.OptionalUint64 == nil) || x.OptionalUint64 != nil && *x.OptionalUint64 != *y.OptionalUint64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalSint32 == nil) != (y.OptionalSint32 == nil) || x.OptionalSint32 != nil && *x.OptionalSint32 != *y.OptionalSint32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalSint64 == nil) != (y.OptionalSint64 == nil) || x.OptionalSint64 != nil && *x.OptionalSint64 != *y.OptionalSint64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalFixed32 == nil) != (y.OptionalFixed32 == nil) || x.OptionalFixed32 != nil && *x.OptionalFixed32 != *y.OptionalFixed32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalFixed64 == nil) != (y.OptionalFixed64 == nil) || x.OptionalFixed64 != nil && *x.OptionalFixed64 != *y.OptionalFixed64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalSfixed32 == nil) != (y.OptionalSfixed32 == nil) || x.OptionalSfixed32 != nil && *x.OptionalSfixed32 != *y.OptionalSfixed32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalSfixed64 == nil) != (y.OptionalSfixed64 == nil) || x.OptionalSfixed64 != nil && *x.OptionalSfixed64 != *y.OptionalSfixed64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalFloat == nil) != (y.OptionalFloat == nil) || x.OptionalFloat != nil && !runtime.EqualFloat(float64(*x.OptionalFloat), float64(*y.OptionalFloat)) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalDouble == nil) != (y.OptionalDouble == nil) || x.OptionalDouble != nil && !runtime.EqualFloat(*x.OptionalDouble, *y.OptionalDouble) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalBool == nil) != (y.OptionalBool == nil) || x.OptionalBool != nil && *x.OptionalBool != *y.OptionalBool {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalString == nil) != (y.OptionalString == nil) || x.OptionalString != nil && *x.OptionalString != *y.OptionalString {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalBytes == nil) != (y.OptionalBytes == nil) || !bytes.Equal(x.OptionalBytes, y.OptionalBytes) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.Optionalgroup.Equal(y.Optionalgroup) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.OptionalNestedMessage.Equal(y.OptionalNestedMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.OptionalForeignMessage.Equal(y.OptionalForeignMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalNestedEnum == nil) != (y.OptionalNestedEnum == nil) || x.OptionalNestedEnum != nil && *x.OptionalNestedEnum != *y.OptionalNestedEnum {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalForeignEnum == nil) != (y.OptionalForeignEnum == nil) || x.OptionalForeignEnum != nil && *x.OptionalForeignEnum != *y.OptionalForeignEnum {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.RepeatedInt32) != len(y.RepeatedInt32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedInt32 {
+		if v != y.RepeatedInt32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedInt64) != len(y.RepeatedInt64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedInt64 {
+		if v != y.RepeatedInt64[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedUint32) != len(y.RepeatedUint32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedUint32 {
+		if v != y.RepeatedUint32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedUint64) != len(y.RepeatedUint64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedUint64 {
+		if v != y.RepeatedUint64[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedSint32) != len(y.RepeatedSint32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedSint32 {
+		if v != y.RepeatedSint32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedSint64) != len(y.RepeatedSint64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedSint64 {
+		if v != y.RepeatedSint64[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedFixed32) != len(y.RepeatedFixed32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedFixed32 {
+		if v != y.RepeatedFixed32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedFixed64) != len(y.RepeatedFixed64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedFixed64 {
+		if v != y.RepeatedFixed64[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedSfixed32) != len(y.RepeatedSfixed32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedSfixed32 {
+		if v != y.RepeatedSfixed32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedSfixed64) != len(y.RepeatedSfixed64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedSfixed64 {
+		if v != y.RepeatedSfixed64[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedFloat) != len(y.RepeatedFloat) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedFloat {
+		if !runtime.EqualFloat(float64(v), float64(y.RepeatedFloat[i])) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedDouble) != len(y.RepeatedDouble) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedDouble {
+		if !runtime.EqualFloat(v, y.RepeatedDouble[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedBool) != len(y.RepeatedBool) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedBool {
+		if v != y.RepeatedBool[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedString) != len(y.RepeatedString) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedString {
+		if v != y.RepeatedString[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedBytes) != len(y.RepeatedBytes) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedBytes {
+		if !bytes.Equal(v, y.RepeatedBytes[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.Repeatedgroup) != len(y.Repeatedgroup) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.Repeatedgroup {
+		if !v.Equal(y.Repeatedgroup[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedNestedMessage) != len(y.RepeatedNestedMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedNestedMessage {
+		if !v.Equal(y.RepeatedNestedMessage[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedForeignMessage) != len(y.RepeatedForeignMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedForeignMessage {
+		if !v.Equal(y.RepeatedForeignMessage[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedNestedEnum) != len(y.RepeatedNestedEnum) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedNestedEnum {
+		if v != y.RepeatedNestedEnum[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedForeignEnum) != len(y.RepeatedForeignEnum) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedForeignEnum {
+		if v != y.RepeatedForeignEnum[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.PackedInt32) != len(y.PackedInt32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.PackedInt32 {
+		if v != y.PackedInt32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.PackedSint64) != len(y.PackedSint64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.PackedSint64 {
+		if v != y.PackedSint64[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.PackedDouble) != len(y.PackedDouble) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.PackedDouble {
+		if !runtime.EqualFloat(v, y.PackedDouble[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.PackedBool) != len(y.PackedBool) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.PackedBool {
+		if v != y.PackedBool[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapInt32Int32) != len(y.MapInt32Int32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapInt32Int32 {
+		if w, ok := y.MapInt32Int32[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapSint64Sint64) != len(y.MapSint64Sint64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapSint64Sint64 {
+		if w, ok := y.MapSint64Sint64[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapStringString) != len(y.MapStringString) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapStringString {
+		if w, ok := y.MapStringString[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapStringBytes) != len(y.MapStringBytes) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapStringBytes {
+		if w, ok := y.MapStringBytes[k]; !ok || !bytes.Equal(v, w) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapStringNestedMessage) != len(y.MapStringNestedMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapStringNestedMessage {
+		if w, ok := y.MapStringNestedMessage[k]; !ok || !v.Equal(w) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapStringNestedEnum) != len(y.MapStringNestedEnum) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapStringNestedEnum {
+		if w, ok := y.MapStringNestedEnum[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if (x.DefaultInt32 == nil) != (y.DefaultInt32 == nil) || x.DefaultInt32 != nil && *x.DefaultInt32 != *y.DefaultInt32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultInt64 == nil) != (y.DefaultInt64 == nil) || x.DefaultInt64 != nil && *x.DefaultInt64 != *y.DefaultInt64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultUint32 == nil) != (y.DefaultUint32 == nil) || x.DefaultUint32 != nil && *x.DefaultUint32 != *y.DefaultUint32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultUint64 == nil) != (y.DefaultUint64 == nil) || x.DefaultUint64 != nil && *x.DefaultUint64 != *y.DefaultUint64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultSint32 == nil) != (y.DefaultSint32 == nil) || x.DefaultSint32 != nil && *x.DefaultSint32 != *y.DefaultSint32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultSint64 == nil) != (y.DefaultSint64 == nil) || x.DefaultSint64 != nil && *x.DefaultSint64 != *y.DefaultSint64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultFixed32 == nil) != (y.DefaultFixed32 == nil) || x.DefaultFixed32 != nil && *x.DefaultFixed32 != *y.DefaultFixed32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultFixed64 == nil) != (y.DefaultFixed64 == nil) || x.DefaultFixed64 != nil && *x.DefaultFixed64 != *y.DefaultFixed64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultSfixed32 == nil) != (y.DefaultSfixed32 == nil) || x.DefaultSfixed32 != nil && *x.DefaultSfixed32 != *y.DefaultSfixed32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultSfixed64 == nil) != (y.DefaultSfixed64 == nil) || x.DefaultSfixed64 != nil && *x.DefaultSfixed64 != *y.DefaultSfixed64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultFloat == nil) != (y.DefaultFloat == nil) || x.DefaultFloat != nil && !runtime.EqualFloat(float64(*x.DefaultFloat), float64(*y.DefaultFloat)) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultDouble == nil) != (y.DefaultDouble == nil) || x.DefaultDouble != nil && !runtime.EqualFloat(*x.DefaultDouble, *y.DefaultDouble) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultBool == nil) != (y.DefaultBool == nil) || x.DefaultBool != nil && *x.DefaultBool != *y.DefaultBool {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultString == nil) != (y.DefaultString == nil) || x.DefaultString != nil && *x.DefaultString != *y.DefaultString {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultBytes == nil) != (y.DefaultBytes == nil) || !bytes.Equal(x.DefaultBytes, y.DefaultBytes) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultNestedEnum == nil) != (y.DefaultNestedEnum == nil) || x.DefaultNestedEnum != nil && *x.DefaultNestedEnum != *y.DefaultNestedEnum {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultForeignEnum == nil) != (y.DefaultForeignEnum == nil) || x.DefaultForeignEnum != nil && *x.DefaultForeignEnum != *y.DefaultForeignEnum {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	switch v := x.OneofField.(type) {
+	case nil:
+		if y.OneofField != nil {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofUint32:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofUint32); !ok || v.OneofUint32 != w.OneofUint32 {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofNestedMessage:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofNestedMessage); !ok || !v.OneofNestedMessage.Equal(w.OneofNestedMessage) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofString:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofString); !ok || v.OneofString != w.OneofString {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofBytes:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofBytes); !ok || !bytes.Equal(v.OneofBytes, w.OneofBytes) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofBool:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofBool); !ok || v.OneofBool != w.OneofBool {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofUint64:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofUint64); !ok || v.OneofUint64 != w.OneofUint64 {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofFloat:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofFloat); !ok || !runtime.EqualFloat(float64(v.OneofFloat), float64(w.OneofFloat)) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofDouble:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofDouble); !ok || !runtime.EqualFloat(v.OneofDouble, w.OneofDouble) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofEnum:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofEnum); !ok || v.OneofEnum != w.OneofEnum {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_Oneofgroup:
+		if w, ok := y.OneofField.(*TestAllTypes_Oneofgroup); !ok || !v.Oneofgroup.Equal(w.Oneofgroup) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	switch v := x.OneofOptional.(type) {
+	case nil:
+		if y.OneofOptional != nil {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofOptionalUint32:
+		if w, ok := y.OneofOptional.(*TestAllTypes_OneofOptionalUint32); !ok || v.OneofOptionalUint32 != w.OneofOptionalUint32 {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	switch v := x.OneofDefaults.(type) {
+	case nil:
+		if y.OneofDefaults != nil {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofDefaultSint32:
+		if w, ok := y.OneofDefaults.(*TestAllTypes_OneofDefaultSint32); !ok || v.OneofDefaultSint32 != w.OneofDefaultSint32 {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofDefaultString:
+		if w, ok := y.OneofDefaults.(*TestAllTypes_OneofDefaultString); !ok || v.OneofDefaultString != w.OneofDefaultString {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestAllTypes) Clone() *TestAllTypes {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestAllTypes)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestAllTypes) CopyInto(dst *TestAllTypes) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	if x.OptionalInt32 != nil {
+		v := *x.OptionalInt32
+		dst.OptionalInt32 = &v
+	} else {
+		dst.OptionalInt32 = nil
+	}
+	if x.OptionalInt64 != nil {
+		v := *x.OptionalInt64
+		dst.OptionalInt64 = &v
+	} else {
+		dst.OptionalInt64 = nil
+	}
+	if x.OptionalUint32 != nil {
+		v := *x.OptionalUint32
+		dst.OptionalUint32 = &v
+	} else {
+		dst.OptionalUint32 = nil
+	}
+	if x.OptionalUint64 != nil {
+		v := *x.OptionalUint64
+		dst.OptionalUint64 = &v
+	} else {
+		dst.OptionalUint64 = nil
+	}
+	if x.OptionalSint32 != nil {
+		v := *x.OptionalSint32
+		dst.OptionalSint32 = &v
+	} else {
+		dst.OptionalSint32 = nil
+	}
+	if x.OptionalSint64 != nil {
+		v := *x.OptionalSint64
+		dst.OptionalSint64 = &v
+	} else {
+		dst.OptionalSint64 = nil
+	}
+	if x.OptionalFixed32 != nil {
+		v := *x.OptionalFixed32
+		dst.OptionalFixed32 = &v
+	} else {
+		dst.OptionalFixed32 = nil
+	}
+	if x.OptionalFixed64 != nil {
+		v := *x.OptionalFixed64
+		dst.OptionalFixed64 = &v
+	} else {
+		dst.OptionalFixed64 = nil
+	}
+	if x.OptionalSfixed32 != nil {
+		v := *x.OptionalSfixed32
+		dst.OptionalSfixed32 = &v
+	} else {
+		dst.OptionalSfixed32 = nil
+	}
+	if x.OptionalSfixed64 != nil {
+		v := *x.OptionalSfixed64
+		dst.OptionalSfixed64 = &v
+	} else {
+		dst.OptionalSfixed64 = nil
+	}
+	if x.OptionalFloat != nil {
+		v := *x.OptionalFloat
+		dst.OptionalFloat = &v
+	} else {
+		dst.OptionalFloat = nil
+	}
+	if x.OptionalDouble != nil {
+		v := *x.OptionalDouble
+		dst.OptionalDouble = &v
+	} else {
+		dst.OptionalDouble = nil
+	}
+	if x.OptionalBool != nil {
+		v := *x.OptionalBool
+		dst.OptionalBool = &v
+	} else {
+		dst.OptionalBool = nil
+	}
+	if x.OptionalString != nil {
+		v := *x.OptionalString
+		dst.OptionalString = &v
+	} else {
+		dst.OptionalString = nil
+	}
+	dst.OptionalBytes = bytes.Clone(x.OptionalBytes)
+	dst.Optionalgroup = x.Optionalgroup.Clone()
+	dst.OptionalNestedMessage = x.OptionalNestedMessage.Clone()
+	dst.OptionalForeignMessage = x.OptionalForeignMessage.Clone()
+	if x.OptionalNestedEnum != nil {
+		v := *x.OptionalNestedEnum
+		dst.OptionalNestedEnum = &v
+	} else {
+		dst.OptionalNestedEnum = nil
+	}
+	if x.OptionalForeignEnum != nil {
+		v := *x.OptionalForeignEnum
+		dst.OptionalForeignEnum = &v
+	} else {
+		dst.OptionalForeignEnum = nil
+	}
+	dst.RepeatedInt32 = slices.Clone(x.RepeatedInt32)
+	dst.RepeatedInt64 = slices.Clone(x.RepeatedInt64)
+	dst.RepeatedUint32 = slices.Clone(x.RepeatedUint32)
+	dst.RepeatedUint64 = slices.Clone(x.RepeatedUint64)
+	dst.RepeatedSint32 = slices.Clone(x.RepeatedSint32)
+	dst.RepeatedSint64 = slices.Clone(x.RepeatedSint64)
+	dst.RepeatedFixed32 = slices.Clone(x.RepeatedFixed32)
+	dst.RepeatedFixed64 = slices.Clone(x.RepeatedFixed64)
+	dst.RepeatedSfixed32 = slices.Clone(x.RepeatedSfixed32)
+	dst.RepeatedSfixed64 = slices.Clone(x.RepeatedSfixed64)
+	dst.RepeatedFloat = slices.Clone(x.RepeatedFloat)
+	dst.RepeatedDouble = slices.Clone(x.RepeatedDouble)
+	dst.RepeatedBool = slices.Clone(x.RepeatedBool)
+	dst.RepeatedString = slices.Clone(x.RepeatedString)
+	if x.RepeatedBytes != nil {
+		dst.RepeatedBytes = make([][]byte, len(x.RepeatedBytes))
+		for i, v := range x.RepeatedBytes {
+			dst.RepeatedBytes[i] = bytes.Clone(v)
+		}
+	} else {
+		dst.RepeatedBytes = nil
+	}
+	if x.Repeatedgroup != nil {
+		dst.Repeatedgroup = make([]*TestAllTypes_RepeatedGroup, len(x.Repeatedgroup))
+		for i, v := range x.Repeatedgroup {
+			dst.Repeatedgroup[i] = v.Clone()
+		}
+	} else {
+		dst.Repeatedgroup = nil
+	}
+	if x.RepeatedNestedMessage != nil {
+		dst.RepeatedNestedMessage = make([]*TestAllTypes_NestedMessage, len(x.RepeatedNestedMessage))
+		for i, v := range x.RepeatedNestedMessage {
+			dst.RepeatedNestedMessage[i] = v.Clone()
+		}
+	} else {
+		dst.RepeatedNestedMessage = nil
+	}
+	if x.RepeatedForeignMessage != nil {
+		dst.RepeatedForeignMessage = make([]*ForeignMessage, len(x.RepeatedForeignMessage))
+		for i, v := range x.RepeatedForeignMessage {
+			dst.RepeatedForeignMessage[i] = v.Clone()
+		}
+	} else {
+		dst.RepeatedForeignMessage = nil
+	}
+	dst.RepeatedNestedEnum = slices.Clone(x.RepeatedNestedEnum)
+	dst.RepeatedForeignEnum = slices.Clone(x.RepeatedForeignEnum)
+	dst.PackedInt32 = slices.Clone(x.PackedInt32)
+	dst.PackedSint64 = slices.Clone(x.PackedSint64)
+	dst.PackedDouble = slices.Clone(x.PackedDouble)
+	dst.PackedBool = slices.Clone(x.PackedBool)
+	dst.MapInt32Int32 = maps.Clone(x.MapInt32Int32)
+	dst.MapSint64Sint64 = maps.Clone(x.MapSint64Sint64)
+	dst.MapStringString = maps.Clone(x.MapStringString)
+	if x.MapStringBytes != nil {
+		dst.MapStringBytes = make(map[string][]byte, len(x.MapStringBytes))
+		for k, v := range x.MapStringBytes {
+			dst.MapStringBytes[k] = bytes.Clone(v)
+		}
+	} else {
+		dst.MapStringBytes = nil
+	}
+	if x.MapStringNestedMessage != nil {
+		dst.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage, len(x.MapStringNestedMessage))
+		for k, v := range x.MapStringNestedMessage {
+			dst.MapStringNestedMessage[k] = v.Clone()
+		}
+	} else {
+		dst.MapStringNestedMessage = nil
+	}
+	dst.MapStringNestedEnum = maps.Clone(x.MapStringNestedEnum)
+	if x.DefaultInt32 != nil {
+		v := *x.DefaultInt32
+		dst.DefaultInt32 = &v
+	} else {
+		dst.DefaultInt32 = nil
+	}
+	if x.DefaultInt64 != nil {
+		v := *x.DefaultInt64
+		dst.DefaultInt64 = &v
+	} else {
+		dst.DefaultInt64 = nil
+	}
+	if x.DefaultUint32 != nil {
+		v := *x.DefaultUint32
+		dst.DefaultUint32 = &v
+	} else {
+		dst.DefaultUint32 = nil
+	}
+	if x.DefaultUint64 != nil {
+		v := *x.DefaultUint64
+		dst.DefaultUint64 = &v
+	} else {
+		dst.DefaultUint64 = nil
+	}
+	if x.DefaultSint32 != nil {
+		v := *x.DefaultSint32
+		dst.DefaultSint32 = &v
+	} else {
+		dst.DefaultSint32 = nil
+	}
+	if x.DefaultSint64 != nil {
+		v := *x.DefaultSint64
+		dst.DefaultSint64 = &v
+	} else {
+		dst.DefaultSint64 = nil
+	}
+	if x.DefaultFixed32 != nil {
+		v := *x.DefaultFixed32
+		dst.DefaultFixed32 = &v
+	} else {
+		dst.DefaultFixed32 = nil
+	}
+	if x.DefaultFixed64 != nil {
+		v := *x.DefaultFixed64
+		dst.DefaultFixed64 = &v
+	} else {
+		dst.DefaultFixed64 = nil
+	}
+	if x.DefaultSfixed32 != nil {
+		v := *x.DefaultSfixed32
+		dst.DefaultSfixed32 = &v
+	} else {
+		dst.DefaultSfixed32 = nil
+	}
+	if x.DefaultSfixed64 != nil {
+		v := *x.DefaultSfixed64
+		dst.DefaultSfixed64 = &v
+	} else {
+		dst.DefaultSfixed64 = nil
+	}
+	if x.DefaultFloat != nil {
+		v := *x.DefaultFloat
+		dst.DefaultFloat = &v
+	} else {
+		dst.DefaultFloat = nil
+	}
+	if x.DefaultDouble != nil {
+		v := *x.DefaultDouble
+		dst.DefaultDouble = &v
+	} else {
+		dst.DefaultDouble = nil
+	}
+	if x.DefaultBool != nil {
+		v := *x.DefaultBool
+		dst.DefaultBool = &v
+	} else {
+		dst.DefaultBool = nil
+	}
+	if x.DefaultString != nil {
+		v := *x.DefaultString
+		dst.DefaultString = &v
+	} else {
+		dst.DefaultString = nil
+	}
+	dst.DefaultBytes = bytes.Clone(x.DefaultBytes)
+	if x.DefaultNestedEnum != nil {
+		v := *x.DefaultNestedEnum
+		dst.DefaultNestedEnum = &v
+	} else {
+		dst.DefaultNestedEnum = nil
+	}
+	if x.DefaultForeignEnum != nil {
+		v := *x.DefaultForeignEnum
+		dst.DefaultForeignEnum = &v
+	} else {
+		dst.DefaultForeignEnum = nil
+	}
+	switch v := x.OneofField.(type) {
+	case nil:
+		dst.OneofField = nil
+	case *TestAllTypes_OneofUint32:
+		dst.OneofField = &TestAllTypes_OneofUint32{OneofUint32: v.OneofUint32}
+	case *TestAllTypes_OneofNestedMessage:
+		dst.OneofField = &TestAllTypes_OneofNestedMessage{OneofNestedMessage: v.OneofNestedMessage.Clone()}
+	case *TestAllTypes_OneofString:
+		dst.OneofField = &TestAllTypes_OneofString{OneofString: v.OneofString}
+	case *TestAllTypes_OneofBytes:
+		dst.OneofField = &TestAllTypes_OneofBytes{OneofBytes: bytes.Clone(v.OneofBytes)}
+	case *TestAllTypes_OneofBool:
+		dst.OneofField = &TestAllTypes_OneofBool{OneofBool: v.OneofBool}
+	case *TestAllTypes_OneofUint64:
+		dst.OneofField = &TestAllTypes_OneofUint64{OneofUint64: v.OneofUint64}
+	case *TestAllTypes_OneofFloat:
+		dst.OneofField = &TestAllTypes_OneofFloat{OneofFloat: v.OneofFloat}
+	case *TestAllTypes_OneofDouble:
+		dst.OneofField = &TestAllTypes_OneofDouble{OneofDouble: v.OneofDouble}
+	case *TestAllTypes_OneofEnum:
+		dst.OneofField = &TestAllTypes_OneofEnum{OneofEnum: v.OneofEnum}
+	case *TestAllTypes_Oneofgroup:
+		dst.OneofField = &TestAllTypes_Oneofgroup{Oneofgroup: v.Oneofgroup.Clone()}
+	}
+	switch v := x.OneofOptional.(type) {
+	case nil:
+		dst.OneofOptional = nil
+	case *TestAllTypes_OneofOptionalUint32:
+		dst.OneofOptional = &TestAllTypes_OneofOptionalUint32{OneofOptionalUint32: v.OneofOptionalUint32}
+	}
+	switch v := x.OneofDefaults.(type) {
+	case nil:
+		dst.OneofDefaults = nil
+	case *TestAllTypes_OneofDefaultSint32:
+		dst.OneofDefaults = &TestAllTypes_OneofDefaultSint32{OneofDefaultSint32: v.OneofDefaultSint32}
+	case *TestAllTypes_OneofDefaultString:
+		dst.OneofDefaults = &TestAllTypes_OneofDefaultString{OneofDefaultString: v.OneofDefaultString}
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestAllTypes) Equal(y *TestAllTypes) bool {
+	return _fastReflection_TestAllTypes_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestAllTypes, or nil if b is canonical.
+func (*TestAllTypes) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestAllTypes.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("optional_int32", protoreflect.Int32Kind, false)
+		case 2:
+			w.Scalar("optional_int64", protoreflect.Int64Kind, false)
+		case 3:
+			w.Scalar("optional_uint32", protoreflect.Uint32Kind, false)
+		case 4:
+			w.Scalar("optional_uint64", protoreflect.Uint64Kind, false)
+		case 5:
+			w.Scalar("optional_sint32", protoreflect.Sint32Kind, false)
+		case 6:
+			w.Scalar("optional_sint64", protoreflect.Sint64Kind, false)
+		case 7:
+			w.Scalar("optional_fixed32", protoreflect.Fixed32Kind, false)
+		case 8:
+			w.Scalar("optional_fixed64", protoreflect.Fixed64Kind, false)
+		case 9:
+			w.Scalar("optional_sfixed32", protoreflect.Sfixed32Kind, false)
+		case 10:
+			w.Scalar("optional_sfixed64", protoreflect.Sfixed64Kind, false)
+		case 11:
+			w.Scalar("optional_float", protoreflect.FloatKind, false)
+		case 12:
+			w.Scalar("optional_double", protoreflect.DoubleKind, false)
+		case 13:
+			w.Scalar("optional_bool", protoreflect.BoolKind, false)
+		case 14:
+			w.Scalar("optional_string", protoreflect.StringKind, false)
+		case 15:
+			w.Scalar("optional_bytes", protoreflect.BytesKind, false)
+		case 16:
+			w.Group("optionalgroup", false, (*TestAllTypes_OptionalGroup)(nil))
+		case 18:
+			w.Message("optional_nested_message", false, (*TestAllTypes_NestedMessage)(nil))
+		case 19:
+			w.Message("optional_foreign_message", false, (*ForeignMessage)(nil))
+		case 21:
+			w.Scalar("optional_nested_enum", protoreflect.EnumKind, false)
+		case 22:
+			w.Scalar("optional_foreign_enum", protoreflect.EnumKind, false)
+		case 31:
+			w.List("repeated_int32", protoreflect.Int32Kind, false)
+		case 32:
+			w.List("repeated_int64", protoreflect.Int64Kind, false)
+		case 33:
+			w.List("repeated_uint32", protoreflect.Uint32Kind, false)
+		case 34:
+			w.List("repeated_uint64", protoreflect.Uint64Kind, false)
+		case 35:
+			w.List("repeated_sint32", protoreflect.Sint32Kind, false)
+		case 36:
+			w.List("repeated_sint64", protoreflect.Sint64Kind, false)
+		case 37:
+			w.List("repeated_fixed32", protoreflect.Fixed32Kind, false)
+		case 38:
+			w.List("repeated_fixed64", protoreflect.Fixed64Kind, false)
+		case 39:
+			w.List("repeated_sfixed32", protoreflect.Sfixed32Kind, false)
+		case 40:
+			w.List("repeated_sfixed64", protoreflect.Sfixed64Kind, false)
+		case 41:
+			w.List("repeated_float", protoreflect.FloatKind, false)
+		case 42:
+			w.List("repeated_double", protoreflect.DoubleKind, false)
+		case 43:
+			w.List("repeated_bool", protoreflect.BoolKind, false)
+		case 44:
+			w.List("repeated_string", protoreflect.StringKind, false)
+		case 45:
+			w.List("repeated_bytes", protoreflect.BytesKind, false)
+		case 46:
+			w.Group("repeatedgroup", true, (*TestAllTypes_RepeatedGroup)(nil))
+		case 48:
+			w.Message("repeated_nested_message", true, (*TestAllTypes_NestedMessage)(nil))
+		case 49:
+			w.Message("repeated_foreign_message", true, (*ForeignMessage)(nil))
+		case 51:
+			w.List("repeated_nested_enum", protoreflect.EnumKind, false)
+		case 52:
+			w.List("repeated_foreign_enum", protoreflect.EnumKind, false)
+		case 53:
+			w.List("packed_int32", protoreflect.Int32Kind, true)
+		case 54:
+			w.List("packed_sint64", protoreflect.Sint64Kind, true)
+		case 55:
+			w.List("packed_double", protoreflect.DoubleKind, true)
+		case 57:
+			w.List("packed_bool", protoreflect.BoolKind, true)
+		case 56:
+			w.Map("map_int32_int32", protoreflect.Int32Kind, protoreflect.Int32Kind, nil)
+		case 61:
+			w.Map("map_sint64_sint64", protoreflect.Sint64Kind, protoreflect.Sint64Kind, nil)
+		case 69:
+			w.Map("map_string_string", protoreflect.StringKind, protoreflect.StringKind, nil)
+		case 70:
+			w.Map("map_string_bytes", protoreflect.StringKind, protoreflect.BytesKind, nil)
+		case 71:
+			w.Map("map_string_nested_message", protoreflect.StringKind, protoreflect.MessageKind, (*TestAllTypes_NestedMessage)(nil))
+		case 73:
+			w.Map("map_string_nested_enum", protoreflect.StringKind, protoreflect.EnumKind, nil)
+		case 81:
+			w.Scalar("default_int32", protoreflect.Int32Kind, false)
+		case 82:
+			w.Scalar("default_int64", protoreflect.Int64Kind, false)
+		case 83:
+			w.Scalar("default_uint32", protoreflect.Uint32Kind, false)
+		case 84:
+			w.Scalar("default_uint64", protoreflect.Uint64Kind, false)
+		case 85:
+			w.Scalar("default_sint32", protoreflect.Sint32Kind, false)
+		case 86:
+			w.Scalar("default_sint64", protoreflect.Sint64Kind, false)
+		case 87:
+			w.Scalar("default_fixed32", protoreflect.Fixed32Kind, false)
+		case 88:
+			w.Scalar("default_fixed64", protoreflect.Fixed64Kind, false)
+		case 89:
+			w.Scalar("default_sfixed32", protoreflect.Sfixed32Kind, false)
+		case 80:
+			w.Scalar("default_sfixed64", protoreflect.Sfixed64Kind, false)
+		case 91:
+			w.Scalar("default_float", protoreflect.FloatKind, false)
+		case 92:
+			w.Scalar("default_double", protoreflect.DoubleKind, false)
+		case 93:
+			w.Scalar("default_bool", protoreflect.BoolKind, false)
+		case 94:
+			w.Scalar("default_string", protoreflect.StringKind, false)
+		case 95:
+			w.Scalar("default_bytes", protoreflect.BytesKind, false)
+		case 96:
+			w.Scalar("default_nested_enum", protoreflect.EnumKind, false)
+		case 97:
+			w.Scalar("default_foreign_enum", protoreflect.EnumKind, false)
+		case 111:
+			w.Oneof(0)
+			w.Scalar("oneof_uint32", protoreflect.Uint32Kind, false)
+		case 112:
+			w.Oneof(0)
+			w.Message("oneof_nested_message", false, (*TestAllTypes_NestedMessage)(nil))
+		case 113:
+			w.Oneof(0)
+			w.Scalar("oneof_string", protoreflect.StringKind, false)
+		case 114:
+			w.Oneof(0)
+			w.Scalar("oneof_bytes", protoreflect.BytesKind, false)
+		case 115:
+			w.Oneof(0)
+			w.Scalar("oneof_bool", protoreflect.BoolKind, false)
+		case 116:
+			w.Oneof(0)
+			w.Scalar("oneof_uint64", protoreflect.Uint64Kind, false)
+		case 117:
+			w.Oneof(0)
+			w.Scalar("oneof_float", protoreflect.FloatKind, false)
+		case 118:
+			w.Oneof(0)
+			w.Scalar("oneof_double", protoreflect.DoubleKind, false)
+		case 119:
+			w.Oneof(0)
+			w.Scalar("oneof_enum", protoreflect.EnumKind, false)
+		case 121:
+			w.Oneof(0)
+			w.Group("oneofgroup", false, (*TestAllTypes_OneofGroup)(nil))
+		case 120:
+			w.Oneof(1)
+			w.Scalar("oneof_optional_uint32", protoreflect.Uint32Kind, false)
+		case 122:
+			w.Oneof(2)
+			w.Scalar("oneof_default_sint32", protoreflect.Sint32Kind, false)
+		case 123:
+			w.Oneof(2)
+			w.Scalar("oneof_default_string", protoreflect.StringKind, false)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_TestAllTypes_NestedMessage             protoreflect.MessageDescriptor
+	fd_TestAllTypes_NestedMessage_a           protoreflect.FieldDescriptor
+	fd_TestAllTypes_NestedMessage_corecursive protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test2_test_proto_init()
+	md_TestAllTypes_NestedMessage = File_internal_testprotos_test2_test_proto.Messages().ByName("TestAllTypes").Messages().ByName("NestedMessage")
+	fd_TestAllTypes_NestedMessage_a = md_TestAllTypes_NestedMessage.Fields().ByName("a")
+	fd_TestAllTypes_NestedMessage_corecursive = md_TestAllTypes_NestedMessage.Fields().ByName("corecursive")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestAllTypes_NestedMessage)(nil)
+
+type fastReflection_TestAllTypes_NestedMessage TestAllTypes_NestedMessage
+
+func (x *TestAllTypes_NestedMessage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestAllTypes_NestedMessage)(x)
+}
+
+func (x *TestAllTypes_NestedMessage) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test2_test_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestAllTypes_NestedMessage_messageType fastReflection_TestAllTypes_NestedMessage_messageType
+var _ protoreflect.MessageType = fastReflection_TestAllTypes_NestedMessage_messageType{}
+
+type fastReflection_TestAllTypes_NestedMessage_messageType struct{}
+
+func (x fastReflection_TestAllTypes_NestedMessage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestAllTypes_NestedMessage)(nil)
+}
+func (x fastReflection_TestAllTypes_NestedMessage_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestAllTypes_NestedMessage)
+}
+func (x fastReflection_TestAllTypes_NestedMessage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestAllTypes_NestedMessage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestAllTypes_NestedMessage) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestAllTypes_NestedMessage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestAllTypes_NestedMessage) Type() protoreflect.MessageType {
+	return _fastReflection_TestAllTypes_NestedMessage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestAllTypes_NestedMessage) New() protoreflect.Message {
+	return new(fastReflection_TestAllTypes_NestedMessage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestAllTypes_NestedMessage) Interface() protoreflect.ProtoMessage {
+	return (*TestAllTypes_NestedMessage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestAllTypes_NestedMessage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.A != nil {
+		value := protoreflect.ValueOfInt32(*x.A)
+		if !f(fd_TestAllTypes_NestedMessage_a, value) {
+			return
+		}
+	}
+	if x.Corecursive != nil {
+		value := protoreflect.ValueOfMessage(x.Corecursive.ProtoReflect())
+		if !f(fd_TestAllTypes_NestedMessage_corecursive, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestAllTypes_NestedMessage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestAllTypes.NestedMessage.a
+		if fd != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		return x.A != nil
+	case 2: // goproto.proto.test2.TestAllTypes.NestedMessage.corecursive
+		if fd != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		return x.Corecursive != nil
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_NestedMessage); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.NestedMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_NestedMessage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestAllTypes.NestedMessage.a
+		if fd != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		x.A = nil
+		return
+	case 2: // goproto.proto.test2.TestAllTypes.NestedMessage.corecursive
+		if fd != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		x.Corecursive = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_NestedMessage); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.NestedMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestAllTypes_NestedMessage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test2.TestAllTypes.NestedMessage.a
+		if descriptor != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		if x.A == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.A
+		return protoreflect.ValueOfInt32(value)
+	case 2: // goproto.proto.test2.TestAllTypes.NestedMessage.corecursive
+		if descriptor != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		value := x.Corecursive
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestAllTypes_NestedMessage); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.NestedMessage does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.NestedMessage does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_NestedMessage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestAllTypes.NestedMessage.a
+		if fd != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		cv := int32(value.Int())
+		x.A = &cv
+		return
+	case 2: // goproto.proto.test2.TestAllTypes.NestedMessage.corecursive
+		if fd != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		x.Corecursive = value.Message().Interface().(*TestAllTypes)
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_NestedMessage); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.NestedMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_NestedMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 2: // goproto.proto.test2.TestAllTypes.NestedMessage.corecursive
+		if fd != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		if x.Corecursive == nil {
+			x.Corecursive = new(TestAllTypes)
+		}
+		return protoreflect.ValueOfMessage(x.Corecursive.ProtoReflect())
+	case 1: // goproto.proto.test2.TestAllTypes.NestedMessage.a
+		if fd != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		panic(fmt.Errorf("field a of message goproto.proto.test2.TestAllTypes.NestedMessage is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_NestedMessage); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.NestedMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestAllTypes_NestedMessage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestAllTypes.NestedMessage.a
+		if fd != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 2: // goproto.proto.test2.TestAllTypes.NestedMessage.corecursive
+		if fd != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		m := new(TestAllTypes)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_NestedMessage); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.NestedMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestAllTypes_NestedMessage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test2.TestAllTypes.NestedMessage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestAllTypes_NestedMessage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_NestedMessage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestAllTypes_NestedMessage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestAllTypes_NestedMessage) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestAllTypes_NestedMessage_methods
+}
+
+var _fastReflection_TestAllTypes_NestedMessage_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestAllTypes_NestedMessage_size,
+	Marshal:           _fastReflection_TestAllTypes_NestedMessage_marshal,
+	Unmarshal:         _fastReflection_TestAllTypes_NestedMessage_unmarshal,
+	Merge:             _fastReflection_TestAllTypes_NestedMessage_merge,
+	CheckInitialized:  _fastReflection_TestAllTypes_NestedMessage_checkInitialized,
+	Equal:             _fastReflection_TestAllTypes_NestedMessage_equal,
+}
+
+func _fastReflection_TestAllTypes_NestedMessage_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestAllTypes_NestedMessage)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.A != nil {
+		n += 1 + runtime.Sov(uint64(*x.A))
+	}
+	if x.Corecursive != nil {
+		l = options.Size(x.Corecursive)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestAllTypes_NestedMessage_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes_NestedMessage)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.Corecursive != nil {
+		l = options.Size(x.Corecursive)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Corecursive); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x12
+	}
+	if x.A != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.A))
+		i--
+		dAtA[i] = 0x8
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestAllTypes_NestedMessage_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes_NestedMessage)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestAllTypes_NestedMessage.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes_NestedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes_NestedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.A = &v
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corecursive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Corecursive == nil {
+				if err := budget.Message(fd_TestAllTypes_NestedMessage_corecursive, int(unsafe.Sizeof(TestAllTypes{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Corecursive = &TestAllTypes{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Corecursive); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_NestedMessage_corecursive, -1)
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestAllTypes_NestedMessage, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_TestAllTypes_NestedMessage_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestAllTypes_NestedMessage)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestAllTypes_NestedMessage)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.A != nil {
+		v := *src.A
+		dst.A = &v
+	}
+	if src.Corecursive != nil {
+		if dst.Corecursive == nil {
+			dst.Corecursive = &TestAllTypes{}
+		}
+		proto.Merge(dst.Corecursive, src.Corecursive)
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestAllTypes_NestedMessage_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestAllTypes_NestedMessage_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestAllTypes_NestedMessage)
+	y, yok := input.MessageB.Interface().(*TestAllTypes_NestedMessage)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.A == nil) != (y.A == nil) || x.A != nil && *x.A != *y.A {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.Corecursive.Equal(y.Corecursive) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestAllTypes_NestedMessage) Clone() *TestAllTypes_NestedMessage {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestAllTypes_NestedMessage)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestAllTypes_NestedMessage) CopyInto(dst *TestAllTypes_NestedMessage) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	if x.A != nil {
+		v := *x.A
+		dst.A = &v
+	} else {
+		dst.A = nil
+	}
+	dst.Corecursive = x.Corecursive.Clone()
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestAllTypes_NestedMessage) Equal(y *TestAllTypes_NestedMessage) bool {
+	return _fastReflection_TestAllTypes_NestedMessage_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestAllTypes_NestedMessage, or nil if b is canonical.
+func (*TestAllTypes_NestedMessage) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestAllTypes_NestedMessage.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("a", protoreflect.Int32Kind, false)
+		case 2:
+			w.Message("corecursive", false, (*TestAllTypes)(nil))
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_TestAllTypes_OptionalGroup                         protoreflect.MessageDescriptor
+	fd_TestAllTypes_OptionalGroup_a                       protoreflect.FieldDescriptor
+	fd_TestAllTypes_OptionalGroup_optional_nested_message protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test2_test_proto_init()
+	md_TestAllTypes_OptionalGroup = File_internal_testprotos_test2_test_proto.Messages().ByName("TestAllTypes").Messages().ByName("OptionalGroup")
+	fd_TestAllTypes_OptionalGroup_a = md_TestAllTypes_OptionalGroup.Fields().ByName("a")
+	fd_TestAllTypes_OptionalGroup_optional_nested_message = md_TestAllTypes_OptionalGroup.Fields().ByName("optional_nested_message")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestAllTypes_OptionalGroup)(nil)
+
+type fastReflection_TestAllTypes_OptionalGroup TestAllTypes_OptionalGroup
+
+func (x *TestAllTypes_OptionalGroup) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestAllTypes_OptionalGroup)(x)
+}
+
+func (x *TestAllTypes_OptionalGroup) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test2_test_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestAllTypes_OptionalGroup_messageType fastReflection_TestAllTypes_OptionalGroup_messageType
+var _ protoreflect.MessageType = fastReflection_TestAllTypes_OptionalGroup_messageType{}
+
+type fastReflection_TestAllTypes_OptionalGroup_messageType struct{}
+
+func (x fastReflection_TestAllTypes_OptionalGroup_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestAllTypes_OptionalGroup)(nil)
+}
+func (x fastReflection_TestAllTypes_OptionalGroup_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestAllTypes_OptionalGroup)
+}
+func (x fastReflection_TestAllTypes_OptionalGroup_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestAllTypes_OptionalGroup
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestAllTypes_OptionalGroup) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestAllTypes_OptionalGroup
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestAllTypes_OptionalGroup) Type() protoreflect.MessageType {
+	return _fastReflection_TestAllTypes_OptionalGroup_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestAllTypes_OptionalGroup) New() protoreflect.Message {
+	return new(fastReflection_TestAllTypes_OptionalGroup)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestAllTypes_OptionalGroup) Interface() protoreflect.ProtoMessage {
+	return (*TestAllTypes_OptionalGroup)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestAllTypes_OptionalGroup) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.A != nil {
+		value := protoreflect.ValueOfInt32(*x.A)
+		if !f(fd_TestAllTypes_OptionalGroup_a, value) {
+			return
+		}
+	}
+	if x.OptionalNestedMessage != nil {
+		value := protoreflect.ValueOfMessage(x.OptionalNestedMessage.ProtoReflect())
+		if !f(fd_TestAllTypes_OptionalGroup_optional_nested_message, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestAllTypes_OptionalGroup) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 17: // goproto.proto.test2.TestAllTypes.OptionalGroup.a
+		if fd != fd_TestAllTypes_OptionalGroup_a {
+			break
+		}
+		return x.A != nil
+	case 1000: // goproto.proto.test2.TestAllTypes.OptionalGroup.optional_nested_message
+		if fd != fd_TestAllTypes_OptionalGroup_optional_nested_message {
+			break
+		}
+		return x.OptionalNestedMessage != nil
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_OptionalGroup); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OptionalGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OptionalGroup does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_OptionalGroup) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 17: // goproto.proto.test2.TestAllTypes.OptionalGroup.a
+		if fd != fd_TestAllTypes_OptionalGroup_a {
+			break
+		}
+		x.A = nil
+		return
+	case 1000: // goproto.proto.test2.TestAllTypes.OptionalGroup.optional_nested_message
+		if fd != fd_TestAllTypes_OptionalGroup_optional_nested_message {
+			break
+		}
+		x.OptionalNestedMessage = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_OptionalGroup); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OptionalGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OptionalGroup does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestAllTypes_OptionalGroup) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 17: // goproto.proto.test2.TestAllTypes.OptionalGroup.a
+		if descriptor != fd_TestAllTypes_OptionalGroup_a {
+			break
+		}
+		if x.A == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.A
+		return protoreflect.ValueOfInt32(value)
+	case 1000: // goproto.proto.test2.TestAllTypes.OptionalGroup.optional_nested_message
+		if descriptor != fd_TestAllTypes_OptionalGroup_optional_nested_message {
+			break
+		}
+		value := x.OptionalNestedMessage
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestAllTypes_OptionalGroup); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OptionalGroup does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OptionalGroup does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_OptionalGroup) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 17: // goproto.proto.test2.TestAllTypes.OptionalGroup.a
+		if fd != fd_TestAllTypes_OptionalGroup_a {
+			break
+		}
+		cv := int32(value.Int())
+		x.A = &cv
+		return
+	case 1000: // goproto.proto.test2.TestAllTypes.OptionalGroup.optional_nested_message
+		if fd != fd_TestAllTypes_OptionalGroup_optional_nested_message {
+			break
+		}
+		x.OptionalNestedMessage = value.Message().Interface().(*TestAllTypes_NestedMessage)
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_OptionalGroup); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OptionalGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OptionalGroup does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_OptionalGroup) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1000: // goproto.proto.test2.TestAllTypes.OptionalGroup.optional_nested_message
+		if fd != fd_TestAllTypes_OptionalGroup_optional_nested_message {
+			break
+		}
+		if x.OptionalNestedMessage == nil {
+			x.OptionalNestedMessage = new(TestAllTypes_NestedMessage)
+		}
+		return protoreflect.ValueOfMessage(x.OptionalNestedMessage.ProtoReflect())
+	case 17: // goproto.proto.test2.TestAllTypes.OptionalGroup.a
+		if fd != fd_TestAllTypes_OptionalGroup_a {
+			break
+		}
+		panic(fmt.Errorf("field a of message goproto.proto.test2.TestAllTypes.OptionalGroup is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_OptionalGroup); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OptionalGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OptionalGroup does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestAllTypes_OptionalGroup) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 17: // goproto.proto.test2.TestAllTypes.OptionalGroup.a
+		if fd != fd_TestAllTypes_OptionalGroup_a {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 1000: // goproto.proto.test2.TestAllTypes.OptionalGroup.optional_nested_message
+		if fd != fd_TestAllTypes_OptionalGroup_optional_nested_message {
+			break
+		}
+		m := new(TestAllTypes_NestedMessage)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_OptionalGroup); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OptionalGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OptionalGroup does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestAllTypes_OptionalGroup) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test2.TestAllTypes.OptionalGroup", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestAllTypes_OptionalGroup) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_OptionalGroup) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestAllTypes_OptionalGroup) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestAllTypes_OptionalGroup) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestAllTypes_OptionalGroup_methods
+}
+
+var _fastReflection_TestAllTypes_OptionalGroup_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestAllTypes_OptionalGroup_size,
+	Marshal:           _fastReflection_TestAllTypes_OptionalGroup_marshal,
+	Unmarshal:         _fastReflection_TestAllTypes_OptionalGroup_unmarshal,
+	Merge:             _fastReflection_TestAllTypes_OptionalGroup_merge,
+	CheckInitialized:  _fastReflection_TestAllTypes_OptionalGroup_checkInitialized,
+	Equal:             _fastReflection_TestAllTypes_OptionalGroup_equal,
+}
+
+func _fastReflection_TestAllTypes_OptionalGroup_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestAllTypes_OptionalGroup)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.A != nil {
+		n += 2 + runtime.Sov(uint64(*x.A))
+	}
+	if x.OptionalNestedMessage != nil {
+		l = options.Size(x.OptionalNestedMessage)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestAllTypes_OptionalGroup_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes_OptionalGroup)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.OptionalNestedMessage != nil {
+		l = options.Size(x.OptionalNestedMessage)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.OptionalNestedMessage); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc2
+	}
+	if x.A != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.A))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestAllTypes_OptionalGroup_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes_OptionalGroup)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestAllTypes_OptionalGroup.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes_OptionalGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes_OptionalGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 17:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.A = &v
+		case 1000:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.OptionalNestedMessage == nil {
+				if err := budget.Message(fd_TestAllTypes_OptionalGroup_optional_nested_message, int(unsafe.Sizeof(TestAllTypes_NestedMessage{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.OptionalNestedMessage = &TestAllTypes_NestedMessage{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OptionalNestedMessage); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_OptionalGroup_optional_nested_message, -1)
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestAllTypes_OptionalGroup, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_TestAllTypes_OptionalGroup_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestAllTypes_OptionalGroup)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestAllTypes_OptionalGroup)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.A != nil {
+		v := *src.A
+		dst.A = &v
+	}
+	if src.OptionalNestedMessage != nil {
+		if dst.OptionalNestedMessage == nil {
+			dst.OptionalNestedMessage = &TestAllTypes_NestedMessage{}
+		}
+		proto.Merge(dst.OptionalNestedMessage, src.OptionalNestedMessage)
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestAllTypes_OptionalGroup_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestAllTypes_OptionalGroup_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestAllTypes_OptionalGroup)
+	y, yok := input.MessageB.Interface().(*TestAllTypes_OptionalGroup)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.A == nil) != (y.A == nil) || x.A != nil && *x.A != *y.A {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.OptionalNestedMessage.Equal(y.OptionalNestedMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestAllTypes_OptionalGroup) Clone() *TestAllTypes_OptionalGroup {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestAllTypes_OptionalGroup)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestAllTypes_OptionalGroup) CopyInto(dst *TestAllTypes_OptionalGroup) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	if x.A != nil {
+		v := *x.A
+		dst.A = &v
+	} else {
+		dst.A = nil
+	}
+	dst.OptionalNestedMessage = x.OptionalNestedMessage.Clone()
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestAllTypes_OptionalGroup) Equal(y *TestAllTypes_OptionalGroup) bool {
+	return _fastReflection_TestAllTypes_OptionalGroup_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestAllTypes_OptionalGroup, or nil if b is canonical.
+func (*TestAllTypes_OptionalGroup) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestAllTypes_OptionalGroup.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 17:
+			w.Scalar("a", protoreflect.Int32Kind, false)
+		case 1000:
+			w.Message("optional_nested_message", false, (*TestAllTypes_NestedMessage)(nil))
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_TestAllTypes_RepeatedGroup   protoreflect.MessageDescriptor
+	fd_TestAllTypes_RepeatedGroup_a protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test2_test_proto_init()
+	md_TestAllTypes_RepeatedGroup = File_internal_testprotos_test2_test_proto.Messages().ByName("TestAllTypes").Messages().ByName("RepeatedGroup")
+	fd_TestAllTypes_RepeatedGroup_a = md_TestAllTypes_RepeatedGroup.Fields().ByName("a")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestAllTypes_RepeatedGroup)(nil)
+
+type fastReflection_TestAllTypes_RepeatedGroup TestAllTypes_RepeatedGroup
+
+func (x *TestAllTypes_RepeatedGroup) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestAllTypes_RepeatedGroup)(x)
+}
+
+func (x *TestAllTypes_RepeatedGroup) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test2_test_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestAllTypes_RepeatedGroup_messageType fastReflection_TestAllTypes_RepeatedGroup_messageType
+var _ protoreflect.MessageType = fastReflection_TestAllTypes_RepeatedGroup_messageType{}
+
+type fastReflection_TestAllTypes_RepeatedGroup_messageType struct{}
+
+func (x fastReflection_TestAllTypes_RepeatedGroup_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestAllTypes_RepeatedGroup)(nil)
+}
+func (x fastReflection_TestAllTypes_RepeatedGroup_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestAllTypes_RepeatedGroup)
+}
+func (x fastReflection_TestAllTypes_RepeatedGroup_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestAllTypes_RepeatedGroup
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestAllTypes_RepeatedGroup) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestAllTypes_RepeatedGroup
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestAllTypes_RepeatedGroup) Type() protoreflect.MessageType {
+	return _fastReflection_TestAllTypes_RepeatedGroup_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestAllTypes_RepeatedGroup) New() protoreflect.Message {
+	return new(fastReflection_TestAllTypes_RepeatedGroup)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestAllTypes_RepeatedGroup) Interface() protoreflect.ProtoMessage {
+	return (*TestAllTypes_RepeatedGroup)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestAllTypes_RepeatedGroup) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.A != nil {
+		value := protoreflect.ValueOfInt32(*x.A)
+		if !f(fd_TestAllTypes_RepeatedGroup_a, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestAllTypes_RepeatedGroup) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 47: // goproto.proto.test2.TestAllTypes.RepeatedGroup.a
+		if fd != fd_TestAllTypes_RepeatedGroup_a {
+			break
+		}
+		return x.A != nil
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_RepeatedGroup); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.RepeatedGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.RepeatedGroup does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_RepeatedGroup) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 47: // goproto.proto.test2.TestAllTypes.RepeatedGroup.a
+		if fd != fd_TestAllTypes_RepeatedGroup_a {
+			break
+		}
+		x.A = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_RepeatedGroup); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.RepeatedGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.RepeatedGroup does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestAllTypes_RepeatedGroup) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 47: // goproto.proto.test2.TestAllTypes.RepeatedGroup.a
+		if descriptor != fd_TestAllTypes_RepeatedGroup_a {
+			break
+		}
+		if x.A == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.A
+		return protoreflect.ValueOfInt32(value)
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestAllTypes_RepeatedGroup); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.RepeatedGroup does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.RepeatedGroup does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_RepeatedGroup) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 47: // goproto.proto.test2.TestAllTypes.RepeatedGroup.a
+		if fd != fd_TestAllTypes_RepeatedGroup_a {
+			break
+		}
+		cv := int32(value.Int())
+		x.A = &cv
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_RepeatedGroup); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.RepeatedGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.RepeatedGroup does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_RepeatedGroup) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 47: // goproto.proto.test2.TestAllTypes.RepeatedGroup.a
+		if fd != fd_TestAllTypes_RepeatedGroup_a {
+			break
+		}
+		panic(fmt.Errorf("field a of message goproto.proto.test2.TestAllTypes.RepeatedGroup is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_RepeatedGroup); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.RepeatedGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.RepeatedGroup does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestAllTypes_RepeatedGroup) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 47: // goproto.proto.test2.TestAllTypes.RepeatedGroup.a
+		if fd != fd_TestAllTypes_RepeatedGroup_a {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_RepeatedGroup); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.RepeatedGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.RepeatedGroup does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestAllTypes_RepeatedGroup) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test2.TestAllTypes.RepeatedGroup", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestAllTypes_RepeatedGroup) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_RepeatedGroup) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestAllTypes_RepeatedGroup) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestAllTypes_RepeatedGroup) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestAllTypes_RepeatedGroup_methods
+}
+
+var _fastReflection_TestAllTypes_RepeatedGroup_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestAllTypes_RepeatedGroup_size,
+	Marshal:           _fastReflection_TestAllTypes_RepeatedGroup_marshal,
+	Unmarshal:         _fastReflection_TestAllTypes_RepeatedGroup_unmarshal,
+	Merge:             _fastReflection_TestAllTypes_RepeatedGroup_merge,
+	CheckInitialized:  _fastReflection_TestAllTypes_RepeatedGroup_checkInitialized,
+	Equal:             _fastReflection_TestAllTypes_RepeatedGroup_equal,
+}
+
+func _fastReflection_TestAllTypes_RepeatedGroup_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestAllTypes_RepeatedGroup)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.A != nil {
+		n += 2 + runtime.Sov(uint64(*x.A))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestAllTypes_RepeatedGroup_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes_RepeatedGroup)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.A != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.A))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestAllTypes_RepeatedGroup_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes_RepeatedGroup)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestAllTypes_RepeatedGroup.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes_RepeatedGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes_RepeatedGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 47:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.A = &v
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestAllTypes_RepeatedGroup, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_TestAllTypes_RepeatedGroup_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestAllTypes_RepeatedGroup)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestAllTypes_RepeatedGroup)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.A != nil {
+		v := *src.A
+		dst.A = &v
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestAllTypes_RepeatedGroup_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestAllTypes_RepeatedGroup_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestAllTypes_RepeatedGroup)
+	y, yok := input.MessageB.Interface().(*TestAllTypes_RepeatedGroup)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.A == nil) != (y.A == nil) || x.A != nil && *x.A != *y.A {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestAllTypes_RepeatedGroup) Clone() *TestAllTypes_RepeatedGroup {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestAllTypes_RepeatedGroup)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestAllTypes_RepeatedGroup) CopyInto(dst *TestAllTypes_RepeatedGroup) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	if x.A != nil {
+		v := *x.A
+		dst.A = &v
+	} else {
+		dst.A = nil
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestAllTypes_RepeatedGroup) Equal(y *TestAllTypes_RepeatedGroup) bool {
+	return _fastReflection_TestAllTypes_RepeatedGroup_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestAllTypes_RepeatedGroup, or nil if b is canonical.
+func (*TestAllTypes_RepeatedGroup) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestAllTypes_RepeatedGroup.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 47:
+			w.Scalar("a", protoreflect.Int32Kind, false)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_TestAllTypes_OneofGroup   protoreflect.MessageDescriptor
+	fd_TestAllTypes_OneofGroup_a protoreflect.FieldDescriptor
+	fd_TestAllTypes_OneofGroup_b protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test2_test_proto_init()
+	md_TestAllTypes_OneofGroup = File_internal_testprotos_test2_test_proto.Messages().ByName("TestAllTypes").Messages().ByName("OneofGroup")
+	fd_TestAllTypes_OneofGroup_a = md_TestAllTypes_OneofGroup.Fields().ByName("a")
+	fd_TestAllTypes_OneofGroup_b = md_TestAllTypes_OneofGroup.Fields().ByName("b")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestAllTypes_OneofGroup)(nil)
+
+type fastReflection_TestAllTypes_OneofGroup TestAllTypes_OneofGroup
+
+func (x *TestAllTypes_OneofGroup) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestAllTypes_OneofGroup)(x)
+}
+
+func (x *TestAllTypes_OneofGroup) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test2_test_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestAllTypes_OneofGroup_messageType fastReflection_TestAllTypes_OneofGroup_messageType
+var _ protoreflect.MessageType = fastReflection_TestAllTypes_OneofGroup_messageType{}
+
+type fastReflection_TestAllTypes_OneofGroup_messageType struct{}
+
+func (x fastReflection_TestAllTypes_OneofGroup_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestAllTypes_OneofGroup)(nil)
+}
+func (x fastReflection_TestAllTypes_OneofGroup_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestAllTypes_OneofGroup)
+}
+func (x fastReflection_TestAllTypes_OneofGroup_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestAllTypes_OneofGroup
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestAllTypes_OneofGroup) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestAllTypes_OneofGroup
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestAllTypes_OneofGroup) Type() protoreflect.MessageType {
+	return _fastReflection_TestAllTypes_OneofGroup_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestAllTypes_OneofGroup) New() protoreflect.Message {
+	return new(fastReflection_TestAllTypes_OneofGroup)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestAllTypes_OneofGroup) Interface() protoreflect.ProtoMessage {
+	return (*TestAllTypes_OneofGroup)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestAllTypes_OneofGroup) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.A != nil {
+		value := protoreflect.ValueOfInt32(*x.A)
+		if !f(fd_TestAllTypes_OneofGroup_a, value) {
+			return
+		}
+	}
+	if x.B != nil {
+		value := protoreflect.ValueOfInt32(*x.B)
+		if !f(fd_TestAllTypes_OneofGroup_b, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestAllTypes_OneofGroup) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestAllTypes.OneofGroup.a
+		if fd != fd_TestAllTypes_OneofGroup_a {
+			break
+		}
+		return x.A != nil
+	case 2: // goproto.proto.test2.TestAllTypes.OneofGroup.b
+		if fd != fd_TestAllTypes_OneofGroup_b {
+			break
+		}
+		return x.B != nil
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_OneofGroup); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OneofGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OneofGroup does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_OneofGroup) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestAllTypes.OneofGroup.a
+		if fd != fd_TestAllTypes_OneofGroup_a {
+			break
+		}
+		x.A = nil
+		return
+	case 2: // goproto.proto.test2.TestAllTypes.OneofGroup.b
+		if fd != fd_TestAllTypes_OneofGroup_b {
+			break
+		}
+		x.B = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_OneofGroup); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OneofGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OneofGroup does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestAllTypes_OneofGroup) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test2.TestAllTypes.OneofGroup.a
+		if descriptor != fd_TestAllTypes_OneofGroup_a {
+			break
+		}
+		if x.A == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.A
+		return protoreflect.ValueOfInt32(value)
+	case 2: // goproto.proto.test2.TestAllTypes.OneofGroup.b
+		if descriptor != fd_TestAllTypes_OneofGroup_b {
+			break
+		}
+		if x.B == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.B
+		return protoreflect.ValueOfInt32(value)
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestAllTypes_OneofGroup); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OneofGroup does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OneofGroup does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_OneofGroup) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestAllTypes.OneofGroup.a
+		if fd != fd_TestAllTypes_OneofGroup_a {
+			break
+		}
+		cv := int32(value.Int())
+		x.A = &cv
+		return
+	case 2: // goproto.proto.test2.TestAllTypes.OneofGroup.b
+		if fd != fd_TestAllTypes_OneofGroup_b {
+			break
+		}
+		cv := int32(value.Int())
+		x.B = &cv
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_OneofGroup); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OneofGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OneofGroup does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_OneofGroup) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestAllTypes.OneofGroup.a
+		if fd != fd_TestAllTypes_OneofGroup_a {
+			break
+		}
+		panic(fmt.Errorf("field a of message goproto.proto.test2.TestAllTypes.OneofGroup is not mutable"))
+	case 2: // goproto.proto.test2.TestAllTypes.OneofGroup.b
+		if fd != fd_TestAllTypes_OneofGroup_b {
+			break
+		}
+		panic(fmt.Errorf("field b of message goproto.proto.test2.TestAllTypes.OneofGroup is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_OneofGroup); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OneofGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OneofGroup does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestAllTypes_OneofGroup) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestAllTypes.OneofGroup.a
+		if fd != fd_TestAllTypes_OneofGroup_a {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 2: // goproto.proto.test2.TestAllTypes.OneofGroup.b
+		if fd != fd_TestAllTypes_OneofGroup_b {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_OneofGroup); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OneofGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestAllTypes.OneofGroup does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestAllTypes_OneofGroup) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test2.TestAllTypes.OneofGroup", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestAllTypes_OneofGroup) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_OneofGroup) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestAllTypes_OneofGroup) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestAllTypes_OneofGroup) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestAllTypes_OneofGroup_methods
+}
+
+var _fastReflection_TestAllTypes_OneofGroup_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestAllTypes_OneofGroup_size,
+	Marshal:           _fastReflection_TestAllTypes_OneofGroup_marshal,
+	Unmarshal:         _fastReflection_TestAllTypes_OneofGroup_unmarshal,
+	Merge:             _fastReflection_TestAllTypes_OneofGroup_merge,
+	CheckInitialized:  _fastReflection_TestAllTypes_OneofGroup_checkInitialized,
+	Equal:             _fastReflection_TestAllTypes_OneofGroup_equal,
+}
+
+func _fastReflection_TestAllTypes_OneofGroup_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestAllTypes_OneofGroup)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.A != nil {
+		n += 1 + runtime.Sov(uint64(*x.A))
+	}
+	if x.B != nil {
+		n += 1 + runtime.Sov(uint64(*x.B))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestAllTypes_OneofGroup_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes_OneofGroup)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.B != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.B))
+		i--
+		dAtA[i] = 0x10
+	}
+	if x.A != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.A))
+		i--
+		dAtA[i] = 0x8
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestAllTypes_OneofGroup_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes_OneofGroup)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestAllTypes_OneofGroup.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes_OneofGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes_OneofGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.A = &v
+		case 2:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.B = &v
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestAllTypes_OneofGroup, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_TestAllTypes_OneofGroup_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestAllTypes_OneofGroup)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestAllTypes_OneofGroup)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.A != nil {
+		v := *src.A
+		dst.A = &v
+	}
+	if src.B != nil {
+		v := *src.B
+		dst.B = &v
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestAllTypes_OneofGroup_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestAllTypes_OneofGroup_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestAllTypes_OneofGroup)
+	y, yok := input.MessageB.Interface().(*TestAllTypes_OneofGroup)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.A == nil) != (y.A == nil) || x.A != nil && *x.A != *y.A {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.B == nil) != (y.B == nil) || x.B != nil && *x.B != *y.B {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestAllTypes_OneofGroup) Clone() *TestAllTypes_OneofGroup {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestAllTypes_OneofGroup)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestAllTypes_OneofGroup) CopyInto(dst *TestAllTypes_OneofGroup) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	if x.A != nil {
+		v := *x.A
+		dst.A = &v
+	} else {
+		dst.A = nil
+	}
+	if x.B != nil {
+		v := *x.B
+		dst.B = &v
+	} else {
+		dst.B = nil
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestAllTypes_OneofGroup) Equal(y *TestAllTypes_OneofGroup) bool {
+	return _fastReflection_TestAllTypes_OneofGroup_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestAllTypes_OneofGroup, or nil if b is canonical.
+func (*TestAllTypes_OneofGroup) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestAllTypes_OneofGroup.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("a", protoreflect.Int32Kind, false)
+		case 2:
+			w.Scalar("b", protoreflect.Int32Kind, false)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_ForeignMessage   protoreflect.MessageDescriptor
+	fd_ForeignMessage_c protoreflect.FieldDescriptor
+	fd_ForeignMessage_d protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test2_test_proto_init()
+	md_ForeignMessage = File_internal_testprotos_test2_test_proto.Messages().ByName("ForeignMessage")
+	fd_ForeignMessage_c = md_ForeignMessage.Fields().ByName("c")
+	fd_ForeignMessage_d = md_ForeignMessage.Fields().ByName("d")
+}
+
+var _ protoreflect.Message = (*fastReflection_ForeignMessage)(nil)
+
+type fastReflection_ForeignMessage ForeignMessage
+
+func (x *ForeignMessage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ForeignMessage)(x)
+}
+
+func (x *ForeignMessage) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test2_test_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ForeignMessage_messageType fastReflection_ForeignMessage_messageType
+var _ protoreflect.MessageType = fastReflection_ForeignMessage_messageType{}
+
+type fastReflection_ForeignMessage_messageType struct{}
+
+func (x fastReflection_ForeignMessage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ForeignMessage)(nil)
+}
+func (x fastReflection_ForeignMessage_messageType) New() protoreflect.Message {
+	return new(fastReflection_ForeignMessage)
+}
+func (x fastReflection_ForeignMessage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForeignMessage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ForeignMessage) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForeignMessage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ForeignMessage) Type() protoreflect.MessageType {
+	return _fastReflection_ForeignMessage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ForeignMessage) New() protoreflect.Message {
+	return new(fastReflection_ForeignMessage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ForeignMessage) Interface() protoreflect.ProtoMessage {
+	return (*ForeignMessage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ForeignMessage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.C != nil {
+		value := protoreflect.ValueOfInt32(*x.C)
+		if !f(fd_ForeignMessage_c, value) {
+			return
+		}
+	}
+	if x.D != nil {
+		value := protoreflect.ValueOfInt32(*x.D)
+		if !f(fd_ForeignMessage_d, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ForeignMessage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.ForeignMessage.c
+		if fd != fd_ForeignMessage_c {
+			break
+		}
+		return x.C != nil
+	case 2: // goproto.proto.test2.ForeignMessage.d
+		if fd != fd_ForeignMessage_d {
+			break
+		}
+		return x.D != nil
+	}
+	if fd := runtime.FieldOf(fd, md_ForeignMessage); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.ForeignMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.ForeignMessage does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForeignMessage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.ForeignMessage.c
+		if fd != fd_ForeignMessage_c {
+			break
+		}
+		x.C = nil
+		return
+	case 2: // goproto.proto.test2.ForeignMessage.d
+		if fd != fd_ForeignMessage_d {
+			break
+		}
+		x.D = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_ForeignMessage); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.ForeignMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.ForeignMessage does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ForeignMessage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test2.ForeignMessage.c
+		if descriptor != fd_ForeignMessage_c {
+			break
+		}
+		if x.C == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.C
+		return protoreflect.ValueOfInt32(value)
+	case 2: // goproto.proto.test2.ForeignMessage.d
+		if descriptor != fd_ForeignMessage_d {
+			break
+		}
+		if x.D == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.D
+		return protoreflect.ValueOfInt32(value)
+	}
+	if fd := runtime.FieldOf(descriptor, md_ForeignMessage); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.ForeignMessage does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.ForeignMessage does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForeignMessage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.ForeignMessage.c
+		if fd != fd_ForeignMessage_c {
+			break
+		}
+		cv := int32(value.Int())
+		x.C = &cv
+		return
+	case 2: // goproto.proto.test2.ForeignMessage.d
+		if fd != fd_ForeignMessage_d {
+			break
+		}
+		cv := int32(value.Int())
+		x.D = &cv
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_ForeignMessage); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.ForeignMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.ForeignMessage does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForeignMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.ForeignMessage.c
+		if fd != fd_ForeignMessage_c {
+			break
+		}
+		panic(fmt.Errorf("field c of message goproto.proto.test2.ForeignMessage is not mutable"))
+	case 2: // goproto.proto.test2.ForeignMessage.d
+		if fd != fd_ForeignMessage_d {
+			break
+		}
+		panic(fmt.Errorf("field d of message goproto.proto.test2.ForeignMessage is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_ForeignMessage); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.ForeignMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.ForeignMessage does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ForeignMessage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.ForeignMessage.c
+		if fd != fd_ForeignMessage_c {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 2: // goproto.proto.test2.ForeignMessage.d
+		if fd != fd_ForeignMessage_d {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	}
+	if fd := runtime.FieldOf(fd, md_ForeignMessage); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.ForeignMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.ForeignMessage does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ForeignMessage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test2.ForeignMessage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ForeignMessage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForeignMessage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ForeignMessage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ForeignMessage) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_ForeignMessage_methods
+}
+
+var _fastReflection_ForeignMessage_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_ForeignMessage_size,
+	Marshal:           _fastReflection_ForeignMessage_marshal,
+	Unmarshal:         _fastReflection_ForeignMessage_unmarshal,
+	Merge:             _fastReflection_ForeignMessage_merge,
+	CheckInitialized:  _fastReflection_ForeignMessage_checkInitialized,
+	Equal:             _fastReflection_ForeignMessage_equal,
+}
+
+func _fastReflection_ForeignMessage_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*ForeignMessage)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.C != nil {
+		n += 1 + runtime.Sov(uint64(*x.C))
+	}
+	if x.D != nil {
+		n += 1 + runtime.Sov(uint64(*x.D))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_ForeignMessage_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*ForeignMessage)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.D != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.D))
+		i--
+		dAtA[i] = 0x10
+	}
+	if x.C != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.C))
+		i--
+		dAtA[i] = 0x8
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_ForeignMessage_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*ForeignMessage)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_ForeignMessage.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForeignMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForeignMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.C = &v
+		case 2:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field D", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.D = &v
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_ForeignMessage, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_ForeignMessage_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*ForeignMessage)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*ForeignMessage)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.C != nil {
+		v := *src.C
+		dst.C = &v
+	}
+	if src.D != nil {
+		v := *src.D
+		dst.D = &v
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_ForeignMessage_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_ForeignMessage_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*ForeignMessage)
+	y, yok := input.MessageB.Interface().(*ForeignMessage)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.C == nil) != (y.C == nil) || x.C != nil && *x.C != *y.C {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.D == nil) != (y.D == nil) || x.D != nil && *x.D != *y.D {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *ForeignMessage) Clone() *ForeignMessage {
+	if x == nil {
+		return nil
+	}
+	dst := new(ForeignMessage)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *ForeignMessage) CopyInto(dst *ForeignMessage) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	if x.C != nil {
+		v := *x.C
+		dst.C = &v
+	} else {
+		dst.C = nil
+	}
+	if x.D != nil {
+		v := *x.D
+		dst.D = &v
+	} else {
+		dst.D = nil
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *ForeignMessage) Equal(y *ForeignMessage) bool {
+	return _fastReflection_ForeignMessage_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a ForeignMessage, or nil if b is canonical.
+func (*ForeignMessage) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_ForeignMessage.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("c", protoreflect.Int32Kind, false)
+		case 2:
+			w.Scalar("d", protoreflect.Int32Kind, false)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_TestExtensionRange      protoreflect.MessageDescriptor
+	fd_TestExtensionRange_name protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test2_test_proto_init()
+	md_TestExtensionRange = File_internal_testprotos_test2_test_proto.Messages().ByName("TestExtensionRange")
+	fd_TestExtensionRange_name = md_TestExtensionRange.Fields().ByName("name")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestExtensionRange)(nil)
+
+type fastReflection_TestExtensionRange TestExtensionRange
+
+func (x *TestExtensionRange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestExtensionRange)(x)
+}
+
+func (x *TestExtensionRange) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test2_test_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestExtensionRange_messageType fastReflection_TestExtensionRange_messageType
+var _ protoreflect.MessageType = fastReflection_TestExtensionRange_messageType{}
+
+type fastReflection_TestExtensionRange_messageType struct{}
+
+func (x fastReflection_TestExtensionRange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestExtensionRange)(nil)
+}
+func (x fastReflection_TestExtensionRange_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestExtensionRange)
+}
+func (x fastReflection_TestExtensionRange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestExtensionRange
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestExtensionRange) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestExtensionRange
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestExtensionRange) Type() protoreflect.MessageType {
+	return _fastReflection_TestExtensionRange_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestExtensionRange) New() protoreflect.Message {
+	return new(fastReflection_TestExtensionRange)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestExtensionRange) Interface() protoreflect.ProtoMessage {
+	return (*TestExtensionRange)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestExtensionRange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != nil {
+		value := protoreflect.ValueOfString(*x.Name)
+		if !f(fd_TestExtensionRange_name, value) {
+			return
+		}
+	}
+	runtime.RangeExtensions(&x.extensionFields, f)
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestExtensionRange) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestExtensionRange.name
+		if fd != fd_TestExtensionRange_name {
+			break
+		}
+		return x.Name != nil
+	}
+	if fd.IsExtension() {
+		return runtime.HasExtension(&x.extensionFields, runtime.ExtensionDescriptor(fd, md_TestExtensionRange))
+	}
+	if fd := runtime.FieldOf(fd, md_TestExtensionRange); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestExtensionRange does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestExtensionRange does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestExtensionRange) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestExtensionRange.name
+		if fd != fd_TestExtensionRange_name {
+			break
+		}
+		x.Name = nil
+		return
+	}
+	if fd.IsExtension() {
+		runtime.ClearExtension(&x.extensionFields, runtime.ExtensionDescriptor(fd, md_TestExtensionRange))
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestExtensionRange); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestExtensionRange does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestExtensionRange does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestExtensionRange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test2.TestExtensionRange.name
+		if descriptor != fd_TestExtensionRange_name {
+			break
+		}
+		if x.Name == nil {
+			return protoreflect.ValueOfString("")
+		}
+		value := *x.Name
+		return protoreflect.ValueOfString(value)
+	}
+	if descriptor.IsExtension() {
+		return runtime.GetExtension(&x.extensionFields, runtime.ExtensionDescriptor(descriptor, md_TestExtensionRange))
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestExtensionRange); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestExtensionRange does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestExtensionRange does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestExtensionRange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestExtensionRange.name
+		if fd != fd_TestExtensionRange_name {
+			break
+		}
+		cv := value.Interface().(string)
+		x.Name = &cv
+		return
+	}
+	if fd.IsExtension() {
+		runtime.SetExtension(&x.extensionFields, runtime.ExtensionDescriptor(fd, md_TestExtensionRange), value)
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestExtensionRange); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestExtensionRange does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestExtensionRange does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestExtensionRange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestExtensionRange.name
+		if fd != fd_TestExtensionRange_name {
+			break
+		}
+		panic(fmt.Errorf("field name of message goproto.proto.test2.TestExtensionRange is not mutable"))
+	}
+	if fd.IsExtension() {
+		return runtime.MutableExtension(&x.extensionFields, runtime.ExtensionDescriptor(fd, md_TestExtensionRange))
+	}
+	if fd := runtime.FieldOf(fd, md_TestExtensionRange); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestExtensionRange does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestExtensionRange does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestExtensionRange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestExtensionRange.name
+		if fd != fd_TestExtensionRange_name {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	}
+	if fd.IsExtension() {
+		return runtime.NewFieldExtension(&x.extensionFields, runtime.ExtensionDescriptor(fd, md_TestExtensionRange))
+	}
+	if fd := runtime.FieldOf(fd, md_TestExtensionRange); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestExtensionRange does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestExtensionRange does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestExtensionRange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test2.TestExtensionRange", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestExtensionRange) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestExtensionRange) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestExtensionRange) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestExtensionRange) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestExtensionRange_methods
+}
+
+var _fastReflection_TestExtensionRange_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestExtensionRange_size,
+	Marshal:           _fastReflection_TestExtensionRange_marshal,
+	Unmarshal:         _fastReflection_TestExtensionRange_unmarshal,
+	Merge:             _fastReflection_TestExtensionRange_merge,
+	CheckInitialized:  _fastReflection_TestExtensionRange_checkInitialized,
+	Equal:             _fastReflection_TestExtensionRange_equal,
+}
+
+func _fastReflection_TestExtensionRange_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestExtensionRange)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.Name != nil {
+		l = len(*x.Name)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	n += runtime.SizeExtensions(&x.extensionFields, options)
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestExtensionRange_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestExtensionRange)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.Name != nil {
+		i -= len(*x.Name)
+		copy(dAtA[i:], *x.Name)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(*x.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	if len(x.extensionFields) > 0 {
+		if encoded, err := runtime.AppendExtensions(dAtA[:0:i], &x.extensionFields, options); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestExtensionRange_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestExtensionRange)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestExtensionRange.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestExtensionRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestExtensionRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestExtensionRange_name, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			s := runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			x.Name = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if n, err := runtime.UnmarshalExtension(dAtA[iNdEx:], &x.extensionFields, &x.unknownFields, md_TestExtensionRange, options); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			} else if n > 0 {
+				iNdEx += n
+				continue
+			}
+			if err := runtime.CheckUnknownField(options, md_TestExtensionRange, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestExtensionRange_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestExtensionRange)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestExtensionRange)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.Name != nil {
+		v := *src.Name
+		dst.Name = &v
+	}
+	if len(src.extensionFields) > 0 {
+		runtime.MergeExtensions(&dst.extensionFields, &src.extensionFields)
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestExtensionRange_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	x := input.Message.Interface().(*TestExtensionRange)
+	if x == nil {
+		return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+	}
+	if err := runtime.CheckExtensionsInitialized(&x.extensionFields); err != nil {
+		return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, err
+	}
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestExtensionRange_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestExtensionRange)
+	y, yok := input.MessageB.Interface().(*TestExtensionRange)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.Name == nil) != (y.Name == nil) || x.Name != nil && *x.Name != *y.Name {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !runtime.EqualExtensions(&x.extensionFields, &y.extensionFields) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestExtensionRange) Clone() *TestExtensionRange {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestExtensionRange)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestExtensionRange) CopyInto(dst *TestExtensionRange) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	if x.Name != nil {
+		v := *x.Name
+		dst.Name = &v
+	} else {
+		dst.Name = nil
+	}
+	dst.extensionFields = nil
+	if len(x.extensionFields) > 0 {
+		runtime.MergeExtensions(&dst.extensionFields, &x.extensionFields)
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestExtensionRange) Equal(y *TestExtensionRange) bool {
+	return _fastReflection_TestExtensionRange_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestExtensionRange, or nil if b is canonical.
+func (*TestExtensionRange) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestExtensionRange.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("name", protoreflect.StringKind, false)
+		default:
+			if md_TestExtensionRange.ExtensionRanges().Has(w.Number()) {
+				w.Extension()
+			} else {
+				w.Unknown()
+			}
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_TestRequired                protoreflect.MessageDescriptor
+	fd_TestRequired_required_field protoreflect.FieldDescriptor
+	fd_TestRequired_optional_field protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test2_test_proto_init()
+	md_TestRequired = File_internal_testprotos_test2_test_proto.Messages().ByName("TestRequired")
+	fd_TestRequired_required_field = md_TestRequired.Fields().ByName("required_field")
+	fd_TestRequired_optional_field = md_TestRequired.Fields().ByName("optional_field")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestRequired)(nil)
+
+type fastReflection_TestRequired TestRequired
+
+func (x *TestRequired) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestRequired)(x)
+}
+
+func (x *TestRequired) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test2_test_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestRequired_messageType fastReflection_TestRequired_messageType
+var _ protoreflect.MessageType = fastReflection_TestRequired_messageType{}
+
+type fastReflection_TestRequired_messageType struct{}
+
+func (x fastReflection_TestRequired_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestRequired)(nil)
+}
+func (x fastReflection_TestRequired_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestRequired)
+}
+func (x fastReflection_TestRequired_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestRequired
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestRequired) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestRequired
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestRequired) Type() protoreflect.MessageType {
+	return _fastReflection_TestRequired_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestRequired) New() protoreflect.Message {
+	return new(fastReflection_TestRequired)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestRequired) Interface() protoreflect.ProtoMessage {
+	return (*TestRequired)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestRequired) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequiredField != nil {
+		value := protoreflect.ValueOfInt32(*x.RequiredField)
+		if !f(fd_TestRequired_required_field, value) {
+			return
+		}
+	}
+	if x.OptionalField != nil {
+		value := protoreflect.ValueOfString(*x.OptionalField)
+		if !f(fd_TestRequired_optional_field, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestRequired) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestRequired.required_field
+		if fd != fd_TestRequired_required_field {
+			break
+		}
+		return x.RequiredField != nil
+	case 2: // goproto.proto.test2.TestRequired.optional_field
+		if fd != fd_TestRequired_optional_field {
+			break
+		}
+		return x.OptionalField != nil
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequired); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequired does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequired does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequired) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestRequired.required_field
+		if fd != fd_TestRequired_required_field {
+			break
+		}
+		x.RequiredField = nil
+		return
+	case 2: // goproto.proto.test2.TestRequired.optional_field
+		if fd != fd_TestRequired_optional_field {
+			break
+		}
+		x.OptionalField = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequired); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequired does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequired does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestRequired) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test2.TestRequired.required_field
+		if descriptor != fd_TestRequired_required_field {
+			break
+		}
+		if x.RequiredField == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.RequiredField
+		return protoreflect.ValueOfInt32(value)
+	case 2: // goproto.proto.test2.TestRequired.optional_field
+		if descriptor != fd_TestRequired_optional_field {
+			break
+		}
+		if x.OptionalField == nil {
+			return protoreflect.ValueOfString("")
+		}
+		value := *x.OptionalField
+		return protoreflect.ValueOfString(value)
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestRequired); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequired does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequired does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequired) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestRequired.required_field
+		if fd != fd_TestRequired_required_field {
+			break
+		}
+		cv := int32(value.Int())
+		x.RequiredField = &cv
+		return
+	case 2: // goproto.proto.test2.TestRequired.optional_field
+		if fd != fd_TestRequired_optional_field {
+			break
+		}
+		cv := value.Interface().(string)
+		x.OptionalField = &cv
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequired); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequired does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequired does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequired) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestRequired.required_field
+		if fd != fd_TestRequired_required_field {
+			break
+		}
+		panic(fmt.Errorf("field required_field of message goproto.proto.test2.TestRequired is not mutable"))
+	case 2: // goproto.proto.test2.TestRequired.optional_field
+		if fd != fd_TestRequired_optional_field {
+			break
+		}
+		panic(fmt.Errorf("field optional_field of message goproto.proto.test2.TestRequired is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequired); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequired does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequired does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestRequired) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestRequired.required_field
+		if fd != fd_TestRequired_required_field {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 2: // goproto.proto.test2.TestRequired.optional_field
+		if fd != fd_TestRequired_optional_field {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequired); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequired does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequired does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestRequired) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test2.TestRequired", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestRequired) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequired) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestRequired) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestRequired) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestRequired_methods
+}
+
+var _fastReflection_TestRequired_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestRequired_size,
+	Marshal:           _fastReflection_TestRequired_marshal,
+	Unmarshal:         _fastReflection_TestRequired_unmarshal,
+	Merge:             _fastReflection_TestRequired_merge,
+	CheckInitialized:  _fastReflection_TestRequired_checkInitialized,
+	Equal:             _fastReflection_TestRequired_equal,
+}
+
+func _fastReflection_TestRequired_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestRequired)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.RequiredField != nil {
+		n += 1 + runtime.Sov(uint64(*x.RequiredField))
+	}
+	if x.OptionalField != nil {
+		l = len(*x.OptionalField)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestRequired_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestRequired)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.OptionalField != nil {
+		i -= len(*x.OptionalField)
+		copy(dAtA[i:], *x.OptionalField)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(*x.OptionalField)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if x.RequiredField != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.RequiredField))
+		i--
+		dAtA[i] = 0x8
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestRequired_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestRequired)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestRequired.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	options, budget := runtime.StartDecodeBudget(options)
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestRequired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestRequired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestRequired_optional_field, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			s := runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			x.OptionalField = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestRequired, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	var flags protoiface.UnmarshalOutputFlags
+	if hasFields[0]&0x1 == 0x1 {
+		flags |= protoiface.UnmarshalInitialized
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: flags}, nil
+}
+
+func _fastReflection_TestRequired_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestRequired)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestRequired)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		dst.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		dst.OptionalField = &v
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestRequired_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	x := input.Message.Interface().(*TestRequired)
+	if x == nil {
+		return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+	}
+	if x.RequiredField == nil {
+		return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, runtime.ErrRequiredNotSet("goproto.proto.test2.TestRequired", "required_field")
+	}
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestRequired_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestRequired)
+	y, yok := input.MessageB.Interface().(*TestRequired)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.RequiredField == nil) != (y.RequiredField == nil) || x.RequiredField != nil && *x.RequiredField != *y.RequiredField {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalField == nil) != (y.OptionalField == nil) || x.OptionalField != nil && *x.OptionalField != *y.OptionalField {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestRequired) Clone() *TestRequired {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestRequired)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestRequired) CopyInto(dst *TestRequired) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	if x.RequiredField != nil {
+		v := *x.RequiredField
+		dst.RequiredField = &v
+	} else {
+		dst.RequiredField = nil
+	}
+	if x.OptionalField != nil {
+		v := *x.OptionalField
+		dst.OptionalField = &v
+	} else {
+		dst.OptionalField = nil
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestRequired) Equal(y *TestRequired) bool {
+	return _fastReflection_TestRequired_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestRequired, or nil if b is canonical.
+func (*TestRequired) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestRequired.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("required_field", protoreflect.Int32Kind, false)
+		case 2:
+			w.Scalar("optional_field", protoreflect.StringKind, false)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var _ protoreflect.List = (*_TestRequiredForeign_2_list)(nil)
+
+type _TestRequiredForeign_2_list struct {
+	list *[]*TestRequired
+}
+
+func (x *_TestRequiredForeign_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestRequiredForeign_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TestRequiredForeign_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TestRequired)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestRequiredForeign_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TestRequired)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestRequiredForeign_2_list) AppendMutable() protoreflect.Value {
+	v := new(TestRequired)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestRequiredForeign_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestRequiredForeign_2_list) NewElement() protoreflect.Value {
+	v := new(TestRequired)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestRequiredForeign_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.Map = (*_TestRequiredForeign_3_map)(nil)
+
+type _TestRequiredForeign_3_map struct {
+	m *map[int32]*TestRequired
+}
+
+func (x *_TestRequiredForeign_3_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_TestRequiredForeign_3_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfInt32(k))
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_TestRequiredForeign_3_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.Int()
+	concreteValue := (int32)(keyUnwrapped)
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_TestRequiredForeign_3_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := (int32)(keyUnwrapped)
+	delete(*x.m, concreteKey)
+}
+
+func (x *_TestRequiredForeign_3_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := (int32)(keyUnwrapped)
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestRequiredForeign_3_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := (int32)(keyUnwrapped)
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TestRequired)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_TestRequiredForeign_3_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.Int()
+	concreteKey := (int32)(keyUnwrapped)
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
+	}
+	newValue := new(TestRequired)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
+}
+
+func (x *_TestRequiredForeign_3_map) NewValue() protoreflect.Value {
+	v := new(TestRequired)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestRequiredForeign_3_map) IsValid() bool {
+	return x.m != nil
+}
+
+var (
+	md_TestRequiredForeign                  protoreflect.MessageDescriptor
+	fd_TestRequiredForeign_optional_message protoreflect.FieldDescriptor
+	fd_TestRequiredForeign_repeated_message protoreflect.FieldDescriptor
+	fd_TestRequiredForeign_map_message      protoreflect.FieldDescriptor
+	fd_TestRequiredForeign_oneof_message    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test2_test_proto_init()
+	md_TestRequiredForeign = File_internal_testprotos_test2_test_proto.Messages().ByName("TestRequiredForeign")
+	fd_TestRequiredForeign_optional_message = md_TestRequiredForeign.Fields().ByName("optional_message")
+	fd_TestRequiredForeign_repeated_message = md_TestRequiredForeign.Fields().ByName("repeated_message")
+	fd_TestRequiredForeign_map_message = md_TestRequiredForeign.Fields().ByName("map_message")
+	fd_TestRequiredForeign_oneof_message = md_TestRequiredForeign.Fields().ByName("oneof_message")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestRequiredForeign)(nil)
+
+type fastReflection_TestRequiredForeign TestRequiredForeign
+
+func (x *TestRequiredForeign) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestRequiredForeign)(x)
+}
+
+func (x *TestRequiredForeign) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test2_test_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestRequiredForeign_messageType fastReflection_TestRequiredForeign_messageType
+var _ protoreflect.MessageType = fastReflection_TestRequiredForeign_messageType{}
+
+type fastReflection_TestRequiredForeign_messageType struct{}
+
+func (x fastReflection_TestRequiredForeign_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestRequiredForeign)(nil)
+}
+func (x fastReflection_TestRequiredForeign_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestRequiredForeign)
+}
+func (x fastReflection_TestRequiredForeign_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestRequiredForeign
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestRequiredForeign) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestRequiredForeign
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestRequiredForeign) Type() protoreflect.MessageType {
+	return _fastReflection_TestRequiredForeign_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestRequiredForeign) New() protoreflect.Message {
+	return new(fastReflection_TestRequiredForeign)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestRequiredForeign) Interface() protoreflect.ProtoMessage {
+	return (*TestRequiredForeign)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestRequiredForeign) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OptionalMessage != nil {
+		value := protoreflect.ValueOfMessage(x.OptionalMessage.ProtoReflect())
+		if !f(fd_TestRequiredForeign_optional_message, value) {
+			return
+		}
+	}
+	if len(x.RepeatedMessage) != 0 {
+		value := protoreflect.ValueOfList(&_TestRequiredForeign_2_list{list: &x.RepeatedMessage})
+		if !f(fd_TestRequiredForeign_repeated_message, value) {
+			return
+		}
+	}
+	if len(x.MapMessage) != 0 {
+		value := protoreflect.ValueOfMap(&_TestRequiredForeign_3_map{m: &x.MapMessage})
+		if !f(fd_TestRequiredForeign_map_message, value) {
+			return
+		}
+	}
+	if x.OneofField != nil {
+		switch o := x.OneofField.(type) {
+		case *TestRequiredForeign_OneofMessage:
+			v := o.OneofMessage
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_TestRequiredForeign_oneof_message, value) {
+				return
+			}
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestRequiredForeign) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestRequiredForeign.optional_message
+		if fd != fd_TestRequiredForeign_optional_message {
+			break
+		}
+		return x.OptionalMessage != nil
+	case 2: // goproto.proto.test2.TestRequiredForeign.repeated_message
+		if fd != fd_TestRequiredForeign_repeated_message {
+			break
+		}
+		return len(x.RepeatedMessage) != 0
+	case 3: // goproto.proto.test2.TestRequiredForeign.map_message
+		if fd != fd_TestRequiredForeign_map_message {
+			break
+		}
+		return len(x.MapMessage) != 0
+	case 4: // goproto.proto.test2.TestRequiredForeign.oneof_message
+		if fd != fd_TestRequiredForeign_oneof_message {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestRequiredForeign_OneofMessage); ok {
+			return true
+		} else {
+			return false
+		}
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredForeign); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredForeign does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredForeign does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequiredForeign) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestRequiredForeign.optional_message
+		if fd != fd_TestRequiredForeign_optional_message {
+			break
+		}
+		x.OptionalMessage = nil
+		return
+	case 2: // goproto.proto.test2.TestRequiredForeign.repeated_message
+		if fd != fd_TestRequiredForeign_repeated_message {
+			break
+		}
+		x.RepeatedMessage = nil
+		return
+	case 3: // goproto.proto.test2.TestRequiredForeign.map_message
+		if fd != fd_TestRequiredForeign_map_message {
+			break
+		}
+		x.MapMessage = nil
+		return
+	case 4: // goproto.proto.test2.TestRequiredForeign.oneof_message
+		if fd != fd_TestRequiredForeign_oneof_message {
+			break
+		}
+		x.OneofField = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredForeign); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredForeign does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredForeign does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestRequiredForeign) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test2.TestRequiredForeign.optional_message
+		if descriptor != fd_TestRequiredForeign_optional_message {
+			break
+		}
+		value := x.OptionalMessage
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 2: // goproto.proto.test2.TestRequiredForeign.repeated_message
+		if descriptor != fd_TestRequiredForeign_repeated_message {
+			break
+		}
+		if len(x.RepeatedMessage) == 0 {
+			return protoreflect.ValueOfList(&_TestRequiredForeign_2_list{})
+		}
+		listValue := &_TestRequiredForeign_2_list{list: &x.RepeatedMessage}
+		return protoreflect.ValueOfList(listValue)
+	case 3: // goproto.proto.test2.TestRequiredForeign.map_message
+		if descriptor != fd_TestRequiredForeign_map_message {
+			break
+		}
+		if len(x.MapMessage) == 0 {
+			return protoreflect.ValueOfMap(&_TestRequiredForeign_3_map{})
+		}
+		mapValue := &_TestRequiredForeign_3_map{m: &x.MapMessage}
+		return protoreflect.ValueOfMap(mapValue)
+	case 4: // goproto.proto.test2.TestRequiredForeign.oneof_message
+		if descriptor != fd_TestRequiredForeign_oneof_message {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfMessage((*TestRequired)(nil).ProtoReflect())
+		} else if v, ok := x.OneofField.(*TestRequiredForeign_OneofMessage); ok {
+			return protoreflect.ValueOfMessage(v.OneofMessage.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*TestRequired)(nil).ProtoReflect())
+		}
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestRequiredForeign); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredForeign does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredForeign does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequiredForeign) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestRequiredForeign.optional_message
+		if fd != fd_TestRequiredForeign_optional_message {
+			break
+		}
+		x.OptionalMessage = value.Message().Interface().(*TestRequired)
+		return
+	case 2: // goproto.proto.test2.TestRequiredForeign.repeated_message
+		if fd != fd_TestRequiredForeign_repeated_message {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestRequiredForeign_2_list)
+		x.RepeatedMessage = *clv.list
+		return
+	case 3: // goproto.proto.test2.TestRequiredForeign.map_message
+		if fd != fd_TestRequiredForeign_map_message {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_TestRequiredForeign_3_map)
+		x.MapMessage = *cmv.m
+		return
+	case 4: // goproto.proto.test2.TestRequiredForeign.oneof_message
+		if fd != fd_TestRequiredForeign_oneof_message {
+			break
+		}
+		cv := value.Message().Interface().(*TestRequired)
+		x.OneofField = &TestRequiredForeign_OneofMessage{OneofMessage: cv}
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredForeign); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredForeign does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredForeign does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequiredForeign) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestRequiredForeign.optional_message
+		if fd != fd_TestRequiredForeign_optional_message {
+			break
+		}
+		if x.OptionalMessage == nil {
+			x.OptionalMessage = new(TestRequired)
+		}
+		return protoreflect.ValueOfMessage(x.OptionalMessage.ProtoReflect())
+	case 2: // goproto.proto.test2.TestRequiredForeign.repeated_message
+		if fd != fd_TestRequiredForeign_repeated_message {
+			break
+		}
+		if x.RepeatedMessage == nil {
+			x.RepeatedMessage = []*TestRequired{}
+		}
+		value := &_TestRequiredForeign_2_list{list: &x.RepeatedMessage}
+		return protoreflect.ValueOfList(value)
+	case 3: // goproto.proto.test2.TestRequiredForeign.map_message
+		if fd != fd_TestRequiredForeign_map_message {
+			break
+		}
+		if x.MapMessage == nil {
+			x.MapMessage = make(map[int32]*TestRequired)
+		}
+		value := &_TestRequiredForeign_3_map{m: &x.MapMessage}
+		return protoreflect.ValueOfMap(value)
+	case 4: // goproto.proto.test2.TestRequiredForeign.oneof_message
+		if fd != fd_TestRequiredForeign_oneof_message {
+			break
+		}
+		if x.OneofField == nil {
+			value := &TestRequired{}
+			oneofValue := &TestRequiredForeign_OneofMessage{OneofMessage: value}
+			x.OneofField = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.OneofField.(type) {
+		case *TestRequiredForeign_OneofMessage:
+			return protoreflect.ValueOfMessage(m.OneofMessage.ProtoReflect())
+		default:
+			value := &TestRequired{}
+			oneofValue := &TestRequiredForeign_OneofMessage{OneofMessage: value}
+			x.OneofField = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredForeign); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredForeign does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredForeign does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestRequiredForeign) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestRequiredForeign.optional_message
+		if fd != fd_TestRequiredForeign_optional_message {
+			break
+		}
+		m := new(TestRequired)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 2: // goproto.proto.test2.TestRequiredForeign.repeated_message
+		if fd != fd_TestRequiredForeign_repeated_message {
+			break
+		}
+		list := []*TestRequired{}
+		return protoreflect.ValueOfList(&_TestRequiredForeign_2_list{list: &list})
+	case 3: // goproto.proto.test2.TestRequiredForeign.map_message
+		if fd != fd_TestRequiredForeign_map_message {
+			break
+		}
+		m := make(map[int32]*TestRequired)
+		return protoreflect.ValueOfMap(&_TestRequiredForeign_3_map{m: &m})
+	case 4: // goproto.proto.test2.TestRequiredForeign.oneof_message
+		if fd != fd_TestRequiredForeign_oneof_message {
+			break
+		}
+		value := &TestRequired{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredForeign); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredForeign does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredForeign does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestRequiredForeign) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "goproto.proto.test2.TestRequiredForeign.oneof_field":
+		if x.OneofField == nil {
+			return nil
+		}
+		switch x.OneofField.(type) {
+		case *TestRequiredForeign_OneofMessage:
+			return x.Descriptor().Fields().ByName("oneof_message")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test2.TestRequiredForeign", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestRequiredForeign) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequiredForeign) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestRequiredForeign) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestRequiredForeign) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestRequiredForeign_methods
+}
+
+var _fastReflection_TestRequiredForeign_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestRequiredForeign_size,
+	Marshal:           _fastReflection_TestRequiredForeign_marshal,
+	Unmarshal:         _fastReflection_TestRequiredForeign_unmarshal,
+	Merge:             _fastReflection_TestRequiredForeign_merge,
+	CheckInitialized:  _fastReflection_TestRequiredForeign_checkInitialized,
+	Equal:             _fastReflection_TestRequiredForeign_equal,
+}
+
+func _fastReflection_TestRequiredForeign_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestRequiredForeign)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.OptionalMessage != nil {
+		l = options.Size(x.OptionalMessage)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.RepeatedMessage) > 0 {
+		for _, e := range x.RepeatedMessage {
+			l = options.Size(e)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if len(x.MapMessage) > 0 {
+		SiZeMaP := func(k int32, v *TestRequired) {
+			l := 0
+			if v != nil {
+				l = options.Size(v)
+			}
+			l += 1 + runtime.Sov(uint64(l))
+			mapEntrySize := 1 + runtime.Sov(uint64(k)) + l
+			n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]int32, 0, len(x.MapMessage))
+			for k := range x.MapMessage {
+				sortme = append(sortme, k)
+			}
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapMessage[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapMessage {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	switch x := x.OneofField.(type) {
+	case *TestRequiredForeign_OneofMessage:
+		if x == nil {
+			break
+		}
+		l = options.Size(x.OneofMessage)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestRequiredForeign_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestRequiredForeign)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	switch x := x.OneofField.(type) {
+	case *TestRequiredForeign_OneofMessage:
+		l = options.Size(x.OneofMessage)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.OneofMessage); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(x.MapMessage) > 0 {
+		MaRsHaLmAp := func(k int32, v *TestRequired) (protoiface.MarshalOutput, error) {
+			baseI := i
+			l = options.Size(v)
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], v); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x12
+			i = runtime.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForMapMessage := make([]int32, 0, len(x.MapMessage))
+			for k := range x.MapMessage {
+				keysForMapMessage = append(keysForMapMessage, int32(k))
+			}
+			sort.Slice(keysForMapMessage, func(i, j int) bool {
+				return keysForMapMessage[i] < keysForMapMessage[j]
+			})
+			for iNdEx := len(keysForMapMessage) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapMessage[int32(keysForMapMessage[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapMessage[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapMessage {
+				v := x.MapMessage[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.RepeatedMessage) > 0 {
+		for iNdEx := len(x.RepeatedMessage) - 1; iNdEx >= 0; iNdEx-- {
+			l = options.Size(x.RepeatedMessage[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.RepeatedMessage[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if x.OptionalMessage != nil {
+		l = options.Size(x.OptionalMessage)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.OptionalMessage); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0xa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestRequiredForeign_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestRequiredForeign)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestRequiredForeign.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestRequiredForeign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestRequiredForeign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.OptionalMessage == nil {
+				if err := budget.Message(fd_TestRequiredForeign_optional_message, int(unsafe.Sizeof(TestRequired{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.OptionalMessage = &TestRequired{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OptionalMessage); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestRequiredForeign_optional_message, -1)
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedMessage", wireType)
+			}
+			if err := budget.List(fd_TestRequiredForeign_repeated_message, len(x.RepeatedMessage), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Message(fd_TestRequiredForeign_repeated_message, int(unsafe.Sizeof(TestRequired{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.RepeatedMessage = append(x.RepeatedMessage, &TestRequired{})
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RepeatedMessage[len(x.RepeatedMessage)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestRequiredForeign_repeated_message, len(x.RepeatedMessage)-1)
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapMessage == nil {
+				x.MapMessage = make(map[int32]*TestRequired)
+			}
+			var mapkey int32
+			var mapvalue *TestRequired
+			var mapvalueErr error
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Message(fd_TestRequiredForeign_map_message, int(unsafe.Sizeof(TestRequired{}))); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapvalue = &TestRequired{}
+					if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+						mapvalueErr = err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if mapvalueErr != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarInMap(mapvalueErr, fd_TestRequiredForeign_map_message, mapkey)
+			}
+			if budget != nil {
+				if _, ok := x.MapMessage[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestRequiredForeign_map_message, len(x.MapMessage), 12); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapMessage[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if oneof, ok := x.OneofField.(*TestRequiredForeign_OneofMessage); ok && oneof.OneofMessage != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], oneof.OneofMessage); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestRequiredForeign_oneof_message, -1)
+				}
+			} else {
+				if err := budget.Message(fd_TestRequiredForeign_oneof_message, int(unsafe.Sizeof(TestRequired{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				v := &TestRequired{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestRequiredForeign_oneof_message, -1)
+				}
+				x.OneofField = &TestRequiredForeign_OneofMessage{v}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestRequiredForeign, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestRequiredForeign_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestRequiredForeign)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestRequiredForeign)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.OptionalMessage != nil {
+		if dst.OptionalMessage == nil {
+			dst.OptionalMessage = &TestRequired{}
+		}
+		proto.Merge(dst.OptionalMessage, src.OptionalMessage)
+	}
+	for _, v := range src.RepeatedMessage {
+		m := &TestRequired{}
+		proto.Merge(m, v)
+		dst.RepeatedMessage = append(dst.RepeatedMessage, m)
+	}
+	if len(src.MapMessage) != 0 {
+		if dst.MapMessage == nil {
+			dst.MapMessage = make(map[int32]*TestRequired, len(src.MapMessage))
+		}
+		for k, v := range src.MapMessage {
+			m := &TestRequired{}
+			proto.Merge(m, v)
+			dst.MapMessage[k] = m
+		}
+	}
+	switch v := src.OneofField.(type) {
+	case *TestRequiredForeign_OneofMessage:
+		if dv, ok := dst.OneofField.(*TestRequiredForeign_OneofMessage); ok && dv.OneofMessage != nil {
+			proto.Merge(dv.OneofMessage, v.OneofMessage)
+		} else {
+			m := &TestRequired{}
+			proto.Merge(m, v.OneofMessage)
+			dst.OneofField = &TestRequiredForeign_OneofMessage{OneofMessage: m}
+		}
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestRequiredForeign_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	x := input.Message.Interface().(*TestRequiredForeign)
+	if x == nil {
+		return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+	}
+	if x.OptionalMessage != nil {
+		if err := proto.CheckInitialized(x.OptionalMessage); err != nil {
+			return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, runtime.RequiredNotSetIn(err, "goproto.proto.test2.TestRequiredForeign", "optional_message")
+		}
+	}
+	for i, v := range x.RepeatedMessage {
+		if err := proto.CheckInitialized(v); err != nil {
+			return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, runtime.RequiredNotSetIn(err, "goproto.proto.test2.TestRequiredForeign", fmt.Sprintf("repeated_message[%d]", i))
+		}
+	}
+	for k, v := range x.MapMessage {
+		if err := proto.CheckInitialized(v); err != nil {
+			return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, runtime.RequiredNotSetIn(err, "goproto.proto.test2.TestRequiredForeign", fmt.Sprintf("map_message[%v]", k))
+		}
+	}
+	if v, ok := x.OneofField.(*TestRequiredForeign_OneofMessage); ok && v.OneofMessage != nil {
+		if err := proto.CheckInitialized(v.OneofMessage); err != nil {
+			return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, runtime.RequiredNotSetIn(err, "goproto.proto.test2.TestRequiredForeign", "oneof_message")
+		}
+	}
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestRequiredForeign_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestRequiredForeign)
+	y, yok := input.MessageB.Interface().(*TestRequiredForeign)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.OptionalMessage.Equal(y.OptionalMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.RepeatedMessage) != len(y.RepeatedMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedMessage {
+		if !v.Equal(y.RepeatedMessage[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapMessage) != len(y.MapMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapMessage {
+		if w, ok := y.MapMessage[k]; !ok || !v.Equal(w) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	switch v := x.OneofField.(type) {
+	case nil:
+		if y.OneofField != nil {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestRequiredForeign_OneofMessage:
+		if w, ok := y.OneofField.(*TestRequiredForeign_OneofMessage); !ok || !v.OneofMessage.Equal(w.OneofMessage) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestRequiredForeign) Clone() *TestRequiredForeign {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestRequiredForeign)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestRequiredForeign) CopyInto(dst *TestRequiredForeign) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.OptionalMessage = x.OptionalMessage.Clone()
+	if x.RepeatedMessage != nil {
+		dst.RepeatedMessage = make([]*TestRequired, len(x.RepeatedMessage))
+		for i, v := range x.RepeatedMessage {
+			dst.RepeatedMessage[i] = v.Clone()
+		}
+	} else {
+		dst.RepeatedMessage = nil
+	}
+	if x.MapMessage != nil {
+		dst.MapMessage = make(map[int32]*TestRequired, len(x.MapMessage))
+		for k, v := range x.MapMessage {
+			dst.MapMessage[k] = v.Clone()
+		}
+	} else {
+		dst.MapMessage = nil
+	}
+	switch v := x.OneofField.(type) {
+	case nil:
+		dst.OneofField = nil
+	case *TestRequiredForeign_OneofMessage:
+		dst.OneofField = &TestRequiredForeign_OneofMessage{OneofMessage: v.OneofMessage.Clone()}
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestRequiredForeign) Equal(y *TestRequiredForeign) bool {
+	return _fastReflection_TestRequiredForeign_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestRequiredForeign, or nil if b is canonical.
+func (*TestRequiredForeign) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestRequiredForeign.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Message("optional_message", false, (*TestRequired)(nil))
+		case 2:
+			w.Message("repeated_message", true, (*TestRequired)(nil))
+		case 3:
+			w.Map("map_message", protoreflect.Int32Kind, protoreflect.MessageKind, (*TestRequired)(nil))
+		case 4:
+			w.Oneof(0)
+			w.Message("oneof_message", false, (*TestRequired)(nil))
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var _ protoreflect.List = (*_TestRequiredGroupFields_3_list)(nil)
+
+type _TestRequiredGroupFields_3_list struct {
+	list *[]*TestRequiredGroupFields_RepeatedGroup
+}
+
+func (x *_TestRequiredGroupFields_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestRequiredGroupFields_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TestRequiredGroupFields_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TestRequiredGroupFields_RepeatedGroup)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestRequiredGroupFields_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TestRequiredGroupFields_RepeatedGroup)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestRequiredGroupFields_3_list) AppendMutable() protoreflect.Value {
+	v := new(TestRequiredGroupFields_RepeatedGroup)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestRequiredGroupFields_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestRequiredGroupFields_3_list) NewElement() protoreflect.Value {
+	v := new(TestRequiredGroupFields_RepeatedGroup)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestRequiredGroupFields_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TestRequiredGroupFields               protoreflect.MessageDescriptor
+	fd_TestRequiredGroupFields_optionalgroup protoreflect.FieldDescriptor
+	fd_TestRequiredGroupFields_repeatedgroup protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test2_test_proto_init()
+	md_TestRequiredGroupFields = File_internal_testprotos_test2_test_proto.Messages().ByName("TestRequiredGroupFields")
+	fd_TestRequiredGroupFields_optionalgroup = md_TestRequiredGroupFields.Fields().ByName("optionalgroup")
+	fd_TestRequiredGroupFields_repeatedgroup = md_TestRequiredGroupFields.Fields().ByName("repeatedgroup")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestRequiredGroupFields)(nil)
+
+type fastReflection_TestRequiredGroupFields TestRequiredGroupFields
+
+func (x *TestRequiredGroupFields) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestRequiredGroupFields)(x)
+}
+
+func (x *TestRequiredGroupFields) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test2_test_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestRequiredGroupFields_messageType fastReflection_TestRequiredGroupFields_messageType
+var _ protoreflect.MessageType = fastReflection_TestRequiredGroupFields_messageType{}
+
+type fastReflection_TestRequiredGroupFields_messageType struct{}
+
+func (x fastReflection_TestRequiredGroupFields_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestRequiredGroupFields)(nil)
+}
+func (x fastReflection_TestRequiredGroupFields_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestRequiredGroupFields)
+}
+func (x fastReflection_TestRequiredGroupFields_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestRequiredGroupFields
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestRequiredGroupFields) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestRequiredGroupFields
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestRequiredGroupFields) Type() protoreflect.MessageType {
+	return _fastReflection_TestRequiredGroupFields_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestRequiredGroupFields) New() protoreflect.Message {
+	return new(fastReflection_TestRequiredGroupFields)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestRequiredGroupFields) Interface() protoreflect.ProtoMessage {
+	return (*TestRequiredGroupFields)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestRequiredGroupFields) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Optionalgroup != nil {
+		value := protoreflect.ValueOfMessage(x.Optionalgroup.ProtoReflect())
+		if !f(fd_TestRequiredGroupFields_optionalgroup, value) {
+			return
+		}
+	}
+	if len(x.Repeatedgroup) != 0 {
+		value := protoreflect.ValueOfList(&_TestRequiredGroupFields_3_list{list: &x.Repeatedgroup})
+		if !f(fd_TestRequiredGroupFields_repeatedgroup, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestRequiredGroupFields) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestRequiredGroupFields.optionalgroup
+		if fd != fd_TestRequiredGroupFields_optionalgroup {
+			break
+		}
+		return x.Optionalgroup != nil
+	case 3: // goproto.proto.test2.TestRequiredGroupFields.repeatedgroup
+		if fd != fd_TestRequiredGroupFields_repeatedgroup {
+			break
+		}
+		return len(x.Repeatedgroup) != 0
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredGroupFields); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequiredGroupFields) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestRequiredGroupFields.optionalgroup
+		if fd != fd_TestRequiredGroupFields_optionalgroup {
+			break
+		}
+		x.Optionalgroup = nil
+		return
+	case 3: // goproto.proto.test2.TestRequiredGroupFields.repeatedgroup
+		if fd != fd_TestRequiredGroupFields_repeatedgroup {
+			break
+		}
+		x.Repeatedgroup = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredGroupFields); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestRequiredGroupFields) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test2.TestRequiredGroupFields.optionalgroup
+		if descriptor != fd_TestRequiredGroupFields_optionalgroup {
+			break
+		}
+		value := x.Optionalgroup
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 3: // goproto.proto.test2.TestRequiredGroupFields.repeatedgroup
+		if descriptor != fd_TestRequiredGroupFields_repeatedgroup {
+			break
+		}
+		if len(x.Repeatedgroup) == 0 {
+			return protoreflect.ValueOfList(&_TestRequiredGroupFields_3_list{})
+		}
+		listValue := &_TestRequiredGroupFields_3_list{list: &x.Repeatedgroup}
+		return protoreflect.ValueOfList(listValue)
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestRequiredGroupFields); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequiredGroupFields) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestRequiredGroupFields.optionalgroup
+		if fd != fd_TestRequiredGroupFields_optionalgroup {
+			break
+		}
+		x.Optionalgroup = value.Message().Interface().(*TestRequiredGroupFields_OptionalGroup)
+		return
+	case 3: // goproto.proto.test2.TestRequiredGroupFields.repeatedgroup
+		if fd != fd_TestRequiredGroupFields_repeatedgroup {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestRequiredGroupFields_3_list)
+		x.Repeatedgroup = *clv.list
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredGroupFields); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequiredGroupFields) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestRequiredGroupFields.optionalgroup
+		if fd != fd_TestRequiredGroupFields_optionalgroup {
+			break
+		}
+		if x.Optionalgroup == nil {
+			x.Optionalgroup = new(TestRequiredGroupFields_OptionalGroup)
+		}
+		return protoreflect.ValueOfMessage(x.Optionalgroup.ProtoReflect())
+	case 3: // goproto.proto.test2.TestRequiredGroupFields.repeatedgroup
+		if fd != fd_TestRequiredGroupFields_repeatedgroup {
+			break
+		}
+		if x.Repeatedgroup == nil {
+			x.Repeatedgroup = []*TestRequiredGroupFields_RepeatedGroup{}
+		}
+		value := &_TestRequiredGroupFields_3_list{list: &x.Repeatedgroup}
+		return protoreflect.ValueOfList(value)
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredGroupFields); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestRequiredGroupFields) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2.TestRequiredGroupFields.optionalgroup
+		if fd != fd_TestRequiredGroupFields_optionalgroup {
+			break
+		}
+		m := new(TestRequiredGroupFields_OptionalGroup)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 3: // goproto.proto.test2.TestRequiredGroupFields.repeatedgroup
+		if fd != fd_TestRequiredGroupFields_repeatedgroup {
+			break
+		}
+		list := []*TestRequiredGroupFields_RepeatedGroup{}
+		return protoreflect.ValueOfList(&_TestRequiredGroupFields_3_list{list: &list})
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredGroupFields); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestRequiredGroupFields) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test2.TestRequiredGroupFields", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestRequiredGroupFields) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequiredGroupFields) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestRequiredGroupFields) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestRequiredGroupFields) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestRequiredGroupFields_methods
+}
+
+var _fastReflection_TestRequiredGroupFields_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestRequiredGroupFields_size,
+	Marshal:           _fastReflection_TestRequiredGroupFields_marshal,
+	Unmarshal:         _fastReflection_TestRequiredGroupFields_unmarshal,
+	Merge:             _fastReflection_TestRequiredGroupFields_merge,
+	CheckInitialized:  _fastReflection_TestRequiredGroupFields_checkInitialized,
+	Equal:             _fastReflection_TestRequiredGroupFields_equal,
+}
+
+func _fastReflection_TestRequiredGroupFields_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestRequiredGroupFields)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.Optionalgroup != nil {
+		l = options.Size(x.Optionalgroup)
+		n += 2 + l
+	}
+	if len(x.Repeatedgroup) > 0 {
+		for _, e := range x.Repeatedgroup {
+			l = options.Size(e)
+			n += 2 + l
+		}
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestRequiredGroupFields_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestRequiredGroupFields)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if len(x.Repeatedgroup) > 0 {
+		for iNdEx := len(x.Repeatedgroup) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			dAtA[i] = 0x1c
+			l = options.Size(x.Repeatedgroup[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.Repeatedgroup[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i--
+			dAtA[i] = 0x1b
+		}
+	}
+	if x.Optionalgroup != nil {
+		i--
+		dAtA[i] = 0xc
+		l = options.Size(x.Optionalgroup)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Optionalgroup); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i--
+		dAtA[i] = 0xb
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestRequiredGroupFields_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestRequiredGroupFields)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestRequiredGroupFields.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestRequiredGroupFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestRequiredGroupFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 3 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Optionalgroup", wireType)
+			}
+			group, n := protowire.ConsumeGroup(protowire.Number(fieldNum), dAtA[iNdEx:])
+			if n < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, protowire.ParseError(n)
+			}
+			if x.Optionalgroup == nil {
+				if err := budget.Message(fd_TestRequiredGroupFields_optionalgroup, int(unsafe.Sizeof(TestRequiredGroupFields_OptionalGroup{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Optionalgroup = &TestRequiredGroupFields_OptionalGroup{}
+			}
+			if err := options.Unmarshal(group, x.Optionalgroup); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestRequiredGroupFields_optionalgroup, -1)
+			}
+			iNdEx += n
+		case 3:
+			if wireType != 3 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Repeatedgroup", wireType)
+			}
+			if err := budget.List(fd_TestRequiredGroupFields_repeatedgroup, len(x.Repeatedgroup), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			group, n := protowire.ConsumeGroup(protowire.Number(fieldNum), dAtA[iNdEx:])
+			if n < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, protowire.ParseError(n)
+			}
+			if err := budget.Message(fd_TestRequiredGroupFields_repeatedgroup, int(unsafe.Sizeof(TestRequiredGroupFields_RepeatedGroup{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.Repeatedgroup = append(x.Repeatedgroup, &TestRequiredGroupFields_RepeatedGroup{})
+			if err := options.Unmarshal(group, x.Repeatedgroup[len(x.Repeatedgroup)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestRequiredGroupFields_repeatedgroup, len(x.Repeatedgroup)-1)
+			}
+			iNdEx += n
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestRequiredGroupFields, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestRequiredGroupFields_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestRequiredGroupFields)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestRequiredGroupFields)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.Optionalgroup != nil {
+		if dst.Optionalgroup == nil {
+			dst.Optionalgroup = &TestRequiredGroupFields_OptionalGroup{}
+		}
+		proto.Merge(dst.Optionalgroup, src.Optionalgroup)
+	}
+	for _, v := range src.Repeatedgroup {
+		m := &TestRequiredGroupFields_RepeatedGroup{}
+		proto.Merge(m, v)
+		dst.Repeatedgroup = append(dst.Repeatedgroup, m)
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestRequiredGroupFields_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	x := input.Message.Interface().(*TestRequiredGroupFields)
+	if x == nil {
+		return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+	}
+	if x.Optionalgroup != nil {
+		if err := proto.CheckInitialized(x.Optionalgroup); err != nil {
+			return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, runtime.RequiredNotSetIn(err, "goproto.proto.test2.TestRequiredGroupFields", "optionalgroup")
+		}
+	}
+	for i, v := range x.Repeatedgroup {
+		if err := proto.CheckInitialized(v); err != nil {
+			return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, runtime.RequiredNotSetIn(err, "goproto.proto.test2.TestRequiredGroupFields", fmt.Sprintf("repeatedgroup[%d]", i))
+		}
+	}
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestRequiredGroupFields_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestRequiredGroupFields)
+	y, yok := input.MessageB.Interface().(*TestRequiredGroupFields)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.Optionalgroup.Equal(y.Optionalgroup) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.Repeatedgroup) != len(y.Repeatedgroup) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.Repeatedgroup {
+		if !v.Equal(y.Repeatedgroup[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestRequiredGroupFields) Clone() *TestRequiredGroupFields {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestRequiredGroupFields)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestRequiredGroupFields) CopyInto(dst *TestRequiredGroupFields) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.Optionalgroup = x.Optionalgroup.Clone()
+	if x.Repeatedgroup != nil {
+		dst.Repeatedgroup = make([]*TestRequiredGroupFields_RepeatedGroup, len(x.Repeatedgroup))
+		for i, v := range x.Repeatedgroup {
+			dst.Repeatedgroup[i] = v.Clone()
+		}
+	} else {
+		dst.Repeatedgroup = nil
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestRequiredGroupFields) Equal(y *TestRequiredGroupFields) bool {
+	return _fastReflection_TestRequiredGroupFields_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestRequiredGroupFields, or nil if b is canonical.
+func (*TestRequiredGroupFields) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestRequiredGroupFields.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Group("optionalgroup", false, (*TestRequiredGroupFields_OptionalGroup)(nil))
+		case 3:
+			w.Group("repeatedgroup", true, (*TestRequiredGroupFields_RepeatedGroup)(nil))
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_TestRequiredGroupFields_OptionalGroup   protoreflect.MessageDescriptor
+	fd_TestRequiredGroupFields_OptionalGroup_a protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test2_test_proto_init()
+	md_TestRequiredGroupFields_OptionalGroup = File_internal_testprotos_test2_test_proto.Messages().ByName("TestRequiredGroupFields").Messages().ByName("OptionalGroup")
+	fd_TestRequiredGroupFields_OptionalGroup_a = md_TestRequiredGroupFields_OptionalGroup.Fields().ByName("a")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestRequiredGroupFields_OptionalGroup)(nil)
+
+type fastReflection_TestRequiredGroupFields_OptionalGroup TestRequiredGroupFields_OptionalGroup
+
+func (x *TestRequiredGroupFields_OptionalGroup) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestRequiredGroupFields_OptionalGroup)(x)
+}
+
+func (x *TestRequiredGroupFields_OptionalGroup) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test2_test_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestRequiredGroupFields_OptionalGroup_messageType fastReflection_TestRequiredGroupFields_OptionalGroup_messageType
+var _ protoreflect.MessageType = fastReflection_TestRequiredGroupFields_OptionalGroup_messageType{}
+
+type fastReflection_TestRequiredGroupFields_OptionalGroup_messageType struct{}
+
+func (x fastReflection_TestRequiredGroupFields_OptionalGroup_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestRequiredGroupFields_OptionalGroup)(nil)
+}
+func (x fastReflection_TestRequiredGroupFields_OptionalGroup_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestRequiredGroupFields_OptionalGroup)
+}
+func (x fastReflection_TestRequiredGroupFields_OptionalGroup_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestRequiredGroupFields_OptionalGroup
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestRequiredGroupFields_OptionalGroup) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestRequiredGroupFields_OptionalGroup
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestRequiredGroupFields_OptionalGroup) Type() protoreflect.MessageType {
+	return _fastReflection_TestRequiredGroupFields_OptionalGroup_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestRequiredGroupFields_OptionalGroup) New() protoreflect.Message {
+	return new(fastReflection_TestRequiredGroupFields_OptionalGroup)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestRequiredGroupFields_OptionalGroup) Interface() protoreflect.ProtoMessage {
+	return (*TestRequiredGroupFields_OptionalGroup)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestRequiredGroupFields_OptionalGroup) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.A != nil {
+		value := protoreflect.ValueOfInt32(*x.A)
+		if !f(fd_TestRequiredGroupFields_OptionalGroup_a, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestRequiredGroupFields_OptionalGroup) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 2: // goproto.proto.test2.TestRequiredGroupFields.OptionalGroup.a
+		if fd != fd_TestRequiredGroupFields_OptionalGroup_a {
+			break
+		}
+		return x.A != nil
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredGroupFields_OptionalGroup); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.OptionalGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.OptionalGroup does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequiredGroupFields_OptionalGroup) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 2: // goproto.proto.test2.TestRequiredGroupFields.OptionalGroup.a
+		if fd != fd_TestRequiredGroupFields_OptionalGroup_a {
+			break
+		}
+		x.A = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredGroupFields_OptionalGroup); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.OptionalGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.OptionalGroup does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestRequiredGroupFields_OptionalGroup) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 2: // goproto.proto.test2.TestRequiredGroupFields.OptionalGroup.a
+		if descriptor != fd_TestRequiredGroupFields_OptionalGroup_a {
+			break
+		}
+		if x.A == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.A
+		return protoreflect.ValueOfInt32(value)
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestRequiredGroupFields_OptionalGroup); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.OptionalGroup does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.OptionalGroup does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequiredGroupFields_OptionalGroup) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 2: // goproto.proto.test2.TestRequiredGroupFields.OptionalGroup.a
+		if fd != fd_TestRequiredGroupFields_OptionalGroup_a {
+			break
+		}
+		cv := int32(value.Int())
+		x.A = &cv
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredGroupFields_OptionalGroup); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.OptionalGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.OptionalGroup does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequiredGroupFields_OptionalGroup) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 2: // goproto.proto.test2.TestRequiredGroupFields.OptionalGroup.a
+		if fd != fd_TestRequiredGroupFields_OptionalGroup_a {
+			break
+		}
+		panic(fmt.Errorf("field a of message goproto.proto.test2.TestRequiredGroupFields.OptionalGroup is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredGroupFields_OptionalGroup); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.OptionalGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.OptionalGroup does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestRequiredGroupFields_OptionalGroup) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 2: // goproto.proto.test2.TestRequiredGroupFields.OptionalGroup.a
+		if fd != fd_TestRequiredGroupFields_OptionalGroup_a {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredGroupFields_OptionalGroup); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.OptionalGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.OptionalGroup does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestRequiredGroupFields_OptionalGroup) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test2.TestRequiredGroupFields.OptionalGroup", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestRequiredGroupFields_OptionalGroup) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequiredGroupFields_OptionalGroup) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestRequiredGroupFields_OptionalGroup) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestRequiredGroupFields_OptionalGroup) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestRequiredGroupFields_OptionalGroup_methods
+}
+
+var _fastReflection_TestRequiredGroupFields_OptionalGroup_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestRequiredGroupFields_OptionalGroup_size,
+	Marshal:           _fastReflection_TestRequiredGroupFields_OptionalGroup_marshal,
+	Unmarshal:         _fastReflection_TestRequiredGroupFields_OptionalGroup_unmarshal,
+	Merge:             _fastReflection_TestRequiredGroupFields_OptionalGroup_merge,
+	CheckInitialized:  _fastReflection_TestRequiredGroupFields_OptionalGroup_checkInitialized,
+	Equal:             _fastReflection_TestRequiredGroupFields_OptionalGroup_equal,
+}
+
+func _fastReflection_TestRequiredGroupFields_OptionalGroup_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestRequiredGroupFields_OptionalGroup)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.A != nil {
+		n += 1 + runtime.Sov(uint64(*x.A))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestRequiredGroupFields_OptionalGroup_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestRequiredGroupFields_OptionalGroup)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.A != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.A))
+		i--
+		dAtA[i] = 0x10
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestRequiredGroupFields_OptionalGroup_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestRequiredGroupFields_OptionalGroup)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestRequiredGroupFields_OptionalGroup.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestRequiredGroupFields_OptionalGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestRequiredGroupFields_OptionalGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.A = &v
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestRequiredGroupFields_OptionalGroup, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	var flags protoiface.UnmarshalOutputFlags
+	if hasFields[0]&0x1 == 0x1 {
+		flags |= protoiface.UnmarshalInitialized
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: flags}, nil
+}
+
+func _fastReflection_TestRequiredGroupFields_OptionalGroup_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestRequiredGroupFields_OptionalGroup)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestRequiredGroupFields_OptionalGroup)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.A != nil {
+		v := *src.A
+		dst.A = &v
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestRequiredGroupFields_OptionalGroup_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	x := input.Message.Interface().(*TestRequiredGroupFields_OptionalGroup)
+	if x == nil {
+		return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+	}
+	if x.A == nil {
+		return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, runtime.ErrRequiredNotSet("goproto.proto.test2.TestRequiredGroupFields.OptionalGroup", "a")
+	}
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestRequiredGroupFields_OptionalGroup_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestRequiredGroupFields_OptionalGroup)
+	y, yok := input.MessageB.Interface().(*TestRequiredGroupFields_OptionalGroup)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.A == nil) != (y.A == nil) || x.A != nil && *x.A != *y.A {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestRequiredGroupFields_OptionalGroup) Clone() *TestRequiredGroupFields_OptionalGroup {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestRequiredGroupFields_OptionalGroup)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestRequiredGroupFields_OptionalGroup) CopyInto(dst *TestRequiredGroupFields_OptionalGroup) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	if x.A != nil {
+		v := *x.A
+		dst.A = &v
+	} else {
+		dst.A = nil
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestRequiredGroupFields_OptionalGroup) Equal(y *TestRequiredGroupFields_OptionalGroup) bool {
+	return _fastReflection_TestRequiredGroupFields_OptionalGroup_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestRequiredGroupFields_OptionalGroup, or nil if b is canonical.
+func (*TestRequiredGroupFields_OptionalGroup) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestRequiredGroupFields_OptionalGroup.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 2:
+			w.Scalar("a", protoreflect.Int32Kind, false)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_TestRequiredGroupFields_RepeatedGroup   protoreflect.MessageDescriptor
+	fd_TestRequiredGroupFields_RepeatedGroup_a protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test2_test_proto_init()
+	md_TestRequiredGroupFields_RepeatedGroup = File_internal_testprotos_test2_test_proto.Messages().ByName("TestRequiredGroupFields").Messages().ByName("RepeatedGroup")
+	fd_TestRequiredGroupFields_RepeatedGroup_a = md_TestRequiredGroupFields_RepeatedGroup.Fields().ByName("a")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestRequiredGroupFields_RepeatedGroup)(nil)
+
+type fastReflection_TestRequiredGroupFields_RepeatedGroup TestRequiredGroupFields_RepeatedGroup
+
+func (x *TestRequiredGroupFields_RepeatedGroup) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestRequiredGroupFields_RepeatedGroup)(x)
+}
+
+func (x *TestRequiredGroupFields_RepeatedGroup) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test2_test_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestRequiredGroupFields_RepeatedGroup_messageType fastReflection_TestRequiredGroupFields_RepeatedGroup_messageType
+var _ protoreflect.MessageType = fastReflection_TestRequiredGroupFields_RepeatedGroup_messageType{}
+
+type fastReflection_TestRequiredGroupFields_RepeatedGroup_messageType struct{}
+
+func (x fastReflection_TestRequiredGroupFields_RepeatedGroup_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestRequiredGroupFields_RepeatedGroup)(nil)
+}
+func (x fastReflection_TestRequiredGroupFields_RepeatedGroup_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestRequiredGroupFields_RepeatedGroup)
+}
+func (x fastReflection_TestRequiredGroupFields_RepeatedGroup_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestRequiredGroupFields_RepeatedGroup
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestRequiredGroupFields_RepeatedGroup) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestRequiredGroupFields_RepeatedGroup
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestRequiredGroupFields_RepeatedGroup) Type() protoreflect.MessageType {
+	return _fastReflection_TestRequiredGroupFields_RepeatedGroup_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestRequiredGroupFields_RepeatedGroup) New() protoreflect.Message {
+	return new(fastReflection_TestRequiredGroupFields_RepeatedGroup)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestRequiredGroupFields_RepeatedGroup) Interface() protoreflect.ProtoMessage {
+	return (*TestRequiredGroupFields_RepeatedGroup)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestRequiredGroupFields_RepeatedGroup) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.A != nil {
+		value := protoreflect.ValueOfInt32(*x.A)
+		if !f(fd_TestRequiredGroupFields_RepeatedGroup_a, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestRequiredGroupFields_RepeatedGroup) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 4: // goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup.a
+		if fd != fd_TestRequiredGroupFields_RepeatedGroup_a {
+			break
+		}
+		return x.A != nil
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredGroupFields_RepeatedGroup); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequiredGroupFields_RepeatedGroup) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 4: // goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup.a
+		if fd != fd_TestRequiredGroupFields_RepeatedGroup_a {
+			break
+		}
+		x.A = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredGroupFields_RepeatedGroup); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestRequiredGroupFields_RepeatedGroup) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 4: // goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup.a
+		if descriptor != fd_TestRequiredGroupFields_RepeatedGroup_a {
+			break
+		}
+		if x.A == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.A
+		return protoreflect.ValueOfInt32(value)
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestRequiredGroupFields_RepeatedGroup); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequiredGroupFields_RepeatedGroup) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 4: // goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup.a
+		if fd != fd_TestRequiredGroupFields_RepeatedGroup_a {
+			break
+		}
+		cv := int32(value.Int())
+		x.A = &cv
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredGroupFields_RepeatedGroup); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequiredGroupFields_RepeatedGroup) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 4: // goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup.a
+		if fd != fd_TestRequiredGroupFields_RepeatedGroup_a {
+			break
+		}
+		panic(fmt.Errorf("field a of message goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredGroupFields_RepeatedGroup); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestRequiredGroupFields_RepeatedGroup) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 4: // goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup.a
+		if fd != fd_TestRequiredGroupFields_RepeatedGroup_a {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequiredGroupFields_RepeatedGroup); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestRequiredGroupFields_RepeatedGroup) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestRequiredGroupFields_RepeatedGroup) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequiredGroupFields_RepeatedGroup) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestRequiredGroupFields_RepeatedGroup) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestRequiredGroupFields_RepeatedGroup) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestRequiredGroupFields_RepeatedGroup_methods
+}
+
+var _fastReflection_TestRequiredGroupFields_RepeatedGroup_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestRequiredGroupFields_RepeatedGroup_size,
+	Marshal:           _fastReflection_TestRequiredGroupFields_RepeatedGroup_marshal,
+	Unmarshal:         _fastReflection_TestRequiredGroupFields_RepeatedGroup_unmarshal,
+	Merge:             _fastReflection_TestRequiredGroupFields_RepeatedGroup_merge,
+	CheckInitialized:  _fastReflection_TestRequiredGroupFields_RepeatedGroup_checkInitialized,
+	Equal:             _fastReflection_TestRequiredGroupFields_RepeatedGroup_equal,
+}
+
+func _fastReflection_TestRequiredGroupFields_RepeatedGroup_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestRequiredGroupFields_RepeatedGroup)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.A != nil {
+		n += 1 + runtime.Sov(uint64(*x.A))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestRequiredGroupFields_RepeatedGroup_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestRequiredGroupFields_RepeatedGroup)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.A != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.A))
+		i--
+		dAtA[i] = 0x20
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestRequiredGroupFields_RepeatedGroup_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestRequiredGroupFields_RepeatedGroup)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestRequiredGroupFields_RepeatedGroup.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestRequiredGroupFields_RepeatedGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestRequiredGroupFields_RepeatedGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.A = &v
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestRequiredGroupFields_RepeatedGroup, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	var flags protoiface.UnmarshalOutputFlags
+	if hasFields[0]&0x1 == 0x1 {
+		flags |= protoiface.UnmarshalInitialized
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: flags}, nil
+}
+
+func _fastReflection_TestRequiredGroupFields_RepeatedGroup_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestRequiredGroupFields_RepeatedGroup)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestRequiredGroupFields_RepeatedGroup)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.A != nil {
+		v := *src.A
+		dst.A = &v
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestRequiredGroupFields_RepeatedGroup_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	x := input.Message.Interface().(*TestRequiredGroupFields_RepeatedGroup)
+	if x == nil {
+		return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+	}
+	if x.A == nil {
+		return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, runtime.ErrRequiredNotSet("goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup", "a")
+	}
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestRequiredGroupFields_RepeatedGroup_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestRequiredGroupFields_RepeatedGroup)
+	y, yok := input.MessageB.Interface().(*TestRequiredGroupFields_RepeatedGroup)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.A == nil) != (y.A == nil) || x.A != nil && *x.A != *y.A {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestRequiredGroupFields_RepeatedGroup) Clone() *TestRequiredGroupFields_RepeatedGroup {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestRequiredGroupFields_RepeatedGroup)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestRequiredGroupFields_RepeatedGroup) CopyInto(dst *TestRequiredGroupFields_RepeatedGroup) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	if x.A != nil {
+		v := *x.A
+		dst.A = &v
+	} else {
+		dst.A = nil
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestRequiredGroupFields_RepeatedGroup) Equal(y *TestRequiredGroupFields_RepeatedGroup) bool {
+	return _fastReflection_TestRequiredGroupFields_RepeatedGroup_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestRequiredGroupFields_RepeatedGroup, or nil if b is canonical.
+func (*TestRequiredGroupFields_RepeatedGroup) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestRequiredGroupFields_RepeatedGroup.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 4:
+			w.Scalar("a", protoreflect.Int32Kind, false)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestAllTypes) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestAllTypes) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.OptionalInt32 != nil {
+		v := *x.OptionalInt32
+		b = append(b, "\"optionalInt32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalInt64 != nil {
+		v := *x.OptionalInt64
+		b = append(b, "\"optionalInt64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalUint32 != nil {
+		v := *x.OptionalUint32
+		b = append(b, "\"optionalUint32\":"...)
+		b = strconv.AppendUint(b, uint64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalUint64 != nil {
+		v := *x.OptionalUint64
+		b = append(b, "\"optionalUint64\":"...)
+		b = runtime.AppendJSONUint64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalSint32 != nil {
+		v := *x.OptionalSint32
+		b = append(b, "\"optionalSint32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalSint64 != nil {
+		v := *x.OptionalSint64
+		b = append(b, "\"optionalSint64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalFixed32 != nil {
+		v := *x.OptionalFixed32
+		b = append(b, "\"optionalFixed32\":"...)
+		b = strconv.AppendUint(b, uint64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalFixed64 != nil {
+		v := *x.OptionalFixed64
+		b = append(b, "\"optionalFixed64\":"...)
+		b = runtime.AppendJSONUint64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalSfixed32 != nil {
+		v := *x.OptionalSfixed32
+		b = append(b, "\"optionalSfixed32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalSfixed64 != nil {
+		v := *x.OptionalSfixed64
+		b = append(b, "\"optionalSfixed64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalFloat != nil {
+		v := *x.OptionalFloat
+		b = append(b, "\"optionalFloat\":"...)
+		b = runtime.AppendJSONFloat(b, float64(v), 32)
+		b = append(b, ',')
+	}
+	if x.OptionalDouble != nil {
+		v := *x.OptionalDouble
+		b = append(b, "\"optionalDouble\":"...)
+		b = runtime.AppendJSONFloat(b, v, 64)
+		b = append(b, ',')
+	}
+	if x.OptionalBool != nil {
+		v := *x.OptionalBool
+		b = append(b, "\"optionalBool\":"...)
+		b = strconv.AppendBool(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalString != nil {
+		v := *x.OptionalString
+		b = append(b, "\"optionalString\":"...)
+		if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test2.TestAllTypes.optional_string"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalBytes != nil {
+		b = append(b, "\"optionalBytes\":"...)
+		b = runtime.AppendJSONBytes(b, x.OptionalBytes)
+		b = append(b, ',')
+	}
+	if x.Optionalgroup != nil {
+		b = append(b, "\"optionalgroup\":"...)
+		if b, err = x.Optionalgroup.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalNestedMessage != nil {
+		b = append(b, "\"optionalNestedMessage\":"...)
+		if b, err = x.OptionalNestedMessage.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalForeignMessage != nil {
+		b = append(b, "\"optionalForeignMessage\":"...)
+		if b, err = x.OptionalForeignMessage.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalNestedEnum != nil {
+		v := *x.OptionalNestedEnum
+		b = append(b, "\"optionalNestedEnum\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+		b = append(b, ',')
+	}
+	if x.OptionalForeignEnum != nil {
+		v := *x.OptionalForeignEnum
+		b = append(b, "\"optionalForeignEnum\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+		b = append(b, ',')
+	}
+	if len(x.RepeatedInt32) > 0 {
+		b = append(b, "\"repeatedInt32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedInt32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedInt64) > 0 {
+		b = append(b, "\"repeatedInt64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedInt64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedUint32) > 0 {
+		b = append(b, "\"repeatedUint32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedUint32 {
+			b = strconv.AppendUint(b, uint64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedUint64) > 0 {
+		b = append(b, "\"repeatedUint64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedUint64 {
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSint32) > 0 {
+		b = append(b, "\"repeatedSint32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSint32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSint64) > 0 {
+		b = append(b, "\"repeatedSint64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSint64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFixed32) > 0 {
+		b = append(b, "\"repeatedFixed32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFixed32 {
+			b = strconv.AppendUint(b, uint64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFixed64) > 0 {
+		b = append(b, "\"repeatedFixed64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFixed64 {
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSfixed32) > 0 {
+		b = append(b, "\"repeatedSfixed32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSfixed32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSfixed64) > 0 {
+		b = append(b, "\"repeatedSfixed64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSfixed64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFloat) > 0 {
+		b = append(b, "\"repeatedFloat\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFloat {
+			b = runtime.AppendJSONFloat(b, float64(v), 32)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedDouble) > 0 {
+		b = append(b, "\"repeatedDouble\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedDouble {
+			b = runtime.AppendJSONFloat(b, v, 64)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedBool) > 0 {
+		b = append(b, "\"repeatedBool\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedBool {
+			b = strconv.AppendBool(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedString) > 0 {
+		b = append(b, "\"repeatedString\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedString {
+			if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test2.TestAllTypes.repeated_string"); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedBytes) > 0 {
+		b = append(b, "\"repeatedBytes\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedBytes {
+			b = runtime.AppendJSONBytes(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.Repeatedgroup) > 0 {
+		b = append(b, "\"repeatedgroup\":"...)
+		b = append(b, '[')
+		for _, v := range x.Repeatedgroup {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedNestedMessage) > 0 {
+		b = append(b, "\"repeatedNestedMessage\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedNestedMessage {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedForeignMessage) > 0 {
+		b = append(b, "\"repeatedForeignMessage\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedForeignMessage {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedNestedEnum) > 0 {
+		b = append(b, "\"repeatedNestedEnum\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedNestedEnum {
+			b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedForeignEnum) > 0 {
+		b = append(b, "\"repeatedForeignEnum\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedForeignEnum {
+			b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.PackedInt32) > 0 {
+		b = append(b, "\"packedInt32\":"...)
+		b = append(b, '[')
+		for _, v := range x.PackedInt32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.PackedSint64) > 0 {
+		b = append(b, "\"packedSint64\":"...)
+		b = append(b, '[')
+		for _, v := range x.PackedSint64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.PackedDouble) > 0 {
+		b = append(b, "\"packedDouble\":"...)
+		b = append(b, '[')
+		for _, v := range x.PackedDouble {
+			b = runtime.AppendJSONFloat(b, v, 64)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.PackedBool) > 0 {
+		b = append(b, "\"packedBool\":"...)
+		b = append(b, '[')
+		for _, v := range x.PackedBool {
+			b = strconv.AppendBool(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.MapInt32Int32) > 0 {
+		b = append(b, "\"mapInt32Int32\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapInt32Int32)) {
+			v := x.MapInt32Int32[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapSint64Sint64) > 0 {
+		b = append(b, "\"mapSint64Sint64\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapSint64Sint64)) {
+			v := x.MapSint64Sint64[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringString) > 0 {
+		b = append(b, "\"mapStringString\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringString)) {
+			v := x.MapStringString[k]
+			if b, err = runtime.AppendJSONString(b, k, "goproto.proto.test2.TestAllTypes.MapStringStringEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test2.TestAllTypes.MapStringStringEntry.value"); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringBytes) > 0 {
+		b = append(b, "\"mapStringBytes\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringBytes)) {
+			v := x.MapStringBytes[k]
+			if b, err = runtime.AppendJSONString(b, k, "goproto.proto.test2.TestAllTypes.MapStringBytesEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			b = runtime.AppendJSONBytes(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringNestedMessage) > 0 {
+		b = append(b, "\"mapStringNestedMessage\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringNestedMessage)) {
+			v := x.MapStringNestedMessage[k]
+			if b, err = runtime.AppendJSONString(b, k, "goproto.proto.test2.TestAllTypes.MapStringNestedMessageEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringNestedEnum) > 0 {
+		b = append(b, "\"mapStringNestedEnum\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringNestedEnum)) {
+			v := x.MapStringNestedEnum[k]
+			if b, err = runtime.AppendJSONString(b, k, "goproto.proto.test2.TestAllTypes.MapStringNestedEnumEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if x.DefaultInt32 != nil {
+		v := *x.DefaultInt32
+		b = append(b, "\"defaultInt32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.DefaultInt64 != nil {
+		v := *x.DefaultInt64
+		b = append(b, "\"defaultInt64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.DefaultUint32 != nil {
+		v := *x.DefaultUint32
+		b = append(b, "\"defaultUint32\":"...)
+		b = strconv.AppendUint(b, uint64(v), 10)
+		b = append(b, ',')
+	}
+	if x.DefaultUint64 != nil {
+		v := *x.DefaultUint64
+		b = append(b, "\"defaultUint64\":"...)
+		b = runtime.AppendJSONUint64(b, v)
+		b = append(b, ',')
+	}
+	if x.DefaultSint32 != nil {
+		v := *x.DefaultSint32
+		b = append(b, "\"defaultSint32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.DefaultSint64 != nil {
+		v := *x.DefaultSint64
+		b = append(b, "\"defaultSint64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.DefaultFixed32 != nil {
+		v := *x.DefaultFixed32
+		b = append(b, "\"defaultFixed32\":"...)
+		b = strconv.AppendUint(b, uint64(v), 10)
+		b = append(b, ',')
+	}
+	if x.DefaultFixed64 != nil {
+		v := *x.DefaultFixed64
+		b = append(b, "\"defaultFixed64\":"...)
+		b = runtime.AppendJSONUint64(b, v)
+		b = append(b, ',')
+	}
+	if x.DefaultSfixed32 != nil {
+		v := *x.DefaultSfixed32
+		b = append(b, "\"defaultSfixed32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.DefaultSfixed64 != nil {
+		v := *x.DefaultSfixed64
+		b = append(b, "\"defaultSfixed64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.DefaultFloat != nil {
+		v := *x.DefaultFloat
+		b = append(b, "\"defaultFloat\":"...)
+		b = runtime.AppendJSONFloat(b, float64(v), 32)
+		b = append(b, ',')
+	}
+	if x.DefaultDouble != nil {
+		v := *x.DefaultDouble
+		b = append(b, "\"defaultDouble\":"...)
+		b = runtime.AppendJSONFloat(b, v, 64)
+		b = append(b, ',')
+	}
+	if x.DefaultBool != nil {
+		v := *x.DefaultBool
+		b = append(b, "\"defaultBool\":"...)
+		b = strconv.AppendBool(b, v)
+		b = append(b, ',')
+	}
+	if x.DefaultString != nil {
+		v := *x.DefaultString
+		b = append(b, "\"defaultString\":"...)
+		if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test2.TestAllTypes.default_string"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.DefaultBytes != nil {
+		b = append(b, "\"defaultBytes\":"...)
+		b = runtime.AppendJSONBytes(b, x.DefaultBytes)
+		b = append(b, ',')
+	}
+	if x.DefaultNestedEnum != nil {
+		v := *x.DefaultNestedEnum
+		b = append(b, "\"defaultNestedEnum\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+		b = append(b, ',')
+	}
+	if x.DefaultForeignEnum != nil {
+		v := *x.DefaultForeignEnum
+		b = append(b, "\"defaultForeignEnum\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofUint32); ok {
+		b = append(b, "\"oneofUint32\":"...)
+		b = strconv.AppendUint(b, uint64(v.OneofUint32), 10)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); ok {
+		b = append(b, "\"oneofNestedMessage\":"...)
+		if b, err = v.OneofNestedMessage.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofString); ok {
+		b = append(b, "\"oneofString\":"...)
+		if b, err = runtime.AppendJSONString(b, v.OneofString, "goproto.proto.test2.TestAllTypes.oneof_string"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofBytes); ok {
+		b = append(b, "\"oneofBytes\":"...)
+		b = runtime.AppendJSONBytes(b, v.OneofBytes)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofBool); ok {
+		b = append(b, "\"oneofBool\":"...)
+		b = strconv.AppendBool(b, v.OneofBool)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofUint64); ok {
+		b = append(b, "\"oneofUint64\":"...)
+		b = runtime.AppendJSONUint64(b, v.OneofUint64)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofFloat); ok {
+		b = append(b, "\"oneofFloat\":"...)
+		b = runtime.AppendJSONFloat(b, float64(v.OneofFloat), 32)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofDouble); ok {
+		b = append(b, "\"oneofDouble\":"...)
+		b = runtime.AppendJSONFloat(b, v.OneofDouble, 64)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofEnum); ok {
+		b = append(b, "\"oneofEnum\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v.OneofEnum), v.OneofEnum.Descriptor())
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_Oneofgroup); ok {
+		b = append(b, "\"oneofgroup\":"...)
+		if b, err = v.Oneofgroup.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofOptional.(*TestAllTypes_OneofOptionalUint32); ok {
+		b = append(b, "\"oneofOptionalUint32\":"...)
+		b = strconv.AppendUint(b, uint64(v.OneofOptionalUint32), 10)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofDefaults.(*TestAllTypes_OneofDefaultSint32); ok {
+		b = append(b, "\"oneofDefaultSint32\":"...)
+		b = strconv.AppendInt(b, int64(v.OneofDefaultSint32), 10)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofDefaults.(*TestAllTypes_OneofDefaultString); ok {
+		b = append(b, "\"oneofDefaultString\":"...)
+		if b, err = runtime.AppendJSONString(b, v.OneofDefaultString, "goproto.proto.test2.TestAllTypes.oneof_default_string"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestAllTypes) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestAllTypes) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalInt32 = &v
+		case 2:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalInt64 = &v
+		case 3:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalUint32 = &v
+		case 4:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalUint64 = &v
+		case 5:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalSint32 = &v
+		case 6:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalSint64 = &v
+		case 7:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalFixed32 = &v
+		case 8:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalFixed64 = &v
+		case 9:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalSfixed32 = &v
+		case 10:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalSfixed64 = &v
+		case 11:
+			v, err := d.Float32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalFloat = &v
+		case 12:
+			v, err := d.Float64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalDouble = &v
+		case 13:
+			v, err := d.Bool(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalBool = &v
+		case 14:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalString = &v
+		case 15:
+			v, err := d.Bytes(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalBytes = v
+		case 16:
+			v := new(TestAllTypes_OptionalGroup)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Optionalgroup = v
+		case 18:
+			v := new(TestAllTypes_NestedMessage)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.OptionalNestedMessage = v
+		case 19:
+			v := new(ForeignMessage)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.OptionalForeignMessage = v
+		case 21:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := TestAllTypes_NestedEnum(v)
+				x.OptionalNestedEnum = &e
+			}
+		case 22:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := ForeignEnum(v)
+				x.OptionalForeignEnum = &e
+			}
+		case 31:
+			return d.List(func() error {
+				v, err := d.Int32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedInt32 = append(x.RepeatedInt32, v)
+				return nil
+			})
+		case 32:
+			return d.List(func() error {
+				v, err := d.Int64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedInt64 = append(x.RepeatedInt64, v)
+				return nil
+			})
+		case 33:
+			return d.List(func() error {
+				v, err := d.Uint32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedUint32 = append(x.RepeatedUint32, v)
+				return nil
+			})
+		case 34:
+			return d.List(func() error {
+				v, err := d.Uint64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedUint64 = append(x.RepeatedUint64, v)
+				return nil
+			})
+		case 35:
+			return d.List(func() error {
+				v, err := d.Int32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedSint32 = append(x.RepeatedSint32, v)
+				return nil
+			})
+		case 36:
+			return d.List(func() error {
+				v, err := d.Int64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedSint64 = append(x.RepeatedSint64, v)
+				return nil
+			})
+		case 37:
+			return d.List(func() error {
+				v, err := d.Uint32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedFixed32 = append(x.RepeatedFixed32, v)
+				return nil
+			})
+		case 38:
+			return d.List(func() error {
+				v, err := d.Uint64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedFixed64 = append(x.RepeatedFixed64, v)
+				return nil
+			})
+		case 39:
+			return d.List(func() error {
+				v, err := d.Int32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedSfixed32 = append(x.RepeatedSfixed32, v)
+				return nil
+			})
+		case 40:
+			return d.List(func() error {
+				v, err := d.Int64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedSfixed64 = append(x.RepeatedSfixed64, v)
+				return nil
+			})
+		case 41:
+			return d.List(func() error {
+				v, err := d.Float32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedFloat = append(x.RepeatedFloat, v)
+				return nil
+			})
+		case 42:
+			return d.List(func() error {
+				v, err := d.Float64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedDouble = append(x.RepeatedDouble, v)
+				return nil
+			})
+		case 43:
+			return d.List(func() error {
+				v, err := d.Bool(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedBool = append(x.RepeatedBool, v)
+				return nil
+			})
+		case 44:
+			return d.List(func() error {
+				v, err := d.String(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedString = append(x.RepeatedString, v)
+				return nil
+			})
+		case 45:
+			return d.List(func() error {
+				v, err := d.Bytes(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedBytes = append(x.RepeatedBytes, v)
+				return nil
+			})
+		case 46:
+			return d.List(func() error {
+				v := new(TestAllTypes_RepeatedGroup)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.Repeatedgroup = append(x.Repeatedgroup, v)
+				return nil
+			})
+		case 48:
+			return d.List(func() error {
+				v := new(TestAllTypes_NestedMessage)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.RepeatedNestedMessage = append(x.RepeatedNestedMessage, v)
+				return nil
+			})
+		case 49:
+			return d.List(func() error {
+				v := new(ForeignMessage)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.RepeatedForeignMessage = append(x.RepeatedForeignMessage, v)
+				return nil
+			})
+		case 51:
+			return d.List(func() error {
+				v, ok, err := d.Enum(fd)
+				if err != nil {
+					return err
+				}
+				if ok {
+					e := TestAllTypes_NestedEnum(v)
+					x.RepeatedNestedEnum = append(x.RepeatedNestedEnum, e)
+				}
+				return nil
+			})
+		case 52:
+			return d.List(func() error {
+				v, ok, err := d.Enum(fd)
+				if err != nil {
+					return err
+				}
+				if ok {
+					e := ForeignEnum(v)
+					x.RepeatedForeignEnum = append(x.RepeatedForeignEnum, e)
+				}
+				return nil
+			})
+		case 53:
+			return d.List(func() error {
+				v, err := d.Int32(fd)
+				if err != nil {
+					return err
+				}
+				x.PackedInt32 = append(x.PackedInt32, v)
+				return nil
+			})
+		case 54:
+			return d.List(func() error {
+				v, err := d.Int64(fd)
+				if err != nil {
+					return err
+				}
+				x.PackedSint64 = append(x.PackedSint64, v)
+				return nil
+			})
+		case 55:
+			return d.List(func() error {
+				v, err := d.Float64(fd)
+				if err != nil {
+					return err
+				}
+				x.PackedDouble = append(x.PackedDouble, v)
+				return nil
+			})
+		case 57:
+			return d.List(func() error {
+				v, err := d.Bool(fd)
+				if err != nil {
+					return err
+				}
+				x.PackedBool = append(x.PackedBool, v)
+				return nil
+			})
+		case 56:
+			if x.MapInt32Int32 == nil {
+				x.MapInt32Int32 = make(map[int32]int32)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := int32(k.Int())
+				if _, ok := x.MapInt32Int32[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.Int32(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.MapInt32Int32[key] = v
+				return nil
+			})
+		case 61:
+			if x.MapSint64Sint64 == nil {
+				x.MapSint64Sint64 = make(map[int64]int64)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.Int()
+				if _, ok := x.MapSint64Sint64[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.Int64(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.MapSint64Sint64[key] = v
+				return nil
+			})
+		case 69:
+			if x.MapStringString == nil {
+				x.MapStringString = make(map[string]string)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.MapStringString[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.String(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.MapStringString[key] = v
+				return nil
+			})
+		case 70:
+			if x.MapStringBytes == nil {
+				x.MapStringBytes = make(map[string][]byte)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.MapStringBytes[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.Bytes(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.MapStringBytes[key] = v
+				return nil
+			})
+		case 71:
+			if x.MapStringNestedMessage == nil {
+				x.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.MapStringNestedMessage[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v := new(TestAllTypes_NestedMessage)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.MapStringNestedMessage[key] = v
+				return nil
+			})
+		case 73:
+			if x.MapStringNestedEnum == nil {
+				x.MapStringNestedEnum = make(map[string]TestAllTypes_NestedEnum)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.MapStringNestedEnum[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, ok, err := d.Enum(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				if ok {
+					e := TestAllTypes_NestedEnum(v)
+					x.MapStringNestedEnum[key] = e
+				}
+				return nil
+			})
+		case 81:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultInt32 = &v
+		case 82:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultInt64 = &v
+		case 83:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultUint32 = &v
+		case 84:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultUint64 = &v
+		case 85:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultSint32 = &v
+		case 86:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultSint64 = &v
+		case 87:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultFixed32 = &v
+		case 88:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultFixed64 = &v
+		case 89:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultSfixed32 = &v
+		case 80:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultSfixed64 = &v
+		case 91:
+			v, err := d.Float32(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultFloat = &v
+		case 92:
+			v, err := d.Float64(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultDouble = &v
+		case 93:
+			v, err := d.Bool(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultBool = &v
+		case 94:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultString = &v
+		case 95:
+			v, err := d.Bytes(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultBytes = v
+		case 96:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := TestAllTypes_NestedEnum(v)
+				x.DefaultNestedEnum = &e
+			}
+		case 97:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := ForeignEnum(v)
+				x.DefaultForeignEnum = &e
+			}
+		case 111:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofUint32{OneofUint32: v}
+		case 112:
+			v := new(TestAllTypes_NestedMessage)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofNestedMessage{OneofNestedMessage: v}
+		case 113:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofString{OneofString: v}
+		case 114:
+			v, err := d.Bytes(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofBytes{OneofBytes: v}
+		case 115:
+			v, err := d.Bool(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofBool{OneofBool: v}
+		case 116:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofUint64{OneofUint64: v}
+		case 117:
+			v, err := d.Float32(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofFloat{OneofFloat: v}
+		case 118:
+			v, err := d.Float64(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofDouble{OneofDouble: v}
+		case 119:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := TestAllTypes_NestedEnum(v)
+				x.OneofField = &TestAllTypes_OneofEnum{OneofEnum: e}
+			}
+		case 121:
+			v := new(TestAllTypes_OneofGroup)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_Oneofgroup{Oneofgroup: v}
+		case 120:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofOptional = &TestAllTypes_OneofOptionalUint32{OneofOptionalUint32: v}
+		case 122:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofDefaults = &TestAllTypes_OneofDefaultSint32{OneofDefaultSint32: v}
+		case 123:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofDefaults = &TestAllTypes_OneofDefaultString{OneofDefaultString: v}
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestAllTypes_NestedMessage) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestAllTypes_NestedMessage) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.A != nil {
+		v := *x.A
+		b = append(b, "\"a\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.Corecursive != nil {
+		b = append(b, "\"corecursive\":"...)
+		if b, err = x.Corecursive.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestAllTypes_NestedMessage) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestAllTypes_NestedMessage) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.A = &v
+		case 2:
+			v := new(TestAllTypes)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Corecursive = v
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestAllTypes_OptionalGroup) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestAllTypes_OptionalGroup) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.A != nil {
+		v := *x.A
+		b = append(b, "\"a\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalNestedMessage != nil {
+		b = append(b, "\"optionalNestedMessage\":"...)
+		if b, err = x.OptionalNestedMessage.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestAllTypes_OptionalGroup) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestAllTypes_OptionalGroup) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 17:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.A = &v
+		case 1000:
+			v := new(TestAllTypes_NestedMessage)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.OptionalNestedMessage = v
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestAllTypes_RepeatedGroup) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestAllTypes_RepeatedGroup) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.A != nil {
+		v := *x.A
+		b = append(b, "\"a\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestAllTypes_RepeatedGroup) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestAllTypes_RepeatedGroup) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 47:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.A = &v
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestAllTypes_OneofGroup) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestAllTypes_OneofGroup) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.A != nil {
+		v := *x.A
+		b = append(b, "\"a\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.B != nil {
+		v := *x.B
+		b = append(b, "\"b\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestAllTypes_OneofGroup) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestAllTypes_OneofGroup) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.A = &v
+		case 2:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.B = &v
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *ForeignMessage) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *ForeignMessage) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.C != nil {
+		v := *x.C
+		b = append(b, "\"c\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.D != nil {
+		v := *x.D
+		b = append(b, "\"d\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *ForeignMessage) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *ForeignMessage) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.C = &v
+		case 2:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.D = &v
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestExtensionRange) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestExtensionRange) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Name != nil {
+		v := *x.Name
+		b = append(b, "\"name\":"...)
+		if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test2.TestExtensionRange.name"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b, err = runtime.AppendJSONExtensions(b, x.ProtoReflect()); err != nil {
+		return b, err
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestExtensionRange) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestExtensionRange) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.Name = &v
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestRequired) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestRequired) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.RequiredField != nil {
+		v := *x.RequiredField
+		b = append(b, "\"requiredField\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalField != nil {
+		v := *x.OptionalField
+		b = append(b, "\"optionalField\":"...)
+		if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test2.TestRequired.optional_field"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestRequired) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestRequired) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.RequiredField = &v
+		case 2:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalField = &v
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestRequiredForeign) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestRequiredForeign) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.OptionalMessage != nil {
+		b = append(b, "\"optionalMessage\":"...)
+		if b, err = x.OptionalMessage.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.RepeatedMessage) > 0 {
+		b = append(b, "\"repeatedMessage\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedMessage {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.MapMessage) > 0 {
+		b = append(b, "\"mapMessage\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapMessage)) {
+			v := x.MapMessage[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestRequiredForeign_OneofMessage); ok {
+		b = append(b, "\"oneofMessage\":"...)
+		if b, err = v.OneofMessage.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestRequiredForeign) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestRequiredForeign) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v := new(TestRequired)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.OptionalMessage = v
+		case 2:
+			return d.List(func() error {
+				v := new(TestRequired)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.RepeatedMessage = append(x.RepeatedMessage, v)
+				return nil
+			})
+		case 3:
+			if x.MapMessage == nil {
+				x.MapMessage = make(map[int32]*TestRequired)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := int32(k.Int())
+				if _, ok := x.MapMessage[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v := new(TestRequired)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.MapMessage[key] = v
+				return nil
+			})
+		case 4:
+			v := new(TestRequired)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.OneofField = &TestRequiredForeign_OneofMessage{OneofMessage: v}
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestRequiredGroupFields) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestRequiredGroupFields) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Optionalgroup != nil {
+		b = append(b, "\"optionalgroup\":"...)
+		if b, err = x.Optionalgroup.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.Repeatedgroup) > 0 {
+		b = append(b, "\"repeatedgroup\":"...)
+		b = append(b, '[')
+		for _, v := range x.Repeatedgroup {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestRequiredGroupFields) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestRequiredGroupFields) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v := new(TestRequiredGroupFields_OptionalGroup)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Optionalgroup = v
+		case 3:
+			return d.List(func() error {
+				v := new(TestRequiredGroupFields_RepeatedGroup)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.Repeatedgroup = append(x.Repeatedgroup, v)
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestRequiredGroupFields_OptionalGroup) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestRequiredGroupFields_OptionalGroup) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.A != nil {
+		v := *x.A
+		b = append(b, "\"a\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestRequiredGroupFields_OptionalGroup) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestRequiredGroupFields_OptionalGroup) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 2:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.A = &v
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestRequiredGroupFields_RepeatedGroup) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestRequiredGroupFields_RepeatedGroup) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.A != nil {
+		v := *x.A
+		b = append(b, "\"a\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestRequiredGroupFields_RepeatedGroup) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestRequiredGroupFields_RepeatedGroup) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 4:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.A = &v
+		}
+		return nil
+	})
+}
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.18.1
+// source: internal/testprotos/test2/test.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ForeignEnum int32
+
+const (
+	ForeignEnum_FOREIGN_FOO ForeignEnum = 4
+	ForeignEnum_FOREIGN_BAR ForeignEnum = 5
+	ForeignEnum_FOREIGN_BAZ ForeignEnum = 6
+)
+
+// Enum value maps for ForeignEnum.
+var (
+	ForeignEnum_name = map[int32]string{
+		4: "FOREIGN_FOO",
+		5: "FOREIGN_BAR",
+		6: "FOREIGN_BAZ",
+	}
+	ForeignEnum_value = map[string]int32{
+		"FOREIGN_FOO": 4,
+		"FOREIGN_BAR": 5,
+		"FOREIGN_BAZ": 6,
+	}
+)
+
+func (x ForeignEnum) Enum() *ForeignEnum {
+	p := new(ForeignEnum)
+	*p = x
+	return p
+}
+
+func (x ForeignEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForeignEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_testprotos_test2_test_proto_enumTypes[0].Descriptor()
+}
+
+func (ForeignEnum) Type() protoreflect.EnumType {
+	return &file_internal_testprotos_test2_test_proto_enumTypes[0]
+}
+
+func (x ForeignEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ForeignEnum) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ForeignEnum(num)
+	return nil
+}
+
+// Deprecated: Use ForeignEnum.Descriptor instead.
+func (ForeignEnum) EnumDescriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2_test_proto_rawDescGZIP(), []int{0}
+}
+
+type TestAllTypes_NestedEnum int32
+
+const (
+	TestAllTypes_FOO TestAllTypes_NestedEnum = 0
+	TestAllTypes_BAR TestAllTypes_NestedEnum = 1
+	TestAllTypes_BAZ TestAllTypes_NestedEnum = 2
+	TestAllTypes_NEG TestAllTypes_NestedEnum = -1 // Intentionally negative.
+)
+
+// Enum value maps for TestAllTypes_NestedEnum.
+var (
+	TestAllTypes_NestedEnum_name = map[int32]string{
+		0:  "FOO",
+		1:  "BAR",
+		2:  "BAZ",
+		-1: "NEG",
+	}
+	TestAllTypes_NestedEnum_value = map[string]int32{
+		"FOO": 0,
+		"BAR": 1,
+		"BAZ": 2,
+		"NEG": -1,
+	}
+)
+
+func (x TestAllTypes_NestedEnum) Enum() *TestAllTypes_NestedEnum {
+	p := new(TestAllTypes_NestedEnum)
+	*p = x
+	return p
+}
+
+func (x TestAllTypes_NestedEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TestAllTypes_NestedEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_testprotos_test2_test_proto_enumTypes[1].Descriptor()
+}
+
+func (TestAllTypes_NestedEnum) Type() protoreflect.EnumType {
+	return &file_internal_testprotos_test2_test_proto_enumTypes[1]
+}
+
+func (x TestAllTypes_NestedEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *TestAllTypes_NestedEnum) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = TestAllTypes_NestedEnum(num)
+	return nil
+}
+
+// Deprecated: Use TestAllTypes_NestedEnum.Descriptor instead.
+func (TestAllTypes_NestedEnum) EnumDescriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2_test_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type TestAllTypes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+	zeroCopied    bool
+
+	OptionalInt32          *int32                                 `protobuf:"varint,1,opt,name=optional_int32,json=optionalInt32" json:"optional_int32,omitempty"`
+	OptionalInt64          *int64                                 `protobuf:"varint,2,opt,name=optional_int64,json=optionalInt64" json:"optional_int64,omitempty"`
+	OptionalUint32         *uint32                                `protobuf:"varint,3,opt,name=optional_uint32,json=optionalUint32" json:"optional_uint32,omitempty"`
+	OptionalUint64         *uint64                                `protobuf:"varint,4,opt,name=optional_uint64,json=optionalUint64" json:"optional_uint64,omitempty"`
+	OptionalSint32         *int32                                 `protobuf:"zigzag32,5,opt,name=optional_sint32,json=optionalSint32" json:"optional_sint32,omitempty"`
+	OptionalSint64         *int64                                 `protobuf:"zigzag64,6,opt,name=optional_sint64,json=optionalSint64" json:"optional_sint64,omitempty"`
+	OptionalFixed32        *uint32                                `protobuf:"fixed32,7,opt,name=optional_fixed32,json=optionalFixed32" json:"optional_fixed32,omitempty"`
+	OptionalFixed64        *uint64                                `protobuf:"fixed64,8,opt,name=optional_fixed64,json=optionalFixed64" json:"optional_fixed64,omitempty"`
+	OptionalSfixed32       *int32                                 `protobuf:"fixed32,9,opt,name=optional_sfixed32,json=optionalSfixed32" json:"optional_sfixed32,omitempty"`
+	OptionalSfixed64       *int64                                 `protobuf:"fixed64,10,opt,name=optional_sfixed64,json=optionalSfixed64" json:"optional_sfixed64,omitempty"`
+	OptionalFloat          *float32                               `protobuf:"fixed32,11,opt,name=optional_float,json=optionalFloat" json:"optional_float,omitempty"`
+	OptionalDouble         *float64                               `protobuf:"fixed64,12,opt,name=optional_double,json=optionalDouble" json:"optional_double,omitempty"`
+	OptionalBool           *bool                                  `protobuf:"varint,13,opt,name=optional_bool,json=optionalBool" json:"optional_bool,omitempty"`
+	OptionalString         *string                                `protobuf:"bytes,14,opt,name=optional_string,json=optionalString" json:"optional_string,omitempty"`
+	OptionalBytes          []byte                                 `protobuf:"bytes,15,opt,name=optional_bytes,json=optionalBytes" json:"optional_bytes,omitempty"`
+	Optionalgroup          *TestAllTypes_OptionalGroup            `protobuf:"group,16,opt,name=OptionalGroup,json=optionalgroup" json:"optionalgroup,omitempty"`
+	OptionalNestedMessage  *TestAllTypes_NestedMessage            `protobuf:"bytes,18,opt,name=optional_nested_message,json=optionalNestedMessage" json:"optional_nested_message,omitempty"`
+	OptionalForeignMessage *ForeignMessage                        `protobuf:"bytes,19,opt,name=optional_foreign_message,json=optionalForeignMessage" json:"optional_foreign_message,omitempty"`
+	OptionalNestedEnum     *TestAllTypes_NestedEnum               `protobuf:"varint,21,opt,name=optional_nested_enum,json=optionalNestedEnum,enum=goproto.proto.test2.TestAllTypes_NestedEnum" json:"optional_nested_enum,omitempty"`
+	OptionalForeignEnum    *ForeignEnum                           `protobuf:"varint,22,opt,name=optional_foreign_enum,json=optionalForeignEnum,enum=goproto.proto.test2.ForeignEnum" json:"optional_foreign_enum,omitempty"`
+	RepeatedInt32          []int32                                `protobuf:"varint,31,rep,name=repeated_int32,json=repeatedInt32" json:"repeated_int32,omitempty"`
+	RepeatedInt64          []int64                                `protobuf:"varint,32,rep,name=repeated_int64,json=repeatedInt64" json:"repeated_int64,omitempty"`
+	RepeatedUint32         []uint32                               `protobuf:"varint,33,rep,name=repeated_uint32,json=repeatedUint32" json:"repeated_uint32,omitempty"`
+	RepeatedUint64         []uint64                               `protobuf:"varint,34,rep,name=repeated_uint64,json=repeatedUint64" json:"repeated_uint64,omitempty"`
+	RepeatedSint32         []int32                                `protobuf:"zigzag32,35,rep,name=repeated_sint32,json=repeatedSint32" json:"repeated_sint32,omitempty"`
+	RepeatedSint64         []int64                                `protobuf:"zigzag64,36,rep,name=repeated_sint64,json=repeatedSint64" json:"repeated_sint64,omitempty"`
+	RepeatedFixed32        []uint32                               `protobuf:"fixed32,37,rep,name=repeated_fixed32,json=repeatedFixed32" json:"repeated_fixed32,omitempty"`
+	RepeatedFixed64        []uint64                               `protobuf:"fixed64,38,rep,name=repeated_fixed64,json=repeatedFixed64" json:"repeated_fixed64,omitempty"`
+	RepeatedSfixed32       []int32                                `protobuf:"fixed32,39,rep,name=repeated_sfixed32,json=repeatedSfixed32" json:"repeated_sfixed32,omitempty"`
+	RepeatedSfixed64       []int64                                `protobuf:"fixed64,40,rep,name=repeated_sfixed64,json=repeatedSfixed64" json:"repeated_sfixed64,omitempty"`
+	RepeatedFloat          []float32                              `protobuf:"fixed32,41,rep,name=repeated_float,json=repeatedFloat" json:"repeated_float,omitempty"`
+	RepeatedDouble         []float64                              `protobuf:"fixed64,42,rep,name=repeated_double,json=repeatedDouble" json:"repeated_double,omitempty"`
+	RepeatedBool           []bool                                 `protobuf:"varint,43,rep,name=repeated_bool,json=repeatedBool" json:"repeated_bool,omitempty"`
+	RepeatedString         []string                               `protobuf:"bytes,44,rep,name=repeated_string,json=repeatedString" json:"repeated_string,omitempty"`
+	RepeatedBytes          [][]byte                               `protobuf:"bytes,45,rep,name=repeated_bytes,json=repeatedBytes" json:"repeated_bytes,omitempty"`
+	Repeatedgroup          []*TestAllTypes_RepeatedGroup          `protobuf:"group,46,rep,name=RepeatedGroup,json=repeatedgroup" json:"repeatedgroup,omitempty"`
+	RepeatedNestedMessage  []*TestAllTypes_NestedMessage          `protobuf:"bytes,48,rep,name=repeated_nested_message,json=repeatedNestedMessage" json:"repeated_nested_message,omitempty"`
+	RepeatedForeignMessage []*ForeignMessage                      `protobuf:"bytes,49,rep,name=repeated_foreign_message,json=repeatedForeignMessage" json:"repeated_foreign_message,omitempty"`
+	RepeatedNestedEnum     []TestAllTypes_NestedEnum              `protobuf:"varint,51,rep,name=repeated_nested_enum,json=repeatedNestedEnum,enum=goproto.proto.test2.TestAllTypes_NestedEnum" json:"repeated_nested_enum,omitempty"`
+	RepeatedForeignEnum    []ForeignEnum                          `protobuf:"varint,52,rep,name=repeated_foreign_enum,json=repeatedForeignEnum,enum=goproto.proto.test2.ForeignEnum" json:"repeated_foreign_enum,omitempty"`
+	PackedInt32            []int32                                `protobuf:"varint,53,rep,packed,name=packed_int32,json=packedInt32" json:"packed_int32,omitempty"`
+	PackedSint64           []int64                                `protobuf:"zigzag64,54,rep,packed,name=packed_sint64,json=packedSint64" json:"packed_sint64,omitempty"`
+	PackedDouble           []float64                              `protobuf:"fixed64,55,rep,packed,name=packed_double,json=packedDouble" json:"packed_double,omitempty"`
+	PackedBool             []bool                                 `protobuf:"varint,57,rep,packed,name=packed_bool,json=packedBool" json:"packed_bool,omitempty"`
+	MapInt32Int32          map[int32]int32                        `protobuf:"bytes,56,rep,name=map_int32_int32,json=mapInt32Int32" json:"map_int32_int32,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	MapSint64Sint64        map[int64]int64                        `protobuf:"bytes,61,rep,name=map_sint64_sint64,json=mapSint64Sint64" json:"map_sint64_sint64,omitempty" protobuf_key:"zigzag64,1,opt,name=key" protobuf_val:"zigzag64,2,opt,name=value"`
+	MapStringString        map[string]string                      `protobuf:"bytes,69,rep,name=map_string_string,json=mapStringString" json:"map_string_string,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MapStringBytes         map[string][]byte                      `protobuf:"bytes,70,rep,name=map_string_bytes,json=mapStringBytes" json:"map_string_bytes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MapStringNestedMessage map[string]*TestAllTypes_NestedMessage `protobuf:"bytes,71,rep,name=map_string_nested_message,json=mapStringNestedMessage" json:"map_string_nested_message,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MapStringNestedEnum    map[string]TestAllTypes_NestedEnum     `protobuf:"bytes,73,rep,name=map_string_nested_enum,json=mapStringNestedEnum" json:"map_string_nested_enum,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=goproto.proto.test2.TestAllTypes_NestedEnum"`
+	// Singular with defaults
+	DefaultInt32       *int32                   `protobuf:"varint,81,opt,name=default_int32,json=defaultInt32,def=81" json:"default_int32,omitempty"`
+	DefaultInt64       *int64                   `protobuf:"varint,82,opt,name=default_int64,json=defaultInt64,def=82" json:"default_int64,omitempty"`
+	DefaultUint32      *uint32                  `protobuf:"varint,83,opt,name=default_uint32,json=defaultUint32,def=83" json:"default_uint32,omitempty"`
+	DefaultUint64      *uint64                  `protobuf:"varint,84,opt,name=default_uint64,json=defaultUint64,def=84" json:"default_uint64,omitempty"`
+	DefaultSint32      *int32                   `protobuf:"zigzag32,85,opt,name=default_sint32,json=defaultSint32,def=-85" json:"default_sint32,omitempty"`
+	DefaultSint64      *int64                   `protobuf:"zigzag64,86,opt,name=default_sint64,json=defaultSint64,def=86" json:"default_sint64,omitempty"`
+	DefaultFixed32     *uint32                  `protobuf:"fixed32,87,opt,name=default_fixed32,json=defaultFixed32,def=87" json:"default_fixed32,omitempty"`
+	DefaultFixed64     *uint64                  `protobuf:"fixed64,88,opt,name=default_fixed64,json=defaultFixed64,def=88" json:"default_fixed64,omitempty"`
+	DefaultSfixed32    *int32                   `protobuf:"fixed32,89,opt,name=default_sfixed32,json=defaultSfixed32,def=89" json:"default_sfixed32,omitempty"`
+	DefaultSfixed64    *int64                   `protobuf:"fixed64,80,opt,name=default_sfixed64,json=defaultSfixed64,def=-90" json:"default_sfixed64,omitempty"`
+	DefaultFloat       *float32                 `protobuf:"fixed32,91,opt,name=default_float,json=defaultFloat,def=91.5" json:"default_float,omitempty"`
+	DefaultDouble      *float64                 `protobuf:"fixed64,92,opt,name=default_double,json=defaultDouble,def=92000" json:"default_double,omitempty"`
+	DefaultBool        *bool                    `protobuf:"varint,93,opt,name=default_bool,json=defaultBool,def=1" json:"default_bool,omitempty"`
+	DefaultString      *string                  `protobuf:"bytes,94,opt,name=default_string,json=defaultString,def=hello" json:"default_string,omitempty"`
+	DefaultBytes       []byte                   `protobuf:"bytes,95,opt,name=default_bytes,json=defaultBytes,def=world" json:"default_bytes,omitempty"`
+	DefaultNestedEnum  *TestAllTypes_NestedEnum `protobuf:"varint,96,opt,name=default_nested_enum,json=defaultNestedEnum,enum=goproto.proto.test2.TestAllTypes_NestedEnum,def=1" json:"default_nested_enum,omitempty"`
+	DefaultForeignEnum *ForeignEnum             `protobuf:"varint,97,opt,name=default_foreign_enum,json=defaultForeignEnum,enum=goproto.proto.test2.ForeignEnum,def=5" json:"default_foreign_enum,omitempty"`
+	// Types that are assignable to OneofField:
+	//	*TestAllTypes_OneofUint32
+	//	*TestAllTypes_OneofNestedMessage
+	//	*TestAllTypes_OneofString
+	//	*TestAllTypes_OneofBytes
+	//	*TestAllTypes_OneofBool
+	//	*TestAllTypes_OneofUint64
+	//	*TestAllTypes_OneofFloat
+	//	*TestAllTypes_OneofDouble
+	//	*TestAllTypes_OneofEnum
+	//	*TestAllTypes_Oneofgroup
+	OneofField isTestAllTypes_OneofField `protobuf_oneof:"oneof_field"`
+	// A oneof with exactly one field.
+	//
+	// Types that are assignable to OneofOptional:
+	//	*TestAllTypes_OneofOptionalUint32
+	OneofOptional isTestAllTypes_OneofOptional `protobuf_oneof:"oneof_optional"`
+	// Types that are assignable to OneofDefaults:
+	//	*TestAllTypes_OneofDefaultSint32
+	//	*TestAllTypes_OneofDefaultString
+	OneofDefaults isTestAllTypes_OneofDefaults `protobuf_oneof:"oneof_defaults"`
+}
+
+// Default values for TestAllTypes fields.
+const (
+	Default_TestAllTypes_DefaultInt32       = int32(81)
+	Default_TestAllTypes_DefaultInt64       = int64(82)
+	Default_TestAllTypes_DefaultUint32      = uint32(83)
+	Default_TestAllTypes_DefaultUint64      = uint64(84)
+	Default_TestAllTypes_DefaultSint32      = int32(-85)
+	Default_TestAllTypes_DefaultSint64      = int64(86)
+	Default_TestAllTypes_DefaultFixed32     = uint32(87)
+	Default_TestAllTypes_DefaultFixed64     = uint64(88)
+	Default_TestAllTypes_DefaultSfixed32    = int32(89)
+	Default_TestAllTypes_DefaultSfixed64    = int64(-90)
+	Default_TestAllTypes_DefaultFloat       = float32(91.5)
+	Default_TestAllTypes_DefaultDouble      = float64(92000)
+	Default_TestAllTypes_DefaultBool        = bool(true)
+	Default_TestAllTypes_DefaultString      = string("hello")
+	Default_TestAllTypes_DefaultNestedEnum  = TestAllTypes_BAR
+	Default_TestAllTypes_DefaultForeignEnum = ForeignEnum_FOREIGN_BAR
+	Default_TestAllTypes_OneofDefaultSint32 = int32(-122)
+	Default_TestAllTypes_OneofDefaultString = string("oneof")
+)
+
+// Default values for TestAllTypes fields.
+var (
+	Default_TestAllTypes_DefaultBytes = []byte("world")
+)
+
+func (x *TestAllTypes) Reset() {
+	*x = TestAllTypes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test2_test_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestAllTypes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAllTypes) ProtoMessage() {}
+
+// Deprecated: Use TestAllTypes.ProtoReflect.Descriptor instead.
+func (*TestAllTypes) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TestAllTypes) GetOptionalInt32() int32 {
+	if x != nil && x.OptionalInt32 != nil {
+		return *x.OptionalInt32
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalInt64() int64 {
+	if x != nil && x.OptionalInt64 != nil {
+		return *x.OptionalInt64
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalUint32() uint32 {
+	if x != nil && x.OptionalUint32 != nil {
+		return *x.OptionalUint32
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalUint64() uint64 {
+	if x != nil && x.OptionalUint64 != nil {
+		return *x.OptionalUint64
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalSint32() int32 {
+	if x != nil && x.OptionalSint32 != nil {
+		return *x.OptionalSint32
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalSint64() int64 {
+	if x != nil && x.OptionalSint64 != nil {
+		return *x.OptionalSint64
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalFixed32() uint32 {
+	if x != nil && x.OptionalFixed32 != nil {
+		return *x.OptionalFixed32
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalFixed64() uint64 {
+	if x != nil && x.OptionalFixed64 != nil {
+		return *x.OptionalFixed64
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalSfixed32() int32 {
+	if x != nil && x.OptionalSfixed32 != nil {
+		return *x.OptionalSfixed32
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalSfixed64() int64 {
+	if x != nil && x.OptionalSfixed64 != nil {
+		return *x.OptionalSfixed64
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalFloat() float32 {
+	if x != nil && x.OptionalFloat != nil {
+		return *x.OptionalFloat
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalDouble() float64 {
+	if x != nil && x.OptionalDouble != nil {
+		return *x.OptionalDouble
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalBool() bool {
+	if x != nil && x.OptionalBool != nil {
+		return *x.OptionalBool
+	}
+	return false
+}
+
+func (x *TestAllTypes) GetOptionalString() string {
+	if x != nil && x.OptionalString != nil {
+		return *x.OptionalString
+	}
+	return ""
+}
+
+func (x *TestAllTypes) GetOptionalBytes() []byte {
+	if x != nil {
+		return x.OptionalBytes
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetOptionalgroup() *TestAllTypes_OptionalGroup {
+	if x != nil {
+		return x.Optionalgroup
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetOptionalNestedMessage() *TestAllTypes_NestedMessage {
+	if x != nil {
+		return x.OptionalNestedMessage
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetOptionalForeignMessage() *ForeignMessage {
+	if x != nil {
+		return x.OptionalForeignMessage
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetOptionalNestedEnum() TestAllTypes_NestedEnum {
+	if x != nil && x.OptionalNestedEnum != nil {
+		return *x.OptionalNestedEnum
+	}
+	return TestAllTypes_FOO
+}
+
+func (x *TestAllTypes) GetOptionalForeignEnum() ForeignEnum {
+	if x != nil && x.OptionalForeignEnum != nil {
+		return *x.OptionalForeignEnum
+	}
+	return ForeignEnum_FOREIGN_FOO
+}
+
+func (x *TestAllTypes) GetRepeatedInt32() []int32 {
+	if x != nil {
+		return x.RepeatedInt32
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedInt64() []int64 {
+	if x != nil {
+		return x.RepeatedInt64
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedUint32() []uint32 {
+	if x != nil {
+		return x.RepeatedUint32
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedUint64() []uint64 {
+	if x != nil {
+		return x.RepeatedUint64
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedSint32() []int32 {
+	if x != nil {
+		return x.RepeatedSint32
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedSint64() []int64 {
+	if x != nil {
+		return x.RepeatedSint64
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedFixed32() []uint32 {
+	if x != nil {
+		return x.RepeatedFixed32
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedFixed64() []uint64 {
+	if x != nil {
+		return x.RepeatedFixed64
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedSfixed32() []int32 {
+	if x != nil {
+		return x.RepeatedSfixed32
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedSfixed64() []int64 {
+	if x != nil {
+		return x.RepeatedSfixed64
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedFloat() []float32 {
+	if x != nil {
+		return x.RepeatedFloat
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedDouble() []float64 {
+	if x != nil {
+		return x.RepeatedDouble
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedBool() []bool {
+	if x != nil {
+		return x.RepeatedBool
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedString() []string {
+	if x != nil {
+		return x.RepeatedString
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedBytes() [][]byte {
+	if x != nil {
+		return x.RepeatedBytes
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedgroup() []*TestAllTypes_RepeatedGroup {
+	if x != nil {
+		return x.Repeatedgroup
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedNestedMessage() []*TestAllTypes_NestedMessage {
+	if x != nil {
+		return x.RepeatedNestedMessage
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedForeignMessage() []*ForeignMessage {
+	if x != nil {
+		return x.RepeatedForeignMessage
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedNestedEnum() []TestAllTypes_NestedEnum {
+	if x != nil {
+		return x.RepeatedNestedEnum
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedForeignEnum() []ForeignEnum {
+	if x != nil {
+		return x.RepeatedForeignEnum
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetPackedInt32() []int32 {
+	if x != nil {
+		return x.PackedInt32
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetPackedSint64() []int64 {
+	if x != nil {
+		return x.PackedSint64
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetPackedDouble() []float64 {
+	if x != nil {
+		return x.PackedDouble
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetPackedBool() []bool {
+	if x != nil {
+		return x.PackedBool
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetMapInt32Int32() map[int32]int32 {
+	if x != nil {
+		return x.MapInt32Int32
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetMapSint64Sint64() map[int64]int64 {
+	if x != nil {
+		return x.MapSint64Sint64
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetMapStringString() map[string]string {
+	if x != nil {
+		return x.MapStringString
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetMapStringBytes() map[string][]byte {
+	if x != nil {
+		return x.MapStringBytes
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetMapStringNestedMessage() map[string]*TestAllTypes_NestedMessage {
+	if x != nil {
+		return x.MapStringNestedMessage
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetMapStringNestedEnum() map[string]TestAllTypes_NestedEnum {
+	if x != nil {
+		return x.MapStringNestedEnum
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetDefaultInt32() int32 {
+	if x != nil && x.DefaultInt32 != nil {
+		return *x.DefaultInt32
+	}
+	return Default_TestAllTypes_DefaultInt32
+}
+
+func (x *TestAllTypes) GetDefaultInt64() int64 {
+	if x != nil && x.DefaultInt64 != nil {
+		return *x.DefaultInt64
+	}
+	return Default_TestAllTypes_DefaultInt64
+}
+
+func (x *TestAllTypes) GetDefaultUint32() uint32 {
+	if x != nil && x.DefaultUint32 != nil {
+		return *x.DefaultUint32
+	}
+	return Default_TestAllTypes_DefaultUint32
+}
+
+func (x *TestAllTypes) GetDefaultUint64() uint64 {
+	if x != nil && x.DefaultUint64 != nil {
+		return *x.DefaultUint64
+	}
+	return Default_TestAllTypes_DefaultUint64
+}
+
+func (x *TestAllTypes) GetDefaultSint32() int32 {
+	if x != nil && x.DefaultSint32 != nil {
+		return *x.DefaultSint32
+	}
+	return Default_TestAllTypes_DefaultSint32
+}
+
+func (x *TestAllTypes) GetDefaultSint64() int64 {
+	if x != nil && x.DefaultSint64 != nil {
+		return *x.DefaultSint64
+	}
+	return Default_TestAllTypes_DefaultSint64
+}
+
+func (x *TestAllTypes) GetDefaultFixed32() uint32 {
+	if x != nil && x.DefaultFixed32 != nil {
+		return *x.DefaultFixed32
+	}
+	return Default_TestAllTypes_DefaultFixed32
+}
+
+func (x *TestAllTypes) GetDefaultFixed64() uint64 {
+	if x != nil && x.DefaultFixed64 != nil {
+		return *x.DefaultFixed64
+	}
+	return Default_TestAllTypes_DefaultFixed64
+}
+
+func (x *TestAllTypes) GetDefaultSfixed32() int32 {
+	if x != nil && x.DefaultSfixed32 != nil {
+		return *x.DefaultSfixed32
+	}
+	return Default_TestAllTypes_DefaultSfixed32
+}
+
+func (x *TestAllTypes) GetDefaultSfixed64() int64 {
+	if x != nil && x.DefaultSfixed64 != nil {
+		return *x.DefaultSfixed64
+	}
+	return Default_TestAllTypes_DefaultSfixed64
+}
+
+func (x *TestAllTypes) GetDefaultFloat() float32 {
+	if x != nil && x.DefaultFloat != nil {
+		return *x.DefaultFloat
+	}
+	return Default_TestAllTypes_DefaultFloat
+}
+
+func (x *TestAllTypes) GetDefaultDouble() float64 {
+	if x != nil && x.DefaultDouble != nil {
+		return *x.DefaultDouble
+	}
+	return Default_TestAllTypes_DefaultDouble
+}
+
+func (x *TestAllTypes) GetDefaultBool() bool {
+	if x != nil && x.DefaultBool != nil {
+		return *x.DefaultBool
+	}
+	return Default_TestAllTypes_DefaultBool
+}
+
+func (x *TestAllTypes) GetDefaultString() string {
+	if x != nil && x.DefaultString != nil {
+		return *x.DefaultString
+	}
+	return Default_TestAllTypes_DefaultString
+}
+
+func (x *TestAllTypes) GetDefaultBytes() []byte {
+	if x != nil && x.DefaultBytes != nil {
+		return x.DefaultBytes
+	}
+	return append([]byte(nil), Default_TestAllTypes_DefaultBytes...)
+}
+
+func (x *TestAllTypes) GetDefaultNestedEnum() TestAllTypes_NestedEnum {
+	if x != nil && x.DefaultNestedEnum != nil {
+		return *x.DefaultNestedEnum
+	}
+	return Default_TestAllTypes_DefaultNestedEnum
+}
+
+func (x *TestAllTypes) GetDefaultForeignEnum() ForeignEnum {
+	if x != nil && x.DefaultForeignEnum != nil {
+		return *x.DefaultForeignEnum
+	}
+	return Default_TestAllTypes_DefaultForeignEnum
+}
+
+func (x *TestAllTypes) GetOneofField() isTestAllTypes_OneofField {
+	if x != nil {
+		return x.OneofField
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetOneofUint32() uint32 {
+	if x, ok := x.GetOneofField().(*TestAllTypes_OneofUint32); ok {
+		return x.OneofUint32
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOneofNestedMessage() *TestAllTypes_NestedMessage {
+	if x, ok := x.GetOneofField().(*TestAllTypes_OneofNestedMessage); ok {
+		return x.OneofNestedMessage
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetOneofString() string {
+	if x, ok := x.GetOneofField().(*TestAllTypes_OneofString); ok {
+		return x.OneofString
+	}
+	return ""
+}
+
+func (x *TestAllTypes) GetOneofBytes() []byte {
+	if x, ok := x.GetOneofField().(*TestAllTypes_OneofBytes); ok {
+		return x.OneofBytes
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetOneofBool() bool {
+	if x, ok := x.GetOneofField().(*TestAllTypes_OneofBool); ok {
+		return x.OneofBool
+	}
+	return false
+}
+
+func (x *TestAllTypes) GetOneofUint64() uint64 {
+	if x, ok := x.GetOneofField().(*TestAllTypes_OneofUint64); ok {
+		return x.OneofUint64
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOneofFloat() float32 {
+	if x, ok := x.GetOneofField().(*TestAllTypes_OneofFloat); ok {
+		return x.OneofFloat
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOneofDouble() float64 {
+	if x, ok := x.GetOneofField().(*TestAllTypes_OneofDouble); ok {
+		return x.OneofDouble
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOneofEnum() TestAllTypes_NestedEnum {
+	if x, ok := x.GetOneofField().(*TestAllTypes_OneofEnum); ok {
+		return x.OneofEnum
+	}
+	return TestAllTypes_FOO
+}
+
+func (x *TestAllTypes) GetOneofgroup() *TestAllTypes_OneofGroup {
+	if x, ok := x.GetOneofField().(*TestAllTypes_Oneofgroup); ok {
+		return x.Oneofgroup
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetOneofOptional() isTestAllTypes_OneofOptional {
+	if x != nil {
+		return x.OneofOptional
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetOneofOptionalUint32() uint32 {
+	if x, ok := x.GetOneofOptional().(*TestAllTypes_OneofOptionalUint32); ok {
+		return x.OneofOptionalUint32
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOneofDefaults() isTestAllTypes_OneofDefaults {
+	if x != nil {
+		return x.OneofDefaults
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetOneofDefaultSint32() int32 {
+	if x, ok := x.GetOneofDefaults().(*TestAllTypes_OneofDefaultSint32); ok {
+		return x.OneofDefaultSint32
+	}
+	return Default_TestAllTypes_OneofDefaultSint32
+}
+
+func (x *TestAllTypes) GetOneofDefaultString() string {
+	if x, ok := x.GetOneofDefaults().(*TestAllTypes_OneofDefaultString); ok {
+		return x.OneofDefaultString
+	}
+	return Default_TestAllTypes_OneofDefaultString
+}
+
+type isTestAllTypes_OneofField interface {
+	isTestAllTypes_OneofField()
+}
+
+type TestAllTypes_OneofUint32 struct {
+	OneofUint32 uint32 `protobuf:"varint,111,opt,name=oneof_uint32,json=oneofUint32,oneof"`
+}
+
+type TestAllTypes_OneofNestedMessage struct {
+	OneofNestedMessage *TestAllTypes_NestedMessage `protobuf:"bytes,112,opt,name=oneof_nested_message,json=oneofNestedMessage,oneof"`
+}
+
+type TestAllTypes_OneofString struct {
+	OneofString string `protobuf:"bytes,113,opt,name=oneof_string,json=oneofString,oneof"`
+}
+
+type TestAllTypes_OneofBytes struct {
+	OneofBytes []byte `protobuf:"bytes,114,opt,name=oneof_bytes,json=oneofBytes,oneof"`
+}
+
+type TestAllTypes_OneofBool struct {
+	OneofBool bool `protobuf:"varint,115,opt,name=oneof_bool,json=oneofBool,oneof"`
+}
+
+type TestAllTypes_OneofUint64 struct {
+	OneofUint64 uint64 `protobuf:"varint,116,opt,name=oneof_uint64,json=oneofUint64,oneof"`
+}
+
+type TestAllTypes_OneofFloat struct {
+	OneofFloat float32 `protobuf:"fixed32,117,opt,name=oneof_float,json=oneofFloat,oneof"`
+}
+
+type TestAllTypes_OneofDouble struct {
+	OneofDouble float64 `protobuf:"fixed64,118,opt,name=oneof_double,json=oneofDouble,oneof"`
+}
+
+type TestAllTypes_OneofEnum struct {
+	OneofEnum TestAllTypes_NestedEnum `protobuf:"varint,119,opt,name=oneof_enum,json=oneofEnum,enum=goproto.proto.test2.TestAllTypes_NestedEnum,oneof"`
+}
+
+type TestAllTypes_Oneofgroup struct {
+	Oneofgroup *TestAllTypes_OneofGroup `protobuf:"group,121,opt,name=OneofGroup,json=oneofgroup,oneof"`
+}
+
+func (*TestAllTypes_OneofUint32) isTestAllTypes_OneofField() {}
+
+func (*TestAllTypes_OneofNestedMessage) isTestAllTypes_OneofField() {}
+
+func (*TestAllTypes_OneofString) isTestAllTypes_OneofField() {}
+
+func (*TestAllTypes_OneofBytes) isTestAllTypes_OneofField() {}
+
+func (*TestAllTypes_OneofBool) isTestAllTypes_OneofField() {}
+
+func (*TestAllTypes_OneofUint64) isTestAllTypes_OneofField() {}
+
+func (*TestAllTypes_OneofFloat) isTestAllTypes_OneofField() {}
+
+func (*TestAllTypes_OneofDouble) isTestAllTypes_OneofField() {}
+
+func (*TestAllTypes_OneofEnum) isTestAllTypes_OneofField() {}
+
+func (*TestAllTypes_Oneofgroup) isTestAllTypes_OneofField() {}
+
+type isTestAllTypes_OneofOptional interface {
+	isTestAllTypes_OneofOptional()
+}
+
+type TestAllTypes_OneofOptionalUint32 struct {
+	OneofOptionalUint32 uint32 `protobuf:"varint,120,opt,name=oneof_optional_uint32,json=oneofOptionalUint32,oneof"`
+}
+
+func (*TestAllTypes_OneofOptionalUint32) isTestAllTypes_OneofOptional() {}
+
+type isTestAllTypes_OneofDefaults interface {
+	isTestAllTypes_OneofDefaults()
+}
+
+type TestAllTypes_OneofDefaultSint32 struct {
+	OneofDefaultSint32 int32 `protobuf:"zigzag32,122,opt,name=oneof_default_sint32,json=oneofDefaultSint32,oneof,def=-122"`
+}
+
+type TestAllTypes_OneofDefaultString struct {
+	OneofDefaultString string `protobuf:"bytes,123,opt,name=oneof_default_string,json=oneofDefaultString,oneof,def=oneof"`
+}
+
+func (*TestAllTypes_OneofDefaultSint32) isTestAllTypes_OneofDefaults() {}
+
+func (*TestAllTypes_OneofDefaultString) isTestAllTypes_OneofDefaults() {}
+
+type ForeignMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	C *int32 `protobuf:"varint,1,opt,name=c" json:"c,omitempty"`
+	D *int32 `protobuf:"varint,2,opt,name=d" json:"d,omitempty"`
+}
+
+func (x *ForeignMessage) Reset() {
+	*x = ForeignMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test2_test_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForeignMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignMessage) ProtoMessage() {}
+
+// Deprecated: Use ForeignMessage.ProtoReflect.Descriptor instead.
+func (*ForeignMessage) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ForeignMessage) GetC() int32 {
+	if x != nil && x.C != nil {
+		return *x.C
+	}
+	return 0
+}
+
+func (x *ForeignMessage) GetD() int32 {
+	if x != nil && x.D != nil {
+		return *x.D
+	}
+	return 0
+}
+
+type TestExtensionRange struct {
+	state           protoimpl.MessageState
+	sizeCache       protoimpl.SizeCache
+	unknownFields   protoimpl.UnknownFields
+	extensionFields protoimpl.ExtensionFields
+
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+}
+
+func (x *TestExtensionRange) Reset() {
+	*x = TestExtensionRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test2_test_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestExtensionRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestExtensionRange) ProtoMessage() {}
+
+// Deprecated: Use TestExtensionRange.ProtoReflect.Descriptor instead.
+func (*TestExtensionRange) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TestExtensionRange) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type TestRequired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequiredField *int32  `protobuf:"varint,1,req,name=required_field,json=requiredField" json:"required_field,omitempty"`
+	OptionalField *string `protobuf:"bytes,2,opt,name=optional_field,json=optionalField" json:"optional_field,omitempty"`
+}
+
+func (x *TestRequired) Reset() {
+	*x = TestRequired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test2_test_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestRequired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRequired) ProtoMessage() {}
+
+// Deprecated: Use TestRequired.ProtoReflect.Descriptor instead.
+func (*TestRequired) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TestRequired) GetRequiredField() int32 {
+	if x != nil && x.RequiredField != nil {
+		return *x.RequiredField
+	}
+	return 0
+}
+
+func (x *TestRequired) GetOptionalField() string {
+	if x != nil && x.OptionalField != nil {
+		return *x.OptionalField
+	}
+	return ""
+}
+
+type TestRequiredForeign struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OptionalMessage *TestRequired           `protobuf:"bytes,1,opt,name=optional_message,json=optionalMessage" json:"optional_message,omitempty"`
+	RepeatedMessage []*TestRequired         `protobuf:"bytes,2,rep,name=repeated_message,json=repeatedMessage" json:"repeated_message,omitempty"`
+	MapMessage      map[int32]*TestRequired `protobuf:"bytes,3,rep,name=map_message,json=mapMessage" json:"map_message,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are assignable to OneofField:
+	//	*TestRequiredForeign_OneofMessage
+	OneofField isTestRequiredForeign_OneofField `protobuf_oneof:"oneof_field"`
+}
+
+func (x *TestRequiredForeign) Reset() {
+	*x = TestRequiredForeign{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test2_test_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestRequiredForeign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRequiredForeign) ProtoMessage() {}
+
+// Deprecated: Use TestRequiredForeign.ProtoReflect.Descriptor instead.
+func (*TestRequiredForeign) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2_test_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TestRequiredForeign) GetOptionalMessage() *TestRequired {
+	if x != nil {
+		return x.OptionalMessage
+	}
+	return nil
+}
+
+func (x *TestRequiredForeign) GetRepeatedMessage() []*TestRequired {
+	if x != nil {
+		return x.RepeatedMessage
+	}
+	return nil
+}
+
+func (x *TestRequiredForeign) GetMapMessage() map[int32]*TestRequired {
+	if x != nil {
+		return x.MapMessage
+	}
+	return nil
+}
+
+func (x *TestRequiredForeign) GetOneofField() isTestRequiredForeign_OneofField {
+	if x != nil {
+		return x.OneofField
+	}
+	return nil
+}
+
+func (x *TestRequiredForeign) GetOneofMessage() *TestRequired {
+	if x, ok := x.GetOneofField().(*TestRequiredForeign_OneofMessage); ok {
+		return x.OneofMessage
+	}
+	return nil
+}
+
+type isTestRequiredForeign_OneofField interface {
+	isTestRequiredForeign_OneofField()
+}
+
+type TestRequiredForeign_OneofMessage struct {
+	OneofMessage *TestRequired `protobuf:"bytes,4,opt,name=oneof_message,json=oneofMessage,oneof"`
+}
+
+func (*TestRequiredForeign_OneofMessage) isTestRequiredForeign_OneofField() {}
+
+type TestRequiredGroupFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Optionalgroup *TestRequiredGroupFields_OptionalGroup   `protobuf:"group,1,opt,name=OptionalGroup,json=optionalgroup" json:"optionalgroup,omitempty"`
+	Repeatedgroup []*TestRequiredGroupFields_RepeatedGroup `protobuf:"group,3,rep,name=RepeatedGroup,json=repeatedgroup" json:"repeatedgroup,omitempty"`
+}
+
+func (x *TestRequiredGroupFields) Reset() {
+	*x = TestRequiredGroupFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test2_test_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestRequiredGroupFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRequiredGroupFields) ProtoMessage() {}
+
+// Deprecated: Use TestRequiredGroupFields.ProtoReflect.Descriptor instead.
+func (*TestRequiredGroupFields) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2_test_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TestRequiredGroupFields) GetOptionalgroup() *TestRequiredGroupFields_OptionalGroup {
+	if x != nil {
+		return x.Optionalgroup
+	}
+	return nil
+}
+
+func (x *TestRequiredGroupFields) GetRepeatedgroup() []*TestRequiredGroupFields_RepeatedGroup {
+	if x != nil {
+		return x.Repeatedgroup
+	}
+	return nil
+}
+
+type TestAllTypes_NestedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A           *int32        `protobuf:"varint,1,opt,name=a" json:"a,omitempty"`
+	Corecursive *TestAllTypes `protobuf:"bytes,2,opt,name=corecursive" json:"corecursive,omitempty"`
+}
+
+func (x *TestAllTypes_NestedMessage) Reset() {
+	*x = TestAllTypes_NestedMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test2_test_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestAllTypes_NestedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAllTypes_NestedMessage) ProtoMessage() {}
+
+// Deprecated: Use TestAllTypes_NestedMessage.ProtoReflect.Descriptor instead.
+func (*TestAllTypes_NestedMessage) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2_test_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *TestAllTypes_NestedMessage) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+func (x *TestAllTypes_NestedMessage) GetCorecursive() *TestAllTypes {
+	if x != nil {
+		return x.Corecursive
+	}
+	return nil
+}
+
+type TestAllTypes_OptionalGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A                     *int32                      `protobuf:"varint,17,opt,name=a" json:"a,omitempty"`
+	OptionalNestedMessage *TestAllTypes_NestedMessage `protobuf:"bytes,1000,opt,name=optional_nested_message,json=optionalNestedMessage" json:"optional_nested_message,omitempty"`
+}
+
+func (x *TestAllTypes_OptionalGroup) Reset() {
+	*x = TestAllTypes_OptionalGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test2_test_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestAllTypes_OptionalGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAllTypes_OptionalGroup) ProtoMessage() {}
+
+// Deprecated: Use TestAllTypes_OptionalGroup.ProtoReflect.Descriptor instead.
+func (*TestAllTypes_OptionalGroup) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2_test_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *TestAllTypes_OptionalGroup) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+func (x *TestAllTypes_OptionalGroup) GetOptionalNestedMessage() *TestAllTypes_NestedMessage {
+	if x != nil {
+		return x.OptionalNestedMessage
+	}
+	return nil
+}
+
+type TestAllTypes_RepeatedGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A *int32 `protobuf:"varint,47,opt,name=a" json:"a,omitempty"`
+}
+
+func (x *TestAllTypes_RepeatedGroup) Reset() {
+	*x = TestAllTypes_RepeatedGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test2_test_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestAllTypes_RepeatedGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAllTypes_RepeatedGroup) ProtoMessage() {}
+
+// Deprecated: Use TestAllTypes_RepeatedGroup.ProtoReflect.Descriptor instead.
+func (*TestAllTypes_RepeatedGroup) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2_test_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *TestAllTypes_RepeatedGroup) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+type TestAllTypes_OneofGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A *int32 `protobuf:"varint,1,opt,name=a" json:"a,omitempty"`
+	B *int32 `protobuf:"varint,2,opt,name=b" json:"b,omitempty"`
+}
+
+func (x *TestAllTypes_OneofGroup) Reset() {
+	*x = TestAllTypes_OneofGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test2_test_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestAllTypes_OneofGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAllTypes_OneofGroup) ProtoMessage() {}
+
+// Deprecated: Use TestAllTypes_OneofGroup.ProtoReflect.Descriptor instead.
+func (*TestAllTypes_OneofGroup) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2_test_proto_rawDescGZIP(), []int{0, 9}
+}
+
+func (x *TestAllTypes_OneofGroup) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+func (x *TestAllTypes_OneofGroup) GetB() int32 {
+	if x != nil && x.B != nil {
+		return *x.B
+	}
+	return 0
+}
+
+type TestRequiredGroupFields_OptionalGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A *int32 `protobuf:"varint,2,req,name=a" json:"a,omitempty"`
+}
+
+func (x *TestRequiredGroupFields_OptionalGroup) Reset() {
+	*x = TestRequiredGroupFields_OptionalGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test2_test_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestRequiredGroupFields_OptionalGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRequiredGroupFields_OptionalGroup) ProtoMessage() {}
+
+// Deprecated: Use TestRequiredGroupFields_OptionalGroup.ProtoReflect.Descriptor instead.
+func (*TestRequiredGroupFields_OptionalGroup) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2_test_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *TestRequiredGroupFields_OptionalGroup) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+type TestRequiredGroupFields_RepeatedGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A *int32 `protobuf:"varint,4,req,name=a" json:"a,omitempty"`
+}
+
+func (x *TestRequiredGroupFields_RepeatedGroup) Reset() {
+	*x = TestRequiredGroupFields_RepeatedGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test2_test_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestRequiredGroupFields_RepeatedGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRequiredGroupFields_RepeatedGroup) ProtoMessage() {}
+
+// Deprecated: Use TestRequiredGroupFields_RepeatedGroup.ProtoReflect.Descriptor instead.
+func (*TestRequiredGroupFields_RepeatedGroup) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2_test_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *TestRequiredGroupFields_RepeatedGroup) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+var file_internal_testprotos_test2_test_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*TestExtensionRange)(nil),
+		ExtensionType: (*int32)(nil),
+		Field:         100,
+		Name:          "goproto.proto.test2.ext_int32",
+		Tag:           "varint,100,opt,name=ext_int32",
+		Filename:      "internal/testprotos/test2/test.proto",
+	},
+	{
+		ExtendedType:  (*TestExtensionRange)(nil),
+		ExtensionType: ([]int32)(nil),
+		Field:         101,
+		Name:          "goproto.proto.test2.ext_packed",
+		Tag:           "varint,101,rep,packed,name=ext_packed",
+		Filename:      "internal/testprotos/test2/test.proto",
+	},
+	{
+		ExtendedType:  (*TestExtensionRange)(nil),
+		ExtensionType: ([]string)(nil),
+		Field:         102,
+		Name:          "goproto.proto.test2.ext_strings",
+		Tag:           "bytes,102,rep,name=ext_strings",
+		Filename:      "internal/testprotos/test2/test.proto",
+	},
+	{
+		ExtendedType:  (*TestExtensionRange)(nil),
+		ExtensionType: (*ForeignMessage)(nil),
+		Field:         103,
+		Name:          "goproto.proto.test2.ext_message",
+		Tag:           "bytes,103,opt,name=ext_message",
+		Filename:      "internal/testprotos/test2/test.proto",
+	},
+	{
+		ExtendedType:  (*TestExtensionRange)(nil),
+		ExtensionType: (*TestRequired)(nil),
+		Field:         104,
+		Name:          "goproto.proto.test2.ext_required",
+		Tag:           "bytes,104,opt,name=ext_required",
+		Filename:      "internal/testprotos/test2/test.proto",
+	},
+	{
+		ExtendedType:  (*TestExtensionRange)(nil),
+		ExtensionType: (*ForeignEnum)(nil),
+		Field:         105,
+		Name:          "goproto.proto.test2.ext_enum",
+		Tag:           "varint,105,opt,name=ext_enum,enum=goproto.proto.test2.ForeignEnum",
+		Filename:      "internal/testprotos/test2/test.proto",
+	},
+	{
+		ExtendedType:  (*TestExtensionRange)(nil),
+		ExtensionType: ([]*ForeignMessage)(nil),
+		Field:         106,
+		Name:          "goproto.proto.test2.ext_messages",
+		Tag:           "bytes,106,rep,name=ext_messages",
+		Filename:      "internal/testprotos/test2/test.proto",
+	},
+}
+
+// Extension fields to TestExtensionRange.
+var (
+	// optional int32 ext_int32 = 100;
+	E_ExtInt32 = &file_internal_testprotos_test2_test_proto_extTypes[0]
+	// repeated int32 ext_packed = 101;
+	E_ExtPacked = &file_internal_testprotos_test2_test_proto_extTypes[1]
+	// repeated string ext_strings = 102;
+	E_ExtStrings = &file_internal_testprotos_test2_test_proto_extTypes[2]
+	// optional goproto.proto.test2.ForeignMessage ext_message = 103;
+	E_ExtMessage = &file_internal_testprotos_test2_test_proto_extTypes[3]
+	// optional goproto.proto.test2.TestRequired ext_required = 104;
+	E_ExtRequired = &file_internal_testprotos_test2_test_proto_extTypes[4]
+	// optional goproto.proto.test2.ForeignEnum ext_enum = 105;
+	E_ExtEnum = &file_internal_testprotos_test2_test_proto_extTypes[5]
+	// repeated goproto.proto.test2.ForeignMessage ext_messages = 106;
+	E_ExtMessages = &file_internal_testprotos_test2_test_proto_extTypes[6]
+)
+
+var File_internal_testprotos_test2_test_proto protoreflect.FileDescriptor
+
+var file_internal_testprotos_test2_test_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x22, 0xbc, 0x2a, 0x0a, 0x0c,
+	0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x55, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x27, 0x0a, 0x0f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x11, 0x52, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x12, 0x52, 0x0e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x29,
+	0x0a, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x07, 0x52, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x06, 0x52, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0f, 0x52,
+	0x10, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x10, 0x52, 0x10, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x25,
+	0x0a, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x42,
+	0x6f, 0x6f, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x2f, 0x2e, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0d, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x67, 0x0a, 0x17, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x6f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x15, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x5d, 0x0a, 0x18, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x46, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x16, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x5e, 0x0a, 0x14, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x6e,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2c, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x12,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x6e,
+	0x75, 0x6d, 0x12, 0x54, 0x0a, 0x15, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x20, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45,
+	0x6e, 0x75, 0x6d, 0x52, 0x13, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x6f, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x0d, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x18, 0x20, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x21, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x18, 0x22, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x23, 0x20, 0x03, 0x28,
+	0x11, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x18, 0x24, 0x20, 0x03, 0x28, 0x12, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x25,
+	0x20, 0x03, 0x28, 0x07, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x26, 0x20, 0x03, 0x28, 0x06, 0x52,
+	0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
+	0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x27, 0x20, 0x03, 0x28, 0x0f, 0x52, 0x10, 0x72, 0x65, 0x70,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x2b, 0x0a,
+	0x11, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x18, 0x28, 0x20, 0x03, 0x28, 0x10, 0x52, 0x10, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x29, 0x20, 0x03,
+	0x28, 0x02, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x18, 0x2a, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x2b, 0x20, 0x03, 0x28,
+	0x08, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6c, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x18, 0x2c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x2d, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x0d, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x55, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x2e, 0x20, 0x03, 0x28, 0x0a, 0x32, 0x2f, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x67, 0x0a, 0x17, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x30, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x15, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x5d, 0x0a, 0x18, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x31, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x16, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x5e,
+	0x0a, 0x14, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x33, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x12, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x54,
+	0x0a, 0x15, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69,
+	0x67, 0x6e, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x34, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x20, 0x2e,
+	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x32, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x52,
+	0x13, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x45, 0x6e, 0x75, 0x6d, 0x12, 0x25, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x18, 0x35, 0x20, 0x03, 0x28, 0x05, 0x42, 0x02, 0x10, 0x01, 0x52, 0x0b,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x27, 0x0a, 0x0d, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x36, 0x20, 0x03,
+	0x28, 0x12, 0x42, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x53, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x12, 0x27, 0x0a, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x64,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x37, 0x20, 0x03, 0x28, 0x01, 0x42, 0x02, 0x10, 0x01, 0x52,
+	0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a,
+	0x0b, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x39, 0x20, 0x03,
+	0x28, 0x08, 0x42, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x6f,
+	0x6f, 0x6c, 0x12, 0x5c, 0x0a, 0x0f, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x38, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x6f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
+	0x61, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0d, 0x6d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x12, 0x62, 0x0a, 0x11, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x73,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x3d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x6f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
+	0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0f, 0x6d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x12, 0x62, 0x0a, 0x11, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x45, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x36, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x6d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x5f, 0x0a, 0x10, 0x6d, 0x61, 0x70, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x46, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x6d, 0x61, 0x70, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x78, 0x0a, 0x19, 0x6d, 0x61, 0x70,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x47, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x6d, 0x61, 0x70,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x6f, 0x0a, 0x16, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x49, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c,
+	0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x13, 0x6d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x45, 0x6e, 0x75, 0x6d, 0x12, 0x27, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x51, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x02, 0x38, 0x31, 0x52,
+	0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x27, 0x0a,
+	0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x52,
+	0x20, 0x01, 0x28, 0x03, 0x3a, 0x02, 0x38, 0x32, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x29, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x53, 0x20, 0x01, 0x28, 0x0d, 0x3a, 0x02,
+	0x38, 0x33, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x12, 0x29, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x75, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x18, 0x54, 0x20, 0x01, 0x28, 0x04, 0x3a, 0x02, 0x38, 0x34, 0x52, 0x0d, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x2a, 0x0a, 0x0e,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x55,
+	0x20, 0x01, 0x28, 0x11, 0x3a, 0x03, 0x2d, 0x38, 0x35, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x29, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x56, 0x20, 0x01, 0x28, 0x12,
+	0x3a, 0x02, 0x38, 0x36, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x12, 0x2b, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x57, 0x20, 0x01, 0x28, 0x07, 0x3a, 0x02, 0x38, 0x37,
+	0x52, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x12, 0x2b, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x18, 0x58, 0x20, 0x01, 0x28, 0x06, 0x3a, 0x02, 0x38, 0x38, 0x52, 0x0e, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x2d, 0x0a,
+	0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x18, 0x59, 0x20, 0x01, 0x28, 0x0f, 0x3a, 0x02, 0x38, 0x39, 0x52, 0x0f, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x2e, 0x0a, 0x10,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
+	0x18, 0x50, 0x20, 0x01, 0x28, 0x10, 0x3a, 0x03, 0x2d, 0x39, 0x30, 0x52, 0x0f, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x29, 0x0a, 0x0d,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x5b, 0x20,
+	0x01, 0x28, 0x02, 0x3a, 0x04, 0x39, 0x31, 0x2e, 0x35, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x5c, 0x20, 0x01, 0x28, 0x01, 0x3a,
+	0x05, 0x39, 0x32, 0x30, 0x30, 0x30, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x5d, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x04, 0x74, 0x72, 0x75,
+	0x65, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x2c,
+	0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x5e, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x0d, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x0d,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x5f, 0x20,
+	0x01, 0x28, 0x0c, 0x3a, 0x05, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18,
+	0x60, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45,
+	0x6e, 0x75, 0x6d, 0x3a, 0x03, 0x42, 0x41, 0x52, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x5f, 0x0a, 0x14, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x65,
+	0x6e, 0x75, 0x6d, 0x18, 0x61, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x67, 0x6f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e,
+	0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x3a, 0x0b, 0x46, 0x4f, 0x52,
+	0x45, 0x49, 0x47, 0x4e, 0x5f, 0x42, 0x41, 0x52, 0x52, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x23, 0x0a, 0x0c,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x6f, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x55, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x12, 0x63, 0x0a, 0x14, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x70, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x12, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x71, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0b, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x72, 0x20, 0x01, 0x28, 0x0c,
+	0x48, 0x00, 0x52, 0x0a, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0a, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x73, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x6f, 0x6f, 0x6c, 0x12,
+	0x23, 0x0a, 0x0c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18,
+	0x74, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x55, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x12, 0x21, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x6c,
+	0x6f, 0x61, 0x74, 0x18, 0x75, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0a, 0x6f, 0x6e, 0x65,
+	0x6f, 0x66, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x0c, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x76, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52,
+	0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x0a,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x77, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2c, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x48, 0x00,
+	0x52, 0x09, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x4e, 0x0a, 0x0a, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x79, 0x20, 0x01, 0x28, 0x0a, 0x32,
+	0x2c, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52,
+	0x0a, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x34, 0x0a, 0x15, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x18, 0x78, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x13, 0x6f, 0x6e,
+	0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x55, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x12, 0x38, 0x0a, 0x14, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x7a, 0x20, 0x01, 0x28, 0x11, 0x3a,
+	0x04, 0x2d, 0x31, 0x32, 0x32, 0x48, 0x02, 0x52, 0x12, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x39, 0x0a, 0x14, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x18, 0x7b, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x48, 0x02, 0x52, 0x12, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x62, 0x0a, 0x0d, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x01, 0x61, 0x12, 0x43, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x75, 0x72,
+	0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x0b, 0x63,
+	0x6f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x1a, 0x87, 0x01, 0x0a, 0x0d, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0c, 0x0a, 0x01,
+	0x61, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x61, 0x12, 0x68, 0x0a, 0x17, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x15, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x1d, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x01, 0x61, 0x1a, 0x40, 0x0a, 0x12, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x12, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x12, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x4d, 0x61, 0x70,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a,
+	0x13, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x7a, 0x0a, 0x1b, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x45, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x74, 0x0a, 0x18,
+	0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45,
+	0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x67, 0x6f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x28, 0x0a, 0x0a, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x61, 0x12, 0x0c,
+	0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x62, 0x22, 0x39, 0x0a, 0x0a,
+	0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x4f,
+	0x4f, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x41, 0x52, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03,
+	0x42, 0x41, 0x5a, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x03, 0x4e, 0x45, 0x47, 0x10, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x10, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x2c, 0x0a, 0x0e, 0x46, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0c, 0x0a, 0x01,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x63, 0x12, 0x0c, 0x0a, 0x01, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x64, 0x22, 0x32, 0x0a, 0x12, 0x54, 0x65, 0x73, 0x74,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x2a, 0x08, 0x08, 0x64, 0x10, 0x80, 0x80, 0x80, 0x80, 0x02, 0x22, 0x5c, 0x0a, 0x0c,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xc7, 0x03, 0x0a, 0x13, 0x54,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x65, 0x69,
+	0x67, 0x6e, 0x12, 0x4c, 0x0a, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52,
+	0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x4c, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x0f, 0x72,
+	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x59,
+	0x0a, 0x0b, 0x6d, 0x61, 0x70, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x4d, 0x61,
+	0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6d,
+	0x61, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x6f, 0x6e, 0x65,
+	0x6f, 0x66, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x60, 0x0a, 0x0f, 0x4d, 0x61, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x22, 0x9b, 0x02, 0x0a, 0x17, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x12, 0x60, 0x0a, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x3a, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x60, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0a, 0x32, 0x3a, 0x2e, 0x67, 0x6f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x1a, 0x1d, 0x0a, 0x0d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05,
+	0x52, 0x01, 0x61, 0x1a, 0x1d, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x04, 0x20, 0x02, 0x28, 0x05, 0x52,
+	0x01, 0x61, 0x2a, 0x40, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x6e, 0x75,
+	0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x45, 0x49, 0x47, 0x4e, 0x5f, 0x46, 0x4f, 0x4f,
+	0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x45, 0x49, 0x47, 0x4e, 0x5f, 0x42, 0x41,
+	0x52, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x45, 0x49, 0x47, 0x4e, 0x5f, 0x42,
+	0x41, 0x5a, 0x10, 0x06, 0x3a, 0x44, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x12, 0x27, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x3a, 0x4a, 0x0a, 0x0a, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x27, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x65, 0x20, 0x03, 0x28, 0x05, 0x42, 0x02, 0x10, 0x01, 0x52, 0x09, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x3a, 0x48, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x27, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x66,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+	0x3a, 0x6d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x27, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x32, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a,
+	0x6d, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12,
+	0x27, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x3a, 0x64,
+	0x0a, 0x08, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x27, 0x2e, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x67, 0x6f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e,
+	0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x07, 0x65, 0x78, 0x74,
+	0x45, 0x6e, 0x75, 0x6d, 0x3a, 0x6f, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x6a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67,
+	0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x32,
+}
+
+var (
+	file_internal_testprotos_test2_test_proto_rawDescOnce sync.Once
+	file_internal_testprotos_test2_test_proto_rawDescData = file_internal_testprotos_test2_test_proto_rawDesc
+)
+
+func file_internal_testprotos_test2_test_proto_rawDescGZIP() []byte {
+	file_internal_testprotos_test2_test_proto_rawDescOnce.Do(func() {
+		file_internal_testprotos_test2_test_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_testprotos_test2_test_proto_rawDescData)
+	})
+	return file_internal_testprotos_test2_test_proto_rawDescData
+}
+
+var file_internal_testprotos_test2_test_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_internal_testprotos_test2_test_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_internal_testprotos_test2_test_proto_goTypes = []interface{}{
+	(ForeignEnum)(0),                   // 0: goproto.proto.test2.ForeignEnum
+	(TestAllTypes_NestedEnum)(0),       // 1: goproto.proto.test2.TestAllTypes.NestedEnum
+	(*TestAllTypes)(nil),               // 2: goproto.proto.test2.TestAllTypes
+	(*ForeignMessage)(nil),             // 3: goproto.proto.test2.ForeignMessage
+	(*TestExtensionRange)(nil),         // 4: goproto.proto.test2.TestExtensionRange
+	(*TestRequired)(nil),               // 5: goproto.proto.test2.TestRequired
+	(*TestRequiredForeign)(nil),        // 6: goproto.proto.test2.TestRequiredForeign
+	(*TestRequiredGroupFields)(nil),    // 7: goproto.proto.test2.TestRequiredGroupFields
+	(*TestAllTypes_NestedMessage)(nil), // 8: goproto.proto.test2.TestAllTypes.NestedMessage
+	(*TestAllTypes_OptionalGroup)(nil), // 9: goproto.proto.test2.TestAllTypes.OptionalGroup
+	(*TestAllTypes_RepeatedGroup)(nil), // 10: goproto.proto.test2.TestAllTypes.RepeatedGroup
+	nil,                                // 11: goproto.proto.test2.TestAllTypes.MapInt32Int32Entry
+	nil,                                // 12: goproto.proto.test2.TestAllTypes.MapSint64Sint64Entry
+	nil,                                // 13: goproto.proto.test2.TestAllTypes.MapStringStringEntry
+	nil,                                // 14: goproto.proto.test2.TestAllTypes.MapStringBytesEntry
+	nil,                                // 15: goproto.proto.test2.TestAllTypes.MapStringNestedMessageEntry
+	nil,                                // 16: goproto.proto.test2.TestAllTypes.MapStringNestedEnumEntry
+	(*TestAllTypes_OneofGroup)(nil),    // 17: goproto.proto.test2.TestAllTypes.OneofGroup
+	nil,                                // 18: goproto.proto.test2.TestRequiredForeign.MapMessageEntry
+	(*TestRequiredGroupFields_OptionalGroup)(nil), // 19: goproto.proto.test2.TestRequiredGroupFields.OptionalGroup
+	(*TestRequiredGroupFields_RepeatedGroup)(nil), // 20: goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup
+}
+var file_internal_testprotos_test2_test_proto_depIdxs = []int32{
+	9,  // 0: goproto.proto.test2.TestAllTypes.optionalgroup:type_name -> goproto.proto.test2.TestAllTypes.OptionalGroup
+	8,  // 1: goproto.proto.test2.TestAllTypes.optional_nested_message:type_name -> goproto.proto.test2.TestAllTypes.NestedMessage
+	3,  // 2: goproto.proto.test2.TestAllTypes.optional_foreign_message:type_name -> goproto.proto.test2.ForeignMessage
+	1,  // 3: goproto.proto.test2.TestAllTypes.optional_nested_enum:type_name -> goproto.proto.test2.TestAllTypes.NestedEnum
+	0,  // 4: goproto.proto.test2.TestAllTypes.optional_foreign_enum:type_name -> goproto.proto.test2.ForeignEnum
+	10, // 5: goproto.proto.test2.TestAllTypes.repeatedgroup:type_name -> goproto.proto.test2.TestAllTypes.RepeatedGroup
+	8,  // 6: goproto.proto.test2.TestAllTypes.repeated_nested_message:type_name -> goproto.proto.test2.TestAllTypes.NestedMessage
+	3,  // 7: goproto.proto.test2.TestAllTypes.repeated_foreign_message:type_name -> goproto.proto.test2.ForeignMessage
+	1,  // 8: goproto.proto.test2.TestAllTypes.repeated_nested_enum:type_name -> goproto.proto.test2.TestAllTypes.NestedEnum
+	0,  // 9: goproto.proto.test2.TestAllTypes.repeated_foreign_enum:type_name -> goproto.proto.test2.ForeignEnum
+	11, // 10: goproto.proto.test2.TestAllTypes.map_int32_int32:type_name -> goproto.proto.test2.TestAllTypes.MapInt32Int32Entry
+	12, // 11: goproto.proto.test2.TestAllTypes.map_sint64_sint64:type_name -> goproto.proto.test2.TestAllTypes.MapSint64Sint64Entry
+	13, // 12: goproto.proto.test2.TestAllTypes.map_string_string:type_name -> goproto.proto.test2.TestAllTypes.MapStringStringEntry
+	14, // 13: goproto.proto.test2.TestAllTypes.map_string_bytes:type_name -> goproto.proto.test2.TestAllTypes.MapStringBytesEntry
+	15, // 14: goproto.proto.test2.TestAllTypes.map_string_nested_message:type_name -> goproto.proto.test2.TestAllTypes.MapStringNestedMessageEntry
+	16, // 15: goproto.proto.test2.TestAllTypes.map_string_nested_enum:type_name -> goproto.proto.test2.TestAllTypes.MapStringNestedEnumEntry
+	1,  // 16: goproto.proto.test2.TestAllTypes.default_nested_enum:type_name -> goproto.proto.test2.TestAllTypes.NestedEnum
+	0,  // 17: goproto.proto.test2.TestAllTypes.default_foreign_enum:type_name -> goproto.proto.test2.ForeignEnum
+	8,  // 18: goproto.proto.test2.TestAllTypes.oneof_nested_message:type_name -> goproto.proto.test2.TestAllTypes.NestedMessage
+	1,  // 19: goproto.proto.test2.TestAllTypes.oneof_enum:type_name -> goproto.proto.test2.TestAllTypes.NestedEnum
+	17, // 20: goproto.proto.test2.TestAllTypes.oneofgroup:type_name -> goproto.proto.test2.TestAllTypes.OneofGroup
+	5,  // 21: goproto.proto.test2.TestRequiredForeign.optional_message:type_name -> goproto.proto.test2.TestRequired
+	5,  // 22: goproto.proto.test2.TestRequiredForeign.repeated_message:type_name -> goproto.proto.test2.TestRequired
+	18, // 23: goproto.proto.test2.TestRequiredForeign.map_message:type_name -> goproto.proto.test2.TestRequiredForeign.MapMessageEntry
+	5,  // 24: goproto.proto.test2.TestRequiredForeign.oneof_message:type_name -> goproto.proto.test2.TestRequired
+	19, // 25: goproto.proto.test2.TestRequiredGroupFields.optionalgroup:type_name -> goproto.proto.test2.TestRequiredGroupFields.OptionalGroup
+	20, // 26: goproto.proto.test2.TestRequiredGroupFields.repeatedgroup:type_name -> goproto.proto.test2.TestRequiredGroupFields.RepeatedGroup
+	2,  // 27: goproto.proto.test2.TestAllTypes.NestedMessage.corecursive:type_name -> goproto.proto.test2.TestAllTypes
+	8,  // 28: goproto.proto.test2.TestAllTypes.OptionalGroup.optional_nested_message:type_name -> goproto.proto.test2.TestAllTypes.NestedMessage
+	8,  // 29: goproto.proto.test2.TestAllTypes.MapStringNestedMessageEntry.value:type_name -> goproto.proto.test2.TestAllTypes.NestedMessage
+	1,  // 30: goproto.proto.test2.TestAllTypes.MapStringNestedEnumEntry.value:type_name -> goproto.proto.test2.TestAllTypes.NestedEnum
+	5,  // 31: goproto.proto.test2.TestRequiredForeign.MapMessageEntry.value:type_name -> goproto.proto.test2.TestRequired
+	4,  // 32: goproto.proto.test2.ext_int32:extendee -> goproto.proto.test2.TestExtensionRange
+	4,  // 33: goproto.proto.test2.ext_packed:extendee -> goproto.proto.test2.TestExtensionRange
+	4,  // 34: goproto.proto.test2.ext_strings:extendee -> goproto.proto.test2.TestExtensionRange
+	4,  // 35: goproto.proto.test2.ext_message:extendee -> goproto.proto.test2.TestExtensionRange
+	4,  // 36: goproto.proto.test2.ext_required:extendee -> goproto.proto.test2.TestExtensionRange
+	4,  // 37: goproto.proto.test2.ext_enum:extendee -> goproto.proto.test2.TestExtensionRange
+	4,  // 38: goproto.proto.test2.ext_messages:extendee -> goproto.proto.test2.TestExtensionRange
+	3,  // 39: goproto.proto.test2.ext_message:type_name -> goproto.proto.test2.ForeignMessage
+	5,  // 40: goproto.proto.test2.ext_required:type_name -> goproto.proto.test2.TestRequired
+	0,  // 41: goproto.proto.test2.ext_enum:type_name -> goproto.proto.test2.ForeignEnum
+	3,  // 42: goproto.proto.test2.ext_messages:type_name -> goproto.proto.test2.ForeignMessage
+	43, // [43:43] is the sub-list for method output_type
+	43, // [43:43] is the sub-list for method input_type
+	39, // [39:43] is the sub-list for extension type_name
+	32, // [32:39] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
+}
+
+func init() { file_internal_testprotos_test2_test_proto_init() }
+func file_internal_testprotos_test2_test_proto_init() {
+	if File_internal_testprotos_test2_test_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_testprotos_test2_test_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestAllTypes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.zeroCopied
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_test2_test_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForeignMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_test2_test_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestExtensionRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.extensionFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_test2_test_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestRequired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_test2_test_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestRequiredForeign); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_test2_test_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestRequiredGroupFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_test2_test_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestAllTypes_NestedMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_test2_test_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestAllTypes_OptionalGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_test2_test_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestAllTypes_RepeatedGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_test2_test_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestAllTypes_OneofGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_test2_test_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestRequiredGroupFields_OptionalGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_test2_test_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestRequiredGroupFields_RepeatedGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_internal_testprotos_test2_test_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*TestAllTypes_OneofUint32)(nil),
+		(*TestAllTypes_OneofNestedMessage)(nil),
+		(*TestAllTypes_OneofString)(nil),
+		(*TestAllTypes_OneofBytes)(nil),
+		(*TestAllTypes_OneofBool)(nil),
+		(*TestAllTypes_OneofUint64)(nil),
+		(*TestAllTypes_OneofFloat)(nil),
+		(*TestAllTypes_OneofDouble)(nil),
+		(*TestAllTypes_OneofEnum)(nil),
+		(*TestAllTypes_Oneofgroup)(nil),
+		(*TestAllTypes_OneofOptionalUint32)(nil),
+		(*TestAllTypes_OneofDefaultSint32)(nil),
+		(*TestAllTypes_OneofDefaultString)(nil),
+	}
+	file_internal_testprotos_test2_test_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*TestRequiredForeign_OneofMessage)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_internal_testprotos_test2_test_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   19,
+			NumExtensions: 7,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_testprotos_test2_test_proto_goTypes,
+		DependencyIndexes: file_internal_testprotos_test2_test_proto_depIdxs,
+		EnumInfos:         file_internal_testprotos_test2_test_proto_enumTypes,
+		MessageInfos:      file_internal_testprotos_test2_test_proto_msgTypes,
+		ExtensionInfos:    file_internal_testprotos_test2_test_proto_extTypes,
+	}.Build()
+	File_internal_testprotos_test2_test_proto = out.File
+	file_internal_testprotos_test2_test_proto_rawDesc = nil
+	file_internal_testprotos_test2_test_proto_goTypes = nil
+	file_internal_testprotos_test2_test_proto_depIdxs = nil
+}
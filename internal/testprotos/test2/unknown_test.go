@@ -0,0 +1,93 @@
+package test2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// withUnknownNested returns the encoding of a TestAllTypes whose nested message holds
+// an unknown varint field numbered num.
+func withUnknownNested(t *testing.T, num protowire.Number) []byte {
+	nested, err := proto.Marshal(&TestAllTypes_NestedMessage{A: proto.Int32(1)})
+	require.NoError(t, err)
+	nested = protowire.AppendTag(nested, num, protowire.VarintType)
+	nested = protowire.AppendVarint(nested, 1)
+	b := protowire.AppendTag(nil, 18, protowire.BytesType)
+	return protowire.AppendBytes(b, nested)
+}
+
+func TestRejectUnknown(t *testing.T) {
+	strict := runtime.RejectUnknown(proto.UnmarshalOptions{}, false)
+	nonCritical := runtime.RejectUnknown(proto.UnmarshalOptions{}, true)
+
+	b := withUnknownNested(t, 5)
+	require.NoError(t, proto.Unmarshal(b, &TestAllTypes{}))
+	err := strict.Unmarshal(b, &TestAllTypes{})
+	var unknown *runtime.UnknownFieldError
+	require.True(t, errors.As(err, &unknown), "%v", err)
+	require.Equal(t, protoreflect.FullName("goproto.proto.test2.TestAllTypes.NestedMessage"), unknown.Message)
+	require.Equal(t, protoreflect.FieldNumber(5), unknown.Number)
+	require.ErrorIs(t, err, proto.Error)
+	require.Error(t, nonCritical.Unmarshal(b, &TestAllTypes{}))
+
+	// fields with their bit 11 set are non-critical
+	b = withUnknownNested(t, 1025)
+	require.Error(t, strict.Unmarshal(b, &TestAllTypes{}))
+	m := &TestAllTypes{}
+	require.NoError(t, nonCritical.Unmarshal(b, m))
+	require.NotEmpty(t, m.OptionalNestedMessage.ProtoReflect().GetUnknown())
+	m = &TestAllTypes{}
+	nonCritical.DiscardUnknown = true
+	require.NoError(t, nonCritical.Unmarshal(b, m))
+	require.Empty(t, m.OptionalNestedMessage.ProtoReflect().GetUnknown())
+
+	// the options hold with zero-copy unmarshal
+	require.Error(t, runtime.ZeroCopy(strict).Unmarshal(withUnknownNested(t, 5), &TestAllTypes{}))
+	require.True(t, runtime.IsZeroCopy(runtime.ZeroCopy(strict)))
+}
+
+func TestRejectUnknownExtensions(t *testing.T) {
+	strict := runtime.RejectUnknown(proto.UnmarshalOptions{}, false)
+	nonCritical := runtime.RejectUnknown(proto.UnmarshalOptions{}, true)
+
+	// known extensions are accepted
+	b, err := proto.Marshal(newExtendedMessage())
+	require.NoError(t, err)
+	require.NoError(t, strict.Unmarshal(b, &TestExtensionRange{}))
+
+	// unknown fields in extension ranges are critical, unless their bit 11 is set
+	unknown := protowire.AppendTag(b, 200, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 1)
+	require.Error(t, strict.Unmarshal(unknown, &TestExtensionRange{}))
+	require.Error(t, nonCritical.Unmarshal(unknown, &TestExtensionRange{}))
+	unknown = protowire.AppendTag(b, 1025, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 1)
+	require.NoError(t, nonCritical.Unmarshal(unknown, &TestExtensionRange{}))
+}
+
+func TestRejectUnknownInput(t *testing.T) {
+	strict := runtime.RejectUnknown(proto.UnmarshalOptions{}, false)
+	for name, tc := range map[string]struct {
+		input   protoiface.UnmarshalInput
+		wantErr bool
+	}{
+		"none": {},
+		// the mode is carried by the resolver, not by flags owned by protobuf-go
+		"resolver":  {input: protoiface.UnmarshalInput{Resolver: strict.Resolver}, wantErr: true},
+		"high bits": {input: protoiface.UnmarshalInput{Flags: 1<<7 | 1<<6}},
+	} {
+		m := &TestAllTypes{}
+		methods := m.ProtoReflect().ProtoMethods()
+		tc.input.Message = m.ProtoReflect()
+		tc.input.Buf = withUnknownNested(t, 5)
+		_, err := methods.Unmarshal(tc.input)
+		require.Equal(t, tc.wantErr, err != nil, "%s: %v", name, err)
+	}
+}
@@ -0,0 +1,11 @@
+package test2023
+
+import (
+	"google.golang.org/protobuf/testing/prototest"
+	"testing"
+)
+
+func TestCompliance(t *testing.T) {
+	prototest.Message{}.Test(t, (&TestAllTypes{}).ProtoReflect().Type())
+	prototest.Message{}.Test(t, (&TestRequired{}).ProtoReflect().Type())
+}
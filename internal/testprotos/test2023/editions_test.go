@@ -0,0 +1,207 @@
+package test2023
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestPresence(t *testing.T) {
+	msg := &TestAllTypes{}
+	m := msg.ProtoReflect()
+
+	// explicit presence is the default, so zero values are populated once set
+	m.Set(fd_TestAllTypes_optional_int32, protoreflect.ValueOfInt32(0))
+	m.Set(fd_TestAllTypes_optional_string, protoreflect.ValueOfString(""))
+	m.Set(fd_TestAllTypes_optional_closed_enum, protoreflect.ValueOfEnum(0))
+	require.True(t, m.Has(fd_TestAllTypes_optional_int32))
+	require.True(t, m.Has(fd_TestAllTypes_optional_string))
+	require.True(t, m.Has(fd_TestAllTypes_optional_closed_enum))
+
+	// implicit presence fields are not populated by zero values
+	m.Set(fd_TestAllTypes_implicit_int32, protoreflect.ValueOfInt32(0))
+	m.Set(fd_TestAllTypes_implicit_string, protoreflect.ValueOfString(""))
+	require.False(t, m.Has(fd_TestAllTypes_implicit_int32))
+	require.False(t, m.Has(fd_TestAllTypes_implicit_string))
+
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	got := &TestAllTypes{}
+	require.NoError(t, proto.Unmarshal(bz, got))
+	require.True(t, proto.Equal(msg, got))
+	require.NotNil(t, got.OptionalInt32)
+	require.NotNil(t, got.OptionalString)
+	require.NotNil(t, got.OptionalClosedEnum)
+
+	m.Clear(fd_TestAllTypes_default_closed_enum)
+	require.Equal(t, protoreflect.EnumNumber(ClosedEnum_CLOSED_BAR), m.Get(fd_TestAllTypes_default_closed_enum).Enum())
+	require.Equal(t, "hello", m.Get(fd_TestAllTypes_default_string).String())
+}
+
+func TestRepeatedFieldEncoding(t *testing.T) {
+	bz, err := proto.Marshal(&TestAllTypes{RepeatedInt32: []int32{1, 2, 3}})
+	require.NoError(t, err)
+	num, typ, _ := protowire.ConsumeTag(bz)
+	require.Equal(t, protowire.Number(31), num)
+	require.Equal(t, protowire.BytesType, typ)
+
+	bz, err = proto.Marshal(&TestAllTypes{ExpandedInt32: []int32{1, 2, 3}})
+	require.NoError(t, err)
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.Equal(t, protowire.Number(53), num)
+		require.Equal(t, protowire.VarintType, typ)
+		n += protowire.ConsumeFieldValue(num, typ, bz[n:])
+		bz = bz[n:]
+	}
+
+	// both encodings are accepted when decoding
+	var packed []byte
+	packed = protowire.AppendTag(packed, 53, protowire.BytesType)
+	packed = protowire.AppendBytes(packed, []byte{1, 2, 3})
+	packed = protowire.AppendTag(packed, 31, protowire.VarintType)
+	packed = protowire.AppendVarint(packed, 4)
+	got := &TestAllTypes{}
+	require.NoError(t, proto.Unmarshal(packed, got))
+	require.Equal(t, []int32{1, 2, 3}, got.ExpandedInt32)
+	require.Equal(t, []int32{4}, got.RepeatedInt32)
+}
+
+func TestUTF8Validation(t *testing.T) {
+	invalid := string([]byte{0xff, 0xfe})
+
+	for _, msg := range []*TestAllTypes{
+		{OptionalString: proto.String(invalid)},
+		{ImplicitString: invalid},
+		{RepeatedString: []string{"ok", invalid}},
+		{MapStringString: map[string]string{invalid: ""}},
+		{MapStringString: map[string]string{"": invalid}},
+		{OneofField: &TestAllTypes_OneofString{OneofString: invalid}},
+	} {
+		_, err := proto.Marshal(msg)
+		require.ErrorIs(t, err, runtime.ErrInvalidUTF8)
+	}
+
+	entry := func(key, value string) []byte {
+		var bz []byte
+		bz = protowire.AppendTag(bz, 1, protowire.BytesType)
+		bz = protowire.AppendString(bz, key)
+		bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+		return protowire.AppendString(bz, value)
+	}
+	for _, field := range []struct {
+		num   protowire.Number
+		value []byte
+	}{
+		{14, []byte(invalid)},
+		{24, []byte(invalid)},
+		{44, []byte(invalid)},
+		{69, entry(invalid, "")},
+		{69, entry("", invalid)},
+		{113, []byte(invalid)},
+	} {
+		bz := protowire.AppendTag(nil, field.num, protowire.BytesType)
+		bz = protowire.AppendBytes(bz, field.value)
+		require.ErrorIs(t, proto.Unmarshal(bz, &TestAllTypes{}), runtime.ErrInvalidUTF8, field.num)
+	}
+
+	// fields which disable validation accept any bytes
+	msg := &TestAllTypes{
+		UnverifiedString:         proto.String(invalid),
+		UnverifiedRepeatedString: []string{invalid},
+		UnverifiedMap:            map[string]string{invalid: invalid},
+	}
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	got := &TestAllTypes{}
+	require.NoError(t, proto.Unmarshal(bz, got))
+	require.True(t, proto.Equal(msg, got))
+}
+
+func TestClosedEnumUnknownValues(t *testing.T) {
+	var unknown []byte
+	unknown = protowire.AppendTag(unknown, 22, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 7)
+
+	var entry []byte
+	entry = protowire.AppendTag(entry, 1, protowire.BytesType)
+	entry = protowire.AppendString(entry, "key")
+	entry = protowire.AppendTag(entry, 2, protowire.VarintType)
+	entry = protowire.AppendVarint(entry, 3)
+	unknownEntry := protowire.AppendTag(nil, 74, protowire.BytesType)
+	unknownEntry = protowire.AppendBytes(unknownEntry, entry)
+
+	var bz []byte
+	bz = append(bz, unknown...)
+	bz = protowire.AppendTag(bz, 52, protowire.BytesType)
+	bz = protowire.AppendBytes(bz, []byte{4, 8, 5})
+	bz = append(bz, unknownEntry...)
+	bz = protowire.AppendTag(bz, 115, protowire.VarintType)
+	bz = protowire.AppendVarint(bz, 10)
+
+	msg := &TestAllTypes{}
+	require.NoError(t, proto.Unmarshal(bz, msg))
+
+	// unknown values of closed enums are kept as unknown fields
+	require.Nil(t, msg.OptionalClosedEnum)
+	require.Equal(t, []ClosedEnum{ClosedEnum_CLOSED_FOO, ClosedEnum_CLOSED_BAR}, msg.RepeatedClosedEnum)
+	require.Empty(t, msg.MapStringClosedEnum)
+	require.Equal(t, ClosedEnum_CLOSED_MAX, msg.GetOneofClosedEnum())
+
+	var wantUnknown []byte
+	wantUnknown = append(wantUnknown, unknown...)
+	wantUnknown = protowire.AppendTag(wantUnknown, 52, protowire.VarintType)
+	wantUnknown = protowire.AppendVarint(wantUnknown, 8)
+	wantUnknown = append(wantUnknown, unknownEntry...)
+	require.Equal(t, wantUnknown, []byte(msg.ProtoReflect().GetUnknown()))
+
+	// unknown values of open enums are set on the field
+	var open []byte
+	open = protowire.AppendTag(open, 21, protowire.VarintType)
+	open = protowire.AppendVarint(open, 7)
+	msg = &TestAllTypes{}
+	require.NoError(t, proto.Unmarshal(open, msg))
+	require.Equal(t, TestAllTypes_NestedEnum(7), msg.GetOptionalNestedEnum())
+	require.Empty(t, msg.ProtoReflect().GetUnknown())
+
+	msg = &TestAllTypes{}
+	require.NoError(t, proto.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(bz, msg))
+	require.Empty(t, msg.ProtoReflect().GetUnknown())
+}
+
+func TestDelimitedMessages(t *testing.T) {
+	msg := &TestAllTypes{
+		DelimitedMessage:         &TestAllTypes_NestedMessage{A: proto.Int32(1)},
+		RepeatedDelimitedMessage: []*TestAllTypes_NestedMessage{{A: proto.Int32(2)}, {}},
+		OneofField:               &TestAllTypes_OneofDelimited{OneofDelimited: &TestAllTypes_NestedMessage{}},
+	}
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+
+	// delimited messages are encoded as groups
+	for src := bz; len(src) > 0; {
+		num, typ, n := protowire.ConsumeTag(src)
+		require.Equal(t, protowire.StartGroupType, typ, num)
+		n += protowire.ConsumeFieldValue(num, typ, src[n:])
+		src = src[n:]
+	}
+
+	got := &TestAllTypes{}
+	require.NoError(t, proto.Unmarshal(bz, got))
+	require.True(t, proto.Equal(msg, got))
+}
+
+func TestRequiredFields(t *testing.T) {
+	bz, err := proto.MarshalOptions{AllowPartial: true}.Marshal(&TestRequired{OptionalField: proto.String("x")})
+	require.NoError(t, err)
+	require.Error(t, proto.Unmarshal(bz, &TestRequired{}))
+	require.NoError(t, proto.UnmarshalOptions{AllowPartial: true}.Unmarshal(bz, &TestRequired{}))
+
+	bz, err = proto.Marshal(&TestRequired{RequiredField: proto.Int32(0)})
+	require.NoError(t, err)
+	require.NoError(t, proto.Unmarshal(bz, &TestRequired{}))
+}
@@ -0,0 +1,14977 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package test2023
+
+import (
+	bytes "bytes"
+	binary "encoding/binary"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	maps "maps"
+	math "math"
+	reflect "reflect"
+	slices "slices"
+	sort "sort"
+	strconv "strconv"
+	sync "sync"
+	atomic "sync/atomic"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
+)
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestAllTypes) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestAllTypes) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.DefaultClosedEnum != nil {
+		v := *x.DefaultClosedEnum
+		b = append(b, "\"default_closed_enum\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.DefaultInt32 != nil {
+		v := *x.DefaultInt32
+		b = append(b, "\"default_int32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.DefaultString != nil {
+		v := *x.DefaultString
+		b = append(b, "\"default_string\":"...)
+		b = runtime.AppendAminoJSONString(b, v)
+		b = append(b, ',')
+	}
+	if x.DelimitedMessage != nil {
+		b = append(b, "\"delimited_message\":"...)
+		if b, err = x.DelimitedMessage.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.ExpandedClosedEnum) > 0 {
+		b = append(b, "\"expanded_closed_enum\":"...)
+		b = append(b, '[')
+		for _, v := range x.ExpandedClosedEnum {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.ExpandedDouble) > 0 {
+		b = append(b, "\"expanded_double\":"...)
+		b = append(b, '[')
+		for _, v := range x.ExpandedDouble {
+			if b, err = runtime.AppendAminoJSONFloat(b, v, 64); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.ExpandedInt32) > 0 {
+		b = append(b, "\"expanded_int32\":"...)
+		b = append(b, '[')
+		for _, v := range x.ExpandedInt32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.ImplicitBytes) > 0 {
+		b = append(b, "\"implicit_bytes\":"...)
+		b = runtime.AppendJSONBytes(b, x.ImplicitBytes)
+		b = append(b, ',')
+	}
+	if x.ImplicitEnum != 0 {
+		b = append(b, "\"implicit_enum\":"...)
+		b = strconv.AppendInt(b, int64(x.ImplicitEnum), 10)
+		b = append(b, ',')
+	}
+	if x.ImplicitInt32 != 0 {
+		b = append(b, "\"implicit_int32\":"...)
+		b = strconv.AppendInt(b, int64(x.ImplicitInt32), 10)
+		b = append(b, ',')
+	}
+	if x.ImplicitString != "" {
+		b = append(b, "\"implicit_string\":"...)
+		b = runtime.AppendAminoJSONString(b, x.ImplicitString)
+		b = append(b, ',')
+	}
+	if len(x.MapInt32Int32) > 0 {
+		b = append(b, "\"map_int32_int32\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.MapInt32Int32) {
+			v := x.MapInt32Int32[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringClosedEnum) > 0 {
+		b = append(b, "\"map_string_closed_enum\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringClosedEnum)) {
+			v := x.MapStringClosedEnum[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringNestedEnum) > 0 {
+		b = append(b, "\"map_string_nested_enum\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringNestedEnum)) {
+			v := x.MapStringNestedEnum[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringNestedMessage) > 0 {
+		b = append(b, "\"map_string_nested_message\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringNestedMessage)) {
+			v := x.MapStringNestedMessage[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringString) > 0 {
+		b = append(b, "\"map_string_string\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringString)) {
+			v := x.MapStringString[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			b = runtime.AppendAminoJSONString(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofBytes); ok {
+		b = append(b, "\"oneof_bytes\":"...)
+		b = runtime.AppendJSONBytes(b, v.OneofBytes)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofClosedEnum); ok {
+		b = append(b, "\"oneof_closed_enum\":"...)
+		b = strconv.AppendInt(b, int64(v.OneofClosedEnum), 10)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofDelimited); ok {
+		b = append(b, "\"oneof_delimited\":"...)
+		if b, err = v.OneofDelimited.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); ok {
+		b = append(b, "\"oneof_nested_message\":"...)
+		if b, err = v.OneofNestedMessage.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofString); ok {
+		b = append(b, "\"oneof_string\":"...)
+		b = runtime.AppendAminoJSONString(b, v.OneofString)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofUint32); ok {
+		b = append(b, "\"oneof_uint32\":"...)
+		b = strconv.AppendUint(b, uint64(v.OneofUint32), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalBool != nil {
+		v := *x.OptionalBool
+		b = append(b, "\"optional_bool\":"...)
+		b = strconv.AppendBool(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalBytes != nil {
+		b = append(b, "\"optional_bytes\":"...)
+		b = runtime.AppendJSONBytes(b, x.OptionalBytes)
+		b = append(b, ',')
+	}
+	if x.OptionalClosedEnum != nil {
+		v := *x.OptionalClosedEnum
+		b = append(b, "\"optional_closed_enum\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalDouble != nil {
+		v := *x.OptionalDouble
+		b = append(b, "\"optional_double\":"...)
+		if b, err = runtime.AppendAminoJSONFloat(b, v, 64); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalFixed32 != nil {
+		v := *x.OptionalFixed32
+		b = append(b, "\"optional_fixed32\":"...)
+		b = strconv.AppendUint(b, uint64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalFixed64 != nil {
+		v := *x.OptionalFixed64
+		b = append(b, "\"optional_fixed64\":"...)
+		b = runtime.AppendJSONUint64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalFloat != nil {
+		v := *x.OptionalFloat
+		b = append(b, "\"optional_float\":"...)
+		if b, err = runtime.AppendAminoJSONFloat(b, float64(v), 32); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalInt32 != nil {
+		v := *x.OptionalInt32
+		b = append(b, "\"optional_int32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalInt64 != nil {
+		v := *x.OptionalInt64
+		b = append(b, "\"optional_int64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalNestedEnum != nil {
+		v := *x.OptionalNestedEnum
+		b = append(b, "\"optional_nested_enum\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalNestedMessage != nil {
+		b = append(b, "\"optional_nested_message\":"...)
+		if b, err = x.OptionalNestedMessage.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalSfixed32 != nil {
+		v := *x.OptionalSfixed32
+		b = append(b, "\"optional_sfixed32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalSfixed64 != nil {
+		v := *x.OptionalSfixed64
+		b = append(b, "\"optional_sfixed64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalSint32 != nil {
+		v := *x.OptionalSint32
+		b = append(b, "\"optional_sint32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalSint64 != nil {
+		v := *x.OptionalSint64
+		b = append(b, "\"optional_sint64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalString != nil {
+		v := *x.OptionalString
+		b = append(b, "\"optional_string\":"...)
+		b = runtime.AppendAminoJSONString(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalUint32 != nil {
+		v := *x.OptionalUint32
+		b = append(b, "\"optional_uint32\":"...)
+		b = strconv.AppendUint(b, uint64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalUint64 != nil {
+		v := *x.OptionalUint64
+		b = append(b, "\"optional_uint64\":"...)
+		b = runtime.AppendJSONUint64(b, v)
+		b = append(b, ',')
+	}
+	if len(x.RepeatedBool) > 0 {
+		b = append(b, "\"repeated_bool\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedBool {
+			b = strconv.AppendBool(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedBytes) > 0 {
+		b = append(b, "\"repeated_bytes\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedBytes {
+			b = runtime.AppendJSONBytes(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedClosedEnum) > 0 {
+		b = append(b, "\"repeated_closed_enum\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedClosedEnum {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedDelimitedMessage) > 0 {
+		b = append(b, "\"repeated_delimited_message\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedDelimitedMessage {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedDouble) > 0 {
+		b = append(b, "\"repeated_double\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedDouble {
+			if b, err = runtime.AppendAminoJSONFloat(b, v, 64); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFixed32) > 0 {
+		b = append(b, "\"repeated_fixed32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFixed32 {
+			b = strconv.AppendUint(b, uint64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFixed64) > 0 {
+		b = append(b, "\"repeated_fixed64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFixed64 {
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFloat) > 0 {
+		b = append(b, "\"repeated_float\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFloat {
+			if b, err = runtime.AppendAminoJSONFloat(b, float64(v), 32); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedInt32) > 0 {
+		b = append(b, "\"repeated_int32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedInt32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedInt64) > 0 {
+		b = append(b, "\"repeated_int64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedInt64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedNestedEnum) > 0 {
+		b = append(b, "\"repeated_nested_enum\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedNestedEnum {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedNestedMessage) > 0 {
+		b = append(b, "\"repeated_nested_message\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedNestedMessage {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSfixed32) > 0 {
+		b = append(b, "\"repeated_sfixed32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSfixed32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSfixed64) > 0 {
+		b = append(b, "\"repeated_sfixed64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSfixed64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSint32) > 0 {
+		b = append(b, "\"repeated_sint32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSint32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSint64) > 0 {
+		b = append(b, "\"repeated_sint64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSint64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedString) > 0 {
+		b = append(b, "\"repeated_string\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedString {
+			b = runtime.AppendAminoJSONString(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedUint32) > 0 {
+		b = append(b, "\"repeated_uint32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedUint32 {
+			b = strconv.AppendUint(b, uint64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedUint64) > 0 {
+		b = append(b, "\"repeated_uint64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedUint64 {
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.UnverifiedMap) > 0 {
+		b = append(b, "\"unverified_map\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.UnverifiedMap)) {
+			v := x.UnverifiedMap[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			b = runtime.AppendAminoJSONString(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.UnverifiedRepeatedString) > 0 {
+		b = append(b, "\"unverified_repeated_string\":"...)
+		b = append(b, '[')
+		for _, v := range x.UnverifiedRepeatedString {
+			b = runtime.AppendAminoJSONString(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if x.UnverifiedString != nil {
+		v := *x.UnverifiedString
+		b = append(b, "\"unverified_string\":"...)
+		b = runtime.AppendAminoJSONString(b, v)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestAllTypes_NestedMessage) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestAllTypes_NestedMessage) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.A != nil {
+		v := *x.A
+		b = append(b, "\"a\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.Corecursive != nil {
+		b = append(b, "\"corecursive\":"...)
+		if b, err = x.Corecursive.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestRequired) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestRequired) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.OptionalField != nil {
+		v := *x.OptionalField
+		b = append(b, "\"optional_field\":"...)
+		b = runtime.AppendAminoJSONString(b, v)
+		b = append(b, ',')
+	}
+	if x.RequiredField != nil {
+		v := *x.RequiredField
+		b = append(b, "\"required_field\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_31_list)(nil)
+
+type _TestAllTypes_31_list struct {
+	list *[]int32
+}
+
+func (x *_TestAllTypes_31_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_31_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt32((*x.list)[i])
+}
+
+func (x *_TestAllTypes_31_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_31_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_31_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedInt32 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_31_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_31_list) NewElement() protoreflect.Value {
+	v := int32(0)
+	return protoreflect.ValueOfInt32(v)
+}
+
+func (x *_TestAllTypes_31_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_32_list)(nil)
+
+type _TestAllTypes_32_list struct {
+	list *[]int64
+}
+
+func (x *_TestAllTypes_32_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_32_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
+
+func (x *_TestAllTypes_32_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_32_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_32_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedInt64 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_32_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_32_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_TestAllTypes_32_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_33_list)(nil)
+
+type _TestAllTypes_33_list struct {
+	list *[]uint32
+}
+
+func (x *_TestAllTypes_33_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_33_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint32((*x.list)[i])
+}
+
+func (x *_TestAllTypes_33_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_33_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_33_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedUint32 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_33_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_33_list) NewElement() protoreflect.Value {
+	v := uint32(0)
+	return protoreflect.ValueOfUint32(v)
+}
+
+func (x *_TestAllTypes_33_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_34_list)(nil)
+
+type _TestAllTypes_34_list struct {
+	list *[]uint64
+}
+
+func (x *_TestAllTypes_34_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_34_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_TestAllTypes_34_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_34_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_34_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedUint64 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_34_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_34_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_TestAllTypes_34_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_35_list)(nil)
+
+type _TestAllTypes_35_list struct {
+	list *[]int32
+}
+
+func (x *_TestAllTypes_35_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_35_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt32((*x.list)[i])
+}
+
+func (x *_TestAllTypes_35_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_35_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_35_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedSint32 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_35_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_35_list) NewElement() protoreflect.Value {
+	v := int32(0)
+	return protoreflect.ValueOfInt32(v)
+}
+
+func (x *_TestAllTypes_35_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_36_list)(nil)
+
+type _TestAllTypes_36_list struct {
+	list *[]int64
+}
+
+func (x *_TestAllTypes_36_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_36_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
+
+func (x *_TestAllTypes_36_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_36_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_36_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedSint64 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_36_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_36_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_TestAllTypes_36_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_37_list)(nil)
+
+type _TestAllTypes_37_list struct {
+	list *[]uint32
+}
+
+func (x *_TestAllTypes_37_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_37_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint32((*x.list)[i])
+}
+
+func (x *_TestAllTypes_37_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_37_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_37_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedFixed32 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_37_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_37_list) NewElement() protoreflect.Value {
+	v := uint32(0)
+	return protoreflect.ValueOfUint32(v)
+}
+
+func (x *_TestAllTypes_37_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_38_list)(nil)
+
+type _TestAllTypes_38_list struct {
+	list *[]uint64
+}
+
+func (x *_TestAllTypes_38_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_38_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_TestAllTypes_38_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_38_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_38_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedFixed64 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_38_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_38_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_TestAllTypes_38_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_39_list)(nil)
+
+type _TestAllTypes_39_list struct {
+	list *[]int32
+}
+
+func (x *_TestAllTypes_39_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_39_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt32((*x.list)[i])
+}
+
+func (x *_TestAllTypes_39_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_39_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_39_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedSfixed32 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_39_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_39_list) NewElement() protoreflect.Value {
+	v := int32(0)
+	return protoreflect.ValueOfInt32(v)
+}
+
+func (x *_TestAllTypes_39_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_40_list)(nil)
+
+type _TestAllTypes_40_list struct {
+	list *[]int64
+}
+
+func (x *_TestAllTypes_40_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_40_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
+
+func (x *_TestAllTypes_40_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_40_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_40_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedSfixed64 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_40_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_40_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_TestAllTypes_40_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_41_list)(nil)
+
+type _TestAllTypes_41_list struct {
+	list *[]float32
+}
+
+func (x *_TestAllTypes_41_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_41_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfFloat32((*x.list)[i])
+}
+
+func (x *_TestAllTypes_41_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Float()
+	concreteValue := (float32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_41_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Float()
+	concreteValue := (float32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_41_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedFloat as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_41_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_41_list) NewElement() protoreflect.Value {
+	v := float32(0)
+	return protoreflect.ValueOfFloat32(v)
+}
+
+func (x *_TestAllTypes_41_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_42_list)(nil)
+
+type _TestAllTypes_42_list struct {
+	list *[]float64
+}
+
+func (x *_TestAllTypes_42_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_42_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfFloat64((*x.list)[i])
+}
+
+func (x *_TestAllTypes_42_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Float()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_42_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Float()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_42_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedDouble as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_42_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_42_list) NewElement() protoreflect.Value {
+	v := float64(0)
+	return protoreflect.ValueOfFloat64(v)
+}
+
+func (x *_TestAllTypes_42_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_43_list)(nil)
+
+type _TestAllTypes_43_list struct {
+	list *[]bool
+}
+
+func (x *_TestAllTypes_43_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_43_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBool((*x.list)[i])
+}
+
+func (x *_TestAllTypes_43_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bool()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_43_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bool()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_43_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedBool as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_43_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_43_list) NewElement() protoreflect.Value {
+	v := false
+	return protoreflect.ValueOfBool(v)
+}
+
+func (x *_TestAllTypes_43_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_44_list)(nil)
+
+type _TestAllTypes_44_list struct {
+	list *[]string
+}
+
+func (x *_TestAllTypes_44_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_44_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TestAllTypes_44_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_44_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_44_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedString as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_44_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_44_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TestAllTypes_44_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_45_list)(nil)
+
+type _TestAllTypes_45_list struct {
+	list *[][]byte
+}
+
+func (x *_TestAllTypes_45_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_45_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_TestAllTypes_45_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_45_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_45_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedBytes as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_45_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_45_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_TestAllTypes_45_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_48_list)(nil)
+
+type _TestAllTypes_48_list struct {
+	list *[]*TestAllTypes_NestedMessage
+}
+
+func (x *_TestAllTypes_48_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_48_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TestAllTypes_48_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TestAllTypes_NestedMessage)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_48_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TestAllTypes_NestedMessage)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_48_list) AppendMutable() protoreflect.Value {
+	v := new(TestAllTypes_NestedMessage)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestAllTypes_48_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_48_list) NewElement() protoreflect.Value {
+	v := new(TestAllTypes_NestedMessage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestAllTypes_48_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_51_list)(nil)
+
+type _TestAllTypes_51_list struct {
+	list *[]TestAllTypes_NestedEnum
+}
+
+func (x *_TestAllTypes_51_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_51_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
+}
+
+func (x *_TestAllTypes_51_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (TestAllTypes_NestedEnum)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_51_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (TestAllTypes_NestedEnum)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_51_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedNestedEnum as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_51_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_51_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_TestAllTypes_51_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_52_list)(nil)
+
+type _TestAllTypes_52_list struct {
+	list *[]ClosedEnum
+}
+
+func (x *_TestAllTypes_52_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_52_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
+}
+
+func (x *_TestAllTypes_52_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (ClosedEnum)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_52_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (ClosedEnum)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_52_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedClosedEnum as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_52_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_52_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_TestAllTypes_52_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_53_list)(nil)
+
+type _TestAllTypes_53_list struct {
+	list *[]int32
+}
+
+func (x *_TestAllTypes_53_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_53_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt32((*x.list)[i])
+}
+
+func (x *_TestAllTypes_53_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_53_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_53_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field ExpandedInt32 as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_53_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_53_list) NewElement() protoreflect.Value {
+	v := int32(0)
+	return protoreflect.ValueOfInt32(v)
+}
+
+func (x *_TestAllTypes_53_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_54_list)(nil)
+
+type _TestAllTypes_54_list struct {
+	list *[]float64
+}
+
+func (x *_TestAllTypes_54_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_54_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfFloat64((*x.list)[i])
+}
+
+func (x *_TestAllTypes_54_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Float()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_54_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Float()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_54_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field ExpandedDouble as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_54_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_54_list) NewElement() protoreflect.Value {
+	v := float64(0)
+	return protoreflect.ValueOfFloat64(v)
+}
+
+func (x *_TestAllTypes_54_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_55_list)(nil)
+
+type _TestAllTypes_55_list struct {
+	list *[]ClosedEnum
+}
+
+func (x *_TestAllTypes_55_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_55_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
+}
+
+func (x *_TestAllTypes_55_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (ClosedEnum)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_55_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (ClosedEnum)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_55_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field ExpandedClosedEnum as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_55_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_55_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_TestAllTypes_55_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.Map = (*_TestAllTypes_56_map)(nil)
+
+type _TestAllTypes_56_map struct {
+	m *map[int32]int32
+}
+
+func (x *_TestAllTypes_56_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_TestAllTypes_56_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfInt32(k))
+		mapValue := protoreflect.ValueOfInt32(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_TestAllTypes_56_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.Int()
+	concreteValue := (int32)(keyUnwrapped)
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_TestAllTypes_56_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := (int32)(keyUnwrapped)
+	delete(*x.m, concreteKey)
+}
+
+func (x *_TestAllTypes_56_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := (int32)(keyUnwrapped)
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfInt32(v)
+}
+
+func (x *_TestAllTypes_56_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := (int32)(keyUnwrapped)
+	valueUnwrapped := value.Int()
+	concreteValue := (int32)(valueUnwrapped)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_TestAllTypes_56_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_TestAllTypes_56_map) NewValue() protoreflect.Value {
+	v := int32(0)
+	return protoreflect.ValueOfInt32(v)
+}
+
+func (x *_TestAllTypes_56_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.Map = (*_TestAllTypes_69_map)(nil)
+
+type _TestAllTypes_69_map struct {
+	m *map[string]string
+}
+
+func (x *_TestAllTypes_69_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_TestAllTypes_69_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfString(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_TestAllTypes_69_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_TestAllTypes_69_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_TestAllTypes_69_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TestAllTypes_69_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_TestAllTypes_69_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_TestAllTypes_69_map) NewValue() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TestAllTypes_69_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.Map = (*_TestAllTypes_71_map)(nil)
+
+type _TestAllTypes_71_map struct {
+	m *map[string]*TestAllTypes_NestedMessage
+}
+
+func (x *_TestAllTypes_71_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_TestAllTypes_71_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_TestAllTypes_71_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_TestAllTypes_71_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_TestAllTypes_71_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestAllTypes_71_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TestAllTypes_NestedMessage)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_TestAllTypes_71_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
+	}
+	newValue := new(TestAllTypes_NestedMessage)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
+}
+
+func (x *_TestAllTypes_71_map) NewValue() protoreflect.Value {
+	v := new(TestAllTypes_NestedMessage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestAllTypes_71_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.Map = (*_TestAllTypes_73_map)(nil)
+
+type _TestAllTypes_73_map struct {
+	m *map[string]TestAllTypes_NestedEnum
+}
+
+func (x *_TestAllTypes_73_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_TestAllTypes_73_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfEnum(v.Number())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_TestAllTypes_73_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_TestAllTypes_73_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_TestAllTypes_73_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_TestAllTypes_73_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Enum()
+	concreteValue := (TestAllTypes_NestedEnum)(valueUnwrapped)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_TestAllTypes_73_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_TestAllTypes_73_map) NewValue() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_TestAllTypes_73_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.Map = (*_TestAllTypes_74_map)(nil)
+
+type _TestAllTypes_74_map struct {
+	m *map[string]ClosedEnum
+}
+
+func (x *_TestAllTypes_74_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_TestAllTypes_74_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfEnum(v.Number())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_TestAllTypes_74_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_TestAllTypes_74_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_TestAllTypes_74_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_TestAllTypes_74_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Enum()
+	concreteValue := (ClosedEnum)(valueUnwrapped)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_TestAllTypes_74_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_TestAllTypes_74_map) NewValue() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_TestAllTypes_74_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_76_list)(nil)
+
+type _TestAllTypes_76_list struct {
+	list *[]string
+}
+
+func (x *_TestAllTypes_76_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_76_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TestAllTypes_76_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_76_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_76_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field UnverifiedRepeatedString as it is not of Message kind"))
+}
+
+func (x *_TestAllTypes_76_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_76_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TestAllTypes_76_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.Map = (*_TestAllTypes_77_map)(nil)
+
+type _TestAllTypes_77_map struct {
+	m *map[string]string
+}
+
+func (x *_TestAllTypes_77_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_TestAllTypes_77_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfString(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_TestAllTypes_77_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_TestAllTypes_77_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_TestAllTypes_77_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TestAllTypes_77_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_TestAllTypes_77_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_TestAllTypes_77_map) NewValue() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TestAllTypes_77_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.List = (*_TestAllTypes_79_list)(nil)
+
+type _TestAllTypes_79_list struct {
+	list *[]*TestAllTypes_NestedMessage
+}
+
+func (x *_TestAllTypes_79_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TestAllTypes_79_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TestAllTypes_79_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TestAllTypes_NestedMessage)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TestAllTypes_79_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TestAllTypes_NestedMessage)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TestAllTypes_79_list) AppendMutable() protoreflect.Value {
+	v := new(TestAllTypes_NestedMessage)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestAllTypes_79_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TestAllTypes_79_list) NewElement() protoreflect.Value {
+	v := new(TestAllTypes_NestedMessage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TestAllTypes_79_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TestAllTypes                            protoreflect.MessageDescriptor
+	fd_TestAllTypes_optional_int32             protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_int64             protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_uint32            protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_uint64            protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_sint32            protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_sint64            protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_fixed32           protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_fixed64           protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_sfixed32          protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_sfixed64          protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_float             protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_double            protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_bool              protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_string            protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_bytes             protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_nested_message    protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_nested_enum       protoreflect.FieldDescriptor
+	fd_TestAllTypes_optional_closed_enum       protoreflect.FieldDescriptor
+	fd_TestAllTypes_implicit_int32             protoreflect.FieldDescriptor
+	fd_TestAllTypes_implicit_string            protoreflect.FieldDescriptor
+	fd_TestAllTypes_implicit_bytes             protoreflect.FieldDescriptor
+	fd_TestAllTypes_implicit_enum              protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_int32             protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_int64             protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_uint32            protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_uint64            protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_sint32            protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_sint64            protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_fixed32           protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_fixed64           protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_sfixed32          protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_sfixed64          protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_float             protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_double            protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_bool              protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_string            protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_bytes             protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_nested_message    protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_nested_enum       protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_closed_enum       protoreflect.FieldDescriptor
+	fd_TestAllTypes_expanded_int32             protoreflect.FieldDescriptor
+	fd_TestAllTypes_expanded_double            protoreflect.FieldDescriptor
+	fd_TestAllTypes_expanded_closed_enum       protoreflect.FieldDescriptor
+	fd_TestAllTypes_map_int32_int32            protoreflect.FieldDescriptor
+	fd_TestAllTypes_map_string_string          protoreflect.FieldDescriptor
+	fd_TestAllTypes_map_string_nested_message  protoreflect.FieldDescriptor
+	fd_TestAllTypes_map_string_nested_enum     protoreflect.FieldDescriptor
+	fd_TestAllTypes_map_string_closed_enum     protoreflect.FieldDescriptor
+	fd_TestAllTypes_unverified_string          protoreflect.FieldDescriptor
+	fd_TestAllTypes_unverified_repeated_string protoreflect.FieldDescriptor
+	fd_TestAllTypes_unverified_map             protoreflect.FieldDescriptor
+	fd_TestAllTypes_delimited_message          protoreflect.FieldDescriptor
+	fd_TestAllTypes_repeated_delimited_message protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_int32              protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_string             protoreflect.FieldDescriptor
+	fd_TestAllTypes_default_closed_enum        protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_uint32               protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_nested_message       protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_string               protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_bytes                protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_closed_enum          protoreflect.FieldDescriptor
+	fd_TestAllTypes_oneof_delimited            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test2023_test_proto_init()
+	md_TestAllTypes = File_internal_testprotos_test2023_test_proto.Messages().ByName("TestAllTypes")
+	fd_TestAllTypes_optional_int32 = md_TestAllTypes.Fields().ByName("optional_int32")
+	fd_TestAllTypes_optional_int64 = md_TestAllTypes.Fields().ByName("optional_int64")
+	fd_TestAllTypes_optional_uint32 = md_TestAllTypes.Fields().ByName("optional_uint32")
+	fd_TestAllTypes_optional_uint64 = md_TestAllTypes.Fields().ByName("optional_uint64")
+	fd_TestAllTypes_optional_sint32 = md_TestAllTypes.Fields().ByName("optional_sint32")
+	fd_TestAllTypes_optional_sint64 = md_TestAllTypes.Fields().ByName("optional_sint64")
+	fd_TestAllTypes_optional_fixed32 = md_TestAllTypes.Fields().ByName("optional_fixed32")
+	fd_TestAllTypes_optional_fixed64 = md_TestAllTypes.Fields().ByName("optional_fixed64")
+	fd_TestAllTypes_optional_sfixed32 = md_TestAllTypes.Fields().ByName("optional_sfixed32")
+	fd_TestAllTypes_optional_sfixed64 = md_TestAllTypes.Fields().ByName("optional_sfixed64")
+	fd_TestAllTypes_optional_float = md_TestAllTypes.Fields().ByName("optional_float")
+	fd_TestAllTypes_optional_double = md_TestAllTypes.Fields().ByName("optional_double")
+	fd_TestAllTypes_optional_bool = md_TestAllTypes.Fields().ByName("optional_bool")
+	fd_TestAllTypes_optional_string = md_TestAllTypes.Fields().ByName("optional_string")
+	fd_TestAllTypes_optional_bytes = md_TestAllTypes.Fields().ByName("optional_bytes")
+	fd_TestAllTypes_optional_nested_message = md_TestAllTypes.Fields().ByName("optional_nested_message")
+	fd_TestAllTypes_optional_nested_enum = md_TestAllTypes.Fields().ByName("optional_nested_enum")
+	fd_TestAllTypes_optional_closed_enum = md_TestAllTypes.Fields().ByName("optional_closed_enum")
+	fd_TestAllTypes_implicit_int32 = md_TestAllTypes.Fields().ByName("implicit_int32")
+	fd_TestAllTypes_implicit_string = md_TestAllTypes.Fields().ByName("implicit_string")
+	fd_TestAllTypes_implicit_bytes = md_TestAllTypes.Fields().ByName("implicit_bytes")
+	fd_TestAllTypes_implicit_enum = md_TestAllTypes.Fields().ByName("implicit_enum")
+	fd_TestAllTypes_repeated_int32 = md_TestAllTypes.Fields().ByName("repeated_int32")
+	fd_TestAllTypes_repeated_int64 = md_TestAllTypes.Fields().ByName("repeated_int64")
+	fd_TestAllTypes_repeated_uint32 = md_TestAllTypes.Fields().ByName("repeated_uint32")
+	fd_TestAllTypes_repeated_uint64 = md_TestAllTypes.Fields().ByName("repeated_uint64")
+	fd_TestAllTypes_repeated_sint32 = md_TestAllTypes.Fields().ByName("repeated_sint32")
+	fd_TestAllTypes_repeated_sint64 = md_TestAllTypes.Fields().ByName("repeated_sint64")
+	fd_TestAllTypes_repeated_fixed32 = md_TestAllTypes.Fields().ByName("repeated_fixed32")
+	fd_TestAllTypes_repeated_fixed64 = md_TestAllTypes.Fields().ByName("repeated_fixed64")
+	fd_TestAllTypes_repeated_sfixed32 = md_TestAllTypes.Fields().ByName("repeated_sfixed32")
+	fd_TestAllTypes_repeated_sfixed64 = md_TestAllTypes.Fields().ByName("repeated_sfixed64")
+	fd_TestAllTypes_repeated_float = md_TestAllTypes.Fields().ByName("repeated_float")
+	fd_TestAllTypes_repeated_double = md_TestAllTypes.Fields().ByName("repeated_double")
+	fd_TestAllTypes_repeated_bool = md_TestAllTypes.Fields().ByName("repeated_bool")
+	fd_TestAllTypes_repeated_string = md_TestAllTypes.Fields().ByName("repeated_string")
+	fd_TestAllTypes_repeated_bytes = md_TestAllTypes.Fields().ByName("repeated_bytes")
+	fd_TestAllTypes_repeated_nested_message = md_TestAllTypes.Fields().ByName("repeated_nested_message")
+	fd_TestAllTypes_repeated_nested_enum = md_TestAllTypes.Fields().ByName("repeated_nested_enum")
+	fd_TestAllTypes_repeated_closed_enum = md_TestAllTypes.Fields().ByName("repeated_closed_enum")
+	fd_TestAllTypes_expanded_int32 = md_TestAllTypes.Fields().ByName("expanded_int32")
+	fd_TestAllTypes_expanded_double = md_TestAllTypes.Fields().ByName("expanded_double")
+	fd_TestAllTypes_expanded_closed_enum = md_TestAllTypes.Fields().ByName("expanded_closed_enum")
+	fd_TestAllTypes_map_int32_int32 = md_TestAllTypes.Fields().ByName("map_int32_int32")
+	fd_TestAllTypes_map_string_string = md_TestAllTypes.Fields().ByName("map_string_string")
+	fd_TestAllTypes_map_string_nested_message = md_TestAllTypes.Fields().ByName("map_string_nested_message")
+	fd_TestAllTypes_map_string_nested_enum = md_TestAllTypes.Fields().ByName("map_string_nested_enum")
+	fd_TestAllTypes_map_string_closed_enum = md_TestAllTypes.Fields().ByName("map_string_closed_enum")
+	fd_TestAllTypes_unverified_string = md_TestAllTypes.Fields().ByName("unverified_string")
+	fd_TestAllTypes_unverified_repeated_string = md_TestAllTypes.Fields().ByName("unverified_repeated_string")
+	fd_TestAllTypes_unverified_map = md_TestAllTypes.Fields().ByName("unverified_map")
+	fd_TestAllTypes_delimited_message = md_TestAllTypes.Fields().ByName("delimited_message")
+	fd_TestAllTypes_repeated_delimited_message = md_TestAllTypes.Fields().ByName("repeated_delimited_message")
+	fd_TestAllTypes_default_int32 = md_TestAllTypes.Fields().ByName("default_int32")
+	fd_TestAllTypes_default_string = md_TestAllTypes.Fields().ByName("default_string")
+	fd_TestAllTypes_default_closed_enum = md_TestAllTypes.Fields().ByName("default_closed_enum")
+	fd_TestAllTypes_oneof_uint32 = md_TestAllTypes.Fields().ByName("oneof_uint32")
+	fd_TestAllTypes_oneof_nested_message = md_TestAllTypes.Fields().ByName("oneof_nested_message")
+	fd_TestAllTypes_oneof_string = md_TestAllTypes.Fields().ByName("oneof_string")
+	fd_TestAllTypes_oneof_bytes = md_TestAllTypes.Fields().ByName("oneof_bytes")
+	fd_TestAllTypes_oneof_closed_enum = md_TestAllTypes.Fields().ByName("oneof_closed_enum")
+	fd_TestAllTypes_oneof_delimited = md_TestAllTypes.Fields().ByName("oneof_delimited")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestAllTypes)(nil)
+
+type fastReflection_TestAllTypes TestAllTypes
+
+func (x *TestAllTypes) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestAllTypes)(x)
+}
+
+func (x *TestAllTypes) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test2023_test_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestAllTypes_messageType fastReflection_TestAllTypes_messageType
+var _ protoreflect.MessageType = fastReflection_TestAllTypes_messageType{}
+
+type fastReflection_TestAllTypes_messageType struct{}
+
+func (x fastReflection_TestAllTypes_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestAllTypes)(nil)
+}
+func (x fastReflection_TestAllTypes_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestAllTypes)
+}
+func (x fastReflection_TestAllTypes_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestAllTypes
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestAllTypes) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestAllTypes
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestAllTypes) Type() protoreflect.MessageType {
+	return _fastReflection_TestAllTypes_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestAllTypes) New() protoreflect.Message {
+	return new(fastReflection_TestAllTypes)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestAllTypes) Interface() protoreflect.ProtoMessage {
+	return (*TestAllTypes)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestAllTypes) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OptionalInt32 != nil {
+		value := protoreflect.ValueOfInt32(*x.OptionalInt32)
+		if !f(fd_TestAllTypes_optional_int32, value) {
+			return
+		}
+	}
+	if x.OptionalInt64 != nil {
+		value := protoreflect.ValueOfInt64(*x.OptionalInt64)
+		if !f(fd_TestAllTypes_optional_int64, value) {
+			return
+		}
+	}
+	if x.OptionalUint32 != nil {
+		value := protoreflect.ValueOfUint32(*x.OptionalUint32)
+		if !f(fd_TestAllTypes_optional_uint32, value) {
+			return
+		}
+	}
+	if x.OptionalUint64 != nil {
+		value := protoreflect.ValueOfUint64(*x.OptionalUint64)
+		if !f(fd_TestAllTypes_optional_uint64, value) {
+			return
+		}
+	}
+	if x.OptionalSint32 != nil {
+		value := protoreflect.ValueOfInt32(*x.OptionalSint32)
+		if !f(fd_TestAllTypes_optional_sint32, value) {
+			return
+		}
+	}
+	if x.OptionalSint64 != nil {
+		value := protoreflect.ValueOfInt64(*x.OptionalSint64)
+		if !f(fd_TestAllTypes_optional_sint64, value) {
+			return
+		}
+	}
+	if x.OptionalFixed32 != nil {
+		value := protoreflect.ValueOfUint32(*x.OptionalFixed32)
+		if !f(fd_TestAllTypes_optional_fixed32, value) {
+			return
+		}
+	}
+	if x.OptionalFixed64 != nil {
+		value := protoreflect.ValueOfUint64(*x.OptionalFixed64)
+		if !f(fd_TestAllTypes_optional_fixed64, value) {
+			return
+		}
+	}
+	if x.OptionalSfixed32 != nil {
+		value := protoreflect.ValueOfInt32(*x.OptionalSfixed32)
+		if !f(fd_TestAllTypes_optional_sfixed32, value) {
+			return
+		}
+	}
+	if x.OptionalSfixed64 != nil {
+		value := protoreflect.ValueOfInt64(*x.OptionalSfixed64)
+		if !f(fd_TestAllTypes_optional_sfixed64, value) {
+			return
+		}
+	}
+	if x.OptionalFloat != nil {
+		value := protoreflect.ValueOfFloat32(*x.OptionalFloat)
+		if !f(fd_TestAllTypes_optional_float, value) {
+			return
+		}
+	}
+	if x.OptionalDouble != nil {
+		value := protoreflect.ValueOfFloat64(*x.OptionalDouble)
+		if !f(fd_TestAllTypes_optional_double, value) {
+			return
+		}
+	}
+	if x.OptionalBool != nil {
+		value := protoreflect.ValueOfBool(*x.OptionalBool)
+		if !f(fd_TestAllTypes_optional_bool, value) {
+			return
+		}
+	}
+	if x.OptionalString != nil {
+		value := protoreflect.ValueOfString(*x.OptionalString)
+		if !f(fd_TestAllTypes_optional_string, value) {
+			return
+		}
+	}
+	if x.OptionalBytes != nil {
+		value := protoreflect.ValueOfBytes(x.OptionalBytes)
+		if !f(fd_TestAllTypes_optional_bytes, value) {
+			return
+		}
+	}
+	if x.OptionalNestedMessage != nil {
+		value := protoreflect.ValueOfMessage(x.OptionalNestedMessage.ProtoReflect())
+		if !f(fd_TestAllTypes_optional_nested_message, value) {
+			return
+		}
+	}
+	if x.OptionalNestedEnum != nil {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(*x.OptionalNestedEnum))
+		if !f(fd_TestAllTypes_optional_nested_enum, value) {
+			return
+		}
+	}
+	if x.OptionalClosedEnum != nil {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(*x.OptionalClosedEnum))
+		if !f(fd_TestAllTypes_optional_closed_enum, value) {
+			return
+		}
+	}
+	if x.ImplicitInt32 != int32(0) {
+		value := protoreflect.ValueOfInt32(x.ImplicitInt32)
+		if !f(fd_TestAllTypes_implicit_int32, value) {
+			return
+		}
+	}
+	if x.ImplicitString != "" {
+		value := protoreflect.ValueOfString(x.ImplicitString)
+		if !f(fd_TestAllTypes_implicit_string, value) {
+			return
+		}
+	}
+	if len(x.ImplicitBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.ImplicitBytes)
+		if !f(fd_TestAllTypes_implicit_bytes, value) {
+			return
+		}
+	}
+	if x.ImplicitEnum != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ImplicitEnum))
+		if !f(fd_TestAllTypes_implicit_enum, value) {
+			return
+		}
+	}
+	if len(x.RepeatedInt32) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_31_list{list: &x.RepeatedInt32})
+		if !f(fd_TestAllTypes_repeated_int32, value) {
+			return
+		}
+	}
+	if len(x.RepeatedInt64) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_32_list{list: &x.RepeatedInt64})
+		if !f(fd_TestAllTypes_repeated_int64, value) {
+			return
+		}
+	}
+	if len(x.RepeatedUint32) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_33_list{list: &x.RepeatedUint32})
+		if !f(fd_TestAllTypes_repeated_uint32, value) {
+			return
+		}
+	}
+	if len(x.RepeatedUint64) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_34_list{list: &x.RepeatedUint64})
+		if !f(fd_TestAllTypes_repeated_uint64, value) {
+			return
+		}
+	}
+	if len(x.RepeatedSint32) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_35_list{list: &x.RepeatedSint32})
+		if !f(fd_TestAllTypes_repeated_sint32, value) {
+			return
+		}
+	}
+	if len(x.RepeatedSint64) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_36_list{list: &x.RepeatedSint64})
+		if !f(fd_TestAllTypes_repeated_sint64, value) {
+			return
+		}
+	}
+	if len(x.RepeatedFixed32) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_37_list{list: &x.RepeatedFixed32})
+		if !f(fd_TestAllTypes_repeated_fixed32, value) {
+			return
+		}
+	}
+	if len(x.RepeatedFixed64) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_38_list{list: &x.RepeatedFixed64})
+		if !f(fd_TestAllTypes_repeated_fixed64, value) {
+			return
+		}
+	}
+	if len(x.RepeatedSfixed32) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_39_list{list: &x.RepeatedSfixed32})
+		if !f(fd_TestAllTypes_repeated_sfixed32, value) {
+			return
+		}
+	}
+	if len(x.RepeatedSfixed64) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_40_list{list: &x.RepeatedSfixed64})
+		if !f(fd_TestAllTypes_repeated_sfixed64, value) {
+			return
+		}
+	}
+	if len(x.RepeatedFloat) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_41_list{list: &x.RepeatedFloat})
+		if !f(fd_TestAllTypes_repeated_float, value) {
+			return
+		}
+	}
+	if len(x.RepeatedDouble) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_42_list{list: &x.RepeatedDouble})
+		if !f(fd_TestAllTypes_repeated_double, value) {
+			return
+		}
+	}
+	if len(x.RepeatedBool) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_43_list{list: &x.RepeatedBool})
+		if !f(fd_TestAllTypes_repeated_bool, value) {
+			return
+		}
+	}
+	if len(x.RepeatedString) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_44_list{list: &x.RepeatedString})
+		if !f(fd_TestAllTypes_repeated_string, value) {
+			return
+		}
+	}
+	if len(x.RepeatedBytes) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_45_list{list: &x.RepeatedBytes})
+		if !f(fd_TestAllTypes_repeated_bytes, value) {
+			return
+		}
+	}
+	if len(x.RepeatedNestedMessage) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_48_list{list: &x.RepeatedNestedMessage})
+		if !f(fd_TestAllTypes_repeated_nested_message, value) {
+			return
+		}
+	}
+	if len(x.RepeatedNestedEnum) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_51_list{list: &x.RepeatedNestedEnum})
+		if !f(fd_TestAllTypes_repeated_nested_enum, value) {
+			return
+		}
+	}
+	if len(x.RepeatedClosedEnum) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_52_list{list: &x.RepeatedClosedEnum})
+		if !f(fd_TestAllTypes_repeated_closed_enum, value) {
+			return
+		}
+	}
+	if len(x.ExpandedInt32) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_53_list{list: &x.ExpandedInt32})
+		if !f(fd_TestAllTypes_expanded_int32, value) {
+			return
+		}
+	}
+	if len(x.ExpandedDouble) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_54_list{list: &x.ExpandedDouble})
+		if !f(fd_TestAllTypes_expanded_double, value) {
+			return
+		}
+	}
+	if len(x.ExpandedClosedEnum) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_55_list{list: &x.ExpandedClosedEnum})
+		if !f(fd_TestAllTypes_expanded_closed_enum, value) {
+			return
+		}
+	}
+	if len(x.MapInt32Int32) != 0 {
+		value := protoreflect.ValueOfMap(&_TestAllTypes_56_map{m: &x.MapInt32Int32})
+		if !f(fd_TestAllTypes_map_int32_int32, value) {
+			return
+		}
+	}
+	if len(x.MapStringString) != 0 {
+		value := protoreflect.ValueOfMap(&_TestAllTypes_69_map{m: &x.MapStringString})
+		if !f(fd_TestAllTypes_map_string_string, value) {
+			return
+		}
+	}
+	if len(x.MapStringNestedMessage) != 0 {
+		value := protoreflect.ValueOfMap(&_TestAllTypes_71_map{m: &x.MapStringNestedMessage})
+		if !f(fd_TestAllTypes_map_string_nested_message, value) {
+			return
+		}
+	}
+	if len(x.MapStringNestedEnum) != 0 {
+		value := protoreflect.ValueOfMap(&_TestAllTypes_73_map{m: &x.MapStringNestedEnum})
+		if !f(fd_TestAllTypes_map_string_nested_enum, value) {
+			return
+		}
+	}
+	if len(x.MapStringClosedEnum) != 0 {
+		value := protoreflect.ValueOfMap(&_TestAllTypes_74_map{m: &x.MapStringClosedEnum})
+		if !f(fd_TestAllTypes_map_string_closed_enum, value) {
+			return
+		}
+	}
+	if x.UnverifiedString != nil {
+		value := protoreflect.ValueOfString(*x.UnverifiedString)
+		if !f(fd_TestAllTypes_unverified_string, value) {
+			return
+		}
+	}
+	if len(x.UnverifiedRepeatedString) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_76_list{list: &x.UnverifiedRepeatedString})
+		if !f(fd_TestAllTypes_unverified_repeated_string, value) {
+			return
+		}
+	}
+	if len(x.UnverifiedMap) != 0 {
+		value := protoreflect.ValueOfMap(&_TestAllTypes_77_map{m: &x.UnverifiedMap})
+		if !f(fd_TestAllTypes_unverified_map, value) {
+			return
+		}
+	}
+	if x.DelimitedMessage != nil {
+		value := protoreflect.ValueOfMessage(x.DelimitedMessage.ProtoReflect())
+		if !f(fd_TestAllTypes_delimited_message, value) {
+			return
+		}
+	}
+	if len(x.RepeatedDelimitedMessage) != 0 {
+		value := protoreflect.ValueOfList(&_TestAllTypes_79_list{list: &x.RepeatedDelimitedMessage})
+		if !f(fd_TestAllTypes_repeated_delimited_message, value) {
+			return
+		}
+	}
+	if x.DefaultInt32 != nil {
+		value := protoreflect.ValueOfInt32(*x.DefaultInt32)
+		if !f(fd_TestAllTypes_default_int32, value) {
+			return
+		}
+	}
+	if x.DefaultString != nil {
+		value := protoreflect.ValueOfString(*x.DefaultString)
+		if !f(fd_TestAllTypes_default_string, value) {
+			return
+		}
+	}
+	if x.DefaultClosedEnum != nil {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(*x.DefaultClosedEnum))
+		if !f(fd_TestAllTypes_default_closed_enum, value) {
+			return
+		}
+	}
+	if x.OneofField != nil {
+		switch o := x.OneofField.(type) {
+		case *TestAllTypes_OneofUint32:
+			v := o.OneofUint32
+			value := protoreflect.ValueOfUint32(v)
+			if !f(fd_TestAllTypes_oneof_uint32, value) {
+				return
+			}
+		case *TestAllTypes_OneofNestedMessage:
+			v := o.OneofNestedMessage
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_TestAllTypes_oneof_nested_message, value) {
+				return
+			}
+		case *TestAllTypes_OneofString:
+			v := o.OneofString
+			value := protoreflect.ValueOfString(v)
+			if !f(fd_TestAllTypes_oneof_string, value) {
+				return
+			}
+		case *TestAllTypes_OneofBytes:
+			v := o.OneofBytes
+			value := protoreflect.ValueOfBytes(v)
+			if !f(fd_TestAllTypes_oneof_bytes, value) {
+				return
+			}
+		case *TestAllTypes_OneofClosedEnum:
+			v := o.OneofClosedEnum
+			value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+			if !f(fd_TestAllTypes_oneof_closed_enum, value) {
+				return
+			}
+		case *TestAllTypes_OneofDelimited:
+			v := o.OneofDelimited
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_TestAllTypes_oneof_delimited, value) {
+				return
+			}
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestAllTypes) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2023.TestAllTypes.optional_int32
+		if fd != fd_TestAllTypes_optional_int32 {
+			break
+		}
+		return x.OptionalInt32 != nil
+	case 2: // goproto.proto.test2023.TestAllTypes.optional_int64
+		if fd != fd_TestAllTypes_optional_int64 {
+			break
+		}
+		return x.OptionalInt64 != nil
+	case 3: // goproto.proto.test2023.TestAllTypes.optional_uint32
+		if fd != fd_TestAllTypes_optional_uint32 {
+			break
+		}
+		return x.OptionalUint32 != nil
+	case 4: // goproto.proto.test2023.TestAllTypes.optional_uint64
+		if fd != fd_TestAllTypes_optional_uint64 {
+			break
+		}
+		return x.OptionalUint64 != nil
+	case 5: // goproto.proto.test2023.TestAllTypes.optional_sint32
+		if fd != fd_TestAllTypes_optional_sint32 {
+			break
+		}
+		return x.OptionalSint32 != nil
+	case 6: // goproto.proto.test2023.TestAllTypes.optional_sint64
+		if fd != fd_TestAllTypes_optional_sint64 {
+			break
+		}
+		return x.OptionalSint64 != nil
+	case 7: // goproto.proto.test2023.TestAllTypes.optional_fixed32
+		if fd != fd_TestAllTypes_optional_fixed32 {
+			break
+		}
+		return x.OptionalFixed32 != nil
+	case 8: // goproto.proto.test2023.TestAllTypes.optional_fixed64
+		if fd != fd_TestAllTypes_optional_fixed64 {
+			break
+		}
+		return x.OptionalFixed64 != nil
+	case 9: // goproto.proto.test2023.TestAllTypes.optional_sfixed32
+		if fd != fd_TestAllTypes_optional_sfixed32 {
+			break
+		}
+		return x.OptionalSfixed32 != nil
+	case 10: // goproto.proto.test2023.TestAllTypes.optional_sfixed64
+		if fd != fd_TestAllTypes_optional_sfixed64 {
+			break
+		}
+		return x.OptionalSfixed64 != nil
+	case 11: // goproto.proto.test2023.TestAllTypes.optional_float
+		if fd != fd_TestAllTypes_optional_float {
+			break
+		}
+		return x.OptionalFloat != nil
+	case 12: // goproto.proto.test2023.TestAllTypes.optional_double
+		if fd != fd_TestAllTypes_optional_double {
+			break
+		}
+		return x.OptionalDouble != nil
+	case 13: // goproto.proto.test2023.TestAllTypes.optional_bool
+		if fd != fd_TestAllTypes_optional_bool {
+			break
+		}
+		return x.OptionalBool != nil
+	case 14: // goproto.proto.test2023.TestAllTypes.optional_string
+		if fd != fd_TestAllTypes_optional_string {
+			break
+		}
+		return x.OptionalString != nil
+	case 15: // goproto.proto.test2023.TestAllTypes.optional_bytes
+		if fd != fd_TestAllTypes_optional_bytes {
+			break
+		}
+		return x.OptionalBytes != nil
+	case 18: // goproto.proto.test2023.TestAllTypes.optional_nested_message
+		if fd != fd_TestAllTypes_optional_nested_message {
+			break
+		}
+		return x.OptionalNestedMessage != nil
+	case 21: // goproto.proto.test2023.TestAllTypes.optional_nested_enum
+		if fd != fd_TestAllTypes_optional_nested_enum {
+			break
+		}
+		return x.OptionalNestedEnum != nil
+	case 22: // goproto.proto.test2023.TestAllTypes.optional_closed_enum
+		if fd != fd_TestAllTypes_optional_closed_enum {
+			break
+		}
+		return x.OptionalClosedEnum != nil
+	case 23: // goproto.proto.test2023.TestAllTypes.implicit_int32
+		if fd != fd_TestAllTypes_implicit_int32 {
+			break
+		}
+		return x.ImplicitInt32 != int32(0)
+	case 24: // goproto.proto.test2023.TestAllTypes.implicit_string
+		if fd != fd_TestAllTypes_implicit_string {
+			break
+		}
+		return x.ImplicitString != ""
+	case 25: // goproto.proto.test2023.TestAllTypes.implicit_bytes
+		if fd != fd_TestAllTypes_implicit_bytes {
+			break
+		}
+		return len(x.ImplicitBytes) != 0
+	case 26: // goproto.proto.test2023.TestAllTypes.implicit_enum
+		if fd != fd_TestAllTypes_implicit_enum {
+			break
+		}
+		return x.ImplicitEnum != 0
+	case 31: // goproto.proto.test2023.TestAllTypes.repeated_int32
+		if fd != fd_TestAllTypes_repeated_int32 {
+			break
+		}
+		return len(x.RepeatedInt32) != 0
+	case 32: // goproto.proto.test2023.TestAllTypes.repeated_int64
+		if fd != fd_TestAllTypes_repeated_int64 {
+			break
+		}
+		return len(x.RepeatedInt64) != 0
+	case 33: // goproto.proto.test2023.TestAllTypes.repeated_uint32
+		if fd != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
+		return len(x.RepeatedUint32) != 0
+	case 34: // goproto.proto.test2023.TestAllTypes.repeated_uint64
+		if fd != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
+		return len(x.RepeatedUint64) != 0
+	case 35: // goproto.proto.test2023.TestAllTypes.repeated_sint32
+		if fd != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
+		return len(x.RepeatedSint32) != 0
+	case 36: // goproto.proto.test2023.TestAllTypes.repeated_sint64
+		if fd != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
+		return len(x.RepeatedSint64) != 0
+	case 37: // goproto.proto.test2023.TestAllTypes.repeated_fixed32
+		if fd != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
+		return len(x.RepeatedFixed32) != 0
+	case 38: // goproto.proto.test2023.TestAllTypes.repeated_fixed64
+		if fd != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
+		return len(x.RepeatedFixed64) != 0
+	case 39: // goproto.proto.test2023.TestAllTypes.repeated_sfixed32
+		if fd != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
+		return len(x.RepeatedSfixed32) != 0
+	case 40: // goproto.proto.test2023.TestAllTypes.repeated_sfixed64
+		if fd != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
+		return len(x.RepeatedSfixed64) != 0
+	case 41: // goproto.proto.test2023.TestAllTypes.repeated_float
+		if fd != fd_TestAllTypes_repeated_float {
+			break
+		}
+		return len(x.RepeatedFloat) != 0
+	case 42: // goproto.proto.test2023.TestAllTypes.repeated_double
+		if fd != fd_TestAllTypes_repeated_double {
+			break
+		}
+		return len(x.RepeatedDouble) != 0
+	case 43: // goproto.proto.test2023.TestAllTypes.repeated_bool
+		if fd != fd_TestAllTypes_repeated_bool {
+			break
+		}
+		return len(x.RepeatedBool) != 0
+	case 44: // goproto.proto.test2023.TestAllTypes.repeated_string
+		if fd != fd_TestAllTypes_repeated_string {
+			break
+		}
+		return len(x.RepeatedString) != 0
+	case 45: // goproto.proto.test2023.TestAllTypes.repeated_bytes
+		if fd != fd_TestAllTypes_repeated_bytes {
+			break
+		}
+		return len(x.RepeatedBytes) != 0
+	case 48: // goproto.proto.test2023.TestAllTypes.repeated_nested_message
+		if fd != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
+		return len(x.RepeatedNestedMessage) != 0
+	case 51: // goproto.proto.test2023.TestAllTypes.repeated_nested_enum
+		if fd != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
+		return len(x.RepeatedNestedEnum) != 0
+	case 52: // goproto.proto.test2023.TestAllTypes.repeated_closed_enum
+		if fd != fd_TestAllTypes_repeated_closed_enum {
+			break
+		}
+		return len(x.RepeatedClosedEnum) != 0
+	case 53: // goproto.proto.test2023.TestAllTypes.expanded_int32
+		if fd != fd_TestAllTypes_expanded_int32 {
+			break
+		}
+		return len(x.ExpandedInt32) != 0
+	case 54: // goproto.proto.test2023.TestAllTypes.expanded_double
+		if fd != fd_TestAllTypes_expanded_double {
+			break
+		}
+		return len(x.ExpandedDouble) != 0
+	case 55: // goproto.proto.test2023.TestAllTypes.expanded_closed_enum
+		if fd != fd_TestAllTypes_expanded_closed_enum {
+			break
+		}
+		return len(x.ExpandedClosedEnum) != 0
+	case 56: // goproto.proto.test2023.TestAllTypes.map_int32_int32
+		if fd != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
+		return len(x.MapInt32Int32) != 0
+	case 69: // goproto.proto.test2023.TestAllTypes.map_string_string
+		if fd != fd_TestAllTypes_map_string_string {
+			break
+		}
+		return len(x.MapStringString) != 0
+	case 71: // goproto.proto.test2023.TestAllTypes.map_string_nested_message
+		if fd != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
+		return len(x.MapStringNestedMessage) != 0
+	case 73: // goproto.proto.test2023.TestAllTypes.map_string_nested_enum
+		if fd != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
+		return len(x.MapStringNestedEnum) != 0
+	case 74: // goproto.proto.test2023.TestAllTypes.map_string_closed_enum
+		if fd != fd_TestAllTypes_map_string_closed_enum {
+			break
+		}
+		return len(x.MapStringClosedEnum) != 0
+	case 75: // goproto.proto.test2023.TestAllTypes.unverified_string
+		if fd != fd_TestAllTypes_unverified_string {
+			break
+		}
+		return x.UnverifiedString != nil
+	case 76: // goproto.proto.test2023.TestAllTypes.unverified_repeated_string
+		if fd != fd_TestAllTypes_unverified_repeated_string {
+			break
+		}
+		return len(x.UnverifiedRepeatedString) != 0
+	case 77: // goproto.proto.test2023.TestAllTypes.unverified_map
+		if fd != fd_TestAllTypes_unverified_map {
+			break
+		}
+		return len(x.UnverifiedMap) != 0
+	case 78: // goproto.proto.test2023.TestAllTypes.delimited_message
+		if fd != fd_TestAllTypes_delimited_message {
+			break
+		}
+		return x.DelimitedMessage != nil
+	case 79: // goproto.proto.test2023.TestAllTypes.repeated_delimited_message
+		if fd != fd_TestAllTypes_repeated_delimited_message {
+			break
+		}
+		return len(x.RepeatedDelimitedMessage) != 0
+	case 81: // goproto.proto.test2023.TestAllTypes.default_int32
+		if fd != fd_TestAllTypes_default_int32 {
+			break
+		}
+		return x.DefaultInt32 != nil
+	case 94: // goproto.proto.test2023.TestAllTypes.default_string
+		if fd != fd_TestAllTypes_default_string {
+			break
+		}
+		return x.DefaultString != nil
+	case 97: // goproto.proto.test2023.TestAllTypes.default_closed_enum
+		if fd != fd_TestAllTypes_default_closed_enum {
+			break
+		}
+		return x.DefaultClosedEnum != nil
+	case 111: // goproto.proto.test2023.TestAllTypes.oneof_uint32
+		if fd != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestAllTypes_OneofUint32); ok {
+			return true
+		} else {
+			return false
+		}
+	case 112: // goproto.proto.test2023.TestAllTypes.oneof_nested_message
+		if fd != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); ok {
+			return true
+		} else {
+			return false
+		}
+	case 113: // goproto.proto.test2023.TestAllTypes.oneof_string
+		if fd != fd_TestAllTypes_oneof_string {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestAllTypes_OneofString); ok {
+			return true
+		} else {
+			return false
+		}
+	case 114: // goproto.proto.test2023.TestAllTypes.oneof_bytes
+		if fd != fd_TestAllTypes_oneof_bytes {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestAllTypes_OneofBytes); ok {
+			return true
+		} else {
+			return false
+		}
+	case 115: // goproto.proto.test2023.TestAllTypes.oneof_closed_enum
+		if fd != fd_TestAllTypes_oneof_closed_enum {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestAllTypes_OneofClosedEnum); ok {
+			return true
+		} else {
+			return false
+		}
+	case 116: // goproto.proto.test2023.TestAllTypes.oneof_delimited
+		if fd != fd_TestAllTypes_oneof_delimited {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestAllTypes_OneofDelimited); ok {
+			return true
+		} else {
+			return false
+		}
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2023.TestAllTypes.optional_int32
+		if fd != fd_TestAllTypes_optional_int32 {
+			break
+		}
+		x.OptionalInt32 = nil
+		return
+	case 2: // goproto.proto.test2023.TestAllTypes.optional_int64
+		if fd != fd_TestAllTypes_optional_int64 {
+			break
+		}
+		x.OptionalInt64 = nil
+		return
+	case 3: // goproto.proto.test2023.TestAllTypes.optional_uint32
+		if fd != fd_TestAllTypes_optional_uint32 {
+			break
+		}
+		x.OptionalUint32 = nil
+		return
+	case 4: // goproto.proto.test2023.TestAllTypes.optional_uint64
+		if fd != fd_TestAllTypes_optional_uint64 {
+			break
+		}
+		x.OptionalUint64 = nil
+		return
+	case 5: // goproto.proto.test2023.TestAllTypes.optional_sint32
+		if fd != fd_TestAllTypes_optional_sint32 {
+			break
+		}
+		x.OptionalSint32 = nil
+		return
+	case 6: // goproto.proto.test2023.TestAllTypes.optional_sint64
+		if fd != fd_TestAllTypes_optional_sint64 {
+			break
+		}
+		x.OptionalSint64 = nil
+		return
+	case 7: // goproto.proto.test2023.TestAllTypes.optional_fixed32
+		if fd != fd_TestAllTypes_optional_fixed32 {
+			break
+		}
+		x.OptionalFixed32 = nil
+		return
+	case 8: // goproto.proto.test2023.TestAllTypes.optional_fixed64
+		if fd != fd_TestAllTypes_optional_fixed64 {
+			break
+		}
+		x.OptionalFixed64 = nil
+		return
+	case 9: // goproto.proto.test2023.TestAllTypes.optional_sfixed32
+		if fd != fd_TestAllTypes_optional_sfixed32 {
+			break
+		}
+		x.OptionalSfixed32 = nil
+		return
+	case 10: // goproto.proto.test2023.TestAllTypes.optional_sfixed64
+		if fd != fd_TestAllTypes_optional_sfixed64 {
+			break
+		}
+		x.OptionalSfixed64 = nil
+		return
+	case 11: // goproto.proto.test2023.TestAllTypes.optional_float
+		if fd != fd_TestAllTypes_optional_float {
+			break
+		}
+		x.OptionalFloat = nil
+		return
+	case 12: // goproto.proto.test2023.TestAllTypes.optional_double
+		if fd != fd_TestAllTypes_optional_double {
+			break
+		}
+		x.OptionalDouble = nil
+		return
+	case 13: // goproto.proto.test2023.TestAllTypes.optional_bool
+		if fd != fd_TestAllTypes_optional_bool {
+			break
+		}
+		x.OptionalBool = nil
+		return
+	case 14: // goproto.proto.test2023.TestAllTypes.optional_string
+		if fd != fd_TestAllTypes_optional_string {
+			break
+		}
+		x.OptionalString = nil
+		return
+	case 15: // goproto.proto.test2023.TestAllTypes.optional_bytes
+		if fd != fd_TestAllTypes_optional_bytes {
+			break
+		}
+		x.OptionalBytes = nil
+		return
+	case 18: // goproto.proto.test2023.TestAllTypes.optional_nested_message
+		if fd != fd_TestAllTypes_optional_nested_message {
+			break
+		}
+		x.OptionalNestedMessage = nil
+		return
+	case 21: // goproto.proto.test2023.TestAllTypes.optional_nested_enum
+		if fd != fd_TestAllTypes_optional_nested_enum {
+			break
+		}
+		x.OptionalNestedEnum = nil
+		return
+	case 22: // goproto.proto.test2023.TestAllTypes.optional_closed_enum
+		if fd != fd_TestAllTypes_optional_closed_enum {
+			break
+		}
+		x.OptionalClosedEnum = nil
+		return
+	case 23: // goproto.proto.test2023.TestAllTypes.implicit_int32
+		if fd != fd_TestAllTypes_implicit_int32 {
+			break
+		}
+		x.ImplicitInt32 = int32(0)
+		return
+	case 24: // goproto.proto.test2023.TestAllTypes.implicit_string
+		if fd != fd_TestAllTypes_implicit_string {
+			break
+		}
+		x.ImplicitString = ""
+		return
+	case 25: // goproto.proto.test2023.TestAllTypes.implicit_bytes
+		if fd != fd_TestAllTypes_implicit_bytes {
+			break
+		}
+		x.ImplicitBytes = nil
+		return
+	case 26: // goproto.proto.test2023.TestAllTypes.implicit_enum
+		if fd != fd_TestAllTypes_implicit_enum {
+			break
+		}
+		x.ImplicitEnum = 0
+		return
+	case 31: // goproto.proto.test2023.TestAllTypes.repeated_int32
+		if fd != fd_TestAllTypes_repeated_int32 {
+			break
+		}
+		x.RepeatedInt32 = nil
+		return
+	case 32: // goproto.proto.test2023.TestAllTypes.repeated_int64
+		if fd != fd_TestAllTypes_repeated_int64 {
+			break
+		}
+		x.RepeatedInt64 = nil
+		return
+	case 33: // goproto.proto.test2023.TestAllTypes.repeated_uint32
+		if fd != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
+		x.RepeatedUint32 = nil
+		return
+	case 34: // goproto.proto.test2023.TestAllTypes.repeated_uint64
+		if fd != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
+		x.RepeatedUint64 = nil
+		return
+	case 35: // goproto.proto.test2023.TestAllTypes.repeated_sint32
+		if fd != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
+		x.RepeatedSint32 = nil
+		return
+	case 36: // goproto.proto.test2023.TestAllTypes.repeated_sint64
+		if fd != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
+		x.RepeatedSint64 = nil
+		return
+	case 37: // goproto.proto.test2023.TestAllTypes.repeated_fixed32
+		if fd != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
+		x.RepeatedFixed32 = nil
+		return
+	case 38: // goproto.proto.test2023.TestAllTypes.repeated_fixed64
+		if fd != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
+		x.RepeatedFixed64 = nil
+		return
+	case 39: // goproto.proto.test2023.TestAllTypes.repeated_sfixed32
+		if fd != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
+		x.RepeatedSfixed32 = nil
+		return
+	case 40: // goproto.proto.test2023.TestAllTypes.repeated_sfixed64
+		if fd != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
+		x.RepeatedSfixed64 = nil
+		return
+	case 41: // goproto.proto.test2023.TestAllTypes.repeated_float
+		if fd != fd_TestAllTypes_repeated_float {
+			break
+		}
+		x.RepeatedFloat = nil
+		return
+	case 42: // goproto.proto.test2023.TestAllTypes.repeated_double
+		if fd != fd_TestAllTypes_repeated_double {
+			break
+		}
+		x.RepeatedDouble = nil
+		return
+	case 43: // goproto.proto.test2023.TestAllTypes.repeated_bool
+		if fd != fd_TestAllTypes_repeated_bool {
+			break
+		}
+		x.RepeatedBool = nil
+		return
+	case 44: // goproto.proto.test2023.TestAllTypes.repeated_string
+		if fd != fd_TestAllTypes_repeated_string {
+			break
+		}
+		x.RepeatedString = nil
+		return
+	case 45: // goproto.proto.test2023.TestAllTypes.repeated_bytes
+		if fd != fd_TestAllTypes_repeated_bytes {
+			break
+		}
+		x.RepeatedBytes = nil
+		return
+	case 48: // goproto.proto.test2023.TestAllTypes.repeated_nested_message
+		if fd != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
+		x.RepeatedNestedMessage = nil
+		return
+	case 51: // goproto.proto.test2023.TestAllTypes.repeated_nested_enum
+		if fd != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
+		x.RepeatedNestedEnum = nil
+		return
+	case 52: // goproto.proto.test2023.TestAllTypes.repeated_closed_enum
+		if fd != fd_TestAllTypes_repeated_closed_enum {
+			break
+		}
+		x.RepeatedClosedEnum = nil
+		return
+	case 53: // goproto.proto.test2023.TestAllTypes.expanded_int32
+		if fd != fd_TestAllTypes_expanded_int32 {
+			break
+		}
+		x.ExpandedInt32 = nil
+		return
+	case 54: // goproto.proto.test2023.TestAllTypes.expanded_double
+		if fd != fd_TestAllTypes_expanded_double {
+			break
+		}
+		x.ExpandedDouble = nil
+		return
+	case 55: // goproto.proto.test2023.TestAllTypes.expanded_closed_enum
+		if fd != fd_TestAllTypes_expanded_closed_enum {
+			break
+		}
+		x.ExpandedClosedEnum = nil
+		return
+	case 56: // goproto.proto.test2023.TestAllTypes.map_int32_int32
+		if fd != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
+		x.MapInt32Int32 = nil
+		return
+	case 69: // goproto.proto.test2023.TestAllTypes.map_string_string
+		if fd != fd_TestAllTypes_map_string_string {
+			break
+		}
+		x.MapStringString = nil
+		return
+	case 71: // goproto.proto.test2023.TestAllTypes.map_string_nested_message
+		if fd != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
+		x.MapStringNestedMessage = nil
+		return
+	case 73: // goproto.proto.test2023.TestAllTypes.map_string_nested_enum
+		if fd != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
+		x.MapStringNestedEnum = nil
+		return
+	case 74: // goproto.proto.test2023.TestAllTypes.map_string_closed_enum
+		if fd != fd_TestAllTypes_map_string_closed_enum {
+			break
+		}
+		x.MapStringClosedEnum = nil
+		return
+	case 75: // goproto.proto.test2023.TestAllTypes.unverified_string
+		if fd != fd_TestAllTypes_unverified_string {
+			break
+		}
+		x.UnverifiedString = nil
+		return
+	case 76: // goproto.proto.test2023.TestAllTypes.unverified_repeated_string
+		if fd != fd_TestAllTypes_unverified_repeated_string {
+			break
+		}
+		x.UnverifiedRepeatedString = nil
+		return
+	case 77: // goproto.proto.test2023.TestAllTypes.unverified_map
+		if fd != fd_TestAllTypes_unverified_map {
+			break
+		}
+		x.UnverifiedMap = nil
+		return
+	case 78: // goproto.proto.test2023.TestAllTypes.delimited_message
+		if fd != fd_TestAllTypes_delimited_message {
+			break
+		}
+		x.DelimitedMessage = nil
+		return
+	case 79: // goproto.proto.test2023.TestAllTypes.repeated_delimited_message
+		if fd != fd_TestAllTypes_repeated_delimited_message {
+			break
+		}
+		x.RepeatedDelimitedMessage = nil
+		return
+	case 81: // goproto.proto.test2023.TestAllTypes.default_int32
+		if fd != fd_TestAllTypes_default_int32 {
+			break
+		}
+		x.DefaultInt32 = nil
+		return
+	case 94: // goproto.proto.test2023.TestAllTypes.default_string
+		if fd != fd_TestAllTypes_default_string {
+			break
+		}
+		x.DefaultString = nil
+		return
+	case 97: // goproto.proto.test2023.TestAllTypes.default_closed_enum
+		if fd != fd_TestAllTypes_default_closed_enum {
+			break
+		}
+		x.DefaultClosedEnum = nil
+		return
+	case 111: // goproto.proto.test2023.TestAllTypes.oneof_uint32
+		if fd != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
+		x.OneofField = nil
+		return
+	case 112: // goproto.proto.test2023.TestAllTypes.oneof_nested_message
+		if fd != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
+		x.OneofField = nil
+		return
+	case 113: // goproto.proto.test2023.TestAllTypes.oneof_string
+		if fd != fd_TestAllTypes_oneof_string {
+			break
+		}
+		x.OneofField = nil
+		return
+	case 114: // goproto.proto.test2023.TestAllTypes.oneof_bytes
+		if fd != fd_TestAllTypes_oneof_bytes {
+			break
+		}
+		x.OneofField = nil
+		return
+	case 115: // goproto.proto.test2023.TestAllTypes.oneof_closed_enum
+		if fd != fd_TestAllTypes_oneof_closed_enum {
+			break
+		}
+		x.OneofField = nil
+		return
+	case 116: // goproto.proto.test2023.TestAllTypes.oneof_delimited
+		if fd != fd_TestAllTypes_oneof_delimited {
+			break
+		}
+		x.OneofField = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestAllTypes) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test2023.TestAllTypes.optional_int32
+		if descriptor != fd_TestAllTypes_optional_int32 {
+			break
+		}
+		if x.OptionalInt32 == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.OptionalInt32
+		return protoreflect.ValueOfInt32(value)
+	case 2: // goproto.proto.test2023.TestAllTypes.optional_int64
+		if descriptor != fd_TestAllTypes_optional_int64 {
+			break
+		}
+		if x.OptionalInt64 == nil {
+			return protoreflect.ValueOfInt64(int64(0))
+		}
+		value := *x.OptionalInt64
+		return protoreflect.ValueOfInt64(value)
+	case 3: // goproto.proto.test2023.TestAllTypes.optional_uint32
+		if descriptor != fd_TestAllTypes_optional_uint32 {
+			break
+		}
+		if x.OptionalUint32 == nil {
+			return protoreflect.ValueOfUint32(uint32(0))
+		}
+		value := *x.OptionalUint32
+		return protoreflect.ValueOfUint32(value)
+	case 4: // goproto.proto.test2023.TestAllTypes.optional_uint64
+		if descriptor != fd_TestAllTypes_optional_uint64 {
+			break
+		}
+		if x.OptionalUint64 == nil {
+			return protoreflect.ValueOfUint64(uint64(0))
+		}
+		value := *x.OptionalUint64
+		return protoreflect.ValueOfUint64(value)
+	case 5: // goproto.proto.test2023.TestAllTypes.optional_sint32
+		if descriptor != fd_TestAllTypes_optional_sint32 {
+			break
+		}
+		if x.OptionalSint32 == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.OptionalSint32
+		return protoreflect.ValueOfInt32(value)
+	case 6: // goproto.proto.test2023.TestAllTypes.optional_sint64
+		if descriptor != fd_TestAllTypes_optional_sint64 {
+			break
+		}
+		if x.OptionalSint64 == nil {
+			return protoreflect.ValueOfInt64(int64(0))
+		}
+		value := *x.OptionalSint64
+		return protoreflect.ValueOfInt64(value)
+	case 7: // goproto.proto.test2023.TestAllTypes.optional_fixed32
+		if descriptor != fd_TestAllTypes_optional_fixed32 {
+			break
+		}
+		if x.OptionalFixed32 == nil {
+			return protoreflect.ValueOfUint32(uint32(0))
+		}
+		value := *x.OptionalFixed32
+		return protoreflect.ValueOfUint32(value)
+	case 8: // goproto.proto.test2023.TestAllTypes.optional_fixed64
+		if descriptor != fd_TestAllTypes_optional_fixed64 {
+			break
+		}
+		if x.OptionalFixed64 == nil {
+			return protoreflect.ValueOfUint64(uint64(0))
+		}
+		value := *x.OptionalFixed64
+		return protoreflect.ValueOfUint64(value)
+	case 9: // goproto.proto.test2023.TestAllTypes.optional_sfixed32
+		if descriptor != fd_TestAllTypes_optional_sfixed32 {
+			break
+		}
+		if x.OptionalSfixed32 == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.OptionalSfixed32
+		return protoreflect.ValueOfInt32(value)
+	case 10: // goproto.proto.test2023.TestAllTypes.optional_sfixed64
+		if descriptor != fd_TestAllTypes_optional_sfixed64 {
+			break
+		}
+		if x.OptionalSfixed64 == nil {
+			return protoreflect.ValueOfInt64(int64(0))
+		}
+		value := *x.OptionalSfixed64
+		return protoreflect.ValueOfInt64(value)
+	case 11: // goproto.proto.test2023.TestAllTypes.optional_float
+		if descriptor != fd_TestAllTypes_optional_float {
+			break
+		}
+		if x.OptionalFloat == nil {
+			return protoreflect.ValueOfFloat32(float32(0))
+		}
+		value := *x.OptionalFloat
+		return protoreflect.ValueOfFloat32(value)
+	case 12: // goproto.proto.test2023.TestAllTypes.optional_double
+		if descriptor != fd_TestAllTypes_optional_double {
+			break
+		}
+		if x.OptionalDouble == nil {
+			return protoreflect.ValueOfFloat64(float64(0))
+		}
+		value := *x.OptionalDouble
+		return protoreflect.ValueOfFloat64(value)
+	case 13: // goproto.proto.test2023.TestAllTypes.optional_bool
+		if descriptor != fd_TestAllTypes_optional_bool {
+			break
+		}
+		if x.OptionalBool == nil {
+			return protoreflect.ValueOfBool(false)
+		}
+		value := *x.OptionalBool
+		return protoreflect.ValueOfBool(value)
+	case 14: // goproto.proto.test2023.TestAllTypes.optional_string
+		if descriptor != fd_TestAllTypes_optional_string {
+			break
+		}
+		if x.OptionalString == nil {
+			return protoreflect.ValueOfString("")
+		}
+		value := *x.OptionalString
+		return protoreflect.ValueOfString(value)
+	case 15: // goproto.proto.test2023.TestAllTypes.optional_bytes
+		if descriptor != fd_TestAllTypes_optional_bytes {
+			break
+		}
+		if x.OptionalBytes == nil {
+			return protoreflect.ValueOfBytes(nil)
+		}
+		value := x.OptionalBytes
+		return protoreflect.ValueOfBytes(value)
+	case 18: // goproto.proto.test2023.TestAllTypes.optional_nested_message
+		if descriptor != fd_TestAllTypes_optional_nested_message {
+			break
+		}
+		value := x.OptionalNestedMessage
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 21: // goproto.proto.test2023.TestAllTypes.optional_nested_enum
+		if descriptor != fd_TestAllTypes_optional_nested_enum {
+			break
+		}
+		if x.OptionalNestedEnum == nil {
+			return protoreflect.ValueOfEnum(0)
+		}
+		value := *x.OptionalNestedEnum
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case 22: // goproto.proto.test2023.TestAllTypes.optional_closed_enum
+		if descriptor != fd_TestAllTypes_optional_closed_enum {
+			break
+		}
+		if x.OptionalClosedEnum == nil {
+			return protoreflect.ValueOfEnum(0)
+		}
+		value := *x.OptionalClosedEnum
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case 23: // goproto.proto.test2023.TestAllTypes.implicit_int32
+		if descriptor != fd_TestAllTypes_implicit_int32 {
+			break
+		}
+		value := x.ImplicitInt32
+		return protoreflect.ValueOfInt32(value)
+	case 24: // goproto.proto.test2023.TestAllTypes.implicit_string
+		if descriptor != fd_TestAllTypes_implicit_string {
+			break
+		}
+		value := x.ImplicitString
+		return protoreflect.ValueOfString(value)
+	case 25: // goproto.proto.test2023.TestAllTypes.implicit_bytes
+		if descriptor != fd_TestAllTypes_implicit_bytes {
+			break
+		}
+		value := x.ImplicitBytes
+		return protoreflect.ValueOfBytes(value)
+	case 26: // goproto.proto.test2023.TestAllTypes.implicit_enum
+		if descriptor != fd_TestAllTypes_implicit_enum {
+			break
+		}
+		value := x.ImplicitEnum
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case 31: // goproto.proto.test2023.TestAllTypes.repeated_int32
+		if descriptor != fd_TestAllTypes_repeated_int32 {
+			break
+		}
+		if len(x.RepeatedInt32) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_31_list{})
+		}
+		listValue := &_TestAllTypes_31_list{list: &x.RepeatedInt32}
+		return protoreflect.ValueOfList(listValue)
+	case 32: // goproto.proto.test2023.TestAllTypes.repeated_int64
+		if descriptor != fd_TestAllTypes_repeated_int64 {
+			break
+		}
+		if len(x.RepeatedInt64) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_32_list{})
+		}
+		listValue := &_TestAllTypes_32_list{list: &x.RepeatedInt64}
+		return protoreflect.ValueOfList(listValue)
+	case 33: // goproto.proto.test2023.TestAllTypes.repeated_uint32
+		if descriptor != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
+		if len(x.RepeatedUint32) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_33_list{})
+		}
+		listValue := &_TestAllTypes_33_list{list: &x.RepeatedUint32}
+		return protoreflect.ValueOfList(listValue)
+	case 34: // goproto.proto.test2023.TestAllTypes.repeated_uint64
+		if descriptor != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
+		if len(x.RepeatedUint64) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_34_list{})
+		}
+		listValue := &_TestAllTypes_34_list{list: &x.RepeatedUint64}
+		return protoreflect.ValueOfList(listValue)
+	case 35: // goproto.proto.test2023.TestAllTypes.repeated_sint32
+		if descriptor != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
+		if len(x.RepeatedSint32) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_35_list{})
+		}
+		listValue := &_TestAllTypes_35_list{list: &x.RepeatedSint32}
+		return protoreflect.ValueOfList(listValue)
+	case 36: // goproto.proto.test2023.TestAllTypes.repeated_sint64
+		if descriptor != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
+		if len(x.RepeatedSint64) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_36_list{})
+		}
+		listValue := &_TestAllTypes_36_list{list: &x.RepeatedSint64}
+		return protoreflect.ValueOfList(listValue)
+	case 37: // goproto.proto.test2023.TestAllTypes.repeated_fixed32
+		if descriptor != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
+		if len(x.RepeatedFixed32) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_37_list{})
+		}
+		listValue := &_TestAllTypes_37_list{list: &x.RepeatedFixed32}
+		return protoreflect.ValueOfList(listValue)
+	case 38: // goproto.proto.test2023.TestAllTypes.repeated_fixed64
+		if descriptor != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
+		if len(x.RepeatedFixed64) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_38_list{})
+		}
+		listValue := &_TestAllTypes_38_list{list: &x.RepeatedFixed64}
+		return protoreflect.ValueOfList(listValue)
+	case 39: // goproto.proto.test2023.TestAllTypes.repeated_sfixed32
+		if descriptor != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
+		if len(x.RepeatedSfixed32) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_39_list{})
+		}
+		listValue := &_TestAllTypes_39_list{list: &x.RepeatedSfixed32}
+		return protoreflect.ValueOfList(listValue)
+	case 40: // goproto.proto.test2023.TestAllTypes.repeated_sfixed64
+		if descriptor != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
+		if len(x.RepeatedSfixed64) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_40_list{})
+		}
+		listValue := &_TestAllTypes_40_list{list: &x.RepeatedSfixed64}
+		return protoreflect.ValueOfList(listValue)
+	case 41: // goproto.proto.test2023.TestAllTypes.repeated_float
+		if descriptor != fd_TestAllTypes_repeated_float {
+			break
+		}
+		if len(x.RepeatedFloat) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_41_list{})
+		}
+		listValue := &_TestAllTypes_41_list{list: &x.RepeatedFloat}
+		return protoreflect.ValueOfList(listValue)
+	case 42: // goproto.proto.test2023.TestAllTypes.repeated_double
+		if descriptor != fd_TestAllTypes_repeated_double {
+			break
+		}
+		if len(x.RepeatedDouble) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_42_list{})
+		}
+		listValue := &_TestAllTypes_42_list{list: &x.RepeatedDouble}
+		return protoreflect.ValueOfList(listValue)
+	case 43: // goproto.proto.test2023.TestAllTypes.repeated_bool
+		if descriptor != fd_TestAllTypes_repeated_bool {
+			break
+		}
+		if len(x.RepeatedBool) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_43_list{})
+		}
+		listValue := &_TestAllTypes_43_list{list: &x.RepeatedBool}
+		return protoreflect.ValueOfList(listValue)
+	case 44: // goproto.proto.test2023.TestAllTypes.repeated_string
+		if descriptor != fd_TestAllTypes_repeated_string {
+			break
+		}
+		if len(x.RepeatedString) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_44_list{})
+		}
+		listValue := &_TestAllTypes_44_list{list: &x.RepeatedString}
+		return protoreflect.ValueOfList(listValue)
+	case 45: // goproto.proto.test2023.TestAllTypes.repeated_bytes
+		if descriptor != fd_TestAllTypes_repeated_bytes {
+			break
+		}
+		if len(x.RepeatedBytes) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_45_list{})
+		}
+		listValue := &_TestAllTypes_45_list{list: &x.RepeatedBytes}
+		return protoreflect.ValueOfList(listValue)
+	case 48: // goproto.proto.test2023.TestAllTypes.repeated_nested_message
+		if descriptor != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
+		if len(x.RepeatedNestedMessage) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_48_list{})
+		}
+		listValue := &_TestAllTypes_48_list{list: &x.RepeatedNestedMessage}
+		return protoreflect.ValueOfList(listValue)
+	case 51: // goproto.proto.test2023.TestAllTypes.repeated_nested_enum
+		if descriptor != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
+		if len(x.RepeatedNestedEnum) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_51_list{})
+		}
+		listValue := &_TestAllTypes_51_list{list: &x.RepeatedNestedEnum}
+		return protoreflect.ValueOfList(listValue)
+	case 52: // goproto.proto.test2023.TestAllTypes.repeated_closed_enum
+		if descriptor != fd_TestAllTypes_repeated_closed_enum {
+			break
+		}
+		if len(x.RepeatedClosedEnum) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_52_list{})
+		}
+		listValue := &_TestAllTypes_52_list{list: &x.RepeatedClosedEnum}
+		return protoreflect.ValueOfList(listValue)
+	case 53: // goproto.proto.test2023.TestAllTypes.expanded_int32
+		if descriptor != fd_TestAllTypes_expanded_int32 {
+			break
+		}
+		if len(x.ExpandedInt32) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_53_list{})
+		}
+		listValue := &_TestAllTypes_53_list{list: &x.ExpandedInt32}
+		return protoreflect.ValueOfList(listValue)
+	case 54: // goproto.proto.test2023.TestAllTypes.expanded_double
+		if descriptor != fd_TestAllTypes_expanded_double {
+			break
+		}
+		if len(x.ExpandedDouble) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_54_list{})
+		}
+		listValue := &_TestAllTypes_54_list{list: &x.ExpandedDouble}
+		return protoreflect.ValueOfList(listValue)
+	case 55: // goproto.proto.test2023.TestAllTypes.expanded_closed_enum
+		if descriptor != fd_TestAllTypes_expanded_closed_enum {
+			break
+		}
+		if len(x.ExpandedClosedEnum) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_55_list{})
+		}
+		listValue := &_TestAllTypes_55_list{list: &x.ExpandedClosedEnum}
+		return protoreflect.ValueOfList(listValue)
+	case 56: // goproto.proto.test2023.TestAllTypes.map_int32_int32
+		if descriptor != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
+		if len(x.MapInt32Int32) == 0 {
+			return protoreflect.ValueOfMap(&_TestAllTypes_56_map{})
+		}
+		mapValue := &_TestAllTypes_56_map{m: &x.MapInt32Int32}
+		return protoreflect.ValueOfMap(mapValue)
+	case 69: // goproto.proto.test2023.TestAllTypes.map_string_string
+		if descriptor != fd_TestAllTypes_map_string_string {
+			break
+		}
+		if len(x.MapStringString) == 0 {
+			return protoreflect.ValueOfMap(&_TestAllTypes_69_map{})
+		}
+		mapValue := &_TestAllTypes_69_map{m: &x.MapStringString}
+		return protoreflect.ValueOfMap(mapValue)
+	case 71: // goproto.proto.test2023.TestAllTypes.map_string_nested_message
+		if descriptor != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
+		if len(x.MapStringNestedMessage) == 0 {
+			return protoreflect.ValueOfMap(&_TestAllTypes_71_map{})
+		}
+		mapValue := &_TestAllTypes_71_map{m: &x.MapStringNestedMessage}
+		return protoreflect.ValueOfMap(mapValue)
+	case 73: // goproto.proto.test2023.TestAllTypes.map_string_nested_enum
+		if descriptor != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
+		if len(x.MapStringNestedEnum) == 0 {
+			return protoreflect.ValueOfMap(&_TestAllTypes_73_map{})
+		}
+		mapValue := &_TestAllTypes_73_map{m: &x.MapStringNestedEnum}
+		return protoreflect.ValueOfMap(mapValue)
+	case 74: // goproto.proto.test2023.TestAllTypes.map_string_closed_enum
+		if descriptor != fd_TestAllTypes_map_string_closed_enum {
+			break
+		}
+		if len(x.MapStringClosedEnum) == 0 {
+			return protoreflect.ValueOfMap(&_TestAllTypes_74_map{})
+		}
+		mapValue := &_TestAllTypes_74_map{m: &x.MapStringClosedEnum}
+		return protoreflect.ValueOfMap(mapValue)
+	case 75: // goproto.proto.test2023.TestAllTypes.unverified_string
+		if descriptor != fd_TestAllTypes_unverified_string {
+			break
+		}
+		if x.UnverifiedString == nil {
+			return protoreflect.ValueOfString("")
+		}
+		value := *x.UnverifiedString
+		return protoreflect.ValueOfString(value)
+	case 76: // goproto.proto.test2023.TestAllTypes.unverified_repeated_string
+		if descriptor != fd_TestAllTypes_unverified_repeated_string {
+			break
+		}
+		if len(x.UnverifiedRepeatedString) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_76_list{})
+		}
+		listValue := &_TestAllTypes_76_list{list: &x.UnverifiedRepeatedString}
+		return protoreflect.ValueOfList(listValue)
+	case 77: // goproto.proto.test2023.TestAllTypes.unverified_map
+		if descriptor != fd_TestAllTypes_unverified_map {
+			break
+		}
+		if len(x.UnverifiedMap) == 0 {
+			return protoreflect.ValueOfMap(&_TestAllTypes_77_map{})
+		}
+		mapValue := &_TestAllTypes_77_map{m: &x.UnverifiedMap}
+		return protoreflect.ValueOfMap(mapValue)
+	case 78: // goproto.proto.test2023.TestAllTypes.delimited_message
+		if descriptor != fd_TestAllTypes_delimited_message {
+			break
+		}
+		value := x.DelimitedMessage
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 79: // goproto.proto.test2023.TestAllTypes.repeated_delimited_message
+		if descriptor != fd_TestAllTypes_repeated_delimited_message {
+			break
+		}
+		if len(x.RepeatedDelimitedMessage) == 0 {
+			return protoreflect.ValueOfList(&_TestAllTypes_79_list{})
+		}
+		listValue := &_TestAllTypes_79_list{list: &x.RepeatedDelimitedMessage}
+		return protoreflect.ValueOfList(listValue)
+	case 81: // goproto.proto.test2023.TestAllTypes.default_int32
+		if descriptor != fd_TestAllTypes_default_int32 {
+			break
+		}
+		if x.DefaultInt32 == nil {
+			return protoreflect.ValueOfInt32(Default_TestAllTypes_DefaultInt32)
+		}
+		value := *x.DefaultInt32
+		return protoreflect.ValueOfInt32(value)
+	case 94: // goproto.proto.test2023.TestAllTypes.default_string
+		if descriptor != fd_TestAllTypes_default_string {
+			break
+		}
+		if x.DefaultString == nil {
+			return protoreflect.ValueOfString(Default_TestAllTypes_DefaultString)
+		}
+		value := *x.DefaultString
+		return protoreflect.ValueOfString(value)
+	case 97: // goproto.proto.test2023.TestAllTypes.default_closed_enum
+		if descriptor != fd_TestAllTypes_default_closed_enum {
+			break
+		}
+		if x.DefaultClosedEnum == nil {
+			return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(Default_TestAllTypes_DefaultClosedEnum))
+		}
+		value := *x.DefaultClosedEnum
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case 111: // goproto.proto.test2023.TestAllTypes.oneof_uint32
+		if descriptor != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfUint32(uint32(0))
+		} else if v, ok := x.OneofField.(*TestAllTypes_OneofUint32); ok {
+			return protoreflect.ValueOfUint32(v.OneofUint32)
+		} else {
+			return protoreflect.ValueOfUint32(uint32(0))
+		}
+	case 112: // goproto.proto.test2023.TestAllTypes.oneof_nested_message
+		if descriptor != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfMessage((*TestAllTypes_NestedMessage)(nil).ProtoReflect())
+		} else if v, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); ok {
+			return protoreflect.ValueOfMessage(v.OneofNestedMessage.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*TestAllTypes_NestedMessage)(nil).ProtoReflect())
+		}
+	case 113: // goproto.proto.test2023.TestAllTypes.oneof_string
+		if descriptor != fd_TestAllTypes_oneof_string {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfString("")
+		} else if v, ok := x.OneofField.(*TestAllTypes_OneofString); ok {
+			return protoreflect.ValueOfString(v.OneofString)
+		} else {
+			return protoreflect.ValueOfString("")
+		}
+	case 114: // goproto.proto.test2023.TestAllTypes.oneof_bytes
+		if descriptor != fd_TestAllTypes_oneof_bytes {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfBytes(nil)
+		} else if v, ok := x.OneofField.(*TestAllTypes_OneofBytes); ok {
+			return protoreflect.ValueOfBytes(v.OneofBytes)
+		} else {
+			return protoreflect.ValueOfBytes(nil)
+		}
+	case 115: // goproto.proto.test2023.TestAllTypes.oneof_closed_enum
+		if descriptor != fd_TestAllTypes_oneof_closed_enum {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfEnum(0)
+		} else if v, ok := x.OneofField.(*TestAllTypes_OneofClosedEnum); ok {
+			return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v.OneofClosedEnum))
+		} else {
+			return protoreflect.ValueOfEnum(0)
+		}
+	case 116: // goproto.proto.test2023.TestAllTypes.oneof_delimited
+		if descriptor != fd_TestAllTypes_oneof_delimited {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfMessage((*TestAllTypes_NestedMessage)(nil).ProtoReflect())
+		} else if v, ok := x.OneofField.(*TestAllTypes_OneofDelimited); ok {
+			return protoreflect.ValueOfMessage(v.OneofDelimited.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*TestAllTypes_NestedMessage)(nil).ProtoReflect())
+		}
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestAllTypes); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2023.TestAllTypes.optional_int32
+		if fd != fd_TestAllTypes_optional_int32 {
+			break
+		}
+		cv := int32(value.Int())
+		x.OptionalInt32 = &cv
+		return
+	case 2: // goproto.proto.test2023.TestAllTypes.optional_int64
+		if fd != fd_TestAllTypes_optional_int64 {
+			break
+		}
+		cv := value.Int()
+		x.OptionalInt64 = &cv
+		return
+	case 3: // goproto.proto.test2023.TestAllTypes.optional_uint32
+		if fd != fd_TestAllTypes_optional_uint32 {
+			break
+		}
+		cv := uint32(value.Uint())
+		x.OptionalUint32 = &cv
+		return
+	case 4: // goproto.proto.test2023.TestAllTypes.optional_uint64
+		if fd != fd_TestAllTypes_optional_uint64 {
+			break
+		}
+		cv := value.Uint()
+		x.OptionalUint64 = &cv
+		return
+	case 5: // goproto.proto.test2023.TestAllTypes.optional_sint32
+		if fd != fd_TestAllTypes_optional_sint32 {
+			break
+		}
+		cv := int32(value.Int())
+		x.OptionalSint32 = &cv
+		return
+	case 6: // goproto.proto.test2023.TestAllTypes.optional_sint64
+		if fd != fd_TestAllTypes_optional_sint64 {
+			break
+		}
+		cv := value.Int()
+		x.OptionalSint64 = &cv
+		return
+	case 7: // goproto.proto.test2023.TestAllTypes.optional_fixed32
+		if fd != fd_TestAllTypes_optional_fixed32 {
+			break
+		}
+		cv := uint32(value.Uint())
+		x.OptionalFixed32 = &cv
+		return
+	case 8: // goproto.proto.test2023.TestAllTypes.optional_fixed64
+		if fd != fd_TestAllTypes_optional_fixed64 {
+			break
+		}
+		cv := value.Uint()
+		x.OptionalFixed64 = &cv
+		return
+	case 9: // goproto.proto.test2023.TestAllTypes.optional_sfixed32
+		if fd != fd_TestAllTypes_optional_sfixed32 {
+			break
+		}
+		cv := int32(value.Int())
+		x.OptionalSfixed32 = &cv
+		return
+	case 10: // goproto.proto.test2023.TestAllTypes.optional_sfixed64
+		if fd != fd_TestAllTypes_optional_sfixed64 {
+			break
+		}
+		cv := value.Int()
+		x.OptionalSfixed64 = &cv
+		return
+	case 11: // goproto.proto.test2023.TestAllTypes.optional_float
+		if fd != fd_TestAllTypes_optional_float {
+			break
+		}
+		cv := float32(value.Float())
+		x.OptionalFloat = &cv
+		return
+	case 12: // goproto.proto.test2023.TestAllTypes.optional_double
+		if fd != fd_TestAllTypes_optional_double {
+			break
+		}
+		cv := value.Float()
+		x.OptionalDouble = &cv
+		return
+	case 13: // goproto.proto.test2023.TestAllTypes.optional_bool
+		if fd != fd_TestAllTypes_optional_bool {
+			break
+		}
+		cv := value.Bool()
+		x.OptionalBool = &cv
+		return
+	case 14: // goproto.proto.test2023.TestAllTypes.optional_string
+		if fd != fd_TestAllTypes_optional_string {
+			break
+		}
+		cv := value.Interface().(string)
+		x.OptionalString = &cv
+		return
+	case 15: // goproto.proto.test2023.TestAllTypes.optional_bytes
+		if fd != fd_TestAllTypes_optional_bytes {
+			break
+		}
+		x.OptionalBytes = value.Bytes()
+		if x.OptionalBytes == nil {
+			x.OptionalBytes = []byte{}
+		}
+		return
+	case 18: // goproto.proto.test2023.TestAllTypes.optional_nested_message
+		if fd != fd_TestAllTypes_optional_nested_message {
+			break
+		}
+		x.OptionalNestedMessage = value.Message().Interface().(*TestAllTypes_NestedMessage)
+		return
+	case 21: // goproto.proto.test2023.TestAllTypes.optional_nested_enum
+		if fd != fd_TestAllTypes_optional_nested_enum {
+			break
+		}
+		cv := (TestAllTypes_NestedEnum)(value.Enum())
+		x.OptionalNestedEnum = &cv
+		return
+	case 22: // goproto.proto.test2023.TestAllTypes.optional_closed_enum
+		if fd != fd_TestAllTypes_optional_closed_enum {
+			break
+		}
+		cv := (ClosedEnum)(value.Enum())
+		x.OptionalClosedEnum = &cv
+		return
+	case 23: // goproto.proto.test2023.TestAllTypes.implicit_int32
+		if fd != fd_TestAllTypes_implicit_int32 {
+			break
+		}
+		x.ImplicitInt32 = int32(value.Int())
+		return
+	case 24: // goproto.proto.test2023.TestAllTypes.implicit_string
+		if fd != fd_TestAllTypes_implicit_string {
+			break
+		}
+		x.ImplicitString = value.Interface().(string)
+		return
+	case 25: // goproto.proto.test2023.TestAllTypes.implicit_bytes
+		if fd != fd_TestAllTypes_implicit_bytes {
+			break
+		}
+		x.ImplicitBytes = value.Bytes()
+		return
+	case 26: // goproto.proto.test2023.TestAllTypes.implicit_enum
+		if fd != fd_TestAllTypes_implicit_enum {
+			break
+		}
+		x.ImplicitEnum = (TestAllTypes_NestedEnum)(value.Enum())
+		return
+	case 31: // goproto.proto.test2023.TestAllTypes.repeated_int32
+		if fd != fd_TestAllTypes_repeated_int32 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_31_list)
+		x.RepeatedInt32 = *clv.list
+		return
+	case 32: // goproto.proto.test2023.TestAllTypes.repeated_int64
+		if fd != fd_TestAllTypes_repeated_int64 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_32_list)
+		x.RepeatedInt64 = *clv.list
+		return
+	case 33: // goproto.proto.test2023.TestAllTypes.repeated_uint32
+		if fd != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_33_list)
+		x.RepeatedUint32 = *clv.list
+		return
+	case 34: // goproto.proto.test2023.TestAllTypes.repeated_uint64
+		if fd != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_34_list)
+		x.RepeatedUint64 = *clv.list
+		return
+	case 35: // goproto.proto.test2023.TestAllTypes.repeated_sint32
+		if fd != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_35_list)
+		x.RepeatedSint32 = *clv.list
+		return
+	case 36: // goproto.proto.test2023.TestAllTypes.repeated_sint64
+		if fd != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_36_list)
+		x.RepeatedSint64 = *clv.list
+		return
+	case 37: // goproto.proto.test2023.TestAllTypes.repeated_fixed32
+		if fd != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_37_list)
+		x.RepeatedFixed32 = *clv.list
+		return
+	case 38: // goproto.proto.test2023.TestAllTypes.repeated_fixed64
+		if fd != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_38_list)
+		x.RepeatedFixed64 = *clv.list
+		return
+	case 39: // goproto.proto.test2023.TestAllTypes.repeated_sfixed32
+		if fd != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_39_list)
+		x.RepeatedSfixed32 = *clv.list
+		return
+	case 40: // goproto.proto.test2023.TestAllTypes.repeated_sfixed64
+		if fd != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_40_list)
+		x.RepeatedSfixed64 = *clv.list
+		return
+	case 41: // goproto.proto.test2023.TestAllTypes.repeated_float
+		if fd != fd_TestAllTypes_repeated_float {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_41_list)
+		x.RepeatedFloat = *clv.list
+		return
+	case 42: // goproto.proto.test2023.TestAllTypes.repeated_double
+		if fd != fd_TestAllTypes_repeated_double {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_42_list)
+		x.RepeatedDouble = *clv.list
+		return
+	case 43: // goproto.proto.test2023.TestAllTypes.repeated_bool
+		if fd != fd_TestAllTypes_repeated_bool {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_43_list)
+		x.RepeatedBool = *clv.list
+		return
+	case 44: // goproto.proto.test2023.TestAllTypes.repeated_string
+		if fd != fd_TestAllTypes_repeated_string {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_44_list)
+		x.RepeatedString = *clv.list
+		return
+	case 45: // goproto.proto.test2023.TestAllTypes.repeated_bytes
+		if fd != fd_TestAllTypes_repeated_bytes {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_45_list)
+		x.RepeatedBytes = *clv.list
+		return
+	case 48: // goproto.proto.test2023.TestAllTypes.repeated_nested_message
+		if fd != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_48_list)
+		x.RepeatedNestedMessage = *clv.list
+		return
+	case 51: // goproto.proto.test2023.TestAllTypes.repeated_nested_enum
+		if fd != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_51_list)
+		x.RepeatedNestedEnum = *clv.list
+		return
+	case 52: // goproto.proto.test2023.TestAllTypes.repeated_closed_enum
+		if fd != fd_TestAllTypes_repeated_closed_enum {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_52_list)
+		x.RepeatedClosedEnum = *clv.list
+		return
+	case 53: // goproto.proto.test2023.TestAllTypes.expanded_int32
+		if fd != fd_TestAllTypes_expanded_int32 {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_53_list)
+		x.ExpandedInt32 = *clv.list
+		return
+	case 54: // goproto.proto.test2023.TestAllTypes.expanded_double
+		if fd != fd_TestAllTypes_expanded_double {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_54_list)
+		x.ExpandedDouble = *clv.list
+		return
+	case 55: // goproto.proto.test2023.TestAllTypes.expanded_closed_enum
+		if fd != fd_TestAllTypes_expanded_closed_enum {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_55_list)
+		x.ExpandedClosedEnum = *clv.list
+		return
+	case 56: // goproto.proto.test2023.TestAllTypes.map_int32_int32
+		if fd != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_TestAllTypes_56_map)
+		x.MapInt32Int32 = *cmv.m
+		return
+	case 69: // goproto.proto.test2023.TestAllTypes.map_string_string
+		if fd != fd_TestAllTypes_map_string_string {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_TestAllTypes_69_map)
+		x.MapStringString = *cmv.m
+		return
+	case 71: // goproto.proto.test2023.TestAllTypes.map_string_nested_message
+		if fd != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_TestAllTypes_71_map)
+		x.MapStringNestedMessage = *cmv.m
+		return
+	case 73: // goproto.proto.test2023.TestAllTypes.map_string_nested_enum
+		if fd != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_TestAllTypes_73_map)
+		x.MapStringNestedEnum = *cmv.m
+		return
+	case 74: // goproto.proto.test2023.TestAllTypes.map_string_closed_enum
+		if fd != fd_TestAllTypes_map_string_closed_enum {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_TestAllTypes_74_map)
+		x.MapStringClosedEnum = *cmv.m
+		return
+	case 75: // goproto.proto.test2023.TestAllTypes.unverified_string
+		if fd != fd_TestAllTypes_unverified_string {
+			break
+		}
+		cv := value.Interface().(string)
+		x.UnverifiedString = &cv
+		return
+	case 76: // goproto.proto.test2023.TestAllTypes.unverified_repeated_string
+		if fd != fd_TestAllTypes_unverified_repeated_string {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_76_list)
+		x.UnverifiedRepeatedString = *clv.list
+		return
+	case 77: // goproto.proto.test2023.TestAllTypes.unverified_map
+		if fd != fd_TestAllTypes_unverified_map {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_TestAllTypes_77_map)
+		x.UnverifiedMap = *cmv.m
+		return
+	case 78: // goproto.proto.test2023.TestAllTypes.delimited_message
+		if fd != fd_TestAllTypes_delimited_message {
+			break
+		}
+		x.DelimitedMessage = value.Message().Interface().(*TestAllTypes_NestedMessage)
+		return
+	case 79: // goproto.proto.test2023.TestAllTypes.repeated_delimited_message
+		if fd != fd_TestAllTypes_repeated_delimited_message {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_TestAllTypes_79_list)
+		x.RepeatedDelimitedMessage = *clv.list
+		return
+	case 81: // goproto.proto.test2023.TestAllTypes.default_int32
+		if fd != fd_TestAllTypes_default_int32 {
+			break
+		}
+		cv := int32(value.Int())
+		x.DefaultInt32 = &cv
+		return
+	case 94: // goproto.proto.test2023.TestAllTypes.default_string
+		if fd != fd_TestAllTypes_default_string {
+			break
+		}
+		cv := value.Interface().(string)
+		x.DefaultString = &cv
+		return
+	case 97: // goproto.proto.test2023.TestAllTypes.default_closed_enum
+		if fd != fd_TestAllTypes_default_closed_enum {
+			break
+		}
+		cv := (ClosedEnum)(value.Enum())
+		x.DefaultClosedEnum = &cv
+		return
+	case 111: // goproto.proto.test2023.TestAllTypes.oneof_uint32
+		if fd != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
+		cv := uint32(value.Uint())
+		x.OneofField = &TestAllTypes_OneofUint32{OneofUint32: cv}
+		return
+	case 112: // goproto.proto.test2023.TestAllTypes.oneof_nested_message
+		if fd != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
+		cv := value.Message().Interface().(*TestAllTypes_NestedMessage)
+		x.OneofField = &TestAllTypes_OneofNestedMessage{OneofNestedMessage: cv}
+		return
+	case 113: // goproto.proto.test2023.TestAllTypes.oneof_string
+		if fd != fd_TestAllTypes_oneof_string {
+			break
+		}
+		cv := value.Interface().(string)
+		x.OneofField = &TestAllTypes_OneofString{OneofString: cv}
+		return
+	case 114: // goproto.proto.test2023.TestAllTypes.oneof_bytes
+		if fd != fd_TestAllTypes_oneof_bytes {
+			break
+		}
+		cv := value.Bytes()
+		x.OneofField = &TestAllTypes_OneofBytes{OneofBytes: cv}
+		return
+	case 115: // goproto.proto.test2023.TestAllTypes.oneof_closed_enum
+		if fd != fd_TestAllTypes_oneof_closed_enum {
+			break
+		}
+		cv := (ClosedEnum)(value.Enum())
+		x.OneofField = &TestAllTypes_OneofClosedEnum{OneofClosedEnum: cv}
+		return
+	case 116: // goproto.proto.test2023.TestAllTypes.oneof_delimited
+		if fd != fd_TestAllTypes_oneof_delimited {
+			break
+		}
+		cv := value.Message().Interface().(*TestAllTypes_NestedMessage)
+		x.OneofField = &TestAllTypes_OneofDelimited{OneofDelimited: cv}
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 18: // goproto.proto.test2023.TestAllTypes.optional_nested_message
+		if fd != fd_TestAllTypes_optional_nested_message {
+			break
+		}
+		if x.OptionalNestedMessage == nil {
+			x.OptionalNestedMessage = new(TestAllTypes_NestedMessage)
+		}
+		return protoreflect.ValueOfMessage(x.OptionalNestedMessage.ProtoReflect())
+	case 31: // goproto.proto.test2023.TestAllTypes.repeated_int32
+		if fd != fd_TestAllTypes_repeated_int32 {
+			break
+		}
+		if x.RepeatedInt32 == nil {
+			x.RepeatedInt32 = []int32{}
+		}
+		value := &_TestAllTypes_31_list{list: &x.RepeatedInt32}
+		return protoreflect.ValueOfList(value)
+	case 32: // goproto.proto.test2023.TestAllTypes.repeated_int64
+		if fd != fd_TestAllTypes_repeated_int64 {
+			break
+		}
+		if x.RepeatedInt64 == nil {
+			x.RepeatedInt64 = []int64{}
+		}
+		value := &_TestAllTypes_32_list{list: &x.RepeatedInt64}
+		return protoreflect.ValueOfList(value)
+	case 33: // goproto.proto.test2023.TestAllTypes.repeated_uint32
+		if fd != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
+		if x.RepeatedUint32 == nil {
+			x.RepeatedUint32 = []uint32{}
+		}
+		value := &_TestAllTypes_33_list{list: &x.RepeatedUint32}
+		return protoreflect.ValueOfList(value)
+	case 34: // goproto.proto.test2023.TestAllTypes.repeated_uint64
+		if fd != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
+		if x.RepeatedUint64 == nil {
+			x.RepeatedUint64 = []uint64{}
+		}
+		value := &_TestAllTypes_34_list{list: &x.RepeatedUint64}
+		return protoreflect.ValueOfList(value)
+	case 35: // goproto.proto.test2023.TestAllTypes.repeated_sint32
+		if fd != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
+		if x.RepeatedSint32 == nil {
+			x.RepeatedSint32 = []int32{}
+		}
+		value := &_TestAllTypes_35_list{list: &x.RepeatedSint32}
+		return protoreflect.ValueOfList(value)
+	case 36: // goproto.proto.test2023.TestAllTypes.repeated_sint64
+		if fd != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
+		if x.RepeatedSint64 == nil {
+			x.RepeatedSint64 = []int64{}
+		}
+		value := &_TestAllTypes_36_list{list: &x.RepeatedSint64}
+		return protoreflect.ValueOfList(value)
+	case 37: // goproto.proto.test2023.TestAllTypes.repeated_fixed32
+		if fd != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
+		if x.RepeatedFixed32 == nil {
+			x.RepeatedFixed32 = []uint32{}
+		}
+		value := &_TestAllTypes_37_list{list: &x.RepeatedFixed32}
+		return protoreflect.ValueOfList(value)
+	case 38: // goproto.proto.test2023.TestAllTypes.repeated_fixed64
+		if fd != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
+		if x.RepeatedFixed64 == nil {
+			x.RepeatedFixed64 = []uint64{}
+		}
+		value := &_TestAllTypes_38_list{list: &x.RepeatedFixed64}
+		return protoreflect.ValueOfList(value)
+	case 39: // goproto.proto.test2023.TestAllTypes.repeated_sfixed32
+		if fd != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
+		if x.RepeatedSfixed32 == nil {
+			x.RepeatedSfixed32 = []int32{}
+		}
+		value := &_TestAllTypes_39_list{list: &x.RepeatedSfixed32}
+		return protoreflect.ValueOfList(value)
+	case 40: // goproto.proto.test2023.TestAllTypes.repeated_sfixed64
+		if fd != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
+		if x.RepeatedSfixed64 == nil {
+			x.RepeatedSfixed64 = []int64{}
+		}
+		value := &_TestAllTypes_40_list{list: &x.RepeatedSfixed64}
+		return protoreflect.ValueOfList(value)
+	case 41: // goproto.proto.test2023.TestAllTypes.repeated_float
+		if fd != fd_TestAllTypes_repeated_float {
+			break
+		}
+		if x.RepeatedFloat == nil {
+			x.RepeatedFloat = []float32{}
+		}
+		value := &_TestAllTypes_41_list{list: &x.RepeatedFloat}
+		return protoreflect.ValueOfList(value)
+	case 42: // goproto.proto.test2023.TestAllTypes.repeated_double
+		if fd != fd_TestAllTypes_repeated_double {
+			break
+		}
+		if x.RepeatedDouble == nil {
+			x.RepeatedDouble = []float64{}
+		}
+		value := &_TestAllTypes_42_list{list: &x.RepeatedDouble}
+		return protoreflect.ValueOfList(value)
+	case 43: // goproto.proto.test2023.TestAllTypes.repeated_bool
+		if fd != fd_TestAllTypes_repeated_bool {
+			break
+		}
+		if x.RepeatedBool == nil {
+			x.RepeatedBool = []bool{}
+		}
+		value := &_TestAllTypes_43_list{list: &x.RepeatedBool}
+		return protoreflect.ValueOfList(value)
+	case 44: // goproto.proto.test2023.TestAllTypes.repeated_string
+		if fd != fd_TestAllTypes_repeated_string {
+			break
+		}
+		if x.RepeatedString == nil {
+			x.RepeatedString = []string{}
+		}
+		value := &_TestAllTypes_44_list{list: &x.RepeatedString}
+		return protoreflect.ValueOfList(value)
+	case 45: // goproto.proto.test2023.TestAllTypes.repeated_bytes
+		if fd != fd_TestAllTypes_repeated_bytes {
+			break
+		}
+		if x.RepeatedBytes == nil {
+			x.RepeatedBytes = [][]byte{}
+		}
+		value := &_TestAllTypes_45_list{list: &x.RepeatedBytes}
+		return protoreflect.ValueOfList(value)
+	case 48: // goproto.proto.test2023.TestAllTypes.repeated_nested_message
+		if fd != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
+		if x.RepeatedNestedMessage == nil {
+			x.RepeatedNestedMessage = []*TestAllTypes_NestedMessage{}
+		}
+		value := &_TestAllTypes_48_list{list: &x.RepeatedNestedMessage}
+		return protoreflect.ValueOfList(value)
+	case 51: // goproto.proto.test2023.TestAllTypes.repeated_nested_enum
+		if fd != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
+		if x.RepeatedNestedEnum == nil {
+			x.RepeatedNestedEnum = []TestAllTypes_NestedEnum{}
+		}
+		value := &_TestAllTypes_51_list{list: &x.RepeatedNestedEnum}
+		return protoreflect.ValueOfList(value)
+	case 52: // goproto.proto.test2023.TestAllTypes.repeated_closed_enum
+		if fd != fd_TestAllTypes_repeated_closed_enum {
+			break
+		}
+		if x.RepeatedClosedEnum == nil {
+			x.RepeatedClosedEnum = []ClosedEnum{}
+		}
+		value := &_TestAllTypes_52_list{list: &x.RepeatedClosedEnum}
+		return protoreflect.ValueOfList(value)
+	case 53: // goproto.proto.test2023.TestAllTypes.expanded_int32
+		if fd != fd_TestAllTypes_expanded_int32 {
+			break
+		}
+		if x.ExpandedInt32 == nil {
+			x.ExpandedInt32 = []int32{}
+		}
+		value := &_TestAllTypes_53_list{list: &x.ExpandedInt32}
+		return protoreflect.ValueOfList(value)
+	case 54: // goproto.proto.test2023.TestAllTypes.expanded_double
+		if fd != fd_TestAllTypes_expanded_double {
+			break
+		}
+		if x.ExpandedDouble == nil {
+			x.ExpandedDouble = []float64{}
+		}
+		value := &_TestAllTypes_54_list{list: &x.ExpandedDouble}
+		return protoreflect.ValueOfList(value)
+	case 55: // goproto.proto.test2023.TestAllTypes.expanded_closed_enum
+		if fd != fd_TestAllTypes_expanded_closed_enum {
+			break
+		}
+		if x.ExpandedClosedEnum == nil {
+			x.ExpandedClosedEnum = []ClosedEnum{}
+		}
+		value := &_TestAllTypes_55_list{list: &x.ExpandedClosedEnum}
+		return protoreflect.ValueOfList(value)
+	case 56: // goproto.proto.test2023.TestAllTypes.map_int32_int32
+		if fd != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
+		if x.MapInt32Int32 == nil {
+			x.MapInt32Int32 = make(map[int32]int32)
+		}
+		value := &_TestAllTypes_56_map{m: &x.MapInt32Int32}
+		return protoreflect.ValueOfMap(value)
+	case 69: // goproto.proto.test2023.TestAllTypes.map_string_string
+		if fd != fd_TestAllTypes_map_string_string {
+			break
+		}
+		if x.MapStringString == nil {
+			x.MapStringString = make(map[string]string)
+		}
+		value := &_TestAllTypes_69_map{m: &x.MapStringString}
+		return protoreflect.ValueOfMap(value)
+	case 71: // goproto.proto.test2023.TestAllTypes.map_string_nested_message
+		if fd != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
+		if x.MapStringNestedMessage == nil {
+			x.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage)
+		}
+		value := &_TestAllTypes_71_map{m: &x.MapStringNestedMessage}
+		return protoreflect.ValueOfMap(value)
+	case 73: // goproto.proto.test2023.TestAllTypes.map_string_nested_enum
+		if fd != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
+		if x.MapStringNestedEnum == nil {
+			x.MapStringNestedEnum = make(map[string]TestAllTypes_NestedEnum)
+		}
+		value := &_TestAllTypes_73_map{m: &x.MapStringNestedEnum}
+		return protoreflect.ValueOfMap(value)
+	case 74: // goproto.proto.test2023.TestAllTypes.map_string_closed_enum
+		if fd != fd_TestAllTypes_map_string_closed_enum {
+			break
+		}
+		if x.MapStringClosedEnum == nil {
+			x.MapStringClosedEnum = make(map[string]ClosedEnum)
+		}
+		value := &_TestAllTypes_74_map{m: &x.MapStringClosedEnum}
+		return protoreflect.ValueOfMap(value)
+	case 76: // goproto.proto.test2023.TestAllTypes.unverified_repeated_string
+		if fd != fd_TestAllTypes_unverified_repeated_string {
+			break
+		}
+		if x.UnverifiedRepeatedString == nil {
+			x.UnverifiedRepeatedString = []string{}
+		}
+		value := &_TestAllTypes_76_list{list: &x.UnverifiedRepeatedString}
+		return protoreflect.ValueOfList(value)
+	case 77: // goproto.proto.test2023.TestAllTypes.unverified_map
+		if fd != fd_TestAllTypes_unverified_map {
+			break
+		}
+		if x.UnverifiedMap == nil {
+			x.UnverifiedMap = make(map[string]string)
+		}
+		value := &_TestAllTypes_77_map{m: &x.UnverifiedMap}
+		return protoreflect.ValueOfMap(value)
+	case 78: // goproto.proto.test2023.TestAllTypes.delimited_message
+		if fd != fd_TestAllTypes_delimited_message {
+			break
+		}
+		if x.DelimitedMessage == nil {
+			x.DelimitedMessage = new(TestAllTypes_NestedMessage)
+		}
+		return protoreflect.ValueOfMessage(x.DelimitedMessage.ProtoReflect())
+	case 79: // goproto.proto.test2023.TestAllTypes.repeated_delimited_message
+		if fd != fd_TestAllTypes_repeated_delimited_message {
+			break
+		}
+		if x.RepeatedDelimitedMessage == nil {
+			x.RepeatedDelimitedMessage = []*TestAllTypes_NestedMessage{}
+		}
+		value := &_TestAllTypes_79_list{list: &x.RepeatedDelimitedMessage}
+		return protoreflect.ValueOfList(value)
+	case 112: // goproto.proto.test2023.TestAllTypes.oneof_nested_message
+		if fd != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
+		if x.OneofField == nil {
+			value := &TestAllTypes_NestedMessage{}
+			oneofValue := &TestAllTypes_OneofNestedMessage{OneofNestedMessage: value}
+			x.OneofField = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.OneofField.(type) {
+		case *TestAllTypes_OneofNestedMessage:
+			return protoreflect.ValueOfMessage(m.OneofNestedMessage.ProtoReflect())
+		default:
+			value := &TestAllTypes_NestedMessage{}
+			oneofValue := &TestAllTypes_OneofNestedMessage{OneofNestedMessage: value}
+			x.OneofField = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case 116: // goproto.proto.test2023.TestAllTypes.oneof_delimited
+		if fd != fd_TestAllTypes_oneof_delimited {
+			break
+		}
+		if x.OneofField == nil {
+			value := &TestAllTypes_NestedMessage{}
+			oneofValue := &TestAllTypes_OneofDelimited{OneofDelimited: value}
+			x.OneofField = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.OneofField.(type) {
+		case *TestAllTypes_OneofDelimited:
+			return protoreflect.ValueOfMessage(m.OneofDelimited.ProtoReflect())
+		default:
+			value := &TestAllTypes_NestedMessage{}
+			oneofValue := &TestAllTypes_OneofDelimited{OneofDelimited: value}
+			x.OneofField = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case 1: // goproto.proto.test2023.TestAllTypes.optional_int32
+		if fd != fd_TestAllTypes_optional_int32 {
+			break
+		}
+		panic(fmt.Errorf("field optional_int32 of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 2: // goproto.proto.test2023.TestAllTypes.optional_int64
+		if fd != fd_TestAllTypes_optional_int64 {
+			break
+		}
+		panic(fmt.Errorf("field optional_int64 of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 3: // goproto.proto.test2023.TestAllTypes.optional_uint32
+		if fd != fd_TestAllTypes_optional_uint32 {
+			break
+		}
+		panic(fmt.Errorf("field optional_uint32 of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 4: // goproto.proto.test2023.TestAllTypes.optional_uint64
+		if fd != fd_TestAllTypes_optional_uint64 {
+			break
+		}
+		panic(fmt.Errorf("field optional_uint64 of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 5: // goproto.proto.test2023.TestAllTypes.optional_sint32
+		if fd != fd_TestAllTypes_optional_sint32 {
+			break
+		}
+		panic(fmt.Errorf("field optional_sint32 of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 6: // goproto.proto.test2023.TestAllTypes.optional_sint64
+		if fd != fd_TestAllTypes_optional_sint64 {
+			break
+		}
+		panic(fmt.Errorf("field optional_sint64 of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 7: // goproto.proto.test2023.TestAllTypes.optional_fixed32
+		if fd != fd_TestAllTypes_optional_fixed32 {
+			break
+		}
+		panic(fmt.Errorf("field optional_fixed32 of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 8: // goproto.proto.test2023.TestAllTypes.optional_fixed64
+		if fd != fd_TestAllTypes_optional_fixed64 {
+			break
+		}
+		panic(fmt.Errorf("field optional_fixed64 of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 9: // goproto.proto.test2023.TestAllTypes.optional_sfixed32
+		if fd != fd_TestAllTypes_optional_sfixed32 {
+			break
+		}
+		panic(fmt.Errorf("field optional_sfixed32 of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 10: // goproto.proto.test2023.TestAllTypes.optional_sfixed64
+		if fd != fd_TestAllTypes_optional_sfixed64 {
+			break
+		}
+		panic(fmt.Errorf("field optional_sfixed64 of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 11: // goproto.proto.test2023.TestAllTypes.optional_float
+		if fd != fd_TestAllTypes_optional_float {
+			break
+		}
+		panic(fmt.Errorf("field optional_float of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 12: // goproto.proto.test2023.TestAllTypes.optional_double
+		if fd != fd_TestAllTypes_optional_double {
+			break
+		}
+		panic(fmt.Errorf("field optional_double of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 13: // goproto.proto.test2023.TestAllTypes.optional_bool
+		if fd != fd_TestAllTypes_optional_bool {
+			break
+		}
+		panic(fmt.Errorf("field optional_bool of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 14: // goproto.proto.test2023.TestAllTypes.optional_string
+		if fd != fd_TestAllTypes_optional_string {
+			break
+		}
+		panic(fmt.Errorf("field optional_string of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 15: // goproto.proto.test2023.TestAllTypes.optional_bytes
+		if fd != fd_TestAllTypes_optional_bytes {
+			break
+		}
+		panic(fmt.Errorf("field optional_bytes of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 21: // goproto.proto.test2023.TestAllTypes.optional_nested_enum
+		if fd != fd_TestAllTypes_optional_nested_enum {
+			break
+		}
+		panic(fmt.Errorf("field optional_nested_enum of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 22: // goproto.proto.test2023.TestAllTypes.optional_closed_enum
+		if fd != fd_TestAllTypes_optional_closed_enum {
+			break
+		}
+		panic(fmt.Errorf("field optional_closed_enum of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 23: // goproto.proto.test2023.TestAllTypes.implicit_int32
+		if fd != fd_TestAllTypes_implicit_int32 {
+			break
+		}
+		panic(fmt.Errorf("field implicit_int32 of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 24: // goproto.proto.test2023.TestAllTypes.implicit_string
+		if fd != fd_TestAllTypes_implicit_string {
+			break
+		}
+		panic(fmt.Errorf("field implicit_string of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 25: // goproto.proto.test2023.TestAllTypes.implicit_bytes
+		if fd != fd_TestAllTypes_implicit_bytes {
+			break
+		}
+		panic(fmt.Errorf("field implicit_bytes of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 26: // goproto.proto.test2023.TestAllTypes.implicit_enum
+		if fd != fd_TestAllTypes_implicit_enum {
+			break
+		}
+		panic(fmt.Errorf("field implicit_enum of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 75: // goproto.proto.test2023.TestAllTypes.unverified_string
+		if fd != fd_TestAllTypes_unverified_string {
+			break
+		}
+		panic(fmt.Errorf("field unverified_string of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 81: // goproto.proto.test2023.TestAllTypes.default_int32
+		if fd != fd_TestAllTypes_default_int32 {
+			break
+		}
+		panic(fmt.Errorf("field default_int32 of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 94: // goproto.proto.test2023.TestAllTypes.default_string
+		if fd != fd_TestAllTypes_default_string {
+			break
+		}
+		panic(fmt.Errorf("field default_string of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 97: // goproto.proto.test2023.TestAllTypes.default_closed_enum
+		if fd != fd_TestAllTypes_default_closed_enum {
+			break
+		}
+		panic(fmt.Errorf("field default_closed_enum of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 111: // goproto.proto.test2023.TestAllTypes.oneof_uint32
+		if fd != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
+		panic(fmt.Errorf("field oneof_uint32 of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 113: // goproto.proto.test2023.TestAllTypes.oneof_string
+		if fd != fd_TestAllTypes_oneof_string {
+			break
+		}
+		panic(fmt.Errorf("field oneof_string of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 114: // goproto.proto.test2023.TestAllTypes.oneof_bytes
+		if fd != fd_TestAllTypes_oneof_bytes {
+			break
+		}
+		panic(fmt.Errorf("field oneof_bytes of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	case 115: // goproto.proto.test2023.TestAllTypes.oneof_closed_enum
+		if fd != fd_TestAllTypes_oneof_closed_enum {
+			break
+		}
+		panic(fmt.Errorf("field oneof_closed_enum of message goproto.proto.test2023.TestAllTypes is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestAllTypes) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2023.TestAllTypes.optional_int32
+		if fd != fd_TestAllTypes_optional_int32 {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 2: // goproto.proto.test2023.TestAllTypes.optional_int64
+		if fd != fd_TestAllTypes_optional_int64 {
+			break
+		}
+		return protoreflect.ValueOfInt64(int64(0))
+	case 3: // goproto.proto.test2023.TestAllTypes.optional_uint32
+		if fd != fd_TestAllTypes_optional_uint32 {
+			break
+		}
+		return protoreflect.ValueOfUint32(uint32(0))
+	case 4: // goproto.proto.test2023.TestAllTypes.optional_uint64
+		if fd != fd_TestAllTypes_optional_uint64 {
+			break
+		}
+		return protoreflect.ValueOfUint64(uint64(0))
+	case 5: // goproto.proto.test2023.TestAllTypes.optional_sint32
+		if fd != fd_TestAllTypes_optional_sint32 {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 6: // goproto.proto.test2023.TestAllTypes.optional_sint64
+		if fd != fd_TestAllTypes_optional_sint64 {
+			break
+		}
+		return protoreflect.ValueOfInt64(int64(0))
+	case 7: // goproto.proto.test2023.TestAllTypes.optional_fixed32
+		if fd != fd_TestAllTypes_optional_fixed32 {
+			break
+		}
+		return protoreflect.ValueOfUint32(uint32(0))
+	case 8: // goproto.proto.test2023.TestAllTypes.optional_fixed64
+		if fd != fd_TestAllTypes_optional_fixed64 {
+			break
+		}
+		return protoreflect.ValueOfUint64(uint64(0))
+	case 9: // goproto.proto.test2023.TestAllTypes.optional_sfixed32
+		if fd != fd_TestAllTypes_optional_sfixed32 {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 10: // goproto.proto.test2023.TestAllTypes.optional_sfixed64
+		if fd != fd_TestAllTypes_optional_sfixed64 {
+			break
+		}
+		return protoreflect.ValueOfInt64(int64(0))
+	case 11: // goproto.proto.test2023.TestAllTypes.optional_float
+		if fd != fd_TestAllTypes_optional_float {
+			break
+		}
+		return protoreflect.ValueOfFloat32(float32(0))
+	case 12: // goproto.proto.test2023.TestAllTypes.optional_double
+		if fd != fd_TestAllTypes_optional_double {
+			break
+		}
+		return protoreflect.ValueOfFloat64(float64(0))
+	case 13: // goproto.proto.test2023.TestAllTypes.optional_bool
+		if fd != fd_TestAllTypes_optional_bool {
+			break
+		}
+		return protoreflect.ValueOfBool(false)
+	case 14: // goproto.proto.test2023.TestAllTypes.optional_string
+		if fd != fd_TestAllTypes_optional_string {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 15: // goproto.proto.test2023.TestAllTypes.optional_bytes
+		if fd != fd_TestAllTypes_optional_bytes {
+			break
+		}
+		return protoreflect.ValueOfBytes(nil)
+	case 18: // goproto.proto.test2023.TestAllTypes.optional_nested_message
+		if fd != fd_TestAllTypes_optional_nested_message {
+			break
+		}
+		m := new(TestAllTypes_NestedMessage)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 21: // goproto.proto.test2023.TestAllTypes.optional_nested_enum
+		if fd != fd_TestAllTypes_optional_nested_enum {
+			break
+		}
+		return protoreflect.ValueOfEnum(0)
+	case 22: // goproto.proto.test2023.TestAllTypes.optional_closed_enum
+		if fd != fd_TestAllTypes_optional_closed_enum {
+			break
+		}
+		return protoreflect.ValueOfEnum(0)
+	case 23: // goproto.proto.test2023.TestAllTypes.implicit_int32
+		if fd != fd_TestAllTypes_implicit_int32 {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 24: // goproto.proto.test2023.TestAllTypes.implicit_string
+		if fd != fd_TestAllTypes_implicit_string {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 25: // goproto.proto.test2023.TestAllTypes.implicit_bytes
+		if fd != fd_TestAllTypes_implicit_bytes {
+			break
+		}
+		return protoreflect.ValueOfBytes(nil)
+	case 26: // goproto.proto.test2023.TestAllTypes.implicit_enum
+		if fd != fd_TestAllTypes_implicit_enum {
+			break
+		}
+		return protoreflect.ValueOfEnum(0)
+	case 31: // goproto.proto.test2023.TestAllTypes.repeated_int32
+		if fd != fd_TestAllTypes_repeated_int32 {
+			break
+		}
+		list := []int32{}
+		return protoreflect.ValueOfList(&_TestAllTypes_31_list{list: &list})
+	case 32: // goproto.proto.test2023.TestAllTypes.repeated_int64
+		if fd != fd_TestAllTypes_repeated_int64 {
+			break
+		}
+		list := []int64{}
+		return protoreflect.ValueOfList(&_TestAllTypes_32_list{list: &list})
+	case 33: // goproto.proto.test2023.TestAllTypes.repeated_uint32
+		if fd != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
+		list := []uint32{}
+		return protoreflect.ValueOfList(&_TestAllTypes_33_list{list: &list})
+	case 34: // goproto.proto.test2023.TestAllTypes.repeated_uint64
+		if fd != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_TestAllTypes_34_list{list: &list})
+	case 35: // goproto.proto.test2023.TestAllTypes.repeated_sint32
+		if fd != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
+		list := []int32{}
+		return protoreflect.ValueOfList(&_TestAllTypes_35_list{list: &list})
+	case 36: // goproto.proto.test2023.TestAllTypes.repeated_sint64
+		if fd != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
+		list := []int64{}
+		return protoreflect.ValueOfList(&_TestAllTypes_36_list{list: &list})
+	case 37: // goproto.proto.test2023.TestAllTypes.repeated_fixed32
+		if fd != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
+		list := []uint32{}
+		return protoreflect.ValueOfList(&_TestAllTypes_37_list{list: &list})
+	case 38: // goproto.proto.test2023.TestAllTypes.repeated_fixed64
+		if fd != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_TestAllTypes_38_list{list: &list})
+	case 39: // goproto.proto.test2023.TestAllTypes.repeated_sfixed32
+		if fd != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
+		list := []int32{}
+		return protoreflect.ValueOfList(&_TestAllTypes_39_list{list: &list})
+	case 40: // goproto.proto.test2023.TestAllTypes.repeated_sfixed64
+		if fd != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
+		list := []int64{}
+		return protoreflect.ValueOfList(&_TestAllTypes_40_list{list: &list})
+	case 41: // goproto.proto.test2023.TestAllTypes.repeated_float
+		if fd != fd_TestAllTypes_repeated_float {
+			break
+		}
+		list := []float32{}
+		return protoreflect.ValueOfList(&_TestAllTypes_41_list{list: &list})
+	case 42: // goproto.proto.test2023.TestAllTypes.repeated_double
+		if fd != fd_TestAllTypes_repeated_double {
+			break
+		}
+		list := []float64{}
+		return protoreflect.ValueOfList(&_TestAllTypes_42_list{list: &list})
+	case 43: // goproto.proto.test2023.TestAllTypes.repeated_bool
+		if fd != fd_TestAllTypes_repeated_bool {
+			break
+		}
+		list := []bool{}
+		return protoreflect.ValueOfList(&_TestAllTypes_43_list{list: &list})
+	case 44: // goproto.proto.test2023.TestAllTypes.repeated_string
+		if fd != fd_TestAllTypes_repeated_string {
+			break
+		}
+		list := []string{}
+		return protoreflect.ValueOfList(&_TestAllTypes_44_list{list: &list})
+	case 45: // goproto.proto.test2023.TestAllTypes.repeated_bytes
+		if fd != fd_TestAllTypes_repeated_bytes {
+			break
+		}
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_TestAllTypes_45_list{list: &list})
+	case 48: // goproto.proto.test2023.TestAllTypes.repeated_nested_message
+		if fd != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
+		list := []*TestAllTypes_NestedMessage{}
+		return protoreflect.ValueOfList(&_TestAllTypes_48_list{list: &list})
+	case 51: // goproto.proto.test2023.TestAllTypes.repeated_nested_enum
+		if fd != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
+		list := []TestAllTypes_NestedEnum{}
+		return protoreflect.ValueOfList(&_TestAllTypes_51_list{list: &list})
+	case 52: // goproto.proto.test2023.TestAllTypes.repeated_closed_enum
+		if fd != fd_TestAllTypes_repeated_closed_enum {
+			break
+		}
+		list := []ClosedEnum{}
+		return protoreflect.ValueOfList(&_TestAllTypes_52_list{list: &list})
+	case 53: // goproto.proto.test2023.TestAllTypes.expanded_int32
+		if fd != fd_TestAllTypes_expanded_int32 {
+			break
+		}
+		list := []int32{}
+		return protoreflect.ValueOfList(&_TestAllTypes_53_list{list: &list})
+	case 54: // goproto.proto.test2023.TestAllTypes.expanded_double
+		if fd != fd_TestAllTypes_expanded_double {
+			break
+		}
+		list := []float64{}
+		return protoreflect.ValueOfList(&_TestAllTypes_54_list{list: &list})
+	case 55: // goproto.proto.test2023.TestAllTypes.expanded_closed_enum
+		if fd != fd_TestAllTypes_expanded_closed_enum {
+			break
+		}
+		list := []ClosedEnum{}
+		return protoreflect.ValueOfList(&_TestAllTypes_55_list{list: &list})
+	case 56: // goproto.proto.test2023.TestAllTypes.map_int32_int32
+		if fd != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
+		m := make(map[int32]int32)
+		return protoreflect.ValueOfMap(&_TestAllTypes_56_map{m: &m})
+	case 69: // goproto.proto.test2023.TestAllTypes.map_string_string
+		if fd != fd_TestAllTypes_map_string_string {
+			break
+		}
+		m := make(map[string]string)
+		return protoreflect.ValueOfMap(&_TestAllTypes_69_map{m: &m})
+	case 71: // goproto.proto.test2023.TestAllTypes.map_string_nested_message
+		if fd != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
+		m := make(map[string]*TestAllTypes_NestedMessage)
+		return protoreflect.ValueOfMap(&_TestAllTypes_71_map{m: &m})
+	case 73: // goproto.proto.test2023.TestAllTypes.map_string_nested_enum
+		if fd != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
+		m := make(map[string]TestAllTypes_NestedEnum)
+		return protoreflect.ValueOfMap(&_TestAllTypes_73_map{m: &m})
+	case 74: // goproto.proto.test2023.TestAllTypes.map_string_closed_enum
+		if fd != fd_TestAllTypes_map_string_closed_enum {
+			break
+		}
+		m := make(map[string]ClosedEnum)
+		return protoreflect.ValueOfMap(&_TestAllTypes_74_map{m: &m})
+	case 75: // goproto.proto.test2023.TestAllTypes.unverified_string
+		if fd != fd_TestAllTypes_unverified_string {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 76: // goproto.proto.test2023.TestAllTypes.unverified_repeated_string
+		if fd != fd_TestAllTypes_unverified_repeated_string {
+			break
+		}
+		list := []string{}
+		return protoreflect.ValueOfList(&_TestAllTypes_76_list{list: &list})
+	case 77: // goproto.proto.test2023.TestAllTypes.unverified_map
+		if fd != fd_TestAllTypes_unverified_map {
+			break
+		}
+		m := make(map[string]string)
+		return protoreflect.ValueOfMap(&_TestAllTypes_77_map{m: &m})
+	case 78: // goproto.proto.test2023.TestAllTypes.delimited_message
+		if fd != fd_TestAllTypes_delimited_message {
+			break
+		}
+		m := new(TestAllTypes_NestedMessage)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 79: // goproto.proto.test2023.TestAllTypes.repeated_delimited_message
+		if fd != fd_TestAllTypes_repeated_delimited_message {
+			break
+		}
+		list := []*TestAllTypes_NestedMessage{}
+		return protoreflect.ValueOfList(&_TestAllTypes_79_list{list: &list})
+	case 81: // goproto.proto.test2023.TestAllTypes.default_int32
+		if fd != fd_TestAllTypes_default_int32 {
+			break
+		}
+		return protoreflect.ValueOfInt32(Default_TestAllTypes_DefaultInt32)
+	case 94: // goproto.proto.test2023.TestAllTypes.default_string
+		if fd != fd_TestAllTypes_default_string {
+			break
+		}
+		return protoreflect.ValueOfString(Default_TestAllTypes_DefaultString)
+	case 97: // goproto.proto.test2023.TestAllTypes.default_closed_enum
+		if fd != fd_TestAllTypes_default_closed_enum {
+			break
+		}
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(Default_TestAllTypes_DefaultClosedEnum))
+	case 111: // goproto.proto.test2023.TestAllTypes.oneof_uint32
+		if fd != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
+		return protoreflect.ValueOfUint32(uint32(0))
+	case 112: // goproto.proto.test2023.TestAllTypes.oneof_nested_message
+		if fd != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
+		value := &TestAllTypes_NestedMessage{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 113: // goproto.proto.test2023.TestAllTypes.oneof_string
+		if fd != fd_TestAllTypes_oneof_string {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 114: // goproto.proto.test2023.TestAllTypes.oneof_bytes
+		if fd != fd_TestAllTypes_oneof_bytes {
+			break
+		}
+		return protoreflect.ValueOfBytes(nil)
+	case 115: // goproto.proto.test2023.TestAllTypes.oneof_closed_enum
+		if fd != fd_TestAllTypes_oneof_closed_enum {
+			break
+		}
+		return protoreflect.ValueOfEnum(0)
+	case 116: // goproto.proto.test2023.TestAllTypes.oneof_delimited
+		if fd != fd_TestAllTypes_oneof_delimited {
+			break
+		}
+		value := &TestAllTypes_NestedMessage{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestAllTypes) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "goproto.proto.test2023.TestAllTypes.oneof_field":
+		if x.OneofField == nil {
+			return nil
+		}
+		switch x.OneofField.(type) {
+		case *TestAllTypes_OneofUint32:
+			return x.Descriptor().Fields().ByName("oneof_uint32")
+		case *TestAllTypes_OneofNestedMessage:
+			return x.Descriptor().Fields().ByName("oneof_nested_message")
+		case *TestAllTypes_OneofString:
+			return x.Descriptor().Fields().ByName("oneof_string")
+		case *TestAllTypes_OneofBytes:
+			return x.Descriptor().Fields().ByName("oneof_bytes")
+		case *TestAllTypes_OneofClosedEnum:
+			return x.Descriptor().Fields().ByName("oneof_closed_enum")
+		case *TestAllTypes_OneofDelimited:
+			return x.Descriptor().Fields().ByName("oneof_delimited")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test2023.TestAllTypes", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestAllTypes) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestAllTypes) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestAllTypes_methods
+}
+
+var _fastReflection_TestAllTypes_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestAllTypes_size,
+	Marshal:           _fastReflection_TestAllTypes_marshal,
+	Unmarshal:         _fastReflection_TestAllTypes_unmarshal,
+	Merge:             _fastReflection_TestAllTypes_merge,
+	CheckInitialized:  _fastReflection_TestAllTypes_checkInitialized,
+	Equal:             _fastReflection_TestAllTypes_equal,
+}
+
+func _fastReflection_TestAllTypes_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestAllTypes)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.OptionalInt32 != nil {
+		n += 1 + runtime.Sov(uint64(*x.OptionalInt32))
+	}
+	if x.OptionalInt64 != nil {
+		n += 1 + runtime.Sov(uint64(*x.OptionalInt64))
+	}
+	if x.OptionalUint32 != nil {
+		n += 1 + runtime.Sov(uint64(*x.OptionalUint32))
+	}
+	if x.OptionalUint64 != nil {
+		n += 1 + runtime.Sov(uint64(*x.OptionalUint64))
+	}
+	if x.OptionalSint32 != nil {
+		n += 1 + runtime.Soz(uint64(*x.OptionalSint32))
+	}
+	if x.OptionalSint64 != nil {
+		n += 1 + runtime.Soz(uint64(*x.OptionalSint64))
+	}
+	if x.OptionalFixed32 != nil {
+		n += 5
+	}
+	if x.OptionalFixed64 != nil {
+		n += 9
+	}
+	if x.OptionalSfixed32 != nil {
+		n += 5
+	}
+	if x.OptionalSfixed64 != nil {
+		n += 9
+	}
+	if x.OptionalFloat != nil {
+		n += 5
+	}
+	if x.OptionalDouble != nil {
+		n += 9
+	}
+	if x.OptionalBool != nil {
+		n += 2
+	}
+	if x.OptionalString != nil {
+		l = len(*x.OptionalString)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.OptionalBytes != nil {
+		l = len(x.OptionalBytes)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.OptionalNestedMessage != nil {
+		l = options.Size(x.OptionalNestedMessage)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.OptionalNestedEnum != nil {
+		n += 2 + runtime.Sov(uint64(*x.OptionalNestedEnum))
+	}
+	if x.OptionalClosedEnum != nil {
+		n += 2 + runtime.Sov(uint64(*x.OptionalClosedEnum))
+	}
+	if x.ImplicitInt32 != 0 {
+		n += 2 + runtime.Sov(uint64(x.ImplicitInt32))
+	}
+	l = len(x.ImplicitString)
+	if l > 0 {
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	l = len(x.ImplicitBytes)
+	if l > 0 {
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.ImplicitEnum != 0 {
+		n += 2 + runtime.Sov(uint64(x.ImplicitEnum))
+	}
+	if len(x.RepeatedInt32) > 0 {
+		l = 0
+		for _, e := range x.RepeatedInt32 {
+			l += runtime.Sov(uint64(e))
+		}
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.RepeatedInt64) > 0 {
+		l = 0
+		for _, e := range x.RepeatedInt64 {
+			l += runtime.Sov(uint64(e))
+		}
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.RepeatedUint32) > 0 {
+		l = 0
+		for _, e := range x.RepeatedUint32 {
+			l += runtime.Sov(uint64(e))
+		}
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.RepeatedUint64) > 0 {
+		l = 0
+		for _, e := range x.RepeatedUint64 {
+			l += runtime.Sov(uint64(e))
+		}
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.RepeatedSint32) > 0 {
+		l = 0
+		for _, e := range x.RepeatedSint32 {
+			l += runtime.Soz(uint64(e))
+		}
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.RepeatedSint64) > 0 {
+		l = 0
+		for _, e := range x.RepeatedSint64 {
+			l += runtime.Soz(uint64(e))
+		}
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.RepeatedFixed32) > 0 {
+		n += 2 + runtime.Sov(uint64(len(x.RepeatedFixed32)*4)) + len(x.RepeatedFixed32)*4
+	}
+	if len(x.RepeatedFixed64) > 0 {
+		n += 2 + runtime.Sov(uint64(len(x.RepeatedFixed64)*8)) + len(x.RepeatedFixed64)*8
+	}
+	if len(x.RepeatedSfixed32) > 0 {
+		n += 2 + runtime.Sov(uint64(len(x.RepeatedSfixed32)*4)) + len(x.RepeatedSfixed32)*4
+	}
+	if len(x.RepeatedSfixed64) > 0 {
+		n += 2 + runtime.Sov(uint64(len(x.RepeatedSfixed64)*8)) + len(x.RepeatedSfixed64)*8
+	}
+	if len(x.RepeatedFloat) > 0 {
+		n += 2 + runtime.Sov(uint64(len(x.RepeatedFloat)*4)) + len(x.RepeatedFloat)*4
+	}
+	if len(x.RepeatedDouble) > 0 {
+		n += 2 + runtime.Sov(uint64(len(x.RepeatedDouble)*8)) + len(x.RepeatedDouble)*8
+	}
+	if len(x.RepeatedBool) > 0 {
+		n += 2 + runtime.Sov(uint64(len(x.RepeatedBool))) + len(x.RepeatedBool)*1
+	}
+	if len(x.RepeatedString) > 0 {
+		for _, s := range x.RepeatedString {
+			l = len(s)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if len(x.RepeatedBytes) > 0 {
+		for _, b := range x.RepeatedBytes {
+			l = len(b)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if len(x.RepeatedNestedMessage) > 0 {
+		for _, e := range x.RepeatedNestedMessage {
+			l = options.Size(e)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if len(x.RepeatedNestedEnum) > 0 {
+		l = 0
+		for _, e := range x.RepeatedNestedEnum {
+			l += runtime.Sov(uint64(e))
+		}
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.RepeatedClosedEnum) > 0 {
+		l = 0
+		for _, e := range x.RepeatedClosedEnum {
+			l += runtime.Sov(uint64(e))
+		}
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.ExpandedInt32) > 0 {
+		for _, e := range x.ExpandedInt32 {
+			n += 2 + runtime.Sov(uint64(e))
+		}
+	}
+	if len(x.ExpandedDouble) > 0 {
+		n += 10 * len(x.ExpandedDouble)
+	}
+	if len(x.ExpandedClosedEnum) > 0 {
+		for _, e := range x.ExpandedClosedEnum {
+			n += 2 + runtime.Sov(uint64(e))
+		}
+	}
+	if len(x.MapInt32Int32) > 0 {
+		SiZeMaP := func(k int32, v int32) {
+			mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]int32, 0, len(x.MapInt32Int32))
+			for k := range x.MapInt32Int32 {
+				sortme = append(sortme, k)
+			}
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapInt32Int32[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapInt32Int32 {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if len(x.MapStringString) > 0 {
+		SiZeMaP := func(k string, v string) {
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.MapStringString))
+			for k := range x.MapStringString {
+				sortme = append(sortme, k)
+			}
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.MapStringString[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapStringString {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if len(x.MapStringNestedMessage) > 0 {
+		SiZeMaP := func(k string, v *TestAllTypes_NestedMessage) {
+			l := 0
+			if v != nil {
+				l = options.Size(v)
+			}
+			l += 1 + runtime.Sov(uint64(l))
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.MapStringNestedMessage))
+			for k := range x.MapStringNestedMessage {
+				sortme = append(sortme, k)
+			}
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.MapStringNestedMessage[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapStringNestedMessage {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if len(x.MapStringNestedEnum) > 0 {
+		SiZeMaP := func(k string, v TestAllTypes_NestedEnum) {
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + runtime.Sov(uint64(v))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.MapStringNestedEnum))
+			for k := range x.MapStringNestedEnum {
+				sortme = append(sortme, k)
+			}
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.MapStringNestedEnum[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapStringNestedEnum {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if len(x.MapStringClosedEnum) > 0 {
+		SiZeMaP := func(k string, v ClosedEnum) {
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + runtime.Sov(uint64(v))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.MapStringClosedEnum))
+			for k := range x.MapStringClosedEnum {
+				sortme = append(sortme, k)
+			}
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.MapStringClosedEnum[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapStringClosedEnum {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if x.UnverifiedString != nil {
+		l = len(*x.UnverifiedString)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.UnverifiedRepeatedString) > 0 {
+		for _, s := range x.UnverifiedRepeatedString {
+			l = len(s)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if len(x.UnverifiedMap) > 0 {
+		SiZeMaP := func(k string, v string) {
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.UnverifiedMap))
+			for k := range x.UnverifiedMap {
+				sortme = append(sortme, k)
+			}
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.UnverifiedMap[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.UnverifiedMap {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if x.DelimitedMessage != nil {
+		l = options.Size(x.DelimitedMessage)
+		n += 4 + l
+	}
+	if len(x.RepeatedDelimitedMessage) > 0 {
+		for _, e := range x.RepeatedDelimitedMessage {
+			l = options.Size(e)
+			n += 4 + l
+		}
+	}
+	if x.DefaultInt32 != nil {
+		n += 2 + runtime.Sov(uint64(*x.DefaultInt32))
+	}
+	if x.DefaultString != nil {
+		l = len(*x.DefaultString)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.DefaultClosedEnum != nil {
+		n += 2 + runtime.Sov(uint64(*x.DefaultClosedEnum))
+	}
+	switch x := x.OneofField.(type) {
+	case *TestAllTypes_OneofUint32:
+		if x == nil {
+			break
+		}
+		n += 2 + runtime.Sov(uint64(x.OneofUint32))
+	case *TestAllTypes_OneofNestedMessage:
+		if x == nil {
+			break
+		}
+		l = options.Size(x.OneofNestedMessage)
+		n += 2 + l + runtime.Sov(uint64(l))
+	case *TestAllTypes_OneofString:
+		if x == nil {
+			break
+		}
+		l = len(x.OneofString)
+		n += 2 + l + runtime.Sov(uint64(l))
+	case *TestAllTypes_OneofBytes:
+		if x == nil {
+			break
+		}
+		l = len(x.OneofBytes)
+		n += 2 + l + runtime.Sov(uint64(l))
+	case *TestAllTypes_OneofClosedEnum:
+		if x == nil {
+			break
+		}
+		n += 2 + runtime.Sov(uint64(x.OneofClosedEnum))
+	case *TestAllTypes_OneofDelimited:
+		if x == nil {
+			break
+		}
+		l = options.Size(x.OneofDelimited)
+		n += 4 + l
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestAllTypes_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	switch x := x.OneofField.(type) {
+	case *TestAllTypes_OneofUint32:
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.OneofUint32))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xf8
+	case *TestAllTypes_OneofNestedMessage:
+		l = options.Size(x.OneofNestedMessage)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.OneofNestedMessage); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x82
+	case *TestAllTypes_OneofString:
+		if !utf8.ValidString(x.OneofString) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.OneofString)
+		copy(dAtA[i:], x.OneofString)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OneofString)))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x8a
+	case *TestAllTypes_OneofBytes:
+		i -= len(x.OneofBytes)
+		copy(dAtA[i:], x.OneofBytes)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OneofBytes)))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x92
+	case *TestAllTypes_OneofClosedEnum:
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.OneofClosedEnum))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x98
+	case *TestAllTypes_OneofDelimited:
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xa4
+		l = options.Size(x.OneofDelimited)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.OneofDelimited); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xa3
+	}
+	if x.DefaultClosedEnum != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.DefaultClosedEnum))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x88
+	}
+	if x.DefaultString != nil {
+		if !utf8.ValidString(*x.DefaultString) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(*x.DefaultString)
+		copy(dAtA[i:], *x.DefaultString)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(*x.DefaultString)))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xf2
+	}
+	if x.DefaultInt32 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.DefaultInt32))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(x.RepeatedDelimitedMessage) > 0 {
+		for iNdEx := len(x.RepeatedDelimitedMessage) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xfc
+			l = options.Size(x.RepeatedDelimitedMessage[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.RepeatedDelimitedMessage[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xfb
+		}
+	}
+	if x.DelimitedMessage != nil {
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xf4
+		l = options.Size(x.DelimitedMessage)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.DelimitedMessage); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xf3
+	}
+	if len(x.UnverifiedMap) > 0 {
+		MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xea
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForUnverifiedMap := make([]string, 0, len(x.UnverifiedMap))
+			for k := range x.UnverifiedMap {
+				keysForUnverifiedMap = append(keysForUnverifiedMap, string(k))
+			}
+			sort.Slice(keysForUnverifiedMap, func(i, j int) bool {
+				return keysForUnverifiedMap[i] < keysForUnverifiedMap[j]
+			})
+			for iNdEx := len(keysForUnverifiedMap) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.UnverifiedMap[string(keysForUnverifiedMap[iNdEx])]
+				out, err := MaRsHaLmAp(keysForUnverifiedMap[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.UnverifiedMap {
+				v := x.UnverifiedMap[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.UnverifiedRepeatedString) > 0 {
+		for iNdEx := len(x.UnverifiedRepeatedString) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(x.UnverifiedRepeatedString[iNdEx])
+			copy(dAtA[i:], x.UnverifiedRepeatedString[iNdEx])
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UnverifiedRepeatedString[iNdEx])))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if x.UnverifiedString != nil {
+		i -= len(*x.UnverifiedString)
+		copy(dAtA[i:], *x.UnverifiedString)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(*x.UnverifiedString)))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(x.MapStringClosedEnum) > 0 {
+		MaRsHaLmAp := func(k string, v ClosedEnum) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i = runtime.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			if !utf8.ValidString(k) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xd2
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForMapStringClosedEnum := make([]string, 0, len(x.MapStringClosedEnum))
+			for k := range x.MapStringClosedEnum {
+				keysForMapStringClosedEnum = append(keysForMapStringClosedEnum, string(k))
+			}
+			sort.Slice(keysForMapStringClosedEnum, func(i, j int) bool {
+				return keysForMapStringClosedEnum[i] < keysForMapStringClosedEnum[j]
+			})
+			for iNdEx := len(keysForMapStringClosedEnum) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapStringClosedEnum[string(keysForMapStringClosedEnum[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapStringClosedEnum[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapStringClosedEnum {
+				v := x.MapStringClosedEnum[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.MapStringNestedEnum) > 0 {
+		MaRsHaLmAp := func(k string, v TestAllTypes_NestedEnum) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i = runtime.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			if !utf8.ValidString(k) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xca
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForMapStringNestedEnum := make([]string, 0, len(x.MapStringNestedEnum))
+			for k := range x.MapStringNestedEnum {
+				keysForMapStringNestedEnum = append(keysForMapStringNestedEnum, string(k))
+			}
+			sort.Slice(keysForMapStringNestedEnum, func(i, j int) bool {
+				return keysForMapStringNestedEnum[i] < keysForMapStringNestedEnum[j]
+			})
+			for iNdEx := len(keysForMapStringNestedEnum) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapStringNestedEnum[string(keysForMapStringNestedEnum[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapStringNestedEnum[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapStringNestedEnum {
+				v := x.MapStringNestedEnum[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.MapStringNestedMessage) > 0 {
+		MaRsHaLmAp := func(k string, v *TestAllTypes_NestedMessage) (protoiface.MarshalOutput, error) {
+			baseI := i
+			l = options.Size(v)
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], v); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x12
+			if !utf8.ValidString(k) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xba
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForMapStringNestedMessage := make([]string, 0, len(x.MapStringNestedMessage))
+			for k := range x.MapStringNestedMessage {
+				keysForMapStringNestedMessage = append(keysForMapStringNestedMessage, string(k))
+			}
+			sort.Slice(keysForMapStringNestedMessage, func(i, j int) bool {
+				return keysForMapStringNestedMessage[i] < keysForMapStringNestedMessage[j]
+			})
+			for iNdEx := len(keysForMapStringNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapStringNestedMessage[string(keysForMapStringNestedMessage[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapStringNestedMessage[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapStringNestedMessage {
+				v := x.MapStringNestedMessage[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.MapStringString) > 0 {
+		MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
+			baseI := i
+			if !utf8.ValidString(v) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			if !utf8.ValidString(k) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xaa
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForMapStringString := make([]string, 0, len(x.MapStringString))
+			for k := range x.MapStringString {
+				keysForMapStringString = append(keysForMapStringString, string(k))
+			}
+			sort.Slice(keysForMapStringString, func(i, j int) bool {
+				return keysForMapStringString[i] < keysForMapStringString[j]
+			})
+			for iNdEx := len(keysForMapStringString) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapStringString[string(keysForMapStringString[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapStringString[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapStringString {
+				v := x.MapStringString[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.MapInt32Int32) > 0 {
+		MaRsHaLmAp := func(k int32, v int32) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i = runtime.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = runtime.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xc2
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForMapInt32Int32 := make([]int32, 0, len(x.MapInt32Int32))
+			for k := range x.MapInt32Int32 {
+				keysForMapInt32Int32 = append(keysForMapInt32Int32, int32(k))
+			}
+			sort.Slice(keysForMapInt32Int32, func(i, j int) bool {
+				return keysForMapInt32Int32[i] < keysForMapInt32Int32[j]
+			})
+			for iNdEx := len(keysForMapInt32Int32) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapInt32Int32[int32(keysForMapInt32Int32[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapInt32Int32[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapInt32Int32 {
+				v := x.MapInt32Int32[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.ExpandedClosedEnum) > 0 {
+		for iNdEx := len(x.ExpandedClosedEnum) - 1; iNdEx >= 0; iNdEx-- {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpandedClosedEnum[iNdEx]))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xb8
+		}
+	}
+	if len(x.ExpandedDouble) > 0 {
+		for iNdEx := len(x.ExpandedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float64bits(float64(x.ExpandedDouble[iNdEx]))
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f1))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xb1
+		}
+	}
+	if len(x.ExpandedInt32) > 0 {
+		for iNdEx := len(x.ExpandedInt32) - 1; iNdEx >= 0; iNdEx-- {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpandedInt32[iNdEx]))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa8
+		}
+	}
+	if len(x.RepeatedClosedEnum) > 0 {
+		var pksize3 int
+		for _, num := range x.RepeatedClosedEnum {
+			pksize3 += runtime.Sov(uint64(num))
+		}
+		i -= pksize3
+		j2 := i
+		for _, num1 := range x.RepeatedClosedEnum {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA[j2] = uint8(num)
+			j2++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize3))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(x.RepeatedNestedEnum) > 0 {
+		var pksize5 int
+		for _, num := range x.RepeatedNestedEnum {
+			pksize5 += runtime.Sov(uint64(num))
+		}
+		i -= pksize5
+		j4 := i
+		for _, num1 := range x.RepeatedNestedEnum {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA[j4] = uint8(num)
+			j4++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize5))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(x.RepeatedNestedMessage) > 0 {
+		for iNdEx := len(x.RepeatedNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
+			l = options.Size(x.RepeatedNestedMessage[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.RepeatedNestedMessage[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(x.RepeatedBytes) > 0 {
+		for iNdEx := len(x.RepeatedBytes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(x.RepeatedBytes[iNdEx])
+			copy(dAtA[i:], x.RepeatedBytes[iNdEx])
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedBytes[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(x.RepeatedString) > 0 {
+		for iNdEx := len(x.RepeatedString) - 1; iNdEx >= 0; iNdEx-- {
+			if !utf8.ValidString(x.RepeatedString[iNdEx]) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(x.RepeatedString[iNdEx])
+			copy(dAtA[i:], x.RepeatedString[iNdEx])
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedString[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(x.RepeatedBool) > 0 {
+		for iNdEx := len(x.RepeatedBool) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if x.RepeatedBool[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedBool)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(x.RepeatedDouble) > 0 {
+		for iNdEx := len(x.RepeatedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f6 := math.Float64bits(float64(x.RepeatedDouble[iNdEx]))
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f6))
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedDouble)*8))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(x.RepeatedFloat) > 0 {
+		for iNdEx := len(x.RepeatedFloat) - 1; iNdEx >= 0; iNdEx-- {
+			f7 := math.Float32bits(float32(x.RepeatedFloat[iNdEx]))
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f7))
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedFloat)*4))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(x.RepeatedSfixed64) > 0 {
+		for iNdEx := len(x.RepeatedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(x.RepeatedSfixed64[iNdEx]))
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedSfixed64)*8))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(x.RepeatedSfixed32) > 0 {
+		for iNdEx := len(x.RepeatedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.RepeatedSfixed32[iNdEx]))
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedSfixed32)*4))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(x.RepeatedFixed64) > 0 {
+		for iNdEx := len(x.RepeatedFixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(x.RepeatedFixed64[iNdEx]))
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedFixed64)*8))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(x.RepeatedFixed32) > 0 {
+		for iNdEx := len(x.RepeatedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.RepeatedFixed32[iNdEx]))
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedFixed32)*4))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(x.RepeatedSint64) > 0 {
+		var pksize9 int
+		for _, num := range x.RepeatedSint64 {
+			pksize9 += runtime.Soz(uint64(num))
+		}
+		i -= pksize9
+		j8 := i
+		for _, num := range x.RepeatedSint64 {
+			x10 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x10 >= 1<<7 {
+				dAtA[j8] = uint8(uint64(x10)&0x7f | 0x80)
+				j8++
+				x10 >>= 7
+			}
+			dAtA[j8] = uint8(x10)
+			j8++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize9))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(x.RepeatedSint32) > 0 {
+		var pksize12 int
+		for _, num := range x.RepeatedSint32 {
+			pksize12 += runtime.Soz(uint64(num))
+		}
+		i -= pksize12
+		j11 := i
+		for _, num := range x.RepeatedSint32 {
+			x13 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x13 >= 1<<7 {
+				dAtA[j11] = uint8(uint64(x13)&0x7f | 0x80)
+				j11++
+				x13 >>= 7
+			}
+			dAtA[j11] = uint8(x13)
+			j11++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize12))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(x.RepeatedUint64) > 0 {
+		var pksize15 int
+		for _, num := range x.RepeatedUint64 {
+			pksize15 += runtime.Sov(uint64(num))
+		}
+		i -= pksize15
+		j14 := i
+		for _, num := range x.RepeatedUint64 {
+			for num >= 1<<7 {
+				dAtA[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA[j14] = uint8(num)
+			j14++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize15))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(x.RepeatedUint32) > 0 {
+		var pksize17 int
+		for _, num := range x.RepeatedUint32 {
+			pksize17 += runtime.Sov(uint64(num))
+		}
+		i -= pksize17
+		j16 := i
+		for _, num := range x.RepeatedUint32 {
+			for num >= 1<<7 {
+				dAtA[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA[j16] = uint8(num)
+			j16++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize17))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(x.RepeatedInt64) > 0 {
+		var pksize19 int
+		for _, num := range x.RepeatedInt64 {
+			pksize19 += runtime.Sov(uint64(num))
+		}
+		i -= pksize19
+		j18 := i
+		for _, num1 := range x.RepeatedInt64 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j18] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j18++
+			}
+			dAtA[j18] = uint8(num)
+			j18++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize19))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(x.RepeatedInt32) > 0 {
+		var pksize21 int
+		for _, num := range x.RepeatedInt32 {
+			pksize21 += runtime.Sov(uint64(num))
+		}
+		i -= pksize21
+		j20 := i
+		for _, num1 := range x.RepeatedInt32 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j20] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j20++
+			}
+			dAtA[j20] = uint8(num)
+			j20++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize21))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if x.ImplicitEnum != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.ImplicitEnum))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(x.ImplicitBytes) > 0 {
+		i -= len(x.ImplicitBytes)
+		copy(dAtA[i:], x.ImplicitBytes)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ImplicitBytes)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(x.ImplicitString) > 0 {
+		if !utf8.ValidString(x.ImplicitString) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.ImplicitString)
+		copy(dAtA[i:], x.ImplicitString)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ImplicitString)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if x.ImplicitInt32 != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.ImplicitInt32))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if x.OptionalClosedEnum != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalClosedEnum))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if x.OptionalNestedEnum != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalNestedEnum))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if x.OptionalNestedMessage != nil {
+		l = options.Size(x.OptionalNestedMessage)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.OptionalNestedMessage); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if x.OptionalBytes != nil {
+		i -= len(x.OptionalBytes)
+		copy(dAtA[i:], x.OptionalBytes)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OptionalBytes)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if x.OptionalString != nil {
+		if !utf8.ValidString(*x.OptionalString) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(*x.OptionalString)
+		copy(dAtA[i:], *x.OptionalString)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(*x.OptionalString)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if x.OptionalBool != nil {
+		i--
+		if *x.OptionalBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if x.OptionalDouble != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*x.OptionalDouble))))
+		i--
+		dAtA[i] = 0x61
+	}
+	if x.OptionalFloat != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(*x.OptionalFloat))))
+		i--
+		dAtA[i] = 0x5d
+	}
+	if x.OptionalSfixed64 != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*x.OptionalSfixed64))
+		i--
+		dAtA[i] = 0x51
+	}
+	if x.OptionalSfixed32 != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*x.OptionalSfixed32))
+		i--
+		dAtA[i] = 0x4d
+	}
+	if x.OptionalFixed64 != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*x.OptionalFixed64))
+		i--
+		dAtA[i] = 0x41
+	}
+	if x.OptionalFixed32 != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*x.OptionalFixed32))
+		i--
+		dAtA[i] = 0x3d
+	}
+	if x.OptionalSint64 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64((uint64(*x.OptionalSint64)<<1)^uint64((*x.OptionalSint64>>63))))
+		i--
+		dAtA[i] = 0x30
+	}
+	if x.OptionalSint32 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64((uint32(*x.OptionalSint32)<<1)^uint32((*x.OptionalSint32>>31))))
+		i--
+		dAtA[i] = 0x28
+	}
+	if x.OptionalUint64 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalUint64))
+		i--
+		dAtA[i] = 0x20
+	}
+	if x.OptionalUint32 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalUint32))
+		i--
+		dAtA[i] = 0x18
+	}
+	if x.OptionalInt64 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalInt64))
+		i--
+		dAtA[i] = 0x10
+	}
+	if x.OptionalInt32 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalInt32))
+		i--
+		dAtA[i] = 0x8
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestAllTypes_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestAllTypes.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	if zeroCopy {
+		x.zeroCopied = true
+	}
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalInt32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OptionalInt32 = &v
+		case 2:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalInt64", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OptionalInt64 = &v
+		case 3:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalUint32", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OptionalUint32 = &v
+		case 4:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalUint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OptionalUint64 = &v
+		case 5:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalSint32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			x.OptionalSint32 = &v
+		case 6:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalSint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			v2 := int64(v)
+			x.OptionalSint64 = &v2
+		case 7:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalFixed32", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			x.OptionalFixed32 = &v
+		case 8:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalFixed64", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			x.OptionalFixed64 = &v
+		case 9:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalSfixed32", wireType)
+			}
+			var v int32
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			x.OptionalSfixed32 = &v
+		case 10:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalSfixed64", wireType)
+			}
+			var v int64
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			x.OptionalSfixed64 = &v
+		case 11:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalFloat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			v2 := float32(math.Float32frombits(v))
+			x.OptionalFloat = &v2
+		case 12:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			x.OptionalDouble = &v2
+		case 13:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			x.OptionalBool = &b
+		case 14:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_optional_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			s := runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			x.OptionalString = &s
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_optional_bytes, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if zeroCopy || x.zeroCopied {
+				x.OptionalBytes = runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy)
+			} else {
+				x.OptionalBytes = append(x.OptionalBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.OptionalBytes == nil {
+					x.OptionalBytes = []byte{}
+				}
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.OptionalNestedMessage == nil {
+				if err := budget.Message(fd_TestAllTypes_optional_nested_message, int(unsafe.Sizeof(TestAllTypes_NestedMessage{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.OptionalNestedMessage = &TestAllTypes_NestedMessage{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OptionalNestedMessage); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_optional_nested_message, -1)
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalNestedEnum", wireType)
+			}
+			var v TestAllTypes_NestedEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= TestAllTypes_NestedEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OptionalNestedEnum = &v
+		case 22:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalClosedEnum", wireType)
+			}
+			var v ClosedEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= ClosedEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if !(v == 0 || (v >= 4 && v <= 6) || v == 10) {
+				if !options.DiscardUnknown {
+					x.unknownFields = protowire.AppendTag(x.unknownFields, 22, protowire.VarintType)
+					x.unknownFields = protowire.AppendVarint(x.unknownFields, uint64(v))
+				}
+			} else {
+				x.OptionalClosedEnum = &v
+			}
+		case 23:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ImplicitInt32", wireType)
+			}
+			x.ImplicitInt32 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.ImplicitInt32 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ImplicitString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_implicit_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.ImplicitString = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ImplicitBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_implicit_bytes, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if zeroCopy || x.zeroCopied {
+				x.ImplicitBytes = runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy)
+			} else {
+				x.ImplicitBytes = append(x.ImplicitBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.ImplicitBytes == nil {
+					x.ImplicitBytes = []byte{}
+				}
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ImplicitEnum", wireType)
+			}
+			x.ImplicitEnum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.ImplicitEnum |= TestAllTypes_NestedEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_int32, len(x.RepeatedInt32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RepeatedInt32 = append(x.RepeatedInt32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_int32, len(x.RepeatedInt32), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedInt32) == 0 {
+					x.RepeatedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RepeatedInt32 = append(x.RepeatedInt32, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32", wireType)
+			}
+		case 32:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_int64, len(x.RepeatedInt64), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RepeatedInt64 = append(x.RepeatedInt64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_int64, len(x.RepeatedInt64), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedInt64) == 0 {
+					x.RepeatedInt64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RepeatedInt64 = append(x.RepeatedInt64, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt64", wireType)
+			}
+		case 33:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_uint32, len(x.RepeatedUint32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RepeatedUint32 = append(x.RepeatedUint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_uint32, len(x.RepeatedUint32), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedUint32) == 0 {
+					x.RepeatedUint32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RepeatedUint32 = append(x.RepeatedUint32, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint32", wireType)
+			}
+		case 34:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_uint64, len(x.RepeatedUint64), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RepeatedUint64 = append(x.RepeatedUint64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_uint64, len(x.RepeatedUint64), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedUint64) == 0 {
+					x.RepeatedUint64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RepeatedUint64 = append(x.RepeatedUint64, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint64", wireType)
+			}
+		case 35:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_sint32, len(x.RepeatedSint32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				x.RepeatedSint32 = append(x.RepeatedSint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_sint32, len(x.RepeatedSint32), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedSint32) == 0 {
+					x.RepeatedSint32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					x.RepeatedSint32 = append(x.RepeatedSint32, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint32", wireType)
+			}
+		case 36:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_sint64, len(x.RepeatedSint64), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				x.RepeatedSint64 = append(x.RepeatedSint64, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_sint64, len(x.RepeatedSint64), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedSint64) == 0 {
+					x.RepeatedSint64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					x.RepeatedSint64 = append(x.RepeatedSint64, int64(v))
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint64", wireType)
+			}
+		case 37:
+			if wireType == 5 {
+				if err := budget.List(fd_TestAllTypes_repeated_fixed32, len(x.RepeatedFixed32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint32
+				if (iNdEx + 4) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				x.RepeatedFixed32 = append(x.RepeatedFixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if err := budget.List(fd_TestAllTypes_repeated_fixed32, len(x.RepeatedFixed32), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedFixed32) == 0 {
+					x.RepeatedFixed32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					x.RepeatedFixed32 = append(x.RepeatedFixed32, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedFixed32", wireType)
+			}
+		case 38:
+			if wireType == 1 {
+				if err := budget.List(fd_TestAllTypes_repeated_fixed64, len(x.RepeatedFixed64), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				x.RepeatedFixed64 = append(x.RepeatedFixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if err := budget.List(fd_TestAllTypes_repeated_fixed64, len(x.RepeatedFixed64), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedFixed64) == 0 {
+					x.RepeatedFixed64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					x.RepeatedFixed64 = append(x.RepeatedFixed64, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedFixed64", wireType)
+			}
+		case 39:
+			if wireType == 5 {
+				if err := budget.List(fd_TestAllTypes_repeated_sfixed32, len(x.RepeatedSfixed32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int32
+				if (iNdEx + 4) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				x.RepeatedSfixed32 = append(x.RepeatedSfixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if err := budget.List(fd_TestAllTypes_repeated_sfixed32, len(x.RepeatedSfixed32), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedSfixed32) == 0 {
+					x.RepeatedSfixed32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					if (iNdEx + 4) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					x.RepeatedSfixed32 = append(x.RepeatedSfixed32, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedSfixed32", wireType)
+			}
+		case 40:
+			if wireType == 1 {
+				if err := budget.List(fd_TestAllTypes_repeated_sfixed64, len(x.RepeatedSfixed64), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				x.RepeatedSfixed64 = append(x.RepeatedSfixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if err := budget.List(fd_TestAllTypes_repeated_sfixed64, len(x.RepeatedSfixed64), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedSfixed64) == 0 {
+					x.RepeatedSfixed64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					x.RepeatedSfixed64 = append(x.RepeatedSfixed64, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedSfixed64", wireType)
+			}
+		case 41:
+			if wireType == 5 {
+				if err := budget.List(fd_TestAllTypes_repeated_float, len(x.RepeatedFloat), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint32
+				if (iNdEx + 4) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				x.RepeatedFloat = append(x.RepeatedFloat, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if err := budget.List(fd_TestAllTypes_repeated_float, len(x.RepeatedFloat), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedFloat) == 0 {
+					x.RepeatedFloat = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					x.RepeatedFloat = append(x.RepeatedFloat, v2)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedFloat", wireType)
+			}
+		case 42:
+			if wireType == 1 {
+				if err := budget.List(fd_TestAllTypes_repeated_double, len(x.RepeatedDouble), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				x.RepeatedDouble = append(x.RepeatedDouble, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if err := budget.List(fd_TestAllTypes_repeated_double, len(x.RepeatedDouble), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedDouble) == 0 {
+					x.RepeatedDouble = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					x.RepeatedDouble = append(x.RepeatedDouble, v2)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedDouble", wireType)
+			}
+		case 43:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_bool, len(x.RepeatedBool), 1, 1); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RepeatedBool = append(x.RepeatedBool, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if err := budget.List(fd_TestAllTypes_repeated_bool, len(x.RepeatedBool), elementCount, 1); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedBool) == 0 {
+					x.RepeatedBool = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RepeatedBool = append(x.RepeatedBool, bool(v != 0))
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedBool", wireType)
+			}
+		case 44:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedString", wireType)
+			}
+			if err := budget.List(fd_TestAllTypes_repeated_string, len(x.RepeatedString), 1, 16); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_repeated_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.RepeatedString = append(x.RepeatedString, runtime.String(dAtA[iNdEx:postIndex], zeroCopy))
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedBytes", wireType)
+			}
+			if err := budget.List(fd_TestAllTypes_repeated_bytes, len(x.RepeatedBytes), 1, 24); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_repeated_bytes, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if zeroCopy || x.zeroCopied {
+				x.RepeatedBytes = append(x.RepeatedBytes, runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy))
+			} else {
+				x.RepeatedBytes = runtime.AppendBytes(x.RepeatedBytes, dAtA[iNdEx:postIndex])
+			}
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedNestedMessage", wireType)
+			}
+			if err := budget.List(fd_TestAllTypes_repeated_nested_message, len(x.RepeatedNestedMessage), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Message(fd_TestAllTypes_repeated_nested_message, int(unsafe.Sizeof(TestAllTypes_NestedMessage{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.RepeatedNestedMessage = append(x.RepeatedNestedMessage, &TestAllTypes_NestedMessage{})
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RepeatedNestedMessage[len(x.RepeatedNestedMessage)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_repeated_nested_message, len(x.RepeatedNestedMessage)-1)
+			}
+			iNdEx = postIndex
+		case 51:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_nested_enum, len(x.RepeatedNestedEnum), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v TestAllTypes_NestedEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= TestAllTypes_NestedEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RepeatedNestedEnum = append(x.RepeatedNestedEnum, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_nested_enum, len(x.RepeatedNestedEnum), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedNestedEnum) == 0 {
+					x.RepeatedNestedEnum = make([]TestAllTypes_NestedEnum, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v TestAllTypes_NestedEnum
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= TestAllTypes_NestedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RepeatedNestedEnum = append(x.RepeatedNestedEnum, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedNestedEnum", wireType)
+			}
+		case 52:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_closed_enum, len(x.RepeatedClosedEnum), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v ClosedEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ClosedEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if !(v == 0 || (v >= 4 && v <= 6) || v == 10) {
+					if !options.DiscardUnknown {
+						x.unknownFields = protowire.AppendTag(x.unknownFields, 52, protowire.VarintType)
+						x.unknownFields = protowire.AppendVarint(x.unknownFields, uint64(v))
+					}
+				} else {
+					x.RepeatedClosedEnum = append(x.RepeatedClosedEnum, v)
+				}
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_closed_enum, len(x.RepeatedClosedEnum), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedClosedEnum) == 0 {
+					x.RepeatedClosedEnum = make([]ClosedEnum, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ClosedEnum
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ClosedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if !(v == 0 || (v >= 4 && v <= 6) || v == 10) {
+						if !options.DiscardUnknown {
+							x.unknownFields = protowire.AppendTag(x.unknownFields, 52, protowire.VarintType)
+							x.unknownFields = protowire.AppendVarint(x.unknownFields, uint64(v))
+						}
+					} else {
+						x.RepeatedClosedEnum = append(x.RepeatedClosedEnum, v)
+					}
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedClosedEnum", wireType)
+			}
+		case 53:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_expanded_int32, len(x.ExpandedInt32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ExpandedInt32 = append(x.ExpandedInt32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_expanded_int32, len(x.ExpandedInt32), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.ExpandedInt32) == 0 {
+					x.ExpandedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.ExpandedInt32 = append(x.ExpandedInt32, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpandedInt32", wireType)
+			}
+		case 54:
+			if wireType == 1 {
+				if err := budget.List(fd_TestAllTypes_expanded_double, len(x.ExpandedDouble), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				x.ExpandedDouble = append(x.ExpandedDouble, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if err := budget.List(fd_TestAllTypes_expanded_double, len(x.ExpandedDouble), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.ExpandedDouble) == 0 {
+					x.ExpandedDouble = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					x.ExpandedDouble = append(x.ExpandedDouble, v2)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpandedDouble", wireType)
+			}
+		case 55:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_expanded_closed_enum, len(x.ExpandedClosedEnum), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v ClosedEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ClosedEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if !(v == 0 || (v >= 4 && v <= 6) || v == 10) {
+					if !options.DiscardUnknown {
+						x.unknownFields = protowire.AppendTag(x.unknownFields, 55, protowire.VarintType)
+						x.unknownFields = protowire.AppendVarint(x.unknownFields, uint64(v))
+					}
+				} else {
+					x.ExpandedClosedEnum = append(x.ExpandedClosedEnum, v)
+				}
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_expanded_closed_enum, len(x.ExpandedClosedEnum), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.ExpandedClosedEnum) == 0 {
+					x.ExpandedClosedEnum = make([]ClosedEnum, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ClosedEnum
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ClosedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if !(v == 0 || (v >= 4 && v <= 6) || v == 10) {
+						if !options.DiscardUnknown {
+							x.unknownFields = protowire.AppendTag(x.unknownFields, 55, protowire.VarintType)
+							x.unknownFields = protowire.AppendVarint(x.unknownFields, uint64(v))
+						}
+					} else {
+						x.ExpandedClosedEnum = append(x.ExpandedClosedEnum, v)
+					}
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpandedClosedEnum", wireType)
+			}
+		case 56:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapInt32Int32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapInt32Int32 == nil {
+				x.MapInt32Int32 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapInt32Int32[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_int32_int32, len(x.MapInt32Int32), 8); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapInt32Int32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 69:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapStringString", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapStringString == nil {
+				x.MapStringString = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_map_string_string, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_map_string_string, intStringLenmapvalue); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapvalue]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapvalue = runtime.String(dAtA[iNdEx:postStringIndexmapvalue], zeroCopy)
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapStringString[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_string_string, len(x.MapStringString), 32); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapStringString[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 71:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapStringNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapStringNestedMessage == nil {
+				x.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage)
+			}
+			var mapkey string
+			var mapvalue *TestAllTypes_NestedMessage
+			var mapvalueErr error
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_map_string_nested_message, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Message(fd_TestAllTypes_map_string_nested_message, int(unsafe.Sizeof(TestAllTypes_NestedMessage{}))); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapvalue = &TestAllTypes_NestedMessage{}
+					if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+						mapvalueErr = err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if mapvalueErr != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarInMap(mapvalueErr, fd_TestAllTypes_map_string_nested_message, mapkey)
+			}
+			if budget != nil {
+				if _, ok := x.MapStringNestedMessage[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_string_nested_message, len(x.MapStringNestedMessage), 24); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapStringNestedMessage[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 73:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapStringNestedEnum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapStringNestedEnum == nil {
+				x.MapStringNestedEnum = make(map[string]TestAllTypes_NestedEnum)
+			}
+			var mapkey string
+			var mapvalue TestAllTypes_NestedEnum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_map_string_nested_enum, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= TestAllTypes_NestedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapStringNestedEnum[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_string_nested_enum, len(x.MapStringNestedEnum), 20); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapStringNestedEnum[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 74:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapStringClosedEnum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapStringClosedEnum == nil {
+				x.MapStringClosedEnum = make(map[string]ClosedEnum)
+			}
+			var mapkey string
+			var mapvalue ClosedEnum
+			var unknownValue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_map_string_closed_enum, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= ClosedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					unknownValue = !(mapvalue == 0 || (mapvalue >= 4 && mapvalue <= 6) || mapvalue == 10)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapStringClosedEnum[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_string_closed_enum, len(x.MapStringClosedEnum), 20); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			if unknownValue {
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[preIndex:postIndex]...)
+				}
+			} else {
+				x.MapStringClosedEnum[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 75:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnverifiedString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_unverified_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			s := runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			x.UnverifiedString = &s
+			iNdEx = postIndex
+		case 76:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnverifiedRepeatedString", wireType)
+			}
+			if err := budget.List(fd_TestAllTypes_unverified_repeated_string, len(x.UnverifiedRepeatedString), 1, 16); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_unverified_repeated_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.UnverifiedRepeatedString = append(x.UnverifiedRepeatedString, runtime.String(dAtA[iNdEx:postIndex], zeroCopy))
+			iNdEx = postIndex
+		case 77:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnverifiedMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.UnverifiedMap == nil {
+				x.UnverifiedMap = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_unverified_map, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_unverified_map, intStringLenmapvalue); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapvalue = runtime.String(dAtA[iNdEx:postStringIndexmapvalue], zeroCopy)
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.UnverifiedMap[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_unverified_map, len(x.UnverifiedMap), 32); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.UnverifiedMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 78:
+			if wireType != 3 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelimitedMessage", wireType)
+			}
+			group, n := protowire.ConsumeGroup(protowire.Number(fieldNum), dAtA[iNdEx:])
+			if n < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, protowire.ParseError(n)
+			}
+			if x.DelimitedMessage == nil {
+				if err := budget.Message(fd_TestAllTypes_delimited_message, int(unsafe.Sizeof(TestAllTypes_NestedMessage{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.DelimitedMessage = &TestAllTypes_NestedMessage{}
+			}
+			if err := options.Unmarshal(group, x.DelimitedMessage); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_delimited_message, -1)
+			}
+			iNdEx += n
+		case 79:
+			if wireType != 3 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedDelimitedMessage", wireType)
+			}
+			if err := budget.List(fd_TestAllTypes_repeated_delimited_message, len(x.RepeatedDelimitedMessage), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			group, n := protowire.ConsumeGroup(protowire.Number(fieldNum), dAtA[iNdEx:])
+			if n < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, protowire.ParseError(n)
+			}
+			if err := budget.Message(fd_TestAllTypes_repeated_delimited_message, int(unsafe.Sizeof(TestAllTypes_NestedMessage{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.RepeatedDelimitedMessage = append(x.RepeatedDelimitedMessage, &TestAllTypes_NestedMessage{})
+			if err := options.Unmarshal(group, x.RepeatedDelimitedMessage[len(x.RepeatedDelimitedMessage)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_repeated_delimited_message, len(x.RepeatedDelimitedMessage)-1)
+			}
+			iNdEx += n
+		case 81:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultInt32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.DefaultInt32 = &v
+		case 94:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_default_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			s := runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			x.DefaultString = &s
+			iNdEx = postIndex
+		case 97:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultClosedEnum", wireType)
+			}
+			var v ClosedEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= ClosedEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if !(v == 0 || (v >= 4 && v <= 6) || v == 10) {
+				if !options.DiscardUnknown {
+					x.unknownFields = protowire.AppendTag(x.unknownFields, 97, protowire.VarintType)
+					x.unknownFields = protowire.AppendVarint(x.unknownFields, uint64(v))
+				}
+			} else {
+				x.DefaultClosedEnum = &v
+			}
+		case 111:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofUint32", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OneofField = &TestAllTypes_OneofUint32{v}
+		case 112:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if oneof, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); ok && oneof.OneofNestedMessage != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], oneof.OneofNestedMessage); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_oneof_nested_message, -1)
+				}
+			} else {
+				if err := budget.Message(fd_TestAllTypes_oneof_nested_message, int(unsafe.Sizeof(TestAllTypes_NestedMessage{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				v := &TestAllTypes_NestedMessage{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_oneof_nested_message, -1)
+				}
+				x.OneofField = &TestAllTypes_OneofNestedMessage{v}
+			}
+			iNdEx = postIndex
+		case 113:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_oneof_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.OneofField = &TestAllTypes_OneofString{runtime.String(dAtA[iNdEx:postIndex], zeroCopy)}
+			iNdEx = postIndex
+		case 114:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_oneof_bytes, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.OneofField = &TestAllTypes_OneofBytes{runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy)}
+			iNdEx = postIndex
+		case 115:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofClosedEnum", wireType)
+			}
+			var v ClosedEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= ClosedEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if !(v == 0 || (v >= 4 && v <= 6) || v == 10) {
+				if !options.DiscardUnknown {
+					x.unknownFields = protowire.AppendTag(x.unknownFields, 115, protowire.VarintType)
+					x.unknownFields = protowire.AppendVarint(x.unknownFields, uint64(v))
+				}
+			} else {
+				x.OneofField = &TestAllTypes_OneofClosedEnum{v}
+			}
+		case 116:
+			if wireType != 3 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofDelimited", wireType)
+			}
+			group, n := protowire.ConsumeGroup(protowire.Number(fieldNum), dAtA[iNdEx:])
+			if n < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, protowire.ParseError(n)
+			}
+			if oneof, ok := x.OneofField.(*TestAllTypes_OneofDelimited); ok && oneof.OneofDelimited != nil {
+				if err := options.Unmarshal(group, oneof.OneofDelimited); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_oneof_delimited, -1)
+				}
+			} else {
+				if err := budget.Message(fd_TestAllTypes_oneof_delimited, int(unsafe.Sizeof(TestAllTypes_NestedMessage{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				v := &TestAllTypes_NestedMessage{}
+				if err := options.Unmarshal(group, v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_oneof_delimited, -1)
+				}
+				x.OneofField = &TestAllTypes_OneofDelimited{v}
+			}
+			iNdEx += n
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestAllTypes, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_TestAllTypes_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestAllTypes)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestAllTypes)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.OptionalInt32 != nil {
+		v := *src.OptionalInt32
+		dst.OptionalInt32 = &v
+	}
+	if src.OptionalInt64 != nil {
+		v := *src.OptionalInt64
+		dst.OptionalInt64 = &v
+	}
+	if src.OptionalUint32 != nil {
+		v := *src.OptionalUint32
+		dst.OptionalUint32 = &v
+	}
+	if src.OptionalUint64 != nil {
+		v := *src.OptionalUint64
+		dst.OptionalUint64 = &v
+	}
+	if src.OptionalSint32 != nil {
+		v := *src.OptionalSint32
+		dst.OptionalSint32 = &v
+	}
+	if src.OptionalSint64 != nil {
+		v := *src.OptionalSint64
+		dst.OptionalSint64 = &v
+	}
+	if src.OptionalFixed32 != nil {
+		v := *src.OptionalFixed32
+		dst.OptionalFixed32 = &v
+	}
+	if src.OptionalFixed64 != nil {
+		v := *src.OptionalFixed64
+		dst.OptionalFixed64 = &v
+	}
+	if src.OptionalSfixed32 != nil {
+		v := *src.OptionalSfixed32
+		dst.OptionalSfixed32 = &v
+	}
+	if src.OptionalSfixed64 != nil {
+		v := *src.OptionalSfixed64
+		dst.OptionalSfixed64 = &v
+	}
+	if src.OptionalFloat != nil {
+		v := *src.OptionalFloat
+		dst.OptionalFloat = &v
+	}
+	if src.OptionalDouble != nil {
+		v := *src.OptionalDouble
+		dst.OptionalDouble = &v
+	}
+	if src.OptionalBool != nil {
+		v := *src.OptionalBool
+		dst.OptionalBool = &v
+	}
+	if src.OptionalString != nil {
+		v := *src.OptionalString
+		dst.OptionalString = &v
+	}
+	if src.OptionalBytes != nil {
+		dst.OptionalBytes = append([]byte{}, src.OptionalBytes...)
+	}
+	if src.OptionalNestedMessage != nil {
+		if dst.OptionalNestedMessage == nil {
+			dst.OptionalNestedMessage = &TestAllTypes_NestedMessage{}
+		}
+		proto.Merge(dst.OptionalNestedMessage, src.OptionalNestedMessage)
+	}
+	if src.OptionalNestedEnum != nil {
+		v := *src.OptionalNestedEnum
+		dst.OptionalNestedEnum = &v
+	}
+	if src.OptionalClosedEnum != nil {
+		v := *src.OptionalClosedEnum
+		dst.OptionalClosedEnum = &v
+	}
+	if src.ImplicitInt32 != int32(0) {
+		dst.ImplicitInt32 = src.ImplicitInt32
+	}
+	if src.ImplicitString != "" {
+		dst.ImplicitString = src.ImplicitString
+	}
+	if len(src.ImplicitBytes) != 0 {
+		dst.ImplicitBytes = append([]byte{}, src.ImplicitBytes...)
+	}
+	if src.ImplicitEnum != 0 {
+		dst.ImplicitEnum = src.ImplicitEnum
+	}
+	if len(src.RepeatedInt32) != 0 {
+		dst.RepeatedInt32 = append(dst.RepeatedInt32, src.RepeatedInt32...)
+	}
+	if len(src.RepeatedInt64) != 0 {
+		dst.RepeatedInt64 = append(dst.RepeatedInt64, src.RepeatedInt64...)
+	}
+	if len(src.RepeatedUint32) != 0 {
+		dst.RepeatedUint32 = append(dst.RepeatedUint32, src.RepeatedUint32...)
+	}
+	if len(src.RepeatedUint64) != 0 {
+		dst.RepeatedUint64 = append(dst.RepeatedUint64, src.RepeatedUint64...)
+	}
+	if len(src.RepeatedSint32) != 0 {
+		dst.RepeatedSint32 = append(dst.RepeatedSint32, src.RepeatedSint32...)
+	}
+	if len(src.RepeatedSint64) != 0 {
+		dst.RepeatedSint64 = append(dst.RepeatedSint64, src.RepeatedSint64...)
+	}
+	if len(src.RepeatedFixed32) != 0 {
+		dst.RepeatedFixed32 = append(dst.RepeatedFixed32, src.RepeatedFixed32...)
+	}
+	if len(src.RepeatedFixed64) != 0 {
+		dst.RepeatedFixed64 = append(dst.RepeatedFixed64, src.RepeatedFixed64...)
+	}
+	if len(src.RepeatedSfixed32) != 0 {
+		dst.RepeatedSfixed32 = append(dst.RepeatedSfixed32, src.RepeatedSfixed32...)
+	}
+	if len(src.RepeatedSfixed64) != 0 {
+		dst.RepeatedSfixed64 = append(dst.RepeatedSfixed64, src.RepeatedSfixed64...)
+	}
+	if len(src.RepeatedFloat) != 0 {
+		dst.RepeatedFloat = append(dst.RepeatedFloat, src.RepeatedFloat...)
+	}
+	if len(src.RepeatedDouble) != 0 {
+		dst.RepeatedDouble = append(dst.RepeatedDouble, src.RepeatedDouble...)
+	}
+	if len(src.RepeatedBool) != 0 {
+		dst.RepeatedBool = append(dst.RepeatedBool, src.RepeatedBool...)
+	}
+	if len(src.RepeatedString) != 0 {
+		dst.RepeatedString = append(dst.RepeatedString, src.RepeatedString...)
+	}
+	for _, v := range src.RepeatedBytes {
+		dst.RepeatedBytes = append(dst.RepeatedBytes, append([]byte{}, v...))
+	}
+	for _, v := range src.RepeatedNestedMessage {
+		m := &TestAllTypes_NestedMessage{}
+		proto.Merge(m, v)
+		dst.RepeatedNestedMessage = append(dst.RepeatedNestedMessage, m)
+	}
+	if len(src.RepeatedNestedEnum) != 0 {
+		dst.RepeatedNestedEnum = append(dst.RepeatedNestedEnum, src.RepeatedNestedEnum...)
+	}
+	if len(src.RepeatedClosedEnum) != 0 {
+		dst.RepeatedClosedEnum = append(dst.RepeatedClosedEnum, src.RepeatedClosedEnum...)
+	}
+	if len(src.ExpandedInt32) != 0 {
+		dst.ExpandedInt32 = append(dst.ExpandedInt32, src.ExpandedInt32...)
+	}
+	if len(src.ExpandedDouble) != 0 {
+		dst.ExpandedDouble = append(dst.ExpandedDouble, src.ExpandedDouble...)
+	}
+	if len(src.ExpandedClosedEnum) != 0 {
+		dst.ExpandedClosedEnum = append(dst.ExpandedClosedEnum, src.ExpandedClosedEnum...)
+	}
+	if len(src.MapInt32Int32) != 0 {
+		if dst.MapInt32Int32 == nil {
+			dst.MapInt32Int32 = make(map[int32]int32, len(src.MapInt32Int32))
+		}
+		for k, v := range src.MapInt32Int32 {
+			dst.MapInt32Int32[k] = v
+		}
+	}
+	if len(src.MapStringString) != 0 {
+		if dst.MapStringString == nil {
+			dst.MapStringString = make(map[string]string, len(src.MapStringString))
+		}
+		for k, v := range src.MapStringString {
+			dst.MapStringString[k] = v
+		}
+	}
+	if len(src.MapStringNestedMessage) != 0 {
+		if dst.MapStringNestedMessage == nil {
+			dst.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage, len(src.MapStringNestedMessage))
+		}
+		for k, v := range src.MapStringNestedMessage {
+			m := &TestAllTypes_NestedMessage{}
+			proto.Merge(m, v)
+			dst.MapStringNestedMessage[k] = m
+		}
+	}
+	if len(src.MapStringNestedEnum) != 0 {
+		if dst.MapStringNestedEnum == nil {
+			dst.MapStringNestedEnum = make(map[string]TestAllTypes_NestedEnum, len(src.MapStringNestedEnum))
+		}
+		for k, v := range src.MapStringNestedEnum {
+			dst.MapStringNestedEnum[k] = v
+		}
+	}
+	if len(src.MapStringClosedEnum) != 0 {
+		if dst.MapStringClosedEnum == nil {
+			dst.MapStringClosedEnum = make(map[string]ClosedEnum, len(src.MapStringClosedEnum))
+		}
+		for k, v := range src.MapStringClosedEnum {
+			dst.MapStringClosedEnum[k] = v
+		}
+	}
+	if src.UnverifiedString != nil {
+		v := *src.UnverifiedString
+		dst.UnverifiedString = &v
+	}
+	if len(src.UnverifiedRepeatedString) != 0 {
+		dst.UnverifiedRepeatedString = append(dst.UnverifiedRepeatedString, src.UnverifiedRepeatedString...)
+	}
+	if len(src.UnverifiedMap) != 0 {
+		if dst.UnverifiedMap == nil {
+			dst.UnverifiedMap = make(map[string]string, len(src.UnverifiedMap))
+		}
+		for k, v := range src.UnverifiedMap {
+			dst.UnverifiedMap[k] = v
+		}
+	}
+	if src.DelimitedMessage != nil {
+		if dst.DelimitedMessage == nil {
+			dst.DelimitedMessage = &TestAllTypes_NestedMessage{}
+		}
+		proto.Merge(dst.DelimitedMessage, src.DelimitedMessage)
+	}
+	for _, v := range src.RepeatedDelimitedMessage {
+		m := &TestAllTypes_NestedMessage{}
+		proto.Merge(m, v)
+		dst.RepeatedDelimitedMessage = append(dst.RepeatedDelimitedMessage, m)
+	}
+	if src.DefaultInt32 != nil {
+		v := *src.DefaultInt32
+		dst.DefaultInt32 = &v
+	}
+	if src.DefaultString != nil {
+		v := *src.DefaultString
+		dst.DefaultString = &v
+	}
+	if src.DefaultClosedEnum != nil {
+		v := *src.DefaultClosedEnum
+		dst.DefaultClosedEnum = &v
+	}
+	switch v := src.OneofField.(type) {
+	case *TestAllTypes_OneofUint32:
+		dst.OneofField = &TestAllTypes_OneofUint32{OneofUint32: v.OneofUint32}
+	case *TestAllTypes_OneofNestedMessage:
+		if dv, ok := dst.OneofField.(*TestAllTypes_OneofNestedMessage); ok && dv.OneofNestedMessage != nil {
+			proto.Merge(dv.OneofNestedMessage, v.OneofNestedMessage)
+		} else {
+			m := &TestAllTypes_NestedMessage{}
+			proto.Merge(m, v.OneofNestedMessage)
+			dst.OneofField = &TestAllTypes_OneofNestedMessage{OneofNestedMessage: m}
+		}
+	case *TestAllTypes_OneofString:
+		dst.OneofField = &TestAllTypes_OneofString{OneofString: v.OneofString}
+	case *TestAllTypes_OneofBytes:
+		dst.OneofField = &TestAllTypes_OneofBytes{OneofBytes: append([]byte{}, v.OneofBytes...)}
+	case *TestAllTypes_OneofClosedEnum:
+		dst.OneofField = &TestAllTypes_OneofClosedEnum{OneofClosedEnum: v.OneofClosedEnum}
+	case *TestAllTypes_OneofDelimited:
+		if dv, ok := dst.OneofField.(*TestAllTypes_OneofDelimited); ok && dv.OneofDelimited != nil {
+			proto.Merge(dv.OneofDelimited, v.OneofDelimited)
+		} else {
+			m := &TestAllTypes_NestedMessage{}
+			proto.Merge(m, v.OneofDelimited)
+			dst.OneofField = &TestAllTypes_OneofDelimited{OneofDelimited: m}
+		}
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestAllTypes_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestAllTypes_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestAllTypes)
+	y, yok := input.MessageB.Interface().(*TestAllTypes)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalInt32 == nil) != (y.OptionalInt32 == nil) || x.OptionalInt32 != nil && *x.OptionalInt32 != *y.OptionalInt32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalInt64 == nil) != (y.OptionalInt64 == nil) || x.OptionalInt64 != nil && *x.OptionalInt64 != *y.OptionalInt64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalUint32 == nil) != (y.OptionalUint32 == nil) || x.OptionalUint32 != nil && *x.OptionalUint32 != *y.OptionalUint32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalUint64 == nil) != (y.OptionalUint64 == nil) || x.OptionalUint64 != nil && *x.OptionalUint64 != *y.OptionalUint64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalSint32 == nil) != (y.OptionalSint32 == nil) || x.OptionalSint32 != nil && *x.OptionalSint32 != *y.OptionalSint32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalSint64 == nil) != (y.OptionalSint64 == nil) || x.OptionalSint64 != nil && *x.OptionalSint64 != *y.OptionalSint64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalFixed32 == nil) != (y.OptionalFixed32 == nil) || x.OptionalFixed32 != nil && *x.OptionalFixed32 != *y.OptionalFixed32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalFixed64 == nil) != (y.OptionalFixed64 == nil) || x.OptionalFixed64 != nil && *x.OptionalFixed64 != *y.OptionalFixed64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalSfixed32 == nil) != (y.OptionalSfixed32 == nil) || x.OptionalSfixed32 != nil && *x.OptionalSfixed32 != *y.OptionalSfixed32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalSfixed64 == nil) != (y.OptionalSfixed64 == nil) || x.OptionalSfixed64 != nil && *x.OptionalSfixed64 != *y.OptionalSfixed64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalFloat == nil) != (y.OptionalFloat == nil) || x.OptionalFloat != nil && !runtime.EqualFloat(float64(*x.OptionalFloat), float64(*y.OptionalFloat)) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalDouble == nil) != (y.OptionalDouble == nil) || x.OptionalDouble != nil && !runtime.EqualFloat(*x.OptionalDouble, *y.OptionalDouble) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalBool == nil) != (y.OptionalBool == nil) || x.OptionalBool != nil && *x.OptionalBool != *y.OptionalBool {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalString == nil) != (y.OptionalString == nil) || x.OptionalString != nil && *x.OptionalString != *y.OptionalString {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalBytes == nil) != (y.OptionalBytes == nil) || !bytes.Equal(x.OptionalBytes, y.OptionalBytes) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.OptionalNestedMessage.Equal(y.OptionalNestedMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalNestedEnum == nil) != (y.OptionalNestedEnum == nil) || x.OptionalNestedEnum != nil && *x.OptionalNestedEnum != *y.OptionalNestedEnum {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalClosedEnum == nil) != (y.OptionalClosedEnum == nil) || x.OptionalClosedEnum != nil && *x.OptionalClosedEnum != *y.OptionalClosedEnum {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.ImplicitInt32 != y.ImplicitInt32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.ImplicitString != y.ImplicitString {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !bytes.Equal(x.ImplicitBytes, y.ImplicitBytes) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.ImplicitEnum != y.ImplicitEnum {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.RepeatedInt32) != len(y.RepeatedInt32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedInt32 {
+		if v != y.RepeatedInt32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedInt64) != len(y.RepeatedInt64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedInt64 {
+		if v != y.RepeatedInt64[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedUint32) != len(y.RepeatedUint32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedUint32 {
+		if v != y.RepeatedUint32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedUint64) != len(y.RepeatedUint64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedUint64 {
+		if v != y.RepeatedUint64[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedSint32) != len(y.RepeatedSint32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedSint32 {
+		if v != y.RepeatedSint32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedSint64) != len(y.RepeatedSint64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedSint64 {
+		if v != y.RepeatedSint64[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedFixed32) != len(y.RepeatedFixed32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedFixed32 {
+		if v != y.RepeatedFixed32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedFixed64) != len(y.RepeatedFixed64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedFixed64 {
+		if v != y.RepeatedFixed64[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedSfixed32) != len(y.RepeatedSfixed32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedSfixed32 {
+		if v != y.RepeatedSfixed32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedSfixed64) != len(y.RepeatedSfixed64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedSfixed64 {
+		if v != y.RepeatedSfixed64[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedFloat) != len(y.RepeatedFloat) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedFloat {
+		if !runtime.EqualFloat(float64(v), float64(y.RepeatedFloat[i])) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedDouble) != len(y.RepeatedDouble) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedDouble {
+		if !runtime.EqualFloat(v, y.RepeatedDouble[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedBool) != len(y.RepeatedBool) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedBool {
+		if v != y.RepeatedBool[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedString) != len(y.RepeatedString) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedString {
+		if v != y.RepeatedString[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedBytes) != len(y.RepeatedBytes) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedBytes {
+		if !bytes.Equal(v, y.RepeatedBytes[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedNestedMessage) != len(y.RepeatedNestedMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedNestedMessage {
+		if !v.Equal(y.RepeatedNestedMessage[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedNestedEnum) != len(y.RepeatedNestedEnum) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedNestedEnum {
+		if v != y.RepeatedNestedEnum[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedClosedEnum) != len(y.RepeatedClosedEnum) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedClosedEnum {
+		if v != y.RepeatedClosedEnum[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.ExpandedInt32) != len(y.ExpandedInt32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.ExpandedInt32 {
+		if v != y.ExpandedInt32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.ExpandedDouble) != len(y.ExpandedDouble) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.ExpandedDouble {
+		if !runtime.EqualFloat(v, y.ExpandedDouble[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.ExpandedClosedEnum) != len(y.ExpandedClosedEnum) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.ExpandedClosedEnum {
+		if v != y.ExpandedClosedEnum[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapInt32Int32) != len(y.MapInt32Int32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapInt32Int32 {
+		if w, ok := y.MapInt32Int32[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapStringString) != len(y.MapStringString) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapStringString {
+		if w, ok := y.MapStringString[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapStringNestedMessage) != len(y.MapStringNestedMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapStringNestedMessage {
+		if w, ok := y.MapStringNestedMessage[k]; !ok || !v.Equal(w) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapStringNestedEnum) != len(y.MapStringNestedEnum) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapStringNestedEnum {
+		if w, ok := y.MapStringNestedEnum[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapStringClosedEnum) != len(y.MapStringClosedEnum) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapStringClosedEnum {
+		if w, ok := y.MapStringClosedEnum[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if (x.UnverifiedString == nil) != (y.UnverifiedString == nil) || x.UnverifiedString != nil && *x.UnverifiedString != *y.UnverifiedString {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.UnverifiedRepeatedString) != len(y.UnverifiedRepeatedString) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.UnverifiedRepeatedString {
+		if v != y.UnverifiedRepeatedString[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.UnverifiedMap) != len(y.UnverifiedMap) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.UnverifiedMap {
+		if w, ok := y.UnverifiedMap[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if !x.DelimitedMessage.Equal(y.DelimitedMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.RepeatedDelimitedMessage) != len(y.RepeatedDelimitedMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedDelimitedMessage {
+		if !v.Equal(y.RepeatedDelimitedMessage[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if (x.DefaultInt32 == nil) != (y.DefaultInt32 == nil) || x.DefaultInt32 != nil && *x.DefaultInt32 != *y.DefaultInt32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultString == nil) != (y.DefaultString == nil) || x.DefaultString != nil && *x.DefaultString != *y.DefaultString {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.DefaultClosedEnum == nil) != (y.DefaultClosedEnum == nil) || x.DefaultClosedEnum != nil && *x.DefaultClosedEnum != *y.DefaultClosedEnum {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	switch v := x.OneofField.(type) {
+	case nil:
+		if y.OneofField != nil {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofUint32:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofUint32); !ok || v.OneofUint32 != w.OneofUint32 {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofNestedMessage:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofNestedMessage); !ok || !v.OneofNestedMessage.Equal(w.OneofNestedMessage) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofString:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofString); !ok || v.OneofString != w.OneofString {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofBytes:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofBytes); !ok || !bytes.Equal(v.OneofBytes, w.OneofBytes) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofClosedEnum:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofClosedEnum); !ok || v.OneofClosedEnum != w.OneofClosedEnum {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofDelimited:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofDelimited); !ok || !v.OneofDelimited.Equal(w.OneofDelimited) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestAllTypes) Clone() *TestAllTypes {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestAllTypes)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestAllTypes) CopyInto(dst *TestAllTypes) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	if x.OptionalInt32 != nil {
+		v := *x.OptionalInt32
+		dst.OptionalInt32 = &v
+	} else {
+		dst.OptionalInt32 = nil
+	}
+	if x.OptionalInt64 != nil {
+		v := *x.OptionalInt64
+		dst.OptionalInt64 = &v
+	} else {
+		dst.OptionalInt64 = nil
+	}
+	if x.OptionalUint32 != nil {
+		v := *x.OptionalUint32
+		dst.OptionalUint32 = &v
+	} else {
+		dst.OptionalUint32 = nil
+	}
+	if x.OptionalUint64 != nil {
+		v := *x.OptionalUint64
+		dst.OptionalUint64 = &v
+	} else {
+		dst.OptionalUint64 = nil
+	}
+	if x.OptionalSint32 != nil {
+		v := *x.OptionalSint32
+		dst.OptionalSint32 = &v
+	} else {
+		dst.OptionalSint32 = nil
+	}
+	if x.OptionalSint64 != nil {
+		v := *x.OptionalSint64
+		dst.OptionalSint64 = &v
+	} else {
+		dst.OptionalSint64 = nil
+	}
+	if x.OptionalFixed32 != nil {
+		v := *x.OptionalFixed32
+		dst.OptionalFixed32 = &v
+	} else {
+		dst.OptionalFixed32 = nil
+	}
+	if x.OptionalFixed64 != nil {
+		v := *x.OptionalFixed64
+		dst.OptionalFixed64 = &v
+	} else {
+		dst.OptionalFixed64 = nil
+	}
+	if x.OptionalSfixed32 != nil {
+		v := *x.OptionalSfixed32
+		dst.OptionalSfixed32 = &v
+	} else {
+		dst.OptionalSfixed32 = nil
+	}
+	if x.OptionalSfixed64 != nil {
+		v := *x.OptionalSfixed64
+		dst.OptionalSfixed64 = &v
+	} else {
+		dst.OptionalSfixed64 = nil
+	}
+	if x.OptionalFloat != nil {
+		v := *x.OptionalFloat
+		dst.OptionalFloat = &v
+	} else {
+		dst.OptionalFloat = nil
+	}
+	if x.OptionalDouble != nil {
+		v := *x.OptionalDouble
+		dst.OptionalDouble = &v
+	} else {
+		dst.OptionalDouble = nil
+	}
+	if x.OptionalBool != nil {
+		v := *x.OptionalBool
+		dst.OptionalBool = &v
+	} else {
+		dst.OptionalBool = nil
+	}
+	if x.OptionalString != nil {
+		v := *x.OptionalString
+		dst.OptionalString = &v
+	} else {
+		dst.OptionalString = nil
+	}
+	dst.OptionalBytes = bytes.Clone(x.OptionalBytes)
+	dst.OptionalNestedMessage = x.OptionalNestedMessage.Clone()
+	if x.OptionalNestedEnum != nil {
+		v := *x.OptionalNestedEnum
+		dst.OptionalNestedEnum = &v
+	} else {
+		dst.OptionalNestedEnum = nil
+	}
+	if x.OptionalClosedEnum != nil {
+		v := *x.OptionalClosedEnum
+		dst.OptionalClosedEnum = &v
+	} else {
+		dst.OptionalClosedEnum = nil
+	}
+	dst.ImplicitInt32 = x.ImplicitInt32
+	dst.ImplicitString = x.ImplicitString
+	dst.ImplicitBytes = bytes.Clone(x.ImplicitBytes)
+	dst.ImplicitEnum = x.ImplicitEnum
+	dst.RepeatedInt32 = slices.Clone(x.RepeatedInt32)
+	dst.RepeatedInt64 = slices.Clone(x.RepeatedInt64)
+	dst.RepeatedUint32 = slices.Clone(x.RepeatedUint32)
+	dst.RepeatedUint64 = slices.Clone(x.RepeatedUint64)
+	dst.RepeatedSint32 = slices.Clone(x.RepeatedSint32)
+	dst.RepeatedSint64 = slices.Clone(x.RepeatedSint64)
+	dst.RepeatedFixed32 = slices.Clone(x.RepeatedFixed32)
+	dst.RepeatedFixed64 = slices.Clone(x.RepeatedFixed64)
+	dst.RepeatedSfixed32 = slices.Clone(x.RepeatedSfixed32)
+	dst.RepeatedSfixed64 = slices.Clone(x.RepeatedSfixed64)
+	dst.RepeatedFloat = slices.Clone(x.RepeatedFloat)
+	dst.RepeatedDouble = slices.Clone(x.RepeatedDouble)
+	dst.RepeatedBool = slices.Clone(x.RepeatedBool)
+	dst.RepeatedString = slices.Clone(x.RepeatedString)
+	if x.RepeatedBytes != nil {
+		dst.RepeatedBytes = make([][]byte, len(x.RepeatedBytes))
+		for i, v := range x.RepeatedBytes {
+			dst.RepeatedBytes[i] = bytes.Clone(v)
+		}
+	} else {
+		dst.RepeatedBytes = nil
+	}
+	if x.RepeatedNestedMessage != nil {
+		dst.RepeatedNestedMessage = make([]*TestAllTypes_NestedMessage, len(x.RepeatedNestedMessage))
+		for i, v := range x.RepeatedNestedMessage {
+			dst.RepeatedNestedMessage[i] = v.Clone()
+		}
+	} else {
+		dst.RepeatedNestedMessage = nil
+	}
+	dst.RepeatedNestedEnum = slices.Clone(x.RepeatedNestedEnum)
+	dst.RepeatedClosedEnum = slices.Clone(x.RepeatedClosedEnum)
+	dst.ExpandedInt32 = slices.Clone(x.ExpandedInt32)
+	dst.ExpandedDouble = slices.Clone(x.ExpandedDouble)
+	dst.ExpandedClosedEnum = slices.Clone(x.ExpandedClosedEnum)
+	dst.MapInt32Int32 = maps.Clone(x.MapInt32Int32)
+	dst.MapStringString = maps.Clone(x.MapStringString)
+	if x.MapStringNestedMessage != nil {
+		dst.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage, len(x.MapStringNestedMessage))
+		for k, v := range x.MapStringNestedMessage {
+			dst.MapStringNestedMessage[k] = v.Clone()
+		}
+	} else {
+		dst.MapStringNestedMessage = nil
+	}
+	dst.MapStringNestedEnum = maps.Clone(x.MapStringNestedEnum)
+	dst.MapStringClosedEnum = maps.Clone(x.MapStringClosedEnum)
+	if x.UnverifiedString != nil {
+		v := *x.UnverifiedString
+		dst.UnverifiedString = &v
+	} else {
+		dst.UnverifiedString = nil
+	}
+	dst.UnverifiedRepeatedString = slices.Clone(x.UnverifiedRepeatedString)
+	dst.UnverifiedMap = maps.Clone(x.UnverifiedMap)
+	dst.DelimitedMessage = x.DelimitedMessage.Clone()
+	if x.RepeatedDelimitedMessage != nil {
+		dst.RepeatedDelimitedMessage = make([]*TestAllTypes_NestedMessage, len(x.RepeatedDelimitedMessage))
+		for i, v := range x.RepeatedDelimitedMessage {
+			dst.RepeatedDelimitedMessage[i] = v.Clone()
+		}
+	} else {
+		dst.RepeatedDelimitedMessage = nil
+	}
+	if x.DefaultInt32 != nil {
+		v := *x.DefaultInt32
+		dst.DefaultInt32 = &v
+	} else {
+		dst.DefaultInt32 = nil
+	}
+	if x.DefaultString != nil {
+		v := *x.DefaultString
+		dst.DefaultString = &v
+	} else {
+		dst.DefaultString = nil
+	}
+	if x.DefaultClosedEnum != nil {
+		v := *x.DefaultClosedEnum
+		dst.DefaultClosedEnum = &v
+	} else {
+		dst.DefaultClosedEnum = nil
+	}
+	switch v := x.OneofField.(type) {
+	case nil:
+		dst.OneofField = nil
+	case *TestAllTypes_OneofUint32:
+		dst.OneofField = &TestAllTypes_OneofUint32{OneofUint32: v.OneofUint32}
+	case *TestAllTypes_OneofNestedMessage:
+		dst.OneofField = &TestAllTypes_OneofNestedMessage{OneofNestedMessage: v.OneofNestedMessage.Clone()}
+	case *TestAllTypes_OneofString:
+		dst.OneofField = &TestAllTypes_OneofString{OneofString: v.OneofString}
+	case *TestAllTypes_OneofBytes:
+		dst.OneofField = &TestAllTypes_OneofBytes{OneofBytes: bytes.Clone(v.OneofBytes)}
+	case *TestAllTypes_OneofClosedEnum:
+		dst.OneofField = &TestAllTypes_OneofClosedEnum{OneofClosedEnum: v.OneofClosedEnum}
+	case *TestAllTypes_OneofDelimited:
+		dst.OneofField = &TestAllTypes_OneofDelimited{OneofDelimited: v.OneofDelimited.Clone()}
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestAllTypes) Equal(y *TestAllTypes) bool {
+	return _fastReflection_TestAllTypes_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestAllTypes, or nil if b is canonical.
+func (*TestAllTypes) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestAllTypes.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("optional_int32", protoreflect.Int32Kind, false)
+		case 2:
+			w.Scalar("optional_int64", protoreflect.Int64Kind, false)
+		case 3:
+			w.Scalar("optional_uint32", protoreflect.Uint32Kind, false)
+		case 4:
+			w.Scalar("optional_uint64", protoreflect.Uint64Kind, false)
+		case 5:
+			w.Scalar("optional_sint32", protoreflect.Sint32Kind, false)
+		case 6:
+			w.Scalar("optional_sint64", protoreflect.Sint64Kind, false)
+		case 7:
+			w.Scalar("optional_fixed32", protoreflect.Fixed32Kind, false)
+		case 8:
+			w.Scalar("optional_fixed64", protoreflect.Fixed64Kind, false)
+		case 9:
+			w.Scalar("optional_sfixed32", protoreflect.Sfixed32Kind, false)
+		case 10:
+			w.Scalar("optional_sfixed64", protoreflect.Sfixed64Kind, false)
+		case 11:
+			w.Scalar("optional_float", protoreflect.FloatKind, false)
+		case 12:
+			w.Scalar("optional_double", protoreflect.DoubleKind, false)
+		case 13:
+			w.Scalar("optional_bool", protoreflect.BoolKind, false)
+		case 14:
+			w.Scalar("optional_string", protoreflect.StringKind, false)
+		case 15:
+			w.Scalar("optional_bytes", protoreflect.BytesKind, false)
+		case 18:
+			w.Message("optional_nested_message", false, (*TestAllTypes_NestedMessage)(nil))
+		case 21:
+			w.Scalar("optional_nested_enum", protoreflect.EnumKind, false)
+		case 22:
+			w.Scalar("optional_closed_enum", protoreflect.EnumKind, false)
+		case 23:
+			w.Scalar("implicit_int32", protoreflect.Int32Kind, true)
+		case 24:
+			w.Scalar("implicit_string", protoreflect.StringKind, true)
+		case 25:
+			w.Scalar("implicit_bytes", protoreflect.BytesKind, true)
+		case 26:
+			w.Scalar("implicit_enum", protoreflect.EnumKind, true)
+		case 31:
+			w.List("repeated_int32", protoreflect.Int32Kind, true)
+		case 32:
+			w.List("repeated_int64", protoreflect.Int64Kind, true)
+		case 33:
+			w.List("repeated_uint32", protoreflect.Uint32Kind, true)
+		case 34:
+			w.List("repeated_uint64", protoreflect.Uint64Kind, true)
+		case 35:
+			w.List("repeated_sint32", protoreflect.Sint32Kind, true)
+		case 36:
+			w.List("repeated_sint64", protoreflect.Sint64Kind, true)
+		case 37:
+			w.List("repeated_fixed32", protoreflect.Fixed32Kind, true)
+		case 38:
+			w.List("repeated_fixed64", protoreflect.Fixed64Kind, true)
+		case 39:
+			w.List("repeated_sfixed32", protoreflect.Sfixed32Kind, true)
+		case 40:
+			w.List("repeated_sfixed64", protoreflect.Sfixed64Kind, true)
+		case 41:
+			w.List("repeated_float", protoreflect.FloatKind, true)
+		case 42:
+			w.List("repeated_double", protoreflect.DoubleKind, true)
+		case 43:
+			w.List("repeated_bool", protoreflect.BoolKind, true)
+		case 44:
+			w.List("repeated_string", protoreflect.StringKind, false)
+		case 45:
+			w.List("repeated_bytes", protoreflect.BytesKind, false)
+		case 48:
+			w.Message("repeated_nested_message", true, (*TestAllTypes_NestedMessage)(nil))
+		case 51:
+			w.List("repeated_nested_enum", protoreflect.EnumKind, true)
+		case 52:
+			w.List("repeated_closed_enum", protoreflect.EnumKind, true)
+		case 53:
+			w.List("expanded_int32", protoreflect.Int32Kind, false)
+		case 54:
+			w.List("expanded_double", protoreflect.DoubleKind, false)
+		case 55:
+			w.List("expanded_closed_enum", protoreflect.EnumKind, false)
+		case 56:
+			w.Map("map_int32_int32", protoreflect.Int32Kind, protoreflect.Int32Kind, nil)
+		case 69:
+			w.Map("map_string_string", protoreflect.StringKind, protoreflect.StringKind, nil)
+		case 71:
+			w.Map("map_string_nested_message", protoreflect.StringKind, protoreflect.MessageKind, (*TestAllTypes_NestedMessage)(nil))
+		case 73:
+			w.Map("map_string_nested_enum", protoreflect.StringKind, protoreflect.EnumKind, nil)
+		case 74:
+			w.Map("map_string_closed_enum", protoreflect.StringKind, protoreflect.EnumKind, nil)
+		case 75:
+			w.Scalar("unverified_string", protoreflect.StringKind, false)
+		case 76:
+			w.List("unverified_repeated_string", protoreflect.StringKind, false)
+		case 77:
+			w.Map("unverified_map", protoreflect.StringKind, protoreflect.StringKind, nil)
+		case 78:
+			w.Group("delimited_message", false, (*TestAllTypes_NestedMessage)(nil))
+		case 79:
+			w.Group("repeated_delimited_message", true, (*TestAllTypes_NestedMessage)(nil))
+		case 81:
+			w.Scalar("default_int32", protoreflect.Int32Kind, false)
+		case 94:
+			w.Scalar("default_string", protoreflect.StringKind, false)
+		case 97:
+			w.Scalar("default_closed_enum", protoreflect.EnumKind, false)
+		case 111:
+			w.Oneof(0)
+			w.Scalar("oneof_uint32", protoreflect.Uint32Kind, false)
+		case 112:
+			w.Oneof(0)
+			w.Message("oneof_nested_message", false, (*TestAllTypes_NestedMessage)(nil))
+		case 113:
+			w.Oneof(0)
+			w.Scalar("oneof_string", protoreflect.StringKind, false)
+		case 114:
+			w.Oneof(0)
+			w.Scalar("oneof_bytes", protoreflect.BytesKind, false)
+		case 115:
+			w.Oneof(0)
+			w.Scalar("oneof_closed_enum", protoreflect.EnumKind, false)
+		case 116:
+			w.Oneof(0)
+			w.Group("oneof_delimited", false, (*TestAllTypes_NestedMessage)(nil))
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_TestAllTypes_NestedMessage             protoreflect.MessageDescriptor
+	fd_TestAllTypes_NestedMessage_a           protoreflect.FieldDescriptor
+	fd_TestAllTypes_NestedMessage_corecursive protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test2023_test_proto_init()
+	md_TestAllTypes_NestedMessage = File_internal_testprotos_test2023_test_proto.Messages().ByName("TestAllTypes").Messages().ByName("NestedMessage")
+	fd_TestAllTypes_NestedMessage_a = md_TestAllTypes_NestedMessage.Fields().ByName("a")
+	fd_TestAllTypes_NestedMessage_corecursive = md_TestAllTypes_NestedMessage.Fields().ByName("corecursive")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestAllTypes_NestedMessage)(nil)
+
+type fastReflection_TestAllTypes_NestedMessage TestAllTypes_NestedMessage
+
+func (x *TestAllTypes_NestedMessage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestAllTypes_NestedMessage)(x)
+}
+
+func (x *TestAllTypes_NestedMessage) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test2023_test_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestAllTypes_NestedMessage_messageType fastReflection_TestAllTypes_NestedMessage_messageType
+var _ protoreflect.MessageType = fastReflection_TestAllTypes_NestedMessage_messageType{}
+
+type fastReflection_TestAllTypes_NestedMessage_messageType struct{}
+
+func (x fastReflection_TestAllTypes_NestedMessage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestAllTypes_NestedMessage)(nil)
+}
+func (x fastReflection_TestAllTypes_NestedMessage_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestAllTypes_NestedMessage)
+}
+func (x fastReflection_TestAllTypes_NestedMessage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestAllTypes_NestedMessage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestAllTypes_NestedMessage) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestAllTypes_NestedMessage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestAllTypes_NestedMessage) Type() protoreflect.MessageType {
+	return _fastReflection_TestAllTypes_NestedMessage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestAllTypes_NestedMessage) New() protoreflect.Message {
+	return new(fastReflection_TestAllTypes_NestedMessage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestAllTypes_NestedMessage) Interface() protoreflect.ProtoMessage {
+	return (*TestAllTypes_NestedMessage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestAllTypes_NestedMessage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.A != nil {
+		value := protoreflect.ValueOfInt32(*x.A)
+		if !f(fd_TestAllTypes_NestedMessage_a, value) {
+			return
+		}
+	}
+	if x.Corecursive != nil {
+		value := protoreflect.ValueOfMessage(x.Corecursive.ProtoReflect())
+		if !f(fd_TestAllTypes_NestedMessage_corecursive, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestAllTypes_NestedMessage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2023.TestAllTypes.NestedMessage.a
+		if fd != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		return x.A != nil
+	case 2: // goproto.proto.test2023.TestAllTypes.NestedMessage.corecursive
+		if fd != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		return x.Corecursive != nil
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_NestedMessage); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes.NestedMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_NestedMessage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2023.TestAllTypes.NestedMessage.a
+		if fd != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		x.A = nil
+		return
+	case 2: // goproto.proto.test2023.TestAllTypes.NestedMessage.corecursive
+		if fd != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		x.Corecursive = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_NestedMessage); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes.NestedMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestAllTypes_NestedMessage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test2023.TestAllTypes.NestedMessage.a
+		if descriptor != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		if x.A == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.A
+		return protoreflect.ValueOfInt32(value)
+	case 2: // goproto.proto.test2023.TestAllTypes.NestedMessage.corecursive
+		if descriptor != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		value := x.Corecursive
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestAllTypes_NestedMessage); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes.NestedMessage does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes.NestedMessage does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_NestedMessage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2023.TestAllTypes.NestedMessage.a
+		if fd != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		cv := int32(value.Int())
+		x.A = &cv
+		return
+	case 2: // goproto.proto.test2023.TestAllTypes.NestedMessage.corecursive
+		if fd != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		x.Corecursive = value.Message().Interface().(*TestAllTypes)
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_NestedMessage); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes.NestedMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_NestedMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 2: // goproto.proto.test2023.TestAllTypes.NestedMessage.corecursive
+		if fd != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		if x.Corecursive == nil {
+			x.Corecursive = new(TestAllTypes)
+		}
+		return protoreflect.ValueOfMessage(x.Corecursive.ProtoReflect())
+	case 1: // goproto.proto.test2023.TestAllTypes.NestedMessage.a
+		if fd != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		panic(fmt.Errorf("field a of message goproto.proto.test2023.TestAllTypes.NestedMessage is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_NestedMessage); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes.NestedMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestAllTypes_NestedMessage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2023.TestAllTypes.NestedMessage.a
+		if fd != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 2: // goproto.proto.test2023.TestAllTypes.NestedMessage.corecursive
+		if fd != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		m := new(TestAllTypes)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_NestedMessage); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes.NestedMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestAllTypes_NestedMessage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test2023.TestAllTypes.NestedMessage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestAllTypes_NestedMessage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_NestedMessage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestAllTypes_NestedMessage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestAllTypes_NestedMessage) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestAllTypes_NestedMessage_methods
+}
+
+var _fastReflection_TestAllTypes_NestedMessage_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestAllTypes_NestedMessage_size,
+	Marshal:           _fastReflection_TestAllTypes_NestedMessage_marshal,
+	Unmarshal:         _fastReflection_TestAllTypes_NestedMessage_unmarshal,
+	Merge:             _fastReflection_TestAllTypes_NestedMessage_merge,
+	CheckInitialized:  _fastReflection_TestAllTypes_NestedMessage_checkInitialized,
+	Equal:             _fastReflection_TestAllTypes_NestedMessage_equal,
+}
+
+func _fastReflection_TestAllTypes_NestedMessage_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestAllTypes_NestedMessage)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.A != nil {
+		n += 1 + runtime.Sov(uint64(*x.A))
+	}
+	if x.Corecursive != nil {
+		l = options.Size(x.Corecursive)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestAllTypes_NestedMessage_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes_NestedMessage)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.Corecursive != nil {
+		l = options.Size(x.Corecursive)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Corecursive); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x12
+	}
+	if x.A != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.A))
+		i--
+		dAtA[i] = 0x8
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestAllTypes_NestedMessage_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes_NestedMessage)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestAllTypes_NestedMessage.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes_NestedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes_NestedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.A = &v
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corecursive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Corecursive == nil {
+				if err := budget.Message(fd_TestAllTypes_NestedMessage_corecursive, int(unsafe.Sizeof(TestAllTypes{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Corecursive = &TestAllTypes{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Corecursive); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_NestedMessage_corecursive, -1)
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestAllTypes_NestedMessage, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_TestAllTypes_NestedMessage_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestAllTypes_NestedMessage)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestAllTypes_NestedMessage)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.A != nil {
+		v := *src.A
+		dst.A = &v
+	}
+	if src.Corecursive != nil {
+		if dst.Corecursive == nil {
+			dst.Corecursive = &TestAllTypes{}
+		}
+		proto.Merge(dst.Corecursive, src.Corecursive)
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestAllTypes_NestedMessage_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestAllTypes_NestedMessage_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestAllTypes_NestedMessage)
+	y, yok := input.MessageB.Interface().(*TestAllTypes_NestedMessage)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.A == nil) != (y.A == nil) || x.A != nil && *x.A != *y.A {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.Corecursive.Equal(y.Corecursive) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestAllTypes_NestedMessage) Clone() *TestAllTypes_NestedMessage {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestAllTypes_NestedMessage)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestAllTypes_NestedMessage) CopyInto(dst *TestAllTypes_NestedMessage) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	if x.A != nil {
+		v := *x.A
+		dst.A = &v
+	} else {
+		dst.A = nil
+	}
+	dst.Corecursive = x.Corecursive.Clone()
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestAllTypes_NestedMessage) Equal(y *TestAllTypes_NestedMessage) bool {
+	return _fastReflection_TestAllTypes_NestedMessage_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestAllTypes_NestedMessage, or nil if b is canonical.
+func (*TestAllTypes_NestedMessage) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestAllTypes_NestedMessage.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("a", protoreflect.Int32Kind, false)
+		case 2:
+			w.Message("corecursive", false, (*TestAllTypes)(nil))
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_TestRequired                protoreflect.MessageDescriptor
+	fd_TestRequired_required_field protoreflect.FieldDescriptor
+	fd_TestRequired_optional_field protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test2023_test_proto_init()
+	md_TestRequired = File_internal_testprotos_test2023_test_proto.Messages().ByName("TestRequired")
+	fd_TestRequired_required_field = md_TestRequired.Fields().ByName("required_field")
+	fd_TestRequired_optional_field = md_TestRequired.Fields().ByName("optional_field")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestRequired)(nil)
+
+type fastReflection_TestRequired TestRequired
+
+func (x *TestRequired) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestRequired)(x)
+}
+
+func (x *TestRequired) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test2023_test_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestRequired_messageType fastReflection_TestRequired_messageType
+var _ protoreflect.MessageType = fastReflection_TestRequired_messageType{}
+
+type fastReflection_TestRequired_messageType struct{}
+
+func (x fastReflection_TestRequired_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestRequired)(nil)
+}
+func (x fastReflection_TestRequired_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestRequired)
+}
+func (x fastReflection_TestRequired_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestRequired
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestRequired) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestRequired
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestRequired) Type() protoreflect.MessageType {
+	return _fastReflection_TestRequired_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestRequired) New() protoreflect.Message {
+	return new(fastReflection_TestRequired)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestRequired) Interface() protoreflect.ProtoMessage {
+	return (*TestRequired)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestRequired) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequiredField != nil {
+		value := protoreflect.ValueOfInt32(*x.RequiredField)
+		if !f(fd_TestRequired_required_field, value) {
+			return
+		}
+	}
+	if x.OptionalField != nil {
+		value := protoreflect.ValueOfString(*x.OptionalField)
+		if !f(fd_TestRequired_optional_field, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestRequired) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2023.TestRequired.required_field
+		if fd != fd_TestRequired_required_field {
+			break
+		}
+		return x.RequiredField != nil
+	case 2: // goproto.proto.test2023.TestRequired.optional_field
+		if fd != fd_TestRequired_optional_field {
+			break
+		}
+		return x.OptionalField != nil
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequired); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestRequired does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestRequired does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequired) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2023.TestRequired.required_field
+		if fd != fd_TestRequired_required_field {
+			break
+		}
+		x.RequiredField = nil
+		return
+	case 2: // goproto.proto.test2023.TestRequired.optional_field
+		if fd != fd_TestRequired_optional_field {
+			break
+		}
+		x.OptionalField = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequired); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestRequired does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestRequired does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestRequired) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test2023.TestRequired.required_field
+		if descriptor != fd_TestRequired_required_field {
+			break
+		}
+		if x.RequiredField == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.RequiredField
+		return protoreflect.ValueOfInt32(value)
+	case 2: // goproto.proto.test2023.TestRequired.optional_field
+		if descriptor != fd_TestRequired_optional_field {
+			break
+		}
+		if x.OptionalField == nil {
+			return protoreflect.ValueOfString("")
+		}
+		value := *x.OptionalField
+		return protoreflect.ValueOfString(value)
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestRequired); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestRequired does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestRequired does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequired) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2023.TestRequired.required_field
+		if fd != fd_TestRequired_required_field {
+			break
+		}
+		cv := int32(value.Int())
+		x.RequiredField = &cv
+		return
+	case 2: // goproto.proto.test2023.TestRequired.optional_field
+		if fd != fd_TestRequired_optional_field {
+			break
+		}
+		cv := value.Interface().(string)
+		x.OptionalField = &cv
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequired); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestRequired does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestRequired does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequired) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2023.TestRequired.required_field
+		if fd != fd_TestRequired_required_field {
+			break
+		}
+		panic(fmt.Errorf("field required_field of message goproto.proto.test2023.TestRequired is not mutable"))
+	case 2: // goproto.proto.test2023.TestRequired.optional_field
+		if fd != fd_TestRequired_optional_field {
+			break
+		}
+		panic(fmt.Errorf("field optional_field of message goproto.proto.test2023.TestRequired is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequired); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestRequired does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestRequired does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestRequired) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test2023.TestRequired.required_field
+		if fd != fd_TestRequired_required_field {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 2: // goproto.proto.test2023.TestRequired.optional_field
+		if fd != fd_TestRequired_optional_field {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	}
+	if fd := runtime.FieldOf(fd, md_TestRequired); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test2023.TestRequired does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test2023.TestRequired does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestRequired) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test2023.TestRequired", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestRequired) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestRequired) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestRequired) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestRequired) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestRequired_methods
+}
+
+var _fastReflection_TestRequired_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestRequired_size,
+	Marshal:           _fastReflection_TestRequired_marshal,
+	Unmarshal:         _fastReflection_TestRequired_unmarshal,
+	Merge:             _fastReflection_TestRequired_merge,
+	CheckInitialized:  _fastReflection_TestRequired_checkInitialized,
+	Equal:             _fastReflection_TestRequired_equal,
+}
+
+func _fastReflection_TestRequired_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestRequired)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.RequiredField != nil {
+		n += 1 + runtime.Sov(uint64(*x.RequiredField))
+	}
+	if x.OptionalField != nil {
+		l = len(*x.OptionalField)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestRequired_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestRequired)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.OptionalField != nil {
+		if !utf8.ValidString(*x.OptionalField) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(*x.OptionalField)
+		copy(dAtA[i:], *x.OptionalField)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(*x.OptionalField)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if x.RequiredField != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.RequiredField))
+		i--
+		dAtA[i] = 0x8
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestRequired_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestRequired)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestRequired.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	options, budget := runtime.StartDecodeBudget(options)
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestRequired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestRequired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestRequired_optional_field, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			s := runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			x.OptionalField = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestRequired, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	var flags protoiface.UnmarshalOutputFlags
+	if hasFields[0]&0x1 == 0x1 {
+		flags |= protoiface.UnmarshalInitialized
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: flags}, nil
+}
+
+func _fastReflection_TestRequired_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestRequired)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestRequired)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		dst.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		dst.OptionalField = &v
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestRequired_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	x := input.Message.Interface().(*TestRequired)
+	if x == nil {
+		return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+	}
+	if x.RequiredField == nil {
+		return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, runtime.ErrRequiredNotSet("goproto.proto.test2023.TestRequired", "required_field")
+	}
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestRequired_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestRequired)
+	y, yok := input.MessageB.Interface().(*TestRequired)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.RequiredField == nil) != (y.RequiredField == nil) || x.RequiredField != nil && *x.RequiredField != *y.RequiredField {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalField == nil) != (y.OptionalField == nil) || x.OptionalField != nil && *x.OptionalField != *y.OptionalField {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestRequired) Clone() *TestRequired {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestRequired)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestRequired) CopyInto(dst *TestRequired) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	if x.RequiredField != nil {
+		v := *x.RequiredField
+		dst.RequiredField = &v
+	} else {
+		dst.RequiredField = nil
+	}
+	if x.OptionalField != nil {
+		v := *x.OptionalField
+		dst.OptionalField = &v
+	} else {
+		dst.OptionalField = nil
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestRequired) Equal(y *TestRequired) bool {
+	return _fastReflection_TestRequired_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestRequired, or nil if b is canonical.
+func (*TestRequired) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestRequired.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("required_field", protoreflect.Int32Kind, false)
+		case 2:
+			w.Scalar("optional_field", protoreflect.StringKind, false)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestAllTypes) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestAllTypes) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.OptionalInt32 != nil {
+		v := *x.OptionalInt32
+		b = append(b, "\"optionalInt32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalInt64 != nil {
+		v := *x.OptionalInt64
+		b = append(b, "\"optionalInt64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalUint32 != nil {
+		v := *x.OptionalUint32
+		b = append(b, "\"optionalUint32\":"...)
+		b = strconv.AppendUint(b, uint64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalUint64 != nil {
+		v := *x.OptionalUint64
+		b = append(b, "\"optionalUint64\":"...)
+		b = runtime.AppendJSONUint64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalSint32 != nil {
+		v := *x.OptionalSint32
+		b = append(b, "\"optionalSint32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalSint64 != nil {
+		v := *x.OptionalSint64
+		b = append(b, "\"optionalSint64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalFixed32 != nil {
+		v := *x.OptionalFixed32
+		b = append(b, "\"optionalFixed32\":"...)
+		b = strconv.AppendUint(b, uint64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalFixed64 != nil {
+		v := *x.OptionalFixed64
+		b = append(b, "\"optionalFixed64\":"...)
+		b = runtime.AppendJSONUint64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalSfixed32 != nil {
+		v := *x.OptionalSfixed32
+		b = append(b, "\"optionalSfixed32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalSfixed64 != nil {
+		v := *x.OptionalSfixed64
+		b = append(b, "\"optionalSfixed64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalFloat != nil {
+		v := *x.OptionalFloat
+		b = append(b, "\"optionalFloat\":"...)
+		b = runtime.AppendJSONFloat(b, float64(v), 32)
+		b = append(b, ',')
+	}
+	if x.OptionalDouble != nil {
+		v := *x.OptionalDouble
+		b = append(b, "\"optionalDouble\":"...)
+		b = runtime.AppendJSONFloat(b, v, 64)
+		b = append(b, ',')
+	}
+	if x.OptionalBool != nil {
+		v := *x.OptionalBool
+		b = append(b, "\"optionalBool\":"...)
+		b = strconv.AppendBool(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalString != nil {
+		v := *x.OptionalString
+		b = append(b, "\"optionalString\":"...)
+		if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test2023.TestAllTypes.optional_string"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalBytes != nil {
+		b = append(b, "\"optionalBytes\":"...)
+		b = runtime.AppendJSONBytes(b, x.OptionalBytes)
+		b = append(b, ',')
+	}
+	if x.OptionalNestedMessage != nil {
+		b = append(b, "\"optionalNestedMessage\":"...)
+		if b, err = x.OptionalNestedMessage.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalNestedEnum != nil {
+		v := *x.OptionalNestedEnum
+		b = append(b, "\"optionalNestedEnum\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+		b = append(b, ',')
+	}
+	if x.OptionalClosedEnum != nil {
+		v := *x.OptionalClosedEnum
+		b = append(b, "\"optionalClosedEnum\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+		b = append(b, ',')
+	}
+	if x.ImplicitInt32 != 0 {
+		b = append(b, "\"implicitInt32\":"...)
+		b = strconv.AppendInt(b, int64(x.ImplicitInt32), 10)
+		b = append(b, ',')
+	}
+	if x.ImplicitString != "" {
+		b = append(b, "\"implicitString\":"...)
+		if b, err = runtime.AppendJSONString(b, x.ImplicitString, "goproto.proto.test2023.TestAllTypes.implicit_string"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.ImplicitBytes) > 0 {
+		b = append(b, "\"implicitBytes\":"...)
+		b = runtime.AppendJSONBytes(b, x.ImplicitBytes)
+		b = append(b, ',')
+	}
+	if x.ImplicitEnum != 0 {
+		b = append(b, "\"implicitEnum\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(x.ImplicitEnum), x.ImplicitEnum.Descriptor())
+		b = append(b, ',')
+	}
+	if len(x.RepeatedInt32) > 0 {
+		b = append(b, "\"repeatedInt32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedInt32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedInt64) > 0 {
+		b = append(b, "\"repeatedInt64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedInt64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedUint32) > 0 {
+		b = append(b, "\"repeatedUint32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedUint32 {
+			b = strconv.AppendUint(b, uint64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedUint64) > 0 {
+		b = append(b, "\"repeatedUint64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedUint64 {
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSint32) > 0 {
+		b = append(b, "\"repeatedSint32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSint32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSint64) > 0 {
+		b = append(b, "\"repeatedSint64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSint64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFixed32) > 0 {
+		b = append(b, "\"repeatedFixed32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFixed32 {
+			b = strconv.AppendUint(b, uint64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFixed64) > 0 {
+		b = append(b, "\"repeatedFixed64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFixed64 {
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSfixed32) > 0 {
+		b = append(b, "\"repeatedSfixed32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSfixed32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSfixed64) > 0 {
+		b = append(b, "\"repeatedSfixed64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSfixed64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFloat) > 0 {
+		b = append(b, "\"repeatedFloat\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFloat {
+			b = runtime.AppendJSONFloat(b, float64(v), 32)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedDouble) > 0 {
+		b = append(b, "\"repeatedDouble\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedDouble {
+			b = runtime.AppendJSONFloat(b, v, 64)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedBool) > 0 {
+		b = append(b, "\"repeatedBool\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedBool {
+			b = strconv.AppendBool(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedString) > 0 {
+		b = append(b, "\"repeatedString\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedString {
+			if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test2023.TestAllTypes.repeated_string"); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedBytes) > 0 {
+		b = append(b, "\"repeatedBytes\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedBytes {
+			b = runtime.AppendJSONBytes(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedNestedMessage) > 0 {
+		b = append(b, "\"repeatedNestedMessage\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedNestedMessage {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedNestedEnum) > 0 {
+		b = append(b, "\"repeatedNestedEnum\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedNestedEnum {
+			b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedClosedEnum) > 0 {
+		b = append(b, "\"repeatedClosedEnum\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedClosedEnum {
+			b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.ExpandedInt32) > 0 {
+		b = append(b, "\"expandedInt32\":"...)
+		b = append(b, '[')
+		for _, v := range x.ExpandedInt32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.ExpandedDouble) > 0 {
+		b = append(b, "\"expandedDouble\":"...)
+		b = append(b, '[')
+		for _, v := range x.ExpandedDouble {
+			b = runtime.AppendJSONFloat(b, v, 64)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.ExpandedClosedEnum) > 0 {
+		b = append(b, "\"expandedClosedEnum\":"...)
+		b = append(b, '[')
+		for _, v := range x.ExpandedClosedEnum {
+			b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.MapInt32Int32) > 0 {
+		b = append(b, "\"mapInt32Int32\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapInt32Int32)) {
+			v := x.MapInt32Int32[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringString) > 0 {
+		b = append(b, "\"mapStringString\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringString)) {
+			v := x.MapStringString[k]
+			if b, err = runtime.AppendJSONString(b, k, "goproto.proto.test2023.TestAllTypes.MapStringStringEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test2023.TestAllTypes.MapStringStringEntry.value"); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringNestedMessage) > 0 {
+		b = append(b, "\"mapStringNestedMessage\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringNestedMessage)) {
+			v := x.MapStringNestedMessage[k]
+			if b, err = runtime.AppendJSONString(b, k, "goproto.proto.test2023.TestAllTypes.MapStringNestedMessageEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringNestedEnum) > 0 {
+		b = append(b, "\"mapStringNestedEnum\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringNestedEnum)) {
+			v := x.MapStringNestedEnum[k]
+			if b, err = runtime.AppendJSONString(b, k, "goproto.proto.test2023.TestAllTypes.MapStringNestedEnumEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringClosedEnum) > 0 {
+		b = append(b, "\"mapStringClosedEnum\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringClosedEnum)) {
+			v := x.MapStringClosedEnum[k]
+			if b, err = runtime.AppendJSONString(b, k, "goproto.proto.test2023.TestAllTypes.MapStringClosedEnumEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if x.UnverifiedString != nil {
+		v := *x.UnverifiedString
+		b = append(b, "\"unverifiedString\":"...)
+		if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test2023.TestAllTypes.unverified_string"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.UnverifiedRepeatedString) > 0 {
+		b = append(b, "\"unverifiedRepeatedString\":"...)
+		b = append(b, '[')
+		for _, v := range x.UnverifiedRepeatedString {
+			if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test2023.TestAllTypes.unverified_repeated_string"); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.UnverifiedMap) > 0 {
+		b = append(b, "\"unverifiedMap\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.UnverifiedMap)) {
+			v := x.UnverifiedMap[k]
+			if b, err = runtime.AppendJSONString(b, k, "goproto.proto.test2023.TestAllTypes.UnverifiedMapEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test2023.TestAllTypes.UnverifiedMapEntry.value"); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if x.DelimitedMessage != nil {
+		b = append(b, "\"delimitedMessage\":"...)
+		if b, err = x.DelimitedMessage.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.RepeatedDelimitedMessage) > 0 {
+		b = append(b, "\"repeatedDelimitedMessage\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedDelimitedMessage {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if x.DefaultInt32 != nil {
+		v := *x.DefaultInt32
+		b = append(b, "\"defaultInt32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.DefaultString != nil {
+		v := *x.DefaultString
+		b = append(b, "\"defaultString\":"...)
+		if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test2023.TestAllTypes.default_string"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.DefaultClosedEnum != nil {
+		v := *x.DefaultClosedEnum
+		b = append(b, "\"defaultClosedEnum\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofUint32); ok {
+		b = append(b, "\"oneofUint32\":"...)
+		b = strconv.AppendUint(b, uint64(v.OneofUint32), 10)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); ok {
+		b = append(b, "\"oneofNestedMessage\":"...)
+		if b, err = v.OneofNestedMessage.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofString); ok {
+		b = append(b, "\"oneofString\":"...)
+		if b, err = runtime.AppendJSONString(b, v.OneofString, "goproto.proto.test2023.TestAllTypes.oneof_string"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofBytes); ok {
+		b = append(b, "\"oneofBytes\":"...)
+		b = runtime.AppendJSONBytes(b, v.OneofBytes)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofClosedEnum); ok {
+		b = append(b, "\"oneofClosedEnum\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v.OneofClosedEnum), v.OneofClosedEnum.Descriptor())
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofDelimited); ok {
+		b = append(b, "\"oneofDelimited\":"...)
+		if b, err = v.OneofDelimited.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestAllTypes) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestAllTypes) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalInt32 = &v
+		case 2:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalInt64 = &v
+		case 3:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalUint32 = &v
+		case 4:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalUint64 = &v
+		case 5:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalSint32 = &v
+		case 6:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalSint64 = &v
+		case 7:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalFixed32 = &v
+		case 8:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalFixed64 = &v
+		case 9:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalSfixed32 = &v
+		case 10:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalSfixed64 = &v
+		case 11:
+			v, err := d.Float32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalFloat = &v
+		case 12:
+			v, err := d.Float64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalDouble = &v
+		case 13:
+			v, err := d.Bool(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalBool = &v
+		case 14:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalString = &v
+		case 15:
+			v, err := d.Bytes(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalBytes = v
+		case 18:
+			v := new(TestAllTypes_NestedMessage)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.OptionalNestedMessage = v
+		case 21:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := TestAllTypes_NestedEnum(v)
+				x.OptionalNestedEnum = &e
+			}
+		case 22:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := ClosedEnum(v)
+				x.OptionalClosedEnum = &e
+			}
+		case 23:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.ImplicitInt32 = v
+		case 24:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.ImplicitString = v
+		case 25:
+			v, err := d.Bytes(fd)
+			if err != nil {
+				return err
+			}
+			x.ImplicitBytes = v
+		case 26:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := TestAllTypes_NestedEnum(v)
+				x.ImplicitEnum = e
+			}
+		case 31:
+			return d.List(func() error {
+				v, err := d.Int32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedInt32 = append(x.RepeatedInt32, v)
+				return nil
+			})
+		case 32:
+			return d.List(func() error {
+				v, err := d.Int64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedInt64 = append(x.RepeatedInt64, v)
+				return nil
+			})
+		case 33:
+			return d.List(func() error {
+				v, err := d.Uint32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedUint32 = append(x.RepeatedUint32, v)
+				return nil
+			})
+		case 34:
+			return d.List(func() error {
+				v, err := d.Uint64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedUint64 = append(x.RepeatedUint64, v)
+				return nil
+			})
+		case 35:
+			return d.List(func() error {
+				v, err := d.Int32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedSint32 = append(x.RepeatedSint32, v)
+				return nil
+			})
+		case 36:
+			return d.List(func() error {
+				v, err := d.Int64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedSint64 = append(x.RepeatedSint64, v)
+				return nil
+			})
+		case 37:
+			return d.List(func() error {
+				v, err := d.Uint32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedFixed32 = append(x.RepeatedFixed32, v)
+				return nil
+			})
+		case 38:
+			return d.List(func() error {
+				v, err := d.Uint64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedFixed64 = append(x.RepeatedFixed64, v)
+				return nil
+			})
+		case 39:
+			return d.List(func() error {
+				v, err := d.Int32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedSfixed32 = append(x.RepeatedSfixed32, v)
+				return nil
+			})
+		case 40:
+			return d.List(func() error {
+				v, err := d.Int64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedSfixed64 = append(x.RepeatedSfixed64, v)
+				return nil
+			})
+		case 41:
+			return d.List(func() error {
+				v, err := d.Float32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedFloat = append(x.RepeatedFloat, v)
+				return nil
+			})
+		case 42:
+			return d.List(func() error {
+				v, err := d.Float64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedDouble = append(x.RepeatedDouble, v)
+				return nil
+			})
+		case 43:
+			return d.List(func() error {
+				v, err := d.Bool(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedBool = append(x.RepeatedBool, v)
+				return nil
+			})
+		case 44:
+			return d.List(func() error {
+				v, err := d.String(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedString = append(x.RepeatedString, v)
+				return nil
+			})
+		case 45:
+			return d.List(func() error {
+				v, err := d.Bytes(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedBytes = append(x.RepeatedBytes, v)
+				return nil
+			})
+		case 48:
+			return d.List(func() error {
+				v := new(TestAllTypes_NestedMessage)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.RepeatedNestedMessage = append(x.RepeatedNestedMessage, v)
+				return nil
+			})
+		case 51:
+			return d.List(func() error {
+				v, ok, err := d.Enum(fd)
+				if err != nil {
+					return err
+				}
+				if ok {
+					e := TestAllTypes_NestedEnum(v)
+					x.RepeatedNestedEnum = append(x.RepeatedNestedEnum, e)
+				}
+				return nil
+			})
+		case 52:
+			return d.List(func() error {
+				v, ok, err := d.Enum(fd)
+				if err != nil {
+					return err
+				}
+				if ok {
+					e := ClosedEnum(v)
+					x.RepeatedClosedEnum = append(x.RepeatedClosedEnum, e)
+				}
+				return nil
+			})
+		case 53:
+			return d.List(func() error {
+				v, err := d.Int32(fd)
+				if err != nil {
+					return err
+				}
+				x.ExpandedInt32 = append(x.ExpandedInt32, v)
+				return nil
+			})
+		case 54:
+			return d.List(func() error {
+				v, err := d.Float64(fd)
+				if err != nil {
+					return err
+				}
+				x.ExpandedDouble = append(x.ExpandedDouble, v)
+				return nil
+			})
+		case 55:
+			return d.List(func() error {
+				v, ok, err := d.Enum(fd)
+				if err != nil {
+					return err
+				}
+				if ok {
+					e := ClosedEnum(v)
+					x.ExpandedClosedEnum = append(x.ExpandedClosedEnum, e)
+				}
+				return nil
+			})
+		case 56:
+			if x.MapInt32Int32 == nil {
+				x.MapInt32Int32 = make(map[int32]int32)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := int32(k.Int())
+				if _, ok := x.MapInt32Int32[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.Int32(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.MapInt32Int32[key] = v
+				return nil
+			})
+		case 69:
+			if x.MapStringString == nil {
+				x.MapStringString = make(map[string]string)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.MapStringString[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.String(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.MapStringString[key] = v
+				return nil
+			})
+		case 71:
+			if x.MapStringNestedMessage == nil {
+				x.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.MapStringNestedMessage[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v := new(TestAllTypes_NestedMessage)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.MapStringNestedMessage[key] = v
+				return nil
+			})
+		case 73:
+			if x.MapStringNestedEnum == nil {
+				x.MapStringNestedEnum = make(map[string]TestAllTypes_NestedEnum)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.MapStringNestedEnum[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, ok, err := d.Enum(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				if ok {
+					e := TestAllTypes_NestedEnum(v)
+					x.MapStringNestedEnum[key] = e
+				}
+				return nil
+			})
+		case 74:
+			if x.MapStringClosedEnum == nil {
+				x.MapStringClosedEnum = make(map[string]ClosedEnum)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.MapStringClosedEnum[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, ok, err := d.Enum(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				if ok {
+					e := ClosedEnum(v)
+					x.MapStringClosedEnum[key] = e
+				}
+				return nil
+			})
+		case 75:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.UnverifiedString = &v
+		case 76:
+			return d.List(func() error {
+				v, err := d.String(fd)
+				if err != nil {
+					return err
+				}
+				x.UnverifiedRepeatedString = append(x.UnverifiedRepeatedString, v)
+				return nil
+			})
+		case 77:
+			if x.UnverifiedMap == nil {
+				x.UnverifiedMap = make(map[string]string)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.UnverifiedMap[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.String(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.UnverifiedMap[key] = v
+				return nil
+			})
+		case 78:
+			v := new(TestAllTypes_NestedMessage)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.DelimitedMessage = v
+		case 79:
+			return d.List(func() error {
+				v := new(TestAllTypes_NestedMessage)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.RepeatedDelimitedMessage = append(x.RepeatedDelimitedMessage, v)
+				return nil
+			})
+		case 81:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultInt32 = &v
+		case 94:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.DefaultString = &v
+		case 97:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := ClosedEnum(v)
+				x.DefaultClosedEnum = &e
+			}
+		case 111:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofUint32{OneofUint32: v}
+		case 112:
+			v := new(TestAllTypes_NestedMessage)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofNestedMessage{OneofNestedMessage: v}
+		case 113:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofString{OneofString: v}
+		case 114:
+			v, err := d.Bytes(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofBytes{OneofBytes: v}
+		case 115:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := ClosedEnum(v)
+				x.OneofField = &TestAllTypes_OneofClosedEnum{OneofClosedEnum: e}
+			}
+		case 116:
+			v := new(TestAllTypes_NestedMessage)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofDelimited{OneofDelimited: v}
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestAllTypes_NestedMessage) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestAllTypes_NestedMessage) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.A != nil {
+		v := *x.A
+		b = append(b, "\"a\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.Corecursive != nil {
+		b = append(b, "\"corecursive\":"...)
+		if b, err = x.Corecursive.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestAllTypes_NestedMessage) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestAllTypes_NestedMessage) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.A = &v
+		case 2:
+			v := new(TestAllTypes)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Corecursive = v
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestRequired) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestRequired) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.RequiredField != nil {
+		v := *x.RequiredField
+		b = append(b, "\"requiredField\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalField != nil {
+		v := *x.OptionalField
+		b = append(b, "\"optionalField\":"...)
+		if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test2023.TestRequired.optional_field"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestRequired) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestRequired) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.RequiredField = &v
+		case 2:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalField = &v
+		}
+		return nil
+	})
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.18.1
+// source: internal/testprotos/test2023/test.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ClosedEnum int32
+
+const (
+	ClosedEnum_CLOSED_ZERO ClosedEnum = 0
+	ClosedEnum_CLOSED_FOO  ClosedEnum = 4
+	ClosedEnum_CLOSED_BAR  ClosedEnum = 5
+	ClosedEnum_CLOSED_BAZ  ClosedEnum = 6
+	ClosedEnum_CLOSED_MAX  ClosedEnum = 10
+)
+
+// Enum value maps for ClosedEnum.
+var (
+	ClosedEnum_name = map[int32]string{
+		0:  "CLOSED_ZERO",
+		4:  "CLOSED_FOO",
+		5:  "CLOSED_BAR",
+		6:  "CLOSED_BAZ",
+		10: "CLOSED_MAX",
+	}
+	ClosedEnum_value = map[string]int32{
+		"CLOSED_ZERO": 0,
+		"CLOSED_FOO":  4,
+		"CLOSED_BAR":  5,
+		"CLOSED_BAZ":  6,
+		"CLOSED_MAX":  10,
+	}
+)
+
+func (x ClosedEnum) Enum() *ClosedEnum {
+	p := new(ClosedEnum)
+	*p = x
+	return p
+}
+
+func (x ClosedEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClosedEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_testprotos_test2023_test_proto_enumTypes[0].Descriptor()
+}
+
+func (ClosedEnum) Type() protoreflect.EnumType {
+	return &file_internal_testprotos_test2023_test_proto_enumTypes[0]
+}
+
+func (x ClosedEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClosedEnum.Descriptor instead.
+func (ClosedEnum) EnumDescriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2023_test_proto_rawDescGZIP(), []int{0}
+}
+
+type TestAllTypes_NestedEnum int32
+
+const (
+	TestAllTypes_FOO TestAllTypes_NestedEnum = 0
+	TestAllTypes_BAR TestAllTypes_NestedEnum = 1
+	TestAllTypes_BAZ TestAllTypes_NestedEnum = 2
+	TestAllTypes_NEG TestAllTypes_NestedEnum = -1 // Intentionally negative.
+)
+
+// Enum value maps for TestAllTypes_NestedEnum.
+var (
+	TestAllTypes_NestedEnum_name = map[int32]string{
+		0:  "FOO",
+		1:  "BAR",
+		2:  "BAZ",
+		-1: "NEG",
+	}
+	TestAllTypes_NestedEnum_value = map[string]int32{
+		"FOO": 0,
+		"BAR": 1,
+		"BAZ": 2,
+		"NEG": -1,
+	}
+)
+
+func (x TestAllTypes_NestedEnum) Enum() *TestAllTypes_NestedEnum {
+	p := new(TestAllTypes_NestedEnum)
+	*p = x
+	return p
+}
+
+func (x TestAllTypes_NestedEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TestAllTypes_NestedEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_testprotos_test2023_test_proto_enumTypes[1].Descriptor()
+}
+
+func (TestAllTypes_NestedEnum) Type() protoreflect.EnumType {
+	return &file_internal_testprotos_test2023_test_proto_enumTypes[1]
+}
+
+func (x TestAllTypes_NestedEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TestAllTypes_NestedEnum.Descriptor instead.
+func (TestAllTypes_NestedEnum) EnumDescriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2023_test_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type TestAllTypes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+	zeroCopied    bool
+
+	// Explicit presence is the default in editions.
+	OptionalInt32         *int32                      `protobuf:"varint,1,opt,name=optional_int32,json=optionalInt32" json:"optional_int32,omitempty"`
+	OptionalInt64         *int64                      `protobuf:"varint,2,opt,name=optional_int64,json=optionalInt64" json:"optional_int64,omitempty"`
+	OptionalUint32        *uint32                     `protobuf:"varint,3,opt,name=optional_uint32,json=optionalUint32" json:"optional_uint32,omitempty"`
+	OptionalUint64        *uint64                     `protobuf:"varint,4,opt,name=optional_uint64,json=optionalUint64" json:"optional_uint64,omitempty"`
+	OptionalSint32        *int32                      `protobuf:"zigzag32,5,opt,name=optional_sint32,json=optionalSint32" json:"optional_sint32,omitempty"`
+	OptionalSint64        *int64                      `protobuf:"zigzag64,6,opt,name=optional_sint64,json=optionalSint64" json:"optional_sint64,omitempty"`
+	OptionalFixed32       *uint32                     `protobuf:"fixed32,7,opt,name=optional_fixed32,json=optionalFixed32" json:"optional_fixed32,omitempty"`
+	OptionalFixed64       *uint64                     `protobuf:"fixed64,8,opt,name=optional_fixed64,json=optionalFixed64" json:"optional_fixed64,omitempty"`
+	OptionalSfixed32      *int32                      `protobuf:"fixed32,9,opt,name=optional_sfixed32,json=optionalSfixed32" json:"optional_sfixed32,omitempty"`
+	OptionalSfixed64      *int64                      `protobuf:"fixed64,10,opt,name=optional_sfixed64,json=optionalSfixed64" json:"optional_sfixed64,omitempty"`
+	OptionalFloat         *float32                    `protobuf:"fixed32,11,opt,name=optional_float,json=optionalFloat" json:"optional_float,omitempty"`
+	OptionalDouble        *float64                    `protobuf:"fixed64,12,opt,name=optional_double,json=optionalDouble" json:"optional_double,omitempty"`
+	OptionalBool          *bool                       `protobuf:"varint,13,opt,name=optional_bool,json=optionalBool" json:"optional_bool,omitempty"`
+	OptionalString        *string                     `protobuf:"bytes,14,opt,name=optional_string,json=optionalString" json:"optional_string,omitempty"`
+	OptionalBytes         []byte                      `protobuf:"bytes,15,opt,name=optional_bytes,json=optionalBytes" json:"optional_bytes,omitempty"`
+	OptionalNestedMessage *TestAllTypes_NestedMessage `protobuf:"bytes,18,opt,name=optional_nested_message,json=optionalNestedMessage" json:"optional_nested_message,omitempty"`
+	OptionalNestedEnum    *TestAllTypes_NestedEnum    `protobuf:"varint,21,opt,name=optional_nested_enum,json=optionalNestedEnum,enum=goproto.proto.test2023.TestAllTypes_NestedEnum" json:"optional_nested_enum,omitempty"`
+	OptionalClosedEnum    *ClosedEnum                 `protobuf:"varint,22,opt,name=optional_closed_enum,json=optionalClosedEnum,enum=goproto.proto.test2023.ClosedEnum" json:"optional_closed_enum,omitempty"`
+	ImplicitInt32         int32                       `protobuf:"varint,23,opt,name=implicit_int32,json=implicitInt32" json:"implicit_int32,omitempty"`
+	ImplicitString        string                      `protobuf:"bytes,24,opt,name=implicit_string,json=implicitString" json:"implicit_string,omitempty"`
+	ImplicitBytes         []byte                      `protobuf:"bytes,25,opt,name=implicit_bytes,json=implicitBytes" json:"implicit_bytes,omitempty"`
+	ImplicitEnum          TestAllTypes_NestedEnum     `protobuf:"varint,26,opt,name=implicit_enum,json=implicitEnum,enum=goproto.proto.test2023.TestAllTypes_NestedEnum" json:"implicit_enum,omitempty"`
+	// Repeated scalars are packed by default in editions.
+	RepeatedInt32            []int32                                `protobuf:"varint,31,rep,packed,name=repeated_int32,json=repeatedInt32" json:"repeated_int32,omitempty"`
+	RepeatedInt64            []int64                                `protobuf:"varint,32,rep,packed,name=repeated_int64,json=repeatedInt64" json:"repeated_int64,omitempty"`
+	RepeatedUint32           []uint32                               `protobuf:"varint,33,rep,packed,name=repeated_uint32,json=repeatedUint32" json:"repeated_uint32,omitempty"`
+	RepeatedUint64           []uint64                               `protobuf:"varint,34,rep,packed,name=repeated_uint64,json=repeatedUint64" json:"repeated_uint64,omitempty"`
+	RepeatedSint32           []int32                                `protobuf:"zigzag32,35,rep,packed,name=repeated_sint32,json=repeatedSint32" json:"repeated_sint32,omitempty"`
+	RepeatedSint64           []int64                                `protobuf:"zigzag64,36,rep,packed,name=repeated_sint64,json=repeatedSint64" json:"repeated_sint64,omitempty"`
+	RepeatedFixed32          []uint32                               `protobuf:"fixed32,37,rep,packed,name=repeated_fixed32,json=repeatedFixed32" json:"repeated_fixed32,omitempty"`
+	RepeatedFixed64          []uint64                               `protobuf:"fixed64,38,rep,packed,name=repeated_fixed64,json=repeatedFixed64" json:"repeated_fixed64,omitempty"`
+	RepeatedSfixed32         []int32                                `protobuf:"fixed32,39,rep,packed,name=repeated_sfixed32,json=repeatedSfixed32" json:"repeated_sfixed32,omitempty"`
+	RepeatedSfixed64         []int64                                `protobuf:"fixed64,40,rep,packed,name=repeated_sfixed64,json=repeatedSfixed64" json:"repeated_sfixed64,omitempty"`
+	RepeatedFloat            []float32                              `protobuf:"fixed32,41,rep,packed,name=repeated_float,json=repeatedFloat" json:"repeated_float,omitempty"`
+	RepeatedDouble           []float64                              `protobuf:"fixed64,42,rep,packed,name=repeated_double,json=repeatedDouble" json:"repeated_double,omitempty"`
+	RepeatedBool             []bool                                 `protobuf:"varint,43,rep,packed,name=repeated_bool,json=repeatedBool" json:"repeated_bool,omitempty"`
+	RepeatedString           []string                               `protobuf:"bytes,44,rep,name=repeated_string,json=repeatedString" json:"repeated_string,omitempty"`
+	RepeatedBytes            [][]byte                               `protobuf:"bytes,45,rep,name=repeated_bytes,json=repeatedBytes" json:"repeated_bytes,omitempty"`
+	RepeatedNestedMessage    []*TestAllTypes_NestedMessage          `protobuf:"bytes,48,rep,name=repeated_nested_message,json=repeatedNestedMessage" json:"repeated_nested_message,omitempty"`
+	RepeatedNestedEnum       []TestAllTypes_NestedEnum              `protobuf:"varint,51,rep,packed,name=repeated_nested_enum,json=repeatedNestedEnum,enum=goproto.proto.test2023.TestAllTypes_NestedEnum" json:"repeated_nested_enum,omitempty"`
+	RepeatedClosedEnum       []ClosedEnum                           `protobuf:"varint,52,rep,packed,name=repeated_closed_enum,json=repeatedClosedEnum,enum=goproto.proto.test2023.ClosedEnum" json:"repeated_closed_enum,omitempty"`
+	ExpandedInt32            []int32                                `protobuf:"varint,53,rep,name=expanded_int32,json=expandedInt32" json:"expanded_int32,omitempty"`
+	ExpandedDouble           []float64                              `protobuf:"fixed64,54,rep,name=expanded_double,json=expandedDouble" json:"expanded_double,omitempty"`
+	ExpandedClosedEnum       []ClosedEnum                           `protobuf:"varint,55,rep,name=expanded_closed_enum,json=expandedClosedEnum,enum=goproto.proto.test2023.ClosedEnum" json:"expanded_closed_enum,omitempty"`
+	MapInt32Int32            map[int32]int32                        `protobuf:"bytes,56,rep,name=map_int32_int32,json=mapInt32Int32" json:"map_int32_int32,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	MapStringString          map[string]string                      `protobuf:"bytes,69,rep,name=map_string_string,json=mapStringString" json:"map_string_string,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MapStringNestedMessage   map[string]*TestAllTypes_NestedMessage `protobuf:"bytes,71,rep,name=map_string_nested_message,json=mapStringNestedMessage" json:"map_string_nested_message,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MapStringNestedEnum      map[string]TestAllTypes_NestedEnum     `protobuf:"bytes,73,rep,name=map_string_nested_enum,json=mapStringNestedEnum" json:"map_string_nested_enum,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=goproto.proto.test2023.TestAllTypes_NestedEnum"`
+	MapStringClosedEnum      map[string]ClosedEnum                  `protobuf:"bytes,74,rep,name=map_string_closed_enum,json=mapStringClosedEnum" json:"map_string_closed_enum,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=goproto.proto.test2023.ClosedEnum"`
+	UnverifiedString         *string                                `protobuf:"bytes,75,opt,name=unverified_string,json=unverifiedString" json:"unverified_string,omitempty"`
+	UnverifiedRepeatedString []string                               `protobuf:"bytes,76,rep,name=unverified_repeated_string,json=unverifiedRepeatedString" json:"unverified_repeated_string,omitempty"`
+	UnverifiedMap            map[string]string                      `protobuf:"bytes,77,rep,name=unverified_map,json=unverifiedMap" json:"unverified_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DelimitedMessage         *TestAllTypes_NestedMessage            `protobuf:"group,78,opt,name=NestedMessage,json=delimitedMessage" json:"delimited_message,omitempty"`
+	RepeatedDelimitedMessage []*TestAllTypes_NestedMessage          `protobuf:"group,79,rep,name=NestedMessage,json=repeatedDelimitedMessage" json:"repeated_delimited_message,omitempty"`
+	DefaultInt32             *int32                                 `protobuf:"varint,81,opt,name=default_int32,json=defaultInt32,def=81" json:"default_int32,omitempty"`
+	DefaultString            *string                                `protobuf:"bytes,94,opt,name=default_string,json=defaultString,def=hello" json:"default_string,omitempty"`
+	DefaultClosedEnum        *ClosedEnum                            `protobuf:"varint,97,opt,name=default_closed_enum,json=defaultClosedEnum,enum=goproto.proto.test2023.ClosedEnum,def=5" json:"default_closed_enum,omitempty"`
+	// Types that are assignable to OneofField:
+	//	*TestAllTypes_OneofUint32
+	//	*TestAllTypes_OneofNestedMessage
+	//	*TestAllTypes_OneofString
+	//	*TestAllTypes_OneofBytes
+	//	*TestAllTypes_OneofClosedEnum
+	//	*TestAllTypes_OneofDelimited
+	OneofField isTestAllTypes_OneofField `protobuf_oneof:"oneof_field"`
+}
+
+// Default values for TestAllTypes fields.
+const (
+	Default_TestAllTypes_DefaultInt32      = int32(81)
+	Default_TestAllTypes_DefaultString     = string("hello")
+	Default_TestAllTypes_DefaultClosedEnum = ClosedEnum_CLOSED_BAR
+)
+
+func (x *TestAllTypes) Reset() {
+	*x = TestAllTypes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test2023_test_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestAllTypes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAllTypes) ProtoMessage() {}
+
+// Deprecated: Use TestAllTypes.ProtoReflect.Descriptor instead.
+func (*TestAllTypes) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2023_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TestAllTypes) GetOptionalInt32() int32 {
+	if x != nil && x.OptionalInt32 != nil {
+		return *x.OptionalInt32
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalInt64() int64 {
+	if x != nil && x.OptionalInt64 != nil {
+		return *x.OptionalInt64
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalUint32() uint32 {
+	if x != nil && x.OptionalUint32 != nil {
+		return *x.OptionalUint32
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalUint64() uint64 {
+	if x != nil && x.OptionalUint64 != nil {
+		return *x.OptionalUint64
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalSint32() int32 {
+	if x != nil && x.OptionalSint32 != nil {
+		return *x.OptionalSint32
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalSint64() int64 {
+	if x != nil && x.OptionalSint64 != nil {
+		return *x.OptionalSint64
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalFixed32() uint32 {
+	if x != nil && x.OptionalFixed32 != nil {
+		return *x.OptionalFixed32
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalFixed64() uint64 {
+	if x != nil && x.OptionalFixed64 != nil {
+		return *x.OptionalFixed64
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalSfixed32() int32 {
+	if x != nil && x.OptionalSfixed32 != nil {
+		return *x.OptionalSfixed32
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalSfixed64() int64 {
+	if x != nil && x.OptionalSfixed64 != nil {
+		return *x.OptionalSfixed64
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalFloat() float32 {
+	if x != nil && x.OptionalFloat != nil {
+		return *x.OptionalFloat
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalDouble() float64 {
+	if x != nil && x.OptionalDouble != nil {
+		return *x.OptionalDouble
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOptionalBool() bool {
+	if x != nil && x.OptionalBool != nil {
+		return *x.OptionalBool
+	}
+	return false
+}
+
+func (x *TestAllTypes) GetOptionalString() string {
+	if x != nil && x.OptionalString != nil {
+		return *x.OptionalString
+	}
+	return ""
+}
+
+func (x *TestAllTypes) GetOptionalBytes() []byte {
+	if x != nil {
+		return x.OptionalBytes
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetOptionalNestedMessage() *TestAllTypes_NestedMessage {
+	if x != nil {
+		return x.OptionalNestedMessage
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetOptionalNestedEnum() TestAllTypes_NestedEnum {
+	if x != nil && x.OptionalNestedEnum != nil {
+		return *x.OptionalNestedEnum
+	}
+	return TestAllTypes_FOO
+}
+
+func (x *TestAllTypes) GetOptionalClosedEnum() ClosedEnum {
+	if x != nil && x.OptionalClosedEnum != nil {
+		return *x.OptionalClosedEnum
+	}
+	return ClosedEnum_CLOSED_ZERO
+}
+
+func (x *TestAllTypes) GetImplicitInt32() int32 {
+	if x != nil {
+		return x.ImplicitInt32
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetImplicitString() string {
+	if x != nil {
+		return x.ImplicitString
+	}
+	return ""
+}
+
+func (x *TestAllTypes) GetImplicitBytes() []byte {
+	if x != nil {
+		return x.ImplicitBytes
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetImplicitEnum() TestAllTypes_NestedEnum {
+	if x != nil {
+		return x.ImplicitEnum
+	}
+	return TestAllTypes_FOO
+}
+
+func (x *TestAllTypes) GetRepeatedInt32() []int32 {
+	if x != nil {
+		return x.RepeatedInt32
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedInt64() []int64 {
+	if x != nil {
+		return x.RepeatedInt64
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedUint32() []uint32 {
+	if x != nil {
+		return x.RepeatedUint32
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedUint64() []uint64 {
+	if x != nil {
+		return x.RepeatedUint64
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedSint32() []int32 {
+	if x != nil {
+		return x.RepeatedSint32
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedSint64() []int64 {
+	if x != nil {
+		return x.RepeatedSint64
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedFixed32() []uint32 {
+	if x != nil {
+		return x.RepeatedFixed32
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedFixed64() []uint64 {
+	if x != nil {
+		return x.RepeatedFixed64
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedSfixed32() []int32 {
+	if x != nil {
+		return x.RepeatedSfixed32
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedSfixed64() []int64 {
+	if x != nil {
+		return x.RepeatedSfixed64
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedFloat() []float32 {
+	if x != nil {
+		return x.RepeatedFloat
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedDouble() []float64 {
+	if x != nil {
+		return x.RepeatedDouble
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedBool() []bool {
+	if x != nil {
+		return x.RepeatedBool
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedString() []string {
+	if x != nil {
+		return x.RepeatedString
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedBytes() [][]byte {
+	if x != nil {
+		return x.RepeatedBytes
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedNestedMessage() []*TestAllTypes_NestedMessage {
+	if x != nil {
+		return x.RepeatedNestedMessage
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedNestedEnum() []TestAllTypes_NestedEnum {
+	if x != nil {
+		return x.RepeatedNestedEnum
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedClosedEnum() []ClosedEnum {
+	if x != nil {
+		return x.RepeatedClosedEnum
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetExpandedInt32() []int32 {
+	if x != nil {
+		return x.ExpandedInt32
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetExpandedDouble() []float64 {
+	if x != nil {
+		return x.ExpandedDouble
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetExpandedClosedEnum() []ClosedEnum {
+	if x != nil {
+		return x.ExpandedClosedEnum
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetMapInt32Int32() map[int32]int32 {
+	if x != nil {
+		return x.MapInt32Int32
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetMapStringString() map[string]string {
+	if x != nil {
+		return x.MapStringString
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetMapStringNestedMessage() map[string]*TestAllTypes_NestedMessage {
+	if x != nil {
+		return x.MapStringNestedMessage
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetMapStringNestedEnum() map[string]TestAllTypes_NestedEnum {
+	if x != nil {
+		return x.MapStringNestedEnum
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetMapStringClosedEnum() map[string]ClosedEnum {
+	if x != nil {
+		return x.MapStringClosedEnum
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetUnverifiedString() string {
+	if x != nil && x.UnverifiedString != nil {
+		return *x.UnverifiedString
+	}
+	return ""
+}
+
+func (x *TestAllTypes) GetUnverifiedRepeatedString() []string {
+	if x != nil {
+		return x.UnverifiedRepeatedString
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetUnverifiedMap() map[string]string {
+	if x != nil {
+		return x.UnverifiedMap
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetDelimitedMessage() *TestAllTypes_NestedMessage {
+	if x != nil {
+		return x.DelimitedMessage
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetRepeatedDelimitedMessage() []*TestAllTypes_NestedMessage {
+	if x != nil {
+		return x.RepeatedDelimitedMessage
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetDefaultInt32() int32 {
+	if x != nil && x.DefaultInt32 != nil {
+		return *x.DefaultInt32
+	}
+	return Default_TestAllTypes_DefaultInt32
+}
+
+func (x *TestAllTypes) GetDefaultString() string {
+	if x != nil && x.DefaultString != nil {
+		return *x.DefaultString
+	}
+	return Default_TestAllTypes_DefaultString
+}
+
+func (x *TestAllTypes) GetDefaultClosedEnum() ClosedEnum {
+	if x != nil && x.DefaultClosedEnum != nil {
+		return *x.DefaultClosedEnum
+	}
+	return Default_TestAllTypes_DefaultClosedEnum
+}
+
+func (x *TestAllTypes) GetOneofField() isTestAllTypes_OneofField {
+	if x != nil {
+		return x.OneofField
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetOneofUint32() uint32 {
+	if x, ok := x.GetOneofField().(*TestAllTypes_OneofUint32); ok {
+		return x.OneofUint32
+	}
+	return 0
+}
+
+func (x *TestAllTypes) GetOneofNestedMessage() *TestAllTypes_NestedMessage {
+	if x, ok := x.GetOneofField().(*TestAllTypes_OneofNestedMessage); ok {
+		return x.OneofNestedMessage
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetOneofString() string {
+	if x, ok := x.GetOneofField().(*TestAllTypes_OneofString); ok {
+		return x.OneofString
+	}
+	return ""
+}
+
+func (x *TestAllTypes) GetOneofBytes() []byte {
+	if x, ok := x.GetOneofField().(*TestAllTypes_OneofBytes); ok {
+		return x.OneofBytes
+	}
+	return nil
+}
+
+func (x *TestAllTypes) GetOneofClosedEnum() ClosedEnum {
+	if x, ok := x.GetOneofField().(*TestAllTypes_OneofClosedEnum); ok {
+		return x.OneofClosedEnum
+	}
+	return ClosedEnum_CLOSED_ZERO
+}
+
+func (x *TestAllTypes) GetOneofDelimited() *TestAllTypes_NestedMessage {
+	if x, ok := x.GetOneofField().(*TestAllTypes_OneofDelimited); ok {
+		return x.OneofDelimited
+	}
+	return nil
+}
+
+type isTestAllTypes_OneofField interface {
+	isTestAllTypes_OneofField()
+}
+
+type TestAllTypes_OneofUint32 struct {
+	OneofUint32 uint32 `protobuf:"varint,111,opt,name=oneof_uint32,json=oneofUint32,oneof"`
+}
+
+type TestAllTypes_OneofNestedMessage struct {
+	OneofNestedMessage *TestAllTypes_NestedMessage `protobuf:"bytes,112,opt,name=oneof_nested_message,json=oneofNestedMessage,oneof"`
+}
+
+type TestAllTypes_OneofString struct {
+	OneofString string `protobuf:"bytes,113,opt,name=oneof_string,json=oneofString,oneof"`
+}
+
+type TestAllTypes_OneofBytes struct {
+	OneofBytes []byte `protobuf:"bytes,114,opt,name=oneof_bytes,json=oneofBytes,oneof"`
+}
+
+type TestAllTypes_OneofClosedEnum struct {
+	OneofClosedEnum ClosedEnum `protobuf:"varint,115,opt,name=oneof_closed_enum,json=oneofClosedEnum,enum=goproto.proto.test2023.ClosedEnum,oneof"`
+}
+
+type TestAllTypes_OneofDelimited struct {
+	OneofDelimited *TestAllTypes_NestedMessage `protobuf:"group,116,opt,name=NestedMessage,json=oneofDelimited,oneof"`
+}
+
+func (*TestAllTypes_OneofUint32) isTestAllTypes_OneofField() {}
+
+func (*TestAllTypes_OneofNestedMessage) isTestAllTypes_OneofField() {}
+
+func (*TestAllTypes_OneofString) isTestAllTypes_OneofField() {}
+
+func (*TestAllTypes_OneofBytes) isTestAllTypes_OneofField() {}
+
+func (*TestAllTypes_OneofClosedEnum) isTestAllTypes_OneofField() {}
+
+func (*TestAllTypes_OneofDelimited) isTestAllTypes_OneofField() {}
+
+type TestRequired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequiredField *int32  `protobuf:"varint,1,req,name=required_field,json=requiredField" json:"required_field,omitempty"`
+	OptionalField *string `protobuf:"bytes,2,opt,name=optional_field,json=optionalField" json:"optional_field,omitempty"`
+}
+
+func (x *TestRequired) Reset() {
+	*x = TestRequired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test2023_test_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestRequired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRequired) ProtoMessage() {}
+
+// Deprecated: Use TestRequired.ProtoReflect.Descriptor instead.
+func (*TestRequired) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2023_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TestRequired) GetRequiredField() int32 {
+	if x != nil && x.RequiredField != nil {
+		return *x.RequiredField
+	}
+	return 0
+}
+
+func (x *TestRequired) GetOptionalField() string {
+	if x != nil && x.OptionalField != nil {
+		return *x.OptionalField
+	}
+	return ""
+}
+
+type TestAllTypes_NestedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A           *int32        `protobuf:"varint,1,opt,name=a" json:"a,omitempty"`
+	Corecursive *TestAllTypes `protobuf:"bytes,2,opt,name=corecursive" json:"corecursive,omitempty"`
+}
+
+func (x *TestAllTypes_NestedMessage) Reset() {
+	*x = TestAllTypes_NestedMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test2023_test_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestAllTypes_NestedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAllTypes_NestedMessage) ProtoMessage() {}
+
+// Deprecated: Use TestAllTypes_NestedMessage.ProtoReflect.Descriptor instead.
+func (*TestAllTypes_NestedMessage) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test2023_test_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *TestAllTypes_NestedMessage) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+func (x *TestAllTypes_NestedMessage) GetCorecursive() *TestAllTypes {
+	if x != nil {
+		return x.Corecursive
+	}
+	return nil
+}
+
+var File_internal_testprotos_test2023_test_proto protoreflect.FileDescriptor
+
+var file_internal_testprotos_test2023_test_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33, 0x2f, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32,
+	0x33, 0x22, 0xe7, 0x23, 0x0a, 0x0c, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x36, 0x34,
+	0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x55, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x11, 0x52, 0x0e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x27, 0x0a, 0x0f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x12, 0x52, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x07, 0x52, 0x0f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12,
+	0x29, 0x0a, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x18, 0x08, 0x20, 0x01, 0x28, 0x06, 0x52, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x10, 0x52, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x6a, 0x0a, 0x17, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32,
+	0x30, 0x32, 0x33, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x15,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x45, 0x6e, 0x75, 0x6d, 0x52, 0x12, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4e, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x54, 0x0a, 0x14, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x75, 0x6d,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33, 0x2e,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x12, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x2c,
+	0x0a, 0x0e, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x0d, 0x69,
+	0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x2e, 0x0a, 0x0f,
+	0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x0e, 0x69, 0x6d,
+	0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x0e,
+	0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x0d, 0x69, 0x6d, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x0d, 0x69, 0x6d,
+	0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2f, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41,
+	0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x6e,
+	0x75, 0x6d, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x6c, 0x69,
+	0x63, 0x69, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x0d, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x18, 0x20, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x21, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0e,
+	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x18, 0x22, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x23, 0x20, 0x03, 0x28, 0x11,
+	0x52, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x18, 0x24, 0x20, 0x03, 0x28, 0x12, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x70,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x25, 0x20,
+	0x03, 0x28, 0x07, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x26, 0x20, 0x03, 0x28, 0x06, 0x52, 0x0f,
+	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12,
+	0x2b, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x18, 0x27, 0x20, 0x03, 0x28, 0x0f, 0x52, 0x10, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x2b, 0x0a, 0x11,
+	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x18, 0x28, 0x20, 0x03, 0x28, 0x10, 0x52, 0x10, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x29, 0x20, 0x03, 0x28,
+	0x02, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x61, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x18, 0x2a, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x70,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x2b, 0x20, 0x03, 0x28, 0x08,
+	0x52, 0x0c, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x18, 0x2c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x2d, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x0d, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x6a,
+	0x0a, 0x17, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x30, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x15, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x72, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e,
+	0x75, 0x6d, 0x18, 0x33, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32,
+	0x33, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x12, 0x72, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x54, 0x0a,
+	0x14, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x34, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x67, 0x6f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x32, 0x30, 0x32, 0x33, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x52,
+	0x12, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x45,
+	0x6e, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x5f,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x35, 0x20, 0x03, 0x28, 0x05, 0x42, 0x05, 0xaa, 0x01, 0x02,
+	0x18, 0x02, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x12, 0x2e, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x18, 0x36, 0x20, 0x03, 0x28, 0x01, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x18,
+	0x02, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x12, 0x5b, 0x0a, 0x14, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x37, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x22, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x45,
+	0x6e, 0x75, 0x6d, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x18, 0x02, 0x52, 0x12, 0x65, 0x78, 0x70, 0x61,
+	0x6e, 0x64, 0x65, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x5f,
+	0x0a, 0x0f, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x18, 0x38, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61,
+	0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0d, 0x6d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12,
+	0x65, 0x0a, 0x11, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x18, 0x45, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32,
+	0x30, 0x32, 0x33, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x6d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x7b, 0x0a, 0x19, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x47, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x67, 0x6f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30,
+	0x32, 0x33, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x6d, 0x61, 0x70,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x72, 0x0a, 0x16, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x49, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x13, 0x6d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x72, 0x0a, 0x16, 0x6d, 0x61, 0x70, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x75,
+	0x6d, 0x18, 0x4a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61,
+	0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x75,
+	0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x6d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x32, 0x0a, 0x11, 0x75,
+	0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x4b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x20, 0x03, 0x52, 0x10, 0x75,
+	0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x43, 0x0a, 0x1a, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x4c, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x20, 0x03, 0x52, 0x18, 0x75, 0x6e, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x12, 0x65, 0x0a, 0x0e, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x4d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x32, 0x30, 0x32, 0x33, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x55, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4d, 0x61, 0x70,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x20, 0x03, 0x52, 0x0d, 0x75, 0x6e,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x12, 0x66, 0x0a, 0x11, 0x64,
+	0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x4e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x28,
+	0x02, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x77, 0x0a, 0x1a, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x4f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x05, 0xaa, 0x01, 0x02,
+	0x28, 0x02, 0x52, 0x18, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0d,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x51, 0x20,
+	0x01, 0x28, 0x05, 0x3a, 0x02, 0x38, 0x31, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x2c, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x5e, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x05, 0x68,
+	0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x12, 0x5e, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x61, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x22, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x45, 0x6e, 0x75, 0x6d, 0x3a, 0x0a, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x42, 0x41, 0x52,
+	0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x45,
+	0x6e, 0x75, 0x6d, 0x12, 0x23, 0x0a, 0x0c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x75, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x6e, 0x65,
+	0x6f, 0x66, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x66, 0x0a, 0x14, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x70, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x12, 0x6f, 0x6e,
+	0x65, 0x6f, 0x66, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x23, 0x0a, 0x0c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x71, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x72, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x6f, 0x6e,
+	0x65, 0x6f, 0x66, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x73, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x48, 0x00, 0x52, 0x0f, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x64, 0x0a, 0x0f, 0x6f, 0x6e,
+	0x65, 0x6f, 0x66, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18, 0x74, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x28, 0x02, 0x48, 0x00,
+	0x52, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x44, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64,
+	0x1a, 0x65, 0x0a, 0x0d, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x61, 0x12,
+	0x46, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x65,
+	0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x1a, 0x40, 0x0a, 0x12, 0x4d, 0x61, 0x70, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x4d, 0x61, 0x70,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x7d, 0x0a,
+	0x1b, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x48,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x32, 0x30, 0x32, 0x33, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x77, 0x0a, 0x18,
+	0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45,
+	0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x67, 0x6f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30,
+	0x32, 0x33, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6a, 0x0a, 0x18, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x32, 0x30, 0x32, 0x33, 0x2e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x4e, 0x0a, 0x12, 0x55, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x20, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x1b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x05, 0xaa, 0x01, 0x02, 0x20, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x39, 0x0a, 0x0a, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12,
+	0x07, 0x0a, 0x03, 0x46, 0x4f, 0x4f, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x41, 0x52, 0x10,
+	0x01, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x41, 0x5a, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x03, 0x4e, 0x45,
+	0x47, 0x10, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x42, 0x0d, 0x0a, 0x0b,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x63, 0x0a, 0x0c, 0x54,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x0e, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x2a, 0x63, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f,
+	0x0a, 0x0b, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x46, 0x4f, 0x4f, 0x10, 0x04, 0x12,
+	0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x42, 0x41, 0x52, 0x10, 0x05, 0x12,
+	0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x42, 0x41, 0x5a, 0x10, 0x06, 0x12,
+	0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x4d, 0x41, 0x58, 0x10, 0x0a, 0x1a,
+	0x04, 0x3a, 0x02, 0x10, 0x02, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x32, 0x30, 0x32, 0x33, 0x62, 0x08, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x70, 0xe8,
+	0x07,
+}
+
+var (
+	file_internal_testprotos_test2023_test_proto_rawDescOnce sync.Once
+	file_internal_testprotos_test2023_test_proto_rawDescData = file_internal_testprotos_test2023_test_proto_rawDesc
+)
+
+func file_internal_testprotos_test2023_test_proto_rawDescGZIP() []byte {
+	file_internal_testprotos_test2023_test_proto_rawDescOnce.Do(func() {
+		file_internal_testprotos_test2023_test_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_testprotos_test2023_test_proto_rawDescData)
+	})
+	return file_internal_testprotos_test2023_test_proto_rawDescData
+}
+
+var file_internal_testprotos_test2023_test_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_internal_testprotos_test2023_test_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_internal_testprotos_test2023_test_proto_goTypes = []interface{}{
+	(ClosedEnum)(0),                    // 0: goproto.proto.test2023.ClosedEnum
+	(TestAllTypes_NestedEnum)(0),       // 1: goproto.proto.test2023.TestAllTypes.NestedEnum
+	(*TestAllTypes)(nil),               // 2: goproto.proto.test2023.TestAllTypes
+	(*TestRequired)(nil),               // 3: goproto.proto.test2023.TestRequired
+	(*TestAllTypes_NestedMessage)(nil), // 4: goproto.proto.test2023.TestAllTypes.NestedMessage
+	nil,                                // 5: goproto.proto.test2023.TestAllTypes.MapInt32Int32Entry
+	nil,                                // 6: goproto.proto.test2023.TestAllTypes.MapStringStringEntry
+	nil,                                // 7: goproto.proto.test2023.TestAllTypes.MapStringNestedMessageEntry
+	nil,                                // 8: goproto.proto.test2023.TestAllTypes.MapStringNestedEnumEntry
+	nil,                                // 9: goproto.proto.test2023.TestAllTypes.MapStringClosedEnumEntry
+	nil,                                // 10: goproto.proto.test2023.TestAllTypes.UnverifiedMapEntry
+}
+var file_internal_testprotos_test2023_test_proto_depIdxs = []int32{
+	4,  // 0: goproto.proto.test2023.TestAllTypes.optional_nested_message:type_name -> goproto.proto.test2023.TestAllTypes.NestedMessage
+	1,  // 1: goproto.proto.test2023.TestAllTypes.optional_nested_enum:type_name -> goproto.proto.test2023.TestAllTypes.NestedEnum
+	0,  // 2: goproto.proto.test2023.TestAllTypes.optional_closed_enum:type_name -> goproto.proto.test2023.ClosedEnum
+	1,  // 3: goproto.proto.test2023.TestAllTypes.implicit_enum:type_name -> goproto.proto.test2023.TestAllTypes.NestedEnum
+	4,  // 4: goproto.proto.test2023.TestAllTypes.repeated_nested_message:type_name -> goproto.proto.test2023.TestAllTypes.NestedMessage
+	1,  // 5: goproto.proto.test2023.TestAllTypes.repeated_nested_enum:type_name -> goproto.proto.test2023.TestAllTypes.NestedEnum
+	0,  // 6: goproto.proto.test2023.TestAllTypes.repeated_closed_enum:type_name -> goproto.proto.test2023.ClosedEnum
+	0,  // 7: goproto.proto.test2023.TestAllTypes.expanded_closed_enum:type_name -> goproto.proto.test2023.ClosedEnum
+	5,  // 8: goproto.proto.test2023.TestAllTypes.map_int32_int32:type_name -> goproto.proto.test2023.TestAllTypes.MapInt32Int32Entry
+	6,  // 9: goproto.proto.test2023.TestAllTypes.map_string_string:type_name -> goproto.proto.test2023.TestAllTypes.MapStringStringEntry
+	7,  // 10: goproto.proto.test2023.TestAllTypes.map_string_nested_message:type_name -> goproto.proto.test2023.TestAllTypes.MapStringNestedMessageEntry
+	8,  // 11: goproto.proto.test2023.TestAllTypes.map_string_nested_enum:type_name -> goproto.proto.test2023.TestAllTypes.MapStringNestedEnumEntry
+	9,  // 12: goproto.proto.test2023.TestAllTypes.map_string_closed_enum:type_name -> goproto.proto.test2023.TestAllTypes.MapStringClosedEnumEntry
+	10, // 13: goproto.proto.test2023.TestAllTypes.unverified_map:type_name -> goproto.proto.test2023.TestAllTypes.UnverifiedMapEntry
+	4,  // 14: goproto.proto.test2023.TestAllTypes.delimited_message:type_name -> goproto.proto.test2023.TestAllTypes.NestedMessage
+	4,  // 15: goproto.proto.test2023.TestAllTypes.repeated_delimited_message:type_name -> goproto.proto.test2023.TestAllTypes.NestedMessage
+	0,  // 16: goproto.proto.test2023.TestAllTypes.default_closed_enum:type_name -> goproto.proto.test2023.ClosedEnum
+	4,  // 17: goproto.proto.test2023.TestAllTypes.oneof_nested_message:type_name -> goproto.proto.test2023.TestAllTypes.NestedMessage
+	0,  // 18: goproto.proto.test2023.TestAllTypes.oneof_closed_enum:type_name -> goproto.proto.test2023.ClosedEnum
+	4,  // 19: goproto.proto.test2023.TestAllTypes.oneof_delimited:type_name -> goproto.proto.test2023.TestAllTypes.NestedMessage
+	2,  // 20: goproto.proto.test2023.TestAllTypes.NestedMessage.corecursive:type_name -> goproto.proto.test2023.TestAllTypes
+	4,  // 21: goproto.proto.test2023.TestAllTypes.MapStringNestedMessageEntry.value:type_name -> goproto.proto.test2023.TestAllTypes.NestedMessage
+	1,  // 22: goproto.proto.test2023.TestAllTypes.MapStringNestedEnumEntry.value:type_name -> goproto.proto.test2023.TestAllTypes.NestedEnum
+	0,  // 23: goproto.proto.test2023.TestAllTypes.MapStringClosedEnumEntry.value:type_name -> goproto.proto.test2023.ClosedEnum
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_internal_testprotos_test2023_test_proto_init() }
+func file_internal_testprotos_test2023_test_proto_init() {
+	if File_internal_testprotos_test2023_test_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_testprotos_test2023_test_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestAllTypes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.zeroCopied
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_test2023_test_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestRequired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_test2023_test_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestAllTypes_NestedMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_internal_testprotos_test2023_test_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*TestAllTypes_OneofUint32)(nil),
+		(*TestAllTypes_OneofNestedMessage)(nil),
+		(*TestAllTypes_OneofString)(nil),
+		(*TestAllTypes_OneofBytes)(nil),
+		(*TestAllTypes_OneofClosedEnum)(nil),
+		(*TestAllTypes_OneofDelimited)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_internal_testprotos_test2023_test_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_testprotos_test2023_test_proto_goTypes,
+		DependencyIndexes: file_internal_testprotos_test2023_test_proto_depIdxs,
+		EnumInfos:         file_internal_testprotos_test2023_test_proto_enumTypes,
+		MessageInfos:      file_internal_testprotos_test2023_test_proto_msgTypes,
+	}.Build()
+	File_internal_testprotos_test2023_test_proto = out.File
+	file_internal_testprotos_test2023_test_proto_rawDesc = nil
+	file_internal_testprotos_test2023_test_proto_goTypes = nil
+	file_internal_testprotos_test2023_test_proto_depIdxs = nil
+}
@@ -0,0 +1,78 @@
+package test3
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/internal/fuzz"
+	"github.com/cosmos/cosmos-proto/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"pgregory.net/rapid"
+)
+
+// sortJSON sorts the keys of the JSON objects of b the way the sign bytes of
+// SIGN_MODE_LEGACY_AMINO_JSON are sorted, through encoding/json.
+func sortJSON(t require.TestingT, b []byte) []byte {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var v any
+	require.NoError(t, d.Decode(&v))
+	sorted, err := json.Marshal(v)
+	require.NoError(t, err)
+	return sorted
+}
+
+func TestAminoJSON(t *testing.T) {
+	for _, typ := range []protoreflect.MessageType{
+		(&TestAllTypes{}).ProtoReflect().Type(),
+		(&TestProto3Optional{}).ProtoReflect().Type(),
+	} {
+		t.Run(string(typ.Descriptor().FullName()), rapid.MakeCheck(func(t *rapid.T) {
+			msg := fuzz.Message(t, typ).Interface()
+			got, err := msg.(interface{ MarshalAminoJSON() ([]byte, error) }).MarshalAminoJSON()
+
+			// the reflection based encoding of the runtime acts as the reference implementation
+			dyn := dynamicpb.NewMessage(typ.Descriptor())
+			proto.Merge(dyn, msg)
+			want, wantErr := runtime.MarshalAminoJSON(dyn)
+			require.Equal(t, wantErr == nil, err == nil, "%v, %v", wantErr, err)
+			if err != nil {
+				return
+			}
+			require.Equal(t, string(want), string(got))
+			require.Equal(t, string(sortJSON(t, got)), string(got))
+		}))
+	}
+}
+
+func TestAminoJSONSemantics(t *testing.T) {
+	for name, tc := range map[string]struct {
+		msg  proto.Message
+		json string
+	}{
+		"empty":             {msg: &TestAllTypes{}, json: `{}`},
+		"sorted fields":     {msg: &TestAllTypes{SingularString: "s", SingularBool: true, SingularNestedMessage: &TestAllTypes_NestedMessage{}}, json: `{"singular_bool":true,"singular_nested_message":{},"singular_string":"s"}`},
+		"64-bit integers":   {msg: &TestAllTypes{SingularInt64: -1, SingularUint32: 2}, json: `{"singular_int64":"-1","singular_uint32":2}`},
+		"enums":             {msg: &TestAllTypes{SingularNestedEnum: TestAllTypes_BAZ}, json: `{"singular_nested_enum":2}`},
+		"floats":            {msg: &TestAllTypes{SingularDouble: 1e21, RepeatedFloat: []float32{1e-7, 0.1}}, json: `{"repeated_float":[1e-7,0.1],"singular_double":1e+21}`},
+		"html escaping":     {msg: &TestAllTypes{SingularString: "<a&b>\u2028\xff"}, json: `{"singular_string":"\u003ca\u0026b\u003e\u2028\ufffd"}`},
+		"map keys":          {msg: &TestAllTypes{MapInt32Int32: map[int32]int32{10: 1, -2: 2, 3: 3}}, json: `{"map_int32_int32":{"-2":2,"10":1,"3":3}}`},
+		"oneof zero value":  {msg: &TestAllTypes{OneofField: &TestAllTypes_OneofUint32{}}, json: `{"oneof_uint32":0}`},
+		"optional zero":     {msg: &TestProto3Optional{OptionalInt32: proto.Int32(0)}, json: `{"optional_int32":0}`},
+		"repeated messages": {msg: &TestAllTypes{RepeatedNestedMessage: []*TestAllTypes_NestedMessage{{A: 1}, {}}}, json: `{"repeated_nested_message":[{"a":1},{}]}`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := runtime.MarshalAminoJSON(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.json, string(got))
+		})
+	}
+
+	_, err := (&TestAllTypes{SingularFloat: float32(math.NaN())}).MarshalAminoJSON()
+	require.Error(t, err)
+}
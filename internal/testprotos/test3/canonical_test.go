@@ -0,0 +1,154 @@
+package test3
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/internal/fuzz"
+	"github.com/cosmos/cosmos-proto/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"pgregory.net/rapid"
+)
+
+// unregisteredDescriptor returns a copy of the descriptor of typ which is not registered,
+// making the runtime validate its encoding through the descriptor.
+func unregisteredDescriptor(t require.TestingT, typ protoreflect.MessageType) protoreflect.MessageDescriptor {
+	md := typ.Descriptor()
+	file, err := protodesc.NewFile(protodesc.ToFileDescriptorProto(md.ParentFile()), protoregistry.GlobalFiles)
+	require.NoError(t, err)
+	return file.Messages().ByName(md.Name())
+}
+
+func TestValidateCanonical(t *testing.T) {
+	for _, typ := range []protoreflect.MessageType{
+		(&TestAllTypes{}).ProtoReflect().Type(),
+		(&TestProto3Optional{}).ProtoReflect().Type(),
+	} {
+		md := unregisteredDescriptor(t, typ)
+		t.Run(string(typ.Descriptor().FullName()), rapid.MakeCheck(func(t *rapid.T) {
+			msg := fuzz.Message(t, typ).Interface()
+			b, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+			require.NoError(t, err)
+			require.NoError(t, msg.(runtime.CanonicalValidator).ValidateCanonical(b))
+			require.NoError(t, runtime.ValidateCanonical(b, md))
+
+			// accepted encodings are exactly those the marshal produces
+			b = mutate(t, b)
+			err = msg.(runtime.CanonicalValidator).ValidateCanonical(b)
+			require.Equal(t, err, runtime.ValidateCanonical(b, md))
+			decoded := typ.New().Interface()
+			if err != nil || proto.Unmarshal(b, decoded) != nil {
+				return
+			}
+			remarshaled, err := proto.MarshalOptions{Deterministic: true}.Marshal(decoded)
+			require.NoError(t, err)
+			require.Equal(t, b, remarshaled)
+		}))
+	}
+}
+
+// mutate alters a byte of b, inserts a byte or moves a range of bytes.
+func mutate(t *rapid.T, b []byte) []byte {
+	if len(b) == 0 {
+		return []byte{rapid.Byte().Draw(t, "byte").(byte)}
+	}
+	b = append([]byte(nil), b...)
+	i := rapid.IntRange(0, len(b)-1).Draw(t, "offset").(int)
+	switch rapid.IntRange(0, 2).Draw(t, "mutation").(int) {
+	case 0:
+		b[i] = rapid.Byte().Draw(t, "byte").(byte)
+	case 1:
+		b = append(b[:i], append([]byte{rapid.Byte().Draw(t, "byte").(byte)}, b[i:]...)...)
+	default:
+		j := rapid.IntRange(i, len(b)).Draw(t, "end").(int)
+		moved := append([]byte(nil), b[i:j]...)
+		b = append(b[:i], b[j:]...)
+		k := rapid.IntRange(0, len(b)).Draw(t, "destination").(int)
+		b = append(b[:k], append(moved, b[k:]...)...)
+	}
+	return b
+}
+
+func varintField(num protowire.Number, v uint64) []byte {
+	return protowire.AppendVarint(protowire.AppendTag(nil, num, protowire.VarintType), v)
+}
+
+func bytesField(num protowire.Number, v []byte) []byte {
+	return protowire.AppendBytes(protowire.AppendTag(nil, num, protowire.BytesType), v)
+}
+
+func concat(b ...[]byte) []byte {
+	var all []byte
+	for _, b := range b {
+		all = append(all, b...)
+	}
+	return all
+}
+
+func TestValidateCanonicalErrors(t *testing.T) {
+	md := unregisteredDescriptor(t, (&TestAllTypes{}).ProtoReflect().Type())
+	for name, tc := range map[string]struct {
+		b      []byte
+		offset int
+		path   string
+		reason string
+	}{
+		"zero value":            {b: varintField(81, 0), offset: 2, path: "singular_int32", reason: "zero value"},
+		"non-minimal varint":    {b: append(protowire.AppendTag(nil, 81, protowire.VarintType), 0x81, 0), offset: 2, path: "singular_int32", reason: "non-minimal varint"},
+		"non-minimal tag":       {b: []byte{0x88, 0x85, 0x00, 0x01}, offset: 0, reason: "non-minimal tag"},
+		"out of order":          {b: concat(varintField(82, 1), varintField(81, 1)), offset: 3, path: "singular_int32", reason: "out of order"},
+		"duplicate":             {b: concat(varintField(81, 1), varintField(81, 2)), offset: 3, path: "singular_int32", reason: "duplicate field"},
+		"wire type":             {b: bytesField(81, []byte{1}), offset: 0, path: "singular_int32", reason: "wire type 2 instead of 0"},
+		"bool out of range":     {b: varintField(93, 2), offset: 2, path: "singular_bool", reason: "out of the range"},
+		"int32 out of range":    {b: varintField(81, 1<<32-1), offset: 2, path: "singular_int32", reason: "out of the range"},
+		"unpacked":              {b: varintField(31, 1), offset: 0, path: "repeated_int32", reason: "unpacked elements of a packed field"},
+		"empty packed":          {b: bytesField(31, nil), offset: 2, path: "repeated_int32", reason: "empty packed field"},
+		"split packed":          {b: concat(bytesField(31, []byte{1}), bytesField(31, []byte{2})), offset: 4, path: "repeated_int32", reason: "duplicate field"},
+		"empty string":          {b: bytesField(94, nil), offset: 2, path: "singular_string", reason: "zero value"},
+		"several oneof members": {b: concat(varintField(111, 1), bytesField(113, []byte("a"))), offset: 3, path: "oneof_string", reason: "several fields of a oneof"},
+		"oneof before fields":   {b: concat(varintField(111, 1), varintField(81, 1)), offset: 3, path: "singular_int32", reason: "out of order"},
+		"unknown before fields": {b: concat(varintField(200, 1), varintField(81, 1)), offset: 3, path: "singular_int32", reason: "out of order"},
+		"unsorted map":          {b: concat(bytesField(56, []byte{0x08, 2, 0x10, 0}), bytesField(56, []byte{0x08, 1, 0x10, 0})), offset: 11, path: "map_int32_int32", reason: "not sorted"},
+		"duplicate map key":     {b: concat(bytesField(56, []byte{0x08, 1, 0x10, 0}), bytesField(56, []byte{0x08, 1, 0x10, 0})), offset: 11, path: "map_int32_int32", reason: "not sorted"},
+		"map entry value":       {b: bytesField(56, []byte{0x08, 1}), offset: 5, path: "map_int32_int32[1]", reason: "without a value"},
+		"map entry key":         {b: bytesField(56, []byte{0x10, 1}), offset: 3, path: "map_int32_int32", reason: "not starting with its key"},
+		"nested": {
+			b:      concat(bytesField(48, nil), bytesField(48, bytesField(2, varintField(81, 0)))),
+			offset: 10, path: "repeated_nested_message[1].corecursive.singular_int32", reason: "zero value",
+		},
+		"nested map value": {
+			b: bytesField(71, concat(bytesField(1, []byte("k")), bytesField(2, varintField(1, 0)))), offset: 9, path: "map_string_nested_message[k].a", reason: "zero value",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := (*TestAllTypes)(nil).ValidateCanonical(tc.b)
+			var canonical *runtime.CanonicalError
+			require.ErrorAs(t, err, &canonical)
+			require.Equal(t, protoreflect.FullName("goproto.proto.test3.TestAllTypes"), canonical.Message)
+			require.Equal(t, tc.offset, canonical.Offset)
+			require.Equal(t, tc.path, canonical.Path)
+			require.Contains(t, canonical.Reason, tc.reason)
+			require.ErrorIs(t, err, proto.Error)
+			require.Equal(t, err, runtime.ValidateCanonical(tc.b, md))
+		})
+	}
+}
+
+func TestValidateCanonicalAccepts(t *testing.T) {
+	for name, b := range map[string][]byte{
+		"empty":          nil,
+		"negative zero":  protowire.AppendFixed32(protowire.AppendTag(nil, 91, protowire.Fixed32Type), 1<<31),
+		"negative int32": varintField(81, 1<<64-1),
+		"zero oneof":     varintField(111, 0),
+		"sorted map":     concat(bytesField(56, []byte{0x08, 1, 0x10, 0}), bytesField(56, []byte{0x08, 0x7f, 0x10, 0})),
+		"unknown fields": concat(varintField(81, 1), varintField(300, 1), varintField(200, 1)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, (*TestAllTypes)(nil).ValidateCanonical(b))
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package test3
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/internal/fuzz"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"pgregory.net/rapid"
+)
+
+func TestClone(t *testing.T) {
+	t.Run("matches proto.Clone", rapid.MakeCheck(func(t *rapid.T) {
+		msg := fuzz.Message(t, (&TestAllTypes{}).ProtoReflect().Type()).Interface().(*TestAllTypes)
+		want, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		require.NoError(t, err)
+
+		clone := msg.Clone()
+		require.True(t, proto.Equal(proto.Clone(msg), clone))
+
+		// resetting the clone in depth leaves the original untouched
+		if clone.SingularNestedMessage != nil {
+			clone.SingularNestedMessage.Reset()
+		}
+		for _, v := range clone.MapStringNestedMessage {
+			v.Reset()
+		}
+		got, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}))
+
+	t.Run("optional fields", rapid.MakeCheck(func(t *rapid.T) {
+		msg := fuzz.Message(t, (&TestProto3Optional{}).ProtoReflect().Type()).Interface().(*TestProto3Optional)
+		dst := &TestProto3Optional{OptionalInt32: proto.Int32(1)}
+		msg.CopyInto(dst)
+		require.True(t, proto.Equal(msg, dst))
+	}))
+}
@@ -7,4 +7,5 @@ import (
 
 func TestCompliance(t *testing.T) {
 	prototest.Message{}.Test(t, (&TestAllTypes{}).ProtoReflect().Type())
+	prototest.Message{}.Test(t, (&TestProto3Optional{}).ProtoReflect().Type())
 }
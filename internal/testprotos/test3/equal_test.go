@@ -0,0 +1,109 @@
+package test3
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/internal/fuzz"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"pgregory.net/rapid"
+)
+
+// reflectEqual compares the messages through their dynamic copies, whose comparison is reflection based.
+func reflectEqual(x, y proto.Message) bool {
+	return protoreflect.ValueOfMessage(toDynamic(x.ProtoReflect())).Equal(protoreflect.ValueOfMessage(toDynamic(y.ProtoReflect())))
+}
+
+// equalMethod compares the messages with the generated Equal method.
+func equalMethod(x, y proto.Message) bool {
+	switch x := x.(type) {
+	case *TestAllTypes:
+		return x.Equal(y.(*TestAllTypes))
+	case *TestProto3Optional:
+		return x.Equal(y.(*TestProto3Optional))
+	default:
+		panic("no Equal method")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	for _, typ := range []protoreflect.MessageType{
+		(&TestAllTypes{}).ProtoReflect().Type(),
+		(&TestProto3Optional{}).ProtoReflect().Type(),
+	} {
+		t.Run(string(typ.Descriptor().FullName()), rapid.MakeCheck(func(t *rapid.T) {
+			x := fuzz.Message(t, typ).Interface()
+			require.True(t, proto.Equal(x, proto.Clone(x)))
+
+			// y differs from x by at most one field, taken from another random message
+			y := proto.Clone(x)
+			other := fuzz.Message(t, typ)
+			fields := typ.Descriptor().Fields()
+			fd := fields.Get(rapid.IntRange(0, fields.Len()-1).Draw(t, "field").(int))
+			if other.Has(fd) {
+				y.ProtoReflect().Set(fd, other.Get(fd))
+			} else {
+				y.ProtoReflect().Clear(fd)
+			}
+			require.Equal(t, reflectEqual(x, y), proto.Equal(x, y))
+			require.Equal(t, reflectEqual(y, x), proto.Equal(y, x))
+			require.Equal(t, proto.Equal(x, y), equalMethod(x, y))
+		}))
+	}
+}
+
+func TestEqualSemantics(t *testing.T) {
+	nan := math.NaN()
+	negZero := math.Copysign(0, -1)
+	for name, tc := range map[string]struct {
+		x, y  proto.Message
+		equal bool
+	}{
+		"nil messages":           {x: (*TestAllTypes)(nil), y: (*TestAllTypes)(nil), equal: true},
+		"nil and empty":          {x: (*TestAllTypes)(nil), y: &TestAllTypes{}, equal: false},
+		"NaN":                    {x: &TestAllTypes{SingularDouble: nan}, y: &TestAllTypes{SingularDouble: -nan}, equal: true},
+		"NaN in lists":           {x: &TestAllTypes{RepeatedFloat: []float32{float32(nan)}}, y: &TestAllTypes{RepeatedFloat: []float32{float32(nan)}}, equal: true},
+		"NaN in maps":            {x: &TestAllTypes{MapInt32Double: map[int32]float64{1: nan}}, y: &TestAllTypes{MapInt32Double: map[int32]float64{1: nan}}, equal: true},
+		"NaN and zero":           {x: &TestAllTypes{SingularDouble: nan}, y: &TestAllTypes{}, equal: false},
+		"negative zero":          {x: &TestAllTypes{SingularDouble: negZero}, y: &TestAllTypes{}, equal: false},
+		"negative zeros":         {x: &TestAllTypes{SingularFloat: float32(negZero)}, y: &TestAllTypes{SingularFloat: float32(negZero)}, equal: true},
+		"zeros with presence":    {x: &TestProto3Optional{OptionalDouble: proto.Float64(negZero)}, y: &TestProto3Optional{OptionalDouble: proto.Float64(0)}, equal: true},
+		"unset optional":         {x: &TestProto3Optional{OptionalInt32: proto.Int32(0)}, y: &TestProto3Optional{}, equal: false},
+		"nil and empty bytes":    {x: &TestAllTypes{SingularBytes: []byte{}}, y: &TestAllTypes{}, equal: true},
+		"nil and empty list":     {x: &TestAllTypes{RepeatedInt32: []int32{}}, y: &TestAllTypes{}, equal: true},
+		"nil and empty map":      {x: &TestAllTypes{MapInt32Int32: map[int32]int32{}}, y: &TestAllTypes{}, equal: true},
+		"map values":             {x: &TestAllTypes{MapInt32Int32: map[int32]int32{1: 1}}, y: &TestAllTypes{MapInt32Int32: map[int32]int32{2: 1}}, equal: false},
+		"unset and empty nested": {x: &TestAllTypes{SingularNestedMessage: &TestAllTypes_NestedMessage{}}, y: &TestAllTypes{}, equal: false},
+		"oneof zero value":       {x: &TestAllTypes{OneofField: &TestAllTypes_OneofUint32{}}, y: &TestAllTypes{}, equal: false},
+		"oneof members":          {x: &TestAllTypes{OneofField: &TestAllTypes_OneofUint32{}}, y: &TestAllTypes{OneofField: &TestAllTypes_OneofUint64{}}, equal: false},
+		"oneof bytes":            {x: &TestAllTypes{OneofField: &TestAllTypes_OneofBytes{}}, y: &TestAllTypes{OneofField: &TestAllTypes_OneofBytes{OneofBytes: []byte{}}}, equal: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.equal, proto.Equal(tc.x, tc.y))
+			require.Equal(t, tc.equal, proto.Equal(tc.y, tc.x))
+			require.Equal(t, tc.equal, equalMethod(tc.x, tc.y))
+			require.Equal(t, tc.equal, equalMethod(tc.y, tc.x))
+		})
+	}
+}
+
+func TestEqualUnknown(t *testing.T) {
+	var a, b []byte
+	a = protowire.AppendTag(a, 1000, protowire.VarintType)
+	a = protowire.AppendVarint(a, 1)
+	b = protowire.AppendTag(b, 1001, protowire.VarintType)
+	b = protowire.AppendVarint(b, 2)
+
+	x, y := &TestAllTypes{}, &TestAllTypes{}
+	x.ProtoReflect().SetUnknown(append(append([]byte{}, a...), b...))
+	y.ProtoReflect().SetUnknown(append(append([]byte{}, b...), a...))
+	// the order of different field numbers does not matter
+	require.True(t, proto.Equal(x, y))
+
+	y.ProtoReflect().SetUnknown(append(append([]byte{}, a...), a...))
+	require.False(t, proto.Equal(x, y))
+	require.False(t, proto.Equal(x, &TestAllTypes{}))
+}
@@ -2,6 +2,7 @@ package test3
 
 import (
 	"github.com/cosmos/cosmos-proto/internal/fuzz"
+	"github.com/cosmos/cosmos-proto/runtime"
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
@@ -27,6 +28,21 @@ func TestMarshalUnmarshal(t *testing.T) {
 	}))
 }
 
+func TestZeroCopyUnmarshal(t *testing.T) {
+	t.Run("matches unmarshal", rapid.MakeCheck(func(t *rapid.T) {
+		mType := (&TestAllTypes{}).ProtoReflect().Type()
+		msg := fuzz.Message(t, mType)
+
+		msgBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Interface())
+		require.NoError(t, err)
+
+		uMsg := mType.New()
+		err = runtime.ZeroCopy(proto.UnmarshalOptions{}).Unmarshal(msgBytes, uMsg.Interface())
+		require.NoError(t, err)
+		require.True(t, proto.Equal(msg.Interface(), uMsg.Interface()))
+	}))
+}
+
 // TestZeroValueOneofIsMarshalled tests that zero values in oneofs are marshalled
 func TestZeroValueOneofIsMarshalled(t *testing.T) {
 	msg1 := &TestAllTypes{OneofField: &TestAllTypes_OneofEnum{OneofEnum: TestAllTypes_FOO}}
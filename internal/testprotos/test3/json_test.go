@@ -0,0 +1,120 @@
+package test3
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/internal/fuzz"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"pgregory.net/rapid"
+)
+
+// compactJSON returns the protojson encoding of m without the whitespace protojson randomly adds.
+func compactJSON(t require.TestingT, m proto.Message) []byte {
+	b, err := protojson.Marshal(m)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, json.Compact(&buf, b))
+	return buf.Bytes()
+}
+
+func TestJSON(t *testing.T) {
+	for _, typ := range []protoreflect.MessageType{
+		(&TestAllTypes{}).ProtoReflect().Type(),
+		(&TestProto3Optional{}).ProtoReflect().Type(),
+	} {
+		t.Run(string(typ.Descriptor().FullName()), rapid.MakeCheck(func(t *rapid.T) {
+			msg := fuzz.Message(t, typ).Interface()
+			want := compactJSON(t, msg)
+			got, err := msg.(json.Marshaler).MarshalJSON()
+			require.NoError(t, err)
+			require.Equal(t, string(want), string(got))
+
+			decoded := typ.New().Interface()
+			require.NoError(t, decoded.(json.Unmarshaler).UnmarshalJSON(got))
+			require.True(t, proto.Equal(msg, decoded))
+		}))
+	}
+}
+
+func TestJSONSemantics(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	for name, tc := range map[string]struct {
+		msg  proto.Message
+		json string
+	}{
+		"empty":             {msg: &TestAllTypes{}, json: `{}`},
+		"64-bit integers":   {msg: &TestAllTypes{SingularInt64: -1, SingularFixed64: math.MaxUint64}, json: `{"singularInt64":"-1","singularFixed64":"18446744073709551615"}`},
+		"special floats":    {msg: &TestAllTypes{SingularFloat: float32(math.Inf(-1)), SingularDouble: math.NaN()}, json: `{"singularFloat":"-Infinity","singularDouble":"NaN"}`},
+		"negative zero":     {msg: &TestAllTypes{SingularDouble: negZero}, json: `{"singularDouble":-0}`},
+		"exponents":         {msg: &TestAllTypes{SingularDouble: 1e21, RepeatedDouble: []float64{1e-7, 0.5}}, json: `{"singularDouble":1e+21,"repeatedDouble":[1e-7,0.5]}`},
+		"unknown enum":      {msg: &TestAllTypes{SingularNestedEnum: 42}, json: `{"singularNestedEnum":42}`},
+		"escaped strings":   {msg: &TestAllTypes{SingularString: "<\"\\\n >"}, json: `{"singularString":"<\"\\\n >"}`},
+		"sorted map keys":   {msg: &TestAllTypes{MapInt32Int32: map[int32]int32{10: 1, -2: 2, 3: 3}, MapBoolBool: map[bool]bool{true: true, false: false}}, json: `{"mapInt32Int32":{"-2":2,"3":3,"10":1},"mapBoolBool":{"false":false,"true":true}}`},
+		"oneof zero value":  {msg: &TestAllTypes{OneofField: &TestAllTypes_OneofUint32{}}, json: `{"oneofUint32":0}`},
+		"optional zero":     {msg: &TestProto3Optional{OptionalInt32: proto.Int32(0), OptionalBytes: []byte{}}, json: `{"optionalInt32":0,"optionalBytes":""}`},
+		"nested messages":   {msg: &TestAllTypes{SingularNestedMessage: &TestAllTypes_NestedMessage{Corecursive: &TestAllTypes{}}}, json: `{"singularNestedMessage":{"corecursive":{}}}`},
+		"repeated messages": {msg: &TestAllTypes{RepeatedNestedMessage: []*TestAllTypes_NestedMessage{{A: 1}, {}}}, json: `{"repeatedNestedMessage":[{"a":1},{}]}`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.json, string(compactJSON(t, tc.msg)))
+			got, err := tc.msg.(json.Marshaler).MarshalJSON()
+			require.NoError(t, err)
+			require.Equal(t, tc.json, string(got))
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	for name, tc := range map[string]struct {
+		json string
+		want proto.Message
+	}{
+		"proto names":       {json: `{"singular_int32":1,"repeated_string":["a"]}`, want: &TestAllTypes{SingularInt32: 1, RepeatedString: []string{"a"}}},
+		"quoted numbers":    {json: `{"singularInt32":"-3","singularUint64":7,"singularFloat":"1.5"}`, want: &TestAllTypes{SingularInt32: -3, SingularUint64: 7, SingularFloat: 1.5}},
+		"exponent integers": {json: `{"singularInt32":1e2}`, want: &TestAllTypes{SingularInt32: 100}},
+		"enum numbers":      {json: `{"singularNestedEnum":2}`, want: &TestAllTypes{SingularNestedEnum: TestAllTypes_BAZ}},
+		"nulls":             {json: `{"singularInt32":null,"singularNestedMessage":null,"repeatedInt32":null}`, want: &TestAllTypes{}},
+		"url base64":        {json: `{"singularBytes":"_-8"}`, want: &TestAllTypes{SingularBytes: []byte{0xff, 0xef}}},
+		"oneof":             {json: `{"oneofString":""}`, want: &TestAllTypes{OneofField: &TestAllTypes_OneofString{}}},
+		"optional":          {json: `{"optionalString":""}`, want: &TestProto3Optional{OptionalString: proto.String("")}},
+		"maps":              {json: `{"mapBoolBool":{"true":false},"mapStringNestedMessage":{"k":{"a":1}}}`, want: &TestAllTypes{MapBoolBool: map[bool]bool{true: false}, MapStringNestedMessage: map[string]*TestAllTypes_NestedMessage{"k": {A: 1}}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got := tc.want.ProtoReflect().Type().New().Interface()
+			require.NoError(t, got.(json.Unmarshaler).UnmarshalJSON([]byte(tc.json)))
+			require.True(t, proto.Equal(tc.want, got), "%v", got)
+		})
+	}
+}
+
+func TestUnmarshalJSONErrors(t *testing.T) {
+	for name, input := range map[string]string{
+		"unknown field":      `{"unknown":1}`,
+		"duplicate field":    `{"singularInt32":1,"singular_int32":2}`,
+		"second oneof":       `{"oneofUint32":1,"oneofString":"a"}`,
+		"duplicate map key":  `{"mapInt32Int32":{"1":1,"1":2}}`,
+		"out of range":       `{"singularInt32":2147483648}`,
+		"fractional integer": `{"singularInt64":"1.5"}`,
+		"unknown enum name":  `{"singularNestedEnum":"QUX"}`,
+		"invalid utf8":       "{\"singularString\":\"\xff\"}",
+		"trailing data":      `{}{}`,
+		"not an object":      `[]`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, protojson.Unmarshal([]byte(input), &TestAllTypes{}))
+			require.Error(t, new(TestAllTypes).UnmarshalJSON([]byte(input)))
+		})
+	}
+}
+
+func TestUnmarshalJSONResets(t *testing.T) {
+	m := &TestAllTypes{SingularInt32: 1, RepeatedInt32: []int32{1}}
+	require.NoError(t, m.UnmarshalJSON([]byte(`{"repeatedInt32":[2]}`)))
+	require.True(t, proto.Equal(&TestAllTypes{RepeatedInt32: []int32{2}}, m))
+}
@@ -0,0 +1,201 @@
+package test3
+
+import (
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/cosmos/cosmos-proto/internal/fuzz"
+	"github.com/cosmos/cosmos-proto/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"pgregory.net/rapid"
+)
+
+// decodeSizes returns the largest list, map and string or bytes value held by m and its messages.
+func decodeSizes(m protoreflect.Message) (limits runtime.DecodeLimits) {
+	grow := func(limit *int, n int) {
+		if n > *limit {
+			*limit = n
+		}
+	}
+	merge := func(nested runtime.DecodeLimits) {
+		grow(&limits.MaxListLength, nested.MaxListLength)
+		grow(&limits.MaxMapEntries, nested.MaxMapEntries)
+		grow(&limits.MaxFieldLength, nested.MaxFieldLength)
+	}
+	value := func(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+		switch fd.Kind() {
+		case protoreflect.StringKind:
+			grow(&limits.MaxFieldLength, len(v.String()))
+		case protoreflect.BytesKind:
+			grow(&limits.MaxFieldLength, len(v.Bytes()))
+		case protoreflect.MessageKind:
+			merge(decodeSizes(v.Message()))
+		}
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			grow(&limits.MaxListLength, v.List().Len())
+			for i := 0; i < v.List().Len(); i++ {
+				value(fd, v.List().Get(i))
+			}
+		case fd.IsMap():
+			grow(&limits.MaxMapEntries, v.Map().Len())
+			v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				value(fd.MapKey(), k.Value())
+				value(fd.MapValue(), v)
+				return true
+			})
+		default:
+			value(fd, v)
+		}
+		return true
+	})
+	return limits
+}
+
+func TestDecodeLimits(t *testing.T) {
+	// the sizes of the structs allocated for the nested messages
+	const nestedSize, messageSize = int(unsafe.Sizeof(TestAllTypes_NestedMessage{})), int(unsafe.Sizeof(TestAllTypes{}))
+
+	t.Run("limits", rapid.MakeCheck(func(t *rapid.T) {
+		typ := (&TestAllTypes{}).ProtoReflect().Type()
+		msg := fuzz.Message(t, typ).Interface()
+		b, err := proto.Marshal(msg)
+		require.NoError(t, err)
+
+		sizes := decodeSizes(msg.ProtoReflect())
+		limits := runtime.DecodeLimits{
+			MaxListLength:  rapid.IntRange(0, 8).Draw(t, "max list length").(int),
+			MaxMapEntries:  rapid.IntRange(0, 8).Draw(t, "max map entries").(int),
+			MaxFieldLength: rapid.IntRange(0, 32).Draw(t, "max field length").(int),
+		}
+		exceeds := func(size, limit int) bool {
+			return limit > 0 && size > limit
+		}
+		decoded := &TestAllTypes{}
+		err = runtime.WithDecodeLimits(proto.UnmarshalOptions{}, limits).Unmarshal(b, decoded)
+		if exceeds(sizes.MaxListLength, limits.MaxListLength) || exceeds(sizes.MaxMapEntries, limits.MaxMapEntries) ||
+			exceeds(sizes.MaxFieldLength, limits.MaxFieldLength) {
+			var limitErr *runtime.DecodeLimitError
+			require.ErrorAs(t, err, &limitErr)
+			require.ErrorIs(t, err, proto.Error)
+			return
+		}
+		require.NoError(t, err)
+		require.True(t, proto.Equal(msg, decoded))
+	}))
+
+	for name, tc := range map[string]struct {
+		msg    *TestAllTypes
+		limits runtime.DecodeLimits
+		field  protoreflect.FullName
+		limit  string
+	}{
+		"packed list": {
+			msg:    &TestAllTypes{RepeatedInt32: []int32{1, 2, 3}},
+			limits: runtime.DecodeLimits{MaxListLength: 2},
+			field:  "goproto.proto.test3.TestAllTypes.repeated_int32", limit: "MaxListLength",
+		},
+		"list": {
+			msg:    &TestAllTypes{RepeatedString: []string{"a", "b", "c"}},
+			limits: runtime.DecodeLimits{MaxListLength: 2},
+			field:  "goproto.proto.test3.TestAllTypes.repeated_string", limit: "MaxListLength",
+		},
+		"map": {
+			msg:    &TestAllTypes{MapInt32Int32: map[int32]int32{1: 1, 2: 2}},
+			limits: runtime.DecodeLimits{MaxMapEntries: 1},
+			field:  "goproto.proto.test3.TestAllTypes.map_int32_int32", limit: "MaxMapEntries",
+		},
+		"bytes": {
+			msg:    &TestAllTypes{SingularBytes: []byte("abc")},
+			limits: runtime.DecodeLimits{MaxFieldLength: 2},
+			field:  "goproto.proto.test3.TestAllTypes.singular_bytes", limit: "MaxFieldLength",
+		},
+		"map key": {
+			msg:    &TestAllTypes{MapStringString: map[string]string{"abc": ""}},
+			limits: runtime.DecodeLimits{MaxFieldLength: 2},
+			field:  "goproto.proto.test3.TestAllTypes.map_string_string", limit: "MaxFieldLength",
+		},
+		"nested": {
+			msg:    &TestAllTypes{SingularNestedMessage: &TestAllTypes_NestedMessage{Corecursive: &TestAllTypes{OneofField: &TestAllTypes_OneofString{OneofString: "abc"}}}},
+			limits: runtime.DecodeLimits{MaxFieldLength: 2},
+			field:  "goproto.proto.test3.TestAllTypes.oneof_string", limit: "MaxFieldLength",
+		},
+		"bytes budget": {
+			// 3 elements of 4 bytes
+			msg:    &TestAllTypes{RepeatedInt32: []int32{1, 2, 3}},
+			limits: runtime.DecodeLimits{MaxBytes: 11},
+			field:  "goproto.proto.test3.TestAllTypes.repeated_int32", limit: "MaxBytes",
+		},
+		"shared bytes budget": {
+			// the strings of both messages share the budget with the nested messages
+			msg: &TestAllTypes{SingularString: strings.Repeat("a", 6), SingularNestedMessage: &TestAllTypes_NestedMessage{
+				Corecursive: &TestAllTypes{SingularString: strings.Repeat("b", 6)},
+			}},
+			limits: runtime.DecodeLimits{MaxBytes: 11 + nestedSize + messageSize},
+			field:  "goproto.proto.test3.TestAllTypes.singular_string", limit: "MaxBytes",
+		},
+		"message budget": {
+			msg:    &TestAllTypes{SingularNestedMessage: &TestAllTypes_NestedMessage{}},
+			limits: runtime.DecodeLimits{MaxBytes: nestedSize - 1},
+			field:  "goproto.proto.test3.TestAllTypes.singular_nested_message", limit: "MaxBytes",
+		},
+		"message list budget": {
+			// 2 pointers and the messages they point to
+			msg:    &TestAllTypes{RepeatedNestedMessage: []*TestAllTypes_NestedMessage{{}, {}}},
+			limits: runtime.DecodeLimits{MaxBytes: 2*(8+nestedSize) - 1},
+			field:  "goproto.proto.test3.TestAllTypes.repeated_nested_message", limit: "MaxBytes",
+		},
+		"message map budget": {
+			// a key of 1 byte, an entry of a string and a pointer, and the message it points to
+			msg:    &TestAllTypes{MapStringNestedMessage: map[string]*TestAllTypes_NestedMessage{"a": {}}},
+			limits: runtime.DecodeLimits{MaxBytes: 1 + 16 + 8 + nestedSize - 1},
+			field:  "goproto.proto.test3.TestAllTypes.map_string_nested_message", limit: "MaxBytes",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			b, err := proto.Marshal(tc.msg)
+			require.NoError(t, err)
+			err = runtime.WithDecodeLimits(proto.UnmarshalOptions{}, tc.limits).Unmarshal(b, &TestAllTypes{})
+			var limitErr *runtime.DecodeLimitError
+			require.ErrorAs(t, err, &limitErr)
+			require.Equal(t, tc.field, limitErr.Field)
+			require.Equal(t, tc.limit, limitErr.Limit)
+
+			// the message fits in limits one unit higher
+			switch tc.limit {
+			case "MaxListLength":
+				tc.limits.MaxListLength++
+			case "MaxMapEntries":
+				tc.limits.MaxMapEntries++
+			case "MaxFieldLength":
+				tc.limits.MaxFieldLength++
+			case "MaxBytes":
+				tc.limits.MaxBytes++
+			}
+			decoded := &TestAllTypes{}
+			require.NoError(t, runtime.WithDecodeLimits(proto.UnmarshalOptions{}, tc.limits).Unmarshal(b, decoded))
+			require.True(t, proto.Equal(tc.msg, decoded))
+		})
+	}
+
+	t.Run("bytes budget per unmarshal", func(t *testing.T) {
+		msg := &TestAllTypes{SingularString: strings.Repeat("a", 6), SingularNestedMessage: &TestAllTypes_NestedMessage{
+			Corecursive: &TestAllTypes{SingularString: strings.Repeat("b", 6)},
+		}}
+		b, err := proto.Marshal(msg)
+		require.NoError(t, err)
+
+		// options kept around start a new budget for each unmarshal
+		options := runtime.WithDecodeLimits(proto.UnmarshalOptions{}, runtime.DecodeLimits{MaxBytes: 12 + nestedSize + messageSize})
+		for i := 0; i < 3; i++ {
+			decoded := &TestAllTypes{}
+			require.NoError(t, options.Unmarshal(b, decoded))
+			require.True(t, proto.Equal(msg, decoded))
+		}
+	})
+}
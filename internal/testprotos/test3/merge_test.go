@@ -0,0 +1,117 @@
+package test3
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/internal/fuzz"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"pgregory.net/rapid"
+)
+
+// toDynamic copies the message into a dynamic message, whose merge is reflection based.
+func toDynamic(msg protoreflect.Message) *dynamicpb.Message {
+	dyn := dynamicpb.NewMessage(msg.Descriptor())
+	proto.Merge(dyn, msg.Interface())
+	return dyn
+}
+
+func TestMerge(t *testing.T) {
+	for _, typ := range []protoreflect.MessageType{
+		(&TestAllTypes{}).ProtoReflect().Type(),
+		(&TestProto3Optional{}).ProtoReflect().Type(),
+	} {
+		t.Run(string(typ.Descriptor().FullName()), rapid.MakeCheck(func(t *rapid.T) {
+			dst := fuzz.Message(t, typ).Interface()
+			src := fuzz.Message(t, typ).Interface()
+			dynDst, dynSrc := toDynamic(dst.ProtoReflect()), toDynamic(src.ProtoReflect())
+			srcBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(src)
+			require.NoError(t, err)
+
+			proto.Merge(dst, src)
+			proto.Merge(dynDst, dynSrc)
+
+			diff := cmp.Diff(toDynamic(dst.ProtoReflect()), dynDst, protocmp.Transform())
+			require.Emptyf(t, diff, "non matching messages\n%s", diff)
+
+			// the merged message does not alias the source
+			proto.Reset(dst)
+			gotSrcBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(src)
+			require.NoError(t, err)
+			require.Equal(t, srcBytes, gotSrcBytes)
+		}))
+	}
+}
+
+func TestMergeSemantics(t *testing.T) {
+	dst := &TestAllTypes{
+		SingularInt32:         1,
+		SingularString:        "dst",
+		RepeatedInt32:         []int32{1},
+		MapStringString:       map[string]string{"a": "dst", "b": "dst"},
+		SingularNestedMessage: &TestAllTypes_NestedMessage{A: 1},
+		OneofField:            &TestAllTypes_OneofNestedMessage{OneofNestedMessage: &TestAllTypes_NestedMessage{A: 1}},
+	}
+	dst.ProtoReflect().SetUnknown([]byte{0xf8, 0x7f, 0x01})
+	src := &TestAllTypes{
+		SingularString:        "src",
+		RepeatedInt32:         []int32{2},
+		MapStringString:       map[string]string{"b": "src"},
+		SingularNestedMessage: &TestAllTypes_NestedMessage{Corecursive: &TestAllTypes{SingularInt32: 2}},
+		OneofField:            &TestAllTypes_OneofNestedMessage{OneofNestedMessage: &TestAllTypes_NestedMessage{Corecursive: &TestAllTypes{}}},
+	}
+	src.ProtoReflect().SetUnknown([]byte{0xf8, 0x7f, 0x02})
+
+	proto.Merge(dst, src)
+	require.Equal(t, []byte{0xf8, 0x7f, 0x01, 0xf8, 0x7f, 0x02}, []byte(dst.ProtoReflect().GetUnknown()))
+	dst.ProtoReflect().SetUnknown(nil)
+	require.True(t, proto.Equal(&TestAllTypes{
+		SingularInt32:         1,
+		SingularString:        "src",
+		RepeatedInt32:         []int32{1, 2},
+		MapStringString:       map[string]string{"a": "dst", "b": "src"},
+		SingularNestedMessage: &TestAllTypes_NestedMessage{A: 1, Corecursive: &TestAllTypes{SingularInt32: 2}},
+		OneofField:            &TestAllTypes_OneofNestedMessage{OneofNestedMessage: &TestAllTypes_NestedMessage{A: 1, Corecursive: &TestAllTypes{}}},
+	}, dst))
+
+	// a different oneof member replaces the current one
+	proto.Merge(dst, &TestAllTypes{OneofField: &TestAllTypes_OneofString{OneofString: ""}})
+	require.Equal(t, "", dst.GetOneofString())
+	require.True(t, dst.ProtoReflect().Has(fd_TestAllTypes_oneof_string))
+
+	clone := proto.Clone(dst).(*TestAllTypes)
+	require.True(t, proto.Equal(dst, clone))
+	require.NotSame(t, dst.SingularNestedMessage, clone.SingularNestedMessage)
+}
+
+// TestUnmarshalMergesOccurrences checks that the occurrences of a singular message field are
+// merged, down to the messages they nest, which requires the options passed on to nested
+// messages to merge rather than reset them.
+func TestUnmarshalMergesOccurrences(t *testing.T) {
+	first, err := proto.Marshal(&TestAllTypes{
+		SingularNestedMessage: &TestAllTypes_NestedMessage{A: 1, Corecursive: &TestAllTypes{SingularInt32: 2}},
+		OneofField:            &TestAllTypes_OneofNestedMessage{OneofNestedMessage: &TestAllTypes_NestedMessage{A: 3}},
+	})
+	require.NoError(t, err)
+	second, err := proto.Marshal(&TestAllTypes{
+		SingularNestedMessage: &TestAllTypes_NestedMessage{Corecursive: &TestAllTypes{SingularString: "s"}},
+		OneofField:            &TestAllTypes_OneofNestedMessage{OneofNestedMessage: &TestAllTypes_NestedMessage{Corecursive: &TestAllTypes{}}},
+	})
+	require.NoError(t, err)
+	want := &TestAllTypes{
+		SingularNestedMessage: &TestAllTypes_NestedMessage{A: 1, Corecursive: &TestAllTypes{SingularInt32: 2, SingularString: "s"}},
+		OneofField:            &TestAllTypes_OneofNestedMessage{OneofNestedMessage: &TestAllTypes_NestedMessage{A: 3, Corecursive: &TestAllTypes{}}},
+	}
+
+	got := &TestAllTypes{}
+	require.NoError(t, proto.Unmarshal(append(first, second...), got))
+	require.True(t, proto.Equal(want, got), "%v", got)
+
+	dyn := dynamicpb.NewMessage(got.ProtoReflect().Descriptor())
+	require.NoError(t, proto.Unmarshal(append(first, second...), dyn))
+	require.True(t, proto.Equal(toDynamic(want.ProtoReflect()), dyn))
+}
@@ -0,0 +1,91 @@
+package test3
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/internal/fuzz"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"pgregory.net/rapid"
+)
+
+func TestProto3OptionalPresence(t *testing.T) {
+	msg := &TestProto3Optional{}
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+
+	// zero values of optional fields are populated once set
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !fd.HasOptionalKeyword() {
+			continue
+		}
+		require.False(t, m.Has(fd), fd.FullName())
+		require.Nil(t, m.WhichOneof(fd.ContainingOneof()), fd.FullName())
+		m.Set(fd, m.NewField(fd))
+		require.True(t, m.Has(fd), fd.FullName())
+		require.Equal(t, fd, m.WhichOneof(fd.ContainingOneof()), fd.FullName())
+	}
+
+	var ranged int
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		require.True(t, fd.HasOptionalKeyword(), fd.FullName())
+		ranged++
+		return true
+	})
+	require.Equal(t, 17, ranged)
+
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	got := &TestProto3Optional{}
+	require.NoError(t, proto.Unmarshal(bz, got))
+	require.True(t, proto.Equal(msg, got))
+	require.NotNil(t, got.OptionalInt32)
+	require.NotNil(t, got.OptionalBytes)
+	require.NotNil(t, got.OptionalForeignEnum)
+	require.Nil(t, got.OneofField)
+
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !fd.HasOptionalKeyword() {
+			continue
+		}
+		m.Clear(fd)
+		require.False(t, m.Has(fd), fd.FullName())
+		require.Nil(t, m.WhichOneof(fd.ContainingOneof()), fd.FullName())
+	}
+	require.Zero(t, proto.Size(msg))
+}
+
+func TestProto3OptionalMarshalUnmarshal(t *testing.T) {
+	t.Run("marshal unmarshal", rapid.MakeCheck(func(t *rapid.T) {
+		mType := (&TestProto3Optional{}).ProtoReflect().Type()
+		msg := fuzz.Message(t, mType)
+
+		msgBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Interface())
+		require.NoError(t, err)
+
+		uMsg := mType.New()
+		require.NoError(t, proto.Unmarshal(msgBytes, uMsg.Interface()))
+		diff := cmp.Diff(uMsg.Interface(), msg.Interface(), protocmp.Transform())
+		require.Emptyf(t, diff, "non matching messages\n%s", diff)
+	}))
+
+	t.Run("matches reflection codec", rapid.MakeCheck(func(t *rapid.T) {
+		mType := (&TestProto3Optional{}).ProtoReflect().Type()
+		msg := fuzz.Message(t, mType)
+
+		msgBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Interface())
+		require.NoError(t, err)
+
+		dynMsg := dynamicpb.NewMessage(mType.Descriptor())
+		require.NoError(t, proto.Unmarshal(msgBytes, dynMsg))
+		dynBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(dynMsg)
+		require.NoError(t, err)
+		require.Equal(t, dynBytes, msgBytes)
+	}))
+}
@@ -0,0 +1,129 @@
+package test3
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"pgregory.net/rapid"
+)
+
+// nestedTestAllTypes returns the encoding of a TestAllTypes nesting messages on the given number
+// of levels, itself included, alternating TestAllTypes and their corecursive NestedMessage.
+// The encoding is written backwards, so that deep messages are built in linear time.
+func nestedTestAllTypes(levels int) []byte {
+	b := make([]byte, 8*levels)
+	i := len(b)
+	for level := levels; level > 1; level-- {
+		l := len(b) - i
+		num := protowire.Number(98) // singular_nested_message of TestAllTypes
+		if level%2 == 1 {
+			num = 2 // corecursive of NestedMessage
+		}
+		prefix := protowire.AppendVarint(protowire.AppendTag(nil, num, protowire.BytesType), uint64(l))
+		i -= len(prefix)
+		copy(b[i:], prefix)
+	}
+	return b[i:]
+}
+
+// nestedLazy returns the encoding of a TestLazyNesting nesting messages on the given number of
+// levels, itself included, alternately through its lazy and eager fields.
+func nestedLazy(levels int) []byte {
+	b := make([]byte, 8*levels)
+	i := len(b)
+	for level := levels; level > 1; level-- {
+		l := len(b) - i
+		num := protowire.Number(1) // lazy
+		if level%2 == 1 {
+			num = 2 // eager
+		}
+		prefix := protowire.AppendVarint(protowire.AppendTag(nil, num, protowire.BytesType), uint64(l))
+		i -= len(prefix)
+		copy(b[i:], prefix)
+	}
+	return b[i:]
+}
+
+// lazyLevels returns the number of levels nested in m, itself included, decoding its lazy fields.
+func lazyLevels(m *TestLazyNesting) int {
+	levels := 0
+	for m != nil {
+		levels++
+		if lazy := m.GetLazy(); lazy != nil {
+			m = lazy
+		} else {
+			m = m.GetEager()
+		}
+	}
+	return levels
+}
+
+func TestRecursionLimit(t *testing.T) {
+	t.Run("matches protobuf-go", rapid.MakeCheck(func(t *rapid.T) {
+		levels := rapid.IntRange(1, 64).Draw(t, "levels").(int)
+		limit := rapid.IntRange(1, 64).Draw(t, "limit").(int)
+		b := nestedTestAllTypes(levels)
+		options := proto.UnmarshalOptions{RecursionLimit: limit}
+
+		// the reflection based codec of dynamicpb acts as the reference implementation
+		wantErr := options.Unmarshal(b, dynamicpb.NewMessage(md_TestAllTypes))
+		err := options.Unmarshal(b, &TestAllTypes{})
+		require.Equal(t, wantErr != nil, err != nil, "%v, %v", wantErr, err)
+		require.Equal(t, levels > limit, err != nil)
+		if err != nil {
+			var limitErr *runtime.RecursionLimitError
+			require.ErrorAs(t, err, &limitErr)
+			require.ErrorIs(t, err, proto.Error)
+		}
+	}))
+
+	t.Run("default limit", func(t *testing.T) {
+		require.NoError(t, proto.Unmarshal(nestedTestAllTypes(protowire.DefaultRecursionLimit), &TestAllTypes{}))
+
+		// deep payloads fail instead of overflowing the stack
+		err := proto.Unmarshal(nestedTestAllTypes(1_000_000), &TestAllTypes{})
+		var limitErr *runtime.RecursionLimitError
+		require.ErrorAs(t, err, &limitErr)
+		require.Contains(t, err.Error(), "exceeded maximum recursion depth")
+	})
+
+	t.Run("lazy fields", rapid.MakeCheck(func(t *rapid.T) {
+		levels := rapid.IntRange(1, 64).Draw(t, "levels").(int)
+		limit := rapid.IntRange(1, 64).Draw(t, "limit").(int)
+		options := proto.UnmarshalOptions{RecursionLimit: limit}
+
+		// lazy fields exceeding the limit fail the unmarshal instead of their later decoding
+		m := &TestLazyNesting{}
+		err := options.Unmarshal(nestedLazy(levels), m)
+		require.Equal(t, levels > limit, err != nil, "%v", err)
+		if err != nil {
+			var limitErr *runtime.RecursionLimitError
+			require.ErrorAs(t, err, &limitErr)
+			return
+		}
+		require.Equal(t, levels, lazyLevels(m))
+	}))
+
+	t.Run("lazy fields with the default limit", func(t *testing.T) {
+		m := &TestLazyNesting{}
+		require.NoError(t, proto.Unmarshal(nestedLazy(protowire.DefaultRecursionLimit), m))
+		require.Equal(t, protowire.DefaultRecursionLimit, lazyLevels(m))
+
+		err := proto.Unmarshal(nestedLazy(protowire.DefaultRecursionLimit+20), &TestLazyNesting{})
+		var limitErr *runtime.RecursionLimitError
+		require.ErrorAs(t, err, &limitErr)
+	})
+
+	t.Run("direct calls", func(t *testing.T) {
+		// inputs built by hand without a depth get the default limit
+		methods := (&TestAllTypes{}).ProtoReflect().ProtoMethods()
+		msg := &TestAllTypes{}
+		_, err := methods.Unmarshal(protoiface.UnmarshalInput{Message: msg.ProtoReflect(), Buf: nestedTestAllTypes(100)})
+		require.NoError(t, err)
+	})
+}
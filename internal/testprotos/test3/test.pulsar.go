@@ -2,19 +2,727 @@
 package test3
 
 import (
+	bytes "bytes"
 	binary "encoding/binary"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	proto "google.golang.org/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
+	maps "maps"
 	math "math"
 	reflect "reflect"
+	slices "slices"
 	sort "sort"
+	strconv "strconv"
 	sync "sync"
+	atomic "sync/atomic"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
 )
 
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestAllTypes) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestAllTypes) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if len(x.MapBoolBool) > 0 {
+		b = append(b, "\"map_bool_bool\":"...)
+		b = append(b, '{')
+		for _, k := range [...]bool{false, true} {
+			if _, ok := x.MapBoolBool[k]; !ok {
+				continue
+			}
+			v := x.MapBoolBool[k]
+			b = append(b, '"')
+			b = strconv.AppendBool(b, k)
+			b = append(b, '"')
+			b = append(b, ':')
+			b = strconv.AppendBool(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapFixed32Fixed32) > 0 {
+		b = append(b, "\"map_fixed32_fixed32\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.MapFixed32Fixed32) {
+			v := x.MapFixed32Fixed32[k]
+			b = runtime.AppendJSONUint64(b, uint64(k))
+			b = append(b, ':')
+			b = strconv.AppendUint(b, uint64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapFixed64Fixed64) > 0 {
+		b = append(b, "\"map_fixed64_fixed64\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.MapFixed64Fixed64) {
+			v := x.MapFixed64Fixed64[k]
+			b = runtime.AppendJSONUint64(b, uint64(k))
+			b = append(b, ':')
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapInt32Double) > 0 {
+		b = append(b, "\"map_int32_double\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.MapInt32Double) {
+			v := x.MapInt32Double[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			if b, err = runtime.AppendAminoJSONFloat(b, v, 64); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapInt32Float) > 0 {
+		b = append(b, "\"map_int32_float\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.MapInt32Float) {
+			v := x.MapInt32Float[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			if b, err = runtime.AppendAminoJSONFloat(b, float64(v), 32); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapInt32Int32) > 0 {
+		b = append(b, "\"map_int32_int32\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.MapInt32Int32) {
+			v := x.MapInt32Int32[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapInt64Int64) > 0 {
+		b = append(b, "\"map_int64_int64\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.MapInt64Int64) {
+			v := x.MapInt64Int64[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapSfixed32Sfixed32) > 0 {
+		b = append(b, "\"map_sfixed32_sfixed32\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.MapSfixed32Sfixed32) {
+			v := x.MapSfixed32Sfixed32[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapSfixed64Sfixed64) > 0 {
+		b = append(b, "\"map_sfixed64_sfixed64\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.MapSfixed64Sfixed64) {
+			v := x.MapSfixed64Sfixed64[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapSint32Sint32) > 0 {
+		b = append(b, "\"map_sint32_sint32\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.MapSint32Sint32) {
+			v := x.MapSint32Sint32[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapSint64Sint64) > 0 {
+		b = append(b, "\"map_sint64_sint64\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.MapSint64Sint64) {
+			v := x.MapSint64Sint64[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringBytes) > 0 {
+		b = append(b, "\"map_string_bytes\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringBytes)) {
+			v := x.MapStringBytes[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			b = runtime.AppendJSONBytes(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringNestedEnum) > 0 {
+		b = append(b, "\"map_string_nested_enum\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringNestedEnum)) {
+			v := x.MapStringNestedEnum[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringNestedMessage) > 0 {
+		b = append(b, "\"map_string_nested_message\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringNestedMessage)) {
+			v := x.MapStringNestedMessage[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringString) > 0 {
+		b = append(b, "\"map_string_string\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringString)) {
+			v := x.MapStringString[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			b = runtime.AppendAminoJSONString(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapUint32Uint32) > 0 {
+		b = append(b, "\"map_uint32_uint32\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.MapUint32Uint32) {
+			v := x.MapUint32Uint32[k]
+			b = runtime.AppendJSONUint64(b, uint64(k))
+			b = append(b, ':')
+			b = strconv.AppendUint(b, uint64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapUint64Uint64) > 0 {
+		b = append(b, "\"map_uint64_uint64\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.MapUint64Uint64) {
+			v := x.MapUint64Uint64[k]
+			b = runtime.AppendJSONUint64(b, uint64(k))
+			b = append(b, ':')
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofBool); ok {
+		b = append(b, "\"oneof_bool\":"...)
+		b = strconv.AppendBool(b, v.OneofBool)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofBytes); ok {
+		b = append(b, "\"oneof_bytes\":"...)
+		b = runtime.AppendJSONBytes(b, v.OneofBytes)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofDouble); ok {
+		b = append(b, "\"oneof_double\":"...)
+		if b, err = runtime.AppendAminoJSONFloat(b, v.OneofDouble, 64); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofEnum); ok {
+		b = append(b, "\"oneof_enum\":"...)
+		b = strconv.AppendInt(b, int64(v.OneofEnum), 10)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofFloat); ok {
+		b = append(b, "\"oneof_float\":"...)
+		if b, err = runtime.AppendAminoJSONFloat(b, float64(v.OneofFloat), 32); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); ok {
+		b = append(b, "\"oneof_nested_message\":"...)
+		if b, err = v.OneofNestedMessage.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofString); ok {
+		b = append(b, "\"oneof_string\":"...)
+		b = runtime.AppendAminoJSONString(b, v.OneofString)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofUint32); ok {
+		b = append(b, "\"oneof_uint32\":"...)
+		b = strconv.AppendUint(b, uint64(v.OneofUint32), 10)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofUint64); ok {
+		b = append(b, "\"oneof_uint64\":"...)
+		b = runtime.AppendJSONUint64(b, v.OneofUint64)
+		b = append(b, ',')
+	}
+	if len(x.RepeatedBool) > 0 {
+		b = append(b, "\"repeated_bool\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedBool {
+			b = strconv.AppendBool(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedBytes) > 0 {
+		b = append(b, "\"repeated_bytes\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedBytes {
+			b = runtime.AppendJSONBytes(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedDouble) > 0 {
+		b = append(b, "\"repeated_double\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedDouble {
+			if b, err = runtime.AppendAminoJSONFloat(b, v, 64); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFixed32) > 0 {
+		b = append(b, "\"repeated_fixed32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFixed32 {
+			b = strconv.AppendUint(b, uint64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFixed64) > 0 {
+		b = append(b, "\"repeated_fixed64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFixed64 {
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFloat) > 0 {
+		b = append(b, "\"repeated_float\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFloat {
+			if b, err = runtime.AppendAminoJSONFloat(b, float64(v), 32); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedForeignEnum) > 0 {
+		b = append(b, "\"repeated_foreign_enum\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedForeignEnum {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedForeignMessage) > 0 {
+		b = append(b, "\"repeated_foreign_message\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedForeignMessage {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedImportenum) > 0 {
+		b = append(b, "\"repeated_importenum\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedImportenum {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedImportmessage) > 0 {
+		b = append(b, "\"repeated_importmessage\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedImportmessage {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedInt32) > 0 {
+		b = append(b, "\"repeated_int32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedInt32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedInt64) > 0 {
+		b = append(b, "\"repeated_int64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedInt64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedNestedEnum) > 0 {
+		b = append(b, "\"repeated_nested_enum\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedNestedEnum {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedNestedMessage) > 0 {
+		b = append(b, "\"repeated_nested_message\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedNestedMessage {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSfixed32) > 0 {
+		b = append(b, "\"repeated_sfixed32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSfixed32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSfixed64) > 0 {
+		b = append(b, "\"repeated_sfixed64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSfixed64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSint32) > 0 {
+		b = append(b, "\"repeated_sint32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSint32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSint64) > 0 {
+		b = append(b, "\"repeated_sint64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSint64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedString) > 0 {
+		b = append(b, "\"repeated_string\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedString {
+			b = runtime.AppendAminoJSONString(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedUint32) > 0 {
+		b = append(b, "\"repeated_uint32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedUint32 {
+			b = strconv.AppendUint(b, uint64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedUint64) > 0 {
+		b = append(b, "\"repeated_uint64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedUint64 {
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if x.SingularBool {
+		b = append(b, "\"singular_bool\":"...)
+		b = strconv.AppendBool(b, x.SingularBool)
+		b = append(b, ',')
+	}
+	if len(x.SingularBytes) > 0 {
+		b = append(b, "\"singular_bytes\":"...)
+		b = runtime.AppendJSONBytes(b, x.SingularBytes)
+		b = append(b, ',')
+	}
+	if x.SingularDouble != 0 {
+		b = append(b, "\"singular_double\":"...)
+		if b, err = runtime.AppendAminoJSONFloat(b, x.SingularDouble, 64); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.SingularFixed32 != 0 {
+		b = append(b, "\"singular_fixed32\":"...)
+		b = strconv.AppendUint(b, uint64(x.SingularFixed32), 10)
+		b = append(b, ',')
+	}
+	if x.SingularFixed64 != 0 {
+		b = append(b, "\"singular_fixed64\":"...)
+		b = runtime.AppendJSONUint64(b, x.SingularFixed64)
+		b = append(b, ',')
+	}
+	if x.SingularFloat != 0 {
+		b = append(b, "\"singular_float\":"...)
+		if b, err = runtime.AppendAminoJSONFloat(b, float64(x.SingularFloat), 32); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.SingularForeignEnum != 0 {
+		b = append(b, "\"singular_foreign_enum\":"...)
+		b = strconv.AppendInt(b, int64(x.SingularForeignEnum), 10)
+		b = append(b, ',')
+	}
+	if x.SingularForeignMessage != nil {
+		b = append(b, "\"singular_foreign_message\":"...)
+		if b, err = x.SingularForeignMessage.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.SingularImportEnum != 0 {
+		b = append(b, "\"singular_import_enum\":"...)
+		b = strconv.AppendInt(b, int64(x.SingularImportEnum), 10)
+		b = append(b, ',')
+	}
+	if x.SingularImportMessage != nil {
+		b = append(b, "\"singular_import_message\":"...)
+		if b, err = x.SingularImportMessage.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.SingularInt32 != 0 {
+		b = append(b, "\"singular_int32\":"...)
+		b = strconv.AppendInt(b, int64(x.SingularInt32), 10)
+		b = append(b, ',')
+	}
+	if x.SingularInt64 != 0 {
+		b = append(b, "\"singular_int64\":"...)
+		b = runtime.AppendJSONInt64(b, x.SingularInt64)
+		b = append(b, ',')
+	}
+	if x.SingularNestedEnum != 0 {
+		b = append(b, "\"singular_nested_enum\":"...)
+		b = strconv.AppendInt(b, int64(x.SingularNestedEnum), 10)
+		b = append(b, ',')
+	}
+	if x.SingularNestedMessage != nil {
+		b = append(b, "\"singular_nested_message\":"...)
+		if b, err = x.SingularNestedMessage.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.SingularSfixed32 != 0 {
+		b = append(b, "\"singular_sfixed32\":"...)
+		b = strconv.AppendInt(b, int64(x.SingularSfixed32), 10)
+		b = append(b, ',')
+	}
+	if x.SingularSfixed64 != 0 {
+		b = append(b, "\"singular_sfixed64\":"...)
+		b = runtime.AppendJSONInt64(b, x.SingularSfixed64)
+		b = append(b, ',')
+	}
+	if x.SingularSint32 != 0 {
+		b = append(b, "\"singular_sint32\":"...)
+		b = strconv.AppendInt(b, int64(x.SingularSint32), 10)
+		b = append(b, ',')
+	}
+	if x.SingularSint64 != 0 {
+		b = append(b, "\"singular_sint64\":"...)
+		b = runtime.AppendJSONInt64(b, x.SingularSint64)
+		b = append(b, ',')
+	}
+	if x.SingularString != "" {
+		b = append(b, "\"singular_string\":"...)
+		b = runtime.AppendAminoJSONString(b, x.SingularString)
+		b = append(b, ',')
+	}
+	if x.SingularUint32 != 0 {
+		b = append(b, "\"singular_uint32\":"...)
+		b = strconv.AppendUint(b, uint64(x.SingularUint32), 10)
+		b = append(b, ',')
+	}
+	if x.SingularUint64 != 0 {
+		b = append(b, "\"singular_uint64\":"...)
+		b = runtime.AppendJSONUint64(b, x.SingularUint64)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestAllTypes_NestedMessage) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestAllTypes_NestedMessage) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.A != 0 {
+		b = append(b, "\"a\":"...)
+		b = strconv.AppendInt(b, int64(x.A), 10)
+		b = append(b, ',')
+	}
+	if x.Corecursive != nil {
+		b = append(b, "\"corecursive\":"...)
+		if b, err = x.Corecursive.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *ForeignMessage) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *ForeignMessage) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.C != 0 {
+		b = append(b, "\"c\":"...)
+		b = strconv.AppendInt(b, int64(x.C), 10)
+		b = append(b, ',')
+	}
+	if x.D != 0 {
+		b = append(b, "\"d\":"...)
+		b = strconv.AppendInt(b, int64(x.D), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
 var _ protoreflect.List = (*_TestAllTypes_31_list)(nil)
 
 type _TestAllTypes_31_list struct {
@@ -3034,126 +3742,306 @@ func (x *fastReflection_TestAllTypes) Range(f func(protoreflect.FieldDescriptor,
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_TestAllTypes) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "goproto.proto.test3.TestAllTypes.singular_int32":
+	switch fd.Number() {
+	case 81: // goproto.proto.test3.TestAllTypes.singular_int32
+		if fd != fd_TestAllTypes_singular_int32 {
+			break
+		}
 		return x.SingularInt32 != int32(0)
-	case "goproto.proto.test3.TestAllTypes.singular_int64":
+	case 82: // goproto.proto.test3.TestAllTypes.singular_int64
+		if fd != fd_TestAllTypes_singular_int64 {
+			break
+		}
 		return x.SingularInt64 != int64(0)
-	case "goproto.proto.test3.TestAllTypes.singular_uint32":
+	case 83: // goproto.proto.test3.TestAllTypes.singular_uint32
+		if fd != fd_TestAllTypes_singular_uint32 {
+			break
+		}
 		return x.SingularUint32 != uint32(0)
-	case "goproto.proto.test3.TestAllTypes.singular_uint64":
+	case 84: // goproto.proto.test3.TestAllTypes.singular_uint64
+		if fd != fd_TestAllTypes_singular_uint64 {
+			break
+		}
 		return x.SingularUint64 != uint64(0)
-	case "goproto.proto.test3.TestAllTypes.singular_sint32":
+	case 85: // goproto.proto.test3.TestAllTypes.singular_sint32
+		if fd != fd_TestAllTypes_singular_sint32 {
+			break
+		}
 		return x.SingularSint32 != int32(0)
-	case "goproto.proto.test3.TestAllTypes.singular_sint64":
+	case 86: // goproto.proto.test3.TestAllTypes.singular_sint64
+		if fd != fd_TestAllTypes_singular_sint64 {
+			break
+		}
 		return x.SingularSint64 != int64(0)
-	case "goproto.proto.test3.TestAllTypes.singular_fixed32":
+	case 87: // goproto.proto.test3.TestAllTypes.singular_fixed32
+		if fd != fd_TestAllTypes_singular_fixed32 {
+			break
+		}
 		return x.SingularFixed32 != uint32(0)
-	case "goproto.proto.test3.TestAllTypes.singular_fixed64":
+	case 88: // goproto.proto.test3.TestAllTypes.singular_fixed64
+		if fd != fd_TestAllTypes_singular_fixed64 {
+			break
+		}
 		return x.SingularFixed64 != uint64(0)
-	case "goproto.proto.test3.TestAllTypes.singular_sfixed32":
+	case 89: // goproto.proto.test3.TestAllTypes.singular_sfixed32
+		if fd != fd_TestAllTypes_singular_sfixed32 {
+			break
+		}
 		return x.SingularSfixed32 != int32(0)
-	case "goproto.proto.test3.TestAllTypes.singular_sfixed64":
+	case 90: // goproto.proto.test3.TestAllTypes.singular_sfixed64
+		if fd != fd_TestAllTypes_singular_sfixed64 {
+			break
+		}
 		return x.SingularSfixed64 != int64(0)
-	case "goproto.proto.test3.TestAllTypes.singular_float":
+	case 91: // goproto.proto.test3.TestAllTypes.singular_float
+		if fd != fd_TestAllTypes_singular_float {
+			break
+		}
 		return x.SingularFloat != float32(0) || math.Signbit(float64(x.SingularFloat))
-	case "goproto.proto.test3.TestAllTypes.singular_double":
+	case 92: // goproto.proto.test3.TestAllTypes.singular_double
+		if fd != fd_TestAllTypes_singular_double {
+			break
+		}
 		return x.SingularDouble != float64(0) || math.Signbit(x.SingularDouble)
-	case "goproto.proto.test3.TestAllTypes.singular_bool":
+	case 93: // goproto.proto.test3.TestAllTypes.singular_bool
+		if fd != fd_TestAllTypes_singular_bool {
+			break
+		}
 		return x.SingularBool != false
-	case "goproto.proto.test3.TestAllTypes.singular_string":
+	case 94: // goproto.proto.test3.TestAllTypes.singular_string
+		if fd != fd_TestAllTypes_singular_string {
+			break
+		}
 		return x.SingularString != ""
-	case "goproto.proto.test3.TestAllTypes.singular_bytes":
+	case 95: // goproto.proto.test3.TestAllTypes.singular_bytes
+		if fd != fd_TestAllTypes_singular_bytes {
+			break
+		}
 		return len(x.SingularBytes) != 0
-	case "goproto.proto.test3.TestAllTypes.singular_nested_message":
+	case 98: // goproto.proto.test3.TestAllTypes.singular_nested_message
+		if fd != fd_TestAllTypes_singular_nested_message {
+			break
+		}
 		return x.SingularNestedMessage != nil
-	case "goproto.proto.test3.TestAllTypes.singular_foreign_message":
+	case 99: // goproto.proto.test3.TestAllTypes.singular_foreign_message
+		if fd != fd_TestAllTypes_singular_foreign_message {
+			break
+		}
 		return x.SingularForeignMessage != nil
-	case "goproto.proto.test3.TestAllTypes.singular_import_message":
+	case 100: // goproto.proto.test3.TestAllTypes.singular_import_message
+		if fd != fd_TestAllTypes_singular_import_message {
+			break
+		}
 		return x.SingularImportMessage != nil
-	case "goproto.proto.test3.TestAllTypes.singular_nested_enum":
+	case 101: // goproto.proto.test3.TestAllTypes.singular_nested_enum
+		if fd != fd_TestAllTypes_singular_nested_enum {
+			break
+		}
 		return x.SingularNestedEnum != 0
-	case "goproto.proto.test3.TestAllTypes.singular_foreign_enum":
+	case 102: // goproto.proto.test3.TestAllTypes.singular_foreign_enum
+		if fd != fd_TestAllTypes_singular_foreign_enum {
+			break
+		}
 		return x.SingularForeignEnum != 0
-	case "goproto.proto.test3.TestAllTypes.singular_import_enum":
+	case 103: // goproto.proto.test3.TestAllTypes.singular_import_enum
+		if fd != fd_TestAllTypes_singular_import_enum {
+			break
+		}
 		return x.SingularImportEnum != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_int32":
+	case 31: // goproto.proto.test3.TestAllTypes.repeated_int32
+		if fd != fd_TestAllTypes_repeated_int32 {
+			break
+		}
 		return len(x.RepeatedInt32) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_int64":
+	case 32: // goproto.proto.test3.TestAllTypes.repeated_int64
+		if fd != fd_TestAllTypes_repeated_int64 {
+			break
+		}
 		return len(x.RepeatedInt64) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_uint32":
+	case 33: // goproto.proto.test3.TestAllTypes.repeated_uint32
+		if fd != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
 		return len(x.RepeatedUint32) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_uint64":
+	case 34: // goproto.proto.test3.TestAllTypes.repeated_uint64
+		if fd != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
 		return len(x.RepeatedUint64) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_sint32":
+	case 35: // goproto.proto.test3.TestAllTypes.repeated_sint32
+		if fd != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
 		return len(x.RepeatedSint32) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_sint64":
+	case 36: // goproto.proto.test3.TestAllTypes.repeated_sint64
+		if fd != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
 		return len(x.RepeatedSint64) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_fixed32":
+	case 37: // goproto.proto.test3.TestAllTypes.repeated_fixed32
+		if fd != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
 		return len(x.RepeatedFixed32) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_fixed64":
+	case 38: // goproto.proto.test3.TestAllTypes.repeated_fixed64
+		if fd != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
 		return len(x.RepeatedFixed64) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_sfixed32":
+	case 39: // goproto.proto.test3.TestAllTypes.repeated_sfixed32
+		if fd != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
 		return len(x.RepeatedSfixed32) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_sfixed64":
+	case 40: // goproto.proto.test3.TestAllTypes.repeated_sfixed64
+		if fd != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
 		return len(x.RepeatedSfixed64) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_float":
+	case 41: // goproto.proto.test3.TestAllTypes.repeated_float
+		if fd != fd_TestAllTypes_repeated_float {
+			break
+		}
 		return len(x.RepeatedFloat) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_double":
+	case 42: // goproto.proto.test3.TestAllTypes.repeated_double
+		if fd != fd_TestAllTypes_repeated_double {
+			break
+		}
 		return len(x.RepeatedDouble) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_bool":
+	case 43: // goproto.proto.test3.TestAllTypes.repeated_bool
+		if fd != fd_TestAllTypes_repeated_bool {
+			break
+		}
 		return len(x.RepeatedBool) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_string":
+	case 44: // goproto.proto.test3.TestAllTypes.repeated_string
+		if fd != fd_TestAllTypes_repeated_string {
+			break
+		}
 		return len(x.RepeatedString) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_bytes":
+	case 45: // goproto.proto.test3.TestAllTypes.repeated_bytes
+		if fd != fd_TestAllTypes_repeated_bytes {
+			break
+		}
 		return len(x.RepeatedBytes) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_nested_message":
+	case 48: // goproto.proto.test3.TestAllTypes.repeated_nested_message
+		if fd != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
 		return len(x.RepeatedNestedMessage) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_foreign_message":
+	case 49: // goproto.proto.test3.TestAllTypes.repeated_foreign_message
+		if fd != fd_TestAllTypes_repeated_foreign_message {
+			break
+		}
 		return len(x.RepeatedForeignMessage) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_importmessage":
+	case 50: // goproto.proto.test3.TestAllTypes.repeated_importmessage
+		if fd != fd_TestAllTypes_repeated_importmessage {
+			break
+		}
 		return len(x.RepeatedImportmessage) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_nested_enum":
+	case 51: // goproto.proto.test3.TestAllTypes.repeated_nested_enum
+		if fd != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
 		return len(x.RepeatedNestedEnum) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_foreign_enum":
+	case 52: // goproto.proto.test3.TestAllTypes.repeated_foreign_enum
+		if fd != fd_TestAllTypes_repeated_foreign_enum {
+			break
+		}
 		return len(x.RepeatedForeignEnum) != 0
-	case "goproto.proto.test3.TestAllTypes.repeated_importenum":
+	case 53: // goproto.proto.test3.TestAllTypes.repeated_importenum
+		if fd != fd_TestAllTypes_repeated_importenum {
+			break
+		}
 		return len(x.RepeatedImportenum) != 0
-	case "goproto.proto.test3.TestAllTypes.map_int32_int32":
+	case 56: // goproto.proto.test3.TestAllTypes.map_int32_int32
+		if fd != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
 		return len(x.MapInt32Int32) != 0
-	case "goproto.proto.test3.TestAllTypes.map_int64_int64":
+	case 57: // goproto.proto.test3.TestAllTypes.map_int64_int64
+		if fd != fd_TestAllTypes_map_int64_int64 {
+			break
+		}
 		return len(x.MapInt64Int64) != 0
-	case "goproto.proto.test3.TestAllTypes.map_uint32_uint32":
+	case 58: // goproto.proto.test3.TestAllTypes.map_uint32_uint32
+		if fd != fd_TestAllTypes_map_uint32_uint32 {
+			break
+		}
 		return len(x.MapUint32Uint32) != 0
-	case "goproto.proto.test3.TestAllTypes.map_uint64_uint64":
+	case 59: // goproto.proto.test3.TestAllTypes.map_uint64_uint64
+		if fd != fd_TestAllTypes_map_uint64_uint64 {
+			break
+		}
 		return len(x.MapUint64Uint64) != 0
-	case "goproto.proto.test3.TestAllTypes.map_sint32_sint32":
+	case 60: // goproto.proto.test3.TestAllTypes.map_sint32_sint32
+		if fd != fd_TestAllTypes_map_sint32_sint32 {
+			break
+		}
 		return len(x.MapSint32Sint32) != 0
-	case "goproto.proto.test3.TestAllTypes.map_sint64_sint64":
+	case 61: // goproto.proto.test3.TestAllTypes.map_sint64_sint64
+		if fd != fd_TestAllTypes_map_sint64_sint64 {
+			break
+		}
 		return len(x.MapSint64Sint64) != 0
-	case "goproto.proto.test3.TestAllTypes.map_fixed32_fixed32":
+	case 62: // goproto.proto.test3.TestAllTypes.map_fixed32_fixed32
+		if fd != fd_TestAllTypes_map_fixed32_fixed32 {
+			break
+		}
 		return len(x.MapFixed32Fixed32) != 0
-	case "goproto.proto.test3.TestAllTypes.map_fixed64_fixed64":
+	case 63: // goproto.proto.test3.TestAllTypes.map_fixed64_fixed64
+		if fd != fd_TestAllTypes_map_fixed64_fixed64 {
+			break
+		}
 		return len(x.MapFixed64Fixed64) != 0
-	case "goproto.proto.test3.TestAllTypes.map_sfixed32_sfixed32":
+	case 64: // goproto.proto.test3.TestAllTypes.map_sfixed32_sfixed32
+		if fd != fd_TestAllTypes_map_sfixed32_sfixed32 {
+			break
+		}
 		return len(x.MapSfixed32Sfixed32) != 0
-	case "goproto.proto.test3.TestAllTypes.map_sfixed64_sfixed64":
+	case 65: // goproto.proto.test3.TestAllTypes.map_sfixed64_sfixed64
+		if fd != fd_TestAllTypes_map_sfixed64_sfixed64 {
+			break
+		}
 		return len(x.MapSfixed64Sfixed64) != 0
-	case "goproto.proto.test3.TestAllTypes.map_int32_float":
+	case 66: // goproto.proto.test3.TestAllTypes.map_int32_float
+		if fd != fd_TestAllTypes_map_int32_float {
+			break
+		}
 		return len(x.MapInt32Float) != 0
-	case "goproto.proto.test3.TestAllTypes.map_int32_double":
+	case 67: // goproto.proto.test3.TestAllTypes.map_int32_double
+		if fd != fd_TestAllTypes_map_int32_double {
+			break
+		}
 		return len(x.MapInt32Double) != 0
-	case "goproto.proto.test3.TestAllTypes.map_bool_bool":
+	case 68: // goproto.proto.test3.TestAllTypes.map_bool_bool
+		if fd != fd_TestAllTypes_map_bool_bool {
+			break
+		}
 		return len(x.MapBoolBool) != 0
-	case "goproto.proto.test3.TestAllTypes.map_string_string":
+	case 69: // goproto.proto.test3.TestAllTypes.map_string_string
+		if fd != fd_TestAllTypes_map_string_string {
+			break
+		}
 		return len(x.MapStringString) != 0
-	case "goproto.proto.test3.TestAllTypes.map_string_bytes":
+	case 70: // goproto.proto.test3.TestAllTypes.map_string_bytes
+		if fd != fd_TestAllTypes_map_string_bytes {
+			break
+		}
 		return len(x.MapStringBytes) != 0
-	case "goproto.proto.test3.TestAllTypes.map_string_nested_message":
+	case 71: // goproto.proto.test3.TestAllTypes.map_string_nested_message
+		if fd != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
 		return len(x.MapStringNestedMessage) != 0
-	case "goproto.proto.test3.TestAllTypes.map_string_nested_enum":
+	case 73: // goproto.proto.test3.TestAllTypes.map_string_nested_enum
+		if fd != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
 		return len(x.MapStringNestedEnum) != 0
-	case "goproto.proto.test3.TestAllTypes.oneof_uint32":
+	case 111: // goproto.proto.test3.TestAllTypes.oneof_uint32
+		if fd != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
 		if x.OneofField == nil {
 			return false
 		} else if _, ok := x.OneofField.(*TestAllTypes_OneofUint32); ok {
@@ -3161,7 +4049,10 @@ func (x *fastReflection_TestAllTypes) Has(fd protoreflect.FieldDescriptor) bool
 		} else {
 			return false
 		}
-	case "goproto.proto.test3.TestAllTypes.oneof_nested_message":
+	case 112: // goproto.proto.test3.TestAllTypes.oneof_nested_message
+		if fd != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
 		if x.OneofField == nil {
 			return false
 		} else if _, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); ok {
@@ -3169,7 +4060,10 @@ func (x *fastReflection_TestAllTypes) Has(fd protoreflect.FieldDescriptor) bool
 		} else {
 			return false
 		}
-	case "goproto.proto.test3.TestAllTypes.oneof_string":
+	case 113: // goproto.proto.test3.TestAllTypes.oneof_string
+		if fd != fd_TestAllTypes_oneof_string {
+			break
+		}
 		if x.OneofField == nil {
 			return false
 		} else if _, ok := x.OneofField.(*TestAllTypes_OneofString); ok {
@@ -3177,7 +4071,10 @@ func (x *fastReflection_TestAllTypes) Has(fd protoreflect.FieldDescriptor) bool
 		} else {
 			return false
 		}
-	case "goproto.proto.test3.TestAllTypes.oneof_bytes":
+	case 114: // goproto.proto.test3.TestAllTypes.oneof_bytes
+		if fd != fd_TestAllTypes_oneof_bytes {
+			break
+		}
 		if x.OneofField == nil {
 			return false
 		} else if _, ok := x.OneofField.(*TestAllTypes_OneofBytes); ok {
@@ -3185,7 +4082,10 @@ func (x *fastReflection_TestAllTypes) Has(fd protoreflect.FieldDescriptor) bool
 		} else {
 			return false
 		}
-	case "goproto.proto.test3.TestAllTypes.oneof_bool":
+	case 115: // goproto.proto.test3.TestAllTypes.oneof_bool
+		if fd != fd_TestAllTypes_oneof_bool {
+			break
+		}
 		if x.OneofField == nil {
 			return false
 		} else if _, ok := x.OneofField.(*TestAllTypes_OneofBool); ok {
@@ -3193,7 +4093,10 @@ func (x *fastReflection_TestAllTypes) Has(fd protoreflect.FieldDescriptor) bool
 		} else {
 			return false
 		}
-	case "goproto.proto.test3.TestAllTypes.oneof_uint64":
+	case 116: // goproto.proto.test3.TestAllTypes.oneof_uint64
+		if fd != fd_TestAllTypes_oneof_uint64 {
+			break
+		}
 		if x.OneofField == nil {
 			return false
 		} else if _, ok := x.OneofField.(*TestAllTypes_OneofUint64); ok {
@@ -3201,7 +4104,10 @@ func (x *fastReflection_TestAllTypes) Has(fd protoreflect.FieldDescriptor) bool
 		} else {
 			return false
 		}
-	case "goproto.proto.test3.TestAllTypes.oneof_float":
+	case 117: // goproto.proto.test3.TestAllTypes.oneof_float
+		if fd != fd_TestAllTypes_oneof_float {
+			break
+		}
 		if x.OneofField == nil {
 			return false
 		} else if _, ok := x.OneofField.(*TestAllTypes_OneofFloat); ok {
@@ -3209,7 +4115,10 @@ func (x *fastReflection_TestAllTypes) Has(fd protoreflect.FieldDescriptor) bool
 		} else {
 			return false
 		}
-	case "goproto.proto.test3.TestAllTypes.oneof_double":
+	case 118: // goproto.proto.test3.TestAllTypes.oneof_double
+		if fd != fd_TestAllTypes_oneof_double {
+			break
+		}
 		if x.OneofField == nil {
 			return false
 		} else if _, ok := x.OneofField.(*TestAllTypes_OneofDouble); ok {
@@ -3217,7 +4126,10 @@ func (x *fastReflection_TestAllTypes) Has(fd protoreflect.FieldDescriptor) bool
 		} else {
 			return false
 		}
-	case "goproto.proto.test3.TestAllTypes.oneof_enum":
+	case 119: // goproto.proto.test3.TestAllTypes.oneof_enum
+		if fd != fd_TestAllTypes_oneof_enum {
+			break
+		}
 		if x.OneofField == nil {
 			return false
 		} else if _, ok := x.OneofField.(*TestAllTypes_OneofEnum); ok {
@@ -3225,12 +4137,14 @@ func (x *fastReflection_TestAllTypes) Has(fd protoreflect.FieldDescriptor) bool
 		} else {
 			return false
 		}
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.TestAllTypes"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not contain field %s", fd.FullName()))
 	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not contain field %s", fd.FullName()))
 }
 
 // Clear clears the field such that a subsequent Has call reports false.
@@ -3240,149 +4154,424 @@ func (x *fastReflection_TestAllTypes) Has(fd protoreflect.FieldDescriptor) bool
 //
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_TestAllTypes) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "goproto.proto.test3.TestAllTypes.singular_int32":
+	switch fd.Number() {
+	case 81: // goproto.proto.test3.TestAllTypes.singular_int32
+		if fd != fd_TestAllTypes_singular_int32 {
+			break
+		}
 		x.SingularInt32 = int32(0)
-	case "goproto.proto.test3.TestAllTypes.singular_int64":
+		return
+	case 82: // goproto.proto.test3.TestAllTypes.singular_int64
+		if fd != fd_TestAllTypes_singular_int64 {
+			break
+		}
 		x.SingularInt64 = int64(0)
-	case "goproto.proto.test3.TestAllTypes.singular_uint32":
+		return
+	case 83: // goproto.proto.test3.TestAllTypes.singular_uint32
+		if fd != fd_TestAllTypes_singular_uint32 {
+			break
+		}
 		x.SingularUint32 = uint32(0)
-	case "goproto.proto.test3.TestAllTypes.singular_uint64":
+		return
+	case 84: // goproto.proto.test3.TestAllTypes.singular_uint64
+		if fd != fd_TestAllTypes_singular_uint64 {
+			break
+		}
 		x.SingularUint64 = uint64(0)
-	case "goproto.proto.test3.TestAllTypes.singular_sint32":
+		return
+	case 85: // goproto.proto.test3.TestAllTypes.singular_sint32
+		if fd != fd_TestAllTypes_singular_sint32 {
+			break
+		}
 		x.SingularSint32 = int32(0)
-	case "goproto.proto.test3.TestAllTypes.singular_sint64":
+		return
+	case 86: // goproto.proto.test3.TestAllTypes.singular_sint64
+		if fd != fd_TestAllTypes_singular_sint64 {
+			break
+		}
 		x.SingularSint64 = int64(0)
-	case "goproto.proto.test3.TestAllTypes.singular_fixed32":
+		return
+	case 87: // goproto.proto.test3.TestAllTypes.singular_fixed32
+		if fd != fd_TestAllTypes_singular_fixed32 {
+			break
+		}
 		x.SingularFixed32 = uint32(0)
-	case "goproto.proto.test3.TestAllTypes.singular_fixed64":
+		return
+	case 88: // goproto.proto.test3.TestAllTypes.singular_fixed64
+		if fd != fd_TestAllTypes_singular_fixed64 {
+			break
+		}
 		x.SingularFixed64 = uint64(0)
-	case "goproto.proto.test3.TestAllTypes.singular_sfixed32":
+		return
+	case 89: // goproto.proto.test3.TestAllTypes.singular_sfixed32
+		if fd != fd_TestAllTypes_singular_sfixed32 {
+			break
+		}
 		x.SingularSfixed32 = int32(0)
-	case "goproto.proto.test3.TestAllTypes.singular_sfixed64":
+		return
+	case 90: // goproto.proto.test3.TestAllTypes.singular_sfixed64
+		if fd != fd_TestAllTypes_singular_sfixed64 {
+			break
+		}
 		x.SingularSfixed64 = int64(0)
-	case "goproto.proto.test3.TestAllTypes.singular_float":
+		return
+	case 91: // goproto.proto.test3.TestAllTypes.singular_float
+		if fd != fd_TestAllTypes_singular_float {
+			break
+		}
 		x.SingularFloat = float32(0)
-	case "goproto.proto.test3.TestAllTypes.singular_double":
+		return
+	case 92: // goproto.proto.test3.TestAllTypes.singular_double
+		if fd != fd_TestAllTypes_singular_double {
+			break
+		}
 		x.SingularDouble = float64(0)
-	case "goproto.proto.test3.TestAllTypes.singular_bool":
+		return
+	case 93: // goproto.proto.test3.TestAllTypes.singular_bool
+		if fd != fd_TestAllTypes_singular_bool {
+			break
+		}
 		x.SingularBool = false
-	case "goproto.proto.test3.TestAllTypes.singular_string":
+		return
+	case 94: // goproto.proto.test3.TestAllTypes.singular_string
+		if fd != fd_TestAllTypes_singular_string {
+			break
+		}
 		x.SingularString = ""
-	case "goproto.proto.test3.TestAllTypes.singular_bytes":
+		return
+	case 95: // goproto.proto.test3.TestAllTypes.singular_bytes
+		if fd != fd_TestAllTypes_singular_bytes {
+			break
+		}
 		x.SingularBytes = nil
-	case "goproto.proto.test3.TestAllTypes.singular_nested_message":
+		return
+	case 98: // goproto.proto.test3.TestAllTypes.singular_nested_message
+		if fd != fd_TestAllTypes_singular_nested_message {
+			break
+		}
 		x.SingularNestedMessage = nil
-	case "goproto.proto.test3.TestAllTypes.singular_foreign_message":
+		return
+	case 99: // goproto.proto.test3.TestAllTypes.singular_foreign_message
+		if fd != fd_TestAllTypes_singular_foreign_message {
+			break
+		}
 		x.SingularForeignMessage = nil
-	case "goproto.proto.test3.TestAllTypes.singular_import_message":
+		return
+	case 100: // goproto.proto.test3.TestAllTypes.singular_import_message
+		if fd != fd_TestAllTypes_singular_import_message {
+			break
+		}
 		x.SingularImportMessage = nil
-	case "goproto.proto.test3.TestAllTypes.singular_nested_enum":
+		return
+	case 101: // goproto.proto.test3.TestAllTypes.singular_nested_enum
+		if fd != fd_TestAllTypes_singular_nested_enum {
+			break
+		}
 		x.SingularNestedEnum = 0
-	case "goproto.proto.test3.TestAllTypes.singular_foreign_enum":
-		x.SingularForeignEnum = 0
-	case "goproto.proto.test3.TestAllTypes.singular_import_enum":
+		return
+	case 102: // goproto.proto.test3.TestAllTypes.singular_foreign_enum
+		if fd != fd_TestAllTypes_singular_foreign_enum {
+			break
+		}
+		x.SingularForeignEnum = 0
+		return
+	case 103: // goproto.proto.test3.TestAllTypes.singular_import_enum
+		if fd != fd_TestAllTypes_singular_import_enum {
+			break
+		}
 		x.SingularImportEnum = 0
-	case "goproto.proto.test3.TestAllTypes.repeated_int32":
+		return
+	case 31: // goproto.proto.test3.TestAllTypes.repeated_int32
+		if fd != fd_TestAllTypes_repeated_int32 {
+			break
+		}
 		x.RepeatedInt32 = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_int64":
+		return
+	case 32: // goproto.proto.test3.TestAllTypes.repeated_int64
+		if fd != fd_TestAllTypes_repeated_int64 {
+			break
+		}
 		x.RepeatedInt64 = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_uint32":
+		return
+	case 33: // goproto.proto.test3.TestAllTypes.repeated_uint32
+		if fd != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
 		x.RepeatedUint32 = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_uint64":
+		return
+	case 34: // goproto.proto.test3.TestAllTypes.repeated_uint64
+		if fd != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
 		x.RepeatedUint64 = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_sint32":
+		return
+	case 35: // goproto.proto.test3.TestAllTypes.repeated_sint32
+		if fd != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
 		x.RepeatedSint32 = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_sint64":
+		return
+	case 36: // goproto.proto.test3.TestAllTypes.repeated_sint64
+		if fd != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
 		x.RepeatedSint64 = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_fixed32":
+		return
+	case 37: // goproto.proto.test3.TestAllTypes.repeated_fixed32
+		if fd != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
 		x.RepeatedFixed32 = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_fixed64":
+		return
+	case 38: // goproto.proto.test3.TestAllTypes.repeated_fixed64
+		if fd != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
 		x.RepeatedFixed64 = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_sfixed32":
+		return
+	case 39: // goproto.proto.test3.TestAllTypes.repeated_sfixed32
+		if fd != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
 		x.RepeatedSfixed32 = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_sfixed64":
+		return
+	case 40: // goproto.proto.test3.TestAllTypes.repeated_sfixed64
+		if fd != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
 		x.RepeatedSfixed64 = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_float":
+		return
+	case 41: // goproto.proto.test3.TestAllTypes.repeated_float
+		if fd != fd_TestAllTypes_repeated_float {
+			break
+		}
 		x.RepeatedFloat = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_double":
+		return
+	case 42: // goproto.proto.test3.TestAllTypes.repeated_double
+		if fd != fd_TestAllTypes_repeated_double {
+			break
+		}
 		x.RepeatedDouble = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_bool":
+		return
+	case 43: // goproto.proto.test3.TestAllTypes.repeated_bool
+		if fd != fd_TestAllTypes_repeated_bool {
+			break
+		}
 		x.RepeatedBool = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_string":
+		return
+	case 44: // goproto.proto.test3.TestAllTypes.repeated_string
+		if fd != fd_TestAllTypes_repeated_string {
+			break
+		}
 		x.RepeatedString = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_bytes":
+		return
+	case 45: // goproto.proto.test3.TestAllTypes.repeated_bytes
+		if fd != fd_TestAllTypes_repeated_bytes {
+			break
+		}
 		x.RepeatedBytes = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_nested_message":
+		return
+	case 48: // goproto.proto.test3.TestAllTypes.repeated_nested_message
+		if fd != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
 		x.RepeatedNestedMessage = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_foreign_message":
+		return
+	case 49: // goproto.proto.test3.TestAllTypes.repeated_foreign_message
+		if fd != fd_TestAllTypes_repeated_foreign_message {
+			break
+		}
 		x.RepeatedForeignMessage = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_importmessage":
+		return
+	case 50: // goproto.proto.test3.TestAllTypes.repeated_importmessage
+		if fd != fd_TestAllTypes_repeated_importmessage {
+			break
+		}
 		x.RepeatedImportmessage = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_nested_enum":
+		return
+	case 51: // goproto.proto.test3.TestAllTypes.repeated_nested_enum
+		if fd != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
 		x.RepeatedNestedEnum = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_foreign_enum":
+		return
+	case 52: // goproto.proto.test3.TestAllTypes.repeated_foreign_enum
+		if fd != fd_TestAllTypes_repeated_foreign_enum {
+			break
+		}
 		x.RepeatedForeignEnum = nil
-	case "goproto.proto.test3.TestAllTypes.repeated_importenum":
+		return
+	case 53: // goproto.proto.test3.TestAllTypes.repeated_importenum
+		if fd != fd_TestAllTypes_repeated_importenum {
+			break
+		}
 		x.RepeatedImportenum = nil
-	case "goproto.proto.test3.TestAllTypes.map_int32_int32":
+		return
+	case 56: // goproto.proto.test3.TestAllTypes.map_int32_int32
+		if fd != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
 		x.MapInt32Int32 = nil
-	case "goproto.proto.test3.TestAllTypes.map_int64_int64":
+		return
+	case 57: // goproto.proto.test3.TestAllTypes.map_int64_int64
+		if fd != fd_TestAllTypes_map_int64_int64 {
+			break
+		}
 		x.MapInt64Int64 = nil
-	case "goproto.proto.test3.TestAllTypes.map_uint32_uint32":
+		return
+	case 58: // goproto.proto.test3.TestAllTypes.map_uint32_uint32
+		if fd != fd_TestAllTypes_map_uint32_uint32 {
+			break
+		}
 		x.MapUint32Uint32 = nil
-	case "goproto.proto.test3.TestAllTypes.map_uint64_uint64":
+		return
+	case 59: // goproto.proto.test3.TestAllTypes.map_uint64_uint64
+		if fd != fd_TestAllTypes_map_uint64_uint64 {
+			break
+		}
 		x.MapUint64Uint64 = nil
-	case "goproto.proto.test3.TestAllTypes.map_sint32_sint32":
+		return
+	case 60: // goproto.proto.test3.TestAllTypes.map_sint32_sint32
+		if fd != fd_TestAllTypes_map_sint32_sint32 {
+			break
+		}
 		x.MapSint32Sint32 = nil
-	case "goproto.proto.test3.TestAllTypes.map_sint64_sint64":
+		return
+	case 61: // goproto.proto.test3.TestAllTypes.map_sint64_sint64
+		if fd != fd_TestAllTypes_map_sint64_sint64 {
+			break
+		}
 		x.MapSint64Sint64 = nil
-	case "goproto.proto.test3.TestAllTypes.map_fixed32_fixed32":
+		return
+	case 62: // goproto.proto.test3.TestAllTypes.map_fixed32_fixed32
+		if fd != fd_TestAllTypes_map_fixed32_fixed32 {
+			break
+		}
 		x.MapFixed32Fixed32 = nil
-	case "goproto.proto.test3.TestAllTypes.map_fixed64_fixed64":
+		return
+	case 63: // goproto.proto.test3.TestAllTypes.map_fixed64_fixed64
+		if fd != fd_TestAllTypes_map_fixed64_fixed64 {
+			break
+		}
 		x.MapFixed64Fixed64 = nil
-	case "goproto.proto.test3.TestAllTypes.map_sfixed32_sfixed32":
+		return
+	case 64: // goproto.proto.test3.TestAllTypes.map_sfixed32_sfixed32
+		if fd != fd_TestAllTypes_map_sfixed32_sfixed32 {
+			break
+		}
 		x.MapSfixed32Sfixed32 = nil
-	case "goproto.proto.test3.TestAllTypes.map_sfixed64_sfixed64":
+		return
+	case 65: // goproto.proto.test3.TestAllTypes.map_sfixed64_sfixed64
+		if fd != fd_TestAllTypes_map_sfixed64_sfixed64 {
+			break
+		}
 		x.MapSfixed64Sfixed64 = nil
-	case "goproto.proto.test3.TestAllTypes.map_int32_float":
+		return
+	case 66: // goproto.proto.test3.TestAllTypes.map_int32_float
+		if fd != fd_TestAllTypes_map_int32_float {
+			break
+		}
 		x.MapInt32Float = nil
-	case "goproto.proto.test3.TestAllTypes.map_int32_double":
+		return
+	case 67: // goproto.proto.test3.TestAllTypes.map_int32_double
+		if fd != fd_TestAllTypes_map_int32_double {
+			break
+		}
 		x.MapInt32Double = nil
-	case "goproto.proto.test3.TestAllTypes.map_bool_bool":
+		return
+	case 68: // goproto.proto.test3.TestAllTypes.map_bool_bool
+		if fd != fd_TestAllTypes_map_bool_bool {
+			break
+		}
 		x.MapBoolBool = nil
-	case "goproto.proto.test3.TestAllTypes.map_string_string":
+		return
+	case 69: // goproto.proto.test3.TestAllTypes.map_string_string
+		if fd != fd_TestAllTypes_map_string_string {
+			break
+		}
 		x.MapStringString = nil
-	case "goproto.proto.test3.TestAllTypes.map_string_bytes":
+		return
+	case 70: // goproto.proto.test3.TestAllTypes.map_string_bytes
+		if fd != fd_TestAllTypes_map_string_bytes {
+			break
+		}
 		x.MapStringBytes = nil
-	case "goproto.proto.test3.TestAllTypes.map_string_nested_message":
+		return
+	case 71: // goproto.proto.test3.TestAllTypes.map_string_nested_message
+		if fd != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
 		x.MapStringNestedMessage = nil
-	case "goproto.proto.test3.TestAllTypes.map_string_nested_enum":
+		return
+	case 73: // goproto.proto.test3.TestAllTypes.map_string_nested_enum
+		if fd != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
 		x.MapStringNestedEnum = nil
-	case "goproto.proto.test3.TestAllTypes.oneof_uint32":
-		x.OneofField = nil
-	case "goproto.proto.test3.TestAllTypes.oneof_nested_message":
+		return
+	case 111: // goproto.proto.test3.TestAllTypes.oneof_uint32
+		if fd != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
 		x.OneofField = nil
-	case "goproto.proto.test3.TestAllTypes.oneof_string":
+		return
+	case 112: // goproto.proto.test3.TestAllTypes.oneof_nested_message
+		if fd != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
 		x.OneofField = nil
-	case "goproto.proto.test3.TestAllTypes.oneof_bytes":
+		return
+	case 113: // goproto.proto.test3.TestAllTypes.oneof_string
+		if fd != fd_TestAllTypes_oneof_string {
+			break
+		}
 		x.OneofField = nil
-	case "goproto.proto.test3.TestAllTypes.oneof_bool":
+		return
+	case 114: // goproto.proto.test3.TestAllTypes.oneof_bytes
+		if fd != fd_TestAllTypes_oneof_bytes {
+			break
+		}
 		x.OneofField = nil
-	case "goproto.proto.test3.TestAllTypes.oneof_uint64":
+		return
+	case 115: // goproto.proto.test3.TestAllTypes.oneof_bool
+		if fd != fd_TestAllTypes_oneof_bool {
+			break
+		}
 		x.OneofField = nil
-	case "goproto.proto.test3.TestAllTypes.oneof_float":
+		return
+	case 116: // goproto.proto.test3.TestAllTypes.oneof_uint64
+		if fd != fd_TestAllTypes_oneof_uint64 {
+			break
+		}
 		x.OneofField = nil
-	case "goproto.proto.test3.TestAllTypes.oneof_double":
+		return
+	case 117: // goproto.proto.test3.TestAllTypes.oneof_float
+		if fd != fd_TestAllTypes_oneof_float {
+			break
+		}
 		x.OneofField = nil
-	case "goproto.proto.test3.TestAllTypes.oneof_enum":
+		return
+	case 118: // goproto.proto.test3.TestAllTypes.oneof_double
+		if fd != fd_TestAllTypes_oneof_double {
+			break
+		}
 		x.OneofField = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.TestAllTypes"))
+		return
+	case 119: // goproto.proto.test3.TestAllTypes.oneof_enum
+		if fd != fd_TestAllTypes_oneof_enum {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not contain field %s", fd.FullName()))
+		x.OneofField = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not extend %s", fd.FullName()))
 	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not contain field %s", fd.FullName()))
 }
 
 // Get retrieves the value for a field.
@@ -3392,299 +4581,479 @@ func (x *fastReflection_TestAllTypes) Clear(fd protoreflect.FieldDescriptor) {
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_TestAllTypes) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "goproto.proto.test3.TestAllTypes.singular_int32":
+	switch descriptor.Number() {
+	case 81: // goproto.proto.test3.TestAllTypes.singular_int32
+		if descriptor != fd_TestAllTypes_singular_int32 {
+			break
+		}
 		value := x.SingularInt32
 		return protoreflect.ValueOfInt32(value)
-	case "goproto.proto.test3.TestAllTypes.singular_int64":
+	case 82: // goproto.proto.test3.TestAllTypes.singular_int64
+		if descriptor != fd_TestAllTypes_singular_int64 {
+			break
+		}
 		value := x.SingularInt64
 		return protoreflect.ValueOfInt64(value)
-	case "goproto.proto.test3.TestAllTypes.singular_uint32":
+	case 83: // goproto.proto.test3.TestAllTypes.singular_uint32
+		if descriptor != fd_TestAllTypes_singular_uint32 {
+			break
+		}
 		value := x.SingularUint32
 		return protoreflect.ValueOfUint32(value)
-	case "goproto.proto.test3.TestAllTypes.singular_uint64":
+	case 84: // goproto.proto.test3.TestAllTypes.singular_uint64
+		if descriptor != fd_TestAllTypes_singular_uint64 {
+			break
+		}
 		value := x.SingularUint64
 		return protoreflect.ValueOfUint64(value)
-	case "goproto.proto.test3.TestAllTypes.singular_sint32":
+	case 85: // goproto.proto.test3.TestAllTypes.singular_sint32
+		if descriptor != fd_TestAllTypes_singular_sint32 {
+			break
+		}
 		value := x.SingularSint32
 		return protoreflect.ValueOfInt32(value)
-	case "goproto.proto.test3.TestAllTypes.singular_sint64":
+	case 86: // goproto.proto.test3.TestAllTypes.singular_sint64
+		if descriptor != fd_TestAllTypes_singular_sint64 {
+			break
+		}
 		value := x.SingularSint64
 		return protoreflect.ValueOfInt64(value)
-	case "goproto.proto.test3.TestAllTypes.singular_fixed32":
+	case 87: // goproto.proto.test3.TestAllTypes.singular_fixed32
+		if descriptor != fd_TestAllTypes_singular_fixed32 {
+			break
+		}
 		value := x.SingularFixed32
 		return protoreflect.ValueOfUint32(value)
-	case "goproto.proto.test3.TestAllTypes.singular_fixed64":
+	case 88: // goproto.proto.test3.TestAllTypes.singular_fixed64
+		if descriptor != fd_TestAllTypes_singular_fixed64 {
+			break
+		}
 		value := x.SingularFixed64
 		return protoreflect.ValueOfUint64(value)
-	case "goproto.proto.test3.TestAllTypes.singular_sfixed32":
+	case 89: // goproto.proto.test3.TestAllTypes.singular_sfixed32
+		if descriptor != fd_TestAllTypes_singular_sfixed32 {
+			break
+		}
 		value := x.SingularSfixed32
 		return protoreflect.ValueOfInt32(value)
-	case "goproto.proto.test3.TestAllTypes.singular_sfixed64":
+	case 90: // goproto.proto.test3.TestAllTypes.singular_sfixed64
+		if descriptor != fd_TestAllTypes_singular_sfixed64 {
+			break
+		}
 		value := x.SingularSfixed64
 		return protoreflect.ValueOfInt64(value)
-	case "goproto.proto.test3.TestAllTypes.singular_float":
+	case 91: // goproto.proto.test3.TestAllTypes.singular_float
+		if descriptor != fd_TestAllTypes_singular_float {
+			break
+		}
 		value := x.SingularFloat
 		return protoreflect.ValueOfFloat32(value)
-	case "goproto.proto.test3.TestAllTypes.singular_double":
+	case 92: // goproto.proto.test3.TestAllTypes.singular_double
+		if descriptor != fd_TestAllTypes_singular_double {
+			break
+		}
 		value := x.SingularDouble
 		return protoreflect.ValueOfFloat64(value)
-	case "goproto.proto.test3.TestAllTypes.singular_bool":
+	case 93: // goproto.proto.test3.TestAllTypes.singular_bool
+		if descriptor != fd_TestAllTypes_singular_bool {
+			break
+		}
 		value := x.SingularBool
 		return protoreflect.ValueOfBool(value)
-	case "goproto.proto.test3.TestAllTypes.singular_string":
+	case 94: // goproto.proto.test3.TestAllTypes.singular_string
+		if descriptor != fd_TestAllTypes_singular_string {
+			break
+		}
 		value := x.SingularString
 		return protoreflect.ValueOfString(value)
-	case "goproto.proto.test3.TestAllTypes.singular_bytes":
+	case 95: // goproto.proto.test3.TestAllTypes.singular_bytes
+		if descriptor != fd_TestAllTypes_singular_bytes {
+			break
+		}
 		value := x.SingularBytes
 		return protoreflect.ValueOfBytes(value)
-	case "goproto.proto.test3.TestAllTypes.singular_nested_message":
+	case 98: // goproto.proto.test3.TestAllTypes.singular_nested_message
+		if descriptor != fd_TestAllTypes_singular_nested_message {
+			break
+		}
 		value := x.SingularNestedMessage
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "goproto.proto.test3.TestAllTypes.singular_foreign_message":
+	case 99: // goproto.proto.test3.TestAllTypes.singular_foreign_message
+		if descriptor != fd_TestAllTypes_singular_foreign_message {
+			break
+		}
 		value := x.SingularForeignMessage
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "goproto.proto.test3.TestAllTypes.singular_import_message":
+	case 100: // goproto.proto.test3.TestAllTypes.singular_import_message
+		if descriptor != fd_TestAllTypes_singular_import_message {
+			break
+		}
 		value := x.SingularImportMessage
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "goproto.proto.test3.TestAllTypes.singular_nested_enum":
+	case 101: // goproto.proto.test3.TestAllTypes.singular_nested_enum
+		if descriptor != fd_TestAllTypes_singular_nested_enum {
+			break
+		}
 		value := x.SingularNestedEnum
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "goproto.proto.test3.TestAllTypes.singular_foreign_enum":
+	case 102: // goproto.proto.test3.TestAllTypes.singular_foreign_enum
+		if descriptor != fd_TestAllTypes_singular_foreign_enum {
+			break
+		}
 		value := x.SingularForeignEnum
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "goproto.proto.test3.TestAllTypes.singular_import_enum":
+	case 103: // goproto.proto.test3.TestAllTypes.singular_import_enum
+		if descriptor != fd_TestAllTypes_singular_import_enum {
+			break
+		}
 		value := x.SingularImportEnum
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "goproto.proto.test3.TestAllTypes.repeated_int32":
+	case 31: // goproto.proto.test3.TestAllTypes.repeated_int32
+		if descriptor != fd_TestAllTypes_repeated_int32 {
+			break
+		}
 		if len(x.RepeatedInt32) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_31_list{})
 		}
 		listValue := &_TestAllTypes_31_list{list: &x.RepeatedInt32}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_int64":
+	case 32: // goproto.proto.test3.TestAllTypes.repeated_int64
+		if descriptor != fd_TestAllTypes_repeated_int64 {
+			break
+		}
 		if len(x.RepeatedInt64) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_32_list{})
 		}
 		listValue := &_TestAllTypes_32_list{list: &x.RepeatedInt64}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_uint32":
+	case 33: // goproto.proto.test3.TestAllTypes.repeated_uint32
+		if descriptor != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
 		if len(x.RepeatedUint32) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_33_list{})
 		}
 		listValue := &_TestAllTypes_33_list{list: &x.RepeatedUint32}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_uint64":
+	case 34: // goproto.proto.test3.TestAllTypes.repeated_uint64
+		if descriptor != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
 		if len(x.RepeatedUint64) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_34_list{})
 		}
 		listValue := &_TestAllTypes_34_list{list: &x.RepeatedUint64}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_sint32":
+	case 35: // goproto.proto.test3.TestAllTypes.repeated_sint32
+		if descriptor != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
 		if len(x.RepeatedSint32) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_35_list{})
 		}
 		listValue := &_TestAllTypes_35_list{list: &x.RepeatedSint32}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_sint64":
+	case 36: // goproto.proto.test3.TestAllTypes.repeated_sint64
+		if descriptor != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
 		if len(x.RepeatedSint64) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_36_list{})
 		}
 		listValue := &_TestAllTypes_36_list{list: &x.RepeatedSint64}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_fixed32":
+	case 37: // goproto.proto.test3.TestAllTypes.repeated_fixed32
+		if descriptor != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
 		if len(x.RepeatedFixed32) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_37_list{})
 		}
 		listValue := &_TestAllTypes_37_list{list: &x.RepeatedFixed32}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_fixed64":
+	case 38: // goproto.proto.test3.TestAllTypes.repeated_fixed64
+		if descriptor != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
 		if len(x.RepeatedFixed64) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_38_list{})
 		}
 		listValue := &_TestAllTypes_38_list{list: &x.RepeatedFixed64}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_sfixed32":
+	case 39: // goproto.proto.test3.TestAllTypes.repeated_sfixed32
+		if descriptor != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
 		if len(x.RepeatedSfixed32) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_39_list{})
 		}
 		listValue := &_TestAllTypes_39_list{list: &x.RepeatedSfixed32}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_sfixed64":
+	case 40: // goproto.proto.test3.TestAllTypes.repeated_sfixed64
+		if descriptor != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
 		if len(x.RepeatedSfixed64) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_40_list{})
 		}
 		listValue := &_TestAllTypes_40_list{list: &x.RepeatedSfixed64}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_float":
+	case 41: // goproto.proto.test3.TestAllTypes.repeated_float
+		if descriptor != fd_TestAllTypes_repeated_float {
+			break
+		}
 		if len(x.RepeatedFloat) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_41_list{})
 		}
 		listValue := &_TestAllTypes_41_list{list: &x.RepeatedFloat}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_double":
+	case 42: // goproto.proto.test3.TestAllTypes.repeated_double
+		if descriptor != fd_TestAllTypes_repeated_double {
+			break
+		}
 		if len(x.RepeatedDouble) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_42_list{})
 		}
 		listValue := &_TestAllTypes_42_list{list: &x.RepeatedDouble}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_bool":
+	case 43: // goproto.proto.test3.TestAllTypes.repeated_bool
+		if descriptor != fd_TestAllTypes_repeated_bool {
+			break
+		}
 		if len(x.RepeatedBool) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_43_list{})
 		}
 		listValue := &_TestAllTypes_43_list{list: &x.RepeatedBool}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_string":
+	case 44: // goproto.proto.test3.TestAllTypes.repeated_string
+		if descriptor != fd_TestAllTypes_repeated_string {
+			break
+		}
 		if len(x.RepeatedString) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_44_list{})
 		}
 		listValue := &_TestAllTypes_44_list{list: &x.RepeatedString}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_bytes":
+	case 45: // goproto.proto.test3.TestAllTypes.repeated_bytes
+		if descriptor != fd_TestAllTypes_repeated_bytes {
+			break
+		}
 		if len(x.RepeatedBytes) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_45_list{})
 		}
 		listValue := &_TestAllTypes_45_list{list: &x.RepeatedBytes}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_nested_message":
+	case 48: // goproto.proto.test3.TestAllTypes.repeated_nested_message
+		if descriptor != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
 		if len(x.RepeatedNestedMessage) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_48_list{})
 		}
 		listValue := &_TestAllTypes_48_list{list: &x.RepeatedNestedMessage}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_foreign_message":
+	case 49: // goproto.proto.test3.TestAllTypes.repeated_foreign_message
+		if descriptor != fd_TestAllTypes_repeated_foreign_message {
+			break
+		}
 		if len(x.RepeatedForeignMessage) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_49_list{})
 		}
 		listValue := &_TestAllTypes_49_list{list: &x.RepeatedForeignMessage}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_importmessage":
+	case 50: // goproto.proto.test3.TestAllTypes.repeated_importmessage
+		if descriptor != fd_TestAllTypes_repeated_importmessage {
+			break
+		}
 		if len(x.RepeatedImportmessage) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_50_list{})
 		}
 		listValue := &_TestAllTypes_50_list{list: &x.RepeatedImportmessage}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_nested_enum":
+	case 51: // goproto.proto.test3.TestAllTypes.repeated_nested_enum
+		if descriptor != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
 		if len(x.RepeatedNestedEnum) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_51_list{})
 		}
 		listValue := &_TestAllTypes_51_list{list: &x.RepeatedNestedEnum}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_foreign_enum":
+	case 52: // goproto.proto.test3.TestAllTypes.repeated_foreign_enum
+		if descriptor != fd_TestAllTypes_repeated_foreign_enum {
+			break
+		}
 		if len(x.RepeatedForeignEnum) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_52_list{})
 		}
 		listValue := &_TestAllTypes_52_list{list: &x.RepeatedForeignEnum}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.repeated_importenum":
+	case 53: // goproto.proto.test3.TestAllTypes.repeated_importenum
+		if descriptor != fd_TestAllTypes_repeated_importenum {
+			break
+		}
 		if len(x.RepeatedImportenum) == 0 {
 			return protoreflect.ValueOfList(&_TestAllTypes_53_list{})
 		}
 		listValue := &_TestAllTypes_53_list{list: &x.RepeatedImportenum}
 		return protoreflect.ValueOfList(listValue)
-	case "goproto.proto.test3.TestAllTypes.map_int32_int32":
+	case 56: // goproto.proto.test3.TestAllTypes.map_int32_int32
+		if descriptor != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
 		if len(x.MapInt32Int32) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_56_map{})
 		}
 		mapValue := &_TestAllTypes_56_map{m: &x.MapInt32Int32}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.map_int64_int64":
+	case 57: // goproto.proto.test3.TestAllTypes.map_int64_int64
+		if descriptor != fd_TestAllTypes_map_int64_int64 {
+			break
+		}
 		if len(x.MapInt64Int64) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_57_map{})
 		}
 		mapValue := &_TestAllTypes_57_map{m: &x.MapInt64Int64}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.map_uint32_uint32":
+	case 58: // goproto.proto.test3.TestAllTypes.map_uint32_uint32
+		if descriptor != fd_TestAllTypes_map_uint32_uint32 {
+			break
+		}
 		if len(x.MapUint32Uint32) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_58_map{})
 		}
 		mapValue := &_TestAllTypes_58_map{m: &x.MapUint32Uint32}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.map_uint64_uint64":
+	case 59: // goproto.proto.test3.TestAllTypes.map_uint64_uint64
+		if descriptor != fd_TestAllTypes_map_uint64_uint64 {
+			break
+		}
 		if len(x.MapUint64Uint64) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_59_map{})
 		}
 		mapValue := &_TestAllTypes_59_map{m: &x.MapUint64Uint64}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.map_sint32_sint32":
+	case 60: // goproto.proto.test3.TestAllTypes.map_sint32_sint32
+		if descriptor != fd_TestAllTypes_map_sint32_sint32 {
+			break
+		}
 		if len(x.MapSint32Sint32) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_60_map{})
 		}
 		mapValue := &_TestAllTypes_60_map{m: &x.MapSint32Sint32}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.map_sint64_sint64":
+	case 61: // goproto.proto.test3.TestAllTypes.map_sint64_sint64
+		if descriptor != fd_TestAllTypes_map_sint64_sint64 {
+			break
+		}
 		if len(x.MapSint64Sint64) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_61_map{})
 		}
 		mapValue := &_TestAllTypes_61_map{m: &x.MapSint64Sint64}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.map_fixed32_fixed32":
+	case 62: // goproto.proto.test3.TestAllTypes.map_fixed32_fixed32
+		if descriptor != fd_TestAllTypes_map_fixed32_fixed32 {
+			break
+		}
 		if len(x.MapFixed32Fixed32) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_62_map{})
 		}
 		mapValue := &_TestAllTypes_62_map{m: &x.MapFixed32Fixed32}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.map_fixed64_fixed64":
+	case 63: // goproto.proto.test3.TestAllTypes.map_fixed64_fixed64
+		if descriptor != fd_TestAllTypes_map_fixed64_fixed64 {
+			break
+		}
 		if len(x.MapFixed64Fixed64) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_63_map{})
 		}
 		mapValue := &_TestAllTypes_63_map{m: &x.MapFixed64Fixed64}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.map_sfixed32_sfixed32":
+	case 64: // goproto.proto.test3.TestAllTypes.map_sfixed32_sfixed32
+		if descriptor != fd_TestAllTypes_map_sfixed32_sfixed32 {
+			break
+		}
 		if len(x.MapSfixed32Sfixed32) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_64_map{})
 		}
 		mapValue := &_TestAllTypes_64_map{m: &x.MapSfixed32Sfixed32}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.map_sfixed64_sfixed64":
+	case 65: // goproto.proto.test3.TestAllTypes.map_sfixed64_sfixed64
+		if descriptor != fd_TestAllTypes_map_sfixed64_sfixed64 {
+			break
+		}
 		if len(x.MapSfixed64Sfixed64) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_65_map{})
 		}
 		mapValue := &_TestAllTypes_65_map{m: &x.MapSfixed64Sfixed64}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.map_int32_float":
+	case 66: // goproto.proto.test3.TestAllTypes.map_int32_float
+		if descriptor != fd_TestAllTypes_map_int32_float {
+			break
+		}
 		if len(x.MapInt32Float) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_66_map{})
 		}
 		mapValue := &_TestAllTypes_66_map{m: &x.MapInt32Float}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.map_int32_double":
+	case 67: // goproto.proto.test3.TestAllTypes.map_int32_double
+		if descriptor != fd_TestAllTypes_map_int32_double {
+			break
+		}
 		if len(x.MapInt32Double) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_67_map{})
 		}
 		mapValue := &_TestAllTypes_67_map{m: &x.MapInt32Double}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.map_bool_bool":
+	case 68: // goproto.proto.test3.TestAllTypes.map_bool_bool
+		if descriptor != fd_TestAllTypes_map_bool_bool {
+			break
+		}
 		if len(x.MapBoolBool) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_68_map{})
 		}
 		mapValue := &_TestAllTypes_68_map{m: &x.MapBoolBool}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.map_string_string":
+	case 69: // goproto.proto.test3.TestAllTypes.map_string_string
+		if descriptor != fd_TestAllTypes_map_string_string {
+			break
+		}
 		if len(x.MapStringString) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_69_map{})
 		}
 		mapValue := &_TestAllTypes_69_map{m: &x.MapStringString}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.map_string_bytes":
+	case 70: // goproto.proto.test3.TestAllTypes.map_string_bytes
+		if descriptor != fd_TestAllTypes_map_string_bytes {
+			break
+		}
 		if len(x.MapStringBytes) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_70_map{})
 		}
 		mapValue := &_TestAllTypes_70_map{m: &x.MapStringBytes}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.map_string_nested_message":
+	case 71: // goproto.proto.test3.TestAllTypes.map_string_nested_message
+		if descriptor != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
 		if len(x.MapStringNestedMessage) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_71_map{})
 		}
 		mapValue := &_TestAllTypes_71_map{m: &x.MapStringNestedMessage}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.map_string_nested_enum":
+	case 73: // goproto.proto.test3.TestAllTypes.map_string_nested_enum
+		if descriptor != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
 		if len(x.MapStringNestedEnum) == 0 {
 			return protoreflect.ValueOfMap(&_TestAllTypes_73_map{})
 		}
 		mapValue := &_TestAllTypes_73_map{m: &x.MapStringNestedEnum}
 		return protoreflect.ValueOfMap(mapValue)
-	case "goproto.proto.test3.TestAllTypes.oneof_uint32":
+	case 111: // goproto.proto.test3.TestAllTypes.oneof_uint32
+		if descriptor != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
 		if x.OneofField == nil {
 			return protoreflect.ValueOfUint32(uint32(0))
 		} else if v, ok := x.OneofField.(*TestAllTypes_OneofUint32); ok {
@@ -3692,7 +5061,10 @@ func (x *fastReflection_TestAllTypes) Get(descriptor protoreflect.FieldDescripto
 		} else {
 			return protoreflect.ValueOfUint32(uint32(0))
 		}
-	case "goproto.proto.test3.TestAllTypes.oneof_nested_message":
+	case 112: // goproto.proto.test3.TestAllTypes.oneof_nested_message
+		if descriptor != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
 		if x.OneofField == nil {
 			return protoreflect.ValueOfMessage((*TestAllTypes_NestedMessage)(nil).ProtoReflect())
 		} else if v, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); ok {
@@ -3700,7 +5072,10 @@ func (x *fastReflection_TestAllTypes) Get(descriptor protoreflect.FieldDescripto
 		} else {
 			return protoreflect.ValueOfMessage((*TestAllTypes_NestedMessage)(nil).ProtoReflect())
 		}
-	case "goproto.proto.test3.TestAllTypes.oneof_string":
+	case 113: // goproto.proto.test3.TestAllTypes.oneof_string
+		if descriptor != fd_TestAllTypes_oneof_string {
+			break
+		}
 		if x.OneofField == nil {
 			return protoreflect.ValueOfString("")
 		} else if v, ok := x.OneofField.(*TestAllTypes_OneofString); ok {
@@ -3708,7 +5083,10 @@ func (x *fastReflection_TestAllTypes) Get(descriptor protoreflect.FieldDescripto
 		} else {
 			return protoreflect.ValueOfString("")
 		}
-	case "goproto.proto.test3.TestAllTypes.oneof_bytes":
+	case 114: // goproto.proto.test3.TestAllTypes.oneof_bytes
+		if descriptor != fd_TestAllTypes_oneof_bytes {
+			break
+		}
 		if x.OneofField == nil {
 			return protoreflect.ValueOfBytes(nil)
 		} else if v, ok := x.OneofField.(*TestAllTypes_OneofBytes); ok {
@@ -3716,7 +5094,10 @@ func (x *fastReflection_TestAllTypes) Get(descriptor protoreflect.FieldDescripto
 		} else {
 			return protoreflect.ValueOfBytes(nil)
 		}
-	case "goproto.proto.test3.TestAllTypes.oneof_bool":
+	case 115: // goproto.proto.test3.TestAllTypes.oneof_bool
+		if descriptor != fd_TestAllTypes_oneof_bool {
+			break
+		}
 		if x.OneofField == nil {
 			return protoreflect.ValueOfBool(false)
 		} else if v, ok := x.OneofField.(*TestAllTypes_OneofBool); ok {
@@ -3724,7 +5105,10 @@ func (x *fastReflection_TestAllTypes) Get(descriptor protoreflect.FieldDescripto
 		} else {
 			return protoreflect.ValueOfBool(false)
 		}
-	case "goproto.proto.test3.TestAllTypes.oneof_uint64":
+	case 116: // goproto.proto.test3.TestAllTypes.oneof_uint64
+		if descriptor != fd_TestAllTypes_oneof_uint64 {
+			break
+		}
 		if x.OneofField == nil {
 			return protoreflect.ValueOfUint64(uint64(0))
 		} else if v, ok := x.OneofField.(*TestAllTypes_OneofUint64); ok {
@@ -3732,7 +5116,10 @@ func (x *fastReflection_TestAllTypes) Get(descriptor protoreflect.FieldDescripto
 		} else {
 			return protoreflect.ValueOfUint64(uint64(0))
 		}
-	case "goproto.proto.test3.TestAllTypes.oneof_float":
+	case 117: // goproto.proto.test3.TestAllTypes.oneof_float
+		if descriptor != fd_TestAllTypes_oneof_float {
+			break
+		}
 		if x.OneofField == nil {
 			return protoreflect.ValueOfFloat32(float32(0))
 		} else if v, ok := x.OneofField.(*TestAllTypes_OneofFloat); ok {
@@ -3740,7 +5127,10 @@ func (x *fastReflection_TestAllTypes) Get(descriptor protoreflect.FieldDescripto
 		} else {
 			return protoreflect.ValueOfFloat32(float32(0))
 		}
-	case "goproto.proto.test3.TestAllTypes.oneof_double":
+	case 118: // goproto.proto.test3.TestAllTypes.oneof_double
+		if descriptor != fd_TestAllTypes_oneof_double {
+			break
+		}
 		if x.OneofField == nil {
 			return protoreflect.ValueOfFloat64(float64(0))
 		} else if v, ok := x.OneofField.(*TestAllTypes_OneofDouble); ok {
@@ -3748,7 +5138,10 @@ func (x *fastReflection_TestAllTypes) Get(descriptor protoreflect.FieldDescripto
 		} else {
 			return protoreflect.ValueOfFloat64(float64(0))
 		}
-	case "goproto.proto.test3.TestAllTypes.oneof_enum":
+	case 119: // goproto.proto.test3.TestAllTypes.oneof_enum
+		if descriptor != fd_TestAllTypes_oneof_enum {
+			break
+		}
 		if x.OneofField == nil {
 			return protoreflect.ValueOfEnum(0)
 		} else if v, ok := x.OneofField.(*TestAllTypes_OneofEnum); ok {
@@ -3756,12 +5149,14 @@ func (x *fastReflection_TestAllTypes) Get(descriptor protoreflect.FieldDescripto
 		} else {
 			return protoreflect.ValueOfEnum(0)
 		}
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.TestAllTypes"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not contain field %s", descriptor.FullName()))
 	}
+	if fd := runtime.FieldOf(descriptor, md_TestAllTypes); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not contain field %s", descriptor.FullName()))
 }
 
 // Set stores the value for a field.
@@ -3775,234 +5170,509 @@ func (x *fastReflection_TestAllTypes) Get(descriptor protoreflect.FieldDescripto
 //
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_TestAllTypes) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "goproto.proto.test3.TestAllTypes.singular_int32":
+	switch fd.Number() {
+	case 81: // goproto.proto.test3.TestAllTypes.singular_int32
+		if fd != fd_TestAllTypes_singular_int32 {
+			break
+		}
 		x.SingularInt32 = int32(value.Int())
-	case "goproto.proto.test3.TestAllTypes.singular_int64":
+		return
+	case 82: // goproto.proto.test3.TestAllTypes.singular_int64
+		if fd != fd_TestAllTypes_singular_int64 {
+			break
+		}
 		x.SingularInt64 = value.Int()
-	case "goproto.proto.test3.TestAllTypes.singular_uint32":
+		return
+	case 83: // goproto.proto.test3.TestAllTypes.singular_uint32
+		if fd != fd_TestAllTypes_singular_uint32 {
+			break
+		}
 		x.SingularUint32 = uint32(value.Uint())
-	case "goproto.proto.test3.TestAllTypes.singular_uint64":
+		return
+	case 84: // goproto.proto.test3.TestAllTypes.singular_uint64
+		if fd != fd_TestAllTypes_singular_uint64 {
+			break
+		}
 		x.SingularUint64 = value.Uint()
-	case "goproto.proto.test3.TestAllTypes.singular_sint32":
+		return
+	case 85: // goproto.proto.test3.TestAllTypes.singular_sint32
+		if fd != fd_TestAllTypes_singular_sint32 {
+			break
+		}
 		x.SingularSint32 = int32(value.Int())
-	case "goproto.proto.test3.TestAllTypes.singular_sint64":
+		return
+	case 86: // goproto.proto.test3.TestAllTypes.singular_sint64
+		if fd != fd_TestAllTypes_singular_sint64 {
+			break
+		}
 		x.SingularSint64 = value.Int()
-	case "goproto.proto.test3.TestAllTypes.singular_fixed32":
+		return
+	case 87: // goproto.proto.test3.TestAllTypes.singular_fixed32
+		if fd != fd_TestAllTypes_singular_fixed32 {
+			break
+		}
 		x.SingularFixed32 = uint32(value.Uint())
-	case "goproto.proto.test3.TestAllTypes.singular_fixed64":
+		return
+	case 88: // goproto.proto.test3.TestAllTypes.singular_fixed64
+		if fd != fd_TestAllTypes_singular_fixed64 {
+			break
+		}
 		x.SingularFixed64 = value.Uint()
-	case "goproto.proto.test3.TestAllTypes.singular_sfixed32":
+		return
+	case 89: // goproto.proto.test3.TestAllTypes.singular_sfixed32
+		if fd != fd_TestAllTypes_singular_sfixed32 {
+			break
+		}
 		x.SingularSfixed32 = int32(value.Int())
-	case "goproto.proto.test3.TestAllTypes.singular_sfixed64":
+		return
+	case 90: // goproto.proto.test3.TestAllTypes.singular_sfixed64
+		if fd != fd_TestAllTypes_singular_sfixed64 {
+			break
+		}
 		x.SingularSfixed64 = value.Int()
-	case "goproto.proto.test3.TestAllTypes.singular_float":
+		return
+	case 91: // goproto.proto.test3.TestAllTypes.singular_float
+		if fd != fd_TestAllTypes_singular_float {
+			break
+		}
 		x.SingularFloat = float32(value.Float())
-	case "goproto.proto.test3.TestAllTypes.singular_double":
+		return
+	case 92: // goproto.proto.test3.TestAllTypes.singular_double
+		if fd != fd_TestAllTypes_singular_double {
+			break
+		}
 		x.SingularDouble = value.Float()
-	case "goproto.proto.test3.TestAllTypes.singular_bool":
+		return
+	case 93: // goproto.proto.test3.TestAllTypes.singular_bool
+		if fd != fd_TestAllTypes_singular_bool {
+			break
+		}
 		x.SingularBool = value.Bool()
-	case "goproto.proto.test3.TestAllTypes.singular_string":
+		return
+	case 94: // goproto.proto.test3.TestAllTypes.singular_string
+		if fd != fd_TestAllTypes_singular_string {
+			break
+		}
 		x.SingularString = value.Interface().(string)
-	case "goproto.proto.test3.TestAllTypes.singular_bytes":
+		return
+	case 95: // goproto.proto.test3.TestAllTypes.singular_bytes
+		if fd != fd_TestAllTypes_singular_bytes {
+			break
+		}
 		x.SingularBytes = value.Bytes()
-	case "goproto.proto.test3.TestAllTypes.singular_nested_message":
+		return
+	case 98: // goproto.proto.test3.TestAllTypes.singular_nested_message
+		if fd != fd_TestAllTypes_singular_nested_message {
+			break
+		}
 		x.SingularNestedMessage = value.Message().Interface().(*TestAllTypes_NestedMessage)
-	case "goproto.proto.test3.TestAllTypes.singular_foreign_message":
+		return
+	case 99: // goproto.proto.test3.TestAllTypes.singular_foreign_message
+		if fd != fd_TestAllTypes_singular_foreign_message {
+			break
+		}
 		x.SingularForeignMessage = value.Message().Interface().(*ForeignMessage)
-	case "goproto.proto.test3.TestAllTypes.singular_import_message":
+		return
+	case 100: // goproto.proto.test3.TestAllTypes.singular_import_message
+		if fd != fd_TestAllTypes_singular_import_message {
+			break
+		}
 		x.SingularImportMessage = value.Message().Interface().(*ImportMessage)
-	case "goproto.proto.test3.TestAllTypes.singular_nested_enum":
+		return
+	case 101: // goproto.proto.test3.TestAllTypes.singular_nested_enum
+		if fd != fd_TestAllTypes_singular_nested_enum {
+			break
+		}
 		x.SingularNestedEnum = (TestAllTypes_NestedEnum)(value.Enum())
-	case "goproto.proto.test3.TestAllTypes.singular_foreign_enum":
+		return
+	case 102: // goproto.proto.test3.TestAllTypes.singular_foreign_enum
+		if fd != fd_TestAllTypes_singular_foreign_enum {
+			break
+		}
 		x.SingularForeignEnum = (ForeignEnum)(value.Enum())
-	case "goproto.proto.test3.TestAllTypes.singular_import_enum":
+		return
+	case 103: // goproto.proto.test3.TestAllTypes.singular_import_enum
+		if fd != fd_TestAllTypes_singular_import_enum {
+			break
+		}
 		x.SingularImportEnum = (ImportEnum)(value.Enum())
-	case "goproto.proto.test3.TestAllTypes.repeated_int32":
+		return
+	case 31: // goproto.proto.test3.TestAllTypes.repeated_int32
+		if fd != fd_TestAllTypes_repeated_int32 {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_31_list)
 		x.RepeatedInt32 = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_int64":
+		return
+	case 32: // goproto.proto.test3.TestAllTypes.repeated_int64
+		if fd != fd_TestAllTypes_repeated_int64 {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_32_list)
 		x.RepeatedInt64 = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_uint32":
+		return
+	case 33: // goproto.proto.test3.TestAllTypes.repeated_uint32
+		if fd != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_33_list)
 		x.RepeatedUint32 = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_uint64":
+		return
+	case 34: // goproto.proto.test3.TestAllTypes.repeated_uint64
+		if fd != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_34_list)
 		x.RepeatedUint64 = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_sint32":
+		return
+	case 35: // goproto.proto.test3.TestAllTypes.repeated_sint32
+		if fd != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_35_list)
 		x.RepeatedSint32 = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_sint64":
+		return
+	case 36: // goproto.proto.test3.TestAllTypes.repeated_sint64
+		if fd != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_36_list)
 		x.RepeatedSint64 = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_fixed32":
+		return
+	case 37: // goproto.proto.test3.TestAllTypes.repeated_fixed32
+		if fd != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_37_list)
 		x.RepeatedFixed32 = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_fixed64":
+		return
+	case 38: // goproto.proto.test3.TestAllTypes.repeated_fixed64
+		if fd != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_38_list)
 		x.RepeatedFixed64 = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_sfixed32":
+		return
+	case 39: // goproto.proto.test3.TestAllTypes.repeated_sfixed32
+		if fd != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_39_list)
 		x.RepeatedSfixed32 = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_sfixed64":
+		return
+	case 40: // goproto.proto.test3.TestAllTypes.repeated_sfixed64
+		if fd != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_40_list)
 		x.RepeatedSfixed64 = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_float":
+		return
+	case 41: // goproto.proto.test3.TestAllTypes.repeated_float
+		if fd != fd_TestAllTypes_repeated_float {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_41_list)
 		x.RepeatedFloat = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_double":
+		return
+	case 42: // goproto.proto.test3.TestAllTypes.repeated_double
+		if fd != fd_TestAllTypes_repeated_double {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_42_list)
 		x.RepeatedDouble = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_bool":
+		return
+	case 43: // goproto.proto.test3.TestAllTypes.repeated_bool
+		if fd != fd_TestAllTypes_repeated_bool {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_43_list)
 		x.RepeatedBool = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_string":
+		return
+	case 44: // goproto.proto.test3.TestAllTypes.repeated_string
+		if fd != fd_TestAllTypes_repeated_string {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_44_list)
 		x.RepeatedString = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_bytes":
+		return
+	case 45: // goproto.proto.test3.TestAllTypes.repeated_bytes
+		if fd != fd_TestAllTypes_repeated_bytes {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_45_list)
 		x.RepeatedBytes = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_nested_message":
+		return
+	case 48: // goproto.proto.test3.TestAllTypes.repeated_nested_message
+		if fd != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_48_list)
 		x.RepeatedNestedMessage = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_foreign_message":
+		return
+	case 49: // goproto.proto.test3.TestAllTypes.repeated_foreign_message
+		if fd != fd_TestAllTypes_repeated_foreign_message {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_49_list)
 		x.RepeatedForeignMessage = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_importmessage":
+		return
+	case 50: // goproto.proto.test3.TestAllTypes.repeated_importmessage
+		if fd != fd_TestAllTypes_repeated_importmessage {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_50_list)
 		x.RepeatedImportmessage = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_nested_enum":
+		return
+	case 51: // goproto.proto.test3.TestAllTypes.repeated_nested_enum
+		if fd != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_51_list)
 		x.RepeatedNestedEnum = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_foreign_enum":
+		return
+	case 52: // goproto.proto.test3.TestAllTypes.repeated_foreign_enum
+		if fd != fd_TestAllTypes_repeated_foreign_enum {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_52_list)
 		x.RepeatedForeignEnum = *clv.list
-	case "goproto.proto.test3.TestAllTypes.repeated_importenum":
+		return
+	case 53: // goproto.proto.test3.TestAllTypes.repeated_importenum
+		if fd != fd_TestAllTypes_repeated_importenum {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_TestAllTypes_53_list)
 		x.RepeatedImportenum = *clv.list
-	case "goproto.proto.test3.TestAllTypes.map_int32_int32":
+		return
+	case 56: // goproto.proto.test3.TestAllTypes.map_int32_int32
+		if fd != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_56_map)
 		x.MapInt32Int32 = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.map_int64_int64":
+		return
+	case 57: // goproto.proto.test3.TestAllTypes.map_int64_int64
+		if fd != fd_TestAllTypes_map_int64_int64 {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_57_map)
 		x.MapInt64Int64 = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.map_uint32_uint32":
+		return
+	case 58: // goproto.proto.test3.TestAllTypes.map_uint32_uint32
+		if fd != fd_TestAllTypes_map_uint32_uint32 {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_58_map)
 		x.MapUint32Uint32 = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.map_uint64_uint64":
+		return
+	case 59: // goproto.proto.test3.TestAllTypes.map_uint64_uint64
+		if fd != fd_TestAllTypes_map_uint64_uint64 {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_59_map)
 		x.MapUint64Uint64 = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.map_sint32_sint32":
+		return
+	case 60: // goproto.proto.test3.TestAllTypes.map_sint32_sint32
+		if fd != fd_TestAllTypes_map_sint32_sint32 {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_60_map)
 		x.MapSint32Sint32 = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.map_sint64_sint64":
+		return
+	case 61: // goproto.proto.test3.TestAllTypes.map_sint64_sint64
+		if fd != fd_TestAllTypes_map_sint64_sint64 {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_61_map)
 		x.MapSint64Sint64 = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.map_fixed32_fixed32":
+		return
+	case 62: // goproto.proto.test3.TestAllTypes.map_fixed32_fixed32
+		if fd != fd_TestAllTypes_map_fixed32_fixed32 {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_62_map)
 		x.MapFixed32Fixed32 = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.map_fixed64_fixed64":
+		return
+	case 63: // goproto.proto.test3.TestAllTypes.map_fixed64_fixed64
+		if fd != fd_TestAllTypes_map_fixed64_fixed64 {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_63_map)
 		x.MapFixed64Fixed64 = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.map_sfixed32_sfixed32":
+		return
+	case 64: // goproto.proto.test3.TestAllTypes.map_sfixed32_sfixed32
+		if fd != fd_TestAllTypes_map_sfixed32_sfixed32 {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_64_map)
 		x.MapSfixed32Sfixed32 = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.map_sfixed64_sfixed64":
+		return
+	case 65: // goproto.proto.test3.TestAllTypes.map_sfixed64_sfixed64
+		if fd != fd_TestAllTypes_map_sfixed64_sfixed64 {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_65_map)
 		x.MapSfixed64Sfixed64 = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.map_int32_float":
+		return
+	case 66: // goproto.proto.test3.TestAllTypes.map_int32_float
+		if fd != fd_TestAllTypes_map_int32_float {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_66_map)
 		x.MapInt32Float = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.map_int32_double":
+		return
+	case 67: // goproto.proto.test3.TestAllTypes.map_int32_double
+		if fd != fd_TestAllTypes_map_int32_double {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_67_map)
 		x.MapInt32Double = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.map_bool_bool":
+		return
+	case 68: // goproto.proto.test3.TestAllTypes.map_bool_bool
+		if fd != fd_TestAllTypes_map_bool_bool {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_68_map)
 		x.MapBoolBool = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.map_string_string":
+		return
+	case 69: // goproto.proto.test3.TestAllTypes.map_string_string
+		if fd != fd_TestAllTypes_map_string_string {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_69_map)
 		x.MapStringString = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.map_string_bytes":
+		return
+	case 70: // goproto.proto.test3.TestAllTypes.map_string_bytes
+		if fd != fd_TestAllTypes_map_string_bytes {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_70_map)
 		x.MapStringBytes = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.map_string_nested_message":
+		return
+	case 71: // goproto.proto.test3.TestAllTypes.map_string_nested_message
+		if fd != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_71_map)
 		x.MapStringNestedMessage = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.map_string_nested_enum":
+		return
+	case 73: // goproto.proto.test3.TestAllTypes.map_string_nested_enum
+		if fd != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_TestAllTypes_73_map)
 		x.MapStringNestedEnum = *cmv.m
-	case "goproto.proto.test3.TestAllTypes.oneof_uint32":
+		return
+	case 111: // goproto.proto.test3.TestAllTypes.oneof_uint32
+		if fd != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
 		cv := uint32(value.Uint())
 		x.OneofField = &TestAllTypes_OneofUint32{OneofUint32: cv}
-	case "goproto.proto.test3.TestAllTypes.oneof_nested_message":
+		return
+	case 112: // goproto.proto.test3.TestAllTypes.oneof_nested_message
+		if fd != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
 		cv := value.Message().Interface().(*TestAllTypes_NestedMessage)
 		x.OneofField = &TestAllTypes_OneofNestedMessage{OneofNestedMessage: cv}
-	case "goproto.proto.test3.TestAllTypes.oneof_string":
+		return
+	case 113: // goproto.proto.test3.TestAllTypes.oneof_string
+		if fd != fd_TestAllTypes_oneof_string {
+			break
+		}
 		cv := value.Interface().(string)
 		x.OneofField = &TestAllTypes_OneofString{OneofString: cv}
-	case "goproto.proto.test3.TestAllTypes.oneof_bytes":
+		return
+	case 114: // goproto.proto.test3.TestAllTypes.oneof_bytes
+		if fd != fd_TestAllTypes_oneof_bytes {
+			break
+		}
 		cv := value.Bytes()
 		x.OneofField = &TestAllTypes_OneofBytes{OneofBytes: cv}
-	case "goproto.proto.test3.TestAllTypes.oneof_bool":
+		return
+	case 115: // goproto.proto.test3.TestAllTypes.oneof_bool
+		if fd != fd_TestAllTypes_oneof_bool {
+			break
+		}
 		cv := value.Bool()
 		x.OneofField = &TestAllTypes_OneofBool{OneofBool: cv}
-	case "goproto.proto.test3.TestAllTypes.oneof_uint64":
+		return
+	case 116: // goproto.proto.test3.TestAllTypes.oneof_uint64
+		if fd != fd_TestAllTypes_oneof_uint64 {
+			break
+		}
 		cv := value.Uint()
 		x.OneofField = &TestAllTypes_OneofUint64{OneofUint64: cv}
-	case "goproto.proto.test3.TestAllTypes.oneof_float":
+		return
+	case 117: // goproto.proto.test3.TestAllTypes.oneof_float
+		if fd != fd_TestAllTypes_oneof_float {
+			break
+		}
 		cv := float32(value.Float())
 		x.OneofField = &TestAllTypes_OneofFloat{OneofFloat: cv}
-	case "goproto.proto.test3.TestAllTypes.oneof_double":
+		return
+	case 118: // goproto.proto.test3.TestAllTypes.oneof_double
+		if fd != fd_TestAllTypes_oneof_double {
+			break
+		}
 		cv := value.Float()
 		x.OneofField = &TestAllTypes_OneofDouble{OneofDouble: cv}
-	case "goproto.proto.test3.TestAllTypes.oneof_enum":
+		return
+	case 119: // goproto.proto.test3.TestAllTypes.oneof_enum
+		if fd != fd_TestAllTypes_oneof_enum {
+			break
+		}
 		cv := (TestAllTypes_NestedEnum)(value.Enum())
 		x.OneofField = &TestAllTypes_OneofEnum{OneofEnum: cv}
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.TestAllTypes"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not contain field %s", fd.FullName()))
+		return
 	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not contain field %s", fd.FullName()))
 }
 
 // Mutable returns a mutable reference to a composite type.
@@ -4016,251 +5686,377 @@ func (x *fastReflection_TestAllTypes) Set(fd protoreflect.FieldDescriptor, value
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_TestAllTypes) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "goproto.proto.test3.TestAllTypes.singular_nested_message":
+	switch fd.Number() {
+	case 98: // goproto.proto.test3.TestAllTypes.singular_nested_message
+		if fd != fd_TestAllTypes_singular_nested_message {
+			break
+		}
 		if x.SingularNestedMessage == nil {
 			x.SingularNestedMessage = new(TestAllTypes_NestedMessage)
 		}
 		return protoreflect.ValueOfMessage(x.SingularNestedMessage.ProtoReflect())
-	case "goproto.proto.test3.TestAllTypes.singular_foreign_message":
+	case 99: // goproto.proto.test3.TestAllTypes.singular_foreign_message
+		if fd != fd_TestAllTypes_singular_foreign_message {
+			break
+		}
 		if x.SingularForeignMessage == nil {
 			x.SingularForeignMessage = new(ForeignMessage)
 		}
 		return protoreflect.ValueOfMessage(x.SingularForeignMessage.ProtoReflect())
-	case "goproto.proto.test3.TestAllTypes.singular_import_message":
+	case 100: // goproto.proto.test3.TestAllTypes.singular_import_message
+		if fd != fd_TestAllTypes_singular_import_message {
+			break
+		}
 		if x.SingularImportMessage == nil {
 			x.SingularImportMessage = new(ImportMessage)
 		}
 		return protoreflect.ValueOfMessage(x.SingularImportMessage.ProtoReflect())
-	case "goproto.proto.test3.TestAllTypes.repeated_int32":
+	case 31: // goproto.proto.test3.TestAllTypes.repeated_int32
+		if fd != fd_TestAllTypes_repeated_int32 {
+			break
+		}
 		if x.RepeatedInt32 == nil {
 			x.RepeatedInt32 = []int32{}
 		}
 		value := &_TestAllTypes_31_list{list: &x.RepeatedInt32}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_int64":
-		if x.RepeatedInt64 == nil {
+	case 32: // goproto.proto.test3.TestAllTypes.repeated_int64
+		if fd != fd_TestAllTypes_repeated_int64 {
+			break
+		}
+		if x.RepeatedInt64 == nil {
 			x.RepeatedInt64 = []int64{}
 		}
 		value := &_TestAllTypes_32_list{list: &x.RepeatedInt64}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_uint32":
+	case 33: // goproto.proto.test3.TestAllTypes.repeated_uint32
+		if fd != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
 		if x.RepeatedUint32 == nil {
 			x.RepeatedUint32 = []uint32{}
 		}
 		value := &_TestAllTypes_33_list{list: &x.RepeatedUint32}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_uint64":
+	case 34: // goproto.proto.test3.TestAllTypes.repeated_uint64
+		if fd != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
 		if x.RepeatedUint64 == nil {
 			x.RepeatedUint64 = []uint64{}
 		}
 		value := &_TestAllTypes_34_list{list: &x.RepeatedUint64}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_sint32":
+	case 35: // goproto.proto.test3.TestAllTypes.repeated_sint32
+		if fd != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
 		if x.RepeatedSint32 == nil {
 			x.RepeatedSint32 = []int32{}
 		}
 		value := &_TestAllTypes_35_list{list: &x.RepeatedSint32}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_sint64":
+	case 36: // goproto.proto.test3.TestAllTypes.repeated_sint64
+		if fd != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
 		if x.RepeatedSint64 == nil {
 			x.RepeatedSint64 = []int64{}
 		}
 		value := &_TestAllTypes_36_list{list: &x.RepeatedSint64}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_fixed32":
+	case 37: // goproto.proto.test3.TestAllTypes.repeated_fixed32
+		if fd != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
 		if x.RepeatedFixed32 == nil {
 			x.RepeatedFixed32 = []uint32{}
 		}
 		value := &_TestAllTypes_37_list{list: &x.RepeatedFixed32}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_fixed64":
+	case 38: // goproto.proto.test3.TestAllTypes.repeated_fixed64
+		if fd != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
 		if x.RepeatedFixed64 == nil {
 			x.RepeatedFixed64 = []uint64{}
 		}
 		value := &_TestAllTypes_38_list{list: &x.RepeatedFixed64}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_sfixed32":
+	case 39: // goproto.proto.test3.TestAllTypes.repeated_sfixed32
+		if fd != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
 		if x.RepeatedSfixed32 == nil {
 			x.RepeatedSfixed32 = []int32{}
 		}
 		value := &_TestAllTypes_39_list{list: &x.RepeatedSfixed32}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_sfixed64":
+	case 40: // goproto.proto.test3.TestAllTypes.repeated_sfixed64
+		if fd != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
 		if x.RepeatedSfixed64 == nil {
 			x.RepeatedSfixed64 = []int64{}
 		}
 		value := &_TestAllTypes_40_list{list: &x.RepeatedSfixed64}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_float":
+	case 41: // goproto.proto.test3.TestAllTypes.repeated_float
+		if fd != fd_TestAllTypes_repeated_float {
+			break
+		}
 		if x.RepeatedFloat == nil {
 			x.RepeatedFloat = []float32{}
 		}
 		value := &_TestAllTypes_41_list{list: &x.RepeatedFloat}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_double":
+	case 42: // goproto.proto.test3.TestAllTypes.repeated_double
+		if fd != fd_TestAllTypes_repeated_double {
+			break
+		}
 		if x.RepeatedDouble == nil {
 			x.RepeatedDouble = []float64{}
 		}
 		value := &_TestAllTypes_42_list{list: &x.RepeatedDouble}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_bool":
+	case 43: // goproto.proto.test3.TestAllTypes.repeated_bool
+		if fd != fd_TestAllTypes_repeated_bool {
+			break
+		}
 		if x.RepeatedBool == nil {
 			x.RepeatedBool = []bool{}
 		}
 		value := &_TestAllTypes_43_list{list: &x.RepeatedBool}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_string":
+	case 44: // goproto.proto.test3.TestAllTypes.repeated_string
+		if fd != fd_TestAllTypes_repeated_string {
+			break
+		}
 		if x.RepeatedString == nil {
 			x.RepeatedString = []string{}
 		}
 		value := &_TestAllTypes_44_list{list: &x.RepeatedString}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_bytes":
+	case 45: // goproto.proto.test3.TestAllTypes.repeated_bytes
+		if fd != fd_TestAllTypes_repeated_bytes {
+			break
+		}
 		if x.RepeatedBytes == nil {
 			x.RepeatedBytes = [][]byte{}
 		}
 		value := &_TestAllTypes_45_list{list: &x.RepeatedBytes}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_nested_message":
+	case 48: // goproto.proto.test3.TestAllTypes.repeated_nested_message
+		if fd != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
 		if x.RepeatedNestedMessage == nil {
 			x.RepeatedNestedMessage = []*TestAllTypes_NestedMessage{}
 		}
 		value := &_TestAllTypes_48_list{list: &x.RepeatedNestedMessage}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_foreign_message":
+	case 49: // goproto.proto.test3.TestAllTypes.repeated_foreign_message
+		if fd != fd_TestAllTypes_repeated_foreign_message {
+			break
+		}
 		if x.RepeatedForeignMessage == nil {
 			x.RepeatedForeignMessage = []*ForeignMessage{}
 		}
 		value := &_TestAllTypes_49_list{list: &x.RepeatedForeignMessage}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_importmessage":
+	case 50: // goproto.proto.test3.TestAllTypes.repeated_importmessage
+		if fd != fd_TestAllTypes_repeated_importmessage {
+			break
+		}
 		if x.RepeatedImportmessage == nil {
 			x.RepeatedImportmessage = []*ImportMessage{}
 		}
 		value := &_TestAllTypes_50_list{list: &x.RepeatedImportmessage}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_nested_enum":
+	case 51: // goproto.proto.test3.TestAllTypes.repeated_nested_enum
+		if fd != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
 		if x.RepeatedNestedEnum == nil {
 			x.RepeatedNestedEnum = []TestAllTypes_NestedEnum{}
 		}
 		value := &_TestAllTypes_51_list{list: &x.RepeatedNestedEnum}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_foreign_enum":
+	case 52: // goproto.proto.test3.TestAllTypes.repeated_foreign_enum
+		if fd != fd_TestAllTypes_repeated_foreign_enum {
+			break
+		}
 		if x.RepeatedForeignEnum == nil {
 			x.RepeatedForeignEnum = []ForeignEnum{}
 		}
 		value := &_TestAllTypes_52_list{list: &x.RepeatedForeignEnum}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.repeated_importenum":
+	case 53: // goproto.proto.test3.TestAllTypes.repeated_importenum
+		if fd != fd_TestAllTypes_repeated_importenum {
+			break
+		}
 		if x.RepeatedImportenum == nil {
 			x.RepeatedImportenum = []ImportEnum{}
 		}
 		value := &_TestAllTypes_53_list{list: &x.RepeatedImportenum}
 		return protoreflect.ValueOfList(value)
-	case "goproto.proto.test3.TestAllTypes.map_int32_int32":
+	case 56: // goproto.proto.test3.TestAllTypes.map_int32_int32
+		if fd != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
 		if x.MapInt32Int32 == nil {
 			x.MapInt32Int32 = make(map[int32]int32)
 		}
 		value := &_TestAllTypes_56_map{m: &x.MapInt32Int32}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.map_int64_int64":
+	case 57: // goproto.proto.test3.TestAllTypes.map_int64_int64
+		if fd != fd_TestAllTypes_map_int64_int64 {
+			break
+		}
 		if x.MapInt64Int64 == nil {
 			x.MapInt64Int64 = make(map[int64]int64)
 		}
 		value := &_TestAllTypes_57_map{m: &x.MapInt64Int64}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.map_uint32_uint32":
+	case 58: // goproto.proto.test3.TestAllTypes.map_uint32_uint32
+		if fd != fd_TestAllTypes_map_uint32_uint32 {
+			break
+		}
 		if x.MapUint32Uint32 == nil {
 			x.MapUint32Uint32 = make(map[uint32]uint32)
 		}
 		value := &_TestAllTypes_58_map{m: &x.MapUint32Uint32}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.map_uint64_uint64":
+	case 59: // goproto.proto.test3.TestAllTypes.map_uint64_uint64
+		if fd != fd_TestAllTypes_map_uint64_uint64 {
+			break
+		}
 		if x.MapUint64Uint64 == nil {
 			x.MapUint64Uint64 = make(map[uint64]uint64)
 		}
 		value := &_TestAllTypes_59_map{m: &x.MapUint64Uint64}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.map_sint32_sint32":
+	case 60: // goproto.proto.test3.TestAllTypes.map_sint32_sint32
+		if fd != fd_TestAllTypes_map_sint32_sint32 {
+			break
+		}
 		if x.MapSint32Sint32 == nil {
 			x.MapSint32Sint32 = make(map[int32]int32)
 		}
 		value := &_TestAllTypes_60_map{m: &x.MapSint32Sint32}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.map_sint64_sint64":
+	case 61: // goproto.proto.test3.TestAllTypes.map_sint64_sint64
+		if fd != fd_TestAllTypes_map_sint64_sint64 {
+			break
+		}
 		if x.MapSint64Sint64 == nil {
 			x.MapSint64Sint64 = make(map[int64]int64)
 		}
 		value := &_TestAllTypes_61_map{m: &x.MapSint64Sint64}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.map_fixed32_fixed32":
+	case 62: // goproto.proto.test3.TestAllTypes.map_fixed32_fixed32
+		if fd != fd_TestAllTypes_map_fixed32_fixed32 {
+			break
+		}
 		if x.MapFixed32Fixed32 == nil {
 			x.MapFixed32Fixed32 = make(map[uint32]uint32)
 		}
 		value := &_TestAllTypes_62_map{m: &x.MapFixed32Fixed32}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.map_fixed64_fixed64":
+	case 63: // goproto.proto.test3.TestAllTypes.map_fixed64_fixed64
+		if fd != fd_TestAllTypes_map_fixed64_fixed64 {
+			break
+		}
 		if x.MapFixed64Fixed64 == nil {
 			x.MapFixed64Fixed64 = make(map[uint64]uint64)
 		}
 		value := &_TestAllTypes_63_map{m: &x.MapFixed64Fixed64}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.map_sfixed32_sfixed32":
+	case 64: // goproto.proto.test3.TestAllTypes.map_sfixed32_sfixed32
+		if fd != fd_TestAllTypes_map_sfixed32_sfixed32 {
+			break
+		}
 		if x.MapSfixed32Sfixed32 == nil {
 			x.MapSfixed32Sfixed32 = make(map[int32]int32)
 		}
 		value := &_TestAllTypes_64_map{m: &x.MapSfixed32Sfixed32}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.map_sfixed64_sfixed64":
+	case 65: // goproto.proto.test3.TestAllTypes.map_sfixed64_sfixed64
+		if fd != fd_TestAllTypes_map_sfixed64_sfixed64 {
+			break
+		}
 		if x.MapSfixed64Sfixed64 == nil {
 			x.MapSfixed64Sfixed64 = make(map[int64]int64)
 		}
 		value := &_TestAllTypes_65_map{m: &x.MapSfixed64Sfixed64}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.map_int32_float":
+	case 66: // goproto.proto.test3.TestAllTypes.map_int32_float
+		if fd != fd_TestAllTypes_map_int32_float {
+			break
+		}
 		if x.MapInt32Float == nil {
 			x.MapInt32Float = make(map[int32]float32)
 		}
 		value := &_TestAllTypes_66_map{m: &x.MapInt32Float}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.map_int32_double":
+	case 67: // goproto.proto.test3.TestAllTypes.map_int32_double
+		if fd != fd_TestAllTypes_map_int32_double {
+			break
+		}
 		if x.MapInt32Double == nil {
 			x.MapInt32Double = make(map[int32]float64)
 		}
 		value := &_TestAllTypes_67_map{m: &x.MapInt32Double}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.map_bool_bool":
+	case 68: // goproto.proto.test3.TestAllTypes.map_bool_bool
+		if fd != fd_TestAllTypes_map_bool_bool {
+			break
+		}
 		if x.MapBoolBool == nil {
 			x.MapBoolBool = make(map[bool]bool)
 		}
 		value := &_TestAllTypes_68_map{m: &x.MapBoolBool}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.map_string_string":
+	case 69: // goproto.proto.test3.TestAllTypes.map_string_string
+		if fd != fd_TestAllTypes_map_string_string {
+			break
+		}
 		if x.MapStringString == nil {
 			x.MapStringString = make(map[string]string)
 		}
 		value := &_TestAllTypes_69_map{m: &x.MapStringString}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.map_string_bytes":
+	case 70: // goproto.proto.test3.TestAllTypes.map_string_bytes
+		if fd != fd_TestAllTypes_map_string_bytes {
+			break
+		}
 		if x.MapStringBytes == nil {
 			x.MapStringBytes = make(map[string][]byte)
 		}
 		value := &_TestAllTypes_70_map{m: &x.MapStringBytes}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.map_string_nested_message":
+	case 71: // goproto.proto.test3.TestAllTypes.map_string_nested_message
+		if fd != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
 		if x.MapStringNestedMessage == nil {
 			x.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage)
 		}
 		value := &_TestAllTypes_71_map{m: &x.MapStringNestedMessage}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.map_string_nested_enum":
+	case 73: // goproto.proto.test3.TestAllTypes.map_string_nested_enum
+		if fd != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
 		if x.MapStringNestedEnum == nil {
 			x.MapStringNestedEnum = make(map[string]TestAllTypes_NestedEnum)
 		}
 		value := &_TestAllTypes_73_map{m: &x.MapStringNestedEnum}
 		return protoreflect.ValueOfMap(value)
-	case "goproto.proto.test3.TestAllTypes.oneof_nested_message":
+	case 112: // goproto.proto.test3.TestAllTypes.oneof_nested_message
+		if fd != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
 		if x.OneofField == nil {
 			value := &TestAllTypes_NestedMessage{}
 			oneofValue := &TestAllTypes_OneofNestedMessage{OneofNestedMessage: value}
@@ -4276,255 +6072,541 @@ func (x *fastReflection_TestAllTypes) Mutable(fd protoreflect.FieldDescriptor) p
 			x.OneofField = oneofValue
 			return protoreflect.ValueOfMessage(value.ProtoReflect())
 		}
-	case "goproto.proto.test3.TestAllTypes.singular_int32":
+	case 81: // goproto.proto.test3.TestAllTypes.singular_int32
+		if fd != fd_TestAllTypes_singular_int32 {
+			break
+		}
 		panic(fmt.Errorf("field singular_int32 of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_int64":
+	case 82: // goproto.proto.test3.TestAllTypes.singular_int64
+		if fd != fd_TestAllTypes_singular_int64 {
+			break
+		}
 		panic(fmt.Errorf("field singular_int64 of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_uint32":
+	case 83: // goproto.proto.test3.TestAllTypes.singular_uint32
+		if fd != fd_TestAllTypes_singular_uint32 {
+			break
+		}
 		panic(fmt.Errorf("field singular_uint32 of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_uint64":
+	case 84: // goproto.proto.test3.TestAllTypes.singular_uint64
+		if fd != fd_TestAllTypes_singular_uint64 {
+			break
+		}
 		panic(fmt.Errorf("field singular_uint64 of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_sint32":
+	case 85: // goproto.proto.test3.TestAllTypes.singular_sint32
+		if fd != fd_TestAllTypes_singular_sint32 {
+			break
+		}
 		panic(fmt.Errorf("field singular_sint32 of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_sint64":
+	case 86: // goproto.proto.test3.TestAllTypes.singular_sint64
+		if fd != fd_TestAllTypes_singular_sint64 {
+			break
+		}
 		panic(fmt.Errorf("field singular_sint64 of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_fixed32":
+	case 87: // goproto.proto.test3.TestAllTypes.singular_fixed32
+		if fd != fd_TestAllTypes_singular_fixed32 {
+			break
+		}
 		panic(fmt.Errorf("field singular_fixed32 of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_fixed64":
+	case 88: // goproto.proto.test3.TestAllTypes.singular_fixed64
+		if fd != fd_TestAllTypes_singular_fixed64 {
+			break
+		}
 		panic(fmt.Errorf("field singular_fixed64 of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_sfixed32":
+	case 89: // goproto.proto.test3.TestAllTypes.singular_sfixed32
+		if fd != fd_TestAllTypes_singular_sfixed32 {
+			break
+		}
 		panic(fmt.Errorf("field singular_sfixed32 of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_sfixed64":
+	case 90: // goproto.proto.test3.TestAllTypes.singular_sfixed64
+		if fd != fd_TestAllTypes_singular_sfixed64 {
+			break
+		}
 		panic(fmt.Errorf("field singular_sfixed64 of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_float":
+	case 91: // goproto.proto.test3.TestAllTypes.singular_float
+		if fd != fd_TestAllTypes_singular_float {
+			break
+		}
 		panic(fmt.Errorf("field singular_float of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_double":
+	case 92: // goproto.proto.test3.TestAllTypes.singular_double
+		if fd != fd_TestAllTypes_singular_double {
+			break
+		}
 		panic(fmt.Errorf("field singular_double of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_bool":
+	case 93: // goproto.proto.test3.TestAllTypes.singular_bool
+		if fd != fd_TestAllTypes_singular_bool {
+			break
+		}
 		panic(fmt.Errorf("field singular_bool of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_string":
+	case 94: // goproto.proto.test3.TestAllTypes.singular_string
+		if fd != fd_TestAllTypes_singular_string {
+			break
+		}
 		panic(fmt.Errorf("field singular_string of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_bytes":
+	case 95: // goproto.proto.test3.TestAllTypes.singular_bytes
+		if fd != fd_TestAllTypes_singular_bytes {
+			break
+		}
 		panic(fmt.Errorf("field singular_bytes of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_nested_enum":
+	case 101: // goproto.proto.test3.TestAllTypes.singular_nested_enum
+		if fd != fd_TestAllTypes_singular_nested_enum {
+			break
+		}
 		panic(fmt.Errorf("field singular_nested_enum of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_foreign_enum":
+	case 102: // goproto.proto.test3.TestAllTypes.singular_foreign_enum
+		if fd != fd_TestAllTypes_singular_foreign_enum {
+			break
+		}
 		panic(fmt.Errorf("field singular_foreign_enum of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.singular_import_enum":
+	case 103: // goproto.proto.test3.TestAllTypes.singular_import_enum
+		if fd != fd_TestAllTypes_singular_import_enum {
+			break
+		}
 		panic(fmt.Errorf("field singular_import_enum of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.oneof_uint32":
+	case 111: // goproto.proto.test3.TestAllTypes.oneof_uint32
+		if fd != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
 		panic(fmt.Errorf("field oneof_uint32 of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.oneof_string":
+	case 113: // goproto.proto.test3.TestAllTypes.oneof_string
+		if fd != fd_TestAllTypes_oneof_string {
+			break
+		}
 		panic(fmt.Errorf("field oneof_string of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.oneof_bytes":
+	case 114: // goproto.proto.test3.TestAllTypes.oneof_bytes
+		if fd != fd_TestAllTypes_oneof_bytes {
+			break
+		}
 		panic(fmt.Errorf("field oneof_bytes of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.oneof_bool":
+	case 115: // goproto.proto.test3.TestAllTypes.oneof_bool
+		if fd != fd_TestAllTypes_oneof_bool {
+			break
+		}
 		panic(fmt.Errorf("field oneof_bool of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.oneof_uint64":
+	case 116: // goproto.proto.test3.TestAllTypes.oneof_uint64
+		if fd != fd_TestAllTypes_oneof_uint64 {
+			break
+		}
 		panic(fmt.Errorf("field oneof_uint64 of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.oneof_float":
+	case 117: // goproto.proto.test3.TestAllTypes.oneof_float
+		if fd != fd_TestAllTypes_oneof_float {
+			break
+		}
 		panic(fmt.Errorf("field oneof_float of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.oneof_double":
+	case 118: // goproto.proto.test3.TestAllTypes.oneof_double
+		if fd != fd_TestAllTypes_oneof_double {
+			break
+		}
 		panic(fmt.Errorf("field oneof_double of message goproto.proto.test3.TestAllTypes is not mutable"))
-	case "goproto.proto.test3.TestAllTypes.oneof_enum":
-		panic(fmt.Errorf("field oneof_enum of message goproto.proto.test3.TestAllTypes is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.TestAllTypes"))
+	case 119: // goproto.proto.test3.TestAllTypes.oneof_enum
+		if fd != fd_TestAllTypes_oneof_enum {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("field oneof_enum of message goproto.proto.test3.TestAllTypes is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes); fd != nil {
+		return x.Mutable(fd)
 	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not contain field %s", fd.FullName()))
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_TestAllTypes) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "goproto.proto.test3.TestAllTypes.singular_int32":
+	switch fd.Number() {
+	case 81: // goproto.proto.test3.TestAllTypes.singular_int32
+		if fd != fd_TestAllTypes_singular_int32 {
+			break
+		}
 		return protoreflect.ValueOfInt32(int32(0))
-	case "goproto.proto.test3.TestAllTypes.singular_int64":
+	case 82: // goproto.proto.test3.TestAllTypes.singular_int64
+		if fd != fd_TestAllTypes_singular_int64 {
+			break
+		}
 		return protoreflect.ValueOfInt64(int64(0))
-	case "goproto.proto.test3.TestAllTypes.singular_uint32":
+	case 83: // goproto.proto.test3.TestAllTypes.singular_uint32
+		if fd != fd_TestAllTypes_singular_uint32 {
+			break
+		}
 		return protoreflect.ValueOfUint32(uint32(0))
-	case "goproto.proto.test3.TestAllTypes.singular_uint64":
+	case 84: // goproto.proto.test3.TestAllTypes.singular_uint64
+		if fd != fd_TestAllTypes_singular_uint64 {
+			break
+		}
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "goproto.proto.test3.TestAllTypes.singular_sint32":
+	case 85: // goproto.proto.test3.TestAllTypes.singular_sint32
+		if fd != fd_TestAllTypes_singular_sint32 {
+			break
+		}
 		return protoreflect.ValueOfInt32(int32(0))
-	case "goproto.proto.test3.TestAllTypes.singular_sint64":
+	case 86: // goproto.proto.test3.TestAllTypes.singular_sint64
+		if fd != fd_TestAllTypes_singular_sint64 {
+			break
+		}
 		return protoreflect.ValueOfInt64(int64(0))
-	case "goproto.proto.test3.TestAllTypes.singular_fixed32":
+	case 87: // goproto.proto.test3.TestAllTypes.singular_fixed32
+		if fd != fd_TestAllTypes_singular_fixed32 {
+			break
+		}
 		return protoreflect.ValueOfUint32(uint32(0))
-	case "goproto.proto.test3.TestAllTypes.singular_fixed64":
+	case 88: // goproto.proto.test3.TestAllTypes.singular_fixed64
+		if fd != fd_TestAllTypes_singular_fixed64 {
+			break
+		}
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "goproto.proto.test3.TestAllTypes.singular_sfixed32":
+	case 89: // goproto.proto.test3.TestAllTypes.singular_sfixed32
+		if fd != fd_TestAllTypes_singular_sfixed32 {
+			break
+		}
 		return protoreflect.ValueOfInt32(int32(0))
-	case "goproto.proto.test3.TestAllTypes.singular_sfixed64":
+	case 90: // goproto.proto.test3.TestAllTypes.singular_sfixed64
+		if fd != fd_TestAllTypes_singular_sfixed64 {
+			break
+		}
 		return protoreflect.ValueOfInt64(int64(0))
-	case "goproto.proto.test3.TestAllTypes.singular_float":
+	case 91: // goproto.proto.test3.TestAllTypes.singular_float
+		if fd != fd_TestAllTypes_singular_float {
+			break
+		}
 		return protoreflect.ValueOfFloat32(float32(0))
-	case "goproto.proto.test3.TestAllTypes.singular_double":
+	case 92: // goproto.proto.test3.TestAllTypes.singular_double
+		if fd != fd_TestAllTypes_singular_double {
+			break
+		}
 		return protoreflect.ValueOfFloat64(float64(0))
-	case "goproto.proto.test3.TestAllTypes.singular_bool":
+	case 93: // goproto.proto.test3.TestAllTypes.singular_bool
+		if fd != fd_TestAllTypes_singular_bool {
+			break
+		}
 		return protoreflect.ValueOfBool(false)
-	case "goproto.proto.test3.TestAllTypes.singular_string":
+	case 94: // goproto.proto.test3.TestAllTypes.singular_string
+		if fd != fd_TestAllTypes_singular_string {
+			break
+		}
 		return protoreflect.ValueOfString("")
-	case "goproto.proto.test3.TestAllTypes.singular_bytes":
+	case 95: // goproto.proto.test3.TestAllTypes.singular_bytes
+		if fd != fd_TestAllTypes_singular_bytes {
+			break
+		}
 		return protoreflect.ValueOfBytes(nil)
-	case "goproto.proto.test3.TestAllTypes.singular_nested_message":
+	case 98: // goproto.proto.test3.TestAllTypes.singular_nested_message
+		if fd != fd_TestAllTypes_singular_nested_message {
+			break
+		}
 		m := new(TestAllTypes_NestedMessage)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "goproto.proto.test3.TestAllTypes.singular_foreign_message":
+	case 99: // goproto.proto.test3.TestAllTypes.singular_foreign_message
+		if fd != fd_TestAllTypes_singular_foreign_message {
+			break
+		}
 		m := new(ForeignMessage)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "goproto.proto.test3.TestAllTypes.singular_import_message":
+	case 100: // goproto.proto.test3.TestAllTypes.singular_import_message
+		if fd != fd_TestAllTypes_singular_import_message {
+			break
+		}
 		m := new(ImportMessage)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "goproto.proto.test3.TestAllTypes.singular_nested_enum":
+	case 101: // goproto.proto.test3.TestAllTypes.singular_nested_enum
+		if fd != fd_TestAllTypes_singular_nested_enum {
+			break
+		}
 		return protoreflect.ValueOfEnum(0)
-	case "goproto.proto.test3.TestAllTypes.singular_foreign_enum":
+	case 102: // goproto.proto.test3.TestAllTypes.singular_foreign_enum
+		if fd != fd_TestAllTypes_singular_foreign_enum {
+			break
+		}
 		return protoreflect.ValueOfEnum(0)
-	case "goproto.proto.test3.TestAllTypes.singular_import_enum":
+	case 103: // goproto.proto.test3.TestAllTypes.singular_import_enum
+		if fd != fd_TestAllTypes_singular_import_enum {
+			break
+		}
 		return protoreflect.ValueOfEnum(0)
-	case "goproto.proto.test3.TestAllTypes.repeated_int32":
+	case 31: // goproto.proto.test3.TestAllTypes.repeated_int32
+		if fd != fd_TestAllTypes_repeated_int32 {
+			break
+		}
 		list := []int32{}
 		return protoreflect.ValueOfList(&_TestAllTypes_31_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_int64":
+	case 32: // goproto.proto.test3.TestAllTypes.repeated_int64
+		if fd != fd_TestAllTypes_repeated_int64 {
+			break
+		}
 		list := []int64{}
 		return protoreflect.ValueOfList(&_TestAllTypes_32_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_uint32":
+	case 33: // goproto.proto.test3.TestAllTypes.repeated_uint32
+		if fd != fd_TestAllTypes_repeated_uint32 {
+			break
+		}
 		list := []uint32{}
 		return protoreflect.ValueOfList(&_TestAllTypes_33_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_uint64":
+	case 34: // goproto.proto.test3.TestAllTypes.repeated_uint64
+		if fd != fd_TestAllTypes_repeated_uint64 {
+			break
+		}
 		list := []uint64{}
 		return protoreflect.ValueOfList(&_TestAllTypes_34_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_sint32":
+	case 35: // goproto.proto.test3.TestAllTypes.repeated_sint32
+		if fd != fd_TestAllTypes_repeated_sint32 {
+			break
+		}
 		list := []int32{}
 		return protoreflect.ValueOfList(&_TestAllTypes_35_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_sint64":
+	case 36: // goproto.proto.test3.TestAllTypes.repeated_sint64
+		if fd != fd_TestAllTypes_repeated_sint64 {
+			break
+		}
 		list := []int64{}
 		return protoreflect.ValueOfList(&_TestAllTypes_36_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_fixed32":
+	case 37: // goproto.proto.test3.TestAllTypes.repeated_fixed32
+		if fd != fd_TestAllTypes_repeated_fixed32 {
+			break
+		}
 		list := []uint32{}
 		return protoreflect.ValueOfList(&_TestAllTypes_37_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_fixed64":
+	case 38: // goproto.proto.test3.TestAllTypes.repeated_fixed64
+		if fd != fd_TestAllTypes_repeated_fixed64 {
+			break
+		}
 		list := []uint64{}
 		return protoreflect.ValueOfList(&_TestAllTypes_38_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_sfixed32":
+	case 39: // goproto.proto.test3.TestAllTypes.repeated_sfixed32
+		if fd != fd_TestAllTypes_repeated_sfixed32 {
+			break
+		}
 		list := []int32{}
 		return protoreflect.ValueOfList(&_TestAllTypes_39_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_sfixed64":
+	case 40: // goproto.proto.test3.TestAllTypes.repeated_sfixed64
+		if fd != fd_TestAllTypes_repeated_sfixed64 {
+			break
+		}
 		list := []int64{}
 		return protoreflect.ValueOfList(&_TestAllTypes_40_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_float":
+	case 41: // goproto.proto.test3.TestAllTypes.repeated_float
+		if fd != fd_TestAllTypes_repeated_float {
+			break
+		}
 		list := []float32{}
 		return protoreflect.ValueOfList(&_TestAllTypes_41_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_double":
+	case 42: // goproto.proto.test3.TestAllTypes.repeated_double
+		if fd != fd_TestAllTypes_repeated_double {
+			break
+		}
 		list := []float64{}
 		return protoreflect.ValueOfList(&_TestAllTypes_42_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_bool":
+	case 43: // goproto.proto.test3.TestAllTypes.repeated_bool
+		if fd != fd_TestAllTypes_repeated_bool {
+			break
+		}
 		list := []bool{}
 		return protoreflect.ValueOfList(&_TestAllTypes_43_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_string":
+	case 44: // goproto.proto.test3.TestAllTypes.repeated_string
+		if fd != fd_TestAllTypes_repeated_string {
+			break
+		}
 		list := []string{}
 		return protoreflect.ValueOfList(&_TestAllTypes_44_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_bytes":
+	case 45: // goproto.proto.test3.TestAllTypes.repeated_bytes
+		if fd != fd_TestAllTypes_repeated_bytes {
+			break
+		}
 		list := [][]byte{}
 		return protoreflect.ValueOfList(&_TestAllTypes_45_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_nested_message":
+	case 48: // goproto.proto.test3.TestAllTypes.repeated_nested_message
+		if fd != fd_TestAllTypes_repeated_nested_message {
+			break
+		}
 		list := []*TestAllTypes_NestedMessage{}
 		return protoreflect.ValueOfList(&_TestAllTypes_48_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_foreign_message":
+	case 49: // goproto.proto.test3.TestAllTypes.repeated_foreign_message
+		if fd != fd_TestAllTypes_repeated_foreign_message {
+			break
+		}
 		list := []*ForeignMessage{}
 		return protoreflect.ValueOfList(&_TestAllTypes_49_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_importmessage":
+	case 50: // goproto.proto.test3.TestAllTypes.repeated_importmessage
+		if fd != fd_TestAllTypes_repeated_importmessage {
+			break
+		}
 		list := []*ImportMessage{}
 		return protoreflect.ValueOfList(&_TestAllTypes_50_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_nested_enum":
+	case 51: // goproto.proto.test3.TestAllTypes.repeated_nested_enum
+		if fd != fd_TestAllTypes_repeated_nested_enum {
+			break
+		}
 		list := []TestAllTypes_NestedEnum{}
 		return protoreflect.ValueOfList(&_TestAllTypes_51_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_foreign_enum":
+	case 52: // goproto.proto.test3.TestAllTypes.repeated_foreign_enum
+		if fd != fd_TestAllTypes_repeated_foreign_enum {
+			break
+		}
 		list := []ForeignEnum{}
 		return protoreflect.ValueOfList(&_TestAllTypes_52_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.repeated_importenum":
+	case 53: // goproto.proto.test3.TestAllTypes.repeated_importenum
+		if fd != fd_TestAllTypes_repeated_importenum {
+			break
+		}
 		list := []ImportEnum{}
 		return protoreflect.ValueOfList(&_TestAllTypes_53_list{list: &list})
-	case "goproto.proto.test3.TestAllTypes.map_int32_int32":
+	case 56: // goproto.proto.test3.TestAllTypes.map_int32_int32
+		if fd != fd_TestAllTypes_map_int32_int32 {
+			break
+		}
 		m := make(map[int32]int32)
 		return protoreflect.ValueOfMap(&_TestAllTypes_56_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.map_int64_int64":
+	case 57: // goproto.proto.test3.TestAllTypes.map_int64_int64
+		if fd != fd_TestAllTypes_map_int64_int64 {
+			break
+		}
 		m := make(map[int64]int64)
 		return protoreflect.ValueOfMap(&_TestAllTypes_57_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.map_uint32_uint32":
+	case 58: // goproto.proto.test3.TestAllTypes.map_uint32_uint32
+		if fd != fd_TestAllTypes_map_uint32_uint32 {
+			break
+		}
 		m := make(map[uint32]uint32)
 		return protoreflect.ValueOfMap(&_TestAllTypes_58_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.map_uint64_uint64":
+	case 59: // goproto.proto.test3.TestAllTypes.map_uint64_uint64
+		if fd != fd_TestAllTypes_map_uint64_uint64 {
+			break
+		}
 		m := make(map[uint64]uint64)
 		return protoreflect.ValueOfMap(&_TestAllTypes_59_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.map_sint32_sint32":
+	case 60: // goproto.proto.test3.TestAllTypes.map_sint32_sint32
+		if fd != fd_TestAllTypes_map_sint32_sint32 {
+			break
+		}
 		m := make(map[int32]int32)
 		return protoreflect.ValueOfMap(&_TestAllTypes_60_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.map_sint64_sint64":
+	case 61: // goproto.proto.test3.TestAllTypes.map_sint64_sint64
+		if fd != fd_TestAllTypes_map_sint64_sint64 {
+			break
+		}
 		m := make(map[int64]int64)
 		return protoreflect.ValueOfMap(&_TestAllTypes_61_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.map_fixed32_fixed32":
+	case 62: // goproto.proto.test3.TestAllTypes.map_fixed32_fixed32
+		if fd != fd_TestAllTypes_map_fixed32_fixed32 {
+			break
+		}
 		m := make(map[uint32]uint32)
 		return protoreflect.ValueOfMap(&_TestAllTypes_62_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.map_fixed64_fixed64":
+	case 63: // goproto.proto.test3.TestAllTypes.map_fixed64_fixed64
+		if fd != fd_TestAllTypes_map_fixed64_fixed64 {
+			break
+		}
 		m := make(map[uint64]uint64)
 		return protoreflect.ValueOfMap(&_TestAllTypes_63_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.map_sfixed32_sfixed32":
+	case 64: // goproto.proto.test3.TestAllTypes.map_sfixed32_sfixed32
+		if fd != fd_TestAllTypes_map_sfixed32_sfixed32 {
+			break
+		}
 		m := make(map[int32]int32)
 		return protoreflect.ValueOfMap(&_TestAllTypes_64_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.map_sfixed64_sfixed64":
+	case 65: // goproto.proto.test3.TestAllTypes.map_sfixed64_sfixed64
+		if fd != fd_TestAllTypes_map_sfixed64_sfixed64 {
+			break
+		}
 		m := make(map[int64]int64)
 		return protoreflect.ValueOfMap(&_TestAllTypes_65_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.map_int32_float":
+	case 66: // goproto.proto.test3.TestAllTypes.map_int32_float
+		if fd != fd_TestAllTypes_map_int32_float {
+			break
+		}
 		m := make(map[int32]float32)
 		return protoreflect.ValueOfMap(&_TestAllTypes_66_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.map_int32_double":
+	case 67: // goproto.proto.test3.TestAllTypes.map_int32_double
+		if fd != fd_TestAllTypes_map_int32_double {
+			break
+		}
 		m := make(map[int32]float64)
 		return protoreflect.ValueOfMap(&_TestAllTypes_67_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.map_bool_bool":
+	case 68: // goproto.proto.test3.TestAllTypes.map_bool_bool
+		if fd != fd_TestAllTypes_map_bool_bool {
+			break
+		}
 		m := make(map[bool]bool)
 		return protoreflect.ValueOfMap(&_TestAllTypes_68_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.map_string_string":
+	case 69: // goproto.proto.test3.TestAllTypes.map_string_string
+		if fd != fd_TestAllTypes_map_string_string {
+			break
+		}
 		m := make(map[string]string)
 		return protoreflect.ValueOfMap(&_TestAllTypes_69_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.map_string_bytes":
+	case 70: // goproto.proto.test3.TestAllTypes.map_string_bytes
+		if fd != fd_TestAllTypes_map_string_bytes {
+			break
+		}
 		m := make(map[string][]byte)
 		return protoreflect.ValueOfMap(&_TestAllTypes_70_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.map_string_nested_message":
+	case 71: // goproto.proto.test3.TestAllTypes.map_string_nested_message
+		if fd != fd_TestAllTypes_map_string_nested_message {
+			break
+		}
 		m := make(map[string]*TestAllTypes_NestedMessage)
 		return protoreflect.ValueOfMap(&_TestAllTypes_71_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.map_string_nested_enum":
+	case 73: // goproto.proto.test3.TestAllTypes.map_string_nested_enum
+		if fd != fd_TestAllTypes_map_string_nested_enum {
+			break
+		}
 		m := make(map[string]TestAllTypes_NestedEnum)
 		return protoreflect.ValueOfMap(&_TestAllTypes_73_map{m: &m})
-	case "goproto.proto.test3.TestAllTypes.oneof_uint32":
+	case 111: // goproto.proto.test3.TestAllTypes.oneof_uint32
+		if fd != fd_TestAllTypes_oneof_uint32 {
+			break
+		}
 		return protoreflect.ValueOfUint32(uint32(0))
-	case "goproto.proto.test3.TestAllTypes.oneof_nested_message":
+	case 112: // goproto.proto.test3.TestAllTypes.oneof_nested_message
+		if fd != fd_TestAllTypes_oneof_nested_message {
+			break
+		}
 		value := &TestAllTypes_NestedMessage{}
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "goproto.proto.test3.TestAllTypes.oneof_string":
-		return protoreflect.ValueOfString("")
-	case "goproto.proto.test3.TestAllTypes.oneof_bytes":
+	case 113: // goproto.proto.test3.TestAllTypes.oneof_string
+		if fd != fd_TestAllTypes_oneof_string {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 114: // goproto.proto.test3.TestAllTypes.oneof_bytes
+		if fd != fd_TestAllTypes_oneof_bytes {
+			break
+		}
 		return protoreflect.ValueOfBytes(nil)
-	case "goproto.proto.test3.TestAllTypes.oneof_bool":
+	case 115: // goproto.proto.test3.TestAllTypes.oneof_bool
+		if fd != fd_TestAllTypes_oneof_bool {
+			break
+		}
 		return protoreflect.ValueOfBool(false)
-	case "goproto.proto.test3.TestAllTypes.oneof_uint64":
+	case 116: // goproto.proto.test3.TestAllTypes.oneof_uint64
+		if fd != fd_TestAllTypes_oneof_uint64 {
+			break
+		}
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "goproto.proto.test3.TestAllTypes.oneof_float":
+	case 117: // goproto.proto.test3.TestAllTypes.oneof_float
+		if fd != fd_TestAllTypes_oneof_float {
+			break
+		}
 		return protoreflect.ValueOfFloat32(float32(0))
-	case "goproto.proto.test3.TestAllTypes.oneof_double":
+	case 118: // goproto.proto.test3.TestAllTypes.oneof_double
+		if fd != fd_TestAllTypes_oneof_double {
+			break
+		}
 		return protoreflect.ValueOfFloat64(float64(0))
-	case "goproto.proto.test3.TestAllTypes.oneof_enum":
-		return protoreflect.ValueOfEnum(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.TestAllTypes"))
+	case 119: // goproto.proto.test3.TestAllTypes.oneof_enum
+		if fd != fd_TestAllTypes_oneof_enum {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not contain field %s", fd.FullName()))
+		return protoreflect.ValueOfEnum(0)
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes); fd != nil {
+		return x.NewField(fd)
 	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes does not contain field %s", fd.FullName()))
 }
 
 // WhichOneof reports which field within the oneof is populated,
@@ -4599,2062 +6681,2202 @@ func (x *fastReflection_TestAllTypes) IsValid() bool {
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
 func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TestAllTypes)
-		if x == nil {
+	return _fastReflection_TestAllTypes_methods
+}
+
+var _fastReflection_TestAllTypes_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestAllTypes_size,
+	Marshal:           _fastReflection_TestAllTypes_marshal,
+	Unmarshal:         _fastReflection_TestAllTypes_unmarshal,
+	Merge:             _fastReflection_TestAllTypes_merge,
+	CheckInitialized:  _fastReflection_TestAllTypes_checkInitialized,
+	Equal:             _fastReflection_TestAllTypes_equal,
+}
+
+func _fastReflection_TestAllTypes_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestAllTypes)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
+				Size:              int(size - 1),
 			}
 		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.SingularInt32 != 0 {
-			n += 2 + runtime.Sov(uint64(x.SingularInt32))
-		}
-		if x.SingularInt64 != 0 {
-			n += 2 + runtime.Sov(uint64(x.SingularInt64))
-		}
-		if x.SingularUint32 != 0 {
-			n += 2 + runtime.Sov(uint64(x.SingularUint32))
-		}
-		if x.SingularUint64 != 0 {
-			n += 2 + runtime.Sov(uint64(x.SingularUint64))
-		}
-		if x.SingularSint32 != 0 {
-			n += 2 + runtime.Soz(uint64(x.SingularSint32))
-		}
-		if x.SingularSint64 != 0 {
-			n += 2 + runtime.Soz(uint64(x.SingularSint64))
-		}
-		if x.SingularFixed32 != 0 {
-			n += 6
-		}
-		if x.SingularFixed64 != 0 {
-			n += 10
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.SingularInt32 != 0 {
+		n += 2 + runtime.Sov(uint64(x.SingularInt32))
+	}
+	if x.SingularInt64 != 0 {
+		n += 2 + runtime.Sov(uint64(x.SingularInt64))
+	}
+	if x.SingularUint32 != 0 {
+		n += 2 + runtime.Sov(uint64(x.SingularUint32))
+	}
+	if x.SingularUint64 != 0 {
+		n += 2 + runtime.Sov(uint64(x.SingularUint64))
+	}
+	if x.SingularSint32 != 0 {
+		n += 2 + runtime.Soz(uint64(x.SingularSint32))
+	}
+	if x.SingularSint64 != 0 {
+		n += 2 + runtime.Soz(uint64(x.SingularSint64))
+	}
+	if x.SingularFixed32 != 0 {
+		n += 6
+	}
+	if x.SingularFixed64 != 0 {
+		n += 10
+	}
+	if x.SingularSfixed32 != 0 {
+		n += 6
+	}
+	if x.SingularSfixed64 != 0 {
+		n += 10
+	}
+	if x.SingularFloat != 0 || math.Signbit(float64(x.SingularFloat)) {
+		n += 6
+	}
+	if x.SingularDouble != 0 || math.Signbit(x.SingularDouble) {
+		n += 10
+	}
+	if x.SingularBool {
+		n += 3
+	}
+	l = len(x.SingularString)
+	if l > 0 {
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	l = len(x.SingularBytes)
+	if l > 0 {
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.SingularNestedMessage != nil {
+		l = options.Size(x.SingularNestedMessage)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.SingularForeignMessage != nil {
+		l = options.Size(x.SingularForeignMessage)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.SingularImportMessage != nil {
+		l = options.Size(x.SingularImportMessage)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.SingularNestedEnum != 0 {
+		n += 2 + runtime.Sov(uint64(x.SingularNestedEnum))
+	}
+	if x.SingularForeignEnum != 0 {
+		n += 2 + runtime.Sov(uint64(x.SingularForeignEnum))
+	}
+	if x.SingularImportEnum != 0 {
+		n += 2 + runtime.Sov(uint64(x.SingularImportEnum))
+	}
+	if len(x.RepeatedInt32) > 0 {
+		l = 0
+		for _, e := range x.RepeatedInt32 {
+			l += runtime.Sov(uint64(e))
 		}
-		if x.SingularSfixed32 != 0 {
-			n += 6
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.RepeatedInt64) > 0 {
+		l = 0
+		for _, e := range x.RepeatedInt64 {
+			l += runtime.Sov(uint64(e))
 		}
-		if x.SingularSfixed64 != 0 {
-			n += 10
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.RepeatedUint32) > 0 {
+		l = 0
+		for _, e := range x.RepeatedUint32 {
+			l += runtime.Sov(uint64(e))
 		}
-		if x.SingularFloat != 0 || math.Signbit(float64(x.SingularFloat)) {
-			n += 6
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.RepeatedUint64) > 0 {
+		l = 0
+		for _, e := range x.RepeatedUint64 {
+			l += runtime.Sov(uint64(e))
 		}
-		if x.SingularDouble != 0 || math.Signbit(x.SingularDouble) {
-			n += 10
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.RepeatedSint32) > 0 {
+		l = 0
+		for _, e := range x.RepeatedSint32 {
+			l += runtime.Soz(uint64(e))
 		}
-		if x.SingularBool {
-			n += 3
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.RepeatedSint64) > 0 {
+		l = 0
+		for _, e := range x.RepeatedSint64 {
+			l += runtime.Soz(uint64(e))
 		}
-		l = len(x.SingularString)
-		if l > 0 {
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.RepeatedFixed32) > 0 {
+		n += 2 + runtime.Sov(uint64(len(x.RepeatedFixed32)*4)) + len(x.RepeatedFixed32)*4
+	}
+	if len(x.RepeatedFixed64) > 0 {
+		n += 2 + runtime.Sov(uint64(len(x.RepeatedFixed64)*8)) + len(x.RepeatedFixed64)*8
+	}
+	if len(x.RepeatedSfixed32) > 0 {
+		n += 2 + runtime.Sov(uint64(len(x.RepeatedSfixed32)*4)) + len(x.RepeatedSfixed32)*4
+	}
+	if len(x.RepeatedSfixed64) > 0 {
+		n += 2 + runtime.Sov(uint64(len(x.RepeatedSfixed64)*8)) + len(x.RepeatedSfixed64)*8
+	}
+	if len(x.RepeatedFloat) > 0 {
+		n += 2 + runtime.Sov(uint64(len(x.RepeatedFloat)*4)) + len(x.RepeatedFloat)*4
+	}
+	if len(x.RepeatedDouble) > 0 {
+		n += 2 + runtime.Sov(uint64(len(x.RepeatedDouble)*8)) + len(x.RepeatedDouble)*8
+	}
+	if len(x.RepeatedBool) > 0 {
+		n += 2 + runtime.Sov(uint64(len(x.RepeatedBool))) + len(x.RepeatedBool)*1
+	}
+	if len(x.RepeatedString) > 0 {
+		for _, s := range x.RepeatedString {
+			l = len(s)
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.SingularBytes)
-		if l > 0 {
+	}
+	if len(x.RepeatedBytes) > 0 {
+		for _, b := range x.RepeatedBytes {
+			l = len(b)
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
-		if x.SingularNestedMessage != nil {
-			l = options.Size(x.SingularNestedMessage)
+	}
+	if len(x.RepeatedNestedMessage) > 0 {
+		for _, e := range x.RepeatedNestedMessage {
+			l = options.Size(e)
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
-		if x.SingularForeignMessage != nil {
-			l = options.Size(x.SingularForeignMessage)
+	}
+	if len(x.RepeatedForeignMessage) > 0 {
+		for _, e := range x.RepeatedForeignMessage {
+			l = options.Size(e)
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
-		if x.SingularImportMessage != nil {
-			l = options.Size(x.SingularImportMessage)
+	}
+	if len(x.RepeatedImportmessage) > 0 {
+		for _, e := range x.RepeatedImportmessage {
+			l = options.Size(e)
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
-		if x.SingularNestedEnum != 0 {
-			n += 2 + runtime.Sov(uint64(x.SingularNestedEnum))
+	}
+	if len(x.RepeatedNestedEnum) > 0 {
+		l = 0
+		for _, e := range x.RepeatedNestedEnum {
+			l += runtime.Sov(uint64(e))
 		}
-		if x.SingularForeignEnum != 0 {
-			n += 2 + runtime.Sov(uint64(x.SingularForeignEnum))
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.RepeatedForeignEnum) > 0 {
+		l = 0
+		for _, e := range x.RepeatedForeignEnum {
+			l += runtime.Sov(uint64(e))
 		}
-		if x.SingularImportEnum != 0 {
-			n += 2 + runtime.Sov(uint64(x.SingularImportEnum))
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.RepeatedImportenum) > 0 {
+		l = 0
+		for _, e := range x.RepeatedImportenum {
+			l += runtime.Sov(uint64(e))
 		}
-		if len(x.RepeatedInt32) > 0 {
-			l = 0
-			for _, e := range x.RepeatedInt32 {
-				l += runtime.Sov(uint64(e))
-			}
-			n += 2 + runtime.Sov(uint64(l)) + l
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.MapInt32Int32) > 0 {
+		SiZeMaP := func(k int32, v int32) {
+			mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
 		}
-		if len(x.RepeatedInt64) > 0 {
-			l = 0
-			for _, e := range x.RepeatedInt64 {
-				l += runtime.Sov(uint64(e))
+		if options.Deterministic {
+			sortme := make([]int32, 0, len(x.MapInt32Int32))
+			for k := range x.MapInt32Int32 {
+				sortme = append(sortme, k)
 			}
-			n += 2 + runtime.Sov(uint64(l)) + l
-		}
-		if len(x.RepeatedUint32) > 0 {
-			l = 0
-			for _, e := range x.RepeatedUint32 {
-				l += runtime.Sov(uint64(e))
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapInt32Int32[k]
+				SiZeMaP(k, v)
 			}
-			n += 2 + runtime.Sov(uint64(l)) + l
-		}
-		if len(x.RepeatedUint64) > 0 {
-			l = 0
-			for _, e := range x.RepeatedUint64 {
-				l += runtime.Sov(uint64(e))
+		} else {
+			for k, v := range x.MapInt32Int32 {
+				SiZeMaP(k, v)
 			}
-			n += 2 + runtime.Sov(uint64(l)) + l
 		}
-		if len(x.RepeatedSint32) > 0 {
-			l = 0
-			for _, e := range x.RepeatedSint32 {
-				l += runtime.Soz(uint64(e))
-			}
-			n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.MapInt64Int64) > 0 {
+		SiZeMaP := func(k int64, v int64) {
+			mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
 		}
-		if len(x.RepeatedSint64) > 0 {
-			l = 0
-			for _, e := range x.RepeatedSint64 {
-				l += runtime.Soz(uint64(e))
+		if options.Deterministic {
+			sortme := make([]int64, 0, len(x.MapInt64Int64))
+			for k := range x.MapInt64Int64 {
+				sortme = append(sortme, k)
 			}
-			n += 2 + runtime.Sov(uint64(l)) + l
-		}
-		if len(x.RepeatedFixed32) > 0 {
-			n += 2 + runtime.Sov(uint64(len(x.RepeatedFixed32)*4)) + len(x.RepeatedFixed32)*4
-		}
-		if len(x.RepeatedFixed64) > 0 {
-			n += 2 + runtime.Sov(uint64(len(x.RepeatedFixed64)*8)) + len(x.RepeatedFixed64)*8
-		}
-		if len(x.RepeatedSfixed32) > 0 {
-			n += 2 + runtime.Sov(uint64(len(x.RepeatedSfixed32)*4)) + len(x.RepeatedSfixed32)*4
-		}
-		if len(x.RepeatedSfixed64) > 0 {
-			n += 2 + runtime.Sov(uint64(len(x.RepeatedSfixed64)*8)) + len(x.RepeatedSfixed64)*8
-		}
-		if len(x.RepeatedFloat) > 0 {
-			n += 2 + runtime.Sov(uint64(len(x.RepeatedFloat)*4)) + len(x.RepeatedFloat)*4
-		}
-		if len(x.RepeatedDouble) > 0 {
-			n += 2 + runtime.Sov(uint64(len(x.RepeatedDouble)*8)) + len(x.RepeatedDouble)*8
-		}
-		if len(x.RepeatedBool) > 0 {
-			n += 2 + runtime.Sov(uint64(len(x.RepeatedBool))) + len(x.RepeatedBool)*1
-		}
-		if len(x.RepeatedString) > 0 {
-			for _, s := range x.RepeatedString {
-				l = len(s)
-				n += 2 + l + runtime.Sov(uint64(l))
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapInt64Int64[k]
+				SiZeMaP(k, v)
 			}
-		}
-		if len(x.RepeatedBytes) > 0 {
-			for _, b := range x.RepeatedBytes {
-				l = len(b)
-				n += 2 + l + runtime.Sov(uint64(l))
+		} else {
+			for k, v := range x.MapInt64Int64 {
+				SiZeMaP(k, v)
 			}
 		}
-		if len(x.RepeatedNestedMessage) > 0 {
-			for _, e := range x.RepeatedNestedMessage {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
+	}
+	if len(x.MapUint32Uint32) > 0 {
+		SiZeMaP := func(k uint32, v uint32) {
+			mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
 		}
-		if len(x.RepeatedForeignMessage) > 0 {
-			for _, e := range x.RepeatedForeignMessage {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if options.Deterministic {
+			sortme := make([]uint32, 0, len(x.MapUint32Uint32))
+			for k := range x.MapUint32Uint32 {
+				sortme = append(sortme, k)
 			}
-		}
-		if len(x.RepeatedImportmessage) > 0 {
-			for _, e := range x.RepeatedImportmessage {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapUint32Uint32[k]
+				SiZeMaP(k, v)
 			}
-		}
-		if len(x.RepeatedNestedEnum) > 0 {
-			l = 0
-			for _, e := range x.RepeatedNestedEnum {
-				l += runtime.Sov(uint64(e))
+		} else {
+			for k, v := range x.MapUint32Uint32 {
+				SiZeMaP(k, v)
 			}
-			n += 2 + runtime.Sov(uint64(l)) + l
 		}
-		if len(x.RepeatedForeignEnum) > 0 {
-			l = 0
-			for _, e := range x.RepeatedForeignEnum {
-				l += runtime.Sov(uint64(e))
-			}
-			n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if len(x.MapUint64Uint64) > 0 {
+		SiZeMaP := func(k uint64, v uint64) {
+			mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
 		}
-		if len(x.RepeatedImportenum) > 0 {
-			l = 0
-			for _, e := range x.RepeatedImportenum {
-				l += runtime.Sov(uint64(e))
+		if options.Deterministic {
+			sortme := make([]uint64, 0, len(x.MapUint64Uint64))
+			for k := range x.MapUint64Uint64 {
+				sortme = append(sortme, k)
 			}
-			n += 2 + runtime.Sov(uint64(l)) + l
-		}
-		if len(x.MapInt32Int32) > 0 {
-			SiZeMaP := func(k int32, v int32) {
-				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapUint64Uint64[k]
+				SiZeMaP(k, v)
 			}
-			if options.Deterministic {
-				sortme := make([]int32, 0, len(x.MapInt32Int32))
-				for k := range x.MapInt32Int32 {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.MapInt32Int32[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapInt32Int32 {
-					SiZeMaP(k, v)
-				}
+		} else {
+			for k, v := range x.MapUint64Uint64 {
+				SiZeMaP(k, v)
 			}
 		}
-		if len(x.MapInt64Int64) > 0 {
-			SiZeMaP := func(k int64, v int64) {
-				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]int64, 0, len(x.MapInt64Int64))
-				for k := range x.MapInt64Int64 {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.MapInt64Int64[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapInt64Int64 {
-					SiZeMaP(k, v)
-				}
-			}
+	}
+	if len(x.MapSint32Sint32) > 0 {
+		SiZeMaP := func(k int32, v int32) {
+			mapEntrySize := 1 + runtime.Soz(uint64(k)) + 1 + runtime.Soz(uint64(v))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
 		}
-		if len(x.MapUint32Uint32) > 0 {
-			SiZeMaP := func(k uint32, v uint32) {
-				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]uint32, 0, len(x.MapUint32Uint32))
-				for k := range x.MapUint32Uint32 {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.MapUint32Uint32[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapUint32Uint32 {
-					SiZeMaP(k, v)
-				}
+		if options.Deterministic {
+			sortme := make([]int32, 0, len(x.MapSint32Sint32))
+			for k := range x.MapSint32Sint32 {
+				sortme = append(sortme, k)
 			}
-		}
-		if len(x.MapUint64Uint64) > 0 {
-			SiZeMaP := func(k uint64, v uint64) {
-				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapSint32Sint32[k]
+				SiZeMaP(k, v)
 			}
-			if options.Deterministic {
-				sortme := make([]uint64, 0, len(x.MapUint64Uint64))
-				for k := range x.MapUint64Uint64 {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.MapUint64Uint64[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapUint64Uint64 {
-					SiZeMaP(k, v)
-				}
+		} else {
+			for k, v := range x.MapSint32Sint32 {
+				SiZeMaP(k, v)
 			}
 		}
-		if len(x.MapSint32Sint32) > 0 {
-			SiZeMaP := func(k int32, v int32) {
-				mapEntrySize := 1 + runtime.Soz(uint64(k)) + 1 + runtime.Soz(uint64(v))
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]int32, 0, len(x.MapSint32Sint32))
-				for k := range x.MapSint32Sint32 {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.MapSint32Sint32[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapSint32Sint32 {
-					SiZeMaP(k, v)
-				}
-			}
+	}
+	if len(x.MapSint64Sint64) > 0 {
+		SiZeMaP := func(k int64, v int64) {
+			mapEntrySize := 1 + runtime.Soz(uint64(k)) + 1 + runtime.Soz(uint64(v))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
 		}
-		if len(x.MapSint64Sint64) > 0 {
-			SiZeMaP := func(k int64, v int64) {
-				mapEntrySize := 1 + runtime.Soz(uint64(k)) + 1 + runtime.Soz(uint64(v))
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]int64, 0, len(x.MapSint64Sint64))
-				for k := range x.MapSint64Sint64 {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.MapSint64Sint64[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapSint64Sint64 {
-					SiZeMaP(k, v)
-				}
+		if options.Deterministic {
+			sortme := make([]int64, 0, len(x.MapSint64Sint64))
+			for k := range x.MapSint64Sint64 {
+				sortme = append(sortme, k)
 			}
-		}
-		if len(x.MapFixed32Fixed32) > 0 {
-			SiZeMaP := func(k uint32, v uint32) {
-				mapEntrySize := 1 + 4 + 1 + 4
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapSint64Sint64[k]
+				SiZeMaP(k, v)
 			}
-			if options.Deterministic {
-				sortme := make([]uint32, 0, len(x.MapFixed32Fixed32))
-				for k := range x.MapFixed32Fixed32 {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.MapFixed32Fixed32[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapFixed32Fixed32 {
-					SiZeMaP(k, v)
-				}
+		} else {
+			for k, v := range x.MapSint64Sint64 {
+				SiZeMaP(k, v)
 			}
 		}
-		if len(x.MapFixed64Fixed64) > 0 {
-			SiZeMaP := func(k uint64, v uint64) {
-				mapEntrySize := 1 + 8 + 1 + 8
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]uint64, 0, len(x.MapFixed64Fixed64))
-				for k := range x.MapFixed64Fixed64 {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.MapFixed64Fixed64[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapFixed64Fixed64 {
-					SiZeMaP(k, v)
-				}
-			}
+	}
+	if len(x.MapFixed32Fixed32) > 0 {
+		SiZeMaP := func(k uint32, v uint32) {
+			mapEntrySize := 1 + 4 + 1 + 4
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
 		}
-		if len(x.MapSfixed32Sfixed32) > 0 {
-			SiZeMaP := func(k int32, v int32) {
-				mapEntrySize := 1 + 4 + 1 + 4
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]int32, 0, len(x.MapSfixed32Sfixed32))
-				for k := range x.MapSfixed32Sfixed32 {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.MapSfixed32Sfixed32[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapSfixed32Sfixed32 {
-					SiZeMaP(k, v)
-				}
+		if options.Deterministic {
+			sortme := make([]uint32, 0, len(x.MapFixed32Fixed32))
+			for k := range x.MapFixed32Fixed32 {
+				sortme = append(sortme, k)
 			}
-		}
-		if len(x.MapSfixed64Sfixed64) > 0 {
-			SiZeMaP := func(k int64, v int64) {
-				mapEntrySize := 1 + 8 + 1 + 8
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapFixed32Fixed32[k]
+				SiZeMaP(k, v)
 			}
-			if options.Deterministic {
-				sortme := make([]int64, 0, len(x.MapSfixed64Sfixed64))
-				for k := range x.MapSfixed64Sfixed64 {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.MapSfixed64Sfixed64[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapSfixed64Sfixed64 {
-					SiZeMaP(k, v)
-				}
+		} else {
+			for k, v := range x.MapFixed32Fixed32 {
+				SiZeMaP(k, v)
 			}
 		}
-		if len(x.MapInt32Float) > 0 {
-			SiZeMaP := func(k int32, v float32) {
-				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + 4
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]int32, 0, len(x.MapInt32Float))
-				for k := range x.MapInt32Float {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.MapInt32Float[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapInt32Float {
-					SiZeMaP(k, v)
-				}
-			}
+	}
+	if len(x.MapFixed64Fixed64) > 0 {
+		SiZeMaP := func(k uint64, v uint64) {
+			mapEntrySize := 1 + 8 + 1 + 8
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
 		}
-		if len(x.MapInt32Double) > 0 {
-			SiZeMaP := func(k int32, v float64) {
-				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + 8
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		if options.Deterministic {
+			sortme := make([]uint64, 0, len(x.MapFixed64Fixed64))
+			for k := range x.MapFixed64Fixed64 {
+				sortme = append(sortme, k)
 			}
-			if options.Deterministic {
-				sortme := make([]int32, 0, len(x.MapInt32Double))
-				for k := range x.MapInt32Double {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.MapInt32Double[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapInt32Double {
-					SiZeMaP(k, v)
-				}
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapFixed64Fixed64[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapFixed64Fixed64 {
+				SiZeMaP(k, v)
 			}
 		}
-		if len(x.MapBoolBool) > 0 {
-			SiZeMaP := func(k bool, v bool) {
-				mapEntrySize := 1 + 1 + 1 + 1
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+	}
+	if len(x.MapSfixed32Sfixed32) > 0 {
+		SiZeMaP := func(k int32, v int32) {
+			mapEntrySize := 1 + 4 + 1 + 4
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]int32, 0, len(x.MapSfixed32Sfixed32))
+			for k := range x.MapSfixed32Sfixed32 {
+				sortme = append(sortme, k)
 			}
-			if options.Deterministic {
-				sortme := make([]bool, 0, len(x.MapBoolBool))
-				for k := range x.MapBoolBool {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return !sortme[i] && sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.MapBoolBool[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapBoolBool {
-					SiZeMaP(k, v)
-				}
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapSfixed32Sfixed32[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapSfixed32Sfixed32 {
+				SiZeMaP(k, v)
 			}
 		}
-		if len(x.MapStringString) > 0 {
-			SiZeMaP := func(k string, v string) {
-				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+	}
+	if len(x.MapSfixed64Sfixed64) > 0 {
+		SiZeMaP := func(k int64, v int64) {
+			mapEntrySize := 1 + 8 + 1 + 8
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]int64, 0, len(x.MapSfixed64Sfixed64))
+			for k := range x.MapSfixed64Sfixed64 {
+				sortme = append(sortme, k)
 			}
-			if options.Deterministic {
-				sortme := make([]string, 0, len(x.MapStringString))
-				for k := range x.MapStringString {
-					sortme = append(sortme, k)
-				}
-				sort.Strings(sortme)
-				for _, k := range sortme {
-					v := x.MapStringString[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapStringString {
-					SiZeMaP(k, v)
-				}
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapSfixed64Sfixed64[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapSfixed64Sfixed64 {
+				SiZeMaP(k, v)
 			}
 		}
-		if len(x.MapStringBytes) > 0 {
-			SiZeMaP := func(k string, v []byte) {
-				l = 1 + len(v) + runtime.Sov(uint64(len(v)))
-				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+	}
+	if len(x.MapInt32Float) > 0 {
+		SiZeMaP := func(k int32, v float32) {
+			mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]int32, 0, len(x.MapInt32Float))
+			for k := range x.MapInt32Float {
+				sortme = append(sortme, k)
 			}
-			if options.Deterministic {
-				sortme := make([]string, 0, len(x.MapStringBytes))
-				for k := range x.MapStringBytes {
-					sortme = append(sortme, k)
-				}
-				sort.Strings(sortme)
-				for _, k := range sortme {
-					v := x.MapStringBytes[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapStringBytes {
-					SiZeMaP(k, v)
-				}
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapInt32Float[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapInt32Float {
+				SiZeMaP(k, v)
 			}
 		}
-		if len(x.MapStringNestedMessage) > 0 {
-			SiZeMaP := func(k string, v *TestAllTypes_NestedMessage) {
-				l := 0
-				if v != nil {
-					l = options.Size(v)
-				}
-				l += 1 + runtime.Sov(uint64(l))
-				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+	}
+	if len(x.MapInt32Double) > 0 {
+		SiZeMaP := func(k int32, v float64) {
+			mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]int32, 0, len(x.MapInt32Double))
+			for k := range x.MapInt32Double {
+				sortme = append(sortme, k)
 			}
-			if options.Deterministic {
-				sortme := make([]string, 0, len(x.MapStringNestedMessage))
-				for k := range x.MapStringNestedMessage {
-					sortme = append(sortme, k)
-				}
-				sort.Strings(sortme)
-				for _, k := range sortme {
-					v := x.MapStringNestedMessage[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapStringNestedMessage {
-					SiZeMaP(k, v)
-				}
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapInt32Double[k]
+				SiZeMaP(k, v)
 			}
+		} else {
+			for k, v := range x.MapInt32Double {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if len(x.MapBoolBool) > 0 {
+		SiZeMaP := func(k bool, v bool) {
+			mapEntrySize := 1 + 1 + 1 + 1
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
 		}
-		if len(x.MapStringNestedEnum) > 0 {
-			SiZeMaP := func(k string, v TestAllTypes_NestedEnum) {
-				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + runtime.Sov(uint64(v))
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		if options.Deterministic {
+			sortme := make([]bool, 0, len(x.MapBoolBool))
+			for k := range x.MapBoolBool {
+				sortme = append(sortme, k)
 			}
-			if options.Deterministic {
-				sortme := make([]string, 0, len(x.MapStringNestedEnum))
-				for k := range x.MapStringNestedEnum {
-					sortme = append(sortme, k)
-				}
-				sort.Strings(sortme)
-				for _, k := range sortme {
-					v := x.MapStringNestedEnum[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MapStringNestedEnum {
-					SiZeMaP(k, v)
-				}
+			sort.Slice(sortme, func(i, j int) bool {
+				return !sortme[i] && sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.MapBoolBool[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapBoolBool {
+				SiZeMaP(k, v)
 			}
 		}
-		switch x := x.OneofField.(type) {
-		case *TestAllTypes_OneofUint32:
-			if x == nil {
-				break
+	}
+	if len(x.MapStringString) > 0 {
+		SiZeMaP := func(k string, v string) {
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.MapStringString))
+			for k := range x.MapStringString {
+				sortme = append(sortme, k)
 			}
-			n += 2 + runtime.Sov(uint64(x.OneofUint32))
-		case *TestAllTypes_OneofNestedMessage:
-			if x == nil {
-				break
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.MapStringString[k]
+				SiZeMaP(k, v)
 			}
-			l = options.Size(x.OneofNestedMessage)
-			n += 2 + l + runtime.Sov(uint64(l))
-		case *TestAllTypes_OneofString:
-			if x == nil {
-				break
+		} else {
+			for k, v := range x.MapStringString {
+				SiZeMaP(k, v)
 			}
-			l = len(x.OneofString)
-			n += 2 + l + runtime.Sov(uint64(l))
-		case *TestAllTypes_OneofBytes:
-			if x == nil {
-				break
+		}
+	}
+	if len(x.MapStringBytes) > 0 {
+		SiZeMaP := func(k string, v []byte) {
+			l = 1 + len(v) + runtime.Sov(uint64(len(v)))
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.MapStringBytes))
+			for k := range x.MapStringBytes {
+				sortme = append(sortme, k)
 			}
-			l = len(x.OneofBytes)
-			n += 2 + l + runtime.Sov(uint64(l))
-		case *TestAllTypes_OneofBool:
-			if x == nil {
-				break
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.MapStringBytes[k]
+				SiZeMaP(k, v)
 			}
-			n += 3
-		case *TestAllTypes_OneofUint64:
-			if x == nil {
-				break
+		} else {
+			for k, v := range x.MapStringBytes {
+				SiZeMaP(k, v)
 			}
-			n += 2 + runtime.Sov(uint64(x.OneofUint64))
-		case *TestAllTypes_OneofFloat:
-			if x == nil {
-				break
+		}
+	}
+	if len(x.MapStringNestedMessage) > 0 {
+		SiZeMaP := func(k string, v *TestAllTypes_NestedMessage) {
+			l := 0
+			if v != nil {
+				l = options.Size(v)
 			}
-			n += 6
-		case *TestAllTypes_OneofDouble:
-			if x == nil {
-				break
+			l += 1 + runtime.Sov(uint64(l))
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.MapStringNestedMessage))
+			for k := range x.MapStringNestedMessage {
+				sortme = append(sortme, k)
 			}
-			n += 10
-		case *TestAllTypes_OneofEnum:
-			if x == nil {
-				break
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.MapStringNestedMessage[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapStringNestedMessage {
+				SiZeMaP(k, v)
 			}
-			n += 2 + runtime.Sov(uint64(x.OneofEnum))
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+	}
+	if len(x.MapStringNestedEnum) > 0 {
+		SiZeMaP := func(k string, v TestAllTypes_NestedEnum) {
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + runtime.Sov(uint64(v))
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
 		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.MapStringNestedEnum))
+			for k := range x.MapStringNestedEnum {
+				sortme = append(sortme, k)
+			}
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.MapStringNestedEnum[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.MapStringNestedEnum {
+				SiZeMaP(k, v)
+			}
 		}
 	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TestAllTypes)
+	switch x := x.OneofField.(type) {
+	case *TestAllTypes_OneofUint32:
+		if x == nil {
+			break
+		}
+		n += 2 + runtime.Sov(uint64(x.OneofUint32))
+	case *TestAllTypes_OneofNestedMessage:
+		if x == nil {
+			break
+		}
+		l = options.Size(x.OneofNestedMessage)
+		n += 2 + l + runtime.Sov(uint64(l))
+	case *TestAllTypes_OneofString:
+		if x == nil {
+			break
+		}
+		l = len(x.OneofString)
+		n += 2 + l + runtime.Sov(uint64(l))
+	case *TestAllTypes_OneofBytes:
+		if x == nil {
+			break
+		}
+		l = len(x.OneofBytes)
+		n += 2 + l + runtime.Sov(uint64(l))
+	case *TestAllTypes_OneofBool:
+		if x == nil {
+			break
+		}
+		n += 3
+	case *TestAllTypes_OneofUint64:
+		if x == nil {
+			break
+		}
+		n += 2 + runtime.Sov(uint64(x.OneofUint64))
+	case *TestAllTypes_OneofFloat:
 		if x == nil {
+			break
+		}
+		n += 6
+	case *TestAllTypes_OneofDouble:
+		if x == nil {
+			break
+		}
+		n += 10
+	case *TestAllTypes_OneofEnum:
+		if x == nil {
+			break
+		}
+		n += 2 + runtime.Sov(uint64(x.OneofEnum))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestAllTypes_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	switch x := x.OneofField.(type) {
+	case *TestAllTypes_OneofUint32:
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.OneofUint32))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xf8
+	case *TestAllTypes_OneofNestedMessage:
+		l = options.Size(x.OneofNestedMessage)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.OneofNestedMessage); err != nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		switch x := x.OneofField.(type) {
-		case *TestAllTypes_OneofUint32:
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OneofUint32))
-			i--
-			dAtA[i] = 0x6
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x82
+	case *TestAllTypes_OneofString:
+		if !utf8.ValidString(x.OneofString) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.OneofString)
+		copy(dAtA[i:], x.OneofString)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OneofString)))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x8a
+	case *TestAllTypes_OneofBytes:
+		i -= len(x.OneofBytes)
+		copy(dAtA[i:], x.OneofBytes)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OneofBytes)))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x92
+	case *TestAllTypes_OneofBool:
+		i--
+		if x.OneofBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x98
+	case *TestAllTypes_OneofUint64:
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.OneofUint64))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xa0
+	case *TestAllTypes_OneofFloat:
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(x.OneofFloat))))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xad
+	case *TestAllTypes_OneofDouble:
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.OneofDouble))))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xb1
+	case *TestAllTypes_OneofEnum:
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.OneofEnum))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xb8
+	}
+	if x.SingularImportEnum != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.SingularImportEnum))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb8
+	}
+	if x.SingularForeignEnum != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.SingularForeignEnum))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb0
+	}
+	if x.SingularNestedEnum != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.SingularNestedEnum))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xa8
+	}
+	if x.SingularImportMessage != nil {
+		l = options.Size(x.SingularImportMessage)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.SingularImportMessage); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xa2
+	}
+	if x.SingularForeignMessage != nil {
+		l = options.Size(x.SingularForeignMessage)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.SingularForeignMessage); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if x.SingularNestedMessage != nil {
+		l = options.Size(x.SingularNestedMessage)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.SingularNestedMessage); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(x.SingularBytes) > 0 {
+		i -= len(x.SingularBytes)
+		copy(dAtA[i:], x.SingularBytes)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SingularBytes)))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(x.SingularString) > 0 {
+		if !utf8.ValidString(x.SingularString) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.SingularString)
+		copy(dAtA[i:], x.SingularString)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SingularString)))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xf2
+	}
+	if x.SingularBool {
+		i--
+		if x.SingularBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xe8
+	}
+	if x.SingularDouble != 0 || math.Signbit(x.SingularDouble) {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.SingularDouble))))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xe1
+	}
+	if x.SingularFloat != 0 || math.Signbit(float64(x.SingularFloat)) {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(x.SingularFloat))))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xdd
+	}
+	if x.SingularSfixed64 != 0 {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(x.SingularSfixed64))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xd1
+	}
+	if x.SingularSfixed32 != 0 {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.SingularSfixed32))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xcd
+	}
+	if x.SingularFixed64 != 0 {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(x.SingularFixed64))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xc1
+	}
+	if x.SingularFixed32 != 0 {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.SingularFixed32))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xbd
+	}
+	if x.SingularSint64 != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64((uint64(x.SingularSint64)<<1)^uint64((x.SingularSint64>>63))))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xb0
+	}
+	if x.SingularSint32 != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64((uint32(x.SingularSint32)<<1)^uint32((x.SingularSint32>>31))))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xa8
+	}
+	if x.SingularUint64 != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.SingularUint64))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xa0
+	}
+	if x.SingularUint32 != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.SingularUint32))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x98
+	}
+	if x.SingularInt64 != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.SingularInt64))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x90
+	}
+	if x.SingularInt32 != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.SingularInt32))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(x.MapStringNestedEnum) > 0 {
+		MaRsHaLmAp := func(k string, v TestAllTypes_NestedEnum) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i = runtime.EncodeVarint(dAtA, i, uint64(v))
 			i--
-			dAtA[i] = 0xf8
-		case *TestAllTypes_OneofNestedMessage:
-			encoded, err := options.Marshal(x.OneofNestedMessage)
-			if err != nil {
+			dAtA[i] = 0x10
+			if !utf8.ValidString(k) {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 					Buf:               input.Buf,
-				}, err
+				}, runtime.ErrInvalidUTF8
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x7
-			i--
-			dAtA[i] = 0x82
-		case *TestAllTypes_OneofString:
-			i -= len(x.OneofString)
-			copy(dAtA[i:], x.OneofString)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OneofString)))
-			i--
-			dAtA[i] = 0x7
-			i--
-			dAtA[i] = 0x8a
-		case *TestAllTypes_OneofBytes:
-			i -= len(x.OneofBytes)
-			copy(dAtA[i:], x.OneofBytes)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OneofBytes)))
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
 			i--
-			dAtA[i] = 0x7
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x92
-		case *TestAllTypes_OneofBool:
+			dAtA[i] = 0x4
 			i--
-			if x.OneofBool {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+			dAtA[i] = 0xca
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForMapStringNestedEnum := make([]string, 0, len(x.MapStringNestedEnum))
+			for k := range x.MapStringNestedEnum {
+				keysForMapStringNestedEnum = append(keysForMapStringNestedEnum, string(k))
+			}
+			sort.Slice(keysForMapStringNestedEnum, func(i, j int) bool {
+				return keysForMapStringNestedEnum[i] < keysForMapStringNestedEnum[j]
+			})
+			for iNdEx := len(keysForMapStringNestedEnum) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapStringNestedEnum[string(keysForMapStringNestedEnum[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapStringNestedEnum[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapStringNestedEnum {
+				v := x.MapStringNestedEnum[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
 			}
-			i--
-			dAtA[i] = 0x7
-			i--
-			dAtA[i] = 0x98
-		case *TestAllTypes_OneofUint64:
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OneofUint64))
-			i--
-			dAtA[i] = 0x7
-			i--
-			dAtA[i] = 0xa0
-		case *TestAllTypes_OneofFloat:
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(x.OneofFloat))))
-			i--
-			dAtA[i] = 0x7
-			i--
-			dAtA[i] = 0xad
-		case *TestAllTypes_OneofDouble:
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.OneofDouble))))
-			i--
-			dAtA[i] = 0x7
-			i--
-			dAtA[i] = 0xb1
-		case *TestAllTypes_OneofEnum:
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OneofEnum))
-			i--
-			dAtA[i] = 0x7
-			i--
-			dAtA[i] = 0xb8
 		}
-		if x.SingularImportEnum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SingularImportEnum))
+	}
+	if len(x.MapStringNestedMessage) > 0 {
+		MaRsHaLmAp := func(k string, v *TestAllTypes_NestedMessage) (protoiface.MarshalOutput, error) {
+			baseI := i
+			l = options.Size(v)
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], v); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
 			i--
-			dAtA[i] = 0x6
+			dAtA[i] = 0x12
+			if !utf8.ValidString(k) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
 			i--
-			dAtA[i] = 0xb8
-		}
-		if x.SingularForeignEnum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SingularForeignEnum))
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x6
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xb0
+			dAtA[i] = 0xba
+			return protoiface.MarshalOutput{}, nil
 		}
-		if x.SingularNestedEnum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SingularNestedEnum))
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xa8
+		if options.Deterministic {
+			keysForMapStringNestedMessage := make([]string, 0, len(x.MapStringNestedMessage))
+			for k := range x.MapStringNestedMessage {
+				keysForMapStringNestedMessage = append(keysForMapStringNestedMessage, string(k))
+			}
+			sort.Slice(keysForMapStringNestedMessage, func(i, j int) bool {
+				return keysForMapStringNestedMessage[i] < keysForMapStringNestedMessage[j]
+			})
+			for iNdEx := len(keysForMapStringNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapStringNestedMessage[string(keysForMapStringNestedMessage[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapStringNestedMessage[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapStringNestedMessage {
+				v := x.MapStringNestedMessage[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
 		}
-		if x.SingularImportMessage != nil {
-			encoded, err := options.Marshal(x.SingularImportMessage)
-			if err != nil {
+	}
+	if len(x.MapStringBytes) > 0 {
+		MaRsHaLmAp := func(k string, v []byte) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			if !utf8.ValidString(k) {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 					Buf:               input.Buf,
-				}, err
+				}, runtime.ErrInvalidUTF8
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
 			i--
-			dAtA[i] = 0x6
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0xa2
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xb2
+			return protoiface.MarshalOutput{}, nil
 		}
-		if x.SingularForeignMessage != nil {
-			encoded, err := options.Marshal(x.SingularForeignMessage)
-			if err != nil {
+		if options.Deterministic {
+			keysForMapStringBytes := make([]string, 0, len(x.MapStringBytes))
+			for k := range x.MapStringBytes {
+				keysForMapStringBytes = append(keysForMapStringBytes, string(k))
+			}
+			sort.Slice(keysForMapStringBytes, func(i, j int) bool {
+				return keysForMapStringBytes[i] < keysForMapStringBytes[j]
+			})
+			for iNdEx := len(keysForMapStringBytes) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapStringBytes[string(keysForMapStringBytes[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapStringBytes[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapStringBytes {
+				v := x.MapStringBytes[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.MapStringString) > 0 {
+		MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
+			baseI := i
+			if !utf8.ValidString(v) {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 					Buf:               input.Buf,
-				}, err
+				}, runtime.ErrInvalidUTF8
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x6
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
 			i--
-			dAtA[i] = 0x9a
-		}
-		if x.SingularNestedMessage != nil {
-			encoded, err := options.Marshal(x.SingularNestedMessage)
-			if err != nil {
+			dAtA[i] = 0x12
+			if !utf8.ValidString(k) {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 					Buf:               input.Buf,
-				}, err
+				}, runtime.ErrInvalidUTF8
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x6
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
 			i--
-			dAtA[i] = 0x92
-		}
-		if len(x.SingularBytes) > 0 {
-			i -= len(x.SingularBytes)
-			copy(dAtA[i:], x.SingularBytes)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SingularBytes)))
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x5
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xfa
+			dAtA[i] = 0xaa
+			return protoiface.MarshalOutput{}, nil
 		}
-		if len(x.SingularString) > 0 {
-			i -= len(x.SingularString)
-			copy(dAtA[i:], x.SingularString)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SingularString)))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xf2
+		if options.Deterministic {
+			keysForMapStringString := make([]string, 0, len(x.MapStringString))
+			for k := range x.MapStringString {
+				keysForMapStringString = append(keysForMapStringString, string(k))
+			}
+			sort.Slice(keysForMapStringString, func(i, j int) bool {
+				return keysForMapStringString[i] < keysForMapStringString[j]
+			})
+			for iNdEx := len(keysForMapStringString) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapStringString[string(keysForMapStringString[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapStringString[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapStringString {
+				v := x.MapStringString[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
 		}
-		if x.SingularBool {
+	}
+	if len(x.MapBoolBool) > 0 {
+		MaRsHaLmAp := func(k bool, v bool) (protoiface.MarshalOutput, error) {
+			baseI := i
 			i--
-			if x.SingularBool {
+			if v {
 				dAtA[i] = 1
 			} else {
 				dAtA[i] = 0
 			}
 			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xe8
-		}
-		if x.SingularDouble != 0 || math.Signbit(x.SingularDouble) {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.SingularDouble))))
+			dAtA[i] = 0x10
 			i--
-			dAtA[i] = 0x5
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
 			i--
-			dAtA[i] = 0xe1
-		}
-		if x.SingularFloat != 0 || math.Signbit(float64(x.SingularFloat)) {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(x.SingularFloat))))
+			dAtA[i] = 0x8
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x5
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xdd
+			dAtA[i] = 0xa2
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForMapBoolBool := make([]bool, 0, len(x.MapBoolBool))
+			for k := range x.MapBoolBool {
+				keysForMapBoolBool = append(keysForMapBoolBool, bool(k))
+			}
+			sort.Slice(keysForMapBoolBool, func(i, j int) bool {
+				return !keysForMapBoolBool[i] && keysForMapBoolBool[j]
+			})
+			for iNdEx := len(keysForMapBoolBool) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapBoolBool[bool(keysForMapBoolBool[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapBoolBool[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapBoolBool {
+				v := x.MapBoolBool[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
 		}
-		if x.SingularSfixed64 != 0 {
+	}
+	if len(x.MapInt32Double) > 0 {
+		MaRsHaLmAp := func(k int32, v float64) (protoiface.MarshalOutput, error) {
+			baseI := i
 			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(x.SingularSfixed64))
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
 			i--
-			dAtA[i] = 0x5
+			dAtA[i] = 0x11
+			i = runtime.EncodeVarint(dAtA, i, uint64(k))
 			i--
-			dAtA[i] = 0xd1
-		}
-		if x.SingularSfixed32 != 0 {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.SingularSfixed32))
+			dAtA[i] = 0x8
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x5
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xcd
+			dAtA[i] = 0x9a
+			return protoiface.MarshalOutput{}, nil
 		}
-		if x.SingularFixed64 != 0 {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(x.SingularFixed64))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xc1
+		if options.Deterministic {
+			keysForMapInt32Double := make([]int32, 0, len(x.MapInt32Double))
+			for k := range x.MapInt32Double {
+				keysForMapInt32Double = append(keysForMapInt32Double, int32(k))
+			}
+			sort.Slice(keysForMapInt32Double, func(i, j int) bool {
+				return keysForMapInt32Double[i] < keysForMapInt32Double[j]
+			})
+			for iNdEx := len(keysForMapInt32Double) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapInt32Double[int32(keysForMapInt32Double[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapInt32Double[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapInt32Double {
+				v := x.MapInt32Double[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
 		}
-		if x.SingularFixed32 != 0 {
+	}
+	if len(x.MapInt32Float) > 0 {
+		MaRsHaLmAp := func(k int32, v float32) (protoiface.MarshalOutput, error) {
+			baseI := i
 			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.SingularFixed32))
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
 			i--
-			dAtA[i] = 0x5
+			dAtA[i] = 0x15
+			i = runtime.EncodeVarint(dAtA, i, uint64(k))
 			i--
-			dAtA[i] = 0xbd
-		}
-		if x.SingularSint64 != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64((uint64(x.SingularSint64)<<1)^uint64((x.SingularSint64>>63))))
+			dAtA[i] = 0x8
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x5
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xb0
+			dAtA[i] = 0x92
+			return protoiface.MarshalOutput{}, nil
 		}
-		if x.SingularSint32 != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64((uint32(x.SingularSint32)<<1)^uint32((x.SingularSint32>>31))))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xa8
+		if options.Deterministic {
+			keysForMapInt32Float := make([]int32, 0, len(x.MapInt32Float))
+			for k := range x.MapInt32Float {
+				keysForMapInt32Float = append(keysForMapInt32Float, int32(k))
+			}
+			sort.Slice(keysForMapInt32Float, func(i, j int) bool {
+				return keysForMapInt32Float[i] < keysForMapInt32Float[j]
+			})
+			for iNdEx := len(keysForMapInt32Float) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapInt32Float[int32(keysForMapInt32Float[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapInt32Float[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapInt32Float {
+				v := x.MapInt32Float[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
 		}
-		if x.SingularUint64 != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SingularUint64))
+	}
+	if len(x.MapSfixed64Sfixed64) > 0 {
+		MaRsHaLmAp := func(k int64, v int64) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
 			i--
-			dAtA[i] = 0x5
+			dAtA[i] = 0x11
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
 			i--
-			dAtA[i] = 0xa0
-		}
-		if x.SingularUint32 != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SingularUint32))
+			dAtA[i] = 0x9
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x5
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0x98
+			dAtA[i] = 0x8a
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForMapSfixed64Sfixed64 := make([]int64, 0, len(x.MapSfixed64Sfixed64))
+			for k := range x.MapSfixed64Sfixed64 {
+				keysForMapSfixed64Sfixed64 = append(keysForMapSfixed64Sfixed64, int64(k))
+			}
+			sort.Slice(keysForMapSfixed64Sfixed64, func(i, j int) bool {
+				return keysForMapSfixed64Sfixed64[i] < keysForMapSfixed64Sfixed64[j]
+			})
+			for iNdEx := len(keysForMapSfixed64Sfixed64) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapSfixed64Sfixed64[int64(keysForMapSfixed64Sfixed64[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapSfixed64Sfixed64[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapSfixed64Sfixed64 {
+				v := x.MapSfixed64Sfixed64[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
 		}
-		if x.SingularInt64 != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SingularInt64))
+	}
+	if len(x.MapSfixed32Sfixed32) > 0 {
+		MaRsHaLmAp := func(k int32, v int32) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
 			i--
-			dAtA[i] = 0x5
+			dAtA[i] = 0x15
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
 			i--
-			dAtA[i] = 0x90
-		}
-		if x.SingularInt32 != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SingularInt32))
+			dAtA[i] = 0xd
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x5
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0x88
-		}
-		if len(x.MapStringNestedEnum) > 0 {
-			MaRsHaLmAp := func(k string, v TestAllTypes_NestedEnum) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i = runtime.EncodeVarint(dAtA, i, uint64(v))
-				i--
-				dAtA[i] = 0x10
-				i -= len(k)
-				copy(dAtA[i:], k)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
-				i--
-				dAtA[i] = 0xa
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0xca
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapStringNestedEnum := make([]string, 0, len(x.MapStringNestedEnum))
-				for k := range x.MapStringNestedEnum {
-					keysForMapStringNestedEnum = append(keysForMapStringNestedEnum, string(k))
-				}
-				sort.Slice(keysForMapStringNestedEnum, func(i, j int) bool {
-					return keysForMapStringNestedEnum[i] < keysForMapStringNestedEnum[j]
-				})
-				for iNdEx := len(keysForMapStringNestedEnum) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapStringNestedEnum[string(keysForMapStringNestedEnum[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapStringNestedEnum[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapStringNestedEnum {
-					v := x.MapStringNestedEnum[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
+			dAtA[i] = 0x82
+			return protoiface.MarshalOutput{}, nil
 		}
-		if len(x.MapStringNestedMessage) > 0 {
-			MaRsHaLmAp := func(k string, v *TestAllTypes_NestedMessage) (protoiface.MarshalOutput, error) {
-				baseI := i
-				encoded, err := options.Marshal(v)
+		if options.Deterministic {
+			keysForMapSfixed32Sfixed32 := make([]int32, 0, len(x.MapSfixed32Sfixed32))
+			for k := range x.MapSfixed32Sfixed32 {
+				keysForMapSfixed32Sfixed32 = append(keysForMapSfixed32Sfixed32, int32(k))
+			}
+			sort.Slice(keysForMapSfixed32Sfixed32, func(i, j int) bool {
+				return keysForMapSfixed32Sfixed32[i] < keysForMapSfixed32Sfixed32[j]
+			})
+			for iNdEx := len(keysForMapSfixed32Sfixed32) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapSfixed32Sfixed32[int32(keysForMapSfixed32Sfixed32[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapSfixed32Sfixed32[iNdEx], v)
 				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-				i -= len(k)
-				copy(dAtA[i:], k)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
-				i--
-				dAtA[i] = 0xa
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0xba
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapStringNestedMessage := make([]string, 0, len(x.MapStringNestedMessage))
-				for k := range x.MapStringNestedMessage {
-					keysForMapStringNestedMessage = append(keysForMapStringNestedMessage, string(k))
-				}
-				sort.Slice(keysForMapStringNestedMessage, func(i, j int) bool {
-					return keysForMapStringNestedMessage[i] < keysForMapStringNestedMessage[j]
-				})
-				for iNdEx := len(keysForMapStringNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapStringNestedMessage[string(keysForMapStringNestedMessage[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapStringNestedMessage[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapStringNestedMessage {
-					v := x.MapStringNestedMessage[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
-		}
-		if len(x.MapStringBytes) > 0 {
-			MaRsHaLmAp := func(k string, v []byte) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
-				i--
-				dAtA[i] = 0x12
-				i -= len(k)
-				copy(dAtA[i:], k)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
-				i--
-				dAtA[i] = 0xa
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0xb2
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapStringBytes := make([]string, 0, len(x.MapStringBytes))
-				for k := range x.MapStringBytes {
-					keysForMapStringBytes = append(keysForMapStringBytes, string(k))
-				}
-				sort.Slice(keysForMapStringBytes, func(i, j int) bool {
-					return keysForMapStringBytes[i] < keysForMapStringBytes[j]
-				})
-				for iNdEx := len(keysForMapStringBytes) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapStringBytes[string(keysForMapStringBytes[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapStringBytes[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapStringBytes {
-					v := x.MapStringBytes[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
-		}
-		if len(x.MapStringString) > 0 {
-			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
-				i--
-				dAtA[i] = 0x12
-				i -= len(k)
-				copy(dAtA[i:], k)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
-				i--
-				dAtA[i] = 0xa
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0xaa
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapStringString := make([]string, 0, len(x.MapStringString))
-				for k := range x.MapStringString {
-					keysForMapStringString = append(keysForMapStringString, string(k))
-				}
-				sort.Slice(keysForMapStringString, func(i, j int) bool {
-					return keysForMapStringString[i] < keysForMapStringString[j]
-				})
-				for iNdEx := len(keysForMapStringString) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapStringString[string(keysForMapStringString[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapStringString[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapStringString {
-					v := x.MapStringString[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
+					return out, err
 				}
 			}
-		}
-		if len(x.MapBoolBool) > 0 {
-			MaRsHaLmAp := func(k bool, v bool) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i--
-				if v {
-					dAtA[i] = 1
-				} else {
-					dAtA[i] = 0
-				}
-				i--
-				dAtA[i] = 0x10
-				i--
-				if k {
-					dAtA[i] = 1
-				} else {
-					dAtA[i] = 0
-				}
-				i--
-				dAtA[i] = 0x8
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0xa2
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapBoolBool := make([]bool, 0, len(x.MapBoolBool))
-				for k := range x.MapBoolBool {
-					keysForMapBoolBool = append(keysForMapBoolBool, bool(k))
-				}
-				sort.Slice(keysForMapBoolBool, func(i, j int) bool {
-					return !keysForMapBoolBool[i] && keysForMapBoolBool[j]
-				})
-				for iNdEx := len(keysForMapBoolBool) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapBoolBool[bool(keysForMapBoolBool[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapBoolBool[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapBoolBool {
-					v := x.MapBoolBool[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
-		}
-		if len(x.MapInt32Double) > 0 {
-			MaRsHaLmAp := func(k int32, v float64) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i -= 8
-				binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
-				i--
-				dAtA[i] = 0x11
-				i = runtime.EncodeVarint(dAtA, i, uint64(k))
-				i--
-				dAtA[i] = 0x8
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0x9a
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapInt32Double := make([]int32, 0, len(x.MapInt32Double))
-				for k := range x.MapInt32Double {
-					keysForMapInt32Double = append(keysForMapInt32Double, int32(k))
-				}
-				sort.Slice(keysForMapInt32Double, func(i, j int) bool {
-					return keysForMapInt32Double[i] < keysForMapInt32Double[j]
-				})
-				for iNdEx := len(keysForMapInt32Double) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapInt32Double[int32(keysForMapInt32Double[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapInt32Double[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapInt32Double {
-					v := x.MapInt32Double[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
-		}
-		if len(x.MapInt32Float) > 0 {
-			MaRsHaLmAp := func(k int32, v float32) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i -= 4
-				binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
-				i--
-				dAtA[i] = 0x15
-				i = runtime.EncodeVarint(dAtA, i, uint64(k))
-				i--
-				dAtA[i] = 0x8
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0x92
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapInt32Float := make([]int32, 0, len(x.MapInt32Float))
-				for k := range x.MapInt32Float {
-					keysForMapInt32Float = append(keysForMapInt32Float, int32(k))
-				}
-				sort.Slice(keysForMapInt32Float, func(i, j int) bool {
-					return keysForMapInt32Float[i] < keysForMapInt32Float[j]
-				})
-				for iNdEx := len(keysForMapInt32Float) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapInt32Float[int32(keysForMapInt32Float[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapInt32Float[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapInt32Float {
-					v := x.MapInt32Float[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
-		}
-		if len(x.MapSfixed64Sfixed64) > 0 {
-			MaRsHaLmAp := func(k int64, v int64) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i -= 8
-				binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
-				i--
-				dAtA[i] = 0x11
-				i -= 8
-				binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
-				i--
-				dAtA[i] = 0x9
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0x8a
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapSfixed64Sfixed64 := make([]int64, 0, len(x.MapSfixed64Sfixed64))
-				for k := range x.MapSfixed64Sfixed64 {
-					keysForMapSfixed64Sfixed64 = append(keysForMapSfixed64Sfixed64, int64(k))
-				}
-				sort.Slice(keysForMapSfixed64Sfixed64, func(i, j int) bool {
-					return keysForMapSfixed64Sfixed64[i] < keysForMapSfixed64Sfixed64[j]
-				})
-				for iNdEx := len(keysForMapSfixed64Sfixed64) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapSfixed64Sfixed64[int64(keysForMapSfixed64Sfixed64[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapSfixed64Sfixed64[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapSfixed64Sfixed64 {
-					v := x.MapSfixed64Sfixed64[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
-		}
-		if len(x.MapSfixed32Sfixed32) > 0 {
-			MaRsHaLmAp := func(k int32, v int32) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i -= 4
-				binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
-				i--
-				dAtA[i] = 0x15
-				i -= 4
-				binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
-				i--
-				dAtA[i] = 0xd
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0x82
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapSfixed32Sfixed32 := make([]int32, 0, len(x.MapSfixed32Sfixed32))
-				for k := range x.MapSfixed32Sfixed32 {
-					keysForMapSfixed32Sfixed32 = append(keysForMapSfixed32Sfixed32, int32(k))
-				}
-				sort.Slice(keysForMapSfixed32Sfixed32, func(i, j int) bool {
-					return keysForMapSfixed32Sfixed32[i] < keysForMapSfixed32Sfixed32[j]
-				})
-				for iNdEx := len(keysForMapSfixed32Sfixed32) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapSfixed32Sfixed32[int32(keysForMapSfixed32Sfixed32[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapSfixed32Sfixed32[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapSfixed32Sfixed32 {
-					v := x.MapSfixed32Sfixed32[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
-		}
-		if len(x.MapFixed64Fixed64) > 0 {
-			MaRsHaLmAp := func(k uint64, v uint64) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i -= 8
-				binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
-				i--
-				dAtA[i] = 0x11
-				i -= 8
-				binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
-				i--
-				dAtA[i] = 0x9
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xfa
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapFixed64Fixed64 := make([]uint64, 0, len(x.MapFixed64Fixed64))
-				for k := range x.MapFixed64Fixed64 {
-					keysForMapFixed64Fixed64 = append(keysForMapFixed64Fixed64, uint64(k))
-				}
-				sort.Slice(keysForMapFixed64Fixed64, func(i, j int) bool {
-					return keysForMapFixed64Fixed64[i] < keysForMapFixed64Fixed64[j]
-				})
-				for iNdEx := len(keysForMapFixed64Fixed64) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapFixed64Fixed64[uint64(keysForMapFixed64Fixed64[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapFixed64Fixed64[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapFixed64Fixed64 {
-					v := x.MapFixed64Fixed64[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
-		}
-		if len(x.MapFixed32Fixed32) > 0 {
-			MaRsHaLmAp := func(k uint32, v uint32) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i -= 4
-				binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
-				i--
-				dAtA[i] = 0x15
-				i -= 4
-				binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
-				i--
-				dAtA[i] = 0xd
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xf2
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapFixed32Fixed32 := make([]uint32, 0, len(x.MapFixed32Fixed32))
-				for k := range x.MapFixed32Fixed32 {
-					keysForMapFixed32Fixed32 = append(keysForMapFixed32Fixed32, uint32(k))
-				}
-				sort.Slice(keysForMapFixed32Fixed32, func(i, j int) bool {
-					return keysForMapFixed32Fixed32[i] < keysForMapFixed32Fixed32[j]
-				})
-				for iNdEx := len(keysForMapFixed32Fixed32) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapFixed32Fixed32[uint32(keysForMapFixed32Fixed32[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapFixed32Fixed32[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapFixed32Fixed32 {
-					v := x.MapFixed32Fixed32[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
-		}
-		if len(x.MapSint64Sint64) > 0 {
-			MaRsHaLmAp := func(k int64, v int64) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i = runtime.EncodeVarint(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
-				i--
-				dAtA[i] = 0x10
-				i = runtime.EncodeVarint(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
-				i--
-				dAtA[i] = 0x8
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xea
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapSint64Sint64 := make([]int64, 0, len(x.MapSint64Sint64))
-				for k := range x.MapSint64Sint64 {
-					keysForMapSint64Sint64 = append(keysForMapSint64Sint64, int64(k))
-				}
-				sort.Slice(keysForMapSint64Sint64, func(i, j int) bool {
-					return keysForMapSint64Sint64[i] < keysForMapSint64Sint64[j]
-				})
-				for iNdEx := len(keysForMapSint64Sint64) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapSint64Sint64[int64(keysForMapSint64Sint64[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapSint64Sint64[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapSint64Sint64 {
-					v := x.MapSint64Sint64[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
-		}
-		if len(x.MapSint32Sint32) > 0 {
-			MaRsHaLmAp := func(k int32, v int32) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i = runtime.EncodeVarint(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
-				i--
-				dAtA[i] = 0x10
-				i = runtime.EncodeVarint(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
-				i--
-				dAtA[i] = 0x8
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xe2
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapSint32Sint32 := make([]int32, 0, len(x.MapSint32Sint32))
-				for k := range x.MapSint32Sint32 {
-					keysForMapSint32Sint32 = append(keysForMapSint32Sint32, int32(k))
-				}
-				sort.Slice(keysForMapSint32Sint32, func(i, j int) bool {
-					return keysForMapSint32Sint32[i] < keysForMapSint32Sint32[j]
-				})
-				for iNdEx := len(keysForMapSint32Sint32) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapSint32Sint32[int32(keysForMapSint32Sint32[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapSint32Sint32[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapSint32Sint32 {
-					v := x.MapSint32Sint32[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
-		}
-		if len(x.MapUint64Uint64) > 0 {
-			MaRsHaLmAp := func(k uint64, v uint64) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i = runtime.EncodeVarint(dAtA, i, uint64(v))
-				i--
-				dAtA[i] = 0x10
-				i = runtime.EncodeVarint(dAtA, i, uint64(k))
-				i--
-				dAtA[i] = 0x8
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xda
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapUint64Uint64 := make([]uint64, 0, len(x.MapUint64Uint64))
-				for k := range x.MapUint64Uint64 {
-					keysForMapUint64Uint64 = append(keysForMapUint64Uint64, uint64(k))
-				}
-				sort.Slice(keysForMapUint64Uint64, func(i, j int) bool {
-					return keysForMapUint64Uint64[i] < keysForMapUint64Uint64[j]
-				})
-				for iNdEx := len(keysForMapUint64Uint64) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapUint64Uint64[uint64(keysForMapUint64Uint64[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapUint64Uint64[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapUint64Uint64 {
-					v := x.MapUint64Uint64[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
-		}
-		if len(x.MapUint32Uint32) > 0 {
-			MaRsHaLmAp := func(k uint32, v uint32) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i = runtime.EncodeVarint(dAtA, i, uint64(v))
-				i--
-				dAtA[i] = 0x10
-				i = runtime.EncodeVarint(dAtA, i, uint64(k))
-				i--
-				dAtA[i] = 0x8
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xd2
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapUint32Uint32 := make([]uint32, 0, len(x.MapUint32Uint32))
-				for k := range x.MapUint32Uint32 {
-					keysForMapUint32Uint32 = append(keysForMapUint32Uint32, uint32(k))
-				}
-				sort.Slice(keysForMapUint32Uint32, func(i, j int) bool {
-					return keysForMapUint32Uint32[i] < keysForMapUint32Uint32[j]
-				})
-				for iNdEx := len(keysForMapUint32Uint32) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapUint32Uint32[uint32(keysForMapUint32Uint32[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapUint32Uint32[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapUint32Uint32 {
-					v := x.MapUint32Uint32[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
-		}
-		if len(x.MapInt64Int64) > 0 {
-			MaRsHaLmAp := func(k int64, v int64) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i = runtime.EncodeVarint(dAtA, i, uint64(v))
-				i--
-				dAtA[i] = 0x10
-				i = runtime.EncodeVarint(dAtA, i, uint64(k))
-				i--
-				dAtA[i] = 0x8
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xca
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapInt64Int64 := make([]int64, 0, len(x.MapInt64Int64))
-				for k := range x.MapInt64Int64 {
-					keysForMapInt64Int64 = append(keysForMapInt64Int64, int64(k))
-				}
-				sort.Slice(keysForMapInt64Int64, func(i, j int) bool {
-					return keysForMapInt64Int64[i] < keysForMapInt64Int64[j]
-				})
-				for iNdEx := len(keysForMapInt64Int64) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapInt64Int64[int64(keysForMapInt64Int64[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapInt64Int64[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapInt64Int64 {
-					v := x.MapInt64Int64[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
-		}
-		if len(x.MapInt32Int32) > 0 {
-			MaRsHaLmAp := func(k int32, v int32) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i = runtime.EncodeVarint(dAtA, i, uint64(v))
-				i--
-				dAtA[i] = 0x10
-				i = runtime.EncodeVarint(dAtA, i, uint64(k))
-				i--
-				dAtA[i] = 0x8
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xc2
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMapInt32Int32 := make([]int32, 0, len(x.MapInt32Int32))
-				for k := range x.MapInt32Int32 {
-					keysForMapInt32Int32 = append(keysForMapInt32Int32, int32(k))
-				}
-				sort.Slice(keysForMapInt32Int32, func(i, j int) bool {
-					return keysForMapInt32Int32[i] < keysForMapInt32Int32[j]
-				})
-				for iNdEx := len(keysForMapInt32Int32) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MapInt32Int32[int32(keysForMapInt32Int32[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMapInt32Int32[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MapInt32Int32 {
-					v := x.MapInt32Int32[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
+		} else {
+			for k := range x.MapSfixed32Sfixed32 {
+				v := x.MapSfixed32Sfixed32[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
 				}
 			}
 		}
-		if len(x.RepeatedImportenum) > 0 {
-			var pksize2 int
-			for _, num := range x.RepeatedImportenum {
-				pksize2 += runtime.Sov(uint64(num))
-			}
-			i -= pksize2
-			j1 := i
-			for _, num1 := range x.RepeatedImportenum {
-				num := uint64(num1)
-				for num >= 1<<7 {
-					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j1++
-				}
-				dAtA[j1] = uint8(num)
-				j1++
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+	}
+	if len(x.MapFixed64Fixed64) > 0 {
+		MaRsHaLmAp := func(k uint64, v uint64) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x11
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
 			i--
-			dAtA[i] = 0xaa
-		}
-		if len(x.RepeatedForeignEnum) > 0 {
-			var pksize4 int
-			for _, num := range x.RepeatedForeignEnum {
-				pksize4 += runtime.Sov(uint64(num))
-			}
-			i -= pksize4
-			j3 := i
-			for _, num1 := range x.RepeatedForeignEnum {
-				num := uint64(num1)
-				for num >= 1<<7 {
-					dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j3++
-				}
-				dAtA[j3] = uint8(num)
-				j3++
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize4))
+			dAtA[i] = 0x9
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
 			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xa2
+			dAtA[i] = 0xfa
+			return protoiface.MarshalOutput{}, nil
 		}
-		if len(x.RepeatedNestedEnum) > 0 {
-			var pksize6 int
-			for _, num := range x.RepeatedNestedEnum {
-				pksize6 += runtime.Sov(uint64(num))
-			}
-			i -= pksize6
-			j5 := i
-			for _, num1 := range x.RepeatedNestedEnum {
-				num := uint64(num1)
-				for num >= 1<<7 {
-					dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j5++
-				}
-				dAtA[j5] = uint8(num)
-				j5++
+		if options.Deterministic {
+			keysForMapFixed64Fixed64 := make([]uint64, 0, len(x.MapFixed64Fixed64))
+			for k := range x.MapFixed64Fixed64 {
+				keysForMapFixed64Fixed64 = append(keysForMapFixed64Fixed64, uint64(k))
+			}
+			sort.Slice(keysForMapFixed64Fixed64, func(i, j int) bool {
+				return keysForMapFixed64Fixed64[i] < keysForMapFixed64Fixed64[j]
+			})
+			for iNdEx := len(keysForMapFixed64Fixed64) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapFixed64Fixed64[uint64(keysForMapFixed64Fixed64[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapFixed64Fixed64[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapFixed64Fixed64 {
+				v := x.MapFixed64Fixed64[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
 			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize6))
+		}
+	}
+	if len(x.MapFixed32Fixed32) > 0 {
+		MaRsHaLmAp := func(k uint32, v uint32) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
 			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0x9a
+			dAtA[i] = 0xf2
+			return protoiface.MarshalOutput{}, nil
 		}
-		if len(x.RepeatedImportmessage) > 0 {
-			for iNdEx := len(x.RepeatedImportmessage) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.RepeatedImportmessage[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0x92
-			}
-		}
-		if len(x.RepeatedForeignMessage) > 0 {
-			for iNdEx := len(x.RepeatedForeignMessage) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.RepeatedForeignMessage[iNdEx])
+		if options.Deterministic {
+			keysForMapFixed32Fixed32 := make([]uint32, 0, len(x.MapFixed32Fixed32))
+			for k := range x.MapFixed32Fixed32 {
+				keysForMapFixed32Fixed32 = append(keysForMapFixed32Fixed32, uint32(k))
+			}
+			sort.Slice(keysForMapFixed32Fixed32, func(i, j int) bool {
+				return keysForMapFixed32Fixed32[i] < keysForMapFixed32Fixed32[j]
+			})
+			for iNdEx := len(keysForMapFixed32Fixed32) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapFixed32Fixed32[uint32(keysForMapFixed32Fixed32[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapFixed32Fixed32[iNdEx], v)
 				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0x8a
-			}
-		}
-		if len(x.RepeatedNestedMessage) > 0 {
-			for iNdEx := len(x.RepeatedNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.RepeatedNestedMessage[iNdEx])
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapFixed32Fixed32 {
+				v := x.MapFixed32Fixed32[k]
+				out, err := MaRsHaLmAp(k, v)
 				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0x82
-			}
-		}
-		if len(x.RepeatedBytes) > 0 {
-			for iNdEx := len(x.RepeatedBytes) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.RepeatedBytes[iNdEx])
-				copy(dAtA[i:], x.RepeatedBytes[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedBytes[iNdEx])))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0xea
-			}
-		}
-		if len(x.RepeatedString) > 0 {
-			for iNdEx := len(x.RepeatedString) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.RepeatedString[iNdEx])
-				copy(dAtA[i:], x.RepeatedString[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedString[iNdEx])))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0xe2
-			}
-		}
-		if len(x.RepeatedBool) > 0 {
-			for iNdEx := len(x.RepeatedBool) - 1; iNdEx >= 0; iNdEx-- {
-				i--
-				if x.RepeatedBool[iNdEx] {
-					dAtA[i] = 1
-				} else {
-					dAtA[i] = 0
+					return out, err
 				}
 			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedBool)))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xda
 		}
-		if len(x.RepeatedDouble) > 0 {
-			for iNdEx := len(x.RepeatedDouble) - 1; iNdEx >= 0; iNdEx-- {
-				f7 := math.Float64bits(float64(x.RepeatedDouble[iNdEx]))
-				i -= 8
-				binary.LittleEndian.PutUint64(dAtA[i:], uint64(f7))
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedDouble)*8))
+	}
+	if len(x.MapSint64Sint64) > 0 {
+		MaRsHaLmAp := func(k int64, v int64) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i = runtime.EncodeVarint(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x10
+			i = runtime.EncodeVarint(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
 			i--
-			dAtA[i] = 0xd2
-		}
-		if len(x.RepeatedFloat) > 0 {
-			for iNdEx := len(x.RepeatedFloat) - 1; iNdEx >= 0; iNdEx-- {
-				f8 := math.Float32bits(float32(x.RepeatedFloat[iNdEx]))
-				i -= 4
-				binary.LittleEndian.PutUint32(dAtA[i:], uint32(f8))
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedFloat)*4))
+			dAtA[i] = 0x8
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xca
+			dAtA[i] = 0xea
+			return protoiface.MarshalOutput{}, nil
 		}
-		if len(x.RepeatedSfixed64) > 0 {
-			for iNdEx := len(x.RepeatedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
-				i -= 8
-				binary.LittleEndian.PutUint64(dAtA[i:], uint64(x.RepeatedSfixed64[iNdEx]))
+		if options.Deterministic {
+			keysForMapSint64Sint64 := make([]int64, 0, len(x.MapSint64Sint64))
+			for k := range x.MapSint64Sint64 {
+				keysForMapSint64Sint64 = append(keysForMapSint64Sint64, int64(k))
+			}
+			sort.Slice(keysForMapSint64Sint64, func(i, j int) bool {
+				return keysForMapSint64Sint64[i] < keysForMapSint64Sint64[j]
+			})
+			for iNdEx := len(keysForMapSint64Sint64) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapSint64Sint64[int64(keysForMapSint64Sint64[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapSint64Sint64[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapSint64Sint64 {
+				v := x.MapSint64Sint64[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
 			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedSfixed64)*8))
+		}
+	}
+	if len(x.MapSint32Sint32) > 0 {
+		MaRsHaLmAp := func(k int32, v int32) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i = runtime.EncodeVarint(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x10
+			i = runtime.EncodeVarint(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
 			i--
-			dAtA[i] = 0xc2
-		}
-		if len(x.RepeatedSfixed32) > 0 {
-			for iNdEx := len(x.RepeatedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
-				i -= 4
-				binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.RepeatedSfixed32[iNdEx]))
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedSfixed32)*4))
+			dAtA[i] = 0x8
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xba
+			dAtA[i] = 0xe2
+			return protoiface.MarshalOutput{}, nil
 		}
-		if len(x.RepeatedFixed64) > 0 {
-			for iNdEx := len(x.RepeatedFixed64) - 1; iNdEx >= 0; iNdEx-- {
-				i -= 8
-				binary.LittleEndian.PutUint64(dAtA[i:], uint64(x.RepeatedFixed64[iNdEx]))
+		if options.Deterministic {
+			keysForMapSint32Sint32 := make([]int32, 0, len(x.MapSint32Sint32))
+			for k := range x.MapSint32Sint32 {
+				keysForMapSint32Sint32 = append(keysForMapSint32Sint32, int32(k))
+			}
+			sort.Slice(keysForMapSint32Sint32, func(i, j int) bool {
+				return keysForMapSint32Sint32[i] < keysForMapSint32Sint32[j]
+			})
+			for iNdEx := len(keysForMapSint32Sint32) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapSint32Sint32[int32(keysForMapSint32Sint32[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapSint32Sint32[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
 			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedFixed64)*8))
+		} else {
+			for k := range x.MapSint32Sint32 {
+				v := x.MapSint32Sint32[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.MapUint64Uint64) > 0 {
+		MaRsHaLmAp := func(k uint64, v uint64) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i = runtime.EncodeVarint(dAtA, i, uint64(v))
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x10
+			i = runtime.EncodeVarint(dAtA, i, uint64(k))
 			i--
-			dAtA[i] = 0xb2
-		}
-		if len(x.RepeatedFixed32) > 0 {
-			for iNdEx := len(x.RepeatedFixed32) - 1; iNdEx >= 0; iNdEx-- {
-				i -= 4
-				binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.RepeatedFixed32[iNdEx]))
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedFixed32)*4))
+			dAtA[i] = 0x8
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xaa
+			dAtA[i] = 0xda
+			return protoiface.MarshalOutput{}, nil
 		}
-		if len(x.RepeatedSint64) > 0 {
-			var pksize10 int
-			for _, num := range x.RepeatedSint64 {
-				pksize10 += runtime.Soz(uint64(num))
-			}
-			i -= pksize10
-			j9 := i
-			for _, num := range x.RepeatedSint64 {
-				x11 := (uint64(num) << 1) ^ uint64((num >> 63))
-				for x11 >= 1<<7 {
-					dAtA[j9] = uint8(uint64(x11)&0x7f | 0x80)
-					j9++
-					x11 >>= 7
-				}
-				dAtA[j9] = uint8(x11)
-				j9++
+		if options.Deterministic {
+			keysForMapUint64Uint64 := make([]uint64, 0, len(x.MapUint64Uint64))
+			for k := range x.MapUint64Uint64 {
+				keysForMapUint64Uint64 = append(keysForMapUint64Uint64, uint64(k))
+			}
+			sort.Slice(keysForMapUint64Uint64, func(i, j int) bool {
+				return keysForMapUint64Uint64[i] < keysForMapUint64Uint64[j]
+			})
+			for iNdEx := len(keysForMapUint64Uint64) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapUint64Uint64[uint64(keysForMapUint64Uint64[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapUint64Uint64[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapUint64Uint64 {
+				v := x.MapUint64Uint64[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
 			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize10))
+		}
+	}
+	if len(x.MapUint32Uint32) > 0 {
+		MaRsHaLmAp := func(k uint32, v uint32) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i = runtime.EncodeVarint(dAtA, i, uint64(v))
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x10
+			i = runtime.EncodeVarint(dAtA, i, uint64(k))
 			i--
-			dAtA[i] = 0xa2
-		}
-		if len(x.RepeatedSint32) > 0 {
-			var pksize13 int
-			for _, num := range x.RepeatedSint32 {
-				pksize13 += runtime.Soz(uint64(num))
-			}
-			i -= pksize13
-			j12 := i
-			for _, num := range x.RepeatedSint32 {
-				x14 := (uint32(num) << 1) ^ uint32((num >> 31))
-				for x14 >= 1<<7 {
-					dAtA[j12] = uint8(uint64(x14)&0x7f | 0x80)
-					j12++
-					x14 >>= 7
-				}
-				dAtA[j12] = uint8(x14)
-				j12++
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize13))
+			dAtA[i] = 0x8
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0x9a
+			dAtA[i] = 0xd2
+			return protoiface.MarshalOutput{}, nil
 		}
-		if len(x.RepeatedUint64) > 0 {
-			var pksize16 int
-			for _, num := range x.RepeatedUint64 {
-				pksize16 += runtime.Sov(uint64(num))
+		if options.Deterministic {
+			keysForMapUint32Uint32 := make([]uint32, 0, len(x.MapUint32Uint32))
+			for k := range x.MapUint32Uint32 {
+				keysForMapUint32Uint32 = append(keysForMapUint32Uint32, uint32(k))
 			}
-			i -= pksize16
-			j15 := i
-			for _, num := range x.RepeatedUint64 {
-				for num >= 1<<7 {
-					dAtA[j15] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j15++
+			sort.Slice(keysForMapUint32Uint32, func(i, j int) bool {
+				return keysForMapUint32Uint32[i] < keysForMapUint32Uint32[j]
+			})
+			for iNdEx := len(keysForMapUint32Uint32) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapUint32Uint32[uint32(keysForMapUint32Uint32[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapUint32Uint32[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapUint32Uint32 {
+				v := x.MapUint32Uint32[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
 				}
-				dAtA[j15] = uint8(num)
-				j15++
 			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize16))
+		}
+	}
+	if len(x.MapInt64Int64) > 0 {
+		MaRsHaLmAp := func(k int64, v int64) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i = runtime.EncodeVarint(dAtA, i, uint64(v))
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x10
+			i = runtime.EncodeVarint(dAtA, i, uint64(k))
 			i--
-			dAtA[i] = 0x92
+			dAtA[i] = 0x8
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xca
+			return protoiface.MarshalOutput{}, nil
 		}
-		if len(x.RepeatedUint32) > 0 {
-			var pksize18 int
-			for _, num := range x.RepeatedUint32 {
-				pksize18 += runtime.Sov(uint64(num))
+		if options.Deterministic {
+			keysForMapInt64Int64 := make([]int64, 0, len(x.MapInt64Int64))
+			for k := range x.MapInt64Int64 {
+				keysForMapInt64Int64 = append(keysForMapInt64Int64, int64(k))
 			}
-			i -= pksize18
-			j17 := i
-			for _, num := range x.RepeatedUint32 {
-				for num >= 1<<7 {
-					dAtA[j17] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j17++
+			sort.Slice(keysForMapInt64Int64, func(i, j int) bool {
+				return keysForMapInt64Int64[i] < keysForMapInt64Int64[j]
+			})
+			for iNdEx := len(keysForMapInt64Int64) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapInt64Int64[int64(keysForMapInt64Int64[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapInt64Int64[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapInt64Int64 {
+				v := x.MapInt64Int64[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
 				}
-				dAtA[j17] = uint8(num)
-				j17++
 			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize18))
+		}
+	}
+	if len(x.MapInt32Int32) > 0 {
+		MaRsHaLmAp := func(k int32, v int32) (protoiface.MarshalOutput, error) {
+			baseI := i
+			i = runtime.EncodeVarint(dAtA, i, uint64(v))
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x10
+			i = runtime.EncodeVarint(dAtA, i, uint64(k))
 			i--
-			dAtA[i] = 0x8a
-		}
-		if len(x.RepeatedInt64) > 0 {
-			var pksize20 int
-			for _, num := range x.RepeatedInt64 {
-				pksize20 += runtime.Sov(uint64(num))
-			}
-			i -= pksize20
-			j19 := i
-			for _, num1 := range x.RepeatedInt64 {
-				num := uint64(num1)
-				for num >= 1<<7 {
-					dAtA[j19] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j19++
-				}
-				dAtA[j19] = uint8(num)
-				j19++
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize20))
+			dAtA[i] = 0x8
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0x82
+			dAtA[i] = 0xc2
+			return protoiface.MarshalOutput{}, nil
 		}
-		if len(x.RepeatedInt32) > 0 {
-			var pksize22 int
-			for _, num := range x.RepeatedInt32 {
-				pksize22 += runtime.Sov(uint64(num))
-			}
-			i -= pksize22
-			j21 := i
-			for _, num1 := range x.RepeatedInt32 {
-				num := uint64(num1)
-				for num >= 1<<7 {
-					dAtA[j21] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j21++
-				}
-				dAtA[j21] = uint8(num)
-				j21++
+		if options.Deterministic {
+			keysForMapInt32Int32 := make([]int32, 0, len(x.MapInt32Int32))
+			for k := range x.MapInt32Int32 {
+				keysForMapInt32Int32 = append(keysForMapInt32Int32, int32(k))
+			}
+			sort.Slice(keysForMapInt32Int32, func(i, j int) bool {
+				return keysForMapInt32Int32[i] < keysForMapInt32Int32[j]
+			})
+			for iNdEx := len(keysForMapInt32Int32) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MapInt32Int32[int32(keysForMapInt32Int32[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMapInt32Int32[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MapInt32Int32 {
+				v := x.MapInt32Int32[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.RepeatedImportenum) > 0 {
+		var pksize2 int
+		for _, num := range x.RepeatedImportenum {
+			pksize2 += runtime.Sov(uint64(num))
+		}
+		i -= pksize2
+		j1 := i
+		for _, num1 := range x.RepeatedImportenum {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(x.RepeatedForeignEnum) > 0 {
+		var pksize4 int
+		for _, num := range x.RepeatedForeignEnum {
+			pksize4 += runtime.Sov(uint64(num))
+		}
+		i -= pksize4
+		j3 := i
+		for _, num1 := range x.RepeatedForeignEnum {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA[j3] = uint8(num)
+			j3++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize4))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(x.RepeatedNestedEnum) > 0 {
+		var pksize6 int
+		for _, num := range x.RepeatedNestedEnum {
+			pksize6 += runtime.Sov(uint64(num))
+		}
+		i -= pksize6
+		j5 := i
+		for _, num1 := range x.RepeatedNestedEnum {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA[j5] = uint8(num)
+			j5++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize6))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(x.RepeatedImportmessage) > 0 {
+		for iNdEx := len(x.RepeatedImportmessage) - 1; iNdEx >= 0; iNdEx-- {
+			l = options.Size(x.RepeatedImportmessage[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.RepeatedImportmessage[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
 			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize22))
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xfa
+			dAtA[i] = 0x92
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+	}
+	if len(x.RepeatedForeignMessage) > 0 {
+		for iNdEx := len(x.RepeatedForeignMessage) - 1; iNdEx >= 0; iNdEx-- {
+			l = options.Size(x.RepeatedForeignMessage[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.RepeatedForeignMessage[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
 		}
-		return protoiface.MarshalOutput{
+	}
+	if len(x.RepeatedNestedMessage) > 0 {
+		for iNdEx := len(x.RepeatedNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
+			l = options.Size(x.RepeatedNestedMessage[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.RepeatedNestedMessage[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(x.RepeatedBytes) > 0 {
+		for iNdEx := len(x.RepeatedBytes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(x.RepeatedBytes[iNdEx])
+			copy(dAtA[i:], x.RepeatedBytes[iNdEx])
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedBytes[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(x.RepeatedString) > 0 {
+		for iNdEx := len(x.RepeatedString) - 1; iNdEx >= 0; iNdEx-- {
+			if !utf8.ValidString(x.RepeatedString[iNdEx]) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(x.RepeatedString[iNdEx])
+			copy(dAtA[i:], x.RepeatedString[iNdEx])
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedString[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(x.RepeatedBool) > 0 {
+		for iNdEx := len(x.RepeatedBool) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if x.RepeatedBool[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedBool)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(x.RepeatedDouble) > 0 {
+		for iNdEx := len(x.RepeatedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f7 := math.Float64bits(float64(x.RepeatedDouble[iNdEx]))
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f7))
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedDouble)*8))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(x.RepeatedFloat) > 0 {
+		for iNdEx := len(x.RepeatedFloat) - 1; iNdEx >= 0; iNdEx-- {
+			f8 := math.Float32bits(float32(x.RepeatedFloat[iNdEx]))
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f8))
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedFloat)*4))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(x.RepeatedSfixed64) > 0 {
+		for iNdEx := len(x.RepeatedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(x.RepeatedSfixed64[iNdEx]))
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedSfixed64)*8))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(x.RepeatedSfixed32) > 0 {
+		for iNdEx := len(x.RepeatedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.RepeatedSfixed32[iNdEx]))
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedSfixed32)*4))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(x.RepeatedFixed64) > 0 {
+		for iNdEx := len(x.RepeatedFixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(x.RepeatedFixed64[iNdEx]))
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedFixed64)*8))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(x.RepeatedFixed32) > 0 {
+		for iNdEx := len(x.RepeatedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.RepeatedFixed32[iNdEx]))
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepeatedFixed32)*4))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(x.RepeatedSint64) > 0 {
+		var pksize10 int
+		for _, num := range x.RepeatedSint64 {
+			pksize10 += runtime.Soz(uint64(num))
+		}
+		i -= pksize10
+		j9 := i
+		for _, num := range x.RepeatedSint64 {
+			x11 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x11 >= 1<<7 {
+				dAtA[j9] = uint8(uint64(x11)&0x7f | 0x80)
+				j9++
+				x11 >>= 7
+			}
+			dAtA[j9] = uint8(x11)
+			j9++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize10))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(x.RepeatedSint32) > 0 {
+		var pksize13 int
+		for _, num := range x.RepeatedSint32 {
+			pksize13 += runtime.Soz(uint64(num))
+		}
+		i -= pksize13
+		j12 := i
+		for _, num := range x.RepeatedSint32 {
+			x14 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x14 >= 1<<7 {
+				dAtA[j12] = uint8(uint64(x14)&0x7f | 0x80)
+				j12++
+				x14 >>= 7
+			}
+			dAtA[j12] = uint8(x14)
+			j12++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize13))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(x.RepeatedUint64) > 0 {
+		var pksize16 int
+		for _, num := range x.RepeatedUint64 {
+			pksize16 += runtime.Sov(uint64(num))
+		}
+		i -= pksize16
+		j15 := i
+		for _, num := range x.RepeatedUint64 {
+			for num >= 1<<7 {
+				dAtA[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA[j15] = uint8(num)
+			j15++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize16))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(x.RepeatedUint32) > 0 {
+		var pksize18 int
+		for _, num := range x.RepeatedUint32 {
+			pksize18 += runtime.Sov(uint64(num))
+		}
+		i -= pksize18
+		j17 := i
+		for _, num := range x.RepeatedUint32 {
+			for num >= 1<<7 {
+				dAtA[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA[j17] = uint8(num)
+			j17++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize18))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(x.RepeatedInt64) > 0 {
+		var pksize20 int
+		for _, num := range x.RepeatedInt64 {
+			pksize20 += runtime.Sov(uint64(num))
+		}
+		i -= pksize20
+		j19 := i
+		for _, num1 := range x.RepeatedInt64 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA[j19] = uint8(num)
+			j19++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize20))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(x.RepeatedInt32) > 0 {
+		var pksize22 int
+		for _, num := range x.RepeatedInt32 {
+			pksize22 += runtime.Sov(uint64(num))
+		}
+		i -= pksize22
+		j21 := i
+		for _, num1 := range x.RepeatedInt32 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA[j21] = uint8(num)
+			j21++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize22))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestAllTypes_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
+			Flags:             input.Flags,
 		}, nil
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TestAllTypes)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestAllTypes.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	if zeroCopy {
+		x.zeroCopied = true
+	}
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 81:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularInt32", wireType)
+			}
+			x.SingularInt32 = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6664,205 +8886,451 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				wire |= uint64(b&0x7F) << shift
+				x.SingularInt32 |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes: wiretype end group for non-group")
+		case 82:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularInt64", wireType)
 			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 81:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularInt32", wireType)
+			x.SingularInt64 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				x.SingularInt32 = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SingularInt32 |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-			case 82:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularInt64", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.SingularInt64 |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.SingularInt64 = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SingularInt64 |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			}
+		case 83:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularUint32", wireType)
+			}
+			x.SingularUint32 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-			case 83:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularUint32", wireType)
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SingularUint32 = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SingularUint32 |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.SingularUint32 |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			case 84:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularUint64", wireType)
+			}
+		case 84:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularUint64", wireType)
+			}
+			x.SingularUint64 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				x.SingularUint64 = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SingularUint64 |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-			case 85:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularSint32", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.SingularUint64 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			}
+		case 85:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularSint32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-				x.SingularSint32 = v
-			case 86:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularSint64", wireType)
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-				x.SingularSint64 = int64(v)
-			case 87:
-				if wireType != 5 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularFixed32", wireType)
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			x.SingularSint32 = v
+		case 86:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularSint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				x.SingularFixed32 = 0
-				if (iNdEx + 4) > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SingularFixed32 = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-				iNdEx += 4
-			case 88:
-				if wireType != 1 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularFixed64", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.SingularFixed64 = 0
-				if (iNdEx + 8) > l {
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			x.SingularSint64 = int64(v)
+		case 87:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularFixed32", wireType)
+			}
+			x.SingularFixed32 = 0
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			x.SingularFixed32 = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+		case 88:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularFixed64", wireType)
+			}
+			x.SingularFixed64 = 0
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			x.SingularFixed64 = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 89:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularSfixed32", wireType)
+			}
+			x.SingularSfixed32 = 0
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			x.SingularSfixed32 = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+		case 90:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularSfixed64", wireType)
+			}
+			x.SingularSfixed64 = 0
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			x.SingularSfixed64 = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 91:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularFloat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			x.SingularFloat = float32(math.Float32frombits(v))
+		case 92:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			x.SingularDouble = float64(math.Float64frombits(v))
+		case 93:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SingularFixed64 = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-			case 89:
-				if wireType != 5 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularSfixed32", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.SingularSfixed32 = 0
-				if (iNdEx + 4) > l {
+			}
+			x.SingularBool = bool(v != 0)
+		case 94:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SingularSfixed32 = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-				iNdEx += 4
-			case 90:
-				if wireType != 1 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularSfixed64", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.SingularSfixed64 = 0
-				if (iNdEx + 8) > l {
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_singular_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.SingularString = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		case 95:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SingularSfixed64 = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-			case 91:
-				if wireType != 5 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularFloat", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var v uint32
-				if (iNdEx + 4) > l {
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_singular_bytes, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if zeroCopy || x.zeroCopied {
+				x.SingularBytes = runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy)
+			} else {
+				x.SingularBytes = append(x.SingularBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.SingularBytes == nil {
+					x.SingularBytes = []byte{}
+				}
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-				iNdEx += 4
-				x.SingularFloat = float32(math.Float32frombits(v))
-			case 92:
-				if wireType != 1 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularDouble", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var v uint64
-				if (iNdEx + 8) > l {
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.SingularNestedMessage == nil {
+				if err := budget.Message(fd_TestAllTypes_singular_nested_message, int(unsafe.Sizeof(TestAllTypes_NestedMessage{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.SingularNestedMessage = &TestAllTypes_NestedMessage{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SingularNestedMessage); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_singular_nested_message, -1)
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularForeignMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				x.SingularDouble = float64(math.Float64frombits(v))
-			case 93:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularBool", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var v int
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.SingularForeignMessage == nil {
+				if err := budget.Message(fd_TestAllTypes_singular_foreign_message, int(unsafe.Sizeof(ForeignMessage{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.SingularForeignMessage = &ForeignMessage{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SingularForeignMessage); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_singular_foreign_message, -1)
+			}
+			iNdEx = postIndex
+		case 100:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularImportMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.SingularImportMessage == nil {
+				if err := budget.Message(fd_TestAllTypes_singular_import_message, int(unsafe.Sizeof(ImportMessage{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.SingularImportMessage = &ImportMessage{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SingularImportMessage); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_singular_import_message, -1)
+			}
+			iNdEx = postIndex
+		case 101:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularNestedEnum", wireType)
+			}
+			x.SingularNestedEnum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.SingularNestedEnum |= TestAllTypes_NestedEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 102:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularForeignEnum", wireType)
+			}
+			x.SingularForeignEnum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.SingularForeignEnum |= ForeignEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 103:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularImportEnum", wireType)
+			}
+			x.SingularImportEnum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.SingularImportEnum |= ImportEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_int32, len(x.RepeatedInt32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int32
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6872,17 +9340,14 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					v |= int32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.SingularBool = bool(v != 0)
-			case 94:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularString", wireType)
-				}
-				var stringLen uint64
+				x.RepeatedInt32 = append(x.RepeatedInt32, v)
+			} else if wireType == 2 {
+				var packedLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6892,29 +9357,62 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if packedLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + packedLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SingularString = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 95:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularBytes", wireType)
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_int32, len(x.RepeatedInt32), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedInt32) == 0 {
+					x.RepeatedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RepeatedInt32 = append(x.RepeatedInt32, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32", wireType)
+			}
+		case 32:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_int64, len(x.RepeatedInt64), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				var byteLen int
+				var v int64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6924,31 +9422,14 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					v |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.SingularBytes = append(x.SingularBytes[:0], dAtA[iNdEx:postIndex]...)
-				if x.SingularBytes == nil {
-					x.SingularBytes = []byte{}
-				}
-				iNdEx = postIndex
-			case 98:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularNestedMessage", wireType)
-				}
-				var msglen int
+				x.RepeatedInt64 = append(x.RepeatedInt64, v)
+			} else if wireType == 2 {
+				var packedLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6958,33 +9439,62 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if packedLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + packedLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.SingularNestedMessage == nil {
-					x.SingularNestedMessage = &TestAllTypes_NestedMessage{}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SingularNestedMessage); err != nil {
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_int64, len(x.RepeatedInt64), elementCount, 8); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx = postIndex
-			case 99:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularForeignMessage", wireType)
+				if elementCount != 0 && len(x.RepeatedInt64) == 0 {
+					x.RepeatedInt64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RepeatedInt64 = append(x.RepeatedInt64, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt64", wireType)
+			}
+		case 33:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_uint32, len(x.RepeatedUint32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				var msglen int
+				var v uint32
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6994,33 +9504,14 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					v |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.SingularForeignMessage == nil {
-					x.SingularForeignMessage = &ForeignMessage{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SingularForeignMessage); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 100:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularImportMessage", wireType)
-				}
-				var msglen int
+				x.RepeatedUint32 = append(x.RepeatedUint32, v)
+			} else if wireType == 2 {
+				var packedLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7030,52 +9521,62 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if packedLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + packedLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.SingularImportMessage == nil {
-					x.SingularImportMessage = &ImportMessage{}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SingularImportMessage); err != nil {
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_uint32, len(x.RepeatedUint32), elementCount, 4); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx = postIndex
-			case 101:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularNestedEnum", wireType)
+				if elementCount != 0 && len(x.RepeatedUint32) == 0 {
+					x.RepeatedUint32 = make([]uint32, 0, elementCount)
 				}
-				x.SingularNestedEnum = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SingularNestedEnum |= TestAllTypes_NestedEnum(b&0x7F) << shift
-					if b < 0x80 {
-						break
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
+					x.RepeatedUint32 = append(x.RepeatedUint32, v)
 				}
-			case 102:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularForeignEnum", wireType)
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint32", wireType)
+			}
+		case 34:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_uint64, len(x.RepeatedUint64), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.SingularForeignEnum = 0
+				var v uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7085,16 +9586,14 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SingularForeignEnum |= ForeignEnum(b&0x7F) << shift
+					v |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 103:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularImportEnum", wireType)
-				}
-				x.SingularImportEnum = 0
+				x.RepeatedUint64 = append(x.RepeatedUint64, v)
+			} else if wireType == 2 {
+				var packedLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7104,31 +9603,37 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SingularImportEnum |= ImportEnum(b&0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 31:
-				if wireType == 0 {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
 					}
-					x.RepeatedInt32 = append(x.RepeatedInt32, v)
-				} else if wireType == 2 {
-					var packedLen int
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_uint64, len(x.RepeatedUint64), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedUint64) == 0 {
+					x.RepeatedUint64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7138,132 +9643,80 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						packedLen |= int(b&0x7F) << shift
+						v |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					x.RepeatedUint64 = append(x.RepeatedUint64, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint64", wireType)
+			}
+		case 35:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_sint32, len(x.RepeatedSint32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
-					}
-					elementCount = count
-					if elementCount != 0 && len(x.RepeatedInt32) == 0 {
-						x.RepeatedInt32 = make([]int32, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v int32
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= int32(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.RepeatedInt32 = append(x.RepeatedInt32, v)
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32", wireType)
 				}
-			case 32:
-				if wireType == 0 {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					x.RepeatedInt64 = append(x.RepeatedInt64, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				x.RepeatedSint32 = append(x.RepeatedSint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-					elementCount = count
-					if elementCount != 0 && len(x.RepeatedInt64) == 0 {
-						x.RepeatedInt64 = make([]int64, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v int64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= int64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.RepeatedInt64 = append(x.RepeatedInt64, v)
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
 					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt64", wireType)
 				}
-			case 33:
-				if wireType == 0 {
-					var v uint32
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_sint32, len(x.RepeatedSint32), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedSint32) == 0 {
+					x.RepeatedSint32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7273,72 +9726,80 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= uint32(b&0x7F) << shift
+						v |= int32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					x.RepeatedUint32 = append(x.RepeatedUint32, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					x.RepeatedSint32 = append(x.RepeatedSint32, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint32", wireType)
+			}
+		case 36:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_sint64, len(x.RepeatedSint64), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				x.RepeatedSint64 = append(x.RepeatedSint64, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-					elementCount = count
-					if elementCount != 0 && len(x.RepeatedUint32) == 0 {
-						x.RepeatedUint32 = make([]uint32, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v uint32
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= uint32(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.RepeatedUint32 = append(x.RepeatedUint32, v)
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
 					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint32", wireType)
 				}
-			case 34:
-				if wireType == 0 {
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_sint64, len(x.RepeatedSint64), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedSint64) == 0 {
+					x.RepeatedSint64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
 					var v uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
@@ -7354,223 +9815,59 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 							break
 						}
 					}
-					x.RepeatedUint64 = append(x.RepeatedUint64, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					x.RepeatedSint64 = append(x.RepeatedSint64, int64(v))
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint64", wireType)
+			}
+		case 37:
+			if wireType == 5 {
+				if err := budget.List(fd_TestAllTypes_repeated_fixed32, len(x.RepeatedFixed32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint32
+				if (iNdEx + 4) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				x.RepeatedFixed32 = append(x.RepeatedFixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
-					}
-					elementCount = count
-					if elementCount != 0 && len(x.RepeatedUint64) == 0 {
-						x.RepeatedUint64 = make([]uint64, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.RepeatedUint64 = append(x.RepeatedUint64, v)
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint64", wireType)
 				}
-			case 35:
-				if wireType == 0 {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					x.RepeatedSint32 = append(x.RepeatedSint32, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					if postIndex > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
-					}
-					elementCount = count
-					if elementCount != 0 && len(x.RepeatedSint32) == 0 {
-						x.RepeatedSint32 = make([]int32, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v int32
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= int32(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-						x.RepeatedSint32 = append(x.RepeatedSint32, v)
-					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint32", wireType)
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 36:
-				if wireType == 0 {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					x.RepeatedSint64 = append(x.RepeatedSint64, int64(v))
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					if postIndex > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
-					}
-					elementCount = count
-					if elementCount != 0 && len(x.RepeatedSint64) == 0 {
-						x.RepeatedSint64 = make([]int64, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-						x.RepeatedSint64 = append(x.RepeatedSint64, int64(v))
-					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint64", wireType)
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if err := budget.List(fd_TestAllTypes_repeated_fixed32, len(x.RepeatedFixed32), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			case 37:
-				if wireType == 5 {
+				if elementCount != 0 && len(x.RepeatedFixed32) == 0 {
+					x.RepeatedFixed32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
 					var v uint32
 					if (iNdEx + 4) > l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
@@ -7578,51 +9875,57 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
 					iNdEx += 4
 					x.RepeatedFixed32 = append(x.RepeatedFixed32, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedFixed32", wireType)
+			}
+		case 38:
+			if wireType == 1 {
+				if err := budget.List(fd_TestAllTypes_repeated_fixed64, len(x.RepeatedFixed64), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				x.RepeatedFixed64 = append(x.RepeatedFixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					var elementCount int
-					elementCount = packedLen / 4
-					if elementCount != 0 && len(x.RepeatedFixed32) == 0 {
-						x.RepeatedFixed32 = make([]uint32, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v uint32
-						if (iNdEx + 4) > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-						iNdEx += 4
-						x.RepeatedFixed32 = append(x.RepeatedFixed32, v)
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedFixed32", wireType)
 				}
-			case 38:
-				if wireType == 1 {
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if err := budget.List(fd_TestAllTypes_repeated_fixed64, len(x.RepeatedFixed64), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedFixed64) == 0 {
+					x.RepeatedFixed64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
 					var v uint64
 					if (iNdEx + 8) > l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
@@ -7630,51 +9933,57 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 					iNdEx += 8
 					x.RepeatedFixed64 = append(x.RepeatedFixed64, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedFixed64", wireType)
+			}
+		case 39:
+			if wireType == 5 {
+				if err := budget.List(fd_TestAllTypes_repeated_sfixed32, len(x.RepeatedSfixed32), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int32
+				if (iNdEx + 4) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				x.RepeatedSfixed32 = append(x.RepeatedSfixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					var elementCount int
-					elementCount = packedLen / 8
-					if elementCount != 0 && len(x.RepeatedFixed64) == 0 {
-						x.RepeatedFixed64 = make([]uint64, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v uint64
-						if (iNdEx + 8) > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-						iNdEx += 8
-						x.RepeatedFixed64 = append(x.RepeatedFixed64, v)
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedFixed64", wireType)
 				}
-			case 39:
-				if wireType == 5 {
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if err := budget.List(fd_TestAllTypes_repeated_sfixed32, len(x.RepeatedSfixed32), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedSfixed32) == 0 {
+					x.RepeatedSfixed32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
 					var v int32
 					if (iNdEx + 4) > l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
@@ -7682,51 +9991,57 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
 					iNdEx += 4
 					x.RepeatedSfixed32 = append(x.RepeatedSfixed32, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedSfixed32", wireType)
+			}
+		case 40:
+			if wireType == 1 {
+				if err := budget.List(fd_TestAllTypes_repeated_sfixed64, len(x.RepeatedSfixed64), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v int64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				x.RepeatedSfixed64 = append(x.RepeatedSfixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					var elementCount int
-					elementCount = packedLen / 4
-					if elementCount != 0 && len(x.RepeatedSfixed32) == 0 {
-						x.RepeatedSfixed32 = make([]int32, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v int32
-						if (iNdEx + 4) > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-						iNdEx += 4
-						x.RepeatedSfixed32 = append(x.RepeatedSfixed32, v)
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedSfixed32", wireType)
 				}
-			case 40:
-				if wireType == 1 {
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if err := budget.List(fd_TestAllTypes_repeated_sfixed64, len(x.RepeatedSfixed64), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedSfixed64) == 0 {
+					x.RepeatedSfixed64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
 					var v int64
 					if (iNdEx + 8) > l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
@@ -7734,232 +10049,25 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 					iNdEx += 8
 					x.RepeatedSfixed64 = append(x.RepeatedSfixed64, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					if postIndex > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					var elementCount int
-					elementCount = packedLen / 8
-					if elementCount != 0 && len(x.RepeatedSfixed64) == 0 {
-						x.RepeatedSfixed64 = make([]int64, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v int64
-						if (iNdEx + 8) > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-						iNdEx += 8
-						x.RepeatedSfixed64 = append(x.RepeatedSfixed64, v)
-					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedSfixed64", wireType)
-				}
-			case 41:
-				if wireType == 5 {
-					var v uint32
-					if (iNdEx + 4) > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-					iNdEx += 4
-					v2 := float32(math.Float32frombits(v))
-					x.RepeatedFloat = append(x.RepeatedFloat, v2)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					if postIndex > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					var elementCount int
-					elementCount = packedLen / 4
-					if elementCount != 0 && len(x.RepeatedFloat) == 0 {
-						x.RepeatedFloat = make([]float32, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v uint32
-						if (iNdEx + 4) > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-						iNdEx += 4
-						v2 := float32(math.Float32frombits(v))
-						x.RepeatedFloat = append(x.RepeatedFloat, v2)
-					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedFloat", wireType)
-				}
-			case 42:
-				if wireType == 1 {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					v2 := float64(math.Float64frombits(v))
-					x.RepeatedDouble = append(x.RepeatedDouble, v2)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					if postIndex > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					var elementCount int
-					elementCount = packedLen / 8
-					if elementCount != 0 && len(x.RepeatedDouble) == 0 {
-						x.RepeatedDouble = make([]float64, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v uint64
-						if (iNdEx + 8) > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-						iNdEx += 8
-						v2 := float64(math.Float64frombits(v))
-						x.RepeatedDouble = append(x.RepeatedDouble, v2)
-					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedDouble", wireType)
 				}
-			case 43:
-				if wireType == 0 {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					x.RepeatedBool = append(x.RepeatedBool, bool(v != 0))
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					if postIndex > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					var elementCount int
-					elementCount = packedLen
-					if elementCount != 0 && len(x.RepeatedBool) == 0 {
-						x.RepeatedBool = make([]bool, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v int
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= int(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.RepeatedBool = append(x.RepeatedBool, bool(v != 0))
-					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedBool", wireType)
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedSfixed64", wireType)
+			}
+		case 41:
+			if wireType == 5 {
+				if err := budget.List(fd_TestAllTypes_repeated_float, len(x.RepeatedFloat), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			case 44:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedString", wireType)
+				var v uint32
+				if (iNdEx + 4) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				var stringLen uint64
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				x.RepeatedFloat = append(x.RepeatedFloat, v2)
+			} else if wireType == 2 {
+				var packedLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7969,61 +10077,57 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if packedLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + packedLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RepeatedString = append(x.RepeatedString, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 45:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedBytes", wireType)
+				var elementCount int
+				elementCount = packedLen / 4
+				if err := budget.List(fd_TestAllTypes_repeated_float, len(x.RepeatedFloat), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
+				if elementCount != 0 && len(x.RepeatedFloat) == 0 {
+					x.RepeatedFloat = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					x.RepeatedFloat = append(x.RepeatedFloat, v2)
 				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedFloat", wireType)
+			}
+		case 42:
+			if wireType == 1 {
+				if err := budget.List(fd_TestAllTypes_repeated_double, len(x.RepeatedDouble), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if postIndex > l {
+				var v uint64
+				if (iNdEx + 8) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RepeatedBytes = append(x.RepeatedBytes, make([]byte, postIndex-iNdEx))
-				copy(x.RepeatedBytes[len(x.RepeatedBytes)-1], dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 48:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedNestedMessage", wireType)
-				}
-				var msglen int
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				x.RepeatedDouble = append(x.RepeatedDouble, v2)
+			} else if wireType == 2 {
+				var packedLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8033,31 +10137,48 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if packedLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + packedLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RepeatedNestedMessage = append(x.RepeatedNestedMessage, &TestAllTypes_NestedMessage{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RepeatedNestedMessage[len(x.RepeatedNestedMessage)-1]); err != nil {
+				var elementCount int
+				elementCount = packedLen / 8
+				if err := budget.List(fd_TestAllTypes_repeated_double, len(x.RepeatedDouble), elementCount, 8); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx = postIndex
-			case 49:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedForeignMessage", wireType)
+				if elementCount != 0 && len(x.RepeatedDouble) == 0 {
+					x.RepeatedDouble = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					x.RepeatedDouble = append(x.RepeatedDouble, v2)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedDouble", wireType)
+			}
+		case 43:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_bool, len(x.RepeatedBool), 1, 1); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				var msglen int
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8067,31 +10188,14 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RepeatedForeignMessage = append(x.RepeatedForeignMessage, &ForeignMessage{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RepeatedForeignMessage[len(x.RepeatedForeignMessage)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 50:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedImportmessage", wireType)
-				}
-				var msglen int
+				x.RepeatedBool = append(x.RepeatedBool, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8101,115 +10205,31 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if packedLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + packedLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RepeatedImportmessage = append(x.RepeatedImportmessage, &ImportMessage{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RepeatedImportmessage[len(x.RepeatedImportmessage)-1]); err != nil {
+				var elementCount int
+				elementCount = packedLen
+				if err := budget.List(fd_TestAllTypes_repeated_bool, len(x.RepeatedBool), elementCount, 1); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx = postIndex
-			case 51:
-				if wireType == 0 {
-					var v TestAllTypes_NestedEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= TestAllTypes_NestedEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					x.RepeatedNestedEnum = append(x.RepeatedNestedEnum, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					if postIndex > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					var elementCount int
-					if elementCount != 0 && len(x.RepeatedNestedEnum) == 0 {
-						x.RepeatedNestedEnum = make([]TestAllTypes_NestedEnum, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v TestAllTypes_NestedEnum
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= TestAllTypes_NestedEnum(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.RepeatedNestedEnum = append(x.RepeatedNestedEnum, v)
-					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedNestedEnum", wireType)
+				if elementCount != 0 && len(x.RepeatedBool) == 0 {
+					x.RepeatedBool = make([]bool, 0, elementCount)
 				}
-			case 52:
-				if wireType == 0 {
-					var v ForeignEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= ForeignEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					x.RepeatedForeignEnum = append(x.RepeatedForeignEnum, v)
-				} else if wireType == 2 {
-					var packedLen int
+				for iNdEx < postIndex {
+					var v int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8219,120 +10239,241 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						packedLen |= int(b&0x7F) << shift
+						v |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					if postIndex > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					var elementCount int
-					if elementCount != 0 && len(x.RepeatedForeignEnum) == 0 {
-						x.RepeatedForeignEnum = make([]ForeignEnum, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v ForeignEnum
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= ForeignEnum(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.RepeatedForeignEnum = append(x.RepeatedForeignEnum, v)
-					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedForeignEnum", wireType)
+					x.RepeatedBool = append(x.RepeatedBool, bool(v != 0))
 				}
-			case 53:
-				if wireType == 0 {
-					var v ImportEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= ImportEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					x.RepeatedImportenum = append(x.RepeatedImportenum, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedBool", wireType)
+			}
+		case 44:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedString", wireType)
+			}
+			if err := budget.List(fd_TestAllTypes_repeated_string, len(x.RepeatedString), 1, 16); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_repeated_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.RepeatedString = append(x.RepeatedString, runtime.String(dAtA[iNdEx:postIndex], zeroCopy))
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedBytes", wireType)
+			}
+			if err := budget.List(fd_TestAllTypes_repeated_bytes, len(x.RepeatedBytes), 1, 24); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_repeated_bytes, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if zeroCopy || x.zeroCopied {
+				x.RepeatedBytes = append(x.RepeatedBytes, runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy))
+			} else {
+				x.RepeatedBytes = runtime.AppendBytes(x.RepeatedBytes, dAtA[iNdEx:postIndex])
+			}
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedNestedMessage", wireType)
+			}
+			if err := budget.List(fd_TestAllTypes_repeated_nested_message, len(x.RepeatedNestedMessage), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Message(fd_TestAllTypes_repeated_nested_message, int(unsafe.Sizeof(TestAllTypes_NestedMessage{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.RepeatedNestedMessage = append(x.RepeatedNestedMessage, &TestAllTypes_NestedMessage{})
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RepeatedNestedMessage[len(x.RepeatedNestedMessage)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_repeated_nested_message, len(x.RepeatedNestedMessage)-1)
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedForeignMessage", wireType)
+			}
+			if err := budget.List(fd_TestAllTypes_repeated_foreign_message, len(x.RepeatedForeignMessage), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Message(fd_TestAllTypes_repeated_foreign_message, int(unsafe.Sizeof(ForeignMessage{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.RepeatedForeignMessage = append(x.RepeatedForeignMessage, &ForeignMessage{})
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RepeatedForeignMessage[len(x.RepeatedForeignMessage)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_repeated_foreign_message, len(x.RepeatedForeignMessage)-1)
+			}
+			iNdEx = postIndex
+		case 50:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedImportmessage", wireType)
+			}
+			if err := budget.List(fd_TestAllTypes_repeated_importmessage, len(x.RepeatedImportmessage), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Message(fd_TestAllTypes_repeated_importmessage, int(unsafe.Sizeof(ImportMessage{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.RepeatedImportmessage = append(x.RepeatedImportmessage, &ImportMessage{})
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RepeatedImportmessage[len(x.RepeatedImportmessage)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_repeated_importmessage, len(x.RepeatedImportmessage)-1)
+			}
+			iNdEx = postIndex
+		case 51:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_nested_enum, len(x.RepeatedNestedEnum), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v TestAllTypes_NestedEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					var elementCount int
-					if elementCount != 0 && len(x.RepeatedImportenum) == 0 {
-						x.RepeatedImportenum = make([]ImportEnum, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v ImportEnum
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= ImportEnum(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.RepeatedImportenum = append(x.RepeatedImportenum, v)
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= TestAllTypes_NestedEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedImportenum", wireType)
-				}
-			case 56:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapInt32Int32", wireType)
 				}
-				var msglen int
+				x.RepeatedNestedEnum = append(x.RepeatedNestedEnum, v)
+			} else if wireType == 2 {
+				var packedLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8342,29 +10483,37 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if packedLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + packedLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MapInt32Int32 == nil {
-					x.MapInt32Int32 = make(map[int32]int32)
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_nested_enum, len(x.RepeatedNestedEnum), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedNestedEnum) == 0 {
+					x.RepeatedNestedEnum = make([]TestAllTypes_NestedEnum, 0, elementCount)
 				}
-				var mapkey int32
-				var mapvalue int32
 				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
+					var v TestAllTypes_NestedEnum
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8374,64 +10523,39 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						wire |= uint64(b&0x7F) << shift
+						v |= TestAllTypes_NestedEnum(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapkey |= int32(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else if fieldNum == 2 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapvalue |= int32(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
-					}
+					x.RepeatedNestedEnum = append(x.RepeatedNestedEnum, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedNestedEnum", wireType)
+			}
+		case 52:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_foreign_enum, len(x.RepeatedForeignEnum), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.MapInt32Int32[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 57:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapInt64Int64", wireType)
+				var v ForeignEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ForeignEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				var msglen int
+				x.RepeatedForeignEnum = append(x.RepeatedForeignEnum, v)
+			} else if wireType == 2 {
+				var packedLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8441,29 +10565,37 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if packedLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + packedLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MapInt64Int64 == nil {
-					x.MapInt64Int64 = make(map[int64]int64)
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_foreign_enum, len(x.RepeatedForeignEnum), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedForeignEnum) == 0 {
+					x.RepeatedForeignEnum = make([]ForeignEnum, 0, elementCount)
 				}
-				var mapkey int64
-				var mapvalue int64
 				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
+					var v ForeignEnum
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8473,64 +10605,39 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						wire |= uint64(b&0x7F) << shift
+						v |= ForeignEnum(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapkey |= int64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else if fieldNum == 2 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapvalue |= int64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
-					}
+					x.RepeatedForeignEnum = append(x.RepeatedForeignEnum, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedForeignEnum", wireType)
+			}
+		case 53:
+			if wireType == 0 {
+				if err := budget.List(fd_TestAllTypes_repeated_importenum, len(x.RepeatedImportenum), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.MapInt64Int64[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 58:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapUint32Uint32", wireType)
+				var v ImportEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ImportEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				var msglen int
+				x.RepeatedImportenum = append(x.RepeatedImportenum, v)
+			} else if wireType == 2 {
+				var packedLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8540,29 +10647,37 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if packedLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + packedLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MapUint32Uint32 == nil {
-					x.MapUint32Uint32 = make(map[uint32]uint32)
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_TestAllTypes_repeated_importenum, len(x.RepeatedImportenum), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.RepeatedImportenum) == 0 {
+					x.RepeatedImportenum = make([]ImportEnum, 0, elementCount)
 				}
-				var mapkey uint32
-				var mapvalue uint32
 				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
+					var v ImportEnum
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8572,64 +10687,53 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						wire |= uint64(b&0x7F) << shift
+						v |= ImportEnum(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapkey |= uint32(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else if fieldNum == 2 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapvalue |= uint32(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
-					}
+					x.RepeatedImportenum = append(x.RepeatedImportenum, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepeatedImportenum", wireType)
+			}
+		case 56:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapInt32Int32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MapUint32Uint32[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 59:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapUint64Uint64", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var msglen int
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapInt32Int32 == nil {
+				x.MapInt32Int32 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8639,29 +10743,13 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.MapUint64Uint64 == nil {
-					x.MapUint64Uint64 = make(map[uint64]uint64)
-				}
-				var mapkey uint64
-				var mapvalue uint64
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8671,96 +10759,103 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						wire |= uint64(b&0x7F) << shift
+						mapkey |= int32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapkey |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else if fieldNum == 2 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapvalue |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 						}
-						if (iNdEx + skippy) > postIndex {
+						if iNdEx >= l {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 						}
-						iNdEx += skippy
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-				}
-				x.MapUint64Uint64[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 60:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapSint32Sint32", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapInt32Int32[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_int32_int32, len(x.MapInt32Int32), 8); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapInt32Int32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 57:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapInt64Int64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapInt64Int64 == nil {
+				x.MapInt64Int64 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
 					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.MapSint32Sint32 == nil {
-					x.MapSint32Sint32 = make(map[int32]int32)
-				}
-				var mapkey int32
-				var mapvalue int32
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8770,70 +10865,87 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						wire |= uint64(b&0x7F) << shift
+						mapkey |= int64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						var mapkeytemp int32
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapkeytemp |= int32(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
-						mapkey = int32(mapkeytemp)
-					} else if fieldNum == 2 {
-						var mapvaluetemp int32
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapvaluetemp |= int32(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						mapvaluetemp = int32((uint32(mapvaluetemp) >> 1) ^ uint32(((mapvaluetemp&1)<<31)>>31))
-						mapvalue = int32(mapvaluetemp)
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 						}
-						if (iNdEx + skippy) > postIndex {
+						if iNdEx >= l {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 						}
-						iNdEx += skippy
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapInt64Int64[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_int64_int64, len(x.MapInt64Int64), 16); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 					}
 				}
-				x.MapSint32Sint32[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 61:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapSint64Sint64", wireType)
+			}
+			x.MapInt64Int64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapUint32Uint32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var msglen int
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapUint32Uint32 == nil {
+				x.MapUint32Uint32 = make(map[uint32]uint32)
+			}
+			var mapkey uint32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8843,29 +10955,13 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.MapSint64Sint64 == nil {
-					x.MapSint64Sint64 = make(map[int64]int64)
-				}
-				var mapkey int64
-				var mapvalue int64
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8875,70 +10971,87 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						wire |= uint64(b&0x7F) << shift
+						mapkey |= uint32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						var mapkeytemp uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapkeytemp |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
-						mapkey = int64(mapkeytemp)
-					} else if fieldNum == 2 {
-						var mapvaluetemp uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapvaluetemp |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
-						mapvalue = int64(mapvaluetemp)
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 						}
-						if (iNdEx + skippy) > postIndex {
+						if iNdEx >= l {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 						}
-						iNdEx += skippy
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
+					iNdEx += skippy
 				}
-				x.MapSint64Sint64[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 62:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapFixed32Fixed32", wireType)
+			}
+			if budget != nil {
+				if _, ok := x.MapUint32Uint32[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_uint32_uint32, len(x.MapUint32Uint32), 8); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapUint32Uint32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapUint64Uint64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var msglen int
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapUint64Uint64 == nil {
+				x.MapUint64Uint64 = make(map[uint64]uint64)
+			}
+			var mapkey uint64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8948,29 +11061,13 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.MapFixed32Fixed32 == nil {
-					x.MapFixed32Fixed32 = make(map[uint32]uint32)
-				}
-				var mapkey uint32
-				var mapvalue uint32
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8980,46 +11077,87 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						wire |= uint64(b&0x7F) << shift
+						mapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						if (iNdEx + 4) > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 						}
-						mapkey = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-						iNdEx += 4
-					} else if fieldNum == 2 {
-						if (iNdEx + 4) > l {
+						if iNdEx >= l {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 						}
-						mapvalue = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-						iNdEx += 4
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
 						}
-						iNdEx += skippy
 					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapUint64Uint64[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_uint64_uint64, len(x.MapUint64Uint64), 16); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapUint64Uint64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapSint32Sint32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MapFixed32Fixed32[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 63:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapFixed64Fixed64", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var msglen int
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapSint32Sint32 == nil {
+				x.MapSint32Sint32 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9029,29 +11167,14 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.MapFixed64Fixed64 == nil {
-					x.MapFixed64Fixed64 = make(map[uint64]uint64)
-				}
-				var mapkey uint64
-				var mapvalue uint64
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9061,46 +11184,92 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						wire |= uint64(b&0x7F) << shift
+						mapkeytemp |= int32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						if (iNdEx + 8) > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 						}
-						mapkey = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-						iNdEx += 8
-					} else if fieldNum == 2 {
-						if (iNdEx + 8) > l {
+						if iNdEx >= l {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 						}
-						mapvalue = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-						iNdEx += 8
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
 						}
-						iNdEx += skippy
 					}
+					mapvaluetemp = int32((uint32(mapvaluetemp) >> 1) ^ uint32(((mapvaluetemp&1)<<31)>>31))
+					mapvalue = int32(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapSint32Sint32[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_sint32_sint32, len(x.MapSint32Sint32), 8); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapSint32Sint32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 61:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapSint64Sint64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MapFixed64Fixed64[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 64:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapSfixed32Sfixed32", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var msglen int
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapSint64Sint64 == nil {
+				x.MapSint64Sint64 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9110,29 +11279,14 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.MapSfixed32Sfixed32 == nil {
-					x.MapSfixed32Sfixed32 = make(map[int32]int32)
-				}
-				var mapkey int32
-				var mapvalue int32
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9142,127 +11296,92 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						wire |= uint64(b&0x7F) << shift
+						mapkeytemp |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						if (iNdEx + 4) > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 						}
-						mapkey = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-						iNdEx += 4
-					} else if fieldNum == 2 {
-						if (iNdEx + 4) > l {
+						if iNdEx >= l {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 						}
-						mapvalue = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-						iNdEx += 4
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
 						}
-						iNdEx += skippy
 					}
-				}
-				x.MapSfixed32Sfixed32[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 65:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapSfixed64Sfixed64", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
+					mapvalue = int64(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
+					iNdEx += skippy
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if budget != nil {
+				if _, ok := x.MapSint64Sint64[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_sint64_sint64, len(x.MapSint64Sint64), 16); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			x.MapSint64Sint64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 62:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapFixed32Fixed32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MapSfixed64Sfixed64 == nil {
-					x.MapSfixed64Sfixed64 = make(map[int64]int64)
-				}
-				var mapkey int64
-				var mapvalue int64
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						wire |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						if (iNdEx + 8) > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapkey = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-						iNdEx += 8
-					} else if fieldNum == 2 {
-						if (iNdEx + 8) > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapvalue = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-						iNdEx += 8
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
-					}
-				}
-				x.MapSfixed64Sfixed64[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 66:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapInt32Float", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var msglen int
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapFixed32Fixed32 == nil {
+				x.MapFixed32Fixed32 = make(map[uint32]uint32)
+			}
+			var mapkey uint32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9272,89 +11391,85 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					mapvalue = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if budget != nil {
+				if _, ok := x.MapFixed32Fixed32[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_fixed32_fixed32, len(x.MapFixed32Fixed32), 8); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
 				}
-				if x.MapInt32Float == nil {
-					x.MapInt32Float = make(map[int32]float32)
+			}
+			x.MapFixed32Fixed32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 63:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapFixed64Fixed64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				var mapkey int32
-				var mapvalue float32
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						wire |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapkey |= int32(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else if fieldNum == 2 {
-						var mapvaluetemp uint32
-						if (iNdEx + 4) > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapvaluetemp = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-						iNdEx += 4
-						mapvalue = math.Float32frombits(mapvaluetemp)
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
-					}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MapInt32Float[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 67:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapInt32Double", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var msglen int
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapFixed64Fixed64 == nil {
+				x.MapFixed64Fixed64 = make(map[uint64]uint64)
+			}
+			var mapkey uint64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9364,89 +11479,85 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					mapvalue = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if budget != nil {
+				if _, ok := x.MapFixed64Fixed64[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_fixed64_fixed64, len(x.MapFixed64Fixed64), 16); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
 				}
-				if x.MapInt32Double == nil {
-					x.MapInt32Double = make(map[int32]float64)
+			}
+			x.MapFixed64Fixed64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 64:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapSfixed32Sfixed32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				var mapkey int32
-				var mapvalue float64
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						wire |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapkey |= int32(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else if fieldNum == 2 {
-						var mapvaluetemp uint64
-						if (iNdEx + 8) > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapvaluetemp = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-						iNdEx += 8
-						mapvalue = math.Float64frombits(mapvaluetemp)
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
-					}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MapInt32Double[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 68:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapBoolBool", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var msglen int
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapSfixed32Sfixed32 == nil {
+				x.MapSfixed32Sfixed32 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9456,100 +11567,85 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					mapkey = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					mapvalue = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if budget != nil {
+				if _, ok := x.MapSfixed32Sfixed32[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_sfixed32_sfixed32, len(x.MapSfixed32Sfixed32), 8); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
 				}
-				if x.MapBoolBool == nil {
-					x.MapBoolBool = make(map[bool]bool)
+			}
+			x.MapSfixed32Sfixed32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 65:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapSfixed64Sfixed64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				var mapkey bool
-				var mapvalue bool
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						wire |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						var mapkeytemp int
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapkeytemp |= int(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						mapkey = bool(mapkeytemp != 0)
-					} else if fieldNum == 2 {
-						var mapvaluetemp int
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapvaluetemp |= int(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						mapvalue = bool(mapvaluetemp != 0)
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
-					}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MapBoolBool[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 69:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapStringString", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var msglen int
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapSfixed64Sfixed64 == nil {
+				x.MapSfixed64Sfixed64 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9559,124 +11655,85 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.MapStringString == nil {
-					x.MapStringString = make(map[string]string)
-				}
-				var mapkey string
-				var mapvalue string
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						wire |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						var stringLenmapkey uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapkey |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapkey := int(stringLenmapkey)
-						if intStringLenmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapkey := iNdEx + intStringLenmapkey
-						if postStringIndexmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapkey > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-						iNdEx = postStringIndexmapkey
-					} else if fieldNum == 2 {
-						var stringLenmapvalue uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapvalue |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapvalue := int(stringLenmapvalue)
-						if intStringLenmapvalue < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-						if postStringIndexmapvalue < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapvalue > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-						iNdEx = postStringIndexmapvalue
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
+					mapkey = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					mapvalue = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapSfixed64Sfixed64[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_sfixed64_sfixed64, len(x.MapSfixed64Sfixed64), 16); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 					}
 				}
-				x.MapStringString[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 70:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapStringBytes", wireType)
+			}
+			x.MapSfixed64Sfixed64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 66:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapInt32Float", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var msglen int
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapInt32Float == nil {
+				x.MapInt32Float = make(map[int32]float32)
+			}
+			var mapkey int32
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9686,29 +11743,13 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.MapStringBytes == nil {
-					x.MapStringBytes = make(map[string][]byte)
-				}
-				var mapkey string
-				var mapvalue []byte
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9718,93 +11759,80 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						wire |= uint64(b&0x7F) << shift
+						mapkey |= int32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						var stringLenmapkey uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapkey |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapkey := int(stringLenmapkey)
-						if intStringLenmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapkey := iNdEx + intStringLenmapkey
-						if postStringIndexmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapkey > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-						iNdEx = postStringIndexmapkey
-					} else if fieldNum == 2 {
-						var mapbyteLen uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapbyteLen |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intMapbyteLen := int(mapbyteLen)
-						if intMapbyteLen < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postbytesIndex := iNdEx + intMapbyteLen
-						if postbytesIndex < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postbytesIndex > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapvalue = make([]byte, mapbyteLen)
-						copy(mapvalue, dAtA[iNdEx:postbytesIndex])
-						iNdEx = postbytesIndex
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapInt32Float[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_int32_float, len(x.MapInt32Float), 8); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapInt32Float[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 67:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapInt32Double", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MapStringBytes[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 71:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapStringNestedMessage", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var msglen int
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapInt32Double == nil {
+				x.MapInt32Double = make(map[int32]float64)
+			}
+			var mapkey int32
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9814,29 +11842,13 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.MapStringNestedMessage == nil {
-					x.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage)
-				}
-				var mapkey string
-				var mapvalue *TestAllTypes_NestedMessage
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9846,94 +11858,80 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						wire |= uint64(b&0x7F) << shift
+						mapkey |= int32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						var stringLenmapkey uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapkey |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapkey := int(stringLenmapkey)
-						if intStringLenmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapkey := iNdEx + intStringLenmapkey
-						if postStringIndexmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapkey > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-						iNdEx = postStringIndexmapkey
-					} else if fieldNum == 2 {
-						var mapmsglen int
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapmsglen |= int(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						if mapmsglen < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postmsgIndex := iNdEx + mapmsglen
-						if postmsgIndex < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postmsgIndex > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapvalue = &TestAllTypes_NestedMessage{}
-						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						iNdEx = postmsgIndex
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapInt32Double[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_int32_double, len(x.MapInt32Double), 12); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapInt32Double[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 68:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapBoolBool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MapStringNestedMessage[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 73:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapStringNestedEnum", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var msglen int
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapBoolBool == nil {
+				x.MapBoolBool = make(map[bool]bool)
+			}
+			var mapkey bool
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9943,29 +11941,14 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.MapStringNestedEnum == nil {
-					x.MapStringNestedEnum = make(map[string]TestAllTypes_NestedEnum)
-				}
-				var mapkey string
-				var mapvalue TestAllTypes_NestedEnum
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9975,133 +11958,90 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						wire |= uint64(b&0x7F) << shift
+						mapkeytemp |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						var stringLenmapkey uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapkey |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapkey := int(stringLenmapkey)
-						if intStringLenmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapkey := iNdEx + intStringLenmapkey
-						if postStringIndexmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 						}
-						if postStringIndexmapkey > l {
+						if iNdEx >= l {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 						}
-						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-						iNdEx = postStringIndexmapkey
-					} else if fieldNum == 2 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapvalue |= TestAllTypes_NestedEnum(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
 						}
-						iNdEx += skippy
-					}
-				}
-				x.MapStringNestedEnum[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 111:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofUint32", wireType)
-				}
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 					}
-				}
-				x.OneofField = &TestAllTypes_OneofUint32{v}
-			case 112:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofNestedMessage", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 					}
-					if iNdEx >= l {
+					if (iNdEx + skippy) > postIndex {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+					iNdEx += skippy
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if budget != nil {
+				if _, ok := x.MapBoolBool[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_bool_bool, len(x.MapBoolBool), 2); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			x.MapBoolBool[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 69:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapStringString", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v := &TestAllTypes_NestedMessage{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				x.OneofField = &TestAllTypes_OneofNestedMessage{v}
-				iNdEx = postIndex
-			case 113:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofString", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var stringLen uint64
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapStringString == nil {
+				x.MapStringString = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10111,62 +12051,143 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.OneofField = &TestAllTypes_OneofString{string(dAtA[iNdEx:postIndex])}
-				iNdEx = postIndex
-			case 114:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofBytes", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-					if iNdEx >= l {
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					if err := budget.Length(fd_TestAllTypes_map_string_string, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_map_string_string, intStringLenmapvalue); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapvalue]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapvalue = runtime.String(dAtA[iNdEx:postStringIndexmapvalue], zeroCopy)
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if budget != nil {
+				if _, ok := x.MapStringString[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_string_string, len(x.MapStringString), 32); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
 				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			x.MapStringString[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 70:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapStringBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v := make([]byte, postIndex-iNdEx)
-				copy(v, dAtA[iNdEx:postIndex])
-				x.OneofField = &TestAllTypes_OneofBytes{v}
-				iNdEx = postIndex
-			case 115:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofBool", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var v int
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapStringBytes == nil {
+				x.MapStringBytes = make(map[string][]byte)
+			}
+			var mapkey string
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10176,18 +12197,141 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				b := bool(v != 0)
-				x.OneofField = &TestAllTypes_OneofBool{b}
-			case 116:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofUint64", wireType)
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_map_string_bytes, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postbytesIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_map_string_bytes, intMapbyteLen); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapvalue = runtime.Bytes(dAtA[iNdEx:postbytesIndex], zeroCopy)
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapStringBytes[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_string_bytes, len(x.MapStringBytes), 40); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
 				}
-				var v uint64
+			}
+			x.MapStringBytes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 71:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapStringNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapStringNestedMessage == nil {
+				x.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage)
+			}
+			var mapkey string
+			var mapvalue *TestAllTypes_NestedMessage
+			var mapvalueErr error
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10197,39 +12341,145 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= uint64(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.OneofField = &TestAllTypes_OneofUint64{v}
-			case 117:
-				if wireType != 5 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofFloat", wireType)
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_map_string_nested_message, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Message(fd_TestAllTypes_map_string_nested_message, int(unsafe.Sizeof(TestAllTypes_NestedMessage{}))); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapvalue = &TestAllTypes_NestedMessage{}
+					if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+						mapvalueErr = err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
-				var v uint32
-				if (iNdEx + 4) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if mapvalueErr != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarInMap(mapvalueErr, fd_TestAllTypes_map_string_nested_message, mapkey)
+			}
+			if budget != nil {
+				if _, ok := x.MapStringNestedMessage[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_string_nested_message, len(x.MapStringNestedMessage), 24); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
 				}
-				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-				iNdEx += 4
-				x.OneofField = &TestAllTypes_OneofFloat{float32(math.Float32frombits(v))}
-			case 118:
-				if wireType != 1 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofDouble", wireType)
+			}
+			x.MapStringNestedMessage[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 73:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MapStringNestedEnum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				var v uint64
-				if (iNdEx + 8) > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				x.OneofField = &TestAllTypes_OneofDouble{float64(math.Float64frombits(v))}
-			case 119:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofEnum", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var v TestAllTypes_NestedEnum
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MapStringNestedEnum == nil {
+				x.MapStringNestedEnum = make(map[string]TestAllTypes_NestedEnum)
+			}
+			var mapkey string
+			var mapvalue TestAllTypes_NestedEnum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10239,70 +12489,2082 @@ func (x *fastReflection_TestAllTypes) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= TestAllTypes_NestedEnum(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.OneofField = &TestAllTypes_OneofEnum{v}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_TestAllTypes_NestedMessage             protoreflect.MessageDescriptor
-	fd_TestAllTypes_NestedMessage_a           protoreflect.FieldDescriptor
-	fd_TestAllTypes_NestedMessage_corecursive protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_internal_testprotos_test3_test_proto_init()
-	md_TestAllTypes_NestedMessage = File_internal_testprotos_test3_test_proto.Messages().ByName("TestAllTypes").Messages().ByName("NestedMessage")
-	fd_TestAllTypes_NestedMessage_a = md_TestAllTypes_NestedMessage.Fields().ByName("a")
-	fd_TestAllTypes_NestedMessage_corecursive = md_TestAllTypes_NestedMessage.Fields().ByName("corecursive")
-}
-
-var _ protoreflect.Message = (*fastReflection_TestAllTypes_NestedMessage)(nil)
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_TestAllTypes_map_string_nested_enum, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= TestAllTypes_NestedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.MapStringNestedEnum[mapkey]; !ok {
+					if err := budget.MapEntry(fd_TestAllTypes_map_string_nested_enum, len(x.MapStringNestedEnum), 20); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.MapStringNestedEnum[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 111:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofUint32", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OneofField = &TestAllTypes_OneofUint32{v}
+		case 112:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if oneof, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); ok && oneof.OneofNestedMessage != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], oneof.OneofNestedMessage); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_oneof_nested_message, -1)
+				}
+			} else {
+				if err := budget.Message(fd_TestAllTypes_oneof_nested_message, int(unsafe.Sizeof(TestAllTypes_NestedMessage{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				v := &TestAllTypes_NestedMessage{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_oneof_nested_message, -1)
+				}
+				x.OneofField = &TestAllTypes_OneofNestedMessage{v}
+			}
+			iNdEx = postIndex
+		case 113:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_oneof_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.OneofField = &TestAllTypes_OneofString{runtime.String(dAtA[iNdEx:postIndex], zeroCopy)}
+			iNdEx = postIndex
+		case 114:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestAllTypes_oneof_bytes, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.OneofField = &TestAllTypes_OneofBytes{runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy)}
+			iNdEx = postIndex
+		case 115:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			x.OneofField = &TestAllTypes_OneofBool{b}
+		case 116:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofUint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OneofField = &TestAllTypes_OneofUint64{v}
+		case 117:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofFloat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			x.OneofField = &TestAllTypes_OneofFloat{float32(math.Float32frombits(v))}
+		case 118:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			x.OneofField = &TestAllTypes_OneofDouble{float64(math.Float64frombits(v))}
+		case 119:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofEnum", wireType)
+			}
+			var v TestAllTypes_NestedEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= TestAllTypes_NestedEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OneofField = &TestAllTypes_OneofEnum{v}
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestAllTypes, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_TestAllTypes_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestAllTypes)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestAllTypes)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.SingularInt32 != int32(0) {
+		dst.SingularInt32 = src.SingularInt32
+	}
+	if src.SingularInt64 != int64(0) {
+		dst.SingularInt64 = src.SingularInt64
+	}
+	if src.SingularUint32 != uint32(0) {
+		dst.SingularUint32 = src.SingularUint32
+	}
+	if src.SingularUint64 != uint64(0) {
+		dst.SingularUint64 = src.SingularUint64
+	}
+	if src.SingularSint32 != int32(0) {
+		dst.SingularSint32 = src.SingularSint32
+	}
+	if src.SingularSint64 != int64(0) {
+		dst.SingularSint64 = src.SingularSint64
+	}
+	if src.SingularFixed32 != uint32(0) {
+		dst.SingularFixed32 = src.SingularFixed32
+	}
+	if src.SingularFixed64 != uint64(0) {
+		dst.SingularFixed64 = src.SingularFixed64
+	}
+	if src.SingularSfixed32 != int32(0) {
+		dst.SingularSfixed32 = src.SingularSfixed32
+	}
+	if src.SingularSfixed64 != int64(0) {
+		dst.SingularSfixed64 = src.SingularSfixed64
+	}
+	if src.SingularFloat != 0 || math.Signbit(float64(src.SingularFloat)) {
+		dst.SingularFloat = src.SingularFloat
+	}
+	if src.SingularDouble != 0 || math.Signbit(src.SingularDouble) {
+		dst.SingularDouble = src.SingularDouble
+	}
+	if src.SingularBool != false {
+		dst.SingularBool = src.SingularBool
+	}
+	if src.SingularString != "" {
+		dst.SingularString = src.SingularString
+	}
+	if len(src.SingularBytes) != 0 {
+		dst.SingularBytes = append([]byte{}, src.SingularBytes...)
+	}
+	if src.SingularNestedMessage != nil {
+		if dst.SingularNestedMessage == nil {
+			dst.SingularNestedMessage = &TestAllTypes_NestedMessage{}
+		}
+		proto.Merge(dst.SingularNestedMessage, src.SingularNestedMessage)
+	}
+	if src.SingularForeignMessage != nil {
+		if dst.SingularForeignMessage == nil {
+			dst.SingularForeignMessage = &ForeignMessage{}
+		}
+		proto.Merge(dst.SingularForeignMessage, src.SingularForeignMessage)
+	}
+	if src.SingularImportMessage != nil {
+		if dst.SingularImportMessage == nil {
+			dst.SingularImportMessage = &ImportMessage{}
+		}
+		proto.Merge(dst.SingularImportMessage, src.SingularImportMessage)
+	}
+	if src.SingularNestedEnum != 0 {
+		dst.SingularNestedEnum = src.SingularNestedEnum
+	}
+	if src.SingularForeignEnum != 0 {
+		dst.SingularForeignEnum = src.SingularForeignEnum
+	}
+	if src.SingularImportEnum != 0 {
+		dst.SingularImportEnum = src.SingularImportEnum
+	}
+	if len(src.RepeatedInt32) != 0 {
+		dst.RepeatedInt32 = append(dst.RepeatedInt32, src.RepeatedInt32...)
+	}
+	if len(src.RepeatedInt64) != 0 {
+		dst.RepeatedInt64 = append(dst.RepeatedInt64, src.RepeatedInt64...)
+	}
+	if len(src.RepeatedUint32) != 0 {
+		dst.RepeatedUint32 = append(dst.RepeatedUint32, src.RepeatedUint32...)
+	}
+	if len(src.RepeatedUint64) != 0 {
+		dst.RepeatedUint64 = append(dst.RepeatedUint64, src.RepeatedUint64...)
+	}
+	if len(src.RepeatedSint32) != 0 {
+		dst.RepeatedSint32 = append(dst.RepeatedSint32, src.RepeatedSint32...)
+	}
+	if len(src.RepeatedSint64) != 0 {
+		dst.RepeatedSint64 = append(dst.RepeatedSint64, src.RepeatedSint64...)
+	}
+	if len(src.RepeatedFixed32) != 0 {
+		dst.RepeatedFixed32 = append(dst.RepeatedFixed32, src.RepeatedFixed32...)
+	}
+	if len(src.RepeatedFixed64) != 0 {
+		dst.RepeatedFixed64 = append(dst.RepeatedFixed64, src.RepeatedFixed64...)
+	}
+	if len(src.RepeatedSfixed32) != 0 {
+		dst.RepeatedSfixed32 = append(dst.RepeatedSfixed32, src.RepeatedSfixed32...)
+	}
+	if len(src.RepeatedSfixed64) != 0 {
+		dst.RepeatedSfixed64 = append(dst.RepeatedSfixed64, src.RepeatedSfixed64...)
+	}
+	if len(src.RepeatedFloat) != 0 {
+		dst.RepeatedFloat = append(dst.RepeatedFloat, src.RepeatedFloat...)
+	}
+	if len(src.RepeatedDouble) != 0 {
+		dst.RepeatedDouble = append(dst.RepeatedDouble, src.RepeatedDouble...)
+	}
+	if len(src.RepeatedBool) != 0 {
+		dst.RepeatedBool = append(dst.RepeatedBool, src.RepeatedBool...)
+	}
+	if len(src.RepeatedString) != 0 {
+		dst.RepeatedString = append(dst.RepeatedString, src.RepeatedString...)
+	}
+	for _, v := range src.RepeatedBytes {
+		dst.RepeatedBytes = append(dst.RepeatedBytes, append([]byte{}, v...))
+	}
+	for _, v := range src.RepeatedNestedMessage {
+		m := &TestAllTypes_NestedMessage{}
+		proto.Merge(m, v)
+		dst.RepeatedNestedMessage = append(dst.RepeatedNestedMessage, m)
+	}
+	for _, v := range src.RepeatedForeignMessage {
+		m := &ForeignMessage{}
+		proto.Merge(m, v)
+		dst.RepeatedForeignMessage = append(dst.RepeatedForeignMessage, m)
+	}
+	for _, v := range src.RepeatedImportmessage {
+		m := &ImportMessage{}
+		proto.Merge(m, v)
+		dst.RepeatedImportmessage = append(dst.RepeatedImportmessage, m)
+	}
+	if len(src.RepeatedNestedEnum) != 0 {
+		dst.RepeatedNestedEnum = append(dst.RepeatedNestedEnum, src.RepeatedNestedEnum...)
+	}
+	if len(src.RepeatedForeignEnum) != 0 {
+		dst.RepeatedForeignEnum = append(dst.RepeatedForeignEnum, src.RepeatedForeignEnum...)
+	}
+	if len(src.RepeatedImportenum) != 0 {
+		dst.RepeatedImportenum = append(dst.RepeatedImportenum, src.RepeatedImportenum...)
+	}
+	if len(src.MapInt32Int32) != 0 {
+		if dst.MapInt32Int32 == nil {
+			dst.MapInt32Int32 = make(map[int32]int32, len(src.MapInt32Int32))
+		}
+		for k, v := range src.MapInt32Int32 {
+			dst.MapInt32Int32[k] = v
+		}
+	}
+	if len(src.MapInt64Int64) != 0 {
+		if dst.MapInt64Int64 == nil {
+			dst.MapInt64Int64 = make(map[int64]int64, len(src.MapInt64Int64))
+		}
+		for k, v := range src.MapInt64Int64 {
+			dst.MapInt64Int64[k] = v
+		}
+	}
+	if len(src.MapUint32Uint32) != 0 {
+		if dst.MapUint32Uint32 == nil {
+			dst.MapUint32Uint32 = make(map[uint32]uint32, len(src.MapUint32Uint32))
+		}
+		for k, v := range src.MapUint32Uint32 {
+			dst.MapUint32Uint32[k] = v
+		}
+	}
+	if len(src.MapUint64Uint64) != 0 {
+		if dst.MapUint64Uint64 == nil {
+			dst.MapUint64Uint64 = make(map[uint64]uint64, len(src.MapUint64Uint64))
+		}
+		for k, v := range src.MapUint64Uint64 {
+			dst.MapUint64Uint64[k] = v
+		}
+	}
+	if len(src.MapSint32Sint32) != 0 {
+		if dst.MapSint32Sint32 == nil {
+			dst.MapSint32Sint32 = make(map[int32]int32, len(src.MapSint32Sint32))
+		}
+		for k, v := range src.MapSint32Sint32 {
+			dst.MapSint32Sint32[k] = v
+		}
+	}
+	if len(src.MapSint64Sint64) != 0 {
+		if dst.MapSint64Sint64 == nil {
+			dst.MapSint64Sint64 = make(map[int64]int64, len(src.MapSint64Sint64))
+		}
+		for k, v := range src.MapSint64Sint64 {
+			dst.MapSint64Sint64[k] = v
+		}
+	}
+	if len(src.MapFixed32Fixed32) != 0 {
+		if dst.MapFixed32Fixed32 == nil {
+			dst.MapFixed32Fixed32 = make(map[uint32]uint32, len(src.MapFixed32Fixed32))
+		}
+		for k, v := range src.MapFixed32Fixed32 {
+			dst.MapFixed32Fixed32[k] = v
+		}
+	}
+	if len(src.MapFixed64Fixed64) != 0 {
+		if dst.MapFixed64Fixed64 == nil {
+			dst.MapFixed64Fixed64 = make(map[uint64]uint64, len(src.MapFixed64Fixed64))
+		}
+		for k, v := range src.MapFixed64Fixed64 {
+			dst.MapFixed64Fixed64[k] = v
+		}
+	}
+	if len(src.MapSfixed32Sfixed32) != 0 {
+		if dst.MapSfixed32Sfixed32 == nil {
+			dst.MapSfixed32Sfixed32 = make(map[int32]int32, len(src.MapSfixed32Sfixed32))
+		}
+		for k, v := range src.MapSfixed32Sfixed32 {
+			dst.MapSfixed32Sfixed32[k] = v
+		}
+	}
+	if len(src.MapSfixed64Sfixed64) != 0 {
+		if dst.MapSfixed64Sfixed64 == nil {
+			dst.MapSfixed64Sfixed64 = make(map[int64]int64, len(src.MapSfixed64Sfixed64))
+		}
+		for k, v := range src.MapSfixed64Sfixed64 {
+			dst.MapSfixed64Sfixed64[k] = v
+		}
+	}
+	if len(src.MapInt32Float) != 0 {
+		if dst.MapInt32Float == nil {
+			dst.MapInt32Float = make(map[int32]float32, len(src.MapInt32Float))
+		}
+		for k, v := range src.MapInt32Float {
+			dst.MapInt32Float[k] = v
+		}
+	}
+	if len(src.MapInt32Double) != 0 {
+		if dst.MapInt32Double == nil {
+			dst.MapInt32Double = make(map[int32]float64, len(src.MapInt32Double))
+		}
+		for k, v := range src.MapInt32Double {
+			dst.MapInt32Double[k] = v
+		}
+	}
+	if len(src.MapBoolBool) != 0 {
+		if dst.MapBoolBool == nil {
+			dst.MapBoolBool = make(map[bool]bool, len(src.MapBoolBool))
+		}
+		for k, v := range src.MapBoolBool {
+			dst.MapBoolBool[k] = v
+		}
+	}
+	if len(src.MapStringString) != 0 {
+		if dst.MapStringString == nil {
+			dst.MapStringString = make(map[string]string, len(src.MapStringString))
+		}
+		for k, v := range src.MapStringString {
+			dst.MapStringString[k] = v
+		}
+	}
+	if len(src.MapStringBytes) != 0 {
+		if dst.MapStringBytes == nil {
+			dst.MapStringBytes = make(map[string][]byte, len(src.MapStringBytes))
+		}
+		for k, v := range src.MapStringBytes {
+			dst.MapStringBytes[k] = append([]byte{}, v...)
+		}
+	}
+	if len(src.MapStringNestedMessage) != 0 {
+		if dst.MapStringNestedMessage == nil {
+			dst.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage, len(src.MapStringNestedMessage))
+		}
+		for k, v := range src.MapStringNestedMessage {
+			m := &TestAllTypes_NestedMessage{}
+			proto.Merge(m, v)
+			dst.MapStringNestedMessage[k] = m
+		}
+	}
+	if len(src.MapStringNestedEnum) != 0 {
+		if dst.MapStringNestedEnum == nil {
+			dst.MapStringNestedEnum = make(map[string]TestAllTypes_NestedEnum, len(src.MapStringNestedEnum))
+		}
+		for k, v := range src.MapStringNestedEnum {
+			dst.MapStringNestedEnum[k] = v
+		}
+	}
+	switch v := src.OneofField.(type) {
+	case *TestAllTypes_OneofUint32:
+		dst.OneofField = &TestAllTypes_OneofUint32{OneofUint32: v.OneofUint32}
+	case *TestAllTypes_OneofNestedMessage:
+		if dv, ok := dst.OneofField.(*TestAllTypes_OneofNestedMessage); ok && dv.OneofNestedMessage != nil {
+			proto.Merge(dv.OneofNestedMessage, v.OneofNestedMessage)
+		} else {
+			m := &TestAllTypes_NestedMessage{}
+			proto.Merge(m, v.OneofNestedMessage)
+			dst.OneofField = &TestAllTypes_OneofNestedMessage{OneofNestedMessage: m}
+		}
+	case *TestAllTypes_OneofString:
+		dst.OneofField = &TestAllTypes_OneofString{OneofString: v.OneofString}
+	case *TestAllTypes_OneofBytes:
+		dst.OneofField = &TestAllTypes_OneofBytes{OneofBytes: append([]byte{}, v.OneofBytes...)}
+	case *TestAllTypes_OneofBool:
+		dst.OneofField = &TestAllTypes_OneofBool{OneofBool: v.OneofBool}
+	case *TestAllTypes_OneofUint64:
+		dst.OneofField = &TestAllTypes_OneofUint64{OneofUint64: v.OneofUint64}
+	case *TestAllTypes_OneofFloat:
+		dst.OneofField = &TestAllTypes_OneofFloat{OneofFloat: v.OneofFloat}
+	case *TestAllTypes_OneofDouble:
+		dst.OneofField = &TestAllTypes_OneofDouble{OneofDouble: v.OneofDouble}
+	case *TestAllTypes_OneofEnum:
+		dst.OneofField = &TestAllTypes_OneofEnum{OneofEnum: v.OneofEnum}
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestAllTypes_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestAllTypes_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestAllTypes)
+	y, yok := input.MessageB.Interface().(*TestAllTypes)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SingularInt32 != y.SingularInt32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SingularInt64 != y.SingularInt64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SingularUint32 != y.SingularUint32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SingularUint64 != y.SingularUint64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SingularSint32 != y.SingularSint32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SingularSint64 != y.SingularSint64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SingularFixed32 != y.SingularFixed32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SingularFixed64 != y.SingularFixed64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SingularSfixed32 != y.SingularSfixed32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SingularSfixed64 != y.SingularSfixed64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !runtime.EqualFloat(float64(x.SingularFloat), float64(y.SingularFloat)) || x.SingularFloat == 0 && math.Signbit(float64(x.SingularFloat)) != math.Signbit(float64(y.SingularFloat)) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !runtime.EqualFloat(x.SingularDouble, y.SingularDouble) || x.SingularDouble == 0 && math.Signbit(x.SingularDouble) != math.Signbit(y.SingularDouble) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SingularBool != y.SingularBool {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SingularString != y.SingularString {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !bytes.Equal(x.SingularBytes, y.SingularBytes) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.SingularNestedMessage.Equal(y.SingularNestedMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.SingularForeignMessage.Equal(y.SingularForeignMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.SingularImportMessage.Equal(y.SingularImportMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SingularNestedEnum != y.SingularNestedEnum {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SingularForeignEnum != y.SingularForeignEnum {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SingularImportEnum != y.SingularImportEnum {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.RepeatedInt32) != len(y.RepeatedInt32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedInt32 {
+		if v != y.RepeatedInt32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedInt64) != len(y.RepeatedInt64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedInt64 {
+		if v != y.RepeatedInt64[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedUint32) != len(y.RepeatedUint32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedUint32 {
+		if v != y.RepeatedUint32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedUint64) != len(y.RepeatedUint64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedUint64 {
+		if v != y.RepeatedUint64[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedSint32) != len(y.RepeatedSint32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedSint32 {
+		if v != y.RepeatedSint32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedSint64) != len(y.RepeatedSint64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedSint64 {
+		if v != y.RepeatedSint64[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedFixed32) != len(y.RepeatedFixed32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedFixed32 {
+		if v != y.RepeatedFixed32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedFixed64) != len(y.RepeatedFixed64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedFixed64 {
+		if v != y.RepeatedFixed64[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedSfixed32) != len(y.RepeatedSfixed32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedSfixed32 {
+		if v != y.RepeatedSfixed32[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedSfixed64) != len(y.RepeatedSfixed64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedSfixed64 {
+		if v != y.RepeatedSfixed64[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedFloat) != len(y.RepeatedFloat) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedFloat {
+		if !runtime.EqualFloat(float64(v), float64(y.RepeatedFloat[i])) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedDouble) != len(y.RepeatedDouble) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedDouble {
+		if !runtime.EqualFloat(v, y.RepeatedDouble[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedBool) != len(y.RepeatedBool) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedBool {
+		if v != y.RepeatedBool[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedString) != len(y.RepeatedString) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedString {
+		if v != y.RepeatedString[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedBytes) != len(y.RepeatedBytes) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedBytes {
+		if !bytes.Equal(v, y.RepeatedBytes[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedNestedMessage) != len(y.RepeatedNestedMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedNestedMessage {
+		if !v.Equal(y.RepeatedNestedMessage[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedForeignMessage) != len(y.RepeatedForeignMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedForeignMessage {
+		if !v.Equal(y.RepeatedForeignMessage[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedImportmessage) != len(y.RepeatedImportmessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedImportmessage {
+		if !v.Equal(y.RepeatedImportmessage[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedNestedEnum) != len(y.RepeatedNestedEnum) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedNestedEnum {
+		if v != y.RepeatedNestedEnum[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedForeignEnum) != len(y.RepeatedForeignEnum) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedForeignEnum {
+		if v != y.RepeatedForeignEnum[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.RepeatedImportenum) != len(y.RepeatedImportenum) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.RepeatedImportenum {
+		if v != y.RepeatedImportenum[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapInt32Int32) != len(y.MapInt32Int32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapInt32Int32 {
+		if w, ok := y.MapInt32Int32[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapInt64Int64) != len(y.MapInt64Int64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapInt64Int64 {
+		if w, ok := y.MapInt64Int64[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapUint32Uint32) != len(y.MapUint32Uint32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapUint32Uint32 {
+		if w, ok := y.MapUint32Uint32[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapUint64Uint64) != len(y.MapUint64Uint64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapUint64Uint64 {
+		if w, ok := y.MapUint64Uint64[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapSint32Sint32) != len(y.MapSint32Sint32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapSint32Sint32 {
+		if w, ok := y.MapSint32Sint32[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapSint64Sint64) != len(y.MapSint64Sint64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapSint64Sint64 {
+		if w, ok := y.MapSint64Sint64[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapFixed32Fixed32) != len(y.MapFixed32Fixed32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapFixed32Fixed32 {
+		if w, ok := y.MapFixed32Fixed32[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapFixed64Fixed64) != len(y.MapFixed64Fixed64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapFixed64Fixed64 {
+		if w, ok := y.MapFixed64Fixed64[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapSfixed32Sfixed32) != len(y.MapSfixed32Sfixed32) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapSfixed32Sfixed32 {
+		if w, ok := y.MapSfixed32Sfixed32[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapSfixed64Sfixed64) != len(y.MapSfixed64Sfixed64) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapSfixed64Sfixed64 {
+		if w, ok := y.MapSfixed64Sfixed64[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapInt32Float) != len(y.MapInt32Float) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapInt32Float {
+		if w, ok := y.MapInt32Float[k]; !ok || !runtime.EqualFloat(float64(v), float64(w)) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapInt32Double) != len(y.MapInt32Double) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapInt32Double {
+		if w, ok := y.MapInt32Double[k]; !ok || !runtime.EqualFloat(v, w) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapBoolBool) != len(y.MapBoolBool) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapBoolBool {
+		if w, ok := y.MapBoolBool[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapStringString) != len(y.MapStringString) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapStringString {
+		if w, ok := y.MapStringString[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapStringBytes) != len(y.MapStringBytes) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapStringBytes {
+		if w, ok := y.MapStringBytes[k]; !ok || !bytes.Equal(v, w) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapStringNestedMessage) != len(y.MapStringNestedMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapStringNestedMessage {
+		if w, ok := y.MapStringNestedMessage[k]; !ok || !v.Equal(w) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.MapStringNestedEnum) != len(y.MapStringNestedEnum) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MapStringNestedEnum {
+		if w, ok := y.MapStringNestedEnum[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	switch v := x.OneofField.(type) {
+	case nil:
+		if y.OneofField != nil {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofUint32:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofUint32); !ok || v.OneofUint32 != w.OneofUint32 {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofNestedMessage:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofNestedMessage); !ok || !v.OneofNestedMessage.Equal(w.OneofNestedMessage) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofString:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofString); !ok || v.OneofString != w.OneofString {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofBytes:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofBytes); !ok || !bytes.Equal(v.OneofBytes, w.OneofBytes) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofBool:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofBool); !ok || v.OneofBool != w.OneofBool {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofUint64:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofUint64); !ok || v.OneofUint64 != w.OneofUint64 {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofFloat:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofFloat); !ok || !runtime.EqualFloat(float64(v.OneofFloat), float64(w.OneofFloat)) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofDouble:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofDouble); !ok || !runtime.EqualFloat(v.OneofDouble, w.OneofDouble) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestAllTypes_OneofEnum:
+		if w, ok := y.OneofField.(*TestAllTypes_OneofEnum); !ok || v.OneofEnum != w.OneofEnum {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestAllTypes) Clone() *TestAllTypes {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestAllTypes)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestAllTypes) CopyInto(dst *TestAllTypes) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.SingularInt32 = x.SingularInt32
+	dst.SingularInt64 = x.SingularInt64
+	dst.SingularUint32 = x.SingularUint32
+	dst.SingularUint64 = x.SingularUint64
+	dst.SingularSint32 = x.SingularSint32
+	dst.SingularSint64 = x.SingularSint64
+	dst.SingularFixed32 = x.SingularFixed32
+	dst.SingularFixed64 = x.SingularFixed64
+	dst.SingularSfixed32 = x.SingularSfixed32
+	dst.SingularSfixed64 = x.SingularSfixed64
+	dst.SingularFloat = x.SingularFloat
+	dst.SingularDouble = x.SingularDouble
+	dst.SingularBool = x.SingularBool
+	dst.SingularString = x.SingularString
+	dst.SingularBytes = bytes.Clone(x.SingularBytes)
+	dst.SingularNestedMessage = x.SingularNestedMessage.Clone()
+	dst.SingularForeignMessage = x.SingularForeignMessage.Clone()
+	dst.SingularImportMessage = x.SingularImportMessage.Clone()
+	dst.SingularNestedEnum = x.SingularNestedEnum
+	dst.SingularForeignEnum = x.SingularForeignEnum
+	dst.SingularImportEnum = x.SingularImportEnum
+	dst.RepeatedInt32 = slices.Clone(x.RepeatedInt32)
+	dst.RepeatedInt64 = slices.Clone(x.RepeatedInt64)
+	dst.RepeatedUint32 = slices.Clone(x.RepeatedUint32)
+	dst.RepeatedUint64 = slices.Clone(x.RepeatedUint64)
+	dst.RepeatedSint32 = slices.Clone(x.RepeatedSint32)
+	dst.RepeatedSint64 = slices.Clone(x.RepeatedSint64)
+	dst.RepeatedFixed32 = slices.Clone(x.RepeatedFixed32)
+	dst.RepeatedFixed64 = slices.Clone(x.RepeatedFixed64)
+	dst.RepeatedSfixed32 = slices.Clone(x.RepeatedSfixed32)
+	dst.RepeatedSfixed64 = slices.Clone(x.RepeatedSfixed64)
+	dst.RepeatedFloat = slices.Clone(x.RepeatedFloat)
+	dst.RepeatedDouble = slices.Clone(x.RepeatedDouble)
+	dst.RepeatedBool = slices.Clone(x.RepeatedBool)
+	dst.RepeatedString = slices.Clone(x.RepeatedString)
+	if x.RepeatedBytes != nil {
+		dst.RepeatedBytes = make([][]byte, len(x.RepeatedBytes))
+		for i, v := range x.RepeatedBytes {
+			dst.RepeatedBytes[i] = bytes.Clone(v)
+		}
+	} else {
+		dst.RepeatedBytes = nil
+	}
+	if x.RepeatedNestedMessage != nil {
+		dst.RepeatedNestedMessage = make([]*TestAllTypes_NestedMessage, len(x.RepeatedNestedMessage))
+		for i, v := range x.RepeatedNestedMessage {
+			dst.RepeatedNestedMessage[i] = v.Clone()
+		}
+	} else {
+		dst.RepeatedNestedMessage = nil
+	}
+	if x.RepeatedForeignMessage != nil {
+		dst.RepeatedForeignMessage = make([]*ForeignMessage, len(x.RepeatedForeignMessage))
+		for i, v := range x.RepeatedForeignMessage {
+			dst.RepeatedForeignMessage[i] = v.Clone()
+		}
+	} else {
+		dst.RepeatedForeignMessage = nil
+	}
+	if x.RepeatedImportmessage != nil {
+		dst.RepeatedImportmessage = make([]*ImportMessage, len(x.RepeatedImportmessage))
+		for i, v := range x.RepeatedImportmessage {
+			dst.RepeatedImportmessage[i] = v.Clone()
+		}
+	} else {
+		dst.RepeatedImportmessage = nil
+	}
+	dst.RepeatedNestedEnum = slices.Clone(x.RepeatedNestedEnum)
+	dst.RepeatedForeignEnum = slices.Clone(x.RepeatedForeignEnum)
+	dst.RepeatedImportenum = slices.Clone(x.RepeatedImportenum)
+	dst.MapInt32Int32 = maps.Clone(x.MapInt32Int32)
+	dst.MapInt64Int64 = maps.Clone(x.MapInt64Int64)
+	dst.MapUint32Uint32 = maps.Clone(x.MapUint32Uint32)
+	dst.MapUint64Uint64 = maps.Clone(x.MapUint64Uint64)
+	dst.MapSint32Sint32 = maps.Clone(x.MapSint32Sint32)
+	dst.MapSint64Sint64 = maps.Clone(x.MapSint64Sint64)
+	dst.MapFixed32Fixed32 = maps.Clone(x.MapFixed32Fixed32)
+	dst.MapFixed64Fixed64 = maps.Clone(x.MapFixed64Fixed64)
+	dst.MapSfixed32Sfixed32 = maps.Clone(x.MapSfixed32Sfixed32)
+	dst.MapSfixed64Sfixed64 = maps.Clone(x.MapSfixed64Sfixed64)
+	dst.MapInt32Float = maps.Clone(x.MapInt32Float)
+	dst.MapInt32Double = maps.Clone(x.MapInt32Double)
+	dst.MapBoolBool = maps.Clone(x.MapBoolBool)
+	dst.MapStringString = maps.Clone(x.MapStringString)
+	if x.MapStringBytes != nil {
+		dst.MapStringBytes = make(map[string][]byte, len(x.MapStringBytes))
+		for k, v := range x.MapStringBytes {
+			dst.MapStringBytes[k] = bytes.Clone(v)
+		}
+	} else {
+		dst.MapStringBytes = nil
+	}
+	if x.MapStringNestedMessage != nil {
+		dst.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage, len(x.MapStringNestedMessage))
+		for k, v := range x.MapStringNestedMessage {
+			dst.MapStringNestedMessage[k] = v.Clone()
+		}
+	} else {
+		dst.MapStringNestedMessage = nil
+	}
+	dst.MapStringNestedEnum = maps.Clone(x.MapStringNestedEnum)
+	switch v := x.OneofField.(type) {
+	case nil:
+		dst.OneofField = nil
+	case *TestAllTypes_OneofUint32:
+		dst.OneofField = &TestAllTypes_OneofUint32{OneofUint32: v.OneofUint32}
+	case *TestAllTypes_OneofNestedMessage:
+		dst.OneofField = &TestAllTypes_OneofNestedMessage{OneofNestedMessage: v.OneofNestedMessage.Clone()}
+	case *TestAllTypes_OneofString:
+		dst.OneofField = &TestAllTypes_OneofString{OneofString: v.OneofString}
+	case *TestAllTypes_OneofBytes:
+		dst.OneofField = &TestAllTypes_OneofBytes{OneofBytes: bytes.Clone(v.OneofBytes)}
+	case *TestAllTypes_OneofBool:
+		dst.OneofField = &TestAllTypes_OneofBool{OneofBool: v.OneofBool}
+	case *TestAllTypes_OneofUint64:
+		dst.OneofField = &TestAllTypes_OneofUint64{OneofUint64: v.OneofUint64}
+	case *TestAllTypes_OneofFloat:
+		dst.OneofField = &TestAllTypes_OneofFloat{OneofFloat: v.OneofFloat}
+	case *TestAllTypes_OneofDouble:
+		dst.OneofField = &TestAllTypes_OneofDouble{OneofDouble: v.OneofDouble}
+	case *TestAllTypes_OneofEnum:
+		dst.OneofField = &TestAllTypes_OneofEnum{OneofEnum: v.OneofEnum}
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestAllTypes) Equal(y *TestAllTypes) bool {
+	return _fastReflection_TestAllTypes_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestAllTypes, or nil if b is canonical.
+func (*TestAllTypes) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestAllTypes.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 81:
+			w.Scalar("singular_int32", protoreflect.Int32Kind, true)
+		case 82:
+			w.Scalar("singular_int64", protoreflect.Int64Kind, true)
+		case 83:
+			w.Scalar("singular_uint32", protoreflect.Uint32Kind, true)
+		case 84:
+			w.Scalar("singular_uint64", protoreflect.Uint64Kind, true)
+		case 85:
+			w.Scalar("singular_sint32", protoreflect.Sint32Kind, true)
+		case 86:
+			w.Scalar("singular_sint64", protoreflect.Sint64Kind, true)
+		case 87:
+			w.Scalar("singular_fixed32", protoreflect.Fixed32Kind, true)
+		case 88:
+			w.Scalar("singular_fixed64", protoreflect.Fixed64Kind, true)
+		case 89:
+			w.Scalar("singular_sfixed32", protoreflect.Sfixed32Kind, true)
+		case 90:
+			w.Scalar("singular_sfixed64", protoreflect.Sfixed64Kind, true)
+		case 91:
+			w.Scalar("singular_float", protoreflect.FloatKind, true)
+		case 92:
+			w.Scalar("singular_double", protoreflect.DoubleKind, true)
+		case 93:
+			w.Scalar("singular_bool", protoreflect.BoolKind, true)
+		case 94:
+			w.Scalar("singular_string", protoreflect.StringKind, true)
+		case 95:
+			w.Scalar("singular_bytes", protoreflect.BytesKind, true)
+		case 98:
+			w.Message("singular_nested_message", false, (*TestAllTypes_NestedMessage)(nil))
+		case 99:
+			w.Message("singular_foreign_message", false, (*ForeignMessage)(nil))
+		case 100:
+			w.Message("singular_import_message", false, (*ImportMessage)(nil))
+		case 101:
+			w.Scalar("singular_nested_enum", protoreflect.EnumKind, true)
+		case 102:
+			w.Scalar("singular_foreign_enum", protoreflect.EnumKind, true)
+		case 103:
+			w.Scalar("singular_import_enum", protoreflect.EnumKind, true)
+		case 31:
+			w.List("repeated_int32", protoreflect.Int32Kind, true)
+		case 32:
+			w.List("repeated_int64", protoreflect.Int64Kind, true)
+		case 33:
+			w.List("repeated_uint32", protoreflect.Uint32Kind, true)
+		case 34:
+			w.List("repeated_uint64", protoreflect.Uint64Kind, true)
+		case 35:
+			w.List("repeated_sint32", protoreflect.Sint32Kind, true)
+		case 36:
+			w.List("repeated_sint64", protoreflect.Sint64Kind, true)
+		case 37:
+			w.List("repeated_fixed32", protoreflect.Fixed32Kind, true)
+		case 38:
+			w.List("repeated_fixed64", protoreflect.Fixed64Kind, true)
+		case 39:
+			w.List("repeated_sfixed32", protoreflect.Sfixed32Kind, true)
+		case 40:
+			w.List("repeated_sfixed64", protoreflect.Sfixed64Kind, true)
+		case 41:
+			w.List("repeated_float", protoreflect.FloatKind, true)
+		case 42:
+			w.List("repeated_double", protoreflect.DoubleKind, true)
+		case 43:
+			w.List("repeated_bool", protoreflect.BoolKind, true)
+		case 44:
+			w.List("repeated_string", protoreflect.StringKind, false)
+		case 45:
+			w.List("repeated_bytes", protoreflect.BytesKind, false)
+		case 48:
+			w.Message("repeated_nested_message", true, (*TestAllTypes_NestedMessage)(nil))
+		case 49:
+			w.Message("repeated_foreign_message", true, (*ForeignMessage)(nil))
+		case 50:
+			w.Message("repeated_importmessage", true, (*ImportMessage)(nil))
+		case 51:
+			w.List("repeated_nested_enum", protoreflect.EnumKind, true)
+		case 52:
+			w.List("repeated_foreign_enum", protoreflect.EnumKind, true)
+		case 53:
+			w.List("repeated_importenum", protoreflect.EnumKind, true)
+		case 56:
+			w.Map("map_int32_int32", protoreflect.Int32Kind, protoreflect.Int32Kind, nil)
+		case 57:
+			w.Map("map_int64_int64", protoreflect.Int64Kind, protoreflect.Int64Kind, nil)
+		case 58:
+			w.Map("map_uint32_uint32", protoreflect.Uint32Kind, protoreflect.Uint32Kind, nil)
+		case 59:
+			w.Map("map_uint64_uint64", protoreflect.Uint64Kind, protoreflect.Uint64Kind, nil)
+		case 60:
+			w.Map("map_sint32_sint32", protoreflect.Sint32Kind, protoreflect.Sint32Kind, nil)
+		case 61:
+			w.Map("map_sint64_sint64", protoreflect.Sint64Kind, protoreflect.Sint64Kind, nil)
+		case 62:
+			w.Map("map_fixed32_fixed32", protoreflect.Fixed32Kind, protoreflect.Fixed32Kind, nil)
+		case 63:
+			w.Map("map_fixed64_fixed64", protoreflect.Fixed64Kind, protoreflect.Fixed64Kind, nil)
+		case 64:
+			w.Map("map_sfixed32_sfixed32", protoreflect.Sfixed32Kind, protoreflect.Sfixed32Kind, nil)
+		case 65:
+			w.Map("map_sfixed64_sfixed64", protoreflect.Sfixed64Kind, protoreflect.Sfixed64Kind, nil)
+		case 66:
+			w.Map("map_int32_float", protoreflect.Int32Kind, protoreflect.FloatKind, nil)
+		case 67:
+			w.Map("map_int32_double", protoreflect.Int32Kind, protoreflect.DoubleKind, nil)
+		case 68:
+			w.Map("map_bool_bool", protoreflect.BoolKind, protoreflect.BoolKind, nil)
+		case 69:
+			w.Map("map_string_string", protoreflect.StringKind, protoreflect.StringKind, nil)
+		case 70:
+			w.Map("map_string_bytes", protoreflect.StringKind, protoreflect.BytesKind, nil)
+		case 71:
+			w.Map("map_string_nested_message", protoreflect.StringKind, protoreflect.MessageKind, (*TestAllTypes_NestedMessage)(nil))
+		case 73:
+			w.Map("map_string_nested_enum", protoreflect.StringKind, protoreflect.EnumKind, nil)
+		case 111:
+			w.Oneof(0)
+			w.Scalar("oneof_uint32", protoreflect.Uint32Kind, false)
+		case 112:
+			w.Oneof(0)
+			w.Message("oneof_nested_message", false, (*TestAllTypes_NestedMessage)(nil))
+		case 113:
+			w.Oneof(0)
+			w.Scalar("oneof_string", protoreflect.StringKind, false)
+		case 114:
+			w.Oneof(0)
+			w.Scalar("oneof_bytes", protoreflect.BytesKind, false)
+		case 115:
+			w.Oneof(0)
+			w.Scalar("oneof_bool", protoreflect.BoolKind, false)
+		case 116:
+			w.Oneof(0)
+			w.Scalar("oneof_uint64", protoreflect.Uint64Kind, false)
+		case 117:
+			w.Oneof(0)
+			w.Scalar("oneof_float", protoreflect.FloatKind, false)
+		case 118:
+			w.Oneof(0)
+			w.Scalar("oneof_double", protoreflect.DoubleKind, false)
+		case 119:
+			w.Oneof(0)
+			w.Scalar("oneof_enum", protoreflect.EnumKind, false)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_TestAllTypes_NestedMessage             protoreflect.MessageDescriptor
+	fd_TestAllTypes_NestedMessage_a           protoreflect.FieldDescriptor
+	fd_TestAllTypes_NestedMessage_corecursive protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test3_test_proto_init()
+	md_TestAllTypes_NestedMessage = File_internal_testprotos_test3_test_proto.Messages().ByName("TestAllTypes").Messages().ByName("NestedMessage")
+	fd_TestAllTypes_NestedMessage_a = md_TestAllTypes_NestedMessage.Fields().ByName("a")
+	fd_TestAllTypes_NestedMessage_corecursive = md_TestAllTypes_NestedMessage.Fields().ByName("corecursive")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestAllTypes_NestedMessage)(nil)
+
+type fastReflection_TestAllTypes_NestedMessage TestAllTypes_NestedMessage
+
+func (x *TestAllTypes_NestedMessage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestAllTypes_NestedMessage)(x)
+}
+
+func (x *TestAllTypes_NestedMessage) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test3_test_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestAllTypes_NestedMessage_messageType fastReflection_TestAllTypes_NestedMessage_messageType
+var _ protoreflect.MessageType = fastReflection_TestAllTypes_NestedMessage_messageType{}
+
+type fastReflection_TestAllTypes_NestedMessage_messageType struct{}
+
+func (x fastReflection_TestAllTypes_NestedMessage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestAllTypes_NestedMessage)(nil)
+}
+func (x fastReflection_TestAllTypes_NestedMessage_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestAllTypes_NestedMessage)
+}
+func (x fastReflection_TestAllTypes_NestedMessage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestAllTypes_NestedMessage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestAllTypes_NestedMessage) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestAllTypes_NestedMessage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestAllTypes_NestedMessage) Type() protoreflect.MessageType {
+	return _fastReflection_TestAllTypes_NestedMessage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestAllTypes_NestedMessage) New() protoreflect.Message {
+	return new(fastReflection_TestAllTypes_NestedMessage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestAllTypes_NestedMessage) Interface() protoreflect.ProtoMessage {
+	return (*TestAllTypes_NestedMessage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestAllTypes_NestedMessage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.A != int32(0) {
+		value := protoreflect.ValueOfInt32(x.A)
+		if !f(fd_TestAllTypes_NestedMessage_a, value) {
+			return
+		}
+	}
+	if x.Corecursive != nil {
+		value := protoreflect.ValueOfMessage(x.Corecursive.ProtoReflect())
+		if !f(fd_TestAllTypes_NestedMessage_corecursive, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestAllTypes_NestedMessage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.TestAllTypes.NestedMessage.a
+		if fd != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		return x.A != int32(0)
+	case 2: // goproto.proto.test3.TestAllTypes.NestedMessage.corecursive
+		if fd != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		return x.Corecursive != nil
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_NestedMessage); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_NestedMessage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.TestAllTypes.NestedMessage.a
+		if fd != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		x.A = int32(0)
+		return
+	case 2: // goproto.proto.test3.TestAllTypes.NestedMessage.corecursive
+		if fd != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		x.Corecursive = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_NestedMessage); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestAllTypes_NestedMessage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test3.TestAllTypes.NestedMessage.a
+		if descriptor != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		value := x.A
+		return protoreflect.ValueOfInt32(value)
+	case 2: // goproto.proto.test3.TestAllTypes.NestedMessage.corecursive
+		if descriptor != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		value := x.Corecursive
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestAllTypes_NestedMessage); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_NestedMessage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.TestAllTypes.NestedMessage.a
+		if fd != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		x.A = int32(value.Int())
+		return
+	case 2: // goproto.proto.test3.TestAllTypes.NestedMessage.corecursive
+		if fd != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		x.Corecursive = value.Message().Interface().(*TestAllTypes)
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_NestedMessage); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_NestedMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 2: // goproto.proto.test3.TestAllTypes.NestedMessage.corecursive
+		if fd != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		if x.Corecursive == nil {
+			x.Corecursive = new(TestAllTypes)
+		}
+		return protoreflect.ValueOfMessage(x.Corecursive.ProtoReflect())
+	case 1: // goproto.proto.test3.TestAllTypes.NestedMessage.a
+		if fd != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		panic(fmt.Errorf("field a of message goproto.proto.test3.TestAllTypes.NestedMessage is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_NestedMessage); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestAllTypes_NestedMessage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.TestAllTypes.NestedMessage.a
+		if fd != fd_TestAllTypes_NestedMessage_a {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 2: // goproto.proto.test3.TestAllTypes.NestedMessage.corecursive
+		if fd != fd_TestAllTypes_NestedMessage_corecursive {
+			break
+		}
+		m := new(TestAllTypes)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(fd, md_TestAllTypes_NestedMessage); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestAllTypes_NestedMessage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test3.TestAllTypes.NestedMessage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestAllTypes_NestedMessage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestAllTypes_NestedMessage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestAllTypes_NestedMessage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestAllTypes_NestedMessage) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestAllTypes_NestedMessage_methods
+}
+
+var _fastReflection_TestAllTypes_NestedMessage_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestAllTypes_NestedMessage_size,
+	Marshal:           _fastReflection_TestAllTypes_NestedMessage_marshal,
+	Unmarshal:         _fastReflection_TestAllTypes_NestedMessage_unmarshal,
+	Merge:             _fastReflection_TestAllTypes_NestedMessage_merge,
+	CheckInitialized:  _fastReflection_TestAllTypes_NestedMessage_checkInitialized,
+	Equal:             _fastReflection_TestAllTypes_NestedMessage_equal,
+}
+
+func _fastReflection_TestAllTypes_NestedMessage_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestAllTypes_NestedMessage)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.A != 0 {
+		n += 1 + runtime.Sov(uint64(x.A))
+	}
+	if x.Corecursive != nil {
+		l = options.Size(x.Corecursive)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
 
-type fastReflection_TestAllTypes_NestedMessage TestAllTypes_NestedMessage
+func _fastReflection_TestAllTypes_NestedMessage_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes_NestedMessage)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.Corecursive != nil {
+		l = options.Size(x.Corecursive)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Corecursive); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x12
+	}
+	if x.A != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.A))
+		i--
+		dAtA[i] = 0x8
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
 
-func (x *TestAllTypes_NestedMessage) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TestAllTypes_NestedMessage)(x)
+func _fastReflection_TestAllTypes_NestedMessage_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestAllTypes_NestedMessage)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestAllTypes_NestedMessage.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes_NestedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes_NestedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			x.A = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.A |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corecursive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Corecursive == nil {
+				if err := budget.Message(fd_TestAllTypes_NestedMessage_corecursive, int(unsafe.Sizeof(TestAllTypes{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Corecursive = &TestAllTypes{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Corecursive); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestAllTypes_NestedMessage_corecursive, -1)
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestAllTypes_NestedMessage, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
 }
 
-func (x *TestAllTypes_NestedMessage) slowProtoReflect() protoreflect.Message {
-	mi := &file_internal_testprotos_test3_test_proto_msgTypes[2]
+func _fastReflection_TestAllTypes_NestedMessage_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestAllTypes_NestedMessage)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestAllTypes_NestedMessage)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.A != int32(0) {
+		dst.A = src.A
+	}
+	if src.Corecursive != nil {
+		if dst.Corecursive == nil {
+			dst.Corecursive = &TestAllTypes{}
+		}
+		proto.Merge(dst.Corecursive, src.Corecursive)
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestAllTypes_NestedMessage_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestAllTypes_NestedMessage_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestAllTypes_NestedMessage)
+	y, yok := input.MessageB.Interface().(*TestAllTypes_NestedMessage)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.A != y.A {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.Corecursive.Equal(y.Corecursive) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestAllTypes_NestedMessage) Clone() *TestAllTypes_NestedMessage {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestAllTypes_NestedMessage)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestAllTypes_NestedMessage) CopyInto(dst *TestAllTypes_NestedMessage) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.A = x.A
+	dst.Corecursive = x.Corecursive.Clone()
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestAllTypes_NestedMessage) Equal(y *TestAllTypes_NestedMessage) bool {
+	return _fastReflection_TestAllTypes_NestedMessage_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestAllTypes_NestedMessage, or nil if b is canonical.
+func (*TestAllTypes_NestedMessage) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestAllTypes_NestedMessage.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("a", protoreflect.Int32Kind, true)
+		case 2:
+			w.Message("corecursive", false, (*TestAllTypes)(nil))
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_ForeignMessage   protoreflect.MessageDescriptor
+	fd_ForeignMessage_c protoreflect.FieldDescriptor
+	fd_ForeignMessage_d protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test3_test_proto_init()
+	md_ForeignMessage = File_internal_testprotos_test3_test_proto.Messages().ByName("ForeignMessage")
+	fd_ForeignMessage_c = md_ForeignMessage.Fields().ByName("c")
+	fd_ForeignMessage_d = md_ForeignMessage.Fields().ByName("d")
+}
+
+var _ protoreflect.Message = (*fastReflection_ForeignMessage)(nil)
+
+type fastReflection_ForeignMessage ForeignMessage
+
+func (x *ForeignMessage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ForeignMessage)(x)
+}
+
+func (x *ForeignMessage) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test3_test_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10313,43 +14575,43 @@ func (x *TestAllTypes_NestedMessage) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TestAllTypes_NestedMessage_messageType fastReflection_TestAllTypes_NestedMessage_messageType
-var _ protoreflect.MessageType = fastReflection_TestAllTypes_NestedMessage_messageType{}
+var _fastReflection_ForeignMessage_messageType fastReflection_ForeignMessage_messageType
+var _ protoreflect.MessageType = fastReflection_ForeignMessage_messageType{}
 
-type fastReflection_TestAllTypes_NestedMessage_messageType struct{}
+type fastReflection_ForeignMessage_messageType struct{}
 
-func (x fastReflection_TestAllTypes_NestedMessage_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TestAllTypes_NestedMessage)(nil)
+func (x fastReflection_ForeignMessage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ForeignMessage)(nil)
 }
-func (x fastReflection_TestAllTypes_NestedMessage_messageType) New() protoreflect.Message {
-	return new(fastReflection_TestAllTypes_NestedMessage)
+func (x fastReflection_ForeignMessage_messageType) New() protoreflect.Message {
+	return new(fastReflection_ForeignMessage)
 }
-func (x fastReflection_TestAllTypes_NestedMessage_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TestAllTypes_NestedMessage
+func (x fastReflection_ForeignMessage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForeignMessage
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TestAllTypes_NestedMessage) Descriptor() protoreflect.MessageDescriptor {
-	return md_TestAllTypes_NestedMessage
+func (x *fastReflection_ForeignMessage) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForeignMessage
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TestAllTypes_NestedMessage) Type() protoreflect.MessageType {
-	return _fastReflection_TestAllTypes_NestedMessage_messageType
+func (x *fastReflection_ForeignMessage) Type() protoreflect.MessageType {
+	return _fastReflection_ForeignMessage_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TestAllTypes_NestedMessage) New() protoreflect.Message {
-	return new(fastReflection_TestAllTypes_NestedMessage)
+func (x *fastReflection_ForeignMessage) New() protoreflect.Message {
+	return new(fastReflection_ForeignMessage)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TestAllTypes_NestedMessage) Interface() protoreflect.ProtoMessage {
-	return (*TestAllTypes_NestedMessage)(x)
+func (x *fastReflection_ForeignMessage) Interface() protoreflect.ProtoMessage {
+	return (*ForeignMessage)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10357,16 +14619,16 @@ func (x *fastReflection_TestAllTypes_NestedMessage) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TestAllTypes_NestedMessage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.A != int32(0) {
-		value := protoreflect.ValueOfInt32(x.A)
-		if !f(fd_TestAllTypes_NestedMessage_a, value) {
+func (x *fastReflection_ForeignMessage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.C != int32(0) {
+		value := protoreflect.ValueOfInt32(x.C)
+		if !f(fd_ForeignMessage_c, value) {
 			return
 		}
 	}
-	if x.Corecursive != nil {
-		value := protoreflect.ValueOfMessage(x.Corecursive.ProtoReflect())
-		if !f(fd_TestAllTypes_NestedMessage_corecursive, value) {
+	if x.D != int32(0) {
+		value := protoreflect.ValueOfInt32(x.D)
+		if !f(fd_ForeignMessage_d, value) {
 			return
 		}
 	}
@@ -10383,18 +14645,26 @@ func (x *fastReflection_TestAllTypes_NestedMessage) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TestAllTypes_NestedMessage) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "goproto.proto.test3.TestAllTypes.NestedMessage.a":
-		return x.A != int32(0)
-	case "goproto.proto.test3.TestAllTypes.NestedMessage.corecursive":
-		return x.Corecursive != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.TestAllTypes.NestedMessage"))
+func (x *fastReflection_ForeignMessage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.ForeignMessage.c
+		if fd != fd_ForeignMessage_c {
+			break
+		}
+		return x.C != int32(0)
+	case 2: // goproto.proto.test3.ForeignMessage.d
+		if fd != fd_ForeignMessage_d {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+		return x.D != int32(0)
+	}
+	if fd := runtime.FieldOf(fd, md_ForeignMessage); fd != nil {
+		return x.Has(fd)
 	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not contain field %s", fd.FullName()))
 }
 
 // Clear clears the field such that a subsequent Has call reports false.
@@ -10403,18 +14673,29 @@ func (x *fastReflection_TestAllTypes_NestedMessage) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TestAllTypes_NestedMessage) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "goproto.proto.test3.TestAllTypes.NestedMessage.a":
-		x.A = int32(0)
-	case "goproto.proto.test3.TestAllTypes.NestedMessage.corecursive":
-		x.Corecursive = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.TestAllTypes.NestedMessage"))
+func (x *fastReflection_ForeignMessage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.ForeignMessage.c
+		if fd != fd_ForeignMessage_c {
+			break
+		}
+		x.C = int32(0)
+		return
+	case 2: // goproto.proto.test3.ForeignMessage.d
+		if fd != fd_ForeignMessage_d {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+		x.D = int32(0)
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_ForeignMessage); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not extend %s", fd.FullName()))
 	}
+	panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not contain field %s", fd.FullName()))
 }
 
 // Get retrieves the value for a field.
@@ -10423,20 +14704,28 @@ func (x *fastReflection_TestAllTypes_NestedMessage) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TestAllTypes_NestedMessage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "goproto.proto.test3.TestAllTypes.NestedMessage.a":
-		value := x.A
+func (x *fastReflection_ForeignMessage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test3.ForeignMessage.c
+		if descriptor != fd_ForeignMessage_c {
+			break
+		}
+		value := x.C
 		return protoreflect.ValueOfInt32(value)
-	case "goproto.proto.test3.TestAllTypes.NestedMessage.corecursive":
-		value := x.Corecursive
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.TestAllTypes.NestedMessage"))
+	case 2: // goproto.proto.test3.ForeignMessage.d
+		if descriptor != fd_ForeignMessage_d {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not contain field %s", descriptor.FullName()))
+		value := x.D
+		return protoreflect.ValueOfInt32(value)
+	}
+	if fd := runtime.FieldOf(descriptor, md_ForeignMessage); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not extend %s", descriptor.FullName()))
 	}
+	panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not contain field %s", descriptor.FullName()))
 }
 
 // Set stores the value for a field.
@@ -10449,18 +14738,29 @@ func (x *fastReflection_TestAllTypes_NestedMessage) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TestAllTypes_NestedMessage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "goproto.proto.test3.TestAllTypes.NestedMessage.a":
-		x.A = int32(value.Int())
-	case "goproto.proto.test3.TestAllTypes.NestedMessage.corecursive":
-		x.Corecursive = value.Message().Interface().(*TestAllTypes)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.TestAllTypes.NestedMessage"))
+func (x *fastReflection_ForeignMessage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.ForeignMessage.c
+		if fd != fd_ForeignMessage_c {
+			break
+		}
+		x.C = int32(value.Int())
+		return
+	case 2: // goproto.proto.test3.ForeignMessage.d
+		if fd != fd_ForeignMessage_d {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+		x.D = int32(value.Int())
+		return
 	}
+	if fd := runtime.FieldOf(fd, md_ForeignMessage); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not contain field %s", fd.FullName()))
 }
 
 // Mutable returns a mutable reference to a composite type.
@@ -10473,48 +14773,60 @@ func (x *fastReflection_TestAllTypes_NestedMessage) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TestAllTypes_NestedMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "goproto.proto.test3.TestAllTypes.NestedMessage.corecursive":
-		if x.Corecursive == nil {
-			x.Corecursive = new(TestAllTypes)
-		}
-		return protoreflect.ValueOfMessage(x.Corecursive.ProtoReflect())
-	case "goproto.proto.test3.TestAllTypes.NestedMessage.a":
-		panic(fmt.Errorf("field a of message goproto.proto.test3.TestAllTypes.NestedMessage is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.TestAllTypes.NestedMessage"))
+func (x *fastReflection_ForeignMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.ForeignMessage.c
+		if fd != fd_ForeignMessage_c {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("field c of message goproto.proto.test3.ForeignMessage is not mutable"))
+	case 2: // goproto.proto.test3.ForeignMessage.d
+		if fd != fd_ForeignMessage_d {
+			break
+		}
+		panic(fmt.Errorf("field d of message goproto.proto.test3.ForeignMessage is not mutable"))
 	}
+	if fd := runtime.FieldOf(fd, md_ForeignMessage); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not contain field %s", fd.FullName()))
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TestAllTypes_NestedMessage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "goproto.proto.test3.TestAllTypes.NestedMessage.a":
+func (x *fastReflection_ForeignMessage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.ForeignMessage.c
+		if fd != fd_ForeignMessage_c {
+			break
+		}
 		return protoreflect.ValueOfInt32(int32(0))
-	case "goproto.proto.test3.TestAllTypes.NestedMessage.corecursive":
-		m := new(TestAllTypes)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.TestAllTypes.NestedMessage"))
+	case 2: // goproto.proto.test3.ForeignMessage.d
+		if fd != fd_ForeignMessage_d {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.TestAllTypes.NestedMessage does not contain field %s", fd.FullName()))
+		return protoreflect.ValueOfInt32(int32(0))
+	}
+	if fd := runtime.FieldOf(fd, md_ForeignMessage); fd != nil {
+		return x.NewField(fd)
 	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not contain field %s", fd.FullName()))
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TestAllTypes_NestedMessage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ForeignMessage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test3.TestAllTypes.NestedMessage", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test3.ForeignMessage", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10522,7 +14834,7 @@ func (x *fastReflection_TestAllTypes_NestedMessage) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TestAllTypes_NestedMessage) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ForeignMessage) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10533,7 +14845,7 @@ func (x *fastReflection_TestAllTypes_NestedMessage) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TestAllTypes_NestedMessage) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ForeignMessage) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10545,7 +14857,7 @@ func (x *fastReflection_TestAllTypes_NestedMessage) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TestAllTypes_NestedMessage) IsValid() bool {
+func (x *fastReflection_ForeignMessage) IsValid() bool {
 	return x != nil
 }
 
@@ -10555,664 +14867,1735 @@ func (x *fastReflection_TestAllTypes_NestedMessage) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TestAllTypes_NestedMessage) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TestAllTypes_NestedMessage)
-		if x == nil {
+func (x *fastReflection_ForeignMessage) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_ForeignMessage_methods
+}
+
+var _fastReflection_ForeignMessage_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_ForeignMessage_size,
+	Marshal:           _fastReflection_ForeignMessage_marshal,
+	Unmarshal:         _fastReflection_ForeignMessage_unmarshal,
+	Merge:             _fastReflection_ForeignMessage_merge,
+	CheckInitialized:  _fastReflection_ForeignMessage_checkInitialized,
+	Equal:             _fastReflection_ForeignMessage_equal,
+}
+
+func _fastReflection_ForeignMessage_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*ForeignMessage)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.C != 0 {
+		n += 1 + runtime.Sov(uint64(x.C))
+	}
+	if x.D != 0 {
+		n += 1 + runtime.Sov(uint64(x.D))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_ForeignMessage_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*ForeignMessage)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.D != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.D))
+		i--
+		dAtA[i] = 0x10
+	}
+	if x.C != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.C))
+		i--
+		dAtA[i] = 0x8
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_ForeignMessage_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*ForeignMessage)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_ForeignMessage.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForeignMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForeignMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+			}
+			x.C = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.C |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field D", wireType)
+			}
+			x.D = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.D |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_ForeignMessage, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_ForeignMessage_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*ForeignMessage)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*ForeignMessage)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.C != int32(0) {
+		dst.C = src.C
+	}
+	if src.D != int32(0) {
+		dst.D = src.D
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_ForeignMessage_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_ForeignMessage_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*ForeignMessage)
+	y, yok := input.MessageB.Interface().(*ForeignMessage)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.C != y.C {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.D != y.D {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *ForeignMessage) Clone() *ForeignMessage {
+	if x == nil {
+		return nil
+	}
+	dst := new(ForeignMessage)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *ForeignMessage) CopyInto(dst *ForeignMessage) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.C = x.C
+	dst.D = x.D
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *ForeignMessage) Equal(y *ForeignMessage) bool {
+	return _fastReflection_ForeignMessage_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a ForeignMessage, or nil if b is canonical.
+func (*ForeignMessage) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_ForeignMessage.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("c", protoreflect.Int32Kind, true)
+		case 2:
+			w.Scalar("d", protoreflect.Int32Kind, true)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestAllTypes) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestAllTypes) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.SingularInt32 != 0 {
+		b = append(b, "\"singularInt32\":"...)
+		b = strconv.AppendInt(b, int64(x.SingularInt32), 10)
+		b = append(b, ',')
+	}
+	if x.SingularInt64 != 0 {
+		b = append(b, "\"singularInt64\":"...)
+		b = runtime.AppendJSONInt64(b, x.SingularInt64)
+		b = append(b, ',')
+	}
+	if x.SingularUint32 != 0 {
+		b = append(b, "\"singularUint32\":"...)
+		b = strconv.AppendUint(b, uint64(x.SingularUint32), 10)
+		b = append(b, ',')
+	}
+	if x.SingularUint64 != 0 {
+		b = append(b, "\"singularUint64\":"...)
+		b = runtime.AppendJSONUint64(b, x.SingularUint64)
+		b = append(b, ',')
+	}
+	if x.SingularSint32 != 0 {
+		b = append(b, "\"singularSint32\":"...)
+		b = strconv.AppendInt(b, int64(x.SingularSint32), 10)
+		b = append(b, ',')
+	}
+	if x.SingularSint64 != 0 {
+		b = append(b, "\"singularSint64\":"...)
+		b = runtime.AppendJSONInt64(b, x.SingularSint64)
+		b = append(b, ',')
+	}
+	if x.SingularFixed32 != 0 {
+		b = append(b, "\"singularFixed32\":"...)
+		b = strconv.AppendUint(b, uint64(x.SingularFixed32), 10)
+		b = append(b, ',')
+	}
+	if x.SingularFixed64 != 0 {
+		b = append(b, "\"singularFixed64\":"...)
+		b = runtime.AppendJSONUint64(b, x.SingularFixed64)
+		b = append(b, ',')
+	}
+	if x.SingularSfixed32 != 0 {
+		b = append(b, "\"singularSfixed32\":"...)
+		b = strconv.AppendInt(b, int64(x.SingularSfixed32), 10)
+		b = append(b, ',')
+	}
+	if x.SingularSfixed64 != 0 {
+		b = append(b, "\"singularSfixed64\":"...)
+		b = runtime.AppendJSONInt64(b, x.SingularSfixed64)
+		b = append(b, ',')
+	}
+	if x.SingularFloat != 0 || math.Signbit(float64(x.SingularFloat)) {
+		b = append(b, "\"singularFloat\":"...)
+		b = runtime.AppendJSONFloat(b, float64(x.SingularFloat), 32)
+		b = append(b, ',')
+	}
+	if x.SingularDouble != 0 || math.Signbit(x.SingularDouble) {
+		b = append(b, "\"singularDouble\":"...)
+		b = runtime.AppendJSONFloat(b, x.SingularDouble, 64)
+		b = append(b, ',')
+	}
+	if x.SingularBool {
+		b = append(b, "\"singularBool\":"...)
+		b = strconv.AppendBool(b, x.SingularBool)
+		b = append(b, ',')
+	}
+	if x.SingularString != "" {
+		b = append(b, "\"singularString\":"...)
+		if b, err = runtime.AppendJSONString(b, x.SingularString, "goproto.proto.test3.TestAllTypes.singular_string"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.SingularBytes) > 0 {
+		b = append(b, "\"singularBytes\":"...)
+		b = runtime.AppendJSONBytes(b, x.SingularBytes)
+		b = append(b, ',')
+	}
+	if x.SingularNestedMessage != nil {
+		b = append(b, "\"singularNestedMessage\":"...)
+		if b, err = x.SingularNestedMessage.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.SingularForeignMessage != nil {
+		b = append(b, "\"singularForeignMessage\":"...)
+		if b, err = x.SingularForeignMessage.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.SingularImportMessage != nil {
+		b = append(b, "\"singularImportMessage\":"...)
+		if b, err = x.SingularImportMessage.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.SingularNestedEnum != 0 {
+		b = append(b, "\"singularNestedEnum\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(x.SingularNestedEnum), x.SingularNestedEnum.Descriptor())
+		b = append(b, ',')
+	}
+	if x.SingularForeignEnum != 0 {
+		b = append(b, "\"singularForeignEnum\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(x.SingularForeignEnum), x.SingularForeignEnum.Descriptor())
+		b = append(b, ',')
+	}
+	if x.SingularImportEnum != 0 {
+		b = append(b, "\"singularImportEnum\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(x.SingularImportEnum), x.SingularImportEnum.Descriptor())
+		b = append(b, ',')
+	}
+	if len(x.RepeatedInt32) > 0 {
+		b = append(b, "\"repeatedInt32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedInt32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedInt64) > 0 {
+		b = append(b, "\"repeatedInt64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedInt64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedUint32) > 0 {
+		b = append(b, "\"repeatedUint32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedUint32 {
+			b = strconv.AppendUint(b, uint64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedUint64) > 0 {
+		b = append(b, "\"repeatedUint64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedUint64 {
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSint32) > 0 {
+		b = append(b, "\"repeatedSint32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSint32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSint64) > 0 {
+		b = append(b, "\"repeatedSint64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSint64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFixed32) > 0 {
+		b = append(b, "\"repeatedFixed32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFixed32 {
+			b = strconv.AppendUint(b, uint64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFixed64) > 0 {
+		b = append(b, "\"repeatedFixed64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFixed64 {
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSfixed32) > 0 {
+		b = append(b, "\"repeatedSfixed32\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSfixed32 {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedSfixed64) > 0 {
+		b = append(b, "\"repeatedSfixed64\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedSfixed64 {
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedFloat) > 0 {
+		b = append(b, "\"repeatedFloat\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedFloat {
+			b = runtime.AppendJSONFloat(b, float64(v), 32)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedDouble) > 0 {
+		b = append(b, "\"repeatedDouble\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedDouble {
+			b = runtime.AppendJSONFloat(b, v, 64)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedBool) > 0 {
+		b = append(b, "\"repeatedBool\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedBool {
+			b = strconv.AppendBool(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedString) > 0 {
+		b = append(b, "\"repeatedString\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedString {
+			if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test3.TestAllTypes.repeated_string"); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedBytes) > 0 {
+		b = append(b, "\"repeatedBytes\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedBytes {
+			b = runtime.AppendJSONBytes(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedNestedMessage) > 0 {
+		b = append(b, "\"repeatedNestedMessage\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedNestedMessage {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedForeignMessage) > 0 {
+		b = append(b, "\"repeatedForeignMessage\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedForeignMessage {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedImportmessage) > 0 {
+		b = append(b, "\"repeatedImportmessage\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedImportmessage {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedNestedEnum) > 0 {
+		b = append(b, "\"repeatedNestedEnum\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedNestedEnum {
+			b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedForeignEnum) > 0 {
+		b = append(b, "\"repeatedForeignEnum\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedForeignEnum {
+			b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.RepeatedImportenum) > 0 {
+		b = append(b, "\"repeatedImportenum\":"...)
+		b = append(b, '[')
+		for _, v := range x.RepeatedImportenum {
+			b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.MapInt32Int32) > 0 {
+		b = append(b, "\"mapInt32Int32\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapInt32Int32)) {
+			v := x.MapInt32Int32[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapInt64Int64) > 0 {
+		b = append(b, "\"mapInt64Int64\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapInt64Int64)) {
+			v := x.MapInt64Int64[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapUint32Uint32) > 0 {
+		b = append(b, "\"mapUint32Uint32\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapUint32Uint32)) {
+			v := x.MapUint32Uint32[k]
+			b = runtime.AppendJSONUint64(b, uint64(k))
+			b = append(b, ':')
+			b = strconv.AppendUint(b, uint64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapUint64Uint64) > 0 {
+		b = append(b, "\"mapUint64Uint64\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapUint64Uint64)) {
+			v := x.MapUint64Uint64[k]
+			b = runtime.AppendJSONUint64(b, uint64(k))
+			b = append(b, ':')
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapSint32Sint32) > 0 {
+		b = append(b, "\"mapSint32Sint32\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapSint32Sint32)) {
+			v := x.MapSint32Sint32[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapSint64Sint64) > 0 {
+		b = append(b, "\"mapSint64Sint64\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapSint64Sint64)) {
+			v := x.MapSint64Sint64[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapFixed32Fixed32) > 0 {
+		b = append(b, "\"mapFixed32Fixed32\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapFixed32Fixed32)) {
+			v := x.MapFixed32Fixed32[k]
+			b = runtime.AppendJSONUint64(b, uint64(k))
+			b = append(b, ':')
+			b = strconv.AppendUint(b, uint64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapFixed64Fixed64) > 0 {
+		b = append(b, "\"mapFixed64Fixed64\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapFixed64Fixed64)) {
+			v := x.MapFixed64Fixed64[k]
+			b = runtime.AppendJSONUint64(b, uint64(k))
+			b = append(b, ':')
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapSfixed32Sfixed32) > 0 {
+		b = append(b, "\"mapSfixed32Sfixed32\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapSfixed32Sfixed32)) {
+			v := x.MapSfixed32Sfixed32[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapSfixed64Sfixed64) > 0 {
+		b = append(b, "\"mapSfixed64Sfixed64\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapSfixed64Sfixed64)) {
+			v := x.MapSfixed64Sfixed64[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = runtime.AppendJSONInt64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapInt32Float) > 0 {
+		b = append(b, "\"mapInt32Float\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapInt32Float)) {
+			v := x.MapInt32Float[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = runtime.AppendJSONFloat(b, float64(v), 32)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapInt32Double) > 0 {
+		b = append(b, "\"mapInt32Double\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapInt32Double)) {
+			v := x.MapInt32Double[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = runtime.AppendJSONFloat(b, v, 64)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapBoolBool) > 0 {
+		b = append(b, "\"mapBoolBool\":"...)
+		b = append(b, '{')
+		for _, k := range [...]bool{false, true} {
+			v, ok := x.MapBoolBool[k]
+			if !ok {
+				continue
+			}
+			b = append(b, '"')
+			b = strconv.AppendBool(b, k)
+			b = append(b, '"')
+			b = append(b, ':')
+			b = strconv.AppendBool(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringString) > 0 {
+		b = append(b, "\"mapStringString\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringString)) {
+			v := x.MapStringString[k]
+			if b, err = runtime.AppendJSONString(b, k, "goproto.proto.test3.TestAllTypes.MapStringStringEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test3.TestAllTypes.MapStringStringEntry.value"); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringBytes) > 0 {
+		b = append(b, "\"mapStringBytes\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringBytes)) {
+			v := x.MapStringBytes[k]
+			if b, err = runtime.AppendJSONString(b, k, "goproto.proto.test3.TestAllTypes.MapStringBytesEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			b = runtime.AppendJSONBytes(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringNestedMessage) > 0 {
+		b = append(b, "\"mapStringNestedMessage\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringNestedMessage)) {
+			v := x.MapStringNestedMessage[k]
+			if b, err = runtime.AppendJSONString(b, k, "goproto.proto.test3.TestAllTypes.MapStringNestedMessageEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.MapStringNestedEnum) > 0 {
+		b = append(b, "\"mapStringNestedEnum\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MapStringNestedEnum)) {
+			v := x.MapStringNestedEnum[k]
+			if b, err = runtime.AppendJSONString(b, k, "goproto.proto.test3.TestAllTypes.MapStringNestedEnumEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofUint32); ok {
+		b = append(b, "\"oneofUint32\":"...)
+		b = strconv.AppendUint(b, uint64(v.OneofUint32), 10)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); ok {
+		b = append(b, "\"oneofNestedMessage\":"...)
+		if b, err = v.OneofNestedMessage.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofString); ok {
+		b = append(b, "\"oneofString\":"...)
+		if b, err = runtime.AppendJSONString(b, v.OneofString, "goproto.proto.test3.TestAllTypes.oneof_string"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofBytes); ok {
+		b = append(b, "\"oneofBytes\":"...)
+		b = runtime.AppendJSONBytes(b, v.OneofBytes)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofBool); ok {
+		b = append(b, "\"oneofBool\":"...)
+		b = strconv.AppendBool(b, v.OneofBool)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofUint64); ok {
+		b = append(b, "\"oneofUint64\":"...)
+		b = runtime.AppendJSONUint64(b, v.OneofUint64)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofFloat); ok {
+		b = append(b, "\"oneofFloat\":"...)
+		b = runtime.AppendJSONFloat(b, float64(v.OneofFloat), 32)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofDouble); ok {
+		b = append(b, "\"oneofDouble\":"...)
+		b = runtime.AppendJSONFloat(b, v.OneofDouble, 64)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestAllTypes_OneofEnum); ok {
+		b = append(b, "\"oneofEnum\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v.OneofEnum), v.OneofEnum.Descriptor())
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestAllTypes) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestAllTypes) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 81:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.SingularInt32 = v
+		case 82:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.SingularInt64 = v
+		case 83:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.SingularUint32 = v
+		case 84:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.SingularUint64 = v
+		case 85:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.SingularSint32 = v
+		case 86:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.SingularSint64 = v
+		case 87:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.SingularFixed32 = v
+		case 88:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.SingularFixed64 = v
+		case 89:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.SingularSfixed32 = v
+		case 90:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.SingularSfixed64 = v
+		case 91:
+			v, err := d.Float32(fd)
+			if err != nil {
+				return err
+			}
+			x.SingularFloat = v
+		case 92:
+			v, err := d.Float64(fd)
+			if err != nil {
+				return err
+			}
+			x.SingularDouble = v
+		case 93:
+			v, err := d.Bool(fd)
+			if err != nil {
+				return err
+			}
+			x.SingularBool = v
+		case 94:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.SingularString = v
+		case 95:
+			v, err := d.Bytes(fd)
+			if err != nil {
+				return err
+			}
+			x.SingularBytes = v
+		case 98:
+			v := new(TestAllTypes_NestedMessage)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.SingularNestedMessage = v
+		case 99:
+			v := new(ForeignMessage)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.SingularForeignMessage = v
+		case 100:
+			v := new(ImportMessage)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.SingularImportMessage = v
+		case 101:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := TestAllTypes_NestedEnum(v)
+				x.SingularNestedEnum = e
+			}
+		case 102:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := ForeignEnum(v)
+				x.SingularForeignEnum = e
+			}
+		case 103:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := ImportEnum(v)
+				x.SingularImportEnum = e
+			}
+		case 31:
+			return d.List(func() error {
+				v, err := d.Int32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedInt32 = append(x.RepeatedInt32, v)
+				return nil
+			})
+		case 32:
+			return d.List(func() error {
+				v, err := d.Int64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedInt64 = append(x.RepeatedInt64, v)
+				return nil
+			})
+		case 33:
+			return d.List(func() error {
+				v, err := d.Uint32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedUint32 = append(x.RepeatedUint32, v)
+				return nil
+			})
+		case 34:
+			return d.List(func() error {
+				v, err := d.Uint64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedUint64 = append(x.RepeatedUint64, v)
+				return nil
+			})
+		case 35:
+			return d.List(func() error {
+				v, err := d.Int32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedSint32 = append(x.RepeatedSint32, v)
+				return nil
+			})
+		case 36:
+			return d.List(func() error {
+				v, err := d.Int64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedSint64 = append(x.RepeatedSint64, v)
+				return nil
+			})
+		case 37:
+			return d.List(func() error {
+				v, err := d.Uint32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedFixed32 = append(x.RepeatedFixed32, v)
+				return nil
+			})
+		case 38:
+			return d.List(func() error {
+				v, err := d.Uint64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedFixed64 = append(x.RepeatedFixed64, v)
+				return nil
+			})
+		case 39:
+			return d.List(func() error {
+				v, err := d.Int32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedSfixed32 = append(x.RepeatedSfixed32, v)
+				return nil
+			})
+		case 40:
+			return d.List(func() error {
+				v, err := d.Int64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedSfixed64 = append(x.RepeatedSfixed64, v)
+				return nil
+			})
+		case 41:
+			return d.List(func() error {
+				v, err := d.Float32(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedFloat = append(x.RepeatedFloat, v)
+				return nil
+			})
+		case 42:
+			return d.List(func() error {
+				v, err := d.Float64(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedDouble = append(x.RepeatedDouble, v)
+				return nil
+			})
+		case 43:
+			return d.List(func() error {
+				v, err := d.Bool(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedBool = append(x.RepeatedBool, v)
+				return nil
+			})
+		case 44:
+			return d.List(func() error {
+				v, err := d.String(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedString = append(x.RepeatedString, v)
+				return nil
+			})
+		case 45:
+			return d.List(func() error {
+				v, err := d.Bytes(fd)
+				if err != nil {
+					return err
+				}
+				x.RepeatedBytes = append(x.RepeatedBytes, v)
+				return nil
+			})
+		case 48:
+			return d.List(func() error {
+				v := new(TestAllTypes_NestedMessage)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.RepeatedNestedMessage = append(x.RepeatedNestedMessage, v)
+				return nil
+			})
+		case 49:
+			return d.List(func() error {
+				v := new(ForeignMessage)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.RepeatedForeignMessage = append(x.RepeatedForeignMessage, v)
+				return nil
+			})
+		case 50:
+			return d.List(func() error {
+				v := new(ImportMessage)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.RepeatedImportmessage = append(x.RepeatedImportmessage, v)
+				return nil
+			})
+		case 51:
+			return d.List(func() error {
+				v, ok, err := d.Enum(fd)
+				if err != nil {
+					return err
+				}
+				if ok {
+					e := TestAllTypes_NestedEnum(v)
+					x.RepeatedNestedEnum = append(x.RepeatedNestedEnum, e)
+				}
+				return nil
+			})
+		case 52:
+			return d.List(func() error {
+				v, ok, err := d.Enum(fd)
+				if err != nil {
+					return err
+				}
+				if ok {
+					e := ForeignEnum(v)
+					x.RepeatedForeignEnum = append(x.RepeatedForeignEnum, e)
+				}
+				return nil
+			})
+		case 53:
+			return d.List(func() error {
+				v, ok, err := d.Enum(fd)
+				if err != nil {
+					return err
+				}
+				if ok {
+					e := ImportEnum(v)
+					x.RepeatedImportenum = append(x.RepeatedImportenum, e)
+				}
+				return nil
+			})
+		case 56:
+			if x.MapInt32Int32 == nil {
+				x.MapInt32Int32 = make(map[int32]int32)
 			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.A != 0 {
-			n += 1 + runtime.Sov(uint64(x.A))
-		}
-		if x.Corecursive != nil {
-			l = options.Size(x.Corecursive)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TestAllTypes_NestedMessage)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.Corecursive != nil {
-			encoded, err := options.Marshal(x.Corecursive)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := int32(k.Int())
+				if _, ok := x.MapInt32Int32[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.Int32(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.MapInt32Int32[key] = v
+				return nil
+			})
+		case 57:
+			if x.MapInt64Int64 == nil {
+				x.MapInt64Int64 = make(map[int64]int64)
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.A != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.A))
-			i--
-			dAtA[i] = 0x8
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TestAllTypes_NestedMessage)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.Int()
+				if _, ok := x.MapInt64Int64[key]; ok {
+					return d.DuplicateMapKey()
 				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				v, err := d.Int64(fd.MapValue())
+				if err != nil {
+					return err
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.MapInt64Int64[key] = v
+				return nil
+			})
+		case 58:
+			if x.MapUint32Uint32 == nil {
+				x.MapUint32Uint32 = make(map[uint32]uint32)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := uint32(k.Uint())
+				if _, ok := x.MapUint32Uint32[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.Uint32(fd.MapValue())
+				if err != nil {
+					return err
 				}
+				x.MapUint32Uint32[key] = v
+				return nil
+			})
+		case 59:
+			if x.MapUint64Uint64 == nil {
+				x.MapUint64Uint64 = make(map[uint64]uint64)
 			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes_NestedMessage: wiretype end group for non-group")
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.Uint()
+				if _, ok := x.MapUint64Uint64[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.Uint64(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.MapUint64Uint64[key] = v
+				return nil
+			})
+		case 60:
+			if x.MapSint32Sint32 == nil {
+				x.MapSint32Sint32 = make(map[int32]int32)
 			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestAllTypes_NestedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := int32(k.Int())
+				if _, ok := x.MapSint32Sint32[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.Int32(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.MapSint32Sint32[key] = v
+				return nil
+			})
+		case 61:
+			if x.MapSint64Sint64 == nil {
+				x.MapSint64Sint64 = make(map[int64]int64)
 			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.Int()
+				if _, ok := x.MapSint64Sint64[key]; ok {
+					return d.DuplicateMapKey()
 				}
-				x.A = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.A |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				v, err := d.Int64(fd.MapValue())
+				if err != nil {
+					return err
 				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corecursive", wireType)
+				x.MapSint64Sint64[key] = v
+				return nil
+			})
+		case 62:
+			if x.MapFixed32Fixed32 == nil {
+				x.MapFixed32Fixed32 = make(map[uint32]uint32)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := uint32(k.Uint())
+				if _, ok := x.MapFixed32Fixed32[key]; ok {
+					return d.DuplicateMapKey()
 				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				v, err := d.Uint32(fd.MapValue())
+				if err != nil {
+					return err
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.MapFixed32Fixed32[key] = v
+				return nil
+			})
+		case 63:
+			if x.MapFixed64Fixed64 == nil {
+				x.MapFixed64Fixed64 = make(map[uint64]uint64)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.Uint()
+				if _, ok := x.MapFixed64Fixed64[key]; ok {
+					return d.DuplicateMapKey()
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				v, err := d.Uint64(fd.MapValue())
+				if err != nil {
+					return err
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.MapFixed64Fixed64[key] = v
+				return nil
+			})
+		case 64:
+			if x.MapSfixed32Sfixed32 == nil {
+				x.MapSfixed32Sfixed32 = make(map[int32]int32)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := int32(k.Int())
+				if _, ok := x.MapSfixed32Sfixed32[key]; ok {
+					return d.DuplicateMapKey()
 				}
-				if x.Corecursive == nil {
-					x.Corecursive = &TestAllTypes{}
+				v, err := d.Int32(fd.MapValue())
+				if err != nil {
+					return err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Corecursive); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.MapSfixed32Sfixed32[key] = v
+				return nil
+			})
+		case 65:
+			if x.MapSfixed64Sfixed64 == nil {
+				x.MapSfixed64Sfixed64 = make(map[int64]int64)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.Int()
+				if _, ok := x.MapSfixed64Sfixed64[key]; ok {
+					return d.DuplicateMapKey()
 				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				v, err := d.Int64(fd.MapValue())
 				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					return err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.MapSfixed64Sfixed64[key] = v
+				return nil
+			})
+		case 66:
+			if x.MapInt32Float == nil {
+				x.MapInt32Float = make(map[int32]float32)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := int32(k.Int())
+				if _, ok := x.MapInt32Float[key]; ok {
+					return d.DuplicateMapKey()
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				v, err := d.Float32(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.MapInt32Float[key] = v
+				return nil
+			})
+		case 67:
+			if x.MapInt32Double == nil {
+				x.MapInt32Double = make(map[int32]float64)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := int32(k.Int())
+				if _, ok := x.MapInt32Double[key]; ok {
+					return d.DuplicateMapKey()
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				v, err := d.Float64(fd.MapValue())
+				if err != nil {
+					return err
 				}
-				iNdEx += skippy
+				x.MapInt32Double[key] = v
+				return nil
+			})
+		case 68:
+			if x.MapBoolBool == nil {
+				x.MapBoolBool = make(map[bool]bool)
 			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_ForeignMessage   protoreflect.MessageDescriptor
-	fd_ForeignMessage_c protoreflect.FieldDescriptor
-	fd_ForeignMessage_d protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_internal_testprotos_test3_test_proto_init()
-	md_ForeignMessage = File_internal_testprotos_test3_test_proto.Messages().ByName("ForeignMessage")
-	fd_ForeignMessage_c = md_ForeignMessage.Fields().ByName("c")
-	fd_ForeignMessage_d = md_ForeignMessage.Fields().ByName("d")
-}
-
-var _ protoreflect.Message = (*fastReflection_ForeignMessage)(nil)
-
-type fastReflection_ForeignMessage ForeignMessage
-
-func (x *ForeignMessage) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ForeignMessage)(x)
-}
-
-func (x *ForeignMessage) slowProtoReflect() protoreflect.Message {
-	mi := &file_internal_testprotos_test3_test_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_ForeignMessage_messageType fastReflection_ForeignMessage_messageType
-var _ protoreflect.MessageType = fastReflection_ForeignMessage_messageType{}
-
-type fastReflection_ForeignMessage_messageType struct{}
-
-func (x fastReflection_ForeignMessage_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ForeignMessage)(nil)
-}
-func (x fastReflection_ForeignMessage_messageType) New() protoreflect.Message {
-	return new(fastReflection_ForeignMessage)
-}
-func (x fastReflection_ForeignMessage_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ForeignMessage
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_ForeignMessage) Descriptor() protoreflect.MessageDescriptor {
-	return md_ForeignMessage
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ForeignMessage) Type() protoreflect.MessageType {
-	return _fastReflection_ForeignMessage_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ForeignMessage) New() protoreflect.Message {
-	return new(fastReflection_ForeignMessage)
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.Bool()
+				if _, ok := x.MapBoolBool[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.Bool(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.MapBoolBool[key] = v
+				return nil
+			})
+		case 69:
+			if x.MapStringString == nil {
+				x.MapStringString = make(map[string]string)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.MapStringString[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.String(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.MapStringString[key] = v
+				return nil
+			})
+		case 70:
+			if x.MapStringBytes == nil {
+				x.MapStringBytes = make(map[string][]byte)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.MapStringBytes[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.Bytes(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.MapStringBytes[key] = v
+				return nil
+			})
+		case 71:
+			if x.MapStringNestedMessage == nil {
+				x.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.MapStringNestedMessage[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v := new(TestAllTypes_NestedMessage)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.MapStringNestedMessage[key] = v
+				return nil
+			})
+		case 73:
+			if x.MapStringNestedEnum == nil {
+				x.MapStringNestedEnum = make(map[string]TestAllTypes_NestedEnum)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.MapStringNestedEnum[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, ok, err := d.Enum(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				if ok {
+					e := TestAllTypes_NestedEnum(v)
+					x.MapStringNestedEnum[key] = e
+				}
+				return nil
+			})
+		case 111:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofUint32{OneofUint32: v}
+		case 112:
+			v := new(TestAllTypes_NestedMessage)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofNestedMessage{OneofNestedMessage: v}
+		case 113:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofString{OneofString: v}
+		case 114:
+			v, err := d.Bytes(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofBytes{OneofBytes: v}
+		case 115:
+			v, err := d.Bool(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofBool{OneofBool: v}
+		case 116:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofUint64{OneofUint64: v}
+		case 117:
+			v, err := d.Float32(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofFloat{OneofFloat: v}
+		case 118:
+			v, err := d.Float64(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestAllTypes_OneofDouble{OneofDouble: v}
+		case 119:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := TestAllTypes_NestedEnum(v)
+				x.OneofField = &TestAllTypes_OneofEnum{OneofEnum: e}
+			}
+		}
+		return nil
+	})
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_ForeignMessage) Interface() protoreflect.ProtoMessage {
-	return (*ForeignMessage)(x)
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestAllTypes_NestedMessage) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_ForeignMessage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.C != int32(0) {
-		value := protoreflect.ValueOfInt32(x.C)
-		if !f(fd_ForeignMessage_c, value) {
-			return
-		}
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestAllTypes_NestedMessage) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
 	}
-	if x.D != int32(0) {
-		value := protoreflect.ValueOfInt32(x.D)
-		if !f(fd_ForeignMessage_d, value) {
-			return
-		}
+	var err error
+	b = append(b, '{')
+	if x.A != 0 {
+		b = append(b, "\"a\":"...)
+		b = strconv.AppendInt(b, int64(x.A), 10)
+		b = append(b, ',')
 	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_ForeignMessage) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "goproto.proto.test3.ForeignMessage.c":
-		return x.C != int32(0)
-	case "goproto.proto.test3.ForeignMessage.d":
-		return x.D != int32(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.ForeignMessage"))
+	if x.Corecursive != nil {
+		b = append(b, "\"corecursive\":"...)
+		if b, err = x.Corecursive.AppendJSON(b); err != nil {
+			return b, err
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not contain field %s", fd.FullName()))
+		b = append(b, ',')
 	}
-}
-
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ForeignMessage) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "goproto.proto.test3.ForeignMessage.c":
-		x.C = int32(0)
-	case "goproto.proto.test3.ForeignMessage.d":
-		x.D = int32(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.ForeignMessage"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not contain field %s", fd.FullName()))
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
 	}
+	return b, nil
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ForeignMessage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "goproto.proto.test3.ForeignMessage.c":
-		value := x.C
-		return protoreflect.ValueOfInt32(value)
-	case "goproto.proto.test3.ForeignMessage.d":
-		value := x.D
-		return protoreflect.ValueOfInt32(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.ForeignMessage"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not contain field %s", descriptor.FullName()))
-	}
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestAllTypes_NestedMessage) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ForeignMessage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "goproto.proto.test3.ForeignMessage.c":
-		x.C = int32(value.Int())
-	case "goproto.proto.test3.ForeignMessage.d":
-		x.D = int32(value.Int())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.ForeignMessage"))
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestAllTypes_NestedMessage) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.A = v
+		case 2:
+			v := new(TestAllTypes)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Corecursive = v
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not contain field %s", fd.FullName()))
-	}
+		return nil
+	})
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ForeignMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "goproto.proto.test3.ForeignMessage.c":
-		panic(fmt.Errorf("field c of message goproto.proto.test3.ForeignMessage is not mutable"))
-	case "goproto.proto.test3.ForeignMessage.d":
-		panic(fmt.Errorf("field d of message goproto.proto.test3.ForeignMessage is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.ForeignMessage"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not contain field %s", fd.FullName()))
-	}
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *ForeignMessage) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ForeignMessage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "goproto.proto.test3.ForeignMessage.c":
-		return protoreflect.ValueOfInt32(int32(0))
-	case "goproto.proto.test3.ForeignMessage.d":
-		return protoreflect.ValueOfInt32(int32(0))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.ForeignMessage"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.ForeignMessage does not contain field %s", fd.FullName()))
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *ForeignMessage) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
 	}
-}
-
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ForeignMessage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test3.ForeignMessage", d.FullName()))
+	b = append(b, '{')
+	if x.C != 0 {
+		b = append(b, "\"c\":"...)
+		b = strconv.AppendInt(b, int64(x.C), 10)
+		b = append(b, ',')
 	}
-	panic("unreachable")
-}
-
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ForeignMessage) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
-}
-
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ForeignMessage) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+	if x.D != 0 {
+		b = append(b, "\"d\":"...)
+		b = strconv.AppendInt(b, int64(x.D), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_ForeignMessage) IsValid() bool {
-	return x != nil
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *ForeignMessage) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_ForeignMessage) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ForeignMessage)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.C != 0 {
-			n += 1 + runtime.Sov(uint64(x.C))
-		}
-		if x.D != 0 {
-			n += 1 + runtime.Sov(uint64(x.D))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ForeignMessage)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.D != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.D))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.C != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.C))
-			i--
-			dAtA[i] = 0x8
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ForeignMessage)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForeignMessage: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForeignMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *ForeignMessage) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
 			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
-				}
-				x.C = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.C |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field D", wireType)
-				}
-				x.D = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.D |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
+			x.C = v
+		case 2:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
 			}
+			x.D = v
 		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
+		return nil
+	})
 }
 
 // Copyright 2018 The Go Authors. All rights reserved.
@@ -11341,6 +16724,7 @@ type TestAllTypes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+	zeroCopied    bool
 
 	SingularInt32          int32                                  `protobuf:"varint,81,opt,name=singular_int32,json=singularInt32,proto3" json:"singular_int32,omitempty"`
 	SingularInt64          int64                                  `protobuf:"varint,82,opt,name=singular_int64,json=singularInt64,proto3" json:"singular_int64,omitempty"`
@@ -12556,6 +17940,8 @@ func file_internal_testprotos_test3_test_proto_init() {
 				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
+			case 3:
+				return &v.zeroCopied
 			default:
 				return nil
 			}
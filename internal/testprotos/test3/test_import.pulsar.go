@@ -2,16 +2,39 @@
 package test3
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protojson "google.golang.org/protobuf/encoding/protojson"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
+	math "math"
 	reflect "reflect"
 	sync "sync"
+	atomic "sync/atomic"
 )
 
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *ImportMessage) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *ImportMessage) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
 var (
 	md_ImportMessage protoreflect.MessageDescriptor
 )
@@ -100,13 +123,15 @@ func (x *fastReflection_ImportMessage) Range(f func(protoreflect.FieldDescriptor
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_ImportMessage) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.ImportMessage"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not contain field %s", fd.FullName()))
+	switch fd.Number() {
+	}
+	if fd := runtime.FieldOf(fd, md_ImportMessage); fd != nil {
+		return x.Has(fd)
 	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not contain field %s", fd.FullName()))
 }
 
 // Clear clears the field such that a subsequent Has call reports false.
@@ -116,13 +141,16 @@ func (x *fastReflection_ImportMessage) Has(fd protoreflect.FieldDescriptor) bool
 //
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_ImportMessage) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.ImportMessage"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not contain field %s", fd.FullName()))
+	switch fd.Number() {
+	}
+	if fd := runtime.FieldOf(fd, md_ImportMessage); fd != nil {
+		x.Clear(fd)
+		return
 	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not contain field %s", fd.FullName()))
 }
 
 // Get retrieves the value for a field.
@@ -132,13 +160,15 @@ func (x *fastReflection_ImportMessage) Clear(fd protoreflect.FieldDescriptor) {
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_ImportMessage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.ImportMessage"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not contain field %s", descriptor.FullName()))
+	switch descriptor.Number() {
+	}
+	if fd := runtime.FieldOf(descriptor, md_ImportMessage); fd != nil {
+		return x.Get(fd)
 	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not contain field %s", descriptor.FullName()))
 }
 
 // Set stores the value for a field.
@@ -152,13 +182,16 @@ func (x *fastReflection_ImportMessage) Get(descriptor protoreflect.FieldDescript
 //
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_ImportMessage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.ImportMessage"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not contain field %s", fd.FullName()))
+	switch fd.Number() {
 	}
+	if fd := runtime.FieldOf(fd, md_ImportMessage); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not contain field %s", fd.FullName()))
 }
 
 // Mutable returns a mutable reference to a composite type.
@@ -172,26 +205,30 @@ func (x *fastReflection_ImportMessage) Set(fd protoreflect.FieldDescriptor, valu
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_ImportMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.ImportMessage"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not contain field %s", fd.FullName()))
+	switch fd.Number() {
+	}
+	if fd := runtime.FieldOf(fd, md_ImportMessage); fd != nil {
+		return x.Mutable(fd)
 	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not contain field %s", fd.FullName()))
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_ImportMessage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.ImportMessage"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not contain field %s", fd.FullName()))
+	switch fd.Number() {
+	}
+	if fd := runtime.FieldOf(fd, md_ImportMessage); fd != nil {
+		return x.NewField(fd)
 	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.ImportMessage does not contain field %s", fd.FullName()))
 }
 
 // WhichOneof reports which field within the oneof is populated,
@@ -242,130 +279,261 @@ func (x *fastReflection_ImportMessage) IsValid() bool {
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
 func (x *fastReflection_ImportMessage) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ImportMessage)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
+	return _fastReflection_ImportMessage_methods
+}
+
+var _fastReflection_ImportMessage_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_ImportMessage_size,
+	Marshal:           _fastReflection_ImportMessage_marshal,
+	Unmarshal:         _fastReflection_ImportMessage_unmarshal,
+	Merge:             _fastReflection_ImportMessage_merge,
+	CheckInitialized:  _fastReflection_ImportMessage_checkInitialized,
+	Equal:             _fastReflection_ImportMessage_equal,
+}
+
+func _fastReflection_ImportMessage_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*ImportMessage)
+	if x == nil {
 		return protoiface.SizeOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+			Size:              0,
 		}
 	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ImportMessage)
-		if x == nil {
-			return protoiface.MarshalOutput{
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+				Size:              int(size - 1),
+			}
 		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_ImportMessage_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*ImportMessage)
+	if x == nil {
 		return protoiface.MarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 			Buf:               input.Buf,
 		}, nil
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ImportMessage)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_ImportMessage_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*ImportMessage)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_ImportMessage.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ImportMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ImportMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_ImportMessage, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ImportMessage: wiretype end group for non-group")
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ImportMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 			}
-			switch fieldNum {
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
 		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_ImportMessage_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*ImportMessage)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*ImportMessage)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_ImportMessage_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+func _fastReflection_ImportMessage_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*ImportMessage)
+	y, yok := input.MessageB.Interface().(*ImportMessage)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *ImportMessage) Clone() *ImportMessage {
+	if x == nil {
+		return nil
+	}
+	dst := new(ImportMessage)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *ImportMessage) CopyInto(dst *ImportMessage) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *ImportMessage) Equal(y *ImportMessage) bool {
+	return _fastReflection_ImportMessage_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a ImportMessage, or nil if b is canonical.
+func (*ImportMessage) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_ImportMessage.FullName())
+	for w.Next() {
+		switch w.Number() {
+		default:
+			w.Unknown()
 		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return w.Err()
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *ImportMessage) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *ImportMessage) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
 	}
+	b = append(b, '{')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *ImportMessage) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *ImportMessage) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		return nil
+	})
 }
 
 // Copyright 2018 The Go Authors. All rights reserved.
@@ -0,0 +1,1009 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package test3
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	math "math"
+	reflect "reflect"
+	sync "sync"
+	atomic "sync/atomic"
+	unsafe "unsafe"
+)
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestLazyNesting) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestLazyNesting) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Eager != nil {
+		b = append(b, "\"eager\":"...)
+		if b, err = x.Eager.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	x.lazyDecodeLazy()
+	if x.Lazy != nil {
+		b = append(b, "\"lazy\":"...)
+		if b, err = x.Lazy.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+var (
+	md_TestLazyNesting       protoreflect.MessageDescriptor
+	fd_TestLazyNesting_lazy  protoreflect.FieldDescriptor
+	fd_TestLazyNesting_eager protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test3_test_lazy_proto_init()
+	md_TestLazyNesting = File_internal_testprotos_test3_test_lazy_proto.Messages().ByName("TestLazyNesting")
+	fd_TestLazyNesting_lazy = md_TestLazyNesting.Fields().ByName("lazy")
+	fd_TestLazyNesting_eager = md_TestLazyNesting.Fields().ByName("eager")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestLazyNesting)(nil)
+
+type fastReflection_TestLazyNesting TestLazyNesting
+
+func (x *TestLazyNesting) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestLazyNesting)(x)
+}
+
+func (x *TestLazyNesting) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test3_test_lazy_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestLazyNesting_messageType fastReflection_TestLazyNesting_messageType
+var _ protoreflect.MessageType = fastReflection_TestLazyNesting_messageType{}
+
+type fastReflection_TestLazyNesting_messageType struct{}
+
+func (x fastReflection_TestLazyNesting_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestLazyNesting)(nil)
+}
+func (x fastReflection_TestLazyNesting_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestLazyNesting)
+}
+func (x fastReflection_TestLazyNesting_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestLazyNesting
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestLazyNesting) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestLazyNesting
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestLazyNesting) Type() protoreflect.MessageType {
+	return _fastReflection_TestLazyNesting_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestLazyNesting) New() protoreflect.Message {
+	return new(fastReflection_TestLazyNesting)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestLazyNesting) Interface() protoreflect.ProtoMessage {
+	return (*TestLazyNesting)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestLazyNesting) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	(*TestLazyNesting)(x).lazyDecodeLazy()
+	if x.Lazy != nil {
+		value := protoreflect.ValueOfMessage(x.Lazy.ProtoReflect())
+		if !f(fd_TestLazyNesting_lazy, value) {
+			return
+		}
+	}
+	if x.Eager != nil {
+		value := protoreflect.ValueOfMessage(x.Eager.ProtoReflect())
+		if !f(fd_TestLazyNesting_eager, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestLazyNesting) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.TestLazyNesting.lazy
+		if fd != fd_TestLazyNesting_lazy {
+			break
+		}
+		if x.lazyFields.Pending(0) {
+			return true
+		}
+		return x.Lazy != nil
+	case 2: // goproto.proto.test3.TestLazyNesting.eager
+		if fd != fd_TestLazyNesting_eager {
+			break
+		}
+		return x.Eager != nil
+	}
+	if fd := runtime.FieldOf(fd, md_TestLazyNesting); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestLazyNesting does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestLazyNesting does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestLazyNesting) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.TestLazyNesting.lazy
+		if fd != fd_TestLazyNesting_lazy {
+			break
+		}
+		x.lazyFields.Discard(0)
+		x.Lazy = nil
+		return
+	case 2: // goproto.proto.test3.TestLazyNesting.eager
+		if fd != fd_TestLazyNesting_eager {
+			break
+		}
+		x.Eager = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestLazyNesting); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestLazyNesting does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestLazyNesting does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestLazyNesting) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test3.TestLazyNesting.lazy
+		if descriptor != fd_TestLazyNesting_lazy {
+			break
+		}
+		(*TestLazyNesting)(x).lazyDecodeLazy()
+		value := x.Lazy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 2: // goproto.proto.test3.TestLazyNesting.eager
+		if descriptor != fd_TestLazyNesting_eager {
+			break
+		}
+		value := x.Eager
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestLazyNesting); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestLazyNesting does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestLazyNesting does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestLazyNesting) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.TestLazyNesting.lazy
+		if fd != fd_TestLazyNesting_lazy {
+			break
+		}
+		x.lazyFields.Discard(0)
+		x.Lazy = value.Message().Interface().(*TestLazyNesting)
+		return
+	case 2: // goproto.proto.test3.TestLazyNesting.eager
+		if fd != fd_TestLazyNesting_eager {
+			break
+		}
+		x.Eager = value.Message().Interface().(*TestLazyNesting)
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestLazyNesting); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestLazyNesting does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestLazyNesting does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestLazyNesting) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.TestLazyNesting.lazy
+		if fd != fd_TestLazyNesting_lazy {
+			break
+		}
+		(*TestLazyNesting)(x).lazyDecodeLazy()
+		x.lazyFields.Discard(0)
+		if x.Lazy == nil {
+			x.Lazy = new(TestLazyNesting)
+		}
+		return protoreflect.ValueOfMessage(x.Lazy.ProtoReflect())
+	case 2: // goproto.proto.test3.TestLazyNesting.eager
+		if fd != fd_TestLazyNesting_eager {
+			break
+		}
+		if x.Eager == nil {
+			x.Eager = new(TestLazyNesting)
+		}
+		return protoreflect.ValueOfMessage(x.Eager.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(fd, md_TestLazyNesting); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestLazyNesting does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestLazyNesting does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestLazyNesting) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.TestLazyNesting.lazy
+		if fd != fd_TestLazyNesting_lazy {
+			break
+		}
+		m := new(TestLazyNesting)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 2: // goproto.proto.test3.TestLazyNesting.eager
+		if fd != fd_TestLazyNesting_eager {
+			break
+		}
+		m := new(TestLazyNesting)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(fd, md_TestLazyNesting); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestLazyNesting does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestLazyNesting does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestLazyNesting) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test3.TestLazyNesting", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestLazyNesting) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestLazyNesting) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestLazyNesting) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestLazyNesting) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestLazyNesting_methods
+}
+
+var _fastReflection_TestLazyNesting_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestLazyNesting_size,
+	Marshal:           _fastReflection_TestLazyNesting_marshal,
+	Unmarshal:         _fastReflection_TestLazyNesting_unmarshal,
+	Merge:             _fastReflection_TestLazyNesting_merge,
+	CheckInitialized:  _fastReflection_TestLazyNesting_checkInitialized,
+	Equal:             _fastReflection_TestLazyNesting_equal,
+}
+
+func _fastReflection_TestLazyNesting_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestLazyNesting)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if raw := runtime.RawLazy(&x.lazyFields, 0, &x.Lazy); raw != nil {
+		n += len(raw)
+	} else {
+		if x.Lazy != nil {
+			l = options.Size(x.Lazy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if x.Eager != nil {
+		l = options.Size(x.Eager)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestLazyNesting_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestLazyNesting)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	x.lazyFields.StartMarshal()
+	defer x.lazyFields.EndMarshal()
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.Eager != nil {
+		l = options.Size(x.Eager)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Eager); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x12
+	}
+	if raw := runtime.RawLazy(&x.lazyFields, 0, &x.Lazy); raw != nil {
+		i -= len(raw)
+		copy(dAtA[i:], raw)
+	} else {
+		if x.Lazy != nil {
+			l = options.Size(x.Lazy)
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.Lazy); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestLazyNesting_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestLazyNesting)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestLazyNesting.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestLazyNesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestLazyNesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Lazy == nil && x.lazyFields.Append(0, 1, dAtA[preIndex:postIndex], fd_TestLazyNesting_lazy.Message(), nil, options) {
+				iNdEx = postIndex
+				break
+			}
+			x.lazyDecodeLazy()
+			x.lazyFields.Discard(0)
+			if x.Lazy == nil {
+				if err := budget.Message(fd_TestLazyNesting_lazy, int(unsafe.Sizeof(TestLazyNesting{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Lazy = &TestLazyNesting{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Lazy); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestLazyNesting_lazy, -1)
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eager", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Eager == nil {
+				if err := budget.Message(fd_TestLazyNesting_eager, int(unsafe.Sizeof(TestLazyNesting{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Eager = &TestLazyNesting{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Eager); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestLazyNesting_eager, -1)
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestLazyNesting, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_TestLazyNesting_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestLazyNesting)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestLazyNesting)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	src.lazyDecodeLazy()
+	dst.lazyDecodeLazy()
+	dst.lazyFields.Discard(0)
+	if src.Lazy != nil {
+		if dst.Lazy == nil {
+			dst.Lazy = &TestLazyNesting{}
+		}
+		proto.Merge(dst.Lazy, src.Lazy)
+	}
+	if src.Eager != nil {
+		if dst.Eager == nil {
+			dst.Eager = &TestLazyNesting{}
+		}
+		proto.Merge(dst.Eager, src.Eager)
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestLazyNesting_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestLazyNesting_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestLazyNesting)
+	y, yok := input.MessageB.Interface().(*TestLazyNesting)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	x.lazyDecodeLazy()
+	y.lazyDecodeLazy()
+	if !x.Lazy.Equal(y.Lazy) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.Eager.Equal(y.Eager) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestLazyNesting) Clone() *TestLazyNesting {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestLazyNesting)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestLazyNesting) CopyInto(dst *TestLazyNesting) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	x.lazyDecodeLazy()
+	dst.lazyFields.Discard(0)
+	dst.Lazy = x.Lazy.Clone()
+	dst.Eager = x.Eager.Clone()
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestLazyNesting) Equal(y *TestLazyNesting) bool {
+	return _fastReflection_TestLazyNesting_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestLazyNesting, or nil if b is canonical.
+func (*TestLazyNesting) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestLazyNesting.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Message("lazy", false, (*TestLazyNesting)(nil))
+		case 2:
+			w.Message("eager", false, (*TestLazyNesting)(nil))
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestLazyNesting) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestLazyNesting) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	x.lazyDecodeLazy()
+	if x.Lazy != nil {
+		b = append(b, "\"lazy\":"...)
+		if b, err = x.Lazy.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Eager != nil {
+		b = append(b, "\"eager\":"...)
+		if b, err = x.Eager.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestLazyNesting) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestLazyNesting) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v := new(TestLazyNesting)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Lazy = v
+		case 2:
+			v := new(TestLazyNesting)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Eager = v
+		}
+		return nil
+	})
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.18.1
+// source: internal/testprotos/test3/test_lazy.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TestLazyNesting nests itself through a lazy field and an eager one,
+// so that recursion limits can be checked across lazy fields.
+type TestLazyNesting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+	lazyFields    runtime.LazyFields
+
+	Lazy  *TestLazyNesting `protobuf:"bytes,1,opt,name=lazy,proto3" json:"lazy,omitempty"`
+	Eager *TestLazyNesting `protobuf:"bytes,2,opt,name=eager,proto3" json:"eager,omitempty"`
+}
+
+func (x *TestLazyNesting) Reset() {
+	*x = TestLazyNesting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test3_test_lazy_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestLazyNesting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestLazyNesting) ProtoMessage() {}
+
+// Deprecated: Use TestLazyNesting.ProtoReflect.Descriptor instead.
+func (*TestLazyNesting) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test3_test_lazy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TestLazyNesting) GetLazy() *TestLazyNesting {
+	if x != nil {
+		x.lazyDecodeLazy()
+		return x.Lazy
+	}
+	return nil
+}
+
+func (x *TestLazyNesting) GetEager() *TestLazyNesting {
+	if x != nil {
+		return x.Eager
+	}
+	return nil
+}
+
+func (x *TestLazyNesting) lazyDecodeLazy() {
+	runtime.DecodeLazy(&x.lazyFields, 0, &x.Lazy)
+}
+
+var File_internal_testprotos_test3_test_lazy_proto protoreflect.FileDescriptor
+
+var file_internal_testprotos_test3_test_lazy_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x33, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x5f, 0x6c, 0x61, 0x7a, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x33,
+	0x22, 0x8b, 0x01, 0x0a, 0x0f, 0x54, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x7a, 0x79, 0x4e, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x04, 0x6c, 0x61, 0x7a, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x33, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x7a,
+	0x79, 0x4e, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x02, 0x28, 0x01, 0x52, 0x04, 0x6c, 0x61,
+	0x7a, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x65, 0x61, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x33, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x7a, 0x79,
+	0x4e, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x65, 0x61, 0x67, 0x65, 0x72, 0x42, 0x3a,
+	0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_internal_testprotos_test3_test_lazy_proto_rawDescOnce sync.Once
+	file_internal_testprotos_test3_test_lazy_proto_rawDescData = file_internal_testprotos_test3_test_lazy_proto_rawDesc
+)
+
+func file_internal_testprotos_test3_test_lazy_proto_rawDescGZIP() []byte {
+	file_internal_testprotos_test3_test_lazy_proto_rawDescOnce.Do(func() {
+		file_internal_testprotos_test3_test_lazy_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_testprotos_test3_test_lazy_proto_rawDescData)
+	})
+	return file_internal_testprotos_test3_test_lazy_proto_rawDescData
+}
+
+var file_internal_testprotos_test3_test_lazy_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_internal_testprotos_test3_test_lazy_proto_goTypes = []interface{}{
+	(*TestLazyNesting)(nil), // 0: goproto.proto.test3.TestLazyNesting
+}
+var file_internal_testprotos_test3_test_lazy_proto_depIdxs = []int32{
+	0, // 0: goproto.proto.test3.TestLazyNesting.lazy:type_name -> goproto.proto.test3.TestLazyNesting
+	0, // 1: goproto.proto.test3.TestLazyNesting.eager:type_name -> goproto.proto.test3.TestLazyNesting
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_internal_testprotos_test3_test_lazy_proto_init() }
+func file_internal_testprotos_test3_test_lazy_proto_init() {
+	if File_internal_testprotos_test3_test_lazy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_testprotos_test3_test_lazy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestLazyNesting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.lazyFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_internal_testprotos_test3_test_lazy_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_testprotos_test3_test_lazy_proto_goTypes,
+		DependencyIndexes: file_internal_testprotos_test3_test_lazy_proto_depIdxs,
+		MessageInfos:      file_internal_testprotos_test3_test_lazy_proto_msgTypes,
+	}.Build()
+	File_internal_testprotos_test3_test_lazy_proto = out.File
+	file_internal_testprotos_test3_test_lazy_proto_rawDesc = nil
+	file_internal_testprotos_test3_test_lazy_proto_goTypes = nil
+	file_internal_testprotos_test3_test_lazy_proto_depIdxs = nil
+}
@@ -2,16 +2,126 @@
 package test3
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	proto "google.golang.org/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
+	math "math"
 	reflect "reflect"
+	strconv "strconv"
 	sync "sync"
+	atomic "sync/atomic"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
 )
 
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *MultiLayeredNesting) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *MultiLayeredNesting) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Nested1 != nil {
+		b = append(b, "\"nested1\":"...)
+		if b, err = x.Nested1.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *MultiLayeredNesting_Nested1) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *MultiLayeredNesting_Nested1) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *MultiLayeredNesting_Nested1_Nested2) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *MultiLayeredNesting_Nested1_Nested2) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Nested_3 != nil {
+		b = append(b, "\"nested_3\":"...)
+		if b, err = x.Nested_3.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *MultiLayeredNesting_Nested1_Nested2_Nested3) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *MultiLayeredNesting_Nested1_Nested2_Nested3) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if v, ok := x.Nested3Oneof.(*MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32); ok {
+		b = append(b, "\"nested_3_int32\":"...)
+		b = strconv.AppendInt(b, int64(v.Nested_3Int32), 10)
+		b = append(b, ',')
+	}
+	if v, ok := x.Nested3Oneof.(*MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String); ok {
+		b = append(b, "\"nested_3_string\":"...)
+		b = runtime.AppendAminoJSONString(b, v.Nested_3String)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
 var (
 	md_MultiLayeredNesting         protoreflect.MessageDescriptor
 	fd_MultiLayeredNesting_nested1 protoreflect.FieldDescriptor
@@ -108,15 +218,20 @@ func (x *fastReflection_MultiLayeredNesting) Range(f func(protoreflect.FieldDesc
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_MultiLayeredNesting) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.nested1":
-		return x.Nested1 != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting"))
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.nested1
+		if fd != fd_MultiLayeredNesting_nested1 {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not contain field %s", fd.FullName()))
+		return x.Nested1 != nil
+	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting); fd != nil {
+		return x.Has(fd)
 	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not contain field %s", fd.FullName()))
 }
 
 // Clear clears the field such that a subsequent Has call reports false.
@@ -126,15 +241,22 @@ func (x *fastReflection_MultiLayeredNesting) Has(fd protoreflect.FieldDescriptor
 //
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MultiLayeredNesting) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.nested1":
-		x.Nested1 = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting"))
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.nested1
+		if fd != fd_MultiLayeredNesting_nested1 {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not contain field %s", fd.FullName()))
+		x.Nested1 = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not extend %s", fd.FullName()))
 	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not contain field %s", fd.FullName()))
 }
 
 // Get retrieves the value for a field.
@@ -144,16 +266,21 @@ func (x *fastReflection_MultiLayeredNesting) Clear(fd protoreflect.FieldDescript
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_MultiLayeredNesting) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.nested1":
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.nested1
+		if descriptor != fd_MultiLayeredNesting_nested1 {
+			break
+		}
 		value := x.Nested1
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not contain field %s", descriptor.FullName()))
 	}
+	if fd := runtime.FieldOf(descriptor, md_MultiLayeredNesting); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not contain field %s", descriptor.FullName()))
 }
 
 // Set stores the value for a field.
@@ -167,15 +294,22 @@ func (x *fastReflection_MultiLayeredNesting) Get(descriptor protoreflect.FieldDe
 //
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MultiLayeredNesting) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.nested1":
-		x.Nested1 = value.Message().Interface().(*MultiLayeredNesting_Nested1)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting"))
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.nested1
+		if fd != fd_MultiLayeredNesting_nested1 {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not contain field %s", fd.FullName()))
+		x.Nested1 = value.Message().Interface().(*MultiLayeredNesting_Nested1)
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not extend %s", fd.FullName()))
 	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not contain field %s", fd.FullName()))
 }
 
 // Mutable returns a mutable reference to a composite type.
@@ -189,34 +323,44 @@ func (x *fastReflection_MultiLayeredNesting) Set(fd protoreflect.FieldDescriptor
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MultiLayeredNesting) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.nested1":
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.nested1
+		if fd != fd_MultiLayeredNesting_nested1 {
+			break
+		}
 		if x.Nested1 == nil {
 			x.Nested1 = new(MultiLayeredNesting_Nested1)
 		}
 		return protoreflect.ValueOfMessage(x.Nested1.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not contain field %s", fd.FullName()))
 	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not contain field %s", fd.FullName()))
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_MultiLayeredNesting) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.nested1":
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.nested1
+		if fd != fd_MultiLayeredNesting_nested1 {
+			break
+		}
 		m := new(MultiLayeredNesting_Nested1)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not contain field %s", fd.FullName()))
 	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting does not contain field %s", fd.FullName()))
 }
 
 // WhichOneof reports which field within the oneof is populated,
@@ -267,92 +411,152 @@ func (x *fastReflection_MultiLayeredNesting) IsValid() bool {
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
 func (x *fastReflection_MultiLayeredNesting) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MultiLayeredNesting)
-		if x == nil {
+	return _fastReflection_MultiLayeredNesting_methods
+}
+
+var _fastReflection_MultiLayeredNesting_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_MultiLayeredNesting_size,
+	Marshal:           _fastReflection_MultiLayeredNesting_marshal,
+	Unmarshal:         _fastReflection_MultiLayeredNesting_unmarshal,
+	Merge:             _fastReflection_MultiLayeredNesting_merge,
+	CheckInitialized:  _fastReflection_MultiLayeredNesting_checkInitialized,
+	Equal:             _fastReflection_MultiLayeredNesting_equal,
+}
+
+func _fastReflection_MultiLayeredNesting_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*MultiLayeredNesting)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
+				Size:              int(size - 1),
 			}
 		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Nested1 != nil {
-			l = options.Size(x.Nested1)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
 	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.Nested1 != nil {
+		l = options.Size(x.Nested1)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MultiLayeredNesting)
-		if x == nil {
+func _fastReflection_MultiLayeredNesting_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*MultiLayeredNesting)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.Nested1 != nil {
+		l = options.Size(x.Nested1)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Nested1); err != nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.Nested1 != nil {
-			encoded, err := options.Marshal(x.Nested1)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
 		}
-		return protoiface.MarshalOutput{
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0xa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_MultiLayeredNesting_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*MultiLayeredNesting)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
+			Flags:             input.Flags,
 		}, nil
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MultiLayeredNesting)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_MultiLayeredNesting.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiLayeredNesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiLayeredNesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nested1", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -362,89 +566,148 @@ func (x *fastReflection_MultiLayeredNesting) ProtoMethods() *protoiface.Methods
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				wire |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiLayeredNesting: wiretype end group for non-group")
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiLayeredNesting: illegal tag %d (wire type %d)", fieldNum, wire)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nested1", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Nested1 == nil {
-					x.Nested1 = &MultiLayeredNesting_Nested1{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Nested1); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Nested1 == nil {
+				if err := budget.Message(fd_MultiLayeredNesting_nested1, int(unsafe.Sizeof(MultiLayeredNesting_Nested1{}))); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
+				x.Nested1 = &MultiLayeredNesting_Nested1{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Nested1); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_MultiLayeredNesting_nested1, -1)
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_MultiLayeredNesting, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
 		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+func _fastReflection_MultiLayeredNesting_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*MultiLayeredNesting)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*MultiLayeredNesting)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.Nested1 != nil {
+		if dst.Nested1 == nil {
+			dst.Nested1 = &MultiLayeredNesting_Nested1{}
 		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		proto.Merge(dst.Nested1, src.Nested1)
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_MultiLayeredNesting_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_MultiLayeredNesting_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*MultiLayeredNesting)
+	y, yok := input.MessageB.Interface().(*MultiLayeredNesting)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.Nested1.Equal(y.Nested1) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *MultiLayeredNesting) Clone() *MultiLayeredNesting {
+	if x == nil {
+		return nil
+	}
+	dst := new(MultiLayeredNesting)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *MultiLayeredNesting) CopyInto(dst *MultiLayeredNesting) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	dst.Nested1 = x.Nested1.Clone()
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *MultiLayeredNesting) Equal(y *MultiLayeredNesting) bool {
+	return _fastReflection_MultiLayeredNesting_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a MultiLayeredNesting, or nil if b is canonical.
+func (*MultiLayeredNesting) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_MultiLayeredNesting.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Message("nested1", false, (*MultiLayeredNesting_Nested1)(nil))
+		default:
+			w.Unknown()
+		}
 	}
+	return w.Err()
 }
 
 var (
@@ -535,13 +798,15 @@ func (x *fastReflection_MultiLayeredNesting_Nested1) Range(f func(protoreflect.F
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_MultiLayeredNesting_Nested1) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not contain field %s", fd.FullName()))
+	switch fd.Number() {
+	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting_Nested1); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not extend %s", fd.FullName()))
 	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not contain field %s", fd.FullName()))
 }
 
 // Clear clears the field such that a subsequent Has call reports false.
@@ -551,13 +816,16 @@ func (x *fastReflection_MultiLayeredNesting_Nested1) Has(fd protoreflect.FieldDe
 //
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MultiLayeredNesting_Nested1) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not contain field %s", fd.FullName()))
+	switch fd.Number() {
+	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting_Nested1); fd != nil {
+		x.Clear(fd)
+		return
 	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not contain field %s", fd.FullName()))
 }
 
 // Get retrieves the value for a field.
@@ -567,13 +835,15 @@ func (x *fastReflection_MultiLayeredNesting_Nested1) Clear(fd protoreflect.Field
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_MultiLayeredNesting_Nested1) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not contain field %s", descriptor.FullName()))
+	switch descriptor.Number() {
+	}
+	if fd := runtime.FieldOf(descriptor, md_MultiLayeredNesting_Nested1); fd != nil {
+		return x.Get(fd)
 	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not contain field %s", descriptor.FullName()))
 }
 
 // Set stores the value for a field.
@@ -587,13 +857,16 @@ func (x *fastReflection_MultiLayeredNesting_Nested1) Get(descriptor protoreflect
 //
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MultiLayeredNesting_Nested1) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not contain field %s", fd.FullName()))
+	switch fd.Number() {
+	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting_Nested1); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not extend %s", fd.FullName()))
 	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not contain field %s", fd.FullName()))
 }
 
 // Mutable returns a mutable reference to a composite type.
@@ -607,26 +880,30 @@ func (x *fastReflection_MultiLayeredNesting_Nested1) Set(fd protoreflect.FieldDe
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MultiLayeredNesting_Nested1) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not contain field %s", fd.FullName()))
+	switch fd.Number() {
 	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting_Nested1); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not contain field %s", fd.FullName()))
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_MultiLayeredNesting_Nested1) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not contain field %s", fd.FullName()))
+	switch fd.Number() {
 	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting_Nested1); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1 does not contain field %s", fd.FullName()))
 }
 
 // WhichOneof reports which field within the oneof is populated,
@@ -677,130 +954,229 @@ func (x *fastReflection_MultiLayeredNesting_Nested1) IsValid() bool {
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
 func (x *fastReflection_MultiLayeredNesting_Nested1) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MultiLayeredNesting_Nested1)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
+	return _fastReflection_MultiLayeredNesting_Nested1_methods
+}
+
+var _fastReflection_MultiLayeredNesting_Nested1_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_MultiLayeredNesting_Nested1_size,
+	Marshal:           _fastReflection_MultiLayeredNesting_Nested1_marshal,
+	Unmarshal:         _fastReflection_MultiLayeredNesting_Nested1_unmarshal,
+	Merge:             _fastReflection_MultiLayeredNesting_Nested1_merge,
+	CheckInitialized:  _fastReflection_MultiLayeredNesting_Nested1_checkInitialized,
+	Equal:             _fastReflection_MultiLayeredNesting_Nested1_equal,
+}
+
+func _fastReflection_MultiLayeredNesting_Nested1_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*MultiLayeredNesting_Nested1)
+	if x == nil {
 		return protoiface.SizeOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+			Size:              0,
 		}
 	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MultiLayeredNesting_Nested1)
-		if x == nil {
-			return protoiface.MarshalOutput{
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+				Size:              int(size - 1),
+			}
 		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_MultiLayeredNesting_Nested1_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*MultiLayeredNesting_Nested1)
+	if x == nil {
 		return protoiface.MarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 			Buf:               input.Buf,
 		}, nil
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MultiLayeredNesting_Nested1)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_MultiLayeredNesting_Nested1_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*MultiLayeredNesting_Nested1)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_MultiLayeredNesting_Nested1.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiLayeredNesting_Nested1: wiretype end group for non-group")
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiLayeredNesting_Nested1: illegal tag %d (wire type %d)", fieldNum, wire)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			switch fieldNum {
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiLayeredNesting_Nested1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiLayeredNesting_Nested1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_MultiLayeredNesting_Nested1, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
 		}
+	}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_MultiLayeredNesting_Nested1_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*MultiLayeredNesting_Nested1)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*MultiLayeredNesting_Nested1)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_MultiLayeredNesting_Nested1_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_MultiLayeredNesting_Nested1_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*MultiLayeredNesting_Nested1)
+	y, yok := input.MessageB.Interface().(*MultiLayeredNesting_Nested1)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *MultiLayeredNesting_Nested1) Clone() *MultiLayeredNesting_Nested1 {
+	if x == nil {
+		return nil
+	}
+	dst := new(MultiLayeredNesting_Nested1)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *MultiLayeredNesting_Nested1) CopyInto(dst *MultiLayeredNesting_Nested1) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *MultiLayeredNesting_Nested1) Equal(y *MultiLayeredNesting_Nested1) bool {
+	return _fastReflection_MultiLayeredNesting_Nested1_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a MultiLayeredNesting_Nested1, or nil if b is canonical.
+func (*MultiLayeredNesting_Nested1) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_MultiLayeredNesting_Nested1.FullName())
+	for w.Next() {
+		switch w.Number() {
+		default:
+			w.Unknown()
+		}
 	}
+	return w.Err()
 }
 
 var (
@@ -899,15 +1275,20 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2) Range(f func(protor
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.nested_3":
-		return x.Nested_3 != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2"))
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.nested_3
+		if fd != fd_MultiLayeredNesting_Nested1_Nested2_nested_3 {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not contain field %s", fd.FullName()))
+		return x.Nested_3 != nil
 	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting_Nested1_Nested2); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not contain field %s", fd.FullName()))
 }
 
 // Clear clears the field such that a subsequent Has call reports false.
@@ -917,15 +1298,22 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2) Has(fd protoreflect
 //
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.nested_3":
-		x.Nested_3 = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2"))
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.nested_3
+		if fd != fd_MultiLayeredNesting_Nested1_Nested2_nested_3 {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not contain field %s", fd.FullName()))
+		x.Nested_3 = nil
+		return
 	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting_Nested1_Nested2); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not contain field %s", fd.FullName()))
 }
 
 // Get retrieves the value for a field.
@@ -935,16 +1323,21 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2) Clear(fd protorefle
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.nested_3":
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.nested_3
+		if descriptor != fd_MultiLayeredNesting_Nested1_Nested2_nested_3 {
+			break
+		}
 		value := x.Nested_3
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not contain field %s", descriptor.FullName()))
 	}
+	if fd := runtime.FieldOf(descriptor, md_MultiLayeredNesting_Nested1_Nested2); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not contain field %s", descriptor.FullName()))
 }
 
 // Set stores the value for a field.
@@ -958,15 +1351,22 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2) Get(descriptor prot
 //
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.nested_3":
-		x.Nested_3 = value.Message().Interface().(*MultiLayeredNesting_Nested1_Nested2_Nested3)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2"))
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.nested_3
+		if fd != fd_MultiLayeredNesting_Nested1_Nested2_nested_3 {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not contain field %s", fd.FullName()))
+		x.Nested_3 = value.Message().Interface().(*MultiLayeredNesting_Nested1_Nested2_Nested3)
+		return
 	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting_Nested1_Nested2); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not contain field %s", fd.FullName()))
 }
 
 // Mutable returns a mutable reference to a composite type.
@@ -980,34 +1380,44 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2) Set(fd protoreflect
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.nested_3":
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.nested_3
+		if fd != fd_MultiLayeredNesting_Nested1_Nested2_nested_3 {
+			break
+		}
 		if x.Nested_3 == nil {
 			x.Nested_3 = new(MultiLayeredNesting_Nested1_Nested2_Nested3)
 		}
 		return protoreflect.ValueOfMessage(x.Nested_3.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not contain field %s", fd.FullName()))
 	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting_Nested1_Nested2); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not contain field %s", fd.FullName()))
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.nested_3":
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.nested_3
+		if fd != fd_MultiLayeredNesting_Nested1_Nested2_nested_3 {
+			break
+		}
 		m := new(MultiLayeredNesting_Nested1_Nested2_Nested3)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not contain field %s", fd.FullName()))
 	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting_Nested1_Nested2); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2 does not contain field %s", fd.FullName()))
 }
 
 // WhichOneof reports which field within the oneof is populated,
@@ -1058,92 +1468,152 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2) IsValid() bool {
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
 func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MultiLayeredNesting_Nested1_Nested2)
-		if x == nil {
+	return _fastReflection_MultiLayeredNesting_Nested1_Nested2_methods
+}
+
+var _fastReflection_MultiLayeredNesting_Nested1_Nested2_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_MultiLayeredNesting_Nested1_Nested2_size,
+	Marshal:           _fastReflection_MultiLayeredNesting_Nested1_Nested2_marshal,
+	Unmarshal:         _fastReflection_MultiLayeredNesting_Nested1_Nested2_unmarshal,
+	Merge:             _fastReflection_MultiLayeredNesting_Nested1_Nested2_merge,
+	CheckInitialized:  _fastReflection_MultiLayeredNesting_Nested1_Nested2_checkInitialized,
+	Equal:             _fastReflection_MultiLayeredNesting_Nested1_Nested2_equal,
+}
+
+func _fastReflection_MultiLayeredNesting_Nested1_Nested2_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*MultiLayeredNesting_Nested1_Nested2)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
+				Size:              int(size - 1),
 			}
 		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Nested_3 != nil {
-			l = options.Size(x.Nested_3)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
 	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.Nested_3 != nil {
+		l = options.Size(x.Nested_3)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MultiLayeredNesting_Nested1_Nested2)
-		if x == nil {
+func _fastReflection_MultiLayeredNesting_Nested1_Nested2_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*MultiLayeredNesting_Nested1_Nested2)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.Nested_3 != nil {
+		l = options.Size(x.Nested_3)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Nested_3); err != nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.Nested_3 != nil {
-			encoded, err := options.Marshal(x.Nested_3)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
 		}
-		return protoiface.MarshalOutput{
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0xa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_MultiLayeredNesting_Nested1_Nested2_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*MultiLayeredNesting_Nested1_Nested2)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
+			Flags:             input.Flags,
 		}, nil
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MultiLayeredNesting_Nested1_Nested2)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_MultiLayeredNesting_Nested1_Nested2.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiLayeredNesting_Nested1_Nested2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiLayeredNesting_Nested1_Nested2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nested_3", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1153,89 +1623,148 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2) ProtoMethods() *pro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				wire |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiLayeredNesting_Nested1_Nested2: wiretype end group for non-group")
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiLayeredNesting_Nested1_Nested2: illegal tag %d (wire type %d)", fieldNum, wire)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nested_3", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Nested_3 == nil {
-					x.Nested_3 = &MultiLayeredNesting_Nested1_Nested2_Nested3{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Nested_3); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Nested_3 == nil {
+				if err := budget.Message(fd_MultiLayeredNesting_Nested1_Nested2_nested_3, int(unsafe.Sizeof(MultiLayeredNesting_Nested1_Nested2_Nested3{}))); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
+				x.Nested_3 = &MultiLayeredNesting_Nested1_Nested2_Nested3{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Nested_3); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_MultiLayeredNesting_Nested1_Nested2_nested_3, -1)
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_MultiLayeredNesting_Nested1_Nested2, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
 		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+func _fastReflection_MultiLayeredNesting_Nested1_Nested2_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*MultiLayeredNesting_Nested1_Nested2)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*MultiLayeredNesting_Nested1_Nested2)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.Nested_3 != nil {
+		if dst.Nested_3 == nil {
+			dst.Nested_3 = &MultiLayeredNesting_Nested1_Nested2_Nested3{}
 		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		proto.Merge(dst.Nested_3, src.Nested_3)
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
 	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_MultiLayeredNesting_Nested1_Nested2_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_MultiLayeredNesting_Nested1_Nested2_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*MultiLayeredNesting_Nested1_Nested2)
+	y, yok := input.MessageB.Interface().(*MultiLayeredNesting_Nested1_Nested2)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.Nested_3.Equal(y.Nested_3) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *MultiLayeredNesting_Nested1_Nested2) Clone() *MultiLayeredNesting_Nested1_Nested2 {
+	if x == nil {
+		return nil
+	}
+	dst := new(MultiLayeredNesting_Nested1_Nested2)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *MultiLayeredNesting_Nested1_Nested2) CopyInto(dst *MultiLayeredNesting_Nested1_Nested2) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.Nested_3 = x.Nested_3.Clone()
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *MultiLayeredNesting_Nested1_Nested2) Equal(y *MultiLayeredNesting_Nested1_Nested2) bool {
+	return _fastReflection_MultiLayeredNesting_Nested1_Nested2_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a MultiLayeredNesting_Nested1_Nested2, or nil if b is canonical.
+func (*MultiLayeredNesting_Nested1_Nested2) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_MultiLayeredNesting_Nested1_Nested2.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Message("nested_3", false, (*MultiLayeredNesting_Nested1_Nested2_Nested3)(nil))
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
 }
 
 var (
@@ -1346,8 +1875,11 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) Range(f fun
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_string":
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_string
+		if fd != fd_MultiLayeredNesting_Nested1_Nested2_Nested3_nested_3_string {
+			break
+		}
 		if x.Nested3Oneof == nil {
 			return false
 		} else if _, ok := x.Nested3Oneof.(*MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String); ok {
@@ -1355,7 +1887,10 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) Has(fd prot
 		} else {
 			return false
 		}
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_int32":
+	case 2: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_int32
+		if fd != fd_MultiLayeredNesting_Nested1_Nested2_Nested3_nested_3_int32 {
+			break
+		}
 		if x.Nested3Oneof == nil {
 			return false
 		} else if _, ok := x.Nested3Oneof.(*MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32); ok {
@@ -1363,12 +1898,14 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) Has(fd prot
 		} else {
 			return false
 		}
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not contain field %s", fd.FullName()))
 	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting_Nested1_Nested2_Nested3); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not contain field %s", fd.FullName()))
 }
 
 // Clear clears the field such that a subsequent Has call reports false.
@@ -1378,17 +1915,28 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) Has(fd prot
 //
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_string":
-		x.Nested3Oneof = nil
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_int32":
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_string
+		if fd != fd_MultiLayeredNesting_Nested1_Nested2_Nested3_nested_3_string {
+			break
+		}
 		x.Nested3Oneof = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3"))
+		return
+	case 2: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_int32
+		if fd != fd_MultiLayeredNesting_Nested1_Nested2_Nested3_nested_3_int32 {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not contain field %s", fd.FullName()))
+		x.Nested3Oneof = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting_Nested1_Nested2_Nested3); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not extend %s", fd.FullName()))
 	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not contain field %s", fd.FullName()))
 }
 
 // Get retrieves the value for a field.
@@ -1398,8 +1946,11 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) Clear(fd pr
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_string":
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_string
+		if descriptor != fd_MultiLayeredNesting_Nested1_Nested2_Nested3_nested_3_string {
+			break
+		}
 		if x.Nested3Oneof == nil {
 			return protoreflect.ValueOfString("")
 		} else if v, ok := x.Nested3Oneof.(*MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String); ok {
@@ -1407,7 +1958,10 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) Get(descrip
 		} else {
 			return protoreflect.ValueOfString("")
 		}
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_int32":
+	case 2: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_int32
+		if descriptor != fd_MultiLayeredNesting_Nested1_Nested2_Nested3_nested_3_int32 {
+			break
+		}
 		if x.Nested3Oneof == nil {
 			return protoreflect.ValueOfInt32(int32(0))
 		} else if v, ok := x.Nested3Oneof.(*MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32); ok {
@@ -1415,12 +1969,14 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) Get(descrip
 		} else {
 			return protoreflect.ValueOfInt32(int32(0))
 		}
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not contain field %s", descriptor.FullName()))
 	}
+	if fd := runtime.FieldOf(descriptor, md_MultiLayeredNesting_Nested1_Nested2_Nested3); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not contain field %s", descriptor.FullName()))
 }
 
 // Set stores the value for a field.
@@ -1434,19 +1990,30 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) Get(descrip
 //
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_string":
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_string
+		if fd != fd_MultiLayeredNesting_Nested1_Nested2_Nested3_nested_3_string {
+			break
+		}
 		cv := value.Interface().(string)
 		x.Nested3Oneof = &MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String{Nested_3String: cv}
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_int32":
+		return
+	case 2: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_int32
+		if fd != fd_MultiLayeredNesting_Nested1_Nested2_Nested3_nested_3_int32 {
+			break
+		}
 		cv := int32(value.Int())
 		x.Nested3Oneof = &MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32{Nested_3Int32: cv}
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3"))
-		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not contain field %s", fd.FullName()))
+		return
 	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting_Nested1_Nested2_Nested3); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not contain field %s", fd.FullName()))
 }
 
 // Mutable returns a mutable reference to a composite type.
@@ -1460,34 +2027,50 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) Set(fd prot
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_string":
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_string
+		if fd != fd_MultiLayeredNesting_Nested1_Nested2_Nested3_nested_3_string {
+			break
+		}
 		panic(fmt.Errorf("field nested_3_string of message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 is not mutable"))
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_int32":
-		panic(fmt.Errorf("field nested_3_int32 of message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3"))
+	case 2: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_int32
+		if fd != fd_MultiLayeredNesting_Nested1_Nested2_Nested3_nested_3_int32 {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("field nested_3_int32 of message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting_Nested1_Nested2_Nested3); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not extend %s", fd.FullName()))
 	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not contain field %s", fd.FullName()))
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_string":
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_string
+		if fd != fd_MultiLayeredNesting_Nested1_Nested2_Nested3_nested_3_string {
+			break
+		}
 		return protoreflect.ValueOfString("")
-	case "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_int32":
-		return protoreflect.ValueOfInt32(int32(0))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3"))
+	case 2: // goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_int32
+		if fd != fd_MultiLayeredNesting_Nested1_Nested2_Nested3_nested_3_int32 {
+			break
 		}
-		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not contain field %s", fd.FullName()))
+		return protoreflect.ValueOfInt32(int32(0))
+	}
+	if fd := runtime.FieldOf(fd, md_MultiLayeredNesting_Nested1_Nested2_Nested3); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not extend %s", fd.FullName()))
 	}
+	panic(fmt.Errorf("message goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3 does not contain field %s", fd.FullName()))
 }
 
 // WhichOneof reports which field within the oneof is populated,
@@ -1548,99 +2131,162 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) IsValid() b
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
 func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MultiLayeredNesting_Nested1_Nested2_Nested3)
-		if x == nil {
+	return _fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3_methods
+}
+
+var _fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3_size,
+	Marshal:           _fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3_marshal,
+	Unmarshal:         _fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3_unmarshal,
+	Merge:             _fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3_merge,
+	CheckInitialized:  _fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3_checkInitialized,
+	Equal:             _fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3_equal,
+}
+
+func _fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*MultiLayeredNesting_Nested1_Nested2_Nested3)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
+				Size:              int(size - 1),
 			}
 		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		switch x := x.Nested3Oneof.(type) {
-		case *MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String:
-			if x == nil {
-				break
-			}
-			l = len(x.Nested_3String)
-			n += 1 + l + runtime.Sov(uint64(l))
-		case *MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32:
-			if x == nil {
-				break
-			}
-			n += 1 + runtime.Sov(uint64(x.Nested_3Int32))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
 	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MultiLayeredNesting_Nested1_Nested2_Nested3)
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	switch x := x.Nested3Oneof.(type) {
+	case *MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String:
 		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		switch x := x.Nested3Oneof.(type) {
-		case *MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String:
-			i -= len(x.Nested_3String)
-			copy(dAtA[i:], x.Nested_3String)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Nested_3String)))
-			i--
-			dAtA[i] = 0xa
-		case *MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32:
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nested_3Int32))
-			i--
-			dAtA[i] = 0x10
+			break
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		l = len(x.Nested_3String)
+		n += 1 + l + runtime.Sov(uint64(l))
+	case *MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32:
+		if x == nil {
+			break
 		}
+		n += 1 + runtime.Sov(uint64(x.Nested_3Int32))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*MultiLayeredNesting_Nested1_Nested2_Nested3)
+	if x == nil {
 		return protoiface.MarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 			Buf:               input.Buf,
 		}, nil
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MultiLayeredNesting_Nested1_Nested2_Nested3)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	switch x := x.Nested3Oneof.(type) {
+	case *MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String:
+		if !utf8.ValidString(x.Nested_3String) {
+			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.Nested_3String)
+		copy(dAtA[i:], x.Nested_3String)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Nested_3String)))
+		i--
+		dAtA[i] = 0xa
+	case *MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32:
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.Nested_3Int32))
+		i--
+		dAtA[i] = 0x10
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*MultiLayeredNesting_Nested1_Nested2_Nested3)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_MultiLayeredNesting_Nested1_Nested2_Nested3.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiLayeredNesting_Nested1_Nested2_Nested3: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiLayeredNesting_Nested1_Nested2_Nested3: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nested_3String", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1650,105 +2296,376 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) ProtoMethod
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				wire |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiLayeredNesting_Nested1_Nested2_Nested3: wiretype end group for non-group")
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiLayeredNesting_Nested1_Nested2_Nested3: illegal tag %d (wire type %d)", fieldNum, wire)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nested_3String", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Nested3Oneof = &MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String{string(dAtA[iNdEx:postIndex])}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nested_3Int32", wireType)
-				}
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.Nested3Oneof = &MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32{v}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_MultiLayeredNesting_Nested1_Nested2_Nested3_nested_3_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.Nested3Oneof = &MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String{runtime.String(dAtA[iNdEx:postIndex], zeroCopy)}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nested_3Int32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if (iNdEx + skippy) > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx += skippy
 			}
+			x.Nested3Oneof = &MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32{v}
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_MultiLayeredNesting_Nested1_Nested2_Nested3, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*MultiLayeredNesting_Nested1_Nested2_Nested3)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*MultiLayeredNesting_Nested1_Nested2_Nested3)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	switch v := src.Nested3Oneof.(type) {
+	case *MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String:
+		dst.Nested3Oneof = &MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String{Nested_3String: v.Nested_3String}
+	case *MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32:
+		dst.Nested3Oneof = &MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32{Nested_3Int32: v.Nested_3Int32}
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*MultiLayeredNesting_Nested1_Nested2_Nested3)
+	y, yok := input.MessageB.Interface().(*MultiLayeredNesting_Nested1_Nested2_Nested3)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	switch v := x.Nested3Oneof.(type) {
+	case nil:
+		if y.Nested3Oneof != nil {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String:
+		if w, ok := y.Nested3Oneof.(*MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String); !ok || v.Nested_3String != w.Nested_3String {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32:
+		if w, ok := y.Nested3Oneof.(*MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32); !ok || v.Nested_3Int32 != w.Nested_3Int32 {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *MultiLayeredNesting_Nested1_Nested2_Nested3) Clone() *MultiLayeredNesting_Nested1_Nested2_Nested3 {
+	if x == nil {
+		return nil
+	}
+	dst := new(MultiLayeredNesting_Nested1_Nested2_Nested3)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *MultiLayeredNesting_Nested1_Nested2_Nested3) CopyInto(dst *MultiLayeredNesting_Nested1_Nested2_Nested3) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	switch v := x.Nested3Oneof.(type) {
+	case nil:
+		dst.Nested3Oneof = nil
+	case *MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String:
+		dst.Nested3Oneof = &MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String{Nested_3String: v.Nested_3String}
+	case *MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32:
+		dst.Nested3Oneof = &MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32{Nested_3Int32: v.Nested_3Int32}
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *MultiLayeredNesting_Nested1_Nested2_Nested3) Equal(y *MultiLayeredNesting_Nested1_Nested2_Nested3) bool {
+	return _fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a MultiLayeredNesting_Nested1_Nested2_Nested3, or nil if b is canonical.
+func (*MultiLayeredNesting_Nested1_Nested2_Nested3) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_MultiLayeredNesting_Nested1_Nested2_Nested3.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Oneof(0)
+			w.Scalar("nested_3_string", protoreflect.StringKind, false)
+		case 2:
+			w.Oneof(0)
+			w.Scalar("nested_3_int32", protoreflect.Int32Kind, false)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *MultiLayeredNesting) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *MultiLayeredNesting) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Nested1 != nil {
+		b = append(b, "\"nested1\":"...)
+		if b, err = x.Nested1.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *MultiLayeredNesting) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *MultiLayeredNesting) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v := new(MultiLayeredNesting_Nested1)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Nested1 = v
 		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *MultiLayeredNesting_Nested1) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *MultiLayeredNesting_Nested1) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *MultiLayeredNesting_Nested1) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *MultiLayeredNesting_Nested1) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		return nil
+	})
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *MultiLayeredNesting_Nested1_Nested2) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *MultiLayeredNesting_Nested1_Nested2) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Nested_3 != nil {
+		b = append(b, "\"nested3\":"...)
+		if b, err = x.Nested_3.AppendJSON(b); err != nil {
+			return b, err
 		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		b = append(b, ',')
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
 	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *MultiLayeredNesting_Nested1_Nested2) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *MultiLayeredNesting_Nested1_Nested2) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v := new(MultiLayeredNesting_Nested1_Nested2_Nested3)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Nested_3 = v
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *MultiLayeredNesting_Nested1_Nested2_Nested3) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *MultiLayeredNesting_Nested1_Nested2_Nested3) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if v, ok := x.Nested3Oneof.(*MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String); ok {
+		b = append(b, "\"nested3String\":"...)
+		if b, err = runtime.AppendJSONString(b, v.Nested_3String, "goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3.nested_3_string"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.Nested3Oneof.(*MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32); ok {
+		b = append(b, "\"nested3Int32\":"...)
+		b = strconv.AppendInt(b, int64(v.Nested_3Int32), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *MultiLayeredNesting_Nested1_Nested2_Nested3) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *MultiLayeredNesting_Nested1_Nested2_Nested3) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.Nested3Oneof = &MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String{Nested_3String: v}
+		case 2:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.Nested3Oneof = &MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32{Nested_3Int32: v}
+		}
+		return nil
+	})
 }
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
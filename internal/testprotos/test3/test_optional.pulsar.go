@@ -0,0 +1,3273 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package test3
+
+import (
+	bytes "bytes"
+	binary "encoding/binary"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	math "math"
+	reflect "reflect"
+	strconv "strconv"
+	sync "sync"
+	atomic "sync/atomic"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
+)
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *TestProto3Optional) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *TestProto3Optional) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if v, ok := x.OneofField.(*TestProto3Optional_OneofString); ok {
+		b = append(b, "\"oneof_string\":"...)
+		b = runtime.AppendAminoJSONString(b, v.OneofString)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestProto3Optional_OneofUint32); ok {
+		b = append(b, "\"oneof_uint32\":"...)
+		b = strconv.AppendUint(b, uint64(v.OneofUint32), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalBool != nil {
+		v := *x.OptionalBool
+		b = append(b, "\"optional_bool\":"...)
+		b = strconv.AppendBool(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalBytes != nil {
+		b = append(b, "\"optional_bytes\":"...)
+		b = runtime.AppendJSONBytes(b, x.OptionalBytes)
+		b = append(b, ',')
+	}
+	if x.OptionalDouble != nil {
+		v := *x.OptionalDouble
+		b = append(b, "\"optional_double\":"...)
+		if b, err = runtime.AppendAminoJSONFloat(b, v, 64); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalFixed32 != nil {
+		v := *x.OptionalFixed32
+		b = append(b, "\"optional_fixed32\":"...)
+		b = strconv.AppendUint(b, uint64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalFixed64 != nil {
+		v := *x.OptionalFixed64
+		b = append(b, "\"optional_fixed64\":"...)
+		b = runtime.AppendJSONUint64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalFloat != nil {
+		v := *x.OptionalFloat
+		b = append(b, "\"optional_float\":"...)
+		if b, err = runtime.AppendAminoJSONFloat(b, float64(v), 32); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalForeignEnum != nil {
+		v := *x.OptionalForeignEnum
+		b = append(b, "\"optional_foreign_enum\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalForeignMessage != nil {
+		b = append(b, "\"optional_foreign_message\":"...)
+		if b, err = x.OptionalForeignMessage.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalInt32 != nil {
+		v := *x.OptionalInt32
+		b = append(b, "\"optional_int32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalInt64 != nil {
+		v := *x.OptionalInt64
+		b = append(b, "\"optional_int64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalSfixed32 != nil {
+		v := *x.OptionalSfixed32
+		b = append(b, "\"optional_sfixed32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalSfixed64 != nil {
+		v := *x.OptionalSfixed64
+		b = append(b, "\"optional_sfixed64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalSint32 != nil {
+		v := *x.OptionalSint32
+		b = append(b, "\"optional_sint32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalSint64 != nil {
+		v := *x.OptionalSint64
+		b = append(b, "\"optional_sint64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalString != nil {
+		v := *x.OptionalString
+		b = append(b, "\"optional_string\":"...)
+		b = runtime.AppendAminoJSONString(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalUint32 != nil {
+		v := *x.OptionalUint32
+		b = append(b, "\"optional_uint32\":"...)
+		b = strconv.AppendUint(b, uint64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalUint64 != nil {
+		v := *x.OptionalUint64
+		b = append(b, "\"optional_uint64\":"...)
+		b = runtime.AppendJSONUint64(b, v)
+		b = append(b, ',')
+	}
+	if x.SingularInt32 != 0 {
+		b = append(b, "\"singular_int32\":"...)
+		b = strconv.AppendInt(b, int64(x.SingularInt32), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+var (
+	md_TestProto3Optional                          protoreflect.MessageDescriptor
+	fd_TestProto3Optional_optional_int32           protoreflect.FieldDescriptor
+	fd_TestProto3Optional_optional_int64           protoreflect.FieldDescriptor
+	fd_TestProto3Optional_optional_uint32          protoreflect.FieldDescriptor
+	fd_TestProto3Optional_optional_uint64          protoreflect.FieldDescriptor
+	fd_TestProto3Optional_optional_sint32          protoreflect.FieldDescriptor
+	fd_TestProto3Optional_optional_sint64          protoreflect.FieldDescriptor
+	fd_TestProto3Optional_optional_fixed32         protoreflect.FieldDescriptor
+	fd_TestProto3Optional_optional_fixed64         protoreflect.FieldDescriptor
+	fd_TestProto3Optional_optional_sfixed32        protoreflect.FieldDescriptor
+	fd_TestProto3Optional_optional_sfixed64        protoreflect.FieldDescriptor
+	fd_TestProto3Optional_optional_float           protoreflect.FieldDescriptor
+	fd_TestProto3Optional_optional_double          protoreflect.FieldDescriptor
+	fd_TestProto3Optional_optional_bool            protoreflect.FieldDescriptor
+	fd_TestProto3Optional_optional_string          protoreflect.FieldDescriptor
+	fd_TestProto3Optional_optional_bytes           protoreflect.FieldDescriptor
+	fd_TestProto3Optional_optional_foreign_message protoreflect.FieldDescriptor
+	fd_TestProto3Optional_optional_foreign_enum    protoreflect.FieldDescriptor
+	fd_TestProto3Optional_singular_int32           protoreflect.FieldDescriptor
+	fd_TestProto3Optional_oneof_uint32             protoreflect.FieldDescriptor
+	fd_TestProto3Optional_oneof_string             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_test3_test_optional_proto_init()
+	md_TestProto3Optional = File_internal_testprotos_test3_test_optional_proto.Messages().ByName("TestProto3Optional")
+	fd_TestProto3Optional_optional_int32 = md_TestProto3Optional.Fields().ByName("optional_int32")
+	fd_TestProto3Optional_optional_int64 = md_TestProto3Optional.Fields().ByName("optional_int64")
+	fd_TestProto3Optional_optional_uint32 = md_TestProto3Optional.Fields().ByName("optional_uint32")
+	fd_TestProto3Optional_optional_uint64 = md_TestProto3Optional.Fields().ByName("optional_uint64")
+	fd_TestProto3Optional_optional_sint32 = md_TestProto3Optional.Fields().ByName("optional_sint32")
+	fd_TestProto3Optional_optional_sint64 = md_TestProto3Optional.Fields().ByName("optional_sint64")
+	fd_TestProto3Optional_optional_fixed32 = md_TestProto3Optional.Fields().ByName("optional_fixed32")
+	fd_TestProto3Optional_optional_fixed64 = md_TestProto3Optional.Fields().ByName("optional_fixed64")
+	fd_TestProto3Optional_optional_sfixed32 = md_TestProto3Optional.Fields().ByName("optional_sfixed32")
+	fd_TestProto3Optional_optional_sfixed64 = md_TestProto3Optional.Fields().ByName("optional_sfixed64")
+	fd_TestProto3Optional_optional_float = md_TestProto3Optional.Fields().ByName("optional_float")
+	fd_TestProto3Optional_optional_double = md_TestProto3Optional.Fields().ByName("optional_double")
+	fd_TestProto3Optional_optional_bool = md_TestProto3Optional.Fields().ByName("optional_bool")
+	fd_TestProto3Optional_optional_string = md_TestProto3Optional.Fields().ByName("optional_string")
+	fd_TestProto3Optional_optional_bytes = md_TestProto3Optional.Fields().ByName("optional_bytes")
+	fd_TestProto3Optional_optional_foreign_message = md_TestProto3Optional.Fields().ByName("optional_foreign_message")
+	fd_TestProto3Optional_optional_foreign_enum = md_TestProto3Optional.Fields().ByName("optional_foreign_enum")
+	fd_TestProto3Optional_singular_int32 = md_TestProto3Optional.Fields().ByName("singular_int32")
+	fd_TestProto3Optional_oneof_uint32 = md_TestProto3Optional.Fields().ByName("oneof_uint32")
+	fd_TestProto3Optional_oneof_string = md_TestProto3Optional.Fields().ByName("oneof_string")
+}
+
+var _ protoreflect.Message = (*fastReflection_TestProto3Optional)(nil)
+
+type fastReflection_TestProto3Optional TestProto3Optional
+
+func (x *TestProto3Optional) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TestProto3Optional)(x)
+}
+
+func (x *TestProto3Optional) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_test3_test_optional_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TestProto3Optional_messageType fastReflection_TestProto3Optional_messageType
+var _ protoreflect.MessageType = fastReflection_TestProto3Optional_messageType{}
+
+type fastReflection_TestProto3Optional_messageType struct{}
+
+func (x fastReflection_TestProto3Optional_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TestProto3Optional)(nil)
+}
+func (x fastReflection_TestProto3Optional_messageType) New() protoreflect.Message {
+	return new(fastReflection_TestProto3Optional)
+}
+func (x fastReflection_TestProto3Optional_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestProto3Optional
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TestProto3Optional) Descriptor() protoreflect.MessageDescriptor {
+	return md_TestProto3Optional
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TestProto3Optional) Type() protoreflect.MessageType {
+	return _fastReflection_TestProto3Optional_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TestProto3Optional) New() protoreflect.Message {
+	return new(fastReflection_TestProto3Optional)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TestProto3Optional) Interface() protoreflect.ProtoMessage {
+	return (*TestProto3Optional)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TestProto3Optional) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OptionalInt32 != nil {
+		value := protoreflect.ValueOfInt32(*x.OptionalInt32)
+		if !f(fd_TestProto3Optional_optional_int32, value) {
+			return
+		}
+	}
+	if x.OptionalInt64 != nil {
+		value := protoreflect.ValueOfInt64(*x.OptionalInt64)
+		if !f(fd_TestProto3Optional_optional_int64, value) {
+			return
+		}
+	}
+	if x.OptionalUint32 != nil {
+		value := protoreflect.ValueOfUint32(*x.OptionalUint32)
+		if !f(fd_TestProto3Optional_optional_uint32, value) {
+			return
+		}
+	}
+	if x.OptionalUint64 != nil {
+		value := protoreflect.ValueOfUint64(*x.OptionalUint64)
+		if !f(fd_TestProto3Optional_optional_uint64, value) {
+			return
+		}
+	}
+	if x.OptionalSint32 != nil {
+		value := protoreflect.ValueOfInt32(*x.OptionalSint32)
+		if !f(fd_TestProto3Optional_optional_sint32, value) {
+			return
+		}
+	}
+	if x.OptionalSint64 != nil {
+		value := protoreflect.ValueOfInt64(*x.OptionalSint64)
+		if !f(fd_TestProto3Optional_optional_sint64, value) {
+			return
+		}
+	}
+	if x.OptionalFixed32 != nil {
+		value := protoreflect.ValueOfUint32(*x.OptionalFixed32)
+		if !f(fd_TestProto3Optional_optional_fixed32, value) {
+			return
+		}
+	}
+	if x.OptionalFixed64 != nil {
+		value := protoreflect.ValueOfUint64(*x.OptionalFixed64)
+		if !f(fd_TestProto3Optional_optional_fixed64, value) {
+			return
+		}
+	}
+	if x.OptionalSfixed32 != nil {
+		value := protoreflect.ValueOfInt32(*x.OptionalSfixed32)
+		if !f(fd_TestProto3Optional_optional_sfixed32, value) {
+			return
+		}
+	}
+	if x.OptionalSfixed64 != nil {
+		value := protoreflect.ValueOfInt64(*x.OptionalSfixed64)
+		if !f(fd_TestProto3Optional_optional_sfixed64, value) {
+			return
+		}
+	}
+	if x.OptionalFloat != nil {
+		value := protoreflect.ValueOfFloat32(*x.OptionalFloat)
+		if !f(fd_TestProto3Optional_optional_float, value) {
+			return
+		}
+	}
+	if x.OptionalDouble != nil {
+		value := protoreflect.ValueOfFloat64(*x.OptionalDouble)
+		if !f(fd_TestProto3Optional_optional_double, value) {
+			return
+		}
+	}
+	if x.OptionalBool != nil {
+		value := protoreflect.ValueOfBool(*x.OptionalBool)
+		if !f(fd_TestProto3Optional_optional_bool, value) {
+			return
+		}
+	}
+	if x.OptionalString != nil {
+		value := protoreflect.ValueOfString(*x.OptionalString)
+		if !f(fd_TestProto3Optional_optional_string, value) {
+			return
+		}
+	}
+	if x.OptionalBytes != nil {
+		value := protoreflect.ValueOfBytes(x.OptionalBytes)
+		if !f(fd_TestProto3Optional_optional_bytes, value) {
+			return
+		}
+	}
+	if x.OptionalForeignMessage != nil {
+		value := protoreflect.ValueOfMessage(x.OptionalForeignMessage.ProtoReflect())
+		if !f(fd_TestProto3Optional_optional_foreign_message, value) {
+			return
+		}
+	}
+	if x.OptionalForeignEnum != nil {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(*x.OptionalForeignEnum))
+		if !f(fd_TestProto3Optional_optional_foreign_enum, value) {
+			return
+		}
+	}
+	if x.SingularInt32 != int32(0) {
+		value := protoreflect.ValueOfInt32(x.SingularInt32)
+		if !f(fd_TestProto3Optional_singular_int32, value) {
+			return
+		}
+	}
+	if x.OneofField != nil {
+		switch o := x.OneofField.(type) {
+		case *TestProto3Optional_OneofUint32:
+			v := o.OneofUint32
+			value := protoreflect.ValueOfUint32(v)
+			if !f(fd_TestProto3Optional_oneof_uint32, value) {
+				return
+			}
+		case *TestProto3Optional_OneofString:
+			v := o.OneofString
+			value := protoreflect.ValueOfString(v)
+			if !f(fd_TestProto3Optional_oneof_string, value) {
+				return
+			}
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TestProto3Optional) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.TestProto3Optional.optional_int32
+		if fd != fd_TestProto3Optional_optional_int32 {
+			break
+		}
+		return x.OptionalInt32 != nil
+	case 2: // goproto.proto.test3.TestProto3Optional.optional_int64
+		if fd != fd_TestProto3Optional_optional_int64 {
+			break
+		}
+		return x.OptionalInt64 != nil
+	case 3: // goproto.proto.test3.TestProto3Optional.optional_uint32
+		if fd != fd_TestProto3Optional_optional_uint32 {
+			break
+		}
+		return x.OptionalUint32 != nil
+	case 4: // goproto.proto.test3.TestProto3Optional.optional_uint64
+		if fd != fd_TestProto3Optional_optional_uint64 {
+			break
+		}
+		return x.OptionalUint64 != nil
+	case 5: // goproto.proto.test3.TestProto3Optional.optional_sint32
+		if fd != fd_TestProto3Optional_optional_sint32 {
+			break
+		}
+		return x.OptionalSint32 != nil
+	case 6: // goproto.proto.test3.TestProto3Optional.optional_sint64
+		if fd != fd_TestProto3Optional_optional_sint64 {
+			break
+		}
+		return x.OptionalSint64 != nil
+	case 7: // goproto.proto.test3.TestProto3Optional.optional_fixed32
+		if fd != fd_TestProto3Optional_optional_fixed32 {
+			break
+		}
+		return x.OptionalFixed32 != nil
+	case 8: // goproto.proto.test3.TestProto3Optional.optional_fixed64
+		if fd != fd_TestProto3Optional_optional_fixed64 {
+			break
+		}
+		return x.OptionalFixed64 != nil
+	case 9: // goproto.proto.test3.TestProto3Optional.optional_sfixed32
+		if fd != fd_TestProto3Optional_optional_sfixed32 {
+			break
+		}
+		return x.OptionalSfixed32 != nil
+	case 10: // goproto.proto.test3.TestProto3Optional.optional_sfixed64
+		if fd != fd_TestProto3Optional_optional_sfixed64 {
+			break
+		}
+		return x.OptionalSfixed64 != nil
+	case 11: // goproto.proto.test3.TestProto3Optional.optional_float
+		if fd != fd_TestProto3Optional_optional_float {
+			break
+		}
+		return x.OptionalFloat != nil
+	case 12: // goproto.proto.test3.TestProto3Optional.optional_double
+		if fd != fd_TestProto3Optional_optional_double {
+			break
+		}
+		return x.OptionalDouble != nil
+	case 13: // goproto.proto.test3.TestProto3Optional.optional_bool
+		if fd != fd_TestProto3Optional_optional_bool {
+			break
+		}
+		return x.OptionalBool != nil
+	case 14: // goproto.proto.test3.TestProto3Optional.optional_string
+		if fd != fd_TestProto3Optional_optional_string {
+			break
+		}
+		return x.OptionalString != nil
+	case 15: // goproto.proto.test3.TestProto3Optional.optional_bytes
+		if fd != fd_TestProto3Optional_optional_bytes {
+			break
+		}
+		return x.OptionalBytes != nil
+	case 16: // goproto.proto.test3.TestProto3Optional.optional_foreign_message
+		if fd != fd_TestProto3Optional_optional_foreign_message {
+			break
+		}
+		return x.OptionalForeignMessage != nil
+	case 17: // goproto.proto.test3.TestProto3Optional.optional_foreign_enum
+		if fd != fd_TestProto3Optional_optional_foreign_enum {
+			break
+		}
+		return x.OptionalForeignEnum != nil
+	case 18: // goproto.proto.test3.TestProto3Optional.singular_int32
+		if fd != fd_TestProto3Optional_singular_int32 {
+			break
+		}
+		return x.SingularInt32 != int32(0)
+	case 19: // goproto.proto.test3.TestProto3Optional.oneof_uint32
+		if fd != fd_TestProto3Optional_oneof_uint32 {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestProto3Optional_OneofUint32); ok {
+			return true
+		} else {
+			return false
+		}
+	case 20: // goproto.proto.test3.TestProto3Optional.oneof_string
+		if fd != fd_TestProto3Optional_oneof_string {
+			break
+		}
+		if x.OneofField == nil {
+			return false
+		} else if _, ok := x.OneofField.(*TestProto3Optional_OneofString); ok {
+			return true
+		} else {
+			return false
+		}
+	}
+	if fd := runtime.FieldOf(fd, md_TestProto3Optional); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestProto3Optional does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestProto3Optional does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestProto3Optional) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.TestProto3Optional.optional_int32
+		if fd != fd_TestProto3Optional_optional_int32 {
+			break
+		}
+		x.OptionalInt32 = nil
+		return
+	case 2: // goproto.proto.test3.TestProto3Optional.optional_int64
+		if fd != fd_TestProto3Optional_optional_int64 {
+			break
+		}
+		x.OptionalInt64 = nil
+		return
+	case 3: // goproto.proto.test3.TestProto3Optional.optional_uint32
+		if fd != fd_TestProto3Optional_optional_uint32 {
+			break
+		}
+		x.OptionalUint32 = nil
+		return
+	case 4: // goproto.proto.test3.TestProto3Optional.optional_uint64
+		if fd != fd_TestProto3Optional_optional_uint64 {
+			break
+		}
+		x.OptionalUint64 = nil
+		return
+	case 5: // goproto.proto.test3.TestProto3Optional.optional_sint32
+		if fd != fd_TestProto3Optional_optional_sint32 {
+			break
+		}
+		x.OptionalSint32 = nil
+		return
+	case 6: // goproto.proto.test3.TestProto3Optional.optional_sint64
+		if fd != fd_TestProto3Optional_optional_sint64 {
+			break
+		}
+		x.OptionalSint64 = nil
+		return
+	case 7: // goproto.proto.test3.TestProto3Optional.optional_fixed32
+		if fd != fd_TestProto3Optional_optional_fixed32 {
+			break
+		}
+		x.OptionalFixed32 = nil
+		return
+	case 8: // goproto.proto.test3.TestProto3Optional.optional_fixed64
+		if fd != fd_TestProto3Optional_optional_fixed64 {
+			break
+		}
+		x.OptionalFixed64 = nil
+		return
+	case 9: // goproto.proto.test3.TestProto3Optional.optional_sfixed32
+		if fd != fd_TestProto3Optional_optional_sfixed32 {
+			break
+		}
+		x.OptionalSfixed32 = nil
+		return
+	case 10: // goproto.proto.test3.TestProto3Optional.optional_sfixed64
+		if fd != fd_TestProto3Optional_optional_sfixed64 {
+			break
+		}
+		x.OptionalSfixed64 = nil
+		return
+	case 11: // goproto.proto.test3.TestProto3Optional.optional_float
+		if fd != fd_TestProto3Optional_optional_float {
+			break
+		}
+		x.OptionalFloat = nil
+		return
+	case 12: // goproto.proto.test3.TestProto3Optional.optional_double
+		if fd != fd_TestProto3Optional_optional_double {
+			break
+		}
+		x.OptionalDouble = nil
+		return
+	case 13: // goproto.proto.test3.TestProto3Optional.optional_bool
+		if fd != fd_TestProto3Optional_optional_bool {
+			break
+		}
+		x.OptionalBool = nil
+		return
+	case 14: // goproto.proto.test3.TestProto3Optional.optional_string
+		if fd != fd_TestProto3Optional_optional_string {
+			break
+		}
+		x.OptionalString = nil
+		return
+	case 15: // goproto.proto.test3.TestProto3Optional.optional_bytes
+		if fd != fd_TestProto3Optional_optional_bytes {
+			break
+		}
+		x.OptionalBytes = nil
+		return
+	case 16: // goproto.proto.test3.TestProto3Optional.optional_foreign_message
+		if fd != fd_TestProto3Optional_optional_foreign_message {
+			break
+		}
+		x.OptionalForeignMessage = nil
+		return
+	case 17: // goproto.proto.test3.TestProto3Optional.optional_foreign_enum
+		if fd != fd_TestProto3Optional_optional_foreign_enum {
+			break
+		}
+		x.OptionalForeignEnum = nil
+		return
+	case 18: // goproto.proto.test3.TestProto3Optional.singular_int32
+		if fd != fd_TestProto3Optional_singular_int32 {
+			break
+		}
+		x.SingularInt32 = int32(0)
+		return
+	case 19: // goproto.proto.test3.TestProto3Optional.oneof_uint32
+		if fd != fd_TestProto3Optional_oneof_uint32 {
+			break
+		}
+		x.OneofField = nil
+		return
+	case 20: // goproto.proto.test3.TestProto3Optional.oneof_string
+		if fd != fd_TestProto3Optional_oneof_string {
+			break
+		}
+		x.OneofField = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestProto3Optional); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestProto3Optional does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestProto3Optional does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TestProto3Optional) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // goproto.proto.test3.TestProto3Optional.optional_int32
+		if descriptor != fd_TestProto3Optional_optional_int32 {
+			break
+		}
+		if x.OptionalInt32 == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.OptionalInt32
+		return protoreflect.ValueOfInt32(value)
+	case 2: // goproto.proto.test3.TestProto3Optional.optional_int64
+		if descriptor != fd_TestProto3Optional_optional_int64 {
+			break
+		}
+		if x.OptionalInt64 == nil {
+			return protoreflect.ValueOfInt64(int64(0))
+		}
+		value := *x.OptionalInt64
+		return protoreflect.ValueOfInt64(value)
+	case 3: // goproto.proto.test3.TestProto3Optional.optional_uint32
+		if descriptor != fd_TestProto3Optional_optional_uint32 {
+			break
+		}
+		if x.OptionalUint32 == nil {
+			return protoreflect.ValueOfUint32(uint32(0))
+		}
+		value := *x.OptionalUint32
+		return protoreflect.ValueOfUint32(value)
+	case 4: // goproto.proto.test3.TestProto3Optional.optional_uint64
+		if descriptor != fd_TestProto3Optional_optional_uint64 {
+			break
+		}
+		if x.OptionalUint64 == nil {
+			return protoreflect.ValueOfUint64(uint64(0))
+		}
+		value := *x.OptionalUint64
+		return protoreflect.ValueOfUint64(value)
+	case 5: // goproto.proto.test3.TestProto3Optional.optional_sint32
+		if descriptor != fd_TestProto3Optional_optional_sint32 {
+			break
+		}
+		if x.OptionalSint32 == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.OptionalSint32
+		return protoreflect.ValueOfInt32(value)
+	case 6: // goproto.proto.test3.TestProto3Optional.optional_sint64
+		if descriptor != fd_TestProto3Optional_optional_sint64 {
+			break
+		}
+		if x.OptionalSint64 == nil {
+			return protoreflect.ValueOfInt64(int64(0))
+		}
+		value := *x.OptionalSint64
+		return protoreflect.ValueOfInt64(value)
+	case 7: // goproto.proto.test3.TestProto3Optional.optional_fixed32
+		if descriptor != fd_TestProto3Optional_optional_fixed32 {
+			break
+		}
+		if x.OptionalFixed32 == nil {
+			return protoreflect.ValueOfUint32(uint32(0))
+		}
+		value := *x.OptionalFixed32
+		return protoreflect.ValueOfUint32(value)
+	case 8: // goproto.proto.test3.TestProto3Optional.optional_fixed64
+		if descriptor != fd_TestProto3Optional_optional_fixed64 {
+			break
+		}
+		if x.OptionalFixed64 == nil {
+			return protoreflect.ValueOfUint64(uint64(0))
+		}
+		value := *x.OptionalFixed64
+		return protoreflect.ValueOfUint64(value)
+	case 9: // goproto.proto.test3.TestProto3Optional.optional_sfixed32
+		if descriptor != fd_TestProto3Optional_optional_sfixed32 {
+			break
+		}
+		if x.OptionalSfixed32 == nil {
+			return protoreflect.ValueOfInt32(int32(0))
+		}
+		value := *x.OptionalSfixed32
+		return protoreflect.ValueOfInt32(value)
+	case 10: // goproto.proto.test3.TestProto3Optional.optional_sfixed64
+		if descriptor != fd_TestProto3Optional_optional_sfixed64 {
+			break
+		}
+		if x.OptionalSfixed64 == nil {
+			return protoreflect.ValueOfInt64(int64(0))
+		}
+		value := *x.OptionalSfixed64
+		return protoreflect.ValueOfInt64(value)
+	case 11: // goproto.proto.test3.TestProto3Optional.optional_float
+		if descriptor != fd_TestProto3Optional_optional_float {
+			break
+		}
+		if x.OptionalFloat == nil {
+			return protoreflect.ValueOfFloat32(float32(0))
+		}
+		value := *x.OptionalFloat
+		return protoreflect.ValueOfFloat32(value)
+	case 12: // goproto.proto.test3.TestProto3Optional.optional_double
+		if descriptor != fd_TestProto3Optional_optional_double {
+			break
+		}
+		if x.OptionalDouble == nil {
+			return protoreflect.ValueOfFloat64(float64(0))
+		}
+		value := *x.OptionalDouble
+		return protoreflect.ValueOfFloat64(value)
+	case 13: // goproto.proto.test3.TestProto3Optional.optional_bool
+		if descriptor != fd_TestProto3Optional_optional_bool {
+			break
+		}
+		if x.OptionalBool == nil {
+			return protoreflect.ValueOfBool(false)
+		}
+		value := *x.OptionalBool
+		return protoreflect.ValueOfBool(value)
+	case 14: // goproto.proto.test3.TestProto3Optional.optional_string
+		if descriptor != fd_TestProto3Optional_optional_string {
+			break
+		}
+		if x.OptionalString == nil {
+			return protoreflect.ValueOfString("")
+		}
+		value := *x.OptionalString
+		return protoreflect.ValueOfString(value)
+	case 15: // goproto.proto.test3.TestProto3Optional.optional_bytes
+		if descriptor != fd_TestProto3Optional_optional_bytes {
+			break
+		}
+		if x.OptionalBytes == nil {
+			return protoreflect.ValueOfBytes(nil)
+		}
+		value := x.OptionalBytes
+		return protoreflect.ValueOfBytes(value)
+	case 16: // goproto.proto.test3.TestProto3Optional.optional_foreign_message
+		if descriptor != fd_TestProto3Optional_optional_foreign_message {
+			break
+		}
+		value := x.OptionalForeignMessage
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 17: // goproto.proto.test3.TestProto3Optional.optional_foreign_enum
+		if descriptor != fd_TestProto3Optional_optional_foreign_enum {
+			break
+		}
+		if x.OptionalForeignEnum == nil {
+			return protoreflect.ValueOfEnum(0)
+		}
+		value := *x.OptionalForeignEnum
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case 18: // goproto.proto.test3.TestProto3Optional.singular_int32
+		if descriptor != fd_TestProto3Optional_singular_int32 {
+			break
+		}
+		value := x.SingularInt32
+		return protoreflect.ValueOfInt32(value)
+	case 19: // goproto.proto.test3.TestProto3Optional.oneof_uint32
+		if descriptor != fd_TestProto3Optional_oneof_uint32 {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfUint32(uint32(0))
+		} else if v, ok := x.OneofField.(*TestProto3Optional_OneofUint32); ok {
+			return protoreflect.ValueOfUint32(v.OneofUint32)
+		} else {
+			return protoreflect.ValueOfUint32(uint32(0))
+		}
+	case 20: // goproto.proto.test3.TestProto3Optional.oneof_string
+		if descriptor != fd_TestProto3Optional_oneof_string {
+			break
+		}
+		if x.OneofField == nil {
+			return protoreflect.ValueOfString("")
+		} else if v, ok := x.OneofField.(*TestProto3Optional_OneofString); ok {
+			return protoreflect.ValueOfString(v.OneofString)
+		} else {
+			return protoreflect.ValueOfString("")
+		}
+	}
+	if fd := runtime.FieldOf(descriptor, md_TestProto3Optional); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestProto3Optional does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestProto3Optional does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestProto3Optional) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.TestProto3Optional.optional_int32
+		if fd != fd_TestProto3Optional_optional_int32 {
+			break
+		}
+		cv := int32(value.Int())
+		x.OptionalInt32 = &cv
+		return
+	case 2: // goproto.proto.test3.TestProto3Optional.optional_int64
+		if fd != fd_TestProto3Optional_optional_int64 {
+			break
+		}
+		cv := value.Int()
+		x.OptionalInt64 = &cv
+		return
+	case 3: // goproto.proto.test3.TestProto3Optional.optional_uint32
+		if fd != fd_TestProto3Optional_optional_uint32 {
+			break
+		}
+		cv := uint32(value.Uint())
+		x.OptionalUint32 = &cv
+		return
+	case 4: // goproto.proto.test3.TestProto3Optional.optional_uint64
+		if fd != fd_TestProto3Optional_optional_uint64 {
+			break
+		}
+		cv := value.Uint()
+		x.OptionalUint64 = &cv
+		return
+	case 5: // goproto.proto.test3.TestProto3Optional.optional_sint32
+		if fd != fd_TestProto3Optional_optional_sint32 {
+			break
+		}
+		cv := int32(value.Int())
+		x.OptionalSint32 = &cv
+		return
+	case 6: // goproto.proto.test3.TestProto3Optional.optional_sint64
+		if fd != fd_TestProto3Optional_optional_sint64 {
+			break
+		}
+		cv := value.Int()
+		x.OptionalSint64 = &cv
+		return
+	case 7: // goproto.proto.test3.TestProto3Optional.optional_fixed32
+		if fd != fd_TestProto3Optional_optional_fixed32 {
+			break
+		}
+		cv := uint32(value.Uint())
+		x.OptionalFixed32 = &cv
+		return
+	case 8: // goproto.proto.test3.TestProto3Optional.optional_fixed64
+		if fd != fd_TestProto3Optional_optional_fixed64 {
+			break
+		}
+		cv := value.Uint()
+		x.OptionalFixed64 = &cv
+		return
+	case 9: // goproto.proto.test3.TestProto3Optional.optional_sfixed32
+		if fd != fd_TestProto3Optional_optional_sfixed32 {
+			break
+		}
+		cv := int32(value.Int())
+		x.OptionalSfixed32 = &cv
+		return
+	case 10: // goproto.proto.test3.TestProto3Optional.optional_sfixed64
+		if fd != fd_TestProto3Optional_optional_sfixed64 {
+			break
+		}
+		cv := value.Int()
+		x.OptionalSfixed64 = &cv
+		return
+	case 11: // goproto.proto.test3.TestProto3Optional.optional_float
+		if fd != fd_TestProto3Optional_optional_float {
+			break
+		}
+		cv := float32(value.Float())
+		x.OptionalFloat = &cv
+		return
+	case 12: // goproto.proto.test3.TestProto3Optional.optional_double
+		if fd != fd_TestProto3Optional_optional_double {
+			break
+		}
+		cv := value.Float()
+		x.OptionalDouble = &cv
+		return
+	case 13: // goproto.proto.test3.TestProto3Optional.optional_bool
+		if fd != fd_TestProto3Optional_optional_bool {
+			break
+		}
+		cv := value.Bool()
+		x.OptionalBool = &cv
+		return
+	case 14: // goproto.proto.test3.TestProto3Optional.optional_string
+		if fd != fd_TestProto3Optional_optional_string {
+			break
+		}
+		cv := value.Interface().(string)
+		x.OptionalString = &cv
+		return
+	case 15: // goproto.proto.test3.TestProto3Optional.optional_bytes
+		if fd != fd_TestProto3Optional_optional_bytes {
+			break
+		}
+		x.OptionalBytes = value.Bytes()
+		if x.OptionalBytes == nil {
+			x.OptionalBytes = []byte{}
+		}
+		return
+	case 16: // goproto.proto.test3.TestProto3Optional.optional_foreign_message
+		if fd != fd_TestProto3Optional_optional_foreign_message {
+			break
+		}
+		x.OptionalForeignMessage = value.Message().Interface().(*ForeignMessage)
+		return
+	case 17: // goproto.proto.test3.TestProto3Optional.optional_foreign_enum
+		if fd != fd_TestProto3Optional_optional_foreign_enum {
+			break
+		}
+		cv := (ForeignEnum)(value.Enum())
+		x.OptionalForeignEnum = &cv
+		return
+	case 18: // goproto.proto.test3.TestProto3Optional.singular_int32
+		if fd != fd_TestProto3Optional_singular_int32 {
+			break
+		}
+		x.SingularInt32 = int32(value.Int())
+		return
+	case 19: // goproto.proto.test3.TestProto3Optional.oneof_uint32
+		if fd != fd_TestProto3Optional_oneof_uint32 {
+			break
+		}
+		cv := uint32(value.Uint())
+		x.OneofField = &TestProto3Optional_OneofUint32{OneofUint32: cv}
+		return
+	case 20: // goproto.proto.test3.TestProto3Optional.oneof_string
+		if fd != fd_TestProto3Optional_oneof_string {
+			break
+		}
+		cv := value.Interface().(string)
+		x.OneofField = &TestProto3Optional_OneofString{OneofString: cv}
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_TestProto3Optional); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestProto3Optional does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestProto3Optional does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestProto3Optional) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 16: // goproto.proto.test3.TestProto3Optional.optional_foreign_message
+		if fd != fd_TestProto3Optional_optional_foreign_message {
+			break
+		}
+		if x.OptionalForeignMessage == nil {
+			x.OptionalForeignMessage = new(ForeignMessage)
+		}
+		return protoreflect.ValueOfMessage(x.OptionalForeignMessage.ProtoReflect())
+	case 1: // goproto.proto.test3.TestProto3Optional.optional_int32
+		if fd != fd_TestProto3Optional_optional_int32 {
+			break
+		}
+		panic(fmt.Errorf("field optional_int32 of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 2: // goproto.proto.test3.TestProto3Optional.optional_int64
+		if fd != fd_TestProto3Optional_optional_int64 {
+			break
+		}
+		panic(fmt.Errorf("field optional_int64 of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 3: // goproto.proto.test3.TestProto3Optional.optional_uint32
+		if fd != fd_TestProto3Optional_optional_uint32 {
+			break
+		}
+		panic(fmt.Errorf("field optional_uint32 of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 4: // goproto.proto.test3.TestProto3Optional.optional_uint64
+		if fd != fd_TestProto3Optional_optional_uint64 {
+			break
+		}
+		panic(fmt.Errorf("field optional_uint64 of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 5: // goproto.proto.test3.TestProto3Optional.optional_sint32
+		if fd != fd_TestProto3Optional_optional_sint32 {
+			break
+		}
+		panic(fmt.Errorf("field optional_sint32 of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 6: // goproto.proto.test3.TestProto3Optional.optional_sint64
+		if fd != fd_TestProto3Optional_optional_sint64 {
+			break
+		}
+		panic(fmt.Errorf("field optional_sint64 of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 7: // goproto.proto.test3.TestProto3Optional.optional_fixed32
+		if fd != fd_TestProto3Optional_optional_fixed32 {
+			break
+		}
+		panic(fmt.Errorf("field optional_fixed32 of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 8: // goproto.proto.test3.TestProto3Optional.optional_fixed64
+		if fd != fd_TestProto3Optional_optional_fixed64 {
+			break
+		}
+		panic(fmt.Errorf("field optional_fixed64 of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 9: // goproto.proto.test3.TestProto3Optional.optional_sfixed32
+		if fd != fd_TestProto3Optional_optional_sfixed32 {
+			break
+		}
+		panic(fmt.Errorf("field optional_sfixed32 of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 10: // goproto.proto.test3.TestProto3Optional.optional_sfixed64
+		if fd != fd_TestProto3Optional_optional_sfixed64 {
+			break
+		}
+		panic(fmt.Errorf("field optional_sfixed64 of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 11: // goproto.proto.test3.TestProto3Optional.optional_float
+		if fd != fd_TestProto3Optional_optional_float {
+			break
+		}
+		panic(fmt.Errorf("field optional_float of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 12: // goproto.proto.test3.TestProto3Optional.optional_double
+		if fd != fd_TestProto3Optional_optional_double {
+			break
+		}
+		panic(fmt.Errorf("field optional_double of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 13: // goproto.proto.test3.TestProto3Optional.optional_bool
+		if fd != fd_TestProto3Optional_optional_bool {
+			break
+		}
+		panic(fmt.Errorf("field optional_bool of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 14: // goproto.proto.test3.TestProto3Optional.optional_string
+		if fd != fd_TestProto3Optional_optional_string {
+			break
+		}
+		panic(fmt.Errorf("field optional_string of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 15: // goproto.proto.test3.TestProto3Optional.optional_bytes
+		if fd != fd_TestProto3Optional_optional_bytes {
+			break
+		}
+		panic(fmt.Errorf("field optional_bytes of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 17: // goproto.proto.test3.TestProto3Optional.optional_foreign_enum
+		if fd != fd_TestProto3Optional_optional_foreign_enum {
+			break
+		}
+		panic(fmt.Errorf("field optional_foreign_enum of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 18: // goproto.proto.test3.TestProto3Optional.singular_int32
+		if fd != fd_TestProto3Optional_singular_int32 {
+			break
+		}
+		panic(fmt.Errorf("field singular_int32 of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 19: // goproto.proto.test3.TestProto3Optional.oneof_uint32
+		if fd != fd_TestProto3Optional_oneof_uint32 {
+			break
+		}
+		panic(fmt.Errorf("field oneof_uint32 of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	case 20: // goproto.proto.test3.TestProto3Optional.oneof_string
+		if fd != fd_TestProto3Optional_oneof_string {
+			break
+		}
+		panic(fmt.Errorf("field oneof_string of message goproto.proto.test3.TestProto3Optional is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_TestProto3Optional); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestProto3Optional does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestProto3Optional does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TestProto3Optional) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // goproto.proto.test3.TestProto3Optional.optional_int32
+		if fd != fd_TestProto3Optional_optional_int32 {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 2: // goproto.proto.test3.TestProto3Optional.optional_int64
+		if fd != fd_TestProto3Optional_optional_int64 {
+			break
+		}
+		return protoreflect.ValueOfInt64(int64(0))
+	case 3: // goproto.proto.test3.TestProto3Optional.optional_uint32
+		if fd != fd_TestProto3Optional_optional_uint32 {
+			break
+		}
+		return protoreflect.ValueOfUint32(uint32(0))
+	case 4: // goproto.proto.test3.TestProto3Optional.optional_uint64
+		if fd != fd_TestProto3Optional_optional_uint64 {
+			break
+		}
+		return protoreflect.ValueOfUint64(uint64(0))
+	case 5: // goproto.proto.test3.TestProto3Optional.optional_sint32
+		if fd != fd_TestProto3Optional_optional_sint32 {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 6: // goproto.proto.test3.TestProto3Optional.optional_sint64
+		if fd != fd_TestProto3Optional_optional_sint64 {
+			break
+		}
+		return protoreflect.ValueOfInt64(int64(0))
+	case 7: // goproto.proto.test3.TestProto3Optional.optional_fixed32
+		if fd != fd_TestProto3Optional_optional_fixed32 {
+			break
+		}
+		return protoreflect.ValueOfUint32(uint32(0))
+	case 8: // goproto.proto.test3.TestProto3Optional.optional_fixed64
+		if fd != fd_TestProto3Optional_optional_fixed64 {
+			break
+		}
+		return protoreflect.ValueOfUint64(uint64(0))
+	case 9: // goproto.proto.test3.TestProto3Optional.optional_sfixed32
+		if fd != fd_TestProto3Optional_optional_sfixed32 {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 10: // goproto.proto.test3.TestProto3Optional.optional_sfixed64
+		if fd != fd_TestProto3Optional_optional_sfixed64 {
+			break
+		}
+		return protoreflect.ValueOfInt64(int64(0))
+	case 11: // goproto.proto.test3.TestProto3Optional.optional_float
+		if fd != fd_TestProto3Optional_optional_float {
+			break
+		}
+		return protoreflect.ValueOfFloat32(float32(0))
+	case 12: // goproto.proto.test3.TestProto3Optional.optional_double
+		if fd != fd_TestProto3Optional_optional_double {
+			break
+		}
+		return protoreflect.ValueOfFloat64(float64(0))
+	case 13: // goproto.proto.test3.TestProto3Optional.optional_bool
+		if fd != fd_TestProto3Optional_optional_bool {
+			break
+		}
+		return protoreflect.ValueOfBool(false)
+	case 14: // goproto.proto.test3.TestProto3Optional.optional_string
+		if fd != fd_TestProto3Optional_optional_string {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 15: // goproto.proto.test3.TestProto3Optional.optional_bytes
+		if fd != fd_TestProto3Optional_optional_bytes {
+			break
+		}
+		return protoreflect.ValueOfBytes(nil)
+	case 16: // goproto.proto.test3.TestProto3Optional.optional_foreign_message
+		if fd != fd_TestProto3Optional_optional_foreign_message {
+			break
+		}
+		m := new(ForeignMessage)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 17: // goproto.proto.test3.TestProto3Optional.optional_foreign_enum
+		if fd != fd_TestProto3Optional_optional_foreign_enum {
+			break
+		}
+		return protoreflect.ValueOfEnum(0)
+	case 18: // goproto.proto.test3.TestProto3Optional.singular_int32
+		if fd != fd_TestProto3Optional_singular_int32 {
+			break
+		}
+		return protoreflect.ValueOfInt32(int32(0))
+	case 19: // goproto.proto.test3.TestProto3Optional.oneof_uint32
+		if fd != fd_TestProto3Optional_oneof_uint32 {
+			break
+		}
+		return protoreflect.ValueOfUint32(uint32(0))
+	case 20: // goproto.proto.test3.TestProto3Optional.oneof_string
+		if fd != fd_TestProto3Optional_oneof_string {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	}
+	if fd := runtime.FieldOf(fd, md_TestProto3Optional); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message goproto.proto.test3.TestProto3Optional does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message goproto.proto.test3.TestProto3Optional does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TestProto3Optional) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "goproto.proto.test3.TestProto3Optional.oneof_field":
+		if x.OneofField == nil {
+			return nil
+		}
+		switch x.OneofField.(type) {
+		case *TestProto3Optional_OneofUint32:
+			return x.Descriptor().Fields().ByName("oneof_uint32")
+		case *TestProto3Optional_OneofString:
+			return x.Descriptor().Fields().ByName("oneof_string")
+		}
+	case "goproto.proto.test3.TestProto3Optional._optional_int32":
+		if x.OptionalInt32 == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_int32")
+	case "goproto.proto.test3.TestProto3Optional._optional_int64":
+		if x.OptionalInt64 == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_int64")
+	case "goproto.proto.test3.TestProto3Optional._optional_uint32":
+		if x.OptionalUint32 == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_uint32")
+	case "goproto.proto.test3.TestProto3Optional._optional_uint64":
+		if x.OptionalUint64 == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_uint64")
+	case "goproto.proto.test3.TestProto3Optional._optional_sint32":
+		if x.OptionalSint32 == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_sint32")
+	case "goproto.proto.test3.TestProto3Optional._optional_sint64":
+		if x.OptionalSint64 == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_sint64")
+	case "goproto.proto.test3.TestProto3Optional._optional_fixed32":
+		if x.OptionalFixed32 == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_fixed32")
+	case "goproto.proto.test3.TestProto3Optional._optional_fixed64":
+		if x.OptionalFixed64 == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_fixed64")
+	case "goproto.proto.test3.TestProto3Optional._optional_sfixed32":
+		if x.OptionalSfixed32 == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_sfixed32")
+	case "goproto.proto.test3.TestProto3Optional._optional_sfixed64":
+		if x.OptionalSfixed64 == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_sfixed64")
+	case "goproto.proto.test3.TestProto3Optional._optional_float":
+		if x.OptionalFloat == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_float")
+	case "goproto.proto.test3.TestProto3Optional._optional_double":
+		if x.OptionalDouble == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_double")
+	case "goproto.proto.test3.TestProto3Optional._optional_bool":
+		if x.OptionalBool == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_bool")
+	case "goproto.proto.test3.TestProto3Optional._optional_string":
+		if x.OptionalString == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_string")
+	case "goproto.proto.test3.TestProto3Optional._optional_bytes":
+		if x.OptionalBytes == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_bytes")
+	case "goproto.proto.test3.TestProto3Optional._optional_foreign_message":
+		if x.OptionalForeignMessage == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_foreign_message")
+	case "goproto.proto.test3.TestProto3Optional._optional_foreign_enum":
+		if x.OptionalForeignEnum == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("optional_foreign_enum")
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test3.TestProto3Optional", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TestProto3Optional) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TestProto3Optional) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TestProto3Optional) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TestProto3Optional) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_TestProto3Optional_methods
+}
+
+var _fastReflection_TestProto3Optional_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_TestProto3Optional_size,
+	Marshal:           _fastReflection_TestProto3Optional_marshal,
+	Unmarshal:         _fastReflection_TestProto3Optional_unmarshal,
+	Merge:             _fastReflection_TestProto3Optional_merge,
+	CheckInitialized:  _fastReflection_TestProto3Optional_checkInitialized,
+	Equal:             _fastReflection_TestProto3Optional_equal,
+}
+
+func _fastReflection_TestProto3Optional_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*TestProto3Optional)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.OptionalInt32 != nil {
+		n += 1 + runtime.Sov(uint64(*x.OptionalInt32))
+	}
+	if x.OptionalInt64 != nil {
+		n += 1 + runtime.Sov(uint64(*x.OptionalInt64))
+	}
+	if x.OptionalUint32 != nil {
+		n += 1 + runtime.Sov(uint64(*x.OptionalUint32))
+	}
+	if x.OptionalUint64 != nil {
+		n += 1 + runtime.Sov(uint64(*x.OptionalUint64))
+	}
+	if x.OptionalSint32 != nil {
+		n += 1 + runtime.Soz(uint64(*x.OptionalSint32))
+	}
+	if x.OptionalSint64 != nil {
+		n += 1 + runtime.Soz(uint64(*x.OptionalSint64))
+	}
+	if x.OptionalFixed32 != nil {
+		n += 5
+	}
+	if x.OptionalFixed64 != nil {
+		n += 9
+	}
+	if x.OptionalSfixed32 != nil {
+		n += 5
+	}
+	if x.OptionalSfixed64 != nil {
+		n += 9
+	}
+	if x.OptionalFloat != nil {
+		n += 5
+	}
+	if x.OptionalDouble != nil {
+		n += 9
+	}
+	if x.OptionalBool != nil {
+		n += 2
+	}
+	if x.OptionalString != nil {
+		l = len(*x.OptionalString)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.OptionalBytes != nil {
+		l = len(x.OptionalBytes)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.OptionalForeignMessage != nil {
+		l = options.Size(x.OptionalForeignMessage)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.OptionalForeignEnum != nil {
+		n += 2 + runtime.Sov(uint64(*x.OptionalForeignEnum))
+	}
+	if x.SingularInt32 != 0 {
+		n += 2 + runtime.Sov(uint64(x.SingularInt32))
+	}
+	switch x := x.OneofField.(type) {
+	case *TestProto3Optional_OneofUint32:
+		if x == nil {
+			break
+		}
+		n += 2 + runtime.Sov(uint64(x.OneofUint32))
+	case *TestProto3Optional_OneofString:
+		if x == nil {
+			break
+		}
+		l = len(x.OneofString)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_TestProto3Optional_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*TestProto3Optional)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	switch x := x.OneofField.(type) {
+	case *TestProto3Optional_OneofUint32:
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.OneofUint32))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	case *TestProto3Optional_OneofString:
+		if !utf8.ValidString(x.OneofString) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.OneofString)
+		copy(dAtA[i:], x.OneofString)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OneofString)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if x.SingularInt32 != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.SingularInt32))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if x.OptionalForeignEnum != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalForeignEnum))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if x.OptionalForeignMessage != nil {
+		l = options.Size(x.OptionalForeignMessage)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.OptionalForeignMessage); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if x.OptionalBytes != nil {
+		i -= len(x.OptionalBytes)
+		copy(dAtA[i:], x.OptionalBytes)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OptionalBytes)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if x.OptionalString != nil {
+		if !utf8.ValidString(*x.OptionalString) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(*x.OptionalString)
+		copy(dAtA[i:], *x.OptionalString)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(*x.OptionalString)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if x.OptionalBool != nil {
+		i--
+		if *x.OptionalBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if x.OptionalDouble != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*x.OptionalDouble))))
+		i--
+		dAtA[i] = 0x61
+	}
+	if x.OptionalFloat != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(*x.OptionalFloat))))
+		i--
+		dAtA[i] = 0x5d
+	}
+	if x.OptionalSfixed64 != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*x.OptionalSfixed64))
+		i--
+		dAtA[i] = 0x51
+	}
+	if x.OptionalSfixed32 != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*x.OptionalSfixed32))
+		i--
+		dAtA[i] = 0x4d
+	}
+	if x.OptionalFixed64 != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*x.OptionalFixed64))
+		i--
+		dAtA[i] = 0x41
+	}
+	if x.OptionalFixed32 != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*x.OptionalFixed32))
+		i--
+		dAtA[i] = 0x3d
+	}
+	if x.OptionalSint64 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64((uint64(*x.OptionalSint64)<<1)^uint64((*x.OptionalSint64>>63))))
+		i--
+		dAtA[i] = 0x30
+	}
+	if x.OptionalSint32 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64((uint32(*x.OptionalSint32)<<1)^uint32((*x.OptionalSint32>>31))))
+		i--
+		dAtA[i] = 0x28
+	}
+	if x.OptionalUint64 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalUint64))
+		i--
+		dAtA[i] = 0x20
+	}
+	if x.OptionalUint32 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalUint32))
+		i--
+		dAtA[i] = 0x18
+	}
+	if x.OptionalInt64 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalInt64))
+		i--
+		dAtA[i] = 0x10
+	}
+	if x.OptionalInt32 != nil {
+		i = runtime.EncodeVarint(dAtA, i, uint64(*x.OptionalInt32))
+		i--
+		dAtA[i] = 0x8
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_TestProto3Optional_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*TestProto3Optional)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_TestProto3Optional.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	if zeroCopy {
+		x.zeroCopied = true
+	}
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestProto3Optional: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TestProto3Optional: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalInt32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OptionalInt32 = &v
+		case 2:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalInt64", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OptionalInt64 = &v
+		case 3:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalUint32", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OptionalUint32 = &v
+		case 4:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalUint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OptionalUint64 = &v
+		case 5:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalSint32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			x.OptionalSint32 = &v
+		case 6:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalSint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			v2 := int64(v)
+			x.OptionalSint64 = &v2
+		case 7:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalFixed32", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			x.OptionalFixed32 = &v
+		case 8:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalFixed64", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			x.OptionalFixed64 = &v
+		case 9:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalSfixed32", wireType)
+			}
+			var v int32
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			x.OptionalSfixed32 = &v
+		case 10:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalSfixed64", wireType)
+			}
+			var v int64
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			x.OptionalSfixed64 = &v
+		case 11:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalFloat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			v2 := float32(math.Float32frombits(v))
+			x.OptionalFloat = &v2
+		case 12:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			x.OptionalDouble = &v2
+		case 13:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			x.OptionalBool = &b
+		case 14:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestProto3Optional_optional_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			s := runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			x.OptionalString = &s
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestProto3Optional_optional_bytes, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if zeroCopy || x.zeroCopied {
+				x.OptionalBytes = runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy)
+			} else {
+				x.OptionalBytes = append(x.OptionalBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.OptionalBytes == nil {
+					x.OptionalBytes = []byte{}
+				}
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalForeignMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.OptionalForeignMessage == nil {
+				if err := budget.Message(fd_TestProto3Optional_optional_foreign_message, int(unsafe.Sizeof(ForeignMessage{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.OptionalForeignMessage = &ForeignMessage{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OptionalForeignMessage); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_TestProto3Optional_optional_foreign_message, -1)
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionalForeignEnum", wireType)
+			}
+			var v ForeignEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= ForeignEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OptionalForeignEnum = &v
+		case 18:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SingularInt32", wireType)
+			}
+			x.SingularInt32 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.SingularInt32 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofUint32", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.OneofField = &TestProto3Optional_OneofUint32{v}
+		case 20:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OneofString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_TestProto3Optional_oneof_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.OneofField = &TestProto3Optional_OneofString{runtime.String(dAtA[iNdEx:postIndex], zeroCopy)}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_TestProto3Optional, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_TestProto3Optional_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*TestProto3Optional)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*TestProto3Optional)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.OptionalInt32 != nil {
+		v := *src.OptionalInt32
+		dst.OptionalInt32 = &v
+	}
+	if src.OptionalInt64 != nil {
+		v := *src.OptionalInt64
+		dst.OptionalInt64 = &v
+	}
+	if src.OptionalUint32 != nil {
+		v := *src.OptionalUint32
+		dst.OptionalUint32 = &v
+	}
+	if src.OptionalUint64 != nil {
+		v := *src.OptionalUint64
+		dst.OptionalUint64 = &v
+	}
+	if src.OptionalSint32 != nil {
+		v := *src.OptionalSint32
+		dst.OptionalSint32 = &v
+	}
+	if src.OptionalSint64 != nil {
+		v := *src.OptionalSint64
+		dst.OptionalSint64 = &v
+	}
+	if src.OptionalFixed32 != nil {
+		v := *src.OptionalFixed32
+		dst.OptionalFixed32 = &v
+	}
+	if src.OptionalFixed64 != nil {
+		v := *src.OptionalFixed64
+		dst.OptionalFixed64 = &v
+	}
+	if src.OptionalSfixed32 != nil {
+		v := *src.OptionalSfixed32
+		dst.OptionalSfixed32 = &v
+	}
+	if src.OptionalSfixed64 != nil {
+		v := *src.OptionalSfixed64
+		dst.OptionalSfixed64 = &v
+	}
+	if src.OptionalFloat != nil {
+		v := *src.OptionalFloat
+		dst.OptionalFloat = &v
+	}
+	if src.OptionalDouble != nil {
+		v := *src.OptionalDouble
+		dst.OptionalDouble = &v
+	}
+	if src.OptionalBool != nil {
+		v := *src.OptionalBool
+		dst.OptionalBool = &v
+	}
+	if src.OptionalString != nil {
+		v := *src.OptionalString
+		dst.OptionalString = &v
+	}
+	if src.OptionalBytes != nil {
+		dst.OptionalBytes = append([]byte{}, src.OptionalBytes...)
+	}
+	if src.OptionalForeignMessage != nil {
+		if dst.OptionalForeignMessage == nil {
+			dst.OptionalForeignMessage = &ForeignMessage{}
+		}
+		proto.Merge(dst.OptionalForeignMessage, src.OptionalForeignMessage)
+	}
+	if src.OptionalForeignEnum != nil {
+		v := *src.OptionalForeignEnum
+		dst.OptionalForeignEnum = &v
+	}
+	if src.SingularInt32 != int32(0) {
+		dst.SingularInt32 = src.SingularInt32
+	}
+	switch v := src.OneofField.(type) {
+	case *TestProto3Optional_OneofUint32:
+		dst.OneofField = &TestProto3Optional_OneofUint32{OneofUint32: v.OneofUint32}
+	case *TestProto3Optional_OneofString:
+		dst.OneofField = &TestProto3Optional_OneofString{OneofString: v.OneofString}
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_TestProto3Optional_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_TestProto3Optional_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*TestProto3Optional)
+	y, yok := input.MessageB.Interface().(*TestProto3Optional)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalInt32 == nil) != (y.OptionalInt32 == nil) || x.OptionalInt32 != nil && *x.OptionalInt32 != *y.OptionalInt32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalInt64 == nil) != (y.OptionalInt64 == nil) || x.OptionalInt64 != nil && *x.OptionalInt64 != *y.OptionalInt64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalUint32 == nil) != (y.OptionalUint32 == nil) || x.OptionalUint32 != nil && *x.OptionalUint32 != *y.OptionalUint32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalUint64 == nil) != (y.OptionalUint64 == nil) || x.OptionalUint64 != nil && *x.OptionalUint64 != *y.OptionalUint64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalSint32 == nil) != (y.OptionalSint32 == nil) || x.OptionalSint32 != nil && *x.OptionalSint32 != *y.OptionalSint32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalSint64 == nil) != (y.OptionalSint64 == nil) || x.OptionalSint64 != nil && *x.OptionalSint64 != *y.OptionalSint64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalFixed32 == nil) != (y.OptionalFixed32 == nil) || x.OptionalFixed32 != nil && *x.OptionalFixed32 != *y.OptionalFixed32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalFixed64 == nil) != (y.OptionalFixed64 == nil) || x.OptionalFixed64 != nil && *x.OptionalFixed64 != *y.OptionalFixed64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalSfixed32 == nil) != (y.OptionalSfixed32 == nil) || x.OptionalSfixed32 != nil && *x.OptionalSfixed32 != *y.OptionalSfixed32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalSfixed64 == nil) != (y.OptionalSfixed64 == nil) || x.OptionalSfixed64 != nil && *x.OptionalSfixed64 != *y.OptionalSfixed64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalFloat == nil) != (y.OptionalFloat == nil) || x.OptionalFloat != nil && !runtime.EqualFloat(float64(*x.OptionalFloat), float64(*y.OptionalFloat)) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalDouble == nil) != (y.OptionalDouble == nil) || x.OptionalDouble != nil && !runtime.EqualFloat(*x.OptionalDouble, *y.OptionalDouble) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalBool == nil) != (y.OptionalBool == nil) || x.OptionalBool != nil && *x.OptionalBool != *y.OptionalBool {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalString == nil) != (y.OptionalString == nil) || x.OptionalString != nil && *x.OptionalString != *y.OptionalString {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalBytes == nil) != (y.OptionalBytes == nil) || !bytes.Equal(x.OptionalBytes, y.OptionalBytes) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.OptionalForeignMessage.Equal(y.OptionalForeignMessage) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if (x.OptionalForeignEnum == nil) != (y.OptionalForeignEnum == nil) || x.OptionalForeignEnum != nil && *x.OptionalForeignEnum != *y.OptionalForeignEnum {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SingularInt32 != y.SingularInt32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	switch v := x.OneofField.(type) {
+	case nil:
+		if y.OneofField != nil {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestProto3Optional_OneofUint32:
+		if w, ok := y.OneofField.(*TestProto3Optional_OneofUint32); !ok || v.OneofUint32 != w.OneofUint32 {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *TestProto3Optional_OneofString:
+		if w, ok := y.OneofField.(*TestProto3Optional_OneofString); !ok || v.OneofString != w.OneofString {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *TestProto3Optional) Clone() *TestProto3Optional {
+	if x == nil {
+		return nil
+	}
+	dst := new(TestProto3Optional)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *TestProto3Optional) CopyInto(dst *TestProto3Optional) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	if x.OptionalInt32 != nil {
+		v := *x.OptionalInt32
+		dst.OptionalInt32 = &v
+	} else {
+		dst.OptionalInt32 = nil
+	}
+	if x.OptionalInt64 != nil {
+		v := *x.OptionalInt64
+		dst.OptionalInt64 = &v
+	} else {
+		dst.OptionalInt64 = nil
+	}
+	if x.OptionalUint32 != nil {
+		v := *x.OptionalUint32
+		dst.OptionalUint32 = &v
+	} else {
+		dst.OptionalUint32 = nil
+	}
+	if x.OptionalUint64 != nil {
+		v := *x.OptionalUint64
+		dst.OptionalUint64 = &v
+	} else {
+		dst.OptionalUint64 = nil
+	}
+	if x.OptionalSint32 != nil {
+		v := *x.OptionalSint32
+		dst.OptionalSint32 = &v
+	} else {
+		dst.OptionalSint32 = nil
+	}
+	if x.OptionalSint64 != nil {
+		v := *x.OptionalSint64
+		dst.OptionalSint64 = &v
+	} else {
+		dst.OptionalSint64 = nil
+	}
+	if x.OptionalFixed32 != nil {
+		v := *x.OptionalFixed32
+		dst.OptionalFixed32 = &v
+	} else {
+		dst.OptionalFixed32 = nil
+	}
+	if x.OptionalFixed64 != nil {
+		v := *x.OptionalFixed64
+		dst.OptionalFixed64 = &v
+	} else {
+		dst.OptionalFixed64 = nil
+	}
+	if x.OptionalSfixed32 != nil {
+		v := *x.OptionalSfixed32
+		dst.OptionalSfixed32 = &v
+	} else {
+		dst.OptionalSfixed32 = nil
+	}
+	if x.OptionalSfixed64 != nil {
+		v := *x.OptionalSfixed64
+		dst.OptionalSfixed64 = &v
+	} else {
+		dst.OptionalSfixed64 = nil
+	}
+	if x.OptionalFloat != nil {
+		v := *x.OptionalFloat
+		dst.OptionalFloat = &v
+	} else {
+		dst.OptionalFloat = nil
+	}
+	if x.OptionalDouble != nil {
+		v := *x.OptionalDouble
+		dst.OptionalDouble = &v
+	} else {
+		dst.OptionalDouble = nil
+	}
+	if x.OptionalBool != nil {
+		v := *x.OptionalBool
+		dst.OptionalBool = &v
+	} else {
+		dst.OptionalBool = nil
+	}
+	if x.OptionalString != nil {
+		v := *x.OptionalString
+		dst.OptionalString = &v
+	} else {
+		dst.OptionalString = nil
+	}
+	dst.OptionalBytes = bytes.Clone(x.OptionalBytes)
+	dst.OptionalForeignMessage = x.OptionalForeignMessage.Clone()
+	if x.OptionalForeignEnum != nil {
+		v := *x.OptionalForeignEnum
+		dst.OptionalForeignEnum = &v
+	} else {
+		dst.OptionalForeignEnum = nil
+	}
+	dst.SingularInt32 = x.SingularInt32
+	switch v := x.OneofField.(type) {
+	case nil:
+		dst.OneofField = nil
+	case *TestProto3Optional_OneofUint32:
+		dst.OneofField = &TestProto3Optional_OneofUint32{OneofUint32: v.OneofUint32}
+	case *TestProto3Optional_OneofString:
+		dst.OneofField = &TestProto3Optional_OneofString{OneofString: v.OneofString}
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *TestProto3Optional) Equal(y *TestProto3Optional) bool {
+	return _fastReflection_TestProto3Optional_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a TestProto3Optional, or nil if b is canonical.
+func (*TestProto3Optional) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_TestProto3Optional.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("optional_int32", protoreflect.Int32Kind, false)
+		case 2:
+			w.Scalar("optional_int64", protoreflect.Int64Kind, false)
+		case 3:
+			w.Scalar("optional_uint32", protoreflect.Uint32Kind, false)
+		case 4:
+			w.Scalar("optional_uint64", protoreflect.Uint64Kind, false)
+		case 5:
+			w.Scalar("optional_sint32", protoreflect.Sint32Kind, false)
+		case 6:
+			w.Scalar("optional_sint64", protoreflect.Sint64Kind, false)
+		case 7:
+			w.Scalar("optional_fixed32", protoreflect.Fixed32Kind, false)
+		case 8:
+			w.Scalar("optional_fixed64", protoreflect.Fixed64Kind, false)
+		case 9:
+			w.Scalar("optional_sfixed32", protoreflect.Sfixed32Kind, false)
+		case 10:
+			w.Scalar("optional_sfixed64", protoreflect.Sfixed64Kind, false)
+		case 11:
+			w.Scalar("optional_float", protoreflect.FloatKind, false)
+		case 12:
+			w.Scalar("optional_double", protoreflect.DoubleKind, false)
+		case 13:
+			w.Scalar("optional_bool", protoreflect.BoolKind, false)
+		case 14:
+			w.Scalar("optional_string", protoreflect.StringKind, false)
+		case 15:
+			w.Scalar("optional_bytes", protoreflect.BytesKind, false)
+		case 16:
+			w.Message("optional_foreign_message", false, (*ForeignMessage)(nil))
+		case 17:
+			w.Scalar("optional_foreign_enum", protoreflect.EnumKind, false)
+		case 18:
+			w.Scalar("singular_int32", protoreflect.Int32Kind, true)
+		case 19:
+			w.Oneof(0)
+			w.Scalar("oneof_uint32", protoreflect.Uint32Kind, false)
+		case 20:
+			w.Oneof(0)
+			w.Scalar("oneof_string", protoreflect.StringKind, false)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *TestProto3Optional) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *TestProto3Optional) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.OptionalInt32 != nil {
+		v := *x.OptionalInt32
+		b = append(b, "\"optionalInt32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalInt64 != nil {
+		v := *x.OptionalInt64
+		b = append(b, "\"optionalInt64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalUint32 != nil {
+		v := *x.OptionalUint32
+		b = append(b, "\"optionalUint32\":"...)
+		b = strconv.AppendUint(b, uint64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalUint64 != nil {
+		v := *x.OptionalUint64
+		b = append(b, "\"optionalUint64\":"...)
+		b = runtime.AppendJSONUint64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalSint32 != nil {
+		v := *x.OptionalSint32
+		b = append(b, "\"optionalSint32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalSint64 != nil {
+		v := *x.OptionalSint64
+		b = append(b, "\"optionalSint64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalFixed32 != nil {
+		v := *x.OptionalFixed32
+		b = append(b, "\"optionalFixed32\":"...)
+		b = strconv.AppendUint(b, uint64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalFixed64 != nil {
+		v := *x.OptionalFixed64
+		b = append(b, "\"optionalFixed64\":"...)
+		b = runtime.AppendJSONUint64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalSfixed32 != nil {
+		v := *x.OptionalSfixed32
+		b = append(b, "\"optionalSfixed32\":"...)
+		b = strconv.AppendInt(b, int64(v), 10)
+		b = append(b, ',')
+	}
+	if x.OptionalSfixed64 != nil {
+		v := *x.OptionalSfixed64
+		b = append(b, "\"optionalSfixed64\":"...)
+		b = runtime.AppendJSONInt64(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalFloat != nil {
+		v := *x.OptionalFloat
+		b = append(b, "\"optionalFloat\":"...)
+		b = runtime.AppendJSONFloat(b, float64(v), 32)
+		b = append(b, ',')
+	}
+	if x.OptionalDouble != nil {
+		v := *x.OptionalDouble
+		b = append(b, "\"optionalDouble\":"...)
+		b = runtime.AppendJSONFloat(b, v, 64)
+		b = append(b, ',')
+	}
+	if x.OptionalBool != nil {
+		v := *x.OptionalBool
+		b = append(b, "\"optionalBool\":"...)
+		b = strconv.AppendBool(b, v)
+		b = append(b, ',')
+	}
+	if x.OptionalString != nil {
+		v := *x.OptionalString
+		b = append(b, "\"optionalString\":"...)
+		if b, err = runtime.AppendJSONString(b, v, "goproto.proto.test3.TestProto3Optional.optional_string"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalBytes != nil {
+		b = append(b, "\"optionalBytes\":"...)
+		b = runtime.AppendJSONBytes(b, x.OptionalBytes)
+		b = append(b, ',')
+	}
+	if x.OptionalForeignMessage != nil {
+		b = append(b, "\"optionalForeignMessage\":"...)
+		if b, err = x.OptionalForeignMessage.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.OptionalForeignEnum != nil {
+		v := *x.OptionalForeignEnum
+		b = append(b, "\"optionalForeignEnum\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+		b = append(b, ',')
+	}
+	if x.SingularInt32 != 0 {
+		b = append(b, "\"singularInt32\":"...)
+		b = strconv.AppendInt(b, int64(x.SingularInt32), 10)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestProto3Optional_OneofUint32); ok {
+		b = append(b, "\"oneofUint32\":"...)
+		b = strconv.AppendUint(b, uint64(v.OneofUint32), 10)
+		b = append(b, ',')
+	}
+	if v, ok := x.OneofField.(*TestProto3Optional_OneofString); ok {
+		b = append(b, "\"oneofString\":"...)
+		if b, err = runtime.AppendJSONString(b, v.OneofString, "goproto.proto.test3.TestProto3Optional.oneof_string"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *TestProto3Optional) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *TestProto3Optional) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalInt32 = &v
+		case 2:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalInt64 = &v
+		case 3:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalUint32 = &v
+		case 4:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalUint64 = &v
+		case 5:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalSint32 = &v
+		case 6:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalSint64 = &v
+		case 7:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalFixed32 = &v
+		case 8:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalFixed64 = &v
+		case 9:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalSfixed32 = &v
+		case 10:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalSfixed64 = &v
+		case 11:
+			v, err := d.Float32(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalFloat = &v
+		case 12:
+			v, err := d.Float64(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalDouble = &v
+		case 13:
+			v, err := d.Bool(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalBool = &v
+		case 14:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalString = &v
+		case 15:
+			v, err := d.Bytes(fd)
+			if err != nil {
+				return err
+			}
+			x.OptionalBytes = v
+		case 16:
+			v := new(ForeignMessage)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.OptionalForeignMessage = v
+		case 17:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := ForeignEnum(v)
+				x.OptionalForeignEnum = &e
+			}
+		case 18:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.SingularInt32 = v
+		case 19:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestProto3Optional_OneofUint32{OneofUint32: v}
+		case 20:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.OneofField = &TestProto3Optional_OneofString{OneofString: v}
+		}
+		return nil
+	})
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.18.1
+// source: internal/testprotos/test3/test_optional.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TestProto3Optional holds proto3 optional fields of every kind,
+// each of which is a member of its own synthetic oneof.
+type TestProto3Optional struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+	zeroCopied    bool
+
+	OptionalInt32          *int32          `protobuf:"varint,1,opt,name=optional_int32,json=optionalInt32,proto3,oneof" json:"optional_int32,omitempty"`
+	OptionalInt64          *int64          `protobuf:"varint,2,opt,name=optional_int64,json=optionalInt64,proto3,oneof" json:"optional_int64,omitempty"`
+	OptionalUint32         *uint32         `protobuf:"varint,3,opt,name=optional_uint32,json=optionalUint32,proto3,oneof" json:"optional_uint32,omitempty"`
+	OptionalUint64         *uint64         `protobuf:"varint,4,opt,name=optional_uint64,json=optionalUint64,proto3,oneof" json:"optional_uint64,omitempty"`
+	OptionalSint32         *int32          `protobuf:"zigzag32,5,opt,name=optional_sint32,json=optionalSint32,proto3,oneof" json:"optional_sint32,omitempty"`
+	OptionalSint64         *int64          `protobuf:"zigzag64,6,opt,name=optional_sint64,json=optionalSint64,proto3,oneof" json:"optional_sint64,omitempty"`
+	OptionalFixed32        *uint32         `protobuf:"fixed32,7,opt,name=optional_fixed32,json=optionalFixed32,proto3,oneof" json:"optional_fixed32,omitempty"`
+	OptionalFixed64        *uint64         `protobuf:"fixed64,8,opt,name=optional_fixed64,json=optionalFixed64,proto3,oneof" json:"optional_fixed64,omitempty"`
+	OptionalSfixed32       *int32          `protobuf:"fixed32,9,opt,name=optional_sfixed32,json=optionalSfixed32,proto3,oneof" json:"optional_sfixed32,omitempty"`
+	OptionalSfixed64       *int64          `protobuf:"fixed64,10,opt,name=optional_sfixed64,json=optionalSfixed64,proto3,oneof" json:"optional_sfixed64,omitempty"`
+	OptionalFloat          *float32        `protobuf:"fixed32,11,opt,name=optional_float,json=optionalFloat,proto3,oneof" json:"optional_float,omitempty"`
+	OptionalDouble         *float64        `protobuf:"fixed64,12,opt,name=optional_double,json=optionalDouble,proto3,oneof" json:"optional_double,omitempty"`
+	OptionalBool           *bool           `protobuf:"varint,13,opt,name=optional_bool,json=optionalBool,proto3,oneof" json:"optional_bool,omitempty"`
+	OptionalString         *string         `protobuf:"bytes,14,opt,name=optional_string,json=optionalString,proto3,oneof" json:"optional_string,omitempty"`
+	OptionalBytes          []byte          `protobuf:"bytes,15,opt,name=optional_bytes,json=optionalBytes,proto3,oneof" json:"optional_bytes,omitempty"`
+	OptionalForeignMessage *ForeignMessage `protobuf:"bytes,16,opt,name=optional_foreign_message,json=optionalForeignMessage,proto3,oneof" json:"optional_foreign_message,omitempty"`
+	OptionalForeignEnum    *ForeignEnum    `protobuf:"varint,17,opt,name=optional_foreign_enum,json=optionalForeignEnum,proto3,enum=goproto.proto.test3.ForeignEnum,oneof" json:"optional_foreign_enum,omitempty"`
+	SingularInt32          int32           `protobuf:"varint,18,opt,name=singular_int32,json=singularInt32,proto3" json:"singular_int32,omitempty"`
+	// Types that are assignable to OneofField:
+	//	*TestProto3Optional_OneofUint32
+	//	*TestProto3Optional_OneofString
+	OneofField isTestProto3Optional_OneofField `protobuf_oneof:"oneof_field"`
+}
+
+func (x *TestProto3Optional) Reset() {
+	*x = TestProto3Optional{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_test3_test_optional_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestProto3Optional) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestProto3Optional) ProtoMessage() {}
+
+// Deprecated: Use TestProto3Optional.ProtoReflect.Descriptor instead.
+func (*TestProto3Optional) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_test3_test_optional_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TestProto3Optional) GetOptionalInt32() int32 {
+	if x != nil && x.OptionalInt32 != nil {
+		return *x.OptionalInt32
+	}
+	return 0
+}
+
+func (x *TestProto3Optional) GetOptionalInt64() int64 {
+	if x != nil && x.OptionalInt64 != nil {
+		return *x.OptionalInt64
+	}
+	return 0
+}
+
+func (x *TestProto3Optional) GetOptionalUint32() uint32 {
+	if x != nil && x.OptionalUint32 != nil {
+		return *x.OptionalUint32
+	}
+	return 0
+}
+
+func (x *TestProto3Optional) GetOptionalUint64() uint64 {
+	if x != nil && x.OptionalUint64 != nil {
+		return *x.OptionalUint64
+	}
+	return 0
+}
+
+func (x *TestProto3Optional) GetOptionalSint32() int32 {
+	if x != nil && x.OptionalSint32 != nil {
+		return *x.OptionalSint32
+	}
+	return 0
+}
+
+func (x *TestProto3Optional) GetOptionalSint64() int64 {
+	if x != nil && x.OptionalSint64 != nil {
+		return *x.OptionalSint64
+	}
+	return 0
+}
+
+func (x *TestProto3Optional) GetOptionalFixed32() uint32 {
+	if x != nil && x.OptionalFixed32 != nil {
+		return *x.OptionalFixed32
+	}
+	return 0
+}
+
+func (x *TestProto3Optional) GetOptionalFixed64() uint64 {
+	if x != nil && x.OptionalFixed64 != nil {
+		return *x.OptionalFixed64
+	}
+	return 0
+}
+
+func (x *TestProto3Optional) GetOptionalSfixed32() int32 {
+	if x != nil && x.OptionalSfixed32 != nil {
+		return *x.OptionalSfixed32
+	}
+	return 0
+}
+
+func (x *TestProto3Optional) GetOptionalSfixed64() int64 {
+	if x != nil && x.OptionalSfixed64 != nil {
+		return *x.OptionalSfixed64
+	}
+	return 0
+}
+
+func (x *TestProto3Optional) GetOptionalFloat() float32 {
+	if x != nil && x.OptionalFloat != nil {
+		return *x.OptionalFloat
+	}
+	return 0
+}
+
+func (x *TestProto3Optional) GetOptionalDouble() float64 {
+	if x != nil && x.OptionalDouble != nil {
+		return *x.OptionalDouble
+	}
+	return 0
+}
+
+func (x *TestProto3Optional) GetOptionalBool() bool {
+	if x != nil && x.OptionalBool != nil {
+		return *x.OptionalBool
+	}
+	return false
+}
+
+func (x *TestProto3Optional) GetOptionalString() string {
+	if x != nil && x.OptionalString != nil {
+		return *x.OptionalString
+	}
+	return ""
+}
+
+func (x *TestProto3Optional) GetOptionalBytes() []byte {
+	if x != nil {
+		return x.OptionalBytes
+	}
+	return nil
+}
+
+func (x *TestProto3Optional) GetOptionalForeignMessage() *ForeignMessage {
+	if x != nil {
+		return x.OptionalForeignMessage
+	}
+	return nil
+}
+
+func (x *TestProto3Optional) GetOptionalForeignEnum() ForeignEnum {
+	if x != nil && x.OptionalForeignEnum != nil {
+		return *x.OptionalForeignEnum
+	}
+	return ForeignEnum_FOREIGN_ZERO
+}
+
+func (x *TestProto3Optional) GetSingularInt32() int32 {
+	if x != nil {
+		return x.SingularInt32
+	}
+	return 0
+}
+
+func (x *TestProto3Optional) GetOneofField() isTestProto3Optional_OneofField {
+	if x != nil {
+		return x.OneofField
+	}
+	return nil
+}
+
+func (x *TestProto3Optional) GetOneofUint32() uint32 {
+	if x, ok := x.GetOneofField().(*TestProto3Optional_OneofUint32); ok {
+		return x.OneofUint32
+	}
+	return 0
+}
+
+func (x *TestProto3Optional) GetOneofString() string {
+	if x, ok := x.GetOneofField().(*TestProto3Optional_OneofString); ok {
+		return x.OneofString
+	}
+	return ""
+}
+
+type isTestProto3Optional_OneofField interface {
+	isTestProto3Optional_OneofField()
+}
+
+type TestProto3Optional_OneofUint32 struct {
+	OneofUint32 uint32 `protobuf:"varint,19,opt,name=oneof_uint32,json=oneofUint32,proto3,oneof"`
+}
+
+type TestProto3Optional_OneofString struct {
+	OneofString string `protobuf:"bytes,20,opt,name=oneof_string,json=oneofString,proto3,oneof"`
+}
+
+func (*TestProto3Optional_OneofUint32) isTestProto3Optional_OneofField() {}
+
+func (*TestProto3Optional_OneofString) isTestProto3Optional_OneofField() {}
+
+var File_internal_testprotos_test3_test_optional_proto protoreflect.FileDescriptor
+
+var file_internal_testprotos_test3_test_optional_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x33, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x13, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x33, 0x1a, 0x24, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x33, 0x2f,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x0a, 0x0a, 0x12, 0x54,
+	0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x33, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x12, 0x2a, 0x0a, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x0d, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a,
+	0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x03, 0x52, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x55, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x04, 0x52, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x55, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x11, 0x48, 0x05,
+	0x52, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x12, 0x48, 0x06, 0x52, 0x0e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x88, 0x01,
+	0x01, 0x12, 0x2e, 0x0a, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x07, 0x48, 0x07, 0x52, 0x0f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x88, 0x01,
+	0x01, 0x12, 0x2e, 0x0a, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x08, 0x20, 0x01, 0x28, 0x06, 0x48, 0x08, 0x52, 0x0f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x88, 0x01,
+	0x01, 0x12, 0x30, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0f, 0x48, 0x09, 0x52, 0x10,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x10, 0x48, 0x0a,
+	0x52, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x48, 0x0b, 0x52,
+	0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x48, 0x0c, 0x52, 0x0e, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x28, 0x0a, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6f, 0x6c,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0d, 0x52, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x42, 0x6f, 0x6f, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x0e, 0x52, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x0f, 0x52, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x62, 0x0a, 0x18, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x33, 0x2e, 0x46, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x10, 0x52, 0x16, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x59, 0x0a, 0x15, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x65, 0x6e, 0x75, 0x6d,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x33, 0x2e, 0x46, 0x6f, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x48, 0x11, 0x52, 0x13, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x88,
+	0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x69, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x69, 0x6e, 0x67,
+	0x75, 0x6c, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x23, 0x0a, 0x0c, 0x6f, 0x6e, 0x65,
+	0x6f, 0x66, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x00, 0x52, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x23,
+	0x0a, 0x0c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x42, 0x0d, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x42, 0x13, 0x0a,
+	0x11, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x6c, 0x6f, 0x61,
+	0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x42, 0x1b,
+	0x0a, 0x19, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x18, 0x0a, 0x16, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_internal_testprotos_test3_test_optional_proto_rawDescOnce sync.Once
+	file_internal_testprotos_test3_test_optional_proto_rawDescData = file_internal_testprotos_test3_test_optional_proto_rawDesc
+)
+
+func file_internal_testprotos_test3_test_optional_proto_rawDescGZIP() []byte {
+	file_internal_testprotos_test3_test_optional_proto_rawDescOnce.Do(func() {
+		file_internal_testprotos_test3_test_optional_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_testprotos_test3_test_optional_proto_rawDescData)
+	})
+	return file_internal_testprotos_test3_test_optional_proto_rawDescData
+}
+
+var file_internal_testprotos_test3_test_optional_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_internal_testprotos_test3_test_optional_proto_goTypes = []interface{}{
+	(*TestProto3Optional)(nil), // 0: goproto.proto.test3.TestProto3Optional
+	(*ForeignMessage)(nil),     // 1: goproto.proto.test3.ForeignMessage
+	(ForeignEnum)(0),           // 2: goproto.proto.test3.ForeignEnum
+}
+var file_internal_testprotos_test3_test_optional_proto_depIdxs = []int32{
+	1, // 0: goproto.proto.test3.TestProto3Optional.optional_foreign_message:type_name -> goproto.proto.test3.ForeignMessage
+	2, // 1: goproto.proto.test3.TestProto3Optional.optional_foreign_enum:type_name -> goproto.proto.test3.ForeignEnum
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_internal_testprotos_test3_test_optional_proto_init() }
+func file_internal_testprotos_test3_test_optional_proto_init() {
+	if File_internal_testprotos_test3_test_optional_proto != nil {
+		return
+	}
+	file_internal_testprotos_test3_test_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_internal_testprotos_test3_test_optional_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestProto3Optional); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.zeroCopied
+			default:
+				return nil
+			}
+		}
+	}
+	file_internal_testprotos_test3_test_optional_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*TestProto3Optional_OneofUint32)(nil),
+		(*TestProto3Optional_OneofString)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_internal_testprotos_test3_test_optional_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_testprotos_test3_test_optional_proto_goTypes,
+		DependencyIndexes: file_internal_testprotos_test3_test_optional_proto_depIdxs,
+		MessageInfos:      file_internal_testprotos_test3_test_optional_proto_msgTypes,
+	}.Build()
+	File_internal_testprotos_test3_test_optional_proto = out.File
+	file_internal_testprotos_test3_test_optional_proto_rawDesc = nil
+	file_internal_testprotos_test3_test_optional_proto_goTypes = nil
+	file_internal_testprotos_test3_test_optional_proto_depIdxs = nil
+}
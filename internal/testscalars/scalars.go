@@ -0,0 +1,62 @@
+// Package testscalars holds the Go types the scalars of the test protos are mapped to.
+package testscalars
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+)
+
+// Int is an integer of arbitrary precision, encoded in base 10.
+type Int struct {
+	i *big.Int
+}
+
+func NewInt(x int64) Int {
+	return Int{i: big.NewInt(x)}
+}
+
+func (i Int) String() string {
+	if i.i == nil {
+		return "<nil>"
+	}
+	return i.i.String()
+}
+
+func (i Int) Marshal() ([]byte, error) {
+	if i.i == nil {
+		return nil, nil
+	}
+	return []byte(i.i.String()), nil
+}
+
+func (i *Int) Unmarshal(b []byte) error {
+	v, ok := new(big.Int).SetString(string(b), 10)
+	if !ok {
+		return fmt.Errorf("invalid integer %q", b)
+	}
+	i.i = v
+	return nil
+}
+
+// Hash is a hash of 32 bytes.
+type Hash []byte
+
+func (h Hash) Marshal() ([]byte, error) {
+	if len(h) != 0 && len(h) != 32 {
+		return nil, fmt.Errorf("invalid hash length %d", len(h))
+	}
+	return h, nil
+}
+
+func (h *Hash) Unmarshal(b []byte) error {
+	if len(b) != 32 {
+		return fmt.Errorf("invalid hash length %d", len(b))
+	}
+	*h = bytes.Clone(b)
+	return nil
+}
+
+func (h Hash) Size() int {
+	return len(h)
+}
@@ -0,0 +1,419 @@
+package runtime
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// The functions below implement the Amino JSON encoding, whose output is signed in
+// SIGN_MODE_LEGACY_AMINO_JSON. The messages generated with the amino feature encode their fields
+// through their AppendAminoJSON method and defer to AppendAminoJSONMessage for the messages of
+// other types, which are encoded through reflection.
+//
+// The encoding is the one of the legacy Amino codec once the sign bytes have their keys sorted:
+//   - objects hold the populated fields, named after their proto name and sorted by name,
+//     zero values, empty lists and empty maps being omitted,
+//   - 64-bit integers are JSON strings and enums are JSON numbers,
+//   - strings are escaped like encoding/json does, HTML characters included,
+//   - floats are encoded like encoding/json does, NaN and infinites being rejected,
+//   - bytes are encoded in standard base64,
+//   - map keys are JSON strings, sorted in their string order,
+//   - google.protobuf.Any is encoded as {"type":name,"value":message}, where name is the
+//     Amino name of the type of the message, set by its amino.name option,
+//   - google.protobuf.Timestamp is encoded as an RFC 3339 string and google.protobuf.Duration
+//     as its number of nanoseconds in a JSON string.
+//
+// Extensions and unknown fields are never encoded.
+
+const (
+	timestampName protoreflect.FullName = "google.protobuf.Timestamp"
+	durationName  protoreflect.FullName = "google.protobuf.Duration"
+)
+
+// aminoJSONAppender is implemented by the messages generated with the amino feature.
+type aminoJSONAppender interface {
+	AppendAminoJSON(b []byte) ([]byte, error)
+}
+
+// aminoNameNumber is the field number of the amino.name message option of the Cosmos SDK,
+// declared in amino/amino.proto, which holds the Amino name of the message type.
+const aminoNameNumber protowire.Number = 11110001
+
+// aminoNameOption is the full name of the amino.name message option.
+const aminoNameOption protoreflect.FullName = "amino.name"
+
+// AminoName returns the Amino name of the message type, read from its amino.name option,
+// which identifies the type of the messages held by a google.protobuf.Any in their Amino
+// JSON encoding, like the names registered with the RegisterConcrete method of the legacy
+// Amino codec. The option is read even when amino/amino.proto is not linked in the binary.
+func AminoName(md protoreflect.MessageDescriptor) (string, bool) {
+	options := md.Options().ProtoReflect()
+	var name string
+	var ok bool
+	options.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.IsExtension() && fd.FullName() == aminoNameOption && fd.Kind() == protoreflect.StringKind {
+			name, ok = v.String(), true
+			return false
+		}
+		return true
+	})
+	if ok {
+		return name, name != ""
+	}
+	// the option is left in the unknown fields when its extension is not registered
+	for b := options.GetUnknown(); len(b) > 0; {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return "", false
+		}
+		b = b[n:]
+		if num == aminoNameNumber && typ == protowire.BytesType {
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return "", false
+			}
+			// the last occurrence wins
+			name, ok = string(v), true
+			b = b[n:]
+			continue
+		}
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return "", false
+		}
+		b = b[n:]
+	}
+	return name, ok && name != ""
+}
+
+// MarshalAminoJSON returns the Amino JSON encoding of m.
+func MarshalAminoJSON(m proto.Message) ([]byte, error) {
+	return AppendAminoJSONMessage(nil, m)
+}
+
+// AppendAminoJSONMessage appends the Amino JSON encoding of m to b.
+func AppendAminoJSONMessage(b []byte, m proto.Message) ([]byte, error) {
+	if m == nil {
+		return append(b, "{}"...), nil
+	}
+	return appendAminoJSONMessage(b, m.ProtoReflect())
+}
+
+func appendAminoJSONMessage(b []byte, m protoreflect.Message) ([]byte, error) {
+	switch m.Descriptor().FullName() {
+	case anyName:
+		return appendAminoJSONAny(b, m, "")
+	case timestampName:
+		return appendAminoJSONTimestamp(b, m)
+	case durationName:
+		return appendAminoJSONDuration(b, m)
+	}
+	if msg, ok := m.Interface().(aminoJSONAppender); ok {
+		return msg.AppendAminoJSON(b)
+	}
+
+	var err error
+	b = append(b, '{')
+	for _, fd := range aminoJSONFields(m.Descriptor()) {
+		if !m.Has(fd) {
+			continue
+		}
+		v := m.Get(fd)
+		// like the generated code, the negative zero of fields without presence is omitted
+		if !fd.HasPresence() && !fd.IsList() && !fd.IsMap() && (fd.Kind() == protoreflect.FloatKind || fd.Kind() == protoreflect.DoubleKind) && v.Float() == 0 {
+			continue
+		}
+		b = AppendAminoJSONString(b, string(fd.Name()))
+		b = append(b, ':')
+		switch {
+		case fd.IsList():
+			b, err = appendAminoJSONList(b, fd, v.List())
+		case fd.IsMap():
+			b, err = appendAminoJSONMap(b, fd, v.Map())
+		default:
+			b, err = appendAminoJSONValue(b, fd, v)
+		}
+		if err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	return endJSON(b, '}'), nil
+}
+
+// aminoJSONFields returns the fields of md in the order of their name, weak fields excluded.
+func aminoJSONFields(md protoreflect.MessageDescriptor) []protoreflect.FieldDescriptor {
+	fields := make([]protoreflect.FieldDescriptor, 0, md.Fields().Len())
+	for i := 0; i < md.Fields().Len(); i++ {
+		if fd := md.Fields().Get(i); !fd.IsWeak() {
+			fields = append(fields, fd)
+		}
+	}
+	slices.SortFunc(fields, func(x, y protoreflect.FieldDescriptor) int {
+		return cmp.Compare(x.Name(), y.Name())
+	})
+	return fields
+}
+
+func appendAminoJSONList(b []byte, fd protoreflect.FieldDescriptor, list protoreflect.List) ([]byte, error) {
+	var err error
+	b = append(b, '[')
+	for i := 0; i < list.Len(); i++ {
+		if b, err = appendAminoJSONValue(b, fd, list.Get(i)); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	return endJSON(b, ']'), nil
+}
+
+func appendAminoJSONMap(b []byte, fd protoreflect.FieldDescriptor, m protoreflect.Map) ([]byte, error) {
+	type entry struct {
+		key   string
+		value protoreflect.Value
+	}
+	entries := make([]entry, 0, m.Len())
+	m.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		entries = append(entries, entry{key: k.String(), value: v})
+		return true
+	})
+	slices.SortFunc(entries, func(x, y entry) int {
+		return cmp.Compare(x.key, y.key)
+	})
+	var err error
+	b = append(b, '{')
+	for _, e := range entries {
+		b = AppendAminoJSONString(b, e.key)
+		b = append(b, ':')
+		if b, err = appendAminoJSONValue(b, fd.MapValue(), e.value); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	return endJSON(b, '}'), nil
+}
+
+// appendAminoJSONValue appends v, a singular value of fd or an element of its list.
+func appendAminoJSONValue(b []byte, fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]byte, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return strconv.AppendBool(b, v.Bool()), nil
+	case protoreflect.StringKind:
+		return AppendAminoJSONString(b, v.String()), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return strconv.AppendInt(b, v.Int(), 10), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return strconv.AppendUint(b, v.Uint(), 10), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return AppendJSONInt64(b, v.Int()), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return AppendJSONUint64(b, v.Uint()), nil
+	case protoreflect.FloatKind:
+		return AppendAminoJSONFloat(b, v.Float(), 32)
+	case protoreflect.DoubleKind:
+		return AppendAminoJSONFloat(b, v.Float(), 64)
+	case protoreflect.BytesKind:
+		return AppendJSONBytes(b, v.Bytes()), nil
+	case protoreflect.EnumKind:
+		return strconv.AppendInt(b, int64(v.Enum()), 10), nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if fd.Message().FullName() == anyName {
+			return appendAminoJSONAny(b, v.Message(), AcceptsInterface(fd))
+		}
+		return appendAminoJSONMessage(b, v.Message())
+	default:
+		panic(fmt.Sprintf("%v has unknown kind: %v", fd.FullName(), fd.Kind()))
+	}
+}
+
+// AppendAminoJSONAny appends the Amino JSON encoding of m, a google.protobuf.Any, to b. Unless
+// iface is empty, the type of the message held by m must implement the interface iface,
+// accepted by the field holding m.
+func AppendAminoJSONAny(b []byte, m proto.Message, iface string) ([]byte, error) {
+	return appendAminoJSONAny(b, m.ProtoReflect(), iface)
+}
+
+func appendAminoJSONAny(b []byte, m protoreflect.Message, iface string) ([]byte, error) {
+	fields := m.Descriptor().Fields()
+	fdType, fdValue := fields.ByNumber(1), fields.ByNumber(2)
+	if !m.Has(fdType) {
+		if !m.Has(fdValue) {
+			// the encoding of a nil interface
+			return append(b, "null"...), nil
+		}
+		return b, fmt.Errorf("%s: %v is not set", anyName, fdType.Name())
+	}
+
+	typeURL := m.Get(fdType).String()
+	mt, err := protoregistry.GlobalTypes.FindMessageByURL(typeURL)
+	if err != nil {
+		return b, fmt.Errorf("%s: unable to resolve %q: %v", anyName, typeURL, err)
+	}
+	md := mt.Descriptor()
+	if err := checkInterface(md, iface); err != nil {
+		return b, fmt.Errorf("%s: %w", anyName, err)
+	}
+	name, ok := AminoName(md)
+	if !ok {
+		return b, fmt.Errorf("%s: %s has no amino.name option", anyName, md.FullName())
+	}
+	em := mt.New()
+	err = proto.UnmarshalOptions{
+		AllowPartial: true,
+		Resolver:     protoregistry.GlobalTypes,
+	}.Unmarshal(m.Get(fdValue).Bytes(), em.Interface())
+	if err != nil {
+		return b, fmt.Errorf("%s: unable to unmarshal %q: %v", anyName, typeURL, err)
+	}
+
+	b = append(b, `{"type":`...)
+	b = AppendAminoJSONString(b, name)
+	b = append(b, `,"value":`...)
+	if b, err = appendAminoJSONMessage(b, em); err != nil {
+		return b, err
+	}
+	return append(b, '}'), nil
+}
+
+func appendAminoJSONTimestamp(b []byte, m protoreflect.Message) ([]byte, error) {
+	fields := m.Descriptor().Fields()
+	secs := m.Get(fields.ByNumber(1)).Int()
+	nanos := m.Get(fields.ByNumber(2)).Int()
+	if secs < minTimestampSeconds || secs > maxTimestampSeconds {
+		return b, fmt.Errorf("%s: seconds out of range %v", timestampName, secs)
+	}
+	if nanos < 0 || nanos > secondsInNanos {
+		return b, fmt.Errorf("%s: nanos out of range %v", timestampName, nanos)
+	}
+	b = append(b, '"')
+	b = time.Unix(secs, nanos).UTC().AppendFormat(b, time.RFC3339Nano)
+	return append(b, '"'), nil
+}
+
+func appendAminoJSONDuration(b []byte, m protoreflect.Message) ([]byte, error) {
+	fields := m.Descriptor().Fields()
+	secs := m.Get(fields.ByNumber(1)).Int()
+	nanos := m.Get(fields.ByNumber(2)).Int()
+	if nanos < -secondsInNanos || nanos > secondsInNanos || (secs > 0 && nanos < 0) || (secs < 0 && nanos > 0) {
+		return b, fmt.Errorf("%s: nanos out of range %v", durationName, nanos)
+	}
+	// the duration must fit the int64 of time.Duration, as in the legacy Amino codec
+	if secs > math.MaxInt64/int64(time.Second) || secs < math.MinInt64/int64(time.Second) {
+		return b, fmt.Errorf("%s: seconds out of range %v", durationName, secs)
+	}
+	d := secs*int64(time.Second) + nanos
+	if (secs > 0 && d < 0) || (secs < 0 && d > 0) {
+		return b, fmt.Errorf("%s: seconds out of range %v", durationName, secs)
+	}
+	return AppendJSONInt64(b, d), nil
+}
+
+// AppendAminoJSONString appends s to b as a JSON string, escaped like encoding/json does:
+// HTML characters, U+2028 and U+2029 are escaped and invalid UTF-8 is replaced with U+FFFD.
+func AppendAminoJSONString(b []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	b = append(b, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= ' ' && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			b = append(b, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				b = append(b, '\\', c)
+			case '\b':
+				b = append(b, '\\', 'b')
+			case '\f':
+				b = append(b, '\\', 'f')
+			case '\n':
+				b = append(b, '\\', 'n')
+			case '\r':
+				b = append(b, '\\', 'r')
+			case '\t':
+				b = append(b, '\\', 't')
+			default:
+				b = append(b, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			b = append(b, s[start:i]...)
+			b = append(b, `\ufffd`...)
+		case r == '\u2028' || r == '\u2029':
+			b = append(b, s[start:i]...)
+			b = append(b, '\\', 'u', '2', '0', '2', hex[r&0xf])
+		default:
+			i += size
+			continue
+		}
+		i += size
+		start = i
+	}
+	b = append(b, s[start:]...)
+	return append(b, '"')
+}
+
+// errAminoJSONFloat reports a NaN or infinite float, which have no Amino JSON encoding.
+var errAminoJSONFloat = errors.New("amino JSON: unsupported float value")
+
+// AppendAminoJSONFloat appends v, a float of bitSize bits, to b as a JSON number formatted
+// like encoding/json does. NaN and infinites are rejected.
+func AppendAminoJSONFloat(b []byte, v float64, bitSize int) ([]byte, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return b, fmt.Errorf("%w %v", errAminoJSONFloat, v)
+	}
+	format := byte('f')
+	if abs := math.Abs(v); abs != 0 {
+		if bitSize == 64 && (abs < 1e-6 || abs >= 1e21) || bitSize == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+	b = strconv.AppendFloat(b, v, format, -1, bitSize)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		if n := len(b); n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return b, nil
+}
+
+// SortedAminoJSONKeys returns the keys of m in the order of their JSON string, which is the
+// order of the keys of the Amino JSON encoding of the map.
+func SortedAminoJSONKeys[K comparable, V any](m map[K]V) []K {
+	type entry struct {
+		key string
+		k   K
+	}
+	entries := make([]entry, 0, len(m))
+	for k := range m {
+		entries = append(entries, entry{key: fmt.Sprint(k), k: k})
+	}
+	slices.SortFunc(entries, func(x, y entry) int {
+		return cmp.Compare(x.key, y.key)
+	})
+	keys := make([]K, len(entries))
+	for i, e := range entries {
+		keys[i] = e.k
+	}
+	return keys
+}
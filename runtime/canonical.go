@@ -0,0 +1,590 @@
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// CanonicalError reports the first difference between the validated bytes and the canonical
+// encoding of the message.
+type CanonicalError struct {
+	// Message is the full name of the validated message.
+	Message protoreflect.FullName
+	// Offset is the offset in the validated bytes of the tag or value at fault.
+	Offset int
+	// Path locates the field at fault from the validated message through field names,
+	// list indexes and map keys, e.g. "items[1].owner.id". It is empty when the field
+	// could not be decoded.
+	Path string
+	// Reason describes the difference.
+	Reason string
+}
+
+func (e *CanonicalError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("proto: non-canonical encoding of %s at offset %d: %s", e.Message, e.Offset, e.Reason)
+	}
+	return fmt.Sprintf("proto: non-canonical encoding of %s at offset %d: %s: %s", e.Message, e.Offset, e.Path, e.Reason)
+}
+
+// Is reports the error as a protobuf error, matching proto.Error.
+func (e *CanonicalError) Is(target error) bool {
+	return target == proto.Error
+}
+
+// CanonicalValidator is implemented by the messages generated with fast reflection, which
+// validate their canonical encoding without going through their descriptor.
+type CanonicalValidator interface {
+	ValidateCanonical(b []byte) error
+}
+
+// ValidateCanonical reports whether buf is the canonical encoding of a message described by md,
+// which is the encoding produced by the deterministic marshal of the message it decodes to.
+// It returns nil if it is, and a *CanonicalError locating the first difference otherwise.
+//
+// The canonical encoding holds the populated extensions first, sorted by number, then the other
+// fields sorted by number, then the member of each oneof in the order of declaration of the
+// oneofs, and finally the unknown fields. Varints are minimal, proto3 scalars holding their zero
+// value are omitted, repeated scalars are packed as their descriptor requires, singular fields
+// appear once, map entries hold their key and their value and are sorted by key, and nested
+// messages are canonical too. Extensions and unknown fields are only checked to be well formed.
+// Unlike the unmarshal, the validation does not check the UTF-8 encoding of strings, nor the
+// presence of required fields.
+//
+// The generated ValidateCanonical method of md is used when its Go type is registered.
+func ValidateCanonical(buf []byte, md protoreflect.MessageDescriptor) error {
+	return CanonicalValidatorOf(md).ValidateCanonical(buf)
+}
+
+// CanonicalValidatorOf returns the generated validator of md or, failing that, a validator walking
+// the message through its descriptor.
+func CanonicalValidatorOf(md protoreflect.MessageDescriptor) CanonicalValidator {
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName()); err == nil && mt.Descriptor() == md {
+		if v, ok := mt.Zero().Interface().(CanonicalValidator); ok {
+			return v
+		}
+	}
+	return descriptorValidator{md: md}
+}
+
+type descriptorValidator struct {
+	md protoreflect.MessageDescriptor
+}
+
+func (v descriptorValidator) ValidateCanonical(b []byte) error {
+	md := v.md
+	w := NewCanonicalWalker(b, md.FullName())
+	for w.Next() {
+		fd := md.Fields().ByNumber(w.Number())
+		if fd == nil {
+			if md.ExtensionRanges().Has(w.Number()) {
+				w.Extension()
+			} else {
+				w.Unknown()
+			}
+			continue
+		}
+		if od := fd.ContainingOneof(); od != nil && !od.IsSynthetic() {
+			w.Oneof(realOneofIndex(od))
+		}
+		name := string(fd.Name())
+		switch {
+		case fd.IsMap():
+			var value CanonicalValidator
+			if md := fd.MapValue().Message(); md != nil {
+				value = CanonicalValidatorOf(md)
+			}
+			w.Map(name, fd.MapKey().Kind(), fd.MapValue().Kind(), value)
+		case fd.Kind() == protoreflect.MessageKind:
+			w.Message(name, fd.IsList(), CanonicalValidatorOf(fd.Message()))
+		case fd.Kind() == protoreflect.GroupKind:
+			w.Group(name, fd.IsList(), CanonicalValidatorOf(fd.Message()))
+		case fd.IsList():
+			w.List(name, fd.Kind(), fd.IsPacked())
+		default:
+			w.Scalar(name, fd.Kind(), !fd.HasPresence())
+		}
+	}
+	return w.Err()
+}
+
+// realOneofIndex returns the index of the oneof among the oneofs of its message which
+// are not synthetic, which is the order in which the marshal encodes them.
+func realOneofIndex(od protoreflect.OneofDescriptor) int {
+	oneofs := od.Parent().(protoreflect.MessageDescriptor).Oneofs()
+	n := 0
+	for i := 0; i < od.Index(); i++ {
+		if !oneofs.Get(i).IsSynthetic() {
+			n++
+		}
+	}
+	return n
+}
+
+// The order of the fields in the canonical encoding. Extensions are ordered by number,
+// the fields of the message by number after them, then oneofs by index and then the
+// unknown fields, in any order.
+const (
+	fieldsOrder  = 1 << 29
+	oneofsOrder  = 1 << 30
+	unknownOrder = 1 << 31
+)
+
+// CanonicalWalker walks the fields of an encoded message, validating that they are canonically
+// encoded. It is driven by the ValidateCanonical methods of the generated messages, which call
+// Next and then, according to the number of the field, the method describing it, and finally
+// return the first error found, reported by Err.
+type CanonicalWalker struct {
+	message protoreflect.FullName
+	b       []byte
+	i       int // offset of the next field, or of the value of the current field
+	start   int // offset of the tag of the current field
+	num     protoreflect.FieldNumber
+	typ     protowire.Type
+	oneof   int // 1 + index of the oneof of the current field
+
+	order    int64                    // order of the previous field
+	prevNum  protoreflect.FieldNumber // number of the previous field
+	count    int                      // consecutive occurrences of the previous field
+	prevKey  uint64                   // key of the previous map entry, unless a string
+	prevSKey []byte                   // key of the previous map entry, if a string
+
+	err error
+}
+
+// NewCanonicalWalker returns a walker of the fields encoded in b, those of the message named message.
+func NewCanonicalWalker(b []byte, message protoreflect.FullName) CanonicalWalker {
+	return CanonicalWalker{message: message, b: b}
+}
+
+// Next decodes the tag of the next field, returning false when there are no more fields or when
+// an error has been found.
+func (w *CanonicalWalker) Next() bool {
+	if w.err != nil || w.i >= len(w.b) {
+		return false
+	}
+	w.start = w.i
+	w.oneof = 0
+	v, n := protowire.ConsumeVarint(w.b[w.i:])
+	if n < 0 {
+		w.fail(w.i, "", "invalid tag: %v", protowire.ParseError(n))
+		return false
+	}
+	if n != protowire.SizeVarint(v) {
+		w.fail(w.i, "", "non-minimal tag")
+		return false
+	}
+	num, typ := protowire.DecodeTag(v)
+	if num < protowire.MinValidNumber || v>>3 > uint64(protowire.MaxValidNumber) {
+		w.fail(w.i, "", "invalid field number %d", v>>3)
+		return false
+	}
+	w.i += n
+	w.num, w.typ = num, typ
+	return true
+}
+
+// Number returns the number of the current field.
+func (w *CanonicalWalker) Number() protoreflect.FieldNumber {
+	return w.num
+}
+
+// Err returns the first error found, or nil if the fields walked so far are canonical.
+func (w *CanonicalWalker) Err() error {
+	return w.err
+}
+
+// Oneof declares the current field as a member of the oneof of the given index, not counting
+// synthetic oneofs. It is called before the method describing the field.
+func (w *CanonicalWalker) Oneof(index int) {
+	w.oneof = index + 1
+}
+
+// Scalar validates the current field as a singular scalar field of the given kind. Fields with
+// implicit presence must not hold their zero value.
+func (w *CanonicalWalker) Scalar(name string, kind protoreflect.Kind, implicit bool) {
+	if !w.wireType(name, kind, false) || !w.inOrder(name, false) {
+		return
+	}
+	start := w.i
+	v, ok := w.value(name, kind)
+	if ok && implicit && v == 0 {
+		w.fail(start, name, "zero value of a field without presence")
+	}
+}
+
+// List validates the current field as a repeated scalar field of the given kind, which is either
+// packed, all the elements being encoded in a single non-empty field, or encoded one by one.
+func (w *CanonicalWalker) List(name string, kind protoreflect.Kind, packed bool) {
+	if !w.wireType(name, kind, packed) || !w.inOrder(name, !packed) {
+		return
+	}
+	if !packed {
+		w.value(name, kind)
+		return
+	}
+	start := w.i
+	l, ok := w.length(name)
+	if !ok {
+		return
+	}
+	if l == 0 {
+		w.fail(start, name, "empty packed field")
+		return
+	}
+	end := w.i + int(l)
+	for w.i < end {
+		if _, ok := w.value(name, kind); !ok {
+			return
+		}
+	}
+	if w.i != end {
+		w.fail(end, name, "truncated packed element")
+	}
+}
+
+// Message validates the current field as a field holding messages validated by v.
+func (w *CanonicalWalker) Message(name string, list bool, v CanonicalValidator) {
+	if !w.wireType(name, protoreflect.MessageKind, false) || !w.inOrder(name, list) {
+		return
+	}
+	path := w.elementPath(name, list)
+	l, ok := w.length(path)
+	if !ok {
+		return
+	}
+	w.nested(w.i, path, v.ValidateCanonical(w.b[w.i:w.i+int(l)]))
+	w.i += int(l)
+}
+
+// Group validates the current field as a field holding groups validated by v.
+func (w *CanonicalWalker) Group(name string, list bool, v CanonicalValidator) {
+	if !w.wireType(name, protoreflect.GroupKind, false) || !w.inOrder(name, list) {
+		return
+	}
+	path := w.elementPath(name, list)
+	n := protowire.ConsumeFieldValue(w.num, protowire.StartGroupType, w.b[w.i:])
+	if n < 0 {
+		w.fail(w.i, path, "%v", protowire.ParseError(n))
+		return
+	}
+	end := w.i + n - protowire.SizeTag(w.num)
+	if !bytes.Equal(w.b[end:w.i+n], protowire.AppendTag(nil, w.num, protowire.EndGroupType)) {
+		w.fail(end, path, "non-minimal end group tag")
+		return
+	}
+	w.nested(w.i, path, v.ValidateCanonical(w.b[w.i:end]))
+	w.i += n
+}
+
+// Map validates the current field as an entry of a map field, holding its key and then its value,
+// with keys strictly increasing. Message values are validated by value.
+func (w *CanonicalWalker) Map(name string, keyKind, valueKind protoreflect.Kind, value CanonicalValidator) {
+	if !w.wireType(name, protoreflect.MessageKind, false) || !w.inOrder(name, true) {
+		return
+	}
+	l, ok := w.length(name)
+	if !ok {
+		return
+	}
+	end := w.i + int(l)
+	if !w.entryTag(name, 1, keyKind, end) {
+		return
+	}
+	keyStart := w.i
+	key, ok := w.value(name, keyKind)
+	if !ok {
+		return
+	}
+	if w.count > 1 && w.compareKey(keyKind, key, w.b[keyStart:w.i]) <= 0 {
+		w.fail(keyStart, name, "map entries not sorted by key")
+		return
+	}
+	w.prevKey, w.prevSKey = key, w.b[keyStart:w.i]
+	path := fmt.Sprintf("%s[%v]", name, w.formatKey(keyKind, key))
+	if !w.entryTag(path, 2, valueKind, end) {
+		return
+	}
+	if valueKind == protoreflect.MessageKind {
+		l, ok := w.length(path)
+		if !ok {
+			return
+		}
+		if w.i+int(l) > end {
+			w.fail(w.i, path, "value overflows its map entry")
+			return
+		}
+		w.nested(w.i, path, value.ValidateCanonical(w.b[w.i:w.i+int(l)]))
+		w.i += int(l)
+	} else if _, ok := w.value(path, valueKind); !ok {
+		return
+	}
+	if w.err == nil && w.i != end {
+		w.fail(w.i, name, "map entry holding more than its key and value")
+	}
+}
+
+// Extension validates the current field as an extension, checking that it is well formed
+// and sorted by number.
+func (w *CanonicalWalker) Extension() {
+	name := fmt.Sprintf("[%d]", w.num)
+	if w.inOrderAt(name, int64(w.num), true) {
+		w.skip(name)
+	}
+}
+
+// Unknown validates the current field as an unknown field, checking that it is well formed
+// and follows the known fields.
+func (w *CanonicalWalker) Unknown() {
+	name := fmt.Sprintf("%d", w.num)
+	if w.inOrderAt(name, unknownOrder, true) {
+		w.skip(name)
+	}
+}
+
+// skip skips the current field, whose content is not validated.
+func (w *CanonicalWalker) skip(path string) {
+	n, err := Skip(w.b[w.start:])
+	if err != nil {
+		w.fail(w.start, path, "%v", err)
+		return
+	}
+	w.i = w.start + n
+}
+
+// inOrder checks the order of the current field, which may follow a previous occurrence when repeats is set.
+func (w *CanonicalWalker) inOrder(name string, repeats bool) bool {
+	order := fieldsOrder + int64(w.num)
+	if w.oneof != 0 {
+		order = oneofsOrder + int64(w.oneof-1)
+	}
+	return w.inOrderAt(name, order, repeats)
+}
+
+func (w *CanonicalWalker) inOrderAt(name string, order int64, repeats bool) bool {
+	switch {
+	case w.count > 0 && order < w.order:
+		w.fail(w.start, name, "field out of order")
+		return false
+	case w.count > 0 && order == w.order && w.num != w.prevNum:
+		if order == unknownOrder {
+			w.prevNum, w.count = w.num, 1
+			return true
+		}
+		w.fail(w.start, name, "several fields of a oneof")
+		return false
+	case w.count > 0 && order == w.order:
+		if !repeats {
+			w.fail(w.start, name, "duplicate field")
+			return false
+		}
+		w.count++
+		return true
+	}
+	w.order, w.prevNum, w.count = order, w.num, 1
+	return true
+}
+
+// wireType checks the wire type of the current field, holding values of the given kind.
+func (w *CanonicalWalker) wireType(name string, kind protoreflect.Kind, packed bool) bool {
+	expected := wireTypeOf(kind)
+	if packed {
+		expected = protowire.BytesType
+	}
+	switch {
+	case w.typ == expected:
+		return true
+	case packed && w.typ == wireTypeOf(kind):
+		w.fail(w.start, name, "unpacked elements of a packed field")
+	default:
+		w.fail(w.start, name, "wire type %d instead of %d", w.typ, expected)
+	}
+	return false
+}
+
+// entryTag checks the tag of the key or the value of a map entry ending at end.
+func (w *CanonicalWalker) entryTag(path string, num protowire.Number, kind protoreflect.Kind, end int) bool {
+	tag := protowire.AppendTag(nil, num, wireTypeOf(kind))
+	if w.i+len(tag) > end || !bytes.Equal(w.b[w.i:w.i+len(tag)], tag) {
+		if num == 1 {
+			w.fail(w.i, path, "map entry not starting with its key")
+		} else {
+			w.fail(w.i, path, "map entry without a value following its key")
+		}
+		return false
+	}
+	w.i += len(tag)
+	return true
+}
+
+// length decodes the length of the current field, which must fit in the remaining bytes.
+func (w *CanonicalWalker) length(path string) (uint64, bool) {
+	start := w.i
+	l, ok := w.varint(path)
+	if ok && l > uint64(len(w.b)-w.i) {
+		w.fail(start, path, "length overflowing the message")
+		return 0, false
+	}
+	return l, ok
+}
+
+func (w *CanonicalWalker) varint(path string) (uint64, bool) {
+	v, n := protowire.ConsumeVarint(w.b[w.i:])
+	if n < 0 {
+		w.fail(w.i, path, "%v", protowire.ParseError(n))
+		return 0, false
+	}
+	if n != protowire.SizeVarint(v) {
+		w.fail(w.i, path, "non-minimal varint")
+		return 0, false
+	}
+	w.i += n
+	return v, true
+}
+
+// value decodes a value of the given kind, returning its bits: the value of varints, the bits of
+// fixed size values and the length of strings and bytes.
+func (w *CanonicalWalker) value(path string, kind protoreflect.Kind) (uint64, bool) {
+	start := w.i
+	switch wireTypeOf(kind) {
+	case protowire.Fixed32Type:
+		v, n := protowire.ConsumeFixed32(w.b[w.i:])
+		if n < 0 {
+			w.fail(w.i, path, "%v", protowire.ParseError(n))
+			return 0, false
+		}
+		w.i += n
+		return uint64(v), true
+	case protowire.Fixed64Type:
+		v, n := protowire.ConsumeFixed64(w.b[w.i:])
+		if n < 0 {
+			w.fail(w.i, path, "%v", protowire.ParseError(n))
+			return 0, false
+		}
+		w.i += n
+		return v, true
+	case protowire.BytesType:
+		l, ok := w.length(path)
+		if ok {
+			w.i += int(l)
+		}
+		return l, ok
+	}
+	v, ok := w.varint(path)
+	if !ok {
+		return 0, false
+	}
+	switch kind {
+	case protoreflect.BoolKind:
+		ok = v <= 1
+	case protoreflect.Int32Kind, protoreflect.EnumKind:
+		ok = v == uint64(int64(int32(v)))
+	case protoreflect.Uint32Kind, protoreflect.Sint32Kind:
+		ok = v <= 1<<32-1
+	}
+	if !ok {
+		w.fail(start, path, "value %d out of the range of %s", v, kind)
+	}
+	return v, ok
+}
+
+// compareKey compares the key of a map entry, whose bits are key and whose encoding is raw,
+// with the key of the previous entry.
+func (w *CanonicalWalker) compareKey(kind protoreflect.Kind, key uint64, raw []byte) int {
+	switch kind {
+	case protoreflect.StringKind:
+		_, n := protowire.ConsumeVarint(raw)
+		_, pn := protowire.ConsumeVarint(w.prevSKey)
+		return bytes.Compare(raw[n:], w.prevSKey[pn:])
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		a, b := signedKey(kind, key), signedKey(kind, w.prevKey)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	}
+	switch {
+	case key < w.prevKey:
+		return -1
+	case key > w.prevKey:
+		return 1
+	}
+	return 0
+}
+
+func signedKey(kind protoreflect.Kind, v uint64) int64 {
+	switch kind {
+	case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return protowire.DecodeZigZag(v)
+	case protoreflect.Sfixed32Kind:
+		return int64(int32(v))
+	}
+	return int64(v)
+}
+
+// formatKey returns the key of the current map entry as formatted in paths.
+func (w *CanonicalWalker) formatKey(kind protoreflect.Kind, key uint64) interface{} {
+	switch kind {
+	case protoreflect.StringKind:
+		_, n := protowire.ConsumeVarint(w.prevSKey)
+		return string(w.prevSKey[n:])
+	case protoreflect.BoolKind:
+		return key == 1
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return key
+	}
+	return signedKey(kind, key)
+}
+
+// elementPath returns the path of the current element of the field.
+func (w *CanonicalWalker) elementPath(name string, list bool) string {
+	if !list {
+		return name
+	}
+	return fmt.Sprintf("%s[%d]", name, w.count-1)
+}
+
+// nested records err, found when validating the message encoded at offset, held by path.
+func (w *CanonicalWalker) nested(offset int, path string, err error) {
+	if err == nil {
+		return
+	}
+	ce, ok := err.(*CanonicalError)
+	if !ok {
+		w.err = err
+		return
+	}
+	if ce.Path != "" {
+		path += "." + ce.Path
+	}
+	w.fail(offset+ce.Offset, path, "%s", ce.Reason)
+}
+
+func (w *CanonicalWalker) fail(offset int, path string, format string, args ...interface{}) {
+	if w.err == nil {
+		w.err = &CanonicalError{Message: w.message, Offset: offset, Path: path, Reason: fmt.Sprintf(format, args...)}
+	}
+}
+
+func wireTypeOf(kind protoreflect.Kind) protowire.Type {
+	switch kind {
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind, protoreflect.FloatKind:
+		return protowire.Fixed32Type
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind, protoreflect.DoubleKind:
+		return protowire.Fixed64Type
+	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.MessageKind:
+		return protowire.BytesType
+	case protoreflect.GroupKind:
+		return protowire.StartGroupType
+	}
+	return protowire.VarintType
+}
@@ -0,0 +1,209 @@
+package runtime
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// canonicalDescriptor returns the descriptor of
+//
+//	message Canonical {
+//	  int64 n = 1;
+//	  repeated int32 list = 2;
+//	  Canonical nested = 3;
+//	  map<string, int64> m = 4;
+//	}
+//
+// which is not registered, so that it is validated through its descriptor.
+func canonicalDescriptor(t *testing.T) protoreflect.MessageDescriptor {
+	field := func(name string, num int32, label descriptorpb.FieldDescriptorProto_Label, typ descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+		fd := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(num),
+			Label:    label.Enum(),
+			Type:     typ.Enum(),
+		}
+		if typeName != "" {
+			fd.TypeName = proto.String(typeName)
+		}
+		return fd
+	}
+	optional, repeated := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("canonical_test.proto"),
+		Package: proto.String("canonical"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Canonical"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("n", 1, optional, descriptorpb.FieldDescriptorProto_TYPE_INT64, ""),
+				field("list", 2, repeated, descriptorpb.FieldDescriptorProto_TYPE_INT32, ""),
+				field("nested", 3, optional, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".canonical.Canonical"),
+				field("m", 4, repeated, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".canonical.Canonical.MEntry"),
+			},
+			NestedType: []*descriptorpb.DescriptorProto{{
+				Name: proto.String("MEntry"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("key", 1, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+					field("value", 2, optional, descriptorpb.FieldDescriptorProto_TYPE_INT64, ""),
+				},
+				Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+			}},
+		}},
+	}, nil)
+	require.NoError(t, err)
+	return fd.Messages().Get(0)
+}
+
+// appendEntry appends an entry of the map field m.
+func appendEntry(b []byte, key string, value int64) []byte {
+	var entry []byte
+	entry = protowire.AppendTag(entry, 1, protowire.BytesType)
+	entry = protowire.AppendString(entry, key)
+	entry = protowire.AppendTag(entry, 2, protowire.VarintType)
+	entry = protowire.AppendVarint(entry, uint64(value))
+	b = protowire.AppendTag(b, 4, protowire.BytesType)
+	return protowire.AppendBytes(b, entry)
+}
+
+func TestValidateCanonical(t *testing.T) {
+	md := canonicalDescriptor(t)
+
+	var valid []byte
+	valid = protowire.AppendTag(valid, 1, protowire.VarintType)
+	valid = protowire.AppendVarint(valid, 1)
+	valid = protowire.AppendTag(valid, 2, protowire.BytesType)
+	valid = protowire.AppendBytes(valid, []byte{1, 2})
+	valid = appendEntry(valid, "a", 1)
+	valid = appendEntry(valid, "b", 2)
+	require.NoError(t, ValidateCanonical(valid, md))
+	require.NoError(t, ValidateCanonical(nil, md))
+
+	for name, tc := range map[string]struct {
+		b      func() ([]byte, int)
+		path   string
+		reason string
+	}{
+		"non-minimal varint": {
+			b: func() ([]byte, int) {
+				b := protowire.AppendTag(nil, 1, protowire.VarintType)
+				return append(b, 0x81, 0x00), len(b)
+			},
+			path:   "n",
+			reason: "non-minimal varint",
+		},
+		"zero value": {
+			b: func() ([]byte, int) {
+				b := protowire.AppendTag(nil, 1, protowire.VarintType)
+				return protowire.AppendVarint(b, 0), len(b)
+			},
+			path:   "n",
+			reason: "zero value of a field without presence",
+		},
+		"unpacked list": {
+			b: func() ([]byte, int) {
+				b := protowire.AppendTag(nil, 1, protowire.VarintType)
+				b = protowire.AppendVarint(b, 1)
+				offset := len(b)
+				b = protowire.AppendTag(b, 2, protowire.VarintType)
+				return protowire.AppendVarint(b, 1), offset
+			},
+			path:   "list",
+			reason: "unpacked elements of a packed field",
+		},
+		"empty packed list": {
+			b: func() ([]byte, int) {
+				b := protowire.AppendTag(nil, 2, protowire.BytesType)
+				return protowire.AppendBytes(b, nil), len(b)
+			},
+			path:   "list",
+			reason: "empty packed field",
+		},
+		"duplicate singular field": {
+			b: func() ([]byte, int) {
+				b := protowire.AppendTag(nil, 1, protowire.VarintType)
+				b = protowire.AppendVarint(b, 1)
+				offset := len(b)
+				b = protowire.AppendTag(b, 1, protowire.VarintType)
+				return protowire.AppendVarint(b, 2), offset
+			},
+			path:   "n",
+			reason: "duplicate field",
+		},
+		"field out of order": {
+			b: func() ([]byte, int) {
+				b := protowire.AppendTag(nil, 2, protowire.BytesType)
+				b = protowire.AppendBytes(b, []byte{1})
+				offset := len(b)
+				b = protowire.AppendTag(b, 1, protowire.VarintType)
+				return protowire.AppendVarint(b, 1), offset
+			},
+			path:   "n",
+			reason: "field out of order",
+		},
+		"out-of-order map keys": {
+			b: func() ([]byte, int) {
+				b := appendEntry(nil, "b", 1)
+				// the key of the second entry follows its tag, its length and the tag of the key
+				offset := len(b) + 3
+				return appendEntry(b, "a", 2), offset
+			},
+			path:   "m",
+			reason: "map entries not sorted by key",
+		},
+		"duplicate map keys": {
+			b: func() ([]byte, int) {
+				b := appendEntry(nil, "a", 1)
+				return appendEntry(b, "a", 2), len(b) + 3
+			},
+			path:   "m",
+			reason: "map entries not sorted by key",
+		},
+		"nested message": {
+			b: func() ([]byte, int) {
+				nested := protowire.AppendTag(nil, 1, protowire.VarintType)
+				offset := len(nested)
+				nested = append(nested, 0x81, 0x00)
+				b := protowire.AppendTag(nil, 3, protowire.BytesType)
+				b = protowire.AppendBytes(b, nested)
+				return b, len(b) - len(nested) + offset
+			},
+			path:   "nested.n",
+			reason: "non-minimal varint",
+		},
+		"map value": {
+			b: func() ([]byte, int) {
+				entry := protowire.AppendTag(nil, 1, protowire.BytesType)
+				entry = protowire.AppendString(entry, "k")
+				entry = protowire.AppendTag(entry, 2, protowire.VarintType)
+				offset := len(entry)
+				entry = append(entry, 0x81, 0x00)
+				b := protowire.AppendTag(nil, 4, protowire.BytesType)
+				b = protowire.AppendBytes(b, entry)
+				return b, len(b) - len(entry) + offset
+			},
+			path:   "m[k]",
+			reason: "non-minimal varint",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			b, offset := tc.b()
+			err := ValidateCanonical(b, md)
+			var ce *CanonicalError
+			require.True(t, errors.As(err, &ce), "%v", err)
+			require.ErrorIs(t, err, proto.Error)
+			require.Equal(t, protoreflect.FullName("canonical.Canonical"), ce.Message)
+			require.Equal(t, offset, ce.Offset)
+			require.Equal(t, tc.path, ce.Path)
+			require.Equal(t, tc.reason, ce.Reason)
+		})
+	}
+}
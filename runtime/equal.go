@@ -0,0 +1,55 @@
+package runtime
+
+import (
+	"bytes"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// EqualFloat reports whether x and y are equal, where NaNs are equal to each other
+// as in proto.Equal.
+func EqualFloat(x, y float64) bool {
+	if math.IsNaN(x) || math.IsNaN(y) {
+		return math.IsNaN(x) && math.IsNaN(y)
+	}
+	return x == y
+}
+
+// EqualUnknown reports whether x and y hold the same unknown fields. As in proto.Equal,
+// the raw bytes of each field number are compared, ignoring the interleaving of
+// different field numbers.
+func EqualUnknown(x, y protoreflect.RawFields) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	if bytes.Equal(x, y) {
+		return true
+	}
+	mx, my := unknownByNumber(x), unknownByNumber(y)
+	if len(mx) != len(my) {
+		return false
+	}
+	for num, bx := range mx {
+		if by, ok := my[num]; !ok || !bytes.Equal(bx, by) {
+			return false
+		}
+	}
+	return true
+}
+
+func unknownByNumber(b protoreflect.RawFields) map[protoreflect.FieldNumber][]byte {
+	fields := make(map[protoreflect.FieldNumber][]byte)
+	for len(b) > 0 {
+		num, _, n := protowire.ConsumeField(b)
+		if n < 0 {
+			// malformed input is kept as is, it can only equal identical bytes
+			fields[-1] = append(fields[-1], b...)
+			break
+		}
+		fields[num] = append(fields[num], b[:n]...)
+		b = b[n:]
+	}
+	return fields
+}
@@ -0,0 +1,558 @@
+package runtime
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/runtime/protoimpl"
+)
+
+// The functions below implement the extension fields of the messages declaring extension ranges.
+// The values are kept in the extensionFields field generated by protoc-gen-go, which is also
+// the storage used by the reflection based implementation of the message.
+
+// ExtensionDescriptor returns the extension type descriptor of fd, which must extend the message
+// described by md with a number declared in one of its extension ranges.
+func ExtensionDescriptor(fd protoreflect.FieldDescriptor, md protoreflect.MessageDescriptor) protoreflect.ExtensionTypeDescriptor {
+	xd, ok := fd.(protoreflect.ExtensionTypeDescriptor)
+	if !ok {
+		panic(fmt.Errorf("extension %v does not implement protoreflect.ExtensionTypeDescriptor", fd.FullName()))
+	}
+	if xd.ContainingMessage().FullName() != md.FullName() {
+		panic(fmt.Errorf("extension %v has mismatching containing message: got %v, want %v", xd.FullName(), xd.ContainingMessage().FullName(), md.FullName()))
+	}
+	if !md.ExtensionRanges().Has(xd.Number()) {
+		panic(fmt.Errorf("extension %v extends %v outside the extension range", xd.FullName(), md.FullName()))
+	}
+	return xd
+}
+
+// RangeExtensions calls f for every populated extension field, and reports whether
+// the iteration was completed, false meaning that f returned false.
+func RangeExtensions(fields *protoimpl.ExtensionFields, f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) bool {
+	for _, x := range *fields {
+		if !x.IsSet() {
+			continue
+		}
+		xd := x.Type().TypeDescriptor()
+		v := x.Value()
+		if xd.IsList() && v.List().Len() == 0 {
+			continue
+		}
+		if !f(xd, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasExtension reports whether the extension field is populated.
+func HasExtension(fields *protoimpl.ExtensionFields, xd protoreflect.ExtensionTypeDescriptor) bool {
+	x, ok := (*fields)[int32(xd.Number())]
+	if !ok || !x.IsSet() {
+		return false
+	}
+	if xd.IsList() {
+		return x.Value().List().Len() > 0
+	}
+	return true
+}
+
+// ClearExtension clears the extension field.
+func ClearExtension(fields *protoimpl.ExtensionFields, xd protoreflect.ExtensionTypeDescriptor) {
+	delete(*fields, int32(xd.Number()))
+}
+
+// GetExtension returns the value of the extension field, or its default value when unpopulated.
+func GetExtension(fields *protoimpl.ExtensionFields, xd protoreflect.ExtensionTypeDescriptor) protoreflect.Value {
+	if x, ok := (*fields)[int32(xd.Number())]; ok && x.IsSet() {
+		return x.Value()
+	}
+	return xd.Type().Zero()
+}
+
+// SetExtension stores the value of the extension field.
+func SetExtension(fields *protoimpl.ExtensionFields, xd protoreflect.ExtensionTypeDescriptor, v protoreflect.Value) {
+	xt := xd.Type()
+	valid := xt.IsValidValue(v)
+	switch {
+	case !valid:
+	case xd.IsList():
+		valid = v.List().IsValid()
+	case xd.Message() != nil:
+		valid = v.Message().IsValid()
+	}
+	if !valid {
+		panic(fmt.Errorf("%v: assigning invalid value", xd.FullName()))
+	}
+	if *fields == nil {
+		*fields = make(protoimpl.ExtensionFields)
+	}
+	var x protoimpl.ExtensionFieldV1
+	x.Set(xt, v)
+	(*fields)[int32(xd.Number())] = x
+}
+
+// MutableExtension returns a mutable reference to the composite value of the extension field,
+// allocating it when unpopulated.
+func MutableExtension(fields *protoimpl.ExtensionFields, xd protoreflect.ExtensionTypeDescriptor) protoreflect.Value {
+	if !xd.IsList() && xd.Message() == nil {
+		panic(fmt.Errorf("invalid Mutable on field %v with non-composite type", xd.FullName()))
+	}
+	if x, ok := (*fields)[int32(xd.Number())]; ok && x.IsSet() {
+		return x.Value()
+	}
+	v := xd.Type().New()
+	SetExtension(fields, xd, v)
+	return v
+}
+
+// NewFieldExtension returns a new value assignable to the extension field.
+func NewFieldExtension(fields *protoimpl.ExtensionFields, xd protoreflect.ExtensionTypeDescriptor) protoreflect.Value {
+	return xd.Type().New()
+}
+
+// MergeExtensions merges the extension fields of src into dst, following the semantics
+// of proto.Merge. Composite values are deep copied.
+func MergeExtensions(dst, src *protoimpl.ExtensionFields) {
+	for num, sx := range *src {
+		if !sx.IsSet() {
+			continue
+		}
+		xt := sx.Type()
+		xd := xt.TypeDescriptor()
+		sv := sx.Value()
+		switch {
+		case xd.IsList():
+			dv := MutableExtension(dst, xd).List()
+			sl := sv.List()
+			for i := 0; i < sl.Len(); i++ {
+				dv.Append(cloneValue(xd, sl.Get(i), dv.NewElement))
+			}
+		case xd.Message() != nil:
+			dx, ok := (*dst)[num]
+			if ok && dx.IsSet() {
+				proto.Merge(dx.Value().Message().Interface(), sv.Message().Interface())
+				continue
+			}
+			SetExtension(dst, xd, cloneValue(xd, sv, xt.New))
+		default:
+			SetExtension(dst, xd, cloneValue(xd, sv, xt.New))
+		}
+	}
+}
+
+func cloneValue(xd protoreflect.ExtensionTypeDescriptor, v protoreflect.Value, newValue func() protoreflect.Value) protoreflect.Value {
+	switch {
+	case xd.Message() != nil:
+		m := newValue()
+		proto.Merge(m.Message().Interface(), v.Message().Interface())
+		return m
+	case xd.Kind() == protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(append([]byte{}, v.Bytes()...))
+	default:
+		return v
+	}
+}
+
+// CheckExtensionsInitialized checks the required fields of the messages held by the extension fields.
+func CheckExtensionsInitialized(fields *protoimpl.ExtensionFields) error {
+	for _, x := range *fields {
+		if !x.IsSet() {
+			continue
+		}
+		xd := x.Type().TypeDescriptor()
+		if xd.Message() == nil {
+			continue
+		}
+		v := x.Value()
+		if !xd.IsList() {
+			if err := proto.CheckInitialized(v.Message().Interface()); err != nil {
+				return RequiredNotSetIn(err, xd.ContainingMessage().FullName(), fmt.Sprintf("[%s]", xd.FullName()))
+			}
+			continue
+		}
+		list := v.List()
+		for i := 0; i < list.Len(); i++ {
+			if err := proto.CheckInitialized(list.Get(i).Message().Interface()); err != nil {
+				return RequiredNotSetIn(err, xd.ContainingMessage().FullName(), fmt.Sprintf("[%s][%d]", xd.FullName(), i))
+			}
+		}
+	}
+	return nil
+}
+
+// EqualExtensions reports whether x and y hold the same populated extension fields
+// with equal values, following the semantics of proto.Equal.
+func EqualExtensions(x, y *protoimpl.ExtensionFields) bool {
+	nx := 0
+	equal := RangeExtensions(x, func(fd protoreflect.FieldDescriptor, vx protoreflect.Value) bool {
+		nx++
+		xd := fd.(protoreflect.ExtensionTypeDescriptor)
+		return HasExtension(y, xd) && vx.Equal(GetExtension(y, xd))
+	})
+	if !equal {
+		return false
+	}
+	ny := 0
+	RangeExtensions(y, func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		ny++
+		return true
+	})
+	return nx == ny
+}
+
+// sortedExtensions returns the populated extension fields, in field number order when deterministic.
+func sortedExtensions(fields *protoimpl.ExtensionFields, deterministic bool) []protoimpl.ExtensionFieldV1 {
+	exts := make([]protoimpl.ExtensionFieldV1, 0, len(*fields))
+	for _, x := range *fields {
+		if x.IsSet() {
+			exts = append(exts, x)
+		}
+	}
+	if deterministic {
+		sort.Slice(exts, func(i, j int) bool {
+			return exts[i].Type().TypeDescriptor().Number() < exts[j].Type().TypeDescriptor().Number()
+		})
+	}
+	return exts
+}
+
+// SizeExtensions returns the size of the encoded extension fields.
+func SizeExtensions(fields *protoimpl.ExtensionFields, options proto.MarshalOptions) int {
+	var n int
+	for _, x := range *fields {
+		if !x.IsSet() {
+			continue
+		}
+		xd := x.Type().TypeDescriptor()
+		v := x.Value()
+		if !xd.IsList() {
+			n += protowire.SizeTag(xd.Number()) + sizeValue(xd, v, options)
+			continue
+		}
+		list := v.List()
+		if list.Len() == 0 {
+			continue
+		}
+		if xd.IsPacked() {
+			var l int
+			for i := 0; i < list.Len(); i++ {
+				l += sizeValue(xd, list.Get(i), options)
+			}
+			n += protowire.SizeTag(xd.Number()) + protowire.SizeBytes(l)
+			continue
+		}
+		for i := 0; i < list.Len(); i++ {
+			n += protowire.SizeTag(xd.Number()) + sizeValue(xd, list.Get(i), options)
+		}
+	}
+	return n
+}
+
+// AppendExtensions appends the encoded extension fields to b.
+func AppendExtensions(b []byte, fields *protoimpl.ExtensionFields, options proto.MarshalOptions) ([]byte, error) {
+	var err error
+	for _, x := range sortedExtensions(fields, options.Deterministic) {
+		xd := x.Type().TypeDescriptor()
+		v := x.Value()
+		if !xd.IsList() {
+			if b, err = appendField(b, xd, v, options); err != nil {
+				return b, err
+			}
+			continue
+		}
+		list := v.List()
+		if list.Len() == 0 {
+			continue
+		}
+		if xd.IsPacked() {
+			var l int
+			for i := 0; i < list.Len(); i++ {
+				l += sizeValue(xd, list.Get(i), options)
+			}
+			b = protowire.AppendTag(b, xd.Number(), protowire.BytesType)
+			b = protowire.AppendVarint(b, uint64(l))
+			for i := 0; i < list.Len(); i++ {
+				if b, err = appendValue(b, xd, list.Get(i), options); err != nil {
+					return b, err
+				}
+			}
+			continue
+		}
+		for i := 0; i < list.Len(); i++ {
+			if b, err = appendField(b, xd, list.Get(i), options); err != nil {
+				return b, err
+			}
+		}
+	}
+	return b, nil
+}
+
+func wireType(fd protoreflect.FieldDescriptor) protowire.Type {
+	switch fd.Kind() {
+	case protoreflect.Sfixed32Kind, protoreflect.Fixed32Kind, protoreflect.FloatKind:
+		return protowire.Fixed32Type
+	case protoreflect.Sfixed64Kind, protoreflect.Fixed64Kind, protoreflect.DoubleKind:
+		return protowire.Fixed64Type
+	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.MessageKind:
+		return protowire.BytesType
+	case protoreflect.GroupKind:
+		return protowire.StartGroupType
+	default:
+		return protowire.VarintType
+	}
+}
+
+func appendField(b []byte, xd protoreflect.ExtensionTypeDescriptor, v protoreflect.Value, options proto.MarshalOptions) ([]byte, error) {
+	b = protowire.AppendTag(b, xd.Number(), wireType(xd))
+	b, err := appendValue(b, xd, v, options)
+	if err != nil {
+		return b, err
+	}
+	if xd.Kind() == protoreflect.GroupKind {
+		b = protowire.AppendTag(b, xd.Number(), protowire.EndGroupType)
+	}
+	return b, nil
+}
+
+func sizeValue(xd protoreflect.ExtensionTypeDescriptor, v protoreflect.Value, options proto.MarshalOptions) int {
+	switch xd.Kind() {
+	case protoreflect.BoolKind:
+		return 1
+	case protoreflect.EnumKind:
+		return protowire.SizeVarint(uint64(v.Enum()))
+	case protoreflect.Int32Kind, protoreflect.Int64Kind:
+		return protowire.SizeVarint(uint64(v.Int()))
+	case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return protowire.SizeVarint(protowire.EncodeZigZag(v.Int()))
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		return protowire.SizeVarint(v.Uint())
+	case protoreflect.Sfixed32Kind, protoreflect.Fixed32Kind, protoreflect.FloatKind:
+		return protowire.SizeFixed32()
+	case protoreflect.Sfixed64Kind, protoreflect.Fixed64Kind, protoreflect.DoubleKind:
+		return protowire.SizeFixed64()
+	case protoreflect.StringKind:
+		return protowire.SizeBytes(len(v.String()))
+	case protoreflect.BytesKind:
+		return protowire.SizeBytes(len(v.Bytes()))
+	case protoreflect.MessageKind:
+		return protowire.SizeBytes(options.Size(v.Message().Interface()))
+	case protoreflect.GroupKind:
+		return options.Size(v.Message().Interface()) + protowire.SizeTag(xd.Number())
+	default:
+		panic(fmt.Errorf("invalid kind %v", xd.Kind()))
+	}
+}
+
+func appendValue(b []byte, xd protoreflect.ExtensionTypeDescriptor, v protoreflect.Value, options proto.MarshalOptions) ([]byte, error) {
+	switch xd.Kind() {
+	case protoreflect.BoolKind:
+		return protowire.AppendVarint(b, protowire.EncodeBool(v.Bool())), nil
+	case protoreflect.EnumKind:
+		return protowire.AppendVarint(b, uint64(v.Enum())), nil
+	case protoreflect.Int32Kind, protoreflect.Int64Kind:
+		return protowire.AppendVarint(b, uint64(v.Int())), nil
+	case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return protowire.AppendVarint(b, protowire.EncodeZigZag(v.Int())), nil
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		return protowire.AppendVarint(b, v.Uint()), nil
+	case protoreflect.Sfixed32Kind:
+		return protowire.AppendFixed32(b, uint32(v.Int())), nil
+	case protoreflect.Fixed32Kind:
+		return protowire.AppendFixed32(b, uint32(v.Uint())), nil
+	case protoreflect.FloatKind:
+		return protowire.AppendFixed32(b, math.Float32bits(float32(v.Float()))), nil
+	case protoreflect.Sfixed64Kind:
+		return protowire.AppendFixed64(b, uint64(v.Int())), nil
+	case protoreflect.Fixed64Kind:
+		return protowire.AppendFixed64(b, v.Uint()), nil
+	case protoreflect.DoubleKind:
+		return protowire.AppendFixed64(b, math.Float64bits(v.Float())), nil
+	case protoreflect.StringKind:
+		return protowire.AppendString(b, v.String()), nil
+	case protoreflect.BytesKind:
+		return protowire.AppendBytes(b, v.Bytes()), nil
+	case protoreflect.MessageKind:
+		m := v.Message().Interface()
+		b = protowire.AppendVarint(b, uint64(options.Size(m)))
+		return options.MarshalAppend(b, m)
+	case protoreflect.GroupKind:
+		return options.MarshalAppend(b, v.Message().Interface())
+	default:
+		panic(fmt.Errorf("invalid kind %v", xd.Kind()))
+	}
+}
+
+// UnmarshalExtension decodes the field at the start of b when it is an extension of the
+// message described by md known to the resolver of the options, storing its value.
+// It returns the length of the decoded field, which is zero when the field must be
+// kept as an unknown field instead. Undeclared values of closed enums found
+// among packed values are appended to unknown.
+func UnmarshalExtension(b []byte, fields *protoimpl.ExtensionFields, unknown *[]byte, md protoreflect.MessageDescriptor, options proto.UnmarshalOptions) (int, error) {
+	num, wtyp, tagLen := protowire.ConsumeTag(b)
+	if tagLen < 0 {
+		return 0, protowire.ParseError(tagLen)
+	}
+	if !md.ExtensionRanges().Has(num) {
+		return 0, nil
+	}
+	resolver := options.Resolver
+	if resolver == nil {
+		resolver = protoregistry.GlobalTypes
+	}
+	xt, err := resolver.FindExtensionByNumber(md.FullName(), num)
+	if err == protoregistry.NotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%v: unable to resolve extension %v: %v", md.FullName(), num, err)
+	}
+	xd := xt.TypeDescriptor()
+	b = b[tagLen:]
+
+	if xd.IsList() && wtyp == protowire.BytesType && wireType(xd) != protowire.BytesType {
+		// packed repeated scalars, accepted whatever the packing of the extension
+		packed, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return 0, protowire.ParseError(n)
+		}
+		list := MutableExtension(fields, xd).List()
+		for len(packed) > 0 {
+			v, m := consumeScalar(packed, xd, wireType(xd))
+			if m < 0 {
+				return 0, protowire.ParseError(m)
+			}
+			if m == 0 {
+				x, m := protowire.ConsumeVarint(packed)
+				if !options.DiscardUnknown {
+					*unknown = protowire.AppendTag(*unknown, num, protowire.VarintType)
+					*unknown = protowire.AppendVarint(*unknown, x)
+				}
+				packed = packed[m:]
+				continue
+			}
+			list.Append(v)
+			packed = packed[m:]
+		}
+		return tagLen + n, nil
+	}
+	if wtyp != wireType(xd) {
+		return 0, nil
+	}
+
+	switch xd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		var buf []byte
+		var n int
+		if wtyp == protowire.StartGroupType {
+			buf, n = protowire.ConsumeGroup(num, b)
+		} else {
+			buf, n = protowire.ConsumeBytes(b)
+		}
+		if n < 0 {
+			return 0, protowire.ParseError(n)
+		}
+		var m protoreflect.Value
+		if xd.IsList() {
+			list := MutableExtension(fields, xd).List()
+			m = list.NewElement()
+			list.Append(m)
+		} else {
+			m = MutableExtension(fields, xd)
+		}
+		options.Merge = true
+		if err := options.Unmarshal(buf, m.Message().Interface()); err != nil {
+			return 0, err
+		}
+		return tagLen + n, nil
+	default:
+		v, n := consumeScalar(b, xd, wtyp)
+		if n < 0 {
+			return 0, protowire.ParseError(n)
+		}
+		if n == 0 {
+			return 0, nil
+		}
+		if xd.IsList() {
+			MutableExtension(fields, xd).List().Append(v)
+		} else {
+			SetExtension(fields, xd, v)
+		}
+		return tagLen + n, nil
+	}
+}
+
+// consumeScalar decodes a scalar value, returning a zero length
+// for the values of closed enums which are not declared.
+func consumeScalar(b []byte, xd protoreflect.ExtensionTypeDescriptor, wtyp protowire.Type) (protoreflect.Value, int) {
+	switch wtyp {
+	case protowire.VarintType:
+		x, n := protowire.ConsumeVarint(b)
+		if n < 0 {
+			return protoreflect.Value{}, n
+		}
+		switch xd.Kind() {
+		case protoreflect.BoolKind:
+			return protoreflect.ValueOfBool(protowire.DecodeBool(x)), n
+		case protoreflect.EnumKind:
+			e := protoreflect.EnumNumber(x)
+			if xd.Enum().IsClosed() && xd.Enum().Values().ByNumber(e) == nil {
+				return protoreflect.Value{}, 0
+			}
+			return protoreflect.ValueOfEnum(e), n
+		case protoreflect.Int32Kind:
+			return protoreflect.ValueOfInt32(int32(x)), n
+		case protoreflect.Int64Kind:
+			return protoreflect.ValueOfInt64(int64(x)), n
+		case protoreflect.Sint32Kind:
+			return protoreflect.ValueOfInt32(int32(protowire.DecodeZigZag(x & math.MaxUint32))), n
+		case protoreflect.Sint64Kind:
+			return protoreflect.ValueOfInt64(protowire.DecodeZigZag(x)), n
+		case protoreflect.Uint32Kind:
+			return protoreflect.ValueOfUint32(uint32(x)), n
+		default:
+			return protoreflect.ValueOfUint64(x), n
+		}
+	case protowire.Fixed32Type:
+		x, n := protowire.ConsumeFixed32(b)
+		if n < 0 {
+			return protoreflect.Value{}, n
+		}
+		switch xd.Kind() {
+		case protoreflect.Sfixed32Kind:
+			return protoreflect.ValueOfInt32(int32(x)), n
+		case protoreflect.FloatKind:
+			return protoreflect.ValueOfFloat32(math.Float32frombits(x)), n
+		default:
+			return protoreflect.ValueOfUint32(x), n
+		}
+	case protowire.Fixed64Type:
+		x, n := protowire.ConsumeFixed64(b)
+		if n < 0 {
+			return protoreflect.Value{}, n
+		}
+		switch xd.Kind() {
+		case protoreflect.Sfixed64Kind:
+			return protoreflect.ValueOfInt64(int64(x)), n
+		case protoreflect.DoubleKind:
+			return protoreflect.ValueOfFloat64(math.Float64frombits(x)), n
+		default:
+			return protoreflect.ValueOfUint64(x), n
+		}
+	default:
+		x, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return protoreflect.Value{}, n
+		}
+		if xd.Kind() == protoreflect.StringKind {
+			return protoreflect.ValueOfString(string(x)), n
+		}
+		return protoreflect.ValueOfBytes(append([]byte{}, x...)), n
+	}
+}
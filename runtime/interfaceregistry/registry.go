@@ -0,0 +1,103 @@
+// Package interfaceregistry maps the interfaces accepted by google.protobuf.Any fields to the
+// message types implementing them.
+//
+// Interfaces are identified by the names used with the cosmos_proto.accepts_interface and
+// cosmos_proto.implements_interface options. The code generated by the interfaces feature
+// registers every message annotated with cosmos_proto.implements_interface in Global when
+// its package is initialized.
+package interfaceregistry
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Global is the registry filled by the generated code.
+var Global = new(Registry)
+
+// Registry holds the message types implementing each interface. The zero value is an empty
+// registry ready to use, and the registry is safe for concurrent use.
+type Registry struct {
+	mu    sync.RWMutex
+	types map[string]map[protoreflect.FullName]protoreflect.MessageType
+}
+
+// Register registers mt as an implementation of each of ifaces. Registering a type again is a
+// no-op, while registering another type with the same full name fails.
+func (r *Registry) Register(mt protoreflect.MessageType, ifaces ...string) error {
+	name := mt.Descriptor().FullName()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, iface := range ifaces {
+		if prev, ok := r.types[iface][name]; ok && prev != mt {
+			return fmt.Errorf("interfaceregistry: another type named %s is already registered as an implementation of %s", name, iface)
+		}
+	}
+	if r.types == nil {
+		r.types = make(map[string]map[protoreflect.FullName]protoreflect.MessageType)
+	}
+	for _, iface := range ifaces {
+		if r.types[iface] == nil {
+			r.types[iface] = make(map[protoreflect.FullName]protoreflect.MessageType)
+		}
+		r.types[iface][name] = mt
+	}
+	return nil
+}
+
+// Interfaces returns the interfaces having registered implementations, sorted by name.
+func (r *Registry) Interfaces() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ifaces := make([]string, 0, len(r.types))
+	for iface := range r.types {
+		ifaces = append(ifaces, iface)
+	}
+	sort.Strings(ifaces)
+	return ifaces
+}
+
+// Implementations returns the message types registered as implementations of iface, sorted
+// by full name.
+func (r *Registry) Implementations(iface string) []protoreflect.MessageType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	types := make([]protoreflect.MessageType, 0, len(r.types[iface]))
+	for _, mt := range r.types[iface] {
+		types = append(types, mt)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].Descriptor().FullName() < types[j].Descriptor().FullName()
+	})
+	return types
+}
+
+// Implements reports whether the message type named name is registered as an implementation
+// of iface.
+func (r *Registry) Implements(iface string, name protoreflect.FullName) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.types[iface][name]
+	return ok
+}
+
+// FindByURL returns the message type identified by the type URL of a google.protobuf.Any,
+// provided that it is registered as an implementation of iface. Otherwise the error wraps
+// protoregistry.NotFound.
+func (r *Registry) FindByURL(iface, url string) (protoreflect.MessageType, error) {
+	name := protoreflect.FullName(url)
+	if i := strings.LastIndexByte(url, '/'); i >= 0 {
+		name = name[i+1:]
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if mt, ok := r.types[iface][name]; ok {
+		return mt, nil
+	}
+	return nil, fmt.Errorf("interfaceregistry: %s is not registered as an implementation of %s: %w", name, iface, protoregistry.NotFound)
+}
@@ -0,0 +1,99 @@
+package interfaceregistry
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	durationType  = (&durationpb.Duration{}).ProtoReflect().Type()
+	timestampType = (&timestamppb.Timestamp{}).ProtoReflect().Type()
+)
+
+func TestRegister(t *testing.T) {
+	r := new(Registry)
+	require.NoError(t, r.Register(timestampType, "cosmos.Time", "cosmos.Value"))
+	require.NoError(t, r.Register(durationType, "cosmos.Value"))
+
+	t.Run("same type again", func(t *testing.T) {
+		require.NoError(t, r.Register(durationType, "cosmos.Value"))
+		require.Len(t, r.Implementations("cosmos.Value"), 2)
+	})
+
+	t.Run("conflicting implementation", func(t *testing.T) {
+		// another type sharing the full name, and so the type URL, of a registered one
+		conflicting := dynamicpb.NewMessageType(durationType.Descriptor())
+		require.Error(t, r.Register(conflicting, "cosmos.Value"))
+		// the registration is all or nothing
+		require.Error(t, r.Register(conflicting, "cosmos.Other", "cosmos.Value"))
+		require.False(t, r.Implements("cosmos.Other", "google.protobuf.Duration"))
+
+		mt, err := r.FindByURL("cosmos.Value", "/google.protobuf.Duration")
+		require.NoError(t, err)
+		require.Equal(t, durationType, mt)
+
+		// the same name can implement unrelated interfaces through another type
+		require.NoError(t, r.Register(conflicting, "cosmos.Other"))
+		mt, err = r.FindByURL("cosmos.Other", "/google.protobuf.Duration")
+		require.NoError(t, err)
+		require.Equal(t, protoreflect.MessageType(conflicting), mt)
+	})
+
+	t.Run("listing", func(t *testing.T) {
+		require.Equal(t, []string{"cosmos.Other", "cosmos.Time", "cosmos.Value"}, r.Interfaces())
+		require.Equal(t, []protoreflect.MessageType{durationType, timestampType}, r.Implementations("cosmos.Value"))
+		require.Empty(t, r.Implementations("cosmos.Unknown"))
+		require.True(t, r.Implements("cosmos.Time", "google.protobuf.Timestamp"))
+		require.False(t, r.Implements("cosmos.Time", "google.protobuf.Duration"))
+	})
+}
+
+func TestFindByURL(t *testing.T) {
+	r := new(Registry)
+	require.NoError(t, r.Register(durationType, "cosmos.Value"))
+
+	for _, url := range []string{
+		"type.googleapis.com/google.protobuf.Duration",
+		"/google.protobuf.Duration",
+		"google.protobuf.Duration",
+	} {
+		mt, err := r.FindByURL("cosmos.Value", url)
+		require.NoError(t, err, url)
+		require.Equal(t, durationType, mt)
+	}
+
+	for name, tc := range map[string]struct{ iface, url string }{
+		"unregistered type": {iface: "cosmos.Value", url: "/google.protobuf.Timestamp"},
+		"other interface":   {iface: "cosmos.Time", url: "/google.protobuf.Duration"},
+		"empty url":         {iface: "cosmos.Value", url: ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := r.FindByURL(tc.iface, tc.url)
+			require.ErrorIs(t, err, protoregistry.NotFound)
+		})
+	}
+}
+
+func TestConcurrentUse(t *testing.T) {
+	r := new(Registry)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, r.Register(durationType, "cosmos.Value"))
+			_, err := r.FindByURL("cosmos.Value", "/google.protobuf.Duration")
+			require.NoError(t, err)
+			r.Interfaces()
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, []string{"cosmos.Value"}, r.Interfaces())
+}
@@ -0,0 +1,217 @@
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"sync/atomic"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"github.com/cosmos/cosmos-proto/runtime/interfaceregistry"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// AcceptsInterface returns the interface accepted by the google.protobuf.Any field fd,
+// declared with the cosmos_proto.accepts_interface option, or an empty string.
+func AcceptsInterface(fd protoreflect.FieldDescriptor) string {
+	return proto.GetExtension(fd.Options(), cosmos_proto.E_AcceptsInterface).(string)
+}
+
+// ImplementsInterfaces returns the interfaces implemented by the message type md,
+// declared with the cosmos_proto.implements_interface option.
+func ImplementsInterfaces(md protoreflect.MessageDescriptor) []string {
+	return proto.GetExtension(md.Options(), cosmos_proto.E_ImplementsInterface).([]string)
+}
+
+// InterfaceError reports a message packed in, or unpacked from, a google.protobuf.Any field
+// accepting an interface which the type of the message does not implement.
+type InterfaceError struct {
+	// Message is the full name of the type of the message.
+	Message protoreflect.FullName
+	// Interface is the name of the interface accepted by the field.
+	Interface string
+}
+
+func (e *InterfaceError) Error() string {
+	return fmt.Sprintf("proto: %s does not implement the interface %s", e.Message, e.Interface)
+}
+
+// Is reports the error as a protobuf error, matching proto.Error.
+func (e *InterfaceError) Is(target error) bool {
+	return target == proto.Error
+}
+
+// ErrInterface returns the error reporting that the message type does not implement iface.
+func ErrInterface(message protoreflect.FullName, iface string) error {
+	return &InterfaceError{Message: message, Interface: iface}
+}
+
+// UnpackAny returns the message packed in a, whose type must implement iface, unless iface is
+// empty. The type is resolved among the implementations of iface registered in
+// interfaceregistry.Global, or else in the global registry, in which case it must implement
+// iface through the cosmos_proto.implements_interface option.
+func UnpackAny(a *anypb.Any, iface string) (proto.Message, error) {
+	mt, err := resolveAny(a.GetTypeUrl(), iface)
+	if err != nil {
+		return nil, err
+	}
+	m := mt.New().Interface()
+	if err := proto.Unmarshal(a.GetValue(), m); err != nil {
+		return nil, fmt.Errorf("proto: unable to unmarshal %q: %w", a.GetTypeUrl(), err)
+	}
+	return m, nil
+}
+
+func resolveAny(url, iface string) (protoreflect.MessageType, error) {
+	if iface != "" {
+		if mt, err := interfaceregistry.Global.FindByURL(iface, url); err == nil {
+			return mt, nil
+		}
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("proto: unable to resolve %q: %w", url, err)
+	}
+	if err := checkInterface(mt.Descriptor(), iface); err != nil {
+		return nil, err
+	}
+	return mt, nil
+}
+
+// PackAny returns a google.protobuf.Any holding the deterministic encoding of m, whose type
+// must implement iface, unless iface is empty, by being registered in interfaceregistry.Global
+// or through the cosmos_proto.implements_interface option.
+func PackAny(m proto.Message, iface string) (*anypb.Any, error) {
+	if err := checkInterface(m.ProtoReflect().Descriptor(), iface); err != nil {
+		return nil, err
+	}
+	a := &anypb.Any{}
+	if err := anypb.MarshalFrom(a, m, proto.MarshalOptions{Deterministic: true}); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// checkInterface checks that the message type md implements iface, unless iface is empty.
+func checkInterface(md protoreflect.MessageDescriptor, iface string) error {
+	if iface != "" && !interfaceregistry.Global.Implements(iface, md.FullName()) &&
+		!slices.Contains(ImplementsInterfaces(md), iface) {
+		return ErrInterface(md.FullName(), iface)
+	}
+	return nil
+}
+
+// AnyCache caches the messages unpacked from a singular or repeated google.protobuf.Any field,
+// for the typed accessors generated by the interfaces feature. A cached message is returned as
+// long as the field holds the same type URL and value, so assigning the field directly is safe,
+// and the cache is safe for concurrent readers. The cached messages are shared by the callers
+// and must be treated as read-only: changes made to them are not packed back into the field.
+type AnyCache struct {
+	entries atomic.Pointer[[]cachedAny]
+}
+
+// cachedAny is a message cached along with the google.protobuf.Any it was unpacked from.
+type cachedAny struct {
+	typeURL string
+	value   []byte
+	message proto.Message
+}
+
+func (c *cachedAny) holds(a *anypb.Any) bool {
+	return c.message != nil && c.typeURL == a.GetTypeUrl() && bytes.Equal(c.value, a.GetValue())
+}
+
+// Unpack returns the message packed in a, which must implement iface, or nil if a is nil.
+func (c *AnyCache) Unpack(a *anypb.Any, iface string) (proto.Message, error) {
+	if a == nil {
+		return nil, nil
+	}
+	entries, err := c.unpack([]*anypb.Any{a}, iface)
+	if err != nil {
+		return nil, err
+	}
+	return entries[0].message, nil
+}
+
+// UnpackList returns the messages packed in list, which must implement iface. The nil
+// elements of list are unpacked to nil messages.
+func (c *AnyCache) UnpackList(list []*anypb.Any, iface string) ([]proto.Message, error) {
+	entries, err := c.unpack(list, iface)
+	if err != nil {
+		return nil, err
+	}
+	ms := make([]proto.Message, len(entries))
+	for i := range entries {
+		ms[i] = entries[i].message
+	}
+	return ms, nil
+}
+
+func (c *AnyCache) unpack(list []*anypb.Any, iface string) ([]cachedAny, error) {
+	cached := c.entries.Load()
+	if cached != nil && holdsAll(*cached, list) {
+		return *cached, nil
+	}
+	entries := make([]cachedAny, len(list))
+	for i, a := range list {
+		switch {
+		case a == nil:
+		case cached != nil && i < len(*cached) && (*cached)[i].holds(a):
+			entries[i] = (*cached)[i]
+		default:
+			m, err := UnpackAny(a, iface)
+			if err != nil {
+				return nil, err
+			}
+			entries[i] = cachedAny{typeURL: a.GetTypeUrl(), value: bytes.Clone(a.GetValue()), message: m}
+		}
+	}
+	c.entries.Store(&entries)
+	return entries, nil
+}
+
+// holdsAll reports whether entries are the messages unpacked from list.
+func holdsAll(entries []cachedAny, list []*anypb.Any) bool {
+	if len(entries) != len(list) {
+		return false
+	}
+	for i, a := range list {
+		if (a == nil) != (entries[i].message == nil) || a != nil && !entries[i].holds(a) {
+			return false
+		}
+	}
+	return true
+}
+
+// Pack returns a google.protobuf.Any holding m, which must implement iface, and caches m as
+// its unpacked message. It returns nil if m is nil.
+func (c *AnyCache) Pack(m proto.Message, iface string) (*anypb.Any, error) {
+	list, err := c.PackList([]proto.Message{m}, iface)
+	if err != nil {
+		return nil, err
+	}
+	return list[0], nil
+}
+
+// PackList returns the google.protobuf.Any holding each of ms, which must implement iface,
+// and caches ms as their unpacked messages. The nil elements of ms are packed to nil.
+func (c *AnyCache) PackList(ms []proto.Message, iface string) ([]*anypb.Any, error) {
+	list := make([]*anypb.Any, len(ms))
+	entries := make([]cachedAny, len(ms))
+	for i, m := range ms {
+		if m == nil {
+			continue
+		}
+		a, err := PackAny(m, iface)
+		if err != nil {
+			return nil, err
+		}
+		list[i] = a
+		entries[i] = cachedAny{typeURL: a.TypeUrl, value: bytes.Clone(a.Value), message: m}
+	}
+	c.entries.Store(&entries)
+	return list, nil
+}
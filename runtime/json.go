@@ -0,0 +1,299 @@
+package runtime
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/cosmos/cosmos-proto/runtime/internal/json"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The functions below implement the JSON format of protojson for the messages generated with
+// the json feature, whose AppendJSON method encodes the fields of the message and defers to
+// AppendJSONMessage for the messages of other types. They also implement the format through
+// reflection for the messages without generated methods, the well-known types and extensions.
+//
+// The encoding is the one of protojson.Marshal with no option set, except that protojson
+// randomly adds spaces after commas to keep its users from depending on its exact output,
+// while no space is ever added here.
+
+// jsonAppender is implemented by the messages generated with the json feature.
+type jsonAppender interface {
+	AppendJSON(b []byte) ([]byte, error)
+}
+
+// MarshalJSON returns the JSON encoding of m, reporting an error if required fields are not set.
+func MarshalJSON(m proto.Message) ([]byte, error) {
+	if m == nil {
+		return []byte("{}"), nil
+	}
+	b, err := AppendJSONMessage(nil, m)
+	if err != nil {
+		return nil, err
+	}
+	return b, proto.CheckInitialized(m)
+}
+
+// AppendJSONMessage appends the JSON encoding of m to b. Required fields are not checked.
+func AppendJSONMessage(b []byte, m proto.Message) ([]byte, error) {
+	if m == nil {
+		return append(b, "{}"...), nil
+	}
+	return appendJSONMessage(b, m.ProtoReflect(), "")
+}
+
+// appendJSONMessage appends the JSON encoding of m to b. A non-empty typeURL is
+// encoded first as the "@type" field of the object, m being embedded in an Any.
+func appendJSONMessage(b []byte, m protoreflect.Message, typeURL string) ([]byte, error) {
+	md := m.Descriptor()
+	if isMessageSet(md) {
+		return b, errors.New("no support for proto1 MessageSets")
+	}
+	if appendWKT := wellKnownJSONAppender(md.FullName()); appendWKT != nil {
+		return appendWKT(b, m)
+	}
+
+	start := len(b)
+	if msg, ok := m.Interface().(jsonAppender); ok {
+		b, err := msg.AppendJSON(b)
+		if err != nil || typeURL == "" {
+			return b, err
+		}
+		field, err := appendJSONTypeURL(nil, typeURL)
+		if err != nil {
+			return b, err
+		}
+		if b[start+1] == '}' {
+			field = field[:len(field)-1]
+		}
+		return slices.Insert(b, start+1, field...), nil
+	}
+
+	var err error
+	b = append(b, '{')
+	if typeURL != "" {
+		if b, err = appendJSONTypeURL(b, typeURL); err != nil {
+			return b, err
+		}
+	}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsWeak() || !m.Has(fd) {
+			continue
+		}
+		if b, err = appendJSONField(b, fd, m.Get(fd)); err != nil {
+			return b, err
+		}
+	}
+	if b, err = AppendJSONExtensions(b, m); err != nil {
+		return b, err
+	}
+	return endJSON(b, '}'), nil
+}
+
+// appendJSONTypeURL appends the "@type" field holding typeURL, followed by a comma.
+func appendJSONTypeURL(b []byte, typeURL string) ([]byte, error) {
+	b = append(b, `"@type":`...)
+	b, err := json.AppendString(b, typeURL)
+	if err != nil {
+		return b, fmt.Errorf("field %v contains invalid UTF-8", "@type")
+	}
+	return append(b, ','), nil
+}
+
+// AppendJSONExtensions appends the populated extension fields of m to b, in the order of their
+// full name, each followed by a comma.
+func AppendJSONExtensions(b []byte, m protoreflect.Message) ([]byte, error) {
+	var extensions []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if fd.IsExtension() {
+			extensions = append(extensions, fd)
+		}
+		return true
+	})
+	sort.Slice(extensions, func(i, j int) bool {
+		return extensions[i].FullName() < extensions[j].FullName()
+	})
+	var err error
+	for _, fd := range extensions {
+		if b, err = appendJSONField(b, fd, m.Get(fd)); err != nil {
+			return b, err
+		}
+	}
+	return b, nil
+}
+
+// appendJSONField appends the field fd holding v, followed by a comma.
+func appendJSONField(b []byte, fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]byte, error) {
+	b, err := json.AppendString(b, fd.JSONName())
+	if err != nil {
+		return b, err
+	}
+	b = append(b, ':')
+	switch {
+	case fd.IsList():
+		b, err = appendJSONList(b, fd, v.List())
+	case fd.IsMap():
+		b, err = appendJSONMap(b, fd, v.Map())
+	default:
+		b, err = appendJSONValue(b, fd, v)
+	}
+	if err != nil {
+		return b, err
+	}
+	return append(b, ','), nil
+}
+
+func appendJSONList(b []byte, fd protoreflect.FieldDescriptor, list protoreflect.List) ([]byte, error) {
+	var err error
+	b = append(b, '[')
+	for i := 0; i < list.Len(); i++ {
+		if b, err = appendJSONValue(b, fd, list.Get(i)); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	return endJSON(b, ']'), nil
+}
+
+func appendJSONMap(b []byte, fd protoreflect.FieldDescriptor, m protoreflect.Map) ([]byte, error) {
+	keys := make([]protoreflect.MapKey, 0, m.Len())
+	m.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Slice(keys, func(i, j int) bool {
+		return lessMapKey(keys[i], keys[j])
+	})
+	var err error
+	b = append(b, '{')
+	for _, k := range keys {
+		if b, err = json.AppendString(b, k.String()); err != nil {
+			return b, fmt.Errorf("field %v contains invalid UTF-8", fd.MapKey().FullName())
+		}
+		b = append(b, ':')
+		if b, err = appendJSONValue(b, fd.MapValue(), m.Get(k)); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	return endJSON(b, '}'), nil
+}
+
+// lessMapKey orders map keys as protojson does: false before true, numbers in numerical
+// order and strings in the order of their UTF-8 encoding.
+func lessMapKey(x, y protoreflect.MapKey) bool {
+	switch v := x.Interface().(type) {
+	case bool:
+		return !v && y.Bool()
+	case int32, int64:
+		return x.Int() < y.Int()
+	case uint32, uint64:
+		return x.Uint() < y.Uint()
+	default:
+		return x.String() < y.String()
+	}
+}
+
+// appendJSONValue appends v, a singular value of fd or an element of its list.
+func appendJSONValue(b []byte, fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]byte, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return strconv.AppendBool(b, v.Bool()), nil
+	case protoreflect.StringKind:
+		return AppendJSONString(b, v.String(), fd.FullName())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return strconv.AppendInt(b, v.Int(), 10), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return strconv.AppendUint(b, v.Uint(), 10), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return AppendJSONInt64(b, v.Int()), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return AppendJSONUint64(b, v.Uint()), nil
+	case protoreflect.FloatKind:
+		return AppendJSONFloat(b, v.Float(), 32), nil
+	case protoreflect.DoubleKind:
+		return AppendJSONFloat(b, v.Float(), 64), nil
+	case protoreflect.BytesKind:
+		return AppendJSONBytes(b, v.Bytes()), nil
+	case protoreflect.EnumKind:
+		return AppendJSONEnum(b, v.Enum(), fd.Enum()), nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return appendJSONMessage(b, v.Message(), "")
+	default:
+		panic(fmt.Sprintf("%v has unknown kind: %v", fd.FullName(), fd.Kind()))
+	}
+}
+
+// endJSON closes the object or array of b with c, dropping the comma following its last value.
+func endJSON(b []byte, c byte) []byte {
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = c
+		return b
+	}
+	return append(b, c)
+}
+
+// AppendJSONString appends s, the value of the field named name, to b as a JSON string.
+func AppendJSONString(b []byte, s string, name protoreflect.FullName) ([]byte, error) {
+	b, err := json.AppendString(b, s)
+	if err != nil {
+		return b, fmt.Errorf("field %v contains invalid UTF-8", name)
+	}
+	return b, nil
+}
+
+// AppendJSONInt64 appends v to b as a JSON string, like all 64-bit integers.
+func AppendJSONInt64(b []byte, v int64) []byte {
+	b = append(b, '"')
+	b = strconv.AppendInt(b, v, 10)
+	return append(b, '"')
+}
+
+// AppendJSONUint64 appends v to b as a JSON string, like all 64-bit integers.
+func AppendJSONUint64(b []byte, v uint64) []byte {
+	b = append(b, '"')
+	b = strconv.AppendUint(b, v, 10)
+	return append(b, '"')
+}
+
+// AppendJSONFloat appends v, a float of bitSize bits, to b as a JSON number,
+// or as a JSON string for NaN and infinites.
+func AppendJSONFloat(b []byte, v float64, bitSize int) []byte {
+	return json.AppendFloat(b, v, bitSize)
+}
+
+// AppendJSONBytes appends the standard base64 encoding of v to b as a JSON string.
+func AppendJSONBytes(b []byte, v []byte) []byte {
+	b = append(b, '"')
+	b = base64.StdEncoding.AppendEncode(b, v)
+	return append(b, '"')
+}
+
+// AppendJSONEnum appends the name of the value v of the enum ed to b as a JSON string,
+// or v as a JSON number when it is not a known value. google.protobuf.NullValue is
+// encoded as null.
+func AppendJSONEnum(b []byte, v protoreflect.EnumNumber, ed protoreflect.EnumDescriptor) []byte {
+	if ed.FullName() == nullValueName {
+		return append(b, "null"...)
+	}
+	value := ed.Values().ByNumber(v)
+	if value == nil {
+		return strconv.AppendInt(b, int64(v), 10)
+	}
+	b = append(b, '"')
+	b = append(b, value.Name()...)
+	return append(b, '"')
+}
+
+// isMessageSet reports whether md uses the message set wire format, which has no JSON format.
+func isMessageSet(md protoreflect.MessageDescriptor) bool {
+	xmd, ok := md.(interface{ IsMessageSet() bool })
+	return ok && xmd.IsMessageSet()
+}
@@ -0,0 +1,673 @@
+package runtime
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-proto/runtime/internal/json"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// jsonDecoder is implemented by the messages generated with the json feature.
+type jsonDecoder interface {
+	DecodeJSON(d *JSONDecoder) error
+}
+
+// JSONDecoder decodes the JSON format of protojson. It is driven by the DecodeJSON method of
+// the messages generated with the json feature, which decodes the fields of the message and
+// defers to Message for the messages of other types. Messages without generated methods,
+// well-known types and extensions are decoded through reflection.
+type JSONDecoder struct {
+	dec     *json.Decoder
+	options protojson.UnmarshalOptions
+	// depth is the number of messages being decoded.
+	depth int
+	// skipTypeURL requests the next object to skip its "@type" field, the object
+	// being the message embedded in an Any.
+	skipTypeURL bool
+	// name is the last field name or map key read.
+	name json.Token
+}
+
+// UnmarshalJSON resets m and decodes the JSON encoding b into it, like protojson does with options.
+func UnmarshalJSON(b []byte, m proto.Message, options protojson.UnmarshalOptions) error {
+	proto.Reset(m)
+	if options.Resolver == nil {
+		options.Resolver = protoregistry.GlobalTypes
+	}
+	if options.RecursionLimit == 0 {
+		options.RecursionLimit = protowire.DefaultRecursionLimit
+	}
+
+	d := &JSONDecoder{dec: json.NewDecoder(b), options: options}
+	if err := d.unmarshalMessage(m.ProtoReflect(), false); err != nil {
+		return err
+	}
+	tok, err := d.dec.Read()
+	if err != nil {
+		return err
+	}
+	if tok.Kind() != json.EOF {
+		return d.unexpectedTokenError(tok)
+	}
+	if options.AllowPartial {
+		return nil
+	}
+	return proto.CheckInitialized(m)
+}
+
+// newError returns an error with the position of pos.
+func (d *JSONDecoder) newError(pos int, f string, x ...any) error {
+	line, column := d.dec.Position(pos)
+	return fmt.Errorf("(line %d:%d): %s", line, column, fmt.Sprintf(f, x...))
+}
+
+// unexpectedTokenError returns a syntax error for the given unexpected token.
+func (d *JSONDecoder) unexpectedTokenError(tok json.Token) error {
+	line, column := d.dec.Position(tok.Pos())
+	return fmt.Errorf("syntax error (line %d:%d): unexpected token %s", line, column, tok.RawString())
+}
+
+// Message decodes the next value into m.
+func (d *JSONDecoder) Message(m proto.Message) error {
+	return d.unmarshalMessage(m.ProtoReflect(), false)
+}
+
+// unmarshalMessage decodes the next value into m. The "@type" field of the object
+// is skipped when skipTypeURL is set, m being embedded in an Any.
+func (d *JSONDecoder) unmarshalMessage(m protoreflect.Message, skipTypeURL bool) error {
+	d.depth++
+	defer func() { d.depth-- }()
+	if d.depth > d.options.RecursionLimit {
+		return errors.New("exceeded max recursion depth")
+	}
+	if unmarshal := wellKnownJSONDecoder(m.Descriptor().FullName()); unmarshal != nil {
+		return unmarshal(d, m)
+	}
+
+	d.skipTypeURL = skipTypeURL
+	if msg, ok := m.Interface().(jsonDecoder); ok {
+		return msg.DecodeJSON(d)
+	}
+	return d.Object(m, func(fd protoreflect.FieldDescriptor) error {
+		return d.unmarshalField(m, fd)
+	})
+}
+
+// Object decodes the next object into m, calling field to decode the value of each of its
+// fields but extensions, which are decoded through reflection. Field names are resolved and
+// checked for duplicates, and null values are skipped, except for the fields whose value
+// encodes null.
+func (d *JSONDecoder) Object(m protoreflect.Message, field func(fd protoreflect.FieldDescriptor) error) error {
+	skipTypeURL := d.skipTypeURL
+	d.skipTypeURL = false
+
+	tok, err := d.dec.Read()
+	if err != nil {
+		return err
+	}
+	if tok.Kind() != json.ObjectOpen {
+		return d.unexpectedTokenError(tok)
+	}
+
+	md := m.Descriptor()
+	if isMessageSet(md) {
+		return errors.New("no support for proto1 MessageSets")
+	}
+
+	var seenNums, seenOneofs fieldSet
+	fields := md.Fields()
+	for {
+		tok, err := d.dec.Read()
+		if err != nil {
+			return err
+		}
+		switch tok.Kind() {
+		default:
+			return d.unexpectedTokenError(tok)
+		case json.ObjectClose:
+			return nil
+		case json.Name:
+		}
+
+		name := tok.Name()
+		if skipTypeURL && name == "@type" {
+			d.dec.Read()
+			continue
+		}
+
+		var fd protoreflect.FieldDescriptor
+		if strings.HasPrefix(name, "[") && strings.HasSuffix(name, "]") {
+			xt, err := d.options.Resolver.FindExtensionByName(protoreflect.FullName(name[1 : len(name)-1]))
+			if err != nil && err != protoregistry.NotFound {
+				return d.newError(tok.Pos(), "unable to resolve %s: %v", tok.RawString(), err)
+			}
+			if xt != nil {
+				fd = xt.TypeDescriptor()
+				if !md.ExtensionRanges().Has(fd.Number()) || fd.ContainingMessage().FullName() != md.FullName() {
+					return d.newError(tok.Pos(), "message %v cannot be extended by %v", md.FullName(), fd.FullName())
+				}
+			}
+		} else {
+			fd = fields.ByJSONName(name)
+			if fd == nil {
+				fd = fields.ByTextName(name)
+			}
+		}
+
+		if fd == nil {
+			if d.options.DiscardUnknown {
+				if err := d.skipJSONValue(); err != nil {
+					return err
+				}
+				continue
+			}
+			return d.newError(tok.Pos(), "unknown field %v", tok.RawString())
+		}
+
+		if !seenNums.add(uint64(fd.Number())) {
+			return d.newError(tok.Pos(), "duplicate field %v", tok.RawString())
+		}
+		if next, _ := d.dec.Peek(); next.Kind() == json.Null && !isKnownValue(fd) && !isNullValue(fd) {
+			d.dec.Read()
+			continue
+		}
+		if od := fd.ContainingOneof(); od != nil && !seenOneofs.add(uint64(od.Index())) {
+			return d.newError(tok.Pos(), "error parsing %s, oneof %v is already set", tok.RawString(), od.FullName())
+		}
+
+		d.name = tok
+		if fd.IsExtension() {
+			err = d.unmarshalField(m, fd)
+		} else {
+			err = field(fd)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// fieldSet is a set of field numbers or oneof indexes.
+type fieldSet struct {
+	lo uint64
+	hi map[uint64]struct{}
+}
+
+// add adds n to the set, reporting whether it was not in the set yet.
+func (s *fieldSet) add(n uint64) bool {
+	if n < 64 {
+		if s.lo&(1<<n) != 0 {
+			return false
+		}
+		s.lo |= 1 << n
+		return true
+	}
+	if _, ok := s.hi[n]; ok {
+		return false
+	}
+	if s.hi == nil {
+		s.hi = make(map[uint64]struct{})
+	}
+	s.hi[n] = struct{}{}
+	return true
+}
+
+func isKnownValue(fd protoreflect.FieldDescriptor) bool {
+	md := fd.Message()
+	return md != nil && md.FullName() == valueName
+}
+
+func isNullValue(fd protoreflect.FieldDescriptor) bool {
+	ed := fd.Enum()
+	return ed != nil && ed.FullName() == nullValueName
+}
+
+// unmarshalField decodes the next value into the field fd of m through reflection.
+func (d *JSONDecoder) unmarshalField(m protoreflect.Message, fd protoreflect.FieldDescriptor) error {
+	switch {
+	case fd.IsList():
+		return d.unmarshalList(m.Mutable(fd).List(), fd)
+	case fd.IsMap():
+		return d.unmarshalMap(m.Mutable(fd).Map(), fd)
+	default:
+		return d.unmarshalSingular(m, fd)
+	}
+}
+
+func (d *JSONDecoder) unmarshalSingular(m protoreflect.Message, fd protoreflect.FieldDescriptor) error {
+	var v protoreflect.Value
+	var err error
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		v = m.NewField(fd)
+		err = d.unmarshalMessage(v.Message(), false)
+	default:
+		v, err = d.unmarshalScalar(fd)
+	}
+	if err != nil {
+		return err
+	}
+	if v.IsValid() {
+		m.Set(fd, v)
+	}
+	return nil
+}
+
+func (d *JSONDecoder) unmarshalList(list protoreflect.List, fd protoreflect.FieldDescriptor) error {
+	return d.List(func() error {
+		var v protoreflect.Value
+		var err error
+		switch fd.Kind() {
+		case protoreflect.MessageKind, protoreflect.GroupKind:
+			v = list.NewElement()
+			err = d.unmarshalMessage(v.Message(), false)
+		default:
+			v, err = d.unmarshalScalar(fd)
+		}
+		if err != nil {
+			return err
+		}
+		if v.IsValid() {
+			list.Append(v)
+		}
+		return nil
+	})
+}
+
+func (d *JSONDecoder) unmarshalMap(m protoreflect.Map, fd protoreflect.FieldDescriptor) error {
+	return d.Map(fd, func(k protoreflect.MapKey) error {
+		if m.Has(k) {
+			return d.DuplicateMapKey()
+		}
+		var v protoreflect.Value
+		var err error
+		switch fd.MapValue().Kind() {
+		case protoreflect.MessageKind, protoreflect.GroupKind:
+			v = m.NewValue()
+			err = d.unmarshalMessage(v.Message(), false)
+		default:
+			v, err = d.unmarshalScalar(fd.MapValue())
+		}
+		if err != nil {
+			return err
+		}
+		if v.IsValid() {
+			m.Set(k, v)
+		}
+		return nil
+	})
+}
+
+// List decodes the next array, calling elem to decode each of its elements.
+func (d *JSONDecoder) List(elem func() error) error {
+	tok, err := d.dec.Read()
+	if err != nil {
+		return err
+	}
+	if tok.Kind() != json.ArrayOpen {
+		return d.unexpectedTokenError(tok)
+	}
+	for {
+		tok, err := d.dec.Peek()
+		if err != nil {
+			return err
+		}
+		if tok.Kind() == json.ArrayClose {
+			d.dec.Read()
+			return nil
+		}
+		if err := elem(); err != nil {
+			return err
+		}
+	}
+}
+
+// Map decodes the next object as the map field fd, calling entry to decode the value of each key.
+// Duplicate keys must be reported by entry, through DuplicateMapKey, before decoding the value.
+func (d *JSONDecoder) Map(fd protoreflect.FieldDescriptor, entry func(k protoreflect.MapKey) error) error {
+	tok, err := d.dec.Read()
+	if err != nil {
+		return err
+	}
+	if tok.Kind() != json.ObjectOpen {
+		return d.unexpectedTokenError(tok)
+	}
+	for {
+		tok, err := d.dec.Read()
+		if err != nil {
+			return err
+		}
+		switch tok.Kind() {
+		default:
+			return d.unexpectedTokenError(tok)
+		case json.ObjectClose:
+			return nil
+		case json.Name:
+		}
+		k, err := d.unmarshalMapKey(tok, fd.MapKey())
+		if err != nil {
+			return err
+		}
+		d.name = tok
+		if err := entry(k); err != nil {
+			return err
+		}
+	}
+}
+
+// DuplicateMapKey returns the error reporting the last map key read as a duplicate.
+func (d *JSONDecoder) DuplicateMapKey() error {
+	return d.newError(d.name.Pos(), "duplicate map key %v", d.name.RawString())
+}
+
+func (d *JSONDecoder) unmarshalMapKey(tok json.Token, fd protoreflect.FieldDescriptor) (protoreflect.MapKey, error) {
+	name := tok.Name()
+	switch kind := fd.Kind(); kind {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(name).MapKey(), nil
+	case protoreflect.BoolKind:
+		switch name {
+		case "true":
+			return protoreflect.ValueOfBool(true).MapKey(), nil
+		case "false":
+			return protoreflect.ValueOfBool(false).MapKey(), nil
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if n, err := strconv.ParseInt(name, 10, 32); err == nil {
+			return protoreflect.ValueOfInt32(int32(n)).MapKey(), nil
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if n, err := strconv.ParseInt(name, 10, 64); err == nil {
+			return protoreflect.ValueOfInt64(n).MapKey(), nil
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if n, err := strconv.ParseUint(name, 10, 32); err == nil {
+			return protoreflect.ValueOfUint32(uint32(n)).MapKey(), nil
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if n, err := strconv.ParseUint(name, 10, 64); err == nil {
+			return protoreflect.ValueOfUint64(n).MapKey(), nil
+		}
+	default:
+		panic(fmt.Sprintf("invalid kind for map key: %v", kind))
+	}
+	return protoreflect.MapKey{}, d.newError(tok.Pos(), "invalid value for %v key: %s", fd.Kind(), tok.RawString())
+}
+
+// Bool decodes the next value as a value of the bool field fd.
+func (d *JSONDecoder) Bool(fd protoreflect.FieldDescriptor) (bool, error) {
+	v, err := d.unmarshalScalar(fd)
+	return v.IsValid() && v.Bool(), err
+}
+
+// Int32 decodes the next value as a value of the 32-bit signed integer field fd.
+func (d *JSONDecoder) Int32(fd protoreflect.FieldDescriptor) (int32, error) {
+	v, err := d.unmarshalScalar(fd)
+	if err != nil {
+		return 0, err
+	}
+	return int32(v.Int()), nil
+}
+
+// Int64 decodes the next value as a value of the 64-bit signed integer field fd.
+func (d *JSONDecoder) Int64(fd protoreflect.FieldDescriptor) (int64, error) {
+	v, err := d.unmarshalScalar(fd)
+	if err != nil {
+		return 0, err
+	}
+	return v.Int(), nil
+}
+
+// Uint32 decodes the next value as a value of the 32-bit unsigned integer field fd.
+func (d *JSONDecoder) Uint32(fd protoreflect.FieldDescriptor) (uint32, error) {
+	v, err := d.unmarshalScalar(fd)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(v.Uint()), nil
+}
+
+// Uint64 decodes the next value as a value of the 64-bit unsigned integer field fd.
+func (d *JSONDecoder) Uint64(fd protoreflect.FieldDescriptor) (uint64, error) {
+	v, err := d.unmarshalScalar(fd)
+	if err != nil {
+		return 0, err
+	}
+	return v.Uint(), nil
+}
+
+// Float32 decodes the next value as a value of the float field fd.
+func (d *JSONDecoder) Float32(fd protoreflect.FieldDescriptor) (float32, error) {
+	v, err := d.unmarshalScalar(fd)
+	if err != nil {
+		return 0, err
+	}
+	return float32(v.Float()), nil
+}
+
+// Float64 decodes the next value as a value of the double field fd.
+func (d *JSONDecoder) Float64(fd protoreflect.FieldDescriptor) (float64, error) {
+	v, err := d.unmarshalScalar(fd)
+	if err != nil {
+		return 0, err
+	}
+	return v.Float(), nil
+}
+
+// String decodes the next value as a value of the string field fd.
+func (d *JSONDecoder) String(fd protoreflect.FieldDescriptor) (string, error) {
+	v, err := d.unmarshalScalar(fd)
+	if err != nil {
+		return "", err
+	}
+	return v.String(), nil
+}
+
+// Bytes decodes the next value as a value of the bytes field fd.
+func (d *JSONDecoder) Bytes(fd protoreflect.FieldDescriptor) ([]byte, error) {
+	v, err := d.unmarshalScalar(fd)
+	if err != nil {
+		return nil, err
+	}
+	return v.Bytes(), nil
+}
+
+// Enum decodes the next value as a value of the enum field fd. It reports false when the
+// value is an unknown name which is discarded, in which case the field must not be set.
+func (d *JSONDecoder) Enum(fd protoreflect.FieldDescriptor) (protoreflect.EnumNumber, bool, error) {
+	v, err := d.unmarshalScalar(fd)
+	if err != nil || !v.IsValid() {
+		return 0, false, err
+	}
+	return v.Enum(), true, nil
+}
+
+// unmarshalScalar decodes the next value as a value of the scalar or enum field fd.
+// The returned value is invalid for discarded enum names.
+func (d *JSONDecoder) unmarshalScalar(fd protoreflect.FieldDescriptor) (protoreflect.Value, error) {
+	tok, err := d.dec.Read()
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	switch kind := fd.Kind(); kind {
+	case protoreflect.BoolKind:
+		if tok.Kind() == json.Bool {
+			return protoreflect.ValueOfBool(tok.Bool()), nil
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if n, ok := unmarshalInt(tok, 32); ok {
+			return protoreflect.ValueOfInt32(int32(n)), nil
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if n, ok := unmarshalInt(tok, 64); ok {
+			return protoreflect.ValueOfInt64(n), nil
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if n, ok := unmarshalUint(tok, 32); ok {
+			return protoreflect.ValueOfUint32(uint32(n)), nil
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if n, ok := unmarshalUint(tok, 64); ok {
+			return protoreflect.ValueOfUint64(n), nil
+		}
+	case protoreflect.FloatKind:
+		if f, ok := unmarshalFloat(tok, 32); ok {
+			return protoreflect.ValueOfFloat32(float32(f)), nil
+		}
+	case protoreflect.DoubleKind:
+		if f, ok := unmarshalFloat(tok, 64); ok {
+			return protoreflect.ValueOfFloat64(f), nil
+		}
+	case protoreflect.StringKind:
+		if tok.Kind() == json.String {
+			return protoreflect.ValueOfString(tok.ParsedString()), nil
+		}
+	case protoreflect.BytesKind:
+		if b, ok := unmarshalBytes(tok); ok {
+			return protoreflect.ValueOfBytes(b), nil
+		}
+	case protoreflect.EnumKind:
+		if v, ok := unmarshalEnum(tok, fd, d.options.DiscardUnknown); ok {
+			return v, nil
+		}
+	default:
+		panic(fmt.Sprintf("unmarshalScalar: invalid scalar kind %v", kind))
+	}
+	return protoreflect.Value{}, d.newError(tok.Pos(), "invalid value for %v field %v: %v", fd.Kind(), fd.JSONName(), tok.RawString())
+}
+
+// numberToken returns the number token held by tok, which may be a JSON number
+// or a JSON string holding a JSON number.
+func numberToken(tok json.Token) (json.Token, bool) {
+	switch tok.Kind() {
+	case json.Number:
+		return tok, true
+	case json.String:
+		s := strings.TrimSpace(tok.ParsedString())
+		if len(s) != len(tok.ParsedString()) {
+			return json.Token{}, false
+		}
+		dec := json.NewDecoder([]byte(s))
+		tok, err := dec.Read()
+		if err != nil {
+			return json.Token{}, false
+		}
+		// ensure there is no non-number content in the string
+		if next, err := dec.Read(); err != nil || next.Kind() != json.EOF {
+			return json.Token{}, false
+		}
+		return tok, true
+	}
+	return json.Token{}, false
+}
+
+func unmarshalInt(tok json.Token, bitSize int) (int64, bool) {
+	tok, ok := numberToken(tok)
+	if !ok {
+		return 0, false
+	}
+	return tok.Int(bitSize)
+}
+
+func unmarshalUint(tok json.Token, bitSize int) (uint64, bool) {
+	tok, ok := numberToken(tok)
+	if !ok {
+		return 0, false
+	}
+	return tok.Uint(bitSize)
+}
+
+func unmarshalFloat(tok json.Token, bitSize int) (float64, bool) {
+	if tok.Kind() == json.String {
+		switch tok.ParsedString() {
+		case "NaN":
+			return math.NaN(), true
+		case "Infinity":
+			return math.Inf(+1), true
+		case "-Infinity":
+			return math.Inf(-1), true
+		}
+	}
+	tok, ok := numberToken(tok)
+	if !ok {
+		return 0, false
+	}
+	return tok.Float(bitSize)
+}
+
+func unmarshalBytes(tok json.Token) ([]byte, bool) {
+	if tok.Kind() != json.String {
+		return nil, false
+	}
+	s := tok.ParsedString()
+	enc := base64.StdEncoding
+	if strings.ContainsAny(s, "-_") {
+		enc = base64.URLEncoding
+	}
+	if len(s)%4 != 0 {
+		enc = enc.WithPadding(base64.NoPadding)
+	}
+	b, err := enc.DecodeString(s)
+	if err != nil {
+		return nil, false
+	}
+	return b, true
+}
+
+func unmarshalEnum(tok json.Token, fd protoreflect.FieldDescriptor, discardUnknown bool) (protoreflect.Value, bool) {
+	switch tok.Kind() {
+	case json.String:
+		if value := fd.Enum().Values().ByName(protoreflect.Name(tok.ParsedString())); value != nil {
+			return protoreflect.ValueOfEnum(value.Number()), true
+		}
+		if discardUnknown {
+			return protoreflect.Value{}, true
+		}
+	case json.Number:
+		if n, ok := tok.Int(32); ok {
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), true
+		}
+	case json.Null:
+		// only valid for google.protobuf.NullValue
+		if isNullValue(fd) {
+			return protoreflect.ValueOfEnum(0), true
+		}
+	}
+	return protoreflect.Value{}, false
+}
+
+// skipJSONValue reads the next value, relying on the decoder to report invalid sequences.
+func (d *JSONDecoder) skipJSONValue() error {
+	var open int
+	for {
+		tok, err := d.dec.Read()
+		if err != nil {
+			return err
+		}
+		switch tok.Kind() {
+		case json.ObjectClose, json.ArrayClose:
+			open--
+		case json.ObjectOpen, json.ArrayOpen:
+			open++
+			if open > d.options.RecursionLimit-d.depth {
+				return errors.New("exceeded max recursion depth")
+			}
+		case json.EOF:
+			// only happens on a bug of the decoder, avoid an infinite loop
+			return errors.New("unexpected EOF")
+		}
+		if open == 0 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,732 @@
+package runtime
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-proto/runtime/internal/json"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// The well-known types have a JSON format of their own, implemented below through reflection
+// as in protojson, whichever their Go implementation is.
+
+const (
+	googleProtobufPackage protoreflect.FullName = "google.protobuf"
+
+	anyName       protoreflect.FullName = "google.protobuf.Any"
+	emptyName     protoreflect.FullName = "google.protobuf.Empty"
+	valueName     protoreflect.FullName = "google.protobuf.Value"
+	nullValueName protoreflect.FullName = "google.protobuf.NullValue"
+)
+
+type (
+	jsonAppendFunc    func(b []byte, m protoreflect.Message) ([]byte, error)
+	jsonUnmarshalFunc func(d *JSONDecoder, m protoreflect.Message) error
+)
+
+// wellKnownJSONAppender returns the function encoding the well-known type name,
+// or nil if the type is encoded as a regular message.
+func wellKnownJSONAppender(name protoreflect.FullName) jsonAppendFunc {
+	if name.Parent() != googleProtobufPackage {
+		return nil
+	}
+	switch name.Name() {
+	case "Any":
+		return appendJSONAny
+	case "Timestamp":
+		return appendJSONTimestamp
+	case "Duration":
+		return appendJSONDuration
+	case "BoolValue", "Int32Value", "Int64Value", "UInt32Value", "UInt64Value",
+		"FloatValue", "DoubleValue", "StringValue", "BytesValue":
+		return appendJSONWrapper
+	case "Struct":
+		return appendJSONStruct
+	case "ListValue":
+		return appendJSONListValue
+	case "Value":
+		return appendJSONKnownValue
+	case "FieldMask":
+		return appendJSONFieldMask
+	}
+	// google.protobuf.Empty has no JSON format of its own
+	return nil
+}
+
+// wellKnownJSONDecoder returns the function decoding the well-known type name,
+// or nil if the type is decoded as a regular message.
+func wellKnownJSONDecoder(name protoreflect.FullName) jsonUnmarshalFunc {
+	if name.Parent() != googleProtobufPackage {
+		return nil
+	}
+	switch name.Name() {
+	case "Any":
+		return (*JSONDecoder).unmarshalAny
+	case "Timestamp":
+		return (*JSONDecoder).unmarshalTimestamp
+	case "Duration":
+		return (*JSONDecoder).unmarshalDuration
+	case "BoolValue", "Int32Value", "Int64Value", "UInt32Value", "UInt64Value",
+		"FloatValue", "DoubleValue", "StringValue", "BytesValue":
+		return (*JSONDecoder).unmarshalWrapper
+	case "Struct":
+		return (*JSONDecoder).unmarshalStruct
+	case "ListValue":
+		return (*JSONDecoder).unmarshalListValue
+	case "Value":
+		return (*JSONDecoder).unmarshalKnownValue
+	case "FieldMask":
+		return (*JSONDecoder).unmarshalFieldMask
+	case "Empty":
+		return (*JSONDecoder).unmarshalEmpty
+	}
+	return nil
+}
+
+// An Any is encoded as the embedded message with an additional "@type" field holding the
+// type URL, or, if the embedded message is a well-known type with a JSON format of its own,
+// as an object holding the "@type" field and a "value" field with the embedded message.
+
+func appendJSONAny(b []byte, m protoreflect.Message) ([]byte, error) {
+	fields := m.Descriptor().Fields()
+	fdType, fdValue := fields.ByNumber(1), fields.ByNumber(2)
+	if !m.Has(fdType) {
+		if !m.Has(fdValue) {
+			return append(b, "{}"...), nil
+		}
+		return b, fmt.Errorf("%s: %v is not set", anyName, fdType.Name())
+	}
+
+	typeURL := m.Get(fdType).String()
+	emt, err := protoregistry.GlobalTypes.FindMessageByURL(typeURL)
+	if err != nil {
+		return b, fmt.Errorf("%s: unable to resolve %q: %v", anyName, typeURL, err)
+	}
+	em := emt.New()
+	err = proto.UnmarshalOptions{
+		AllowPartial: true, // required fields are never checked within an Any
+		Resolver:     protoregistry.GlobalTypes,
+	}.Unmarshal(m.Get(fdValue).Bytes(), em.Interface())
+	if err != nil {
+		return b, fmt.Errorf("%s: unable to unmarshal %q: %v", anyName, typeURL, err)
+	}
+
+	appendWKT := wellKnownJSONAppender(emt.Descriptor().FullName())
+	if appendWKT == nil {
+		return appendJSONMessage(b, em, typeURL)
+	}
+	b = append(b, '{')
+	if b, err = appendJSONTypeURL(b, typeURL); err != nil {
+		return b, err
+	}
+	b = append(b, `"value":`...)
+	if b, err = appendWKT(b, em); err != nil {
+		return b, err
+	}
+	return append(b, '}'), nil
+}
+
+func (d *JSONDecoder) unmarshalAny(m protoreflect.Message) error {
+	start, err := d.dec.Peek()
+	if err != nil {
+		return err
+	}
+	if start.Kind() != json.ObjectOpen {
+		return d.unexpectedTokenError(start)
+	}
+
+	// the "@type" field is looked for with another decoder, the object holding
+	// the fields of the embedded message which are then read by d
+	tok, err := findTypeURL(&JSONDecoder{dec: d.dec.Clone(), options: d.options, depth: d.depth})
+	switch err {
+	case errEmptyObject:
+		d.dec.Read()
+		d.dec.Read()
+		return nil
+	case errMissingType:
+		if d.options.DiscardUnknown {
+			return d.skipJSONValue()
+		}
+		return d.newError(start.Pos(), err.Error())
+	default:
+		if err != nil {
+			return err
+		}
+	}
+
+	typeURL := tok.ParsedString()
+	emt, err := d.options.Resolver.FindMessageByURL(typeURL)
+	if err != nil {
+		return d.newError(tok.Pos(), "unable to resolve %v: %q", tok.RawString(), err)
+	}
+	em := emt.New()
+	if unmarshal := wellKnownJSONDecoder(emt.Descriptor().FullName()); unmarshal != nil {
+		err = d.unmarshalAnyValue(unmarshal, em)
+	} else {
+		err = d.unmarshalMessage(em, true)
+	}
+	if err != nil {
+		return err
+	}
+	b, err := proto.MarshalOptions{
+		AllowPartial:  true, // required fields are never checked within an Any
+		Deterministic: true,
+	}.Marshal(em.Interface())
+	if err != nil {
+		return d.newError(start.Pos(), "error in marshaling Any.value field: %v", err)
+	}
+
+	fields := m.Descriptor().Fields()
+	m.Set(fields.ByNumber(1), protoreflect.ValueOfString(typeURL))
+	m.Set(fields.ByNumber(2), protoreflect.ValueOfBytes(b))
+	return nil
+}
+
+var (
+	errEmptyObject = errors.New(`empty object`)
+	errMissingType = errors.New(`missing "@type" field`)
+)
+
+// findTypeURL returns the token of the value of the "@type" field of the next object.
+// It returns errEmptyObject if the object is empty and errMissingType if it has no
+// "@type" field.
+func findTypeURL(d *JSONDecoder) (json.Token, error) {
+	var typeURL string
+	var typeTok json.Token
+	numFields := 0
+	d.dec.Read()
+	for {
+		tok, err := d.dec.Read()
+		if err != nil {
+			return json.Token{}, err
+		}
+		switch tok.Kind() {
+		case json.ObjectClose:
+			if typeURL == "" {
+				if numFields > 0 {
+					return json.Token{}, errMissingType
+				}
+				return json.Token{}, errEmptyObject
+			}
+			return typeTok, nil
+
+		case json.Name:
+			numFields++
+			if tok.Name() != "@type" {
+				if err := d.skipJSONValue(); err != nil {
+					return json.Token{}, err
+				}
+				continue
+			}
+			if typeURL != "" {
+				return json.Token{}, d.newError(tok.Pos(), `duplicate "@type" field`)
+			}
+			tok, err := d.dec.Read()
+			if err != nil {
+				return json.Token{}, err
+			}
+			if tok.Kind() != json.String {
+				return json.Token{}, d.newError(tok.Pos(), `@type field value is not a string: %v`, tok.RawString())
+			}
+			typeURL = tok.ParsedString()
+			if typeURL == "" {
+				return json.Token{}, d.newError(tok.Pos(), `@type field contains empty value`)
+			}
+			typeTok = tok
+		}
+	}
+}
+
+// unmarshalAnyValue decodes the "value" field of the next object into m, a well-known type.
+func (d *JSONDecoder) unmarshalAnyValue(unmarshal jsonUnmarshalFunc, m protoreflect.Message) error {
+	d.dec.Read()
+	var found bool
+	for {
+		tok, err := d.dec.Read()
+		if err != nil {
+			return err
+		}
+		switch tok.Kind() {
+		case json.ObjectClose:
+			// the value of google.protobuf.Empty may be omitted
+			if !found && m.Descriptor().FullName() != emptyName {
+				return d.newError(tok.Pos(), `missing "value" field`)
+			}
+			return nil
+
+		case json.Name:
+			switch tok.Name() {
+			case "@type":
+				// already read by findTypeURL
+				d.dec.Read()
+			case "value":
+				if found {
+					return d.newError(tok.Pos(), `duplicate "value" field`)
+				}
+				if err := unmarshal(d, m); err != nil {
+					return err
+				}
+				found = true
+			default:
+				if d.options.DiscardUnknown {
+					if err := d.skipJSONValue(); err != nil {
+						return err
+					}
+					continue
+				}
+				return d.newError(tok.Pos(), "unknown field %v", tok.RawString())
+			}
+		}
+	}
+}
+
+// Wrappers are encoded as their value.
+
+func appendJSONWrapper(b []byte, m protoreflect.Message) ([]byte, error) {
+	fd := m.Descriptor().Fields().ByNumber(1)
+	return appendJSONValue(b, fd, m.Get(fd))
+}
+
+func (d *JSONDecoder) unmarshalWrapper(m protoreflect.Message) error {
+	fd := m.Descriptor().Fields().ByNumber(1)
+	v, err := d.unmarshalScalar(fd)
+	if err != nil {
+		return err
+	}
+	m.Set(fd, v)
+	return nil
+}
+
+// An Empty is encoded as an empty object, which is the encoding of the message
+// anyway, but its decoding does not accept any field.
+
+func (d *JSONDecoder) unmarshalEmpty(protoreflect.Message) error {
+	tok, err := d.dec.Read()
+	if err != nil {
+		return err
+	}
+	if tok.Kind() != json.ObjectOpen {
+		return d.unexpectedTokenError(tok)
+	}
+	for {
+		tok, err := d.dec.Read()
+		if err != nil {
+			return err
+		}
+		switch tok.Kind() {
+		case json.ObjectClose:
+			return nil
+		case json.Name:
+			if d.options.DiscardUnknown {
+				if err := d.skipJSONValue(); err != nil {
+					return err
+				}
+				continue
+			}
+			return d.newError(tok.Pos(), "unknown field %v", tok.RawString())
+		default:
+			return d.unexpectedTokenError(tok)
+		}
+	}
+}
+
+// A Struct is encoded as its fields map, and a ListValue as its values list.
+
+func appendJSONStruct(b []byte, m protoreflect.Message) ([]byte, error) {
+	fd := m.Descriptor().Fields().ByNumber(1)
+	return appendJSONMap(b, fd, m.Get(fd).Map())
+}
+
+func (d *JSONDecoder) unmarshalStruct(m protoreflect.Message) error {
+	fd := m.Descriptor().Fields().ByNumber(1)
+	return d.unmarshalMap(m.Mutable(fd).Map(), fd)
+}
+
+func appendJSONListValue(b []byte, m protoreflect.Message) ([]byte, error) {
+	fd := m.Descriptor().Fields().ByNumber(1)
+	return appendJSONList(b, fd, m.Get(fd).List())
+}
+
+func (d *JSONDecoder) unmarshalListValue(m protoreflect.Message) error {
+	fd := m.Descriptor().Fields().ByNumber(1)
+	return d.unmarshalList(m.Mutable(fd).List(), fd)
+}
+
+// A Value is encoded as the member of its kind oneof which is set, one of them being required.
+
+const (
+	valueNullValueNumber   protoreflect.FieldNumber = 1
+	valueNumberValueNumber protoreflect.FieldNumber = 2
+	valueStringValueNumber protoreflect.FieldNumber = 3
+	valueBoolValueNumber   protoreflect.FieldNumber = 4
+	valueStructValueNumber protoreflect.FieldNumber = 5
+	valueListValueNumber   protoreflect.FieldNumber = 6
+)
+
+func appendJSONKnownValue(b []byte, m protoreflect.Message) ([]byte, error) {
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("kind"))
+	if fd == nil {
+		return b, fmt.Errorf("%s: none of the oneof fields is set", valueName)
+	}
+	if fd.Number() == valueNumberValueNumber {
+		if v := m.Get(fd).Float(); math.IsNaN(v) || math.IsInf(v, 0) {
+			return b, fmt.Errorf("%s: invalid %v value", fd.FullName(), v)
+		}
+	}
+	return appendJSONValue(b, fd, m.Get(fd))
+}
+
+func (d *JSONDecoder) unmarshalKnownValue(m protoreflect.Message) error {
+	tok, err := d.dec.Peek()
+	if err != nil {
+		return err
+	}
+
+	fields := m.Descriptor().Fields()
+	var fd protoreflect.FieldDescriptor
+	var v protoreflect.Value
+	switch tok.Kind() {
+	case json.Null:
+		d.dec.Read()
+		fd = fields.ByNumber(valueNullValueNumber)
+		v = protoreflect.ValueOfEnum(0)
+
+	case json.Bool:
+		tok, err := d.dec.Read()
+		if err != nil {
+			return err
+		}
+		fd = fields.ByNumber(valueBoolValueNumber)
+		v = protoreflect.ValueOfBool(tok.Bool())
+
+	case json.Number:
+		tok, err := d.dec.Read()
+		if err != nil {
+			return err
+		}
+		fd = fields.ByNumber(valueNumberValueNumber)
+		f, ok := unmarshalFloat(tok, 64)
+		if !ok {
+			return d.newError(tok.Pos(), "invalid %v: %v", valueName, tok.RawString())
+		}
+		v = protoreflect.ValueOfFloat64(f)
+
+	case json.String:
+		// strings are always decoded as string_value, even when encoding a
+		// number_value such as "NaN"
+		tok, err := d.dec.Read()
+		if err != nil {
+			return err
+		}
+		fd = fields.ByNumber(valueStringValueNumber)
+		v = protoreflect.ValueOfString(tok.ParsedString())
+
+	case json.ObjectOpen:
+		fd = fields.ByNumber(valueStructValueNumber)
+		v = m.NewField(fd)
+		if err := d.unmarshalStruct(v.Message()); err != nil {
+			return err
+		}
+
+	case json.ArrayOpen:
+		fd = fields.ByNumber(valueListValueNumber)
+		v = m.NewField(fd)
+		if err := d.unmarshalListValue(v.Message()); err != nil {
+			return err
+		}
+
+	default:
+		return d.newError(tok.Pos(), "invalid %v: %v", valueName, tok.RawString())
+	}
+
+	m.Set(fd, v)
+	return nil
+}
+
+// A Duration is encoded as a JSON string holding a number of seconds suffixed with "s",
+// with 0, 3, 6 or 9 fractional digits, and a Timestamp as a JSON string holding the
+// time in the RFC 3339 format normalized to UTC, with 0, 3, 6 or 9 fractional digits.
+
+const (
+	secondsInNanos       = 999999999
+	maxSecondsInDuration = 315576000000
+	maxTimestampSeconds  = 253402300799
+	minTimestampSeconds  = -62135596800
+)
+
+func appendJSONDuration(b []byte, m protoreflect.Message) ([]byte, error) {
+	fields := m.Descriptor().Fields()
+	secs := m.Get(fields.ByNumber(1)).Int()
+	nanos := m.Get(fields.ByNumber(2)).Int()
+	if secs < -maxSecondsInDuration || secs > maxSecondsInDuration {
+		return b, fmt.Errorf("%s: seconds out of range %v", m.Descriptor().FullName(), secs)
+	}
+	if nanos < -secondsInNanos || nanos > secondsInNanos {
+		return b, fmt.Errorf("%s: nanos out of range %v", m.Descriptor().FullName(), nanos)
+	}
+	if (secs > 0 && nanos < 0) || (secs < 0 && nanos > 0) {
+		return b, fmt.Errorf("%s: signs of seconds and nanos do not match", m.Descriptor().FullName())
+	}
+	var sign string
+	if secs < 0 || nanos < 0 {
+		sign, secs, nanos = "-", -1*secs, -1*nanos
+	}
+	x := fmt.Sprintf("%s%d.%09d", sign, secs, nanos)
+	x = strings.TrimSuffix(x, "000")
+	x = strings.TrimSuffix(x, "000")
+	x = strings.TrimSuffix(x, ".000")
+	b = append(b, '"')
+	b = append(b, x...)
+	return append(b, `s"`...), nil
+}
+
+func (d *JSONDecoder) unmarshalDuration(m protoreflect.Message) error {
+	tok, err := d.dec.Read()
+	if err != nil {
+		return err
+	}
+	if tok.Kind() != json.String {
+		return d.unexpectedTokenError(tok)
+	}
+	secs, nanos, ok := parseDuration(tok.ParsedString())
+	if !ok {
+		return d.newError(tok.Pos(), "invalid %v value %v", m.Descriptor().FullName(), tok.RawString())
+	}
+	// nanos are validated by parseDuration
+	if secs < -maxSecondsInDuration || secs > maxSecondsInDuration {
+		return d.newError(tok.Pos(), "%v value out of range: %v", m.Descriptor().FullName(), tok.RawString())
+	}
+	fields := m.Descriptor().Fields()
+	m.Set(fields.ByNumber(1), protoreflect.ValueOfInt64(secs))
+	m.Set(fields.ByNumber(2), protoreflect.ValueOfInt32(nanos))
+	return nil
+}
+
+// parseDuration parses a decimal number of seconds suffixed with "s", with an optional sign,
+// an integer part or a fractional part of up to 9 digits, or both, such as 1s, 0.1s, 1.s,
+// .1s, +1s, -1s or -.1s.
+func parseDuration(input string) (int64, int32, bool) {
+	b := []byte(input)
+	size := len(b)
+	if size < 2 || b[size-1] != 's' {
+		return 0, 0, false
+	}
+	b = b[:size-1]
+
+	var neg bool
+	switch b[0] {
+	case '-':
+		neg = true
+		b = b[1:]
+	case '+':
+		b = b[1:]
+	}
+	if len(b) == 0 {
+		return 0, 0, false
+	}
+
+	var intp []byte
+	switch {
+	case b[0] == '0':
+		b = b[1:]
+	case '1' <= b[0] && b[0] <= '9':
+		intp = b[0:]
+		b = b[1:]
+		n := 1
+		for len(b) > 0 && '0' <= b[0] && b[0] <= '9' {
+			n++
+			b = b[1:]
+		}
+		intp = intp[:n]
+	case b[0] == '.':
+	default:
+		return 0, 0, false
+	}
+
+	hasFrac := false
+	var frac [9]byte
+	if len(b) > 0 {
+		if b[0] != '.' {
+			return 0, 0, false
+		}
+		b = b[1:]
+		n := 0
+		for len(b) > 0 && n < 9 && '0' <= b[0] && b[0] <= '9' {
+			frac[n] = b[0]
+			n++
+			b = b[1:]
+		}
+		if len(b) > 0 {
+			return 0, 0, false
+		}
+		for i := n; i < 9; i++ {
+			frac[i] = '0'
+		}
+		hasFrac = true
+	}
+
+	var secs int64
+	if len(intp) > 0 {
+		var err error
+		secs, err = strconv.ParseInt(string(intp), 10, 64)
+		if err != nil {
+			return 0, 0, false
+		}
+	}
+
+	var nanos int64
+	if hasFrac {
+		nanob := bytes.TrimLeft(frac[:], "0")
+		if len(nanob) > 0 {
+			var err error
+			nanos, err = strconv.ParseInt(string(nanob), 10, 32)
+			if err != nil {
+				return 0, 0, false
+			}
+		}
+	}
+
+	if neg {
+		if secs > 0 {
+			secs = -secs
+		}
+		if nanos > 0 {
+			nanos = -nanos
+		}
+	}
+	return secs, int32(nanos), true
+}
+
+func appendJSONTimestamp(b []byte, m protoreflect.Message) ([]byte, error) {
+	fields := m.Descriptor().Fields()
+	secs := m.Get(fields.ByNumber(1)).Int()
+	nanos := m.Get(fields.ByNumber(2)).Int()
+	if secs < minTimestampSeconds || secs > maxTimestampSeconds {
+		return b, fmt.Errorf("%s: seconds out of range %v", m.Descriptor().FullName(), secs)
+	}
+	if nanos < 0 || nanos > secondsInNanos {
+		return b, fmt.Errorf("%s: nanos out of range %v", m.Descriptor().FullName(), nanos)
+	}
+	x := time.Unix(secs, nanos).UTC().Format("2006-01-02T15:04:05.000000000")
+	x = strings.TrimSuffix(x, "000")
+	x = strings.TrimSuffix(x, "000")
+	x = strings.TrimSuffix(x, ".000")
+	b = append(b, '"')
+	b = append(b, x...)
+	return append(b, `Z"`...), nil
+}
+
+func (d *JSONDecoder) unmarshalTimestamp(m protoreflect.Message) error {
+	tok, err := d.dec.Read()
+	if err != nil {
+		return err
+	}
+	if tok.Kind() != json.String {
+		return d.unexpectedTokenError(tok)
+	}
+	s := tok.ParsedString()
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return d.newError(tok.Pos(), "invalid %v value %v", m.Descriptor().FullName(), tok.RawString())
+	}
+	secs := t.Unix()
+	if secs < minTimestampSeconds || secs > maxTimestampSeconds {
+		return d.newError(tok.Pos(), "%v value out of range: %v", m.Descriptor().FullName(), tok.RawString())
+	}
+	// time.Parse accepts more than 9 fractional digits
+	i := strings.LastIndexByte(s, '.')
+	j := strings.LastIndexAny(s, "Z-+")
+	if i >= 0 && j >= i && j-i > len(".999999999") {
+		return d.newError(tok.Pos(), "invalid %v value %v", m.Descriptor().FullName(), tok.RawString())
+	}
+	fields := m.Descriptor().Fields()
+	m.Set(fields.ByNumber(1), protoreflect.ValueOfInt64(secs))
+	m.Set(fields.ByNumber(2), protoreflect.ValueOfInt32(int32(t.Nanosecond())))
+	return nil
+}
+
+// A FieldMask is encoded as a JSON string holding its paths in lower camel case, separated
+// by commas. Paths which would not be decoded back as they are cannot be encoded.
+
+func appendJSONFieldMask(b []byte, m protoreflect.Message) ([]byte, error) {
+	fd := m.Descriptor().Fields().ByNumber(1)
+	list := m.Get(fd).List()
+	paths := make([]string, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		s := list.Get(i).String()
+		if !protoreflect.FullName(s).IsValid() {
+			return b, fmt.Errorf("%s contains invalid path: %q", fd.FullName(), s)
+		}
+		cc := jsonCamelCase(s)
+		if s != jsonSnakeCase(cc) {
+			return b, fmt.Errorf("%s contains irreversible value %q", fd.FullName(), s)
+		}
+		paths = append(paths, cc)
+	}
+	return json.AppendString(b, strings.Join(paths, ","))
+}
+
+func (d *JSONDecoder) unmarshalFieldMask(m protoreflect.Message) error {
+	tok, err := d.dec.Read()
+	if err != nil {
+		return err
+	}
+	if tok.Kind() != json.String {
+		return d.unexpectedTokenError(tok)
+	}
+	str := strings.TrimSpace(tok.ParsedString())
+	if str == "" {
+		return nil
+	}
+	fd := m.Descriptor().Fields().ByNumber(1)
+	list := m.Mutable(fd).List()
+	for _, s0 := range strings.Split(str, ",") {
+		s := jsonSnakeCase(s0)
+		if strings.Contains(s0, "_") || !protoreflect.FullName(s).IsValid() {
+			return d.newError(tok.Pos(), "%v contains invalid path: %q", fd.FullName(), s0)
+		}
+		list.Append(protoreflect.ValueOfString(s))
+	}
+	return nil
+}
+
+// jsonCamelCase converts a snake_case identifier to a camelCase identifier,
+// according to the protobuf JSON specification.
+func jsonCamelCase(s string) string {
+	var b []byte
+	var wasUnderscore bool
+	for i := 0; i < len(s); i++ { // proto identifiers are always ASCII
+		c := s[i]
+		if c != '_' {
+			if wasUnderscore && 'a' <= c && c <= 'z' {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+		}
+		wasUnderscore = c == '_'
+	}
+	return string(b)
+}
+
+// jsonSnakeCase converts a camelCase identifier to a snake_case identifier,
+// according to the protobuf JSON specification.
+func jsonSnakeCase(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ { // proto identifiers are always ASCII
+		c := s[i]
+		if 'A' <= c && c <= 'Z' {
+			b = append(b, '_')
+			c += 'a' - 'A'
+		}
+		b = append(b, c)
+	}
+	return string(b)
+}
@@ -0,0 +1,307 @@
+package runtime
+
+import (
+	"sync"
+	"sync/atomic"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxLazyDepth bounds the nesting validated before recording a lazy field, deeper
+// encodings, as well as those exceeding the recursion limit, are decoded eagerly.
+const maxLazyDepth = 100
+
+// LazyFields holds the encoding of the lazy message fields of a generated message.
+// Fields are identified by their index among the lazy fields of the message, up to 64.
+//
+// The generated unmarshal records the encoding of a lazy field instead of decoding it,
+// the field is decoded on first access through its getter or through Get, Range or
+// Mutable, concurrent accesses being safe. The recorded encoding is marshalled back as
+// long as the field was not decoded, and dropped once it is, the field being then
+// marshalled so that changes made through the decoded messages are never lost. A field
+// assigned through its struct field before being decoded replaces the recorded encoding.
+type LazyFields struct {
+	mu      sync.Mutex
+	pending atomic.Uint64
+	raw     [][]byte
+	options proto.UnmarshalOptions
+	// marshal is held by the marshal of the message, so that the fields decoded meanwhile
+	// do not change the encoding between the computation of its size and its writing
+	marshal sync.RWMutex
+}
+
+// lazyMessage is the pointer type P of the generated message T held by lazy fields.
+type lazyMessage[T any] interface {
+	*T
+	proto.Message
+}
+
+// Pending reports whether the lazy field i holds an encoding which was not decoded yet.
+func (l *LazyFields) Pending(i int) bool {
+	return l.pending.Load()&(1<<i) != 0
+}
+
+// Append records b, the encoding of one occurrence of the lazy field i of a message with
+// n lazy fields, whose message type is md. It returns false, without recording anything,
+// when b is not an encoding which is known to decode successfully, in which case the
+// occurrence must be decoded eagerly to report the error. Occurrences are never recorded
+// when options reject unknown fields or carry decode limits. The field must be empty.
+// The scalars the fields of md and of its nested messages are annotated with, resolved
+// by the generator, are given by their full names.
+func (l *LazyFields) Append(i, n int, b []byte, md protoreflect.MessageDescriptor, scalars map[protoreflect.FullName]string, options proto.UnmarshalOptions) bool {
+	if rejectsUnknown(options) || hasDecodeLimits(options) {
+		// the unknown fields and the exceeded limits of the occurrence must be reported by the unmarshal
+		return false
+	}
+	_, _, m := protowire.ConsumeTag(b)
+	if m < 0 {
+		return false
+	}
+	// the messages nested in the field must fit in what is left of the recursion limit,
+	// otherwise decoding the field later on would fail
+	limit := options.RecursionLimit
+	if limit == 0 {
+		limit = protowire.DefaultRecursionLimit
+	}
+	v, m := protowire.ConsumeBytes(b[m:])
+	if m < 0 || !validLazy(v, md, scalars, 0, min(maxLazyDepth, limit-1)) {
+		return false
+	}
+	if l.raw == nil {
+		l.raw = make([][]byte, n)
+	}
+	if !l.Pending(i) {
+		l.raw[i] = nil
+	}
+	l.raw[i] = append(l.raw[i], b...)
+	options.Merge = true
+	options.AllowPartial = true
+	l.options = options
+	l.pending.Or(1 << i)
+	return true
+}
+
+// DecodeLazy decodes the singular lazy field i held by *f, unless it was already decoded.
+// The field is left undecoded when its encoding fails to decode, which only happens when
+// the validators run by the unmarshal, such as those of scalars, changed since it was
+// recorded.
+func DecodeLazy[T any, P lazyMessage[T]](l *LazyFields, i int, f *P) {
+	if !l.Pending(i) {
+		return
+	}
+	unlock := l.lock()
+	defer unlock()
+	if !l.Pending(i) {
+		return
+	}
+	if *f != nil {
+		// the field was assigned before being decoded
+		l.Discard(i)
+		return
+	}
+	v := P(new(T))
+	if err := l.decode(i, func(b []byte) error { return l.options.Unmarshal(b, v) }); err != nil {
+		return
+	}
+	*f = v
+	l.Discard(i)
+}
+
+// DecodeLazyList decodes the repeated lazy field i held by *f, unless it was already decoded.
+// The field is left undecoded when its encoding fails to decode, as with DecodeLazy.
+func DecodeLazyList[T any, P lazyMessage[T]](l *LazyFields, i int, f *[]P) {
+	if !l.Pending(i) {
+		return
+	}
+	unlock := l.lock()
+	defer unlock()
+	if !l.Pending(i) {
+		return
+	}
+	if len(*f) > 0 {
+		// the field was assigned before being decoded
+		l.Discard(i)
+		return
+	}
+	var list []P
+	err := l.decode(i, func(b []byte) error {
+		v := P(new(T))
+		list = append(list, v)
+		return l.options.Unmarshal(b, v)
+	})
+	if err != nil {
+		return
+	}
+	*f = list
+	l.Discard(i)
+}
+
+// lock locks the lazy fields for decoding one of them, which waits for the marshals
+// in progress, and returns the function unlocking them.
+func (l *LazyFields) lock() func() {
+	l.marshal.Lock()
+	l.mu.Lock()
+	return func() {
+		l.mu.Unlock()
+		l.marshal.Unlock()
+	}
+}
+
+// StartMarshal prevents the lazy fields from being decoded until EndMarshal is called,
+// which keeps the encoding of the message stable while it is marshalled.
+func (l *LazyFields) StartMarshal() {
+	l.marshal.RLock()
+}
+
+// EndMarshal ends the marshal started by StartMarshal.
+func (l *LazyFields) EndMarshal() {
+	l.marshal.RUnlock()
+}
+
+// RawLazy returns the recorded encoding of the singular lazy field i held by *f, tags
+// included, when the field was not decoded yet. It returns nil otherwise, the field being
+// then marshalled.
+func RawLazy[T any, P lazyMessage[T]](l *LazyFields, i int, f *P) []byte {
+	return l.pendingRaw(i, func() bool { return *f == nil })
+}
+
+// RawLazyList returns the recorded encoding of the repeated lazy field i held by *f, tags
+// included, when the field was not decoded yet. It returns nil otherwise, the field being
+// then marshalled.
+func RawLazyList[T any, P lazyMessage[T]](l *LazyFields, i int, f *[]P) []byte {
+	return l.pendingRaw(i, func() bool { return len(*f) == 0 })
+}
+
+// pendingRaw returns the recorded encoding of the lazy field i when the field was not
+// decoded yet, or nil when the field is not empty, as reported by empty, since it was
+// then assigned before being decoded.
+func (l *LazyFields) pendingRaw(i int, empty func() bool) []byte {
+	if !l.Pending(i) {
+		return nil
+	}
+	// the field is read along its concurrent decoding
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.Pending(i) || !empty() {
+		return nil
+	}
+	return l.raw[i]
+}
+
+// decode calls decode on the payload of each recorded occurrence of the lazy field i.
+func (l *LazyFields) decode(i int, decode func(b []byte) error) error {
+	for b := l.raw[i]; len(b) > 0; {
+		_, _, n := protowire.ConsumeTag(b)
+		v, m := protowire.ConsumeBytes(b[n:])
+		// the encoding was validated by Append
+		if err := decode(v); err != nil {
+			return err
+		}
+		b = b[n+m:]
+	}
+	return nil
+}
+
+// Discard drops the recorded encoding of the lazy field i, once it was written.
+func (l *LazyFields) Discard(i int) {
+	if i < len(l.raw) {
+		l.raw[i] = nil
+	}
+	l.pending.And(^uint64(1 << i))
+}
+
+// Reset drops the recorded encoding of all the lazy fields.
+func (l *LazyFields) Reset() {
+	clear(l.raw)
+	l.pending.Store(0)
+}
+
+// validLazy reports whether b is a valid encoding of a message of type md, which the
+// unmarshal of md then decodes without error. It errs on the side of rejection: strings
+// must be valid UTF-8 regardless of the syntax and extensions are not accepted. Messages
+// nested deeper than maxDepth are rejected.
+func validLazy(b []byte, md protoreflect.MessageDescriptor, scalars map[protoreflect.FullName]string, depth, maxDepth int) bool {
+	if depth > maxDepth {
+		return false
+	}
+	for len(b) > 0 {
+		num, wtyp, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return false
+		}
+		b = b[n:]
+		fd := md.Fields().ByNumber(num)
+		if fd == nil {
+			if md.ExtensionRanges().Has(num) {
+				return false
+			}
+			n = protowire.ConsumeFieldValue(num, wtyp, b)
+		} else {
+			n = validLazyValue(b, fd, wtyp, scalars, depth, maxDepth)
+		}
+		if n < 0 {
+			return false
+		}
+		b = b[n:]
+	}
+	return true
+}
+
+// validLazyValue returns the length of the valid value of fd at the start of b, or a
+// negative number when it is not valid.
+func validLazyValue(b []byte, fd protoreflect.FieldDescriptor, wtyp protowire.Type, scalars map[protoreflect.FullName]string, depth, maxDepth int) int {
+	if fd.IsList() && wtyp == protowire.BytesType && wireType(fd) != protowire.BytesType {
+		packed, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return n
+		}
+		for len(packed) > 0 {
+			m := protowire.ConsumeFieldValue(fd.Number(), wireType(fd), packed)
+			if m < 0 {
+				return m
+			}
+			packed = packed[m:]
+		}
+		return n
+	}
+	if wtyp != wireType(fd) {
+		return -1
+	}
+	// the values rejected by the validator of their scalar are left to the unmarshal to report
+	scalar := scalars[fd.FullName()]
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		if scalar != "" {
+			// the values of the map are validated along with their key by the unmarshal
+			return -1
+		}
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 || !validLazy(v, fd.Message(), scalars, depth+1, maxDepth) {
+			return -1
+		}
+		return n
+	case protoreflect.GroupKind:
+		v, n := protowire.ConsumeGroup(fd.Number(), b)
+		if n < 0 || !validLazy(v, fd.Message(), scalars, depth+1, maxDepth) {
+			return -1
+		}
+		return n
+	case protoreflect.StringKind:
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 || !utf8.Valid(v) || !validScalar(scalar, v) {
+			return -1
+		}
+		return n
+	case protoreflect.BytesKind:
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 || !validScalar(scalar, v) {
+			return -1
+		}
+		return n
+	default:
+		return protowire.ConsumeFieldValue(fd.Number(), wtyp, b)
+	}
+}
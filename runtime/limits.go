@@ -0,0 +1,136 @@
+package runtime
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DecodeLimits bounds the memory the generated unmarshal allocates from untrusted input. Each limit
+// is checked before allocating, and a zero limit leaves the corresponding allocations unbounded.
+type DecodeLimits struct {
+	// MaxBytes bounds the bytes allocated by the whole unmarshal for the content of strings and
+	// bytes, the elements of repeated fields and the entries of maps, counted in their 64-bit
+	// memory layout, as well as for the structs of the nested messages.
+	MaxBytes int
+	// MaxListLength bounds the number of elements of each repeated field.
+	MaxListLength int
+	// MaxMapEntries bounds the number of entries of each map field.
+	MaxMapEntries int
+	// MaxFieldLength bounds the length of each string and bytes value.
+	MaxFieldLength int
+}
+
+// DecodeLimitError reports the value of a field exceeding one of the DecodeLimits.
+type DecodeLimitError struct {
+	// Field is the full name of the field holding the value.
+	Field protoreflect.FullName
+	// Limit is the name of the exceeded limit, e.g. "MaxListLength".
+	Limit string
+}
+
+func (e *DecodeLimitError) Error() string {
+	return fmt.Sprintf("proto: %s exceeds DecodeLimits.%s", e.Field, e.Limit)
+}
+
+// Is reports the error as a protobuf error, matching proto.Error.
+func (e *DecodeLimitError) Is(target error) bool {
+	return target == proto.Error
+}
+
+// WithDecodeLimits returns a copy of options making the generated unmarshal fail with a
+// *DecodeLimitError when the decoded message exceeds limits. Each unmarshal using the returned
+// options gets its own MaxBytes budget, shared by the messages nested in the unmarshalled one:
+//
+//	err := runtime.WithDecodeLimits(proto.UnmarshalOptions{}, limits).Unmarshal(txBytes, tx)
+//
+// Messages without generated fast reflection, extensions and unknown fields are not limited.
+func WithDecodeLimits(options proto.UnmarshalOptions, limits DecodeLimits) proto.UnmarshalOptions {
+	return withResolver(options, func(r *unmarshalResolver) {
+		r.limits = &limits
+		r.budget = nil
+	})
+}
+
+// hasDecodeLimits reports whether options were returned by WithDecodeLimits.
+func hasDecodeLimits(options proto.UnmarshalOptions) bool {
+	return resolverOf(options).limits != nil
+}
+
+// StartDecodeBudget returns the budget of the unmarshal using options, along with the options
+// passing it on to the unmarshal of the nested messages. Options returned by WithDecodeLimits only
+// carry the limits, a new budget is therefore started by the generated unmarshal of the top-level
+// message, and shared by the messages nested in it. The budget is nil when options carry no limits.
+func StartDecodeBudget(options proto.UnmarshalOptions) (proto.UnmarshalOptions, *DecodeBudget) {
+	r := resolverOf(options)
+	if r.budget != nil || r.limits == nil {
+		return options, r.budget
+	}
+	budget := &DecodeBudget{limits: *r.limits}
+	return withResolver(options, func(r *unmarshalResolver) {
+		r.budget = budget
+	}), budget
+}
+
+// DecodeBudget enforces the DecodeLimits of an unmarshal, through the calls of the generated
+// unmarshal preceding its allocations. The methods of a nil budget accept everything.
+type DecodeBudget struct {
+	limits DecodeLimits
+	// used is the part of MaxBytes which was allocated, updated atomically since lazy fields
+	// can be decoded concurrently after the unmarshal.
+	used atomic.Int64
+}
+
+// Length accepts a string or bytes value of n bytes held by the field fd.
+func (b *DecodeBudget) Length(fd protoreflect.FieldDescriptor, n int) error {
+	if b == nil {
+		return nil
+	}
+	if b.limits.MaxFieldLength > 0 && n > b.limits.MaxFieldLength {
+		return &DecodeLimitError{Field: fd.FullName(), Limit: "MaxFieldLength"}
+	}
+	return b.spend(fd, n)
+}
+
+// List accepts n elements of size bytes appended to the repeated field fd, which holds length elements.
+func (b *DecodeBudget) List(fd protoreflect.FieldDescriptor, length, n, size int) error {
+	if b == nil {
+		return nil
+	}
+	if b.limits.MaxListLength > 0 && length+n > b.limits.MaxListLength {
+		return &DecodeLimitError{Field: fd.FullName(), Limit: "MaxListLength"}
+	}
+	return b.spend(fd, n*size)
+}
+
+// MapEntry accepts an entry of size bytes inserted in the map field fd, which holds length entries.
+func (b *DecodeBudget) MapEntry(fd protoreflect.FieldDescriptor, length, size int) error {
+	if b == nil {
+		return nil
+	}
+	if b.limits.MaxMapEntries > 0 && length+1 > b.limits.MaxMapEntries {
+		return &DecodeLimitError{Field: fd.FullName(), Limit: "MaxMapEntries"}
+	}
+	return b.spend(fd, size)
+}
+
+// Message accepts a message of size bytes allocated for the field fd, as a singular value,
+// an element or a map value.
+func (b *DecodeBudget) Message(fd protoreflect.FieldDescriptor, size int) error {
+	if b == nil {
+		return nil
+	}
+	return b.spend(fd, size)
+}
+
+func (b *DecodeBudget) spend(fd protoreflect.FieldDescriptor, n int) error {
+	if b.limits.MaxBytes <= 0 {
+		return nil
+	}
+	if b.used.Add(int64(n)) > int64(b.limits.MaxBytes) {
+		return &DecodeLimitError{Field: fd.FullName(), Limit: "MaxBytes"}
+	}
+	return nil
+}
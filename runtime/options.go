@@ -0,0 +1,38 @@
+package runtime
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// unmarshalResolver marks the unmarshal options carrying the options of the generated unmarshal
+// which proto.UnmarshalOptions has no room for. The generated unmarshal forwards its options to
+// the nested messages, so the options, carried by the resolver, reach the whole message tree.
+type unmarshalResolver struct {
+	protoregistry.ExtensionTypeResolver
+	zeroCopy bool
+	unknown  unknownFields
+	limits   *DecodeLimits
+	// budget is started by the top-level message, for the messages nested in it
+	budget *DecodeBudget
+}
+
+// withResolver returns a copy of options whose unmarshalResolver is updated by set.
+func withResolver(options proto.UnmarshalOptions, set func(r *unmarshalResolver)) proto.UnmarshalOptions {
+	r, ok := options.Resolver.(unmarshalResolver)
+	if !ok {
+		r.ExtensionTypeResolver = options.Resolver
+		if r.ExtensionTypeResolver == nil {
+			r.ExtensionTypeResolver = protoregistry.GlobalTypes
+		}
+	}
+	set(&r)
+	options.Resolver = r
+	return options
+}
+
+// resolverOf returns the unmarshalResolver of options, or its zero value if there is none.
+func resolverOf(options proto.UnmarshalOptions) unmarshalResolver {
+	r, _ := options.Resolver.(unmarshalResolver)
+	return r
+}
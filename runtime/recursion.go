@@ -0,0 +1,45 @@
+package runtime
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RecursionLimitError reports a message nested deeper than the recursion limit of the unmarshal,
+// set by proto.UnmarshalOptions.RecursionLimit and defaulting to protowire.DefaultRecursionLimit.
+type RecursionLimitError struct {
+	// Message is the full name of the message exceeding the limit.
+	Message protoreflect.FullName
+}
+
+func (e *RecursionLimitError) Error() string {
+	return fmt.Sprintf("proto: %s: exceeded maximum recursion depth", e.Message)
+}
+
+// Is reports the error as a protobuf error, matching proto.Error.
+func (e *RecursionLimitError) Is(target error) bool {
+	return target == proto.Error
+}
+
+// ErrRecursionLimit returns the error reporting that the message exceeds the recursion limit.
+func ErrRecursionLimit(message protoreflect.FullName) error {
+	return &RecursionLimitError{Message: message}
+}
+
+// nestedRecursionLimit returns the recursion limit of the messages nested in a message
+// unmarshalled at the given depth, which is the number of levels left to it, itself included.
+// The zero depth of inputs built by hand stands for the default limit. An exhausted limit is
+// negative, proto.UnmarshalOptions replacing a zero limit by the default one, and the generated
+// unmarshal fails on negative depths.
+func nestedRecursionLimit(depth int) int {
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	if depth--; depth <= 0 {
+		return -1
+	}
+	return depth
+}
@@ -1,8 +1,10 @@
 package runtime
 
 import (
+	"errors"
 	"fmt"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/runtime/protoiface"
 	"io"
 	"math/bits"
@@ -124,18 +126,66 @@ func MarshalInputToOptions(input protoiface.MarshalInput) proto.MarshalOptions {
 	}
 }
 
+// UnmarshalInputToOptions returns the options used to unmarshal the messages nested in input.
+// Merge is always set: nested messages are either freshly allocated, reset by the caller or
+// already populated by a previous occurrence of the same field, which protobuf requires to be merged.
 func UnmarshalInputToOptions(input protoiface.UnmarshalInput) proto.UnmarshalOptions {
 	return proto.UnmarshalOptions{
 		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-		Merge:             false,
+		Merge:             true,
 		AllowPartial:      true, // defaults to true as the required fields check is done after the unmarshalling
 		DiscardUnknown:    input.Flags&protoiface.UnmarshalDiscardUnknown != 0,
 		Resolver:          input.Resolver,
+		RecursionLimit:    nestedRecursionLimit(input.Depth),
 	}
 }
 
+// FieldOf returns the descriptor of md describing the same field as fd, which may have been
+// obtained from another source, or nil if fd does not describe a field of the message.
+func FieldOf(fd protoreflect.FieldDescriptor, md protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	if fd.IsExtension() || fd.ContainingMessage().FullName() != md.FullName() {
+		return nil
+	}
+	return md.Fields().ByName(fd.Name())
+}
+
 var (
 	ErrInvalidLength        = fmt.Errorf("proto: negative length found during unmarshaling")
 	ErrIntOverflow          = fmt.Errorf("proto: integer overflow")
 	ErrUnexpectedEndOfGroup = fmt.Errorf("proto: unexpected end of group")
+	ErrInvalidUTF8          = fmt.Errorf("proto: string field contains invalid UTF-8")
+	ErrSizeMismatch         = fmt.Errorf("proto: size of the marshaled message does not match its computed size")
 )
+
+// RequiredNotSetError reports a required field which is not populated.
+type RequiredNotSetError struct {
+	// Message is the full name of the checked message.
+	Message protoreflect.FullName
+	// Path locates the missing field from the checked message through
+	// field names, list indexes and map keys, e.g. "items[1].owner.id".
+	Path string
+}
+
+func (e *RequiredNotSetError) Error() string {
+	return fmt.Sprintf("proto: required field %s.%s not set", e.Message, e.Path)
+}
+
+// Is reports the error as a protobuf error, matching proto.Error.
+func (e *RequiredNotSetError) Is(target error) bool {
+	return target == proto.Error
+}
+
+// ErrRequiredNotSet returns the error reporting that the required field of the message is not set.
+func ErrRequiredNotSet(message protoreflect.FullName, field string) error {
+	return &RequiredNotSetError{Message: message, Path: field}
+}
+
+// RequiredNotSetIn returns err, found when checking the message held by field,
+// as reported by the message which contains the field.
+func RequiredNotSetIn(err error, message protoreflect.FullName, field string) error {
+	var notSet *RequiredNotSetError
+	if !errors.As(err, &notSet) {
+		return fmt.Errorf("%s.%s: %w", message, field, err)
+	}
+	return &RequiredNotSetError{Message: message, Path: field + "." + notSet.Path}
+}
@@ -0,0 +1,280 @@
+package runtime
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"unsafe"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"github.com/cosmos/cosmos-proto/runtime/scalarregistry"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldScalar returns the scalar the field fd is annotated with through the cosmos_proto.scalar
+// option, or an empty string.
+func FieldScalar(fd protoreflect.FieldDescriptor) string {
+	return proto.GetExtension(fd.Options(), cosmos_proto.E_Scalar).(string)
+}
+
+// Scalar is the contract of the Go types T that string and bytes fields are mapped to through the
+// scalar plugin option, which are the ones of the custom types of gogoproto: T is encoded by
+//
+//	func (T) Marshal() ([]byte, error)
+//	func (*T) Unmarshal([]byte) error
+//
+// where the encoding is the content of the field on the wire. A field is populated when the
+// encoding of its value is not empty, the zero value of T must therefore encode to nothing and
+// decoding an empty field resets the value to the zero value of T instead of calling Unmarshal.
+// T may also implement Size() int, returning the length of its encoding without building it.
+// Unmarshal must copy the bytes it keeps, since they may be reused by the caller.
+type Scalar[T any] interface {
+	*T
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+// ScalarError reports a value of a scalar field which cannot be encoded or decoded.
+type ScalarError struct {
+	// Field is the full name of the field holding the value.
+	Field protoreflect.FullName
+	// Err is the error returned by the Go type of the field.
+	Err error
+}
+
+func (e *ScalarError) Error() string {
+	return fmt.Sprintf("proto: invalid value for %s: %v", e.Field, e.Err)
+}
+
+func (e *ScalarError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports the error as a protobuf error, matching proto.Error.
+func (e *ScalarError) Is(target error) bool {
+	return target == proto.Error
+}
+
+// HasScalar reports whether the scalar value v is populated, which is when its encoding is not
+// empty.
+func HasScalar[T any, P Scalar[T]](v P) bool {
+	return SizeScalar[T, P](v) > 0
+}
+
+// SizeScalar returns the length of the encoding of v, or zero when it cannot be encoded, in
+// which case MarshalScalar reports the error.
+func SizeScalar[T any, P Scalar[T]](v P) int {
+	if isZeroScalar(v) {
+		return 0
+	}
+	if s, ok := any(v).(interface{ Size() int }); ok {
+		return s.Size()
+	}
+	b, _ := v.Marshal()
+	return len(b)
+}
+
+// MarshalScalar returns the encoding of the scalar value v held by the field fd.
+func MarshalScalar[T any, P Scalar[T]](fd protoreflect.FieldDescriptor, v P) ([]byte, error) {
+	b, err := encodeScalar[T, P](v)
+	if err != nil {
+		return nil, &ScalarError{Field: fd.FullName(), Err: err}
+	}
+	return b, nil
+}
+
+func encodeScalar[T any, P Scalar[T]](v P) ([]byte, error) {
+	if isZeroScalar(v) {
+		return nil, nil
+	}
+	return v.Marshal()
+}
+
+// UnmarshalScalar decodes b into the scalar value v held by the field fd. An empty b resets v.
+func UnmarshalScalar[T any, P Scalar[T]](fd protoreflect.FieldDescriptor, v P, b []byte) error {
+	if len(b) == 0 {
+		ClearScalar[T](v)
+		return nil
+	}
+	if err := v.Unmarshal(b); err != nil {
+		return &ScalarError{Field: fd.FullName(), Err: err}
+	}
+	return nil
+}
+
+// isZeroScalar reports whether v holds the zero value of T. T may not be comparable, the memory
+// of v is therefore compared against the one of the zero value, which only holds zero bits.
+func isZeroScalar[T any](v *T) bool {
+	for _, b := range unsafe.Slice((*byte)(unsafe.Pointer(v)), unsafe.Sizeof(*v)) {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ClearScalar resets v to the zero value of its type.
+func ClearScalar[T any](v *T) {
+	var zero T
+	*v = zero
+}
+
+// ScalarValue returns the scalar value v held by the field fd as a string or bytes value, or the
+// default value of the field when v is not populated. It panics when v cannot be encoded, as
+// reflection has no way to report the error.
+func ScalarValue[T any, P Scalar[T]](fd protoreflect.FieldDescriptor, v P) protoreflect.Value {
+	b, err := MarshalScalar[T, P](fd, v)
+	if err != nil {
+		panic(err)
+	}
+	if len(b) == 0 {
+		return fd.Default()
+	}
+	if fd.Kind() == protoreflect.StringKind {
+		return protoreflect.ValueOfString(string(b))
+	}
+	return protoreflect.ValueOfBytes(b)
+}
+
+// SetScalar decodes the string or bytes value into the scalar value v held by the field fd. It
+// panics when value cannot be decoded, as reflection has no way to report the error.
+func SetScalar[T any, P Scalar[T]](fd protoreflect.FieldDescriptor, v P, value protoreflect.Value) {
+	var b []byte
+	if fd.Kind() == protoreflect.StringKind {
+		b = []byte(value.String())
+	} else {
+		b = value.Bytes()
+	}
+	if err := UnmarshalScalar[T, P](fd, v, b); err != nil {
+		panic(err)
+	}
+}
+
+// CopyScalar overwrites dst with a deep copy of the scalar value src held by the field fd, going
+// through its encoding. It panics when src cannot be encoded or decoded back.
+func CopyScalar[T any, P Scalar[T]](fd protoreflect.FieldDescriptor, dst, src P) {
+	b, err := MarshalScalar[T, P](fd, src)
+	if err == nil {
+		err = UnmarshalScalar[T, P](fd, dst, b)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// EqualScalar reports whether the scalar values a and b have the same encoding. Values which
+// cannot be encoded are only equal to themselves.
+func EqualScalar[T any, P Scalar[T]](a, b P) bool {
+	if a == b {
+		return true
+	}
+	ea, errA := encodeScalar[T, P](a)
+	eb, errB := encodeScalar[T, P](b)
+	return errA == nil && errB == nil && bytes.Equal(ea, eb)
+}
+
+// InvalidScalarError reports the value of a field rejected by the validator registered in
+// scalarregistry.Global for the scalar the field is annotated with.
+type InvalidScalarError struct {
+	// Message is the full name of the decoded message.
+	Message protoreflect.FullName
+	// Path locates the field from the decoded message through field names,
+	// list indexes and map keys, e.g. "msgs[1].from_address".
+	Path string
+	// Scalar is the name of the scalar, e.g. "cosmos.AddressString".
+	Scalar string
+	// Err is the error returned by the validator.
+	Err error
+}
+
+func (e *InvalidScalarError) Error() string {
+	return fmt.Sprintf("proto: invalid %s value for %s.%s: %v", e.Scalar, e.Message, e.Path, e.Err)
+}
+
+func (e *InvalidScalarError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports the error as a protobuf error, matching proto.Error.
+func (e *InvalidScalarError) Is(target error) bool {
+	return target == proto.Error
+}
+
+// ValidateScalar validates b, the value of the field fd annotated with the scalar, or of its
+// element index when fd is a list and index is not negative, through the validator registered
+// in scalarregistry.Global. Values of scalars without a validator are valid. The values of map
+// fields are validated by ValidateScalarInMap.
+func ValidateScalar(scalar string, fd protoreflect.FieldDescriptor, index int, b []byte) error {
+	validate := scalarregistry.Global.Validator(scalar)
+	if validate == nil {
+		return nil
+	}
+	if err := validate(b); err != nil {
+		return &InvalidScalarError{Message: fd.ContainingMessage().FullName(), Path: elementName(fd, index), Scalar: scalar, Err: err}
+	}
+	return nil
+}
+
+// ValidateScalarInMap validates b, the value of the entry of the map field fd annotated with the
+// scalar with the given key, through the validator registered in scalarregistry.Global. The keys
+// of map fields are not validated.
+func ValidateScalarInMap[K comparable](scalar string, fd protoreflect.FieldDescriptor, key K, b []byte) error {
+	validate := scalarregistry.Global.Validator(scalar)
+	if validate == nil {
+		return nil
+	}
+	if err := validate(b); err != nil {
+		return &InvalidScalarError{Message: fd.ContainingMessage().FullName(), Path: entryName(fd, key), Scalar: scalar, Err: err}
+	}
+	return nil
+}
+
+// InvalidScalarIn returns err, found when decoding the message held by the field fd, or by its
+// element index when index is not negative, as reported by the message containing the field.
+// Errors other than *InvalidScalarError are returned as they are.
+func InvalidScalarIn(err error, fd protoreflect.FieldDescriptor, index int) error {
+	return invalidScalarAt(err, fd, elementName(fd, index))
+}
+
+// InvalidScalarInMap returns err, found when decoding the message held by the entry of the map
+// field fd with the given key, as reported by the message containing the field. Keys are
+// formatted as in the paths of CanonicalError, e.g. "inputs[cosmos1abc].amount".
+// Errors other than *InvalidScalarError are returned as they are.
+func InvalidScalarInMap(err error, fd protoreflect.FieldDescriptor, key interface{}) error {
+	return invalidScalarAt(err, fd, entryName(fd, key))
+}
+
+func invalidScalarAt(err error, fd protoreflect.FieldDescriptor, element string) error {
+	var invalid *InvalidScalarError
+	if !errors.As(err, &invalid) {
+		return err
+	}
+	return &InvalidScalarError{
+		Message: fd.ContainingMessage().FullName(),
+		Path:    element + "." + invalid.Path,
+		Scalar:  invalid.Scalar,
+		Err:     invalid.Err,
+	}
+}
+
+func elementName(fd protoreflect.FieldDescriptor, index int) string {
+	if index < 0 {
+		return string(fd.Name())
+	}
+	return fmt.Sprintf("%s[%d]", fd.Name(), index)
+}
+
+func entryName(fd protoreflect.FieldDescriptor, key interface{}) string {
+	return fmt.Sprintf("%s[%v]", fd.Name(), key)
+}
+
+// validScalar reports whether b is a valid value of the scalar, if any, according to its
+// validator.
+func validScalar(scalar string, b []byte) bool {
+	if scalar == "" {
+		return true
+	}
+	validate := scalarregistry.Global.Validator(scalar)
+	return validate == nil || validate(b) == nil
+}
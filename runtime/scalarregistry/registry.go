@@ -0,0 +1,65 @@
+// Package scalarregistry maps the scalars declared with cosmos_proto.declare_scalar to the
+// functions validating the values of the fields annotated with them.
+//
+// Scalars are identified by the names used with the cosmos_proto.scalar option, e.g.
+// cosmos.AddressString. The unmarshal generated by the fast feature validates the string and
+// bytes fields annotated with a scalar through the validator registered in Global, and fails
+// with a *runtime.InvalidScalarError when it rejects the value. The values of the map fields
+// annotated with a scalar are validated as well, but not their keys.
+package scalarregistry
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Validator checks the value of a field annotated with a scalar, which is the content of the
+// string or bytes field. The value must not be retained or modified.
+type Validator func(value []byte) error
+
+// Global is the registry used by the generated code.
+var Global = new(Registry)
+
+// Registry holds the validators of scalars. The zero value is an empty registry ready to use,
+// and the registry is safe for concurrent use.
+type Registry struct {
+	mu         sync.RWMutex
+	validators map[string]Validator
+}
+
+// Register registers v as the validator of the scalar, which has at most one validator.
+func (r *Registry) Register(scalar string, v Validator) error {
+	if v == nil {
+		return fmt.Errorf("scalarregistry: nil validator for %s", scalar)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.validators[scalar]; ok {
+		return fmt.Errorf("scalarregistry: a validator of %s is already registered", scalar)
+	}
+	if r.validators == nil {
+		r.validators = make(map[string]Validator)
+	}
+	r.validators[scalar] = v
+	return nil
+}
+
+// Validator returns the validator of the scalar, or nil if none is registered.
+func (r *Registry) Validator(scalar string) Validator {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.validators[scalar]
+}
+
+// Scalars returns the scalars having a registered validator, sorted by name.
+func (r *Registry) Scalars() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	scalars := make([]string, 0, len(r.validators))
+	for scalar := range r.validators {
+		scalars = append(scalars, scalar)
+	}
+	sort.Strings(scalars)
+	return scalars
+}
@@ -0,0 +1,70 @@
+package scalarregistry
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errEmpty = errors.New("empty value")
+
+func notEmpty(value []byte) error {
+	if len(value) == 0 {
+		return errEmpty
+	}
+	return nil
+}
+
+func TestRegister(t *testing.T) {
+	r := new(Registry)
+	require.Nil(t, r.Validator("cosmos.AddressString"))
+	require.Empty(t, r.Scalars())
+
+	require.NoError(t, r.Register("cosmos.AddressString", notEmpty))
+	v := r.Validator("cosmos.AddressString")
+	require.NotNil(t, v)
+	require.NoError(t, v([]byte("cosmos1")))
+	require.ErrorIs(t, v(nil), errEmpty)
+
+	t.Run("duplicate scalar", func(t *testing.T) {
+		require.Error(t, r.Register("cosmos.AddressString", func([]byte) error { return nil }))
+		// the first validator is kept
+		require.ErrorIs(t, r.Validator("cosmos.AddressString")(nil), errEmpty)
+	})
+
+	t.Run("nil validator", func(t *testing.T) {
+		require.Error(t, r.Register("cosmos.Dec", nil))
+		require.Nil(t, r.Validator("cosmos.Dec"))
+	})
+
+	require.NoError(t, r.Register("cosmos.Dec", notEmpty))
+	require.Equal(t, []string{"cosmos.AddressString", "cosmos.Dec"}, r.Scalars())
+}
+
+func TestConcurrentUse(t *testing.T) {
+	r := new(Registry)
+	var wg sync.WaitGroup
+	errs := make([]error, 8)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = r.Register("cosmos.Int", notEmpty)
+			r.Validator("cosmos.Int")
+			r.Scalars()
+		}(i)
+	}
+	wg.Wait()
+
+	// exactly one of the registrations succeeds
+	registered := 0
+	for _, err := range errs {
+		if err == nil {
+			registered++
+		}
+	}
+	require.Equal(t, 1, registered)
+	require.Equal(t, []string{"cosmos.Int"}, r.Scalars())
+}
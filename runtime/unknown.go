@@ -0,0 +1,124 @@
+package runtime
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// unknownFields is the handling of unknown fields requested from the generated unmarshal.
+type unknownFields uint8
+
+const (
+	keepUnknown unknownFields = iota
+	rejectUnknown
+	rejectCriticalUnknown
+)
+
+// nonCriticalBit is set in the number of the non-critical fields, as defined by ADR-020.
+const nonCriticalBit = 1 << 10
+
+// UnknownFieldError reports an unknown field rejected by the generated unmarshal.
+type UnknownFieldError struct {
+	// Message is the full name of the message holding the unknown field.
+	Message protoreflect.FullName
+	// Number is the number of the unknown field.
+	Number protoreflect.FieldNumber
+}
+
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("proto: %s: unknown field %d", e.Message, e.Number)
+}
+
+// Is reports the error as a protobuf error, matching proto.Error.
+func (e *UnknownFieldError) Is(target error) bool {
+	return target == proto.Error
+}
+
+// RejectUnknown returns a copy of options making the generated unmarshal fail with an
+// *UnknownFieldError on the first unknown field, as required by ADR-020 and ADR-027 when
+// decoding transactions. When allowNonCritical is set, unknown fields are accepted in the
+// non-critical range, as reported by IsNonCritical, and kept as unknown fields unless
+// DiscardUnknown is set. Unknown fields in the extension ranges of a message are critical.
+//
+// The non-critical range is fixed to the field numbers with bit 11 set: unlike a range a schema
+// could declare, it cannot be narrowed, widened or disabled per message, so messages whose
+// schema reserves other numbers for non-critical fields are checked with bit 11 all the same.
+//
+// The mode is carried by the resolver of options, which the generated unmarshal passes on to
+// nested messages, including the messages held by google.protobuf.Any values, see
+// CheckUnknownAny. The fields of messages without generated fast reflection are not checked,
+// and neither are the unknown values of closed enums, kept as unknown fields by proto2.
+func RejectUnknown(options proto.UnmarshalOptions, allowNonCritical bool) proto.UnmarshalOptions {
+	return withResolver(options, func(r *unmarshalResolver) {
+		r.unknown = rejectUnknown
+		if allowNonCritical {
+			r.unknown = rejectCriticalUnknown
+		}
+	})
+}
+
+// rejectsUnknown reports whether options were returned by RejectUnknown.
+func rejectsUnknown(options proto.UnmarshalOptions) bool {
+	return resolverOf(options).unknown != keepUnknown
+}
+
+// IsNonCritical reports whether an unknown field numbered num is non-critical, which is the
+// case when the number has its bit 11 set, following the convention of ADR-020.
+func IsNonCritical(num protoreflect.FieldNumber) bool {
+	return num&nonCriticalBit != 0
+}
+
+// CheckUnknownField returns the error rejecting the unknown field numbered num found in the
+// message md, or nil if options accept the field.
+func CheckUnknownField(options proto.UnmarshalOptions, md protoreflect.MessageDescriptor, num protoreflect.FieldNumber) error {
+	switch resolverOf(options).unknown {
+	case rejectUnknown:
+	case rejectCriticalUnknown:
+		if IsNonCritical(num) {
+			return nil
+		}
+	default:
+		return nil
+	}
+	return &UnknownFieldError{Message: md.FullName(), Number: num}
+}
+
+// packedAny is implemented by the google.protobuf.Any messages.
+type packedAny interface {
+	GetTypeUrl() string
+	GetValue() []byte
+}
+
+// CheckUnknownAny checks the message packed in a, a google.protobuf.Any just decoded by the
+// generated unmarshal with options, for the unknown fields rejected by options. The type of the
+// packed message is resolved through the resolver of options when it resolves messages, through
+// protoregistry.GlobalTypes otherwise, and an Any whose type cannot be resolved is rejected,
+// as its fields cannot be told apart from unknown ones. The packed message is decoded one level
+// below a, with the same options, and then dropped.
+func CheckUnknownAny(options proto.UnmarshalOptions, a packedAny) error {
+	if !rejectsUnknown(options) {
+		return nil
+	}
+	url := a.GetTypeUrl()
+	if url == "" && len(a.GetValue()) == 0 {
+		return nil
+	}
+	resolver, ok := resolverOf(options).ExtensionTypeResolver.(interface {
+		FindMessageByURL(url string) (protoreflect.MessageType, error)
+	})
+	if !ok {
+		resolver = protoregistry.GlobalTypes
+	}
+	mt, err := resolver.FindMessageByURL(url)
+	if err != nil {
+		return fmt.Errorf("proto: unable to resolve %q: %w", url, err)
+	}
+	if options.RecursionLimit = nestedRecursionLimit(options.RecursionLimit); options.RecursionLimit < 0 {
+		return ErrRecursionLimit(mt.Descriptor().FullName())
+	}
+	options.Merge = false
+	return options.Unmarshal(a.GetValue(), mt.New().Interface())
+}
@@ -0,0 +1,60 @@
+package runtime
+
+import (
+	"unsafe"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ZeroCopy returns a copy of options requesting a zero-copy unmarshal from the generated code:
+// bytes fields alias the unmarshalled buffer and string fields are built on top of it without
+// being copied. Unknown fields, extensions and messages without generated fast reflection are
+// still copied.
+//
+// Aliasing is only safe as long as the buffer outlives the message and is never modified
+// afterwards: reusing the buffer, for instance because it comes from a pool or a network read
+// loop, silently changes the content of the message, and strings built on top of it break
+// the immutability expected by the Go runtime. Conversely, writing to the bytes fields of the
+// message writes into the buffer. Zero-copy unmarshal is meant for read-only decode paths,
+// such as query handlers, where the caller owns the buffer for the lifetime of the message.
+func ZeroCopy(options proto.UnmarshalOptions) proto.UnmarshalOptions {
+	return withResolver(options, func(r *unmarshalResolver) {
+		r.zeroCopy = true
+	})
+}
+
+// IsZeroCopy reports whether options were returned by ZeroCopy.
+func IsZeroCopy(options proto.UnmarshalOptions) bool {
+	return resolverOf(options).zeroCopy
+}
+
+// String returns the content of b as a string, sharing the memory of b when zeroCopy is set.
+func String(b []byte, zeroCopy bool) string {
+	if zeroCopy {
+		return unsafe.String(unsafe.SliceData(b), len(b))
+	}
+	return string(b)
+}
+
+// Bytes returns a copy of b in newly allocated memory, or b itself when zeroCopy is set. The capacity of the returned
+// slice is capped to its length, so that appending to it never writes past b.
+func Bytes(b []byte, zeroCopy bool) []byte {
+	if zeroCopy {
+		return b[:len(b):len(b)]
+	}
+	v := make([]byte, len(b))
+	copy(v, b)
+	return v
+}
+
+// AppendBytes appends a copy of b to s, reusing the memory of the element past the end of s,
+// left there by a reset keeping the capacity of s, when there is one. That element must not
+// alias the buffer given to a zero-copy unmarshal.
+func AppendBytes(s [][]byte, b []byte) [][]byte {
+	if len(s) < cap(s) {
+		if v := s[:len(s)+1][len(s)]; v != nil {
+			return append(s, append(v[:0], b...))
+		}
+	}
+	return append(s, Bytes(b, false))
+}
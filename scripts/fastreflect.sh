@@ -2,12 +2,19 @@
 
 set -e
 
+# messages generated with sync.Pool backed recycling
+POOL_OPTS="--go-pulsar_opt=pool=github.com/cosmos/cosmos-proto/testpb.PoolableMessage --go-pulsar_opt=pool=github.com/cosmos/cosmos-proto/testpb.PoolableChild"
+# message fields decoded lazily, besides those marked with the lazy field option
+LAZY_OPTS="--go-pulsar_opt=lazy=LazyBlock.last_header"
+# Go types of the fields annotated with scalars
+SCALAR_OPTS="--go-pulsar_opt=scalar=Int=github.com/cosmos/cosmos-proto/internal/testscalars.Int --go-pulsar_opt=scalar=Hash=github.com/cosmos/cosmos-proto/internal/testscalars.Hash"
+
 build() {
     echo finding protobuf files in "$1"
     proto_files=$(find "$1" -name "*.proto")
     for file in $proto_files; do
       echo "building proto file $file"
-      protoc -I=. --plugin /usr/bin/protoc-gen-go-pulsar --go-pulsar_out=. --go-pulsar_opt=features=protoc+fast "$file"
+      protoc -I=. -I=proto --plugin /usr/bin/protoc-gen-go-pulsar --go-pulsar_out=. --go-pulsar_opt=features=protoc+fast+json+amino+interfaces $POOL_OPTS $LAZY_OPTS $SCALAR_OPTS "$file"
     done
 }
 
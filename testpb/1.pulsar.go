@@ -1,19 +1,225 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
 package testpb
 
 import (
+	bytes "bytes"
 	binary "encoding/binary"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	proto "google.golang.org/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
+	maps "maps"
 	math "math"
 	reflect "reflect"
+	slices "slices"
 	sort "sort"
+	strconv "strconv"
 	sync "sync"
+	atomic "sync/atomic"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
 )
 
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *A) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *A) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if len(x.BYTES) > 0 {
+		b = append(b, "\"BYTES\":"...)
+		b = runtime.AppendJSONBytes(b, x.BYTES)
+		b = append(b, ',')
+	}
+	if x.DOUBLE != 0 {
+		b = append(b, "\"DOUBLE\":"...)
+		if b, err = runtime.AppendAminoJSONFloat(b, x.DOUBLE, 64); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.FIXED32 != 0 {
+		b = append(b, "\"FIXED32\":"...)
+		b = strconv.AppendUint(b, uint64(x.FIXED32), 10)
+		b = append(b, ',')
+	}
+	if x.FIXED64 != 0 {
+		b = append(b, "\"FIXED64\":"...)
+		b = runtime.AppendJSONUint64(b, x.FIXED64)
+		b = append(b, ',')
+	}
+	if x.FLOAT != 0 {
+		b = append(b, "\"FLOAT\":"...)
+		if b, err = runtime.AppendAminoJSONFloat(b, float64(x.FLOAT), 32); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.INT32 != 0 {
+		b = append(b, "\"INT32\":"...)
+		b = strconv.AppendInt(b, int64(x.INT32), 10)
+		b = append(b, ',')
+	}
+	if x.INT64 != 0 {
+		b = append(b, "\"INT64\":"...)
+		b = runtime.AppendJSONInt64(b, x.INT64)
+		b = append(b, ',')
+	}
+	if len(x.LIST) > 0 {
+		b = append(b, "\"LIST\":"...)
+		b = append(b, '[')
+		for _, v := range x.LIST {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.LIST_ENUM) > 0 {
+		b = append(b, "\"LIST_ENUM\":"...)
+		b = append(b, '[')
+		for _, v := range x.LIST_ENUM {
+			b = strconv.AppendInt(b, int64(v), 10)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.MAP) > 0 {
+		b = append(b, "\"MAP\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MAP)) {
+			v := x.MAP[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if x.MESSAGE != nil {
+		b = append(b, "\"MESSAGE\":"...)
+		if b, err = x.MESSAGE.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.ONEOF.(*A_ONEOF_B); ok {
+		b = append(b, "\"ONEOF_B\":"...)
+		if b, err = v.ONEOF_B.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.ONEOF.(*A_ONEOF_STRING); ok {
+		b = append(b, "\"ONEOF_STRING\":"...)
+		b = runtime.AppendAminoJSONString(b, v.ONEOF_STRING)
+		b = append(b, ',')
+	}
+	if x.SFIXED32 != 0 {
+		b = append(b, "\"SFIXED32\":"...)
+		b = strconv.AppendInt(b, int64(x.SFIXED32), 10)
+		b = append(b, ',')
+	}
+	if x.SFIXED64 != 0 {
+		b = append(b, "\"SFIXED64\":"...)
+		b = runtime.AppendJSONInt64(b, x.SFIXED64)
+		b = append(b, ',')
+	}
+	if x.SING64 != 0 {
+		b = append(b, "\"SING64\":"...)
+		b = runtime.AppendJSONInt64(b, x.SING64)
+		b = append(b, ',')
+	}
+	if x.SINT32 != 0 {
+		b = append(b, "\"SINT32\":"...)
+		b = strconv.AppendInt(b, int64(x.SINT32), 10)
+		b = append(b, ',')
+	}
+	if x.STRING != "" {
+		b = append(b, "\"STRING\":"...)
+		b = runtime.AppendAminoJSONString(b, x.STRING)
+		b = append(b, ',')
+	}
+	if x.UINT32 != 0 {
+		b = append(b, "\"UINT32\":"...)
+		b = strconv.AppendUint(b, uint64(x.UINT32), 10)
+		b = append(b, ',')
+	}
+	if x.UINT64 != 0 {
+		b = append(b, "\"UINT64\":"...)
+		b = runtime.AppendJSONUint64(b, x.UINT64)
+		b = append(b, ',')
+	}
+	if x.Enum != 0 {
+		b = append(b, "\"enum\":"...)
+		b = strconv.AppendInt(b, int64(x.Enum), 10)
+		b = append(b, ',')
+	}
+	if x.Imported != nil {
+		b = append(b, "\"imported\":"...)
+		if b, err = x.Imported.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.SomeBoolean {
+		b = append(b, "\"some_boolean\":"...)
+		b = strconv.AppendBool(b, x.SomeBoolean)
+		b = append(b, ',')
+	}
+	if x.Type_ != "" {
+		b = append(b, "\"type\":"...)
+		b = runtime.AppendAminoJSONString(b, x.Type_)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *B) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *B) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.X != "" {
+		b = append(b, "\"x\":"...)
+		b = runtime.AppendAminoJSONString(b, x.X)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
 var _ protoreflect.Map = (*_A_18_map)(nil)
 
 type _A_18_map struct {
@@ -485,46 +691,106 @@ func (x *fastReflection_A) Range(f func(protoreflect.FieldDescriptor, protorefle
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_A) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "A.enum":
+	switch fd.Number() {
+	case 1: // A.enum
+		if fd.FullName() != "A.enum" {
+			break
+		}
 		return x.Enum != 0
-	case "A.some_boolean":
+	case 2: // A.some_boolean
+		if fd.FullName() != "A.some_boolean" {
+			break
+		}
 		return x.SomeBoolean != false
-	case "A.INT32":
+	case 3: // A.INT32
+		if fd.FullName() != "A.INT32" {
+			break
+		}
 		return x.INT32 != int32(0)
-	case "A.SINT32":
+	case 4: // A.SINT32
+		if fd.FullName() != "A.SINT32" {
+			break
+		}
 		return x.SINT32 != int32(0)
-	case "A.UINT32":
+	case 5: // A.UINT32
+		if fd.FullName() != "A.UINT32" {
+			break
+		}
 		return x.UINT32 != uint32(0)
-	case "A.INT64":
+	case 6: // A.INT64
+		if fd.FullName() != "A.INT64" {
+			break
+		}
 		return x.INT64 != int64(0)
-	case "A.SING64":
+	case 7: // A.SING64
+		if fd.FullName() != "A.SING64" {
+			break
+		}
 		return x.SING64 != int64(0)
-	case "A.UINT64":
+	case 8: // A.UINT64
+		if fd.FullName() != "A.UINT64" {
+			break
+		}
 		return x.UINT64 != uint64(0)
-	case "A.SFIXED32":
+	case 9: // A.SFIXED32
+		if fd.FullName() != "A.SFIXED32" {
+			break
+		}
 		return x.SFIXED32 != int32(0)
-	case "A.FIXED32":
+	case 10: // A.FIXED32
+		if fd.FullName() != "A.FIXED32" {
+			break
+		}
 		return x.FIXED32 != uint32(0)
-	case "A.FLOAT":
+	case 11: // A.FLOAT
+		if fd.FullName() != "A.FLOAT" {
+			break
+		}
 		return x.FLOAT != float32(0) || math.Signbit(float64(x.FLOAT))
-	case "A.SFIXED64":
+	case 12: // A.SFIXED64
+		if fd.FullName() != "A.SFIXED64" {
+			break
+		}
 		return x.SFIXED64 != int64(0)
-	case "A.FIXED64":
+	case 13: // A.FIXED64
+		if fd.FullName() != "A.FIXED64" {
+			break
+		}
 		return x.FIXED64 != uint64(0)
-	case "A.DOUBLE":
+	case 14: // A.DOUBLE
+		if fd.FullName() != "A.DOUBLE" {
+			break
+		}
 		return x.DOUBLE != float64(0) || math.Signbit(x.DOUBLE)
-	case "A.STRING":
+	case 15: // A.STRING
+		if fd.FullName() != "A.STRING" {
+			break
+		}
 		return x.STRING != ""
-	case "A.BYTES":
+	case 16: // A.BYTES
+		if fd.FullName() != "A.BYTES" {
+			break
+		}
 		return len(x.BYTES) != 0
-	case "A.MESSAGE":
+	case 17: // A.MESSAGE
+		if fd.FullName() != "A.MESSAGE" {
+			break
+		}
 		return x.MESSAGE != nil
-	case "A.MAP":
+	case 18: // A.MAP
+		if fd.FullName() != "A.MAP" {
+			break
+		}
 		return len(x.MAP) != 0
-	case "A.LIST":
+	case 19: // A.LIST
+		if fd.FullName() != "A.LIST" {
+			break
+		}
 		return len(x.LIST) != 0
-	case "A.ONEOF_B":
+	case 20: // A.ONEOF_B
+		if fd.FullName() != "A.ONEOF_B" {
+			break
+		}
 		if x.ONEOF == nil {
 			return false
 		} else if _, ok := x.ONEOF.(*A_ONEOF_B); ok {
@@ -532,7 +798,10 @@ func (x *fastReflection_A) Has(fd protoreflect.FieldDescriptor) bool {
 		} else {
 			return false
 		}
-	case "A.ONEOF_STRING":
+	case 21: // A.ONEOF_STRING
+		if fd.FullName() != "A.ONEOF_STRING" {
+			break
+		}
 		if x.ONEOF == nil {
 			return false
 		} else if _, ok := x.ONEOF.(*A_ONEOF_STRING); ok {
@@ -540,18 +809,29 @@ func (x *fastReflection_A) Has(fd protoreflect.FieldDescriptor) bool {
 		} else {
 			return false
 		}
-	case "A.LIST_ENUM":
+	case 22: // A.LIST_ENUM
+		if fd.FullName() != "A.LIST_ENUM" {
+			break
+		}
 		return len(x.LIST_ENUM) != 0
-	case "A.imported":
+	case 23: // A.imported
+		if fd.FullName() != "A.imported" {
+			break
+		}
 		return x.Imported != nil
-	case "A.type":
-		return x.Type_ != ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: A"))
+	case 24: // A.type
+		if fd.FullName() != "A.type" {
+			break
 		}
-		panic(fmt.Errorf("message A does not contain field %s", fd.FullName()))
+		return x.Type_ != ""
+	}
+	if fd := runtime.FieldOf(fd, md_A); fd != nil {
+		return x.Has(fd)
 	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message A does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message A does not contain field %s", fd.FullName()))
 }
 
 // Clear clears the field such that a subsequent Has call reports false.
@@ -561,61 +841,160 @@ func (x *fastReflection_A) Has(fd protoreflect.FieldDescriptor) bool {
 //
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_A) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "A.enum":
+	switch fd.Number() {
+	case 1: // A.enum
+		if fd.FullName() != "A.enum" {
+			break
+		}
 		x.Enum = 0
-	case "A.some_boolean":
+		return
+	case 2: // A.some_boolean
+		if fd.FullName() != "A.some_boolean" {
+			break
+		}
 		x.SomeBoolean = false
-	case "A.INT32":
+		return
+	case 3: // A.INT32
+		if fd.FullName() != "A.INT32" {
+			break
+		}
 		x.INT32 = int32(0)
-	case "A.SINT32":
+		return
+	case 4: // A.SINT32
+		if fd.FullName() != "A.SINT32" {
+			break
+		}
 		x.SINT32 = int32(0)
-	case "A.UINT32":
+		return
+	case 5: // A.UINT32
+		if fd.FullName() != "A.UINT32" {
+			break
+		}
 		x.UINT32 = uint32(0)
-	case "A.INT64":
+		return
+	case 6: // A.INT64
+		if fd.FullName() != "A.INT64" {
+			break
+		}
 		x.INT64 = int64(0)
-	case "A.SING64":
+		return
+	case 7: // A.SING64
+		if fd.FullName() != "A.SING64" {
+			break
+		}
 		x.SING64 = int64(0)
-	case "A.UINT64":
+		return
+	case 8: // A.UINT64
+		if fd.FullName() != "A.UINT64" {
+			break
+		}
 		x.UINT64 = uint64(0)
-	case "A.SFIXED32":
+		return
+	case 9: // A.SFIXED32
+		if fd.FullName() != "A.SFIXED32" {
+			break
+		}
 		x.SFIXED32 = int32(0)
-	case "A.FIXED32":
+		return
+	case 10: // A.FIXED32
+		if fd.FullName() != "A.FIXED32" {
+			break
+		}
 		x.FIXED32 = uint32(0)
-	case "A.FLOAT":
+		return
+	case 11: // A.FLOAT
+		if fd.FullName() != "A.FLOAT" {
+			break
+		}
 		x.FLOAT = float32(0)
-	case "A.SFIXED64":
+		return
+	case 12: // A.SFIXED64
+		if fd.FullName() != "A.SFIXED64" {
+			break
+		}
 		x.SFIXED64 = int64(0)
-	case "A.FIXED64":
+		return
+	case 13: // A.FIXED64
+		if fd.FullName() != "A.FIXED64" {
+			break
+		}
 		x.FIXED64 = uint64(0)
-	case "A.DOUBLE":
+		return
+	case 14: // A.DOUBLE
+		if fd.FullName() != "A.DOUBLE" {
+			break
+		}
 		x.DOUBLE = float64(0)
-	case "A.STRING":
+		return
+	case 15: // A.STRING
+		if fd.FullName() != "A.STRING" {
+			break
+		}
 		x.STRING = ""
-	case "A.BYTES":
+		return
+	case 16: // A.BYTES
+		if fd.FullName() != "A.BYTES" {
+			break
+		}
 		x.BYTES = nil
-	case "A.MESSAGE":
+		return
+	case 17: // A.MESSAGE
+		if fd.FullName() != "A.MESSAGE" {
+			break
+		}
 		x.MESSAGE = nil
-	case "A.MAP":
+		return
+	case 18: // A.MAP
+		if fd.FullName() != "A.MAP" {
+			break
+		}
 		x.MAP = nil
-	case "A.LIST":
+		return
+	case 19: // A.LIST
+		if fd.FullName() != "A.LIST" {
+			break
+		}
 		x.LIST = nil
-	case "A.ONEOF_B":
+		return
+	case 20: // A.ONEOF_B
+		if fd.FullName() != "A.ONEOF_B" {
+			break
+		}
 		x.ONEOF = nil
-	case "A.ONEOF_STRING":
+		return
+	case 21: // A.ONEOF_STRING
+		if fd.FullName() != "A.ONEOF_STRING" {
+			break
+		}
 		x.ONEOF = nil
-	case "A.LIST_ENUM":
+		return
+	case 22: // A.LIST_ENUM
+		if fd.FullName() != "A.LIST_ENUM" {
+			break
+		}
 		x.LIST_ENUM = nil
-	case "A.imported":
+		return
+	case 23: // A.imported
+		if fd.FullName() != "A.imported" {
+			break
+		}
 		x.Imported = nil
-	case "A.type":
-		x.Type_ = ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: A"))
+		return
+	case 24: // A.type
+		if fd.FullName() != "A.type" {
+			break
 		}
-		panic(fmt.Errorf("message A does not contain field %s", fd.FullName()))
+		x.Type_ = ""
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_A); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message A does not extend %s", fd.FullName()))
 	}
+	panic(fmt.Errorf("message A does not contain field %s", fd.FullName()))
 }
 
 // Get retrieves the value for a field.
@@ -625,71 +1004,131 @@ func (x *fastReflection_A) Clear(fd protoreflect.FieldDescriptor) {
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_A) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "A.enum":
+	switch descriptor.Number() {
+	case 1: // A.enum
+		if descriptor.FullName() != "A.enum" {
+			break
+		}
 		value := x.Enum
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "A.some_boolean":
+	case 2: // A.some_boolean
+		if descriptor.FullName() != "A.some_boolean" {
+			break
+		}
 		value := x.SomeBoolean
 		return protoreflect.ValueOfBool(value)
-	case "A.INT32":
+	case 3: // A.INT32
+		if descriptor.FullName() != "A.INT32" {
+			break
+		}
 		value := x.INT32
 		return protoreflect.ValueOfInt32(value)
-	case "A.SINT32":
+	case 4: // A.SINT32
+		if descriptor.FullName() != "A.SINT32" {
+			break
+		}
 		value := x.SINT32
 		return protoreflect.ValueOfInt32(value)
-	case "A.UINT32":
+	case 5: // A.UINT32
+		if descriptor.FullName() != "A.UINT32" {
+			break
+		}
 		value := x.UINT32
 		return protoreflect.ValueOfUint32(value)
-	case "A.INT64":
+	case 6: // A.INT64
+		if descriptor.FullName() != "A.INT64" {
+			break
+		}
 		value := x.INT64
 		return protoreflect.ValueOfInt64(value)
-	case "A.SING64":
+	case 7: // A.SING64
+		if descriptor.FullName() != "A.SING64" {
+			break
+		}
 		value := x.SING64
 		return protoreflect.ValueOfInt64(value)
-	case "A.UINT64":
+	case 8: // A.UINT64
+		if descriptor.FullName() != "A.UINT64" {
+			break
+		}
 		value := x.UINT64
 		return protoreflect.ValueOfUint64(value)
-	case "A.SFIXED32":
+	case 9: // A.SFIXED32
+		if descriptor.FullName() != "A.SFIXED32" {
+			break
+		}
 		value := x.SFIXED32
 		return protoreflect.ValueOfInt32(value)
-	case "A.FIXED32":
+	case 10: // A.FIXED32
+		if descriptor.FullName() != "A.FIXED32" {
+			break
+		}
 		value := x.FIXED32
 		return protoreflect.ValueOfUint32(value)
-	case "A.FLOAT":
+	case 11: // A.FLOAT
+		if descriptor.FullName() != "A.FLOAT" {
+			break
+		}
 		value := x.FLOAT
 		return protoreflect.ValueOfFloat32(value)
-	case "A.SFIXED64":
+	case 12: // A.SFIXED64
+		if descriptor.FullName() != "A.SFIXED64" {
+			break
+		}
 		value := x.SFIXED64
 		return protoreflect.ValueOfInt64(value)
-	case "A.FIXED64":
+	case 13: // A.FIXED64
+		if descriptor.FullName() != "A.FIXED64" {
+			break
+		}
 		value := x.FIXED64
 		return protoreflect.ValueOfUint64(value)
-	case "A.DOUBLE":
+	case 14: // A.DOUBLE
+		if descriptor.FullName() != "A.DOUBLE" {
+			break
+		}
 		value := x.DOUBLE
 		return protoreflect.ValueOfFloat64(value)
-	case "A.STRING":
+	case 15: // A.STRING
+		if descriptor.FullName() != "A.STRING" {
+			break
+		}
 		value := x.STRING
 		return protoreflect.ValueOfString(value)
-	case "A.BYTES":
+	case 16: // A.BYTES
+		if descriptor.FullName() != "A.BYTES" {
+			break
+		}
 		value := x.BYTES
 		return protoreflect.ValueOfBytes(value)
-	case "A.MESSAGE":
+	case 17: // A.MESSAGE
+		if descriptor.FullName() != "A.MESSAGE" {
+			break
+		}
 		value := x.MESSAGE
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "A.MAP":
+	case 18: // A.MAP
+		if descriptor.FullName() != "A.MAP" {
+			break
+		}
 		if len(x.MAP) == 0 {
 			return protoreflect.ValueOfMap(&_A_18_map{})
 		}
 		mapValue := &_A_18_map{m: &x.MAP}
 		return protoreflect.ValueOfMap(mapValue)
-	case "A.LIST":
+	case 19: // A.LIST
+		if descriptor.FullName() != "A.LIST" {
+			break
+		}
 		if len(x.LIST) == 0 {
 			return protoreflect.ValueOfList(&_A_19_list{})
 		}
 		listValue := &_A_19_list{list: &x.LIST}
 		return protoreflect.ValueOfList(listValue)
-	case "A.ONEOF_B":
+	case 20: // A.ONEOF_B
+		if descriptor.FullName() != "A.ONEOF_B" {
+			break
+		}
 		if x.ONEOF == nil {
 			return protoreflect.ValueOfMessage((*B)(nil).ProtoReflect())
 		} else if v, ok := x.ONEOF.(*A_ONEOF_B); ok {
@@ -697,7 +1136,10 @@ func (x *fastReflection_A) Get(descriptor protoreflect.FieldDescriptor) protoref
 		} else {
 			return protoreflect.ValueOfMessage((*B)(nil).ProtoReflect())
 		}
-	case "A.ONEOF_STRING":
+	case 21: // A.ONEOF_STRING
+		if descriptor.FullName() != "A.ONEOF_STRING" {
+			break
+		}
 		if x.ONEOF == nil {
 			return protoreflect.ValueOfString("")
 		} else if v, ok := x.ONEOF.(*A_ONEOF_STRING); ok {
@@ -705,24 +1147,35 @@ func (x *fastReflection_A) Get(descriptor protoreflect.FieldDescriptor) protoref
 		} else {
 			return protoreflect.ValueOfString("")
 		}
-	case "A.LIST_ENUM":
+	case 22: // A.LIST_ENUM
+		if descriptor.FullName() != "A.LIST_ENUM" {
+			break
+		}
 		if len(x.LIST_ENUM) == 0 {
 			return protoreflect.ValueOfList(&_A_22_list{})
 		}
 		listValue := &_A_22_list{list: &x.LIST_ENUM}
 		return protoreflect.ValueOfList(listValue)
-	case "A.imported":
+	case 23: // A.imported
+		if descriptor.FullName() != "A.imported" {
+			break
+		}
 		value := x.Imported
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "A.type":
+	case 24: // A.type
+		if descriptor.FullName() != "A.type" {
+			break
+		}
 		value := x.Type_
 		return protoreflect.ValueOfString(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: A"))
-		}
-		panic(fmt.Errorf("message A does not contain field %s", descriptor.FullName()))
 	}
+	if fd := runtime.FieldOf(descriptor, md_A); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message A does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message A does not contain field %s", descriptor.FullName()))
 }
 
 // Set stores the value for a field.
@@ -736,69 +1189,168 @@ func (x *fastReflection_A) Get(descriptor protoreflect.FieldDescriptor) protoref
 //
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_A) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "A.enum":
+	switch fd.Number() {
+	case 1: // A.enum
+		if fd.FullName() != "A.enum" {
+			break
+		}
 		x.Enum = (Enumeration)(value.Enum())
-	case "A.some_boolean":
+		return
+	case 2: // A.some_boolean
+		if fd.FullName() != "A.some_boolean" {
+			break
+		}
 		x.SomeBoolean = value.Bool()
-	case "A.INT32":
+		return
+	case 3: // A.INT32
+		if fd.FullName() != "A.INT32" {
+			break
+		}
 		x.INT32 = int32(value.Int())
-	case "A.SINT32":
+		return
+	case 4: // A.SINT32
+		if fd.FullName() != "A.SINT32" {
+			break
+		}
 		x.SINT32 = int32(value.Int())
-	case "A.UINT32":
+		return
+	case 5: // A.UINT32
+		if fd.FullName() != "A.UINT32" {
+			break
+		}
 		x.UINT32 = uint32(value.Uint())
-	case "A.INT64":
+		return
+	case 6: // A.INT64
+		if fd.FullName() != "A.INT64" {
+			break
+		}
 		x.INT64 = value.Int()
-	case "A.SING64":
+		return
+	case 7: // A.SING64
+		if fd.FullName() != "A.SING64" {
+			break
+		}
 		x.SING64 = value.Int()
-	case "A.UINT64":
-		x.UINT64 = value.Uint()
-	case "A.SFIXED32":
-		x.SFIXED32 = int32(value.Int())
-	case "A.FIXED32":
+		return
+	case 8: // A.UINT64
+		if fd.FullName() != "A.UINT64" {
+			break
+		}
+		x.UINT64 = value.Uint()
+		return
+	case 9: // A.SFIXED32
+		if fd.FullName() != "A.SFIXED32" {
+			break
+		}
+		x.SFIXED32 = int32(value.Int())
+		return
+	case 10: // A.FIXED32
+		if fd.FullName() != "A.FIXED32" {
+			break
+		}
 		x.FIXED32 = uint32(value.Uint())
-	case "A.FLOAT":
+		return
+	case 11: // A.FLOAT
+		if fd.FullName() != "A.FLOAT" {
+			break
+		}
 		x.FLOAT = float32(value.Float())
-	case "A.SFIXED64":
+		return
+	case 12: // A.SFIXED64
+		if fd.FullName() != "A.SFIXED64" {
+			break
+		}
 		x.SFIXED64 = value.Int()
-	case "A.FIXED64":
+		return
+	case 13: // A.FIXED64
+		if fd.FullName() != "A.FIXED64" {
+			break
+		}
 		x.FIXED64 = value.Uint()
-	case "A.DOUBLE":
+		return
+	case 14: // A.DOUBLE
+		if fd.FullName() != "A.DOUBLE" {
+			break
+		}
 		x.DOUBLE = value.Float()
-	case "A.STRING":
+		return
+	case 15: // A.STRING
+		if fd.FullName() != "A.STRING" {
+			break
+		}
 		x.STRING = value.Interface().(string)
-	case "A.BYTES":
+		return
+	case 16: // A.BYTES
+		if fd.FullName() != "A.BYTES" {
+			break
+		}
 		x.BYTES = value.Bytes()
-	case "A.MESSAGE":
+		return
+	case 17: // A.MESSAGE
+		if fd.FullName() != "A.MESSAGE" {
+			break
+		}
 		x.MESSAGE = value.Message().Interface().(*B)
-	case "A.MAP":
+		return
+	case 18: // A.MAP
+		if fd.FullName() != "A.MAP" {
+			break
+		}
 		mv := value.Map()
 		cmv := mv.(*_A_18_map)
 		x.MAP = *cmv.m
-	case "A.LIST":
+		return
+	case 19: // A.LIST
+		if fd.FullName() != "A.LIST" {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_A_19_list)
 		x.LIST = *clv.list
-	case "A.ONEOF_B":
+		return
+	case 20: // A.ONEOF_B
+		if fd.FullName() != "A.ONEOF_B" {
+			break
+		}
 		cv := value.Message().Interface().(*B)
 		x.ONEOF = &A_ONEOF_B{ONEOF_B: cv}
-	case "A.ONEOF_STRING":
+		return
+	case 21: // A.ONEOF_STRING
+		if fd.FullName() != "A.ONEOF_STRING" {
+			break
+		}
 		cv := value.Interface().(string)
 		x.ONEOF = &A_ONEOF_STRING{ONEOF_STRING: cv}
-	case "A.LIST_ENUM":
+		return
+	case 22: // A.LIST_ENUM
+		if fd.FullName() != "A.LIST_ENUM" {
+			break
+		}
 		lv := value.List()
 		clv := lv.(*_A_22_list)
 		x.LIST_ENUM = *clv.list
-	case "A.imported":
+		return
+	case 23: // A.imported
+		if fd.FullName() != "A.imported" {
+			break
+		}
 		x.Imported = value.Message().Interface().(*ImportedMessage)
-	case "A.type":
-		x.Type_ = value.Interface().(string)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: A"))
+		return
+	case 24: // A.type
+		if fd.FullName() != "A.type" {
+			break
 		}
-		panic(fmt.Errorf("message A does not contain field %s", fd.FullName()))
+		x.Type_ = value.Interface().(string)
+		return
 	}
+	if fd := runtime.FieldOf(fd, md_A); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message A does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message A does not contain field %s", fd.FullName()))
 }
 
 // Mutable returns a mutable reference to a composite type.
@@ -812,25 +1364,37 @@ func (x *fastReflection_A) Set(fd protoreflect.FieldDescriptor, value protorefle
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_A) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "A.MESSAGE":
+	switch fd.Number() {
+	case 17: // A.MESSAGE
+		if fd.FullName() != "A.MESSAGE" {
+			break
+		}
 		if x.MESSAGE == nil {
 			x.MESSAGE = new(B)
 		}
 		return protoreflect.ValueOfMessage(x.MESSAGE.ProtoReflect())
-	case "A.MAP":
+	case 18: // A.MAP
+		if fd.FullName() != "A.MAP" {
+			break
+		}
 		if x.MAP == nil {
 			x.MAP = make(map[string]*B)
 		}
 		value := &_A_18_map{m: &x.MAP}
 		return protoreflect.ValueOfMap(value)
-	case "A.LIST":
+	case 19: // A.LIST
+		if fd.FullName() != "A.LIST" {
+			break
+		}
 		if x.LIST == nil {
 			x.LIST = []*B{}
 		}
 		value := &_A_19_list{list: &x.LIST}
 		return protoreflect.ValueOfList(value)
-	case "A.ONEOF_B":
+	case 20: // A.ONEOF_B
+		if fd.FullName() != "A.ONEOF_B" {
+			break
+		}
 		if x.ONEOF == nil {
 			value := &B{}
 			oneofValue := &A_ONEOF_B{ONEOF_B: value}
@@ -846,126 +1410,262 @@ func (x *fastReflection_A) Mutable(fd protoreflect.FieldDescriptor) protoreflect
 			x.ONEOF = oneofValue
 			return protoreflect.ValueOfMessage(value.ProtoReflect())
 		}
-	case "A.LIST_ENUM":
+	case 22: // A.LIST_ENUM
+		if fd.FullName() != "A.LIST_ENUM" {
+			break
+		}
 		if x.LIST_ENUM == nil {
 			x.LIST_ENUM = []Enumeration{}
 		}
 		value := &_A_22_list{list: &x.LIST_ENUM}
 		return protoreflect.ValueOfList(value)
-	case "A.imported":
+	case 23: // A.imported
+		if fd.FullName() != "A.imported" {
+			break
+		}
 		if x.Imported == nil {
 			x.Imported = new(ImportedMessage)
 		}
 		return protoreflect.ValueOfMessage(x.Imported.ProtoReflect())
-	case "A.enum":
+	case 1: // A.enum
+		if fd.FullName() != "A.enum" {
+			break
+		}
 		panic(fmt.Errorf("field enum of message A is not mutable"))
-	case "A.some_boolean":
+	case 2: // A.some_boolean
+		if fd.FullName() != "A.some_boolean" {
+			break
+		}
 		panic(fmt.Errorf("field some_boolean of message A is not mutable"))
-	case "A.INT32":
+	case 3: // A.INT32
+		if fd.FullName() != "A.INT32" {
+			break
+		}
 		panic(fmt.Errorf("field INT32 of message A is not mutable"))
-	case "A.SINT32":
+	case 4: // A.SINT32
+		if fd.FullName() != "A.SINT32" {
+			break
+		}
 		panic(fmt.Errorf("field SINT32 of message A is not mutable"))
-	case "A.UINT32":
+	case 5: // A.UINT32
+		if fd.FullName() != "A.UINT32" {
+			break
+		}
 		panic(fmt.Errorf("field UINT32 of message A is not mutable"))
-	case "A.INT64":
+	case 6: // A.INT64
+		if fd.FullName() != "A.INT64" {
+			break
+		}
 		panic(fmt.Errorf("field INT64 of message A is not mutable"))
-	case "A.SING64":
+	case 7: // A.SING64
+		if fd.FullName() != "A.SING64" {
+			break
+		}
 		panic(fmt.Errorf("field SING64 of message A is not mutable"))
-	case "A.UINT64":
+	case 8: // A.UINT64
+		if fd.FullName() != "A.UINT64" {
+			break
+		}
 		panic(fmt.Errorf("field UINT64 of message A is not mutable"))
-	case "A.SFIXED32":
+	case 9: // A.SFIXED32
+		if fd.FullName() != "A.SFIXED32" {
+			break
+		}
 		panic(fmt.Errorf("field SFIXED32 of message A is not mutable"))
-	case "A.FIXED32":
+	case 10: // A.FIXED32
+		if fd.FullName() != "A.FIXED32" {
+			break
+		}
 		panic(fmt.Errorf("field FIXED32 of message A is not mutable"))
-	case "A.FLOAT":
+	case 11: // A.FLOAT
+		if fd.FullName() != "A.FLOAT" {
+			break
+		}
 		panic(fmt.Errorf("field FLOAT of message A is not mutable"))
-	case "A.SFIXED64":
+	case 12: // A.SFIXED64
+		if fd.FullName() != "A.SFIXED64" {
+			break
+		}
 		panic(fmt.Errorf("field SFIXED64 of message A is not mutable"))
-	case "A.FIXED64":
+	case 13: // A.FIXED64
+		if fd.FullName() != "A.FIXED64" {
+			break
+		}
 		panic(fmt.Errorf("field FIXED64 of message A is not mutable"))
-	case "A.DOUBLE":
+	case 14: // A.DOUBLE
+		if fd.FullName() != "A.DOUBLE" {
+			break
+		}
 		panic(fmt.Errorf("field DOUBLE of message A is not mutable"))
-	case "A.STRING":
+	case 15: // A.STRING
+		if fd.FullName() != "A.STRING" {
+			break
+		}
 		panic(fmt.Errorf("field STRING of message A is not mutable"))
-	case "A.BYTES":
+	case 16: // A.BYTES
+		if fd.FullName() != "A.BYTES" {
+			break
+		}
 		panic(fmt.Errorf("field BYTES of message A is not mutable"))
-	case "A.ONEOF_STRING":
+	case 21: // A.ONEOF_STRING
+		if fd.FullName() != "A.ONEOF_STRING" {
+			break
+		}
 		panic(fmt.Errorf("field ONEOF_STRING of message A is not mutable"))
-	case "A.type":
-		panic(fmt.Errorf("field type of message A is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: A"))
+	case 24: // A.type
+		if fd.FullName() != "A.type" {
+			break
 		}
-		panic(fmt.Errorf("message A does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("field type of message A is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_A); fd != nil {
+		return x.Mutable(fd)
 	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message A does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message A does not contain field %s", fd.FullName()))
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_A) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "A.enum":
+	switch fd.Number() {
+	case 1: // A.enum
+		if fd.FullName() != "A.enum" {
+			break
+		}
 		return protoreflect.ValueOfEnum(0)
-	case "A.some_boolean":
+	case 2: // A.some_boolean
+		if fd.FullName() != "A.some_boolean" {
+			break
+		}
 		return protoreflect.ValueOfBool(false)
-	case "A.INT32":
+	case 3: // A.INT32
+		if fd.FullName() != "A.INT32" {
+			break
+		}
 		return protoreflect.ValueOfInt32(int32(0))
-	case "A.SINT32":
+	case 4: // A.SINT32
+		if fd.FullName() != "A.SINT32" {
+			break
+		}
 		return protoreflect.ValueOfInt32(int32(0))
-	case "A.UINT32":
+	case 5: // A.UINT32
+		if fd.FullName() != "A.UINT32" {
+			break
+		}
 		return protoreflect.ValueOfUint32(uint32(0))
-	case "A.INT64":
+	case 6: // A.INT64
+		if fd.FullName() != "A.INT64" {
+			break
+		}
 		return protoreflect.ValueOfInt64(int64(0))
-	case "A.SING64":
+	case 7: // A.SING64
+		if fd.FullName() != "A.SING64" {
+			break
+		}
 		return protoreflect.ValueOfInt64(int64(0))
-	case "A.UINT64":
+	case 8: // A.UINT64
+		if fd.FullName() != "A.UINT64" {
+			break
+		}
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "A.SFIXED32":
+	case 9: // A.SFIXED32
+		if fd.FullName() != "A.SFIXED32" {
+			break
+		}
 		return protoreflect.ValueOfInt32(int32(0))
-	case "A.FIXED32":
+	case 10: // A.FIXED32
+		if fd.FullName() != "A.FIXED32" {
+			break
+		}
 		return protoreflect.ValueOfUint32(uint32(0))
-	case "A.FLOAT":
+	case 11: // A.FLOAT
+		if fd.FullName() != "A.FLOAT" {
+			break
+		}
 		return protoreflect.ValueOfFloat32(float32(0))
-	case "A.SFIXED64":
+	case 12: // A.SFIXED64
+		if fd.FullName() != "A.SFIXED64" {
+			break
+		}
 		return protoreflect.ValueOfInt64(int64(0))
-	case "A.FIXED64":
+	case 13: // A.FIXED64
+		if fd.FullName() != "A.FIXED64" {
+			break
+		}
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "A.DOUBLE":
+	case 14: // A.DOUBLE
+		if fd.FullName() != "A.DOUBLE" {
+			break
+		}
 		return protoreflect.ValueOfFloat64(float64(0))
-	case "A.STRING":
+	case 15: // A.STRING
+		if fd.FullName() != "A.STRING" {
+			break
+		}
 		return protoreflect.ValueOfString("")
-	case "A.BYTES":
+	case 16: // A.BYTES
+		if fd.FullName() != "A.BYTES" {
+			break
+		}
 		return protoreflect.ValueOfBytes(nil)
-	case "A.MESSAGE":
+	case 17: // A.MESSAGE
+		if fd.FullName() != "A.MESSAGE" {
+			break
+		}
 		m := new(B)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "A.MAP":
+	case 18: // A.MAP
+		if fd.FullName() != "A.MAP" {
+			break
+		}
 		m := make(map[string]*B)
 		return protoreflect.ValueOfMap(&_A_18_map{m: &m})
-	case "A.LIST":
+	case 19: // A.LIST
+		if fd.FullName() != "A.LIST" {
+			break
+		}
 		list := []*B{}
 		return protoreflect.ValueOfList(&_A_19_list{list: &list})
-	case "A.ONEOF_B":
+	case 20: // A.ONEOF_B
+		if fd.FullName() != "A.ONEOF_B" {
+			break
+		}
 		value := &B{}
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "A.ONEOF_STRING":
+	case 21: // A.ONEOF_STRING
+		if fd.FullName() != "A.ONEOF_STRING" {
+			break
+		}
 		return protoreflect.ValueOfString("")
-	case "A.LIST_ENUM":
+	case 22: // A.LIST_ENUM
+		if fd.FullName() != "A.LIST_ENUM" {
+			break
+		}
 		list := []Enumeration{}
 		return protoreflect.ValueOfList(&_A_22_list{list: &list})
-	case "A.imported":
+	case 23: // A.imported
+		if fd.FullName() != "A.imported" {
+			break
+		}
 		m := new(ImportedMessage)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "A.type":
-		return protoreflect.ValueOfString("")
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: A"))
+	case 24: // A.type
+		if fd.FullName() != "A.type" {
+			break
 		}
-		panic(fmt.Errorf("message A does not contain field %s", fd.FullName()))
+		return protoreflect.ValueOfString("")
+	}
+	if fd := runtime.FieldOf(fd, md_A); fd != nil {
+		return x.NewField(fd)
 	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message A does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message A does not contain field %s", fd.FullName()))
 }
 
 // WhichOneof reports which field within the oneof is populated,
@@ -1026,445 +1726,549 @@ func (x *fastReflection_A) IsValid() bool {
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
 func (x *fastReflection_A) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*A)
-		if x == nil {
+	return _fastReflection_A_methods
+}
+
+var _fastReflection_A_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_A_size,
+	Marshal:           _fastReflection_A_marshal,
+	Unmarshal:         _fastReflection_A_unmarshal,
+	Merge:             _fastReflection_A_merge,
+	CheckInitialized:  _fastReflection_A_checkInitialized,
+	Equal:             _fastReflection_A_equal,
+}
+
+func _fastReflection_A_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*A)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Enum != 0 {
-			n += 1 + runtime.Sov(uint64(x.Enum))
-		}
-		if x.SomeBoolean {
-			n += 2
-		}
-		if x.INT32 != 0 {
-			n += 1 + runtime.Sov(uint64(x.INT32))
-		}
-		if x.SINT32 != 0 {
-			n += 1 + runtime.Soz(uint64(x.SINT32))
-		}
-		if x.UINT32 != 0 {
-			n += 1 + runtime.Sov(uint64(x.UINT32))
-		}
-		if x.INT64 != 0 {
-			n += 1 + runtime.Sov(uint64(x.INT64))
-		}
-		if x.SING64 != 0 {
-			n += 1 + runtime.Soz(uint64(x.SING64))
-		}
-		if x.UINT64 != 0 {
-			n += 1 + runtime.Sov(uint64(x.UINT64))
-		}
-		if x.SFIXED32 != 0 {
-			n += 5
-		}
-		if x.FIXED32 != 0 {
-			n += 5
-		}
-		if x.FLOAT != 0 || math.Signbit(float64(x.FLOAT)) {
-			n += 5
-		}
-		if x.SFIXED64 != 0 {
-			n += 9
-		}
-		if x.FIXED64 != 0 {
-			n += 9
-		}
-		if x.DOUBLE != 0 || math.Signbit(x.DOUBLE) {
-			n += 9
-		}
-		l = len(x.STRING)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.BYTES)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if x.MESSAGE != nil {
-			l = options.Size(x.MESSAGE)
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.MAP) > 0 {
-			SiZeMaP := func(k string, v *B) {
-				l := 0
-				if v != nil {
-					l = options.Size(v)
-				}
-				l += 1 + runtime.Sov(uint64(l))
-				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
-				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]string, 0, len(x.MAP))
-				for k := range x.MAP {
-					sortme = append(sortme, k)
-				}
-				sort.Strings(sortme)
-				for _, k := range sortme {
-					v := x.MAP[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.MAP {
-					SiZeMaP(k, v)
-				}
+				Size:              int(size - 1),
 			}
 		}
-		if len(x.LIST) > 0 {
-			for _, e := range x.LIST {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.Enum != 0 {
+		n += 1 + runtime.Sov(uint64(x.Enum))
+	}
+	if x.SomeBoolean {
+		n += 2
+	}
+	if x.INT32 != 0 {
+		n += 1 + runtime.Sov(uint64(x.INT32))
+	}
+	if x.SINT32 != 0 {
+		n += 1 + runtime.Soz(uint64(x.SINT32))
+	}
+	if x.UINT32 != 0 {
+		n += 1 + runtime.Sov(uint64(x.UINT32))
+	}
+	if x.INT64 != 0 {
+		n += 1 + runtime.Sov(uint64(x.INT64))
+	}
+	if x.SING64 != 0 {
+		n += 1 + runtime.Soz(uint64(x.SING64))
+	}
+	if x.UINT64 != 0 {
+		n += 1 + runtime.Sov(uint64(x.UINT64))
+	}
+	if x.SFIXED32 != 0 {
+		n += 5
+	}
+	if x.FIXED32 != 0 {
+		n += 5
+	}
+	if x.FLOAT != 0 || math.Signbit(float64(x.FLOAT)) {
+		n += 5
+	}
+	if x.SFIXED64 != 0 {
+		n += 9
+	}
+	if x.FIXED64 != 0 {
+		n += 9
+	}
+	if x.DOUBLE != 0 || math.Signbit(x.DOUBLE) {
+		n += 9
+	}
+	l = len(x.STRING)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	l = len(x.BYTES)
+	if l > 0 {
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.MESSAGE != nil {
+		l = options.Size(x.MESSAGE)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.MAP) > 0 {
+		SiZeMaP := func(k string, v *B) {
+			l := 0
+			if v != nil {
+				l = options.Size(v)
 			}
+			l += 1 + runtime.Sov(uint64(l))
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
 		}
-		switch x := x.ONEOF.(type) {
-		case *A_ONEOF_B:
-			if x == nil {
-				break
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.MAP))
+			for k := range x.MAP {
+				sortme = append(sortme, k)
 			}
-			l = options.Size(x.ONEOF_B)
-			n += 2 + l + runtime.Sov(uint64(l))
-		case *A_ONEOF_STRING:
-			if x == nil {
-				break
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.MAP[k]
+				SiZeMaP(k, v)
 			}
-			l = len(x.ONEOF_STRING)
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.LIST_ENUM) > 0 {
-			l = 0
-			for _, e := range x.LIST_ENUM {
-				l += runtime.Sov(uint64(e))
+		} else {
+			for k, v := range x.MAP {
+				SiZeMaP(k, v)
 			}
-			n += 2 + runtime.Sov(uint64(l)) + l
 		}
-		if x.Imported != nil {
-			l = options.Size(x.Imported)
+	}
+	if len(x.LIST) > 0 {
+		for _, e := range x.LIST {
+			l = options.Size(e)
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Type_)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
+	}
+	switch x := x.ONEOF.(type) {
+	case *A_ONEOF_B:
+		if x == nil {
+			break
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		l = options.Size(x.ONEOF_B)
+		n += 2 + l + runtime.Sov(uint64(l))
+	case *A_ONEOF_STRING:
+		if x == nil {
+			break
 		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		l = len(x.ONEOF_STRING)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.LIST_ENUM) > 0 {
+		l = 0
+		for _, e := range x.LIST_ENUM {
+			l += runtime.Sov(uint64(e))
 		}
+		n += 2 + runtime.Sov(uint64(l)) + l
+	}
+	if x.Imported != nil {
+		l = options.Size(x.Imported)
+		n += 2 + l + runtime.Sov(uint64(l))
 	}
+	l = len(x.Type_)
+	if l > 0 {
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*A)
-		if x == nil {
+func _fastReflection_A_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*A)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	switch x := x.ONEOF.(type) {
+	case *A_ONEOF_B:
+		l = options.Size(x.ONEOF_B)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.ONEOF_B); err != nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		switch x := x.ONEOF.(type) {
-		case *A_ONEOF_B:
-			encoded, err := options.Marshal(x.ONEOF_B)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xa2
-		case *A_ONEOF_STRING:
-			i -= len(x.ONEOF_STRING)
-			copy(dAtA[i:], x.ONEOF_STRING)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ONEOF_STRING)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xaa
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
 		}
-		if len(x.Type_) > 0 {
-			i -= len(x.Type_)
-			copy(dAtA[i:], x.Type_)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Type_)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xc2
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	case *A_ONEOF_STRING:
+		if !utf8.ValidString(x.ONEOF_STRING) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
 		}
-		if x.Imported != nil {
-			encoded, err := options.Marshal(x.Imported)
-			if err != nil {
+		i -= len(x.ONEOF_STRING)
+		copy(dAtA[i:], x.ONEOF_STRING)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ONEOF_STRING)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(x.Type_) > 0 {
+		if !utf8.ValidString(x.Type_) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.Type_)
+		copy(dAtA[i:], x.Type_)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Type_)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if x.Imported != nil {
+		l = options.Size(x.Imported)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Imported); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(x.LIST_ENUM) > 0 {
+		var pksize2 int
+		for _, num := range x.LIST_ENUM {
+			pksize2 += runtime.Sov(uint64(num))
+		}
+		i -= pksize2
+		j1 := i
+		for _, num1 := range x.LIST_ENUM {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(x.LIST) > 0 {
+		for iNdEx := len(x.LIST) - 1; iNdEx >= 0; iNdEx-- {
+			l = options.Size(x.LIST[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.LIST[iNdEx]); err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 					Buf:               input.Buf,
 				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xba
-		}
-		if len(x.LIST_ENUM) > 0 {
-			var pksize2 int
-			for _, num := range x.LIST_ENUM {
-				pksize2 += runtime.Sov(uint64(num))
-			}
-			i -= pksize2
-			j1 := i
-			for _, num1 := range x.LIST_ENUM {
-				num := uint64(num1)
-				for num >= 1<<7 {
-					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j1++
-				}
-				dAtA[j1] = uint8(num)
-				j1++
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
 			i--
 			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0xb2
-		}
-		if len(x.LIST) > 0 {
-			for iNdEx := len(x.LIST) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.LIST[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0x9a
-			}
-		}
-		if len(x.MAP) > 0 {
-			MaRsHaLmAp := func(k string, v *B) (protoiface.MarshalOutput, error) {
-				baseI := i
-				encoded, err := options.Marshal(v)
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-				i -= len(k)
-				copy(dAtA[i:], k)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
-				i--
-				dAtA[i] = 0xa
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0x92
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMAP := make([]string, 0, len(x.MAP))
-				for k := range x.MAP {
-					keysForMAP = append(keysForMAP, string(k))
-				}
-				sort.Slice(keysForMAP, func(i, j int) bool {
-					return keysForMAP[i] < keysForMAP[j]
-				})
-				for iNdEx := len(keysForMAP) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MAP[string(keysForMAP[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMAP[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.MAP {
-					v := x.MAP[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
+			dAtA[i] = 0x9a
 		}
-		if x.MESSAGE != nil {
-			encoded, err := options.Marshal(x.MESSAGE)
-			if err != nil {
+	}
+	if len(x.MAP) > 0 {
+		MaRsHaLmAp := func(k string, v *B) (protoiface.MarshalOutput, error) {
+			baseI := i
+			l = options.Size(v)
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], v); err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 					Buf:               input.Buf,
 				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x12
+			if !utf8.ValidString(k) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
 			i--
-			dAtA[i] = 0x8a
-		}
-		if len(x.BYTES) > 0 {
-			i -= len(x.BYTES)
-			copy(dAtA[i:], x.BYTES)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BYTES)))
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 			i--
 			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0x82
-		}
-		if len(x.STRING) > 0 {
-			i -= len(x.STRING)
-			copy(dAtA[i:], x.STRING)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.STRING)))
-			i--
-			dAtA[i] = 0x7a
-		}
-		if x.DOUBLE != 0 || math.Signbit(x.DOUBLE) {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.DOUBLE))))
-			i--
-			dAtA[i] = 0x71
-		}
-		if x.FIXED64 != 0 {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(x.FIXED64))
-			i--
-			dAtA[i] = 0x69
-		}
-		if x.SFIXED64 != 0 {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(x.SFIXED64))
-			i--
-			dAtA[i] = 0x61
-		}
-		if x.FLOAT != 0 || math.Signbit(float64(x.FLOAT)) {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(x.FLOAT))))
-			i--
-			dAtA[i] = 0x5d
+			dAtA[i] = 0x92
+			return protoiface.MarshalOutput{}, nil
 		}
-		if x.FIXED32 != 0 {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.FIXED32))
-			i--
-			dAtA[i] = 0x55
-		}
-		if x.SFIXED32 != 0 {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.SFIXED32))
-			i--
-			dAtA[i] = 0x4d
-		}
-		if x.UINT64 != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.UINT64))
-			i--
-			dAtA[i] = 0x40
-		}
-		if x.SING64 != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64((uint64(x.SING64)<<1)^uint64((x.SING64>>63))))
-			i--
-			dAtA[i] = 0x38
-		}
-		if x.INT64 != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.INT64))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.UINT32 != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.UINT32))
-			i--
-			dAtA[i] = 0x28
+		if options.Deterministic {
+			keysForMAP := make([]string, 0, len(x.MAP))
+			for k := range x.MAP {
+				keysForMAP = append(keysForMAP, string(k))
+			}
+			sort.Slice(keysForMAP, func(i, j int) bool {
+				return keysForMAP[i] < keysForMAP[j]
+			})
+			for iNdEx := len(keysForMAP) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.MAP[string(keysForMAP[iNdEx])]
+				out, err := MaRsHaLmAp(keysForMAP[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.MAP {
+				v := x.MAP[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
 		}
-		if x.SINT32 != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64((uint32(x.SINT32)<<1)^uint32((x.SINT32>>31))))
-			i--
-			dAtA[i] = 0x20
+	}
+	if x.MESSAGE != nil {
+		l = options.Size(x.MESSAGE)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.MESSAGE); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
 		}
-		if x.INT32 != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.INT32))
-			i--
-			dAtA[i] = 0x18
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(x.BYTES) > 0 {
+		i -= len(x.BYTES)
+		copy(dAtA[i:], x.BYTES)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BYTES)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(x.STRING) > 0 {
+		if !utf8.ValidString(x.STRING) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
 		}
+		i -= len(x.STRING)
+		copy(dAtA[i:], x.STRING)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.STRING)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if x.DOUBLE != 0 || math.Signbit(x.DOUBLE) {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.DOUBLE))))
+		i--
+		dAtA[i] = 0x71
+	}
+	if x.FIXED64 != 0 {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(x.FIXED64))
+		i--
+		dAtA[i] = 0x69
+	}
+	if x.SFIXED64 != 0 {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(x.SFIXED64))
+		i--
+		dAtA[i] = 0x61
+	}
+	if x.FLOAT != 0 || math.Signbit(float64(x.FLOAT)) {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(x.FLOAT))))
+		i--
+		dAtA[i] = 0x5d
+	}
+	if x.FIXED32 != 0 {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.FIXED32))
+		i--
+		dAtA[i] = 0x55
+	}
+	if x.SFIXED32 != 0 {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(x.SFIXED32))
+		i--
+		dAtA[i] = 0x4d
+	}
+	if x.UINT64 != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.UINT64))
+		i--
+		dAtA[i] = 0x40
+	}
+	if x.SING64 != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64((uint64(x.SING64)<<1)^uint64((x.SING64>>63))))
+		i--
+		dAtA[i] = 0x38
+	}
+	if x.INT64 != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.INT64))
+		i--
+		dAtA[i] = 0x30
+	}
+	if x.UINT32 != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.UINT32))
+		i--
+		dAtA[i] = 0x28
+	}
+	if x.SINT32 != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64((uint32(x.SINT32)<<1)^uint32((x.SINT32>>31))))
+		i--
+		dAtA[i] = 0x20
+	}
+	if x.INT32 != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.INT32))
+		i--
+		dAtA[i] = 0x18
+	}
+	if x.SomeBoolean {
+		i--
 		if x.SomeBoolean {
-			i--
-			if x.SomeBoolean {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.Enum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Enum))
-			i--
-			dAtA[i] = 0x8
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
+			dAtA[i] = 1
 		} else {
-			input.Buf = dAtA
+			dAtA[i] = 0
 		}
-		return protoiface.MarshalOutput{
+		i--
+		dAtA[i] = 0x10
+	}
+	if x.Enum != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.Enum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_A_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*A)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
+			Flags:             input.Flags,
 		}, nil
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*A)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_A.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	if zeroCopy {
+		x.zeroCopied = true
+	}
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: A: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: A: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
+			}
+			x.Enum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1474,346 +2278,367 @@ func (x *fastReflection_A) ProtoMethods() *protoiface.Methods {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				wire |= uint64(b&0x7F) << shift
+				x.Enum |= Enumeration(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: A: wiretype end group for non-group")
+		case 2:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SomeBoolean", wireType)
 			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: A: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
-				}
-				x.Enum = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Enum |= Enumeration(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SomeBoolean", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.SomeBoolean = bool(v != 0)
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field INT32", wireType)
-				}
-				x.INT32 = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.INT32 |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SINT32", wireType)
-				}
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-				x.SINT32 = v
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UINT32", wireType)
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.UINT32 = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.UINT32 |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field INT64", wireType)
+			}
+			x.SomeBoolean = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field INT32", wireType)
+			}
+			x.INT32 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				x.INT64 = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.INT64 |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-			case 7:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SING64", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.INT32 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			}
+		case 4:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SINT32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-				x.SING64 = int64(v)
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UINT64", wireType)
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.UINT64 = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.UINT64 |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			case 9:
-				if wireType != 5 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SFIXED32", wireType)
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			x.SINT32 = v
+		case 5:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UINT32", wireType)
+			}
+			x.UINT32 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				x.SFIXED32 = 0
-				if (iNdEx + 4) > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SFIXED32 = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-				iNdEx += 4
-			case 10:
-				if wireType != 5 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FIXED32", wireType)
-				}
-				x.FIXED32 = 0
-				if (iNdEx + 4) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.UINT32 |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.FIXED32 = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-				iNdEx += 4
-			case 11:
-				if wireType != 5 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FLOAT", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field INT64", wireType)
+			}
+			x.INT64 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				var v uint32
-				if (iNdEx + 4) > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-				iNdEx += 4
-				x.FLOAT = float32(math.Float32frombits(v))
-			case 12:
-				if wireType != 1 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SFIXED64", wireType)
-				}
-				x.SFIXED64 = 0
-				if (iNdEx + 8) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.INT64 |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.SFIXED64 = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-			case 13:
-				if wireType != 1 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FIXED64", wireType)
+			}
+		case 7:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SING64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				x.FIXED64 = 0
-				if (iNdEx + 8) > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.FIXED64 = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-			case 14:
-				if wireType != 1 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DOUBLE", wireType)
-				}
-				var v uint64
-				if (iNdEx + 8) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				x.DOUBLE = float64(math.Float64frombits(v))
-			case 15:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field STRING", wireType)
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			x.SING64 = int64(v)
+		case 8:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UINT64", wireType)
+			}
+			x.UINT64 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.UINT64 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+		case 9:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SFIXED32", wireType)
+			}
+			x.SFIXED32 = 0
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			x.SFIXED32 = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+		case 10:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FIXED32", wireType)
+			}
+			x.FIXED32 = 0
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			x.FIXED32 = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+		case 11:
+			if wireType != 5 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FLOAT", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			x.FLOAT = float32(math.Float32frombits(v))
+		case 12:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SFIXED64", wireType)
+			}
+			x.SFIXED64 = 0
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			x.SFIXED64 = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 13:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FIXED64", wireType)
+			}
+			x.FIXED64 = 0
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			x.FIXED64 = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 14:
+			if wireType != 1 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DOUBLE", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			x.DOUBLE = float64(math.Float64frombits(v))
+		case 15:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field STRING", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.STRING = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 16:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BYTES", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_A_STRING, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.STRING = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BYTES", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_A_BYTES, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if zeroCopy || x.zeroCopied {
+				x.BYTES = runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy)
+			} else {
 				x.BYTES = append(x.BYTES[:0], dAtA[iNdEx:postIndex]...)
 				if x.BYTES == nil {
 					x.BYTES = []byte{}
 				}
-				iNdEx = postIndex
-			case 17:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MESSAGE", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MESSAGE", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MESSAGE == nil {
-					x.MESSAGE = &B{}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MESSAGE); err != nil {
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MESSAGE == nil {
+				if err := budget.Message(fd_A_MESSAGE, int(unsafe.Sizeof(B{}))); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx = postIndex
-			case 18:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MAP", wireType)
+				x.MESSAGE = &B{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MESSAGE); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_A_MESSAGE, -1)
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MAP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var msglen int
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.MAP == nil {
+				x.MAP = make(map[string]*B)
+			}
+			var mapkey string
+			var mapvalue *B
+			var mapvalueErr error
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1823,29 +2648,14 @@ func (x *fastReflection_A) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.MAP == nil {
-					x.MAP = make(map[string]*B)
-				}
-				var mapkey string
-				var mapvalue *B
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1855,128 +2665,219 @@ func (x *fastReflection_A) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						wire |= uint64(b&0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						var stringLenmapkey uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapkey |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapkey := int(stringLenmapkey)
-						if intStringLenmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapkey := iNdEx + intStringLenmapkey
-						if postStringIndexmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapkey > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-						iNdEx = postStringIndexmapkey
-					} else if fieldNum == 2 {
-						var mapmsglen int
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapmsglen |= int(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						if mapmsglen < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postmsgIndex := iNdEx + mapmsglen
-						if postmsgIndex < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_A_MAP, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 						}
-						if postmsgIndex > l {
+						if iNdEx >= l {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 						}
-						mapvalue = &B{}
-						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						iNdEx = postmsgIndex
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
 						}
-						iNdEx += skippy
 					}
-				}
-				x.MAP[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 19:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LIST", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					if mapmsglen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 					}
-					if iNdEx >= l {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postmsgIndex > l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					if err := budget.Message(fd_A_MAP, int(unsafe.Sizeof(B{}))); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapvalue = &B{}
+					if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+						mapvalueErr = err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if mapvalueErr != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarInMap(mapvalueErr, fd_A_MAP, mapkey)
+			}
+			if budget != nil {
+				if _, ok := x.MAP[mapkey]; !ok {
+					if err := budget.MapEntry(fd_A_MAP, len(x.MAP), 24); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			x.MAP[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LIST", wireType)
+			}
+			if err := budget.List(fd_A_LIST, len(x.LIST), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Message(fd_A_LIST, int(unsafe.Sizeof(B{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.LIST = append(x.LIST, &B{})
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LIST[len(x.LIST)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_A_LIST, len(x.LIST)-1)
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ONEOF_B", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if oneof, ok := x.ONEOF.(*A_ONEOF_B); ok && oneof.ONEOF_B != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], oneof.ONEOF_B); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_A_ONEOF_B, -1)
+				}
+			} else {
+				if err := budget.Message(fd_A_ONEOF_B, int(unsafe.Sizeof(B{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				v := &B{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_A_ONEOF_B, -1)
 				}
-				if postIndex > l {
+				x.ONEOF = &A_ONEOF_B{v}
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ONEOF_STRING", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LIST = append(x.LIST, &B{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LIST[len(x.LIST)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 20:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ONEOF_B", wireType)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_A_ONEOF_STRING, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.ONEOF = &A_ONEOF_STRING{runtime.String(dAtA[iNdEx:postIndex], zeroCopy)}
+			iNdEx = postIndex
+		case 22:
+			if wireType == 0 {
+				if err := budget.List(fd_A_LIST_ENUM, len(x.LIST_ENUM), 1, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				var msglen int
+				var v Enumeration
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1986,32 +2887,14 @@ func (x *fastReflection_A) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					v |= Enumeration(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				v := &B{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				x.ONEOF = &A_ONEOF_B{v}
-				iNdEx = postIndex
-			case 21:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ONEOF_STRING", wireType)
-				}
-				var stringLen uint64
+				x.LIST_ENUM = append(x.LIST_ENUM, v)
+			} else if wireType == 2 {
+				var packedLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2021,26 +2904,36 @@ func (x *fastReflection_A) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if packedLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + packedLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ONEOF = &A_ONEOF_STRING{string(dAtA[iNdEx:postIndex])}
-				iNdEx = postIndex
-			case 22:
-				if wireType == 0 {
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_A_LIST_ENUM, len(x.LIST_ENUM), elementCount, 4); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.LIST_ENUM) == 0 {
+					x.LIST_ENUM = make([]Enumeration, 0, elementCount)
+				}
+				for iNdEx < postIndex {
 					var v Enumeration
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
@@ -2057,158 +2950,474 @@ func (x *fastReflection_A) ProtoMethods() *protoiface.Methods {
 						}
 					}
 					x.LIST_ENUM = append(x.LIST_ENUM, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					if postIndex > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					var elementCount int
-					if elementCount != 0 && len(x.LIST_ENUM) == 0 {
-						x.LIST_ENUM = make([]Enumeration, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v Enumeration
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= Enumeration(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.LIST_ENUM = append(x.LIST_ENUM, v)
-					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LIST_ENUM", wireType)
 				}
-			case 23:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Imported", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LIST_ENUM", wireType)
+			}
+		case 23:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Imported", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Imported == nil {
-					x.Imported = &ImportedMessage{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Imported); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 24:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.Type_ = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Imported == nil {
+				if err := budget.Message(fd_A_imported, int(unsafe.Sizeof(ImportedMessage{}))); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.Imported = &ImportedMessage{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Imported); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_A_imported, -1)
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if (iNdEx + skippy) > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx += skippy
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_A_type, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.Type_ = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_A, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_A_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*A)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*A)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.Enum != 0 {
+		dst.Enum = src.Enum
+	}
+	if src.SomeBoolean != false {
+		dst.SomeBoolean = src.SomeBoolean
+	}
+	if src.INT32 != int32(0) {
+		dst.INT32 = src.INT32
+	}
+	if src.SINT32 != int32(0) {
+		dst.SINT32 = src.SINT32
+	}
+	if src.UINT32 != uint32(0) {
+		dst.UINT32 = src.UINT32
+	}
+	if src.INT64 != int64(0) {
+		dst.INT64 = src.INT64
+	}
+	if src.SING64 != int64(0) {
+		dst.SING64 = src.SING64
+	}
+	if src.UINT64 != uint64(0) {
+		dst.UINT64 = src.UINT64
+	}
+	if src.SFIXED32 != int32(0) {
+		dst.SFIXED32 = src.SFIXED32
+	}
+	if src.FIXED32 != uint32(0) {
+		dst.FIXED32 = src.FIXED32
+	}
+	if src.FLOAT != 0 || math.Signbit(float64(src.FLOAT)) {
+		dst.FLOAT = src.FLOAT
+	}
+	if src.SFIXED64 != int64(0) {
+		dst.SFIXED64 = src.SFIXED64
+	}
+	if src.FIXED64 != uint64(0) {
+		dst.FIXED64 = src.FIXED64
+	}
+	if src.DOUBLE != 0 || math.Signbit(src.DOUBLE) {
+		dst.DOUBLE = src.DOUBLE
+	}
+	if src.STRING != "" {
+		dst.STRING = src.STRING
+	}
+	if len(src.BYTES) != 0 {
+		dst.BYTES = append([]byte{}, src.BYTES...)
+	}
+	if src.MESSAGE != nil {
+		if dst.MESSAGE == nil {
+			dst.MESSAGE = &B{}
+		}
+		proto.Merge(dst.MESSAGE, src.MESSAGE)
+	}
+	if len(src.MAP) != 0 {
+		if dst.MAP == nil {
+			dst.MAP = make(map[string]*B, len(src.MAP))
+		}
+		for k, v := range src.MAP {
+			m := &B{}
+			proto.Merge(m, v)
+			dst.MAP[k] = m
+		}
+	}
+	for _, v := range src.LIST {
+		m := &B{}
+		proto.Merge(m, v)
+		dst.LIST = append(dst.LIST, m)
+	}
+	switch v := src.ONEOF.(type) {
+	case *A_ONEOF_B:
+		if dv, ok := dst.ONEOF.(*A_ONEOF_B); ok && dv.ONEOF_B != nil {
+			proto.Merge(dv.ONEOF_B, v.ONEOF_B)
+		} else {
+			m := &B{}
+			proto.Merge(m, v.ONEOF_B)
+			dst.ONEOF = &A_ONEOF_B{ONEOF_B: m}
+		}
+	case *A_ONEOF_STRING:
+		dst.ONEOF = &A_ONEOF_STRING{ONEOF_STRING: v.ONEOF_STRING}
+	}
+	if len(src.LIST_ENUM) != 0 {
+		dst.LIST_ENUM = append(dst.LIST_ENUM, src.LIST_ENUM...)
+	}
+	if src.Imported != nil {
+		if dst.Imported == nil {
+			dst.Imported = &ImportedMessage{}
+		}
+		proto.Merge(dst.Imported, src.Imported)
+	}
+	if src.Type_ != "" {
+		dst.Type_ = src.Type_
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_A_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_A_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*A)
+	y, yok := input.MessageB.Interface().(*A)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.Enum != y.Enum {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SomeBoolean != y.SomeBoolean {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.INT32 != y.INT32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SINT32 != y.SINT32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.UINT32 != y.UINT32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.INT64 != y.INT64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SING64 != y.SING64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.UINT64 != y.UINT64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SFIXED32 != y.SFIXED32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.FIXED32 != y.FIXED32 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !runtime.EqualFloat(float64(x.FLOAT), float64(y.FLOAT)) || x.FLOAT == 0 && math.Signbit(float64(x.FLOAT)) != math.Signbit(float64(y.FLOAT)) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.SFIXED64 != y.SFIXED64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.FIXED64 != y.FIXED64 {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !runtime.EqualFloat(x.DOUBLE, y.DOUBLE) || x.DOUBLE == 0 && math.Signbit(x.DOUBLE) != math.Signbit(y.DOUBLE) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.STRING != y.STRING {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !bytes.Equal(x.BYTES, y.BYTES) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.MESSAGE.Equal(y.MESSAGE) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.MAP) != len(y.MAP) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.MAP {
+		if w, ok := y.MAP[k]; !ok || !v.Equal(w) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.LIST) != len(y.LIST) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.LIST {
+		if !v.Equal(y.LIST[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	switch v := x.ONEOF.(type) {
+	case nil:
+		if y.ONEOF != nil {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *A_ONEOF_B:
+		if w, ok := y.ONEOF.(*A_ONEOF_B); !ok || !v.ONEOF_B.Equal(w.ONEOF_B) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *A_ONEOF_STRING:
+		if w, ok := y.ONEOF.(*A_ONEOF_STRING); !ok || v.ONEOF_STRING != w.ONEOF_STRING {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.LIST_ENUM) != len(y.LIST_ENUM) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.LIST_ENUM {
+		if v != y.LIST_ENUM[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
 		}
+	}
+	if !x.Imported.Equal(y.Imported) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.Type_ != y.Type_ {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+// Clone returns a deep copy of the message.
+func (x *A) Clone() *A {
+	if x == nil {
+		return nil
+	}
+	dst := new(A)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *A) CopyInto(dst *A) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.Enum = x.Enum
+	dst.SomeBoolean = x.SomeBoolean
+	dst.INT32 = x.INT32
+	dst.SINT32 = x.SINT32
+	dst.UINT32 = x.UINT32
+	dst.INT64 = x.INT64
+	dst.SING64 = x.SING64
+	dst.UINT64 = x.UINT64
+	dst.SFIXED32 = x.SFIXED32
+	dst.FIXED32 = x.FIXED32
+	dst.FLOAT = x.FLOAT
+	dst.SFIXED64 = x.SFIXED64
+	dst.FIXED64 = x.FIXED64
+	dst.DOUBLE = x.DOUBLE
+	dst.STRING = x.STRING
+	dst.BYTES = bytes.Clone(x.BYTES)
+	dst.MESSAGE = x.MESSAGE.Clone()
+	if x.MAP != nil {
+		dst.MAP = make(map[string]*B, len(x.MAP))
+		for k, v := range x.MAP {
+			dst.MAP[k] = v.Clone()
+		}
+	} else {
+		dst.MAP = nil
+	}
+	if x.LIST != nil {
+		dst.LIST = make([]*B, len(x.LIST))
+		for i, v := range x.LIST {
+			dst.LIST[i] = v.Clone()
 		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	} else {
+		dst.LIST = nil
+	}
+	switch v := x.ONEOF.(type) {
+	case nil:
+		dst.ONEOF = nil
+	case *A_ONEOF_B:
+		dst.ONEOF = &A_ONEOF_B{ONEOF_B: v.ONEOF_B.Clone()}
+	case *A_ONEOF_STRING:
+		dst.ONEOF = &A_ONEOF_STRING{ONEOF_STRING: v.ONEOF_STRING}
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	dst.LIST_ENUM = slices.Clone(x.LIST_ENUM)
+	dst.Imported = x.Imported.Clone()
+	dst.Type_ = x.Type_
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *A) Equal(y *A) bool {
+	return _fastReflection_A_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a A, or nil if b is canonical.
+func (*A) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_A.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("enum", protoreflect.EnumKind, true)
+		case 2:
+			w.Scalar("some_boolean", protoreflect.BoolKind, true)
+		case 3:
+			w.Scalar("INT32", protoreflect.Int32Kind, true)
+		case 4:
+			w.Scalar("SINT32", protoreflect.Sint32Kind, true)
+		case 5:
+			w.Scalar("UINT32", protoreflect.Uint32Kind, true)
+		case 6:
+			w.Scalar("INT64", protoreflect.Int64Kind, true)
+		case 7:
+			w.Scalar("SING64", protoreflect.Sint64Kind, true)
+		case 8:
+			w.Scalar("UINT64", protoreflect.Uint64Kind, true)
+		case 9:
+			w.Scalar("SFIXED32", protoreflect.Sfixed32Kind, true)
+		case 10:
+			w.Scalar("FIXED32", protoreflect.Fixed32Kind, true)
+		case 11:
+			w.Scalar("FLOAT", protoreflect.FloatKind, true)
+		case 12:
+			w.Scalar("SFIXED64", protoreflect.Sfixed64Kind, true)
+		case 13:
+			w.Scalar("FIXED64", protoreflect.Fixed64Kind, true)
+		case 14:
+			w.Scalar("DOUBLE", protoreflect.DoubleKind, true)
+		case 15:
+			w.Scalar("STRING", protoreflect.StringKind, true)
+		case 16:
+			w.Scalar("BYTES", protoreflect.BytesKind, true)
+		case 17:
+			w.Message("MESSAGE", false, (*B)(nil))
+		case 18:
+			w.Map("MAP", protoreflect.StringKind, protoreflect.MessageKind, (*B)(nil))
+		case 19:
+			w.Message("LIST", true, (*B)(nil))
+		case 20:
+			w.Oneof(0)
+			w.Message("ONEOF_B", false, (*B)(nil))
+		case 21:
+			w.Oneof(0)
+			w.Scalar("ONEOF_STRING", protoreflect.StringKind, false)
+		case 22:
+			w.List("LIST_ENUM", protoreflect.EnumKind, true)
+		case 23:
+			w.Message("imported", false, (*ImportedMessage)(nil))
+		case 24:
+			w.Scalar("type", protoreflect.StringKind, true)
+		default:
+			w.Unknown()
+		}
 	}
+	return w.Err()
 }
 
 var (
@@ -2307,15 +3516,20 @@ func (x *fastReflection_B) Range(f func(protoreflect.FieldDescriptor, protorefle
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_B) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "B.x":
-		return x.X != ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: B"))
+	switch fd.Number() {
+	case 1: // B.x
+		if fd.FullName() != "B.x" {
+			break
 		}
-		panic(fmt.Errorf("message B does not contain field %s", fd.FullName()))
+		return x.X != ""
 	}
+	if fd := runtime.FieldOf(fd, md_B); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message B does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message B does not contain field %s", fd.FullName()))
 }
 
 // Clear clears the field such that a subsequent Has call reports false.
@@ -2325,15 +3539,22 @@ func (x *fastReflection_B) Has(fd protoreflect.FieldDescriptor) bool {
 //
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_B) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "B.x":
-		x.X = ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: B"))
+	switch fd.Number() {
+	case 1: // B.x
+		if fd.FullName() != "B.x" {
+			break
 		}
-		panic(fmt.Errorf("message B does not contain field %s", fd.FullName()))
+		x.X = ""
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_B); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message B does not extend %s", fd.FullName()))
 	}
+	panic(fmt.Errorf("message B does not contain field %s", fd.FullName()))
 }
 
 // Get retrieves the value for a field.
@@ -2343,16 +3564,21 @@ func (x *fastReflection_B) Clear(fd protoreflect.FieldDescriptor) {
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_B) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "B.x":
+	switch descriptor.Number() {
+	case 1: // B.x
+		if descriptor.FullName() != "B.x" {
+			break
+		}
 		value := x.X
 		return protoreflect.ValueOfString(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: B"))
-		}
-		panic(fmt.Errorf("message B does not contain field %s", descriptor.FullName()))
 	}
+	if fd := runtime.FieldOf(descriptor, md_B); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message B does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message B does not contain field %s", descriptor.FullName()))
 }
 
 // Set stores the value for a field.
@@ -2366,15 +3592,22 @@ func (x *fastReflection_B) Get(descriptor protoreflect.FieldDescriptor) protoref
 //
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_B) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "B.x":
-		x.X = value.Interface().(string)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: B"))
+	switch fd.Number() {
+	case 1: // B.x
+		if fd.FullName() != "B.x" {
+			break
 		}
-		panic(fmt.Errorf("message B does not contain field %s", fd.FullName()))
+		x.X = value.Interface().(string)
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_B); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message B does not extend %s", fd.FullName()))
 	}
+	panic(fmt.Errorf("message B does not contain field %s", fd.FullName()))
 }
 
 // Mutable returns a mutable reference to a composite type.
@@ -2388,30 +3621,40 @@ func (x *fastReflection_B) Set(fd protoreflect.FieldDescriptor, value protorefle
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_B) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "B.x":
-		panic(fmt.Errorf("field x of message B is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: B"))
+	switch fd.Number() {
+	case 1: // B.x
+		if fd.FullName() != "B.x" {
+			break
 		}
-		panic(fmt.Errorf("message B does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("field x of message B is not mutable"))
 	}
+	if fd := runtime.FieldOf(fd, md_B); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message B does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message B does not contain field %s", fd.FullName()))
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_B) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "B.x":
-		return protoreflect.ValueOfString("")
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: B"))
+	switch fd.Number() {
+	case 1: // B.x
+		if fd.FullName() != "B.x" {
+			break
 		}
-		panic(fmt.Errorf("message B does not contain field %s", fd.FullName()))
+		return protoreflect.ValueOfString("")
+	}
+	if fd := runtime.FieldOf(fd, md_B); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message B does not extend %s", fd.FullName()))
 	}
+	panic(fmt.Errorf("message B does not contain field %s", fd.FullName()))
 }
 
 // WhichOneof reports which field within the oneof is populated,
@@ -2462,85 +3705,148 @@ func (x *fastReflection_B) IsValid() bool {
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
 func (x *fastReflection_B) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*B)
-		if x == nil {
+	return _fastReflection_B_methods
+}
+
+var _fastReflection_B_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_B_size,
+	Marshal:           _fastReflection_B_marshal,
+	Unmarshal:         _fastReflection_B_unmarshal,
+	Merge:             _fastReflection_B_merge,
+	CheckInitialized:  _fastReflection_B_checkInitialized,
+	Equal:             _fastReflection_B_equal,
+}
+
+func _fastReflection_B_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*B)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
+				Size:              int(size - 1),
 			}
 		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.X)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
 	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	l = len(x.X)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*B)
-		if x == nil {
+func _fastReflection_B_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*B)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if len(x.X) > 0 {
+		if !utf8.ValidString(x.X) {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.X) > 0 {
-			i -= len(x.X)
-			copy(dAtA[i:], x.X)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.X)))
-			i--
-			dAtA[i] = 0xa
+			}, runtime.ErrInvalidUTF8
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
+		i -= len(x.X)
+		copy(dAtA[i:], x.X)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.X)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_B_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*B)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
+			Flags:             input.Flags,
 		}, nil
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*B)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_B.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: B: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: B: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field X", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2550,85 +3856,549 @@ func (x *fastReflection_B) ProtoMethods() *protoiface.Methods {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				wire |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: B: wiretype end group for non-group")
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: B: illegal tag %d (wire type %d)", fieldNum, wire)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field X", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_B_x, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.X = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_B, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_B_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*B)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*B)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.X != "" {
+		dst.X = src.X
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_B_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_B_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*B)
+	y, yok := input.MessageB.Interface().(*B)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.X != y.X {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *B) Clone() *B {
+	if x == nil {
+		return nil
+	}
+	dst := new(B)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *B) CopyInto(dst *B) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.X = x.X
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *B) Equal(y *B) bool {
+	return _fastReflection_B_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a B, or nil if b is canonical.
+func (*B) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_B.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("x", protoreflect.StringKind, true)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *A) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *A) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Enum != 0 {
+		b = append(b, "\"enum\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(x.Enum), x.Enum.Descriptor())
+		b = append(b, ',')
+	}
+	if x.SomeBoolean {
+		b = append(b, "\"someBoolean\":"...)
+		b = strconv.AppendBool(b, x.SomeBoolean)
+		b = append(b, ',')
+	}
+	if x.INT32 != 0 {
+		b = append(b, "\"INT32\":"...)
+		b = strconv.AppendInt(b, int64(x.INT32), 10)
+		b = append(b, ',')
+	}
+	if x.SINT32 != 0 {
+		b = append(b, "\"SINT32\":"...)
+		b = strconv.AppendInt(b, int64(x.SINT32), 10)
+		b = append(b, ',')
+	}
+	if x.UINT32 != 0 {
+		b = append(b, "\"UINT32\":"...)
+		b = strconv.AppendUint(b, uint64(x.UINT32), 10)
+		b = append(b, ',')
+	}
+	if x.INT64 != 0 {
+		b = append(b, "\"INT64\":"...)
+		b = runtime.AppendJSONInt64(b, x.INT64)
+		b = append(b, ',')
+	}
+	if x.SING64 != 0 {
+		b = append(b, "\"SING64\":"...)
+		b = runtime.AppendJSONInt64(b, x.SING64)
+		b = append(b, ',')
+	}
+	if x.UINT64 != 0 {
+		b = append(b, "\"UINT64\":"...)
+		b = runtime.AppendJSONUint64(b, x.UINT64)
+		b = append(b, ',')
+	}
+	if x.SFIXED32 != 0 {
+		b = append(b, "\"SFIXED32\":"...)
+		b = strconv.AppendInt(b, int64(x.SFIXED32), 10)
+		b = append(b, ',')
+	}
+	if x.FIXED32 != 0 {
+		b = append(b, "\"FIXED32\":"...)
+		b = strconv.AppendUint(b, uint64(x.FIXED32), 10)
+		b = append(b, ',')
+	}
+	if x.FLOAT != 0 || math.Signbit(float64(x.FLOAT)) {
+		b = append(b, "\"FLOAT\":"...)
+		b = runtime.AppendJSONFloat(b, float64(x.FLOAT), 32)
+		b = append(b, ',')
+	}
+	if x.SFIXED64 != 0 {
+		b = append(b, "\"SFIXED64\":"...)
+		b = runtime.AppendJSONInt64(b, x.SFIXED64)
+		b = append(b, ',')
+	}
+	if x.FIXED64 != 0 {
+		b = append(b, "\"FIXED64\":"...)
+		b = runtime.AppendJSONUint64(b, x.FIXED64)
+		b = append(b, ',')
+	}
+	if x.DOUBLE != 0 || math.Signbit(x.DOUBLE) {
+		b = append(b, "\"DOUBLE\":"...)
+		b = runtime.AppendJSONFloat(b, x.DOUBLE, 64)
+		b = append(b, ',')
+	}
+	if x.STRING != "" {
+		b = append(b, "\"STRING\":"...)
+		if b, err = runtime.AppendJSONString(b, x.STRING, "A.STRING"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.BYTES) > 0 {
+		b = append(b, "\"BYTES\":"...)
+		b = runtime.AppendJSONBytes(b, x.BYTES)
+		b = append(b, ',')
+	}
+	if x.MESSAGE != nil {
+		b = append(b, "\"MESSAGE\":"...)
+		if b, err = x.MESSAGE.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.MAP) > 0 {
+		b = append(b, "\"MAP\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.MAP)) {
+			v := x.MAP[k]
+			if b, err = runtime.AppendJSONString(b, k, "A.MAPEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.LIST) > 0 {
+		b = append(b, "\"LIST\":"...)
+		b = append(b, '[')
+		for _, v := range x.LIST {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if v, ok := x.ONEOF.(*A_ONEOF_B); ok {
+		b = append(b, "\"ONEOFB\":"...)
+		if b, err = v.ONEOF_B.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.ONEOF.(*A_ONEOF_STRING); ok {
+		b = append(b, "\"ONEOFSTRING\":"...)
+		if b, err = runtime.AppendJSONString(b, v.ONEOF_STRING, "A.ONEOF_STRING"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.LIST_ENUM) > 0 {
+		b = append(b, "\"LISTENUM\":"...)
+		b = append(b, '[')
+		for _, v := range x.LIST_ENUM {
+			b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v), v.Descriptor())
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if x.Imported != nil {
+		b = append(b, "\"imported\":"...)
+		if b, err = x.Imported.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Type_ != "" {
+		b = append(b, "\"type\":"...)
+		if b, err = runtime.AppendJSONString(b, x.Type_, "A.type"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *A) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *A) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := Enumeration(v)
+				x.Enum = e
+			}
+		case 2:
+			v, err := d.Bool(fd)
+			if err != nil {
+				return err
+			}
+			x.SomeBoolean = v
+		case 3:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.INT32 = v
+		case 4:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.SINT32 = v
+		case 5:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.UINT32 = v
+		case 6:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.INT64 = v
+		case 7:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.SING64 = v
+		case 8:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.UINT64 = v
+		case 9:
+			v, err := d.Int32(fd)
+			if err != nil {
+				return err
+			}
+			x.SFIXED32 = v
+		case 10:
+			v, err := d.Uint32(fd)
+			if err != nil {
+				return err
+			}
+			x.FIXED32 = v
+		case 11:
+			v, err := d.Float32(fd)
+			if err != nil {
+				return err
+			}
+			x.FLOAT = v
+		case 12:
+			v, err := d.Int64(fd)
+			if err != nil {
+				return err
+			}
+			x.SFIXED64 = v
+		case 13:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.FIXED64 = v
+		case 14:
+			v, err := d.Float64(fd)
+			if err != nil {
+				return err
+			}
+			x.DOUBLE = v
+		case 15:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.STRING = v
+		case 16:
+			v, err := d.Bytes(fd)
+			if err != nil {
+				return err
+			}
+			x.BYTES = v
+		case 17:
+			v := new(B)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.MESSAGE = v
+		case 18:
+			if x.MAP == nil {
+				x.MAP = make(map[string]*B)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.MAP[key]; ok {
+					return d.DuplicateMapKey()
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				v := new(B)
+				if err := d.Message(v); err != nil {
+					return err
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.MAP[key] = v
+				return nil
+			})
+		case 19:
+			return d.List(func() error {
+				v := new(B)
+				if err := d.Message(v); err != nil {
+					return err
 				}
-				x.X = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				x.LIST = append(x.LIST, v)
+				return nil
+			})
+		case 20:
+			v := new(B)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.ONEOF = &A_ONEOF_B{ONEOF_B: v}
+		case 21:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.ONEOF = &A_ONEOF_STRING{ONEOF_STRING: v}
+		case 22:
+			return d.List(func() error {
+				v, ok, err := d.Enum(fd)
 				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					return err
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if ok {
+					e := Enumeration(v)
+					x.LIST_ENUM = append(x.LIST_ENUM, e)
 				}
-				iNdEx += skippy
+				return nil
+			})
+		case 23:
+			v := new(ImportedMessage)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Imported = v
+		case 24:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
 			}
+			x.Type_ = v
 		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *B) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *B) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.X != "" {
+		b = append(b, "\"x\":"...)
+		if b, err = runtime.AppendJSONString(b, x.X, "B.x"); err != nil {
+			return b, err
 		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		b = append(b, ',')
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
 	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *B) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *B) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.X = v
+		}
+		return nil
+	})
 }
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
@@ -2694,6 +4464,7 @@ type A struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+	zeroCopied    bool
 
 	Enum        Enumeration   `protobuf:"varint,1,opt,name=enum,proto3,enum=Enumeration" json:"enum,omitempty"`
 	SomeBoolean bool          `protobuf:"varint,2,opt,name=some_boolean,json=someBoolean,proto3" json:"some_boolean,omitempty"`
@@ -3081,6 +4852,8 @@ func file_testpb_1_proto_init() {
 				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
+			case 3:
+				return &v.zeroCopied
 			default:
 				return nil
 			}
@@ -0,0 +1,5056 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package testpb
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	interfaceregistry "github.com/cosmos/cosmos-proto/runtime/interfaceregistry"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	maps "maps"
+	math "math"
+	reflect "reflect"
+	slices "slices"
+	sort "sort"
+	strconv "strconv"
+	sync "sync"
+	atomic "sync/atomic"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
+)
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *AminoTx) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *AminoTx) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.A != nil {
+		b = append(b, "\"a\":"...)
+		if b, err = x.A.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Any != nil {
+		b = append(b, "\"any\":"...)
+		if b, err = runtime.AppendAminoJSONAny(b, x.Any, ""); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.Labels) > 0 {
+		b = append(b, "\"labels\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.Labels) {
+			v := x.Labels[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = runtime.AppendAminoJSONString(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if x.Memo != "" {
+		b = append(b, "\"memo\":"...)
+		b = runtime.AppendAminoJSONString(b, x.Memo)
+		b = append(b, ',')
+	}
+	if len(x.Msgs) > 0 {
+		b = append(b, "\"msgs\":"...)
+		b = append(b, '[')
+		for _, v := range x.Msgs {
+			if b, err = runtime.AppendAminoJSONAny(b, v, "AminoMsg"); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if x.Period != nil {
+		b = append(b, "\"period\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.Period); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Timeout != nil {
+		b = append(b, "\"timeout\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.Timeout); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.TimeoutHeight != 0 {
+		b = append(b, "\"timeout_height\":"...)
+		b = runtime.AppendJSONUint64(b, x.TimeoutHeight)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *AminoSend) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *AminoSend) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if len(x.Amount) > 0 {
+		b = append(b, "\"amount\":"...)
+		b = append(b, '[')
+		for _, v := range x.Amount {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if x.FromAddress != "" {
+		b = append(b, "\"from_address\":"...)
+		b = runtime.AppendAminoJSONString(b, x.FromAddress)
+		b = append(b, ',')
+	}
+	if x.ToAddress != "" {
+		b = append(b, "\"to_address\":"...)
+		b = runtime.AppendAminoJSONString(b, x.ToAddress)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *AminoCoin) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *AminoCoin) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.Amount != "" {
+		b = append(b, "\"amount\":"...)
+		b = runtime.AppendAminoJSONString(b, x.Amount)
+		b = append(b, ',')
+	}
+	if x.Denom != "" {
+		b = append(b, "\"denom\":"...)
+		b = runtime.AppendAminoJSONString(b, x.Denom)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *AminoVote) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *AminoVote) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.Option != 0 {
+		b = append(b, "\"option\":"...)
+		b = strconv.AppendInt(b, int64(x.Option), 10)
+		b = append(b, ',')
+	}
+	if x.ProposalId != 0 {
+		b = append(b, "\"proposal_id\":"...)
+		b = runtime.AppendJSONUint64(b, x.ProposalId)
+		b = append(b, ',')
+	}
+	if x.Voter != "" {
+		b = append(b, "\"voter\":"...)
+		b = runtime.AppendAminoJSONString(b, x.Voter)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+var _ protoreflect.List = (*_AminoTx_1_list)(nil)
+
+type _AminoTx_1_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_AminoTx_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AminoTx_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_AminoTx_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AminoTx_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AminoTx_1_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AminoTx_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AminoTx_1_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AminoTx_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.Map = (*_AminoTx_8_map)(nil)
+
+type _AminoTx_8_map struct {
+	m *map[int32]string
+}
+
+func (x *_AminoTx_8_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_AminoTx_8_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfInt32(k))
+		mapValue := protoreflect.ValueOfString(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_AminoTx_8_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.Int()
+	concreteValue := (int32)(keyUnwrapped)
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_AminoTx_8_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := (int32)(keyUnwrapped)
+	delete(*x.m, concreteKey)
+}
+
+func (x *_AminoTx_8_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := (int32)(keyUnwrapped)
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AminoTx_8_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := (int32)(keyUnwrapped)
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_AminoTx_8_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_AminoTx_8_map) NewValue() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AminoTx_8_map) IsValid() bool {
+	return x.m != nil
+}
+
+var (
+	md_AminoTx                protoreflect.MessageDescriptor
+	fd_AminoTx_msgs           protoreflect.FieldDescriptor
+	fd_AminoTx_memo           protoreflect.FieldDescriptor
+	fd_AminoTx_timeout_height protoreflect.FieldDescriptor
+	fd_AminoTx_timeout        protoreflect.FieldDescriptor
+	fd_AminoTx_period         protoreflect.FieldDescriptor
+	fd_AminoTx_any            protoreflect.FieldDescriptor
+	fd_AminoTx_a              protoreflect.FieldDescriptor
+	fd_AminoTx_labels         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_testpb_amino_proto_init()
+	md_AminoTx = File_testpb_amino_proto.Messages().ByName("AminoTx")
+	fd_AminoTx_msgs = md_AminoTx.Fields().ByName("msgs")
+	fd_AminoTx_memo = md_AminoTx.Fields().ByName("memo")
+	fd_AminoTx_timeout_height = md_AminoTx.Fields().ByName("timeout_height")
+	fd_AminoTx_timeout = md_AminoTx.Fields().ByName("timeout")
+	fd_AminoTx_period = md_AminoTx.Fields().ByName("period")
+	fd_AminoTx_any = md_AminoTx.Fields().ByName("any")
+	fd_AminoTx_a = md_AminoTx.Fields().ByName("a")
+	fd_AminoTx_labels = md_AminoTx.Fields().ByName("labels")
+}
+
+var _ protoreflect.Message = (*fastReflection_AminoTx)(nil)
+
+type fastReflection_AminoTx AminoTx
+
+func (x *AminoTx) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AminoTx)(x)
+}
+
+func (x *AminoTx) slowProtoReflect() protoreflect.Message {
+	mi := &file_testpb_amino_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AminoTx_messageType fastReflection_AminoTx_messageType
+var _ protoreflect.MessageType = fastReflection_AminoTx_messageType{}
+
+type fastReflection_AminoTx_messageType struct{}
+
+func (x fastReflection_AminoTx_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AminoTx)(nil)
+}
+func (x fastReflection_AminoTx_messageType) New() protoreflect.Message {
+	return new(fastReflection_AminoTx)
+}
+func (x fastReflection_AminoTx_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AminoTx
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AminoTx) Descriptor() protoreflect.MessageDescriptor {
+	return md_AminoTx
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AminoTx) Type() protoreflect.MessageType {
+	return _fastReflection_AminoTx_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AminoTx) New() protoreflect.Message {
+	return new(fastReflection_AminoTx)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AminoTx) Interface() protoreflect.ProtoMessage {
+	return (*AminoTx)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AminoTx) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Msgs) != 0 {
+		value := protoreflect.ValueOfList(&_AminoTx_1_list{list: &x.Msgs})
+		if !f(fd_AminoTx_msgs, value) {
+			return
+		}
+	}
+	if x.Memo != "" {
+		value := protoreflect.ValueOfString(x.Memo)
+		if !f(fd_AminoTx_memo, value) {
+			return
+		}
+	}
+	if x.TimeoutHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutHeight)
+		if !f(fd_AminoTx_timeout_height, value) {
+			return
+		}
+	}
+	if x.Timeout != nil {
+		value := protoreflect.ValueOfMessage(x.Timeout.ProtoReflect())
+		if !f(fd_AminoTx_timeout, value) {
+			return
+		}
+	}
+	if x.Period != nil {
+		value := protoreflect.ValueOfMessage(x.Period.ProtoReflect())
+		if !f(fd_AminoTx_period, value) {
+			return
+		}
+	}
+	if x.Any != nil {
+		value := protoreflect.ValueOfMessage(x.Any.ProtoReflect())
+		if !f(fd_AminoTx_any, value) {
+			return
+		}
+	}
+	if x.A != nil {
+		value := protoreflect.ValueOfMessage(x.A.ProtoReflect())
+		if !f(fd_AminoTx_a, value) {
+			return
+		}
+	}
+	if len(x.Labels) != 0 {
+		value := protoreflect.ValueOfMap(&_AminoTx_8_map{m: &x.Labels})
+		if !f(fd_AminoTx_labels, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AminoTx) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // AminoTx.msgs
+		if fd.FullName() != "AminoTx.msgs" {
+			break
+		}
+		return len(x.Msgs) != 0
+	case 2: // AminoTx.memo
+		if fd.FullName() != "AminoTx.memo" {
+			break
+		}
+		return x.Memo != ""
+	case 3: // AminoTx.timeout_height
+		if fd != fd_AminoTx_timeout_height {
+			break
+		}
+		return x.TimeoutHeight != uint64(0)
+	case 4: // AminoTx.timeout
+		if fd.FullName() != "AminoTx.timeout" {
+			break
+		}
+		return x.Timeout != nil
+	case 5: // AminoTx.period
+		if fd.FullName() != "AminoTx.period" {
+			break
+		}
+		return x.Period != nil
+	case 6: // AminoTx.any
+		if fd.FullName() != "AminoTx.any" {
+			break
+		}
+		return x.Any != nil
+	case 7: // AminoTx.a
+		if fd.FullName() != "AminoTx.a" {
+			break
+		}
+		return x.A != nil
+	case 8: // AminoTx.labels
+		if fd.FullName() != "AminoTx.labels" {
+			break
+		}
+		return len(x.Labels) != 0
+	}
+	if fd := runtime.FieldOf(fd, md_AminoTx); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoTx does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoTx does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AminoTx) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // AminoTx.msgs
+		if fd.FullName() != "AminoTx.msgs" {
+			break
+		}
+		x.Msgs = nil
+		return
+	case 2: // AminoTx.memo
+		if fd.FullName() != "AminoTx.memo" {
+			break
+		}
+		x.Memo = ""
+		return
+	case 3: // AminoTx.timeout_height
+		if fd != fd_AminoTx_timeout_height {
+			break
+		}
+		x.TimeoutHeight = uint64(0)
+		return
+	case 4: // AminoTx.timeout
+		if fd.FullName() != "AminoTx.timeout" {
+			break
+		}
+		x.Timeout = nil
+		return
+	case 5: // AminoTx.period
+		if fd.FullName() != "AminoTx.period" {
+			break
+		}
+		x.Period = nil
+		return
+	case 6: // AminoTx.any
+		if fd.FullName() != "AminoTx.any" {
+			break
+		}
+		x.Any = nil
+		return
+	case 7: // AminoTx.a
+		if fd.FullName() != "AminoTx.a" {
+			break
+		}
+		x.A = nil
+		return
+	case 8: // AminoTx.labels
+		if fd.FullName() != "AminoTx.labels" {
+			break
+		}
+		x.Labels = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_AminoTx); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoTx does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoTx does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AminoTx) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // AminoTx.msgs
+		if descriptor.FullName() != "AminoTx.msgs" {
+			break
+		}
+		if len(x.Msgs) == 0 {
+			return protoreflect.ValueOfList(&_AminoTx_1_list{})
+		}
+		listValue := &_AminoTx_1_list{list: &x.Msgs}
+		return protoreflect.ValueOfList(listValue)
+	case 2: // AminoTx.memo
+		if descriptor.FullName() != "AminoTx.memo" {
+			break
+		}
+		value := x.Memo
+		return protoreflect.ValueOfString(value)
+	case 3: // AminoTx.timeout_height
+		if descriptor != fd_AminoTx_timeout_height {
+			break
+		}
+		value := x.TimeoutHeight
+		return protoreflect.ValueOfUint64(value)
+	case 4: // AminoTx.timeout
+		if descriptor.FullName() != "AminoTx.timeout" {
+			break
+		}
+		value := x.Timeout
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 5: // AminoTx.period
+		if descriptor.FullName() != "AminoTx.period" {
+			break
+		}
+		value := x.Period
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 6: // AminoTx.any
+		if descriptor.FullName() != "AminoTx.any" {
+			break
+		}
+		value := x.Any
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 7: // AminoTx.a
+		if descriptor.FullName() != "AminoTx.a" {
+			break
+		}
+		value := x.A
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 8: // AminoTx.labels
+		if descriptor.FullName() != "AminoTx.labels" {
+			break
+		}
+		if len(x.Labels) == 0 {
+			return protoreflect.ValueOfMap(&_AminoTx_8_map{})
+		}
+		mapValue := &_AminoTx_8_map{m: &x.Labels}
+		return protoreflect.ValueOfMap(mapValue)
+	}
+	if fd := runtime.FieldOf(descriptor, md_AminoTx); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message AminoTx does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message AminoTx does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AminoTx) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // AminoTx.msgs
+		if fd.FullName() != "AminoTx.msgs" {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_AminoTx_1_list)
+		x.Msgs = *clv.list
+		return
+	case 2: // AminoTx.memo
+		if fd.FullName() != "AminoTx.memo" {
+			break
+		}
+		x.Memo = value.Interface().(string)
+		return
+	case 3: // AminoTx.timeout_height
+		if fd != fd_AminoTx_timeout_height {
+			break
+		}
+		x.TimeoutHeight = value.Uint()
+		return
+	case 4: // AminoTx.timeout
+		if fd.FullName() != "AminoTx.timeout" {
+			break
+		}
+		x.Timeout = value.Message().Interface().(*timestamppb.Timestamp)
+		return
+	case 5: // AminoTx.period
+		if fd.FullName() != "AminoTx.period" {
+			break
+		}
+		x.Period = value.Message().Interface().(*durationpb.Duration)
+		return
+	case 6: // AminoTx.any
+		if fd.FullName() != "AminoTx.any" {
+			break
+		}
+		x.Any = value.Message().Interface().(*anypb.Any)
+		return
+	case 7: // AminoTx.a
+		if fd.FullName() != "AminoTx.a" {
+			break
+		}
+		x.A = value.Message().Interface().(*A)
+		return
+	case 8: // AminoTx.labels
+		if fd.FullName() != "AminoTx.labels" {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_AminoTx_8_map)
+		x.Labels = *cmv.m
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_AminoTx); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoTx does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoTx does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AminoTx) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // AminoTx.msgs
+		if fd.FullName() != "AminoTx.msgs" {
+			break
+		}
+		if x.Msgs == nil {
+			x.Msgs = []*anypb.Any{}
+		}
+		value := &_AminoTx_1_list{list: &x.Msgs}
+		return protoreflect.ValueOfList(value)
+	case 4: // AminoTx.timeout
+		if fd.FullName() != "AminoTx.timeout" {
+			break
+		}
+		if x.Timeout == nil {
+			x.Timeout = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Timeout.ProtoReflect())
+	case 5: // AminoTx.period
+		if fd.FullName() != "AminoTx.period" {
+			break
+		}
+		if x.Period == nil {
+			x.Period = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Period.ProtoReflect())
+	case 6: // AminoTx.any
+		if fd.FullName() != "AminoTx.any" {
+			break
+		}
+		if x.Any == nil {
+			x.Any = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Any.ProtoReflect())
+	case 7: // AminoTx.a
+		if fd.FullName() != "AminoTx.a" {
+			break
+		}
+		if x.A == nil {
+			x.A = new(A)
+		}
+		return protoreflect.ValueOfMessage(x.A.ProtoReflect())
+	case 8: // AminoTx.labels
+		if fd.FullName() != "AminoTx.labels" {
+			break
+		}
+		if x.Labels == nil {
+			x.Labels = make(map[int32]string)
+		}
+		value := &_AminoTx_8_map{m: &x.Labels}
+		return protoreflect.ValueOfMap(value)
+	case 2: // AminoTx.memo
+		if fd.FullName() != "AminoTx.memo" {
+			break
+		}
+		panic(fmt.Errorf("field memo of message AminoTx is not mutable"))
+	case 3: // AminoTx.timeout_height
+		if fd != fd_AminoTx_timeout_height {
+			break
+		}
+		panic(fmt.Errorf("field timeout_height of message AminoTx is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_AminoTx); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoTx does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoTx does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AminoTx) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // AminoTx.msgs
+		if fd.FullName() != "AminoTx.msgs" {
+			break
+		}
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_AminoTx_1_list{list: &list})
+	case 2: // AminoTx.memo
+		if fd.FullName() != "AminoTx.memo" {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 3: // AminoTx.timeout_height
+		if fd != fd_AminoTx_timeout_height {
+			break
+		}
+		return protoreflect.ValueOfUint64(uint64(0))
+	case 4: // AminoTx.timeout
+		if fd.FullName() != "AminoTx.timeout" {
+			break
+		}
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 5: // AminoTx.period
+		if fd.FullName() != "AminoTx.period" {
+			break
+		}
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 6: // AminoTx.any
+		if fd.FullName() != "AminoTx.any" {
+			break
+		}
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 7: // AminoTx.a
+		if fd.FullName() != "AminoTx.a" {
+			break
+		}
+		m := new(A)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 8: // AminoTx.labels
+		if fd.FullName() != "AminoTx.labels" {
+			break
+		}
+		m := make(map[int32]string)
+		return protoreflect.ValueOfMap(&_AminoTx_8_map{m: &m})
+	}
+	if fd := runtime.FieldOf(fd, md_AminoTx); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoTx does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoTx does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AminoTx) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in AminoTx", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AminoTx) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AminoTx) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AminoTx) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AminoTx) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_AminoTx_methods
+}
+
+var _fastReflection_AminoTx_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_AminoTx_size,
+	Marshal:           _fastReflection_AminoTx_marshal,
+	Unmarshal:         _fastReflection_AminoTx_unmarshal,
+	Merge:             _fastReflection_AminoTx_merge,
+	CheckInitialized:  _fastReflection_AminoTx_checkInitialized,
+	Equal:             _fastReflection_AminoTx_equal,
+}
+
+func _fastReflection_AminoTx_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*AminoTx)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if len(x.Msgs) > 0 {
+		for _, e := range x.Msgs {
+			l = options.Size(e)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	l = len(x.Memo)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.TimeoutHeight != 0 {
+		n += 1 + runtime.Sov(uint64(x.TimeoutHeight))
+	}
+	if x.Timeout != nil {
+		l = options.Size(x.Timeout)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.Period != nil {
+		l = options.Size(x.Period)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.Any != nil {
+		l = options.Size(x.Any)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.A != nil {
+		l = options.Size(x.A)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.Labels) > 0 {
+		SiZeMaP := func(k int32, v string) {
+			mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+			n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]int32, 0, len(x.Labels))
+			for k := range x.Labels {
+				sortme = append(sortme, k)
+			}
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.Labels[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.Labels {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_AminoTx_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*AminoTx)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if len(x.Labels) > 0 {
+		MaRsHaLmAp := func(k int32, v string) (protoiface.MarshalOutput, error) {
+			baseI := i
+			if !utf8.ValidString(v) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = runtime.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForLabels := make([]int32, 0, len(x.Labels))
+			for k := range x.Labels {
+				keysForLabels = append(keysForLabels, int32(k))
+			}
+			sort.Slice(keysForLabels, func(i, j int) bool {
+				return keysForLabels[i] < keysForLabels[j]
+			})
+			for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.Labels[int32(keysForLabels[iNdEx])]
+				out, err := MaRsHaLmAp(keysForLabels[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.Labels {
+				v := x.Labels[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if x.A != nil {
+		l = options.Size(x.A)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.A); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if x.Any != nil {
+		l = options.Size(x.Any)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Any); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x32
+	}
+	if x.Period != nil {
+		l = options.Size(x.Period)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Period); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if x.Timeout != nil {
+		l = options.Size(x.Timeout)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Timeout); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x22
+	}
+	if x.TimeoutHeight != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(x.Memo) > 0 {
+		if !utf8.ValidString(x.Memo) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.Memo)
+		copy(dAtA[i:], x.Memo)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Memo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(x.Msgs) > 0 {
+		for iNdEx := len(x.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			l = options.Size(x.Msgs[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.Msgs[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_AminoTx_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*AminoTx)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_AminoTx.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AminoTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AminoTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			if err := budget.List(fd_AminoTx_msgs, len(x.Msgs), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Message(fd_AminoTx_msgs, int(unsafe.Sizeof(anypb.Any{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.Msgs = append(x.Msgs, &anypb.Any{})
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msgs[len(x.Msgs)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_AminoTx_msgs, len(x.Msgs)-1)
+			}
+			if err := runtime.CheckUnknownAny(options, x.Msgs[len(x.Msgs)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_AminoTx_memo, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.Memo = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			x.TimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.TimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Timeout == nil {
+				if err := budget.Message(fd_AminoTx_timeout, int(unsafe.Sizeof(timestamppb.Timestamp{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Timeout = &timestamppb.Timestamp{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Timeout); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_AminoTx_timeout, -1)
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Period == nil {
+				if err := budget.Message(fd_AminoTx_period, int(unsafe.Sizeof(durationpb.Duration{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Period = &durationpb.Duration{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Period); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_AminoTx_period, -1)
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Any", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Any == nil {
+				if err := budget.Message(fd_AminoTx_any, int(unsafe.Sizeof(anypb.Any{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Any = &anypb.Any{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Any); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_AminoTx_any, -1)
+			}
+			if err := runtime.CheckUnknownAny(options, x.Any); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.A == nil {
+				if err := budget.Message(fd_AminoTx_a, int(unsafe.Sizeof(A{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.A = &A{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.A); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_AminoTx_a, -1)
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Labels == nil {
+				x.Labels = make(map[int32]string)
+			}
+			var mapkey int32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_AminoTx_labels, intStringLenmapvalue); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapvalue]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapvalue = runtime.String(dAtA[iNdEx:postStringIndexmapvalue], zeroCopy)
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if budget != nil {
+				if _, ok := x.Labels[mapkey]; !ok {
+					if err := budget.MapEntry(fd_AminoTx_labels, len(x.Labels), 20); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_AminoTx, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_AminoTx_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*AminoTx)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*AminoTx)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	for _, v := range src.Msgs {
+		m := &anypb.Any{}
+		proto.Merge(m, v)
+		dst.Msgs = append(dst.Msgs, m)
+	}
+	if src.Memo != "" {
+		dst.Memo = src.Memo
+	}
+	if src.TimeoutHeight != uint64(0) {
+		dst.TimeoutHeight = src.TimeoutHeight
+	}
+	if src.Timeout != nil {
+		if dst.Timeout == nil {
+			dst.Timeout = &timestamppb.Timestamp{}
+		}
+		proto.Merge(dst.Timeout, src.Timeout)
+	}
+	if src.Period != nil {
+		if dst.Period == nil {
+			dst.Period = &durationpb.Duration{}
+		}
+		proto.Merge(dst.Period, src.Period)
+	}
+	if src.Any != nil {
+		if dst.Any == nil {
+			dst.Any = &anypb.Any{}
+		}
+		proto.Merge(dst.Any, src.Any)
+	}
+	if src.A != nil {
+		if dst.A == nil {
+			dst.A = &A{}
+		}
+		proto.Merge(dst.A, src.A)
+	}
+	if len(src.Labels) != 0 {
+		if dst.Labels == nil {
+			dst.Labels = make(map[int32]string, len(src.Labels))
+		}
+		for k, v := range src.Labels {
+			dst.Labels[k] = v
+		}
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_AminoTx_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_AminoTx_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*AminoTx)
+	y, yok := input.MessageB.Interface().(*AminoTx)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.Msgs) != len(y.Msgs) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.Msgs {
+		if !proto.Equal(v, y.Msgs[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if x.Memo != y.Memo {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.TimeoutHeight != y.TimeoutHeight {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.Timeout, y.Timeout) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.Period, y.Period) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.Any, y.Any) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.A.Equal(y.A) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.Labels) != len(y.Labels) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.Labels {
+		if w, ok := y.Labels[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *AminoTx) Clone() *AminoTx {
+	if x == nil {
+		return nil
+	}
+	dst := new(AminoTx)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *AminoTx) CopyInto(dst *AminoTx) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	if x.Msgs != nil {
+		dst.Msgs = make([]*anypb.Any, len(x.Msgs))
+		for i, v := range x.Msgs {
+			dst.Msgs[i] = proto.Clone(v).(*anypb.Any)
+		}
+	} else {
+		dst.Msgs = nil
+	}
+	dst.Memo = x.Memo
+	dst.TimeoutHeight = x.TimeoutHeight
+	dst.Timeout = proto.Clone(x.Timeout).(*timestamppb.Timestamp)
+	dst.Period = proto.Clone(x.Period).(*durationpb.Duration)
+	dst.Any = proto.Clone(x.Any).(*anypb.Any)
+	dst.A = x.A.Clone()
+	dst.Labels = maps.Clone(x.Labels)
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *AminoTx) Equal(y *AminoTx) bool {
+	return _fastReflection_AminoTx_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a AminoTx, or nil if b is canonical.
+func (*AminoTx) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_AminoTx.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Message("msgs", true, runtime.CanonicalValidatorOf(fd_AminoTx_msgs.Message()))
+		case 2:
+			w.Scalar("memo", protoreflect.StringKind, true)
+		case 3:
+			w.Scalar("timeout_height", protoreflect.Uint64Kind, true)
+		case 4:
+			w.Message("timeout", false, runtime.CanonicalValidatorOf(fd_AminoTx_timeout.Message()))
+		case 5:
+			w.Message("period", false, runtime.CanonicalValidatorOf(fd_AminoTx_period.Message()))
+		case 6:
+			w.Message("any", false, runtime.CanonicalValidatorOf(fd_AminoTx_any.Message()))
+		case 7:
+			w.Message("a", false, (*A)(nil))
+		case 8:
+			w.Map("labels", protoreflect.Int32Kind, protoreflect.StringKind, nil)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var _ protoreflect.List = (*_AminoSend_3_list)(nil)
+
+type _AminoSend_3_list struct {
+	list *[]*AminoCoin
+}
+
+func (x *_AminoSend_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AminoSend_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_AminoSend_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AminoCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AminoSend_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AminoCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AminoSend_3_list) AppendMutable() protoreflect.Value {
+	v := new(AminoCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AminoSend_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AminoSend_3_list) NewElement() protoreflect.Value {
+	v := new(AminoCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AminoSend_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_AminoSend              protoreflect.MessageDescriptor
+	fd_AminoSend_from_address protoreflect.FieldDescriptor
+	fd_AminoSend_to_address   protoreflect.FieldDescriptor
+	fd_AminoSend_amount       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_testpb_amino_proto_init()
+	md_AminoSend = File_testpb_amino_proto.Messages().ByName("AminoSend")
+	fd_AminoSend_from_address = md_AminoSend.Fields().ByName("from_address")
+	fd_AminoSend_to_address = md_AminoSend.Fields().ByName("to_address")
+	fd_AminoSend_amount = md_AminoSend.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_AminoSend)(nil)
+
+type fastReflection_AminoSend AminoSend
+
+func (x *AminoSend) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AminoSend)(x)
+}
+
+func (x *AminoSend) slowProtoReflect() protoreflect.Message {
+	mi := &file_testpb_amino_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AminoSend_messageType fastReflection_AminoSend_messageType
+var _ protoreflect.MessageType = fastReflection_AminoSend_messageType{}
+
+type fastReflection_AminoSend_messageType struct{}
+
+func (x fastReflection_AminoSend_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AminoSend)(nil)
+}
+func (x fastReflection_AminoSend_messageType) New() protoreflect.Message {
+	return new(fastReflection_AminoSend)
+}
+func (x fastReflection_AminoSend_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AminoSend
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AminoSend) Descriptor() protoreflect.MessageDescriptor {
+	return md_AminoSend
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AminoSend) Type() protoreflect.MessageType {
+	return _fastReflection_AminoSend_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AminoSend) New() protoreflect.Message {
+	return new(fastReflection_AminoSend)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AminoSend) Interface() protoreflect.ProtoMessage {
+	return (*AminoSend)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AminoSend) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FromAddress != "" {
+		value := protoreflect.ValueOfString(x.FromAddress)
+		if !f(fd_AminoSend_from_address, value) {
+			return
+		}
+	}
+	if x.ToAddress != "" {
+		value := protoreflect.ValueOfString(x.ToAddress)
+		if !f(fd_AminoSend_to_address, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_AminoSend_3_list{list: &x.Amount})
+		if !f(fd_AminoSend_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AminoSend) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // AminoSend.from_address
+		if fd != fd_AminoSend_from_address {
+			break
+		}
+		return x.FromAddress != ""
+	case 2: // AminoSend.to_address
+		if fd != fd_AminoSend_to_address {
+			break
+		}
+		return x.ToAddress != ""
+	case 3: // AminoSend.amount
+		if fd.FullName() != "AminoSend.amount" {
+			break
+		}
+		return len(x.Amount) != 0
+	}
+	if fd := runtime.FieldOf(fd, md_AminoSend); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoSend does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoSend does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AminoSend) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // AminoSend.from_address
+		if fd != fd_AminoSend_from_address {
+			break
+		}
+		x.FromAddress = ""
+		return
+	case 2: // AminoSend.to_address
+		if fd != fd_AminoSend_to_address {
+			break
+		}
+		x.ToAddress = ""
+		return
+	case 3: // AminoSend.amount
+		if fd.FullName() != "AminoSend.amount" {
+			break
+		}
+		x.Amount = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_AminoSend); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoSend does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoSend does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AminoSend) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // AminoSend.from_address
+		if descriptor != fd_AminoSend_from_address {
+			break
+		}
+		value := x.FromAddress
+		return protoreflect.ValueOfString(value)
+	case 2: // AminoSend.to_address
+		if descriptor != fd_AminoSend_to_address {
+			break
+		}
+		value := x.ToAddress
+		return protoreflect.ValueOfString(value)
+	case 3: // AminoSend.amount
+		if descriptor.FullName() != "AminoSend.amount" {
+			break
+		}
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_AminoSend_3_list{})
+		}
+		listValue := &_AminoSend_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	}
+	if fd := runtime.FieldOf(descriptor, md_AminoSend); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message AminoSend does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message AminoSend does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AminoSend) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // AminoSend.from_address
+		if fd != fd_AminoSend_from_address {
+			break
+		}
+		x.FromAddress = value.Interface().(string)
+		return
+	case 2: // AminoSend.to_address
+		if fd != fd_AminoSend_to_address {
+			break
+		}
+		x.ToAddress = value.Interface().(string)
+		return
+	case 3: // AminoSend.amount
+		if fd.FullName() != "AminoSend.amount" {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_AminoSend_3_list)
+		x.Amount = *clv.list
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_AminoSend); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoSend does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoSend does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AminoSend) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 3: // AminoSend.amount
+		if fd.FullName() != "AminoSend.amount" {
+			break
+		}
+		if x.Amount == nil {
+			x.Amount = []*AminoCoin{}
+		}
+		value := &_AminoSend_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case 1: // AminoSend.from_address
+		if fd != fd_AminoSend_from_address {
+			break
+		}
+		panic(fmt.Errorf("field from_address of message AminoSend is not mutable"))
+	case 2: // AminoSend.to_address
+		if fd != fd_AminoSend_to_address {
+			break
+		}
+		panic(fmt.Errorf("field to_address of message AminoSend is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_AminoSend); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoSend does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoSend does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AminoSend) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // AminoSend.from_address
+		if fd != fd_AminoSend_from_address {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 2: // AminoSend.to_address
+		if fd != fd_AminoSend_to_address {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 3: // AminoSend.amount
+		if fd.FullName() != "AminoSend.amount" {
+			break
+		}
+		list := []*AminoCoin{}
+		return protoreflect.ValueOfList(&_AminoSend_3_list{list: &list})
+	}
+	if fd := runtime.FieldOf(fd, md_AminoSend); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoSend does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoSend does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AminoSend) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in AminoSend", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AminoSend) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AminoSend) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AminoSend) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AminoSend) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_AminoSend_methods
+}
+
+var _fastReflection_AminoSend_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_AminoSend_size,
+	Marshal:           _fastReflection_AminoSend_marshal,
+	Unmarshal:         _fastReflection_AminoSend_unmarshal,
+	Merge:             _fastReflection_AminoSend_merge,
+	CheckInitialized:  _fastReflection_AminoSend_checkInitialized,
+	Equal:             _fastReflection_AminoSend_equal,
+}
+
+func _fastReflection_AminoSend_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*AminoSend)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	l = len(x.FromAddress)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	l = len(x.ToAddress)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.Amount) > 0 {
+		for _, e := range x.Amount {
+			l = options.Size(e)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_AminoSend_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*AminoSend)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if len(x.Amount) > 0 {
+		for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			l = options.Size(x.Amount[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.Amount[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(x.ToAddress) > 0 {
+		if !utf8.ValidString(x.ToAddress) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.ToAddress)
+		copy(dAtA[i:], x.ToAddress)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(x.FromAddress) > 0 {
+		if !utf8.ValidString(x.FromAddress) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.FromAddress)
+		copy(dAtA[i:], x.FromAddress)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_AminoSend_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*AminoSend)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_AminoSend.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AminoSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AminoSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_AminoSend_from_address, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.FromAddress = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_AminoSend_to_address, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.ToAddress = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			if err := budget.List(fd_AminoSend_amount, len(x.Amount), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Message(fd_AminoSend_amount, int(unsafe.Sizeof(AminoCoin{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.Amount = append(x.Amount, &AminoCoin{})
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_AminoSend_amount, len(x.Amount)-1)
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_AminoSend, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_AminoSend_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*AminoSend)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*AminoSend)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.FromAddress != "" {
+		dst.FromAddress = src.FromAddress
+	}
+	if src.ToAddress != "" {
+		dst.ToAddress = src.ToAddress
+	}
+	for _, v := range src.Amount {
+		m := &AminoCoin{}
+		proto.Merge(m, v)
+		dst.Amount = append(dst.Amount, m)
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_AminoSend_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_AminoSend_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*AminoSend)
+	y, yok := input.MessageB.Interface().(*AminoSend)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.FromAddress != y.FromAddress {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.ToAddress != y.ToAddress {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.Amount) != len(y.Amount) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.Amount {
+		if !v.Equal(y.Amount[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *AminoSend) Clone() *AminoSend {
+	if x == nil {
+		return nil
+	}
+	dst := new(AminoSend)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *AminoSend) CopyInto(dst *AminoSend) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.FromAddress = x.FromAddress
+	dst.ToAddress = x.ToAddress
+	if x.Amount != nil {
+		dst.Amount = make([]*AminoCoin, len(x.Amount))
+		for i, v := range x.Amount {
+			dst.Amount[i] = v.Clone()
+		}
+	} else {
+		dst.Amount = nil
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *AminoSend) Equal(y *AminoSend) bool {
+	return _fastReflection_AminoSend_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a AminoSend, or nil if b is canonical.
+func (*AminoSend) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_AminoSend.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("from_address", protoreflect.StringKind, true)
+		case 2:
+			w.Scalar("to_address", protoreflect.StringKind, true)
+		case 3:
+			w.Message("amount", true, (*AminoCoin)(nil))
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_AminoCoin        protoreflect.MessageDescriptor
+	fd_AminoCoin_denom  protoreflect.FieldDescriptor
+	fd_AminoCoin_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_testpb_amino_proto_init()
+	md_AminoCoin = File_testpb_amino_proto.Messages().ByName("AminoCoin")
+	fd_AminoCoin_denom = md_AminoCoin.Fields().ByName("denom")
+	fd_AminoCoin_amount = md_AminoCoin.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_AminoCoin)(nil)
+
+type fastReflection_AminoCoin AminoCoin
+
+func (x *AminoCoin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AminoCoin)(x)
+}
+
+func (x *AminoCoin) slowProtoReflect() protoreflect.Message {
+	mi := &file_testpb_amino_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AminoCoin_messageType fastReflection_AminoCoin_messageType
+var _ protoreflect.MessageType = fastReflection_AminoCoin_messageType{}
+
+type fastReflection_AminoCoin_messageType struct{}
+
+func (x fastReflection_AminoCoin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AminoCoin)(nil)
+}
+func (x fastReflection_AminoCoin_messageType) New() protoreflect.Message {
+	return new(fastReflection_AminoCoin)
+}
+func (x fastReflection_AminoCoin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AminoCoin
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AminoCoin) Descriptor() protoreflect.MessageDescriptor {
+	return md_AminoCoin
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AminoCoin) Type() protoreflect.MessageType {
+	return _fastReflection_AminoCoin_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AminoCoin) New() protoreflect.Message {
+	return new(fastReflection_AminoCoin)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AminoCoin) Interface() protoreflect.ProtoMessage {
+	return (*AminoCoin)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AminoCoin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_AminoCoin_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_AminoCoin_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AminoCoin) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // AminoCoin.denom
+		if fd.FullName() != "AminoCoin.denom" {
+			break
+		}
+		return x.Denom != ""
+	case 2: // AminoCoin.amount
+		if fd.FullName() != "AminoCoin.amount" {
+			break
+		}
+		return x.Amount != ""
+	}
+	if fd := runtime.FieldOf(fd, md_AminoCoin); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoCoin does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoCoin does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AminoCoin) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // AminoCoin.denom
+		if fd.FullName() != "AminoCoin.denom" {
+			break
+		}
+		x.Denom = ""
+		return
+	case 2: // AminoCoin.amount
+		if fd.FullName() != "AminoCoin.amount" {
+			break
+		}
+		x.Amount = ""
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_AminoCoin); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoCoin does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoCoin does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AminoCoin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // AminoCoin.denom
+		if descriptor.FullName() != "AminoCoin.denom" {
+			break
+		}
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case 2: // AminoCoin.amount
+		if descriptor.FullName() != "AminoCoin.amount" {
+			break
+		}
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	}
+	if fd := runtime.FieldOf(descriptor, md_AminoCoin); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message AminoCoin does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message AminoCoin does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AminoCoin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // AminoCoin.denom
+		if fd.FullName() != "AminoCoin.denom" {
+			break
+		}
+		x.Denom = value.Interface().(string)
+		return
+	case 2: // AminoCoin.amount
+		if fd.FullName() != "AminoCoin.amount" {
+			break
+		}
+		x.Amount = value.Interface().(string)
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_AminoCoin); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoCoin does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoCoin does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AminoCoin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // AminoCoin.denom
+		if fd.FullName() != "AminoCoin.denom" {
+			break
+		}
+		panic(fmt.Errorf("field denom of message AminoCoin is not mutable"))
+	case 2: // AminoCoin.amount
+		if fd.FullName() != "AminoCoin.amount" {
+			break
+		}
+		panic(fmt.Errorf("field amount of message AminoCoin is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_AminoCoin); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoCoin does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoCoin does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AminoCoin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // AminoCoin.denom
+		if fd.FullName() != "AminoCoin.denom" {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 2: // AminoCoin.amount
+		if fd.FullName() != "AminoCoin.amount" {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	}
+	if fd := runtime.FieldOf(fd, md_AminoCoin); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoCoin does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoCoin does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AminoCoin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in AminoCoin", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AminoCoin) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AminoCoin) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AminoCoin) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AminoCoin) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_AminoCoin_methods
+}
+
+var _fastReflection_AminoCoin_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_AminoCoin_size,
+	Marshal:           _fastReflection_AminoCoin_marshal,
+	Unmarshal:         _fastReflection_AminoCoin_unmarshal,
+	Merge:             _fastReflection_AminoCoin_merge,
+	CheckInitialized:  _fastReflection_AminoCoin_checkInitialized,
+	Equal:             _fastReflection_AminoCoin_equal,
+}
+
+func _fastReflection_AminoCoin_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*AminoCoin)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	l = len(x.Denom)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	l = len(x.Amount)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_AminoCoin_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*AminoCoin)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if len(x.Amount) > 0 {
+		if !utf8.ValidString(x.Amount) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.Amount)
+		copy(dAtA[i:], x.Amount)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(x.Denom) > 0 {
+		if !utf8.ValidString(x.Denom) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.Denom)
+		copy(dAtA[i:], x.Denom)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_AminoCoin_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*AminoCoin)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_AminoCoin.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AminoCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AminoCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_AminoCoin_denom, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.Denom = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_AminoCoin_amount, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.Amount = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_AminoCoin, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_AminoCoin_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*AminoCoin)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*AminoCoin)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.Denom != "" {
+		dst.Denom = src.Denom
+	}
+	if src.Amount != "" {
+		dst.Amount = src.Amount
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_AminoCoin_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_AminoCoin_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*AminoCoin)
+	y, yok := input.MessageB.Interface().(*AminoCoin)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.Denom != y.Denom {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.Amount != y.Amount {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *AminoCoin) Clone() *AminoCoin {
+	if x == nil {
+		return nil
+	}
+	dst := new(AminoCoin)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *AminoCoin) CopyInto(dst *AminoCoin) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.Denom = x.Denom
+	dst.Amount = x.Amount
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *AminoCoin) Equal(y *AminoCoin) bool {
+	return _fastReflection_AminoCoin_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a AminoCoin, or nil if b is canonical.
+func (*AminoCoin) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_AminoCoin.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("denom", protoreflect.StringKind, true)
+		case 2:
+			w.Scalar("amount", protoreflect.StringKind, true)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_AminoVote             protoreflect.MessageDescriptor
+	fd_AminoVote_proposal_id protoreflect.FieldDescriptor
+	fd_AminoVote_voter       protoreflect.FieldDescriptor
+	fd_AminoVote_option      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_testpb_amino_proto_init()
+	md_AminoVote = File_testpb_amino_proto.Messages().ByName("AminoVote")
+	fd_AminoVote_proposal_id = md_AminoVote.Fields().ByName("proposal_id")
+	fd_AminoVote_voter = md_AminoVote.Fields().ByName("voter")
+	fd_AminoVote_option = md_AminoVote.Fields().ByName("option")
+}
+
+var _ protoreflect.Message = (*fastReflection_AminoVote)(nil)
+
+type fastReflection_AminoVote AminoVote
+
+func (x *AminoVote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AminoVote)(x)
+}
+
+func (x *AminoVote) slowProtoReflect() protoreflect.Message {
+	mi := &file_testpb_amino_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AminoVote_messageType fastReflection_AminoVote_messageType
+var _ protoreflect.MessageType = fastReflection_AminoVote_messageType{}
+
+type fastReflection_AminoVote_messageType struct{}
+
+func (x fastReflection_AminoVote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AminoVote)(nil)
+}
+func (x fastReflection_AminoVote_messageType) New() protoreflect.Message {
+	return new(fastReflection_AminoVote)
+}
+func (x fastReflection_AminoVote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AminoVote
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AminoVote) Descriptor() protoreflect.MessageDescriptor {
+	return md_AminoVote
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AminoVote) Type() protoreflect.MessageType {
+	return _fastReflection_AminoVote_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AminoVote) New() protoreflect.Message {
+	return new(fastReflection_AminoVote)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AminoVote) Interface() protoreflect.ProtoMessage {
+	return (*AminoVote)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AminoVote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_AminoVote_proposal_id, value) {
+			return
+		}
+	}
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_AminoVote_voter, value) {
+			return
+		}
+	}
+	if x.Option != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Option))
+		if !f(fd_AminoVote_option, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AminoVote) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // AminoVote.proposal_id
+		if fd != fd_AminoVote_proposal_id {
+			break
+		}
+		return x.ProposalId != uint64(0)
+	case 2: // AminoVote.voter
+		if fd.FullName() != "AminoVote.voter" {
+			break
+		}
+		return x.Voter != ""
+	case 3: // AminoVote.option
+		if fd.FullName() != "AminoVote.option" {
+			break
+		}
+		return x.Option != 0
+	}
+	if fd := runtime.FieldOf(fd, md_AminoVote); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoVote does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoVote does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AminoVote) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // AminoVote.proposal_id
+		if fd != fd_AminoVote_proposal_id {
+			break
+		}
+		x.ProposalId = uint64(0)
+		return
+	case 2: // AminoVote.voter
+		if fd.FullName() != "AminoVote.voter" {
+			break
+		}
+		x.Voter = ""
+		return
+	case 3: // AminoVote.option
+		if fd.FullName() != "AminoVote.option" {
+			break
+		}
+		x.Option = 0
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_AminoVote); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoVote does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoVote does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AminoVote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // AminoVote.proposal_id
+		if descriptor != fd_AminoVote_proposal_id {
+			break
+		}
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case 2: // AminoVote.voter
+		if descriptor.FullName() != "AminoVote.voter" {
+			break
+		}
+		value := x.Voter
+		return protoreflect.ValueOfString(value)
+	case 3: // AminoVote.option
+		if descriptor.FullName() != "AminoVote.option" {
+			break
+		}
+		value := x.Option
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	}
+	if fd := runtime.FieldOf(descriptor, md_AminoVote); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message AminoVote does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message AminoVote does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AminoVote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // AminoVote.proposal_id
+		if fd != fd_AminoVote_proposal_id {
+			break
+		}
+		x.ProposalId = value.Uint()
+		return
+	case 2: // AminoVote.voter
+		if fd.FullName() != "AminoVote.voter" {
+			break
+		}
+		x.Voter = value.Interface().(string)
+		return
+	case 3: // AminoVote.option
+		if fd.FullName() != "AminoVote.option" {
+			break
+		}
+		x.Option = (Enumeration)(value.Enum())
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_AminoVote); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoVote does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoVote does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AminoVote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // AminoVote.proposal_id
+		if fd != fd_AminoVote_proposal_id {
+			break
+		}
+		panic(fmt.Errorf("field proposal_id of message AminoVote is not mutable"))
+	case 2: // AminoVote.voter
+		if fd.FullName() != "AminoVote.voter" {
+			break
+		}
+		panic(fmt.Errorf("field voter of message AminoVote is not mutable"))
+	case 3: // AminoVote.option
+		if fd.FullName() != "AminoVote.option" {
+			break
+		}
+		panic(fmt.Errorf("field option of message AminoVote is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_AminoVote); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoVote does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoVote does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AminoVote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // AminoVote.proposal_id
+		if fd != fd_AminoVote_proposal_id {
+			break
+		}
+		return protoreflect.ValueOfUint64(uint64(0))
+	case 2: // AminoVote.voter
+		if fd.FullName() != "AminoVote.voter" {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 3: // AminoVote.option
+		if fd.FullName() != "AminoVote.option" {
+			break
+		}
+		return protoreflect.ValueOfEnum(0)
+	}
+	if fd := runtime.FieldOf(fd, md_AminoVote); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message AminoVote does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message AminoVote does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AminoVote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in AminoVote", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AminoVote) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AminoVote) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AminoVote) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AminoVote) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_AminoVote_methods
+}
+
+var _fastReflection_AminoVote_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_AminoVote_size,
+	Marshal:           _fastReflection_AminoVote_marshal,
+	Unmarshal:         _fastReflection_AminoVote_unmarshal,
+	Merge:             _fastReflection_AminoVote_merge,
+	CheckInitialized:  _fastReflection_AminoVote_checkInitialized,
+	Equal:             _fastReflection_AminoVote_equal,
+}
+
+func _fastReflection_AminoVote_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*AminoVote)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.ProposalId != 0 {
+		n += 1 + runtime.Sov(uint64(x.ProposalId))
+	}
+	l = len(x.Voter)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.Option != 0 {
+		n += 1 + runtime.Sov(uint64(x.Option))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_AminoVote_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*AminoVote)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.Option != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.Option))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(x.Voter) > 0 {
+		if !utf8.ValidString(x.Voter) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.Voter)
+		copy(dAtA[i:], x.Voter)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if x.ProposalId != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_AminoVote_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*AminoVote)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_AminoVote.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AminoVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AminoVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			x.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_AminoVote_voter, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.Voter = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			x.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.Option |= Enumeration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_AminoVote, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_AminoVote_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*AminoVote)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*AminoVote)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.ProposalId != uint64(0) {
+		dst.ProposalId = src.ProposalId
+	}
+	if src.Voter != "" {
+		dst.Voter = src.Voter
+	}
+	if src.Option != 0 {
+		dst.Option = src.Option
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_AminoVote_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_AminoVote_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*AminoVote)
+	y, yok := input.MessageB.Interface().(*AminoVote)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.ProposalId != y.ProposalId {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.Voter != y.Voter {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.Option != y.Option {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *AminoVote) Clone() *AminoVote {
+	if x == nil {
+		return nil
+	}
+	dst := new(AminoVote)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *AminoVote) CopyInto(dst *AminoVote) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.ProposalId = x.ProposalId
+	dst.Voter = x.Voter
+	dst.Option = x.Option
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *AminoVote) Equal(y *AminoVote) bool {
+	return _fastReflection_AminoVote_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a AminoVote, or nil if b is canonical.
+func (*AminoVote) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_AminoVote.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("proposal_id", protoreflect.Uint64Kind, true)
+		case 2:
+			w.Scalar("voter", protoreflect.StringKind, true)
+		case 3:
+			w.Scalar("option", protoreflect.EnumKind, true)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+// AminoMsgI is implemented by the messages declaring the AminoMsg interface
+// with the cosmos_proto.implements_interface option.
+//
+// AminoMsg is a message signed in Amino JSON.
+type AminoMsgI interface {
+	proto.Message
+	Implements_AminoMsg()
+}
+
+// GetMsgsCached returns the messages packed in msgs, nil elements included.
+// The messages are unpacked on the first call and cached until the field changes.
+func (x *AminoTx) GetMsgsCached() ([]AminoMsgI, error) {
+	if x == nil {
+		return nil, nil
+	}
+	ms, err := x.cachedMsgs.UnpackList(x.GetMsgs(), "AminoMsg")
+	if err != nil {
+		return nil, err
+	}
+	vs := make([]AminoMsgI, len(ms))
+	for i, m := range ms {
+		if m == nil {
+			continue
+		}
+		v, ok := m.(AminoMsgI)
+		if !ok {
+			return nil, runtime.ErrInterface(m.ProtoReflect().Descriptor().FullName(), "AminoMsg")
+		}
+		vs[i] = v
+	}
+	return vs, nil
+}
+
+// SetMsgs packs each of vs in msgs.
+func (x *AminoTx) SetMsgs(vs []AminoMsgI) error {
+	ms := make([]proto.Message, len(vs))
+	for i, v := range vs {
+		ms[i] = v
+	}
+	list, err := x.cachedMsgs.PackList(ms, "AminoMsg")
+	if err != nil {
+		return err
+	}
+	x.Msgs = list
+	return nil
+}
+
+// Implements_AminoMsg marks AminoSend as an implementation of the AminoMsg interface.
+func (*AminoSend) Implements_AminoMsg() {}
+
+// Implements_AminoMsg marks AminoVote as an implementation of the AminoMsg interface.
+func (*AminoVote) Implements_AminoMsg() {}
+
+func init() {
+	file_testpb_amino_proto_init()
+	if err := interfaceregistry.Global.Register((*AminoSend)(nil).ProtoReflect().Type(), "AminoMsg"); err != nil {
+		panic(err)
+	}
+	if err := interfaceregistry.Global.Register((*AminoVote)(nil).ProtoReflect().Type(), "AminoMsg"); err != nil {
+		panic(err)
+	}
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *AminoTx) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *AminoTx) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if len(x.Msgs) > 0 {
+		b = append(b, "\"msgs\":"...)
+		b = append(b, '[')
+		for _, v := range x.Msgs {
+			if b, err = runtime.AppendJSONMessage(b, v); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if x.Memo != "" {
+		b = append(b, "\"memo\":"...)
+		if b, err = runtime.AppendJSONString(b, x.Memo, "AminoTx.memo"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.TimeoutHeight != 0 {
+		b = append(b, "\"timeoutHeight\":"...)
+		b = runtime.AppendJSONUint64(b, x.TimeoutHeight)
+		b = append(b, ',')
+	}
+	if x.Timeout != nil {
+		b = append(b, "\"timeout\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.Timeout); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Period != nil {
+		b = append(b, "\"period\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.Period); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Any != nil {
+		b = append(b, "\"any\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.Any); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.A != nil {
+		b = append(b, "\"a\":"...)
+		if b, err = x.A.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.Labels) > 0 {
+		b = append(b, "\"labels\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.Labels)) {
+			v := x.Labels[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			if b, err = runtime.AppendJSONString(b, v, "AminoTx.LabelsEntry.value"); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *AminoTx) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *AminoTx) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			return d.List(func() error {
+				v := new(anypb.Any)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.Msgs = append(x.Msgs, v)
+				return nil
+			})
+		case 2:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.Memo = v
+		case 3:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.TimeoutHeight = v
+		case 4:
+			v := new(timestamppb.Timestamp)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Timeout = v
+		case 5:
+			v := new(durationpb.Duration)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Period = v
+		case 6:
+			v := new(anypb.Any)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Any = v
+		case 7:
+			v := new(A)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.A = v
+		case 8:
+			if x.Labels == nil {
+				x.Labels = make(map[int32]string)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := int32(k.Int())
+				if _, ok := x.Labels[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.String(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.Labels[key] = v
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *AminoSend) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *AminoSend) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.FromAddress != "" {
+		b = append(b, "\"fromAddress\":"...)
+		if b, err = runtime.AppendJSONString(b, x.FromAddress, "AminoSend.from_address"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.ToAddress != "" {
+		b = append(b, "\"toAddress\":"...)
+		if b, err = runtime.AppendJSONString(b, x.ToAddress, "AminoSend.to_address"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.Amount) > 0 {
+		b = append(b, "\"amount\":"...)
+		b = append(b, '[')
+		for _, v := range x.Amount {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *AminoSend) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *AminoSend) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.FromAddress = v
+		case 2:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.ToAddress = v
+		case 3:
+			return d.List(func() error {
+				v := new(AminoCoin)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.Amount = append(x.Amount, v)
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *AminoCoin) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *AminoCoin) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Denom != "" {
+		b = append(b, "\"denom\":"...)
+		if b, err = runtime.AppendJSONString(b, x.Denom, "AminoCoin.denom"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Amount != "" {
+		b = append(b, "\"amount\":"...)
+		if b, err = runtime.AppendJSONString(b, x.Amount, "AminoCoin.amount"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *AminoCoin) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *AminoCoin) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.Denom = v
+		case 2:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.Amount = v
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *AminoVote) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *AminoVote) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.ProposalId != 0 {
+		b = append(b, "\"proposalId\":"...)
+		b = runtime.AppendJSONUint64(b, x.ProposalId)
+		b = append(b, ',')
+	}
+	if x.Voter != "" {
+		b = append(b, "\"voter\":"...)
+		if b, err = runtime.AppendJSONString(b, x.Voter, "AminoVote.voter"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Option != 0 {
+		b = append(b, "\"option\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(x.Option), x.Option.Descriptor())
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *AminoVote) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *AminoVote) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.ProposalId = v
+		case 2:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.Voter = v
+		case 3:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := Enumeration(v)
+				x.Option = e
+			}
+		}
+		return nil
+	})
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.18.1
+// source: testpb/amino.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AminoTx holds the messages implementing the AminoMsg interface, which are encoded in
+// Amino JSON with the Amino name set by the amino.name option of their type.
+type AminoTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+	cachedMsgs    runtime.AnyCache
+
+	Msgs          []*anypb.Any           `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	Memo          string                 `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	TimeoutHeight uint64                 `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	Timeout       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Period        *durationpb.Duration   `protobuf:"bytes,5,opt,name=period,proto3" json:"period,omitempty"`
+	Any           *anypb.Any             `protobuf:"bytes,6,opt,name=any,proto3" json:"any,omitempty"`
+	A             *A                     `protobuf:"bytes,7,opt,name=a,proto3" json:"a,omitempty"`
+	Labels        map[int32]string       `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *AminoTx) Reset() {
+	*x = AminoTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_amino_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AminoTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AminoTx) ProtoMessage() {}
+
+// Deprecated: Use AminoTx.ProtoReflect.Descriptor instead.
+func (*AminoTx) Descriptor() ([]byte, []int) {
+	return file_testpb_amino_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AminoTx) GetMsgs() []*anypb.Any {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+func (x *AminoTx) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *AminoTx) GetTimeoutHeight() uint64 {
+	if x != nil {
+		return x.TimeoutHeight
+	}
+	return 0
+}
+
+func (x *AminoTx) GetTimeout() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *AminoTx) GetPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *AminoTx) GetAny() *anypb.Any {
+	if x != nil {
+		return x.Any
+	}
+	return nil
+}
+
+func (x *AminoTx) GetA() *A {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *AminoTx) GetLabels() map[int32]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type AminoSend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromAddress string       `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string       `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount      []*AminoCoin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *AminoSend) Reset() {
+	*x = AminoSend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_amino_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AminoSend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AminoSend) ProtoMessage() {}
+
+// Deprecated: Use AminoSend.ProtoReflect.Descriptor instead.
+func (*AminoSend) Descriptor() ([]byte, []int) {
+	return file_testpb_amino_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AminoSend) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+func (x *AminoSend) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *AminoSend) GetAmount() []*AminoCoin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type AminoCoin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *AminoCoin) Reset() {
+	*x = AminoCoin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_amino_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AminoCoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AminoCoin) ProtoMessage() {}
+
+// Deprecated: Use AminoCoin.ProtoReflect.Descriptor instead.
+func (*AminoCoin) Descriptor() ([]byte, []int) {
+	return file_testpb_amino_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AminoCoin) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *AminoCoin) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type AminoVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalId uint64      `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Voter      string      `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	Option     Enumeration `protobuf:"varint,3,opt,name=option,proto3,enum=Enumeration" json:"option,omitempty"`
+}
+
+func (x *AminoVote) Reset() {
+	*x = AminoVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_amino_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AminoVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AminoVote) ProtoMessage() {}
+
+// Deprecated: Use AminoVote.ProtoReflect.Descriptor instead.
+func (*AminoVote) Descriptor() ([]byte, []int) {
+	return file_testpb_amino_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AminoVote) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *AminoVote) GetVoter() string {
+	if x != nil {
+		return x.Voter
+	}
+	return ""
+}
+
+func (x *AminoVote) GetOption() Enumeration {
+	if x != nil {
+		return x.Option
+	}
+	return Enumeration_One
+}
+
+var File_testpb_amino_proto protoreflect.FileDescriptor
+
+var file_testpb_amino_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1a, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2f,
+	0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x03, 0x0a, 0x07, 0x41, 0x6d, 0x69, 0x6e,
+	0x6f, 0x54, 0x78, 0x12, 0x36, 0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x0c, 0xca, 0xb4, 0x2d, 0x08, 0x41, 0x6d, 0x69,
+	0x6e, 0x6f, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x65, 0x6d, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x31, 0x0a, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x26, 0x0a, 0x03, 0x61, 0x6e, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x6e, 0x79, 0x52, 0x03, 0x61, 0x6e, 0x79, 0x12, 0x10, 0x0a, 0x01, 0x61, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x02, 0x2e, 0x41, 0x52, 0x01, 0x61, 0x12, 0x2c, 0x0a, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x41, 0x6d, 0x69, 0x6e,
+	0x6f, 0x54, 0x78, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x94, 0x01, 0x0a, 0x09, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x53, 0x65, 0x6e, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x21, 0xca, 0xb4, 0x2d, 0x08, 0x41, 0x6d, 0x69, 0x6e,
+	0x6f, 0x4d, 0x73, 0x67, 0x8a, 0xe7, 0xb0, 0x2a, 0x10, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2f,
+	0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x22, 0x50, 0x0a, 0x09, 0x41, 0x6d, 0x69,
+	0x6e, 0x6f, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x15, 0x8a, 0xe7, 0xb0, 0x2a, 0x10, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x62, 0x2f, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x43, 0x6f, 0x69, 0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x09,
+	0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72,
+	0x12, 0x24, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0c, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x21, 0xca, 0xb4, 0x2d, 0x08, 0x41, 0x6d, 0x69, 0x6e,
+	0x6f, 0x4d, 0x73, 0x67, 0x8a, 0xe7, 0xb0, 0x2a, 0x10, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2f,
+	0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x63, 0xea, 0x9b, 0x83, 0x03, 0x37,
+	0x0a, 0x08, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x4d, 0x73, 0x67, 0x12, 0x2b, 0x41, 0x6d, 0x69, 0x6e,
+	0x6f, 0x4d, 0x73, 0x67, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x20, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x41, 0x6d, 0x69, 0x6e,
+	0x6f, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x2e, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_testpb_amino_proto_rawDescOnce sync.Once
+	file_testpb_amino_proto_rawDescData = file_testpb_amino_proto_rawDesc
+)
+
+func file_testpb_amino_proto_rawDescGZIP() []byte {
+	file_testpb_amino_proto_rawDescOnce.Do(func() {
+		file_testpb_amino_proto_rawDescData = protoimpl.X.CompressGZIP(file_testpb_amino_proto_rawDescData)
+	})
+	return file_testpb_amino_proto_rawDescData
+}
+
+var file_testpb_amino_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_testpb_amino_proto_goTypes = []interface{}{
+	(*AminoTx)(nil),               // 0: AminoTx
+	(*AminoSend)(nil),             // 1: AminoSend
+	(*AminoCoin)(nil),             // 2: AminoCoin
+	(*AminoVote)(nil),             // 3: AminoVote
+	nil,                           // 4: AminoTx.LabelsEntry
+	(*anypb.Any)(nil),             // 5: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 7: google.protobuf.Duration
+	(*A)(nil),                     // 8: A
+	(Enumeration)(0),              // 9: Enumeration
+}
+var file_testpb_amino_proto_depIdxs = []int32{
+	5, // 0: AminoTx.msgs:type_name -> google.protobuf.Any
+	6, // 1: AminoTx.timeout:type_name -> google.protobuf.Timestamp
+	7, // 2: AminoTx.period:type_name -> google.protobuf.Duration
+	5, // 3: AminoTx.any:type_name -> google.protobuf.Any
+	8, // 4: AminoTx.a:type_name -> A
+	4, // 5: AminoTx.labels:type_name -> AminoTx.LabelsEntry
+	2, // 6: AminoSend.amount:type_name -> AminoCoin
+	9, // 7: AminoVote.option:type_name -> Enumeration
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_testpb_amino_proto_init() }
+func file_testpb_amino_proto_init() {
+	if File_testpb_amino_proto != nil {
+		return
+	}
+	file_testpb_amino_options_proto_init()
+	file_testpb_1_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_testpb_amino_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AminoTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.cachedMsgs
+			default:
+				return nil
+			}
+		}
+		file_testpb_amino_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AminoSend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testpb_amino_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AminoCoin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testpb_amino_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AminoVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_testpb_amino_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_testpb_amino_proto_goTypes,
+		DependencyIndexes: file_testpb_amino_proto_depIdxs,
+		MessageInfos:      file_testpb_amino_proto_msgTypes,
+	}.Build()
+	File_testpb_amino_proto = out.File
+	file_testpb_amino_proto_rawDesc = nil
+	file_testpb_amino_proto_goTypes = nil
+	file_testpb_amino_proto_depIdxs = nil
+}
@@ -0,0 +1,96 @@
+package testpb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-proto/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAminoJSON(t *testing.T) {
+	tx := &AminoTx{
+		Msgs: []*anypb.Any{
+			mustAny(t, &AminoSend{FromAddress: "from", ToAddress: "to", Amount: []*AminoCoin{{Denom: "stake", Amount: "10"}, {Denom: "atom"}}}),
+			mustAny(t, &AminoVote{ProposalId: 1, Option: Enumeration_Two}),
+			mustAny(t, &AminoVote{}),
+		},
+		Memo:          "<memo>",
+		TimeoutHeight: 100,
+		Timeout:       timestamppb.New(time.Date(2020, 1, 2, 3, 4, 5, 600, time.UTC)),
+		Period:        durationpb.New(-1500 * time.Millisecond),
+		Any:           mustAny(t, &AminoCoin{Denom: "unchecked"}),
+		A:             &A{INT64: -5, SomeBoolean: true},
+		Labels:        map[int32]string{2: "b", 10: "a"},
+	}
+	want := `{"a":{"INT64":"-5","some_boolean":true},` +
+		`"any":{"type":"testpb/AminoCoin","value":{"denom":"unchecked"}},` +
+		`"labels":{"10":"a","2":"b"},` +
+		`"memo":"\u003cmemo\u003e",` +
+		`"msgs":[` +
+		`{"type":"testpb/AminoSend","value":{"amount":[{"amount":"10","denom":"stake"},{"denom":"atom"}],"from_address":"from","to_address":"to"}},` +
+		`{"type":"testpb/AminoVote","value":{"option":1,"proposal_id":"1"}},` +
+		`{"type":"testpb/AminoVote","value":{}}],` +
+		`"period":"-1500000000",` +
+		`"timeout":"2020-01-02T03:04:05.0000006Z",` +
+		`"timeout_height":"100"}`
+	got, err := tx.MarshalAminoJSON()
+	require.NoError(t, err)
+	require.Equal(t, want, string(got))
+
+	// the reflection based encoding of the runtime matches the generated one
+	dyn := dynamicpb.NewMessage(tx.ProtoReflect().Descriptor())
+	proto.Merge(dyn, tx)
+	got, err = runtime.MarshalAminoJSON(dyn)
+	require.NoError(t, err)
+	require.Equal(t, want, string(got))
+}
+
+func TestAminoJSONInterfaces(t *testing.T) {
+	// A does not implement AminoMsg
+	_, err := (&AminoTx{Msgs: []*anypb.Any{mustAny(t, &A{})}}).MarshalAminoJSON()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not implement the interface AminoMsg")
+
+	// B has no Amino name
+	_, err = (&AminoTx{Any: mustAny(t, &B{})}).MarshalAminoJSON()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "B has no amino.name option")
+
+	_, err = (&AminoTx{Any: &anypb.Any{TypeUrl: "type.googleapis.com/Unknown"}}).MarshalAminoJSON()
+	require.Error(t, err)
+
+	// an empty Any is encoded like a nil interface
+	got, err := (&AminoTx{Any: &anypb.Any{}}).MarshalAminoJSON()
+	require.NoError(t, err)
+	require.Equal(t, `{"any":null}`, string(got))
+}
+
+func TestAminoName(t *testing.T) {
+	name, ok := runtime.AminoName(md_AminoSend)
+	require.True(t, ok)
+	require.Equal(t, "testpb/AminoSend", name)
+	_, ok = runtime.AminoName(md_AminoTx)
+	require.False(t, ok)
+
+	// the option is read from the unknown fields when amino/amino.proto is not linked
+	options := &descriptorpb.MessageOptions{}
+	options.ProtoReflect().SetUnknown(protowire.AppendString(protowire.AppendTag(nil, 11110001, protowire.BytesType), "testpb/AminoVote"))
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:        proto.String("testpb/amino_unlinked.proto"),
+		Package:     proto.String("unlinked"),
+		MessageType: []*descriptorpb.DescriptorProto{{Name: proto.String("AminoVote"), Options: options}},
+	}, nil)
+	require.NoError(t, err)
+	name, ok = runtime.AminoName(fd.Messages().ByName("AminoVote"))
+	require.True(t, ok)
+	require.Equal(t, "testpb/AminoVote", name)
+}
@@ -0,0 +1,53 @@
+package testpb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestClone(t *testing.T) {
+	msg := newBenchMsg()
+	msg.LIST_ENUM = []Enumeration{Enumeration_Two}
+	msg.ProtoReflect().SetUnknown([]byte{0xf8, 0x7f, 0x01})
+	want, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	require.NoError(t, err)
+
+	clone := msg.Clone()
+	require.True(t, proto.Equal(msg, clone))
+
+	// the clone does not share any memory with the original
+	clone.BYTES[0] = 'x'
+	clone.MESSAGE.X = "changed"
+	clone.MAP["a"].X = "changed"
+	clone.LIST[0].X = "changed"
+	clone.ONEOF.(*A_ONEOF_B).ONEOF_B.X = "changed"
+	clone.LIST_ENUM[0] = Enumeration_One
+	clone.ProtoReflect().GetUnknown()[0] = 0
+	got, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	require.Nil(t, (*A)(nil).Clone())
+}
+
+func TestCopyInto(t *testing.T) {
+	msg := newBenchMsg()
+	dst := &A{
+		SomeBoolean: true,
+		MAP:         map[string]*B{"b": {X: "bb"}},
+		LIST:        []*B{{}, {}},
+		ONEOF:       &A_ONEOF_STRING{ONEOF_STRING: "dst"},
+	}
+	require.NotZero(t, proto.Size(dst))
+
+	// dst is overwritten rather than merged into
+	msg.CopyInto(dst)
+	require.True(t, proto.Equal(msg, dst))
+	require.Equal(t, proto.Size(msg), proto.Size(dst))
+	require.NotSame(t, msg.MESSAGE, dst.MESSAGE)
+
+	(*A)(nil).CopyInto(dst)
+	require.True(t, proto.Equal(&A{}, dst))
+}
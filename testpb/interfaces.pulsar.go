@@ -0,0 +1,3356 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package testpb
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	interfaceregistry "github.com/cosmos/cosmos-proto/runtime/interfaceregistry"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	io "io"
+	math "math"
+	reflect "reflect"
+	sync "sync"
+	atomic "sync/atomic"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
+)
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *Ed25519PubKey) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *Ed25519PubKey) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if len(x.Key) > 0 {
+		b = append(b, "\"key\":"...)
+		b = runtime.AppendJSONBytes(b, x.Key)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *Secp256K1PubKey) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *Secp256K1PubKey) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if len(x.Key) > 0 {
+		b = append(b, "\"key\":"...)
+		b = runtime.AppendJSONBytes(b, x.Key)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *Account) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *Account) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Address != "" {
+		b = append(b, "\"address\":"...)
+		b = runtime.AppendAminoJSONString(b, x.Address)
+		b = append(b, ',')
+	}
+	if len(x.Keys) > 0 {
+		b = append(b, "\"keys\":"...)
+		b = append(b, '[')
+		for _, v := range x.Keys {
+			if b, err = runtime.AppendAminoJSONAny(b, v, "PubKey"); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	x.lazyDecodeLazyKey()
+	if x.LazyKey != nil {
+		b = append(b, "\"lazy_key\":"...)
+		if b, err = runtime.AppendAminoJSONAny(b, x.LazyKey, "PubKey"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.PubKey != nil {
+		b = append(b, "\"pub_key\":"...)
+		if b, err = runtime.AppendAminoJSONAny(b, x.PubKey, "PubKey"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.Signer.(*Account_SignerKey); ok {
+		b = append(b, "\"signer_key\":"...)
+		if b, err = runtime.AppendAminoJSONAny(b, v.SignerKey, "PubKey"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.Signer.(*Account_SignerName); ok {
+		b = append(b, "\"signer_name\":"...)
+		b = runtime.AppendAminoJSONString(b, v.SignerName)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+var (
+	md_Ed25519PubKey     protoreflect.MessageDescriptor
+	fd_Ed25519PubKey_key protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_testpb_interfaces_proto_init()
+	md_Ed25519PubKey = File_testpb_interfaces_proto.Messages().ByName("Ed25519PubKey")
+	fd_Ed25519PubKey_key = md_Ed25519PubKey.Fields().ByName("key")
+}
+
+var _ protoreflect.Message = (*fastReflection_Ed25519PubKey)(nil)
+
+type fastReflection_Ed25519PubKey Ed25519PubKey
+
+func (x *Ed25519PubKey) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Ed25519PubKey)(x)
+}
+
+func (x *Ed25519PubKey) slowProtoReflect() protoreflect.Message {
+	mi := &file_testpb_interfaces_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Ed25519PubKey_messageType fastReflection_Ed25519PubKey_messageType
+var _ protoreflect.MessageType = fastReflection_Ed25519PubKey_messageType{}
+
+type fastReflection_Ed25519PubKey_messageType struct{}
+
+func (x fastReflection_Ed25519PubKey_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Ed25519PubKey)(nil)
+}
+func (x fastReflection_Ed25519PubKey_messageType) New() protoreflect.Message {
+	return new(fastReflection_Ed25519PubKey)
+}
+func (x fastReflection_Ed25519PubKey_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Ed25519PubKey
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Ed25519PubKey) Descriptor() protoreflect.MessageDescriptor {
+	return md_Ed25519PubKey
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Ed25519PubKey) Type() protoreflect.MessageType {
+	return _fastReflection_Ed25519PubKey_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Ed25519PubKey) New() protoreflect.Message {
+	return new(fastReflection_Ed25519PubKey)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Ed25519PubKey) Interface() protoreflect.ProtoMessage {
+	return (*Ed25519PubKey)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Ed25519PubKey) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Key) != 0 {
+		value := protoreflect.ValueOfBytes(x.Key)
+		if !f(fd_Ed25519PubKey_key, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Ed25519PubKey) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // Ed25519PubKey.key
+		if fd != fd_Ed25519PubKey_key {
+			break
+		}
+		return len(x.Key) != 0
+	}
+	if fd := runtime.FieldOf(fd, md_Ed25519PubKey); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message Ed25519PubKey does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message Ed25519PubKey does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Ed25519PubKey) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // Ed25519PubKey.key
+		if fd != fd_Ed25519PubKey_key {
+			break
+		}
+		x.Key = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_Ed25519PubKey); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message Ed25519PubKey does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message Ed25519PubKey does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Ed25519PubKey) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // Ed25519PubKey.key
+		if descriptor != fd_Ed25519PubKey_key {
+			break
+		}
+		value := x.Key
+		return protoreflect.ValueOfBytes(value)
+	}
+	if fd := runtime.FieldOf(descriptor, md_Ed25519PubKey); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message Ed25519PubKey does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message Ed25519PubKey does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Ed25519PubKey) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // Ed25519PubKey.key
+		if fd != fd_Ed25519PubKey_key {
+			break
+		}
+		x.Key = value.Bytes()
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_Ed25519PubKey); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message Ed25519PubKey does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message Ed25519PubKey does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Ed25519PubKey) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // Ed25519PubKey.key
+		if fd != fd_Ed25519PubKey_key {
+			break
+		}
+		panic(fmt.Errorf("field key of message Ed25519PubKey is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_Ed25519PubKey); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message Ed25519PubKey does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message Ed25519PubKey does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Ed25519PubKey) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // Ed25519PubKey.key
+		if fd != fd_Ed25519PubKey_key {
+			break
+		}
+		return protoreflect.ValueOfBytes(nil)
+	}
+	if fd := runtime.FieldOf(fd, md_Ed25519PubKey); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message Ed25519PubKey does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message Ed25519PubKey does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Ed25519PubKey) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in Ed25519PubKey", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Ed25519PubKey) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Ed25519PubKey) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Ed25519PubKey) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Ed25519PubKey) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_Ed25519PubKey_methods
+}
+
+var _fastReflection_Ed25519PubKey_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_Ed25519PubKey_size,
+	Marshal:           _fastReflection_Ed25519PubKey_marshal,
+	Unmarshal:         _fastReflection_Ed25519PubKey_unmarshal,
+	Merge:             _fastReflection_Ed25519PubKey_merge,
+	CheckInitialized:  _fastReflection_Ed25519PubKey_checkInitialized,
+	Equal:             _fastReflection_Ed25519PubKey_equal,
+}
+
+func _fastReflection_Ed25519PubKey_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*Ed25519PubKey)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	l = len(x.Key)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_Ed25519PubKey_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*Ed25519PubKey)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if len(x.Key) > 0 {
+		i -= len(x.Key)
+		copy(dAtA[i:], x.Key)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_Ed25519PubKey_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*Ed25519PubKey)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_Ed25519PubKey.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	if zeroCopy {
+		x.zeroCopied = true
+	}
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Ed25519PubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Ed25519PubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_Ed25519PubKey_key, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if zeroCopy || x.zeroCopied {
+				x.Key = runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy)
+			} else {
+				x.Key = append(x.Key[:0], dAtA[iNdEx:postIndex]...)
+				if x.Key == nil {
+					x.Key = []byte{}
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_Ed25519PubKey, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_Ed25519PubKey_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*Ed25519PubKey)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*Ed25519PubKey)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if len(src.Key) != 0 {
+		dst.Key = append([]byte{}, src.Key...)
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_Ed25519PubKey_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_Ed25519PubKey_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*Ed25519PubKey)
+	y, yok := input.MessageB.Interface().(*Ed25519PubKey)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !bytes.Equal(x.Key, y.Key) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *Ed25519PubKey) Clone() *Ed25519PubKey {
+	if x == nil {
+		return nil
+	}
+	dst := new(Ed25519PubKey)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *Ed25519PubKey) CopyInto(dst *Ed25519PubKey) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.Key = bytes.Clone(x.Key)
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *Ed25519PubKey) Equal(y *Ed25519PubKey) bool {
+	return _fastReflection_Ed25519PubKey_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a Ed25519PubKey, or nil if b is canonical.
+func (*Ed25519PubKey) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_Ed25519PubKey.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("key", protoreflect.BytesKind, true)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_Secp256K1PubKey     protoreflect.MessageDescriptor
+	fd_Secp256K1PubKey_key protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_testpb_interfaces_proto_init()
+	md_Secp256K1PubKey = File_testpb_interfaces_proto.Messages().ByName("Secp256k1PubKey")
+	fd_Secp256K1PubKey_key = md_Secp256K1PubKey.Fields().ByName("key")
+}
+
+var _ protoreflect.Message = (*fastReflection_Secp256K1PubKey)(nil)
+
+type fastReflection_Secp256K1PubKey Secp256K1PubKey
+
+func (x *Secp256K1PubKey) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Secp256K1PubKey)(x)
+}
+
+func (x *Secp256K1PubKey) slowProtoReflect() protoreflect.Message {
+	mi := &file_testpb_interfaces_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Secp256K1PubKey_messageType fastReflection_Secp256K1PubKey_messageType
+var _ protoreflect.MessageType = fastReflection_Secp256K1PubKey_messageType{}
+
+type fastReflection_Secp256K1PubKey_messageType struct{}
+
+func (x fastReflection_Secp256K1PubKey_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Secp256K1PubKey)(nil)
+}
+func (x fastReflection_Secp256K1PubKey_messageType) New() protoreflect.Message {
+	return new(fastReflection_Secp256K1PubKey)
+}
+func (x fastReflection_Secp256K1PubKey_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Secp256K1PubKey
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Secp256K1PubKey) Descriptor() protoreflect.MessageDescriptor {
+	return md_Secp256K1PubKey
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Secp256K1PubKey) Type() protoreflect.MessageType {
+	return _fastReflection_Secp256K1PubKey_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Secp256K1PubKey) New() protoreflect.Message {
+	return new(fastReflection_Secp256K1PubKey)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Secp256K1PubKey) Interface() protoreflect.ProtoMessage {
+	return (*Secp256K1PubKey)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Secp256K1PubKey) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Key) != 0 {
+		value := protoreflect.ValueOfBytes(x.Key)
+		if !f(fd_Secp256K1PubKey_key, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Secp256K1PubKey) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // Secp256k1PubKey.key
+		if fd != fd_Secp256K1PubKey_key {
+			break
+		}
+		return len(x.Key) != 0
+	}
+	if fd := runtime.FieldOf(fd, md_Secp256K1PubKey); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message Secp256k1PubKey does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message Secp256k1PubKey does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Secp256K1PubKey) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // Secp256k1PubKey.key
+		if fd != fd_Secp256K1PubKey_key {
+			break
+		}
+		x.Key = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_Secp256K1PubKey); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message Secp256k1PubKey does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message Secp256k1PubKey does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Secp256K1PubKey) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // Secp256k1PubKey.key
+		if descriptor != fd_Secp256K1PubKey_key {
+			break
+		}
+		value := x.Key
+		return protoreflect.ValueOfBytes(value)
+	}
+	if fd := runtime.FieldOf(descriptor, md_Secp256K1PubKey); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message Secp256k1PubKey does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message Secp256k1PubKey does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Secp256K1PubKey) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // Secp256k1PubKey.key
+		if fd != fd_Secp256K1PubKey_key {
+			break
+		}
+		x.Key = value.Bytes()
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_Secp256K1PubKey); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message Secp256k1PubKey does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message Secp256k1PubKey does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Secp256K1PubKey) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // Secp256k1PubKey.key
+		if fd != fd_Secp256K1PubKey_key {
+			break
+		}
+		panic(fmt.Errorf("field key of message Secp256k1PubKey is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_Secp256K1PubKey); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message Secp256k1PubKey does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message Secp256k1PubKey does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Secp256K1PubKey) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // Secp256k1PubKey.key
+		if fd != fd_Secp256K1PubKey_key {
+			break
+		}
+		return protoreflect.ValueOfBytes(nil)
+	}
+	if fd := runtime.FieldOf(fd, md_Secp256K1PubKey); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message Secp256k1PubKey does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message Secp256k1PubKey does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Secp256K1PubKey) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in Secp256k1PubKey", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Secp256K1PubKey) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Secp256K1PubKey) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Secp256K1PubKey) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Secp256K1PubKey) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_Secp256K1PubKey_methods
+}
+
+var _fastReflection_Secp256K1PubKey_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_Secp256K1PubKey_size,
+	Marshal:           _fastReflection_Secp256K1PubKey_marshal,
+	Unmarshal:         _fastReflection_Secp256K1PubKey_unmarshal,
+	Merge:             _fastReflection_Secp256K1PubKey_merge,
+	CheckInitialized:  _fastReflection_Secp256K1PubKey_checkInitialized,
+	Equal:             _fastReflection_Secp256K1PubKey_equal,
+}
+
+func _fastReflection_Secp256K1PubKey_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*Secp256K1PubKey)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	l = len(x.Key)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_Secp256K1PubKey_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*Secp256K1PubKey)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if len(x.Key) > 0 {
+		i -= len(x.Key)
+		copy(dAtA[i:], x.Key)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_Secp256K1PubKey_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*Secp256K1PubKey)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_Secp256K1PubKey.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	if zeroCopy {
+		x.zeroCopied = true
+	}
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Secp256K1PubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Secp256K1PubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_Secp256K1PubKey_key, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if zeroCopy || x.zeroCopied {
+				x.Key = runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy)
+			} else {
+				x.Key = append(x.Key[:0], dAtA[iNdEx:postIndex]...)
+				if x.Key == nil {
+					x.Key = []byte{}
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_Secp256K1PubKey, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_Secp256K1PubKey_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*Secp256K1PubKey)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*Secp256K1PubKey)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if len(src.Key) != 0 {
+		dst.Key = append([]byte{}, src.Key...)
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_Secp256K1PubKey_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_Secp256K1PubKey_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*Secp256K1PubKey)
+	y, yok := input.MessageB.Interface().(*Secp256K1PubKey)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !bytes.Equal(x.Key, y.Key) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *Secp256K1PubKey) Clone() *Secp256K1PubKey {
+	if x == nil {
+		return nil
+	}
+	dst := new(Secp256K1PubKey)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *Secp256K1PubKey) CopyInto(dst *Secp256K1PubKey) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.Key = bytes.Clone(x.Key)
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *Secp256K1PubKey) Equal(y *Secp256K1PubKey) bool {
+	return _fastReflection_Secp256K1PubKey_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a Secp256K1PubKey, or nil if b is canonical.
+func (*Secp256K1PubKey) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_Secp256K1PubKey.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("key", protoreflect.BytesKind, true)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var _ protoreflect.List = (*_Account_3_list)(nil)
+
+type _Account_3_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_Account_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Account_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Account_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Account_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Account_3_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Account_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Account_3_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Account_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Account             protoreflect.MessageDescriptor
+	fd_Account_address     protoreflect.FieldDescriptor
+	fd_Account_pub_key     protoreflect.FieldDescriptor
+	fd_Account_keys        protoreflect.FieldDescriptor
+	fd_Account_signer_key  protoreflect.FieldDescriptor
+	fd_Account_signer_name protoreflect.FieldDescriptor
+	fd_Account_lazy_key    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_testpb_interfaces_proto_init()
+	md_Account = File_testpb_interfaces_proto.Messages().ByName("Account")
+	fd_Account_address = md_Account.Fields().ByName("address")
+	fd_Account_pub_key = md_Account.Fields().ByName("pub_key")
+	fd_Account_keys = md_Account.Fields().ByName("keys")
+	fd_Account_signer_key = md_Account.Fields().ByName("signer_key")
+	fd_Account_signer_name = md_Account.Fields().ByName("signer_name")
+	fd_Account_lazy_key = md_Account.Fields().ByName("lazy_key")
+}
+
+var _ protoreflect.Message = (*fastReflection_Account)(nil)
+
+type fastReflection_Account Account
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Account)(x)
+}
+
+func (x *Account) slowProtoReflect() protoreflect.Message {
+	mi := &file_testpb_interfaces_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Account_messageType fastReflection_Account_messageType
+var _ protoreflect.MessageType = fastReflection_Account_messageType{}
+
+type fastReflection_Account_messageType struct{}
+
+func (x fastReflection_Account_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Account)(nil)
+}
+func (x fastReflection_Account_messageType) New() protoreflect.Message {
+	return new(fastReflection_Account)
+}
+func (x fastReflection_Account_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Account
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Account) Descriptor() protoreflect.MessageDescriptor {
+	return md_Account
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Account) Type() protoreflect.MessageType {
+	return _fastReflection_Account_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Account) New() protoreflect.Message {
+	return new(fastReflection_Account)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Account) Interface() protoreflect.ProtoMessage {
+	return (*Account)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Account) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Account_address, value) {
+			return
+		}
+	}
+	if x.PubKey != nil {
+		value := protoreflect.ValueOfMessage(x.PubKey.ProtoReflect())
+		if !f(fd_Account_pub_key, value) {
+			return
+		}
+	}
+	if len(x.Keys) != 0 {
+		value := protoreflect.ValueOfList(&_Account_3_list{list: &x.Keys})
+		if !f(fd_Account_keys, value) {
+			return
+		}
+	}
+	if x.Signer != nil {
+		switch o := x.Signer.(type) {
+		case *Account_SignerKey:
+			v := o.SignerKey
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_Account_signer_key, value) {
+				return
+			}
+		case *Account_SignerName:
+			v := o.SignerName
+			value := protoreflect.ValueOfString(v)
+			if !f(fd_Account_signer_name, value) {
+				return
+			}
+		}
+	}
+	(*Account)(x).lazyDecodeLazyKey()
+	if x.LazyKey != nil {
+		value := protoreflect.ValueOfMessage(x.LazyKey.ProtoReflect())
+		if !f(fd_Account_lazy_key, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Account) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // Account.address
+		if fd.FullName() != "Account.address" {
+			break
+		}
+		return x.Address != ""
+	case 2: // Account.pub_key
+		if fd.FullName() != "Account.pub_key" {
+			break
+		}
+		return x.PubKey != nil
+	case 3: // Account.keys
+		if fd.FullName() != "Account.keys" {
+			break
+		}
+		return len(x.Keys) != 0
+	case 4: // Account.signer_key
+		if fd != fd_Account_signer_key {
+			break
+		}
+		if x.Signer == nil {
+			return false
+		} else if _, ok := x.Signer.(*Account_SignerKey); ok {
+			return true
+		} else {
+			return false
+		}
+	case 5: // Account.signer_name
+		if fd != fd_Account_signer_name {
+			break
+		}
+		if x.Signer == nil {
+			return false
+		} else if _, ok := x.Signer.(*Account_SignerName); ok {
+			return true
+		} else {
+			return false
+		}
+	case 6: // Account.lazy_key
+		if fd.FullName() != "Account.lazy_key" {
+			break
+		}
+		if x.lazyFields.Pending(0) {
+			return true
+		}
+		return x.LazyKey != nil
+	}
+	if fd := runtime.FieldOf(fd, md_Account); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message Account does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message Account does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Account) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // Account.address
+		if fd.FullName() != "Account.address" {
+			break
+		}
+		x.Address = ""
+		return
+	case 2: // Account.pub_key
+		if fd.FullName() != "Account.pub_key" {
+			break
+		}
+		x.PubKey = nil
+		return
+	case 3: // Account.keys
+		if fd.FullName() != "Account.keys" {
+			break
+		}
+		x.Keys = nil
+		return
+	case 4: // Account.signer_key
+		if fd != fd_Account_signer_key {
+			break
+		}
+		x.Signer = nil
+		return
+	case 5: // Account.signer_name
+		if fd != fd_Account_signer_name {
+			break
+		}
+		x.Signer = nil
+		return
+	case 6: // Account.lazy_key
+		if fd.FullName() != "Account.lazy_key" {
+			break
+		}
+		x.lazyFields.Discard(0)
+		x.LazyKey = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_Account); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message Account does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message Account does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Account) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // Account.address
+		if descriptor.FullName() != "Account.address" {
+			break
+		}
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case 2: // Account.pub_key
+		if descriptor.FullName() != "Account.pub_key" {
+			break
+		}
+		value := x.PubKey
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 3: // Account.keys
+		if descriptor.FullName() != "Account.keys" {
+			break
+		}
+		if len(x.Keys) == 0 {
+			return protoreflect.ValueOfList(&_Account_3_list{})
+		}
+		listValue := &_Account_3_list{list: &x.Keys}
+		return protoreflect.ValueOfList(listValue)
+	case 4: // Account.signer_key
+		if descriptor != fd_Account_signer_key {
+			break
+		}
+		if x.Signer == nil {
+			return protoreflect.ValueOfMessage((*anypb.Any)(nil).ProtoReflect())
+		} else if v, ok := x.Signer.(*Account_SignerKey); ok {
+			return protoreflect.ValueOfMessage(v.SignerKey.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*anypb.Any)(nil).ProtoReflect())
+		}
+	case 5: // Account.signer_name
+		if descriptor != fd_Account_signer_name {
+			break
+		}
+		if x.Signer == nil {
+			return protoreflect.ValueOfString("")
+		} else if v, ok := x.Signer.(*Account_SignerName); ok {
+			return protoreflect.ValueOfString(v.SignerName)
+		} else {
+			return protoreflect.ValueOfString("")
+		}
+	case 6: // Account.lazy_key
+		if descriptor.FullName() != "Account.lazy_key" {
+			break
+		}
+		(*Account)(x).lazyDecodeLazyKey()
+		value := x.LazyKey
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(descriptor, md_Account); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message Account does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message Account does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Account) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // Account.address
+		if fd.FullName() != "Account.address" {
+			break
+		}
+		x.Address = value.Interface().(string)
+		return
+	case 2: // Account.pub_key
+		if fd.FullName() != "Account.pub_key" {
+			break
+		}
+		x.PubKey = value.Message().Interface().(*anypb.Any)
+		return
+	case 3: // Account.keys
+		if fd.FullName() != "Account.keys" {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_Account_3_list)
+		x.Keys = *clv.list
+		return
+	case 4: // Account.signer_key
+		if fd != fd_Account_signer_key {
+			break
+		}
+		cv := value.Message().Interface().(*anypb.Any)
+		x.Signer = &Account_SignerKey{SignerKey: cv}
+		return
+	case 5: // Account.signer_name
+		if fd != fd_Account_signer_name {
+			break
+		}
+		cv := value.Interface().(string)
+		x.Signer = &Account_SignerName{SignerName: cv}
+		return
+	case 6: // Account.lazy_key
+		if fd.FullName() != "Account.lazy_key" {
+			break
+		}
+		x.lazyFields.Discard(0)
+		x.LazyKey = value.Message().Interface().(*anypb.Any)
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_Account); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message Account does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message Account does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Account) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 2: // Account.pub_key
+		if fd.FullName() != "Account.pub_key" {
+			break
+		}
+		if x.PubKey == nil {
+			x.PubKey = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.PubKey.ProtoReflect())
+	case 3: // Account.keys
+		if fd.FullName() != "Account.keys" {
+			break
+		}
+		if x.Keys == nil {
+			x.Keys = []*anypb.Any{}
+		}
+		value := &_Account_3_list{list: &x.Keys}
+		return protoreflect.ValueOfList(value)
+	case 4: // Account.signer_key
+		if fd != fd_Account_signer_key {
+			break
+		}
+		if x.Signer == nil {
+			value := &anypb.Any{}
+			oneofValue := &Account_SignerKey{SignerKey: value}
+			x.Signer = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Signer.(type) {
+		case *Account_SignerKey:
+			return protoreflect.ValueOfMessage(m.SignerKey.ProtoReflect())
+		default:
+			value := &anypb.Any{}
+			oneofValue := &Account_SignerKey{SignerKey: value}
+			x.Signer = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case 6: // Account.lazy_key
+		if fd.FullName() != "Account.lazy_key" {
+			break
+		}
+		(*Account)(x).lazyDecodeLazyKey()
+		x.lazyFields.Discard(0)
+		if x.LazyKey == nil {
+			x.LazyKey = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.LazyKey.ProtoReflect())
+	case 1: // Account.address
+		if fd.FullName() != "Account.address" {
+			break
+		}
+		panic(fmt.Errorf("field address of message Account is not mutable"))
+	case 5: // Account.signer_name
+		if fd != fd_Account_signer_name {
+			break
+		}
+		panic(fmt.Errorf("field signer_name of message Account is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_Account); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message Account does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message Account does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Account) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // Account.address
+		if fd.FullName() != "Account.address" {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 2: // Account.pub_key
+		if fd.FullName() != "Account.pub_key" {
+			break
+		}
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 3: // Account.keys
+		if fd.FullName() != "Account.keys" {
+			break
+		}
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_Account_3_list{list: &list})
+	case 4: // Account.signer_key
+		if fd != fd_Account_signer_key {
+			break
+		}
+		value := &anypb.Any{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 5: // Account.signer_name
+		if fd != fd_Account_signer_name {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 6: // Account.lazy_key
+		if fd.FullName() != "Account.lazy_key" {
+			break
+		}
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(fd, md_Account); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message Account does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message Account does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Account) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "Account.signer":
+		if x.Signer == nil {
+			return nil
+		}
+		switch x.Signer.(type) {
+		case *Account_SignerKey:
+			return x.Descriptor().Fields().ByName("signer_key")
+		case *Account_SignerName:
+			return x.Descriptor().Fields().ByName("signer_name")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in Account", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Account) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Account) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Account) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Account) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_Account_methods
+}
+
+var _fastReflection_Account_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_Account_size,
+	Marshal:           _fastReflection_Account_marshal,
+	Unmarshal:         _fastReflection_Account_unmarshal,
+	Merge:             _fastReflection_Account_merge,
+	CheckInitialized:  _fastReflection_Account_checkInitialized,
+	Equal:             _fastReflection_Account_equal,
+}
+
+func _fastReflection_Account_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*Account)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	l = len(x.Address)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.PubKey != nil {
+		l = options.Size(x.PubKey)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.Keys) > 0 {
+		for _, e := range x.Keys {
+			l = options.Size(e)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	switch x := x.Signer.(type) {
+	case *Account_SignerKey:
+		if x == nil {
+			break
+		}
+		l = options.Size(x.SignerKey)
+		n += 1 + l + runtime.Sov(uint64(l))
+	case *Account_SignerName:
+		if x == nil {
+			break
+		}
+		l = len(x.SignerName)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if raw := runtime.RawLazy(&x.lazyFields, 0, &x.LazyKey); raw != nil {
+		n += len(raw)
+	} else {
+		if x.LazyKey != nil {
+			l = options.Size(x.LazyKey)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_Account_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*Account)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	x.lazyFields.StartMarshal()
+	defer x.lazyFields.EndMarshal()
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	switch x := x.Signer.(type) {
+	case *Account_SignerKey:
+		l = options.Size(x.SignerKey)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.SignerKey); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x22
+	case *Account_SignerName:
+		if !utf8.ValidString(x.SignerName) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.SignerName)
+		copy(dAtA[i:], x.SignerName)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignerName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if raw := runtime.RawLazy(&x.lazyFields, 0, &x.LazyKey); raw != nil {
+		i -= len(raw)
+		copy(dAtA[i:], raw)
+	} else {
+		if x.LazyKey != nil {
+			l = options.Size(x.LazyKey)
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.LazyKey); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(x.Keys) > 0 {
+		for iNdEx := len(x.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			l = options.Size(x.Keys[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.Keys[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if x.PubKey != nil {
+		l = options.Size(x.PubKey)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.PubKey); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(x.Address) > 0 {
+		if !utf8.ValidString(x.Address) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.Address)
+		copy(dAtA[i:], x.Address)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_Account_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*Account)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_Account.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Account: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_Account_address, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.Address = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.PubKey == nil {
+				if err := budget.Message(fd_Account_pub_key, int(unsafe.Sizeof(anypb.Any{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.PubKey = &anypb.Any{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PubKey); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_Account_pub_key, -1)
+			}
+			if err := runtime.CheckUnknownAny(options, x.PubKey); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			if err := budget.List(fd_Account_keys, len(x.Keys), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Message(fd_Account_keys, int(unsafe.Sizeof(anypb.Any{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.Keys = append(x.Keys, &anypb.Any{})
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Keys[len(x.Keys)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_Account_keys, len(x.Keys)-1)
+			}
+			if err := runtime.CheckUnknownAny(options, x.Keys[len(x.Keys)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignerKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if oneof, ok := x.Signer.(*Account_SignerKey); ok && oneof.SignerKey != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], oneof.SignerKey); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_Account_signer_key, -1)
+				}
+				if err := runtime.CheckUnknownAny(options, oneof.SignerKey); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+			} else {
+				if err := budget.Message(fd_Account_signer_key, int(unsafe.Sizeof(anypb.Any{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				v := &anypb.Any{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_Account_signer_key, -1)
+				}
+				if err := runtime.CheckUnknownAny(options, v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Signer = &Account_SignerKey{v}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_Account_signer_name, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.Signer = &Account_SignerName{runtime.String(dAtA[iNdEx:postIndex], zeroCopy)}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LazyKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.LazyKey == nil && x.lazyFields.Append(0, 1, dAtA[preIndex:postIndex], fd_Account_lazy_key.Message(), nil, options) {
+				iNdEx = postIndex
+				break
+			}
+			x.lazyDecodeLazyKey()
+			x.lazyFields.Discard(0)
+			if x.LazyKey == nil {
+				if err := budget.Message(fd_Account_lazy_key, int(unsafe.Sizeof(anypb.Any{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.LazyKey = &anypb.Any{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LazyKey); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_Account_lazy_key, -1)
+			}
+			if err := runtime.CheckUnknownAny(options, x.LazyKey); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_Account, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_Account_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*Account)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*Account)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.Address != "" {
+		dst.Address = src.Address
+	}
+	if src.PubKey != nil {
+		if dst.PubKey == nil {
+			dst.PubKey = &anypb.Any{}
+		}
+		proto.Merge(dst.PubKey, src.PubKey)
+	}
+	for _, v := range src.Keys {
+		m := &anypb.Any{}
+		proto.Merge(m, v)
+		dst.Keys = append(dst.Keys, m)
+	}
+	switch v := src.Signer.(type) {
+	case *Account_SignerKey:
+		if dv, ok := dst.Signer.(*Account_SignerKey); ok && dv.SignerKey != nil {
+			proto.Merge(dv.SignerKey, v.SignerKey)
+		} else {
+			m := &anypb.Any{}
+			proto.Merge(m, v.SignerKey)
+			dst.Signer = &Account_SignerKey{SignerKey: m}
+		}
+	case *Account_SignerName:
+		dst.Signer = &Account_SignerName{SignerName: v.SignerName}
+	}
+	src.lazyDecodeLazyKey()
+	dst.lazyDecodeLazyKey()
+	dst.lazyFields.Discard(0)
+	if src.LazyKey != nil {
+		if dst.LazyKey == nil {
+			dst.LazyKey = &anypb.Any{}
+		}
+		proto.Merge(dst.LazyKey, src.LazyKey)
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_Account_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_Account_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*Account)
+	y, yok := input.MessageB.Interface().(*Account)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.Address != y.Address {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.PubKey, y.PubKey) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.Keys) != len(y.Keys) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.Keys {
+		if !proto.Equal(v, y.Keys[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	switch v := x.Signer.(type) {
+	case nil:
+		if y.Signer != nil {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *Account_SignerKey:
+		if w, ok := y.Signer.(*Account_SignerKey); !ok || !proto.Equal(v.SignerKey, w.SignerKey) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *Account_SignerName:
+		if w, ok := y.Signer.(*Account_SignerName); !ok || v.SignerName != w.SignerName {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	x.lazyDecodeLazyKey()
+	y.lazyDecodeLazyKey()
+	if !proto.Equal(x.LazyKey, y.LazyKey) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *Account) Clone() *Account {
+	if x == nil {
+		return nil
+	}
+	dst := new(Account)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *Account) CopyInto(dst *Account) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.Address = x.Address
+	dst.PubKey = proto.Clone(x.PubKey).(*anypb.Any)
+	if x.Keys != nil {
+		dst.Keys = make([]*anypb.Any, len(x.Keys))
+		for i, v := range x.Keys {
+			dst.Keys[i] = proto.Clone(v).(*anypb.Any)
+		}
+	} else {
+		dst.Keys = nil
+	}
+	switch v := x.Signer.(type) {
+	case nil:
+		dst.Signer = nil
+	case *Account_SignerKey:
+		dst.Signer = &Account_SignerKey{SignerKey: proto.Clone(v.SignerKey).(*anypb.Any)}
+	case *Account_SignerName:
+		dst.Signer = &Account_SignerName{SignerName: v.SignerName}
+	}
+	x.lazyDecodeLazyKey()
+	dst.lazyFields.Discard(0)
+	dst.LazyKey = proto.Clone(x.LazyKey).(*anypb.Any)
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *Account) Equal(y *Account) bool {
+	return _fastReflection_Account_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a Account, or nil if b is canonical.
+func (*Account) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_Account.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("address", protoreflect.StringKind, true)
+		case 2:
+			w.Message("pub_key", false, runtime.CanonicalValidatorOf(fd_Account_pub_key.Message()))
+		case 3:
+			w.Message("keys", true, runtime.CanonicalValidatorOf(fd_Account_keys.Message()))
+		case 4:
+			w.Oneof(0)
+			w.Message("signer_key", false, runtime.CanonicalValidatorOf(fd_Account_signer_key.Message()))
+		case 5:
+			w.Oneof(0)
+			w.Scalar("signer_name", protoreflect.StringKind, false)
+		case 6:
+			w.Message("lazy_key", false, runtime.CanonicalValidatorOf(fd_Account_lazy_key.Message()))
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+// PubKeyI is implemented by the messages declaring the PubKey interface
+// with the cosmos_proto.implements_interface option.
+//
+// PubKey is the public key verifying the signatures of an account.
+type PubKeyI interface {
+	proto.Message
+	Implements_PubKey()
+}
+
+// Implements_PubKey marks Ed25519PubKey as an implementation of the PubKey interface.
+func (*Ed25519PubKey) Implements_PubKey() {}
+
+// Implements_PubKey marks Secp256K1PubKey as an implementation of the PubKey interface.
+func (*Secp256K1PubKey) Implements_PubKey() {}
+
+// GetPubKeyCached returns the message packed in pub_key, or nil if the field is not set.
+// The message is unpacked on the first call and cached until the field changes.
+func (x *Account) GetPubKeyCached() (PubKeyI, error) {
+	if x == nil {
+		return nil, nil
+	}
+	m, err := x.cachedPubKey.Unpack(x.GetPubKey(), "PubKey")
+	if m == nil {
+		return nil, err
+	}
+	v, ok := m.(PubKeyI)
+	if !ok {
+		return nil, runtime.ErrInterface(m.ProtoReflect().Descriptor().FullName(), "PubKey")
+	}
+	return v, nil
+}
+
+// SetPubKey packs v in pub_key, which is cleared if v is nil.
+func (x *Account) SetPubKey(v PubKeyI) error {
+	a, err := x.cachedPubKey.Pack(v, "PubKey")
+	if err != nil {
+		return err
+	}
+	x.PubKey = a
+	return nil
+}
+
+// GetKeysCached returns the messages packed in keys, nil elements included.
+// The messages are unpacked on the first call and cached until the field changes.
+func (x *Account) GetKeysCached() ([]PubKeyI, error) {
+	if x == nil {
+		return nil, nil
+	}
+	ms, err := x.cachedKeys.UnpackList(x.GetKeys(), "PubKey")
+	if err != nil {
+		return nil, err
+	}
+	vs := make([]PubKeyI, len(ms))
+	for i, m := range ms {
+		if m == nil {
+			continue
+		}
+		v, ok := m.(PubKeyI)
+		if !ok {
+			return nil, runtime.ErrInterface(m.ProtoReflect().Descriptor().FullName(), "PubKey")
+		}
+		vs[i] = v
+	}
+	return vs, nil
+}
+
+// SetKeys packs each of vs in keys.
+func (x *Account) SetKeys(vs []PubKeyI) error {
+	ms := make([]proto.Message, len(vs))
+	for i, v := range vs {
+		ms[i] = v
+	}
+	list, err := x.cachedKeys.PackList(ms, "PubKey")
+	if err != nil {
+		return err
+	}
+	x.Keys = list
+	return nil
+}
+
+// GetSignerKeyCached returns the message packed in signer_key, or nil if the field is not set.
+// The message is unpacked on the first call and cached until the field changes.
+func (x *Account) GetSignerKeyCached() (PubKeyI, error) {
+	if x == nil {
+		return nil, nil
+	}
+	m, err := x.cachedSignerKey.Unpack(x.GetSignerKey(), "PubKey")
+	if m == nil {
+		return nil, err
+	}
+	v, ok := m.(PubKeyI)
+	if !ok {
+		return nil, runtime.ErrInterface(m.ProtoReflect().Descriptor().FullName(), "PubKey")
+	}
+	return v, nil
+}
+
+// SetSignerKey packs v in signer_key, which is cleared if v is nil.
+func (x *Account) SetSignerKey(v PubKeyI) error {
+	a, err := x.cachedSignerKey.Pack(v, "PubKey")
+	if err != nil {
+		return err
+	}
+	if a == nil {
+		if _, ok := x.Signer.(*Account_SignerKey); ok {
+			x.Signer = nil
+		}
+		return nil
+	}
+	x.Signer = &Account_SignerKey{SignerKey: a}
+	return nil
+}
+
+// GetLazyKeyCached returns the message packed in lazy_key, or nil if the field is not set.
+// The message is unpacked on the first call and cached until the field changes.
+func (x *Account) GetLazyKeyCached() (PubKeyI, error) {
+	if x == nil {
+		return nil, nil
+	}
+	m, err := x.cachedLazyKey.Unpack(x.GetLazyKey(), "PubKey")
+	if m == nil {
+		return nil, err
+	}
+	v, ok := m.(PubKeyI)
+	if !ok {
+		return nil, runtime.ErrInterface(m.ProtoReflect().Descriptor().FullName(), "PubKey")
+	}
+	return v, nil
+}
+
+// SetLazyKey packs v in lazy_key, which is cleared if v is nil.
+func (x *Account) SetLazyKey(v PubKeyI) error {
+	a, err := x.cachedLazyKey.Pack(v, "PubKey")
+	if err != nil {
+		return err
+	}
+	x.lazyFields.Discard(0)
+	x.LazyKey = a
+	return nil
+}
+
+func init() {
+	file_testpb_interfaces_proto_init()
+	if err := interfaceregistry.Global.Register((*Ed25519PubKey)(nil).ProtoReflect().Type(), "PubKey"); err != nil {
+		panic(err)
+	}
+	if err := interfaceregistry.Global.Register((*Secp256K1PubKey)(nil).ProtoReflect().Type(), "PubKey"); err != nil {
+		panic(err)
+	}
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *Ed25519PubKey) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *Ed25519PubKey) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if len(x.Key) > 0 {
+		b = append(b, "\"key\":"...)
+		b = runtime.AppendJSONBytes(b, x.Key)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *Ed25519PubKey) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *Ed25519PubKey) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.Bytes(fd)
+			if err != nil {
+				return err
+			}
+			x.Key = v
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *Secp256K1PubKey) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *Secp256K1PubKey) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if len(x.Key) > 0 {
+		b = append(b, "\"key\":"...)
+		b = runtime.AppendJSONBytes(b, x.Key)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *Secp256K1PubKey) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *Secp256K1PubKey) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.Bytes(fd)
+			if err != nil {
+				return err
+			}
+			x.Key = v
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *Account) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *Account) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Address != "" {
+		b = append(b, "\"address\":"...)
+		if b, err = runtime.AppendJSONString(b, x.Address, "Account.address"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.PubKey != nil {
+		b = append(b, "\"pubKey\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.PubKey); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.Keys) > 0 {
+		b = append(b, "\"keys\":"...)
+		b = append(b, '[')
+		for _, v := range x.Keys {
+			if b, err = runtime.AppendJSONMessage(b, v); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if v, ok := x.Signer.(*Account_SignerKey); ok {
+		b = append(b, "\"signerKey\":"...)
+		if b, err = runtime.AppendJSONMessage(b, v.SignerKey); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.Signer.(*Account_SignerName); ok {
+		b = append(b, "\"signerName\":"...)
+		if b, err = runtime.AppendJSONString(b, v.SignerName, "Account.signer_name"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	x.lazyDecodeLazyKey()
+	if x.LazyKey != nil {
+		b = append(b, "\"lazyKey\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.LazyKey); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *Account) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *Account) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.Address = v
+		case 2:
+			v := new(anypb.Any)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.PubKey = v
+		case 3:
+			return d.List(func() error {
+				v := new(anypb.Any)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.Keys = append(x.Keys, v)
+				return nil
+			})
+		case 4:
+			v := new(anypb.Any)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Signer = &Account_SignerKey{SignerKey: v}
+		case 5:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.Signer = &Account_SignerName{SignerName: v}
+		case 6:
+			v := new(anypb.Any)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.LazyKey = v
+		}
+		return nil
+	})
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.18.1
+// source: testpb/interfaces.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Ed25519PubKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+	zeroCopied    bool
+
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *Ed25519PubKey) Reset() {
+	*x = Ed25519PubKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_interfaces_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ed25519PubKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ed25519PubKey) ProtoMessage() {}
+
+// Deprecated: Use Ed25519PubKey.ProtoReflect.Descriptor instead.
+func (*Ed25519PubKey) Descriptor() ([]byte, []int) {
+	return file_testpb_interfaces_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Ed25519PubKey) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type Secp256K1PubKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+	zeroCopied    bool
+
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *Secp256K1PubKey) Reset() {
+	*x = Secp256K1PubKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_interfaces_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secp256K1PubKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secp256K1PubKey) ProtoMessage() {}
+
+// Deprecated: Use Secp256K1PubKey.ProtoReflect.Descriptor instead.
+func (*Secp256K1PubKey) Descriptor() ([]byte, []int) {
+	return file_testpb_interfaces_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Secp256K1PubKey) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+// Account holds public keys in each kind of field accepting an interface.
+type Account struct {
+	state           protoimpl.MessageState
+	sizeCache       protoimpl.SizeCache
+	unknownFields   protoimpl.UnknownFields
+	lazyFields      runtime.LazyFields
+	cachedPubKey    runtime.AnyCache
+	cachedKeys      runtime.AnyCache
+	cachedSignerKey runtime.AnyCache
+	cachedLazyKey   runtime.AnyCache
+
+	Address string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PubKey  *anypb.Any   `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Keys    []*anypb.Any `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	// Types that are assignable to Signer:
+	//	*Account_SignerKey
+	//	*Account_SignerName
+	Signer  isAccount_Signer `protobuf_oneof:"signer"`
+	LazyKey *anypb.Any       `protobuf:"bytes,6,opt,name=lazy_key,json=lazyKey,proto3" json:"lazy_key,omitempty"`
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_interfaces_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_testpb_interfaces_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Account) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Account) GetPubKey() *anypb.Any {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+func (x *Account) GetKeys() []*anypb.Any {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *Account) GetSigner() isAccount_Signer {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+func (x *Account) GetSignerKey() *anypb.Any {
+	if x, ok := x.GetSigner().(*Account_SignerKey); ok {
+		return x.SignerKey
+	}
+	return nil
+}
+
+func (x *Account) GetSignerName() string {
+	if x, ok := x.GetSigner().(*Account_SignerName); ok {
+		return x.SignerName
+	}
+	return ""
+}
+
+func (x *Account) GetLazyKey() *anypb.Any {
+	if x != nil {
+		x.lazyDecodeLazyKey()
+		return x.LazyKey
+	}
+	return nil
+}
+
+func (x *Account) lazyDecodeLazyKey() {
+	runtime.DecodeLazy(&x.lazyFields, 0, &x.LazyKey)
+}
+
+type isAccount_Signer interface {
+	isAccount_Signer()
+}
+
+type Account_SignerKey struct {
+	SignerKey *anypb.Any `protobuf:"bytes,4,opt,name=signer_key,json=signerKey,proto3,oneof"`
+}
+
+type Account_SignerName struct {
+	SignerName string `protobuf:"bytes,5,opt,name=signer_name,json=signerName,proto3,oneof"`
+}
+
+func (*Account_SignerKey) isAccount_Signer() {}
+
+func (*Account_SignerName) isAccount_Signer() {}
+
+var File_testpb_interfaces_proto protoreflect.FileDescriptor
+
+var file_testpb_interfaces_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x2d, 0x0a, 0x0d, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x3a, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x2f,
+	0x0a, 0x0f, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x3a, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22,
+	0xc3, 0x02, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x0a, 0xca, 0xb4,
+	0x2d, 0x06, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x12, 0x34, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x42, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
+	0x42, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x48, 0x00, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x08,
+	0x6c, 0x61, 0x7a, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x42, 0x0c, 0xca, 0xb4, 0x2d, 0x06, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x28, 0x01, 0x52, 0x07, 0x6c, 0x61, 0x7a, 0x79, 0x4b, 0x65, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x42, 0x76, 0xea, 0x9b, 0x83, 0x03, 0x4a, 0x0a, 0x06, 0x50, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x20, 0x69, 0x73, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_testpb_interfaces_proto_rawDescOnce sync.Once
+	file_testpb_interfaces_proto_rawDescData = file_testpb_interfaces_proto_rawDesc
+)
+
+func file_testpb_interfaces_proto_rawDescGZIP() []byte {
+	file_testpb_interfaces_proto_rawDescOnce.Do(func() {
+		file_testpb_interfaces_proto_rawDescData = protoimpl.X.CompressGZIP(file_testpb_interfaces_proto_rawDescData)
+	})
+	return file_testpb_interfaces_proto_rawDescData
+}
+
+var file_testpb_interfaces_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_testpb_interfaces_proto_goTypes = []interface{}{
+	(*Ed25519PubKey)(nil),   // 0: Ed25519PubKey
+	(*Secp256K1PubKey)(nil), // 1: Secp256k1PubKey
+	(*Account)(nil),         // 2: Account
+	(*anypb.Any)(nil),       // 3: google.protobuf.Any
+}
+var file_testpb_interfaces_proto_depIdxs = []int32{
+	3, // 0: Account.pub_key:type_name -> google.protobuf.Any
+	3, // 1: Account.keys:type_name -> google.protobuf.Any
+	3, // 2: Account.signer_key:type_name -> google.protobuf.Any
+	3, // 3: Account.lazy_key:type_name -> google.protobuf.Any
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_testpb_interfaces_proto_init() }
+func file_testpb_interfaces_proto_init() {
+	if File_testpb_interfaces_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_testpb_interfaces_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ed25519PubKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.zeroCopied
+			default:
+				return nil
+			}
+		}
+		file_testpb_interfaces_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1PubKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.zeroCopied
+			default:
+				return nil
+			}
+		}
+		file_testpb_interfaces_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Account); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.lazyFields
+			case 4:
+				return &v.cachedPubKey
+			case 5:
+				return &v.cachedKeys
+			case 6:
+				return &v.cachedSignerKey
+			case 7:
+				return &v.cachedLazyKey
+			default:
+				return nil
+			}
+		}
+	}
+	file_testpb_interfaces_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*Account_SignerKey)(nil),
+		(*Account_SignerName)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_testpb_interfaces_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_testpb_interfaces_proto_goTypes,
+		DependencyIndexes: file_testpb_interfaces_proto_depIdxs,
+		MessageInfos:      file_testpb_interfaces_proto_msgTypes,
+	}.Build()
+	File_testpb_interfaces_proto = out.File
+	file_testpb_interfaces_proto_rawDesc = nil
+	file_testpb_interfaces_proto_goTypes = nil
+	file_testpb_interfaces_proto_depIdxs = nil
+}
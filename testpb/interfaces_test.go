@@ -0,0 +1,196 @@
+package testpb
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/runtime"
+	"github.com/cosmos/cosmos-proto/runtime/interfaceregistry"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestInterfaceAccessors(t *testing.T) {
+	t.Run("singular", func(t *testing.T) {
+		key := &Ed25519PubKey{Key: []byte("ed25519")}
+		acc := &Account{}
+		require.NoError(t, acc.SetPubKey(key))
+		require.True(t, proto.Equal(mustAny(t, key), acc.PubKey))
+
+		// the message which was set is cached
+		got, err := acc.GetPubKeyCached()
+		require.NoError(t, err)
+		require.Same(t, key, got)
+
+		// unmarshalled messages are unpacked once
+		b, err := proto.Marshal(acc)
+		require.NoError(t, err)
+		acc = &Account{}
+		require.NoError(t, proto.Unmarshal(b, acc))
+		got, err = acc.GetPubKeyCached()
+		require.NoError(t, err)
+		require.True(t, proto.Equal(key, got))
+		again, err := acc.GetPubKeyCached()
+		require.NoError(t, err)
+		require.Same(t, got, again)
+
+		// the cache follows the content of the field
+		other := &Secp256K1PubKey{Key: []byte("secp256k1")}
+		acc.PubKey = mustAny(t, other)
+		got, err = acc.GetPubKeyCached()
+		require.NoError(t, err)
+		require.True(t, proto.Equal(other, got))
+		acc.PubKey.Value = mustAny(t, &Secp256K1PubKey{Key: []byte("updated")}).Value
+		got, err = acc.GetPubKeyCached()
+		require.NoError(t, err)
+		require.Equal(t, []byte("updated"), got.(*Secp256K1PubKey).Key)
+
+		require.NoError(t, acc.SetPubKey(nil))
+		require.Nil(t, acc.PubKey)
+		got, err = acc.GetPubKeyCached()
+		require.NoError(t, err)
+		require.Nil(t, got)
+
+		got, err = (*Account)(nil).GetPubKeyCached()
+		require.NoError(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("repeated", func(t *testing.T) {
+		keys := []PubKeyI{&Ed25519PubKey{Key: []byte("a")}, nil, &Secp256K1PubKey{Key: []byte("b")}}
+		acc := &Account{}
+		require.NoError(t, acc.SetKeys(keys))
+		require.Len(t, acc.Keys, 3)
+		require.Nil(t, acc.Keys[1])
+		got, err := acc.GetKeysCached()
+		require.NoError(t, err)
+		require.Equal(t, keys, got)
+
+		acc.Keys = append(acc.Keys, mustAny(t, &Ed25519PubKey{Key: []byte("c")}))
+		got, err = acc.GetKeysCached()
+		require.NoError(t, err)
+		require.Len(t, got, 4)
+		require.Same(t, keys[0], got[0])
+		require.True(t, proto.Equal(&Ed25519PubKey{Key: []byte("c")}, got[3]))
+	})
+
+	t.Run("oneof", func(t *testing.T) {
+		key := &Ed25519PubKey{Key: []byte("signer")}
+		acc := &Account{Signer: &Account_SignerName{SignerName: "name"}}
+		require.NoError(t, acc.SetSignerKey(nil))
+		require.Equal(t, "name", acc.GetSignerName())
+
+		require.NoError(t, acc.SetSignerKey(key))
+		got, err := acc.GetSignerKeyCached()
+		require.NoError(t, err)
+		require.Same(t, key, got)
+
+		require.NoError(t, acc.SetSignerKey(nil))
+		require.Nil(t, acc.Signer)
+	})
+
+	t.Run("lazy", func(t *testing.T) {
+		key := &Ed25519PubKey{Key: []byte("lazy")}
+		b, err := proto.Marshal(&Account{LazyKey: mustAny(t, key)})
+		require.NoError(t, err)
+		acc := &Account{}
+		require.NoError(t, proto.Unmarshal(b, acc))
+		got, err := acc.GetLazyKeyCached()
+		require.NoError(t, err)
+		require.True(t, proto.Equal(key, got))
+
+		// the recorded encoding is not marshalled in place of the new value
+		acc = &Account{}
+		require.NoError(t, proto.Unmarshal(b, acc))
+		other := &Secp256K1PubKey{Key: []byte("other")}
+		require.NoError(t, acc.SetLazyKey(other))
+		b, err = proto.Marshal(acc)
+		require.NoError(t, err)
+		acc = &Account{}
+		require.NoError(t, proto.Unmarshal(b, acc))
+		require.True(t, proto.Equal(mustAny(t, other), acc.GetLazyKey()))
+	})
+
+	t.Run("interface not implemented", func(t *testing.T) {
+		// the setters only take the messages implementing the Go type of the interface
+		var _ PubKeyI = (*Ed25519PubKey)(nil)
+		var _ AminoMsgI = (*AminoSend)(nil)
+		_, isPubKey := interface{}(&AminoSend{}).(PubKeyI)
+		require.False(t, isPubKey)
+
+		var ifaceErr *runtime.InterfaceError
+		_, err := runtime.PackAny(&AminoSend{}, "PubKey")
+		require.ErrorAs(t, err, &ifaceErr)
+		require.ErrorIs(t, err, proto.Error)
+		require.Equal(t, "PubKey", ifaceErr.Interface)
+
+		acc := &Account{}
+		acc.Keys = []*anypb.Any{mustAny(t, &Ed25519PubKey{}), mustAny(t, &AminoVote{})}
+		_, err = acc.GetKeysCached()
+		require.ErrorAs(t, err, &ifaceErr)
+		require.EqualValues(t, "AminoVote", ifaceErr.Message)
+
+		acc.PubKey = &anypb.Any{TypeUrl: "/unknown.Type"}
+		_, err = acc.GetPubKeyCached()
+		require.Error(t, err)
+	})
+
+	t.Run("concurrent readers", func(t *testing.T) {
+		acc := &Account{PubKey: mustAny(t, &Ed25519PubKey{Key: []byte("shared")})}
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				got, err := acc.GetPubKeyCached()
+				require.NoError(t, err)
+				require.Equal(t, []byte("shared"), got.(*Ed25519PubKey).Key)
+			}()
+		}
+		wg.Wait()
+	})
+}
+
+func TestInterfaceRegistry(t *testing.T) {
+	t.Run("generated registrations", func(t *testing.T) {
+		require.Subset(t, interfaceregistry.Global.Interfaces(), []string{"AminoMsg", "PubKey"})
+		require.Equal(t, []protoreflect.MessageType{
+			(&Ed25519PubKey{}).ProtoReflect().Type(),
+			(&Secp256K1PubKey{}).ProtoReflect().Type(),
+		}, interfaceregistry.Global.Implementations("PubKey"))
+
+		mt, err := interfaceregistry.Global.FindByURL("AminoMsg", "type.googleapis.com/AminoVote")
+		require.NoError(t, err)
+		require.Equal(t, md_AminoVote, mt.Descriptor())
+		_, err = interfaceregistry.Global.FindByURL("PubKey", "/AminoVote")
+		require.ErrorIs(t, err, protoregistry.NotFound)
+	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		var r interfaceregistry.Registry
+		mt := (&Ed25519PubKey{}).ProtoReflect().Type()
+		require.NoError(t, r.Register(mt, "PubKey", "Key"))
+		require.NoError(t, r.Register(mt, "PubKey"))
+		require.Error(t, r.Register(dynamicpb.NewMessageType(md_Ed25519PubKey), "Key"))
+		require.Equal(t, []string{"Key", "PubKey"}, r.Interfaces())
+		require.True(t, r.Implements("Key", "Ed25519PubKey"))
+	})
+
+	t.Run("registered implementations", func(t *testing.T) {
+		// A implements the interface through its registration only
+		require.NoError(t, interfaceregistry.Global.Register((&A{}).ProtoReflect().Type(), "Registered"))
+		a, err := runtime.PackAny(&A{INT32: 7}, "Registered")
+		require.NoError(t, err)
+		m, err := runtime.UnpackAny(a, "Registered")
+		require.NoError(t, err)
+		require.True(t, proto.Equal(&A{INT32: 7}, m))
+
+		_, err = runtime.PackAny(&B{}, "Registered")
+		var ifaceErr *runtime.InterfaceError
+		require.ErrorAs(t, err, &ifaceErr)
+	})
+}
@@ -0,0 +1,5096 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package testpb
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	io "io"
+	maps "maps"
+	math "math"
+	reflect "reflect"
+	slices "slices"
+	sort "sort"
+	strconv "strconv"
+	sync "sync"
+	atomic "sync/atomic"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
+)
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *JSONWellKnown) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *JSONWellKnown) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.A != nil {
+		b = append(b, "\"a\":"...)
+		if b, err = x.A.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Any != nil {
+		b = append(b, "\"any\":"...)
+		if b, err = runtime.AppendAminoJSONAny(b, x.Any, ""); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.AnyValues) > 0 {
+		b = append(b, "\"any_values\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.AnyValues)) {
+			v := x.AnyValues[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			if b, err = runtime.AppendAminoJSONAny(b, v, ""); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.Anys) > 0 {
+		b = append(b, "\"anys\":"...)
+		b = append(b, '[')
+		for _, v := range x.Anys {
+			if b, err = runtime.AppendAminoJSONAny(b, v, ""); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if v, ok := x.Kind.(*JSONWellKnown_At); ok {
+		b = append(b, "\"at\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, v.At); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.BoolValue != nil {
+		b = append(b, "\"bool_value\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.BoolValue); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.BytesValue != nil {
+		b = append(b, "\"bytes_value\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.BytesValue); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.DoubleValue != nil {
+		b = append(b, "\"double_value\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.DoubleValue); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Duration != nil {
+		b = append(b, "\"duration\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.Duration); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Empty != nil {
+		b = append(b, "\"empty\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.Empty); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.FieldMask != nil {
+		b = append(b, "\"field_mask\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.FieldMask); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.FloatValue != nil {
+		b = append(b, "\"float_value\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.FloatValue); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Int32Value != nil {
+		b = append(b, "\"int32_value\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.Int32Value); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Int64Value != nil {
+		b = append(b, "\"int64_value\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.Int64Value); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.ListValue != nil {
+		b = append(b, "\"list_value\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.ListValue); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.Kind.(*JSONWellKnown_Null); ok {
+		b = append(b, "\"null\":"...)
+		b = strconv.AppendInt(b, int64(v.Null), 10)
+		b = append(b, ',')
+	}
+	if x.NullValue != 0 {
+		b = append(b, "\"null_value\":"...)
+		b = strconv.AppendInt(b, int64(x.NullValue), 10)
+		b = append(b, ',')
+	}
+	if x.StringValue != nil {
+		b = append(b, "\"string_value\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.StringValue); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Struct != nil {
+		b = append(b, "\"struct\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.Struct); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Timestamp != nil {
+		b = append(b, "\"timestamp\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.Timestamp); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Uint32Value != nil {
+		b = append(b, "\"uint32_value\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.Uint32Value); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Uint64Value != nil {
+		b = append(b, "\"uint64_value\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.Uint64Value); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Value != nil {
+		b = append(b, "\"value\":"...)
+		if b, err = runtime.AppendAminoJSONMessage(b, x.Value); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.Values) > 0 {
+		b = append(b, "\"values\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.Values)) {
+			v := x.Values[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			if b, err = runtime.AppendAminoJSONMessage(b, v); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+var _ protoreflect.List = (*_JSONWellKnown_19_list)(nil)
+
+type _JSONWellKnown_19_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_JSONWellKnown_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_JSONWellKnown_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_JSONWellKnown_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_JSONWellKnown_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_JSONWellKnown_19_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_JSONWellKnown_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_JSONWellKnown_19_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_JSONWellKnown_19_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.Map = (*_JSONWellKnown_20_map)(nil)
+
+type _JSONWellKnown_20_map struct {
+	m *map[string]*structpb.Value
+}
+
+func (x *_JSONWellKnown_20_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_JSONWellKnown_20_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_JSONWellKnown_20_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_JSONWellKnown_20_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_JSONWellKnown_20_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_JSONWellKnown_20_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*structpb.Value)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_JSONWellKnown_20_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
+	}
+	newValue := new(structpb.Value)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
+}
+
+func (x *_JSONWellKnown_20_map) NewValue() protoreflect.Value {
+	v := new(structpb.Value)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_JSONWellKnown_20_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.Map = (*_JSONWellKnown_24_map)(nil)
+
+type _JSONWellKnown_24_map struct {
+	m *map[string]*anypb.Any
+}
+
+func (x *_JSONWellKnown_24_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_JSONWellKnown_24_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_JSONWellKnown_24_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_JSONWellKnown_24_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_JSONWellKnown_24_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_JSONWellKnown_24_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_JSONWellKnown_24_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
+	}
+	newValue := new(anypb.Any)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
+}
+
+func (x *_JSONWellKnown_24_map) NewValue() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_JSONWellKnown_24_map) IsValid() bool {
+	return x.m != nil
+}
+
+var (
+	md_JSONWellKnown              protoreflect.MessageDescriptor
+	fd_JSONWellKnown_any          protoreflect.FieldDescriptor
+	fd_JSONWellKnown_timestamp    protoreflect.FieldDescriptor
+	fd_JSONWellKnown_duration     protoreflect.FieldDescriptor
+	fd_JSONWellKnown_struct       protoreflect.FieldDescriptor
+	fd_JSONWellKnown_value        protoreflect.FieldDescriptor
+	fd_JSONWellKnown_list_value   protoreflect.FieldDescriptor
+	fd_JSONWellKnown_null_value   protoreflect.FieldDescriptor
+	fd_JSONWellKnown_field_mask   protoreflect.FieldDescriptor
+	fd_JSONWellKnown_empty        protoreflect.FieldDescriptor
+	fd_JSONWellKnown_bool_value   protoreflect.FieldDescriptor
+	fd_JSONWellKnown_int32_value  protoreflect.FieldDescriptor
+	fd_JSONWellKnown_int64_value  protoreflect.FieldDescriptor
+	fd_JSONWellKnown_uint32_value protoreflect.FieldDescriptor
+	fd_JSONWellKnown_uint64_value protoreflect.FieldDescriptor
+	fd_JSONWellKnown_float_value  protoreflect.FieldDescriptor
+	fd_JSONWellKnown_double_value protoreflect.FieldDescriptor
+	fd_JSONWellKnown_string_value protoreflect.FieldDescriptor
+	fd_JSONWellKnown_bytes_value  protoreflect.FieldDescriptor
+	fd_JSONWellKnown_anys         protoreflect.FieldDescriptor
+	fd_JSONWellKnown_values       protoreflect.FieldDescriptor
+	fd_JSONWellKnown_a            protoreflect.FieldDescriptor
+	fd_JSONWellKnown_null         protoreflect.FieldDescriptor
+	fd_JSONWellKnown_at           protoreflect.FieldDescriptor
+	fd_JSONWellKnown_any_values   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_testpb_json_proto_init()
+	md_JSONWellKnown = File_testpb_json_proto.Messages().ByName("JSONWellKnown")
+	fd_JSONWellKnown_any = md_JSONWellKnown.Fields().ByName("any")
+	fd_JSONWellKnown_timestamp = md_JSONWellKnown.Fields().ByName("timestamp")
+	fd_JSONWellKnown_duration = md_JSONWellKnown.Fields().ByName("duration")
+	fd_JSONWellKnown_struct = md_JSONWellKnown.Fields().ByName("struct")
+	fd_JSONWellKnown_value = md_JSONWellKnown.Fields().ByName("value")
+	fd_JSONWellKnown_list_value = md_JSONWellKnown.Fields().ByName("list_value")
+	fd_JSONWellKnown_null_value = md_JSONWellKnown.Fields().ByName("null_value")
+	fd_JSONWellKnown_field_mask = md_JSONWellKnown.Fields().ByName("field_mask")
+	fd_JSONWellKnown_empty = md_JSONWellKnown.Fields().ByName("empty")
+	fd_JSONWellKnown_bool_value = md_JSONWellKnown.Fields().ByName("bool_value")
+	fd_JSONWellKnown_int32_value = md_JSONWellKnown.Fields().ByName("int32_value")
+	fd_JSONWellKnown_int64_value = md_JSONWellKnown.Fields().ByName("int64_value")
+	fd_JSONWellKnown_uint32_value = md_JSONWellKnown.Fields().ByName("uint32_value")
+	fd_JSONWellKnown_uint64_value = md_JSONWellKnown.Fields().ByName("uint64_value")
+	fd_JSONWellKnown_float_value = md_JSONWellKnown.Fields().ByName("float_value")
+	fd_JSONWellKnown_double_value = md_JSONWellKnown.Fields().ByName("double_value")
+	fd_JSONWellKnown_string_value = md_JSONWellKnown.Fields().ByName("string_value")
+	fd_JSONWellKnown_bytes_value = md_JSONWellKnown.Fields().ByName("bytes_value")
+	fd_JSONWellKnown_anys = md_JSONWellKnown.Fields().ByName("anys")
+	fd_JSONWellKnown_values = md_JSONWellKnown.Fields().ByName("values")
+	fd_JSONWellKnown_a = md_JSONWellKnown.Fields().ByName("a")
+	fd_JSONWellKnown_null = md_JSONWellKnown.Fields().ByName("null")
+	fd_JSONWellKnown_at = md_JSONWellKnown.Fields().ByName("at")
+	fd_JSONWellKnown_any_values = md_JSONWellKnown.Fields().ByName("any_values")
+}
+
+var _ protoreflect.Message = (*fastReflection_JSONWellKnown)(nil)
+
+type fastReflection_JSONWellKnown JSONWellKnown
+
+func (x *JSONWellKnown) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_JSONWellKnown)(x)
+}
+
+func (x *JSONWellKnown) slowProtoReflect() protoreflect.Message {
+	mi := &file_testpb_json_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_JSONWellKnown_messageType fastReflection_JSONWellKnown_messageType
+var _ protoreflect.MessageType = fastReflection_JSONWellKnown_messageType{}
+
+type fastReflection_JSONWellKnown_messageType struct{}
+
+func (x fastReflection_JSONWellKnown_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_JSONWellKnown)(nil)
+}
+func (x fastReflection_JSONWellKnown_messageType) New() protoreflect.Message {
+	return new(fastReflection_JSONWellKnown)
+}
+func (x fastReflection_JSONWellKnown_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_JSONWellKnown
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_JSONWellKnown) Descriptor() protoreflect.MessageDescriptor {
+	return md_JSONWellKnown
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_JSONWellKnown) Type() protoreflect.MessageType {
+	return _fastReflection_JSONWellKnown_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_JSONWellKnown) New() protoreflect.Message {
+	return new(fastReflection_JSONWellKnown)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_JSONWellKnown) Interface() protoreflect.ProtoMessage {
+	return (*JSONWellKnown)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_JSONWellKnown) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Any != nil {
+		value := protoreflect.ValueOfMessage(x.Any.ProtoReflect())
+		if !f(fd_JSONWellKnown_any, value) {
+			return
+		}
+	}
+	if x.Timestamp != nil {
+		value := protoreflect.ValueOfMessage(x.Timestamp.ProtoReflect())
+		if !f(fd_JSONWellKnown_timestamp, value) {
+			return
+		}
+	}
+	if x.Duration != nil {
+		value := protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+		if !f(fd_JSONWellKnown_duration, value) {
+			return
+		}
+	}
+	if x.Struct != nil {
+		value := protoreflect.ValueOfMessage(x.Struct.ProtoReflect())
+		if !f(fd_JSONWellKnown_struct, value) {
+			return
+		}
+	}
+	if x.Value != nil {
+		value := protoreflect.ValueOfMessage(x.Value.ProtoReflect())
+		if !f(fd_JSONWellKnown_value, value) {
+			return
+		}
+	}
+	if x.ListValue != nil {
+		value := protoreflect.ValueOfMessage(x.ListValue.ProtoReflect())
+		if !f(fd_JSONWellKnown_list_value, value) {
+			return
+		}
+	}
+	if x.NullValue != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.NullValue))
+		if !f(fd_JSONWellKnown_null_value, value) {
+			return
+		}
+	}
+	if x.FieldMask != nil {
+		value := protoreflect.ValueOfMessage(x.FieldMask.ProtoReflect())
+		if !f(fd_JSONWellKnown_field_mask, value) {
+			return
+		}
+	}
+	if x.Empty != nil {
+		value := protoreflect.ValueOfMessage(x.Empty.ProtoReflect())
+		if !f(fd_JSONWellKnown_empty, value) {
+			return
+		}
+	}
+	if x.BoolValue != nil {
+		value := protoreflect.ValueOfMessage(x.BoolValue.ProtoReflect())
+		if !f(fd_JSONWellKnown_bool_value, value) {
+			return
+		}
+	}
+	if x.Int32Value != nil {
+		value := protoreflect.ValueOfMessage(x.Int32Value.ProtoReflect())
+		if !f(fd_JSONWellKnown_int32_value, value) {
+			return
+		}
+	}
+	if x.Int64Value != nil {
+		value := protoreflect.ValueOfMessage(x.Int64Value.ProtoReflect())
+		if !f(fd_JSONWellKnown_int64_value, value) {
+			return
+		}
+	}
+	if x.Uint32Value != nil {
+		value := protoreflect.ValueOfMessage(x.Uint32Value.ProtoReflect())
+		if !f(fd_JSONWellKnown_uint32_value, value) {
+			return
+		}
+	}
+	if x.Uint64Value != nil {
+		value := protoreflect.ValueOfMessage(x.Uint64Value.ProtoReflect())
+		if !f(fd_JSONWellKnown_uint64_value, value) {
+			return
+		}
+	}
+	if x.FloatValue != nil {
+		value := protoreflect.ValueOfMessage(x.FloatValue.ProtoReflect())
+		if !f(fd_JSONWellKnown_float_value, value) {
+			return
+		}
+	}
+	if x.DoubleValue != nil {
+		value := protoreflect.ValueOfMessage(x.DoubleValue.ProtoReflect())
+		if !f(fd_JSONWellKnown_double_value, value) {
+			return
+		}
+	}
+	if x.StringValue != nil {
+		value := protoreflect.ValueOfMessage(x.StringValue.ProtoReflect())
+		if !f(fd_JSONWellKnown_string_value, value) {
+			return
+		}
+	}
+	if x.BytesValue != nil {
+		value := protoreflect.ValueOfMessage(x.BytesValue.ProtoReflect())
+		if !f(fd_JSONWellKnown_bytes_value, value) {
+			return
+		}
+	}
+	if len(x.Anys) != 0 {
+		value := protoreflect.ValueOfList(&_JSONWellKnown_19_list{list: &x.Anys})
+		if !f(fd_JSONWellKnown_anys, value) {
+			return
+		}
+	}
+	if len(x.Values) != 0 {
+		value := protoreflect.ValueOfMap(&_JSONWellKnown_20_map{m: &x.Values})
+		if !f(fd_JSONWellKnown_values, value) {
+			return
+		}
+	}
+	if x.A != nil {
+		value := protoreflect.ValueOfMessage(x.A.ProtoReflect())
+		if !f(fd_JSONWellKnown_a, value) {
+			return
+		}
+	}
+	if x.Kind != nil {
+		switch o := x.Kind.(type) {
+		case *JSONWellKnown_Null:
+			v := o.Null
+			value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+			if !f(fd_JSONWellKnown_null, value) {
+				return
+			}
+		case *JSONWellKnown_At:
+			v := o.At
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_JSONWellKnown_at, value) {
+				return
+			}
+		}
+	}
+	if len(x.AnyValues) != 0 {
+		value := protoreflect.ValueOfMap(&_JSONWellKnown_24_map{m: &x.AnyValues})
+		if !f(fd_JSONWellKnown_any_values, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_JSONWellKnown) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // JSONWellKnown.any
+		if fd != fd_JSONWellKnown_any {
+			break
+		}
+		return x.Any != nil
+	case 2: // JSONWellKnown.timestamp
+		if fd != fd_JSONWellKnown_timestamp {
+			break
+		}
+		return x.Timestamp != nil
+	case 3: // JSONWellKnown.duration
+		if fd != fd_JSONWellKnown_duration {
+			break
+		}
+		return x.Duration != nil
+	case 4: // JSONWellKnown.struct
+		if fd != fd_JSONWellKnown_struct {
+			break
+		}
+		return x.Struct != nil
+	case 5: // JSONWellKnown.value
+		if fd != fd_JSONWellKnown_value {
+			break
+		}
+		return x.Value != nil
+	case 6: // JSONWellKnown.list_value
+		if fd != fd_JSONWellKnown_list_value {
+			break
+		}
+		return x.ListValue != nil
+	case 7: // JSONWellKnown.null_value
+		if fd != fd_JSONWellKnown_null_value {
+			break
+		}
+		return x.NullValue != 0
+	case 8: // JSONWellKnown.field_mask
+		if fd != fd_JSONWellKnown_field_mask {
+			break
+		}
+		return x.FieldMask != nil
+	case 9: // JSONWellKnown.empty
+		if fd != fd_JSONWellKnown_empty {
+			break
+		}
+		return x.Empty != nil
+	case 10: // JSONWellKnown.bool_value
+		if fd != fd_JSONWellKnown_bool_value {
+			break
+		}
+		return x.BoolValue != nil
+	case 11: // JSONWellKnown.int32_value
+		if fd != fd_JSONWellKnown_int32_value {
+			break
+		}
+		return x.Int32Value != nil
+	case 12: // JSONWellKnown.int64_value
+		if fd != fd_JSONWellKnown_int64_value {
+			break
+		}
+		return x.Int64Value != nil
+	case 13: // JSONWellKnown.uint32_value
+		if fd != fd_JSONWellKnown_uint32_value {
+			break
+		}
+		return x.Uint32Value != nil
+	case 14: // JSONWellKnown.uint64_value
+		if fd != fd_JSONWellKnown_uint64_value {
+			break
+		}
+		return x.Uint64Value != nil
+	case 15: // JSONWellKnown.float_value
+		if fd != fd_JSONWellKnown_float_value {
+			break
+		}
+		return x.FloatValue != nil
+	case 16: // JSONWellKnown.double_value
+		if fd != fd_JSONWellKnown_double_value {
+			break
+		}
+		return x.DoubleValue != nil
+	case 17: // JSONWellKnown.string_value
+		if fd != fd_JSONWellKnown_string_value {
+			break
+		}
+		return x.StringValue != nil
+	case 18: // JSONWellKnown.bytes_value
+		if fd != fd_JSONWellKnown_bytes_value {
+			break
+		}
+		return x.BytesValue != nil
+	case 19: // JSONWellKnown.anys
+		if fd != fd_JSONWellKnown_anys {
+			break
+		}
+		return len(x.Anys) != 0
+	case 20: // JSONWellKnown.values
+		if fd != fd_JSONWellKnown_values {
+			break
+		}
+		return len(x.Values) != 0
+	case 21: // JSONWellKnown.a
+		if fd.FullName() != "JSONWellKnown.a" {
+			break
+		}
+		return x.A != nil
+	case 22: // JSONWellKnown.null
+		if fd != fd_JSONWellKnown_null {
+			break
+		}
+		if x.Kind == nil {
+			return false
+		} else if _, ok := x.Kind.(*JSONWellKnown_Null); ok {
+			return true
+		} else {
+			return false
+		}
+	case 23: // JSONWellKnown.at
+		if fd.FullName() != "JSONWellKnown.at" {
+			break
+		}
+		if x.Kind == nil {
+			return false
+		} else if _, ok := x.Kind.(*JSONWellKnown_At); ok {
+			return true
+		} else {
+			return false
+		}
+	case 24: // JSONWellKnown.any_values
+		if fd != fd_JSONWellKnown_any_values {
+			break
+		}
+		return len(x.AnyValues) != 0
+	}
+	if fd := runtime.FieldOf(fd, md_JSONWellKnown); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message JSONWellKnown does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message JSONWellKnown does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_JSONWellKnown) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // JSONWellKnown.any
+		if fd != fd_JSONWellKnown_any {
+			break
+		}
+		x.Any = nil
+		return
+	case 2: // JSONWellKnown.timestamp
+		if fd != fd_JSONWellKnown_timestamp {
+			break
+		}
+		x.Timestamp = nil
+		return
+	case 3: // JSONWellKnown.duration
+		if fd != fd_JSONWellKnown_duration {
+			break
+		}
+		x.Duration = nil
+		return
+	case 4: // JSONWellKnown.struct
+		if fd != fd_JSONWellKnown_struct {
+			break
+		}
+		x.Struct = nil
+		return
+	case 5: // JSONWellKnown.value
+		if fd != fd_JSONWellKnown_value {
+			break
+		}
+		x.Value = nil
+		return
+	case 6: // JSONWellKnown.list_value
+		if fd != fd_JSONWellKnown_list_value {
+			break
+		}
+		x.ListValue = nil
+		return
+	case 7: // JSONWellKnown.null_value
+		if fd != fd_JSONWellKnown_null_value {
+			break
+		}
+		x.NullValue = 0
+		return
+	case 8: // JSONWellKnown.field_mask
+		if fd != fd_JSONWellKnown_field_mask {
+			break
+		}
+		x.FieldMask = nil
+		return
+	case 9: // JSONWellKnown.empty
+		if fd != fd_JSONWellKnown_empty {
+			break
+		}
+		x.Empty = nil
+		return
+	case 10: // JSONWellKnown.bool_value
+		if fd != fd_JSONWellKnown_bool_value {
+			break
+		}
+		x.BoolValue = nil
+		return
+	case 11: // JSONWellKnown.int32_value
+		if fd != fd_JSONWellKnown_int32_value {
+			break
+		}
+		x.Int32Value = nil
+		return
+	case 12: // JSONWellKnown.int64_value
+		if fd != fd_JSONWellKnown_int64_value {
+			break
+		}
+		x.Int64Value = nil
+		return
+	case 13: // JSONWellKnown.uint32_value
+		if fd != fd_JSONWellKnown_uint32_value {
+			break
+		}
+		x.Uint32Value = nil
+		return
+	case 14: // JSONWellKnown.uint64_value
+		if fd != fd_JSONWellKnown_uint64_value {
+			break
+		}
+		x.Uint64Value = nil
+		return
+	case 15: // JSONWellKnown.float_value
+		if fd != fd_JSONWellKnown_float_value {
+			break
+		}
+		x.FloatValue = nil
+		return
+	case 16: // JSONWellKnown.double_value
+		if fd != fd_JSONWellKnown_double_value {
+			break
+		}
+		x.DoubleValue = nil
+		return
+	case 17: // JSONWellKnown.string_value
+		if fd != fd_JSONWellKnown_string_value {
+			break
+		}
+		x.StringValue = nil
+		return
+	case 18: // JSONWellKnown.bytes_value
+		if fd != fd_JSONWellKnown_bytes_value {
+			break
+		}
+		x.BytesValue = nil
+		return
+	case 19: // JSONWellKnown.anys
+		if fd != fd_JSONWellKnown_anys {
+			break
+		}
+		x.Anys = nil
+		return
+	case 20: // JSONWellKnown.values
+		if fd != fd_JSONWellKnown_values {
+			break
+		}
+		x.Values = nil
+		return
+	case 21: // JSONWellKnown.a
+		if fd.FullName() != "JSONWellKnown.a" {
+			break
+		}
+		x.A = nil
+		return
+	case 22: // JSONWellKnown.null
+		if fd != fd_JSONWellKnown_null {
+			break
+		}
+		x.Kind = nil
+		return
+	case 23: // JSONWellKnown.at
+		if fd.FullName() != "JSONWellKnown.at" {
+			break
+		}
+		x.Kind = nil
+		return
+	case 24: // JSONWellKnown.any_values
+		if fd != fd_JSONWellKnown_any_values {
+			break
+		}
+		x.AnyValues = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_JSONWellKnown); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message JSONWellKnown does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message JSONWellKnown does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_JSONWellKnown) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // JSONWellKnown.any
+		if descriptor != fd_JSONWellKnown_any {
+			break
+		}
+		value := x.Any
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 2: // JSONWellKnown.timestamp
+		if descriptor != fd_JSONWellKnown_timestamp {
+			break
+		}
+		value := x.Timestamp
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 3: // JSONWellKnown.duration
+		if descriptor != fd_JSONWellKnown_duration {
+			break
+		}
+		value := x.Duration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 4: // JSONWellKnown.struct
+		if descriptor != fd_JSONWellKnown_struct {
+			break
+		}
+		value := x.Struct
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 5: // JSONWellKnown.value
+		if descriptor != fd_JSONWellKnown_value {
+			break
+		}
+		value := x.Value
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 6: // JSONWellKnown.list_value
+		if descriptor != fd_JSONWellKnown_list_value {
+			break
+		}
+		value := x.ListValue
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 7: // JSONWellKnown.null_value
+		if descriptor != fd_JSONWellKnown_null_value {
+			break
+		}
+		value := x.NullValue
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case 8: // JSONWellKnown.field_mask
+		if descriptor != fd_JSONWellKnown_field_mask {
+			break
+		}
+		value := x.FieldMask
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 9: // JSONWellKnown.empty
+		if descriptor != fd_JSONWellKnown_empty {
+			break
+		}
+		value := x.Empty
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 10: // JSONWellKnown.bool_value
+		if descriptor != fd_JSONWellKnown_bool_value {
+			break
+		}
+		value := x.BoolValue
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 11: // JSONWellKnown.int32_value
+		if descriptor != fd_JSONWellKnown_int32_value {
+			break
+		}
+		value := x.Int32Value
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 12: // JSONWellKnown.int64_value
+		if descriptor != fd_JSONWellKnown_int64_value {
+			break
+		}
+		value := x.Int64Value
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 13: // JSONWellKnown.uint32_value
+		if descriptor != fd_JSONWellKnown_uint32_value {
+			break
+		}
+		value := x.Uint32Value
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 14: // JSONWellKnown.uint64_value
+		if descriptor != fd_JSONWellKnown_uint64_value {
+			break
+		}
+		value := x.Uint64Value
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 15: // JSONWellKnown.float_value
+		if descriptor != fd_JSONWellKnown_float_value {
+			break
+		}
+		value := x.FloatValue
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 16: // JSONWellKnown.double_value
+		if descriptor != fd_JSONWellKnown_double_value {
+			break
+		}
+		value := x.DoubleValue
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 17: // JSONWellKnown.string_value
+		if descriptor != fd_JSONWellKnown_string_value {
+			break
+		}
+		value := x.StringValue
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 18: // JSONWellKnown.bytes_value
+		if descriptor != fd_JSONWellKnown_bytes_value {
+			break
+		}
+		value := x.BytesValue
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 19: // JSONWellKnown.anys
+		if descriptor != fd_JSONWellKnown_anys {
+			break
+		}
+		if len(x.Anys) == 0 {
+			return protoreflect.ValueOfList(&_JSONWellKnown_19_list{})
+		}
+		listValue := &_JSONWellKnown_19_list{list: &x.Anys}
+		return protoreflect.ValueOfList(listValue)
+	case 20: // JSONWellKnown.values
+		if descriptor != fd_JSONWellKnown_values {
+			break
+		}
+		if len(x.Values) == 0 {
+			return protoreflect.ValueOfMap(&_JSONWellKnown_20_map{})
+		}
+		mapValue := &_JSONWellKnown_20_map{m: &x.Values}
+		return protoreflect.ValueOfMap(mapValue)
+	case 21: // JSONWellKnown.a
+		if descriptor.FullName() != "JSONWellKnown.a" {
+			break
+		}
+		value := x.A
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 22: // JSONWellKnown.null
+		if descriptor != fd_JSONWellKnown_null {
+			break
+		}
+		if x.Kind == nil {
+			return protoreflect.ValueOfEnum(0)
+		} else if v, ok := x.Kind.(*JSONWellKnown_Null); ok {
+			return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v.Null))
+		} else {
+			return protoreflect.ValueOfEnum(0)
+		}
+	case 23: // JSONWellKnown.at
+		if descriptor.FullName() != "JSONWellKnown.at" {
+			break
+		}
+		if x.Kind == nil {
+			return protoreflect.ValueOfMessage((*timestamppb.Timestamp)(nil).ProtoReflect())
+		} else if v, ok := x.Kind.(*JSONWellKnown_At); ok {
+			return protoreflect.ValueOfMessage(v.At.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*timestamppb.Timestamp)(nil).ProtoReflect())
+		}
+	case 24: // JSONWellKnown.any_values
+		if descriptor != fd_JSONWellKnown_any_values {
+			break
+		}
+		if len(x.AnyValues) == 0 {
+			return protoreflect.ValueOfMap(&_JSONWellKnown_24_map{})
+		}
+		mapValue := &_JSONWellKnown_24_map{m: &x.AnyValues}
+		return protoreflect.ValueOfMap(mapValue)
+	}
+	if fd := runtime.FieldOf(descriptor, md_JSONWellKnown); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message JSONWellKnown does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message JSONWellKnown does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_JSONWellKnown) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // JSONWellKnown.any
+		if fd != fd_JSONWellKnown_any {
+			break
+		}
+		x.Any = value.Message().Interface().(*anypb.Any)
+		return
+	case 2: // JSONWellKnown.timestamp
+		if fd != fd_JSONWellKnown_timestamp {
+			break
+		}
+		x.Timestamp = value.Message().Interface().(*timestamppb.Timestamp)
+		return
+	case 3: // JSONWellKnown.duration
+		if fd != fd_JSONWellKnown_duration {
+			break
+		}
+		x.Duration = value.Message().Interface().(*durationpb.Duration)
+		return
+	case 4: // JSONWellKnown.struct
+		if fd != fd_JSONWellKnown_struct {
+			break
+		}
+		x.Struct = value.Message().Interface().(*structpb.Struct)
+		return
+	case 5: // JSONWellKnown.value
+		if fd != fd_JSONWellKnown_value {
+			break
+		}
+		x.Value = value.Message().Interface().(*structpb.Value)
+		return
+	case 6: // JSONWellKnown.list_value
+		if fd != fd_JSONWellKnown_list_value {
+			break
+		}
+		x.ListValue = value.Message().Interface().(*structpb.ListValue)
+		return
+	case 7: // JSONWellKnown.null_value
+		if fd != fd_JSONWellKnown_null_value {
+			break
+		}
+		x.NullValue = (structpb.NullValue)(value.Enum())
+		return
+	case 8: // JSONWellKnown.field_mask
+		if fd != fd_JSONWellKnown_field_mask {
+			break
+		}
+		x.FieldMask = value.Message().Interface().(*fieldmaskpb.FieldMask)
+		return
+	case 9: // JSONWellKnown.empty
+		if fd != fd_JSONWellKnown_empty {
+			break
+		}
+		x.Empty = value.Message().Interface().(*emptypb.Empty)
+		return
+	case 10: // JSONWellKnown.bool_value
+		if fd != fd_JSONWellKnown_bool_value {
+			break
+		}
+		x.BoolValue = value.Message().Interface().(*wrapperspb.BoolValue)
+		return
+	case 11: // JSONWellKnown.int32_value
+		if fd != fd_JSONWellKnown_int32_value {
+			break
+		}
+		x.Int32Value = value.Message().Interface().(*wrapperspb.Int32Value)
+		return
+	case 12: // JSONWellKnown.int64_value
+		if fd != fd_JSONWellKnown_int64_value {
+			break
+		}
+		x.Int64Value = value.Message().Interface().(*wrapperspb.Int64Value)
+		return
+	case 13: // JSONWellKnown.uint32_value
+		if fd != fd_JSONWellKnown_uint32_value {
+			break
+		}
+		x.Uint32Value = value.Message().Interface().(*wrapperspb.UInt32Value)
+		return
+	case 14: // JSONWellKnown.uint64_value
+		if fd != fd_JSONWellKnown_uint64_value {
+			break
+		}
+		x.Uint64Value = value.Message().Interface().(*wrapperspb.UInt64Value)
+		return
+	case 15: // JSONWellKnown.float_value
+		if fd != fd_JSONWellKnown_float_value {
+			break
+		}
+		x.FloatValue = value.Message().Interface().(*wrapperspb.FloatValue)
+		return
+	case 16: // JSONWellKnown.double_value
+		if fd != fd_JSONWellKnown_double_value {
+			break
+		}
+		x.DoubleValue = value.Message().Interface().(*wrapperspb.DoubleValue)
+		return
+	case 17: // JSONWellKnown.string_value
+		if fd != fd_JSONWellKnown_string_value {
+			break
+		}
+		x.StringValue = value.Message().Interface().(*wrapperspb.StringValue)
+		return
+	case 18: // JSONWellKnown.bytes_value
+		if fd != fd_JSONWellKnown_bytes_value {
+			break
+		}
+		x.BytesValue = value.Message().Interface().(*wrapperspb.BytesValue)
+		return
+	case 19: // JSONWellKnown.anys
+		if fd != fd_JSONWellKnown_anys {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_JSONWellKnown_19_list)
+		x.Anys = *clv.list
+		return
+	case 20: // JSONWellKnown.values
+		if fd != fd_JSONWellKnown_values {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_JSONWellKnown_20_map)
+		x.Values = *cmv.m
+		return
+	case 21: // JSONWellKnown.a
+		if fd.FullName() != "JSONWellKnown.a" {
+			break
+		}
+		x.A = value.Message().Interface().(*A)
+		return
+	case 22: // JSONWellKnown.null
+		if fd != fd_JSONWellKnown_null {
+			break
+		}
+		cv := (structpb.NullValue)(value.Enum())
+		x.Kind = &JSONWellKnown_Null{Null: cv}
+		return
+	case 23: // JSONWellKnown.at
+		if fd.FullName() != "JSONWellKnown.at" {
+			break
+		}
+		cv := value.Message().Interface().(*timestamppb.Timestamp)
+		x.Kind = &JSONWellKnown_At{At: cv}
+		return
+	case 24: // JSONWellKnown.any_values
+		if fd != fd_JSONWellKnown_any_values {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_JSONWellKnown_24_map)
+		x.AnyValues = *cmv.m
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_JSONWellKnown); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message JSONWellKnown does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message JSONWellKnown does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_JSONWellKnown) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // JSONWellKnown.any
+		if fd != fd_JSONWellKnown_any {
+			break
+		}
+		if x.Any == nil {
+			x.Any = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Any.ProtoReflect())
+	case 2: // JSONWellKnown.timestamp
+		if fd != fd_JSONWellKnown_timestamp {
+			break
+		}
+		if x.Timestamp == nil {
+			x.Timestamp = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Timestamp.ProtoReflect())
+	case 3: // JSONWellKnown.duration
+		if fd != fd_JSONWellKnown_duration {
+			break
+		}
+		if x.Duration == nil {
+			x.Duration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+	case 4: // JSONWellKnown.struct
+		if fd != fd_JSONWellKnown_struct {
+			break
+		}
+		if x.Struct == nil {
+			x.Struct = new(structpb.Struct)
+		}
+		return protoreflect.ValueOfMessage(x.Struct.ProtoReflect())
+	case 5: // JSONWellKnown.value
+		if fd != fd_JSONWellKnown_value {
+			break
+		}
+		if x.Value == nil {
+			x.Value = new(structpb.Value)
+		}
+		return protoreflect.ValueOfMessage(x.Value.ProtoReflect())
+	case 6: // JSONWellKnown.list_value
+		if fd != fd_JSONWellKnown_list_value {
+			break
+		}
+		if x.ListValue == nil {
+			x.ListValue = new(structpb.ListValue)
+		}
+		return protoreflect.ValueOfMessage(x.ListValue.ProtoReflect())
+	case 8: // JSONWellKnown.field_mask
+		if fd != fd_JSONWellKnown_field_mask {
+			break
+		}
+		if x.FieldMask == nil {
+			x.FieldMask = new(fieldmaskpb.FieldMask)
+		}
+		return protoreflect.ValueOfMessage(x.FieldMask.ProtoReflect())
+	case 9: // JSONWellKnown.empty
+		if fd != fd_JSONWellKnown_empty {
+			break
+		}
+		if x.Empty == nil {
+			x.Empty = new(emptypb.Empty)
+		}
+		return protoreflect.ValueOfMessage(x.Empty.ProtoReflect())
+	case 10: // JSONWellKnown.bool_value
+		if fd != fd_JSONWellKnown_bool_value {
+			break
+		}
+		if x.BoolValue == nil {
+			x.BoolValue = new(wrapperspb.BoolValue)
+		}
+		return protoreflect.ValueOfMessage(x.BoolValue.ProtoReflect())
+	case 11: // JSONWellKnown.int32_value
+		if fd != fd_JSONWellKnown_int32_value {
+			break
+		}
+		if x.Int32Value == nil {
+			x.Int32Value = new(wrapperspb.Int32Value)
+		}
+		return protoreflect.ValueOfMessage(x.Int32Value.ProtoReflect())
+	case 12: // JSONWellKnown.int64_value
+		if fd != fd_JSONWellKnown_int64_value {
+			break
+		}
+		if x.Int64Value == nil {
+			x.Int64Value = new(wrapperspb.Int64Value)
+		}
+		return protoreflect.ValueOfMessage(x.Int64Value.ProtoReflect())
+	case 13: // JSONWellKnown.uint32_value
+		if fd != fd_JSONWellKnown_uint32_value {
+			break
+		}
+		if x.Uint32Value == nil {
+			x.Uint32Value = new(wrapperspb.UInt32Value)
+		}
+		return protoreflect.ValueOfMessage(x.Uint32Value.ProtoReflect())
+	case 14: // JSONWellKnown.uint64_value
+		if fd != fd_JSONWellKnown_uint64_value {
+			break
+		}
+		if x.Uint64Value == nil {
+			x.Uint64Value = new(wrapperspb.UInt64Value)
+		}
+		return protoreflect.ValueOfMessage(x.Uint64Value.ProtoReflect())
+	case 15: // JSONWellKnown.float_value
+		if fd != fd_JSONWellKnown_float_value {
+			break
+		}
+		if x.FloatValue == nil {
+			x.FloatValue = new(wrapperspb.FloatValue)
+		}
+		return protoreflect.ValueOfMessage(x.FloatValue.ProtoReflect())
+	case 16: // JSONWellKnown.double_value
+		if fd != fd_JSONWellKnown_double_value {
+			break
+		}
+		if x.DoubleValue == nil {
+			x.DoubleValue = new(wrapperspb.DoubleValue)
+		}
+		return protoreflect.ValueOfMessage(x.DoubleValue.ProtoReflect())
+	case 17: // JSONWellKnown.string_value
+		if fd != fd_JSONWellKnown_string_value {
+			break
+		}
+		if x.StringValue == nil {
+			x.StringValue = new(wrapperspb.StringValue)
+		}
+		return protoreflect.ValueOfMessage(x.StringValue.ProtoReflect())
+	case 18: // JSONWellKnown.bytes_value
+		if fd != fd_JSONWellKnown_bytes_value {
+			break
+		}
+		if x.BytesValue == nil {
+			x.BytesValue = new(wrapperspb.BytesValue)
+		}
+		return protoreflect.ValueOfMessage(x.BytesValue.ProtoReflect())
+	case 19: // JSONWellKnown.anys
+		if fd != fd_JSONWellKnown_anys {
+			break
+		}
+		if x.Anys == nil {
+			x.Anys = []*anypb.Any{}
+		}
+		value := &_JSONWellKnown_19_list{list: &x.Anys}
+		return protoreflect.ValueOfList(value)
+	case 20: // JSONWellKnown.values
+		if fd != fd_JSONWellKnown_values {
+			break
+		}
+		if x.Values == nil {
+			x.Values = make(map[string]*structpb.Value)
+		}
+		value := &_JSONWellKnown_20_map{m: &x.Values}
+		return protoreflect.ValueOfMap(value)
+	case 21: // JSONWellKnown.a
+		if fd.FullName() != "JSONWellKnown.a" {
+			break
+		}
+		if x.A == nil {
+			x.A = new(A)
+		}
+		return protoreflect.ValueOfMessage(x.A.ProtoReflect())
+	case 23: // JSONWellKnown.at
+		if fd.FullName() != "JSONWellKnown.at" {
+			break
+		}
+		if x.Kind == nil {
+			value := &timestamppb.Timestamp{}
+			oneofValue := &JSONWellKnown_At{At: value}
+			x.Kind = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Kind.(type) {
+		case *JSONWellKnown_At:
+			return protoreflect.ValueOfMessage(m.At.ProtoReflect())
+		default:
+			value := &timestamppb.Timestamp{}
+			oneofValue := &JSONWellKnown_At{At: value}
+			x.Kind = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case 24: // JSONWellKnown.any_values
+		if fd != fd_JSONWellKnown_any_values {
+			break
+		}
+		if x.AnyValues == nil {
+			x.AnyValues = make(map[string]*anypb.Any)
+		}
+		value := &_JSONWellKnown_24_map{m: &x.AnyValues}
+		return protoreflect.ValueOfMap(value)
+	case 7: // JSONWellKnown.null_value
+		if fd != fd_JSONWellKnown_null_value {
+			break
+		}
+		panic(fmt.Errorf("field null_value of message JSONWellKnown is not mutable"))
+	case 22: // JSONWellKnown.null
+		if fd != fd_JSONWellKnown_null {
+			break
+		}
+		panic(fmt.Errorf("field null of message JSONWellKnown is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_JSONWellKnown); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message JSONWellKnown does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message JSONWellKnown does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_JSONWellKnown) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // JSONWellKnown.any
+		if fd != fd_JSONWellKnown_any {
+			break
+		}
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 2: // JSONWellKnown.timestamp
+		if fd != fd_JSONWellKnown_timestamp {
+			break
+		}
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 3: // JSONWellKnown.duration
+		if fd != fd_JSONWellKnown_duration {
+			break
+		}
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 4: // JSONWellKnown.struct
+		if fd != fd_JSONWellKnown_struct {
+			break
+		}
+		m := new(structpb.Struct)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 5: // JSONWellKnown.value
+		if fd != fd_JSONWellKnown_value {
+			break
+		}
+		m := new(structpb.Value)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 6: // JSONWellKnown.list_value
+		if fd != fd_JSONWellKnown_list_value {
+			break
+		}
+		m := new(structpb.ListValue)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 7: // JSONWellKnown.null_value
+		if fd != fd_JSONWellKnown_null_value {
+			break
+		}
+		return protoreflect.ValueOfEnum(0)
+	case 8: // JSONWellKnown.field_mask
+		if fd != fd_JSONWellKnown_field_mask {
+			break
+		}
+		m := new(fieldmaskpb.FieldMask)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 9: // JSONWellKnown.empty
+		if fd != fd_JSONWellKnown_empty {
+			break
+		}
+		m := new(emptypb.Empty)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 10: // JSONWellKnown.bool_value
+		if fd != fd_JSONWellKnown_bool_value {
+			break
+		}
+		m := new(wrapperspb.BoolValue)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 11: // JSONWellKnown.int32_value
+		if fd != fd_JSONWellKnown_int32_value {
+			break
+		}
+		m := new(wrapperspb.Int32Value)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 12: // JSONWellKnown.int64_value
+		if fd != fd_JSONWellKnown_int64_value {
+			break
+		}
+		m := new(wrapperspb.Int64Value)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 13: // JSONWellKnown.uint32_value
+		if fd != fd_JSONWellKnown_uint32_value {
+			break
+		}
+		m := new(wrapperspb.UInt32Value)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 14: // JSONWellKnown.uint64_value
+		if fd != fd_JSONWellKnown_uint64_value {
+			break
+		}
+		m := new(wrapperspb.UInt64Value)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 15: // JSONWellKnown.float_value
+		if fd != fd_JSONWellKnown_float_value {
+			break
+		}
+		m := new(wrapperspb.FloatValue)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 16: // JSONWellKnown.double_value
+		if fd != fd_JSONWellKnown_double_value {
+			break
+		}
+		m := new(wrapperspb.DoubleValue)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 17: // JSONWellKnown.string_value
+		if fd != fd_JSONWellKnown_string_value {
+			break
+		}
+		m := new(wrapperspb.StringValue)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 18: // JSONWellKnown.bytes_value
+		if fd != fd_JSONWellKnown_bytes_value {
+			break
+		}
+		m := new(wrapperspb.BytesValue)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 19: // JSONWellKnown.anys
+		if fd != fd_JSONWellKnown_anys {
+			break
+		}
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_JSONWellKnown_19_list{list: &list})
+	case 20: // JSONWellKnown.values
+		if fd != fd_JSONWellKnown_values {
+			break
+		}
+		m := make(map[string]*structpb.Value)
+		return protoreflect.ValueOfMap(&_JSONWellKnown_20_map{m: &m})
+	case 21: // JSONWellKnown.a
+		if fd.FullName() != "JSONWellKnown.a" {
+			break
+		}
+		m := new(A)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 22: // JSONWellKnown.null
+		if fd != fd_JSONWellKnown_null {
+			break
+		}
+		return protoreflect.ValueOfEnum(0)
+	case 23: // JSONWellKnown.at
+		if fd.FullName() != "JSONWellKnown.at" {
+			break
+		}
+		value := &timestamppb.Timestamp{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 24: // JSONWellKnown.any_values
+		if fd != fd_JSONWellKnown_any_values {
+			break
+		}
+		m := make(map[string]*anypb.Any)
+		return protoreflect.ValueOfMap(&_JSONWellKnown_24_map{m: &m})
+	}
+	if fd := runtime.FieldOf(fd, md_JSONWellKnown); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message JSONWellKnown does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message JSONWellKnown does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_JSONWellKnown) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "JSONWellKnown.kind":
+		if x.Kind == nil {
+			return nil
+		}
+		switch x.Kind.(type) {
+		case *JSONWellKnown_Null:
+			return x.Descriptor().Fields().ByName("null")
+		case *JSONWellKnown_At:
+			return x.Descriptor().Fields().ByName("at")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in JSONWellKnown", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_JSONWellKnown) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_JSONWellKnown) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_JSONWellKnown) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_JSONWellKnown) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_JSONWellKnown_methods
+}
+
+var _fastReflection_JSONWellKnown_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_JSONWellKnown_size,
+	Marshal:           _fastReflection_JSONWellKnown_marshal,
+	Unmarshal:         _fastReflection_JSONWellKnown_unmarshal,
+	Merge:             _fastReflection_JSONWellKnown_merge,
+	CheckInitialized:  _fastReflection_JSONWellKnown_checkInitialized,
+	Equal:             _fastReflection_JSONWellKnown_equal,
+}
+
+func _fastReflection_JSONWellKnown_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*JSONWellKnown)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.Any != nil {
+		l = options.Size(x.Any)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.Timestamp != nil {
+		l = options.Size(x.Timestamp)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.Duration != nil {
+		l = options.Size(x.Duration)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.Struct != nil {
+		l = options.Size(x.Struct)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.Value != nil {
+		l = options.Size(x.Value)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.ListValue != nil {
+		l = options.Size(x.ListValue)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.NullValue != 0 {
+		n += 1 + runtime.Sov(uint64(x.NullValue))
+	}
+	if x.FieldMask != nil {
+		l = options.Size(x.FieldMask)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.Empty != nil {
+		l = options.Size(x.Empty)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.BoolValue != nil {
+		l = options.Size(x.BoolValue)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.Int32Value != nil {
+		l = options.Size(x.Int32Value)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.Int64Value != nil {
+		l = options.Size(x.Int64Value)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.Uint32Value != nil {
+		l = options.Size(x.Uint32Value)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.Uint64Value != nil {
+		l = options.Size(x.Uint64Value)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.FloatValue != nil {
+		l = options.Size(x.FloatValue)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.DoubleValue != nil {
+		l = options.Size(x.DoubleValue)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.StringValue != nil {
+		l = options.Size(x.StringValue)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if x.BytesValue != nil {
+		l = options.Size(x.BytesValue)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.Anys) > 0 {
+		for _, e := range x.Anys {
+			l = options.Size(e)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if len(x.Values) > 0 {
+		SiZeMaP := func(k string, v *structpb.Value) {
+			l := 0
+			if v != nil {
+				l = options.Size(v)
+			}
+			l += 1 + runtime.Sov(uint64(l))
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.Values))
+			for k := range x.Values {
+				sortme = append(sortme, k)
+			}
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.Values[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.Values {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if x.A != nil {
+		l = options.Size(x.A)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	switch x := x.Kind.(type) {
+	case *JSONWellKnown_Null:
+		if x == nil {
+			break
+		}
+		n += 2 + runtime.Sov(uint64(x.Null))
+	case *JSONWellKnown_At:
+		if x == nil {
+			break
+		}
+		l = options.Size(x.At)
+		n += 2 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.AnyValues) > 0 {
+		SiZeMaP := func(k string, v *anypb.Any) {
+			l := 0
+			if v != nil {
+				l = options.Size(v)
+			}
+			l += 1 + runtime.Sov(uint64(l))
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
+			n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.AnyValues))
+			for k := range x.AnyValues {
+				sortme = append(sortme, k)
+			}
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.AnyValues[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.AnyValues {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_JSONWellKnown_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*JSONWellKnown)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	switch x := x.Kind.(type) {
+	case *JSONWellKnown_Null:
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.Null))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	case *JSONWellKnown_At:
+		l = options.Size(x.At)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.At); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(x.AnyValues) > 0 {
+		MaRsHaLmAp := func(k string, v *anypb.Any) (protoiface.MarshalOutput, error) {
+			baseI := i
+			l = options.Size(v)
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], v); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x12
+			if !utf8.ValidString(k) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForAnyValues := make([]string, 0, len(x.AnyValues))
+			for k := range x.AnyValues {
+				keysForAnyValues = append(keysForAnyValues, string(k))
+			}
+			sort.Slice(keysForAnyValues, func(i, j int) bool {
+				return keysForAnyValues[i] < keysForAnyValues[j]
+			})
+			for iNdEx := len(keysForAnyValues) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.AnyValues[string(keysForAnyValues[iNdEx])]
+				out, err := MaRsHaLmAp(keysForAnyValues[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.AnyValues {
+				v := x.AnyValues[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if x.A != nil {
+		l = options.Size(x.A)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.A); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(x.Values) > 0 {
+		MaRsHaLmAp := func(k string, v *structpb.Value) (protoiface.MarshalOutput, error) {
+			baseI := i
+			l = options.Size(v)
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], v); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x12
+			if !utf8.ValidString(k) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForValues := make([]string, 0, len(x.Values))
+			for k := range x.Values {
+				keysForValues = append(keysForValues, string(k))
+			}
+			sort.Slice(keysForValues, func(i, j int) bool {
+				return keysForValues[i] < keysForValues[j]
+			})
+			for iNdEx := len(keysForValues) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.Values[string(keysForValues[iNdEx])]
+				out, err := MaRsHaLmAp(keysForValues[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.Values {
+				v := x.Values[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.Anys) > 0 {
+		for iNdEx := len(x.Anys) - 1; iNdEx >= 0; iNdEx-- {
+			l = options.Size(x.Anys[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.Anys[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if x.BytesValue != nil {
+		l = options.Size(x.BytesValue)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.BytesValue); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if x.StringValue != nil {
+		l = options.Size(x.StringValue)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.StringValue); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if x.DoubleValue != nil {
+		l = options.Size(x.DoubleValue)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.DoubleValue); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if x.FloatValue != nil {
+		l = options.Size(x.FloatValue)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.FloatValue); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if x.Uint64Value != nil {
+		l = options.Size(x.Uint64Value)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Uint64Value); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x72
+	}
+	if x.Uint32Value != nil {
+		l = options.Size(x.Uint32Value)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Uint32Value); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if x.Int64Value != nil {
+		l = options.Size(x.Int64Value)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Int64Value); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x62
+	}
+	if x.Int32Value != nil {
+		l = options.Size(x.Int32Value)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Int32Value); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if x.BoolValue != nil {
+		l = options.Size(x.BoolValue)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.BoolValue); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x52
+	}
+	if x.Empty != nil {
+		l = options.Size(x.Empty)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Empty); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if x.FieldMask != nil {
+		l = options.Size(x.FieldMask)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.FieldMask); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x42
+	}
+	if x.NullValue != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.NullValue))
+		i--
+		dAtA[i] = 0x38
+	}
+	if x.ListValue != nil {
+		l = options.Size(x.ListValue)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.ListValue); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x32
+	}
+	if x.Value != nil {
+		l = options.Size(x.Value)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Value); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if x.Struct != nil {
+		l = options.Size(x.Struct)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Struct); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x22
+	}
+	if x.Duration != nil {
+		l = options.Size(x.Duration)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Duration); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if x.Timestamp != nil {
+		l = options.Size(x.Timestamp)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Timestamp); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x12
+	}
+	if x.Any != nil {
+		l = options.Size(x.Any)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Any); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0xa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_JSONWellKnown_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*JSONWellKnown)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_JSONWellKnown.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: JSONWellKnown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: JSONWellKnown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Any", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Any == nil {
+				if err := budget.Message(fd_JSONWellKnown_any, int(unsafe.Sizeof(anypb.Any{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Any = &anypb.Any{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Any); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_any, -1)
+			}
+			if err := runtime.CheckUnknownAny(options, x.Any); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Timestamp == nil {
+				if err := budget.Message(fd_JSONWellKnown_timestamp, int(unsafe.Sizeof(timestamppb.Timestamp{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Timestamp = &timestamppb.Timestamp{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Timestamp); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_timestamp, -1)
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Duration == nil {
+				if err := budget.Message(fd_JSONWellKnown_duration, int(unsafe.Sizeof(durationpb.Duration{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Duration = &durationpb.Duration{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Duration); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_duration, -1)
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Struct", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Struct == nil {
+				if err := budget.Message(fd_JSONWellKnown_struct, int(unsafe.Sizeof(structpb.Struct{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Struct = &structpb.Struct{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Struct); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_struct, -1)
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Value == nil {
+				if err := budget.Message(fd_JSONWellKnown_value, int(unsafe.Sizeof(structpb.Value{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Value = &structpb.Value{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Value); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_value, -1)
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ListValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.ListValue == nil {
+				if err := budget.Message(fd_JSONWellKnown_list_value, int(unsafe.Sizeof(structpb.ListValue{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.ListValue = &structpb.ListValue{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ListValue); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_list_value, -1)
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NullValue", wireType)
+			}
+			x.NullValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.NullValue |= structpb.NullValue(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.FieldMask == nil {
+				if err := budget.Message(fd_JSONWellKnown_field_mask, int(unsafe.Sizeof(fieldmaskpb.FieldMask{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.FieldMask = &fieldmaskpb.FieldMask{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FieldMask); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_field_mask, -1)
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Empty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Empty == nil {
+				if err := budget.Message(fd_JSONWellKnown_empty, int(unsafe.Sizeof(emptypb.Empty{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Empty = &emptypb.Empty{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Empty); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_empty, -1)
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.BoolValue == nil {
+				if err := budget.Message(fd_JSONWellKnown_bool_value, int(unsafe.Sizeof(wrapperspb.BoolValue{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.BoolValue = &wrapperspb.BoolValue{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BoolValue); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_bool_value, -1)
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Int32Value == nil {
+				if err := budget.Message(fd_JSONWellKnown_int32_value, int(unsafe.Sizeof(wrapperspb.Int32Value{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Int32Value = &wrapperspb.Int32Value{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Int32Value); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_int32_value, -1)
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Int64Value == nil {
+				if err := budget.Message(fd_JSONWellKnown_int64_value, int(unsafe.Sizeof(wrapperspb.Int64Value{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Int64Value = &wrapperspb.Int64Value{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Int64Value); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_int64_value, -1)
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uint32Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Uint32Value == nil {
+				if err := budget.Message(fd_JSONWellKnown_uint32_value, int(unsafe.Sizeof(wrapperspb.UInt32Value{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Uint32Value = &wrapperspb.UInt32Value{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Uint32Value); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_uint32_value, -1)
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uint64Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Uint64Value == nil {
+				if err := budget.Message(fd_JSONWellKnown_uint64_value, int(unsafe.Sizeof(wrapperspb.UInt64Value{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Uint64Value = &wrapperspb.UInt64Value{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Uint64Value); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_uint64_value, -1)
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FloatValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.FloatValue == nil {
+				if err := budget.Message(fd_JSONWellKnown_float_value, int(unsafe.Sizeof(wrapperspb.FloatValue{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.FloatValue = &wrapperspb.FloatValue{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FloatValue); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_float_value, -1)
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.DoubleValue == nil {
+				if err := budget.Message(fd_JSONWellKnown_double_value, int(unsafe.Sizeof(wrapperspb.DoubleValue{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.DoubleValue = &wrapperspb.DoubleValue{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DoubleValue); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_double_value, -1)
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.StringValue == nil {
+				if err := budget.Message(fd_JSONWellKnown_string_value, int(unsafe.Sizeof(wrapperspb.StringValue{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.StringValue = &wrapperspb.StringValue{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StringValue); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_string_value, -1)
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.BytesValue == nil {
+				if err := budget.Message(fd_JSONWellKnown_bytes_value, int(unsafe.Sizeof(wrapperspb.BytesValue{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.BytesValue = &wrapperspb.BytesValue{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BytesValue); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_bytes_value, -1)
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Anys", wireType)
+			}
+			if err := budget.List(fd_JSONWellKnown_anys, len(x.Anys), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Message(fd_JSONWellKnown_anys, int(unsafe.Sizeof(anypb.Any{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.Anys = append(x.Anys, &anypb.Any{})
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Anys[len(x.Anys)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_anys, len(x.Anys)-1)
+			}
+			if err := runtime.CheckUnknownAny(options, x.Anys[len(x.Anys)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Values == nil {
+				x.Values = make(map[string]*structpb.Value)
+			}
+			var mapkey string
+			var mapvalue *structpb.Value
+			var mapvalueErr error
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_JSONWellKnown_values, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Message(fd_JSONWellKnown_values, int(unsafe.Sizeof(structpb.Value{}))); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapvalue = &structpb.Value{}
+					if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+						mapvalueErr = err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if mapvalueErr != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarInMap(mapvalueErr, fd_JSONWellKnown_values, mapkey)
+			}
+			if budget != nil {
+				if _, ok := x.Values[mapkey]; !ok {
+					if err := budget.MapEntry(fd_JSONWellKnown_values, len(x.Values), 24); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.Values[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.A == nil {
+				if err := budget.Message(fd_JSONWellKnown_a, int(unsafe.Sizeof(A{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.A = &A{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.A); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_a, -1)
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Null", wireType)
+			}
+			var v structpb.NullValue
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= structpb.NullValue(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			x.Kind = &JSONWellKnown_Null{v}
+		case 23:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if oneof, ok := x.Kind.(*JSONWellKnown_At); ok && oneof.At != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], oneof.At); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_at, -1)
+				}
+			} else {
+				if err := budget.Message(fd_JSONWellKnown_at, int(unsafe.Sizeof(timestamppb.Timestamp{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				v := &timestamppb.Timestamp{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_JSONWellKnown_at, -1)
+				}
+				x.Kind = &JSONWellKnown_At{v}
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnyValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.AnyValues == nil {
+				x.AnyValues = make(map[string]*anypb.Any)
+			}
+			var mapkey string
+			var mapvalue *anypb.Any
+			var mapvalueErr error
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_JSONWellKnown_any_values, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Message(fd_JSONWellKnown_any_values, int(unsafe.Sizeof(anypb.Any{}))); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapvalue = &anypb.Any{}
+					if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+						mapvalueErr = err
+					} else if err := runtime.CheckUnknownAny(options, mapvalue); err != nil {
+						mapvalueErr = err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if mapvalueErr != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarInMap(mapvalueErr, fd_JSONWellKnown_any_values, mapkey)
+			}
+			if budget != nil {
+				if _, ok := x.AnyValues[mapkey]; !ok {
+					if err := budget.MapEntry(fd_JSONWellKnown_any_values, len(x.AnyValues), 24); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.AnyValues[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_JSONWellKnown, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_JSONWellKnown_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*JSONWellKnown)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*JSONWellKnown)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.Any != nil {
+		if dst.Any == nil {
+			dst.Any = &anypb.Any{}
+		}
+		proto.Merge(dst.Any, src.Any)
+	}
+	if src.Timestamp != nil {
+		if dst.Timestamp == nil {
+			dst.Timestamp = &timestamppb.Timestamp{}
+		}
+		proto.Merge(dst.Timestamp, src.Timestamp)
+	}
+	if src.Duration != nil {
+		if dst.Duration == nil {
+			dst.Duration = &durationpb.Duration{}
+		}
+		proto.Merge(dst.Duration, src.Duration)
+	}
+	if src.Struct != nil {
+		if dst.Struct == nil {
+			dst.Struct = &structpb.Struct{}
+		}
+		proto.Merge(dst.Struct, src.Struct)
+	}
+	if src.Value != nil {
+		if dst.Value == nil {
+			dst.Value = &structpb.Value{}
+		}
+		proto.Merge(dst.Value, src.Value)
+	}
+	if src.ListValue != nil {
+		if dst.ListValue == nil {
+			dst.ListValue = &structpb.ListValue{}
+		}
+		proto.Merge(dst.ListValue, src.ListValue)
+	}
+	if src.NullValue != 0 {
+		dst.NullValue = src.NullValue
+	}
+	if src.FieldMask != nil {
+		if dst.FieldMask == nil {
+			dst.FieldMask = &fieldmaskpb.FieldMask{}
+		}
+		proto.Merge(dst.FieldMask, src.FieldMask)
+	}
+	if src.Empty != nil {
+		if dst.Empty == nil {
+			dst.Empty = &emptypb.Empty{}
+		}
+		proto.Merge(dst.Empty, src.Empty)
+	}
+	if src.BoolValue != nil {
+		if dst.BoolValue == nil {
+			dst.BoolValue = &wrapperspb.BoolValue{}
+		}
+		proto.Merge(dst.BoolValue, src.BoolValue)
+	}
+	if src.Int32Value != nil {
+		if dst.Int32Value == nil {
+			dst.Int32Value = &wrapperspb.Int32Value{}
+		}
+		proto.Merge(dst.Int32Value, src.Int32Value)
+	}
+	if src.Int64Value != nil {
+		if dst.Int64Value == nil {
+			dst.Int64Value = &wrapperspb.Int64Value{}
+		}
+		proto.Merge(dst.Int64Value, src.Int64Value)
+	}
+	if src.Uint32Value != nil {
+		if dst.Uint32Value == nil {
+			dst.Uint32Value = &wrapperspb.UInt32Value{}
+		}
+		proto.Merge(dst.Uint32Value, src.Uint32Value)
+	}
+	if src.Uint64Value != nil {
+		if dst.Uint64Value == nil {
+			dst.Uint64Value = &wrapperspb.UInt64Value{}
+		}
+		proto.Merge(dst.Uint64Value, src.Uint64Value)
+	}
+	if src.FloatValue != nil {
+		if dst.FloatValue == nil {
+			dst.FloatValue = &wrapperspb.FloatValue{}
+		}
+		proto.Merge(dst.FloatValue, src.FloatValue)
+	}
+	if src.DoubleValue != nil {
+		if dst.DoubleValue == nil {
+			dst.DoubleValue = &wrapperspb.DoubleValue{}
+		}
+		proto.Merge(dst.DoubleValue, src.DoubleValue)
+	}
+	if src.StringValue != nil {
+		if dst.StringValue == nil {
+			dst.StringValue = &wrapperspb.StringValue{}
+		}
+		proto.Merge(dst.StringValue, src.StringValue)
+	}
+	if src.BytesValue != nil {
+		if dst.BytesValue == nil {
+			dst.BytesValue = &wrapperspb.BytesValue{}
+		}
+		proto.Merge(dst.BytesValue, src.BytesValue)
+	}
+	for _, v := range src.Anys {
+		m := &anypb.Any{}
+		proto.Merge(m, v)
+		dst.Anys = append(dst.Anys, m)
+	}
+	if len(src.Values) != 0 {
+		if dst.Values == nil {
+			dst.Values = make(map[string]*structpb.Value, len(src.Values))
+		}
+		for k, v := range src.Values {
+			m := &structpb.Value{}
+			proto.Merge(m, v)
+			dst.Values[k] = m
+		}
+	}
+	if src.A != nil {
+		if dst.A == nil {
+			dst.A = &A{}
+		}
+		proto.Merge(dst.A, src.A)
+	}
+	switch v := src.Kind.(type) {
+	case *JSONWellKnown_Null:
+		dst.Kind = &JSONWellKnown_Null{Null: v.Null}
+	case *JSONWellKnown_At:
+		if dv, ok := dst.Kind.(*JSONWellKnown_At); ok && dv.At != nil {
+			proto.Merge(dv.At, v.At)
+		} else {
+			m := &timestamppb.Timestamp{}
+			proto.Merge(m, v.At)
+			dst.Kind = &JSONWellKnown_At{At: m}
+		}
+	}
+	if len(src.AnyValues) != 0 {
+		if dst.AnyValues == nil {
+			dst.AnyValues = make(map[string]*anypb.Any, len(src.AnyValues))
+		}
+		for k, v := range src.AnyValues {
+			m := &anypb.Any{}
+			proto.Merge(m, v)
+			dst.AnyValues[k] = m
+		}
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_JSONWellKnown_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_JSONWellKnown_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*JSONWellKnown)
+	y, yok := input.MessageB.Interface().(*JSONWellKnown)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.Any, y.Any) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.Timestamp, y.Timestamp) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.Duration, y.Duration) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.Struct, y.Struct) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.Value, y.Value) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.ListValue, y.ListValue) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.NullValue != y.NullValue {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.FieldMask, y.FieldMask) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.Empty, y.Empty) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.BoolValue, y.BoolValue) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.Int32Value, y.Int32Value) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.Int64Value, y.Int64Value) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.Uint32Value, y.Uint32Value) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.Uint64Value, y.Uint64Value) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.FloatValue, y.FloatValue) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.DoubleValue, y.DoubleValue) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.StringValue, y.StringValue) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !proto.Equal(x.BytesValue, y.BytesValue) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.Anys) != len(y.Anys) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.Anys {
+		if !proto.Equal(v, y.Anys[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.Values) != len(y.Values) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.Values {
+		if w, ok := y.Values[k]; !ok || !proto.Equal(v, w) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if !x.A.Equal(y.A) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	switch v := x.Kind.(type) {
+	case nil:
+		if y.Kind != nil {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *JSONWellKnown_Null:
+		if w, ok := y.Kind.(*JSONWellKnown_Null); !ok || v.Null != w.Null {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *JSONWellKnown_At:
+		if w, ok := y.Kind.(*JSONWellKnown_At); !ok || !proto.Equal(v.At, w.At) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.AnyValues) != len(y.AnyValues) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.AnyValues {
+		if w, ok := y.AnyValues[k]; !ok || !proto.Equal(v, w) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *JSONWellKnown) Clone() *JSONWellKnown {
+	if x == nil {
+		return nil
+	}
+	dst := new(JSONWellKnown)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *JSONWellKnown) CopyInto(dst *JSONWellKnown) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.Any = proto.Clone(x.Any).(*anypb.Any)
+	dst.Timestamp = proto.Clone(x.Timestamp).(*timestamppb.Timestamp)
+	dst.Duration = proto.Clone(x.Duration).(*durationpb.Duration)
+	dst.Struct = proto.Clone(x.Struct).(*structpb.Struct)
+	dst.Value = proto.Clone(x.Value).(*structpb.Value)
+	dst.ListValue = proto.Clone(x.ListValue).(*structpb.ListValue)
+	dst.NullValue = x.NullValue
+	dst.FieldMask = proto.Clone(x.FieldMask).(*fieldmaskpb.FieldMask)
+	dst.Empty = proto.Clone(x.Empty).(*emptypb.Empty)
+	dst.BoolValue = proto.Clone(x.BoolValue).(*wrapperspb.BoolValue)
+	dst.Int32Value = proto.Clone(x.Int32Value).(*wrapperspb.Int32Value)
+	dst.Int64Value = proto.Clone(x.Int64Value).(*wrapperspb.Int64Value)
+	dst.Uint32Value = proto.Clone(x.Uint32Value).(*wrapperspb.UInt32Value)
+	dst.Uint64Value = proto.Clone(x.Uint64Value).(*wrapperspb.UInt64Value)
+	dst.FloatValue = proto.Clone(x.FloatValue).(*wrapperspb.FloatValue)
+	dst.DoubleValue = proto.Clone(x.DoubleValue).(*wrapperspb.DoubleValue)
+	dst.StringValue = proto.Clone(x.StringValue).(*wrapperspb.StringValue)
+	dst.BytesValue = proto.Clone(x.BytesValue).(*wrapperspb.BytesValue)
+	if x.Anys != nil {
+		dst.Anys = make([]*anypb.Any, len(x.Anys))
+		for i, v := range x.Anys {
+			dst.Anys[i] = proto.Clone(v).(*anypb.Any)
+		}
+	} else {
+		dst.Anys = nil
+	}
+	if x.Values != nil {
+		dst.Values = make(map[string]*structpb.Value, len(x.Values))
+		for k, v := range x.Values {
+			dst.Values[k] = proto.Clone(v).(*structpb.Value)
+		}
+	} else {
+		dst.Values = nil
+	}
+	dst.A = x.A.Clone()
+	switch v := x.Kind.(type) {
+	case nil:
+		dst.Kind = nil
+	case *JSONWellKnown_Null:
+		dst.Kind = &JSONWellKnown_Null{Null: v.Null}
+	case *JSONWellKnown_At:
+		dst.Kind = &JSONWellKnown_At{At: proto.Clone(v.At).(*timestamppb.Timestamp)}
+	}
+	if x.AnyValues != nil {
+		dst.AnyValues = make(map[string]*anypb.Any, len(x.AnyValues))
+		for k, v := range x.AnyValues {
+			dst.AnyValues[k] = proto.Clone(v).(*anypb.Any)
+		}
+	} else {
+		dst.AnyValues = nil
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *JSONWellKnown) Equal(y *JSONWellKnown) bool {
+	return _fastReflection_JSONWellKnown_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a JSONWellKnown, or nil if b is canonical.
+func (*JSONWellKnown) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_JSONWellKnown.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Message("any", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_any.Message()))
+		case 2:
+			w.Message("timestamp", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_timestamp.Message()))
+		case 3:
+			w.Message("duration", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_duration.Message()))
+		case 4:
+			w.Message("struct", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_struct.Message()))
+		case 5:
+			w.Message("value", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_value.Message()))
+		case 6:
+			w.Message("list_value", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_list_value.Message()))
+		case 7:
+			w.Scalar("null_value", protoreflect.EnumKind, true)
+		case 8:
+			w.Message("field_mask", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_field_mask.Message()))
+		case 9:
+			w.Message("empty", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_empty.Message()))
+		case 10:
+			w.Message("bool_value", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_bool_value.Message()))
+		case 11:
+			w.Message("int32_value", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_int32_value.Message()))
+		case 12:
+			w.Message("int64_value", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_int64_value.Message()))
+		case 13:
+			w.Message("uint32_value", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_uint32_value.Message()))
+		case 14:
+			w.Message("uint64_value", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_uint64_value.Message()))
+		case 15:
+			w.Message("float_value", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_float_value.Message()))
+		case 16:
+			w.Message("double_value", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_double_value.Message()))
+		case 17:
+			w.Message("string_value", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_string_value.Message()))
+		case 18:
+			w.Message("bytes_value", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_bytes_value.Message()))
+		case 19:
+			w.Message("anys", true, runtime.CanonicalValidatorOf(fd_JSONWellKnown_anys.Message()))
+		case 20:
+			w.Map("values", protoreflect.StringKind, protoreflect.MessageKind, runtime.CanonicalValidatorOf(fd_JSONWellKnown_values.MapValue().Message()))
+		case 21:
+			w.Message("a", false, (*A)(nil))
+		case 22:
+			w.Oneof(0)
+			w.Scalar("null", protoreflect.EnumKind, false)
+		case 23:
+			w.Oneof(0)
+			w.Message("at", false, runtime.CanonicalValidatorOf(fd_JSONWellKnown_at.Message()))
+		case 24:
+			w.Map("any_values", protoreflect.StringKind, protoreflect.MessageKind, runtime.CanonicalValidatorOf(fd_JSONWellKnown_any_values.MapValue().Message()))
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *JSONWellKnown) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *JSONWellKnown) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Any != nil {
+		b = append(b, "\"any\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.Any); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Timestamp != nil {
+		b = append(b, "\"timestamp\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.Timestamp); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Duration != nil {
+		b = append(b, "\"duration\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.Duration); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Struct != nil {
+		b = append(b, "\"struct\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.Struct); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Value != nil {
+		b = append(b, "\"value\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.Value); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.ListValue != nil {
+		b = append(b, "\"listValue\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.ListValue); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.NullValue != 0 {
+		b = append(b, "\"nullValue\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(x.NullValue), x.NullValue.Descriptor())
+		b = append(b, ',')
+	}
+	if x.FieldMask != nil {
+		b = append(b, "\"fieldMask\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.FieldMask); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Empty != nil {
+		b = append(b, "\"empty\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.Empty); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.BoolValue != nil {
+		b = append(b, "\"boolValue\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.BoolValue); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Int32Value != nil {
+		b = append(b, "\"int32Value\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.Int32Value); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Int64Value != nil {
+		b = append(b, "\"int64Value\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.Int64Value); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Uint32Value != nil {
+		b = append(b, "\"uint32Value\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.Uint32Value); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Uint64Value != nil {
+		b = append(b, "\"uint64Value\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.Uint64Value); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.FloatValue != nil {
+		b = append(b, "\"floatValue\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.FloatValue); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.DoubleValue != nil {
+		b = append(b, "\"doubleValue\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.DoubleValue); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.StringValue != nil {
+		b = append(b, "\"stringValue\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.StringValue); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.BytesValue != nil {
+		b = append(b, "\"bytesValue\":"...)
+		if b, err = runtime.AppendJSONMessage(b, x.BytesValue); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.Anys) > 0 {
+		b = append(b, "\"anys\":"...)
+		b = append(b, '[')
+		for _, v := range x.Anys {
+			if b, err = runtime.AppendJSONMessage(b, v); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.Values) > 0 {
+		b = append(b, "\"values\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.Values)) {
+			v := x.Values[k]
+			if b, err = runtime.AppendJSONString(b, k, "JSONWellKnown.ValuesEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			if b, err = runtime.AppendJSONMessage(b, v); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if x.A != nil {
+		b = append(b, "\"a\":"...)
+		if b, err = x.A.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.Kind.(*JSONWellKnown_Null); ok {
+		b = append(b, "\"null\":"...)
+		b = runtime.AppendJSONEnum(b, protoreflect.EnumNumber(v.Null), v.Null.Descriptor())
+		b = append(b, ',')
+	}
+	if v, ok := x.Kind.(*JSONWellKnown_At); ok {
+		b = append(b, "\"at\":"...)
+		if b, err = runtime.AppendJSONMessage(b, v.At); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.AnyValues) > 0 {
+		b = append(b, "\"anyValues\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.AnyValues)) {
+			v := x.AnyValues[k]
+			if b, err = runtime.AppendJSONString(b, k, "JSONWellKnown.AnyValuesEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			if b, err = runtime.AppendJSONMessage(b, v); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *JSONWellKnown) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *JSONWellKnown) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v := new(anypb.Any)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Any = v
+		case 2:
+			v := new(timestamppb.Timestamp)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Timestamp = v
+		case 3:
+			v := new(durationpb.Duration)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Duration = v
+		case 4:
+			v := new(structpb.Struct)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Struct = v
+		case 5:
+			v := new(structpb.Value)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Value = v
+		case 6:
+			v := new(structpb.ListValue)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.ListValue = v
+		case 7:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := structpb.NullValue(v)
+				x.NullValue = e
+			}
+		case 8:
+			v := new(fieldmaskpb.FieldMask)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.FieldMask = v
+		case 9:
+			v := new(emptypb.Empty)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Empty = v
+		case 10:
+			v := new(wrapperspb.BoolValue)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.BoolValue = v
+		case 11:
+			v := new(wrapperspb.Int32Value)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Int32Value = v
+		case 12:
+			v := new(wrapperspb.Int64Value)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Int64Value = v
+		case 13:
+			v := new(wrapperspb.UInt32Value)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Uint32Value = v
+		case 14:
+			v := new(wrapperspb.UInt64Value)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Uint64Value = v
+		case 15:
+			v := new(wrapperspb.FloatValue)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.FloatValue = v
+		case 16:
+			v := new(wrapperspb.DoubleValue)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.DoubleValue = v
+		case 17:
+			v := new(wrapperspb.StringValue)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.StringValue = v
+		case 18:
+			v := new(wrapperspb.BytesValue)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.BytesValue = v
+		case 19:
+			return d.List(func() error {
+				v := new(anypb.Any)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.Anys = append(x.Anys, v)
+				return nil
+			})
+		case 20:
+			if x.Values == nil {
+				x.Values = make(map[string]*structpb.Value)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.Values[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v := new(structpb.Value)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.Values[key] = v
+				return nil
+			})
+		case 21:
+			v := new(A)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.A = v
+		case 22:
+			v, ok, err := d.Enum(fd)
+			if err != nil {
+				return err
+			}
+			if ok {
+				e := structpb.NullValue(v)
+				x.Kind = &JSONWellKnown_Null{Null: e}
+			}
+		case 23:
+			v := new(timestamppb.Timestamp)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Kind = &JSONWellKnown_At{At: v}
+		case 24:
+			if x.AnyValues == nil {
+				x.AnyValues = make(map[string]*anypb.Any)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.AnyValues[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v := new(anypb.Any)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.AnyValues[key] = v
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.18.1
+// source: testpb/json.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// JSONWellKnown holds the well-known types, whose JSON format is implemented by the runtime.
+type JSONWellKnown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Any         *anypb.Any                 `protobuf:"bytes,1,opt,name=any,proto3" json:"any,omitempty"`
+	Timestamp   *timestamppb.Timestamp     `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Duration    *durationpb.Duration       `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Struct      *structpb.Struct           `protobuf:"bytes,4,opt,name=struct,proto3" json:"struct,omitempty"`
+	Value       *structpb.Value            `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	ListValue   *structpb.ListValue        `protobuf:"bytes,6,opt,name=list_value,json=listValue,proto3" json:"list_value,omitempty"`
+	NullValue   structpb.NullValue         `protobuf:"varint,7,opt,name=null_value,json=nullValue,proto3,enum=google.protobuf.NullValue" json:"null_value,omitempty"`
+	FieldMask   *fieldmaskpb.FieldMask     `protobuf:"bytes,8,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	Empty       *emptypb.Empty             `protobuf:"bytes,9,opt,name=empty,proto3" json:"empty,omitempty"`
+	BoolValue   *wrapperspb.BoolValue      `protobuf:"bytes,10,opt,name=bool_value,json=boolValue,proto3" json:"bool_value,omitempty"`
+	Int32Value  *wrapperspb.Int32Value     `protobuf:"bytes,11,opt,name=int32_value,json=int32Value,proto3" json:"int32_value,omitempty"`
+	Int64Value  *wrapperspb.Int64Value     `protobuf:"bytes,12,opt,name=int64_value,json=int64Value,proto3" json:"int64_value,omitempty"`
+	Uint32Value *wrapperspb.UInt32Value    `protobuf:"bytes,13,opt,name=uint32_value,json=uint32Value,proto3" json:"uint32_value,omitempty"`
+	Uint64Value *wrapperspb.UInt64Value    `protobuf:"bytes,14,opt,name=uint64_value,json=uint64Value,proto3" json:"uint64_value,omitempty"`
+	FloatValue  *wrapperspb.FloatValue     `protobuf:"bytes,15,opt,name=float_value,json=floatValue,proto3" json:"float_value,omitempty"`
+	DoubleValue *wrapperspb.DoubleValue    `protobuf:"bytes,16,opt,name=double_value,json=doubleValue,proto3" json:"double_value,omitempty"`
+	StringValue *wrapperspb.StringValue    `protobuf:"bytes,17,opt,name=string_value,json=stringValue,proto3" json:"string_value,omitempty"`
+	BytesValue  *wrapperspb.BytesValue     `protobuf:"bytes,18,opt,name=bytes_value,json=bytesValue,proto3" json:"bytes_value,omitempty"`
+	Anys        []*anypb.Any               `protobuf:"bytes,19,rep,name=anys,proto3" json:"anys,omitempty"`
+	Values      map[string]*structpb.Value `protobuf:"bytes,20,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	A           *A                         `protobuf:"bytes,21,opt,name=a,proto3" json:"a,omitempty"`
+	// Types that are assignable to Kind:
+	//	*JSONWellKnown_Null
+	//	*JSONWellKnown_At
+	Kind      isJSONWellKnown_Kind  `protobuf_oneof:"kind"`
+	AnyValues map[string]*anypb.Any `protobuf:"bytes,24,rep,name=any_values,json=anyValues,proto3" json:"any_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *JSONWellKnown) Reset() {
+	*x = JSONWellKnown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_json_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONWellKnown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONWellKnown) ProtoMessage() {}
+
+// Deprecated: Use JSONWellKnown.ProtoReflect.Descriptor instead.
+func (*JSONWellKnown) Descriptor() ([]byte, []int) {
+	return file_testpb_json_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JSONWellKnown) GetAny() *anypb.Any {
+	if x != nil {
+		return x.Any
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetStruct() *structpb.Struct {
+	if x != nil {
+		return x.Struct
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetListValue() *structpb.ListValue {
+	if x != nil {
+		return x.ListValue
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetNullValue() structpb.NullValue {
+	if x != nil {
+		return x.NullValue
+	}
+	return structpb.NullValue(0)
+}
+
+func (x *JSONWellKnown) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetEmpty() *emptypb.Empty {
+	if x != nil {
+		return x.Empty
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetBoolValue() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.BoolValue
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetInt32Value() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Int32Value
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetInt64Value() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Int64Value
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetUint32Value() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Uint32Value
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetUint64Value() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Uint64Value
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetFloatValue() *wrapperspb.FloatValue {
+	if x != nil {
+		return x.FloatValue
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetDoubleValue() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.DoubleValue
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetStringValue() *wrapperspb.StringValue {
+	if x != nil {
+		return x.StringValue
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetBytesValue() *wrapperspb.BytesValue {
+	if x != nil {
+		return x.BytesValue
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetAnys() []*anypb.Any {
+	if x != nil {
+		return x.Anys
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetValues() map[string]*structpb.Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetA() *A {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetKind() isJSONWellKnown_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetNull() structpb.NullValue {
+	if x, ok := x.GetKind().(*JSONWellKnown_Null); ok {
+		return x.Null
+	}
+	return structpb.NullValue(0)
+}
+
+func (x *JSONWellKnown) GetAt() *timestamppb.Timestamp {
+	if x, ok := x.GetKind().(*JSONWellKnown_At); ok {
+		return x.At
+	}
+	return nil
+}
+
+func (x *JSONWellKnown) GetAnyValues() map[string]*anypb.Any {
+	if x != nil {
+		return x.AnyValues
+	}
+	return nil
+}
+
+type isJSONWellKnown_Kind interface {
+	isJSONWellKnown_Kind()
+}
+
+type JSONWellKnown_Null struct {
+	Null structpb.NullValue `protobuf:"varint,22,opt,name=null,proto3,enum=google.protobuf.NullValue,oneof"`
+}
+
+type JSONWellKnown_At struct {
+	At *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=at,proto3,oneof"`
+}
+
+func (*JSONWellKnown_Null) isJSONWellKnown_Kind() {}
+
+func (*JSONWellKnown_At) isJSONWellKnown_Kind() {}
+
+var File_testpb_json_proto protoreflect.FileDescriptor
+
+var file_testpb_json_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x62, 0x2f, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xda, 0x0b, 0x0a,
+	0x0d, 0x4a, 0x53, 0x4f, 0x4e, 0x57, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x26,
+	0x0a, 0x03, 0x61, 0x6e, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x52, 0x03, 0x61, 0x6e, 0x79, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x06, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x0a,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x05,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x3c, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3c,
+	0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0a, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x0c,
+	0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a,
+	0x0c, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3c,
+	0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x0c,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a,
+	0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3c,
+	0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x04,
+	0x61, 0x6e, 0x79, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
+	0x52, 0x04, 0x61, 0x6e, 0x79, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x57, 0x65, 0x6c,
+	0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x01, 0x61, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x02, 0x2e, 0x41, 0x52, 0x01, 0x61, 0x12, 0x30, 0x0a, 0x04,
+	0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4e, 0x75, 0x6c,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x75, 0x6c, 0x6c, 0x12, 0x2c,
+	0x0a, 0x02, 0x61, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x02, 0x61, 0x74, 0x12, 0x3c, 0x0a, 0x0a,
+	0x61, 0x6e, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x57, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x2e, 0x41, 0x6e, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x09, 0x61, 0x6e, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x51, 0x0a, 0x0b, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x52, 0x0a,
+	0x0e, 0x41, 0x6e, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_testpb_json_proto_rawDescOnce sync.Once
+	file_testpb_json_proto_rawDescData = file_testpb_json_proto_rawDesc
+)
+
+func file_testpb_json_proto_rawDescGZIP() []byte {
+	file_testpb_json_proto_rawDescOnce.Do(func() {
+		file_testpb_json_proto_rawDescData = protoimpl.X.CompressGZIP(file_testpb_json_proto_rawDescData)
+	})
+	return file_testpb_json_proto_rawDescData
+}
+
+var file_testpb_json_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_testpb_json_proto_goTypes = []interface{}{
+	(*JSONWellKnown)(nil),          // 0: JSONWellKnown
+	nil,                            // 1: JSONWellKnown.ValuesEntry
+	nil,                            // 2: JSONWellKnown.AnyValuesEntry
+	(*anypb.Any)(nil),              // 3: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil),  // 4: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 5: google.protobuf.Duration
+	(*structpb.Struct)(nil),        // 6: google.protobuf.Struct
+	(*structpb.Value)(nil),         // 7: google.protobuf.Value
+	(*structpb.ListValue)(nil),     // 8: google.protobuf.ListValue
+	(structpb.NullValue)(0),        // 9: google.protobuf.NullValue
+	(*fieldmaskpb.FieldMask)(nil),  // 10: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),          // 11: google.protobuf.Empty
+	(*wrapperspb.BoolValue)(nil),   // 12: google.protobuf.BoolValue
+	(*wrapperspb.Int32Value)(nil),  // 13: google.protobuf.Int32Value
+	(*wrapperspb.Int64Value)(nil),  // 14: google.protobuf.Int64Value
+	(*wrapperspb.UInt32Value)(nil), // 15: google.protobuf.UInt32Value
+	(*wrapperspb.UInt64Value)(nil), // 16: google.protobuf.UInt64Value
+	(*wrapperspb.FloatValue)(nil),  // 17: google.protobuf.FloatValue
+	(*wrapperspb.DoubleValue)(nil), // 18: google.protobuf.DoubleValue
+	(*wrapperspb.StringValue)(nil), // 19: google.protobuf.StringValue
+	(*wrapperspb.BytesValue)(nil),  // 20: google.protobuf.BytesValue
+	(*A)(nil),                      // 21: A
+}
+var file_testpb_json_proto_depIdxs = []int32{
+	3,  // 0: JSONWellKnown.any:type_name -> google.protobuf.Any
+	4,  // 1: JSONWellKnown.timestamp:type_name -> google.protobuf.Timestamp
+	5,  // 2: JSONWellKnown.duration:type_name -> google.protobuf.Duration
+	6,  // 3: JSONWellKnown.struct:type_name -> google.protobuf.Struct
+	7,  // 4: JSONWellKnown.value:type_name -> google.protobuf.Value
+	8,  // 5: JSONWellKnown.list_value:type_name -> google.protobuf.ListValue
+	9,  // 6: JSONWellKnown.null_value:type_name -> google.protobuf.NullValue
+	10, // 7: JSONWellKnown.field_mask:type_name -> google.protobuf.FieldMask
+	11, // 8: JSONWellKnown.empty:type_name -> google.protobuf.Empty
+	12, // 9: JSONWellKnown.bool_value:type_name -> google.protobuf.BoolValue
+	13, // 10: JSONWellKnown.int32_value:type_name -> google.protobuf.Int32Value
+	14, // 11: JSONWellKnown.int64_value:type_name -> google.protobuf.Int64Value
+	15, // 12: JSONWellKnown.uint32_value:type_name -> google.protobuf.UInt32Value
+	16, // 13: JSONWellKnown.uint64_value:type_name -> google.protobuf.UInt64Value
+	17, // 14: JSONWellKnown.float_value:type_name -> google.protobuf.FloatValue
+	18, // 15: JSONWellKnown.double_value:type_name -> google.protobuf.DoubleValue
+	19, // 16: JSONWellKnown.string_value:type_name -> google.protobuf.StringValue
+	20, // 17: JSONWellKnown.bytes_value:type_name -> google.protobuf.BytesValue
+	3,  // 18: JSONWellKnown.anys:type_name -> google.protobuf.Any
+	1,  // 19: JSONWellKnown.values:type_name -> JSONWellKnown.ValuesEntry
+	21, // 20: JSONWellKnown.a:type_name -> A
+	9,  // 21: JSONWellKnown.null:type_name -> google.protobuf.NullValue
+	4,  // 22: JSONWellKnown.at:type_name -> google.protobuf.Timestamp
+	2,  // 23: JSONWellKnown.any_values:type_name -> JSONWellKnown.AnyValuesEntry
+	7,  // 24: JSONWellKnown.ValuesEntry.value:type_name -> google.protobuf.Value
+	3,  // 25: JSONWellKnown.AnyValuesEntry.value:type_name -> google.protobuf.Any
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_testpb_json_proto_init() }
+func file_testpb_json_proto_init() {
+	if File_testpb_json_proto != nil {
+		return
+	}
+	file_testpb_1_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_testpb_json_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSONWellKnown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_testpb_json_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*JSONWellKnown_Null)(nil),
+		(*JSONWellKnown_At)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_testpb_json_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_testpb_json_proto_goTypes,
+		DependencyIndexes: file_testpb_json_proto_depIdxs,
+		MessageInfos:      file_testpb_json_proto_msgTypes,
+	}.Build()
+	File_testpb_json_proto = out.File
+	file_testpb_json_proto_rawDesc = nil
+	file_testpb_json_proto_goTypes = nil
+	file_testpb_json_proto_depIdxs = nil
+}
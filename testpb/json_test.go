@@ -0,0 +1,254 @@
+package testpb
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-proto/internal/fuzz"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"pgregory.net/rapid"
+)
+
+func newJSONWellKnown(t *testing.T) *JSONWellKnown {
+	anyA, err := anypb.New(&A{STRING: "any", UINT64: 1, MESSAGE: &B{X: "nested"}})
+	require.NoError(t, err)
+	timestamp, err := anypb.New(timestamppb.New(time.Unix(1, 5000)))
+	require.NoError(t, err)
+	value, err := structpb.NewValue(map[string]any{"list": []any{1.5, "a", nil, true}, "object": map[string]any{}})
+	require.NoError(t, err)
+	return &JSONWellKnown{
+		Any:         anyA,
+		Timestamp:   timestamppb.New(time.Date(2020, 1, 2, 3, 4, 5, 600, time.UTC)),
+		Duration:    durationpb.New(-1500 * time.Millisecond),
+		Struct:      value.GetStructValue(),
+		Value:       structpb.NewNullValue(),
+		ListValue:   &structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("s")}},
+		FieldMask:   &fieldmaskpb.FieldMask{Paths: []string{"a.b_c", "d"}},
+		Empty:       &emptypb.Empty{},
+		BoolValue:   wrapperspb.Bool(false),
+		Int32Value:  wrapperspb.Int32(-1),
+		Int64Value:  wrapperspb.Int64(-2),
+		Uint32Value: wrapperspb.UInt32(3),
+		Uint64Value: wrapperspb.UInt64(4),
+		FloatValue:  wrapperspb.Float(0.5),
+		DoubleValue: wrapperspb.Double(-0.25),
+		StringValue: wrapperspb.String(""),
+		BytesValue:  wrapperspb.Bytes([]byte("bytes")),
+		Anys:        []*anypb.Any{timestamp, anyA},
+		Values:      map[string]*structpb.Value{"b": structpb.NewBoolValue(true), "a": structpb.NewNumberValue(1)},
+		A:           &A{INT64: -5, Enum: Enumeration_Two},
+		Kind:        &JSONWellKnown_Null{},
+	}
+}
+
+func TestJSONWellKnown(t *testing.T) {
+	msg := newJSONWellKnown(t)
+	want, err := protojson.Marshal(msg)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, json.Compact(&buf, want))
+	got, err := msg.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, buf.String(), string(got))
+
+	decoded := new(JSONWellKnown)
+	require.NoError(t, decoded.UnmarshalJSON(got))
+	require.True(t, proto.Equal(msg, decoded))
+
+	empty, err := (&JSONWellKnown{}).MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, `{}`, string(empty))
+}
+
+func TestUnmarshalJSONWellKnown(t *testing.T) {
+	for name, tc := range map[string]struct {
+		json string
+		want *JSONWellKnown
+	}{
+		"null value":       {json: `{"value":null,"nullValue":null}`, want: &JSONWellKnown{Value: structpb.NewNullValue()}},
+		"null oneof":       {json: `{"null":null}`, want: &JSONWellKnown{Kind: &JSONWellKnown_Null{}}},
+		"timestamp offset": {json: `{"at":"1970-01-01T01:00:00+01:00"}`, want: &JSONWellKnown{Kind: &JSONWellKnown_At{At: &timestamppb.Timestamp{}}}},
+		"any type first":   {json: `{"any":{"@type":"type.googleapis.com/google.protobuf.Duration","value":"1s"}}`, want: &JSONWellKnown{Any: mustAny(t, durationpb.New(time.Second))}},
+		"any type last":    {json: `{"any":{"STRING":"s","@type":"type.googleapis.com/A"}}`, want: &JSONWellKnown{Any: mustAny(t, &A{STRING: "s"})}},
+		"wrapper null":     {json: `{"int32Value":null}`, want: &JSONWellKnown{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got := new(JSONWellKnown)
+			require.NoError(t, got.UnmarshalJSON([]byte(tc.json)))
+			require.True(t, proto.Equal(tc.want, got), "%v", got)
+		})
+	}
+
+	for name, input := range map[string]string{
+		"unresolvable any":   `{"any":{"@type":"type.googleapis.com/Unknown"}}`,
+		"any without type":   `{"any":{"STRING":"s"}}`,
+		"invalid duration":   `{"duration":"1"}`,
+		"invalid timestamp":  `{"timestamp":"2020-01-02"}`,
+		"invalid field mask": `{"fieldMask":"a_b"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, protojson.Unmarshal([]byte(input), &JSONWellKnown{}))
+			require.Error(t, new(JSONWellKnown).UnmarshalJSON([]byte(input)))
+		})
+	}
+}
+
+func mustAny(t *testing.T, m proto.Message) *anypb.Any {
+	a, err := anypb.New(m)
+	require.NoError(t, err)
+	return a
+}
+
+func TestJSONWellKnownRapid(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		msg := &JSONWellKnown{
+			Timestamp:   genTimestamp.Draw(t, "timestamp").(*timestamppb.Timestamp),
+			Duration:    genDuration.Draw(t, "duration").(*durationpb.Duration),
+			Struct:      genStruct(0).Draw(t, "struct").(*structpb.Struct),
+			Value:       genValue(0).Draw(t, "value").(*structpb.Value),
+			ListValue:   genListValue(0).Draw(t, "list value").(*structpb.ListValue),
+			FieldMask:   &fieldmaskpb.FieldMask{Paths: rapid.SliceOf(genFieldPath).Draw(t, "paths").([]string)},
+			Empty:       &emptypb.Empty{},
+			BoolValue:   wrapperspb.Bool(rapid.Bool().Draw(t, "bool").(bool)),
+			Int32Value:  wrapperspb.Int32(rapid.Int32().Draw(t, "int32").(int32)),
+			Int64Value:  wrapperspb.Int64(rapid.Int64().Draw(t, "int64").(int64)),
+			Uint32Value: wrapperspb.UInt32(rapid.Uint32().Draw(t, "uint32").(uint32)),
+			Uint64Value: wrapperspb.UInt64(rapid.Uint64().Draw(t, "uint64").(uint64)),
+			FloatValue:  wrapperspb.Float(rapid.Float32().Draw(t, "float").(float32)),
+			DoubleValue: wrapperspb.Double(rapid.Float64().Draw(t, "double").(float64)),
+			StringValue: wrapperspb.String(rapid.String().Draw(t, "string").(string)),
+			BytesValue:  wrapperspb.Bytes(rapid.SliceOf(rapid.Byte()).Draw(t, "bytes").([]byte)),
+			Anys:        rapid.SliceOfN(genAny, 0, 3).Draw(t, "anys").([]*anypb.Any),
+			Values:      rapid.MapOfN(rapid.String(), genValue(0), 0, 3).Draw(t, "values").(map[string]*structpb.Value),
+			A:           fuzz.Message(t, (&A{}).ProtoReflect().Type()).Interface().(*A),
+			AnyValues:   rapid.MapOfN(rapid.String(), genAny, 0, 3).Draw(t, "any values").(map[string]*anypb.Any),
+		}
+		if rapid.Bool().Draw(t, "has any").(bool) {
+			msg.Any = genAny.Draw(t, "any").(*anypb.Any)
+		}
+		switch rapid.IntRange(0, 2).Draw(t, "kind").(int) {
+		case 1:
+			msg.Kind = &JSONWellKnown_Null{}
+		case 2:
+			msg.Kind = &JSONWellKnown_At{At: genTimestamp.Draw(t, "at").(*timestamppb.Timestamp)}
+		}
+
+		want, err := protojson.Marshal(msg)
+		require.NoError(t, err)
+		var buf bytes.Buffer
+		require.NoError(t, json.Compact(&buf, want))
+		got, err := msg.MarshalJSON()
+		require.NoError(t, err)
+		require.Equal(t, buf.String(), string(got))
+
+		// packed values holding maps may be encoded in any order, so decoded messages are compared
+		// through their JSON, which sorts map keys, rather than with proto.Equal
+		decoded := new(JSONWellKnown)
+		require.NoError(t, decoded.UnmarshalJSON(got))
+		again, err := decoded.MarshalJSON()
+		require.NoError(t, err)
+		require.Equal(t, string(got), string(again))
+
+		reference := new(JSONWellKnown)
+		require.NoError(t, protojson.Unmarshal(got, reference))
+		again, err = reference.MarshalJSON()
+		require.NoError(t, err)
+		require.Equal(t, string(got), string(again))
+	})
+}
+
+// genTimestamp generates timestamps within the range JSON can represent, 0001-01-01 to 9999-12-31.
+var genTimestamp = rapid.Custom(func(t *rapid.T) *timestamppb.Timestamp {
+	return &timestamppb.Timestamp{
+		Seconds: rapid.Int64Range(-62135596800, 253402300799).Draw(t, "seconds").(int64),
+		Nanos:   rapid.Int32Range(0, 999999999).Draw(t, "nanos").(int32),
+	}
+})
+
+// genDuration generates durations within ±10000 years, whose seconds and nanos share a sign.
+var genDuration = rapid.Custom(func(t *rapid.T) *durationpb.Duration {
+	d := &durationpb.Duration{
+		Seconds: rapid.Int64Range(-315576000000, 315576000000).Draw(t, "seconds").(int64),
+		Nanos:   rapid.Int32Range(0, 999999999).Draw(t, "nanos").(int32),
+	}
+	if d.Seconds < 0 || d.Seconds == 0 && rapid.Bool().Draw(t, "negative").(bool) {
+		d.Nanos = -d.Nanos
+	}
+	return d
+})
+
+// genFieldPath generates field mask paths which round trip through their lowerCamelCase JSON form.
+var genFieldPath = rapid.StringMatching(`[a-z][a-z0-9]*(_[a-z][a-z0-9]*)*(\.[a-z][a-z0-9]*(_[a-z][a-z0-9]*)*)*`)
+
+// genAny generates Any values packing a type the global registry resolves.
+var genAny = rapid.Custom(func(t *rapid.T) *anypb.Any {
+	var packed proto.Message
+	switch rapid.IntRange(0, 4).Draw(t, "packed type").(int) {
+	case 0:
+		packed = fuzz.Message(t, (&A{}).ProtoReflect().Type()).Interface()
+	case 1:
+		packed = genTimestamp.Draw(t, "timestamp").(*timestamppb.Timestamp)
+	case 2:
+		packed = genDuration.Draw(t, "duration").(*durationpb.Duration)
+	case 3:
+		packed = genValue(0).Draw(t, "value").(*structpb.Value)
+	default:
+		packed = wrapperspb.Int64(rapid.Int64().Draw(t, "int64").(int64))
+	}
+	a, err := anypb.New(packed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+})
+
+// maxValueDepth bounds the nesting of generated structpb values.
+const maxValueDepth = 2
+
+// genValue generates a structpb.Value holding finite numbers, nesting structs and lists up to maxValueDepth.
+func genValue(depth int) *rapid.Generator {
+	return rapid.Custom(func(t *rapid.T) *structpb.Value {
+		kinds := 4
+		if depth < maxValueDepth {
+			kinds = 6
+		}
+		switch rapid.IntRange(0, kinds-1).Draw(t, "value kind").(int) {
+		case 0:
+			return structpb.NewNullValue()
+		case 1:
+			return structpb.NewBoolValue(rapid.Bool().Draw(t, "bool").(bool))
+		case 2:
+			return structpb.NewNumberValue(rapid.Float64Range(-math.MaxFloat64, math.MaxFloat64).Draw(t, "number").(float64))
+		case 3:
+			return structpb.NewStringValue(rapid.String().Draw(t, "string").(string))
+		case 4:
+			return structpb.NewStructValue(genStruct(depth+1).Draw(t, "struct").(*structpb.Struct))
+		default:
+			return structpb.NewListValue(genListValue(depth+1).Draw(t, "list").(*structpb.ListValue))
+		}
+	})
+}
+
+func genStruct(depth int) *rapid.Generator {
+	return rapid.Custom(func(t *rapid.T) *structpb.Struct {
+		return &structpb.Struct{Fields: rapid.MapOfN(rapid.String(), genValue(depth), 0, 3).Draw(t, "fields").(map[string]*structpb.Value)}
+	})
+}
+
+func genListValue(depth int) *rapid.Generator {
+	return rapid.Custom(func(t *rapid.T) *structpb.ListValue {
+		return &structpb.ListValue{Values: rapid.SliceOfN(genValue(depth), 0, 3).Draw(t, "values").([]*structpb.Value)}
+	})
+}
@@ -0,0 +1,2509 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package testpb
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	math "math"
+	reflect "reflect"
+	sync "sync"
+	atomic "sync/atomic"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
+)
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *LazyBlock) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *LazyBlock) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if len(x.EagerTxs) > 0 {
+		b = append(b, "\"eager_txs\":"...)
+		b = append(b, '[')
+		for _, v := range x.EagerTxs {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if x.Header != nil {
+		b = append(b, "\"header\":"...)
+		if b, err = x.Header.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	x.lazyDecodeLastHeader()
+	if x.LastHeader != nil {
+		b = append(b, "\"last_header\":"...)
+		if b, err = x.LastHeader.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	x.lazyDecodeTxs()
+	if len(x.Txs) > 0 {
+		b = append(b, "\"txs\":"...)
+		b = append(b, '[')
+		for _, v := range x.Txs {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *LazyHeader) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *LazyHeader) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.ChainId != "" {
+		b = append(b, "\"chain_id\":"...)
+		b = runtime.AppendAminoJSONString(b, x.ChainId)
+		b = append(b, ',')
+	}
+	if x.Height != 0 {
+		b = append(b, "\"height\":"...)
+		b = runtime.AppendJSONUint64(b, x.Height)
+		b = append(b, ',')
+	}
+	x.lazyDecodeParent()
+	if x.Parent != nil {
+		b = append(b, "\"parent\":"...)
+		if b, err = x.Parent.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+var _ protoreflect.List = (*_LazyBlock_2_list)(nil)
+
+type _LazyBlock_2_list struct {
+	list *[]*A
+}
+
+func (x *_LazyBlock_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LazyBlock_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LazyBlock_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*A)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LazyBlock_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*A)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LazyBlock_2_list) AppendMutable() protoreflect.Value {
+	v := new(A)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LazyBlock_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LazyBlock_2_list) NewElement() protoreflect.Value {
+	v := new(A)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LazyBlock_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_LazyBlock_4_list)(nil)
+
+type _LazyBlock_4_list struct {
+	list *[]*A
+}
+
+func (x *_LazyBlock_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LazyBlock_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LazyBlock_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*A)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LazyBlock_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*A)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LazyBlock_4_list) AppendMutable() protoreflect.Value {
+	v := new(A)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LazyBlock_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LazyBlock_4_list) NewElement() protoreflect.Value {
+	v := new(A)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LazyBlock_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_LazyBlock             protoreflect.MessageDescriptor
+	fd_LazyBlock_header      protoreflect.FieldDescriptor
+	fd_LazyBlock_txs         protoreflect.FieldDescriptor
+	fd_LazyBlock_last_header protoreflect.FieldDescriptor
+	fd_LazyBlock_eager_txs   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_testpb_lazy_proto_init()
+	md_LazyBlock = File_testpb_lazy_proto.Messages().ByName("LazyBlock")
+	fd_LazyBlock_header = md_LazyBlock.Fields().ByName("header")
+	fd_LazyBlock_txs = md_LazyBlock.Fields().ByName("txs")
+	fd_LazyBlock_last_header = md_LazyBlock.Fields().ByName("last_header")
+	fd_LazyBlock_eager_txs = md_LazyBlock.Fields().ByName("eager_txs")
+}
+
+var _ protoreflect.Message = (*fastReflection_LazyBlock)(nil)
+
+type fastReflection_LazyBlock LazyBlock
+
+func (x *LazyBlock) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LazyBlock)(x)
+}
+
+func (x *LazyBlock) slowProtoReflect() protoreflect.Message {
+	mi := &file_testpb_lazy_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LazyBlock_messageType fastReflection_LazyBlock_messageType
+var _ protoreflect.MessageType = fastReflection_LazyBlock_messageType{}
+
+type fastReflection_LazyBlock_messageType struct{}
+
+func (x fastReflection_LazyBlock_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LazyBlock)(nil)
+}
+func (x fastReflection_LazyBlock_messageType) New() protoreflect.Message {
+	return new(fastReflection_LazyBlock)
+}
+func (x fastReflection_LazyBlock_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LazyBlock
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LazyBlock) Descriptor() protoreflect.MessageDescriptor {
+	return md_LazyBlock
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LazyBlock) Type() protoreflect.MessageType {
+	return _fastReflection_LazyBlock_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LazyBlock) New() protoreflect.Message {
+	return new(fastReflection_LazyBlock)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LazyBlock) Interface() protoreflect.ProtoMessage {
+	return (*LazyBlock)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LazyBlock) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Header != nil {
+		value := protoreflect.ValueOfMessage(x.Header.ProtoReflect())
+		if !f(fd_LazyBlock_header, value) {
+			return
+		}
+	}
+	(*LazyBlock)(x).lazyDecodeTxs()
+	if len(x.Txs) != 0 {
+		value := protoreflect.ValueOfList(&_LazyBlock_2_list{list: &x.Txs})
+		if !f(fd_LazyBlock_txs, value) {
+			return
+		}
+	}
+	(*LazyBlock)(x).lazyDecodeLastHeader()
+	if x.LastHeader != nil {
+		value := protoreflect.ValueOfMessage(x.LastHeader.ProtoReflect())
+		if !f(fd_LazyBlock_last_header, value) {
+			return
+		}
+	}
+	if len(x.EagerTxs) != 0 {
+		value := protoreflect.ValueOfList(&_LazyBlock_4_list{list: &x.EagerTxs})
+		if !f(fd_LazyBlock_eager_txs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LazyBlock) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // LazyBlock.header
+		if fd.FullName() != "LazyBlock.header" {
+			break
+		}
+		return x.Header != nil
+	case 2: // LazyBlock.txs
+		if fd.FullName() != "LazyBlock.txs" {
+			break
+		}
+		if x.lazyFields.Pending(0) {
+			return true
+		}
+		return len(x.Txs) != 0
+	case 3: // LazyBlock.last_header
+		if fd != fd_LazyBlock_last_header {
+			break
+		}
+		if x.lazyFields.Pending(1) {
+			return true
+		}
+		return x.LastHeader != nil
+	case 4: // LazyBlock.eager_txs
+		if fd != fd_LazyBlock_eager_txs {
+			break
+		}
+		return len(x.EagerTxs) != 0
+	}
+	if fd := runtime.FieldOf(fd, md_LazyBlock); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message LazyBlock does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message LazyBlock does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LazyBlock) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // LazyBlock.header
+		if fd.FullName() != "LazyBlock.header" {
+			break
+		}
+		x.Header = nil
+		return
+	case 2: // LazyBlock.txs
+		if fd.FullName() != "LazyBlock.txs" {
+			break
+		}
+		x.lazyFields.Discard(0)
+		x.Txs = nil
+		return
+	case 3: // LazyBlock.last_header
+		if fd != fd_LazyBlock_last_header {
+			break
+		}
+		x.lazyFields.Discard(1)
+		x.LastHeader = nil
+		return
+	case 4: // LazyBlock.eager_txs
+		if fd != fd_LazyBlock_eager_txs {
+			break
+		}
+		x.EagerTxs = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_LazyBlock); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message LazyBlock does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message LazyBlock does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LazyBlock) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // LazyBlock.header
+		if descriptor.FullName() != "LazyBlock.header" {
+			break
+		}
+		value := x.Header
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 2: // LazyBlock.txs
+		if descriptor.FullName() != "LazyBlock.txs" {
+			break
+		}
+		(*LazyBlock)(x).lazyDecodeTxs()
+		if len(x.Txs) == 0 {
+			return protoreflect.ValueOfList(&_LazyBlock_2_list{})
+		}
+		listValue := &_LazyBlock_2_list{list: &x.Txs}
+		return protoreflect.ValueOfList(listValue)
+	case 3: // LazyBlock.last_header
+		if descriptor != fd_LazyBlock_last_header {
+			break
+		}
+		(*LazyBlock)(x).lazyDecodeLastHeader()
+		value := x.LastHeader
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 4: // LazyBlock.eager_txs
+		if descriptor != fd_LazyBlock_eager_txs {
+			break
+		}
+		if len(x.EagerTxs) == 0 {
+			return protoreflect.ValueOfList(&_LazyBlock_4_list{})
+		}
+		listValue := &_LazyBlock_4_list{list: &x.EagerTxs}
+		return protoreflect.ValueOfList(listValue)
+	}
+	if fd := runtime.FieldOf(descriptor, md_LazyBlock); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message LazyBlock does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message LazyBlock does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LazyBlock) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // LazyBlock.header
+		if fd.FullName() != "LazyBlock.header" {
+			break
+		}
+		x.Header = value.Message().Interface().(*LazyHeader)
+		return
+	case 2: // LazyBlock.txs
+		if fd.FullName() != "LazyBlock.txs" {
+			break
+		}
+		x.lazyFields.Discard(0)
+		lv := value.List()
+		clv := lv.(*_LazyBlock_2_list)
+		x.Txs = *clv.list
+		return
+	case 3: // LazyBlock.last_header
+		if fd != fd_LazyBlock_last_header {
+			break
+		}
+		x.lazyFields.Discard(1)
+		x.LastHeader = value.Message().Interface().(*LazyHeader)
+		return
+	case 4: // LazyBlock.eager_txs
+		if fd != fd_LazyBlock_eager_txs {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_LazyBlock_4_list)
+		x.EagerTxs = *clv.list
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_LazyBlock); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message LazyBlock does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message LazyBlock does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LazyBlock) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // LazyBlock.header
+		if fd.FullName() != "LazyBlock.header" {
+			break
+		}
+		if x.Header == nil {
+			x.Header = new(LazyHeader)
+		}
+		return protoreflect.ValueOfMessage(x.Header.ProtoReflect())
+	case 2: // LazyBlock.txs
+		if fd.FullName() != "LazyBlock.txs" {
+			break
+		}
+		(*LazyBlock)(x).lazyDecodeTxs()
+		x.lazyFields.Discard(0)
+		if x.Txs == nil {
+			x.Txs = []*A{}
+		}
+		value := &_LazyBlock_2_list{list: &x.Txs}
+		return protoreflect.ValueOfList(value)
+	case 3: // LazyBlock.last_header
+		if fd != fd_LazyBlock_last_header {
+			break
+		}
+		(*LazyBlock)(x).lazyDecodeLastHeader()
+		x.lazyFields.Discard(1)
+		if x.LastHeader == nil {
+			x.LastHeader = new(LazyHeader)
+		}
+		return protoreflect.ValueOfMessage(x.LastHeader.ProtoReflect())
+	case 4: // LazyBlock.eager_txs
+		if fd != fd_LazyBlock_eager_txs {
+			break
+		}
+		if x.EagerTxs == nil {
+			x.EagerTxs = []*A{}
+		}
+		value := &_LazyBlock_4_list{list: &x.EagerTxs}
+		return protoreflect.ValueOfList(value)
+	}
+	if fd := runtime.FieldOf(fd, md_LazyBlock); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message LazyBlock does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message LazyBlock does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LazyBlock) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // LazyBlock.header
+		if fd.FullName() != "LazyBlock.header" {
+			break
+		}
+		m := new(LazyHeader)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 2: // LazyBlock.txs
+		if fd.FullName() != "LazyBlock.txs" {
+			break
+		}
+		list := []*A{}
+		return protoreflect.ValueOfList(&_LazyBlock_2_list{list: &list})
+	case 3: // LazyBlock.last_header
+		if fd != fd_LazyBlock_last_header {
+			break
+		}
+		m := new(LazyHeader)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 4: // LazyBlock.eager_txs
+		if fd != fd_LazyBlock_eager_txs {
+			break
+		}
+		list := []*A{}
+		return protoreflect.ValueOfList(&_LazyBlock_4_list{list: &list})
+	}
+	if fd := runtime.FieldOf(fd, md_LazyBlock); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message LazyBlock does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message LazyBlock does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LazyBlock) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in LazyBlock", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LazyBlock) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LazyBlock) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LazyBlock) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LazyBlock) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_LazyBlock_methods
+}
+
+var _fastReflection_LazyBlock_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_LazyBlock_size,
+	Marshal:           _fastReflection_LazyBlock_marshal,
+	Unmarshal:         _fastReflection_LazyBlock_unmarshal,
+	Merge:             _fastReflection_LazyBlock_merge,
+	CheckInitialized:  _fastReflection_LazyBlock_checkInitialized,
+	Equal:             _fastReflection_LazyBlock_equal,
+}
+
+func _fastReflection_LazyBlock_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*LazyBlock)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.Header != nil {
+		l = options.Size(x.Header)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if raw := runtime.RawLazyList(&x.lazyFields, 0, &x.Txs); raw != nil {
+		n += len(raw)
+	} else {
+		if len(x.Txs) > 0 {
+			for _, e := range x.Txs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+	}
+	if raw := runtime.RawLazy(&x.lazyFields, 1, &x.LastHeader); raw != nil {
+		n += len(raw)
+	} else {
+		if x.LastHeader != nil {
+			l = options.Size(x.LastHeader)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if len(x.EagerTxs) > 0 {
+		for _, e := range x.EagerTxs {
+			l = options.Size(e)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_LazyBlock_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*LazyBlock)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	x.lazyFields.StartMarshal()
+	defer x.lazyFields.EndMarshal()
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if len(x.EagerTxs) > 0 {
+		for iNdEx := len(x.EagerTxs) - 1; iNdEx >= 0; iNdEx-- {
+			l = options.Size(x.EagerTxs[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.EagerTxs[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if raw := runtime.RawLazy(&x.lazyFields, 1, &x.LastHeader); raw != nil {
+		i -= len(raw)
+		copy(dAtA[i:], raw)
+	} else {
+		if x.LastHeader != nil {
+			l = options.Size(x.LastHeader)
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.LastHeader); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if raw := runtime.RawLazyList(&x.lazyFields, 0, &x.Txs); raw != nil {
+		i -= len(raw)
+		copy(dAtA[i:], raw)
+	} else {
+		if len(x.Txs) > 0 {
+			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
+				l = options.Size(x.Txs[iNdEx])
+				i -= l
+				if encoded, err := options.MarshalAppend(dAtA[:i], x.Txs[iNdEx]); err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				} else if len(encoded) != i+l {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, runtime.ErrSizeMismatch
+				}
+				i = runtime.EncodeVarint(dAtA, i, uint64(l))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+	}
+	if x.Header != nil {
+		l = options.Size(x.Header)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Header); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0xa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_LazyBlock_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*LazyBlock)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_LazyBlock.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LazyBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LazyBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Header == nil {
+				if err := budget.Message(fd_LazyBlock_header, int(unsafe.Sizeof(LazyHeader{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Header = &LazyHeader{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Header); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_LazyBlock_header, -1)
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			if err := budget.List(fd_LazyBlock_txs, len(x.Txs), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if len(x.Txs) == 0 && x.lazyFields.Append(0, 2, dAtA[preIndex:postIndex], fd_LazyBlock_txs.Message(), nil, options) {
+				iNdEx = postIndex
+				break
+			}
+			x.lazyDecodeTxs()
+			x.lazyFields.Discard(0)
+			if err := budget.Message(fd_LazyBlock_txs, int(unsafe.Sizeof(A{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.Txs = append(x.Txs, &A{})
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_LazyBlock_txs, len(x.Txs)-1)
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.LastHeader == nil && x.lazyFields.Append(1, 2, dAtA[preIndex:postIndex], fd_LazyBlock_last_header.Message(), nil, options) {
+				iNdEx = postIndex
+				break
+			}
+			x.lazyDecodeLastHeader()
+			x.lazyFields.Discard(1)
+			if x.LastHeader == nil {
+				if err := budget.Message(fd_LazyBlock_last_header, int(unsafe.Sizeof(LazyHeader{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.LastHeader = &LazyHeader{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastHeader); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_LazyBlock_last_header, -1)
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EagerTxs", wireType)
+			}
+			if err := budget.List(fd_LazyBlock_eager_txs, len(x.EagerTxs), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Message(fd_LazyBlock_eager_txs, int(unsafe.Sizeof(A{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.EagerTxs = append(x.EagerTxs, &A{})
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EagerTxs[len(x.EagerTxs)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_LazyBlock_eager_txs, len(x.EagerTxs)-1)
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_LazyBlock, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_LazyBlock_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*LazyBlock)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*LazyBlock)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.Header != nil {
+		if dst.Header == nil {
+			dst.Header = &LazyHeader{}
+		}
+		proto.Merge(dst.Header, src.Header)
+	}
+	src.lazyDecodeTxs()
+	dst.lazyDecodeTxs()
+	dst.lazyFields.Discard(0)
+	for _, v := range src.Txs {
+		m := &A{}
+		proto.Merge(m, v)
+		dst.Txs = append(dst.Txs, m)
+	}
+	src.lazyDecodeLastHeader()
+	dst.lazyDecodeLastHeader()
+	dst.lazyFields.Discard(1)
+	if src.LastHeader != nil {
+		if dst.LastHeader == nil {
+			dst.LastHeader = &LazyHeader{}
+		}
+		proto.Merge(dst.LastHeader, src.LastHeader)
+	}
+	for _, v := range src.EagerTxs {
+		m := &A{}
+		proto.Merge(m, v)
+		dst.EagerTxs = append(dst.EagerTxs, m)
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_LazyBlock_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_LazyBlock_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*LazyBlock)
+	y, yok := input.MessageB.Interface().(*LazyBlock)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !x.Header.Equal(y.Header) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	x.lazyDecodeTxs()
+	y.lazyDecodeTxs()
+	if len(x.Txs) != len(y.Txs) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.Txs {
+		if !v.Equal(y.Txs[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	x.lazyDecodeLastHeader()
+	y.lazyDecodeLastHeader()
+	if !x.LastHeader.Equal(y.LastHeader) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.EagerTxs) != len(y.EagerTxs) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.EagerTxs {
+		if !v.Equal(y.EagerTxs[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *LazyBlock) Clone() *LazyBlock {
+	if x == nil {
+		return nil
+	}
+	dst := new(LazyBlock)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *LazyBlock) CopyInto(dst *LazyBlock) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.Header = x.Header.Clone()
+	x.lazyDecodeTxs()
+	dst.lazyFields.Discard(0)
+	if x.Txs != nil {
+		dst.Txs = make([]*A, len(x.Txs))
+		for i, v := range x.Txs {
+			dst.Txs[i] = v.Clone()
+		}
+	} else {
+		dst.Txs = nil
+	}
+	x.lazyDecodeLastHeader()
+	dst.lazyFields.Discard(1)
+	dst.LastHeader = x.LastHeader.Clone()
+	if x.EagerTxs != nil {
+		dst.EagerTxs = make([]*A, len(x.EagerTxs))
+		for i, v := range x.EagerTxs {
+			dst.EagerTxs[i] = v.Clone()
+		}
+	} else {
+		dst.EagerTxs = nil
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *LazyBlock) Equal(y *LazyBlock) bool {
+	return _fastReflection_LazyBlock_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a LazyBlock, or nil if b is canonical.
+func (*LazyBlock) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_LazyBlock.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Message("header", false, (*LazyHeader)(nil))
+		case 2:
+			w.Message("txs", true, (*A)(nil))
+		case 3:
+			w.Message("last_header", false, (*LazyHeader)(nil))
+		case 4:
+			w.Message("eager_txs", true, (*A)(nil))
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_LazyHeader          protoreflect.MessageDescriptor
+	fd_LazyHeader_height   protoreflect.FieldDescriptor
+	fd_LazyHeader_chain_id protoreflect.FieldDescriptor
+	fd_LazyHeader_parent   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_testpb_lazy_proto_init()
+	md_LazyHeader = File_testpb_lazy_proto.Messages().ByName("LazyHeader")
+	fd_LazyHeader_height = md_LazyHeader.Fields().ByName("height")
+	fd_LazyHeader_chain_id = md_LazyHeader.Fields().ByName("chain_id")
+	fd_LazyHeader_parent = md_LazyHeader.Fields().ByName("parent")
+}
+
+var _ protoreflect.Message = (*fastReflection_LazyHeader)(nil)
+
+type fastReflection_LazyHeader LazyHeader
+
+func (x *LazyHeader) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LazyHeader)(x)
+}
+
+func (x *LazyHeader) slowProtoReflect() protoreflect.Message {
+	mi := &file_testpb_lazy_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LazyHeader_messageType fastReflection_LazyHeader_messageType
+var _ protoreflect.MessageType = fastReflection_LazyHeader_messageType{}
+
+type fastReflection_LazyHeader_messageType struct{}
+
+func (x fastReflection_LazyHeader_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LazyHeader)(nil)
+}
+func (x fastReflection_LazyHeader_messageType) New() protoreflect.Message {
+	return new(fastReflection_LazyHeader)
+}
+func (x fastReflection_LazyHeader_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LazyHeader
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LazyHeader) Descriptor() protoreflect.MessageDescriptor {
+	return md_LazyHeader
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LazyHeader) Type() protoreflect.MessageType {
+	return _fastReflection_LazyHeader_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LazyHeader) New() protoreflect.Message {
+	return new(fastReflection_LazyHeader)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LazyHeader) Interface() protoreflect.ProtoMessage {
+	return (*LazyHeader)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LazyHeader) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_LazyHeader_height, value) {
+			return
+		}
+	}
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_LazyHeader_chain_id, value) {
+			return
+		}
+	}
+	(*LazyHeader)(x).lazyDecodeParent()
+	if x.Parent != nil {
+		value := protoreflect.ValueOfMessage(x.Parent.ProtoReflect())
+		if !f(fd_LazyHeader_parent, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LazyHeader) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // LazyHeader.height
+		if fd != fd_LazyHeader_height {
+			break
+		}
+		return x.Height != uint64(0)
+	case 2: // LazyHeader.chain_id
+		if fd != fd_LazyHeader_chain_id {
+			break
+		}
+		return x.ChainId != ""
+	case 3: // LazyHeader.parent
+		if fd != fd_LazyHeader_parent {
+			break
+		}
+		if x.lazyFields.Pending(0) {
+			return true
+		}
+		return x.Parent != nil
+	}
+	if fd := runtime.FieldOf(fd, md_LazyHeader); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message LazyHeader does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message LazyHeader does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LazyHeader) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // LazyHeader.height
+		if fd != fd_LazyHeader_height {
+			break
+		}
+		x.Height = uint64(0)
+		return
+	case 2: // LazyHeader.chain_id
+		if fd != fd_LazyHeader_chain_id {
+			break
+		}
+		x.ChainId = ""
+		return
+	case 3: // LazyHeader.parent
+		if fd != fd_LazyHeader_parent {
+			break
+		}
+		x.lazyFields.Discard(0)
+		x.Parent = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_LazyHeader); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message LazyHeader does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message LazyHeader does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LazyHeader) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // LazyHeader.height
+		if descriptor != fd_LazyHeader_height {
+			break
+		}
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	case 2: // LazyHeader.chain_id
+		if descriptor != fd_LazyHeader_chain_id {
+			break
+		}
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case 3: // LazyHeader.parent
+		if descriptor != fd_LazyHeader_parent {
+			break
+		}
+		(*LazyHeader)(x).lazyDecodeParent()
+		value := x.Parent
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(descriptor, md_LazyHeader); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message LazyHeader does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message LazyHeader does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LazyHeader) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // LazyHeader.height
+		if fd != fd_LazyHeader_height {
+			break
+		}
+		x.Height = value.Uint()
+		return
+	case 2: // LazyHeader.chain_id
+		if fd != fd_LazyHeader_chain_id {
+			break
+		}
+		x.ChainId = value.Interface().(string)
+		return
+	case 3: // LazyHeader.parent
+		if fd != fd_LazyHeader_parent {
+			break
+		}
+		x.lazyFields.Discard(0)
+		x.Parent = value.Message().Interface().(*LazyBlock)
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_LazyHeader); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message LazyHeader does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message LazyHeader does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LazyHeader) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 3: // LazyHeader.parent
+		if fd != fd_LazyHeader_parent {
+			break
+		}
+		(*LazyHeader)(x).lazyDecodeParent()
+		x.lazyFields.Discard(0)
+		if x.Parent == nil {
+			x.Parent = new(LazyBlock)
+		}
+		return protoreflect.ValueOfMessage(x.Parent.ProtoReflect())
+	case 1: // LazyHeader.height
+		if fd != fd_LazyHeader_height {
+			break
+		}
+		panic(fmt.Errorf("field height of message LazyHeader is not mutable"))
+	case 2: // LazyHeader.chain_id
+		if fd != fd_LazyHeader_chain_id {
+			break
+		}
+		panic(fmt.Errorf("field chain_id of message LazyHeader is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_LazyHeader); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message LazyHeader does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message LazyHeader does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LazyHeader) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // LazyHeader.height
+		if fd != fd_LazyHeader_height {
+			break
+		}
+		return protoreflect.ValueOfUint64(uint64(0))
+	case 2: // LazyHeader.chain_id
+		if fd != fd_LazyHeader_chain_id {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 3: // LazyHeader.parent
+		if fd != fd_LazyHeader_parent {
+			break
+		}
+		m := new(LazyBlock)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	}
+	if fd := runtime.FieldOf(fd, md_LazyHeader); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message LazyHeader does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message LazyHeader does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LazyHeader) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in LazyHeader", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LazyHeader) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LazyHeader) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LazyHeader) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LazyHeader) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_LazyHeader_methods
+}
+
+var _fastReflection_LazyHeader_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_LazyHeader_size,
+	Marshal:           _fastReflection_LazyHeader_marshal,
+	Unmarshal:         _fastReflection_LazyHeader_unmarshal,
+	Merge:             _fastReflection_LazyHeader_merge,
+	CheckInitialized:  _fastReflection_LazyHeader_checkInitialized,
+	Equal:             _fastReflection_LazyHeader_equal,
+}
+
+func _fastReflection_LazyHeader_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*LazyHeader)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	if x.Height != 0 {
+		n += 1 + runtime.Sov(uint64(x.Height))
+	}
+	l = len(x.ChainId)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if raw := runtime.RawLazy(&x.lazyFields, 0, &x.Parent); raw != nil {
+		n += len(raw)
+	} else {
+		if x.Parent != nil {
+			l = options.Size(x.Parent)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_LazyHeader_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*LazyHeader)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	x.lazyFields.StartMarshal()
+	defer x.lazyFields.EndMarshal()
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if raw := runtime.RawLazy(&x.lazyFields, 0, &x.Parent); raw != nil {
+		i -= len(raw)
+		copy(dAtA[i:], raw)
+	} else {
+		if x.Parent != nil {
+			l = options.Size(x.Parent)
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.Parent); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(x.ChainId) > 0 {
+		if !utf8.ValidString(x.ChainId) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.ChainId)
+		copy(dAtA[i:], x.ChainId)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if x.Height != 0 {
+		i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_LazyHeader_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*LazyHeader)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_LazyHeader.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LazyHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LazyHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			x.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				x.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_LazyHeader_chain_id, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.ChainId = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Parent == nil && x.lazyFields.Append(0, 1, dAtA[preIndex:postIndex], fd_LazyHeader_parent.Message(), nil, options) {
+				iNdEx = postIndex
+				break
+			}
+			x.lazyDecodeParent()
+			x.lazyFields.Discard(0)
+			if x.Parent == nil {
+				if err := budget.Message(fd_LazyHeader_parent, int(unsafe.Sizeof(LazyBlock{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Parent = &LazyBlock{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Parent); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_LazyHeader_parent, -1)
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_LazyHeader, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_LazyHeader_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*LazyHeader)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*LazyHeader)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.Height != uint64(0) {
+		dst.Height = src.Height
+	}
+	if src.ChainId != "" {
+		dst.ChainId = src.ChainId
+	}
+	src.lazyDecodeParent()
+	dst.lazyDecodeParent()
+	dst.lazyFields.Discard(0)
+	if src.Parent != nil {
+		if dst.Parent == nil {
+			dst.Parent = &LazyBlock{}
+		}
+		proto.Merge(dst.Parent, src.Parent)
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_LazyHeader_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_LazyHeader_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*LazyHeader)
+	y, yok := input.MessageB.Interface().(*LazyHeader)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.Height != y.Height {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.ChainId != y.ChainId {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	x.lazyDecodeParent()
+	y.lazyDecodeParent()
+	if !x.Parent.Equal(y.Parent) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *LazyHeader) Clone() *LazyHeader {
+	if x == nil {
+		return nil
+	}
+	dst := new(LazyHeader)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *LazyHeader) CopyInto(dst *LazyHeader) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.Height = x.Height
+	dst.ChainId = x.ChainId
+	x.lazyDecodeParent()
+	dst.lazyFields.Discard(0)
+	dst.Parent = x.Parent.Clone()
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *LazyHeader) Equal(y *LazyHeader) bool {
+	return _fastReflection_LazyHeader_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a LazyHeader, or nil if b is canonical.
+func (*LazyHeader) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_LazyHeader.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("height", protoreflect.Uint64Kind, true)
+		case 2:
+			w.Scalar("chain_id", protoreflect.StringKind, true)
+		case 3:
+			w.Message("parent", false, (*LazyBlock)(nil))
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *LazyBlock) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *LazyBlock) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Header != nil {
+		b = append(b, "\"header\":"...)
+		if b, err = x.Header.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	x.lazyDecodeTxs()
+	if len(x.Txs) > 0 {
+		b = append(b, "\"txs\":"...)
+		b = append(b, '[')
+		for _, v := range x.Txs {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	x.lazyDecodeLastHeader()
+	if x.LastHeader != nil {
+		b = append(b, "\"lastHeader\":"...)
+		if b, err = x.LastHeader.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.EagerTxs) > 0 {
+		b = append(b, "\"eagerTxs\":"...)
+		b = append(b, '[')
+		for _, v := range x.EagerTxs {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *LazyBlock) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *LazyBlock) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v := new(LazyHeader)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Header = v
+		case 2:
+			return d.List(func() error {
+				v := new(A)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.Txs = append(x.Txs, v)
+				return nil
+			})
+		case 3:
+			v := new(LazyHeader)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.LastHeader = v
+		case 4:
+			return d.List(func() error {
+				v := new(A)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.EagerTxs = append(x.EagerTxs, v)
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *LazyHeader) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *LazyHeader) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Height != 0 {
+		b = append(b, "\"height\":"...)
+		b = runtime.AppendJSONUint64(b, x.Height)
+		b = append(b, ',')
+	}
+	if x.ChainId != "" {
+		b = append(b, "\"chainId\":"...)
+		if b, err = runtime.AppendJSONString(b, x.ChainId, "LazyHeader.chain_id"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	x.lazyDecodeParent()
+	if x.Parent != nil {
+		b = append(b, "\"parent\":"...)
+		if b, err = x.Parent.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *LazyHeader) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *LazyHeader) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.Uint64(fd)
+			if err != nil {
+				return err
+			}
+			x.Height = v
+		case 2:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.ChainId = v
+		case 3:
+			v := new(LazyBlock)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Parent = v
+		}
+		return nil
+	})
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.18.1
+// source: testpb/lazy.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LazyBlock holds lazy fields, marked either through the lazy field option
+// or through the plugin lazy option in scripts/fastreflect.sh.
+type LazyBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+	lazyFields    runtime.LazyFields
+
+	Header *LazyHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Txs    []*A        `protobuf:"bytes,2,rep,name=txs,proto3" json:"txs,omitempty"`
+	// decoded lazily through the plugin lazy option
+	LastHeader *LazyHeader `protobuf:"bytes,3,opt,name=last_header,json=lastHeader,proto3" json:"last_header,omitempty"`
+	EagerTxs   []*A        `protobuf:"bytes,4,rep,name=eager_txs,json=eagerTxs,proto3" json:"eager_txs,omitempty"`
+}
+
+func (x *LazyBlock) Reset() {
+	*x = LazyBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_lazy_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LazyBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LazyBlock) ProtoMessage() {}
+
+// Deprecated: Use LazyBlock.ProtoReflect.Descriptor instead.
+func (*LazyBlock) Descriptor() ([]byte, []int) {
+	return file_testpb_lazy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LazyBlock) GetHeader() *LazyHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *LazyBlock) GetTxs() []*A {
+	if x != nil {
+		x.lazyDecodeTxs()
+		return x.Txs
+	}
+	return nil
+}
+
+func (x *LazyBlock) GetLastHeader() *LazyHeader {
+	if x != nil {
+		x.lazyDecodeLastHeader()
+		return x.LastHeader
+	}
+	return nil
+}
+
+func (x *LazyBlock) GetEagerTxs() []*A {
+	if x != nil {
+		return x.EagerTxs
+	}
+	return nil
+}
+
+func (x *LazyBlock) lazyDecodeTxs() {
+	runtime.DecodeLazyList(&x.lazyFields, 0, &x.Txs)
+}
+
+func (x *LazyBlock) lazyDecodeLastHeader() {
+	runtime.DecodeLazy(&x.lazyFields, 1, &x.LastHeader)
+}
+
+type LazyHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+	lazyFields    runtime.LazyFields
+
+	Height  uint64     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	ChainId string     `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Parent  *LazyBlock `protobuf:"bytes,3,opt,name=parent,proto3" json:"parent,omitempty"`
+}
+
+func (x *LazyHeader) Reset() {
+	*x = LazyHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_lazy_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LazyHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LazyHeader) ProtoMessage() {}
+
+// Deprecated: Use LazyHeader.ProtoReflect.Descriptor instead.
+func (*LazyHeader) Descriptor() ([]byte, []int) {
+	return file_testpb_lazy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LazyHeader) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *LazyHeader) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *LazyHeader) GetParent() *LazyBlock {
+	if x != nil {
+		x.lazyDecodeParent()
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *LazyHeader) lazyDecodeParent() {
+	runtime.DecodeLazy(&x.lazyFields, 0, &x.Parent)
+}
+
+var File_testpb_lazy_proto protoreflect.FileDescriptor
+
+var file_testpb_lazy_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x6c, 0x61, 0x7a, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x31, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x99, 0x01, 0x0a, 0x09, 0x4c, 0x61, 0x7a, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x23, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x4c, 0x61, 0x7a, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x02, 0x2e, 0x41, 0x42, 0x02, 0x28, 0x01, 0x52, 0x03, 0x74, 0x78, 0x73,
+	0x12, 0x2c, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4c, 0x61, 0x7a, 0x79, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x09, 0x65, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x02, 0x2e, 0x41, 0x52, 0x08, 0x65, 0x61, 0x67, 0x65, 0x72, 0x54, 0x78, 0x73, 0x22,
+	0x67, 0x0a, 0x0a, 0x4c, 0x61, 0x7a, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x26, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x4c, 0x61, 0x7a, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x02, 0x28, 0x01,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_testpb_lazy_proto_rawDescOnce sync.Once
+	file_testpb_lazy_proto_rawDescData = file_testpb_lazy_proto_rawDesc
+)
+
+func file_testpb_lazy_proto_rawDescGZIP() []byte {
+	file_testpb_lazy_proto_rawDescOnce.Do(func() {
+		file_testpb_lazy_proto_rawDescData = protoimpl.X.CompressGZIP(file_testpb_lazy_proto_rawDescData)
+	})
+	return file_testpb_lazy_proto_rawDescData
+}
+
+var file_testpb_lazy_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testpb_lazy_proto_goTypes = []interface{}{
+	(*LazyBlock)(nil),  // 0: LazyBlock
+	(*LazyHeader)(nil), // 1: LazyHeader
+	(*A)(nil),          // 2: A
+}
+var file_testpb_lazy_proto_depIdxs = []int32{
+	1, // 0: LazyBlock.header:type_name -> LazyHeader
+	2, // 1: LazyBlock.txs:type_name -> A
+	1, // 2: LazyBlock.last_header:type_name -> LazyHeader
+	2, // 3: LazyBlock.eager_txs:type_name -> A
+	0, // 4: LazyHeader.parent:type_name -> LazyBlock
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_testpb_lazy_proto_init() }
+func file_testpb_lazy_proto_init() {
+	if File_testpb_lazy_proto != nil {
+		return
+	}
+	file_testpb_1_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_testpb_lazy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LazyBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.lazyFields
+			default:
+				return nil
+			}
+		}
+		file_testpb_lazy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LazyHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.lazyFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_testpb_lazy_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_testpb_lazy_proto_goTypes,
+		DependencyIndexes: file_testpb_lazy_proto_depIdxs,
+		MessageInfos:      file_testpb_lazy_proto_msgTypes,
+	}.Build()
+	File_testpb_lazy_proto = out.File
+	file_testpb_lazy_proto_rawDesc = nil
+	file_testpb_lazy_proto_goTypes = nil
+	file_testpb_lazy_proto_depIdxs = nil
+}
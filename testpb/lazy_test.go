@@ -0,0 +1,300 @@
+package testpb
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func newLazyBlock() *LazyBlock {
+	return &LazyBlock{
+		Header:     &LazyHeader{Height: 1, ChainId: "chain", Parent: &LazyBlock{Txs: []*A{{STRING: "parent"}}}},
+		Txs:        []*A{newBenchMsg(), {UINT64: 2}, {}},
+		LastHeader: &LazyHeader{Height: 2},
+		EagerTxs:   []*A{{STRING: "eager"}},
+	}
+}
+
+func TestLazyUnmarshal(t *testing.T) {
+	msg := newLazyBlock()
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+
+	got := &LazyBlock{}
+	require.NoError(t, proto.Unmarshal(bz, got))
+	// lazy fields are left undecoded while the others are decoded
+	require.Nil(t, got.Txs)
+	require.Nil(t, got.LastHeader)
+	require.Nil(t, got.Header.Parent)
+	require.Len(t, got.EagerTxs, 1)
+	require.True(t, got.ProtoReflect().Has(fd_LazyBlock_txs))
+	require.True(t, got.ProtoReflect().Has(fd_LazyBlock_last_header))
+	// Has does not decode the field
+	require.Nil(t, got.Txs)
+
+	// the undecoded encoding is marshalled back
+	encoded, err := proto.Marshal(got)
+	require.NoError(t, err)
+	require.Equal(t, bz, encoded)
+	require.Equal(t, len(bz), proto.Size(got))
+
+	require.Len(t, got.GetTxs(), 3)
+	require.True(t, proto.Equal(msg.Txs[0], got.Txs[0]))
+	require.Equal(t, uint64(2), got.GetLastHeader().Height)
+	require.Equal(t, "parent", got.Header.GetParent().GetTxs()[0].STRING)
+	require.True(t, proto.Equal(msg, got))
+}
+
+func TestLazyReflection(t *testing.T) {
+	bz, err := proto.Marshal(newLazyBlock())
+	require.NoError(t, err)
+
+	got := &LazyBlock{}
+	require.NoError(t, proto.Unmarshal(bz, got))
+	require.Equal(t, 3, got.ProtoReflect().Get(fd_LazyBlock_txs).List().Len())
+	require.NotNil(t, got.Txs)
+
+	got = &LazyBlock{}
+	require.NoError(t, proto.Unmarshal(bz, got))
+	fields := 0
+	got.ProtoReflect().Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		fields++
+		return true
+	})
+	require.Equal(t, 4, fields)
+	require.Len(t, got.Txs, 3)
+	require.Equal(t, uint64(2), got.LastHeader.Height)
+
+	// the decoded fields are marshalled back to the same encoding
+	encoded, err := proto.Marshal(got)
+	require.NoError(t, err)
+	require.Equal(t, bz, encoded)
+}
+
+func TestLazyMarshalRaw(t *testing.T) {
+	// a tx whose fields are not encoded in field number order,
+	// which a marshal of the decoded tx would reorder
+	var tx []byte
+	tx = protowire.AppendTag(tx, 15, protowire.BytesType)
+	tx = protowire.AppendString(tx, "second")
+	tx = protowire.AppendTag(tx, 8, protowire.VarintType)
+	tx = protowire.AppendVarint(tx, 1)
+	var bz []byte
+	bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+	bz = protowire.AppendBytes(bz, tx)
+
+	got := &LazyBlock{}
+	require.NoError(t, proto.Unmarshal(bz, got))
+	encoded, err := proto.Marshal(got)
+	require.NoError(t, err)
+	require.Equal(t, bz, encoded)
+	encoded, err = proto.MarshalOptions{Deterministic: true}.Marshal(got)
+	require.NoError(t, err)
+	require.Equal(t, bz, encoded)
+
+	// decoding the field drops the recorded encoding, the field being marshalled again
+	require.Equal(t, "second", got.GetTxs()[0].STRING)
+	encoded, err = proto.Marshal(got)
+	require.NoError(t, err)
+	want, err := proto.Marshal(&LazyBlock{Txs: []*A{{STRING: "second", UINT64: 1}}})
+	require.NoError(t, err)
+	require.Equal(t, want, encoded)
+
+	// writing the field drops the recorded encoding
+	got.ProtoReflect().Mutable(fd_LazyBlock_txs).List().Append(protoreflect.ValueOfMessage((&A{UINT64: 3}).ProtoReflect()))
+	encoded, err = proto.Marshal(got)
+	require.NoError(t, err)
+	require.NotEqual(t, bz, encoded)
+	want, err = proto.Marshal(&LazyBlock{Txs: []*A{{STRING: "second", UINT64: 1}, {UINT64: 3}}})
+	require.NoError(t, err)
+	require.Equal(t, want, encoded)
+
+	got = &LazyBlock{}
+	require.NoError(t, proto.Unmarshal(bz, got))
+	got.ProtoReflect().Clear(fd_LazyBlock_txs)
+	require.False(t, got.ProtoReflect().Has(fd_LazyBlock_txs))
+	require.Zero(t, proto.Size(got))
+}
+
+func TestLazyMerge(t *testing.T) {
+	bz, err := proto.Marshal(newLazyBlock())
+	require.NoError(t, err)
+
+	// occurrences of undecoded fields are accumulated
+	got := &LazyBlock{}
+	require.NoError(t, proto.Unmarshal(bz, got))
+	require.NoError(t, proto.UnmarshalOptions{Merge: true}.Unmarshal(bz, got))
+	require.Nil(t, got.Txs)
+	require.Len(t, got.GetTxs(), 6)
+
+	// occurrences of decoded fields are merged eagerly
+	require.NoError(t, proto.UnmarshalOptions{Merge: true}.Unmarshal(bz, got))
+	require.Len(t, got.Txs, 9)
+
+	src := &LazyBlock{}
+	require.NoError(t, proto.Unmarshal(bz, src))
+	dst := &LazyBlock{}
+	require.NoError(t, proto.Unmarshal(bz, dst))
+	proto.Merge(dst, src)
+	require.Len(t, dst.Txs, 6)
+	require.Equal(t, uint64(2), dst.LastHeader.Height)
+
+	clone := &LazyBlock{}
+	require.NoError(t, proto.Unmarshal(bz, clone))
+	require.True(t, proto.Equal(newLazyBlock(), clone.Clone()))
+}
+
+func TestLazyInvalid(t *testing.T) {
+	// invalid encodings are decoded eagerly, reporting the error
+	var tx []byte
+	tx = protowire.AppendTag(tx, 15, protowire.BytesType)
+	tx = protowire.AppendBytes(tx, []byte{0xff})
+	var bz []byte
+	bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+	bz = protowire.AppendBytes(bz, tx)
+	require.Error(t, proto.Unmarshal(bz, &LazyBlock{}))
+
+	bz = bz[:0]
+	bz = protowire.AppendTag(bz, 3, protowire.BytesType)
+	bz = protowire.AppendBytes(bz, []byte{0x08})
+	require.Error(t, proto.Unmarshal(bz, &LazyBlock{}))
+}
+
+func TestLazyRejectUnknown(t *testing.T) {
+	// lazy fields are decoded eagerly when unknown fields are rejected
+	var tx []byte
+	tx = protowire.AppendTag(tx, 999, protowire.VarintType)
+	tx = protowire.AppendVarint(tx, 1)
+	var bz []byte
+	bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+	bz = protowire.AppendBytes(bz, tx)
+	require.NoError(t, proto.Unmarshal(bz, &LazyBlock{}))
+	require.Error(t, runtime.RejectUnknown(proto.UnmarshalOptions{}, false).Unmarshal(bz, &LazyBlock{}))
+}
+
+func TestLazyConcurrentReaders(t *testing.T) {
+	bz, err := proto.Marshal(newLazyBlock())
+	require.NoError(t, err)
+	got := &LazyBlock{}
+	require.NoError(t, proto.Unmarshal(bz, got))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			switch i % 4 {
+			case 0:
+				require.Len(t, got.GetTxs(), 3)
+			case 1:
+				require.Equal(t, 3, got.ProtoReflect().Get(fd_LazyBlock_txs).List().Len())
+			case 2:
+				encoded, err := proto.Marshal(got)
+				require.NoError(t, err)
+				require.Equal(t, bz, encoded)
+			case 3:
+				require.True(t, proto.Equal(newLazyBlock(), got))
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestLazyChanges(t *testing.T) {
+	bz, err := proto.Marshal(newLazyBlock())
+	require.NoError(t, err)
+
+	tests := map[string]func(got *LazyBlock){
+		"assign decoded field": func(got *LazyBlock) {
+			got.GetLastHeader()
+			got.LastHeader = &LazyHeader{Height: 5}
+		},
+		"assign undecoded field": func(got *LazyBlock) {
+			got.LastHeader = &LazyHeader{Height: 5}
+			got.Txs = []*A{{UINT64: 5}}
+		},
+		"clear decoded field": func(got *LazyBlock) {
+			got.GetLastHeader()
+			got.LastHeader = nil
+			got.GetTxs()
+			got.Txs = nil
+		},
+		"mutate through getter": func(got *LazyBlock) {
+			got.GetLastHeader().Height = 7
+			got.GetTxs()[0].STRING = "changed"
+		},
+		"replace element": func(got *LazyBlock) {
+			got.GetTxs()[1] = &A{UINT64: 8}
+		},
+		"append element": func(got *LazyBlock) {
+			got.Txs = append(got.GetTxs(), &A{UINT64: 9})
+		},
+		"truncate list": func(got *LazyBlock) {
+			got.Txs = got.GetTxs()[:1]
+		},
+		"read only": func(got *LazyBlock) {
+			got.GetLastHeader()
+			got.GetTxs()
+		},
+	}
+	for name, change := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := &LazyBlock{}
+			require.NoError(t, proto.Unmarshal(bz, got))
+			change(got)
+
+			encoded, err := proto.Marshal(got)
+			require.NoError(t, err)
+			require.Equal(t, len(encoded), proto.Size(got))
+			decoded := &LazyBlock{}
+			require.NoError(t, proto.Unmarshal(encoded, decoded))
+			require.True(t, proto.Equal(got, decoded))
+		})
+	}
+}
+
+func Benchmark_Marshal_Lazy(b *testing.B) {
+	txs := []*A{newBenchMsg(), newBenchMsg(), newBenchMsg()}
+	bz, err := proto.Marshal(&LazyBlock{Txs: txs})
+	require.NoError(b, err)
+	msg := &LazyBlock{}
+	require.NoError(b, proto.Unmarshal(bz, msg))
+
+	b.Run("undecoded", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := proto.Marshal(msg); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("decoded", func(b *testing.B) {
+		decoded := &LazyBlock{}
+		require.NoError(b, proto.Unmarshal(bz, decoded))
+		decoded.GetTxs()
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := proto.Marshal(decoded); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("eager", func(b *testing.B) {
+		// the same transactions, in a field which is not lazy
+		eager := &LazyBlock{EagerTxs: txs}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := proto.Marshal(eager); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,4061 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package testpb
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	maps "maps"
+	math "math"
+	reflect "reflect"
+	slices "slices"
+	sort "sort"
+	sync "sync"
+	atomic "sync/atomic"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
+)
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *PoolableMessage) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *PoolableMessage) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Child != nil {
+		b = append(b, "\"child\":"...)
+		if b, err = x.Child.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.Children) > 0 {
+		b = append(b, "\"children\":"...)
+		b = append(b, '[')
+		for _, v := range x.Children {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if v, ok := x.Choice.(*PoolableMessage_ChoiceChild); ok {
+		b = append(b, "\"choice_child\":"...)
+		if b, err = v.ChoiceChild.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.Choice.(*PoolableMessage_ChoiceString); ok {
+		b = append(b, "\"choice_string\":"...)
+		b = runtime.AppendAminoJSONString(b, v.ChoiceString)
+		b = append(b, ',')
+	}
+	if len(x.Chunks) > 0 {
+		b = append(b, "\"chunks\":"...)
+		b = append(b, '[')
+		for _, v := range x.Chunks {
+			b = runtime.AppendJSONBytes(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.Data) > 0 {
+		b = append(b, "\"data\":"...)
+		b = runtime.AppendJSONBytes(b, x.Data)
+		b = append(b, ',')
+	}
+	if len(x.Named) > 0 {
+		b = append(b, "\"named\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.Named)) {
+			v := x.Named[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if x.NotPoolable != nil {
+		b = append(b, "\"not_poolable\":"...)
+		if b, err = x.NotPoolable.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.Numbers) > 0 {
+		b = append(b, "\"numbers\":"...)
+		b = append(b, '[')
+		for _, v := range x.Numbers {
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.Others) > 0 {
+		b = append(b, "\"others\":"...)
+		b = append(b, '[')
+		for _, v := range x.Others {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.Tags) > 0 {
+		b = append(b, "\"tags\":"...)
+		b = append(b, '[')
+		for _, v := range x.Tags {
+			b = runtime.AppendAminoJSONString(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *PoolableChild) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *PoolableChild) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.Name != "" {
+		b = append(b, "\"name\":"...)
+		b = runtime.AppendAminoJSONString(b, x.Name)
+		b = append(b, ',')
+	}
+	if len(x.Payload) > 0 {
+		b = append(b, "\"payload\":"...)
+		b = runtime.AppendJSONBytes(b, x.Payload)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+var _ protoreflect.List = (*_PoolableMessage_2_list)(nil)
+
+type _PoolableMessage_2_list struct {
+	list *[]*PoolableChild
+}
+
+func (x *_PoolableMessage_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoolableMessage_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PoolableMessage_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolableChild)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoolableMessage_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolableChild)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoolableMessage_2_list) AppendMutable() protoreflect.Value {
+	v := new(PoolableChild)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolableMessage_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoolableMessage_2_list) NewElement() protoreflect.Value {
+	v := new(PoolableChild)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolableMessage_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PoolableMessage_4_list)(nil)
+
+type _PoolableMessage_4_list struct {
+	list *[]uint64
+}
+
+func (x *_PoolableMessage_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoolableMessage_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_PoolableMessage_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoolableMessage_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoolableMessage_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PoolableMessage at list field Numbers as it is not of Message kind"))
+}
+
+func (x *_PoolableMessage_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoolableMessage_4_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_PoolableMessage_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PoolableMessage_8_list)(nil)
+
+type _PoolableMessage_8_list struct {
+	list *[][]byte
+}
+
+func (x *_PoolableMessage_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoolableMessage_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_PoolableMessage_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoolableMessage_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoolableMessage_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PoolableMessage at list field Chunks as it is not of Message kind"))
+}
+
+func (x *_PoolableMessage_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoolableMessage_8_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_PoolableMessage_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PoolableMessage_9_list)(nil)
+
+type _PoolableMessage_9_list struct {
+	list *[]string
+}
+
+func (x *_PoolableMessage_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoolableMessage_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PoolableMessage_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoolableMessage_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoolableMessage_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PoolableMessage at list field Tags as it is not of Message kind"))
+}
+
+func (x *_PoolableMessage_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoolableMessage_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PoolableMessage_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PoolableMessage_10_list)(nil)
+
+type _PoolableMessage_10_list struct {
+	list *[]*B
+}
+
+func (x *_PoolableMessage_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoolableMessage_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PoolableMessage_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*B)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoolableMessage_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*B)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoolableMessage_10_list) AppendMutable() protoreflect.Value {
+	v := new(B)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolableMessage_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoolableMessage_10_list) NewElement() protoreflect.Value {
+	v := new(B)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolableMessage_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.Map = (*_PoolableMessage_11_map)(nil)
+
+type _PoolableMessage_11_map struct {
+	m *map[string]*PoolableChild
+}
+
+func (x *_PoolableMessage_11_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_PoolableMessage_11_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_PoolableMessage_11_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_PoolableMessage_11_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_PoolableMessage_11_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolableMessage_11_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolableChild)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_PoolableMessage_11_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
+	}
+	newValue := new(PoolableChild)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
+}
+
+func (x *_PoolableMessage_11_map) NewValue() protoreflect.Value {
+	v := new(PoolableChild)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolableMessage_11_map) IsValid() bool {
+	return x.m != nil
+}
+
+var (
+	md_PoolableMessage               protoreflect.MessageDescriptor
+	fd_PoolableMessage_data          protoreflect.FieldDescriptor
+	fd_PoolableMessage_children      protoreflect.FieldDescriptor
+	fd_PoolableMessage_child         protoreflect.FieldDescriptor
+	fd_PoolableMessage_numbers       protoreflect.FieldDescriptor
+	fd_PoolableMessage_choice_child  protoreflect.FieldDescriptor
+	fd_PoolableMessage_choice_string protoreflect.FieldDescriptor
+	fd_PoolableMessage_not_poolable  protoreflect.FieldDescriptor
+	fd_PoolableMessage_chunks        protoreflect.FieldDescriptor
+	fd_PoolableMessage_tags          protoreflect.FieldDescriptor
+	fd_PoolableMessage_others        protoreflect.FieldDescriptor
+	fd_PoolableMessage_named         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_testpb_pool_proto_init()
+	md_PoolableMessage = File_testpb_pool_proto.Messages().ByName("PoolableMessage")
+	fd_PoolableMessage_data = md_PoolableMessage.Fields().ByName("data")
+	fd_PoolableMessage_children = md_PoolableMessage.Fields().ByName("children")
+	fd_PoolableMessage_child = md_PoolableMessage.Fields().ByName("child")
+	fd_PoolableMessage_numbers = md_PoolableMessage.Fields().ByName("numbers")
+	fd_PoolableMessage_choice_child = md_PoolableMessage.Fields().ByName("choice_child")
+	fd_PoolableMessage_choice_string = md_PoolableMessage.Fields().ByName("choice_string")
+	fd_PoolableMessage_not_poolable = md_PoolableMessage.Fields().ByName("not_poolable")
+	fd_PoolableMessage_chunks = md_PoolableMessage.Fields().ByName("chunks")
+	fd_PoolableMessage_tags = md_PoolableMessage.Fields().ByName("tags")
+	fd_PoolableMessage_others = md_PoolableMessage.Fields().ByName("others")
+	fd_PoolableMessage_named = md_PoolableMessage.Fields().ByName("named")
+}
+
+var _ protoreflect.Message = (*fastReflection_PoolableMessage)(nil)
+
+type fastReflection_PoolableMessage PoolableMessage
+
+func (x *PoolableMessage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PoolableMessage)(x)
+}
+
+func (x *PoolableMessage) slowProtoReflect() protoreflect.Message {
+	mi := &file_testpb_pool_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PoolableMessage_messageType fastReflection_PoolableMessage_messageType
+var _ protoreflect.MessageType = fastReflection_PoolableMessage_messageType{}
+
+type fastReflection_PoolableMessage_messageType struct{}
+
+func (x fastReflection_PoolableMessage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PoolableMessage)(nil)
+}
+func (x fastReflection_PoolableMessage_messageType) New() protoreflect.Message {
+	return new(fastReflection_PoolableMessage)
+}
+func (x fastReflection_PoolableMessage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoolableMessage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PoolableMessage) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoolableMessage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PoolableMessage) Type() protoreflect.MessageType {
+	return _fastReflection_PoolableMessage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PoolableMessage) New() protoreflect.Message {
+	return new(fastReflection_PoolableMessage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PoolableMessage) Interface() protoreflect.ProtoMessage {
+	return (*PoolableMessage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PoolableMessage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_PoolableMessage_data, value) {
+			return
+		}
+	}
+	if len(x.Children) != 0 {
+		value := protoreflect.ValueOfList(&_PoolableMessage_2_list{list: &x.Children})
+		if !f(fd_PoolableMessage_children, value) {
+			return
+		}
+	}
+	if x.Child != nil {
+		value := protoreflect.ValueOfMessage(x.Child.ProtoReflect())
+		if !f(fd_PoolableMessage_child, value) {
+			return
+		}
+	}
+	if len(x.Numbers) != 0 {
+		value := protoreflect.ValueOfList(&_PoolableMessage_4_list{list: &x.Numbers})
+		if !f(fd_PoolableMessage_numbers, value) {
+			return
+		}
+	}
+	if x.Choice != nil {
+		switch o := x.Choice.(type) {
+		case *PoolableMessage_ChoiceChild:
+			v := o.ChoiceChild
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_PoolableMessage_choice_child, value) {
+				return
+			}
+		case *PoolableMessage_ChoiceString:
+			v := o.ChoiceString
+			value := protoreflect.ValueOfString(v)
+			if !f(fd_PoolableMessage_choice_string, value) {
+				return
+			}
+		}
+	}
+	if x.NotPoolable != nil {
+		value := protoreflect.ValueOfMessage(x.NotPoolable.ProtoReflect())
+		if !f(fd_PoolableMessage_not_poolable, value) {
+			return
+		}
+	}
+	if len(x.Chunks) != 0 {
+		value := protoreflect.ValueOfList(&_PoolableMessage_8_list{list: &x.Chunks})
+		if !f(fd_PoolableMessage_chunks, value) {
+			return
+		}
+	}
+	if len(x.Tags) != 0 {
+		value := protoreflect.ValueOfList(&_PoolableMessage_9_list{list: &x.Tags})
+		if !f(fd_PoolableMessage_tags, value) {
+			return
+		}
+	}
+	if len(x.Others) != 0 {
+		value := protoreflect.ValueOfList(&_PoolableMessage_10_list{list: &x.Others})
+		if !f(fd_PoolableMessage_others, value) {
+			return
+		}
+	}
+	if len(x.Named) != 0 {
+		value := protoreflect.ValueOfMap(&_PoolableMessage_11_map{m: &x.Named})
+		if !f(fd_PoolableMessage_named, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PoolableMessage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // PoolableMessage.data
+		if fd != fd_PoolableMessage_data {
+			break
+		}
+		return len(x.Data) != 0
+	case 2: // PoolableMessage.children
+		if fd != fd_PoolableMessage_children {
+			break
+		}
+		return len(x.Children) != 0
+	case 3: // PoolableMessage.child
+		if fd != fd_PoolableMessage_child {
+			break
+		}
+		return x.Child != nil
+	case 4: // PoolableMessage.numbers
+		if fd != fd_PoolableMessage_numbers {
+			break
+		}
+		return len(x.Numbers) != 0
+	case 5: // PoolableMessage.choice_child
+		if fd != fd_PoolableMessage_choice_child {
+			break
+		}
+		if x.Choice == nil {
+			return false
+		} else if _, ok := x.Choice.(*PoolableMessage_ChoiceChild); ok {
+			return true
+		} else {
+			return false
+		}
+	case 6: // PoolableMessage.choice_string
+		if fd != fd_PoolableMessage_choice_string {
+			break
+		}
+		if x.Choice == nil {
+			return false
+		} else if _, ok := x.Choice.(*PoolableMessage_ChoiceString); ok {
+			return true
+		} else {
+			return false
+		}
+	case 7: // PoolableMessage.not_poolable
+		if fd != fd_PoolableMessage_not_poolable {
+			break
+		}
+		return x.NotPoolable != nil
+	case 8: // PoolableMessage.chunks
+		if fd != fd_PoolableMessage_chunks {
+			break
+		}
+		return len(x.Chunks) != 0
+	case 9: // PoolableMessage.tags
+		if fd != fd_PoolableMessage_tags {
+			break
+		}
+		return len(x.Tags) != 0
+	case 10: // PoolableMessage.others
+		if fd != fd_PoolableMessage_others {
+			break
+		}
+		return len(x.Others) != 0
+	case 11: // PoolableMessage.named
+		if fd != fd_PoolableMessage_named {
+			break
+		}
+		return len(x.Named) != 0
+	}
+	if fd := runtime.FieldOf(fd, md_PoolableMessage); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message PoolableMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message PoolableMessage does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolableMessage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // PoolableMessage.data
+		if fd != fd_PoolableMessage_data {
+			break
+		}
+		x.Data = nil
+		return
+	case 2: // PoolableMessage.children
+		if fd != fd_PoolableMessage_children {
+			break
+		}
+		x.Children = nil
+		return
+	case 3: // PoolableMessage.child
+		if fd != fd_PoolableMessage_child {
+			break
+		}
+		x.Child = nil
+		return
+	case 4: // PoolableMessage.numbers
+		if fd != fd_PoolableMessage_numbers {
+			break
+		}
+		x.Numbers = nil
+		return
+	case 5: // PoolableMessage.choice_child
+		if fd != fd_PoolableMessage_choice_child {
+			break
+		}
+		x.Choice = nil
+		return
+	case 6: // PoolableMessage.choice_string
+		if fd != fd_PoolableMessage_choice_string {
+			break
+		}
+		x.Choice = nil
+		return
+	case 7: // PoolableMessage.not_poolable
+		if fd != fd_PoolableMessage_not_poolable {
+			break
+		}
+		x.NotPoolable = nil
+		return
+	case 8: // PoolableMessage.chunks
+		if fd != fd_PoolableMessage_chunks {
+			break
+		}
+		x.Chunks = nil
+		return
+	case 9: // PoolableMessage.tags
+		if fd != fd_PoolableMessage_tags {
+			break
+		}
+		x.Tags = nil
+		return
+	case 10: // PoolableMessage.others
+		if fd != fd_PoolableMessage_others {
+			break
+		}
+		x.Others = nil
+		return
+	case 11: // PoolableMessage.named
+		if fd != fd_PoolableMessage_named {
+			break
+		}
+		x.Named = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_PoolableMessage); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message PoolableMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message PoolableMessage does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PoolableMessage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // PoolableMessage.data
+		if descriptor != fd_PoolableMessage_data {
+			break
+		}
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	case 2: // PoolableMessage.children
+		if descriptor != fd_PoolableMessage_children {
+			break
+		}
+		if len(x.Children) == 0 {
+			return protoreflect.ValueOfList(&_PoolableMessage_2_list{})
+		}
+		listValue := &_PoolableMessage_2_list{list: &x.Children}
+		return protoreflect.ValueOfList(listValue)
+	case 3: // PoolableMessage.child
+		if descriptor != fd_PoolableMessage_child {
+			break
+		}
+		value := x.Child
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 4: // PoolableMessage.numbers
+		if descriptor != fd_PoolableMessage_numbers {
+			break
+		}
+		if len(x.Numbers) == 0 {
+			return protoreflect.ValueOfList(&_PoolableMessage_4_list{})
+		}
+		listValue := &_PoolableMessage_4_list{list: &x.Numbers}
+		return protoreflect.ValueOfList(listValue)
+	case 5: // PoolableMessage.choice_child
+		if descriptor != fd_PoolableMessage_choice_child {
+			break
+		}
+		if x.Choice == nil {
+			return protoreflect.ValueOfMessage((*PoolableChild)(nil).ProtoReflect())
+		} else if v, ok := x.Choice.(*PoolableMessage_ChoiceChild); ok {
+			return protoreflect.ValueOfMessage(v.ChoiceChild.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*PoolableChild)(nil).ProtoReflect())
+		}
+	case 6: // PoolableMessage.choice_string
+		if descriptor != fd_PoolableMessage_choice_string {
+			break
+		}
+		if x.Choice == nil {
+			return protoreflect.ValueOfString("")
+		} else if v, ok := x.Choice.(*PoolableMessage_ChoiceString); ok {
+			return protoreflect.ValueOfString(v.ChoiceString)
+		} else {
+			return protoreflect.ValueOfString("")
+		}
+	case 7: // PoolableMessage.not_poolable
+		if descriptor != fd_PoolableMessage_not_poolable {
+			break
+		}
+		value := x.NotPoolable
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 8: // PoolableMessage.chunks
+		if descriptor != fd_PoolableMessage_chunks {
+			break
+		}
+		if len(x.Chunks) == 0 {
+			return protoreflect.ValueOfList(&_PoolableMessage_8_list{})
+		}
+		listValue := &_PoolableMessage_8_list{list: &x.Chunks}
+		return protoreflect.ValueOfList(listValue)
+	case 9: // PoolableMessage.tags
+		if descriptor != fd_PoolableMessage_tags {
+			break
+		}
+		if len(x.Tags) == 0 {
+			return protoreflect.ValueOfList(&_PoolableMessage_9_list{})
+		}
+		listValue := &_PoolableMessage_9_list{list: &x.Tags}
+		return protoreflect.ValueOfList(listValue)
+	case 10: // PoolableMessage.others
+		if descriptor != fd_PoolableMessage_others {
+			break
+		}
+		if len(x.Others) == 0 {
+			return protoreflect.ValueOfList(&_PoolableMessage_10_list{})
+		}
+		listValue := &_PoolableMessage_10_list{list: &x.Others}
+		return protoreflect.ValueOfList(listValue)
+	case 11: // PoolableMessage.named
+		if descriptor != fd_PoolableMessage_named {
+			break
+		}
+		if len(x.Named) == 0 {
+			return protoreflect.ValueOfMap(&_PoolableMessage_11_map{})
+		}
+		mapValue := &_PoolableMessage_11_map{m: &x.Named}
+		return protoreflect.ValueOfMap(mapValue)
+	}
+	if fd := runtime.FieldOf(descriptor, md_PoolableMessage); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message PoolableMessage does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message PoolableMessage does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolableMessage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // PoolableMessage.data
+		if fd != fd_PoolableMessage_data {
+			break
+		}
+		x.Data = value.Bytes()
+		return
+	case 2: // PoolableMessage.children
+		if fd != fd_PoolableMessage_children {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_PoolableMessage_2_list)
+		x.Children = *clv.list
+		return
+	case 3: // PoolableMessage.child
+		if fd != fd_PoolableMessage_child {
+			break
+		}
+		x.Child = value.Message().Interface().(*PoolableChild)
+		return
+	case 4: // PoolableMessage.numbers
+		if fd != fd_PoolableMessage_numbers {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_PoolableMessage_4_list)
+		x.Numbers = *clv.list
+		return
+	case 5: // PoolableMessage.choice_child
+		if fd != fd_PoolableMessage_choice_child {
+			break
+		}
+		cv := value.Message().Interface().(*PoolableChild)
+		x.Choice = &PoolableMessage_ChoiceChild{ChoiceChild: cv}
+		return
+	case 6: // PoolableMessage.choice_string
+		if fd != fd_PoolableMessage_choice_string {
+			break
+		}
+		cv := value.Interface().(string)
+		x.Choice = &PoolableMessage_ChoiceString{ChoiceString: cv}
+		return
+	case 7: // PoolableMessage.not_poolable
+		if fd != fd_PoolableMessage_not_poolable {
+			break
+		}
+		x.NotPoolable = value.Message().Interface().(*B)
+		return
+	case 8: // PoolableMessage.chunks
+		if fd != fd_PoolableMessage_chunks {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_PoolableMessage_8_list)
+		x.Chunks = *clv.list
+		return
+	case 9: // PoolableMessage.tags
+		if fd != fd_PoolableMessage_tags {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_PoolableMessage_9_list)
+		x.Tags = *clv.list
+		return
+	case 10: // PoolableMessage.others
+		if fd != fd_PoolableMessage_others {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_PoolableMessage_10_list)
+		x.Others = *clv.list
+		return
+	case 11: // PoolableMessage.named
+		if fd != fd_PoolableMessage_named {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_PoolableMessage_11_map)
+		x.Named = *cmv.m
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_PoolableMessage); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message PoolableMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message PoolableMessage does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolableMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 2: // PoolableMessage.children
+		if fd != fd_PoolableMessage_children {
+			break
+		}
+		if x.Children == nil {
+			x.Children = []*PoolableChild{}
+		}
+		value := &_PoolableMessage_2_list{list: &x.Children}
+		return protoreflect.ValueOfList(value)
+	case 3: // PoolableMessage.child
+		if fd != fd_PoolableMessage_child {
+			break
+		}
+		if x.Child == nil {
+			x.Child = new(PoolableChild)
+		}
+		return protoreflect.ValueOfMessage(x.Child.ProtoReflect())
+	case 4: // PoolableMessage.numbers
+		if fd != fd_PoolableMessage_numbers {
+			break
+		}
+		if x.Numbers == nil {
+			x.Numbers = []uint64{}
+		}
+		value := &_PoolableMessage_4_list{list: &x.Numbers}
+		return protoreflect.ValueOfList(value)
+	case 5: // PoolableMessage.choice_child
+		if fd != fd_PoolableMessage_choice_child {
+			break
+		}
+		if x.Choice == nil {
+			value := &PoolableChild{}
+			oneofValue := &PoolableMessage_ChoiceChild{ChoiceChild: value}
+			x.Choice = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Choice.(type) {
+		case *PoolableMessage_ChoiceChild:
+			return protoreflect.ValueOfMessage(m.ChoiceChild.ProtoReflect())
+		default:
+			value := &PoolableChild{}
+			oneofValue := &PoolableMessage_ChoiceChild{ChoiceChild: value}
+			x.Choice = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case 7: // PoolableMessage.not_poolable
+		if fd != fd_PoolableMessage_not_poolable {
+			break
+		}
+		if x.NotPoolable == nil {
+			x.NotPoolable = new(B)
+		}
+		return protoreflect.ValueOfMessage(x.NotPoolable.ProtoReflect())
+	case 8: // PoolableMessage.chunks
+		if fd != fd_PoolableMessage_chunks {
+			break
+		}
+		if x.Chunks == nil {
+			x.Chunks = [][]byte{}
+		}
+		value := &_PoolableMessage_8_list{list: &x.Chunks}
+		return protoreflect.ValueOfList(value)
+	case 9: // PoolableMessage.tags
+		if fd != fd_PoolableMessage_tags {
+			break
+		}
+		if x.Tags == nil {
+			x.Tags = []string{}
+		}
+		value := &_PoolableMessage_9_list{list: &x.Tags}
+		return protoreflect.ValueOfList(value)
+	case 10: // PoolableMessage.others
+		if fd != fd_PoolableMessage_others {
+			break
+		}
+		if x.Others == nil {
+			x.Others = []*B{}
+		}
+		value := &_PoolableMessage_10_list{list: &x.Others}
+		return protoreflect.ValueOfList(value)
+	case 11: // PoolableMessage.named
+		if fd != fd_PoolableMessage_named {
+			break
+		}
+		if x.Named == nil {
+			x.Named = make(map[string]*PoolableChild)
+		}
+		value := &_PoolableMessage_11_map{m: &x.Named}
+		return protoreflect.ValueOfMap(value)
+	case 1: // PoolableMessage.data
+		if fd != fd_PoolableMessage_data {
+			break
+		}
+		panic(fmt.Errorf("field data of message PoolableMessage is not mutable"))
+	case 6: // PoolableMessage.choice_string
+		if fd != fd_PoolableMessage_choice_string {
+			break
+		}
+		panic(fmt.Errorf("field choice_string of message PoolableMessage is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_PoolableMessage); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message PoolableMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message PoolableMessage does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PoolableMessage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // PoolableMessage.data
+		if fd != fd_PoolableMessage_data {
+			break
+		}
+		return protoreflect.ValueOfBytes(nil)
+	case 2: // PoolableMessage.children
+		if fd != fd_PoolableMessage_children {
+			break
+		}
+		list := []*PoolableChild{}
+		return protoreflect.ValueOfList(&_PoolableMessage_2_list{list: &list})
+	case 3: // PoolableMessage.child
+		if fd != fd_PoolableMessage_child {
+			break
+		}
+		m := new(PoolableChild)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 4: // PoolableMessage.numbers
+		if fd != fd_PoolableMessage_numbers {
+			break
+		}
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_PoolableMessage_4_list{list: &list})
+	case 5: // PoolableMessage.choice_child
+		if fd != fd_PoolableMessage_choice_child {
+			break
+		}
+		value := &PoolableChild{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 6: // PoolableMessage.choice_string
+		if fd != fd_PoolableMessage_choice_string {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 7: // PoolableMessage.not_poolable
+		if fd != fd_PoolableMessage_not_poolable {
+			break
+		}
+		m := new(B)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 8: // PoolableMessage.chunks
+		if fd != fd_PoolableMessage_chunks {
+			break
+		}
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_PoolableMessage_8_list{list: &list})
+	case 9: // PoolableMessage.tags
+		if fd != fd_PoolableMessage_tags {
+			break
+		}
+		list := []string{}
+		return protoreflect.ValueOfList(&_PoolableMessage_9_list{list: &list})
+	case 10: // PoolableMessage.others
+		if fd != fd_PoolableMessage_others {
+			break
+		}
+		list := []*B{}
+		return protoreflect.ValueOfList(&_PoolableMessage_10_list{list: &list})
+	case 11: // PoolableMessage.named
+		if fd != fd_PoolableMessage_named {
+			break
+		}
+		m := make(map[string]*PoolableChild)
+		return protoreflect.ValueOfMap(&_PoolableMessage_11_map{m: &m})
+	}
+	if fd := runtime.FieldOf(fd, md_PoolableMessage); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message PoolableMessage does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message PoolableMessage does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PoolableMessage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "PoolableMessage.choice":
+		if x.Choice == nil {
+			return nil
+		}
+		switch x.Choice.(type) {
+		case *PoolableMessage_ChoiceChild:
+			return x.Descriptor().Fields().ByName("choice_child")
+		case *PoolableMessage_ChoiceString:
+			return x.Descriptor().Fields().ByName("choice_string")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in PoolableMessage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PoolableMessage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolableMessage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PoolableMessage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PoolableMessage) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_PoolableMessage_methods
+}
+
+var _fastReflection_PoolableMessage_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_PoolableMessage_size,
+	Marshal:           _fastReflection_PoolableMessage_marshal,
+	Unmarshal:         _fastReflection_PoolableMessage_unmarshal,
+	Merge:             _fastReflection_PoolableMessage_merge,
+	CheckInitialized:  _fastReflection_PoolableMessage_checkInitialized,
+	Equal:             _fastReflection_PoolableMessage_equal,
+}
+
+func _fastReflection_PoolableMessage_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*PoolableMessage)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	l = len(x.Data)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.Children) > 0 {
+		for _, e := range x.Children {
+			l = options.Size(e)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if x.Child != nil {
+		l = options.Size(x.Child)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.Numbers) > 0 {
+		l = 0
+		for _, e := range x.Numbers {
+			l += runtime.Sov(uint64(e))
+		}
+		n += 1 + runtime.Sov(uint64(l)) + l
+	}
+	switch x := x.Choice.(type) {
+	case *PoolableMessage_ChoiceChild:
+		if x == nil {
+			break
+		}
+		l = options.Size(x.ChoiceChild)
+		n += 1 + l + runtime.Sov(uint64(l))
+	case *PoolableMessage_ChoiceString:
+		if x == nil {
+			break
+		}
+		l = len(x.ChoiceString)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.NotPoolable != nil {
+		l = options.Size(x.NotPoolable)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.Chunks) > 0 {
+		for _, b := range x.Chunks {
+			l = len(b)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if len(x.Tags) > 0 {
+		for _, s := range x.Tags {
+			l = len(s)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if len(x.Others) > 0 {
+		for _, e := range x.Others {
+			l = options.Size(e)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if len(x.Named) > 0 {
+		SiZeMaP := func(k string, v *PoolableChild) {
+			l := 0
+			if v != nil {
+				l = options.Size(v)
+			}
+			l += 1 + runtime.Sov(uint64(l))
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
+			n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.Named))
+			for k := range x.Named {
+				sortme = append(sortme, k)
+			}
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.Named[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.Named {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_PoolableMessage_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*PoolableMessage)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	switch x := x.Choice.(type) {
+	case *PoolableMessage_ChoiceChild:
+		l = options.Size(x.ChoiceChild)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.ChoiceChild); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x2a
+	case *PoolableMessage_ChoiceString:
+		if !utf8.ValidString(x.ChoiceString) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.ChoiceString)
+		copy(dAtA[i:], x.ChoiceString)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChoiceString)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(x.Named) > 0 {
+		MaRsHaLmAp := func(k string, v *PoolableChild) (protoiface.MarshalOutput, error) {
+			baseI := i
+			l = options.Size(v)
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], v); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x12
+			if !utf8.ValidString(k) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForNamed := make([]string, 0, len(x.Named))
+			for k := range x.Named {
+				keysForNamed = append(keysForNamed, string(k))
+			}
+			sort.Slice(keysForNamed, func(i, j int) bool {
+				return keysForNamed[i] < keysForNamed[j]
+			})
+			for iNdEx := len(keysForNamed) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.Named[string(keysForNamed[iNdEx])]
+				out, err := MaRsHaLmAp(keysForNamed[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.Named {
+				v := x.Named[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.Others) > 0 {
+		for iNdEx := len(x.Others) - 1; iNdEx >= 0; iNdEx-- {
+			l = options.Size(x.Others[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.Others[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(x.Tags) > 0 {
+		for iNdEx := len(x.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			if !utf8.ValidString(x.Tags[iNdEx]) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(x.Tags[iNdEx])
+			copy(dAtA[i:], x.Tags[iNdEx])
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(x.Chunks) > 0 {
+		for iNdEx := len(x.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(x.Chunks[iNdEx])
+			copy(dAtA[i:], x.Chunks[iNdEx])
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Chunks[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if x.NotPoolable != nil {
+		l = options.Size(x.NotPoolable)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.NotPoolable); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(x.Numbers) > 0 {
+		var pksize2 int
+		for _, num := range x.Numbers {
+			pksize2 += runtime.Sov(uint64(num))
+		}
+		i -= pksize2
+		j1 := i
+		for _, num := range x.Numbers {
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if x.Child != nil {
+		l = options.Size(x.Child)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.Child); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(x.Children) > 0 {
+		for iNdEx := len(x.Children) - 1; iNdEx >= 0; iNdEx-- {
+			l = options.Size(x.Children[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.Children[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(x.Data) > 0 {
+		i -= len(x.Data)
+		copy(dAtA[i:], x.Data)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_PoolableMessage_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*PoolableMessage)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_PoolableMessage.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	if zeroCopy {
+		x.zeroCopied = true
+	}
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoolableMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoolableMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_PoolableMessage_data, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if zeroCopy || x.zeroCopied {
+				x.Data = runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy)
+			} else {
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			if err := budget.List(fd_PoolableMessage_children, len(x.Children), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if len(x.Children) == cap(x.Children) {
+				if err := budget.Message(fd_PoolableMessage_children, int(unsafe.Sizeof(PoolableChild{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Children = append(x.Children, PoolableChildFromPool())
+			} else {
+				x.Children = x.Children[:len(x.Children)+1]
+				if x.Children[len(x.Children)-1] == nil {
+					if err := budget.Message(fd_PoolableMessage_children, int(unsafe.Sizeof(PoolableChild{}))); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					x.Children[len(x.Children)-1] = PoolableChildFromPool()
+				}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Children[len(x.Children)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_PoolableMessage_children, len(x.Children)-1)
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Child == nil {
+				if err := budget.Message(fd_PoolableMessage_child, int(unsafe.Sizeof(PoolableChild{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Child = PoolableChildFromPool()
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Child); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_PoolableMessage_child, -1)
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				if err := budget.List(fd_PoolableMessage_numbers, len(x.Numbers), 1, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Numbers = append(x.Numbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if err := budget.List(fd_PoolableMessage_numbers, len(x.Numbers), elementCount, 8); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if elementCount != 0 && len(x.Numbers) == 0 {
+					x.Numbers = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.Numbers = append(x.Numbers, v)
+				}
+			} else {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Numbers", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChoiceChild", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if oneof, ok := x.Choice.(*PoolableMessage_ChoiceChild); ok && oneof.ChoiceChild != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], oneof.ChoiceChild); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_PoolableMessage_choice_child, -1)
+				}
+			} else {
+				if err := budget.Message(fd_PoolableMessage_choice_child, int(unsafe.Sizeof(PoolableChild{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				v := PoolableChildFromPool()
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_PoolableMessage_choice_child, -1)
+				}
+				x.Choice = &PoolableMessage_ChoiceChild{v}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChoiceString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_PoolableMessage_choice_string, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.Choice = &PoolableMessage_ChoiceString{runtime.String(dAtA[iNdEx:postIndex], zeroCopy)}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NotPoolable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.NotPoolable == nil {
+				if err := budget.Message(fd_PoolableMessage_not_poolable, int(unsafe.Sizeof(B{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.NotPoolable = &B{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NotPoolable); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_PoolableMessage_not_poolable, -1)
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			if err := budget.List(fd_PoolableMessage_chunks, len(x.Chunks), 1, 24); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_PoolableMessage_chunks, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if zeroCopy || x.zeroCopied {
+				x.Chunks = append(x.Chunks, runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy))
+			} else {
+				x.Chunks = runtime.AppendBytes(x.Chunks, dAtA[iNdEx:postIndex])
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			if err := budget.List(fd_PoolableMessage_tags, len(x.Tags), 1, 16); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_PoolableMessage_tags, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.Tags = append(x.Tags, runtime.String(dAtA[iNdEx:postIndex], zeroCopy))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Others", wireType)
+			}
+			if err := budget.List(fd_PoolableMessage_others, len(x.Others), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Message(fd_PoolableMessage_others, int(unsafe.Sizeof(B{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.Others = append(x.Others, &B{})
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Others[len(x.Others)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_PoolableMessage_others, len(x.Others)-1)
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Named", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Named == nil {
+				x.Named = make(map[string]*PoolableChild)
+			}
+			var mapkey string
+			var mapvalue *PoolableChild
+			var mapvalueErr error
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_PoolableMessage_named, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Message(fd_PoolableMessage_named, int(unsafe.Sizeof(PoolableChild{}))); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapvalue = PoolableChildFromPool()
+					if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+						mapvalueErr = err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if mapvalueErr != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarInMap(mapvalueErr, fd_PoolableMessage_named, mapkey)
+			}
+			if budget != nil {
+				if _, ok := x.Named[mapkey]; !ok {
+					if err := budget.MapEntry(fd_PoolableMessage_named, len(x.Named), 24); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.Named[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_PoolableMessage, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_PoolableMessage_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*PoolableMessage)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*PoolableMessage)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if len(src.Data) != 0 {
+		dst.Data = append([]byte{}, src.Data...)
+	}
+	for _, v := range src.Children {
+		m := PoolableChildFromPool()
+		proto.Merge(m, v)
+		dst.Children = append(dst.Children, m)
+	}
+	if src.Child != nil {
+		if dst.Child == nil {
+			dst.Child = PoolableChildFromPool()
+		}
+		proto.Merge(dst.Child, src.Child)
+	}
+	if len(src.Numbers) != 0 {
+		dst.Numbers = append(dst.Numbers, src.Numbers...)
+	}
+	switch v := src.Choice.(type) {
+	case *PoolableMessage_ChoiceChild:
+		if dv, ok := dst.Choice.(*PoolableMessage_ChoiceChild); ok && dv.ChoiceChild != nil {
+			proto.Merge(dv.ChoiceChild, v.ChoiceChild)
+		} else {
+			m := PoolableChildFromPool()
+			proto.Merge(m, v.ChoiceChild)
+			dst.Choice = &PoolableMessage_ChoiceChild{ChoiceChild: m}
+		}
+	case *PoolableMessage_ChoiceString:
+		dst.Choice = &PoolableMessage_ChoiceString{ChoiceString: v.ChoiceString}
+	}
+	if src.NotPoolable != nil {
+		if dst.NotPoolable == nil {
+			dst.NotPoolable = &B{}
+		}
+		proto.Merge(dst.NotPoolable, src.NotPoolable)
+	}
+	for _, v := range src.Chunks {
+		dst.Chunks = append(dst.Chunks, append([]byte{}, v...))
+	}
+	if len(src.Tags) != 0 {
+		dst.Tags = append(dst.Tags, src.Tags...)
+	}
+	for _, v := range src.Others {
+		m := &B{}
+		proto.Merge(m, v)
+		dst.Others = append(dst.Others, m)
+	}
+	if len(src.Named) != 0 {
+		if dst.Named == nil {
+			dst.Named = make(map[string]*PoolableChild, len(src.Named))
+		}
+		for k, v := range src.Named {
+			m := PoolableChildFromPool()
+			proto.Merge(m, v)
+			dst.Named[k] = m
+		}
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_PoolableMessage_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_PoolableMessage_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*PoolableMessage)
+	y, yok := input.MessageB.Interface().(*PoolableMessage)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !bytes.Equal(x.Data, y.Data) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.Children) != len(y.Children) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.Children {
+		if !v.Equal(y.Children[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if !x.Child.Equal(y.Child) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.Numbers) != len(y.Numbers) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.Numbers {
+		if v != y.Numbers[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	switch v := x.Choice.(type) {
+	case nil:
+		if y.Choice != nil {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *PoolableMessage_ChoiceChild:
+		if w, ok := y.Choice.(*PoolableMessage_ChoiceChild); !ok || !v.ChoiceChild.Equal(w.ChoiceChild) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *PoolableMessage_ChoiceString:
+		if w, ok := y.Choice.(*PoolableMessage_ChoiceString); !ok || v.ChoiceString != w.ChoiceString {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if !x.NotPoolable.Equal(y.NotPoolable) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.Chunks) != len(y.Chunks) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.Chunks {
+		if !bytes.Equal(v, y.Chunks[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.Tags) != len(y.Tags) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.Tags {
+		if v != y.Tags[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.Others) != len(y.Others) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.Others {
+		if !v.Equal(y.Others[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.Named) != len(y.Named) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.Named {
+		if w, ok := y.Named[k]; !ok || !v.Equal(w) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+var _PoolableMessage_pool = sync.Pool{
+	New: func() interface{} {
+		return &PoolableMessage{}
+	},
+}
+
+// ResetKeepCapacity resets the message while retaining the allocated
+// capacity of its repeated and bytes fields, nested poolable messages
+// are returned to their pools.
+func (x *PoolableMessage) ResetKeepCapacity() {
+	if x == nil {
+		return
+	}
+	var f0 []byte
+	if !x.zeroCopied {
+		f0 = x.Data[:0]
+	}
+	for _, mm := range x.Children {
+		mm.ResetKeepCapacity()
+	}
+	f1 := x.Children[:0]
+	x.Child.ReturnToPool()
+	f2 := x.Numbers[:0]
+	if oneof, ok := x.Choice.(*PoolableMessage_ChoiceChild); ok {
+		oneof.ChoiceChild.ReturnToPool()
+	}
+	if x.zeroCopied {
+		clear(x.Chunks)
+	}
+	f3 := x.Chunks[:0]
+	clear(x.Tags)
+	f4 := x.Tags[:0]
+	clear(x.Others)
+	f5 := x.Others[:0]
+	for _, mm := range x.Named {
+		mm.ReturnToPool()
+	}
+	x.Reset()
+	x.Data = f0
+	x.Children = f1
+	x.Numbers = f2
+	x.Chunks = f3
+	x.Tags = f4
+	x.Others = f5
+}
+
+// ReturnToPool resets the message and puts it back into its pool,
+// the message must not be used after this call.
+func (x *PoolableMessage) ReturnToPool() {
+	if x != nil {
+		x.ResetKeepCapacity()
+		_PoolableMessage_pool.Put(x)
+	}
+}
+
+// PoolableMessageFromPool returns a PoolableMessage taken from its pool,
+// the message should be given back through ReturnToPool once no longer used.
+// Unmarshal into it using proto.UnmarshalOptions{Merge: true}, as a plain
+// unmarshal resets the message and drops the memory retained by the pool.
+func PoolableMessageFromPool() *PoolableMessage {
+	return _PoolableMessage_pool.Get().(*PoolableMessage)
+}
+
+// Clone returns a deep copy of the message.
+func (x *PoolableMessage) Clone() *PoolableMessage {
+	if x == nil {
+		return nil
+	}
+	dst := new(PoolableMessage)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *PoolableMessage) CopyInto(dst *PoolableMessage) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.Data = bytes.Clone(x.Data)
+	if x.Children != nil {
+		dst.Children = make([]*PoolableChild, len(x.Children))
+		for i, v := range x.Children {
+			dst.Children[i] = v.Clone()
+		}
+	} else {
+		dst.Children = nil
+	}
+	dst.Child = x.Child.Clone()
+	dst.Numbers = slices.Clone(x.Numbers)
+	switch v := x.Choice.(type) {
+	case nil:
+		dst.Choice = nil
+	case *PoolableMessage_ChoiceChild:
+		dst.Choice = &PoolableMessage_ChoiceChild{ChoiceChild: v.ChoiceChild.Clone()}
+	case *PoolableMessage_ChoiceString:
+		dst.Choice = &PoolableMessage_ChoiceString{ChoiceString: v.ChoiceString}
+	}
+	dst.NotPoolable = x.NotPoolable.Clone()
+	if x.Chunks != nil {
+		dst.Chunks = make([][]byte, len(x.Chunks))
+		for i, v := range x.Chunks {
+			dst.Chunks[i] = bytes.Clone(v)
+		}
+	} else {
+		dst.Chunks = nil
+	}
+	dst.Tags = slices.Clone(x.Tags)
+	if x.Others != nil {
+		dst.Others = make([]*B, len(x.Others))
+		for i, v := range x.Others {
+			dst.Others[i] = v.Clone()
+		}
+	} else {
+		dst.Others = nil
+	}
+	if x.Named != nil {
+		dst.Named = make(map[string]*PoolableChild, len(x.Named))
+		for k, v := range x.Named {
+			dst.Named[k] = v.Clone()
+		}
+	} else {
+		dst.Named = nil
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *PoolableMessage) Equal(y *PoolableMessage) bool {
+	return _fastReflection_PoolableMessage_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a PoolableMessage, or nil if b is canonical.
+func (*PoolableMessage) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_PoolableMessage.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("data", protoreflect.BytesKind, true)
+		case 2:
+			w.Message("children", true, (*PoolableChild)(nil))
+		case 3:
+			w.Message("child", false, (*PoolableChild)(nil))
+		case 4:
+			w.List("numbers", protoreflect.Uint64Kind, true)
+		case 5:
+			w.Oneof(0)
+			w.Message("choice_child", false, (*PoolableChild)(nil))
+		case 6:
+			w.Oneof(0)
+			w.Scalar("choice_string", protoreflect.StringKind, false)
+		case 7:
+			w.Message("not_poolable", false, (*B)(nil))
+		case 8:
+			w.List("chunks", protoreflect.BytesKind, false)
+		case 9:
+			w.List("tags", protoreflect.StringKind, false)
+		case 10:
+			w.Message("others", true, (*B)(nil))
+		case 11:
+			w.Map("named", protoreflect.StringKind, protoreflect.MessageKind, (*PoolableChild)(nil))
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var (
+	md_PoolableChild         protoreflect.MessageDescriptor
+	fd_PoolableChild_name    protoreflect.FieldDescriptor
+	fd_PoolableChild_payload protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_testpb_pool_proto_init()
+	md_PoolableChild = File_testpb_pool_proto.Messages().ByName("PoolableChild")
+	fd_PoolableChild_name = md_PoolableChild.Fields().ByName("name")
+	fd_PoolableChild_payload = md_PoolableChild.Fields().ByName("payload")
+}
+
+var _ protoreflect.Message = (*fastReflection_PoolableChild)(nil)
+
+type fastReflection_PoolableChild PoolableChild
+
+func (x *PoolableChild) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PoolableChild)(x)
+}
+
+func (x *PoolableChild) slowProtoReflect() protoreflect.Message {
+	mi := &file_testpb_pool_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PoolableChild_messageType fastReflection_PoolableChild_messageType
+var _ protoreflect.MessageType = fastReflection_PoolableChild_messageType{}
+
+type fastReflection_PoolableChild_messageType struct{}
+
+func (x fastReflection_PoolableChild_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PoolableChild)(nil)
+}
+func (x fastReflection_PoolableChild_messageType) New() protoreflect.Message {
+	return new(fastReflection_PoolableChild)
+}
+func (x fastReflection_PoolableChild_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoolableChild
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PoolableChild) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoolableChild
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PoolableChild) Type() protoreflect.MessageType {
+	return _fastReflection_PoolableChild_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PoolableChild) New() protoreflect.Message {
+	return new(fastReflection_PoolableChild)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PoolableChild) Interface() protoreflect.ProtoMessage {
+	return (*PoolableChild)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PoolableChild) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_PoolableChild_name, value) {
+			return
+		}
+	}
+	if len(x.Payload) != 0 {
+		value := protoreflect.ValueOfBytes(x.Payload)
+		if !f(fd_PoolableChild_payload, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PoolableChild) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // PoolableChild.name
+		if fd != fd_PoolableChild_name {
+			break
+		}
+		return x.Name != ""
+	case 2: // PoolableChild.payload
+		if fd != fd_PoolableChild_payload {
+			break
+		}
+		return len(x.Payload) != 0
+	}
+	if fd := runtime.FieldOf(fd, md_PoolableChild); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message PoolableChild does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message PoolableChild does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolableChild) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // PoolableChild.name
+		if fd != fd_PoolableChild_name {
+			break
+		}
+		x.Name = ""
+		return
+	case 2: // PoolableChild.payload
+		if fd != fd_PoolableChild_payload {
+			break
+		}
+		x.Payload = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_PoolableChild); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message PoolableChild does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message PoolableChild does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PoolableChild) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // PoolableChild.name
+		if descriptor != fd_PoolableChild_name {
+			break
+		}
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case 2: // PoolableChild.payload
+		if descriptor != fd_PoolableChild_payload {
+			break
+		}
+		value := x.Payload
+		return protoreflect.ValueOfBytes(value)
+	}
+	if fd := runtime.FieldOf(descriptor, md_PoolableChild); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message PoolableChild does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message PoolableChild does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolableChild) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // PoolableChild.name
+		if fd != fd_PoolableChild_name {
+			break
+		}
+		x.Name = value.Interface().(string)
+		return
+	case 2: // PoolableChild.payload
+		if fd != fd_PoolableChild_payload {
+			break
+		}
+		x.Payload = value.Bytes()
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_PoolableChild); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message PoolableChild does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message PoolableChild does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolableChild) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // PoolableChild.name
+		if fd != fd_PoolableChild_name {
+			break
+		}
+		panic(fmt.Errorf("field name of message PoolableChild is not mutable"))
+	case 2: // PoolableChild.payload
+		if fd != fd_PoolableChild_payload {
+			break
+		}
+		panic(fmt.Errorf("field payload of message PoolableChild is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_PoolableChild); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message PoolableChild does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message PoolableChild does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PoolableChild) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // PoolableChild.name
+		if fd != fd_PoolableChild_name {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 2: // PoolableChild.payload
+		if fd != fd_PoolableChild_payload {
+			break
+		}
+		return protoreflect.ValueOfBytes(nil)
+	}
+	if fd := runtime.FieldOf(fd, md_PoolableChild); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message PoolableChild does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message PoolableChild does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PoolableChild) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in PoolableChild", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PoolableChild) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolableChild) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PoolableChild) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PoolableChild) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_PoolableChild_methods
+}
+
+var _fastReflection_PoolableChild_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_PoolableChild_size,
+	Marshal:           _fastReflection_PoolableChild_marshal,
+	Unmarshal:         _fastReflection_PoolableChild_unmarshal,
+	Merge:             _fastReflection_PoolableChild_merge,
+	CheckInitialized:  _fastReflection_PoolableChild_checkInitialized,
+	Equal:             _fastReflection_PoolableChild_equal,
+}
+
+func _fastReflection_PoolableChild_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*PoolableChild)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	l = len(x.Name)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	l = len(x.Payload)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_PoolableChild_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*PoolableChild)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if len(x.Payload) > 0 {
+		i -= len(x.Payload)
+		copy(dAtA[i:], x.Payload)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(x.Name) > 0 {
+		if !utf8.ValidString(x.Name) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.Name)
+		copy(dAtA[i:], x.Name)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_PoolableChild_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*PoolableChild)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_PoolableChild.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	if zeroCopy {
+		x.zeroCopied = true
+	}
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoolableChild: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoolableChild: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_PoolableChild_name, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.Name = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_PoolableChild_payload, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if zeroCopy || x.zeroCopied {
+				x.Payload = runtime.Bytes(dAtA[iNdEx:postIndex], zeroCopy)
+			} else {
+				x.Payload = append(x.Payload[:0], dAtA[iNdEx:postIndex]...)
+				if x.Payload == nil {
+					x.Payload = []byte{}
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_PoolableChild, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_PoolableChild_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*PoolableChild)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*PoolableChild)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.Name != "" {
+		dst.Name = src.Name
+	}
+	if len(src.Payload) != 0 {
+		dst.Payload = append([]byte{}, src.Payload...)
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_PoolableChild_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_PoolableChild_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*PoolableChild)
+	y, yok := input.MessageB.Interface().(*PoolableChild)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.Name != y.Name {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !bytes.Equal(x.Payload, y.Payload) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+var _PoolableChild_pool = sync.Pool{
+	New: func() interface{} {
+		return &PoolableChild{}
+	},
+}
+
+// ResetKeepCapacity resets the message while retaining the allocated
+// capacity of its repeated and bytes fields, nested poolable messages
+// are returned to their pools.
+func (x *PoolableChild) ResetKeepCapacity() {
+	if x == nil {
+		return
+	}
+	var f0 []byte
+	if !x.zeroCopied {
+		f0 = x.Payload[:0]
+	}
+	x.Reset()
+	x.Payload = f0
+}
+
+// ReturnToPool resets the message and puts it back into its pool,
+// the message must not be used after this call.
+func (x *PoolableChild) ReturnToPool() {
+	if x != nil {
+		x.ResetKeepCapacity()
+		_PoolableChild_pool.Put(x)
+	}
+}
+
+// PoolableChildFromPool returns a PoolableChild taken from its pool,
+// the message should be given back through ReturnToPool once no longer used.
+// Unmarshal into it using proto.UnmarshalOptions{Merge: true}, as a plain
+// unmarshal resets the message and drops the memory retained by the pool.
+func PoolableChildFromPool() *PoolableChild {
+	return _PoolableChild_pool.Get().(*PoolableChild)
+}
+
+// Clone returns a deep copy of the message.
+func (x *PoolableChild) Clone() *PoolableChild {
+	if x == nil {
+		return nil
+	}
+	dst := new(PoolableChild)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *PoolableChild) CopyInto(dst *PoolableChild) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.Name = x.Name
+	dst.Payload = bytes.Clone(x.Payload)
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *PoolableChild) Equal(y *PoolableChild) bool {
+	return _fastReflection_PoolableChild_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a PoolableChild, or nil if b is canonical.
+func (*PoolableChild) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_PoolableChild.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("name", protoreflect.StringKind, true)
+		case 2:
+			w.Scalar("payload", protoreflect.BytesKind, true)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *PoolableMessage) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *PoolableMessage) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if len(x.Data) > 0 {
+		b = append(b, "\"data\":"...)
+		b = runtime.AppendJSONBytes(b, x.Data)
+		b = append(b, ',')
+	}
+	if len(x.Children) > 0 {
+		b = append(b, "\"children\":"...)
+		b = append(b, '[')
+		for _, v := range x.Children {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if x.Child != nil {
+		b = append(b, "\"child\":"...)
+		if b, err = x.Child.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.Numbers) > 0 {
+		b = append(b, "\"numbers\":"...)
+		b = append(b, '[')
+		for _, v := range x.Numbers {
+			b = runtime.AppendJSONUint64(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if v, ok := x.Choice.(*PoolableMessage_ChoiceChild); ok {
+		b = append(b, "\"choiceChild\":"...)
+		if b, err = v.ChoiceChild.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.Choice.(*PoolableMessage_ChoiceString); ok {
+		b = append(b, "\"choiceString\":"...)
+		if b, err = runtime.AppendJSONString(b, v.ChoiceString, "PoolableMessage.choice_string"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.NotPoolable != nil {
+		b = append(b, "\"notPoolable\":"...)
+		if b, err = x.NotPoolable.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.Chunks) > 0 {
+		b = append(b, "\"chunks\":"...)
+		b = append(b, '[')
+		for _, v := range x.Chunks {
+			b = runtime.AppendJSONBytes(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.Tags) > 0 {
+		b = append(b, "\"tags\":"...)
+		b = append(b, '[')
+		for _, v := range x.Tags {
+			if b, err = runtime.AppendJSONString(b, v, "PoolableMessage.tags"); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.Others) > 0 {
+		b = append(b, "\"others\":"...)
+		b = append(b, '[')
+		for _, v := range x.Others {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.Named) > 0 {
+		b = append(b, "\"named\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.Named)) {
+			v := x.Named[k]
+			if b, err = runtime.AppendJSONString(b, k, "PoolableMessage.NamedEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *PoolableMessage) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *PoolableMessage) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.Bytes(fd)
+			if err != nil {
+				return err
+			}
+			x.Data = v
+		case 2:
+			return d.List(func() error {
+				v := new(PoolableChild)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.Children = append(x.Children, v)
+				return nil
+			})
+		case 3:
+			v := new(PoolableChild)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Child = v
+		case 4:
+			return d.List(func() error {
+				v, err := d.Uint64(fd)
+				if err != nil {
+					return err
+				}
+				x.Numbers = append(x.Numbers, v)
+				return nil
+			})
+		case 5:
+			v := new(PoolableChild)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Choice = &PoolableMessage_ChoiceChild{ChoiceChild: v}
+		case 6:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.Choice = &PoolableMessage_ChoiceString{ChoiceString: v}
+		case 7:
+			v := new(B)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.NotPoolable = v
+		case 8:
+			return d.List(func() error {
+				v, err := d.Bytes(fd)
+				if err != nil {
+					return err
+				}
+				x.Chunks = append(x.Chunks, v)
+				return nil
+			})
+		case 9:
+			return d.List(func() error {
+				v, err := d.String(fd)
+				if err != nil {
+					return err
+				}
+				x.Tags = append(x.Tags, v)
+				return nil
+			})
+		case 10:
+			return d.List(func() error {
+				v := new(B)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.Others = append(x.Others, v)
+				return nil
+			})
+		case 11:
+			if x.Named == nil {
+				x.Named = make(map[string]*PoolableChild)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.Named[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v := new(PoolableChild)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.Named[key] = v
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *PoolableChild) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *PoolableChild) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Name != "" {
+		b = append(b, "\"name\":"...)
+		if b, err = runtime.AppendJSONString(b, x.Name, "PoolableChild.name"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.Payload) > 0 {
+		b = append(b, "\"payload\":"...)
+		b = runtime.AppendJSONBytes(b, x.Payload)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *PoolableChild) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *PoolableChild) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.Name = v
+		case 2:
+			v, err := d.Bytes(fd)
+			if err != nil {
+				return err
+			}
+			x.Payload = v
+		}
+		return nil
+	})
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.18.1
+// source: testpb/pool.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PoolableMessage and PoolableChild are marked as poolable
+// through the plugin pool option in scripts/fastreflect.sh.
+type PoolableMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+	zeroCopied    bool
+
+	Data     []byte           `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Children []*PoolableChild `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	Child    *PoolableChild   `protobuf:"bytes,3,opt,name=child,proto3" json:"child,omitempty"`
+	Numbers  []uint64         `protobuf:"varint,4,rep,packed,name=numbers,proto3" json:"numbers,omitempty"`
+	// Types that are assignable to Choice:
+	//	*PoolableMessage_ChoiceChild
+	//	*PoolableMessage_ChoiceString
+	Choice      isPoolableMessage_Choice  `protobuf_oneof:"choice"`
+	NotPoolable *B                        `protobuf:"bytes,7,opt,name=not_poolable,json=notPoolable,proto3" json:"not_poolable,omitempty"`
+	Chunks      [][]byte                  `protobuf:"bytes,8,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	Tags        []string                  `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Others      []*B                      `protobuf:"bytes,10,rep,name=others,proto3" json:"others,omitempty"`
+	Named       map[string]*PoolableChild `protobuf:"bytes,11,rep,name=named,proto3" json:"named,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PoolableMessage) Reset() {
+	*x = PoolableMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_pool_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolableMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolableMessage) ProtoMessage() {}
+
+// Deprecated: Use PoolableMessage.ProtoReflect.Descriptor instead.
+func (*PoolableMessage) Descriptor() ([]byte, []int) {
+	return file_testpb_pool_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PoolableMessage) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PoolableMessage) GetChildren() []*PoolableChild {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *PoolableMessage) GetChild() *PoolableChild {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *PoolableMessage) GetNumbers() []uint64 {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *PoolableMessage) GetChoice() isPoolableMessage_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *PoolableMessage) GetChoiceChild() *PoolableChild {
+	if x, ok := x.GetChoice().(*PoolableMessage_ChoiceChild); ok {
+		return x.ChoiceChild
+	}
+	return nil
+}
+
+func (x *PoolableMessage) GetChoiceString() string {
+	if x, ok := x.GetChoice().(*PoolableMessage_ChoiceString); ok {
+		return x.ChoiceString
+	}
+	return ""
+}
+
+func (x *PoolableMessage) GetNotPoolable() *B {
+	if x != nil {
+		return x.NotPoolable
+	}
+	return nil
+}
+
+func (x *PoolableMessage) GetChunks() [][]byte {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+func (x *PoolableMessage) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PoolableMessage) GetOthers() []*B {
+	if x != nil {
+		return x.Others
+	}
+	return nil
+}
+
+func (x *PoolableMessage) GetNamed() map[string]*PoolableChild {
+	if x != nil {
+		return x.Named
+	}
+	return nil
+}
+
+type isPoolableMessage_Choice interface {
+	isPoolableMessage_Choice()
+}
+
+type PoolableMessage_ChoiceChild struct {
+	ChoiceChild *PoolableChild `protobuf:"bytes,5,opt,name=choice_child,json=choiceChild,proto3,oneof"`
+}
+
+type PoolableMessage_ChoiceString struct {
+	ChoiceString string `protobuf:"bytes,6,opt,name=choice_string,json=choiceString,proto3,oneof"`
+}
+
+func (*PoolableMessage_ChoiceChild) isPoolableMessage_Choice() {}
+
+func (*PoolableMessage_ChoiceString) isPoolableMessage_Choice() {}
+
+type PoolableChild struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+	zeroCopied    bool
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *PoolableChild) Reset() {
+	*x = PoolableChild{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_pool_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolableChild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolableChild) ProtoMessage() {}
+
+// Deprecated: Use PoolableChild.ProtoReflect.Descriptor instead.
+func (*PoolableChild) Descriptor() ([]byte, []int) {
+	return file_testpb_pool_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PoolableChild) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PoolableChild) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+var File_testpb_pool_proto protoreflect.FileDescriptor
+
+var file_testpb_pool_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x31, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x03, 0x0a, 0x0f, 0x50, 0x6f, 0x6f, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2a, 0x0a, 0x08, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x50, 0x6f, 0x6f, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x08, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x0c, 0x63, 0x68, 0x6f, 0x69, 0x63,
+	0x65, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x50, 0x6f, 0x6f, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x48, 0x00, 0x52,
+	0x0b, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x0d,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x02, 0x2e, 0x42, 0x52, 0x0b, 0x6e,
+	0x6f, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x06, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x02, 0x2e, 0x42, 0x52, 0x06, 0x6f, 0x74, 0x68, 0x65,
+	0x72, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
+	0x6e, 0x61, 0x6d, 0x65, 0x64, 0x1a, 0x48, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x08, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x3d, 0x0a, 0x0d, 0x50, 0x6f, 0x6f,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_testpb_pool_proto_rawDescOnce sync.Once
+	file_testpb_pool_proto_rawDescData = file_testpb_pool_proto_rawDesc
+)
+
+func file_testpb_pool_proto_rawDescGZIP() []byte {
+	file_testpb_pool_proto_rawDescOnce.Do(func() {
+		file_testpb_pool_proto_rawDescData = protoimpl.X.CompressGZIP(file_testpb_pool_proto_rawDescData)
+	})
+	return file_testpb_pool_proto_rawDescData
+}
+
+var file_testpb_pool_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_testpb_pool_proto_goTypes = []interface{}{
+	(*PoolableMessage)(nil), // 0: PoolableMessage
+	(*PoolableChild)(nil),   // 1: PoolableChild
+	nil,                     // 2: PoolableMessage.NamedEntry
+	(*B)(nil),               // 3: B
+}
+var file_testpb_pool_proto_depIdxs = []int32{
+	1, // 0: PoolableMessage.children:type_name -> PoolableChild
+	1, // 1: PoolableMessage.child:type_name -> PoolableChild
+	1, // 2: PoolableMessage.choice_child:type_name -> PoolableChild
+	3, // 3: PoolableMessage.not_poolable:type_name -> B
+	3, // 4: PoolableMessage.others:type_name -> B
+	2, // 5: PoolableMessage.named:type_name -> PoolableMessage.NamedEntry
+	1, // 6: PoolableMessage.NamedEntry.value:type_name -> PoolableChild
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_testpb_pool_proto_init() }
+func file_testpb_pool_proto_init() {
+	if File_testpb_pool_proto != nil {
+		return
+	}
+	file_testpb_1_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_testpb_pool_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolableMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.zeroCopied
+			default:
+				return nil
+			}
+		}
+		file_testpb_pool_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolableChild); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.zeroCopied
+			default:
+				return nil
+			}
+		}
+	}
+	file_testpb_pool_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*PoolableMessage_ChoiceChild)(nil),
+		(*PoolableMessage_ChoiceString)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_testpb_pool_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_testpb_pool_proto_goTypes,
+		DependencyIndexes: file_testpb_pool_proto_depIdxs,
+		MessageInfos:      file_testpb_pool_proto_msgTypes,
+	}.Build()
+	File_testpb_pool_proto = out.File
+	file_testpb_pool_proto_rawDesc = nil
+	file_testpb_pool_proto_goTypes = nil
+	file_testpb_pool_proto_depIdxs = nil
+}
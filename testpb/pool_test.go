@@ -0,0 +1,108 @@
+package testpb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPool(t *testing.T) {
+	msg := &PoolableMessage{
+		Data:        []byte("data"),
+		Children:    []*PoolableChild{{Name: "first", Payload: []byte{1}}, {Name: "second"}},
+		Child:       &PoolableChild{Name: "child"},
+		Numbers:     []uint64{1, 2, 3},
+		Choice:      &PoolableMessage_ChoiceChild{ChoiceChild: &PoolableChild{Payload: []byte("choice")}},
+		NotPoolable: &B{X: "b"},
+		Chunks:      [][]byte{[]byte("chunk")},
+		Tags:        []string{"tag"},
+		Others:      []*B{{X: "other"}},
+		Named:       map[string]*PoolableChild{"named": {Name: "named"}},
+	}
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+
+	t.Run("reset keeps capacity", func(t *testing.T) {
+		pooled := PoolableMessageFromPool()
+		require.NoError(t, proto.Unmarshal(bz, pooled))
+		require.True(t, proto.Equal(msg, pooled))
+
+		children, data, chunk := pooled.Children, &pooled.Data[0], &pooled.Chunks[0][0]
+		pooled.ResetKeepCapacity()
+		require.True(t, proto.Equal(&PoolableMessage{}, pooled))
+		require.Len(t, pooled.Children, 0)
+		require.Equal(t, cap(children), cap(pooled.Children))
+
+		// the elements of the other lists are dropped, except for bytes
+		require.Empty(t, pooled.Tags[:1][0])
+		require.Nil(t, pooled.Others[:1][0])
+
+		// the retained children are reused by unmarshal
+		require.NoError(t, proto.UnmarshalOptions{Merge: true}.Unmarshal(bz, pooled))
+		require.True(t, proto.Equal(msg, pooled))
+		require.Same(t, children[0], pooled.Children[0])
+		require.Same(t, children[1], pooled.Children[1])
+		// and so is the memory of the bytes fields
+		require.Same(t, data, &pooled.Data[0])
+		require.Same(t, chunk, &pooled.Chunks[0][0])
+	})
+
+	t.Run("return to pool", func(t *testing.T) {
+		pooled := PoolableMessageFromPool()
+		require.NoError(t, proto.Unmarshal(bz, pooled))
+		pooled.ReturnToPool()
+
+		pooled = PoolableMessageFromPool()
+		require.True(t, proto.Equal(&PoolableMessage{}, pooled))
+		require.NoError(t, proto.Unmarshal(bz, pooled))
+		require.True(t, proto.Equal(msg, pooled))
+	})
+
+	t.Run("map values", func(t *testing.T) {
+		pooled := PoolableMessageFromPool()
+		require.NoError(t, proto.Unmarshal(bz, pooled))
+		named := pooled.Named["named"]
+		pooled.ResetKeepCapacity()
+		require.Nil(t, pooled.Named)
+		// the map value was reset when returned to its pool
+		require.True(t, proto.Equal(&PoolableChild{}, named))
+	})
+
+	t.Run("nil message", func(t *testing.T) {
+		var pooled *PoolableMessage
+		require.NotPanics(t, pooled.ReturnToPool)
+		require.NotPanics(t, pooled.ResetKeepCapacity)
+	})
+}
+
+func Benchmark_Unmarshal_Pool(b *testing.B) {
+	msg := &PoolableMessage{
+		Data:     make([]byte, 64),
+		Children: []*PoolableChild{{Name: "first", Payload: make([]byte, 32)}, {Name: "second"}},
+		Child:    &PoolableChild{Name: "child"},
+		Numbers:  []uint64{1, 2, 3},
+	}
+	bz, err := proto.Marshal(msg)
+	require.NoError(b, err)
+
+	b.Run("pool", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			pooled := PoolableMessageFromPool()
+			if err := (proto.UnmarshalOptions{Merge: true}).Unmarshal(bz, pooled); err != nil {
+				b.Fatal(err)
+			}
+			pooled.ReturnToPool()
+		}
+	})
+
+	b.Run("new", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := proto.Unmarshal(bz, &PoolableMessage{}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -3,6 +3,8 @@ package testpb
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-proto/runtime"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -321,3 +323,105 @@ func Benchmark_SetUnknown_SR(b *testing.B) {
 		a.slowProtoReflect().SetUnknown(f)
 	}
 }
+
+func newBenchMsg() *A {
+	return &A{
+		Enum:    Enumeration_Two,
+		INT32:   2,
+		STRING:  "fourteen",
+		BYTES:   []byte("fifteen"),
+		MESSAGE: &B{X: "something"},
+		MAP:     map[string]*B{"a": {X: "aa"}},
+		LIST:    []*B{{X: "list"}},
+		ONEOF:   &A_ONEOF_B{ONEOF_B: &B{X: "ONEOF"}},
+	}
+}
+
+// requireNoAllocs fails the benchmark if f allocates, the fast path is expected to be allocation free.
+func requireNoAllocs(b *testing.B, f func()) {
+	b.Helper()
+	if allocs := testing.AllocsPerRun(100, f); allocs != 0 {
+		b.Fatalf("expected no allocations, got %v", allocs)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		f()
+	}
+}
+
+func Benchmark_ProtoMethods_FR(b *testing.B) {
+	msg := newBenchMsg().ProtoReflect()
+	requireNoAllocs(b, func() {
+		_ = msg.ProtoMethods()
+	})
+}
+
+func Benchmark_Size_FR(b *testing.B) {
+	msg := newBenchMsg()
+	requireNoAllocs(b, func() {
+		_ = proto.Size(msg)
+	})
+}
+
+func Benchmark_MarshalAppend_FR(b *testing.B) {
+	msg := newBenchMsg()
+	buf := make([]byte, 0, proto.Size(msg))
+	requireNoAllocs(b, func() {
+		_, _ = proto.MarshalOptions{}.MarshalAppend(buf[:0], msg)
+	})
+}
+
+func Benchmark_Equal_FR(b *testing.B) {
+	x, y := newBenchMsg(), newBenchMsg()
+	requireNoAllocs(b, func() {
+		_ = proto.Equal(x, y)
+	})
+}
+
+func Benchmark_Equal_SR(b *testing.B) {
+	x, y := newBenchMsg().slowProtoReflect(), newBenchMsg().slowProtoReflect()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = protoreflect.ValueOfMessage(x).Equal(protoreflect.ValueOfMessage(y))
+	}
+}
+
+func Benchmark_Clone_FR(b *testing.B) {
+	msg := newBenchMsg()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = msg.Clone()
+	}
+}
+
+func Benchmark_ProtoClone_FR(b *testing.B) {
+	msg := newBenchMsg()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = proto.Clone(msg)
+	}
+}
+
+func benchmarkUnmarshal(b *testing.B, options proto.UnmarshalOptions) {
+	bz, err := proto.Marshal(newBenchMsg())
+	if err != nil {
+		b.Fatal(err)
+	}
+	msg := &A{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := options.Unmarshal(bz, msg); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_Unmarshal_FR(b *testing.B) {
+	benchmarkUnmarshal(b, proto.UnmarshalOptions{})
+}
+
+func Benchmark_Unmarshal_ZeroCopy_FR(b *testing.B) {
+	benchmarkUnmarshal(b, runtime.ZeroCopy(proto.UnmarshalOptions{}))
+}
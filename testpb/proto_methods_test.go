@@ -17,6 +17,7 @@ func TestProtoMethods(t *testing.T) {
 	t.Run("testSize", rapid.MakeCheck(testSize))
 	t.Run("testMarshal", rapid.MakeCheck(testMarshal))
 	t.Run("testUnmarshal", rapid.MakeCheck(testUnmarshal))
+	t.Run("testMarshalAppend", rapid.MakeCheck(testMarshalAppend))
 }
 
 func testSize(t *rapid.T) {
@@ -47,6 +48,29 @@ func testMarshal(t *rapid.T) {
 	require.Equal(t, canonical, result)
 }
 
+func testMarshalAppend(t *rapid.T) {
+	msg := getRapidMsg(t)
+	opts := proto.MarshalOptions{Deterministic: true}
+	expected, err := opts.Marshal(&msg)
+	require.NoError(t, err)
+
+	// the message is appended after the existing content, in place when the capacity allows it
+	prefix := []byte("prefix")
+	buf := make([]byte, len(prefix), len(prefix)+len(expected))
+	copy(buf, prefix)
+	result, err := opts.MarshalAppend(buf, &msg)
+	require.NoError(t, err)
+	require.Equal(t, append(prefix, expected...), result)
+	require.Equal(t, &buf[:1][0], &result[0])
+
+	// sizes cached by a previous size computation are honored
+	size := proto.Size(&msg)
+	result, err = proto.MarshalOptions{Deterministic: true, UseCachedSize: true}.MarshalAppend(nil, &msg)
+	require.NoError(t, err)
+	require.Equal(t, size, len(result))
+	require.Equal(t, expected, result)
+}
+
 func testUnmarshal(t *rapid.T) {
 	a := getRapidMsg(t)
 	fastMsg := a.ProtoReflect()
@@ -185,3 +209,35 @@ var genMessageB = rapid.Custom(func(t *rapid.T) *B {
 	}
 	return &msg
 })
+
+func TestUseCachedSize(t *testing.T) {
+	opts := proto.MarshalOptions{UseCachedSize: true}
+	want, err := proto.Marshal(&LazyHeader{Height: 42, ChainId: "chain"})
+	require.NoError(t, err)
+
+	// a message which was never sized has no cached size
+	got, err := opts.Marshal(&LazyHeader{Height: 42, ChainId: "chain"})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	// neither have clones, nor messages copied into
+	msg := &LazyHeader{Height: 42, ChainId: "chain"}
+	require.Equal(t, len(want), proto.Size(msg))
+	got, err = opts.Marshal(msg.Clone())
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	dst := &LazyHeader{ChainId: "a much longer chain id"}
+	require.NotEqual(t, len(want), proto.Size(dst))
+	msg.CopyInto(dst)
+	got, err = opts.Marshal(dst)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	// an empty message caches its size of zero
+	empty := &LazyHeader{}
+	require.Zero(t, proto.Size(empty))
+	got, err = opts.Marshal(empty)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
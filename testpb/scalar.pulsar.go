@@ -0,0 +1,5389 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package testpb
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	testscalars "github.com/cosmos/cosmos-proto/internal/testscalars"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	maps "maps"
+	math "math"
+	reflect "reflect"
+	slices "slices"
+	sort "sort"
+	sync "sync"
+	atomic "sync/atomic"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
+)
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *ScalarCoin) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *ScalarCoin) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if v, err := runtime.MarshalScalar(fd_ScalarCoin_amount, &x.Amount); err != nil {
+		return b, err
+	} else if len(v) > 0 {
+		b = append(b, "\"amount\":"...)
+		b = runtime.AppendAminoJSONString(b, string(v))
+		b = append(b, ',')
+	}
+	if len(x.Amounts) > 0 {
+		b = append(b, "\"amounts\":"...)
+		b = append(b, '[')
+		for _, v := range x.Amounts {
+			b = runtime.AppendAminoJSONString(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if x.Denom != "" {
+		b = append(b, "\"denom\":"...)
+		b = runtime.AppendAminoJSONString(b, x.Denom)
+		b = append(b, ',')
+	}
+	if v, err := runtime.MarshalScalar(fd_ScalarCoin_hash, &x.Hash); err != nil {
+		return b, err
+	} else if len(v) > 0 {
+		b = append(b, "\"hash\":"...)
+		b = runtime.AppendJSONBytes(b, v)
+		b = append(b, ',')
+	}
+	if x.Max != nil {
+		v := *x.Max
+		b = append(b, "\"max\":"...)
+		b = runtime.AppendAminoJSONString(b, v)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *ScalarInput) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *ScalarInput) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	b = append(b, '{')
+	if x.Address != "" {
+		b = append(b, "\"address\":"...)
+		b = runtime.AppendAminoJSONString(b, x.Address)
+		b = append(b, ',')
+	}
+	if len(x.Limits) > 0 {
+		b = append(b, "\"limits\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.Limits)) {
+			v := x.Limits[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			b = runtime.AppendAminoJSONString(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if x.Rate != "" {
+		b = append(b, "\"rate\":"...)
+		b = runtime.AppendAminoJSONString(b, x.Rate)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// MarshalAminoJSON returns the legacy Amino JSON encoding of x, with its keys sorted.
+func (x *ScalarMsg) MarshalAminoJSON() ([]byte, error) {
+	return x.AppendAminoJSON(nil)
+}
+
+// AppendAminoJSON appends the legacy Amino JSON encoding of x to b.
+func (x *ScalarMsg) AppendAminoJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.FromAddress != "" {
+		b = append(b, "\"from_address\":"...)
+		b = runtime.AppendAminoJSONString(b, x.FromAddress)
+		b = append(b, ',')
+	}
+	if len(x.Inputs) > 0 {
+		b = append(b, "\"inputs\":"...)
+		b = append(b, '[')
+		for _, v := range x.Inputs {
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	x.lazyDecodeLazyInput()
+	if x.LazyInput != nil {
+		b = append(b, "\"lazy_input\":"...)
+		if b, err = x.LazyInput.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.NamedInputs) > 0 {
+		b = append(b, "\"named_inputs\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.NamedInputs)) {
+			v := x.NamedInputs[k]
+			b = runtime.AppendAminoJSONString(b, k)
+			b = append(b, ':')
+			if b, err = v.AppendAminoJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.Recipients) > 0 {
+		b = append(b, "\"recipients\":"...)
+		b = append(b, '{')
+		for _, k := range runtime.SortedAminoJSONKeys(x.Recipients) {
+			v := x.Recipients[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			b = runtime.AppendAminoJSONString(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if len(x.Signers) > 0 {
+		b = append(b, "\"signers\":"...)
+		b = append(b, '[')
+		for _, v := range x.Signers {
+			b = runtime.AppendAminoJSONString(b, v)
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if v, ok := x.Recipient.(*ScalarMsg_ToAddress); ok {
+		b = append(b, "\"to_address\":"...)
+		b = runtime.AppendAminoJSONString(b, v.ToAddress)
+		b = append(b, ',')
+	}
+	if v, ok := x.Recipient.(*ScalarMsg_ToInput); ok {
+		b = append(b, "\"to_input\":"...)
+		if b, err = v.ToInput.AppendAminoJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+var _ protoreflect.List = (*_ScalarCoin_4_list)(nil)
+
+type _ScalarCoin_4_list struct {
+	list *[]string
+}
+
+func (x *_ScalarCoin_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ScalarCoin_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ScalarCoin_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ScalarCoin_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ScalarCoin_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ScalarCoin at list field Amounts as it is not of Message kind"))
+}
+
+func (x *_ScalarCoin_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ScalarCoin_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ScalarCoin_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ScalarCoin         protoreflect.MessageDescriptor
+	fd_ScalarCoin_denom   protoreflect.FieldDescriptor
+	fd_ScalarCoin_amount  protoreflect.FieldDescriptor
+	fd_ScalarCoin_hash    protoreflect.FieldDescriptor
+	fd_ScalarCoin_amounts protoreflect.FieldDescriptor
+	fd_ScalarCoin_max     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_testpb_scalar_proto_init()
+	md_ScalarCoin = File_testpb_scalar_proto.Messages().ByName("ScalarCoin")
+	fd_ScalarCoin_denom = md_ScalarCoin.Fields().ByName("denom")
+	fd_ScalarCoin_amount = md_ScalarCoin.Fields().ByName("amount")
+	fd_ScalarCoin_hash = md_ScalarCoin.Fields().ByName("hash")
+	fd_ScalarCoin_amounts = md_ScalarCoin.Fields().ByName("amounts")
+	fd_ScalarCoin_max = md_ScalarCoin.Fields().ByName("max")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScalarCoin)(nil)
+
+type fastReflection_ScalarCoin ScalarCoin
+
+func (x *ScalarCoin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScalarCoin)(x)
+}
+
+func (x *ScalarCoin) slowProtoReflect() protoreflect.Message {
+	mi := &file_testpb_scalar_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScalarCoin_messageType fastReflection_ScalarCoin_messageType
+var _ protoreflect.MessageType = fastReflection_ScalarCoin_messageType{}
+
+type fastReflection_ScalarCoin_messageType struct{}
+
+func (x fastReflection_ScalarCoin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScalarCoin)(nil)
+}
+func (x fastReflection_ScalarCoin_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScalarCoin)
+}
+func (x fastReflection_ScalarCoin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScalarCoin
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScalarCoin) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScalarCoin
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScalarCoin) Type() protoreflect.MessageType {
+	return _fastReflection_ScalarCoin_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScalarCoin) New() protoreflect.Message {
+	return new(fastReflection_ScalarCoin)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScalarCoin) Interface() protoreflect.ProtoMessage {
+	return (*ScalarCoin)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScalarCoin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_ScalarCoin_denom, value) {
+			return
+		}
+	}
+	if runtime.HasScalar(&x.Amount) {
+		value := runtime.ScalarValue(fd_ScalarCoin_amount, &x.Amount)
+		if !f(fd_ScalarCoin_amount, value) {
+			return
+		}
+	}
+	if runtime.HasScalar(&x.Hash) {
+		value := runtime.ScalarValue(fd_ScalarCoin_hash, &x.Hash)
+		if !f(fd_ScalarCoin_hash, value) {
+			return
+		}
+	}
+	if len(x.Amounts) != 0 {
+		value := protoreflect.ValueOfList(&_ScalarCoin_4_list{list: &x.Amounts})
+		if !f(fd_ScalarCoin_amounts, value) {
+			return
+		}
+	}
+	if x.Max != nil {
+		value := protoreflect.ValueOfString(*x.Max)
+		if !f(fd_ScalarCoin_max, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScalarCoin) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // ScalarCoin.denom
+		if fd.FullName() != "ScalarCoin.denom" {
+			break
+		}
+		return x.Denom != ""
+	case 2: // ScalarCoin.amount
+		if fd != fd_ScalarCoin_amount {
+			break
+		}
+		return runtime.HasScalar(&x.Amount)
+	case 3: // ScalarCoin.hash
+		if fd.FullName() != "ScalarCoin.hash" {
+			break
+		}
+		return runtime.HasScalar(&x.Hash)
+	case 4: // ScalarCoin.amounts
+		if fd != fd_ScalarCoin_amounts {
+			break
+		}
+		return len(x.Amounts) != 0
+	case 5: // ScalarCoin.max
+		if fd.FullName() != "ScalarCoin.max" {
+			break
+		}
+		return x.Max != nil
+	}
+	if fd := runtime.FieldOf(fd, md_ScalarCoin); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message ScalarCoin does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarCoin does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScalarCoin) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // ScalarCoin.denom
+		if fd.FullName() != "ScalarCoin.denom" {
+			break
+		}
+		x.Denom = ""
+		return
+	case 2: // ScalarCoin.amount
+		if fd != fd_ScalarCoin_amount {
+			break
+		}
+		runtime.ClearScalar(&x.Amount)
+		return
+	case 3: // ScalarCoin.hash
+		if fd.FullName() != "ScalarCoin.hash" {
+			break
+		}
+		runtime.ClearScalar(&x.Hash)
+		return
+	case 4: // ScalarCoin.amounts
+		if fd != fd_ScalarCoin_amounts {
+			break
+		}
+		x.Amounts = nil
+		return
+	case 5: // ScalarCoin.max
+		if fd.FullName() != "ScalarCoin.max" {
+			break
+		}
+		x.Max = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_ScalarCoin); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message ScalarCoin does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarCoin does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScalarCoin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // ScalarCoin.denom
+		if descriptor.FullName() != "ScalarCoin.denom" {
+			break
+		}
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case 2: // ScalarCoin.amount
+		if descriptor != fd_ScalarCoin_amount {
+			break
+		}
+		return runtime.ScalarValue(descriptor, &x.Amount)
+	case 3: // ScalarCoin.hash
+		if descriptor.FullName() != "ScalarCoin.hash" {
+			break
+		}
+		return runtime.ScalarValue(descriptor, &x.Hash)
+	case 4: // ScalarCoin.amounts
+		if descriptor != fd_ScalarCoin_amounts {
+			break
+		}
+		if len(x.Amounts) == 0 {
+			return protoreflect.ValueOfList(&_ScalarCoin_4_list{})
+		}
+		listValue := &_ScalarCoin_4_list{list: &x.Amounts}
+		return protoreflect.ValueOfList(listValue)
+	case 5: // ScalarCoin.max
+		if descriptor.FullName() != "ScalarCoin.max" {
+			break
+		}
+		if x.Max == nil {
+			return protoreflect.ValueOfString("")
+		}
+		value := *x.Max
+		return protoreflect.ValueOfString(value)
+	}
+	if fd := runtime.FieldOf(descriptor, md_ScalarCoin); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message ScalarCoin does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarCoin does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScalarCoin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // ScalarCoin.denom
+		if fd.FullName() != "ScalarCoin.denom" {
+			break
+		}
+		x.Denom = value.Interface().(string)
+		return
+	case 2: // ScalarCoin.amount
+		if fd != fd_ScalarCoin_amount {
+			break
+		}
+		runtime.SetScalar(fd, &x.Amount, value)
+		return
+	case 3: // ScalarCoin.hash
+		if fd.FullName() != "ScalarCoin.hash" {
+			break
+		}
+		runtime.SetScalar(fd, &x.Hash, value)
+		return
+	case 4: // ScalarCoin.amounts
+		if fd != fd_ScalarCoin_amounts {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_ScalarCoin_4_list)
+		x.Amounts = *clv.list
+		return
+	case 5: // ScalarCoin.max
+		if fd.FullName() != "ScalarCoin.max" {
+			break
+		}
+		cv := value.Interface().(string)
+		x.Max = &cv
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_ScalarCoin); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message ScalarCoin does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarCoin does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScalarCoin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 4: // ScalarCoin.amounts
+		if fd != fd_ScalarCoin_amounts {
+			break
+		}
+		if x.Amounts == nil {
+			x.Amounts = []string{}
+		}
+		value := &_ScalarCoin_4_list{list: &x.Amounts}
+		return protoreflect.ValueOfList(value)
+	case 1: // ScalarCoin.denom
+		if fd.FullName() != "ScalarCoin.denom" {
+			break
+		}
+		panic(fmt.Errorf("field denom of message ScalarCoin is not mutable"))
+	case 2: // ScalarCoin.amount
+		if fd != fd_ScalarCoin_amount {
+			break
+		}
+		panic(fmt.Errorf("field amount of message ScalarCoin is not mutable"))
+	case 3: // ScalarCoin.hash
+		if fd.FullName() != "ScalarCoin.hash" {
+			break
+		}
+		panic(fmt.Errorf("field hash of message ScalarCoin is not mutable"))
+	case 5: // ScalarCoin.max
+		if fd.FullName() != "ScalarCoin.max" {
+			break
+		}
+		panic(fmt.Errorf("field max of message ScalarCoin is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_ScalarCoin); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message ScalarCoin does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarCoin does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScalarCoin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // ScalarCoin.denom
+		if fd.FullName() != "ScalarCoin.denom" {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 2: // ScalarCoin.amount
+		if fd != fd_ScalarCoin_amount {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 3: // ScalarCoin.hash
+		if fd.FullName() != "ScalarCoin.hash" {
+			break
+		}
+		return protoreflect.ValueOfBytes(nil)
+	case 4: // ScalarCoin.amounts
+		if fd != fd_ScalarCoin_amounts {
+			break
+		}
+		list := []string{}
+		return protoreflect.ValueOfList(&_ScalarCoin_4_list{list: &list})
+	case 5: // ScalarCoin.max
+		if fd.FullName() != "ScalarCoin.max" {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	}
+	if fd := runtime.FieldOf(fd, md_ScalarCoin); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message ScalarCoin does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarCoin does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScalarCoin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "ScalarCoin._max":
+		if x.Max == nil {
+			return nil
+		}
+		return x.Descriptor().Fields().ByName("max")
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ScalarCoin", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScalarCoin) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScalarCoin) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScalarCoin) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScalarCoin) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_ScalarCoin_methods
+}
+
+var _fastReflection_ScalarCoin_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_ScalarCoin_size,
+	Marshal:           _fastReflection_ScalarCoin_marshal,
+	Unmarshal:         _fastReflection_ScalarCoin_unmarshal,
+	Merge:             _fastReflection_ScalarCoin_merge,
+	CheckInitialized:  _fastReflection_ScalarCoin_checkInitialized,
+	Equal:             _fastReflection_ScalarCoin_equal,
+}
+
+func _fastReflection_ScalarCoin_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*ScalarCoin)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	l = len(x.Denom)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	l = runtime.SizeScalar(&x.Amount)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	l = runtime.SizeScalar(&x.Hash)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.Amounts) > 0 {
+		for _, s := range x.Amounts {
+			l = len(s)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if x.Max != nil {
+		l = len(*x.Max)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_ScalarCoin_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*ScalarCoin)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if x.Max != nil {
+		if !utf8.ValidString(*x.Max) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(*x.Max)
+		copy(dAtA[i:], *x.Max)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(*x.Max)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(x.Amounts) > 0 {
+		for iNdEx := len(x.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			if !utf8.ValidString(x.Amounts[iNdEx]) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(x.Amounts[iNdEx])
+			copy(dAtA[i:], x.Amounts[iNdEx])
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amounts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if encoded, err := runtime.MarshalScalar(fd_ScalarCoin_hash, &x.Hash); err != nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, err
+	} else if len(encoded) > 0 {
+		i -= len(encoded)
+		copy(dAtA[i:], encoded)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if encoded, err := runtime.MarshalScalar(fd_ScalarCoin_amount, &x.Amount); err != nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, err
+	} else if len(encoded) > 0 {
+		if !utf8.Valid(encoded) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(encoded)
+		copy(dAtA[i:], encoded)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(x.Denom) > 0 {
+		if !utf8.ValidString(x.Denom) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.Denom)
+		copy(dAtA[i:], x.Denom)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_ScalarCoin_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*ScalarCoin)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_ScalarCoin.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScalarCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScalarCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_ScalarCoin_denom, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			x.Denom = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_ScalarCoin_amount, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			if err := runtime.ValidateScalar("Int", fd_ScalarCoin_amount, -1, dAtA[iNdEx:postIndex]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if err := runtime.UnmarshalScalar(fd_ScalarCoin_amount, &x.Amount, dAtA[iNdEx:postIndex]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_ScalarCoin_hash, byteLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if err := runtime.ValidateScalar("Hash", fd_ScalarCoin_hash, -1, dAtA[iNdEx:postIndex]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if err := runtime.UnmarshalScalar(fd_ScalarCoin_hash, &x.Hash, dAtA[iNdEx:postIndex]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			if err := budget.List(fd_ScalarCoin_amounts, len(x.Amounts), 1, 16); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_ScalarCoin_amounts, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			if err := runtime.ValidateScalar("Int", fd_ScalarCoin_amounts, len(x.Amounts), dAtA[iNdEx:postIndex]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.Amounts = append(x.Amounts, runtime.String(dAtA[iNdEx:postIndex], zeroCopy))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_ScalarCoin_max, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			if err := runtime.ValidateScalar("Int", fd_ScalarCoin_max, -1, dAtA[iNdEx:postIndex]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			s := runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			x.Max = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_ScalarCoin, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_ScalarCoin_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*ScalarCoin)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*ScalarCoin)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.Denom != "" {
+		dst.Denom = src.Denom
+	}
+	if runtime.HasScalar(&src.Amount) {
+		runtime.CopyScalar(fd_ScalarCoin_amount, &dst.Amount, &src.Amount)
+	}
+	if runtime.HasScalar(&src.Hash) {
+		runtime.CopyScalar(fd_ScalarCoin_hash, &dst.Hash, &src.Hash)
+	}
+	if len(src.Amounts) != 0 {
+		dst.Amounts = append(dst.Amounts, src.Amounts...)
+	}
+	if src.Max != nil {
+		v := *src.Max
+		dst.Max = &v
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_ScalarCoin_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_ScalarCoin_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*ScalarCoin)
+	y, yok := input.MessageB.Interface().(*ScalarCoin)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.Denom != y.Denom {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !runtime.EqualScalar(&x.Amount, &y.Amount) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if !runtime.EqualScalar(&x.Hash, &y.Hash) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.Amounts) != len(y.Amounts) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.Amounts {
+		if v != y.Amounts[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if (x.Max == nil) != (y.Max == nil) || x.Max != nil && *x.Max != *y.Max {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *ScalarCoin) Clone() *ScalarCoin {
+	if x == nil {
+		return nil
+	}
+	dst := new(ScalarCoin)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *ScalarCoin) CopyInto(dst *ScalarCoin) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.Denom = x.Denom
+	runtime.CopyScalar(fd_ScalarCoin_amount, &dst.Amount, &x.Amount)
+	runtime.CopyScalar(fd_ScalarCoin_hash, &dst.Hash, &x.Hash)
+	dst.Amounts = slices.Clone(x.Amounts)
+	if x.Max != nil {
+		v := *x.Max
+		dst.Max = &v
+	} else {
+		dst.Max = nil
+	}
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *ScalarCoin) Equal(y *ScalarCoin) bool {
+	return _fastReflection_ScalarCoin_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a ScalarCoin, or nil if b is canonical.
+func (*ScalarCoin) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_ScalarCoin.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("denom", protoreflect.StringKind, true)
+		case 2:
+			w.Scalar("amount", protoreflect.StringKind, true)
+		case 3:
+			w.Scalar("hash", protoreflect.BytesKind, true)
+		case 4:
+			w.List("amounts", protoreflect.StringKind, false)
+		case 5:
+			w.Scalar("max", protoreflect.StringKind, false)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var _ protoreflect.Map = (*_ScalarInput_3_map)(nil)
+
+type _ScalarInput_3_map struct {
+	m *map[string]string
+}
+
+func (x *_ScalarInput_3_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_ScalarInput_3_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfString(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_ScalarInput_3_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_ScalarInput_3_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_ScalarInput_3_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ScalarInput_3_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_ScalarInput_3_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_ScalarInput_3_map) NewValue() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ScalarInput_3_map) IsValid() bool {
+	return x.m != nil
+}
+
+var (
+	md_ScalarInput         protoreflect.MessageDescriptor
+	fd_ScalarInput_address protoreflect.FieldDescriptor
+	fd_ScalarInput_rate    protoreflect.FieldDescriptor
+	fd_ScalarInput_limits  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_testpb_scalar_proto_init()
+	md_ScalarInput = File_testpb_scalar_proto.Messages().ByName("ScalarInput")
+	fd_ScalarInput_address = md_ScalarInput.Fields().ByName("address")
+	fd_ScalarInput_rate = md_ScalarInput.Fields().ByName("rate")
+	fd_ScalarInput_limits = md_ScalarInput.Fields().ByName("limits")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScalarInput)(nil)
+
+type fastReflection_ScalarInput ScalarInput
+
+func (x *ScalarInput) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScalarInput)(x)
+}
+
+func (x *ScalarInput) slowProtoReflect() protoreflect.Message {
+	mi := &file_testpb_scalar_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScalarInput_messageType fastReflection_ScalarInput_messageType
+var _ protoreflect.MessageType = fastReflection_ScalarInput_messageType{}
+
+type fastReflection_ScalarInput_messageType struct{}
+
+func (x fastReflection_ScalarInput_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScalarInput)(nil)
+}
+func (x fastReflection_ScalarInput_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScalarInput)
+}
+func (x fastReflection_ScalarInput_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScalarInput
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScalarInput) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScalarInput
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScalarInput) Type() protoreflect.MessageType {
+	return _fastReflection_ScalarInput_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScalarInput) New() protoreflect.Message {
+	return new(fastReflection_ScalarInput)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScalarInput) Interface() protoreflect.ProtoMessage {
+	return (*ScalarInput)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScalarInput) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ScalarInput_address, value) {
+			return
+		}
+	}
+	if x.Rate != "" {
+		value := protoreflect.ValueOfString(x.Rate)
+		if !f(fd_ScalarInput_rate, value) {
+			return
+		}
+	}
+	if len(x.Limits) != 0 {
+		value := protoreflect.ValueOfMap(&_ScalarInput_3_map{m: &x.Limits})
+		if !f(fd_ScalarInput_limits, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScalarInput) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // ScalarInput.address
+		if fd != fd_ScalarInput_address {
+			break
+		}
+		return x.Address != ""
+	case 2: // ScalarInput.rate
+		if fd.FullName() != "ScalarInput.rate" {
+			break
+		}
+		return x.Rate != ""
+	case 3: // ScalarInput.limits
+		if fd != fd_ScalarInput_limits {
+			break
+		}
+		return len(x.Limits) != 0
+	}
+	if fd := runtime.FieldOf(fd, md_ScalarInput); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message ScalarInput does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarInput does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScalarInput) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // ScalarInput.address
+		if fd != fd_ScalarInput_address {
+			break
+		}
+		x.Address = ""
+		return
+	case 2: // ScalarInput.rate
+		if fd.FullName() != "ScalarInput.rate" {
+			break
+		}
+		x.Rate = ""
+		return
+	case 3: // ScalarInput.limits
+		if fd != fd_ScalarInput_limits {
+			break
+		}
+		x.Limits = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_ScalarInput); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message ScalarInput does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarInput does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScalarInput) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // ScalarInput.address
+		if descriptor != fd_ScalarInput_address {
+			break
+		}
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case 2: // ScalarInput.rate
+		if descriptor.FullName() != "ScalarInput.rate" {
+			break
+		}
+		value := x.Rate
+		return protoreflect.ValueOfString(value)
+	case 3: // ScalarInput.limits
+		if descriptor != fd_ScalarInput_limits {
+			break
+		}
+		if len(x.Limits) == 0 {
+			return protoreflect.ValueOfMap(&_ScalarInput_3_map{})
+		}
+		mapValue := &_ScalarInput_3_map{m: &x.Limits}
+		return protoreflect.ValueOfMap(mapValue)
+	}
+	if fd := runtime.FieldOf(descriptor, md_ScalarInput); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message ScalarInput does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarInput does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScalarInput) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // ScalarInput.address
+		if fd != fd_ScalarInput_address {
+			break
+		}
+		x.Address = value.Interface().(string)
+		return
+	case 2: // ScalarInput.rate
+		if fd.FullName() != "ScalarInput.rate" {
+			break
+		}
+		x.Rate = value.Interface().(string)
+		return
+	case 3: // ScalarInput.limits
+		if fd != fd_ScalarInput_limits {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_ScalarInput_3_map)
+		x.Limits = *cmv.m
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_ScalarInput); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message ScalarInput does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarInput does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScalarInput) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 3: // ScalarInput.limits
+		if fd != fd_ScalarInput_limits {
+			break
+		}
+		if x.Limits == nil {
+			x.Limits = make(map[string]string)
+		}
+		value := &_ScalarInput_3_map{m: &x.Limits}
+		return protoreflect.ValueOfMap(value)
+	case 1: // ScalarInput.address
+		if fd != fd_ScalarInput_address {
+			break
+		}
+		panic(fmt.Errorf("field address of message ScalarInput is not mutable"))
+	case 2: // ScalarInput.rate
+		if fd.FullName() != "ScalarInput.rate" {
+			break
+		}
+		panic(fmt.Errorf("field rate of message ScalarInput is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_ScalarInput); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message ScalarInput does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarInput does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScalarInput) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // ScalarInput.address
+		if fd != fd_ScalarInput_address {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 2: // ScalarInput.rate
+		if fd.FullName() != "ScalarInput.rate" {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 3: // ScalarInput.limits
+		if fd != fd_ScalarInput_limits {
+			break
+		}
+		m := make(map[string]string)
+		return protoreflect.ValueOfMap(&_ScalarInput_3_map{m: &m})
+	}
+	if fd := runtime.FieldOf(fd, md_ScalarInput); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message ScalarInput does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarInput does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScalarInput) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ScalarInput", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScalarInput) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScalarInput) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScalarInput) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScalarInput) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_ScalarInput_methods
+}
+
+var _fastReflection_ScalarInput_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_ScalarInput_size,
+	Marshal:           _fastReflection_ScalarInput_marshal,
+	Unmarshal:         _fastReflection_ScalarInput_unmarshal,
+	Merge:             _fastReflection_ScalarInput_merge,
+	CheckInitialized:  _fastReflection_ScalarInput_checkInitialized,
+	Equal:             _fastReflection_ScalarInput_equal,
+}
+
+func _fastReflection_ScalarInput_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*ScalarInput)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	l = len(x.Address)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	l = len(x.Rate)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.Limits) > 0 {
+		SiZeMaP := func(k string, v string) {
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+			n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.Limits))
+			for k := range x.Limits {
+				sortme = append(sortme, k)
+			}
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.Limits[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.Limits {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_ScalarInput_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*ScalarInput)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	if len(x.Limits) > 0 {
+		MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
+			baseI := i
+			if !utf8.ValidString(v) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			if !utf8.ValidString(k) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForLimits := make([]string, 0, len(x.Limits))
+			for k := range x.Limits {
+				keysForLimits = append(keysForLimits, string(k))
+			}
+			sort.Slice(keysForLimits, func(i, j int) bool {
+				return keysForLimits[i] < keysForLimits[j]
+			})
+			for iNdEx := len(keysForLimits) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.Limits[string(keysForLimits[iNdEx])]
+				out, err := MaRsHaLmAp(keysForLimits[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.Limits {
+				v := x.Limits[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.Rate) > 0 {
+		if !utf8.ValidString(x.Rate) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.Rate)
+		copy(dAtA[i:], x.Rate)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(x.Address) > 0 {
+		if !utf8.ValidString(x.Address) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.Address)
+		copy(dAtA[i:], x.Address)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+func _fastReflection_ScalarInput_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*ScalarInput)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_ScalarInput.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScalarInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScalarInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_ScalarInput_address, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			if err := runtime.ValidateScalar("AddressString", fd_ScalarInput_address, -1, dAtA[iNdEx:postIndex]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.Address = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_ScalarInput_rate, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			if err := runtime.ValidateScalar("Dec", fd_ScalarInput_rate, -1, dAtA[iNdEx:postIndex]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.Rate = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Limits == nil {
+				x.Limits = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			var mapvalueRaw []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_ScalarInput_limits, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_ScalarInput_limits, intStringLenmapvalue); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapvalue]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapvalueRaw = dAtA[iNdEx:postStringIndexmapvalue]
+					mapvalue = runtime.String(dAtA[iNdEx:postStringIndexmapvalue], zeroCopy)
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if err := runtime.ValidateScalarInMap("Dec", fd_ScalarInput_limits, mapkey, mapvalueRaw); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if budget != nil {
+				if _, ok := x.Limits[mapkey]; !ok {
+					if err := budget.MapEntry(fd_ScalarInput_limits, len(x.Limits), 32); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.Limits[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_ScalarInput, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_ScalarInput_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*ScalarInput)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*ScalarInput)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.Address != "" {
+		dst.Address = src.Address
+	}
+	if src.Rate != "" {
+		dst.Rate = src.Rate
+	}
+	if len(src.Limits) != 0 {
+		if dst.Limits == nil {
+			dst.Limits = make(map[string]string, len(src.Limits))
+		}
+		for k, v := range src.Limits {
+			dst.Limits[k] = v
+		}
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_ScalarInput_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_ScalarInput_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*ScalarInput)
+	y, yok := input.MessageB.Interface().(*ScalarInput)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.Address != y.Address {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.Rate != y.Rate {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.Limits) != len(y.Limits) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.Limits {
+		if w, ok := y.Limits[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *ScalarInput) Clone() *ScalarInput {
+	if x == nil {
+		return nil
+	}
+	dst := new(ScalarInput)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *ScalarInput) CopyInto(dst *ScalarInput) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.Address = x.Address
+	dst.Rate = x.Rate
+	dst.Limits = maps.Clone(x.Limits)
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *ScalarInput) Equal(y *ScalarInput) bool {
+	return _fastReflection_ScalarInput_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a ScalarInput, or nil if b is canonical.
+func (*ScalarInput) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_ScalarInput.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("address", protoreflect.StringKind, true)
+		case 2:
+			w.Scalar("rate", protoreflect.StringKind, true)
+		case 3:
+			w.Map("limits", protoreflect.StringKind, protoreflect.StringKind, nil)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+var _ protoreflect.List = (*_ScalarMsg_2_list)(nil)
+
+type _ScalarMsg_2_list struct {
+	list *[]string
+}
+
+func (x *_ScalarMsg_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ScalarMsg_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ScalarMsg_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ScalarMsg_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ScalarMsg_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ScalarMsg at list field Signers as it is not of Message kind"))
+}
+
+func (x *_ScalarMsg_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ScalarMsg_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ScalarMsg_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ScalarMsg_3_list)(nil)
+
+type _ScalarMsg_3_list struct {
+	list *[]*ScalarInput
+}
+
+func (x *_ScalarMsg_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ScalarMsg_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ScalarMsg_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScalarInput)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ScalarMsg_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScalarInput)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ScalarMsg_3_list) AppendMutable() protoreflect.Value {
+	v := new(ScalarInput)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScalarMsg_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ScalarMsg_3_list) NewElement() protoreflect.Value {
+	v := new(ScalarInput)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScalarMsg_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.Map = (*_ScalarMsg_4_map)(nil)
+
+type _ScalarMsg_4_map struct {
+	m *map[string]*ScalarInput
+}
+
+func (x *_ScalarMsg_4_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_ScalarMsg_4_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_ScalarMsg_4_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_ScalarMsg_4_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_ScalarMsg_4_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScalarMsg_4_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScalarInput)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_ScalarMsg_4_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
+	}
+	newValue := new(ScalarInput)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
+}
+
+func (x *_ScalarMsg_4_map) NewValue() protoreflect.Value {
+	v := new(ScalarInput)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScalarMsg_4_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.Map = (*_ScalarMsg_8_map)(nil)
+
+type _ScalarMsg_8_map struct {
+	m *map[int32]string
+}
+
+func (x *_ScalarMsg_8_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_ScalarMsg_8_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfInt32(k))
+		mapValue := protoreflect.ValueOfString(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_ScalarMsg_8_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.Int()
+	concreteValue := (int32)(keyUnwrapped)
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_ScalarMsg_8_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := (int32)(keyUnwrapped)
+	delete(*x.m, concreteKey)
+}
+
+func (x *_ScalarMsg_8_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := (int32)(keyUnwrapped)
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ScalarMsg_8_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.Int()
+	concreteKey := (int32)(keyUnwrapped)
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_ScalarMsg_8_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_ScalarMsg_8_map) NewValue() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ScalarMsg_8_map) IsValid() bool {
+	return x.m != nil
+}
+
+var (
+	md_ScalarMsg              protoreflect.MessageDescriptor
+	fd_ScalarMsg_from_address protoreflect.FieldDescriptor
+	fd_ScalarMsg_signers      protoreflect.FieldDescriptor
+	fd_ScalarMsg_inputs       protoreflect.FieldDescriptor
+	fd_ScalarMsg_named_inputs protoreflect.FieldDescriptor
+	fd_ScalarMsg_lazy_input   protoreflect.FieldDescriptor
+	fd_ScalarMsg_to_address   protoreflect.FieldDescriptor
+	fd_ScalarMsg_to_input     protoreflect.FieldDescriptor
+	fd_ScalarMsg_recipients   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_testpb_scalar_proto_init()
+	md_ScalarMsg = File_testpb_scalar_proto.Messages().ByName("ScalarMsg")
+	fd_ScalarMsg_from_address = md_ScalarMsg.Fields().ByName("from_address")
+	fd_ScalarMsg_signers = md_ScalarMsg.Fields().ByName("signers")
+	fd_ScalarMsg_inputs = md_ScalarMsg.Fields().ByName("inputs")
+	fd_ScalarMsg_named_inputs = md_ScalarMsg.Fields().ByName("named_inputs")
+	fd_ScalarMsg_lazy_input = md_ScalarMsg.Fields().ByName("lazy_input")
+	fd_ScalarMsg_to_address = md_ScalarMsg.Fields().ByName("to_address")
+	fd_ScalarMsg_to_input = md_ScalarMsg.Fields().ByName("to_input")
+	fd_ScalarMsg_recipients = md_ScalarMsg.Fields().ByName("recipients")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScalarMsg)(nil)
+
+type fastReflection_ScalarMsg ScalarMsg
+
+func (x *ScalarMsg) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScalarMsg)(x)
+}
+
+func (x *ScalarMsg) slowProtoReflect() protoreflect.Message {
+	mi := &file_testpb_scalar_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScalarMsg_messageType fastReflection_ScalarMsg_messageType
+var _ protoreflect.MessageType = fastReflection_ScalarMsg_messageType{}
+
+type fastReflection_ScalarMsg_messageType struct{}
+
+func (x fastReflection_ScalarMsg_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScalarMsg)(nil)
+}
+func (x fastReflection_ScalarMsg_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScalarMsg)
+}
+func (x fastReflection_ScalarMsg_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScalarMsg
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScalarMsg) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScalarMsg
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScalarMsg) Type() protoreflect.MessageType {
+	return _fastReflection_ScalarMsg_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScalarMsg) New() protoreflect.Message {
+	return new(fastReflection_ScalarMsg)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScalarMsg) Interface() protoreflect.ProtoMessage {
+	return (*ScalarMsg)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScalarMsg) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FromAddress != "" {
+		value := protoreflect.ValueOfString(x.FromAddress)
+		if !f(fd_ScalarMsg_from_address, value) {
+			return
+		}
+	}
+	if len(x.Signers) != 0 {
+		value := protoreflect.ValueOfList(&_ScalarMsg_2_list{list: &x.Signers})
+		if !f(fd_ScalarMsg_signers, value) {
+			return
+		}
+	}
+	if len(x.Inputs) != 0 {
+		value := protoreflect.ValueOfList(&_ScalarMsg_3_list{list: &x.Inputs})
+		if !f(fd_ScalarMsg_inputs, value) {
+			return
+		}
+	}
+	if len(x.NamedInputs) != 0 {
+		value := protoreflect.ValueOfMap(&_ScalarMsg_4_map{m: &x.NamedInputs})
+		if !f(fd_ScalarMsg_named_inputs, value) {
+			return
+		}
+	}
+	(*ScalarMsg)(x).lazyDecodeLazyInput()
+	if x.LazyInput != nil {
+		value := protoreflect.ValueOfMessage(x.LazyInput.ProtoReflect())
+		if !f(fd_ScalarMsg_lazy_input, value) {
+			return
+		}
+	}
+	if x.Recipient != nil {
+		switch o := x.Recipient.(type) {
+		case *ScalarMsg_ToAddress:
+			v := o.ToAddress
+			value := protoreflect.ValueOfString(v)
+			if !f(fd_ScalarMsg_to_address, value) {
+				return
+			}
+		case *ScalarMsg_ToInput:
+			v := o.ToInput
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_ScalarMsg_to_input, value) {
+				return
+			}
+		}
+	}
+	if len(x.Recipients) != 0 {
+		value := protoreflect.ValueOfMap(&_ScalarMsg_8_map{m: &x.Recipients})
+		if !f(fd_ScalarMsg_recipients, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScalarMsg) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Number() {
+	case 1: // ScalarMsg.from_address
+		if fd != fd_ScalarMsg_from_address {
+			break
+		}
+		return x.FromAddress != ""
+	case 2: // ScalarMsg.signers
+		if fd != fd_ScalarMsg_signers {
+			break
+		}
+		return len(x.Signers) != 0
+	case 3: // ScalarMsg.inputs
+		if fd.FullName() != "ScalarMsg.inputs" {
+			break
+		}
+		return len(x.Inputs) != 0
+	case 4: // ScalarMsg.named_inputs
+		if fd != fd_ScalarMsg_named_inputs {
+			break
+		}
+		return len(x.NamedInputs) != 0
+	case 5: // ScalarMsg.lazy_input
+		if fd != fd_ScalarMsg_lazy_input {
+			break
+		}
+		if x.lazyFields.Pending(0) {
+			return true
+		}
+		return x.LazyInput != nil
+	case 6: // ScalarMsg.to_address
+		if fd != fd_ScalarMsg_to_address {
+			break
+		}
+		if x.Recipient == nil {
+			return false
+		} else if _, ok := x.Recipient.(*ScalarMsg_ToAddress); ok {
+			return true
+		} else {
+			return false
+		}
+	case 7: // ScalarMsg.to_input
+		if fd != fd_ScalarMsg_to_input {
+			break
+		}
+		if x.Recipient == nil {
+			return false
+		} else if _, ok := x.Recipient.(*ScalarMsg_ToInput); ok {
+			return true
+		} else {
+			return false
+		}
+	case 8: // ScalarMsg.recipients
+		if fd != fd_ScalarMsg_recipients {
+			break
+		}
+		return len(x.Recipients) != 0
+	}
+	if fd := runtime.FieldOf(fd, md_ScalarMsg); fd != nil {
+		return x.Has(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message ScalarMsg does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarMsg does not contain field %s", fd.FullName()))
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScalarMsg) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.Number() {
+	case 1: // ScalarMsg.from_address
+		if fd != fd_ScalarMsg_from_address {
+			break
+		}
+		x.FromAddress = ""
+		return
+	case 2: // ScalarMsg.signers
+		if fd != fd_ScalarMsg_signers {
+			break
+		}
+		x.Signers = nil
+		return
+	case 3: // ScalarMsg.inputs
+		if fd.FullName() != "ScalarMsg.inputs" {
+			break
+		}
+		x.Inputs = nil
+		return
+	case 4: // ScalarMsg.named_inputs
+		if fd != fd_ScalarMsg_named_inputs {
+			break
+		}
+		x.NamedInputs = nil
+		return
+	case 5: // ScalarMsg.lazy_input
+		if fd != fd_ScalarMsg_lazy_input {
+			break
+		}
+		x.lazyFields.Discard(0)
+		x.LazyInput = nil
+		return
+	case 6: // ScalarMsg.to_address
+		if fd != fd_ScalarMsg_to_address {
+			break
+		}
+		x.Recipient = nil
+		return
+	case 7: // ScalarMsg.to_input
+		if fd != fd_ScalarMsg_to_input {
+			break
+		}
+		x.Recipient = nil
+		return
+	case 8: // ScalarMsg.recipients
+		if fd != fd_ScalarMsg_recipients {
+			break
+		}
+		x.Recipients = nil
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_ScalarMsg); fd != nil {
+		x.Clear(fd)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message ScalarMsg does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarMsg does not contain field %s", fd.FullName()))
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScalarMsg) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.Number() {
+	case 1: // ScalarMsg.from_address
+		if descriptor != fd_ScalarMsg_from_address {
+			break
+		}
+		value := x.FromAddress
+		return protoreflect.ValueOfString(value)
+	case 2: // ScalarMsg.signers
+		if descriptor != fd_ScalarMsg_signers {
+			break
+		}
+		if len(x.Signers) == 0 {
+			return protoreflect.ValueOfList(&_ScalarMsg_2_list{})
+		}
+		listValue := &_ScalarMsg_2_list{list: &x.Signers}
+		return protoreflect.ValueOfList(listValue)
+	case 3: // ScalarMsg.inputs
+		if descriptor.FullName() != "ScalarMsg.inputs" {
+			break
+		}
+		if len(x.Inputs) == 0 {
+			return protoreflect.ValueOfList(&_ScalarMsg_3_list{})
+		}
+		listValue := &_ScalarMsg_3_list{list: &x.Inputs}
+		return protoreflect.ValueOfList(listValue)
+	case 4: // ScalarMsg.named_inputs
+		if descriptor != fd_ScalarMsg_named_inputs {
+			break
+		}
+		if len(x.NamedInputs) == 0 {
+			return protoreflect.ValueOfMap(&_ScalarMsg_4_map{})
+		}
+		mapValue := &_ScalarMsg_4_map{m: &x.NamedInputs}
+		return protoreflect.ValueOfMap(mapValue)
+	case 5: // ScalarMsg.lazy_input
+		if descriptor != fd_ScalarMsg_lazy_input {
+			break
+		}
+		(*ScalarMsg)(x).lazyDecodeLazyInput()
+		value := x.LazyInput
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 6: // ScalarMsg.to_address
+		if descriptor != fd_ScalarMsg_to_address {
+			break
+		}
+		if x.Recipient == nil {
+			return protoreflect.ValueOfString("")
+		} else if v, ok := x.Recipient.(*ScalarMsg_ToAddress); ok {
+			return protoreflect.ValueOfString(v.ToAddress)
+		} else {
+			return protoreflect.ValueOfString("")
+		}
+	case 7: // ScalarMsg.to_input
+		if descriptor != fd_ScalarMsg_to_input {
+			break
+		}
+		if x.Recipient == nil {
+			return protoreflect.ValueOfMessage((*ScalarInput)(nil).ProtoReflect())
+		} else if v, ok := x.Recipient.(*ScalarMsg_ToInput); ok {
+			return protoreflect.ValueOfMessage(v.ToInput.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*ScalarInput)(nil).ProtoReflect())
+		}
+	case 8: // ScalarMsg.recipients
+		if descriptor != fd_ScalarMsg_recipients {
+			break
+		}
+		if len(x.Recipients) == 0 {
+			return protoreflect.ValueOfMap(&_ScalarMsg_8_map{})
+		}
+		mapValue := &_ScalarMsg_8_map{m: &x.Recipients}
+		return protoreflect.ValueOfMap(mapValue)
+	}
+	if fd := runtime.FieldOf(descriptor, md_ScalarMsg); fd != nil {
+		return x.Get(fd)
+	}
+	if descriptor.IsExtension() {
+		panic(fmt.Errorf("message ScalarMsg does not extend %s", descriptor.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarMsg does not contain field %s", descriptor.FullName()))
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScalarMsg) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.Number() {
+	case 1: // ScalarMsg.from_address
+		if fd != fd_ScalarMsg_from_address {
+			break
+		}
+		x.FromAddress = value.Interface().(string)
+		return
+	case 2: // ScalarMsg.signers
+		if fd != fd_ScalarMsg_signers {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_ScalarMsg_2_list)
+		x.Signers = *clv.list
+		return
+	case 3: // ScalarMsg.inputs
+		if fd.FullName() != "ScalarMsg.inputs" {
+			break
+		}
+		lv := value.List()
+		clv := lv.(*_ScalarMsg_3_list)
+		x.Inputs = *clv.list
+		return
+	case 4: // ScalarMsg.named_inputs
+		if fd != fd_ScalarMsg_named_inputs {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_ScalarMsg_4_map)
+		x.NamedInputs = *cmv.m
+		return
+	case 5: // ScalarMsg.lazy_input
+		if fd != fd_ScalarMsg_lazy_input {
+			break
+		}
+		x.lazyFields.Discard(0)
+		x.LazyInput = value.Message().Interface().(*ScalarInput)
+		return
+	case 6: // ScalarMsg.to_address
+		if fd != fd_ScalarMsg_to_address {
+			break
+		}
+		cv := value.Interface().(string)
+		x.Recipient = &ScalarMsg_ToAddress{ToAddress: cv}
+		return
+	case 7: // ScalarMsg.to_input
+		if fd != fd_ScalarMsg_to_input {
+			break
+		}
+		cv := value.Message().Interface().(*ScalarInput)
+		x.Recipient = &ScalarMsg_ToInput{ToInput: cv}
+		return
+	case 8: // ScalarMsg.recipients
+		if fd != fd_ScalarMsg_recipients {
+			break
+		}
+		mv := value.Map()
+		cmv := mv.(*_ScalarMsg_8_map)
+		x.Recipients = *cmv.m
+		return
+	}
+	if fd := runtime.FieldOf(fd, md_ScalarMsg); fd != nil {
+		x.Set(fd, value)
+		return
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message ScalarMsg does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarMsg does not contain field %s", fd.FullName()))
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScalarMsg) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 2: // ScalarMsg.signers
+		if fd != fd_ScalarMsg_signers {
+			break
+		}
+		if x.Signers == nil {
+			x.Signers = []string{}
+		}
+		value := &_ScalarMsg_2_list{list: &x.Signers}
+		return protoreflect.ValueOfList(value)
+	case 3: // ScalarMsg.inputs
+		if fd.FullName() != "ScalarMsg.inputs" {
+			break
+		}
+		if x.Inputs == nil {
+			x.Inputs = []*ScalarInput{}
+		}
+		value := &_ScalarMsg_3_list{list: &x.Inputs}
+		return protoreflect.ValueOfList(value)
+	case 4: // ScalarMsg.named_inputs
+		if fd != fd_ScalarMsg_named_inputs {
+			break
+		}
+		if x.NamedInputs == nil {
+			x.NamedInputs = make(map[string]*ScalarInput)
+		}
+		value := &_ScalarMsg_4_map{m: &x.NamedInputs}
+		return protoreflect.ValueOfMap(value)
+	case 5: // ScalarMsg.lazy_input
+		if fd != fd_ScalarMsg_lazy_input {
+			break
+		}
+		(*ScalarMsg)(x).lazyDecodeLazyInput()
+		x.lazyFields.Discard(0)
+		if x.LazyInput == nil {
+			x.LazyInput = new(ScalarInput)
+		}
+		return protoreflect.ValueOfMessage(x.LazyInput.ProtoReflect())
+	case 7: // ScalarMsg.to_input
+		if fd != fd_ScalarMsg_to_input {
+			break
+		}
+		if x.Recipient == nil {
+			value := &ScalarInput{}
+			oneofValue := &ScalarMsg_ToInput{ToInput: value}
+			x.Recipient = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Recipient.(type) {
+		case *ScalarMsg_ToInput:
+			return protoreflect.ValueOfMessage(m.ToInput.ProtoReflect())
+		default:
+			value := &ScalarInput{}
+			oneofValue := &ScalarMsg_ToInput{ToInput: value}
+			x.Recipient = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case 8: // ScalarMsg.recipients
+		if fd != fd_ScalarMsg_recipients {
+			break
+		}
+		if x.Recipients == nil {
+			x.Recipients = make(map[int32]string)
+		}
+		value := &_ScalarMsg_8_map{m: &x.Recipients}
+		return protoreflect.ValueOfMap(value)
+	case 1: // ScalarMsg.from_address
+		if fd != fd_ScalarMsg_from_address {
+			break
+		}
+		panic(fmt.Errorf("field from_address of message ScalarMsg is not mutable"))
+	case 6: // ScalarMsg.to_address
+		if fd != fd_ScalarMsg_to_address {
+			break
+		}
+		panic(fmt.Errorf("field to_address of message ScalarMsg is not mutable"))
+	}
+	if fd := runtime.FieldOf(fd, md_ScalarMsg); fd != nil {
+		return x.Mutable(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message ScalarMsg does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarMsg does not contain field %s", fd.FullName()))
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScalarMsg) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Number() {
+	case 1: // ScalarMsg.from_address
+		if fd != fd_ScalarMsg_from_address {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 2: // ScalarMsg.signers
+		if fd != fd_ScalarMsg_signers {
+			break
+		}
+		list := []string{}
+		return protoreflect.ValueOfList(&_ScalarMsg_2_list{list: &list})
+	case 3: // ScalarMsg.inputs
+		if fd.FullName() != "ScalarMsg.inputs" {
+			break
+		}
+		list := []*ScalarInput{}
+		return protoreflect.ValueOfList(&_ScalarMsg_3_list{list: &list})
+	case 4: // ScalarMsg.named_inputs
+		if fd != fd_ScalarMsg_named_inputs {
+			break
+		}
+		m := make(map[string]*ScalarInput)
+		return protoreflect.ValueOfMap(&_ScalarMsg_4_map{m: &m})
+	case 5: // ScalarMsg.lazy_input
+		if fd != fd_ScalarMsg_lazy_input {
+			break
+		}
+		m := new(ScalarInput)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case 6: // ScalarMsg.to_address
+		if fd != fd_ScalarMsg_to_address {
+			break
+		}
+		return protoreflect.ValueOfString("")
+	case 7: // ScalarMsg.to_input
+		if fd != fd_ScalarMsg_to_input {
+			break
+		}
+		value := &ScalarInput{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case 8: // ScalarMsg.recipients
+		if fd != fd_ScalarMsg_recipients {
+			break
+		}
+		m := make(map[int32]string)
+		return protoreflect.ValueOfMap(&_ScalarMsg_8_map{m: &m})
+	}
+	if fd := runtime.FieldOf(fd, md_ScalarMsg); fd != nil {
+		return x.NewField(fd)
+	}
+	if fd.IsExtension() {
+		panic(fmt.Errorf("message ScalarMsg does not extend %s", fd.FullName()))
+	}
+	panic(fmt.Errorf("message ScalarMsg does not contain field %s", fd.FullName()))
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScalarMsg) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "ScalarMsg.recipient":
+		if x.Recipient == nil {
+			return nil
+		}
+		switch x.Recipient.(type) {
+		case *ScalarMsg_ToAddress:
+			return x.Descriptor().Fields().ByName("to_address")
+		case *ScalarMsg_ToInput:
+			return x.Descriptor().Fields().ByName("to_input")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ScalarMsg", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScalarMsg) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScalarMsg) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScalarMsg) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScalarMsg) ProtoMethods() *protoiface.Methods {
+	return _fastReflection_ScalarMsg_methods
+}
+
+var _fastReflection_ScalarMsg_methods = &protoiface.Methods{
+	NoUnkeyedLiterals: struct{}{},
+	Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+	Size:              _fastReflection_ScalarMsg_size,
+	Marshal:           _fastReflection_ScalarMsg_marshal,
+	Unmarshal:         _fastReflection_ScalarMsg_unmarshal,
+	Merge:             _fastReflection_ScalarMsg_merge,
+	CheckInitialized:  _fastReflection_ScalarMsg_checkInitialized,
+	Equal:             _fastReflection_ScalarMsg_equal,
+}
+
+func _fastReflection_ScalarMsg_size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x := input.Message.Interface().(*ScalarMsg)
+	if x == nil {
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              0,
+		}
+	}
+	if input.Flags&protoiface.MarshalUseCachedSize != 0 {
+		if size := atomic.LoadInt32(&x.sizeCache); size > 0 {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              int(size - 1),
+			}
+		}
+	}
+	options := runtime.SizeInputToOptions(input)
+	_ = options
+	var n int
+	var l int
+	_ = l
+	l = len(x.FromAddress)
+	if l > 0 {
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.Signers) > 0 {
+		for _, s := range x.Signers {
+			l = len(s)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if len(x.Inputs) > 0 {
+		for _, e := range x.Inputs {
+			l = options.Size(e)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	if len(x.NamedInputs) > 0 {
+		SiZeMaP := func(k string, v *ScalarInput) {
+			l := 0
+			if v != nil {
+				l = options.Size(v)
+			}
+			l += 1 + runtime.Sov(uint64(l))
+			mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
+			n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]string, 0, len(x.NamedInputs))
+			for k := range x.NamedInputs {
+				sortme = append(sortme, k)
+			}
+			sort.Strings(sortme)
+			for _, k := range sortme {
+				v := x.NamedInputs[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.NamedInputs {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if raw := runtime.RawLazy(&x.lazyFields, 0, &x.LazyInput); raw != nil {
+		n += len(raw)
+	} else {
+		if x.LazyInput != nil {
+			l = options.Size(x.LazyInput)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+	}
+	switch x := x.Recipient.(type) {
+	case *ScalarMsg_ToAddress:
+		if x == nil {
+			break
+		}
+		l = len(x.ToAddress)
+		n += 1 + l + runtime.Sov(uint64(l))
+	case *ScalarMsg_ToInput:
+		if x == nil {
+			break
+		}
+		l = options.Size(x.ToInput)
+		n += 1 + l + runtime.Sov(uint64(l))
+	}
+	if len(x.Recipients) > 0 {
+		SiZeMaP := func(k int32, v string) {
+			mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+			n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+		}
+		if options.Deterministic {
+			sortme := make([]int32, 0, len(x.Recipients))
+			for k := range x.Recipients {
+				sortme = append(sortme, k)
+			}
+			sort.Slice(sortme, func(i, j int) bool {
+				return sortme[i] < sortme[j]
+			})
+			for _, k := range sortme {
+				v := x.Recipients[k]
+				SiZeMaP(k, v)
+			}
+		} else {
+			for k, v := range x.Recipients {
+				SiZeMaP(k, v)
+			}
+		}
+	}
+	if x.unknownFields != nil {
+		n += len(x.unknownFields)
+	}
+	if n > math.MaxInt32-1 {
+		atomic.StoreInt32(&x.sizeCache, 0)
+	} else {
+		atomic.StoreInt32(&x.sizeCache, int32(n+1))
+	}
+	return protoiface.SizeOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Size:              n,
+	}
+}
+
+func _fastReflection_ScalarMsg_marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x := input.Message.Interface().(*ScalarMsg)
+	if x == nil {
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	x.lazyFields.StartMarshal()
+	defer x.lazyFields.EndMarshal()
+	options := runtime.MarshalInputToOptions(input)
+	_ = options
+	size := options.Size(x)
+	buf := append(input.Buf, make([]byte, size)...)
+	dAtA := buf[len(input.Buf):]
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if x.unknownFields != nil {
+		i -= len(x.unknownFields)
+		copy(dAtA[i:], x.unknownFields)
+	}
+	switch x := x.Recipient.(type) {
+	case *ScalarMsg_ToAddress:
+		if !utf8.ValidString(x.ToAddress) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.ToAddress)
+		copy(dAtA[i:], x.ToAddress)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAddress)))
+		i--
+		dAtA[i] = 0x32
+	case *ScalarMsg_ToInput:
+		l = options.Size(x.ToInput)
+		i -= l
+		if encoded, err := options.MarshalAppend(dAtA[:i], x.ToInput); err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		} else if len(encoded) != i+l {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrSizeMismatch
+		}
+		i = runtime.EncodeVarint(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(x.Recipients) > 0 {
+		MaRsHaLmAp := func(k int32, v string) (protoiface.MarshalOutput, error) {
+			baseI := i
+			if !utf8.ValidString(v) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = runtime.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForRecipients := make([]int32, 0, len(x.Recipients))
+			for k := range x.Recipients {
+				keysForRecipients = append(keysForRecipients, int32(k))
+			}
+			sort.Slice(keysForRecipients, func(i, j int) bool {
+				return keysForRecipients[i] < keysForRecipients[j]
+			})
+			for iNdEx := len(keysForRecipients) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.Recipients[int32(keysForRecipients[iNdEx])]
+				out, err := MaRsHaLmAp(keysForRecipients[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.Recipients {
+				v := x.Recipients[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if raw := runtime.RawLazy(&x.lazyFields, 0, &x.LazyInput); raw != nil {
+		i -= len(raw)
+		copy(dAtA[i:], raw)
+	} else {
+		if x.LazyInput != nil {
+			l = options.Size(x.LazyInput)
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.LazyInput); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(x.NamedInputs) > 0 {
+		MaRsHaLmAp := func(k string, v *ScalarInput) (protoiface.MarshalOutput, error) {
+			baseI := i
+			l = options.Size(v)
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], v); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x12
+			if !utf8.ValidString(k) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+			return protoiface.MarshalOutput{}, nil
+		}
+		if options.Deterministic {
+			keysForNamedInputs := make([]string, 0, len(x.NamedInputs))
+			for k := range x.NamedInputs {
+				keysForNamedInputs = append(keysForNamedInputs, string(k))
+			}
+			sort.Slice(keysForNamedInputs, func(i, j int) bool {
+				return keysForNamedInputs[i] < keysForNamedInputs[j]
+			})
+			for iNdEx := len(keysForNamedInputs) - 1; iNdEx >= 0; iNdEx-- {
+				v := x.NamedInputs[string(keysForNamedInputs[iNdEx])]
+				out, err := MaRsHaLmAp(keysForNamedInputs[iNdEx], v)
+				if err != nil {
+					return out, err
+				}
+			}
+		} else {
+			for k := range x.NamedInputs {
+				v := x.NamedInputs[k]
+				out, err := MaRsHaLmAp(k, v)
+				if err != nil {
+					return out, err
+				}
+			}
+		}
+	}
+	if len(x.Inputs) > 0 {
+		for iNdEx := len(x.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			l = options.Size(x.Inputs[iNdEx])
+			i -= l
+			if encoded, err := options.MarshalAppend(dAtA[:i], x.Inputs[iNdEx]); err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			} else if len(encoded) != i+l {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrSizeMismatch
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(l))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(x.Signers) > 0 {
+		for iNdEx := len(x.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			if !utf8.ValidString(x.Signers[iNdEx]) {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, runtime.ErrInvalidUTF8
+			}
+			i -= len(x.Signers[iNdEx])
+			copy(dAtA[i:], x.Signers[iNdEx])
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(x.FromAddress) > 0 {
+		if !utf8.ValidString(x.FromAddress) {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, runtime.ErrInvalidUTF8
+		}
+		i -= len(x.FromAddress)
+		copy(dAtA[i:], x.FromAddress)
+		i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return protoiface.MarshalOutput{
+		NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		Buf:               buf,
+	}, nil
+}
+
+var _ScalarMsg_LazyInput_scalars = map[protoreflect.FullName]string{
+	"ScalarInput.address": "AddressString",
+	"ScalarInput.limits":  "Dec",
+	"ScalarInput.rate":    "Dec",
+}
+
+func _fastReflection_ScalarMsg_unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	x := input.Message.Interface().(*ScalarMsg)
+	if x == nil {
+		return protoiface.UnmarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Flags:             input.Flags,
+		}, nil
+	}
+	if input.Depth < 0 {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrRecursionLimit(md_ScalarMsg.FullName())
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	_ = options
+	dAtA := input.Buf
+	zeroCopy := runtime.IsZeroCopy(options)
+	options, budget := runtime.StartDecodeBudget(options)
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScalarMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScalarMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_ScalarMsg_from_address, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			if err := runtime.ValidateScalar("AddressString", fd_ScalarMsg_from_address, -1, dAtA[iNdEx:postIndex]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.FromAddress = runtime.String(dAtA[iNdEx:postIndex], zeroCopy)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			if err := budget.List(fd_ScalarMsg_signers, len(x.Signers), 1, 16); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_ScalarMsg_signers, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			if err := runtime.ValidateScalar("AddressString", fd_ScalarMsg_signers, len(x.Signers), dAtA[iNdEx:postIndex]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.Signers = append(x.Signers, runtime.String(dAtA[iNdEx:postIndex], zeroCopy))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			if err := budget.List(fd_ScalarMsg_inputs, len(x.Inputs), 1, 8); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Message(fd_ScalarMsg_inputs, int(unsafe.Sizeof(ScalarInput{}))); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.Inputs = append(x.Inputs, &ScalarInput{})
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Inputs[len(x.Inputs)-1]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_ScalarMsg_inputs, len(x.Inputs)-1)
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NamedInputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.NamedInputs == nil {
+				x.NamedInputs = make(map[string]*ScalarInput)
+			}
+			var mapkey string
+			var mapvalue *ScalarInput
+			var mapvalueErr error
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_ScalarMsg_named_inputs, intStringLenmapkey); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapkey = runtime.String(dAtA[iNdEx:postStringIndexmapkey], zeroCopy)
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Message(fd_ScalarMsg_named_inputs, int(unsafe.Sizeof(ScalarInput{}))); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					mapvalue = &ScalarInput{}
+					if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+						mapvalueErr = err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if mapvalueErr != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarInMap(mapvalueErr, fd_ScalarMsg_named_inputs, mapkey)
+			}
+			if budget != nil {
+				if _, ok := x.NamedInputs[mapkey]; !ok {
+					if err := budget.MapEntry(fd_ScalarMsg_named_inputs, len(x.NamedInputs), 24); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.NamedInputs[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LazyInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.LazyInput == nil && x.lazyFields.Append(0, 1, dAtA[preIndex:postIndex], fd_ScalarMsg_lazy_input.Message(), _ScalarMsg_LazyInput_scalars, options) {
+				iNdEx = postIndex
+				break
+			}
+			x.lazyDecodeLazyInput()
+			x.lazyFields.Discard(0)
+			if x.LazyInput == nil {
+				if err := budget.Message(fd_ScalarMsg_lazy_input, int(unsafe.Sizeof(ScalarInput{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.LazyInput = &ScalarInput{}
+			}
+			if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LazyInput); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_ScalarMsg_lazy_input, -1)
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if err := budget.Length(fd_ScalarMsg_to_address, intStringLen); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+			}
+			if err := runtime.ValidateScalar("AddressString", fd_ScalarMsg_to_address, -1, dAtA[iNdEx:postIndex]); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			x.Recipient = &ScalarMsg_ToAddress{runtime.String(dAtA[iNdEx:postIndex], zeroCopy)}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if oneof, ok := x.Recipient.(*ScalarMsg_ToInput); ok && oneof.ToInput != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], oneof.ToInput); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_ScalarMsg_to_input, -1)
+				}
+			} else {
+				if err := budget.Message(fd_ScalarMsg_to_input, int(unsafe.Sizeof(ScalarInput{}))); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				v := &ScalarInput{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.InvalidScalarIn(err, fd_ScalarMsg_to_input, -1)
+				}
+				x.Recipient = &ScalarMsg_ToInput{v}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if postIndex > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if x.Recipients == nil {
+				x.Recipients = make(map[int32]string)
+			}
+			var mapkey int32
+			var mapvalue string
+			var mapvalueRaw []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if err := budget.Length(fd_ScalarMsg_recipients, intStringLenmapvalue); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapvalue]) {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidUTF8
+					}
+					mapvalueRaw = dAtA[iNdEx:postStringIndexmapvalue]
+					mapvalue = runtime.String(dAtA[iNdEx:postStringIndexmapvalue], zeroCopy)
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			if err := runtime.ValidateScalarInMap("AddressString", fd_ScalarMsg_recipients, mapkey, mapvalueRaw); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if budget != nil {
+				if _, ok := x.Recipients[mapkey]; !ok {
+					if err := budget.MapEntry(fd_ScalarMsg_recipients, len(x.Recipients), 20); err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+				}
+			}
+			x.Recipients[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			if err := runtime.CheckUnknownField(options, md_ScalarMsg, protoreflect.FieldNumber(fieldNum)); err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			skippy, err := runtime.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			}
+			if !options.DiscardUnknown {
+				x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+	}
+	return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.UnmarshalInitialized}, nil
+}
+
+func _fastReflection_ScalarMsg_merge(input protoiface.MergeInput) protoiface.MergeOutput {
+	dst, ok := input.Destination.Interface().(*ScalarMsg)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	src, ok := input.Source.Interface().(*ScalarMsg)
+	if !ok {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if src == nil {
+		return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+	}
+	if src.FromAddress != "" {
+		dst.FromAddress = src.FromAddress
+	}
+	if len(src.Signers) != 0 {
+		dst.Signers = append(dst.Signers, src.Signers...)
+	}
+	for _, v := range src.Inputs {
+		m := &ScalarInput{}
+		proto.Merge(m, v)
+		dst.Inputs = append(dst.Inputs, m)
+	}
+	if len(src.NamedInputs) != 0 {
+		if dst.NamedInputs == nil {
+			dst.NamedInputs = make(map[string]*ScalarInput, len(src.NamedInputs))
+		}
+		for k, v := range src.NamedInputs {
+			m := &ScalarInput{}
+			proto.Merge(m, v)
+			dst.NamedInputs[k] = m
+		}
+	}
+	src.lazyDecodeLazyInput()
+	dst.lazyDecodeLazyInput()
+	dst.lazyFields.Discard(0)
+	if src.LazyInput != nil {
+		if dst.LazyInput == nil {
+			dst.LazyInput = &ScalarInput{}
+		}
+		proto.Merge(dst.LazyInput, src.LazyInput)
+	}
+	switch v := src.Recipient.(type) {
+	case *ScalarMsg_ToAddress:
+		dst.Recipient = &ScalarMsg_ToAddress{ToAddress: v.ToAddress}
+	case *ScalarMsg_ToInput:
+		if dv, ok := dst.Recipient.(*ScalarMsg_ToInput); ok && dv.ToInput != nil {
+			proto.Merge(dv.ToInput, v.ToInput)
+		} else {
+			m := &ScalarInput{}
+			proto.Merge(m, v.ToInput)
+			dst.Recipient = &ScalarMsg_ToInput{ToInput: m}
+		}
+	}
+	if len(src.Recipients) != 0 {
+		if dst.Recipients == nil {
+			dst.Recipients = make(map[int32]string, len(src.Recipients))
+		}
+		for k, v := range src.Recipients {
+			dst.Recipients[k] = v
+		}
+	}
+	if len(src.unknownFields) > 0 {
+		dst.unknownFields = append(dst.unknownFields, src.unknownFields...)
+	}
+	return protoiface.MergeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: protoiface.MergeComplete}
+}
+
+func _fastReflection_ScalarMsg_checkInitialized(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+}
+
+func _fastReflection_ScalarMsg_equal(input protoiface.EqualInput) protoiface.EqualOutput {
+	x, xok := input.MessageA.Interface().(*ScalarMsg)
+	y, yok := input.MessageB.Interface().(*ScalarMsg)
+	if !xok || !yok {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: protoreflect.ValueOfMessage(input.MessageA).Equal(protoreflect.ValueOfMessage(input.MessageB))}
+	}
+	if x == y {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: true}
+	}
+	if x == nil || y == nil {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if x.FromAddress != y.FromAddress {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	if len(x.Signers) != len(y.Signers) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.Signers {
+		if v != y.Signers[i] {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.Inputs) != len(y.Inputs) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for i, v := range x.Inputs {
+		if !v.Equal(y.Inputs[i]) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.NamedInputs) != len(y.NamedInputs) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.NamedInputs {
+		if w, ok := y.NamedInputs[k]; !ok || !v.Equal(w) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	x.lazyDecodeLazyInput()
+	y.lazyDecodeLazyInput()
+	if !x.LazyInput.Equal(y.LazyInput) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	switch v := x.Recipient.(type) {
+	case nil:
+		if y.Recipient != nil {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *ScalarMsg_ToAddress:
+		if w, ok := y.Recipient.(*ScalarMsg_ToAddress); !ok || v.ToAddress != w.ToAddress {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	case *ScalarMsg_ToInput:
+		if w, ok := y.Recipient.(*ScalarMsg_ToInput); !ok || !v.ToInput.Equal(w.ToInput) {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	if len(x.Recipients) != len(y.Recipients) {
+		return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	for k, v := range x.Recipients {
+		if w, ok := y.Recipients[k]; !ok || v != w {
+			return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		}
+	}
+	return protoiface.EqualOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Equal: runtime.EqualUnknown(x.unknownFields, y.unknownFields)}
+}
+
+// Clone returns a deep copy of the message.
+func (x *ScalarMsg) Clone() *ScalarMsg {
+	if x == nil {
+		return nil
+	}
+	dst := new(ScalarMsg)
+	x.CopyInto(dst)
+	return dst
+}
+
+// CopyInto overwrites dst with a deep copy of the message,
+// dst does not share any memory with the message afterwards.
+func (x *ScalarMsg) CopyInto(dst *ScalarMsg) {
+	if x == dst {
+		return
+	}
+	if x == nil {
+		dst.Reset()
+		return
+	}
+	dst.FromAddress = x.FromAddress
+	dst.Signers = slices.Clone(x.Signers)
+	if x.Inputs != nil {
+		dst.Inputs = make([]*ScalarInput, len(x.Inputs))
+		for i, v := range x.Inputs {
+			dst.Inputs[i] = v.Clone()
+		}
+	} else {
+		dst.Inputs = nil
+	}
+	if x.NamedInputs != nil {
+		dst.NamedInputs = make(map[string]*ScalarInput, len(x.NamedInputs))
+		for k, v := range x.NamedInputs {
+			dst.NamedInputs[k] = v.Clone()
+		}
+	} else {
+		dst.NamedInputs = nil
+	}
+	x.lazyDecodeLazyInput()
+	dst.lazyFields.Discard(0)
+	dst.LazyInput = x.LazyInput.Clone()
+	switch v := x.Recipient.(type) {
+	case nil:
+		dst.Recipient = nil
+	case *ScalarMsg_ToAddress:
+		dst.Recipient = &ScalarMsg_ToAddress{ToAddress: v.ToAddress}
+	case *ScalarMsg_ToInput:
+		dst.Recipient = &ScalarMsg_ToInput{ToInput: v.ToInput.Clone()}
+	}
+	dst.Recipients = maps.Clone(x.Recipients)
+	dst.unknownFields = bytes.Clone(x.unknownFields)
+	dst.sizeCache = 0
+}
+
+// Equal reports whether the message is equal to y, following the semantics of proto.Equal.
+func (x *ScalarMsg) Equal(y *ScalarMsg) bool {
+	return _fastReflection_ScalarMsg_equal(protoiface.EqualInput{MessageA: x.ProtoReflect(), MessageB: y.ProtoReflect()}).Equal
+}
+
+// ValidateCanonical returns a *runtime.CanonicalError locating the first difference
+// between b and the canonical encoding of a ScalarMsg, or nil if b is canonical.
+func (*ScalarMsg) ValidateCanonical(b []byte) error {
+	w := runtime.NewCanonicalWalker(b, md_ScalarMsg.FullName())
+	for w.Next() {
+		switch w.Number() {
+		case 1:
+			w.Scalar("from_address", protoreflect.StringKind, true)
+		case 2:
+			w.List("signers", protoreflect.StringKind, false)
+		case 3:
+			w.Message("inputs", true, (*ScalarInput)(nil))
+		case 4:
+			w.Map("named_inputs", protoreflect.StringKind, protoreflect.MessageKind, (*ScalarInput)(nil))
+		case 5:
+			w.Message("lazy_input", false, (*ScalarInput)(nil))
+		case 6:
+			w.Oneof(0)
+			w.Scalar("to_address", protoreflect.StringKind, false)
+		case 7:
+			w.Oneof(0)
+			w.Message("to_input", false, (*ScalarInput)(nil))
+		case 8:
+			w.Map("recipients", protoreflect.Int32Kind, protoreflect.StringKind, nil)
+		default:
+			w.Unknown()
+		}
+	}
+	return w.Err()
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *ScalarCoin) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *ScalarCoin) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Denom != "" {
+		b = append(b, "\"denom\":"...)
+		if b, err = runtime.AppendJSONString(b, x.Denom, "ScalarCoin.denom"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, err := runtime.MarshalScalar(fd_ScalarCoin_amount, &x.Amount); err != nil {
+		return b, err
+	} else if len(v) > 0 {
+		b = append(b, "\"amount\":"...)
+		if b, err = runtime.AppendJSONString(b, string(v), "ScalarCoin.amount"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, err := runtime.MarshalScalar(fd_ScalarCoin_hash, &x.Hash); err != nil {
+		return b, err
+	} else if len(v) > 0 {
+		b = append(b, "\"hash\":"...)
+		b = runtime.AppendJSONBytes(b, v)
+		b = append(b, ',')
+	}
+	if len(x.Amounts) > 0 {
+		b = append(b, "\"amounts\":"...)
+		b = append(b, '[')
+		for _, v := range x.Amounts {
+			if b, err = runtime.AppendJSONString(b, v, "ScalarCoin.amounts"); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if x.Max != nil {
+		v := *x.Max
+		b = append(b, "\"max\":"...)
+		if b, err = runtime.AppendJSONString(b, v, "ScalarCoin.max"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *ScalarCoin) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *ScalarCoin) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.Denom = v
+		case 2:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			return runtime.UnmarshalScalar(fd, &x.Amount, []byte(v))
+		case 3:
+			v, err := d.Bytes(fd)
+			if err != nil {
+				return err
+			}
+			return runtime.UnmarshalScalar(fd, &x.Hash, v)
+		case 4:
+			return d.List(func() error {
+				v, err := d.String(fd)
+				if err != nil {
+					return err
+				}
+				x.Amounts = append(x.Amounts, v)
+				return nil
+			})
+		case 5:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.Max = &v
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *ScalarInput) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *ScalarInput) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.Address != "" {
+		b = append(b, "\"address\":"...)
+		if b, err = runtime.AppendJSONString(b, x.Address, "ScalarInput.address"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if x.Rate != "" {
+		b = append(b, "\"rate\":"...)
+		if b, err = runtime.AppendJSONString(b, x.Rate, "ScalarInput.rate"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.Limits) > 0 {
+		b = append(b, "\"limits\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.Limits)) {
+			v := x.Limits[k]
+			if b, err = runtime.AppendJSONString(b, k, "ScalarInput.LimitsEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			if b, err = runtime.AppendJSONString(b, v, "ScalarInput.LimitsEntry.value"); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *ScalarInput) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *ScalarInput) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.Address = v
+		case 2:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.Rate = v
+		case 3:
+			if x.Limits == nil {
+				x.Limits = make(map[string]string)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.Limits[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.String(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.Limits[key] = v
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+// MarshalJSON returns the JSON encoding of x, which is the encoding of protojson.
+func (x *ScalarMsg) MarshalJSON() ([]byte, error) {
+	return runtime.MarshalJSON(x)
+}
+
+// AppendJSON appends the JSON encoding of x to b. Required fields are not checked.
+func (x *ScalarMsg) AppendJSON(b []byte) ([]byte, error) {
+	if x == nil {
+		return append(b, "{}"...), nil
+	}
+	var err error
+	b = append(b, '{')
+	if x.FromAddress != "" {
+		b = append(b, "\"fromAddress\":"...)
+		if b, err = runtime.AppendJSONString(b, x.FromAddress, "ScalarMsg.from_address"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.Signers) > 0 {
+		b = append(b, "\"signers\":"...)
+		b = append(b, '[')
+		for _, v := range x.Signers {
+			if b, err = runtime.AppendJSONString(b, v, "ScalarMsg.signers"); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.Inputs) > 0 {
+		b = append(b, "\"inputs\":"...)
+		b = append(b, '[')
+		for _, v := range x.Inputs {
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = ']'
+		b = append(b, ',')
+	}
+	if len(x.NamedInputs) > 0 {
+		b = append(b, "\"namedInputs\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.NamedInputs)) {
+			v := x.NamedInputs[k]
+			if b, err = runtime.AppendJSONString(b, k, "ScalarMsg.NamedInputsEntry.key"); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			if b, err = v.AppendJSON(b); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	x.lazyDecodeLazyInput()
+	if x.LazyInput != nil {
+		b = append(b, "\"lazyInput\":"...)
+		if b, err = x.LazyInput.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.Recipient.(*ScalarMsg_ToAddress); ok {
+		b = append(b, "\"toAddress\":"...)
+		if b, err = runtime.AppendJSONString(b, v.ToAddress, "ScalarMsg.to_address"); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if v, ok := x.Recipient.(*ScalarMsg_ToInput); ok {
+		b = append(b, "\"toInput\":"...)
+		if b, err = v.ToInput.AppendJSON(b); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+	}
+	if len(x.Recipients) > 0 {
+		b = append(b, "\"recipients\":"...)
+		b = append(b, '{')
+		for _, k := range slices.Sorted(maps.Keys(x.Recipients)) {
+			v := x.Recipients[k]
+			b = runtime.AppendJSONInt64(b, int64(k))
+			b = append(b, ':')
+			if b, err = runtime.AppendJSONString(b, v, "ScalarMsg.RecipientsEntry.value"); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+		}
+		b[len(b)-1] = '}'
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+// UnmarshalJSON resets x and decodes the JSON encoding b into it, like protojson does.
+func (x *ScalarMsg) UnmarshalJSON(b []byte) error {
+	return runtime.UnmarshalJSON(b, x, protojson.UnmarshalOptions{})
+}
+
+// DecodeJSON decodes the next value of d into x. It is called by the runtime, which
+// handles the well-known types and the nesting limit of the decoded messages.
+func (x *ScalarMsg) DecodeJSON(d *runtime.JSONDecoder) error {
+	return d.Object(x.ProtoReflect(), func(fd protoreflect.FieldDescriptor) error {
+		switch fd.Number() {
+		case 1:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.FromAddress = v
+		case 2:
+			return d.List(func() error {
+				v, err := d.String(fd)
+				if err != nil {
+					return err
+				}
+				x.Signers = append(x.Signers, v)
+				return nil
+			})
+		case 3:
+			return d.List(func() error {
+				v := new(ScalarInput)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.Inputs = append(x.Inputs, v)
+				return nil
+			})
+		case 4:
+			if x.NamedInputs == nil {
+				x.NamedInputs = make(map[string]*ScalarInput)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := k.String()
+				if _, ok := x.NamedInputs[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v := new(ScalarInput)
+				if err := d.Message(v); err != nil {
+					return err
+				}
+				x.NamedInputs[key] = v
+				return nil
+			})
+		case 5:
+			v := new(ScalarInput)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.LazyInput = v
+		case 6:
+			v, err := d.String(fd)
+			if err != nil {
+				return err
+			}
+			x.Recipient = &ScalarMsg_ToAddress{ToAddress: v}
+		case 7:
+			v := new(ScalarInput)
+			if err := d.Message(v); err != nil {
+				return err
+			}
+			x.Recipient = &ScalarMsg_ToInput{ToInput: v}
+		case 8:
+			if x.Recipients == nil {
+				x.Recipients = make(map[int32]string)
+			}
+			return d.Map(fd, func(k protoreflect.MapKey) error {
+				key := int32(k.Int())
+				if _, ok := x.Recipients[key]; ok {
+					return d.DuplicateMapKey()
+				}
+				v, err := d.String(fd.MapValue())
+				if err != nil {
+					return err
+				}
+				x.Recipients[key] = v
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.18.1
+// source: testpb/scalar.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ScalarCoin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom  string           `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount testscalars.Int  `json:"amount,omitempty"`
+	Hash   testscalars.Hash `json:"hash,omitempty"`
+	// only singular fields without explicit presence are mapped
+	Amounts []string `protobuf:"bytes,4,rep,name=amounts,proto3" json:"amounts,omitempty"`
+	Max     *string  `protobuf:"bytes,5,opt,name=max,proto3,oneof" json:"max,omitempty"`
+}
+
+func (x *ScalarCoin) Reset() {
+	*x = ScalarCoin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_scalar_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScalarCoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalarCoin) ProtoMessage() {}
+
+// Deprecated: Use ScalarCoin.ProtoReflect.Descriptor instead.
+func (*ScalarCoin) Descriptor() ([]byte, []int) {
+	return file_testpb_scalar_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScalarCoin) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *ScalarCoin) GetAmount() (v testscalars.Int) {
+	if x != nil {
+		return x.Amount
+	}
+	return v
+}
+
+func (x *ScalarCoin) GetHash() (v testscalars.Hash) {
+	if x != nil {
+		return x.Hash
+	}
+	return v
+}
+
+func (x *ScalarCoin) GetAmounts() []string {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+func (x *ScalarCoin) GetMax() string {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return ""
+}
+
+type ScalarInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Rate    string `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	// the values of maps are validated, not their keys
+	Limits map[string]string `protobuf:"bytes,3,rep,name=limits,proto3" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ScalarInput) Reset() {
+	*x = ScalarInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_scalar_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScalarInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalarInput) ProtoMessage() {}
+
+// Deprecated: Use ScalarInput.ProtoReflect.Descriptor instead.
+func (*ScalarInput) Descriptor() ([]byte, []int) {
+	return file_testpb_scalar_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScalarInput) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ScalarInput) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *ScalarInput) GetLimits() map[string]string {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type ScalarMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+	lazyFields    runtime.LazyFields
+
+	FromAddress string                  `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	Signers     []string                `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	Inputs      []*ScalarInput          `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	NamedInputs map[string]*ScalarInput `protobuf:"bytes,4,rep,name=named_inputs,json=namedInputs,proto3" json:"named_inputs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	LazyInput   *ScalarInput            `protobuf:"bytes,5,opt,name=lazy_input,json=lazyInput,proto3" json:"lazy_input,omitempty"`
+	// Types that are assignable to Recipient:
+	//	*ScalarMsg_ToAddress
+	//	*ScalarMsg_ToInput
+	Recipient  isScalarMsg_Recipient `protobuf_oneof:"recipient"`
+	Recipients map[int32]string      `protobuf:"bytes,8,rep,name=recipients,proto3" json:"recipients,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ScalarMsg) Reset() {
+	*x = ScalarMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_scalar_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScalarMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalarMsg) ProtoMessage() {}
+
+// Deprecated: Use ScalarMsg.ProtoReflect.Descriptor instead.
+func (*ScalarMsg) Descriptor() ([]byte, []int) {
+	return file_testpb_scalar_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScalarMsg) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+func (x *ScalarMsg) GetSigners() []string {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *ScalarMsg) GetInputs() []*ScalarInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *ScalarMsg) GetNamedInputs() map[string]*ScalarInput {
+	if x != nil {
+		return x.NamedInputs
+	}
+	return nil
+}
+
+func (x *ScalarMsg) GetLazyInput() *ScalarInput {
+	if x != nil {
+		x.lazyDecodeLazyInput()
+		return x.LazyInput
+	}
+	return nil
+}
+
+func (x *ScalarMsg) GetRecipient() isScalarMsg_Recipient {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+func (x *ScalarMsg) GetToAddress() string {
+	if x, ok := x.GetRecipient().(*ScalarMsg_ToAddress); ok {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *ScalarMsg) GetToInput() *ScalarInput {
+	if x, ok := x.GetRecipient().(*ScalarMsg_ToInput); ok {
+		return x.ToInput
+	}
+	return nil
+}
+
+func (x *ScalarMsg) GetRecipients() map[int32]string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *ScalarMsg) lazyDecodeLazyInput() {
+	runtime.DecodeLazy(&x.lazyFields, 0, &x.LazyInput)
+}
+
+type isScalarMsg_Recipient interface {
+	isScalarMsg_Recipient()
+}
+
+type ScalarMsg_ToAddress struct {
+	ToAddress string `protobuf:"bytes,6,opt,name=to_address,json=toAddress,proto3,oneof"`
+}
+
+type ScalarMsg_ToInput struct {
+	ToInput *ScalarInput `protobuf:"bytes,7,opt,name=to_input,json=toInput,proto3,oneof"`
+}
+
+func (*ScalarMsg_ToAddress) isScalarMsg_Recipient() {}
+
+func (*ScalarMsg_ToInput) isScalarMsg_Recipient() {}
+
+var File_testpb_scalar_proto protoreflect.FileDescriptor
+
+var file_testpb_scalar_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xac, 0x01, 0x0a, 0x0a, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x69, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xd2, 0xb4, 0x2d, 0x03, 0x49, 0x6e, 0x74, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x08, 0xd2, 0xb4, 0x2d, 0x04, 0x48, 0x61, 0x73, 0x68, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x07, 0xd2, 0xb4, 0x2d, 0x03, 0x49, 0x6e, 0x74, 0x52, 0x07,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xd2, 0xb4, 0x2d, 0x03, 0x49, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x78, 0x22,
+	0xcd, 0x01, 0x0a, 0x0b, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x2b, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x11, 0xd2, 0xb4, 0x2d, 0x0d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xd2, 0xb4, 0x2d, 0x03,
+	0x44, 0x65, 0x63, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x53, 0x63, 0x61, 0x6c,
+	0x61, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x07, 0xd2, 0xb4, 0x2d, 0x03, 0x44, 0x65, 0x63, 0x52, 0x06, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xcd, 0x04, 0x0a, 0x09, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x34, 0x0a,
+	0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0xd2, 0xb4, 0x2d, 0x0d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0xd2, 0xb4, 0x2d, 0x0d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73,
+	0x12, 0x24, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x5f,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x53,
+	0x63, 0x61, 0x6c, 0x61, 0x72, 0x4d, 0x73, 0x67, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x64,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x6c, 0x61, 0x7a, 0x79, 0x5f, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x63, 0x61,
+	0x6c, 0x61, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x02, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x61,
+	0x7a, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xd2, 0xb4, 0x2d,
+	0x0d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x00,
+	0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x74,
+	0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x07, 0x74,
+	0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x4d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x53, 0x63, 0x61,
+	0x6c, 0x61, 0x72, 0x4d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x11, 0xd2, 0xb4, 0x2d, 0x0d, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x4c, 0x0a, 0x10, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x63, 0x61,
+	0x6c, 0x61, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x42,
+	0xaa, 0x02, 0xf2, 0x9b, 0x83, 0x03, 0x47, 0x0a, 0x03, 0x49, 0x6e, 0x74, 0x12, 0x3d, 0x49, 0x6e,
+	0x74, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x20,
+	0x6f, 0x66, 0x20, 0x61, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x72, 0x79, 0x20, 0x70, 0x72, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20,
+	0x69, 0x6e, 0x20, 0x62, 0x61, 0x73, 0x65, 0x20, 0x31, 0x30, 0x2e, 0x1a, 0x01, 0x01, 0xf2, 0x9b,
+	0x83, 0x03, 0x26, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x48, 0x61, 0x73, 0x68, 0x20,
+	0x69, 0x73, 0x20, 0x61, 0x20, 0x68, 0x61, 0x73, 0x68, 0x20, 0x6f, 0x66, 0x20, 0x33, 0x32, 0x20,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x1a, 0x01, 0x02, 0xf2, 0x9b, 0x83, 0x03, 0x4a, 0x0a, 0x0d,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x73, 0x20,
+	0x61, 0x6e, 0x20, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x62, 0x65,
+	0x63, 0x68, 0x33, 0x32, 0x2e, 0x1a, 0x01, 0x01, 0xf2, 0x9b, 0x83, 0x03, 0x38, 0x0a, 0x03, 0x44,
+	0x65, 0x63, 0x12, 0x2e, 0x44, 0x65, 0x63, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x64, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x20, 0x69,
+	0x74, 0x73, 0x20, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x20, 0x66, 0x6f, 0x72,
+	0x6d, 0x2e, 0x1a, 0x01, 0x01, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_testpb_scalar_proto_rawDescOnce sync.Once
+	file_testpb_scalar_proto_rawDescData = file_testpb_scalar_proto_rawDesc
+)
+
+func file_testpb_scalar_proto_rawDescGZIP() []byte {
+	file_testpb_scalar_proto_rawDescOnce.Do(func() {
+		file_testpb_scalar_proto_rawDescData = protoimpl.X.CompressGZIP(file_testpb_scalar_proto_rawDescData)
+	})
+	return file_testpb_scalar_proto_rawDescData
+}
+
+var file_testpb_scalar_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_testpb_scalar_proto_goTypes = []interface{}{
+	(*ScalarCoin)(nil),  // 0: ScalarCoin
+	(*ScalarInput)(nil), // 1: ScalarInput
+	(*ScalarMsg)(nil),   // 2: ScalarMsg
+	nil,                 // 3: ScalarInput.LimitsEntry
+	nil,                 // 4: ScalarMsg.NamedInputsEntry
+	nil,                 // 5: ScalarMsg.RecipientsEntry
+}
+var file_testpb_scalar_proto_depIdxs = []int32{
+	3, // 0: ScalarInput.limits:type_name -> ScalarInput.LimitsEntry
+	1, // 1: ScalarMsg.inputs:type_name -> ScalarInput
+	4, // 2: ScalarMsg.named_inputs:type_name -> ScalarMsg.NamedInputsEntry
+	1, // 3: ScalarMsg.lazy_input:type_name -> ScalarInput
+	1, // 4: ScalarMsg.to_input:type_name -> ScalarInput
+	5, // 5: ScalarMsg.recipients:type_name -> ScalarMsg.RecipientsEntry
+	1, // 6: ScalarMsg.NamedInputsEntry.value:type_name -> ScalarInput
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_testpb_scalar_proto_init() }
+func file_testpb_scalar_proto_init() {
+	if File_testpb_scalar_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_testpb_scalar_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScalarCoin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testpb_scalar_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScalarInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testpb_scalar_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScalarMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.lazyFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_testpb_scalar_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_testpb_scalar_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*ScalarMsg_ToAddress)(nil),
+		(*ScalarMsg_ToInput)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_testpb_scalar_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_testpb_scalar_proto_goTypes,
+		DependencyIndexes: file_testpb_scalar_proto_depIdxs,
+		MessageInfos:      file_testpb_scalar_proto_msgTypes,
+	}.Build()
+	File_testpb_scalar_proto = out.File
+	file_testpb_scalar_proto_rawDesc = nil
+	file_testpb_scalar_proto_goTypes = nil
+	file_testpb_scalar_proto_depIdxs = nil
+}
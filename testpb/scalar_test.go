@@ -0,0 +1,151 @@
+package testpb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/internal/testscalars"
+	"github.com/cosmos/cosmos-proto/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestScalarFields(t *testing.T) {
+	// the hash is not valid UTF-8, which bytes fields do not require
+	hash := testscalars.Hash(bytes.Repeat([]byte{0xff}, 32))
+	coin := &ScalarCoin{Denom: "stake", Amount: testscalars.NewInt(-12345678901234567), Hash: hash, Amounts: []string{"1"}}
+
+	// the wire format is the one of the string and bytes fields
+	dyn := dynamicpb.NewMessage(md_ScalarCoin)
+	dyn.Set(fd_ScalarCoin_denom, protoreflect.ValueOfString("stake"))
+	dyn.Set(fd_ScalarCoin_amount, protoreflect.ValueOfString("-12345678901234567"))
+	dyn.Set(fd_ScalarCoin_hash, protoreflect.ValueOfBytes(hash))
+	dyn.Mutable(fd_ScalarCoin_amounts).List().Append(protoreflect.ValueOfString("1"))
+
+	t.Run("codec", func(t *testing.T) {
+		b, err := proto.MarshalOptions{Deterministic: true}.Marshal(coin)
+		require.NoError(t, err)
+		want, err := proto.MarshalOptions{Deterministic: true}.Marshal(dyn)
+		require.NoError(t, err)
+		require.Equal(t, want, b)
+		require.Equal(t, len(b), proto.Size(coin))
+
+		got := &ScalarCoin{}
+		require.NoError(t, proto.Unmarshal(b, got))
+		require.Equal(t, "-12345678901234567", got.GetAmount().String())
+		require.Equal(t, hash, got.GetHash())
+		require.True(t, proto.Equal(coin, got))
+
+		// unpopulated scalars are not encoded, and empty fields reset the value
+		b, err = proto.Marshal(&ScalarCoin{Denom: "stake"})
+		require.NoError(t, err)
+		require.NoError(t, proto.Unmarshal(append(b, 0x12, 0), got))
+		require.Equal(t, testscalars.Int{}, got.Amount)
+	})
+
+	t.Run("slow reflection", func(t *testing.T) {
+		// mapped fields have no protobuf struct tag, so the reflection of protoimpl
+		// treats them as missing while the fast reflection holds their Go type
+		slow := coin.slowProtoReflect()
+		require.False(t, slow.Has(fd_ScalarCoin_amount))
+		require.False(t, slow.Has(fd_ScalarCoin_hash))
+		require.Equal(t, "", slow.Get(fd_ScalarCoin_amount).String())
+		slow.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			require.NotEqual(t, fd_ScalarCoin_amount.Name(), fd.Name())
+			require.NotEqual(t, fd_ScalarCoin_hash.Name(), fd.Name())
+			return true
+		})
+		require.True(t, slow.Has(fd_ScalarCoin_denom))
+		require.True(t, slow.Has(fd_ScalarCoin_amounts))
+
+		fast := coin.ProtoReflect()
+		require.True(t, fast.Has(fd_ScalarCoin_amount))
+		require.Equal(t, "-12345678901234567", fast.Get(fd_ScalarCoin_amount).String())
+	})
+
+	t.Run("presence", func(t *testing.T) {
+		// the zero value of the Go type is not populated, whether it is comparable or not
+		require.False(t, runtime.HasScalar(&testscalars.Int{}))
+		require.False(t, runtime.HasScalar(new(testscalars.Hash)))
+		require.True(t, runtime.HasScalar(&coin.Amount))
+		require.Equal(t, 32, runtime.SizeScalar(&hash))
+		// an empty encoding is not populated either
+		empty := testscalars.Hash{}
+		require.False(t, runtime.HasScalar(&empty))
+
+		// sizing unpopulated scalars allocates nothing
+		unpopulated := &ScalarCoin{Denom: "stake"}
+		require.Zero(t, testing.AllocsPerRun(10, func() { proto.Size(unpopulated) }))
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		var scalarErr *runtime.ScalarError
+		_, err := proto.Marshal(&ScalarCoin{Hash: testscalars.Hash("short")})
+		require.ErrorAs(t, err, &scalarErr)
+		require.ErrorIs(t, err, proto.Error)
+		require.EqualValues(t, "ScalarCoin.hash", scalarErr.Field)
+
+		bad := dynamicpb.NewMessage(md_ScalarCoin)
+		bad.Set(fd_ScalarCoin_amount, protoreflect.ValueOfString("1.5"))
+		b, err := proto.Marshal(bad)
+		require.NoError(t, err)
+		err = proto.Unmarshal(b, &ScalarCoin{})
+		require.ErrorAs(t, err, &scalarErr)
+		require.EqualValues(t, "ScalarCoin.amount", scalarErr.Field)
+	})
+
+	t.Run("reflection", func(t *testing.T) {
+		m := (&ScalarCoin{}).ProtoReflect()
+		require.False(t, m.Has(fd_ScalarCoin_amount))
+		require.Equal(t, "", m.Get(fd_ScalarCoin_amount).String())
+
+		m.Set(fd_ScalarCoin_amount, protoreflect.ValueOfString("42"))
+		m.Set(fd_ScalarCoin_hash, protoreflect.ValueOfBytes(hash))
+		require.True(t, m.Has(fd_ScalarCoin_amount))
+		require.Equal(t, "42", m.Get(fd_ScalarCoin_amount).String())
+		require.Equal(t, []byte(hash), m.Get(fd_ScalarCoin_hash).Bytes())
+
+		var ranged []protoreflect.Name
+		m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			ranged = append(ranged, fd.Name())
+			return true
+		})
+		require.Equal(t, []protoreflect.Name{"amount", "hash"}, ranged)
+
+		m.Clear(fd_ScalarCoin_amount)
+		require.False(t, m.Has(fd_ScalarCoin_amount))
+		require.Panics(t, func() { m.Set(fd_ScalarCoin_hash, protoreflect.ValueOfBytes([]byte("short"))) })
+	})
+
+	t.Run("copies", func(t *testing.T) {
+		clone := coin.Clone()
+		require.True(t, proto.Equal(coin, clone))
+		clone.Hash[0] = 0xfe
+		require.Equal(t, byte(0xff), coin.Hash[0])
+		require.False(t, proto.Equal(coin, clone))
+
+		merged := &ScalarCoin{Amount: testscalars.NewInt(1)}
+		proto.Merge(merged, &ScalarCoin{Hash: hash})
+		require.Equal(t, "1", merged.Amount.String())
+		require.Equal(t, hash, merged.Hash)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := coin.MarshalJSON()
+		require.NoError(t, err)
+		want, err := protojson.Marshal(dyn)
+		require.NoError(t, err)
+		require.JSONEq(t, string(want), string(b))
+
+		got := &ScalarCoin{}
+		require.NoError(t, got.UnmarshalJSON(b))
+		require.True(t, proto.Equal(coin, got))
+
+		b, err = coin.MarshalAminoJSON()
+		require.NoError(t, err)
+		require.Contains(t, string(b), `"amount":"-12345678901234567"`)
+	})
+}
@@ -0,0 +1,138 @@
+package testpb
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/runtime"
+	"github.com/cosmos/cosmos-proto/runtime/scalarregistry"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+var errNotAddress = errors.New("not a cosmos address")
+
+func init() {
+	err := scalarregistry.Global.Register("AddressString", func(value []byte) error {
+		if !strings.HasPrefix(string(value), "cosmos1") {
+			return errNotAddress
+		}
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	canonicalDec := regexp.MustCompile(`^-?(0|[1-9][0-9]*)\.[0-9]{18}$`)
+	err = scalarregistry.Global.Register("Dec", func(value []byte) error {
+		if !canonicalDec.Match(value) {
+			return errors.New("not a canonical decimal")
+		}
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func TestScalarValidation(t *testing.T) {
+	const addr, dec = "cosmos1valid", "1.500000000000000000"
+	input := func() *ScalarInput {
+		return &ScalarInput{Address: addr, Rate: dec, Limits: map[string]string{"max": dec}}
+	}
+	valid := func() *ScalarMsg {
+		return &ScalarMsg{
+			FromAddress: addr,
+			Signers:     []string{addr, addr},
+			Inputs:      []*ScalarInput{input(), input()},
+			NamedInputs: map[string]*ScalarInput{"a": input(), "b": input()},
+			LazyInput:   input(),
+			Recipient:   &ScalarMsg_ToAddress{ToAddress: addr},
+			Recipients:  map[int32]string{1: addr, 2: addr},
+		}
+	}
+
+	b, err := proto.Marshal(valid())
+	require.NoError(t, err)
+	got := &ScalarMsg{}
+	require.NoError(t, proto.Unmarshal(b, got))
+	require.True(t, proto.Equal(valid(), got))
+
+	for _, tc := range []struct {
+		name   string
+		modify func(m *ScalarMsg)
+		path   string
+		scalar string
+	}{
+		{"singular", func(m *ScalarMsg) { m.FromAddress = "bad" }, "from_address", "AddressString"},
+		{"list", func(m *ScalarMsg) { m.Signers[1] = "bad" }, "signers[1]", "AddressString"},
+		{"oneof", func(m *ScalarMsg) { m.Recipient = &ScalarMsg_ToAddress{ToAddress: "bad"} }, "to_address", "AddressString"},
+		{"message list", func(m *ScalarMsg) { m.Inputs[1].Rate = "1.5" }, "inputs[1].rate", "Dec"},
+		{"map", func(m *ScalarMsg) { m.NamedInputs["b"].Address = "bad" }, "named_inputs[b].address", "AddressString"},
+		{"oneof message", func(m *ScalarMsg) { m.Recipient = &ScalarMsg_ToInput{ToInput: &ScalarInput{Rate: "01.5"}} }, "to_input.rate", "Dec"},
+		{"lazy", func(m *ScalarMsg) { m.LazyInput.Address = "bad" }, "lazy_input.address", "AddressString"},
+		{"map value", func(m *ScalarMsg) { m.Recipients[2] = "bad" }, "recipients[2]", "AddressString"},
+		{"nested map value", func(m *ScalarMsg) { m.Inputs[0].Limits["max"] = "1.5" }, "inputs[0].limits[max]", "Dec"},
+		{"lazy map value", func(m *ScalarMsg) { m.LazyInput.Limits["min"] = "1.5" }, "lazy_input.limits[min]", "Dec"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m := valid()
+			tc.modify(m)
+			b, err := proto.Marshal(m)
+			require.NoError(t, err)
+
+			err = proto.Unmarshal(b, &ScalarMsg{})
+			var invalid *runtime.InvalidScalarError
+			require.ErrorAs(t, err, &invalid)
+			require.ErrorIs(t, err, proto.Error)
+			require.EqualValues(t, "ScalarMsg", invalid.Message)
+			require.Equal(t, tc.path, invalid.Path)
+			require.Equal(t, tc.scalar, invalid.Scalar)
+		})
+	}
+
+	t.Run("map value before key", func(t *testing.T) {
+		value, err := proto.Marshal(&ScalarInput{Address: "bad"})
+		require.NoError(t, err)
+		entry := protowire.AppendBytes(protowire.AppendTag(nil, 2, protowire.BytesType), value)
+		entry = protowire.AppendString(protowire.AppendTag(entry, 1, protowire.BytesType), "c")
+		b := protowire.AppendBytes(protowire.AppendTag(nil, 4, protowire.BytesType), entry)
+
+		err = proto.Unmarshal(b, &ScalarMsg{})
+		var invalid *runtime.InvalidScalarError
+		require.ErrorAs(t, err, &invalid)
+		require.Equal(t, "named_inputs[c].address", invalid.Path)
+	})
+
+	t.Run("missing map value", func(t *testing.T) {
+		// the value of an entry without one is empty, which is validated as well
+		entry := protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 3)
+		b := protowire.AppendBytes(protowire.AppendTag(nil, 8, protowire.BytesType), entry)
+
+		err := proto.Unmarshal(b, &ScalarMsg{})
+		var invalid *runtime.InvalidScalarError
+		require.ErrorAs(t, err, &invalid)
+		require.Equal(t, "recipients[3]", invalid.Path)
+	})
+
+	t.Run("validator error", func(t *testing.T) {
+		b, err := proto.Marshal(&ScalarMsg{FromAddress: "bad"})
+		require.NoError(t, err)
+		err = proto.Unmarshal(b, &ScalarMsg{})
+		require.ErrorIs(t, err, errNotAddress)
+		require.EqualError(t, err, "proto: invalid AddressString value for ScalarMsg.from_address: not a cosmos address")
+	})
+
+	t.Run("registry", func(t *testing.T) {
+		require.Subset(t, scalarregistry.Global.Scalars(), []string{"AddressString", "Dec"})
+		require.Error(t, scalarregistry.Global.Register("Dec", func([]byte) error { return nil }))
+		require.Nil(t, scalarregistry.Global.Validator("Int"))
+
+		var r scalarregistry.Registry
+		require.Error(t, r.Register("Dec", nil))
+		require.NoError(t, r.Register("Dec", func([]byte) error { return nil }))
+		require.Equal(t, []string{"Dec"}, r.Scalars())
+	})
+}
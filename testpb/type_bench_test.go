@@ -3,6 +3,7 @@ package testpb
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-proto/internal/testprotos/test3"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -70,3 +71,116 @@ func Benchmark_MessageType_Descriptor_SR(b *testing.B) {
 		_ = typ.Descriptor()
 	}
 }
+
+// dispatchMsg returns a populated message, whose fields are accessed by the field dispatch benchmarks
+// the way generic code over protoreflect.Message does.
+func dispatchMsg() *A {
+	return &A{
+		Enum:      Enumeration_Two,
+		INT32:     2,
+		UINT64:    7,
+		DOUBLE:    13,
+		STRING:    "fourteen",
+		BYTES:     []byte("fifteen"),
+		MESSAGE:   &B{X: "something"},
+		MAP:       map[string]*B{"a": {X: "aa"}},
+		LIST:      []*B{{X: "list"}},
+		ONEOF:     &A_ONEOF_B{ONEOF_B: &B{X: "ONEOF"}},
+		LIST_ENUM: []Enumeration{Enumeration_One},
+	}
+}
+
+func benchmarkFieldDispatch(b *testing.B, msg protoreflect.Message, f func(protoreflect.Message, protoreflect.FieldDescriptor)) {
+	fields := msg.Descriptor().Fields()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < fields.Len(); j++ {
+			f(msg, fields.Get(j))
+		}
+	}
+}
+
+// benchmarkFastFieldDispatch also dispatches on the fields of a message declared in a package,
+// whose long field names are costly to compare.
+func benchmarkFastFieldDispatch(b *testing.B, f func(protoreflect.Message, protoreflect.FieldDescriptor)) {
+	b.Run("A", func(b *testing.B) {
+		benchmarkFieldDispatch(b, dispatchMsg().ProtoReflect(), f)
+	})
+	b.Run("TestAllTypes", func(b *testing.B) {
+		benchmarkFieldDispatch(b, (&test3.TestAllTypes{SingularNestedMessage: &test3.TestAllTypes_NestedMessage{}}).ProtoReflect(), f)
+	})
+}
+
+func hasField(m protoreflect.Message, fd protoreflect.FieldDescriptor) { _ = m.Has(fd) }
+
+func getField(m protoreflect.Message, fd protoreflect.FieldDescriptor) { _ = m.Get(fd) }
+
+func setField(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+	if m.Has(fd) {
+		m.Set(fd, m.Get(fd))
+	}
+}
+
+func newField(m protoreflect.Message, fd protoreflect.FieldDescriptor) { _ = m.NewField(fd) }
+
+func mutableField(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+	if fd.Message() != nil || fd.IsList() {
+		_ = m.Mutable(fd)
+	}
+}
+
+func clearField(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+	if !fd.HasPresence() && !fd.IsList() && !fd.IsMap() {
+		m.Clear(fd)
+	}
+}
+
+func Benchmark_FieldDispatch_Has_FR(b *testing.B) {
+	benchmarkFastFieldDispatch(b, hasField)
+}
+
+func Benchmark_FieldDispatch_Has_SR(b *testing.B) {
+	benchmarkFieldDispatch(b, dispatchMsg().slowProtoReflect(), hasField)
+}
+
+func Benchmark_FieldDispatch_Get_FR(b *testing.B) {
+	benchmarkFastFieldDispatch(b, getField)
+}
+
+func Benchmark_FieldDispatch_Get_SR(b *testing.B) {
+	benchmarkFieldDispatch(b, dispatchMsg().slowProtoReflect(), getField)
+}
+
+func Benchmark_FieldDispatch_Set_FR(b *testing.B) {
+	benchmarkFastFieldDispatch(b, setField)
+}
+
+func Benchmark_FieldDispatch_Set_SR(b *testing.B) {
+	benchmarkFieldDispatch(b, dispatchMsg().slowProtoReflect(), setField)
+}
+
+func Benchmark_FieldDispatch_Clear_FR(b *testing.B) {
+	benchmarkFastFieldDispatch(b, clearField)
+}
+
+func Benchmark_FieldDispatch_Clear_SR(b *testing.B) {
+	benchmarkFieldDispatch(b, dispatchMsg().slowProtoReflect(), clearField)
+}
+
+func Benchmark_FieldDispatch_Mutable_FR(b *testing.B) {
+	benchmarkFastFieldDispatch(b, mutableField)
+}
+
+func Benchmark_FieldDispatch_Mutable_SR(b *testing.B) {
+	benchmarkFieldDispatch(b, dispatchMsg().slowProtoReflect(), mutableField)
+}
+
+func Benchmark_FieldDispatch_NewField_FR(b *testing.B) {
+	benchmarkFastFieldDispatch(b, newField)
+}
+
+func Benchmark_FieldDispatch_NewField_SR(b *testing.B) {
+	benchmarkFieldDispatch(b, dispatchMsg().slowProtoReflect(), newField)
+}
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 )
 
 func TestType(t *testing.T) {
@@ -13,3 +16,25 @@ func TestType(t *testing.T) {
 	require.Equal(t, sTyp.Descriptor(), typ.Descriptor())         // assert descriptor equality
 	require.Equal(t, sTyp.Zero().IsValid(), typ.Zero().IsValid()) // assert invalidity
 }
+
+func TestFieldDescriptorsFromAnotherSource(t *testing.T) {
+	// descriptors built again from the file describe the same fields without being identical
+	file, err := protodesc.NewFile(protodesc.ToFileDescriptorProto(File_testpb_1_proto), protoregistry.GlobalFiles)
+	require.NoError(t, err)
+	md := file.Messages().ByName("A")
+	require.NotEqual(t, md_A, md)
+
+	msg := (&A{}).ProtoReflect()
+	msg.Set(md.Fields().ByName("INT32"), protoreflect.ValueOfInt32(1))
+	msg.Mutable(md.Fields().ByName("LIST")).List().Append(protoreflect.ValueOfMessage((&B{X: "x"}).ProtoReflect()))
+	require.True(t, msg.Has(md.Fields().ByName("INT32")))
+	require.Equal(t, int64(1), msg.Get(md.Fields().ByName("INT32")).Int())
+	require.Equal(t, 1, msg.Get(fd_A_LIST).List().Len())
+	require.Equal(t, "", msg.NewField(md.Fields().ByName("STRING")).String())
+
+	msg.Clear(md.Fields().ByName("INT32"))
+	require.False(t, msg.Has(fd_A_INT32))
+
+	// fields of other messages with the same number do not belong to the message
+	require.Panics(t, func() { msg.Has(md_B.Fields().ByNumber(1)) })
+}
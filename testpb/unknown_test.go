@@ -0,0 +1,87 @@
+package testpb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// anyWithUnknown returns a google.protobuf.Any packing a B which holds an unknown
+// varint field numbered num.
+func anyWithUnknown(t *testing.T, num protowire.Number) *anypb.Any {
+	value, err := proto.Marshal(&B{X: "b"})
+	require.NoError(t, err)
+	value = protowire.AppendTag(value, num, protowire.VarintType)
+	value = protowire.AppendVarint(value, 1)
+	return &anypb.Any{TypeUrl: "type.googleapis.com/B", Value: value}
+}
+
+func TestRejectUnknownAny(t *testing.T) {
+	strict := runtime.RejectUnknown(proto.UnmarshalOptions{}, false)
+	nonCritical := runtime.RejectUnknown(proto.UnmarshalOptions{}, true)
+
+	for name, msg := range map[string]*JSONWellKnown{
+		"singular": {Any: anyWithUnknown(t, 5)},
+		"repeated": {Anys: []*anypb.Any{{}, anyWithUnknown(t, 5)}},
+		"map":      {AnyValues: map[string]*anypb.Any{"key": anyWithUnknown(t, 5)}},
+		"nested": {Any: func() *anypb.Any {
+			nested, err := anypb.New(&JSONWellKnown{Any: anyWithUnknown(t, 5)})
+			require.NoError(t, err)
+			return nested
+		}()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			b, err := proto.Marshal(msg)
+			require.NoError(t, err)
+			require.NoError(t, proto.Unmarshal(b, &JSONWellKnown{}))
+
+			err = strict.Unmarshal(b, &JSONWellKnown{})
+			var unknown *runtime.UnknownFieldError
+			require.True(t, errors.As(err, &unknown), "%v", err)
+			require.Equal(t, protoreflect.FullName("B"), unknown.Message)
+			require.Equal(t, protoreflect.FieldNumber(5), unknown.Number)
+			require.Error(t, nonCritical.Unmarshal(b, &JSONWellKnown{}))
+		})
+	}
+
+	t.Run("non-critical", func(t *testing.T) {
+		b, err := proto.Marshal(&JSONWellKnown{Any: anyWithUnknown(t, 1025)})
+		require.NoError(t, err)
+		require.Error(t, strict.Unmarshal(b, &JSONWellKnown{}))
+		m := &JSONWellKnown{}
+		require.NoError(t, nonCritical.Unmarshal(b, m))
+		// the packed value is left untouched
+		require.True(t, proto.Equal(anyWithUnknown(t, 1025), m.Any))
+	})
+
+	t.Run("unresolvable", func(t *testing.T) {
+		b, err := proto.Marshal(&JSONWellKnown{Any: &anypb.Any{TypeUrl: "type.googleapis.com/Unknown"}})
+		require.NoError(t, err)
+		require.NoError(t, proto.Unmarshal(b, &JSONWellKnown{}))
+		require.ErrorIs(t, strict.Unmarshal(b, &JSONWellKnown{}), protoregistry.NotFound)
+
+		// types are resolved through the resolver of the options
+		b, err = proto.Marshal(&JSONWellKnown{Any: anyWithUnknown(t, 5)})
+		require.NoError(t, err)
+		options := runtime.RejectUnknown(proto.UnmarshalOptions{Resolver: new(protoregistry.Types)}, false)
+		require.ErrorIs(t, options.Unmarshal(b, &JSONWellKnown{}), protoregistry.NotFound)
+	})
+
+	t.Run("recursion limit", func(t *testing.T) {
+		b, err := proto.Marshal(&JSONWellKnown{Any: anyWithUnknown(t, 1025)})
+		require.NoError(t, err)
+		options := nonCritical
+		options.RecursionLimit = 3
+		require.NoError(t, options.Unmarshal(b, &JSONWellKnown{}))
+		options.RecursionLimit = 2
+		var limit *runtime.RecursionLimitError
+		require.True(t, errors.As(options.Unmarshal(b, &JSONWellKnown{}), &limit))
+	})
+}
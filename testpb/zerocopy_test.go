@@ -0,0 +1,110 @@
+package testpb
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestZeroCopyUnmarshal(t *testing.T) {
+	msg := newBenchMsg()
+	msg.ProtoReflect().SetUnknown([]byte{0xf8, 0x7f, 0x01})
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+
+	options := runtime.ZeroCopy(proto.UnmarshalOptions{})
+	require.True(t, runtime.IsZeroCopy(options))
+	require.False(t, runtime.IsZeroCopy(proto.UnmarshalOptions{}))
+
+	aliased := &A{}
+	require.NoError(t, options.Unmarshal(bz, aliased))
+	require.True(t, proto.Equal(msg, aliased))
+	copied := &A{}
+	require.NoError(t, proto.Unmarshal(bz, copied))
+
+	// overwriting the buffer changes the aliasing message only
+	for i := range bz {
+		bz[i] = 'x'
+	}
+	require.Equal(t, "xxxxxxxx", aliased.STRING)
+	require.Equal(t, []byte("xxxxxxx"), aliased.BYTES)
+	require.Equal(t, "xxxxxxxxx", aliased.MESSAGE.X)
+	require.Equal(t, "xxxxx", aliased.ONEOF.(*A_ONEOF_B).ONEOF_B.X)
+	for k, v := range aliased.MAP {
+		require.Equal(t, "x", k)
+		require.Equal(t, "xx", v.X)
+	}
+	require.True(t, proto.Equal(msg, copied))
+
+	// unknown fields are still copied
+	require.Equal(t, []byte{0xf8, 0x7f, 0x01}, []byte(aliased.ProtoReflect().GetUnknown()))
+
+	// appending to an aliased bytes field does not write into the buffer
+	require.Equal(t, len(aliased.BYTES), cap(aliased.BYTES))
+}
+
+func TestZeroCopyOptions(t *testing.T) {
+	options := runtime.ZeroCopy(proto.UnmarshalOptions{DiscardUnknown: true})
+	require.True(t, options.DiscardUnknown)
+	require.Equal(t, options, runtime.ZeroCopy(options))
+
+	msg := &A{STRING: "string"}
+	msg.ProtoReflect().SetUnknown([]byte{0xf8, 0x7f, 0x01})
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	got := &A{}
+	require.NoError(t, options.Unmarshal(bz, got))
+	require.Equal(t, "string", got.STRING)
+	require.Empty(t, got.ProtoReflect().GetUnknown())
+}
+
+func TestZeroCopyBufferNotReused(t *testing.T) {
+	bz, err := proto.Marshal(&A{BYTES: []byte("hello world")})
+	require.NoError(t, err)
+	other, err := proto.Marshal(&A{BYTES: []byte("XXXXX")})
+	require.NoError(t, err)
+	want := append([]byte(nil), bz...)
+
+	// unmarshalling again into the message does not write into the aliased buffer
+	msg := &A{}
+	require.NoError(t, runtime.ZeroCopy(proto.UnmarshalOptions{}).Unmarshal(bz, msg))
+	require.NoError(t, proto.UnmarshalOptions{Merge: true}.Unmarshal(other, msg))
+	require.Equal(t, []byte("XXXXX"), msg.BYTES)
+	require.Equal(t, want, bz)
+
+	// neither does unmarshalling into a message reset for its pool
+	bz, err = proto.Marshal(&PoolableMessage{Data: []byte("hello world"), Children: []*PoolableChild{{Payload: []byte("hello world")}}})
+	require.NoError(t, err)
+	other, err = proto.Marshal(&PoolableMessage{Data: []byte("XXXXX"), Children: []*PoolableChild{{Payload: []byte("XXXXX")}}})
+	require.NoError(t, err)
+	want = append([]byte(nil), bz...)
+
+	pooled := PoolableMessageFromPool()
+	require.NoError(t, runtime.ZeroCopy(proto.UnmarshalOptions{Merge: true}).Unmarshal(bz, pooled))
+	pooled.ResetKeepCapacity()
+	require.Nil(t, pooled.Data)
+	require.NoError(t, proto.UnmarshalOptions{Merge: true}.Unmarshal(other, pooled))
+	require.Equal(t, []byte("XXXXX"), pooled.Children[0].Payload)
+	require.Equal(t, want, bz)
+	pooled.ReturnToPool()
+}
+
+func TestUnmarshalReusesBytes(t *testing.T) {
+	bz, err := proto.Marshal(&A{BYTES: []byte("hello")})
+	require.NoError(t, err)
+
+	// copying unmarshals reuse the memory of the bytes fields
+	msg := &A{BYTES: make([]byte, 0, 16)}
+	data := &msg.BYTES[:1][0]
+	require.NoError(t, proto.UnmarshalOptions{Merge: true}.Unmarshal(bz, msg))
+	require.Equal(t, []byte("hello"), msg.BYTES)
+	require.Same(t, data, &msg.BYTES[0])
+
+	// until the message was given to a zero-copy unmarshal
+	require.NoError(t, runtime.ZeroCopy(proto.UnmarshalOptions{Merge: true}).Unmarshal(bz, msg))
+	require.Same(t, &bz[len(bz)-5], &msg.BYTES[0])
+	require.NoError(t, proto.UnmarshalOptions{Merge: true}.Unmarshal(bz, msg))
+	require.NotSame(t, &bz[len(bz)-5], &msg.BYTES[0])
+}